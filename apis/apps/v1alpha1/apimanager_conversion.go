@@ -0,0 +1,1458 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	appsv1beta1 "github.com/3scale/3scale-operator/apis/apps/v1beta1"
+)
+
+const (
+	// V1beta1SpecAnnotation keeps the v1beta1 spec of an APIManager read as v1alpha1
+	// when the v1alpha1 spec cannot represent it exactly,
+	// e.g. empty groups like `networking: {}`.
+	// The annotation is only used while the v1alpha1 spec is not changed
+	V1beta1SpecAnnotation = "apps.3scale.net/v1beta1-spec"
+)
+
+var _ conversion.Convertible = &APIManager{}
+
+// ConvertTo converts this APIManager to the hub version (v1beta1).
+// Every v1alpha1 field has a v1beta1 counterpart, so the conversion does not lose data
+func (src *APIManager) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*appsv1beta1.APIManager)
+	in := src.DeepCopy()
+
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec = apimanagerSpecToV1beta1(&in.Spec)
+	dst.Status = apimanagerStatusToV1beta1(&in.Status)
+
+	restored, ok := dst.Annotations[V1beta1SpecAnnotation]
+	if !ok {
+		return nil
+	}
+
+	delete(dst.Annotations, V1beta1SpecAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	restoredSpec := appsv1beta1.APIManagerSpec{}
+	if err := json.Unmarshal([]byte(restored), &restoredSpec); err != nil {
+		// Not written by the operator, the v1alpha1 spec is used
+		return nil
+	}
+
+	// The restored spec is only valid when the v1alpha1 spec was not changed since
+	if equality.Semantic.DeepEqual(apimanagerSpecFromV1beta1(&restoredSpec), in.Spec) {
+		dst.Spec = restoredSpec
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version.
+// Fields added in v1beta1 with no v1alpha1 counterpart must be kept in the V1beta1SpecAnnotation
+// like the v1beta1 groups are
+func (dst *APIManager) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*appsv1beta1.APIManager)
+	in := src.DeepCopy()
+
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec = apimanagerSpecFromV1beta1(&in.Spec)
+	dst.Status = apimanagerStatusFromV1beta1(&in.Status)
+
+	if equality.Semantic.DeepEqual(apimanagerSpecToV1beta1(dst.Spec.DeepCopy()), src.Spec) {
+		return nil
+	}
+
+	raw, err := json.Marshal(src.Spec)
+	if err != nil {
+		return err
+	}
+
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[V1beta1SpecAnnotation] = string(raw)
+
+	return nil
+}
+
+// isEmpty returns true when the struct pointed by obj has every field unset.
+// The v1beta1 groups are only set when they have content
+func isEmpty(obj interface{}) bool {
+	return reflect.ValueOf(obj).Elem().IsZero()
+}
+
+// apimanagerSpecToV1beta1 moves the settings of each component to the v1beta1 groups.
+// The v1beta1 workloads group keeps whether the component is set in v1alpha1,
+// the other groups are only set when they have content
+func apimanagerSpecToV1beta1(in *APIManagerSpec) appsv1beta1.APIManagerSpec {
+	out := appsv1beta1.APIManagerSpec{
+		WildcardDomain: in.WildcardDomain,
+		AppLabel:       in.AppLabel,
+		Labels:         in.Labels,
+		TenantName:     in.TenantName,
+		Mode:           in.Mode,
+		Hibernated:     in.Hibernated,
+		GatewayOnly:    (*appsv1beta1.GatewayOnlySpec)(in.GatewayOnly),
+	}
+
+	workloads := &appsv1beta1.WorkloadsSpec{
+		ImageStreamTagImportInsecure: in.ImageStreamTagImportInsecure,
+		ResourceRequirementsEnabled:  in.ResourceRequirementsEnabled,
+		ImagePullSecrets:             in.ImagePullSecrets,
+		ImagePullSecretsPolicy:       in.ImagePullSecretsPolicy,
+		ImageRegistryOverride:        (*appsv1beta1.ImageRegistryOverrideSpec)(in.ImageRegistryOverride),
+		TerminationMessagePolicy:     in.TerminationMessagePolicy,
+		PodSecurityStandard:          in.PodSecurityStandard,
+		ImagePullPolicy:              in.ImagePullPolicy,
+		UnreachableTolerationSeconds: in.UnreachableTolerationSeconds,
+		JobsTemplate:                 (*appsv1beta1.JobsTemplateSpec)(in.JobsTemplate),
+		ServiceAccounts:              serviceAccountsToV1beta1(in.ServiceAccounts),
+		PodDisruptionBudget:          podDisruptionBudgetToV1beta1(in.PodDisruptionBudget),
+		Shutdown:                     (*appsv1beta1.ShutdownSpec)(in.Shutdown),
+	}
+	networking := &appsv1beta1.NetworkingSpec{
+		ExternalBackend: (*appsv1beta1.ExternalBackendSpec)(in.ExternalBackend),
+	}
+	storage := &appsv1beta1.StorageSpec{
+		ExternalComponents: externalComponentsToV1beta1(in.ExternalComponents),
+		HighAvailability:   (*appsv1beta1.HighAvailabilitySpec)(in.HighAvailability),
+	}
+	security := &appsv1beta1.SecuritySpec{}
+
+	if in.Apicast != nil {
+		apicastToV1beta1(in.Apicast, workloads, networking)
+	}
+	if in.Backend != nil {
+		backendToV1beta1(in.Backend, workloads, storage)
+	}
+	if in.System != nil {
+		systemToV1beta1(in.System, workloads, networking, storage, security)
+	}
+	if in.Zync != nil {
+		zyncToV1beta1(in.Zync, workloads, networking, storage)
+	}
+
+	if !isEmpty(workloads) {
+		out.Workloads = workloads
+	}
+	if !isEmpty(networking) {
+		out.Networking = networking
+	}
+	if !isEmpty(storage) {
+		out.Storage = storage
+	}
+	if !isEmpty(security) {
+		out.Security = security
+	}
+
+	out.Monitoring = monitoringToV1beta1(in.Monitoring, in.Metrics)
+	out.Databases = databasesToV1beta1(in.Databases)
+
+	return out
+}
+
+// apimanagerSpecFromV1beta1 merges the v1beta1 groups back into the settings of each component
+func apimanagerSpecFromV1beta1(in *appsv1beta1.APIManagerSpec) APIManagerSpec {
+	out := APIManagerSpec{
+		APIManagerCommonSpec: APIManagerCommonSpec{
+			WildcardDomain: in.WildcardDomain,
+			AppLabel:       in.AppLabel,
+			Labels:         in.Labels,
+			TenantName:     in.TenantName,
+		},
+		Mode:        in.Mode,
+		Hibernated:  in.Hibernated,
+		GatewayOnly: (*GatewayOnlySpec)(in.GatewayOnly),
+	}
+
+	workloads := in.Workloads
+	if workloads == nil {
+		workloads = &appsv1beta1.WorkloadsSpec{}
+	}
+	networking := in.Networking
+	if networking == nil {
+		networking = &appsv1beta1.NetworkingSpec{}
+	}
+	storage := in.Storage
+	if storage == nil {
+		storage = &appsv1beta1.StorageSpec{}
+	}
+	security := in.Security
+	if security == nil {
+		security = &appsv1beta1.SecuritySpec{}
+	}
+
+	out.ImageStreamTagImportInsecure = workloads.ImageStreamTagImportInsecure
+	out.ResourceRequirementsEnabled = workloads.ResourceRequirementsEnabled
+	out.ImagePullSecrets = workloads.ImagePullSecrets
+	out.ImagePullSecretsPolicy = workloads.ImagePullSecretsPolicy
+	out.ImageRegistryOverride = (*ImageRegistryOverrideSpec)(workloads.ImageRegistryOverride)
+	out.TerminationMessagePolicy = workloads.TerminationMessagePolicy
+	out.PodSecurityStandard = workloads.PodSecurityStandard
+	out.ImagePullPolicy = workloads.ImagePullPolicy
+	out.UnreachableTolerationSeconds = workloads.UnreachableTolerationSeconds
+	out.JobsTemplate = (*JobsTemplateSpec)(workloads.JobsTemplate)
+	out.ServiceAccounts = serviceAccountsFromV1beta1(workloads.ServiceAccounts)
+	out.PodDisruptionBudget = podDisruptionBudgetFromV1beta1(workloads.PodDisruptionBudget)
+	out.Shutdown = (*ShutdownSpec)(workloads.Shutdown)
+
+	out.ExternalBackend = (*ExternalBackendSpec)(networking.ExternalBackend)
+	out.ExternalComponents = externalComponentsFromV1beta1(storage.ExternalComponents)
+	out.HighAvailability = (*HighAvailabilitySpec)(storage.HighAvailability)
+
+	out.Apicast = apicastFromV1beta1(workloads, networking)
+	out.Backend = backendFromV1beta1(workloads, storage)
+	out.System = systemFromV1beta1(workloads, networking, storage, security)
+	out.Zync = zyncFromV1beta1(workloads, networking, storage)
+
+	out.Monitoring, out.Metrics = monitoringFromV1beta1(in.Monitoring)
+	out.Databases = databasesFromV1beta1(in.Databases)
+
+	return out
+}
+
+func apicastToV1beta1(in *ApicastSpec, workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec) {
+	workloads.Apicast = &appsv1beta1.ApicastSpec{
+		Image:         in.Image,
+		ManagementAPI: in.ApicastManagementAPI,
+		OpenSSLVerify: in.OpenSSLVerify,
+		ResponseCodes: in.IncludeResponseCodes,
+		RegistryURL:   in.RegistryURL,
+	}
+
+	apicastNetworking := &appsv1beta1.ApicastNetworkingSpec{}
+
+	if in.ProductionSpec != nil {
+		production := in.ProductionSpec
+		workloads.Apicast.Production = &appsv1beta1.ApicastProductionSpec{
+			PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(production.PodTemplateOverridesSpec),
+			Replicas:                 production.Replicas,
+			HPA:                      (*appsv1beta1.HorizontalPodAutoscalerSpec)(production.HPA),
+			Probes:                   probesToV1beta1(production.Probes),
+			WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(production.WaitInitContainer),
+			Resources:                production.Resources,
+			Workers:                  production.Workers,
+			LogLevel:                 production.LogLevel,
+			CustomPolicies:           customPoliciesToV1beta1(production.CustomPolicies),
+			OpenTracing:              (*appsv1beta1.APIcastOpenTracingSpec)(production.OpenTracing),
+			CustomEnvironments:       customEnvironmentsToV1beta1(production.CustomEnvironments),
+			Warmup:                   warmupToV1beta1(production.Warmup),
+		}
+
+		productionNetworking := &appsv1beta1.ApicastProductionNetworkingSpec{
+			HTTPSPort:                    production.HTTPSPort,
+			HTTPSVerifyDepth:             production.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef:    production.HTTPSCertificateSecretRef,
+			ClientTLS:                    (*appsv1beta1.APIcastClientTLSSpec)(production.ClientTLS),
+			AllProxy:                     production.AllProxy,
+			HTTPProxy:                    production.HTTPProxy,
+			HTTPSProxy:                   production.HTTPSProxy,
+			NoProxy:                      production.NoProxy,
+			LBDeregistrationDelaySeconds: production.LBDeregistrationDelaySeconds,
+			ReadinessGates:               production.ReadinessGates,
+			AWSLoadBalancer:              (*appsv1beta1.APIcastAWSLoadBalancerSpec)(production.AWSLoadBalancer),
+		}
+		if !isEmpty(productionNetworking) {
+			apicastNetworking.Production = productionNetworking
+		}
+	}
+
+	if in.StagingSpec != nil {
+		staging := in.StagingSpec
+		workloads.Apicast.Staging = &appsv1beta1.ApicastStagingSpec{
+			PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(staging.PodTemplateOverridesSpec),
+			Replicas:                 staging.Replicas,
+			Probes:                   probesToV1beta1(staging.Probes),
+			Resources:                staging.Resources,
+			LogLevel:                 staging.LogLevel,
+			CustomPolicies:           customPoliciesToV1beta1(staging.CustomPolicies),
+			OpenTracing:              (*appsv1beta1.APIcastOpenTracingSpec)(staging.OpenTracing),
+			CustomEnvironments:       customEnvironmentsToV1beta1(staging.CustomEnvironments),
+			Warmup:                   warmupToV1beta1(staging.Warmup),
+		}
+
+		stagingNetworking := &appsv1beta1.ApicastStagingNetworkingSpec{
+			HTTPSPort:                    staging.HTTPSPort,
+			HTTPSVerifyDepth:             staging.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef:    staging.HTTPSCertificateSecretRef,
+			AllProxy:                     staging.AllProxy,
+			HTTPProxy:                    staging.HTTPProxy,
+			HTTPSProxy:                   staging.HTTPSProxy,
+			NoProxy:                      staging.NoProxy,
+			LBDeregistrationDelaySeconds: staging.LBDeregistrationDelaySeconds,
+			ReadinessGates:               staging.ReadinessGates,
+			AWSLoadBalancer:              (*appsv1beta1.APIcastAWSLoadBalancerSpec)(staging.AWSLoadBalancer),
+		}
+		if !isEmpty(stagingNetworking) {
+			apicastNetworking.Staging = stagingNetworking
+		}
+	}
+
+	if !isEmpty(apicastNetworking) {
+		networking.Apicast = apicastNetworking
+	}
+}
+
+func apicastFromV1beta1(workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec) *ApicastSpec {
+	apicast := workloads.Apicast
+	apicastNetworking := networking.Apicast
+	if apicast == nil && apicastNetworking == nil {
+		return nil
+	}
+	if apicast == nil {
+		apicast = &appsv1beta1.ApicastSpec{}
+	}
+	if apicastNetworking == nil {
+		apicastNetworking = &appsv1beta1.ApicastNetworkingSpec{}
+	}
+
+	out := &ApicastSpec{
+		ApicastManagementAPI: apicast.ManagementAPI,
+		OpenSSLVerify:        apicast.OpenSSLVerify,
+		IncludeResponseCodes: apicast.ResponseCodes,
+		RegistryURL:          apicast.RegistryURL,
+		Image:                apicast.Image,
+	}
+
+	if apicast.Production != nil || apicastNetworking.Production != nil {
+		production := apicast.Production
+		if production == nil {
+			production = &appsv1beta1.ApicastProductionSpec{}
+		}
+		productionNetworking := apicastNetworking.Production
+		if productionNetworking == nil {
+			productionNetworking = &appsv1beta1.ApicastProductionNetworkingSpec{}
+		}
+		out.ProductionSpec = &ApicastProductionSpec{
+			PodTemplateOverridesSpec:     podTemplateOverridesFromV1beta1(production.PodTemplateOverridesSpec),
+			Replicas:                     production.Replicas,
+			HPA:                          (*HorizontalPodAutoscalerSpec)(production.HPA),
+			Probes:                       probesFromV1beta1(production.Probes),
+			WaitInitContainer:            (*InitContainerSpec)(production.WaitInitContainer),
+			Resources:                    production.Resources,
+			Workers:                      production.Workers,
+			LogLevel:                     production.LogLevel,
+			CustomPolicies:               customPoliciesFromV1beta1(production.CustomPolicies),
+			OpenTracing:                  (*APIcastOpenTracingSpec)(production.OpenTracing),
+			CustomEnvironments:           customEnvironmentsFromV1beta1(production.CustomEnvironments),
+			HTTPSPort:                    productionNetworking.HTTPSPort,
+			HTTPSVerifyDepth:             productionNetworking.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef:    productionNetworking.HTTPSCertificateSecretRef,
+			AllProxy:                     productionNetworking.AllProxy,
+			HTTPProxy:                    productionNetworking.HTTPProxy,
+			HTTPSProxy:                   productionNetworking.HTTPSProxy,
+			NoProxy:                      productionNetworking.NoProxy,
+			ClientTLS:                    (*APIcastClientTLSSpec)(productionNetworking.ClientTLS),
+			Warmup:                       warmupFromV1beta1(production.Warmup),
+			LBDeregistrationDelaySeconds: productionNetworking.LBDeregistrationDelaySeconds,
+			ReadinessGates:               productionNetworking.ReadinessGates,
+			AWSLoadBalancer:              (*APIcastAWSLoadBalancerSpec)(productionNetworking.AWSLoadBalancer),
+		}
+	}
+
+	if apicast.Staging != nil || apicastNetworking.Staging != nil {
+		staging := apicast.Staging
+		if staging == nil {
+			staging = &appsv1beta1.ApicastStagingSpec{}
+		}
+		stagingNetworking := apicastNetworking.Staging
+		if stagingNetworking == nil {
+			stagingNetworking = &appsv1beta1.ApicastStagingNetworkingSpec{}
+		}
+		out.StagingSpec = &ApicastStagingSpec{
+			PodTemplateOverridesSpec:     podTemplateOverridesFromV1beta1(staging.PodTemplateOverridesSpec),
+			Replicas:                     staging.Replicas,
+			Probes:                       probesFromV1beta1(staging.Probes),
+			Resources:                    staging.Resources,
+			LogLevel:                     staging.LogLevel,
+			CustomPolicies:               customPoliciesFromV1beta1(staging.CustomPolicies),
+			OpenTracing:                  (*APIcastOpenTracingSpec)(staging.OpenTracing),
+			CustomEnvironments:           customEnvironmentsFromV1beta1(staging.CustomEnvironments),
+			HTTPSPort:                    stagingNetworking.HTTPSPort,
+			HTTPSVerifyDepth:             stagingNetworking.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef:    stagingNetworking.HTTPSCertificateSecretRef,
+			AllProxy:                     stagingNetworking.AllProxy,
+			HTTPProxy:                    stagingNetworking.HTTPProxy,
+			HTTPSProxy:                   stagingNetworking.HTTPSProxy,
+			NoProxy:                      stagingNetworking.NoProxy,
+			Warmup:                       warmupFromV1beta1(staging.Warmup),
+			LBDeregistrationDelaySeconds: stagingNetworking.LBDeregistrationDelaySeconds,
+			ReadinessGates:               stagingNetworking.ReadinessGates,
+			AWSLoadBalancer:              (*APIcastAWSLoadBalancerSpec)(stagingNetworking.AWSLoadBalancer),
+		}
+	}
+
+	return out
+}
+
+func backendToV1beta1(in *BackendSpec, workloads *appsv1beta1.WorkloadsSpec, storage *appsv1beta1.StorageSpec) {
+	workloads.Backend = &appsv1beta1.BackendSpec{
+		Image:    in.Image,
+		Listener: backendListenerToV1beta1(in.ListenerSpec),
+		Worker:   backendWorkerToV1beta1(in.WorkerSpec),
+		Cron:     backendCronToV1beta1(in.CronSpec),
+	}
+
+	redis := &appsv1beta1.RedisSpec{
+		PodPlacementSpec: appsv1beta1.PodPlacementSpec{
+			NodeSelector:              in.RedisNodeSelector,
+			Affinity:                  in.RedisAffinity,
+			Tolerations:               in.RedisTolerations,
+			TopologySpreadConstraints: in.RedisTopologySpreadConstraints,
+			PriorityClassName:         in.RedisPriorityClassName,
+		},
+		Image:                 in.RedisImage,
+		PersistentVolumeClaim: (*appsv1beta1.RedisPersistentVolumeClaimSpec)(in.RedisPersistentVolumeClaimSpec),
+		Resources:             in.RedisResources,
+		PodSecurityContext:    in.RedisPodSecurityContext,
+		SecurityContext:       in.RedisSecurityContext,
+	}
+	if !isEmpty(redis) {
+		storage.BackendRedis = redis
+	}
+}
+
+func backendFromV1beta1(workloads *appsv1beta1.WorkloadsSpec, storage *appsv1beta1.StorageSpec) *BackendSpec {
+	backend := workloads.Backend
+	redis := storage.BackendRedis
+	if backend == nil && redis == nil {
+		return nil
+	}
+	if backend == nil {
+		backend = &appsv1beta1.BackendSpec{}
+	}
+	if redis == nil {
+		redis = &appsv1beta1.RedisSpec{}
+	}
+
+	return &BackendSpec{
+		Image:                          backend.Image,
+		RedisImage:                     redis.Image,
+		RedisPersistentVolumeClaimSpec: (*BackendRedisPersistentVolumeClaimSpec)(redis.PersistentVolumeClaim),
+		RedisNodeSelector:              redis.NodeSelector,
+		RedisAffinity:                  redis.Affinity,
+		RedisTolerations:               redis.Tolerations,
+		RedisTopologySpreadConstraints: redis.TopologySpreadConstraints,
+		RedisPriorityClassName:         redis.PriorityClassName,
+		RedisResources:                 redis.Resources,
+		RedisPodSecurityContext:        redis.PodSecurityContext,
+		RedisSecurityContext:           redis.SecurityContext,
+		ListenerSpec:                   backendListenerFromV1beta1(backend.Listener),
+		WorkerSpec:                     backendWorkerFromV1beta1(backend.Worker),
+		CronSpec:                       backendCronFromV1beta1(backend.Cron),
+	}
+}
+
+func systemToV1beta1(in *SystemSpec, workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec, storage *appsv1beta1.StorageSpec, security *appsv1beta1.SecuritySpec) {
+	workloads.System = &appsv1beta1.SystemSpec{
+		Image:           in.Image,
+		CacheStore:      in.CacheStore,
+		App:             systemAppToV1beta1(in.AppSpec),
+		Sidekiq:         systemSidekiqToV1beta1(in.SidekiqSpec),
+		Sphinx:          systemSphinxToV1beta1(in.SphinxSpec),
+		DeveloperPortal: (*appsv1beta1.SystemDeveloperPortalSpec)(in.DeveloperPortal),
+		InboundEmail:    (*appsv1beta1.SystemInboundEmailSpec)(in.InboundEmail),
+	}
+
+	memcached := &appsv1beta1.MemcachedSpec{
+		PodPlacementSpec: appsv1beta1.PodPlacementSpec{
+			NodeSelector:              in.MemcachedNodeSelector,
+			Affinity:                  in.MemcachedAffinity,
+			Tolerations:               in.MemcachedTolerations,
+			TopologySpreadConstraints: in.MemcachedTopologySpreadConstraints,
+			PriorityClassName:         in.MemcachedPriorityClassName,
+		},
+		Image:              in.MemcachedImage,
+		Resources:          in.MemcachedResources,
+		PodSecurityContext: in.MemcachedPodSecurityContext,
+		SecurityContext:    in.MemcachedSecurityContext,
+	}
+	if !isEmpty(memcached) {
+		workloads.System.Memcached = memcached
+	}
+
+	networking.SystemMasterRoute = (*appsv1beta1.SystemMasterRouteSpec)(in.MasterRoute)
+
+	redis := &appsv1beta1.RedisSpec{
+		PodPlacementSpec: appsv1beta1.PodPlacementSpec{
+			NodeSelector:              in.RedisNodeSelector,
+			Affinity:                  in.RedisAffinity,
+			Tolerations:               in.RedisTolerations,
+			TopologySpreadConstraints: in.RedisTopologySpreadConstraints,
+			PriorityClassName:         in.RedisPriorityClassName,
+		},
+		Image:                 in.RedisImage,
+		PersistentVolumeClaim: (*appsv1beta1.RedisPersistentVolumeClaimSpec)(in.RedisPersistentVolumeClaimSpec),
+		Resources:             in.RedisResources,
+		PodSecurityContext:    in.RedisPodSecurityContext,
+		SecurityContext:       in.RedisSecurityContext,
+	}
+	if !isEmpty(redis) {
+		storage.SystemRedis = redis
+	}
+	storage.SystemFileStorage = systemFileStorageToV1beta1(in.FileStorageSpec)
+	storage.SystemDatabase = systemDatabaseToV1beta1(in.DatabaseSpec)
+
+	security.AdminSSO = (*appsv1beta1.SystemAdminSSOSpec)(in.AdminSSO)
+	security.AccessTokens = accessTokensToV1beta1(in.AccessTokens)
+	security.CORS = (*appsv1beta1.SystemCORSSpec)(in.CORS)
+}
+
+func systemFromV1beta1(workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec, storage *appsv1beta1.StorageSpec, security *appsv1beta1.SecuritySpec) *SystemSpec {
+	system := workloads.System
+	if system == nil &&
+		networking.SystemMasterRoute == nil &&
+		storage.SystemRedis == nil &&
+		storage.SystemFileStorage == nil &&
+		storage.SystemDatabase == nil &&
+		isEmpty(security) {
+		return nil
+	}
+	if system == nil {
+		system = &appsv1beta1.SystemSpec{}
+	}
+	memcached := system.Memcached
+	if memcached == nil {
+		memcached = &appsv1beta1.MemcachedSpec{}
+	}
+	redis := storage.SystemRedis
+	if redis == nil {
+		redis = &appsv1beta1.RedisSpec{}
+	}
+
+	return &SystemSpec{
+		Image:                              system.Image,
+		MemcachedImage:                     memcached.Image,
+		CacheStore:                         system.CacheStore,
+		MemcachedNodeSelector:              memcached.NodeSelector,
+		MemcachedAffinity:                  memcached.Affinity,
+		MemcachedTolerations:               memcached.Tolerations,
+		MemcachedTopologySpreadConstraints: memcached.TopologySpreadConstraints,
+		MemcachedPriorityClassName:         memcached.PriorityClassName,
+		MemcachedResources:                 memcached.Resources,
+		MemcachedPodSecurityContext:        memcached.PodSecurityContext,
+		MemcachedSecurityContext:           memcached.SecurityContext,
+		RedisImage:                         redis.Image,
+		RedisPersistentVolumeClaimSpec:     (*SystemRedisPersistentVolumeClaimSpec)(redis.PersistentVolumeClaim),
+		RedisNodeSelector:                  redis.NodeSelector,
+		RedisAffinity:                      redis.Affinity,
+		RedisTolerations:                   redis.Tolerations,
+		RedisTopologySpreadConstraints:     redis.TopologySpreadConstraints,
+		RedisPriorityClassName:             redis.PriorityClassName,
+		RedisResources:                     redis.Resources,
+		RedisPodSecurityContext:            redis.PodSecurityContext,
+		RedisSecurityContext:               redis.SecurityContext,
+		FileStorageSpec:                    systemFileStorageFromV1beta1(storage.SystemFileStorage),
+		DatabaseSpec:                       systemDatabaseFromV1beta1(storage.SystemDatabase),
+		AppSpec:                            systemAppFromV1beta1(system.App),
+		SidekiqSpec:                        systemSidekiqFromV1beta1(system.Sidekiq),
+		SphinxSpec:                         systemSphinxFromV1beta1(system.Sphinx),
+		AdminSSO:                           (*SystemAdminSSOSpec)(security.AdminSSO),
+		AccessTokens:                       accessTokensFromV1beta1(security.AccessTokens),
+		CORS:                               (*SystemCORSSpec)(security.CORS),
+		DeveloperPortal:                    (*SystemDeveloperPortalSpec)(system.DeveloperPortal),
+		InboundEmail:                       (*SystemInboundEmailSpec)(system.InboundEmail),
+		MasterRoute:                        (*SystemMasterRouteSpec)(networking.SystemMasterRoute),
+	}
+}
+
+func zyncToV1beta1(in *ZyncSpec, workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec, storage *appsv1beta1.StorageSpec) {
+	workloads.Zync = &appsv1beta1.ZyncSpec{
+		Image: in.Image,
+		Que:   zyncQueToV1beta1(in.QueSpec),
+	}
+
+	if in.AppSpec != nil {
+		app := in.AppSpec
+		workloads.Zync.App = &appsv1beta1.ZyncAppSpec{
+			PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(app.PodTemplateOverridesSpec),
+			Replicas:                 app.Replicas,
+			Probes:                   probesToV1beta1(app.Probes),
+			WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(app.WaitInitContainer),
+			RuntimeTuning:            (*appsv1beta1.RubyRuntimeTuningSpec)(app.RuntimeTuning),
+			Resources:                app.Resources,
+		}
+
+		zyncNetworking := &appsv1beta1.ZyncNetworkingSpec{
+			ForceSSL:       app.ForceSSL,
+			TrustedProxies: app.TrustedProxies,
+		}
+		if !isEmpty(zyncNetworking) {
+			networking.Zync = zyncNetworking
+		}
+	}
+
+	networking.ExternalZync = (*appsv1beta1.ExternalZyncSpec)(in.ExternalZync)
+
+	database := &appsv1beta1.ZyncDatabaseSpec{
+		PodPlacementSpec: appsv1beta1.PodPlacementSpec{
+			NodeSelector:              in.DatabaseNodeSelector,
+			Affinity:                  in.DatabaseAffinity,
+			Tolerations:               in.DatabaseTolerations,
+			TopologySpreadConstraints: in.DatabaseTopologySpreadConstraints,
+			PriorityClassName:         in.DatabasePriorityClassName,
+		},
+		Image:                 in.PostgreSQLImage,
+		PodSecurityContext:    in.DatabasePodSecurityContext,
+		SecurityContext:       in.DatabaseSecurityContext,
+		Resources:             in.DatabaseResources,
+		SharedMemorySizeLimit: in.DatabaseSharedMemorySizeLimit,
+		PersistentVolumeClaim: (*appsv1beta1.ZyncDatabasePersistentVolumeClaimSpec)(in.DatabaseStorage),
+		Maintenance:           (*appsv1beta1.ZyncDatabaseMaintenanceSpec)(in.DatabaseMaintenance),
+		Connection:            (*appsv1beta1.ZyncDatabaseConnectionSpec)(in.Database),
+	}
+	if !isEmpty(database) {
+		storage.ZyncDatabase = database
+	}
+}
+
+func zyncFromV1beta1(workloads *appsv1beta1.WorkloadsSpec, networking *appsv1beta1.NetworkingSpec, storage *appsv1beta1.StorageSpec) *ZyncSpec {
+	zync := workloads.Zync
+	if zync == nil && networking.Zync == nil && networking.ExternalZync == nil && storage.ZyncDatabase == nil {
+		return nil
+	}
+	if zync == nil {
+		zync = &appsv1beta1.ZyncSpec{}
+	}
+	database := storage.ZyncDatabase
+	if database == nil {
+		database = &appsv1beta1.ZyncDatabaseSpec{}
+	}
+
+	out := &ZyncSpec{
+		Image:                             zync.Image,
+		PostgreSQLImage:                   database.Image,
+		DatabaseNodeSelector:              database.NodeSelector,
+		DatabaseAffinity:                  database.Affinity,
+		DatabaseTolerations:               database.Tolerations,
+		DatabaseTopologySpreadConstraints: database.TopologySpreadConstraints,
+		DatabasePriorityClassName:         database.PriorityClassName,
+		DatabasePodSecurityContext:        database.PodSecurityContext,
+		DatabaseSecurityContext:           database.SecurityContext,
+		DatabaseResources:                 database.Resources,
+		DatabaseSharedMemorySizeLimit:     database.SharedMemorySizeLimit,
+		DatabaseStorage:                   (*ZyncDatabaseStorageSpec)(database.PersistentVolumeClaim),
+		Database:                          (*ZyncDatabaseSpec)(database.Connection),
+		DatabaseMaintenance:               (*ZyncDatabaseMaintenanceSpec)(database.Maintenance),
+		QueSpec:                           zyncQueFromV1beta1(zync.Que),
+		ExternalZync:                      (*ExternalZyncSpec)(networking.ExternalZync),
+	}
+
+	if zync.App != nil || networking.Zync != nil {
+		app := zync.App
+		if app == nil {
+			app = &appsv1beta1.ZyncAppSpec{}
+		}
+		zyncNetworking := networking.Zync
+		if zyncNetworking == nil {
+			zyncNetworking = &appsv1beta1.ZyncNetworkingSpec{}
+		}
+		out.AppSpec = &ZyncAppSpec{
+			PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(app.PodTemplateOverridesSpec),
+			Replicas:                 app.Replicas,
+			Probes:                   probesFromV1beta1(app.Probes),
+			WaitInitContainer:        (*InitContainerSpec)(app.WaitInitContainer),
+			RuntimeTuning:            (*RubyRuntimeTuningSpec)(app.RuntimeTuning),
+			Resources:                app.Resources,
+			ForceSSL:                 zyncNetworking.ForceSSL,
+			TrustedProxies:           zyncNetworking.TrustedProxies,
+		}
+	}
+
+	return out
+}
+
+// monitoringToV1beta1 moves spec.metrics to spec.monitoring.metrics.
+// spec.monitoring.enabled is only set when spec.monitoring is set in v1alpha1
+func monitoringToV1beta1(in *MonitoringSpec, metrics *MetricsSpec) *appsv1beta1.MonitoringSpec {
+	if in == nil && metrics == nil {
+		return nil
+	}
+
+	out := &appsv1beta1.MonitoringSpec{
+		Metrics: metricsToV1beta1(metrics),
+	}
+	if in != nil {
+		enabled := in.Enabled
+		out.Enabled = &enabled
+		out.EnablePrometheusRules = in.EnablePrometheusRules
+		out.DatabaseExporters = in.DatabaseExporters
+		out.Apicast = in.Apicast
+		out.Backend = in.Backend
+		out.System = in.System
+		out.Zync = in.Zync
+		out.RecordingRules = (*appsv1beta1.RecordingRulesSpec)(in.RecordingRules)
+		out.Grafana = (*appsv1beta1.GrafanaSpec)(in.Grafana)
+		out.PrometheusRules = prometheusRulesToV1beta1(in.PrometheusRules)
+	}
+	return out
+}
+
+func monitoringFromV1beta1(in *appsv1beta1.MonitoringSpec) (*MonitoringSpec, *MetricsSpec) {
+	if in == nil {
+		return nil, nil
+	}
+
+	metrics := metricsFromV1beta1(in.Metrics)
+
+	monitoring := &MonitoringSpec{
+		EnablePrometheusRules: in.EnablePrometheusRules,
+		DatabaseExporters:     in.DatabaseExporters,
+		Apicast:               in.Apicast,
+		Backend:               in.Backend,
+		System:                in.System,
+		Zync:                  in.Zync,
+		RecordingRules:        (*RecordingRulesSpec)(in.RecordingRules),
+		Grafana:               (*GrafanaSpec)(in.Grafana),
+		PrometheusRules:       prometheusRulesFromV1beta1(in.PrometheusRules),
+	}
+	if in.Enabled == nil && isEmpty(monitoring) {
+		return nil, metrics
+	}
+	if in.Enabled != nil {
+		monitoring.Enabled = *in.Enabled
+	}
+
+	return monitoring, metrics
+}
+
+func podDisruptionBudgetToV1beta1(in *PodDisruptionBudgetSpec) *appsv1beta1.PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.PodDisruptionBudgetSpec{
+		Enabled:           in.Enabled,
+		ApicastProduction: (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.ApicastProduction),
+		ApicastStaging:    (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.ApicastStaging),
+		BackendListener:   (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.BackendListener),
+		BackendWorker:     (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.BackendWorker),
+		BackendCron:       (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.BackendCron),
+		SystemApp:         (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.SystemApp),
+		SystemSidekiq:     (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.SystemSidekiq),
+		Zync:              (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.Zync),
+		ZyncQue:           (*appsv1beta1.PodDisruptionBudgetPolicySpec)(in.ZyncQue),
+	}
+}
+
+func podDisruptionBudgetFromV1beta1(in *appsv1beta1.PodDisruptionBudgetSpec) *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	return &PodDisruptionBudgetSpec{
+		Enabled:           in.Enabled,
+		ApicastProduction: (*PodDisruptionBudgetPolicySpec)(in.ApicastProduction),
+		ApicastStaging:    (*PodDisruptionBudgetPolicySpec)(in.ApicastStaging),
+		BackendListener:   (*PodDisruptionBudgetPolicySpec)(in.BackendListener),
+		BackendWorker:     (*PodDisruptionBudgetPolicySpec)(in.BackendWorker),
+		BackendCron:       (*PodDisruptionBudgetPolicySpec)(in.BackendCron),
+		SystemApp:         (*PodDisruptionBudgetPolicySpec)(in.SystemApp),
+		SystemSidekiq:     (*PodDisruptionBudgetPolicySpec)(in.SystemSidekiq),
+		Zync:              (*PodDisruptionBudgetPolicySpec)(in.Zync),
+		ZyncQue:           (*PodDisruptionBudgetPolicySpec)(in.ZyncQue),
+	}
+}
+
+func serviceAccountsToV1beta1(in *ServiceAccountsSpec) *appsv1beta1.ServiceAccountsSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.ServiceAccountsSpec{
+		Amp:     (*appsv1beta1.ServiceAccountSpec)(in.Amp),
+		ZyncQue: (*appsv1beta1.ServiceAccountSpec)(in.ZyncQue),
+	}
+}
+
+func serviceAccountsFromV1beta1(in *appsv1beta1.ServiceAccountsSpec) *ServiceAccountsSpec {
+	if in == nil {
+		return nil
+	}
+	return &ServiceAccountsSpec{
+		Amp:     (*ServiceAccountSpec)(in.Amp),
+		ZyncQue: (*ServiceAccountSpec)(in.ZyncQue),
+	}
+}
+
+func podTemplateOverridesToV1beta1(in PodTemplateOverridesSpec) appsv1beta1.PodTemplateOverridesSpec {
+	return appsv1beta1.PodTemplateOverridesSpec{
+		PodPlacementSpec:              appsv1beta1.PodPlacementSpec(in.PodPlacementSpec),
+		UnreachableTolerationSeconds:  in.UnreachableTolerationSeconds,
+		Labels:                        in.Labels,
+		Annotations:                   in.Annotations,
+		PodSecurityContext:            in.PodSecurityContext,
+		SecurityContext:               in.SecurityContext,
+		Env:                           in.Env,
+		Sidecars:                      in.Sidecars,
+		SidecarVolumes:                in.SidecarVolumes,
+		InitContainers:                in.InitContainers,
+		TerminationGracePeriodSeconds: in.TerminationGracePeriodSeconds,
+		PreStopCommand:                in.PreStopCommand,
+		DNSPolicy:                     in.DNSPolicy,
+		DNSConfig:                     in.DNSConfig,
+		HostAliases:                   in.HostAliases,
+		RuntimeClassName:              in.RuntimeClassName,
+		ImagePullPolicy:               in.ImagePullPolicy,
+		ImagePullSecrets:              in.ImagePullSecrets,
+	}
+}
+
+func podTemplateOverridesFromV1beta1(in appsv1beta1.PodTemplateOverridesSpec) PodTemplateOverridesSpec {
+	return PodTemplateOverridesSpec{
+		PodPlacementSpec:              PodPlacementSpec(in.PodPlacementSpec),
+		UnreachableTolerationSeconds:  in.UnreachableTolerationSeconds,
+		Labels:                        in.Labels,
+		Annotations:                   in.Annotations,
+		PodSecurityContext:            in.PodSecurityContext,
+		SecurityContext:               in.SecurityContext,
+		Env:                           in.Env,
+		Sidecars:                      in.Sidecars,
+		SidecarVolumes:                in.SidecarVolumes,
+		InitContainers:                in.InitContainers,
+		TerminationGracePeriodSeconds: in.TerminationGracePeriodSeconds,
+		PreStopCommand:                in.PreStopCommand,
+		DNSPolicy:                     in.DNSPolicy,
+		DNSConfig:                     in.DNSConfig,
+		HostAliases:                   in.HostAliases,
+		RuntimeClassName:              in.RuntimeClassName,
+		ImagePullPolicy:               in.ImagePullPolicy,
+		ImagePullSecrets:              in.ImagePullSecrets,
+	}
+}
+
+func probesToV1beta1(in *ProbesSpec) *appsv1beta1.ProbesSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.ProbesSpec{
+		Liveness:  (*appsv1beta1.ProbeSpec)(in.Liveness),
+		Readiness: (*appsv1beta1.ProbeSpec)(in.Readiness),
+		Startup:   (*appsv1beta1.ProbeSpec)(in.Startup),
+	}
+}
+
+func probesFromV1beta1(in *appsv1beta1.ProbesSpec) *ProbesSpec {
+	if in == nil {
+		return nil
+	}
+	return &ProbesSpec{
+		Liveness:  (*ProbeSpec)(in.Liveness),
+		Readiness: (*ProbeSpec)(in.Readiness),
+		Startup:   (*ProbeSpec)(in.Startup),
+	}
+}
+
+func customPoliciesToV1beta1(in []CustomPolicySpec) []appsv1beta1.CustomPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]appsv1beta1.CustomPolicySpec, len(in))
+	for idx := range in {
+		out[idx] = appsv1beta1.CustomPolicySpec(in[idx])
+	}
+	return out
+}
+
+func customPoliciesFromV1beta1(in []appsv1beta1.CustomPolicySpec) []CustomPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]CustomPolicySpec, len(in))
+	for idx := range in {
+		out[idx] = CustomPolicySpec(in[idx])
+	}
+	return out
+}
+
+func customEnvironmentsToV1beta1(in []CustomEnvironmentSpec) []appsv1beta1.CustomEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]appsv1beta1.CustomEnvironmentSpec, len(in))
+	for idx := range in {
+		out[idx] = appsv1beta1.CustomEnvironmentSpec(in[idx])
+	}
+	return out
+}
+
+func customEnvironmentsFromV1beta1(in []appsv1beta1.CustomEnvironmentSpec) []CustomEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]CustomEnvironmentSpec, len(in))
+	for idx := range in {
+		out[idx] = CustomEnvironmentSpec(in[idx])
+	}
+	return out
+}
+
+func warmupToV1beta1(in *APIcastWarmupSpec) *appsv1beta1.APIcastWarmupSpec {
+	if in == nil {
+		return nil
+	}
+	out := &appsv1beta1.APIcastWarmupSpec{
+		Enabled:        in.Enabled,
+		TimeoutSeconds: in.TimeoutSeconds,
+	}
+	if in.Targets != nil {
+		out.Targets = make([]appsv1beta1.APIcastWarmupTargetSpec, len(in.Targets))
+		for idx := range in.Targets {
+			out.Targets[idx] = appsv1beta1.APIcastWarmupTargetSpec(in.Targets[idx])
+		}
+	}
+	return out
+}
+
+func warmupFromV1beta1(in *appsv1beta1.APIcastWarmupSpec) *APIcastWarmupSpec {
+	if in == nil {
+		return nil
+	}
+	out := &APIcastWarmupSpec{
+		Enabled:        in.Enabled,
+		TimeoutSeconds: in.TimeoutSeconds,
+	}
+	if in.Targets != nil {
+		out.Targets = make([]APIcastWarmupTargetSpec, len(in.Targets))
+		for idx := range in.Targets {
+			out.Targets[idx] = APIcastWarmupTargetSpec(in.Targets[idx])
+		}
+	}
+	return out
+}
+
+func backendListenerToV1beta1(in *BackendListenerSpec) *appsv1beta1.BackendListenerSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.BackendListenerSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		Probes:                   probesToV1beta1(in.Probes),
+		Resources:                in.Resources,
+		RequestLogging:           (*appsv1beta1.BackendListenerRequestLoggingSpec)(in.RequestLogging),
+	}
+}
+
+func backendListenerFromV1beta1(in *appsv1beta1.BackendListenerSpec) *BackendListenerSpec {
+	if in == nil {
+		return nil
+	}
+	return &BackendListenerSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		Probes:                   probesFromV1beta1(in.Probes),
+		Resources:                in.Resources,
+		RequestLogging:           (*BackendListenerRequestLoggingSpec)(in.RequestLogging),
+	}
+}
+
+func backendWorkerToV1beta1(in *BackendWorkerSpec) *appsv1beta1.BackendWorkerSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.BackendWorkerSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func backendWorkerFromV1beta1(in *appsv1beta1.BackendWorkerSpec) *BackendWorkerSpec {
+	if in == nil {
+		return nil
+	}
+	return &BackendWorkerSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func backendCronToV1beta1(in *BackendCronSpec) *appsv1beta1.BackendCronSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.BackendCronSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func backendCronFromV1beta1(in *appsv1beta1.BackendCronSpec) *BackendCronSpec {
+	if in == nil {
+		return nil
+	}
+	return &BackendCronSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func systemAppToV1beta1(in *SystemAppSpec) *appsv1beta1.SystemAppSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.SystemAppSpec{
+		PodTemplateOverridesSpec:    podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                    in.Replicas,
+		Probes:                      probesToV1beta1(in.Probes),
+		RuntimeTuning:               (*appsv1beta1.RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		MasterContainerResources:    in.MasterContainerResources,
+		ProviderContainerResources:  in.ProviderContainerResources,
+		DeveloperContainerResources: in.DeveloperContainerResources,
+	}
+}
+
+func systemAppFromV1beta1(in *appsv1beta1.SystemAppSpec) *SystemAppSpec {
+	if in == nil {
+		return nil
+	}
+	return &SystemAppSpec{
+		PodTemplateOverridesSpec:    podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                    in.Replicas,
+		Probes:                      probesFromV1beta1(in.Probes),
+		RuntimeTuning:               (*RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		MasterContainerResources:    in.MasterContainerResources,
+		ProviderContainerResources:  in.ProviderContainerResources,
+		DeveloperContainerResources: in.DeveloperContainerResources,
+	}
+}
+
+func systemSidekiqToV1beta1(in *SystemSidekiqSpec) *appsv1beta1.SystemSidekiqSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.SystemSidekiqSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(in.WaitInitContainer),
+		RuntimeTuning:            (*appsv1beta1.RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		Resources:                in.Resources,
+	}
+}
+
+func systemSidekiqFromV1beta1(in *appsv1beta1.SystemSidekiqSpec) *SystemSidekiqSpec {
+	if in == nil {
+		return nil
+	}
+	return &SystemSidekiqSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		WaitInitContainer:        (*InitContainerSpec)(in.WaitInitContainer),
+		RuntimeTuning:            (*RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		Resources:                in.Resources,
+	}
+}
+
+func systemSphinxToV1beta1(in *SystemSphinxSpec) *appsv1beta1.SystemSphinxSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.SystemSphinxSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Probes:                   probesToV1beta1(in.Probes),
+		WaitInitContainer:        (*appsv1beta1.InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func systemSphinxFromV1beta1(in *appsv1beta1.SystemSphinxSpec) *SystemSphinxSpec {
+	if in == nil {
+		return nil
+	}
+	return &SystemSphinxSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Probes:                   probesFromV1beta1(in.Probes),
+		WaitInitContainer:        (*InitContainerSpec)(in.WaitInitContainer),
+		Resources:                in.Resources,
+	}
+}
+
+func zyncQueToV1beta1(in *ZyncQueSpec) *appsv1beta1.ZyncQueSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.ZyncQueSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesToV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		Probes:                   probesToV1beta1(in.Probes),
+		RuntimeTuning:            (*appsv1beta1.RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		Resources:                in.Resources,
+		ServiceAccountToken:      (*appsv1beta1.ZyncQueServiceAccountTokenSpec)(in.ServiceAccountToken),
+		WorkerCount:              in.WorkerCount,
+		PollingInterval:          in.PollingInterval,
+	}
+}
+
+func zyncQueFromV1beta1(in *appsv1beta1.ZyncQueSpec) *ZyncQueSpec {
+	if in == nil {
+		return nil
+	}
+	return &ZyncQueSpec{
+		PodTemplateOverridesSpec: podTemplateOverridesFromV1beta1(in.PodTemplateOverridesSpec),
+		Replicas:                 in.Replicas,
+		Probes:                   probesFromV1beta1(in.Probes),
+		RuntimeTuning:            (*RubyRuntimeTuningSpec)(in.RuntimeTuning),
+		Resources:                in.Resources,
+		ServiceAccountToken:      (*ZyncQueServiceAccountTokenSpec)(in.ServiceAccountToken),
+		WorkerCount:              in.WorkerCount,
+		PollingInterval:          in.PollingInterval,
+	}
+}
+
+func persistentVolumeClaimToV1beta1(storageClassName *string, resources *PersistentVolumeClaimResources, volumeName *string, annotations, labels map[string]string) *appsv1beta1.PersistentVolumeClaimSpec {
+	return &appsv1beta1.PersistentVolumeClaimSpec{
+		StorageClassName: storageClassName,
+		Resources:        (*appsv1beta1.PersistentVolumeClaimResources)(resources),
+		VolumeName:       volumeName,
+		Annotations:      annotations,
+		Labels:           labels,
+	}
+}
+
+func systemFileStorageToV1beta1(in *SystemFileStorageSpec) *appsv1beta1.SystemFileStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := &appsv1beta1.SystemFileStorageSpec{
+		DeprecatedS3: (*appsv1beta1.DeprecatedSystemS3Spec)(in.DeprecatedS3),
+		S3:           (*appsv1beta1.SystemS3Spec)(in.S3),
+	}
+	if in.PVC != nil {
+		out.PersistentVolumeClaim = persistentVolumeClaimToV1beta1(in.PVC.StorageClassName, in.PVC.Resources, in.PVC.VolumeName, in.PVC.Annotations, in.PVC.Labels)
+	}
+	return out
+}
+
+func systemFileStorageFromV1beta1(in *appsv1beta1.SystemFileStorageSpec) *SystemFileStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := &SystemFileStorageSpec{
+		DeprecatedS3: (*DeprecatedSystemS3Spec)(in.DeprecatedS3),
+		S3:           (*SystemS3Spec)(in.S3),
+	}
+	if pvc := in.PersistentVolumeClaim; pvc != nil {
+		out.PVC = &SystemPVCSpec{
+			StorageClassName: pvc.StorageClassName,
+			Resources:        (*PersistentVolumeClaimResources)(pvc.Resources),
+			VolumeName:       pvc.VolumeName,
+			Annotations:      pvc.Annotations,
+			Labels:           pvc.Labels,
+		}
+	}
+	return out
+}
+
+func systemDatabaseToV1beta1(in *SystemDatabaseSpec) *appsv1beta1.SystemDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := &appsv1beta1.SystemDatabaseSpec{}
+	if mysql := in.MySQL; mysql != nil {
+		out.MySQL = &appsv1beta1.SystemMySQLSpec{
+			PodPlacementSpec:      appsv1beta1.PodPlacementSpec(mysql.PodPlacementSpec),
+			Image:                 mysql.Image,
+			Resources:             mysql.Resources,
+			PodSecurityContext:    mysql.PodSecurityContext,
+			SecurityContext:       mysql.SecurityContext,
+			SharedMemorySizeLimit: mysql.SharedMemorySizeLimit,
+		}
+		if pvc := mysql.PersistentVolumeClaimSpec; pvc != nil {
+			out.MySQL.PersistentVolumeClaim = persistentVolumeClaimToV1beta1(pvc.StorageClassName, pvc.Resources, pvc.VolumeName, pvc.Annotations, pvc.Labels)
+		}
+	}
+	if postgresql := in.PostgreSQL; postgresql != nil {
+		out.PostgreSQL = &appsv1beta1.SystemPostgreSQLSpec{
+			PodPlacementSpec:   appsv1beta1.PodPlacementSpec(postgresql.PodPlacementSpec),
+			Image:              postgresql.Image,
+			Resources:          postgresql.Resources,
+			PodSecurityContext: postgresql.PodSecurityContext,
+			SecurityContext:    postgresql.SecurityContext,
+		}
+		if pvc := postgresql.PersistentVolumeClaimSpec; pvc != nil {
+			out.PostgreSQL.PersistentVolumeClaim = persistentVolumeClaimToV1beta1(pvc.StorageClassName, pvc.Resources, pvc.VolumeName, pvc.Annotations, pvc.Labels)
+		}
+	}
+	return out
+}
+
+func systemDatabaseFromV1beta1(in *appsv1beta1.SystemDatabaseSpec) *SystemDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := &SystemDatabaseSpec{}
+	if mysql := in.MySQL; mysql != nil {
+		out.MySQL = &SystemMySQLSpec{
+			PodPlacementSpec:      PodPlacementSpec(mysql.PodPlacementSpec),
+			Image:                 mysql.Image,
+			Resources:             mysql.Resources,
+			PodSecurityContext:    mysql.PodSecurityContext,
+			SecurityContext:       mysql.SecurityContext,
+			SharedMemorySizeLimit: mysql.SharedMemorySizeLimit,
+		}
+		if pvc := mysql.PersistentVolumeClaim; pvc != nil {
+			out.MySQL.PersistentVolumeClaimSpec = &SystemMySQLPVCSpec{
+				StorageClassName: pvc.StorageClassName,
+				Resources:        (*PersistentVolumeClaimResources)(pvc.Resources),
+				VolumeName:       pvc.VolumeName,
+				Annotations:      pvc.Annotations,
+				Labels:           pvc.Labels,
+			}
+		}
+	}
+	if postgresql := in.PostgreSQL; postgresql != nil {
+		out.PostgreSQL = &SystemPostgreSQLSpec{
+			PodPlacementSpec:   PodPlacementSpec(postgresql.PodPlacementSpec),
+			Image:              postgresql.Image,
+			Resources:          postgresql.Resources,
+			PodSecurityContext: postgresql.PodSecurityContext,
+			SecurityContext:    postgresql.SecurityContext,
+		}
+		if pvc := postgresql.PersistentVolumeClaim; pvc != nil {
+			out.PostgreSQL.PersistentVolumeClaimSpec = &SystemPostgreSQLPVCSpec{
+				StorageClassName: pvc.StorageClassName,
+				Resources:        (*PersistentVolumeClaimResources)(pvc.Resources),
+				VolumeName:       pvc.VolumeName,
+				Annotations:      pvc.Annotations,
+				Labels:           pvc.Labels,
+			}
+		}
+	}
+	return out
+}
+
+func accessTokensToV1beta1(in []SystemAccessTokenSpec) []appsv1beta1.SystemAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]appsv1beta1.SystemAccessTokenSpec, len(in))
+	for idx := range in {
+		out[idx] = appsv1beta1.SystemAccessTokenSpec(in[idx])
+	}
+	return out
+}
+
+func accessTokensFromV1beta1(in []appsv1beta1.SystemAccessTokenSpec) []SystemAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := make([]SystemAccessTokenSpec, len(in))
+	for idx := range in {
+		out[idx] = SystemAccessTokenSpec(in[idx])
+	}
+	return out
+}
+
+func externalComponentsToV1beta1(in *ExternalComponentsSpec) *appsv1beta1.ExternalComponentsSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.ExternalComponentsSpec{
+		System:  (*appsv1beta1.ExternalSystemComponents)(in.System),
+		Backend: (*appsv1beta1.ExternalBackendComponents)(in.Backend),
+		Zync:    (*appsv1beta1.ExternalZyncComponents)(in.Zync),
+	}
+}
+
+func externalComponentsFromV1beta1(in *appsv1beta1.ExternalComponentsSpec) *ExternalComponentsSpec {
+	if in == nil {
+		return nil
+	}
+	return &ExternalComponentsSpec{
+		System:  (*ExternalSystemComponents)(in.System),
+		Backend: (*ExternalBackendComponents)(in.Backend),
+		Zync:    (*ExternalZyncComponents)(in.Zync),
+	}
+}
+
+func prometheusRulesToV1beta1(in *PrometheusRulesSpec) *appsv1beta1.PrometheusRulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := &appsv1beta1.PrometheusRulesSpec{}
+	if in.Overrides != nil {
+		out.Overrides = make([]appsv1beta1.PrometheusRuleOverride, len(in.Overrides))
+		for idx := range in.Overrides {
+			out.Overrides[idx] = appsv1beta1.PrometheusRuleOverride(in.Overrides[idx])
+		}
+	}
+	return out
+}
+
+func prometheusRulesFromV1beta1(in *appsv1beta1.PrometheusRulesSpec) *PrometheusRulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := &PrometheusRulesSpec{}
+	if in.Overrides != nil {
+		out.Overrides = make([]PrometheusRuleOverride, len(in.Overrides))
+		for idx := range in.Overrides {
+			out.Overrides[idx] = PrometheusRuleOverride(in.Overrides[idx])
+		}
+	}
+	return out
+}
+
+func metricsToV1beta1(in *MetricsSpec) *appsv1beta1.MetricsSpec {
+	if in == nil {
+		return nil
+	}
+	return &appsv1beta1.MetricsSpec{Statsd: (*appsv1beta1.StatsdSpec)(in.Statsd)}
+}
+
+func metricsFromV1beta1(in *appsv1beta1.MetricsSpec) *MetricsSpec {
+	if in == nil {
+		return nil
+	}
+	return &MetricsSpec{Statsd: (*StatsdSpec)(in.Statsd)}
+}
+
+func databasesToV1beta1(in *DatabasesSpec) *appsv1beta1.DatabasesSpec {
+	if in == nil {
+		return nil
+	}
+	out := &appsv1beta1.DatabasesSpec{}
+	if backup := in.Backup; backup != nil {
+		out.Backup = &appsv1beta1.DatabaseBackupSpec{
+			Enabled:   backup.Enabled,
+			S3:        (*appsv1beta1.DatabaseBackupS3Spec)(backup.S3),
+			Retention: backup.Retention,
+		}
+		if pvc := backup.PersistentVolumeClaim; pvc != nil {
+			out.Backup.PersistentVolumeClaim = &appsv1beta1.DatabaseBackupPVCSpec{
+				StorageClassName: pvc.StorageClassName,
+				Resources:        (*appsv1beta1.PersistentVolumeClaimResources)(pvc.Resources),
+			}
+		}
+	}
+	return out
+}
+
+func databasesFromV1beta1(in *appsv1beta1.DatabasesSpec) *DatabasesSpec {
+	if in == nil {
+		return nil
+	}
+	out := &DatabasesSpec{}
+	if backup := in.Backup; backup != nil {
+		out.Backup = &DatabaseBackupSpec{
+			Enabled:   backup.Enabled,
+			S3:        (*DatabaseBackupS3Spec)(backup.S3),
+			Retention: backup.Retention,
+		}
+		if pvc := backup.PersistentVolumeClaim; pvc != nil {
+			out.Backup.PersistentVolumeClaim = &DatabaseBackupPVCSpec{
+				StorageClassName: pvc.StorageClassName,
+				Resources:        (*PersistentVolumeClaimResources)(pvc.Resources),
+			}
+		}
+	}
+	return out
+}
+
+func apimanagerStatusToV1beta1(in *APIManagerStatus) appsv1beta1.APIManagerStatus {
+	out := appsv1beta1.APIManagerStatus{
+		Conditions:                    in.Conditions,
+		Deployments:                   in.Deployments,
+		Shutdown:                      (*appsv1beta1.ShutdownStatus)(in.Shutdown),
+		Standby:                       (*appsv1beta1.StandbyStatus)(in.Standby),
+		BackendListenerRequestLogging: (*appsv1beta1.RequestLoggingStatus)(in.BackendListenerRequestLogging),
+		AdminSSO:                      (*appsv1beta1.AdminSSOStatus)(in.AdminSSO),
+		Hosts:                         in.Hosts,
+		DeveloperPortal:               in.DeveloperPortal,
+		MasterEndpoint:                in.MasterEndpoint,
+		ZyncMode:                      in.ZyncMode,
+		Topology:                      in.Topology,
+		FileStorageMigration:          (*appsv1beta1.FileStorageMigrationStatus)(in.FileStorageMigration),
+		ExternalBackendEndpoint:       in.ExternalBackendEndpoint,
+		ThreescaleVersion:             in.ThreescaleVersion,
+		DatabaseBackup:                (*appsv1beta1.DatabaseBackupStatus)(in.DatabaseBackup),
+	}
+	if in.Components != nil {
+		out.Components = make(map[string]appsv1beta1.ComponentStatus, len(in.Components))
+		for name, component := range in.Components {
+			out.Components[name] = appsv1beta1.ComponentStatus(component)
+		}
+	}
+	if in.Workloads != nil {
+		out.Workloads = make([]appsv1beta1.WorkloadStatus, len(in.Workloads))
+		for idx, workload := range in.Workloads {
+			out.Workloads[idx] = appsv1beta1.WorkloadStatus{
+				Name:        workload.Name,
+				LastFailure: (*appsv1beta1.WorkloadFailure)(workload.LastFailure),
+			}
+			if workload.Zones != nil {
+				out.Workloads[idx].Zones = make([]appsv1beta1.WorkloadZone, len(workload.Zones))
+				for zoneIdx := range workload.Zones {
+					out.Workloads[idx].Zones[zoneIdx] = appsv1beta1.WorkloadZone(workload.Zones[zoneIdx])
+				}
+			}
+		}
+	}
+	if in.AccessTokens != nil {
+		out.AccessTokens = make([]appsv1beta1.AccessTokenStatus, len(in.AccessTokens))
+		for idx := range in.AccessTokens {
+			out.AccessTokens[idx] = appsv1beta1.AccessTokenStatus(in.AccessTokens[idx])
+		}
+	}
+	return out
+}
+
+func apimanagerStatusFromV1beta1(in *appsv1beta1.APIManagerStatus) APIManagerStatus {
+	out := APIManagerStatus{
+		Conditions:                    in.Conditions,
+		Deployments:                   in.Deployments,
+		Shutdown:                      (*ShutdownStatus)(in.Shutdown),
+		Standby:                       (*StandbyStatus)(in.Standby),
+		BackendListenerRequestLogging: (*RequestLoggingStatus)(in.BackendListenerRequestLogging),
+		AdminSSO:                      (*AdminSSOStatus)(in.AdminSSO),
+		Hosts:                         in.Hosts,
+		DeveloperPortal:               in.DeveloperPortal,
+		MasterEndpoint:                in.MasterEndpoint,
+		ZyncMode:                      in.ZyncMode,
+		Topology:                      in.Topology,
+		FileStorageMigration:          (*FileStorageMigrationStatus)(in.FileStorageMigration),
+		ExternalBackendEndpoint:       in.ExternalBackendEndpoint,
+		ThreescaleVersion:             in.ThreescaleVersion,
+		DatabaseBackup:                (*DatabaseBackupStatus)(in.DatabaseBackup),
+	}
+	if in.Components != nil {
+		out.Components = make(map[string]ComponentStatus, len(in.Components))
+		for name, component := range in.Components {
+			out.Components[name] = ComponentStatus(component)
+		}
+	}
+	if in.Workloads != nil {
+		out.Workloads = make([]WorkloadStatus, len(in.Workloads))
+		for idx, workload := range in.Workloads {
+			out.Workloads[idx] = WorkloadStatus{
+				Name:        workload.Name,
+				LastFailure: (*WorkloadFailure)(workload.LastFailure),
+			}
+			if workload.Zones != nil {
+				out.Workloads[idx].Zones = make([]WorkloadZone, len(workload.Zones))
+				for zoneIdx := range workload.Zones {
+					out.Workloads[idx].Zones[zoneIdx] = WorkloadZone(workload.Zones[zoneIdx])
+				}
+			}
+		}
+	}
+	if in.AccessTokens != nil {
+		out.AccessTokens = make([]AccessTokenStatus, len(in.AccessTokens))
+		for idx := range in.AccessTokens {
+			out.AccessTokens[idx] = AccessTokenStatus(in.AccessTokens[idx])
+		}
+	}
+	return out
+}
@@ -0,0 +1,179 @@
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	appsv1beta1 "github.com/3scale/3scale-operator/apis/apps/v1beta1"
+)
+
+const conversionFuzzIterations = 500
+
+// conversionFuzzer fills the objects with values the API server would accept,
+// so they can be serialized in the V1beta1SpecAnnotation
+func conversionFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.NewWithSeed(seed).NilChance(0.3).NumElements(0, 3).Funcs(
+		func(q *resource.Quantity, c fuzz.Continue) {
+			*q = *resource.NewQuantity(c.Int63n(1000), resource.DecimalSI)
+		},
+		func(val *intstr.IntOrString, c fuzz.Continue) {
+			if c.RandBool() {
+				*val = intstr.FromInt(c.Intn(100))
+			} else {
+				*val = intstr.FromString(c.RandString())
+			}
+		},
+	)
+}
+
+func TestAPIManagerConversionRoundTripFromV1alpha1(t *testing.T) {
+	for i := int64(0); i < conversionFuzzIterations; i++ {
+		apimanager := &APIManager{ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager"}}
+		fuzzer := conversionFuzzer(i)
+		fuzzer.Fuzz(&apimanager.Spec)
+		fuzzer.Fuzz(&apimanager.Status)
+
+		hub := &appsv1beta1.APIManager{}
+		if err := apimanager.ConvertTo(hub); err != nil {
+			t.Fatalf("seed %d: %v", i, err)
+		}
+
+		converted := &APIManager{}
+		if err := converted.ConvertFrom(hub); err != nil {
+			t.Fatalf("seed %d: %v", i, err)
+		}
+
+		if _, ok := converted.Annotations[V1beta1SpecAnnotation]; ok {
+			t.Errorf("seed %d: unexpected %s annotation", i, V1beta1SpecAnnotation)
+		}
+
+		if !reflect.DeepEqual(apimanager, converted) {
+			t.Errorf("seed %d: round trip does not match:\n%#v\n%#v", i, apimanager.Spec, converted.Spec)
+		}
+	}
+}
+
+func TestAPIManagerConversionRoundTripFromV1beta1(t *testing.T) {
+	for i := int64(0); i < conversionFuzzIterations; i++ {
+		hub := &appsv1beta1.APIManager{ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager"}}
+		fuzzer := conversionFuzzer(i)
+		fuzzer.Fuzz(&hub.Spec)
+		fuzzer.Fuzz(&hub.Status)
+
+		apimanager := &APIManager{}
+		if err := apimanager.ConvertFrom(hub); err != nil {
+			t.Fatalf("seed %d: %v", i, err)
+		}
+
+		converted := &appsv1beta1.APIManager{}
+		if err := apimanager.ConvertTo(converted); err != nil {
+			t.Fatalf("seed %d: %v", i, err)
+		}
+
+		if !equality.Semantic.DeepEqual(hub.ObjectMeta, converted.ObjectMeta) {
+			t.Errorf("seed %d: object meta does not match:\n%#v\n%#v", i, hub.ObjectMeta, converted.ObjectMeta)
+		}
+		if !equality.Semantic.DeepEqual(hub.Spec, converted.Spec) {
+			t.Errorf("seed %d: spec does not match:\n%#v\n%#v", i, hub.Spec, converted.Spec)
+		}
+		if !reflect.DeepEqual(hub.Status, converted.Status) {
+			t.Errorf("seed %d: status does not match:\n%#v\n%#v", i, hub.Status, converted.Status)
+		}
+	}
+}
+
+func TestAPIManagerConversionV1beta1SpecAnnotation(t *testing.T) {
+	hub := &appsv1beta1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager"},
+		Spec: appsv1beta1.APIManagerSpec{
+			WildcardDomain: "example.com",
+			Networking:     &appsv1beta1.NetworkingSpec{},
+		},
+	}
+
+	apimanager := &APIManager{}
+	if err := apimanager.ConvertFrom(hub); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := apimanager.Annotations[V1beta1SpecAnnotation]; !ok {
+		t.Fatalf("expected %s annotation", V1beta1SpecAnnotation)
+	}
+
+	converted := &appsv1beta1.APIManager{}
+	if err := apimanager.ConvertTo(converted); err != nil {
+		t.Fatal(err)
+	}
+	if converted.Spec.Networking == nil {
+		t.Error("expected the networking group restored from the annotation")
+	}
+	if converted.Annotations != nil {
+		t.Errorf("unexpected annotations: %v", converted.Annotations)
+	}
+
+	// Changes made through v1alpha1 discard the annotation
+	apimanager.Spec.WildcardDomain = "changed.example.com"
+	converted = &appsv1beta1.APIManager{}
+	if err := apimanager.ConvertTo(converted); err != nil {
+		t.Fatal(err)
+	}
+	if converted.Spec.WildcardDomain != "changed.example.com" {
+		t.Errorf("expected the v1alpha1 wildcard domain, got %s", converted.Spec.WildcardDomain)
+	}
+	if converted.Spec.Networking != nil {
+		t.Errorf("unexpected networking group: %v", converted.Spec.Networking)
+	}
+}
+
+func TestAPIManagerConversionGroups(t *testing.T) {
+	enabled := true
+	apimanager := &APIManager{
+		Spec: APIManagerSpec{
+			APIManagerCommonSpec: APIManagerCommonSpec{WildcardDomain: "example.com"},
+			Apicast: &ApicastSpec{
+				ProductionSpec: &ApicastProductionSpec{HTTPSPort: &[]int32{8443}[0]},
+			},
+			System: &SystemSpec{
+				MemcachedImage: &[]string{"memcached"}[0],
+				CORS:           &SystemCORSSpec{AllowedOrigins: []string{"https://example.com"}},
+			},
+			Monitoring: &MonitoringSpec{Enabled: enabled},
+			Metrics:    &MetricsSpec{Statsd: &StatsdSpec{Host: "statsd"}},
+		},
+	}
+
+	hub := &appsv1beta1.APIManager{}
+	if err := apimanager.ConvertTo(hub); err != nil {
+		t.Fatal(err)
+	}
+
+	if hub.Spec.Workloads == nil || hub.Spec.Workloads.Apicast == nil || hub.Spec.Workloads.Apicast.Production == nil {
+		t.Fatal("expected spec.workloads.apicast.production")
+	}
+	if hub.Spec.Networking == nil || hub.Spec.Networking.Apicast == nil || hub.Spec.Networking.Apicast.Production == nil ||
+		*hub.Spec.Networking.Apicast.Production.HTTPSPort != 8443 {
+		t.Errorf("expected spec.networking.apicast.production.httpsPort, got %#v", hub.Spec.Networking)
+	}
+	if hub.Spec.Networking.Apicast.Staging != nil {
+		t.Errorf("unexpected spec.networking.apicast.staging")
+	}
+	if hub.Spec.Workloads.System == nil || hub.Spec.Workloads.System.Memcached == nil ||
+		*hub.Spec.Workloads.System.Memcached.Image != "memcached" {
+		t.Errorf("expected spec.workloads.system.memcached.image, got %#v", hub.Spec.Workloads.System)
+	}
+	if hub.Spec.Storage != nil {
+		t.Errorf("unexpected spec.storage: %#v", hub.Spec.Storage)
+	}
+	if hub.Spec.Security == nil || hub.Spec.Security.CORS == nil {
+		t.Errorf("expected spec.security.cors")
+	}
+	if hub.Spec.Monitoring == nil || hub.Spec.Monitoring.Enabled == nil || !*hub.Spec.Monitoring.Enabled ||
+		hub.Spec.Monitoring.Metrics == nil || hub.Spec.Monitoring.Metrics.Statsd.Host != "statsd" {
+		t.Errorf("expected spec.monitoring.enabled and spec.monitoring.metrics, got %#v", hub.Spec.Monitoring)
+	}
+}
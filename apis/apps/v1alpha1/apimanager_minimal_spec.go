@@ -0,0 +1,167 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// MinimalSpec returns the smallest APIManager spec that, once defaulted,
+// leads to the same effective spec as the current one. Fields holding
+// values the defaulting logic would set anyway are stripped.
+//
+// Fields holding a default value, or an empty object, that cannot be
+// stripped because the effective spec depends on them being set are
+// returned as json paths.
+func (apimanager *APIManager) MinimalSpec() (*APIManagerSpec, []string, error) {
+	m := &apiManagerSpecMinimizer{apimanager: apimanager}
+
+	var err error
+	m.effective, err = m.effectiveSpec(&apimanager.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.defaults, err = m.effectiveSpec(&APIManagerSpec{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.minimal, err = specToMap(&apimanager.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.minimize(m.minimal, []string{"spec"})
+
+	minimalSpec, err := mapToSpec(m.minimal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return minimalSpec, m.notMinimized, nil
+}
+
+type apiManagerSpecMinimizer struct {
+	apimanager *APIManager
+	// defaulted current spec
+	effective map[string]interface{}
+	// defaulted empty spec
+	defaults map[string]interface{}
+	// minimal spec being computed
+	minimal      map[string]interface{}
+	notMinimized []string
+}
+
+// minimize strips, depth first, every field of obj whose removal does not change the effective spec
+func (m *apiManagerSpecMinimizer) minimize(obj map[string]interface{}, path []string) {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := append(append([]string{}, path...), key)
+
+		if child, ok := obj[key].(map[string]interface{}); ok {
+			m.minimize(child, fieldPath)
+			if len(child) > 0 {
+				continue
+			}
+			// Empty objects are most likely created by the defaulting logic,
+			// the ones that cannot be removed are meaningful by their presence
+			if !m.tryRemove(obj, key) {
+				m.notMinimized = append(m.notMinimized, strings.Join(fieldPath, "."))
+			}
+			continue
+		}
+
+		if m.tryRemove(obj, key) {
+			continue
+		}
+
+		if defaultValue, ok := lookupPath(m.defaults, fieldPath[1:]); ok && reflect.DeepEqual(defaultValue, obj[key]) {
+			m.notMinimized = append(m.notMinimized, strings.Join(fieldPath, "."))
+		}
+	}
+}
+
+// tryRemove removes the key from obj when the effective spec does not change
+func (m *apiManagerSpecMinimizer) tryRemove(obj map[string]interface{}, key string) bool {
+	value := obj[key]
+	delete(obj, key)
+
+	if m.isEquivalent() {
+		return true
+	}
+
+	obj[key] = value
+	return false
+}
+
+func (m *apiManagerSpecMinimizer) isEquivalent() bool {
+	spec, err := mapToSpec(m.minimal)
+	if err != nil {
+		return false
+	}
+
+	effective, err := m.effectiveSpec(spec)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(effective, m.effective)
+}
+
+func (m *apiManagerSpecMinimizer) effectiveSpec(spec *APIManagerSpec) (map[string]interface{}, error) {
+	apimanager := &APIManager{
+		ObjectMeta: *m.apimanager.ObjectMeta.DeepCopy(),
+		Spec:       *spec.DeepCopy(),
+	}
+
+	_, err := apimanager.SetSpecDefaults()
+	if err != nil {
+		return nil, err
+	}
+
+	return specToMap(&apimanager.Spec)
+}
+
+func lookupPath(obj map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = obj
+	for _, key := range path {
+		currentMap, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = currentMap[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func specToMap(spec *APIManagerSpec) (map[string]interface{}, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	err = json.Unmarshal(raw, &result)
+	return result, err
+}
+
+func mapToSpec(obj map[string]interface{}) (*APIManagerSpec, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &APIManagerSpec{}
+	err = json.Unmarshal(raw, spec)
+	return spec, err
+}
@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAPIManagerMinimalSpecRoundTrip(t *testing.T) {
+	customTenantName := "mytenant"
+	falseValue := false
+	var threeReplicas int64 = 3
+
+	cases := []struct {
+		testName             string
+		apimanagerFactory    func() *APIManager
+		expectedMinimal      *APIManagerSpec
+		expectedNotMinimized []string
+	}{
+		{"minimum", minimumAPIManagerTest,
+			&APIManagerSpec{APIManagerCommonSpec: APIManagerCommonSpec{WildcardDomain: "test.3scale.com"}},
+			nil,
+		},
+		{"custom values", func() *APIManager {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.TenantName = &customTenantName
+			apimanager.Spec.ResourceRequirementsEnabled = &falseValue
+			apimanager.Spec.Apicast = &ApicastSpec{
+				ProductionSpec: &ApicastProductionSpec{Replicas: &threeReplicas},
+			}
+			return apimanager
+		}, &APIManagerSpec{
+			APIManagerCommonSpec: APIManagerCommonSpec{
+				WildcardDomain:              "test.3scale.com",
+				TenantName:                  &customTenantName,
+				ResourceRequirementsEnabled: &falseValue,
+			},
+			Apicast: &ApicastSpec{
+				ProductionSpec: &ApicastProductionSpec{Replicas: &threeReplicas},
+			},
+		}, nil},
+		{"postgresql", func() *APIManager {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.System = &SystemSpec{
+				DatabaseSpec: &SystemDatabaseSpec{PostgreSQL: &SystemPostgreSQLSpec{}},
+			}
+			return apimanager
+		}, &APIManagerSpec{
+			APIManagerCommonSpec: APIManagerCommonSpec{WildcardDomain: "test.3scale.com"},
+			System: &SystemSpec{
+				DatabaseSpec: &SystemDatabaseSpec{PostgreSQL: &SystemPostgreSQLSpec{}},
+			},
+		}, []string{"spec.system.database.postgresql"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := tc.apimanagerFactory()
+			_, err := apimanager.SetDefaults()
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			minimal, notMinimized, err := apimanager.MinimalSpec()
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(minimal, tc.expectedMinimal) {
+				subT.Errorf("unexpected minimal spec: %s", cmp.Diff(minimal, tc.expectedMinimal))
+			}
+
+			if !reflect.DeepEqual(notMinimized, tc.expectedNotMinimized) {
+				subT.Errorf("unexpected not minimized fields: got %v, expected %v", notMinimized, tc.expectedNotMinimized)
+			}
+
+			// defaulting the minimal spec leads to the same spec
+			roundTrip := &APIManager{ObjectMeta: apimanager.ObjectMeta, Spec: *minimal}
+			_, err = roundTrip.SetDefaults()
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if !reflect.DeepEqual(roundTrip.Spec, apimanager.Spec) {
+				subT.Errorf("round trip spec differs: %s", cmp.Diff(roundTrip.Spec, apimanager.Spec))
+			}
+		})
+	}
+}
@@ -18,7 +18,13 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/RHsyseng/operator-utils/pkg/olm"
 	"github.com/go-logr/logr"
@@ -26,11 +32,15 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	appsv1beta1 "github.com/3scale/3scale-operator/apis/apps/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
 	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/version"
 )
 
@@ -43,6 +53,11 @@ const (
 	Default3scaleAppLabel       = "3scale-api-management"
 )
 
+// StorageVersionAnnotation is set to the storage version of the APIManager CRD.
+// Setting it makes the operator write the APIManagers stored in older versions,
+// which migrates them to the storage version
+const StorageVersionAnnotation = "apps.3scale.net/storage-version"
+
 const (
 	defaultTenantName                  = "3scale"
 	defaultImageStreamImportInsecure   = false
@@ -61,6 +76,51 @@ const (
 	DefaultHTTPSPort int32 = 8443
 )
 
+const (
+	// MinUnreachableTolerationSeconds is the minimum unreachable toleration seconds.
+	// Shorter values evict the pods on transient node heartbeat failures
+	MinUnreachableTolerationSeconds int64 = 30
+)
+
+const (
+	// DeveloperPortalEnabled and DeveloperPortalDisabled are the developer portal states reported in the status
+	DeveloperPortalEnabled  = "Enabled"
+	DeveloperPortalDisabled = "Disabled"
+)
+
+const (
+	// ZyncModeInternal and ZyncModeExternal are the zync modes reported in the status
+	ZyncModeInternal = "Internal"
+	ZyncModeExternal = "External"
+)
+
+const (
+	// ImagePullSecretsPolicyReplace and ImagePullSecretsPolicyMerge define how
+	// spec.imagePullSecrets is combined with the default image pull secrets
+	ImagePullSecretsPolicyReplace = "Replace"
+	ImagePullSecretsPolicyMerge   = "Merge"
+)
+
+const (
+	// AmpServiceAccountName and ZyncQueServiceAccountName are the default names of the
+	// service accounts created by the operator
+	AmpServiceAccountName     = "amp"
+	ZyncQueServiceAccountName = "zync-que-sa"
+)
+
+const (
+	// PodSecurityStandardRestricted and PodSecurityStandardPrivileged define whether the
+	// security contexts of the workloads are defaulted to the restricted Pod Security Standard
+	PodSecurityStandardRestricted = "restricted"
+	PodSecurityStandardPrivileged = "privileged"
+)
+
+const (
+	// TopologyFull and TopologyGatewayOnly are the topologies reported in the status
+	TopologyFull        = "Full"
+	TopologyGatewayOnly = "GatewayOnly"
+)
+
 // APIManagerSpec defines the desired state of APIManager
 type APIManagerSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -79,11 +139,42 @@ type APIManagerSpec struct {
 	HighAvailability *HighAvailabilitySpec `json:"highAvailability,omitempty"`
 	// +optional
 	ExternalComponents *ExternalComponentsSpec `json:"externalComponents,omitempty"`
+	// ExternalBackend configures system and apicast to use a backend managed outside
+	// of the APIManager. The backend-listener, backend-worker and backend-cron objects
+	// are not deployed and the backend redis must be external. Objects deployed before
+	// switching to an external backend are left in place but they are no longer reconciled
+	// +optional
+	ExternalBackend *ExternalBackendSpec `json:"externalBackend,omitempty"`
 
 	// +optional
 	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// +optional
 	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// Metrics configures metrics sinks other than prometheus scraping
+	// +optional
+	Metrics *MetricsSpec `json:"metrics,omitempty"`
+	// +optional
+	Shutdown *ShutdownSpec `json:"shutdown,omitempty"`
+	// Mode is the disaster recovery mode of the APIManager. In standby mode,
+	// the components writing to the databases (backend-cron, system-sidekiq and zync-que)
+	// are scaled down until the APIManager is switched to active. Defaults to active
+	// +kubebuilder:validation:Enum=active;standby
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+	// Hibernated scales down all the components to zero replicas, e.g. to park development
+	// installs overnight. The persistent volumes and the secrets are kept. The replicas
+	// the components had are restored when it is unset
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+	// GatewayOnly deploys only the apicast gateways, connected to a 3scale
+	// control plane managed outside of the APIManager. When set, system, backend,
+	// zync and their databases are not deployed. It cannot be set or unset on existing installs
+	// +optional
+	GatewayOnly *GatewayOnlySpec `json:"gatewayOnly,omitempty"`
+	// Databases configures the operations run by the operator
+	// on the internal databases
+	// +optional
+	Databases *DatabasesSpec `json:"databases,omitempty"`
 }
 
 // APIManagerStatus defines the observed state of APIManager
@@ -101,6 +192,289 @@ type APIManagerStatus struct {
 	// APIManager Deployment Configs
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Deployments",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podStatuses"
 	Deployments olm.DeploymentStatus `json:"deployments"`
+
+	// Components reports the replicas, readiness and deployed image
+	// of the DeploymentConfig of each component, by DeploymentConfig name
+	// +optional
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+
+	// Workloads reports the components whose containers have been
+	// terminated with failures (OOMKilled, CrashLoopBackOff),
+	// and the zone distribution of the multi-replica components
+	// +optional
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+
+	// Shutdown reports the progress of the ordered shutdown
+	// while the APIManager is being deleted
+	// +optional
+	Shutdown *ShutdownStatus `json:"shutdown,omitempty"`
+
+	// Standby reports the components kept scaled down by the standby mode
+	// and the progress of the activation
+	// +optional
+	Standby *StandbyStatus `json:"standby,omitempty"`
+
+	// BackendListenerRequestLogging reports when the backend-listener
+	// request logging was enabled and when it expires
+	// +optional
+	BackendListenerRequestLogging *RequestLoggingStatus `json:"backendListenerRequestLogging,omitempty"`
+
+	// AdminSSO reports the authentication provider configured
+	// in the default tenant for the admin portal single sign-on
+	// +optional
+	AdminSSO *AdminSSOStatus `json:"adminSSO,omitempty"`
+
+	// AccessTokens reports the access tokens created by the
+	// operator in the default tenant
+	// +optional
+	AccessTokens []AccessTokenStatus `json:"accessTokens,omitempty"`
+
+	// Hosts lists the hosts of the default routes computed
+	// from the wildcard domain and the tenant name
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// DeveloperPortal reports whether the developer portal is Enabled or Disabled
+	// +optional
+	DeveloperPortal string `json:"developerPortal,omitempty"`
+
+	// MasterEndpoint reports the endpoint the master admin portal is reachable at.
+	// The master route URL, or the internal system-master service URL
+	// when the master route is disabled
+	// +optional
+	MasterEndpoint string `json:"masterEndpoint,omitempty"`
+
+	// ZyncMode reports whether system uses the Internal zync deployed
+	// by the operator or an External zync
+	// +optional
+	ZyncMode string `json:"zyncMode,omitempty"`
+
+	// Topology reports whether the APIManager deploys the Full 3scale
+	// installation or only the gateways (GatewayOnly)
+	// +optional
+	Topology string `json:"topology,omitempty"`
+
+	// FileStorageMigration reports the progress of the migration
+	// of the system file storage from the PVC to S3
+	// +optional
+	FileStorageMigration *FileStorageMigrationStatus `json:"fileStorageMigration,omitempty"`
+
+	// ExternalBackendEndpoint reports the listener endpoint of the external
+	// backend system and apicast are connected to
+	// +optional
+	ExternalBackendEndpoint string `json:"externalBackendEndpoint,omitempty"`
+
+	// ThreescaleVersion is the 3scale release the components are deployed with.
+	// It is updated once the pre-upgrade database backup, when enabled, has completed
+	// +optional
+	ThreescaleVersion string `json:"threescaleVersion,omitempty"`
+
+	// DatabaseBackup reports the progress of the last pre-upgrade
+	// backup of the internal databases
+	// +optional
+	DatabaseBackup *DatabaseBackupStatus `json:"databaseBackup,omitempty"`
+}
+
+// StandbyStatus defines the observed state of the standby mode
+type StandbyStatus struct {
+	// ScaledDownComponents are the DeploymentConfigs kept with zero replicas.
+	// On activation, they are brought up one by one in dependency order
+	// +optional
+	ScaledDownComponents []string `json:"scaledDownComponents,omitempty"`
+
+	// ZyncResyncPending is set until zync domains have been resynchronized after activation
+	// +optional
+	ZyncResyncPending bool `json:"zyncResyncPending,omitempty"`
+}
+
+const (
+	FileStorageMigrationPhaseSyncing   = "Syncing"
+	FileStorageMigrationPhaseCompleted = "Completed"
+	FileStorageMigrationPhaseFailed    = "Failed"
+)
+
+const (
+	DatabaseBackupPhaseRunning   = "Running"
+	DatabaseBackupPhaseCompleted = "Completed"
+	DatabaseBackupPhaseFailed    = "Failed"
+)
+
+// DatabaseBackupStatus defines the observed state of the pre-upgrade database backup
+type DatabaseBackupStatus struct {
+	// Phase of the backup: Running, Completed or Failed.
+	// The upgrade is halted until the backup has completed
+	Phase string `json:"phase"`
+
+	// ThreescaleVersion is the 3scale release being upgraded to
+	ThreescaleVersion string `json:"threescaleVersion"`
+
+	// JobNames are the names of the jobs dumping the internal databases
+	// +optional
+	JobNames []string `json:"jobNames,omitempty"`
+
+	// StartTime is the time the backup started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time all the database dumps completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message holds the reason of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FileStorageMigrationStatus defines the observed state of the system file storage migration
+type FileStorageMigrationStatus struct {
+	// Phase of the migration: Syncing, Completed or Failed.
+	// The file storage is only switched to S3 once the files have been synced
+	Phase string `json:"phase"`
+
+	// ConfigurationSecretName is the S3 configuration secret of the target bucket
+	ConfigurationSecretName string `json:"configurationSecretName"`
+
+	// JobName is the name of the job syncing the files
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// StartTime is the time the migration was requested
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the file storage was switched to S3
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Files found in the system-storage PVC by the last sync
+	// +optional
+	Files int64 `json:"files,omitempty"`
+
+	// UploadedFiles is the number of files uploaded by the last sync.
+	// Files already in the bucket with the same content are skipped
+	// +optional
+	UploadedFiles int64 `json:"uploadedFiles,omitempty"`
+
+	// Message holds the reason of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// PVCRetained is set while the system-storage PVC is kept after the migration
+	// +optional
+	PVCRetained bool `json:"pvcRetained,omitempty"`
+}
+
+// RequestLoggingStatus defines the observed state of a temporary request logging
+type RequestLoggingStatus struct {
+	// StartTime is the time the request logging was enabled
+	StartTime metav1.Time `json:"startTime"`
+
+	// ExpirationTime is the time the request logging is disabled by the operator
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// AdminSSOStatus defines the observed state of the admin portal single sign-on
+type AdminSSOStatus struct {
+	// ProviderID is the ID of the authentication provider in the default tenant
+	ProviderID int64 `json:"providerID"`
+
+	// Created is true when the authentication provider was created by the operator.
+	// Only created providers are removed when the single sign-on is disabled
+	// +optional
+	Created bool `json:"created,omitempty"`
+
+	// CredentialsHash is the hash of the client credentials last configured in the provider
+	// +optional
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+}
+
+// AccessTokenStatus defines the observed state of an access token of the default tenant
+type AccessTokenStatus struct {
+	// Name of the access token
+	Name string `json:"name"`
+
+	// ID of the access token in the default tenant
+	ID int64 `json:"id"`
+
+	// SecretName is the secret holding the access token value
+	SecretName string `json:"secretName"`
+}
+
+// ShutdownStatus defines the observed state of the ordered shutdown
+type ShutdownStatus struct {
+	// Stage currently being run
+	Stage string `json:"stage"`
+
+	// StageStartTime is the time the current stage started
+	StageStartTime metav1.Time `json:"stageStartTime"`
+}
+
+// ComponentStatus defines the observed state of the DeploymentConfig of an APIManager component
+type ComponentStatus struct {
+	// DesiredReplicas is the number of replicas of the DeploymentConfig
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// AvailableReplicas is the number of available pods of the DeploymentConfig
+	AvailableReplicas int32 `json:"availableReplicas"`
+
+	// Ready is true when the DeploymentConfig is available and its latest rollout is complete
+	Ready bool `json:"ready"`
+
+	// Degraded is true when the latest rollout failed or the containers are crash-looping
+	// +optional
+	Degraded bool `json:"degraded,omitempty"`
+
+	// Image of the first container of the DeploymentConfig pod template
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Version is the 3scale release the component is labeled with
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// WorkloadStatus defines the observed failures and zone distribution of an APIManager component
+type WorkloadStatus struct {
+	// Name of the component's DeploymentConfig
+	Name string `json:"name"`
+
+	// LastFailure is the most recent container failure observed in the component's pods
+	// +optional
+	LastFailure *WorkloadFailure `json:"lastFailure,omitempty"`
+
+	// Zones is the distribution of the scheduled pods per topology zone.
+	// Only reported for components with more than one replica
+	// +optional
+	Zones []WorkloadZone `json:"zones,omitempty"`
+}
+
+// WorkloadZone describes the pods of a component scheduled in a topology zone
+type WorkloadZone struct {
+	// Name of the zone
+	Name string `json:"name"`
+
+	// Pods is the number of pods scheduled in the zone
+	Pods int32 `json:"pods"`
+}
+
+// WorkloadFailure describes a container failure
+type WorkloadFailure struct {
+	// Reason of the failure: OOMKilled, CrashLoopBackOff
+	Reason string `json:"reason"`
+
+	// Count is the number of restarts of the failing container
+	Count int32 `json:"count"`
+
+	// Timestamp of the last container termination
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Container name
+	Container string `json:"container"`
+
+	// Message is the termination message of the last container termination
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (s *APIManagerStatus) Equals(other *APIManagerStatus, logger logr.Logger) bool {
@@ -121,6 +495,96 @@ func (s *APIManagerStatus) Equals(other *APIManagerStatus, logger logr.Logger) b
 		return false
 	}
 
+	if !reflect.DeepEqual(s.Components, other.Components) {
+		diff := cmp.Diff(s.Components, other.Components)
+		logger.V(1).Info("Components not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.Workloads, other.Workloads) {
+		diff := cmp.Diff(s.Workloads, other.Workloads)
+		logger.V(1).Info("Workloads not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.Shutdown, other.Shutdown) {
+		diff := cmp.Diff(s.Shutdown, other.Shutdown)
+		logger.V(1).Info("Shutdown not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.Standby, other.Standby) {
+		diff := cmp.Diff(s.Standby, other.Standby)
+		logger.V(1).Info("Standby not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.FileStorageMigration, other.FileStorageMigration) {
+		diff := cmp.Diff(s.FileStorageMigration, other.FileStorageMigration)
+		logger.V(1).Info("FileStorageMigration not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.BackendListenerRequestLogging, other.BackendListenerRequestLogging) {
+		diff := cmp.Diff(s.BackendListenerRequestLogging, other.BackendListenerRequestLogging)
+		logger.V(1).Info("BackendListenerRequestLogging not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.AdminSSO, other.AdminSSO) {
+		diff := cmp.Diff(s.AdminSSO, other.AdminSSO)
+		logger.V(1).Info("AdminSSO not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.AccessTokens, other.AccessTokens) {
+		diff := cmp.Diff(s.AccessTokens, other.AccessTokens)
+		logger.V(1).Info("AccessTokens not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.Hosts, other.Hosts) {
+		diff := cmp.Diff(s.Hosts, other.Hosts)
+		logger.V(1).Info("Hosts not equal", "difference", diff)
+		return false
+	}
+
+	if s.DeveloperPortal != other.DeveloperPortal {
+		logger.V(1).Info("DeveloperPortal not equal", "current", s.DeveloperPortal, "other", other.DeveloperPortal)
+		return false
+	}
+
+	if s.MasterEndpoint != other.MasterEndpoint {
+		logger.V(1).Info("MasterEndpoint not equal", "current", s.MasterEndpoint, "other", other.MasterEndpoint)
+		return false
+	}
+
+	if s.ZyncMode != other.ZyncMode {
+		logger.V(1).Info("ZyncMode not equal", "current", s.ZyncMode, "other", other.ZyncMode)
+		return false
+	}
+
+	if s.Topology != other.Topology {
+		logger.V(1).Info("Topology not equal", "current", s.Topology, "other", other.Topology)
+		return false
+	}
+
+	if s.ExternalBackendEndpoint != other.ExternalBackendEndpoint {
+		logger.V(1).Info("ExternalBackendEndpoint not equal", "current", s.ExternalBackendEndpoint, "other", other.ExternalBackendEndpoint)
+		return false
+	}
+
+	if s.ThreescaleVersion != other.ThreescaleVersion {
+		logger.V(1).Info("ThreescaleVersion not equal", "current", s.ThreescaleVersion, "other", other.ThreescaleVersion)
+		return false
+	}
+
+	if !reflect.DeepEqual(s.DatabaseBackup, other.DatabaseBackup) {
+		diff := cmp.Diff(s.DatabaseBackup, other.DatabaseBackup)
+		logger.V(1).Info("DatabaseBackup not equal", "difference", diff)
+		return false
+	}
+
 	return true
 }
 
@@ -150,6 +614,59 @@ type APIManager struct {
 
 const (
 	APIManagerAvailableConditionType common.ConditionType = "Available"
+	// APIManagerSystemCacheStoreWarningConditionType is set when the system
+	// cache store configuration is likely to cause issues
+	APIManagerSystemCacheStoreWarningConditionType common.ConditionType = "SystemCacheStoreWarning"
+	// APIManagerWorkloadCrashLoopingConditionType is set when some component
+	// containers have been restarting repeatedly in the last hour
+	APIManagerWorkloadCrashLoopingConditionType common.ConditionType = "WorkloadCrashLooping"
+	// APIManagerShuttingDownConditionType is set while the components
+	// are being stopped in order before the APIManager is deleted
+	APIManagerShuttingDownConditionType common.ConditionType = "ShuttingDown"
+	// APIManagerMonitoringPartiallyAvailableConditionType is set when monitoring
+	// is enabled and some of the monitoring CRDs are not installed in the cluster
+	APIManagerMonitoringPartiallyAvailableConditionType common.ConditionType = "MonitoringPartiallyAvailable"
+	// APIManagerStandbyConditionType is set while the APIManager is in standby
+	// mode or being activated
+	APIManagerStandbyConditionType common.ConditionType = "Standby"
+	// APIManagerHibernatedConditionType is set while the APIManager is hibernated
+	APIManagerHibernatedConditionType common.ConditionType = "Hibernated"
+	// APIManagerReconciliationPausedConditionType is set while the reconciliation
+	// of the objects owned by the APIManager is paused
+	APIManagerReconciliationPausedConditionType common.ConditionType = "ReconciliationPaused"
+	// APIManagerRouteHostsWarningConditionType is set when some default route
+	// hosts exceed the DNS length limits and will be rejected by the routers
+	APIManagerRouteHostsWarningConditionType common.ConditionType = "RouteHostsWarning"
+	// APIManagerSingleZoneWorkloadsConditionType is set when all the replicas
+	// of some critical component are scheduled in a single zone of a multi-zone cluster
+	APIManagerSingleZoneWorkloadsConditionType common.ConditionType = "SingleZoneWorkloads"
+	// APIManagerAdminSSOReadyConditionType reports whether the admin portal
+	// single sign-on is configured and verified in the default tenant
+	APIManagerAdminSSOReadyConditionType common.ConditionType = "AdminSSOReady"
+	// APIManagerAccessTokensReadyConditionType reports whether the access
+	// tokens of the default tenant are created and stored in their secrets
+	APIManagerAccessTokensReadyConditionType common.ConditionType = "AccessTokensReady"
+	// APIManagerProgressingConditionType is set while some component
+	// DeploymentConfig is missing or rolling out
+	APIManagerProgressingConditionType common.ConditionType = "Progressing"
+	// APIManagerDegradedConditionType is set when the latest rollout of some
+	// component failed or its containers are crash-looping
+	APIManagerDegradedConditionType common.ConditionType = "Degraded"
+	// APIManagerBackendReachableConditionType reports whether the external backend
+	// listener endpoint is reachable. Only set when the connectivity checks are enabled
+	APIManagerBackendReachableConditionType common.ConditionType = "BackendReachable"
+	// APIManagerSystemInboundEmailSecretMissingConditionType is set when the
+	// system inbound email credentials secret does not exist
+	APIManagerSystemInboundEmailSecretMissingConditionType common.ConditionType = "SystemInboundEmailSecretMissing"
+	// APIManagerPrometheusRuleOverridesWarningConditionType is set when some
+	// alert overrides cannot be applied to the generated PrometheusRules
+	APIManagerPrometheusRuleOverridesWarningConditionType common.ConditionType = "PrometheusRuleOverridesWarning"
+	// APIManagerPersistentVolumeClaimErrorConditionType is set when changes of
+	// the PersistentVolumeClaims spec cannot be applied to the existing claims
+	APIManagerPersistentVolumeClaimErrorConditionType common.ConditionType = "PersistentVolumeClaimError"
+	// APIManagerDeprecatedAPIVersionConditionType is set when clients other than
+	// the operator write the APIManager through the deprecated v1alpha1 API
+	APIManagerDeprecatedAPIVersionConditionType common.ConditionType = "DeprecatedAPIVersion"
 )
 
 type APIManagerCommonSpec struct {
@@ -158,6 +675,11 @@ type APIManagerCommonSpec struct {
 	WildcardDomain string `json:"wildcardDomain"`
 	// +optional
 	AppLabel *string `json:"appLabel,omitempty"`
+	// Labels added to all the resources and pods generated by the operator,
+	// e.g. for chargeback. The labels set by the operator and the component
+	// labels take precedence. Removed keys are not removed from the existing objects
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 	// +optional
 	TenantName *string `json:"tenantName,omitempty"`
 	// +optional
@@ -166,6 +688,118 @@ type APIManagerCommonSpec struct {
 	ResourceRequirementsEnabled *bool `json:"resourceRequirementsEnabled,omitempty"`
 	// +optional
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// ImagePullSecretsPolicy defines how the image pull secrets are combined with the
+	// default ones in the managed service accounts. Replace uses only the image pull
+	// secrets when set. Merge appends them to the defaults, deduplicated by name.
+	// Defaults to Replace
+	// +kubebuilder:validation:Enum=Replace;Merge
+	// +optional
+	ImagePullSecretsPolicy *string `json:"imagePullSecretsPolicy,omitempty"`
+	// ImageRegistryOverride pulls the default images from a mirrored registry.
+	// Images explicitly set in the component specs are not rewritten
+	// +optional
+	ImageRegistryOverride *ImageRegistryOverrideSpec `json:"imageRegistryOverride,omitempty"`
+	// TerminationMessagePolicy of the containers of the components. With FallbackToLogsOnError,
+	// the last lines of the log are the termination message of the failed containers
+	// not writing to the termination message file. Defaults to FallbackToLogsOnError
+	// +kubebuilder:validation:Enum=File;FallbackToLogsOnError
+	// +optional
+	TerminationMessagePolicy *v1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+	// PodSecurityStandard the security contexts of the workloads comply with. With restricted, the unset
+	// fields of the security contexts are defaulted to comply with the restricted Pod Security Standard.
+	// The pods run as non-root with the RuntimeDefault seccomp profile, and the containers drop all the
+	// capabilities and do not allow privilege escalation. With privileged or when not set, the security
+	// contexts are not defaulted, e.g. on clusters where setting the seccomp profile is not allowed
+	// +kubebuilder:validation:Enum=restricted;privileged
+	// +optional
+	PodSecurityStandard *string `json:"podSecurityStandard,omitempty"`
+	// ImagePullPolicy of the containers of all the workloads, e.g. Always on development clusters using
+	// floating image tags. Overridden by the image pull policy of the component specs. When not set,
+	// the images are pulled if not present, except for zync-que which always pulls them
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// UnreachableTolerationSeconds is how long the pods stay bound to a not-ready or unreachable
+	// node before being evicted. The not-ready and unreachable tolerations are added to the pods
+	// along with the tolerations of the components. It can be overridden per component.
+	// When not set, the cluster default is used
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	UnreachableTolerationSeconds *int64 `json:"unreachableTolerationSeconds,omitempty"`
+	// JobsTemplate overrides the scheduling settings of the pods of the jobs created by the operator.
+	// When not set, the jobs inherit the settings of the component they belong to
+	// +optional
+	JobsTemplate *JobsTemplateSpec `json:"jobsTemplate,omitempty"`
+	// ServiceAccounts configures the service accounts created by the operator, e.g. to annotate
+	// them to access S3 or external databases with workload identity instead of static credentials
+	// +optional
+	ServiceAccounts *ServiceAccountsSpec `json:"serviceAccounts,omitempty"`
+}
+
+// JobsTemplateSpec defines the scheduling settings of the pods of the
+// jobs created by the operator. Set fields take precedence over the
+// settings inherited from the components
+type JobsTemplateSpec struct {
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+}
+
+// JobPodTemplateOptions returns the scheduling settings set in the jobs template
+func (spec *JobsTemplateSpec) JobPodTemplateOptions() *helper.JobPodTemplateOptions {
+	if spec == nil {
+		return nil
+	}
+
+	opts := &helper.JobPodTemplateOptions{
+		NodeSelector: spec.NodeSelector,
+		Affinity:     spec.Affinity,
+		Tolerations:  spec.Tolerations,
+	}
+	if spec.PriorityClassName != nil {
+		opts.PriorityClassName = *spec.PriorityClassName
+	}
+	return opts
+}
+
+// ServiceAccountsSpec configures the service accounts created by the operator
+type ServiceAccountsSpec struct {
+	// Amp is the service account of all the workloads but zync-que
+	// +optional
+	Amp *ServiceAccountSpec `json:"amp,omitempty"`
+	// ZyncQue is the service account of zync-que
+	// +optional
+	ZyncQue *ServiceAccountSpec `json:"zyncQue,omitempty"`
+}
+
+// ServiceAccountSpec configures a service account created by the operator
+type ServiceAccountSpec struct {
+	// Name of the service account. The pods are rolled out to run as the renamed service
+	// account. The service account previously created is not removed.
+	// Defaults to amp, or zync-que-sa for zync-que
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Annotations of the service account, e.g. eks.amazonaws.com/role-arn to assume
+	// an IAM role with IRSA. Annotations removed from the list are kept in the service account
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ImageRegistryOverrideSpec defines the registry mirroring the default images
+type ImageRegistryOverrideSpec struct {
+	// Host is the registry host, with optional port, replacing
+	// the registry of the default images. I.e. "mirror.example.com:5000"
+	Host string `json:"host"`
+	// RepositoryPrefix is prepended to the repository of the default images
+	// +optional
+	RepositoryPrefix *string `json:"repositoryPrefix,omitempty"`
 }
 
 // CustomEnvironmentSpec contains or has reference to an APIcast custom environment
@@ -173,6 +807,65 @@ type CustomEnvironmentSpec struct {
 	SecretRef *v1.LocalObjectReference `json:"secretRef"`
 }
 
+// APIcastClientTLSSpec configures the verification of the client certificates
+type APIcastClientTLSSpec struct {
+	// CASecretRef references the secret containing the PEM encoded CA bundle
+	// in the `ca.crt` key. Client certificates not issued by any of the CAs are rejected
+	CASecretRef *v1.LocalObjectReference `json:"caSecretRef"`
+	// VerifyDepth defines the maximum length of the client certificate chain.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	VerifyDepth *int64 `json:"verifyDepth,omitempty"` // APICAST_HTTPS_VERIFY_DEPTH
+}
+
+// APIcastWarmupSpec configures the requests sent to the local gateway from the
+// readiness probe. The pod is not ready until all of them get a response, which
+// loads the gateway configuration of the hosts and resolves the upstream connections.
+// Failed requests are retried on the next probe, they never restart the container
+type APIcastWarmupSpec struct {
+	// Enabled turns on the warm-up. Defaults to false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Targets are the requests sent to the gateway
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Targets []APIcastWarmupTargetSpec `json:"targets"`
+	// TimeoutSeconds bounds each warm-up request. Defaults to 5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+func (w *APIcastWarmupSpec) IsEnabled() bool {
+	return w != nil && w.Enabled != nil && *w.Enabled
+}
+
+// APIcastAWSLoadBalancerSpec configures the target group of the AWS load balancer
+// controller. The deregistration delay of the target group is set to the
+// lbDeregistrationDelaySeconds of the gateway
+type APIcastAWSLoadBalancerSpec struct {
+	// TargetType of the target group, ip or instance. Defaults to ip
+	// +kubebuilder:validation:Enum=ip;instance
+	// +optional
+	TargetType *string `json:"targetType,omitempty"`
+	// TargetGroupBindingName adds the target health readiness gate of the TargetGroupBinding,
+	// so the pods are not ready until they are healthy in the target group
+	// +optional
+	TargetGroupBindingName *string `json:"targetGroupBindingName,omitempty"`
+}
+
+// APIcastWarmupTargetSpec defines a warm-up request
+type APIcastWarmupTargetSpec struct {
+	// Host sent in the Host header, usually the public host of a product
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	Host string `json:"host"`
+	// Path requested. Defaults to /
+	// +kubebuilder:validation:Pattern=`^/[a-zA-Z0-9._~!&()*+,;=:@%/?-]*$`
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
 // CustomPolicySpec contains or has reference to an APIcast custom policy
 type CustomPolicySpec struct {
 	// Name specifies the name of the custom policy
@@ -205,12 +898,24 @@ type ApicastSpec struct {
 }
 
 type ApicastProductionSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// HPA scales the pods with a HorizontalPodAutoscaler instead of a fixed number
+	// of replicas. Replicas cannot be set along with it
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 	// +optional
@@ -260,15 +965,41 @@ type ApicastProductionSpec struct {
 	// * character, which matches all hosts, effectively disables the proxy.
 	// +optional
 	NoProxy *string `json:"noProxy,omitempty"` // NO_PROXY
+	// ClientTLS enables the verification of the client certificates
+	// against a CA bundle. Requires TLS at APIcast pod level to be enabled.
+	// +optional
+	ClientTLS *APIcastClientTLSSpec `json:"clientTLS,omitempty"`
+	// Warmup sends requests to the gateway before the pod is marked as ready,
+	// so rollouts do not route traffic to cold pods
+	// +optional
+	Warmup *APIcastWarmupSpec `json:"warmup,omitempty"`
+	// LBDeregistrationDelaySeconds delays the termination of the pods with a preStop hook,
+	// so external load balancers deregister them while the gateway still serves traffic.
+	// The termination grace period is extended by the delay. Defaults to no delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	LBDeregistrationDelaySeconds *int64 `json:"lbDeregistrationDelaySeconds,omitempty"`
+	// ReadinessGates of the pods, i.e. conditions set by external load balancer controllers
+	// once the pod is registered
+	// +optional
+	ReadinessGates []v1.PodReadinessGate `json:"readinessGates,omitempty"`
+	// AWSLoadBalancer annotates the service for the AWS load balancer controller
+	// +optional
+	AWSLoadBalancer *APIcastAWSLoadBalancerSpec `json:"awsLoadBalancer,omitempty"`
 }
 
 type ApicastStagingSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
-	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 	// +optional
@@ -315,8 +1046,26 @@ type ApicastStagingSpec struct {
 	// * character, which matches all hosts, effectively disables the proxy.
 	// +optional
 	NoProxy *string `json:"noProxy,omitempty"` // NO_PROXY
-}
-
+	// Warmup sends requests to the gateway before the pod is marked as ready,
+	// so rollouts do not route traffic to cold pods
+	// +optional
+	Warmup *APIcastWarmupSpec `json:"warmup,omitempty"`
+	// LBDeregistrationDelaySeconds delays the termination of the pods with a preStop hook,
+	// so external load balancers deregister them while the gateway still serves traffic.
+	// The termination grace period is extended by the delay. Defaults to no delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	LBDeregistrationDelaySeconds *int64 `json:"lbDeregistrationDelaySeconds,omitempty"`
+	// ReadinessGates of the pods, i.e. conditions set by external load balancer controllers
+	// once the pod is registered
+	// +optional
+	ReadinessGates []v1.PodReadinessGate `json:"readinessGates,omitempty"`
+	// AWSLoadBalancer annotates the service for the AWS load balancer controller
+	// +optional
+	AWSLoadBalancer *APIcastAWSLoadBalancerSpec `json:"awsLoadBalancer,omitempty"`
+}
+
 type BackendSpec struct {
 	// +optional
 	Image *string `json:"image,omitempty"`
@@ -324,12 +1073,36 @@ type BackendSpec struct {
 	RedisImage *string `json:"redisImage,omitempty"`
 	// +optional
 	RedisPersistentVolumeClaimSpec *BackendRedisPersistentVolumeClaimSpec `json:"redisPersistentVolumeClaim,omitempty"`
+	// RedisNodeSelector of the backend redis pods. Only the nodes with matching labels are eligible
+	// +optional
+	RedisNodeSelector map[string]string `json:"redisNodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	RedisAffinity *v1.Affinity `json:"redisAffinity,omitempty"`
 	// +optional
 	RedisTolerations []v1.Toleration `json:"redisTolerations,omitempty"`
+	// RedisTopologySpreadConstraints of the backend redis pods, e.g. to spread them across zones
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisTopologySpreadConstraints []v1.TopologySpreadConstraint `json:"redisTopologySpreadConstraints,omitempty"`
+	// RedisPriorityClassName of the redis pods. When not set, the cluster default priority is used
+	// +optional
+	RedisPriorityClassName *string `json:"redisPriorityClassName,omitempty"`
 	// +optional
 	RedisResources *v1.ResourceRequirements `json:"redisResources,omitempty"`
+	// RedisPodSecurityContext of the redis pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user redis runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisPodSecurityContext *v1.PodSecurityContext `json:"redisPodSecurityContext,omitempty"`
+	// RedisSecurityContext of the redis container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisSecurityContext *v1.SecurityContext `json:"redisSecurityContext,omitempty"`
 	// +optional
 	ListenerSpec *BackendListenerSpec `json:"listenerSpec,omitempty"`
 	// +optional
@@ -341,37 +1114,75 @@ type BackendSpec struct {
 type BackendRedisPersistentVolumeClaimSpec struct {
 	// +optional
 	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type BackendListenerSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
-	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// RequestLogging temporarily enables the request logging of backend-listener.
+	// The operator disables it once the TTL has expired
+	// +optional
+	RequestLogging *BackendListenerRequestLoggingSpec `json:"requestLogging,omitempty"`
+}
+
+type BackendListenerRequestLoggingSpec struct {
+	// Enabled turns on the request logging. Set back to false by the operator when the TTL expires
+	Enabled bool `json:"enabled"`
+	// SamplingRate is the percentage of requests logged. Defaults to 100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingRate *int32 `json:"samplingRate,omitempty"`
+	// TTLSeconds is the time the request logging is kept enabled. Defaults to 3600
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTLSeconds *int64 `json:"ttlSeconds,omitempty"`
 }
 
 type BackendWorkerSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
-	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type BackendCronSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
-	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 }
@@ -383,24 +1194,78 @@ type SystemSpec struct {
 	// +optional
 	MemcachedImage *string `json:"memcachedImage,omitempty"`
 
+	// CacheStore selects the system rails cache store.
+	// When redis is selected, system-memcache is not deployed and system-redis is used for caching.
+	// Defaults to memcached
+	// +kubebuilder:validation:Enum=memcached;redis
+	// +optional
+	CacheStore *string `json:"cacheStore,omitempty"`
+
+	// MemcachedNodeSelector of the memcached pods. Only the nodes with matching labels are eligible
+	// +optional
+	MemcachedNodeSelector map[string]string `json:"memcachedNodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	MemcachedAffinity *v1.Affinity `json:"memcachedAffinity,omitempty"`
 	// +optional
 	MemcachedTolerations []v1.Toleration `json:"memcachedTolerations,omitempty"`
+	// MemcachedTopologySpreadConstraints of the memcached pods, e.g. to spread them across zones
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	MemcachedTopologySpreadConstraints []v1.TopologySpreadConstraint `json:"memcachedTopologySpreadConstraints,omitempty"`
+	// MemcachedPriorityClassName of the memcached pods. When not set, the cluster default priority is used
+	// +optional
+	MemcachedPriorityClassName *string `json:"memcachedPriorityClassName,omitempty"`
 	// +optional
 	MemcachedResources *v1.ResourceRequirements `json:"memcachedResources,omitempty"`
+	// MemcachedPodSecurityContext of the memcached pods
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	MemcachedPodSecurityContext *v1.PodSecurityContext `json:"memcachedPodSecurityContext,omitempty"`
+	// MemcachedSecurityContext of the memcached container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	MemcachedSecurityContext *v1.SecurityContext `json:"memcachedSecurityContext,omitempty"`
 
 	// +optional
 	RedisImage *string `json:"redisImage,omitempty"`
 
 	// +optional
 	RedisPersistentVolumeClaimSpec *SystemRedisPersistentVolumeClaimSpec `json:"redisPersistentVolumeClaim,omitempty"`
+	// RedisNodeSelector of the system redis pods. Only the nodes with matching labels are eligible
+	// +optional
+	RedisNodeSelector map[string]string `json:"redisNodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	RedisAffinity *v1.Affinity `json:"redisAffinity,omitempty"`
 	// +optional
 	RedisTolerations []v1.Toleration `json:"redisTolerations,omitempty"`
+	// RedisTopologySpreadConstraints of the system redis pods, e.g. to spread them across zones
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisTopologySpreadConstraints []v1.TopologySpreadConstraint `json:"redisTopologySpreadConstraints,omitempty"`
+	// RedisPriorityClassName of the redis pods. When not set, the cluster default priority is used
+	// +optional
+	RedisPriorityClassName *string `json:"redisPriorityClassName,omitempty"`
 	// +optional
 	RedisResources *v1.ResourceRequirements `json:"redisResources,omitempty"`
+	// RedisPodSecurityContext of the redis pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user redis runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisPodSecurityContext *v1.PodSecurityContext `json:"redisPodSecurityContext,omitempty"`
+	// RedisSecurityContext of the redis container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	RedisSecurityContext *v1.SecurityContext `json:"redisSecurityContext,omitempty"`
 
 	// TODO should this field be optional? We have different approaches in Kubernetes.
 	// For example, in v1.Volume it is optional and there's an implied behaviour
@@ -423,15 +1288,149 @@ type SystemSpec struct {
 	SidekiqSpec *SystemSidekiqSpec `json:"sidekiqSpec,omitempty"`
 	// +optional
 	SphinxSpec *SystemSphinxSpec `json:"sphinxSpec,omitempty"`
+
+	// AdminSSO configures the OpenID Connect single sign-on
+	// of the default tenant admin portal
+	// +optional
+	AdminSSO *SystemAdminSSOSpec `json:"adminSSO,omitempty"`
+
+	// AccessTokens are the access tokens the operator creates for the
+	// default tenant admin user, i.e. read-only tokens for integrations
+	// +optional
+	AccessTokens []SystemAccessTokenSpec `json:"accessTokens,omitempty"`
+
+	// CORS configures the cross-origin resource sharing headers
+	// of the admin and master APIs. When not set, CORS is disabled
+	// +optional
+	CORS *SystemCORSSpec `json:"cors,omitempty"`
+
+	// DeveloperPortal configures the developer portal of the tenants.
+	// When not set, the developer portal is enabled
+	// +optional
+	DeveloperPortal *SystemDeveloperPortalSpec `json:"developerPortal,omitempty"`
+
+	// InboundEmail configures the mailbox the support replies and bounced
+	// emails are fetched from. When not set, it is configured in the admin portal
+	// +optional
+	InboundEmail *SystemInboundEmailSpec `json:"inboundEmail,omitempty"`
+
+	// MasterRoute configures the external route of the master admin portal.
+	// When not set, the master route is created
+	// +optional
+	MasterRoute *SystemMasterRouteSpec `json:"masterRoute,omitempty"`
+}
+
+// SystemAdminSSOSpec defines the identity provider the default tenant
+// admin portal users sign in with, i.e. a RH-SSO realm
+type SystemAdminSSOSpec struct {
+	// IssuerURL is the OpenID Connect issuer URL of the identity provider.
+	// I.e. https://sso.example.com/auth/realms/3scale
+	IssuerURL string `json:"issuerURL"`
+	// ClientCredentialsSecretRef references the secret holding the client
+	// credentials in the `clientID` and `clientSecret` keys
+	ClientCredentialsSecretRef v1.LocalObjectReference `json:"clientCredentialsSecretRef"`
+	// AutoProvision approves the admin portal users signing in
+	// for the first time. Defaults to false
+	// +optional
+	AutoProvision *bool `json:"autoProvision,omitempty"`
+	// Published makes the provider available in the admin portal
+	// login page. Defaults to true
+	// +optional
+	Published *bool `json:"published,omitempty"`
+}
+
+// SystemAccessTokenSpec defines an access token of the default tenant admin user.
+// The token value is stored in the `token` key of a secret owned by the APIManager
+type SystemAccessTokenSpec struct {
+	// Name of the access token. Unique among the access tokens
+	Name string `json:"name"`
+	// Scopes of the access token
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+	// Permission of the access token, ro (read-only) or rw (read-write). Defaults to ro
+	// +kubebuilder:validation:Enum=ro;rw
+	// +optional
+	Permission *string `json:"permission,omitempty"`
+	// SecretName is the secret the access token value is stored in
+	SecretName string `json:"secretName"`
+}
+
+// SystemCORSSpec defines the cross-origin requests allowed
+// on the admin and master APIs
+type SystemCORSSpec struct {
+	// AllowedOrigins are the origins allowed to send cross-origin requests.
+	// "*" allows any origin
+	// +kubebuilder:validation:MinItems=1
+	AllowedOrigins []string `json:"allowedOrigins"`
+	// AllowedHeaders are the request headers allowed in cross-origin requests.
+	// Defaults to any header
+	// +optional
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+	// MaxAge is the number of seconds the preflight responses can be cached
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAge *int32 `json:"maxAge,omitempty"`
+	// AllowCredentials allows cross-origin requests with credentials.
+	// Not allowed together with the "*" origin. Defaults to false
+	// +optional
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+}
+
+// SystemDeveloperPortalSpec defines whether the developer portal is served
+type SystemDeveloperPortalSpec struct {
+	// Enabled serves the developer portal. When disabled, the system-developer
+	// container, service and routes are removed. Defaults to true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SystemMasterRouteSpec defines whether the master admin portal is exposed
+type SystemMasterRouteSpec struct {
+	// Enabled exposes the master admin portal in the master route. When disabled,
+	// the master route is removed and the master admin portal is only reachable
+	// in the cluster through the system-master service. Defaults to true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SystemInboundEmailSpec defines the mailbox system fetches
+// the inbound emails from
+type SystemInboundEmailSpec struct {
+	// Protocol used to fetch the emails
+	// +kubebuilder:validation:Enum=imap;pop3
+	Protocol string `json:"protocol"`
+	// Host of the mail server
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// Port of the mail server. Defaults to the well-known port of the protocol:
+	// 993 (imap) and 995 (pop3) with SSL, 143 (imap) and 110 (pop3) without it
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// SSL connects to the mail server over TLS. Defaults to true
+	// +optional
+	SSL *bool `json:"ssl,omitempty"`
+	// CredentialsSecretRef references the secret holding the mailbox
+	// credentials in the `username` and `password` keys
+	CredentialsSecretRef v1.LocalObjectReference `json:"credentialsSecretRef"`
 }
 
 type SystemAppSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
 	// +optional
 	MasterContainerResources *v1.ResourceRequirements `json:"masterContainerResources,omitempty"`
 	// +optional
@@ -441,21 +1440,35 @@ type SystemAppSpec struct {
 }
 
 type SystemSidekiqSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type SystemSphinxSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Probes tunes the liveness probe and adds a startup probe to the containers.
+	// Unset values keep the default probes. The sphinx containers have no readiness probe
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 }
@@ -474,6 +1487,14 @@ type SystemFileStorageSpec struct {
 type SystemRedisPersistentVolumeClaimSpec struct {
 	// +optional
 	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type SystemPVCSpec struct {
@@ -486,6 +1507,14 @@ type SystemPVCSpec struct {
 	// VolumeName is the binding reference to the PersistentVolume backing this claim.
 	// +optional
 	VolumeName *string `json:"volumeName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type DeprecatedSystemS3Spec struct {
@@ -510,31 +1539,53 @@ type SystemDatabaseSpec struct {
 }
 
 type SystemMySQLSpec struct {
+	PodPlacementSpec `json:",inline"`
+
 	// +optional
 	Image *string `json:"image,omitempty"`
 
 	// +optional
 	PersistentVolumeClaimSpec *SystemMySQLPVCSpec `json:"persistentVolumeClaim,omitempty"`
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user the database runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
-	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+	// SharedMemorySizeLimit mounts a memory backed volume of the given size
+	// at /dev/shm. When not set the container runtime default is used
+	// +optional
+	SharedMemorySizeLimit *resource.Quantity `json:"sharedMemorySizeLimit,omitempty"`
 }
 
 type SystemPostgreSQLSpec struct {
+	PodPlacementSpec `json:",inline"`
+
 	// +optional
 	Image *string `json:"image,omitempty"`
 
 	// +optional
 	PersistentVolumeClaimSpec *SystemPostgreSQLPVCSpec `json:"persistentVolumeClaim,omitempty"`
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user the database runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
-	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
 }
 
 type SystemMySQLPVCSpec struct {
@@ -547,6 +1598,14 @@ type SystemMySQLPVCSpec struct {
 	// VolumeName is the binding reference to the PersistentVolume backing this claim.
 	// +optional
 	VolumeName *string `json:"volumeName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type SystemPostgreSQLPVCSpec struct {
@@ -559,6 +1618,14 @@ type SystemPostgreSQLPVCSpec struct {
 	// VolumeName is the binding reference to the PersistentVolume backing this claim.
 	// +optional
 	VolumeName *string `json:"volumeName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type ZyncSpec struct {
@@ -567,40 +1634,231 @@ type ZyncSpec struct {
 	// +optional
 	PostgreSQLImage *string `json:"postgreSQLImage,omitempty"`
 
+	// DatabaseNodeSelector of the zync database pods. Only the nodes with matching labels are eligible.
+	// Does not take effect when the database is managed externally
+	// +optional
+	DatabaseNodeSelector map[string]string `json:"databaseNodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	DatabaseAffinity *v1.Affinity `json:"databaseAffinity,omitempty"`
 	// +optional
 	DatabaseTolerations []v1.Toleration `json:"databaseTolerations,omitempty"`
+	// DatabaseTopologySpreadConstraints of the zync database pods.
+	// Does not take effect when the database is managed externally
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DatabaseTopologySpreadConstraints []v1.TopologySpreadConstraint `json:"databaseTopologySpreadConstraints,omitempty"`
+	// DatabasePriorityClassName of the zync database pods. When not set, the cluster default priority is used
+	// +optional
+	DatabasePriorityClassName *string `json:"databasePriorityClassName,omitempty"`
+	// DatabasePodSecurityContext of the zync database pods. The fsGroup is mandatory when
+	// the data is stored in a PersistentVolumeClaim, so it is writable by the user the database runs as.
+	// Does not take effect when the database is managed externally
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DatabasePodSecurityContext *v1.PodSecurityContext `json:"databasePodSecurityContext,omitempty"`
+	// DatabaseSecurityContext of the zync database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DatabaseSecurityContext *v1.SecurityContext `json:"databaseSecurityContext,omitempty"`
 	// +optional
 	DatabaseResources *v1.ResourceRequirements `json:"databaseResources,omitempty"`
+	// DatabaseSharedMemorySizeLimit mounts a memory backed volume of the given size
+	// at /dev/shm of the zync database. When not set the container runtime default is used
+	// +optional
+	DatabaseSharedMemorySizeLimit *resource.Quantity `json:"databaseSharedMemorySizeLimit,omitempty"`
+	// DatabaseStorage stores the data of the internal zync database in a PersistentVolumeClaim.
+	// When not set the data is stored in an ephemeral volume.
+	// Does not take effect when the database is external
+	// +optional
+	DatabaseStorage *ZyncDatabaseStorageSpec `json:"databaseStorage,omitempty"`
+	// Database configures the connection to an external zync database
+	// +optional
+	Database *ZyncDatabaseSpec `json:"database,omitempty"`
+	// DatabaseMaintenance configures the periodic maintenance of the internal
+	// zync database. Does not take effect when the database is external
+	// +optional
+	DatabaseMaintenance *ZyncDatabaseMaintenanceSpec `json:"databaseMaintenance,omitempty"`
 
 	// +optional
 	AppSpec *ZyncAppSpec `json:"appSpec,omitempty"`
 
 	// +optional
 	QueSpec *ZyncQueSpec `json:"queSpec,omitempty"`
+
+	// ExternalZync configures system to use a zync managed outside of the APIManager.
+	// The zync, zync-que and zync-database objects are not deployed. Objects
+	// deployed before switching to an external zync are left in place
+	// but they are no longer reconciled
+	// +optional
+	ExternalZync *ExternalZyncSpec `json:"externalZync,omitempty"`
+}
+
+// ExternalZyncSpec configures the connection of system to an external zync
+type ExternalZyncSpec struct {
+	// Endpoint is the URL of the external zync API
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint"`
+	// SecretRef references the secret containing the zync authentication
+	// token in the ZYNC_AUTHENTICATION_TOKEN key
+	SecretRef v1.LocalObjectReference `json:"secretRef"`
+}
+
+// ExternalBackendSpec configures the connection of system and apicast to an external backend
+type ExternalBackendSpec struct {
+	// ListenerEndpoint is the URL of the external backend-listener
+	// system and apicast connect to. I.e. http://backend-listener.example.com:3000
+	// +kubebuilder:validation:Pattern=`^https?://`
+	ListenerEndpoint string `json:"listenerEndpoint"`
+	// RouteEndpoint is the public URL of the external backend-listener the gateways
+	// deployed outside of the cluster are configured with. Defaults to the listener endpoint
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RouteEndpoint *string `json:"routeEndpoint,omitempty"`
+	// InternalAPISecretRef references the secret containing the credentials
+	// of the backend internal API in the username and password keys
+	InternalAPISecretRef v1.LocalObjectReference `json:"internalAPISecretRef"`
+	// ConnectivityCheckEnabled periodically checks the listener endpoint is reachable.
+	// The result is reported in the BackendReachable condition
+	// +optional
+	ConnectivityCheckEnabled *bool `json:"connectivityCheckEnabled,omitempty"`
+}
+
+// ZyncDatabaseStorageSpec configures the PersistentVolumeClaim of the internal zync database
+type ZyncDatabaseStorageSpec struct {
+	// Size requested for the volume. Increasing it expands the volume when the
+	// storage class allows volume expansion. Defaults to 1Gi
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// StorageClassName of the volume. The cluster default storage class is used when not set.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// VolumeName is the binding reference to the PersistentVolume backing this claim.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	VolumeName *string `json:"volumeName,omitempty"`
+	// AccessModes of the volume. Defaults to ReadWriteOnce.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	AccessModes []v1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
+// ZyncDatabaseSpec configures the connection of zync and zync-que to an
+// external zync database
+type ZyncDatabaseSpec struct {
+	// SSLCASecretRef references the secret containing the PEM encoded CA bundle
+	// the database server certificate is verified against, in the `ca.crt` key.
+	// The client certificate and key can be provided in the `tls.crt` and
+	// `tls.key` keys. Changes of the secret roll out zync and zync-que
+	// +optional
+	SSLCASecretRef *v1.LocalObjectReference `json:"sslCASecretRef,omitempty"`
+	// SSLMode is the libpq SSL mode of the connection. Defaults to verify-full
+	// when SSLCASecretRef is set
+	// +kubebuilder:validation:Enum=disable;allow;prefer;require;verify-ca;verify-full
+	// +optional
+	SSLMode *string `json:"sslMode,omitempty"` // DATABASE_SSL_MODE
+}
+
+// ZyncDatabaseMaintenanceSpec configures a CronJob vacuuming and analyzing
+// the internal zync database, which accumulates dead tuples from the que jobs
+type ZyncDatabaseMaintenanceSpec struct {
+	// Enabled deploys the maintenance CronJob. Defaults to false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Schedule of the maintenance in Cron format. Defaults to "0 3 * * 0"
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// Repack also runs pg_repack to reclaim the space of bloated tables when it
+	// is available in the database image. Defaults to false
+	// +optional
+	Repack *bool `json:"repack,omitempty"`
 }
 
 type ZyncAppSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
+	// +optional
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// ForceSSL makes zync generate https URLs and treat incoming requests
+	// as secure. Useful when TLS is terminated before reaching zync,
+	// for example by a service mesh.
+	// +optional
+	ForceSSL *bool `json:"forceSSL,omitempty"` // FORCE_SSL
+	// TrustedProxies specifies the list of CIDRs of the proxies whose
+	// X-Forwarded-* headers are trusted by zync
+	// +optional
+	TrustedProxies []string `json:"trustedProxies,omitempty"` // TRUSTED_PROXIES
 }
 
 type ZyncQueSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
 	// +optional
 	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
 	// +optional
-	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
 	// +optional
-	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// ServiceAccountToken configures the credentials zync-que uses to
+	// manage routes through the API server
+	// +optional
+	ServiceAccountToken *ZyncQueServiceAccountTokenSpec `json:"serviceAccountToken,omitempty"`
+	// WorkerCount is the number of que workers processing the jobs in each pod. Defaults to 10
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkerCount *int32 `json:"workerCount,omitempty"` // QUE_WORKER_COUNT
+	// PollingInterval is the number of seconds the idle que workers wait
+	// before polling the jobs queue again. Defaults to 5
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"` // QUE_POLL_INTERVAL
+}
+
+// ZyncQueServiceAccountTokenSpec configures the projected ServiceAccount
+// token mounted into the zync-que pods
+type ZyncQueServiceAccountTokenSpec struct {
+	// Legacy makes zync-que use the ServiceAccount token automounted
+	// by the cluster instead of a projected token. Needed on clusters
+	// without the kube-root-ca.crt ConfigMap
+	// +optional
+	Legacy *bool `json:"legacy,omitempty"`
+	// Audience of the projected token. Defaults to the API server audience
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+	// ExpirationSeconds is the requested validity of the projected token.
+	// The kubelet refreshes it before it expires. Defaults to 3600
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
 }
 
 type HighAvailabilitySpec struct {
@@ -621,6 +1879,10 @@ type ExternalComponentsSpec struct {
 type ExternalSystemComponents struct {
 	// +optional
 	Redis *bool `json:"redis,omitempty"`
+	// RedisCASecretRef references the secret with the CA certificate, in the ca.crt key,
+	// of the external system redis when it is reached through rediss:// URLs
+	// +optional
+	RedisCASecretRef *v1.LocalObjectReference `json:"redisCASecretRef,omitempty"`
 	// +optional
 	Database *bool `json:"database,omitempty"`
 }
@@ -628,6 +1890,10 @@ type ExternalSystemComponents struct {
 type ExternalBackendComponents struct {
 	// +optional
 	Redis *bool `json:"redis,omitempty"`
+	// RedisCASecretRef references the secret with the CA certificate, in the ca.crt key,
+	// of the external backend redis when it is reached through rediss:// URLs
+	// +optional
+	RedisCASecretRef *v1.LocalObjectReference `json:"redisCASecretRef,omitempty"`
 }
 
 type ExternalZyncComponents struct {
@@ -637,70 +1903,514 @@ type ExternalZyncComponents struct {
 
 type PodDisruptionBudgetSpec struct {
 	Enabled bool `json:"enabled,omitempty"`
-}
 
-type MonitoringSpec struct {
-	Enabled bool `json:"enabled,omitempty"`
+	// Disruption policies of the components. When not set, maxUnavailable is 1
 	// +optional
-	EnablePrometheusRules *bool `json:"enablePrometheusRules,omitempty"`
+	ApicastProduction *PodDisruptionBudgetPolicySpec `json:"apicastProduction,omitempty"`
+	// +optional
+	ApicastStaging *PodDisruptionBudgetPolicySpec `json:"apicastStaging,omitempty"`
+	// +optional
+	BackendListener *PodDisruptionBudgetPolicySpec `json:"backendListener,omitempty"`
+	// +optional
+	BackendWorker *PodDisruptionBudgetPolicySpec `json:"backendWorker,omitempty"`
+	// +optional
+	BackendCron *PodDisruptionBudgetPolicySpec `json:"backendCron,omitempty"`
+	// +optional
+	SystemApp *PodDisruptionBudgetPolicySpec `json:"systemApp,omitempty"`
+	// +optional
+	SystemSidekiq *PodDisruptionBudgetPolicySpec `json:"systemSidekiq,omitempty"`
+	// +optional
+	Zync *PodDisruptionBudgetPolicySpec `json:"zync,omitempty"`
+	// +optional
+	ZyncQue *PodDisruptionBudgetPolicySpec `json:"zyncQue,omitempty"`
 }
 
-// PersistentVolumeClaimResources defines the resources configuration
-// of the backup data destination PersistentVolumeClaim
-type PersistentVolumeClaimResources struct {
-	// Storage Resource requests to be used on the PersistentVolumeClaim.
-	// To learn more about resource requests see:
-	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
-	Requests resource.Quantity `json:"requests"` // Should this be a string or a resoure.Quantity? it seems it is serialized as a string
+// PodDisruptionBudgetPolicySpec is the disruption policy of the pods of a component.
+// Only one of minAvailable and maxUnavailable can be set
+type PodDisruptionBudgetPolicySpec struct {
+	// MinAvailable is the number or percentage of pods that must remain available
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that can be unavailable
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
-type APIcastOpenTracingSpec struct {
-	// Enabled controls whether OpenTracing integration with APIcast is enabled.
-	// By default it is not enabled.
+// RubyRuntimeTuningSpec tunes the memory allocator and the garbage collector of the
+// Ruby processes of a component, mapped to the well known env vars
+type RubyRuntimeTuningSpec struct {
+	// MallocArenaMax sets MALLOC_ARENA_MAX, the maximum number of glibc malloc arenas.
+	// Low values, like 2, reduce the memory fragmentation of multithreaded processes
+	// +kubebuilder:validation:Minimum=1
 	// +optional
-	Enabled *bool `json:"enabled,omitempty"`
+	MallocArenaMax *int32 `json:"mallocArenaMax,omitempty"`
+	// GCHeapGrowthFactor sets RUBY_GC_HEAP_GROWTH_FACTOR, the factor the heap grows by
+	// when more slots are needed, i.e. 1.25. Must be greater than 1
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
 	// +optional
-	// TracingLibrary controls which OpenTracing library is loaded. At the moment
-	// the only supported tracer is `jaeger`. If not set, `jaeger` will be used.
-	TracingLibrary *string `json:"tracingLibrary,omitempty"`
-	// TracingConfigSecretRef contains a secret reference the OpenTracing configuration.
-	// Each supported tracing library provides a default configuration file
-	// that is used if TracingConfig is not specified.
+	GCHeapGrowthFactor *string `json:"gcHeapGrowthFactor,omitempty"`
+	// GCHeapInitSlots sets RUBY_GC_HEAP_INIT_SLOTS, the number of heap slots allocated on boot
+	// +kubebuilder:validation:Minimum=1
 	// +optional
-	TracingConfigSecretRef *v1.LocalObjectReference `json:"tracingConfigSecretRef,omitempty"`
+	GCHeapInitSlots *int64 `json:"gcHeapInitSlots,omitempty"`
+	// RubyYJIT sets RUBY_YJIT_ENABLE, enabling the YJIT compiler.
+	// Ignored by images running Ruby versions without YJIT support
+	// +optional
+	RubyYJIT *bool `json:"rubyYJIT,omitempty"`
 }
 
-// SetDefaults sets the default values for the APIManager spec and returns true if the spec was changed
-func (apimanager *APIManager) SetDefaults() (bool, error) {
-	var err error
-	changed := false
-
-	tmpChanged := apimanager.setAPIManagerAnnotationsDefaults()
-	changed = changed || tmpChanged
-
-	tmpChanged = apimanager.setAPIManagerCommonSpecDefaults()
-	changed = changed || tmpChanged
-
-	tmpChanged = apimanager.setBackendSpecDefaults()
-	changed = changed || tmpChanged
-
-	tmpChanged = apimanager.setApicastSpecDefaults()
-	changed = changed || tmpChanged
+// ProbesSpec tunes the probes of the containers of a component
+type ProbesSpec struct {
+	// +optional
+	Liveness *ProbeSpec `json:"liveness,omitempty"`
+	// +optional
+	Readiness *ProbeSpec `json:"readiness,omitempty"`
+	// Startup adds a startup probe checking the liveness probe endpoint. The liveness
+	// and readiness probes are not run until it succeeds. Defaults to a period of
+	// 10 seconds and a failure threshold of 30
+	// +optional
+	Startup *ProbeSpec `json:"startup,omitempty"`
+}
 
-	tmpChanged, err = apimanager.setSystemSpecDefaults()
-	changed = changed || tmpChanged
-	if err != nil {
-		return changed, err
-	}
+// ProbeSpec overrides the timing of a probe. Unset fields keep the default values
+type ProbeSpec struct {
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
 
-	tmpChanged = apimanager.setZyncDefaults()
-	changed = changed || tmpChanged
+// InitContainerSpec overrides an init container managed by the operator. Unset fields keep the default values
+type InitContainerSpec struct {
+	// Disabled removes the init container from the pods
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+	// Image of the init container. The image is no longer updated by the image change trigger when set
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// Command of the init container
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// TimeoutSeconds after which the init container fails, so the pod is restarted. No timeout by default
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
 
-	return changed, err
+// PodPlacementSpec defines where the pods of a component are scheduled
+type PodPlacementSpec struct {
+	// NodeSelector of the pods. Only the nodes with matching labels are eligible
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// TopologySpreadConstraints of the pods, e.g. to spread them across zones
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName of the pods. When not set, the cluster default priority is used
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
 }
 
-func (apimanager *APIManager) setAPIManagerAnnotationsDefaults() bool {
-	changed := false
+// PodTemplateOverridesSpec defines the overrides of the pod template of a component
+type PodTemplateOverridesSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// UnreachableTolerationSeconds overrides spec.unreachableTolerationSeconds for the pods
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	UnreachableTolerationSeconds *int64 `json:"unreachableTolerationSeconds,omitempty"`
+	// Labels added to the pods and services of the component.
+	// Labels set by the operator take precedence
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations added to the deployment configs, pods and services of the component.
+	// Annotations set by the operator take precedence
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// PodSecurityContext of the pods. When not set, the pod security context
+	// is defaulted by the SecurityContextConstraints admission
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of every container of the pods, init containers included
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+	// Env vars added to the containers after the env vars managed by the operator.
+	// The names of the env vars managed by the operator are not allowed
+	// +optional
+	Env []v1.EnvVar `json:"env,omitempty"`
+	// Sidecars are containers added to the pods after the containers managed by the operator,
+	// e.g. log shippers or SQL proxies. The names must not clash with the containers managed by the operator.
+	// The schema is not generated, the containers are validated when the pods are created
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Sidecars []v1.Container `json:"sidecars,omitempty"`
+	// SidecarVolumes are volumes added to the pods, to be mounted by the sidecars
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SidecarVolumes []v1.Volume `json:"sidecarVolumes,omitempty"`
+	// InitContainers are added to the pods after the init containers managed by the operator.
+	// The schema is not generated, the containers are validated when the pods are created
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// TerminationGracePeriodSeconds of the pods. Defaults to 30 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// PreStopCommand is run in the containers before they are stopped, e.g. to drain the in-flight
+	// requests or jobs. The command runs within the termination grace period
+	// +optional
+	PreStopCommand []string `json:"preStopCommand,omitempty"`
+	// DNSPolicy of the pods. Defaults to ClusterFirst
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// DNSConfig of the pods, e.g. to add search domains or to tune the ndots option.
+	// It is merged with the DNS configuration generated from the DNSPolicy
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// HostAliases are added to the hosts file of the pods, e.g. to resolve the hostnames
+	// that are not in the cluster DNS
+	// +optional
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+	// RuntimeClassName of the pods, e.g. to run them with a sandboxed container runtime.
+	// When not set, the default container runtime is used
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// ImagePullPolicy of the containers of the pods. Overrides the global image pull policy of the APIManager.
+	// When neither is set, the images are pulled if not present, except for zync-que which always pulls them
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// ImagePullSecrets of the pods, e.g. to pull the images of the component from a different private
+	// registry. Overrides spec.imagePullSecrets and is combined with the default image pull secrets
+	// according to the image pull secrets policy. Set in the zync-que service account for zync-que
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+type MonitoringSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// +optional
+	EnablePrometheusRules *bool `json:"enablePrometheusRules,omitempty"`
+	// DatabaseExporters deploys prometheus exporters for the internal
+	// backend-redis, system-redis, system database and zync-database instances.
+	// External databases are not monitored.
+	// +optional
+	DatabaseExporters *bool `json:"databaseExporters,omitempty"`
+	// Apicast enables the monitoring resources of apicast. Defaults to true
+	// +optional
+	Apicast *bool `json:"apicast,omitempty"`
+	// Backend enables the monitoring of backend. When false, the backend
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	Backend *bool `json:"backend,omitempty"`
+	// System enables the monitoring of system. When false, the system
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	System *bool `json:"system,omitempty"`
+	// Zync enables the monitoring of zync. When false, the zync
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	Zync *bool `json:"zync,omitempty"`
+	// RecordingRules adds SLO-style availability and latency recording rules
+	// of the gateways, requires the PrometheusRules to be enabled
+	// +optional
+	RecordingRules *RecordingRulesSpec `json:"recordingRules,omitempty"`
+	// Grafana configures the datasource and the labels of the grafana dashboards
+	// +optional
+	Grafana *GrafanaSpec `json:"grafana,omitempty"`
+	// PrometheusRules overrides the severity and the threshold of the alerts
+	// generated by the operator, or disables them
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+}
+
+// PrometheusRulesSpec tunes the alerts of the PrometheusRules generated by the operator
+type PrometheusRulesSpec struct {
+	// Overrides of the alerts, matched by alert name
+	// +optional
+	Overrides []PrometheusRuleOverride `json:"overrides,omitempty"`
+}
+
+// PrometheusRuleOverride tunes a single alert
+type PrometheusRuleOverride struct {
+	// AlertName is the name of the alert, e.g. ThreescaleApicastJobDown
+	AlertName string `json:"alertName"`
+	// Severity replaces the severity label of the alert
+	// +optional
+	Severity *string `json:"severity,omitempty"`
+	// Threshold replaces the number the alert expression is compared to.
+	// Only applies to alerts whose expression ends with a single comparison to a number
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+	// Disabled removes the alert from the PrometheusRule
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// GrafanaSpec configures the grafana dashboards to match the grafana instance
+// importing them
+type GrafanaSpec struct {
+	// DatasourceName is the name of the prometheus datasource selected by
+	// default in the dashboards. Defaults to Prometheus
+	// +optional
+	DatasourceName *string `json:"datasourceName,omitempty"`
+	// DashboardLabels are the labels matched by the dashboardLabelSelector of
+	// the grafana instance. They replace the default monitoring-key: middleware label
+	// +optional
+	DashboardLabels map[string]string `json:"dashboardLabels,omitempty"`
+}
+
+// RecordingRulesSpec configures the recording rules of the apicast production
+// and backend-listener gateways. The success ratio and latency percentiles
+// are recorded per namespace, and the error budget burn rate alerts are derived
+// from the availability objectives
+type RecordingRulesSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// ApicastAvailabilityObjective is the percentage of apicast production
+	// requests expected not to fail with 5XX status codes. Defaults to 99.5
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	// +optional
+	ApicastAvailabilityObjective *string `json:"apicastAvailabilityObjective,omitempty"`
+	// BackendAvailabilityObjective is the percentage of backend-listener
+	// requests expected not to fail with 5XX status codes. Defaults to 99.5
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	// +optional
+	BackendAvailabilityObjective *string `json:"backendAvailabilityObjective,omitempty"`
+}
+
+type MetricsSpec struct {
+	// Statsd makes system and backend emit runtime metrics to a statsd server.
+	// Independent of spec.monitoring
+	// +optional
+	Statsd *StatsdSpec `json:"statsd,omitempty"`
+}
+
+type StatsdSpec struct {
+	// Host of the statsd server
+	Host string `json:"host"`
+	// Port of the statsd server. Defaults to 8125
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to send the metrics. Defaults to udp
+	// +kubebuilder:validation:Enum=udp;tcp
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+	// Prefix prepended to the metric names
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+const (
+	APIManagerModeActive  = "active"
+	APIManagerModeStandby = "standby"
+)
+
+const (
+	DefaultShutdownBackendQueuesDrainTimeoutSeconds int64 = 300
+	DefaultShutdownDeadlineSeconds                  int64 = 900
+)
+
+const (
+	DefaultDatabaseBackupRetention int32 = 3
+	DefaultDatabaseBackupPVCSize         = "10Gi"
+)
+
+const (
+	DefaultRequestLoggingSamplingRate int32 = 100
+	DefaultRequestLoggingTTLSeconds   int64 = 3600
+)
+
+// GatewayOnlySpec defines the remote control plane the apicast
+// gateways of a gateway only APIManager are connected to
+type GatewayOnlySpec struct {
+	// PortalEndpointSecretRef references the secret holding the remote admin portal
+	// endpoint, including an access token, in the `AdminPortalURL` key.
+	// I.e. https://<access-token>@<tenant>-admin.<remote-wildcard-domain>
+	PortalEndpointSecretRef v1.LocalObjectReference `json:"portalEndpointSecretRef"`
+}
+
+// HorizontalPodAutoscalerSpec scales the pods of a component on their resource usage
+type HorizontalPodAutoscalerSpec struct {
+	// MinReplicas is the lower limit of the replicas. Defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of the replicas
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// CPUTargetUtilization is the target average CPU utilization of the pods, as a percentage
+	// of the requested CPU. Defaults to 80 when no target is set
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CPUTargetUtilization *int32 `json:"cpuTargetUtilization,omitempty"`
+	// MemoryTargetUtilization is the target average memory utilization of the pods, as a percentage
+	// of the requested memory
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MemoryTargetUtilization *int32 `json:"memoryTargetUtilization,omitempty"`
+}
+
+// ShutdownSpec configures the ordered shutdown of the components
+// run when the APIManager is deleted
+type ShutdownSpec struct {
+	// Enabled adds a finalizer to the APIManager. On deletion, traffic accepting
+	// components are stopped first, then workers once the backend queues are drained,
+	// and finally databases.
+	Enabled bool `json:"enabled,omitempty"`
+	// BackendQueuesDrainTimeoutSeconds is the maximum time waiting for the
+	// backend-worker queues to be drained. Defaults to 300 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackendQueuesDrainTimeoutSeconds *int64 `json:"backendQueuesDrainTimeoutSeconds,omitempty"`
+	// DeadlineSeconds is the maximum duration of the whole shutdown sequence,
+	// counted from the deletion request. When exceeded, the APIManager is
+	// deleted regardless of the remaining stages. Defaults to 900 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DeadlineSeconds *int64 `json:"deadlineSeconds,omitempty"`
+}
+
+// DatabasesSpec configures the operations run on the internal databases
+type DatabasesSpec struct {
+	// Backup dumps the internal databases before upgrades
+	// +optional
+	Backup *DatabaseBackupSpec `json:"backup,omitempty"`
+}
+
+// DatabaseBackupSpec configures the logical backup of the internal system
+// and zync databases taken before upgrading to a new 3scale release.
+// External databases are not backed up
+type DatabaseBackupSpec struct {
+	// Enabled runs a dump job for each internal database when the 3scale
+	// release changes. The components are only upgraded once all the dumps completed
+	Enabled bool `json:"enabled,omitempty"`
+	// PersistentVolumeClaim stores the dumps in a PersistentVolumeClaim
+	// created by the operator. Union type with S3, only one of the fields can be set.
+	// The dumps are stored in a PersistentVolumeClaim when none is set
+	// +optional
+	PersistentVolumeClaim *DatabaseBackupPVCSpec `json:"persistentVolumeClaim,omitempty"`
+	// S3 uploads the dumps to an S3 compatible bucket
+	// +optional
+	S3 *DatabaseBackupS3Spec `json:"s3,omitempty"`
+	// Retention is the number of dumps kept for each database. Defaults to 3
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Retention *int32 `json:"retention,omitempty"`
+}
+
+// DatabaseBackupPVCSpec defines the PersistentVolumeClaim the dumps are stored in
+type DatabaseBackupPVCSpec struct {
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources represents the minimum resources the volume should have. Defaults to 10Gi
+	// +optional
+	Resources *PersistentVolumeClaimResources `json:"resources,omitempty"`
+}
+
+// DatabaseBackupS3Spec defines the bucket the dumps are uploaded to
+type DatabaseBackupS3Spec struct {
+	// SecretRef references the secret with the bucket, the endpoint and the credentials,
+	// in the format of the system file storage S3 configuration secret
+	SecretRef v1.LocalObjectReference `json:"secretRef"`
+}
+
+// PersistentVolumeClaimResources defines the resources configuration
+// of the backup data destination PersistentVolumeClaim
+type PersistentVolumeClaimResources struct {
+	// Storage Resource requests to be used on the PersistentVolumeClaim.
+	// To learn more about resource requests see:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Requests resource.Quantity `json:"requests"` // Should this be a string or a resoure.Quantity? it seems it is serialized as a string
+}
+
+type APIcastOpenTracingSpec struct {
+	// Enabled controls whether OpenTracing integration with APIcast is enabled.
+	// By default it is not enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	// TracingLibrary controls which OpenTracing library is loaded. At the moment
+	// the only supported tracer is `jaeger`. If not set, `jaeger` will be used.
+	TracingLibrary *string `json:"tracingLibrary,omitempty"`
+	// TracingConfigSecretRef contains a secret reference the OpenTracing configuration.
+	// Each supported tracing library provides a default configuration file
+	// that is used if TracingConfig is not specified.
+	// +optional
+	TracingConfigSecretRef *v1.LocalObjectReference `json:"tracingConfigSecretRef,omitempty"`
+}
+
+// SetDefaults sets the default values for the APIManager spec and returns true if the spec was changed
+func (apimanager *APIManager) SetDefaults() (bool, error) {
+	changed := apimanager.setAPIManagerAnnotationsDefaults()
+
+	tmpChanged, err := apimanager.SetSpecDefaults()
+	changed = changed || tmpChanged
+
+	return changed, err
+}
+
+// SetSpecDefaults sets the default values for the APIManager spec only,
+// leaving the object metadata untouched, and returns true if the spec was changed
+func (apimanager *APIManager) SetSpecDefaults() (bool, error) {
+	var err error
+	changed := false
+
+	tmpChanged := apimanager.setAPIManagerCommonSpecDefaults()
+	changed = changed || tmpChanged
+
+	tmpChanged = apimanager.setApicastSpecDefaults()
+	changed = changed || tmpChanged
+
+	// The control plane is managed outside of the APIManager
+	if apimanager.IsGatewayOnly() {
+		return changed, nil
+	}
+
+	tmpChanged = apimanager.setBackendSpecDefaults()
+	changed = changed || tmpChanged
+
+	tmpChanged, err = apimanager.setSystemSpecDefaults()
+	changed = changed || tmpChanged
+	if err != nil {
+		return changed, err
+	}
+
+	tmpChanged = apimanager.setZyncDefaults()
+	changed = changed || tmpChanged
+
+	return changed, err
+}
+
+func (apimanager *APIManager) setAPIManagerAnnotationsDefaults() bool {
+	changed := false
 
 	if apimanager.Annotations == nil {
 		apimanager.Annotations = map[string]string{}
@@ -717,6 +2427,11 @@ func (apimanager *APIManager) setAPIManagerAnnotationsDefaults() bool {
 		changed = true
 	}
 
+	if v, ok := apimanager.Annotations[StorageVersionAnnotation]; !ok || v != appsv1beta1.GroupVersion.Version {
+		apimanager.Annotations[StorageVersionAnnotation] = appsv1beta1.GroupVersion.Version
+		changed = true
+	}
+
 	return changed
 }
 
@@ -760,24 +2475,9 @@ func (apimanager *APIManager) setApicastSpecDefaults() bool {
 		changed = true
 	}
 
-	if spec.Apicast.StagingSpec.Replicas == nil {
-		spec.Apicast.StagingSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
-	if spec.Apicast.ProductionSpec.Replicas == nil {
-		spec.Apicast.ProductionSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
 	return changed
 }
 
-func (apimanager *APIManager) defaultReplicas() *int64 {
-	var defaultReplicas int64 = 1
-	return &defaultReplicas
-}
-
 func (apimanager *APIManager) setBackendSpecDefaults() bool {
 	changed := false
 	spec := &apimanager.Spec
@@ -802,21 +2502,6 @@ func (apimanager *APIManager) setBackendSpecDefaults() bool {
 		changed = true
 	}
 
-	if spec.Backend.ListenerSpec.Replicas == nil {
-		spec.Backend.ListenerSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
-	if spec.Backend.CronSpec.Replicas == nil {
-		spec.Backend.CronSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
-	if spec.Backend.WorkerSpec.Replicas == nil {
-		spec.Backend.WorkerSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
 	return changed
 }
 
@@ -849,6 +2534,11 @@ func (apimanager *APIManager) setAPIManagerCommonSpecDefaults() bool {
 		changed = true
 	}
 
+	if normalizedWildcardDomain := helper.NormalizeDomain(spec.WildcardDomain); normalizedWildcardDomain != spec.WildcardDomain {
+		spec.WildcardDomain = normalizedWildcardDomain
+		changed = true
+	}
+
 	// TODO do something with mandatory parameters?
 	// TODO check that only compatible ProductRelease versions are compatible?
 
@@ -891,16 +2581,6 @@ func (apimanager *APIManager) setSystemSpecDefaults() (bool, error) {
 		changed = true
 	}
 
-	if spec.System.AppSpec.Replicas == nil {
-		spec.System.AppSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
-	if spec.System.SidekiqSpec.Replicas == nil {
-		spec.System.SidekiqSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
 	return changed, nil
 }
 
@@ -957,16 +2637,6 @@ func (apimanager *APIManager) setZyncDefaults() bool {
 		changed = true
 	}
 
-	if spec.Zync.AppSpec.Replicas == nil {
-		spec.Zync.AppSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
-	if spec.Zync.QueSpec.Replicas == nil {
-		spec.Zync.QueSpec.Replicas = apimanager.defaultReplicas()
-		changed = true
-	}
-
 	return changed
 }
 
@@ -1059,62 +2729,413 @@ func ZyncDatabase(e *ExternalComponentsSpec) bool {
 	return e != nil && e.Zync != nil && e.Zync.Database != nil && *e.Zync.Database
 }
 
+// BackendRedisCASecretName returns the secret with the CA certificate of the
+// external backend redis, empty when not set
+func (apimanager *APIManager) BackendRedisCASecretName() string {
+	e := apimanager.Spec.ExternalComponents
+	if e == nil || e.Backend == nil || e.Backend.RedisCASecretRef == nil {
+		return ""
+	}
+	return e.Backend.RedisCASecretRef.Name
+}
+
+// SystemRedisCASecretName returns the secret with the CA certificate of the
+// external system redis, empty when not set
+func (apimanager *APIManager) SystemRedisCASecretName() string {
+	e := apimanager.Spec.ExternalComponents
+	if e == nil || e.System == nil || e.System.RedisCASecretRef == nil {
+		return ""
+	}
+	return e.System.RedisCASecretRef.Name
+}
+
 func (apimanager *APIManager) IsPDBEnabled() bool {
 	return apimanager.Spec.PodDisruptionBudget != nil && apimanager.Spec.PodDisruptionBudget.Enabled
 }
 
+// ContainerTerminationMessagePolicy returns the termination message policy of the containers of the components
+func (apimanager *APIManager) ContainerTerminationMessagePolicy() v1.TerminationMessagePolicy {
+	if apimanager.Spec.TerminationMessagePolicy == nil {
+		return v1.TerminationMessageFallbackToLogsOnError
+	}
+	return *apimanager.Spec.TerminationMessagePolicy
+}
+
+// IsRestrictedPodSecurityStandard returns true when the security contexts of the workloads
+// are defaulted to comply with the restricted Pod Security Standard
+func (apimanager *APIManager) IsRestrictedPodSecurityStandard() bool {
+	return apimanager.Spec.PodSecurityStandard != nil && *apimanager.Spec.PodSecurityStandard == PodSecurityStandardRestricted
+}
+
+// ImagePullPolicy returns the image pull policy of a component: the given component image pull
+// policy when set, the global one otherwise. Nil when neither is set
+func (apimanager *APIManager) ImagePullPolicy(componentPolicy *v1.PullPolicy) *v1.PullPolicy {
+	if componentPolicy != nil {
+		return componentPolicy
+	}
+	return apimanager.Spec.ImagePullPolicy
+}
+
+// ServiceAccountName returns the name of the service account created by the operator with
+// the given default name, amp or zync-que-sa, unless it is renamed in spec.serviceAccounts
+func (apimanager *APIManager) ServiceAccountName(defaultName string) string {
+	if spec := apimanager.serviceAccountSpec(defaultName); spec != nil && spec.Name != nil {
+		return *spec.Name
+	}
+	return defaultName
+}
+
+// ServiceAccountAnnotations returns the annotations set in spec.serviceAccounts for the
+// service account created by the operator with the given default name
+func (apimanager *APIManager) ServiceAccountAnnotations(defaultName string) map[string]string {
+	if spec := apimanager.serviceAccountSpec(defaultName); spec != nil {
+		return spec.Annotations
+	}
+	return nil
+}
+
+func (apimanager *APIManager) serviceAccountSpec(defaultName string) *ServiceAccountSpec {
+	if apimanager.Spec.ServiceAccounts == nil {
+		return nil
+	}
+
+	switch defaultName {
+	case AmpServiceAccountName:
+		return apimanager.Spec.ServiceAccounts.Amp
+	case ZyncQueServiceAccountName:
+		return apimanager.Spec.ServiceAccounts.ZyncQue
+	}
+	return nil
+}
+
 func (apimanager *APIManager) IsSystemPostgreSQLEnabled() bool {
 	return !apimanager.IsExternal(SystemDatabase) &&
+		apimanager.Spec.System != nil &&
 		apimanager.Spec.System.DatabaseSpec != nil &&
 		apimanager.Spec.System.DatabaseSpec.PostgreSQL != nil
 }
 
 func (apimanager *APIManager) IsSystemMysqlEnabled() bool {
-	return !apimanager.IsExternal(SystemDatabase) && !apimanager.IsSystemPostgreSQLEnabled()
+	return !apimanager.IsGatewayOnly() && !apimanager.IsExternal(SystemDatabase) && !apimanager.IsSystemPostgreSQLEnabled()
 }
 
-func (apimanager *APIManager) IsMonitoringEnabled() bool {
-	return apimanager.Spec.Monitoring != nil && apimanager.Spec.Monitoring.Enabled
+func (apimanager *APIManager) IsSystemCacheStoreRedis() bool {
+	return apimanager.Spec.System != nil &&
+		apimanager.Spec.System.CacheStore != nil &&
+		*apimanager.Spec.System.CacheStore == component.SystemCacheStoreRedis
 }
 
-func (apimanager *APIManager) IsPrometheusRulesEnabled() bool {
-	return (apimanager.IsMonitoringEnabled() &&
-		(apimanager.Spec.Monitoring.EnablePrometheusRules == nil || *apimanager.Spec.Monitoring.EnablePrometheusRules))
+func (apimanager *APIManager) IsSystemAdminSSOEnabled() bool {
+	return apimanager.Spec.System != nil && apimanager.Spec.System.AdminSSO != nil
 }
 
-func (apimanager *APIManager) IsAPIcastProductionOpenTracingEnabled() bool {
-	return apimanager.Spec.Apicast != nil && apimanager.Spec.Apicast.ProductionSpec != nil &&
-		apimanager.Spec.Apicast.ProductionSpec.OpenTracing != nil &&
-		apimanager.Spec.Apicast.ProductionSpec.OpenTracing.Enabled != nil &&
-		*apimanager.Spec.Apicast.ProductionSpec.OpenTracing.Enabled
+// SystemAccessTokens returns the access tokens of the default tenant admin user
+func (apimanager *APIManager) SystemAccessTokens() []SystemAccessTokenSpec {
+	if apimanager.Spec.System == nil {
+		return nil
+	}
+	return apimanager.Spec.System.AccessTokens
 }
 
-func (apimanager *APIManager) IsAPIcastStagingOpenTracingEnabled() bool {
-	return apimanager.Spec.Apicast != nil && apimanager.Spec.Apicast.StagingSpec != nil &&
-		apimanager.Spec.Apicast.StagingSpec.OpenTracing != nil &&
-		apimanager.Spec.Apicast.StagingSpec.OpenTracing.Enabled != nil &&
-		*apimanager.Spec.Apicast.StagingSpec.OpenTracing.Enabled
+func (apimanager *APIManager) IsSystemInboundEmailEnabled() bool {
+	return apimanager.Spec.System != nil && apimanager.Spec.System.InboundEmail != nil
 }
 
-func (apimanager *APIManager) Validate() field.ErrorList {
-	fieldErrors := field.ErrorList{}
+// IsZyncDatabaseMaintenanceEnabled returns true when the maintenance of the
+// internal zync database is enabled
+func (apimanager *APIManager) IsZyncDatabaseMaintenanceEnabled() bool {
+	if apimanager.IsExternal(ZyncDatabase) || apimanager.Spec.Zync == nil {
+		return false
+	}
+	maintenanceSpec := apimanager.Spec.Zync.DatabaseMaintenance
+	return maintenanceSpec != nil && maintenanceSpec.Enabled != nil && *maintenanceSpec.Enabled
+}
 
-	specFldPath := field.NewPath("spec")
+func (apimanager *APIManager) IsZyncDatabaseTLSEnabled() bool {
+	return apimanager.Spec.Zync != nil && apimanager.Spec.Zync.Database != nil && apimanager.Spec.Zync.Database.SSLCASecretRef != nil
+}
 
-	if apimanager.Spec.Apicast != nil {
-		apicastFldPath := specFldPath.Child("apicast")
+// IsExternalZync returns true when system uses a zync managed outside of the APIManager
+func (apimanager *APIManager) IsExternalZync() bool {
+	return apimanager.Spec.Zync != nil && apimanager.Spec.Zync.ExternalZync != nil
+}
 
-		if apimanager.Spec.Apicast.ProductionSpec != nil {
-			prodSpecFldPath := apicastFldPath.Child("productionSpec")
+// IsExternalBackend returns true when system and apicast use a backend managed outside of the APIManager
+func (apimanager *APIManager) IsExternalBackend() bool {
+	return apimanager.Spec.ExternalBackend != nil
+}
 
-			customPoliciesFldPath := prodSpecFldPath.Child("customPolicies")
-			duplicatePolicyMap := make(map[string]int)
-			for idx, customPolicySpec := range apimanager.Spec.Apicast.ProductionSpec.CustomPolicies {
-				customPoliciesIdxFldPath := customPoliciesFldPath.Index(idx)
+// ReferencedSecretNames returns the names of the user provided secrets the APIManager
+// reads, so it is reconciled when they are created or changed
+func (apimanager *APIManager) ReferencedSecretNames() []string {
+	names := []string{}
+	if apimanager.Spec.Apicast != nil && apimanager.Spec.Apicast.ProductionSpec != nil {
+		clientTLSSpec := apimanager.Spec.Apicast.ProductionSpec.ClientTLS
+		if clientTLSSpec != nil && clientTLSSpec.CASecretRef != nil {
+			names = append(names, clientTLSSpec.CASecretRef.Name)
+		}
+	}
+	if apimanager.IsSystemAdminSSOEnabled() {
+		names = append(names, apimanager.Spec.System.AdminSSO.ClientCredentialsSecretRef.Name)
+	}
+	for _, accessToken := range apimanager.SystemAccessTokens() {
+		names = append(names, accessToken.SecretName)
+	}
+	if apimanager.IsSystemInboundEmailEnabled() {
+		names = append(names, apimanager.Spec.System.InboundEmail.CredentialsSecretRef.Name)
+	}
+	if apimanager.IsGatewayOnly() {
+		names = append(names, apimanager.Spec.GatewayOnly.PortalEndpointSecretRef.Name)
+	}
+	if apimanager.IsZyncDatabaseTLSEnabled() {
+		names = append(names, apimanager.Spec.Zync.Database.SSLCASecretRef.Name)
+	}
+	return names
+}
 
-				// check custom policy secret is set
-				if customPolicySpec.SecretRef == nil {
-					fieldErrors = append(fieldErrors, field.Invalid(customPoliciesIdxFldPath, customPolicySpec, "custom policy secret is mandatory"))
+// IsExternalBackendConnectivityCheckEnabled returns true when the external backend reachability is checked
+func (apimanager *APIManager) IsExternalBackendConnectivityCheckEnabled() bool {
+	return apimanager.IsExternalBackend() &&
+		apimanager.Spec.ExternalBackend.ConnectivityCheckEnabled != nil &&
+		*apimanager.Spec.ExternalBackend.ConnectivityCheckEnabled
+}
+
+// ExternalBackendRouteEndpoint returns the public endpoint of the external backend,
+// the listener endpoint when not set
+func (apimanager *APIManager) ExternalBackendRouteEndpoint() string {
+	if !apimanager.IsExternalBackend() {
+		return ""
+	}
+	return helper.GetStringPointerValueOrDefault(apimanager.Spec.ExternalBackend.RouteEndpoint, apimanager.Spec.ExternalBackend.ListenerEndpoint)
+}
+
+// ZyncMode returns the zync mode reported in the status.
+// Empty in gateway only mode, as system is not deployed
+func (apimanager *APIManager) ZyncMode() string {
+	if apimanager.IsGatewayOnly() {
+		return ""
+	}
+	if apimanager.IsExternalZync() {
+		return ZyncModeExternal
+	}
+	return ZyncModeInternal
+}
+
+func (apimanager *APIManager) IsSystemCORSEnabled() bool {
+	return apimanager.Spec.System != nil && apimanager.Spec.System.CORS != nil
+}
+
+// IsSystemDeveloperPortalEnabled returns false only when the developer portal is explicitly disabled
+func (apimanager *APIManager) IsSystemDeveloperPortalEnabled() bool {
+	if apimanager.Spec.System == nil || apimanager.Spec.System.DeveloperPortal == nil {
+		return true
+	}
+	enabled := apimanager.Spec.System.DeveloperPortal.Enabled
+	return enabled == nil || *enabled
+}
+
+// IsSystemMasterRouteEnabled returns false only when the master route is explicitly disabled
+func (apimanager *APIManager) IsSystemMasterRouteEnabled() bool {
+	if apimanager.Spec.System == nil || apimanager.Spec.System.MasterRoute == nil {
+		return true
+	}
+	enabled := apimanager.Spec.System.MasterRoute.Enabled
+	return enabled == nil || *enabled
+}
+
+func (apimanager *APIManager) IsMonitoringEnabled() bool {
+	return apimanager.Spec.Monitoring != nil && apimanager.Spec.Monitoring.Enabled
+}
+
+func (apimanager *APIManager) IsPrometheusRulesEnabled() bool {
+	return (apimanager.IsMonitoringEnabled() &&
+		(apimanager.Spec.Monitoring.EnablePrometheusRules == nil || *apimanager.Spec.Monitoring.EnablePrometheusRules))
+}
+
+// IsComponentMetricsEnabled tells whether the pods of the component expose metrics,
+// which happens unless the component monitoring is explicitly disabled.
+// Component names match the threescale_component label values
+func (apimanager *APIManager) IsComponentMetricsEnabled(componentName string) bool {
+	if apimanager.Spec.Monitoring == nil {
+		return true
+	}
+
+	var enabled *bool
+	switch componentName {
+	case "apicast":
+		enabled = apimanager.Spec.Monitoring.Apicast
+	case "backend":
+		enabled = apimanager.Spec.Monitoring.Backend
+	case "system":
+		enabled = apimanager.Spec.Monitoring.System
+	case "zync":
+		enabled = apimanager.Spec.Monitoring.Zync
+	}
+
+	return enabled == nil || *enabled
+}
+
+// IsComponentMonitoringEnabled tells whether the monitoring resources of the component are reconciled
+func (apimanager *APIManager) IsComponentMonitoringEnabled(componentName string) bool {
+	return apimanager.IsMonitoringEnabled() && apimanager.IsComponentMetricsEnabled(componentName)
+}
+
+// IsComponentPrometheusRulesEnabled tells whether the PrometheusRules of the component are reconciled
+func (apimanager *APIManager) IsComponentPrometheusRulesEnabled(componentName string) bool {
+	return apimanager.IsPrometheusRulesEnabled() && apimanager.IsComponentMetricsEnabled(componentName)
+}
+
+// IsRecordingRulesEnabled tells whether the recording rules of the gateways are reconciled
+func (apimanager *APIManager) IsRecordingRulesEnabled() bool {
+	return apimanager.IsPrometheusRulesEnabled() &&
+		apimanager.Spec.Monitoring.RecordingRules != nil && apimanager.Spec.Monitoring.RecordingRules.Enabled
+}
+
+// PrometheusRuleOverrides returns the alert overrides of the PrometheusRules
+func (apimanager *APIManager) PrometheusRuleOverrides() []PrometheusRuleOverride {
+	if apimanager.Spec.Monitoring == nil || apimanager.Spec.Monitoring.PrometheusRules == nil {
+		return nil
+	}
+	return apimanager.Spec.Monitoring.PrometheusRules.Overrides
+}
+
+func (apimanager *APIManager) IsDatabaseExportersEnabled() bool {
+	return (apimanager.IsMonitoringEnabled() &&
+		apimanager.Spec.Monitoring.DatabaseExporters != nil && *apimanager.Spec.Monitoring.DatabaseExporters)
+}
+
+func (apimanager *APIManager) IsOrderedShutdownEnabled() bool {
+	return apimanager.Spec.Shutdown != nil && apimanager.Spec.Shutdown.Enabled
+}
+
+func (apimanager *APIManager) ShutdownBackendQueuesDrainTimeout() time.Duration {
+	seconds := DefaultShutdownBackendQueuesDrainTimeoutSeconds
+	if apimanager.Spec.Shutdown != nil && apimanager.Spec.Shutdown.BackendQueuesDrainTimeoutSeconds != nil {
+		seconds = *apimanager.Spec.Shutdown.BackendQueuesDrainTimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (apimanager *APIManager) IsBackendListenerRequestLoggingEnabled() bool {
+	return apimanager.Spec.Backend != nil && apimanager.Spec.Backend.ListenerSpec != nil &&
+		apimanager.Spec.Backend.ListenerSpec.RequestLogging != nil && apimanager.Spec.Backend.ListenerSpec.RequestLogging.Enabled
+}
+
+func (apimanager *APIManager) BackendListenerRequestLoggingSamplingRate() int32 {
+	if apimanager.IsBackendListenerRequestLoggingEnabled() && apimanager.Spec.Backend.ListenerSpec.RequestLogging.SamplingRate != nil {
+		return *apimanager.Spec.Backend.ListenerSpec.RequestLogging.SamplingRate
+	}
+	return DefaultRequestLoggingSamplingRate
+}
+
+func (apimanager *APIManager) BackendListenerRequestLoggingTTL() time.Duration {
+	seconds := DefaultRequestLoggingTTLSeconds
+	if apimanager.IsBackendListenerRequestLoggingEnabled() && apimanager.Spec.Backend.ListenerSpec.RequestLogging.TTLSeconds != nil {
+		seconds = *apimanager.Spec.Backend.ListenerSpec.RequestLogging.TTLSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// IsGatewayOnly returns true when only the apicast gateways are deployed
+func (apimanager *APIManager) IsGatewayOnly() bool {
+	return apimanager.Spec.GatewayOnly != nil
+}
+
+// Topology returns the topology reported in the status
+func (apimanager *APIManager) Topology() string {
+	if apimanager.IsGatewayOnly() {
+		return TopologyGatewayOnly
+	}
+	return TopologyFull
+}
+
+func (apimanager *APIManager) IsStandby() bool {
+	return apimanager.Spec.Mode != nil && *apimanager.Spec.Mode == APIManagerModeStandby
+}
+
+// IsHibernated tells whether all the components have to be scaled down to zero replicas
+func (apimanager *APIManager) IsHibernated() bool {
+	return apimanager.Spec.Hibernated
+}
+
+// IsScaledDownForStandby tells whether the component has to be kept with zero replicas,
+// either because of the standby mode or because its activation is still pending
+func (apimanager *APIManager) IsScaledDownForStandby(componentName string) bool {
+	if apimanager.IsStandby() {
+		return true
+	}
+
+	if apimanager.Status.Standby == nil {
+		return false
+	}
+
+	for _, name := range apimanager.Status.Standby.ScaledDownComponents {
+		if name == componentName {
+			return true
+		}
+	}
+	return false
+}
+
+func (apimanager *APIManager) IsDatabaseBackupEnabled() bool {
+	return apimanager.Spec.Databases != nil && apimanager.Spec.Databases.Backup != nil && apimanager.Spec.Databases.Backup.Enabled
+}
+
+func (apimanager *APIManager) DatabaseBackupRetention() int32 {
+	if apimanager.IsDatabaseBackupEnabled() && apimanager.Spec.Databases.Backup.Retention != nil {
+		return *apimanager.Spec.Databases.Backup.Retention
+	}
+	return DefaultDatabaseBackupRetention
+}
+
+// IsDatabaseBackupFailed returns true while a failed pre-upgrade backup halts the upgrade
+func (apimanager *APIManager) IsDatabaseBackupFailed() bool {
+	backup := apimanager.Status.DatabaseBackup
+	return backup != nil && backup.Phase == DatabaseBackupPhaseFailed &&
+		backup.ThreescaleVersion != apimanager.Status.ThreescaleVersion
+}
+
+func (apimanager *APIManager) ShutdownDeadline() time.Duration {
+	seconds := DefaultShutdownDeadlineSeconds
+	if apimanager.Spec.Shutdown != nil && apimanager.Spec.Shutdown.DeadlineSeconds != nil {
+		seconds = *apimanager.Spec.Shutdown.DeadlineSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (apimanager *APIManager) IsAPIcastProductionOpenTracingEnabled() bool {
+	return apimanager.Spec.Apicast != nil && apimanager.Spec.Apicast.ProductionSpec != nil &&
+		apimanager.Spec.Apicast.ProductionSpec.OpenTracing != nil &&
+		apimanager.Spec.Apicast.ProductionSpec.OpenTracing.Enabled != nil &&
+		*apimanager.Spec.Apicast.ProductionSpec.OpenTracing.Enabled
+}
+
+func (apimanager *APIManager) IsAPIcastStagingOpenTracingEnabled() bool {
+	return apimanager.Spec.Apicast != nil && apimanager.Spec.Apicast.StagingSpec != nil &&
+		apimanager.Spec.Apicast.StagingSpec.OpenTracing != nil &&
+		apimanager.Spec.Apicast.StagingSpec.OpenTracing.Enabled != nil &&
+		*apimanager.Spec.Apicast.StagingSpec.OpenTracing.Enabled
+}
+
+func (apimanager *APIManager) Validate() field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	specFldPath := field.NewPath("spec")
+
+	if apimanager.Spec.Apicast != nil {
+		apicastFldPath := specFldPath.Child("apicast")
+
+		if apimanager.Spec.Apicast.ProductionSpec != nil {
+			prodSpecFldPath := apicastFldPath.Child("productionSpec")
+
+			customPoliciesFldPath := prodSpecFldPath.Child("customPolicies")
+			duplicatePolicyMap := make(map[string]int)
+			for idx, customPolicySpec := range apimanager.Spec.Apicast.ProductionSpec.CustomPolicies {
+				customPoliciesIdxFldPath := customPoliciesFldPath.Index(idx)
+
+				// check custom policy secret is set
+				if customPolicySpec.SecretRef == nil {
+					fieldErrors = append(fieldErrors, field.Invalid(customPoliciesIdxFldPath, customPolicySpec, "custom policy secret is mandatory"))
 				} else if customPolicySpec.SecretRef.Name == "" {
 					fieldErrors = append(fieldErrors, field.Invalid(customPoliciesIdxFldPath, customPolicySpec, "custom policy secret name is empty"))
 				}
@@ -1174,6 +3195,27 @@ func (apimanager *APIManager) Validate() field.ErrorList {
 			if apimanager.Spec.Apicast.ProductionSpec.HTTPSPort != nil && *apimanager.Spec.Apicast.ProductionSpec.HTTPSPort == DefaultHTTPPort {
 				fieldErrors = append(fieldErrors, field.Invalid(httpsPortFldPath, apimanager.Spec.Apicast.ProductionSpec.HTTPSPort, "HTTPS port conflicts with HTTP port"))
 			}
+
+			// the load balancer deregistration delay is implemented with a preStop hook
+			if len(apimanager.Spec.Apicast.ProductionSpec.PreStopCommand) > 0 && apimanager.Spec.Apicast.ProductionSpec.LBDeregistrationDelaySeconds != nil {
+				fieldErrors = append(fieldErrors, field.Invalid(prodSpecFldPath.Child("preStopCommand"), apimanager.Spec.Apicast.ProductionSpec.PreStopCommand, "conflicts with lbDeregistrationDelaySeconds"))
+			}
+
+			// check client TLS requires TLS at pod level
+			if clientTLSSpec := apimanager.Spec.Apicast.ProductionSpec.ClientTLS; clientTLSSpec != nil {
+				clientTLSFldPath := prodSpecFldPath.Child("clientTLS")
+				if apimanager.Spec.Apicast.ProductionSpec.HTTPSPort == nil && apimanager.Spec.Apicast.ProductionSpec.HTTPSCertificateSecretRef == nil {
+					fieldErrors = append(fieldErrors, field.Invalid(clientTLSFldPath, clientTLSSpec, "client TLS requires httpsPort or httpsCertificateSecretRef to be set"))
+				}
+
+				if clientTLSSpec.CASecretRef == nil || clientTLSSpec.CASecretRef.Name == "" {
+					fieldErrors = append(fieldErrors, field.Invalid(clientTLSFldPath.Child("caSecretRef"), clientTLSSpec.CASecretRef, "client TLS CA secret name is mandatory"))
+				}
+
+				if clientTLSSpec.VerifyDepth != nil && apimanager.Spec.Apicast.ProductionSpec.HTTPSVerifyDepth != nil {
+					fieldErrors = append(fieldErrors, field.Invalid(clientTLSFldPath.Child("verifyDepth"), clientTLSSpec.VerifyDepth, "conflicts with httpsVerifyDepth"))
+				}
+			}
 		}
 
 		if apimanager.Spec.Apicast.StagingSpec != nil {
@@ -1245,6 +3287,1150 @@ func (apimanager *APIManager) Validate() field.ErrorList {
 			if apimanager.Spec.Apicast.StagingSpec.HTTPSPort != nil && *apimanager.Spec.Apicast.StagingSpec.HTTPSPort == DefaultHTTPPort {
 				fieldErrors = append(fieldErrors, field.Invalid(httpsPortFldPath, apimanager.Spec.Apicast.StagingSpec.HTTPSPort, "HTTPS port conflicts with HTTP port"))
 			}
+
+			// the load balancer deregistration delay is implemented with a preStop hook
+			if len(apimanager.Spec.Apicast.StagingSpec.PreStopCommand) > 0 && apimanager.Spec.Apicast.StagingSpec.LBDeregistrationDelaySeconds != nil {
+				fieldErrors = append(fieldErrors, field.Invalid(stagingSpecFldPath.Child("preStopCommand"), apimanager.Spec.Apicast.StagingSpec.PreStopCommand, "conflicts with lbDeregistrationDelaySeconds"))
+			}
+		}
+	}
+
+	if apimanager.Spec.Zync != nil && apimanager.Spec.Zync.AppSpec != nil {
+		trustedProxiesFldPath := specFldPath.Child("zync").Child("appSpec").Child("trustedProxies")
+		for idx, trustedProxy := range apimanager.Spec.Zync.AppSpec.TrustedProxies {
+			if _, _, err := net.ParseCIDR(trustedProxy); err != nil {
+				fieldErrors = append(fieldErrors, field.Invalid(trustedProxiesFldPath.Index(idx), trustedProxy, "trusted proxy is not a valid CIDR"))
+			}
+		}
+	}
+
+	if apimanager.IsZyncDatabaseTLSEnabled() {
+		sslCASecretRefFldPath := specFldPath.Child("zync").Child("database").Child("sslCASecretRef")
+		if apimanager.Spec.Zync.Database.SSLCASecretRef.Name == "" {
+			fieldErrors = append(fieldErrors, field.Required(sslCASecretRefFldPath.Child("name"), "zync database CA secret name is mandatory"))
+		}
+		if !apimanager.IsExternal(ZyncDatabase) {
+			fieldErrors = append(fieldErrors, field.Invalid(sslCASecretRefFldPath, apimanager.Spec.Zync.Database.SSLCASecretRef.Name, "requires an external zync database"))
+		}
+	}
+
+	if apimanager.Spec.Zync != nil && apimanager.Spec.Zync.DatabaseStorage != nil {
+		storageFldPath := specFldPath.Child("zync").Child("databaseStorage")
+		storageSpec := apimanager.Spec.Zync.DatabaseStorage
+		if storageSpec.Size != nil && storageSpec.Size.Sign() <= 0 {
+			fieldErrors = append(fieldErrors, field.Invalid(storageFldPath.Child("size"), storageSpec.Size.String(), "size must be greater than zero"))
+		}
+		for idx, accessMode := range storageSpec.AccessModes {
+			if accessMode != v1.ReadWriteOnce && accessMode != v1.ReadWriteMany {
+				fieldErrors = append(fieldErrors, field.NotSupported(storageFldPath.Child("accessModes").Index(idx), accessMode, []string{string(v1.ReadWriteOnce), string(v1.ReadWriteMany)}))
+			}
+		}
+	}
+
+	if apimanager.Spec.Zync != nil && apimanager.Spec.Zync.DatabaseMaintenance != nil {
+		schedule := apimanager.Spec.Zync.DatabaseMaintenance.Schedule
+		if schedule != nil && !isCronSchedule(*schedule) {
+			fieldErrors = append(fieldErrors, field.Invalid(specFldPath.Child("zync").Child("databaseMaintenance").Child("schedule"), *schedule, "schedule must have five fields or be a predefined schedule like @daily"))
+		}
+	}
+
+	if apimanager.Spec.Metrics != nil && apimanager.Spec.Metrics.Statsd != nil {
+		statsdSpec := apimanager.Spec.Metrics.Statsd
+		statsdFldPath := specFldPath.Child("metrics").Child("statsd")
+		if net.ParseIP(statsdSpec.Host) == nil && len(validation.IsDNS1123Subdomain(statsdSpec.Host)) > 0 {
+			fieldErrors = append(fieldErrors, field.Invalid(statsdFldPath.Child("host"), statsdSpec.Host, "statsd host is not a valid IP address or DNS name"))
+		}
+		if statsdSpec.Port != nil && (*statsdSpec.Port < 1 || *statsdSpec.Port > 65535) {
+			fieldErrors = append(fieldErrors, field.Invalid(statsdFldPath.Child("port"), *statsdSpec.Port, "statsd port must be between 1 and 65535"))
+		}
+		if statsdSpec.Protocol != nil && *statsdSpec.Protocol != component.StatsdProtocolUDP && *statsdSpec.Protocol != component.StatsdProtocolTCP {
+			fieldErrors = append(fieldErrors, field.NotSupported(statsdFldPath.Child("protocol"), *statsdSpec.Protocol, []string{component.StatsdProtocolUDP, component.StatsdProtocolTCP}))
+		}
+	}
+
+	if apimanager.Spec.Monitoring != nil && apimanager.Spec.Monitoring.Grafana != nil {
+		dashboardLabelsFldPath := specFldPath.Child("monitoring").Child("grafana").Child("dashboardLabels")
+		for key, value := range apimanager.Spec.Monitoring.Grafana.DashboardLabels {
+			for _, msg := range validation.IsQualifiedName(key) {
+				fieldErrors = append(fieldErrors, field.Invalid(dashboardLabelsFldPath, key, msg))
+			}
+			for _, msg := range validation.IsValidLabelValue(value) {
+				fieldErrors = append(fieldErrors, field.Invalid(dashboardLabelsFldPath.Key(key), value, msg))
+			}
+		}
+	}
+
+	overridesFldPath := specFldPath.Child("monitoring").Child("prometheusRules").Child("overrides")
+	overriddenAlerts := map[string]bool{}
+	for idx, override := range apimanager.PrometheusRuleOverrides() {
+		overrideFldPath := overridesFldPath.Index(idx)
+		if override.AlertName == "" {
+			fieldErrors = append(fieldErrors, field.Required(overrideFldPath.Child("alertName"), "alert name not provided"))
+		} else if overriddenAlerts[override.AlertName] {
+			fieldErrors = append(fieldErrors, field.Duplicate(overrideFldPath.Child("alertName"), override.AlertName))
+		}
+		overriddenAlerts[override.AlertName] = true
+
+		if override.Severity != nil {
+			if *override.Severity == "" {
+				fieldErrors = append(fieldErrors, field.Invalid(overrideFldPath.Child("severity"), *override.Severity, "severity is empty"))
+			}
+			for _, msg := range validation.IsValidLabelValue(*override.Severity) {
+				fieldErrors = append(fieldErrors, field.Invalid(overrideFldPath.Child("severity"), *override.Severity, msg))
+			}
+		}
+		if override.Threshold != nil {
+			if _, err := strconv.ParseFloat(*override.Threshold, 64); err != nil {
+				fieldErrors = append(fieldErrors, field.Invalid(overrideFldPath.Child("threshold"), *override.Threshold, "threshold is not a number"))
+			}
+		}
+	}
+
+	if apimanager.Spec.System != nil && apimanager.Spec.System.CacheStore != nil {
+		cacheStore := *apimanager.Spec.System.CacheStore
+		if cacheStore != component.SystemCacheStoreMemcached && cacheStore != component.SystemCacheStoreRedis {
+			cacheStoreFldPath := specFldPath.Child("system").Child("cacheStore")
+			fieldErrors = append(fieldErrors, field.NotSupported(cacheStoreFldPath, cacheStore, []string{component.SystemCacheStoreMemcached, component.SystemCacheStoreRedis}))
+		}
+	}
+
+	if apimanager.IsSystemAdminSSOEnabled() {
+		adminSSOSpec := apimanager.Spec.System.AdminSSO
+		adminSSOFldPath := specFldPath.Child("system").Child("adminSSO")
+		if issuerURL, err := url.Parse(adminSSOSpec.IssuerURL); err != nil || (issuerURL.Scheme != "http" && issuerURL.Scheme != "https") || issuerURL.Host == "" {
+			fieldErrors = append(fieldErrors, field.Invalid(adminSSOFldPath.Child("issuerURL"), adminSSOSpec.IssuerURL, "issuer URL must be an absolute http or https URL"))
+		}
+		if adminSSOSpec.ClientCredentialsSecretRef.Name == "" {
+			fieldErrors = append(fieldErrors, field.Invalid(adminSSOFldPath.Child("clientCredentialsSecretRef"), adminSSOSpec.ClientCredentialsSecretRef, "client credentials secret name is empty"))
+		}
+	}
+
+	if len(apimanager.SystemAccessTokens()) > 0 {
+		fieldErrors = append(fieldErrors, apimanager.validateSystemAccessTokens(specFldPath.Child("system").Child("accessTokens"))...)
+	}
+
+	if apimanager.IsSystemCORSEnabled() {
+		corsSpec := apimanager.Spec.System.CORS
+		corsFldPath := specFldPath.Child("system").Child("cors")
+		if len(corsSpec.AllowedOrigins) == 0 {
+			fieldErrors = append(fieldErrors, field.Required(corsFldPath.Child("allowedOrigins"), "at least one allowed origin is mandatory"))
+		}
+		allowCredentials := corsSpec.AllowCredentials != nil && *corsSpec.AllowCredentials
+		for idx, origin := range corsSpec.AllowedOrigins {
+			originFldPath := corsFldPath.Child("allowedOrigins").Index(idx)
+			if strings.TrimSpace(origin) == "" {
+				fieldErrors = append(fieldErrors, field.Invalid(originFldPath, origin, "allowed origin is empty"))
+			}
+			if origin == "*" && allowCredentials {
+				fieldErrors = append(fieldErrors, field.Invalid(originFldPath, origin, "wildcard origin is not allowed together with allowCredentials"))
+			}
+		}
+		for idx, header := range corsSpec.AllowedHeaders {
+			if strings.TrimSpace(header) == "" {
+				fieldErrors = append(fieldErrors, field.Invalid(corsFldPath.Child("allowedHeaders").Index(idx), header, "allowed header is empty"))
+			}
+		}
+		if corsSpec.MaxAge != nil && *corsSpec.MaxAge < 0 {
+			fieldErrors = append(fieldErrors, field.Invalid(corsFldPath.Child("maxAge"), *corsSpec.MaxAge, "max age must not be negative"))
+		}
+	}
+
+	if apimanager.Spec.PodDisruptionBudget != nil {
+		pdbSpec := apimanager.Spec.PodDisruptionBudget
+		pdbFldPath := specFldPath.Child("podDisruptionBudget")
+		policies := []struct {
+			name   string
+			policy *PodDisruptionBudgetPolicySpec
+		}{
+			{"apicastProduction", pdbSpec.ApicastProduction},
+			{"apicastStaging", pdbSpec.ApicastStaging},
+			{"backendListener", pdbSpec.BackendListener},
+			{"backendWorker", pdbSpec.BackendWorker},
+			{"backendCron", pdbSpec.BackendCron},
+			{"systemApp", pdbSpec.SystemApp},
+			{"systemSidekiq", pdbSpec.SystemSidekiq},
+			{"zync", pdbSpec.Zync},
+			{"zyncQue", pdbSpec.ZyncQue},
+		}
+		for _, p := range policies {
+			if p.policy != nil {
+				fieldErrors = append(fieldErrors, p.policy.Validate(pdbFldPath.Child(p.name))...)
+			}
+		}
+	}
+
+	fieldErrors = append(fieldErrors, apimanager.validateUnreachableTolerationSeconds(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateExtraEnv(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateSidecars(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateDNS(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateServiceAccounts(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateRuntimeTuning(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateHorizontalPodAutoscalers(specFldPath)...)
+	fieldErrors = append(fieldErrors, apimanager.validateDatabaseSecurityContexts(specFldPath)...)
+
+	if apimanager.IsGatewayOnly() {
+		fieldErrors = append(fieldErrors, apimanager.validateGatewayOnly(specFldPath)...)
+	}
+
+	if apimanager.IsExternalBackend() {
+		fieldErrors = append(fieldErrors, apimanager.validateExternalBackend(specFldPath)...)
+	}
+
+	if apimanager.Spec.ExternalComponents != nil {
+		fieldErrors = append(fieldErrors, apimanager.validateExternalRedisCA(specFldPath.Child("externalComponents"))...)
+	}
+
+	if apimanager.Spec.Databases != nil && apimanager.Spec.Databases.Backup != nil {
+		fieldErrors = append(fieldErrors, apimanager.validateDatabaseBackup(specFldPath.Child("databases").Child("backup"))...)
+	}
+
+	fieldErrors = append(fieldErrors, apimanager.validateImages(specFldPath)...)
+
+	if apimanager.Spec.ImageRegistryOverride != nil {
+		imageRegistryOverrideFldPath := specFldPath.Child("imageRegistryOverride")
+		host := apimanager.Spec.ImageRegistryOverride.Host
+		if reason := helper.ImageRegistryHostError(host); reason != "" {
+			fieldErrors = append(fieldErrors, field.Invalid(imageRegistryOverrideFldPath.Child("host"), host, reason))
+		}
+		if repositoryPrefix := apimanager.Spec.ImageRegistryOverride.RepositoryPrefix; repositoryPrefix != nil {
+			if reason := helper.ImageRepositoryPrefixError(*repositoryPrefix); reason != "" {
+				fieldErrors = append(fieldErrors, field.Invalid(imageRegistryOverrideFldPath.Child("repositoryPrefix"), *repositoryPrefix, reason))
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateImages checks the per-component image overrides are tag or digest image references
+func (apimanager *APIManager) validateImages(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type imageValue struct {
+		fldPath *field.Path
+		image   *string
+	}
+	values := []imageValue{}
+
+	if apimanager.Spec.Apicast != nil {
+		values = append(values, imageValue{specFldPath.Child("apicast").Child("image"), apimanager.Spec.Apicast.Image})
+	}
+
+	if apimanager.Spec.Backend != nil {
+		backendFldPath := specFldPath.Child("backend")
+		values = append(values,
+			imageValue{backendFldPath.Child("image"), apimanager.Spec.Backend.Image},
+			imageValue{backendFldPath.Child("redisImage"), apimanager.Spec.Backend.RedisImage},
+		)
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		values = append(values,
+			imageValue{systemFldPath.Child("image"), apimanager.Spec.System.Image},
+			imageValue{systemFldPath.Child("memcachedImage"), apimanager.Spec.System.MemcachedImage},
+			imageValue{systemFldPath.Child("redisImage"), apimanager.Spec.System.RedisImage},
+		)
+		if database := apimanager.Spec.System.DatabaseSpec; database != nil {
+			databaseFldPath := systemFldPath.Child("database")
+			if database.MySQL != nil {
+				values = append(values, imageValue{databaseFldPath.Child("mysql").Child("image"), database.MySQL.Image})
+			}
+			if database.PostgreSQL != nil {
+				values = append(values, imageValue{databaseFldPath.Child("postgresql").Child("image"), database.PostgreSQL.Image})
+			}
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		values = append(values,
+			imageValue{zyncFldPath.Child("image"), apimanager.Spec.Zync.Image},
+			imageValue{zyncFldPath.Child("postgreSQLImage"), apimanager.Spec.Zync.PostgreSQLImage},
+		)
+	}
+
+	for _, v := range values {
+		if v.image == nil {
+			continue
+		}
+		if reason := helper.ImageReferenceError(*v.image); reason != "" {
+			fieldErrors = append(fieldErrors, field.Invalid(v.fldPath, *v.image, reason))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateDatabaseBackup checks a single destination is set
+func (apimanager *APIManager) validateDatabaseBackup(backupFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	backup := apimanager.Spec.Databases.Backup
+	if backup.PersistentVolumeClaim != nil && backup.S3 != nil {
+		fieldErrors = append(fieldErrors, field.Invalid(backupFldPath, backup, "only one of persistentVolumeClaim and s3 can be set"))
+	}
+	if backup.S3 != nil && backup.S3.SecretRef.Name == "" {
+		fieldErrors = append(fieldErrors, field.Required(backupFldPath.Child("s3").Child("secretRef").Child("name"), "S3 configuration secret name is mandatory"))
+	}
+	if backup.Retention != nil && *backup.Retention < 1 {
+		fieldErrors = append(fieldErrors, field.Invalid(backupFldPath.Child("retention"), *backup.Retention, "must be greater than or equal to 1"))
+	}
+
+	return fieldErrors
+}
+
+// validateGatewayOnly rejects the settings of the components
+// not deployed in gateway only mode
+func (apimanager *APIManager) validateGatewayOnly(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	gatewayOnlyFldPath := specFldPath.Child("gatewayOnly")
+	if apimanager.Spec.GatewayOnly.PortalEndpointSecretRef.Name == "" {
+		fieldErrors = append(fieldErrors, field.Required(gatewayOnlyFldPath.Child("portalEndpointSecretRef").Child("name"), "portal endpoint secret name is mandatory"))
+	}
+
+	controlPlaneFields := []struct {
+		name string
+		set  bool
+	}{
+		{"system", apimanager.Spec.System != nil},
+		{"backend", apimanager.Spec.Backend != nil},
+		{"zync", apimanager.Spec.Zync != nil},
+		{"highAvailability", apimanager.Spec.HighAvailability != nil},
+		{"externalComponents", apimanager.Spec.ExternalComponents != nil},
+		{"externalBackend", apimanager.Spec.ExternalBackend != nil},
+		{"shutdown", apimanager.Spec.Shutdown != nil},
+		{"databases", apimanager.Spec.Databases != nil},
+		{"mode", apimanager.IsStandby()},
+	}
+	for _, f := range controlPlaneFields {
+		if f.set {
+			fieldErrors = append(fieldErrors, field.Forbidden(specFldPath.Child(f.name), "not supported in gateway only mode, the control plane is managed outside of the APIManager"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateSystemAccessTokens requires unique access token names and secrets,
+// and the scopes and permissions supported by the admin portal
+func (apimanager *APIManager) validateSystemAccessTokens(accessTokensFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+	permissions := []string{component.SystemAccessTokenPermissionReadOnly, component.SystemAccessTokenPermissionReadWrite}
+
+	names := map[string]bool{}
+	secretNames := map[string]bool{}
+	for idx, accessToken := range apimanager.SystemAccessTokens() {
+		accessTokenFldPath := accessTokensFldPath.Index(idx)
+
+		if accessToken.Name == "" {
+			fieldErrors = append(fieldErrors, field.Required(accessTokenFldPath.Child("name"), "access token name is empty"))
+		} else if names[accessToken.Name] {
+			fieldErrors = append(fieldErrors, field.Duplicate(accessTokenFldPath.Child("name"), accessToken.Name))
+		}
+		names[accessToken.Name] = true
+
+		if errs := validation.IsDNS1123Subdomain(accessToken.SecretName); len(errs) > 0 {
+			fieldErrors = append(fieldErrors, field.Invalid(accessTokenFldPath.Child("secretName"), accessToken.SecretName, strings.Join(errs, ", ")))
+		} else if secretNames[accessToken.SecretName] {
+			fieldErrors = append(fieldErrors, field.Duplicate(accessTokenFldPath.Child("secretName"), accessToken.SecretName))
+		}
+		secretNames[accessToken.SecretName] = true
+
+		if len(accessToken.Scopes) == 0 {
+			fieldErrors = append(fieldErrors, field.Required(accessTokenFldPath.Child("scopes"), "at least one scope is mandatory"))
+		}
+		for scopeIdx, scope := range accessToken.Scopes {
+			if !helper.ArrayContains(component.SystemAccessTokenScopes, scope) {
+				fieldErrors = append(fieldErrors, field.NotSupported(accessTokenFldPath.Child("scopes").Index(scopeIdx), scope, component.SystemAccessTokenScopes))
+			}
+		}
+
+		if accessToken.Permission != nil && !helper.ArrayContains(permissions, *accessToken.Permission) {
+			fieldErrors = append(fieldErrors, field.NotSupported(accessTokenFldPath.Child("permission"), *accessToken.Permission, permissions))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateExternalRedisCA rejects the redis CA secret references of the redis deployed by the operator
+func (apimanager *APIManager) validateExternalRedisCA(externalComponentsFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type redisCA struct {
+		name      string
+		secretRef *v1.LocalObjectReference
+		external  bool
+	}
+
+	e := apimanager.Spec.ExternalComponents
+	redisCAs := []redisCA{}
+	if e.Backend != nil {
+		redisCAs = append(redisCAs, redisCA{"backend", e.Backend.RedisCASecretRef, BackendRedis(e)})
+	}
+	if e.System != nil {
+		redisCAs = append(redisCAs, redisCA{"system", e.System.RedisCASecretRef, SystemRedis(e)})
+	}
+
+	for _, ca := range redisCAs {
+		if ca.secretRef == nil {
+			continue
+		}
+		caFldPath := externalComponentsFldPath.Child(ca.name).Child("redisCASecretRef")
+		if !ca.external {
+			fieldErrors = append(fieldErrors, field.Forbidden(caFldPath, "only supported with an external redis"))
+		} else if ca.secretRef.Name == "" {
+			fieldErrors = append(fieldErrors, field.Required(caFldPath.Child("name"), "redis CA secret name is empty"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateExternalBackend requires the backend redis to be external
+// and rejects the settings of the internal backend redis
+func (apimanager *APIManager) validateExternalBackend(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	externalBackendFldPath := specFldPath.Child("externalBackend")
+	if apimanager.Spec.ExternalBackend.InternalAPISecretRef.Name == "" {
+		fieldErrors = append(fieldErrors, field.Required(externalBackendFldPath.Child("internalAPISecretRef").Child("name"), "internal API secret name is mandatory"))
+	}
+
+	if !apimanager.IsExternal(BackendRedis) {
+		fieldErrors = append(fieldErrors, field.Required(specFldPath.Child("externalComponents").Child("backend").Child("redis"), "the backend redis must be external with an external backend"))
+	}
+
+	if apimanager.Spec.Backend == nil {
+		return fieldErrors
+	}
+
+	backendFldPath := specFldPath.Child("backend")
+	redisFields := []struct {
+		name string
+		set  bool
+	}{
+		{"redisImage", apimanager.Spec.Backend.RedisImage != nil},
+		{"redisPersistentVolumeClaim", apimanager.Spec.Backend.RedisPersistentVolumeClaimSpec != nil},
+		{"redisNodeSelector", apimanager.Spec.Backend.RedisNodeSelector != nil},
+		{"redisAffinity", apimanager.Spec.Backend.RedisAffinity != nil},
+		{"redisTolerations", apimanager.Spec.Backend.RedisTolerations != nil},
+		{"redisTopologySpreadConstraints", apimanager.Spec.Backend.RedisTopologySpreadConstraints != nil},
+		{"redisPriorityClassName", apimanager.Spec.Backend.RedisPriorityClassName != nil},
+		{"redisResources", apimanager.Spec.Backend.RedisResources != nil},
+		{"redisPodSecurityContext", apimanager.Spec.Backend.RedisPodSecurityContext != nil},
+		{"redisSecurityContext", apimanager.Spec.Backend.RedisSecurityContext != nil},
+	}
+	for _, f := range redisFields {
+		if f.set {
+			fieldErrors = append(fieldErrors, field.Forbidden(backendFldPath.Child(f.name), "not supported with an external backend, the backend redis is managed outside of the APIManager"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// ValidateTopologyChange rejects switching an existing install between the full
+// and the gateway only topologies. Installs reconciled before the topology was
+// reported in the status are full installs
+func (apimanager *APIManager) ValidateTopologyChange() field.ErrorList {
+	current := apimanager.Status.Topology
+	if current == "" && len(apimanager.Status.Conditions) > 0 {
+		current = TopologyFull
+	}
+
+	if current == "" || current == apimanager.Topology() {
+		return nil
+	}
+
+	return field.ErrorList{
+		field.Forbidden(field.NewPath("spec").Child("gatewayOnly"), fmt.Sprintf("switching the %s topology of an existing install to %s is not supported", current, apimanager.Topology())),
+	}
+}
+
+// validateUnreachableTolerationSeconds checks the global and per component
+// unreachable toleration seconds are not below the minimum
+func (apimanager *APIManager) validateUnreachableTolerationSeconds(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type tolerationSecondsValue struct {
+		fldPath *field.Path
+		value   *int64
+	}
+	values := []tolerationSecondsValue{
+		{specFldPath.Child("unreachableTolerationSeconds"), apimanager.Spec.UnreachableTolerationSeconds},
+	}
+
+	if apimanager.Spec.Apicast != nil {
+		apicastFldPath := specFldPath.Child("apicast")
+		if apimanager.Spec.Apicast.ProductionSpec != nil {
+			values = append(values, tolerationSecondsValue{apicastFldPath.Child("productionSpec", "unreachableTolerationSeconds"), apimanager.Spec.Apicast.ProductionSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.Apicast.StagingSpec != nil {
+			values = append(values, tolerationSecondsValue{apicastFldPath.Child("stagingSpec", "unreachableTolerationSeconds"), apimanager.Spec.Apicast.StagingSpec.UnreachableTolerationSeconds})
+		}
+	}
+
+	if apimanager.Spec.Backend != nil {
+		backendFldPath := specFldPath.Child("backend")
+		if apimanager.Spec.Backend.ListenerSpec != nil {
+			values = append(values, tolerationSecondsValue{backendFldPath.Child("listenerSpec", "unreachableTolerationSeconds"), apimanager.Spec.Backend.ListenerSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.Backend.WorkerSpec != nil {
+			values = append(values, tolerationSecondsValue{backendFldPath.Child("workerSpec", "unreachableTolerationSeconds"), apimanager.Spec.Backend.WorkerSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.Backend.CronSpec != nil {
+			values = append(values, tolerationSecondsValue{backendFldPath.Child("cronSpec", "unreachableTolerationSeconds"), apimanager.Spec.Backend.CronSpec.UnreachableTolerationSeconds})
+		}
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if apimanager.Spec.System.AppSpec != nil {
+			values = append(values, tolerationSecondsValue{systemFldPath.Child("appSpec", "unreachableTolerationSeconds"), apimanager.Spec.System.AppSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.System.SidekiqSpec != nil {
+			values = append(values, tolerationSecondsValue{systemFldPath.Child("sidekiqSpec", "unreachableTolerationSeconds"), apimanager.Spec.System.SidekiqSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.System.SphinxSpec != nil {
+			values = append(values, tolerationSecondsValue{systemFldPath.Child("sphinxSpec", "unreachableTolerationSeconds"), apimanager.Spec.System.SphinxSpec.UnreachableTolerationSeconds})
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		if apimanager.Spec.Zync.AppSpec != nil {
+			values = append(values, tolerationSecondsValue{zyncFldPath.Child("appSpec", "unreachableTolerationSeconds"), apimanager.Spec.Zync.AppSpec.UnreachableTolerationSeconds})
+		}
+		if apimanager.Spec.Zync.QueSpec != nil {
+			values = append(values, tolerationSecondsValue{zyncFldPath.Child("queSpec", "unreachableTolerationSeconds"), apimanager.Spec.Zync.QueSpec.UnreachableTolerationSeconds})
+		}
+	}
+
+	for _, v := range values {
+		if v.value != nil && *v.value < MinUnreachableTolerationSeconds {
+			fieldErrors = append(fieldErrors, field.Invalid(v.fldPath, *v.value, fmt.Sprintf("must be at least %d seconds", MinUnreachableTolerationSeconds)))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateDatabaseSecurityContexts requires the fsGroup in the pod security context of
+// the internal databases storing the data in a PersistentVolumeClaim. The database images
+// expect specific UIDs, the volume is only writable by other users through the fsGroup
+func (apimanager *APIManager) validateDatabaseSecurityContexts(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type podSecurityContextValue struct {
+		fldPath *field.Path
+		value   *v1.PodSecurityContext
+	}
+	values := []podSecurityContextValue{}
+
+	if apimanager.Spec.Backend != nil && !apimanager.IsExternal(BackendRedis) {
+		values = append(values, podSecurityContextValue{specFldPath.Child("backend", "redisPodSecurityContext"), apimanager.Spec.Backend.RedisPodSecurityContext})
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if !apimanager.IsExternal(SystemRedis) {
+			values = append(values, podSecurityContextValue{systemFldPath.Child("redisPodSecurityContext"), apimanager.Spec.System.RedisPodSecurityContext})
+		}
+		if databaseSpec := apimanager.Spec.System.DatabaseSpec; databaseSpec != nil && !apimanager.IsExternal(SystemDatabase) {
+			if databaseSpec.MySQL != nil {
+				values = append(values, podSecurityContextValue{systemFldPath.Child("database", "mysql", "podSecurityContext"), databaseSpec.MySQL.PodSecurityContext})
+			}
+			if databaseSpec.PostgreSQL != nil {
+				values = append(values, podSecurityContextValue{systemFldPath.Child("database", "postgresql", "podSecurityContext"), databaseSpec.PostgreSQL.PodSecurityContext})
+			}
+		}
+	}
+
+	// The zync database data is stored in an ephemeral volume by default
+	if apimanager.Spec.Zync != nil && apimanager.Spec.Zync.DatabaseStorage != nil && !apimanager.IsExternal(ZyncDatabase) {
+		values = append(values, podSecurityContextValue{specFldPath.Child("zync", "databasePodSecurityContext"), apimanager.Spec.Zync.DatabasePodSecurityContext})
+	}
+
+	for _, v := range values {
+		if v.value != nil && v.value.FSGroup == nil {
+			fieldErrors = append(fieldErrors, field.Required(v.fldPath.Child("fsGroup"), "fsGroup is mandatory, the database data is stored in a PersistentVolumeClaim"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateExtraEnv checks the extra env vars of the components. The names of the
+// env vars managed by the operator are rejected, so they are not shadowed
+func (apimanager *APIManager) validateExtraEnv(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type extraEnvValue struct {
+		fldPath  *field.Path
+		env      []v1.EnvVar
+		reserved []string
+	}
+	values := []extraEnvValue{}
+
+	if apimanager.Spec.Apicast != nil {
+		apicastFldPath := specFldPath.Child("apicast")
+		if apimanager.Spec.Apicast.ProductionSpec != nil {
+			values = append(values, extraEnvValue{apicastFldPath.Child("productionSpec", "env"), apimanager.Spec.Apicast.ProductionSpec.Env, component.ApicastReservedEnvVarNames})
+		}
+		if apimanager.Spec.Apicast.StagingSpec != nil {
+			values = append(values, extraEnvValue{apicastFldPath.Child("stagingSpec", "env"), apimanager.Spec.Apicast.StagingSpec.Env, component.ApicastReservedEnvVarNames})
+		}
+	}
+
+	if apimanager.Spec.Backend != nil {
+		backendFldPath := specFldPath.Child("backend")
+		if apimanager.Spec.Backend.ListenerSpec != nil {
+			values = append(values, extraEnvValue{backendFldPath.Child("listenerSpec", "env"), apimanager.Spec.Backend.ListenerSpec.Env, component.BackendReservedEnvVarNames})
+		}
+		if apimanager.Spec.Backend.WorkerSpec != nil {
+			values = append(values, extraEnvValue{backendFldPath.Child("workerSpec", "env"), apimanager.Spec.Backend.WorkerSpec.Env, component.BackendReservedEnvVarNames})
+		}
+		if apimanager.Spec.Backend.CronSpec != nil {
+			values = append(values, extraEnvValue{backendFldPath.Child("cronSpec", "env"), apimanager.Spec.Backend.CronSpec.Env, component.BackendReservedEnvVarNames})
+		}
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if apimanager.Spec.System.AppSpec != nil {
+			values = append(values, extraEnvValue{systemFldPath.Child("appSpec", "env"), apimanager.Spec.System.AppSpec.Env, component.SystemReservedEnvVarNames})
+		}
+		if apimanager.Spec.System.SidekiqSpec != nil {
+			values = append(values, extraEnvValue{systemFldPath.Child("sidekiqSpec", "env"), apimanager.Spec.System.SidekiqSpec.Env, component.SystemReservedEnvVarNames})
+		}
+		if apimanager.Spec.System.SphinxSpec != nil {
+			values = append(values, extraEnvValue{systemFldPath.Child("sphinxSpec", "env"), apimanager.Spec.System.SphinxSpec.Env, component.SystemReservedEnvVarNames})
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		if apimanager.Spec.Zync.AppSpec != nil {
+			values = append(values, extraEnvValue{zyncFldPath.Child("appSpec", "env"), apimanager.Spec.Zync.AppSpec.Env, component.ZyncReservedEnvVarNames})
+		}
+		if apimanager.Spec.Zync.QueSpec != nil {
+			values = append(values, extraEnvValue{zyncFldPath.Child("queSpec", "env"), apimanager.Spec.Zync.QueSpec.Env, component.ZyncReservedEnvVarNames})
+		}
+	}
+
+	for _, v := range values {
+		reserved := map[string]bool{}
+		for _, name := range v.reserved {
+			reserved[name] = true
+		}
+
+		names := map[string]bool{}
+		for idx, envVar := range v.env {
+			nameFldPath := v.fldPath.Index(idx).Child("name")
+			switch {
+			case envVar.Name == "":
+				fieldErrors = append(fieldErrors, field.Required(nameFldPath, "env var name is mandatory"))
+			case len(validation.IsEnvVarName(envVar.Name)) > 0:
+				fieldErrors = append(fieldErrors, field.Invalid(nameFldPath, envVar.Name, strings.Join(validation.IsEnvVarName(envVar.Name), ", ")))
+			case reserved[envVar.Name]:
+				fieldErrors = append(fieldErrors, field.Forbidden(nameFldPath, fmt.Sprintf("'%s' is managed by the operator. Reserved names: %s", envVar.Name, strings.Join(sortedStrings(v.reserved), ", "))))
+			case names[envVar.Name]:
+				fieldErrors = append(fieldErrors, field.Duplicate(nameFldPath, envVar.Name))
+			}
+			names[envVar.Name] = true
+
+			if envVar.Value != "" && envVar.ValueFrom != nil {
+				fieldErrors = append(fieldErrors, field.Invalid(v.fldPath.Index(idx).Child("valueFrom"), envVar.Name, "value and valueFrom are mutually exclusive"))
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateSidecars checks the sidecars and the init containers of the components. The containers
+// and volumes are validated by the API server when the pods are created, only the names are checked,
+// as the sidecars are reconciled by name
+func (apimanager *APIManager) validateSidecars(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type sidecarsValue struct {
+		fldPath        *field.Path
+		containers     []v1.Container
+		volumes        []v1.Volume
+		initContainers []v1.Container
+	}
+	values := []sidecarsValue{}
+
+	if apimanager.Spec.Apicast != nil {
+		apicastFldPath := specFldPath.Child("apicast")
+		if spec := apimanager.Spec.Apicast.ProductionSpec; spec != nil {
+			values = append(values, sidecarsValue{apicastFldPath.Child("productionSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.Apicast.StagingSpec; spec != nil {
+			values = append(values, sidecarsValue{apicastFldPath.Child("stagingSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+	}
+
+	if apimanager.Spec.Backend != nil {
+		backendFldPath := specFldPath.Child("backend")
+		if spec := apimanager.Spec.Backend.ListenerSpec; spec != nil {
+			values = append(values, sidecarsValue{backendFldPath.Child("listenerSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.Backend.WorkerSpec; spec != nil {
+			values = append(values, sidecarsValue{backendFldPath.Child("workerSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.Backend.CronSpec; spec != nil {
+			values = append(values, sidecarsValue{backendFldPath.Child("cronSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if spec := apimanager.Spec.System.AppSpec; spec != nil {
+			values = append(values, sidecarsValue{systemFldPath.Child("appSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.System.SidekiqSpec; spec != nil {
+			values = append(values, sidecarsValue{systemFldPath.Child("sidekiqSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.System.SphinxSpec; spec != nil {
+			values = append(values, sidecarsValue{systemFldPath.Child("sphinxSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		if spec := apimanager.Spec.Zync.AppSpec; spec != nil {
+			values = append(values, sidecarsValue{zyncFldPath.Child("appSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+		if spec := apimanager.Spec.Zync.QueSpec; spec != nil {
+			values = append(values, sidecarsValue{zyncFldPath.Child("queSpec"), spec.Sidecars, spec.SidecarVolumes, spec.InitContainers})
+		}
+	}
+
+	validateName := func(fldPath *field.Path, name string, names map[string]bool) {
+		switch {
+		case name == "":
+			fieldErrors = append(fieldErrors, field.Required(fldPath, "name is mandatory"))
+		case len(validation.IsDNS1123Label(name)) > 0:
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath, name, strings.Join(validation.IsDNS1123Label(name), ", ")))
+		case names[name]:
+			fieldErrors = append(fieldErrors, field.Duplicate(fldPath, name))
+		}
+		names[name] = true
+	}
+
+	for _, v := range values {
+		containerNames := map[string]bool{}
+		for idx, container := range v.containers {
+			containerFldPath := v.fldPath.Child("sidecars").Index(idx)
+			validateName(containerFldPath.Child("name"), container.Name, containerNames)
+			if container.Image == "" {
+				fieldErrors = append(fieldErrors, field.Required(containerFldPath.Child("image"), "image is mandatory"))
+			}
+		}
+
+		volumeNames := map[string]bool{}
+		for idx, volume := range v.volumes {
+			validateName(v.fldPath.Child("sidecarVolumes").Index(idx).Child("name"), volume.Name, volumeNames)
+		}
+
+		initContainerNames := map[string]bool{}
+		for idx, container := range v.initContainers {
+			containerFldPath := v.fldPath.Child("initContainers").Index(idx)
+			validateName(containerFldPath.Child("name"), container.Name, initContainerNames)
+			if container.Image == "" {
+				fieldErrors = append(fieldErrors, field.Required(containerFldPath.Child("image"), "image is mandatory"))
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateDNS checks the DNS settings and the host aliases of the components. The None DNS policy
+// ignores the cluster DNS, so the nameservers must be set in the DNS config
+func (apimanager *APIManager) validateDNS(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type dnsValue struct {
+		fldPath     *field.Path
+		dnsPolicy   *v1.DNSPolicy
+		dnsConfig   *v1.PodDNSConfig
+		hostAliases []v1.HostAlias
+	}
+	values := []dnsValue{}
+
+	if apimanager.Spec.Apicast != nil {
+		apicastFldPath := specFldPath.Child("apicast")
+		if spec := apimanager.Spec.Apicast.ProductionSpec; spec != nil {
+			values = append(values, dnsValue{apicastFldPath.Child("productionSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.Apicast.StagingSpec; spec != nil {
+			values = append(values, dnsValue{apicastFldPath.Child("stagingSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+	}
+
+	if apimanager.Spec.Backend != nil {
+		backendFldPath := specFldPath.Child("backend")
+		if spec := apimanager.Spec.Backend.ListenerSpec; spec != nil {
+			values = append(values, dnsValue{backendFldPath.Child("listenerSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.Backend.WorkerSpec; spec != nil {
+			values = append(values, dnsValue{backendFldPath.Child("workerSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.Backend.CronSpec; spec != nil {
+			values = append(values, dnsValue{backendFldPath.Child("cronSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+	}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if spec := apimanager.Spec.System.AppSpec; spec != nil {
+			values = append(values, dnsValue{systemFldPath.Child("appSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.System.SidekiqSpec; spec != nil {
+			values = append(values, dnsValue{systemFldPath.Child("sidekiqSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.System.SphinxSpec; spec != nil {
+			values = append(values, dnsValue{systemFldPath.Child("sphinxSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		if spec := apimanager.Spec.Zync.AppSpec; spec != nil {
+			values = append(values, dnsValue{zyncFldPath.Child("appSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+		if spec := apimanager.Spec.Zync.QueSpec; spec != nil {
+			values = append(values, dnsValue{zyncFldPath.Child("queSpec"), spec.DNSPolicy, spec.DNSConfig, spec.HostAliases})
+		}
+	}
+
+	for _, v := range values {
+		if v.dnsPolicy != nil && *v.dnsPolicy == v1.DNSNone && (v.dnsConfig == nil || len(v.dnsConfig.Nameservers) == 0) {
+			fieldErrors = append(fieldErrors, field.Required(v.fldPath.Child("dnsConfig", "nameservers"), "nameservers are mandatory with the None DNS policy"))
+		}
+
+		for idx, hostAlias := range v.hostAliases {
+			hostAliasFldPath := v.fldPath.Child("hostAliases").Index(idx)
+			if net.ParseIP(hostAlias.IP) == nil {
+				fieldErrors = append(fieldErrors, field.Invalid(hostAliasFldPath.Child("ip"), hostAlias.IP, "ip is not a valid IP address"))
+			}
+			if len(hostAlias.Hostnames) == 0 {
+				fieldErrors = append(fieldErrors, field.Required(hostAliasFldPath.Child("hostnames"), "hostnames are mandatory"))
+			}
+			for hostnameIdx, hostname := range hostAlias.Hostnames {
+				if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+					fieldErrors = append(fieldErrors, field.Invalid(hostAliasFldPath.Child("hostnames").Index(hostnameIdx), hostname, strings.Join(errs, ", ")))
+				}
+			}
+		}
+
+		if v.dnsConfig == nil {
+			continue
+		}
+
+		for idx, nameserver := range v.dnsConfig.Nameservers {
+			if net.ParseIP(nameserver) == nil {
+				fieldErrors = append(fieldErrors, field.Invalid(v.fldPath.Child("dnsConfig", "nameservers").Index(idx), nameserver, "nameserver is not a valid IP address"))
+			}
+		}
+		for idx, option := range v.dnsConfig.Options {
+			if option.Name == "" {
+				fieldErrors = append(fieldErrors, field.Required(v.fldPath.Child("dnsConfig", "options").Index(idx).Child("name"), "name is mandatory"))
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateServiceAccounts checks the names of the service accounts created by the operator
+// are valid and different
+func (apimanager *APIManager) validateServiceAccounts(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	if apimanager.Spec.ServiceAccounts == nil {
+		return fieldErrors
+	}
+
+	serviceAccountsFldPath := specFldPath.Child("serviceAccounts")
+	for _, sa := range []struct {
+		fldPath *field.Path
+		spec    *ServiceAccountSpec
+	}{
+		{serviceAccountsFldPath.Child("amp"), apimanager.Spec.ServiceAccounts.Amp},
+		{serviceAccountsFldPath.Child("zyncQue"), apimanager.Spec.ServiceAccounts.ZyncQue},
+	} {
+		if sa.spec == nil || sa.spec.Name == nil {
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(*sa.spec.Name); len(errs) > 0 {
+			fieldErrors = append(fieldErrors, field.Invalid(sa.fldPath.Child("name"), *sa.spec.Name, strings.Join(errs, ", ")))
+		}
+	}
+
+	if apimanager.ServiceAccountName(AmpServiceAccountName) == apimanager.ServiceAccountName(ZyncQueServiceAccountName) {
+		fieldErrors = append(fieldErrors, field.Invalid(serviceAccountsFldPath.Child("zyncQue", "name"), apimanager.ServiceAccountName(ZyncQueServiceAccountName), "zync-que cannot share the service account of the other workloads"))
+	}
+
+	return fieldErrors
+}
+
+// validateHorizontalPodAutoscalers checks the replicas limits of the autoscaled components.
+// Fixed replicas cannot be set along with the autoscaler
+func (apimanager *APIManager) validateHorizontalPodAutoscalers(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	if apimanager.Spec.Apicast == nil || apimanager.Spec.Apicast.ProductionSpec == nil || apimanager.Spec.Apicast.ProductionSpec.HPA == nil {
+		return fieldErrors
+	}
+
+	productionFldPath := specFldPath.Child("apicast", "productionSpec")
+	production := apimanager.Spec.Apicast.ProductionSpec
+	hpa := production.HPA
+	hpaFldPath := productionFldPath.Child("hpa")
+
+	if production.Replicas != nil {
+		fieldErrors = append(fieldErrors, field.Invalid(productionFldPath.Child("replicas"), *production.Replicas, "replicas cannot be set when hpa is set"))
+	}
+
+	minReplicas := int32(1)
+	if hpa.MinReplicas != nil {
+		minReplicas = *hpa.MinReplicas
+		if minReplicas < 1 {
+			fieldErrors = append(fieldErrors, field.Invalid(hpaFldPath.Child("minReplicas"), minReplicas, "must be greater than or equal to 1"))
+		}
+	}
+	if hpa.MaxReplicas < minReplicas {
+		fieldErrors = append(fieldErrors, field.Invalid(hpaFldPath.Child("maxReplicas"), hpa.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+	if hpa.CPUTargetUtilization != nil && *hpa.CPUTargetUtilization < 1 {
+		fieldErrors = append(fieldErrors, field.Invalid(hpaFldPath.Child("cpuTargetUtilization"), *hpa.CPUTargetUtilization, "must be greater than or equal to 1"))
+	}
+	if hpa.MemoryTargetUtilization != nil && *hpa.MemoryTargetUtilization < 1 {
+		fieldErrors = append(fieldErrors, field.Invalid(hpaFldPath.Child("memoryTargetUtilization"), *hpa.MemoryTargetUtilization, "must be greater than or equal to 1"))
+	}
+
+	return fieldErrors
+}
+
+// validateRuntimeTuning checks the Ruby runtime tuning values of the components are positive.
+// The env vars set by the tuning cannot also be set as extra env vars
+func (apimanager *APIManager) validateRuntimeTuning(specFldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	type runtimeTuningValue struct {
+		fldPath *field.Path
+		spec    *RubyRuntimeTuningSpec
+		env     []v1.EnvVar
+	}
+	values := []runtimeTuningValue{}
+
+	if apimanager.Spec.System != nil {
+		systemFldPath := specFldPath.Child("system")
+		if apimanager.Spec.System.AppSpec != nil {
+			values = append(values, runtimeTuningValue{systemFldPath.Child("appSpec"), apimanager.Spec.System.AppSpec.RuntimeTuning, apimanager.Spec.System.AppSpec.Env})
+		}
+		if apimanager.Spec.System.SidekiqSpec != nil {
+			values = append(values, runtimeTuningValue{systemFldPath.Child("sidekiqSpec"), apimanager.Spec.System.SidekiqSpec.RuntimeTuning, apimanager.Spec.System.SidekiqSpec.Env})
+		}
+	}
+
+	if apimanager.Spec.Zync != nil {
+		zyncFldPath := specFldPath.Child("zync")
+		if apimanager.Spec.Zync.AppSpec != nil {
+			values = append(values, runtimeTuningValue{zyncFldPath.Child("appSpec"), apimanager.Spec.Zync.AppSpec.RuntimeTuning, apimanager.Spec.Zync.AppSpec.Env})
+		}
+		if apimanager.Spec.Zync.QueSpec != nil {
+			values = append(values, runtimeTuningValue{zyncFldPath.Child("queSpec"), apimanager.Spec.Zync.QueSpec.RuntimeTuning, apimanager.Spec.Zync.QueSpec.Env})
+		}
+	}
+
+	for _, v := range values {
+		if v.spec == nil {
+			continue
+		}
+
+		tuningFldPath := v.fldPath.Child("runtimeTuning")
+		tuned := map[string]bool{}
+
+		if v.spec.MallocArenaMax != nil {
+			tuned[component.MallocArenaMaxEnvVarName] = true
+			if *v.spec.MallocArenaMax < 1 {
+				fieldErrors = append(fieldErrors, field.Invalid(tuningFldPath.Child("mallocArenaMax"), *v.spec.MallocArenaMax, "must be greater than or equal to 1"))
+			}
+		}
+		if v.spec.GCHeapGrowthFactor != nil {
+			tuned[component.RubyGCHeapGrowthFactorEnvVarName] = true
+			factor, err := strconv.ParseFloat(*v.spec.GCHeapGrowthFactor, 64)
+			if err != nil || factor <= 1 {
+				fieldErrors = append(fieldErrors, field.Invalid(tuningFldPath.Child("gcHeapGrowthFactor"), *v.spec.GCHeapGrowthFactor, "must be a number greater than 1"))
+			}
+		}
+		if v.spec.GCHeapInitSlots != nil {
+			tuned[component.RubyGCHeapInitSlotsEnvVarName] = true
+			if *v.spec.GCHeapInitSlots < 1 {
+				fieldErrors = append(fieldErrors, field.Invalid(tuningFldPath.Child("gcHeapInitSlots"), *v.spec.GCHeapInitSlots, "must be greater than or equal to 1"))
+			}
+		}
+		if v.spec.RubyYJIT != nil {
+			tuned[component.RubyYJITEnableEnvVarName] = true
+		}
+
+		for idx, envVar := range v.env {
+			if tuned[envVar.Name] {
+				fieldErrors = append(fieldErrors, field.Forbidden(v.fldPath.Child("env").Index(idx).Child("name"), fmt.Sprintf("'%s' is set by runtimeTuning", envVar.Name)))
+			}
+		}
+	}
+
+	return fieldErrors
+}
+
+func sortedStrings(values []string) []string {
+	result := append([]string{}, values...)
+	sort.Strings(result)
+	return result
+}
+
+// Validate checks only one of minAvailable and maxUnavailable is set and the values are
+// non-negative integers or percentages
+func (p *PodDisruptionBudgetPolicySpec) Validate(fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	if p.MinAvailable != nil && p.MaxUnavailable != nil {
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath, p, "minAvailable and maxUnavailable cannot be both set"))
+	}
+
+	values := []struct {
+		name  string
+		value *intstr.IntOrString
+	}{
+		{"minAvailable", p.MinAvailable},
+		{"maxUnavailable", p.MaxUnavailable},
+	}
+	for _, v := range values {
+		if v.value == nil {
+			continue
+		}
+		// The percentage is scaled to 100 pods to check its format and range
+		scaled, err := intstr.GetValueFromIntOrPercent(v.value, 100, true)
+		if err != nil {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child(v.name), v.value.String(), "must be an integer or a percentage"))
+		} else if scaled < 0 || (v.value.Type == intstr.String && scaled > 100) {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child(v.name), v.value.String(), "must be a non-negative integer or a percentage between 0% and 100%"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// ServiceAccountImagePullSecrets returns the image pull secrets of the managed service
+// accounts, combining the defaults with spec.imagePullSecrets according to the policy
+func (apimanager *APIManager) ServiceAccountImagePullSecrets(defaults []v1.LocalObjectReference) []v1.LocalObjectReference {
+	if apimanager.Spec.ImagePullSecrets == nil {
+		return defaults
+	}
+
+	return apimanager.combineImagePullSecrets(defaults, apimanager.Spec.ImagePullSecrets)
+}
+
+// ComponentImagePullSecrets returns the image pull secrets of a component, combining the defaults
+// with the image pull secrets of the component spec according to the policy. Nil when the image
+// pull secrets of the component spec are not set
+func (apimanager *APIManager) ComponentImagePullSecrets(defaults, componentSecrets []v1.LocalObjectReference) []v1.LocalObjectReference {
+	if componentSecrets == nil {
+		return nil
+	}
+
+	return apimanager.combineImagePullSecrets(defaults, componentSecrets)
+}
+
+func (apimanager *APIManager) combineImagePullSecrets(defaults, secrets []v1.LocalObjectReference) []v1.LocalObjectReference {
+	if apimanager.Spec.ImagePullSecretsPolicy != nil && *apimanager.Spec.ImagePullSecretsPolicy == ImagePullSecretsPolicyMerge {
+		return helper.MergeImagePullSecrets(defaults, secrets)
+	}
+
+	return secrets
+}
+
+// DefaultImageURL returns the default image reference, rewritten
+// to the mirrored registry when the image registry override is set
+func (apimanager *APIManager) DefaultImageURL(image string) string {
+	override := apimanager.Spec.ImageRegistryOverride
+	if override == nil {
+		return image
+	}
+
+	repositoryPrefix := ""
+	if override.RepositoryPrefix != nil {
+		repositoryPrefix = *override.RepositoryPrefix
+	}
+	return helper.OverrideImageRegistry(image, override.Host, repositoryPrefix)
+}
+
+// DefaultRouteHosts returns the hosts of the routes created for
+// the default tenant and the master portal. In gateway only mode,
+// only the apicast routes are created, by the operator instead of zync
+func (apimanager *APIManager) DefaultRouteHosts() []string {
+	tenantName := defaultTenantName
+	if apimanager.Spec.TenantName != nil {
+		tenantName = *apimanager.Spec.TenantName
+	}
+	wildcardDomain := helper.NormalizeDomain(apimanager.Spec.WildcardDomain)
+
+	if apimanager.IsGatewayOnly() {
+		return []string{
+			fmt.Sprintf("api-%s-apicast-production.%s", tenantName, wildcardDomain), // Apicast Production Route
+			fmt.Sprintf("api-%s-apicast-staging.%s", tenantName, wildcardDomain),    // Apicast Staging Route
+		}
+	}
+
+	hosts := []string{}
+
+	// The external backend route is managed outside of the APIManager
+	if !apimanager.IsExternalBackend() {
+		hosts = append(hosts, fmt.Sprintf("backend-%s.%s", tenantName, wildcardDomain)) // Backend Listener route
+	}
+
+	hosts = append(hosts,
+		fmt.Sprintf("api-%s-apicast-production.%s", tenantName, wildcardDomain), // Apicast Production default tenant Route
+		fmt.Sprintf("api-%s-apicast-staging.%s", tenantName, wildcardDomain),    // Apicast Staging default tenant Route
+	)
+
+	if apimanager.IsSystemMasterRouteEnabled() {
+		hosts = append(hosts, fmt.Sprintf("master.%s", wildcardDomain)) // System's Master Portal Route
+	}
+
+	if apimanager.IsSystemDeveloperPortalEnabled() {
+		hosts = append(hosts, fmt.Sprintf("%s.%s", tenantName, wildcardDomain)) // System's default tenant Developer Portal Route
+	}
+
+	return append(hosts, fmt.Sprintf("%s-admin.%s", tenantName, wildcardDomain)) // System's default tenant Admin Portal Route
+}
+
+// isCronSchedule checks the shape of the schedule, the values are validated
+// by the CronJob controller
+func isCronSchedule(schedule string) bool {
+	if strings.HasPrefix(schedule, "@") {
+		return len(strings.Fields(schedule)) == 1
+	}
+	return len(strings.Fields(schedule)) == 5
+}
+
+// ValidateRouteHosts checks the default route hosts against the DNS length limits
+func (apimanager *APIManager) ValidateRouteHosts() field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	wildcardDomainFldPath := field.NewPath("spec").Child("wildcardDomain")
+	for _, host := range apimanager.DefaultRouteHosts() {
+		if reason := helper.HostLengthError(host); reason != "" {
+			fieldErrors = append(fieldErrors, field.Invalid(wildcardDomainFldPath, apimanager.Spec.WildcardDomain, fmt.Sprintf("route host %s: %s", host, reason)))
 		}
 	}
 
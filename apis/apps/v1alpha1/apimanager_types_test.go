@@ -2,13 +2,18 @@ package v1alpha1
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/version"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestSetDefaults(t *testing.T) {
@@ -21,8 +26,6 @@ func TestSetDefaults(t *testing.T) {
 	tmpDefaultApicastResponseCodes := defaultApicastResponseCodes
 	tmpDefaultApicastRegistryURL := defaultApicastRegistryURL
 
-	var tmpDefaultReplicas int64 = 1
-
 	inputAPIManager := minimumAPIManagerTest()
 
 	expectedAPIManager := APIManager{
@@ -30,6 +33,7 @@ func TestSetDefaults(t *testing.T) {
 			Annotations: map[string]string{
 				OperatorVersionAnnotation:   version.Version,
 				ThreescaleVersionAnnotation: product.ThreescaleRelease,
+				StorageVersionAnnotation:    "v1beta1",
 			},
 		},
 		Spec: APIManagerSpec{
@@ -45,40 +49,22 @@ func TestSetDefaults(t *testing.T) {
 				ApicastManagementAPI: &tmpDefaultApicastManagementAPI,
 				OpenSSLVerify:        &tmpDefaultApicastOpenSSLVerify,
 				RegistryURL:          &tmpDefaultApicastRegistryURL,
-				ProductionSpec: &ApicastProductionSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				StagingSpec: &ApicastStagingSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
+				ProductionSpec:       &ApicastProductionSpec{},
+				StagingSpec:          &ApicastStagingSpec{},
 			},
 			Backend: &BackendSpec{
-				ListenerSpec: &BackendListenerSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				WorkerSpec: &BackendWorkerSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				CronSpec: &BackendCronSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
+				ListenerSpec: &BackendListenerSpec{},
+				WorkerSpec:   &BackendWorkerSpec{},
+				CronSpec:     &BackendCronSpec{},
 			},
 			System: &SystemSpec{
-				AppSpec: &SystemAppSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				SidekiqSpec: &SystemSidekiqSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				SphinxSpec: &SystemSphinxSpec{},
+				AppSpec:     &SystemAppSpec{},
+				SidekiqSpec: &SystemSidekiqSpec{},
+				SphinxSpec:  &SystemSphinxSpec{},
 			},
 			Zync: &ZyncSpec{
-				AppSpec: &ZyncAppSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
-				QueSpec: &ZyncQueSpec{
-					Replicas: &tmpDefaultReplicas,
-				},
+				AppSpec: &ZyncAppSpec{},
+				QueSpec: &ZyncQueSpec{},
 			},
 			PodDisruptionBudget: nil,
 		},
@@ -176,6 +162,75 @@ func TestZyncExternalDatabaseIsEnabled(t *testing.T) {
 	}
 }
 
+func TestZyncTrustedProxiesValidation(t *testing.T) {
+	cases := []struct {
+		testName       string
+		trustedProxies []string
+		expectedErrors int
+	}{
+		{"WithoutTrustedProxies", nil, 0},
+		{"WithValidCIDRs", []string{"10.0.0.0/8", "192.168.1.0/24", "fd00::/8"}, 0},
+		{"WithPlainIP", []string{"10.0.0.1"}, 1},
+		{"WithSomeInvalidCIDRs", []string{"10.0.0.0/8", "foo", "300.0.0.0/8"}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Zync = &ZyncSpec{
+				AppSpec: &ZyncAppSpec{TrustedProxies: tc.trustedProxies},
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestApicastClientTLSValidation(t *testing.T) {
+	var (
+		httpsPort   int32 = 8443
+		verifyDepth int64 = 2
+		caSecretRef       = &v1.LocalObjectReference{Name: "myclientcasecret"}
+	)
+
+	cases := []struct {
+		testName       string
+		productionSpec *ApicastProductionSpec
+		expectedErrors int
+	}{
+		{"WithoutClientTLS", &ApicastProductionSpec{}, 0},
+		{"WithPodTLS", &ApicastProductionSpec{
+			HTTPSPort: &httpsPort,
+			ClientTLS: &APIcastClientTLSSpec{CASecretRef: caSecretRef, VerifyDepth: &verifyDepth},
+		}, 0},
+		{"WithoutPodTLS", &ApicastProductionSpec{
+			ClientTLS: &APIcastClientTLSSpec{CASecretRef: caSecretRef},
+		}, 1},
+		{"WithoutCASecret", &ApicastProductionSpec{
+			HTTPSPort: &httpsPort,
+			ClientTLS: &APIcastClientTLSSpec{},
+		}, 1},
+		{"WithConflictingVerifyDepth", &ApicastProductionSpec{
+			HTTPSPort:        &httpsPort,
+			HTTPSVerifyDepth: &verifyDepth,
+			ClientTLS:        &APIcastClientTLSSpec{CASecretRef: caSecretRef, VerifyDepth: &verifyDepth},
+		}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Apicast = &ApicastSpec{ProductionSpec: tc.productionSpec}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
 func minimumAPIManagerTest() *APIManager {
 	return &APIManager{
 		Spec: APIManagerSpec{
@@ -185,3 +240,1216 @@ func minimumAPIManagerTest() *APIManager {
 		},
 	}
 }
+
+func TestStatsdValidation(t *testing.T) {
+	var (
+		validPort   int32 = 8125
+		invalidPort int32 = 70000
+		tcpProtocol       = "tcp"
+		badProtocol       = "http"
+	)
+
+	cases := []struct {
+		testName       string
+		statsdSpec     *StatsdSpec
+		expectedErrors int
+	}{
+		{"WithoutStatsd", nil, 0},
+		{"WithDNSHost", &StatsdSpec{Host: "statsd.monitoring.svc", Port: &validPort, Protocol: &tcpProtocol}, 0},
+		{"WithIPHost", &StatsdSpec{Host: "10.0.0.1"}, 0},
+		{"WithEmptyHost", &StatsdSpec{Host: ""}, 1},
+		{"WithInvalidHost", &StatsdSpec{Host: "statsd:8125"}, 1},
+		{"WithInvalidPort", &StatsdSpec{Host: "statsd", Port: &invalidPort}, 1},
+		{"WithInvalidProtocol", &StatsdSpec{Host: "statsd", Protocol: &badProtocol}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			if tc.statsdSpec != nil {
+				apimanager.Spec.Metrics = &MetricsSpec{Statsd: tc.statsdSpec}
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestSystemCORSValidation(t *testing.T) {
+	var (
+		trueValue       = true
+		maxAge    int32 = 3600
+		negative  int32 = -1
+	)
+
+	cases := []struct {
+		testName       string
+		corsSpec       *SystemCORSSpec
+		expectedErrors int
+	}{
+		{"WithoutCORS", nil, 0},
+		{"WithOrigins", &SystemCORSSpec{AllowedOrigins: []string{"https://a.example.com"}, AllowedHeaders: []string{"Authorization"}, MaxAge: &maxAge}, 0},
+		{"WithWildcardOrigin", &SystemCORSSpec{AllowedOrigins: []string{"*"}}, 0},
+		{"WithCredentials", &SystemCORSSpec{AllowedOrigins: []string{"https://a.example.com"}, AllowCredentials: &trueValue}, 0},
+		{"WithoutOrigins", &SystemCORSSpec{}, 1},
+		{"WithEmptyOrigin", &SystemCORSSpec{AllowedOrigins: []string{""}}, 1},
+		{"WithEmptyHeader", &SystemCORSSpec{AllowedOrigins: []string{"*"}, AllowedHeaders: []string{" "}}, 1},
+		{"WithNegativeMaxAge", &SystemCORSSpec{AllowedOrigins: []string{"*"}, MaxAge: &negative}, 1},
+		{"WithWildcardOriginAndCredentials", &SystemCORSSpec{AllowedOrigins: []string{"https://a.example.com", "*"}, AllowCredentials: &trueValue}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			if tc.corsSpec != nil {
+				apimanager.Spec.System = &SystemSpec{CORS: tc.corsSpec}
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestZyncDatabaseTLSValidation(t *testing.T) {
+	trueValue := true
+
+	cases := []struct {
+		testName         string
+		databaseSpec     *ZyncDatabaseSpec
+		externalDatabase bool
+		expectedErrors   int
+	}{
+		{"WithoutDatabaseTLS", nil, false, 0},
+		{"WithSSLCASecretRef", &ZyncDatabaseSpec{SSLCASecretRef: &v1.LocalObjectReference{Name: "zync-db-tls"}}, true, 0},
+		{"WithEmptySSLCASecretRef", &ZyncDatabaseSpec{SSLCASecretRef: &v1.LocalObjectReference{}}, true, 1},
+		{"WithInternalDatabase", &ZyncDatabaseSpec{SSLCASecretRef: &v1.LocalObjectReference{Name: "zync-db-tls"}}, false, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			if tc.databaseSpec != nil {
+				apimanager.Spec.Zync = &ZyncSpec{Database: tc.databaseSpec}
+			}
+			if tc.externalDatabase {
+				apimanager.Spec.ExternalComponents = &ExternalComponentsSpec{
+					Zync: &ExternalZyncComponents{Database: &trueValue},
+				}
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestExternalRedisCAValidation(t *testing.T) {
+	trueValue := true
+	caSecretRef := &v1.LocalObjectReference{Name: "redis-ca"}
+
+	cases := []struct {
+		testName           string
+		externalComponents *ExternalComponentsSpec
+		expectedErrors     int
+	}{
+		{"WithoutRedisCA", &ExternalComponentsSpec{Backend: &ExternalBackendComponents{Redis: &trueValue}}, 0},
+		{"WithBackendRedisCA", &ExternalComponentsSpec{Backend: &ExternalBackendComponents{Redis: &trueValue, RedisCASecretRef: caSecretRef}}, 0},
+		{"WithSystemRedisCA", &ExternalComponentsSpec{System: &ExternalSystemComponents{Redis: &trueValue, RedisCASecretRef: caSecretRef}}, 0},
+		{"WithEmptyRedisCA", &ExternalComponentsSpec{System: &ExternalSystemComponents{Redis: &trueValue, RedisCASecretRef: &v1.LocalObjectReference{}}}, 1},
+		{"WithInternalBackendRedis", &ExternalComponentsSpec{Backend: &ExternalBackendComponents{RedisCASecretRef: caSecretRef}}, 1},
+		{"WithInternalRedis", &ExternalComponentsSpec{
+			Backend: &ExternalBackendComponents{RedisCASecretRef: caSecretRef},
+			System:  &ExternalSystemComponents{RedisCASecretRef: caSecretRef},
+		}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.ExternalComponents = tc.externalComponents
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestSystemAccessTokensValidation(t *testing.T) {
+	token := func(name, secretName string, scopes ...string) SystemAccessTokenSpec {
+		return SystemAccessTokenSpec{Name: name, Scopes: scopes, SecretName: secretName}
+	}
+	withPermission := func(accessToken SystemAccessTokenSpec, permission string) SystemAccessTokenSpec {
+		accessToken.Permission = &permission
+		return accessToken
+	}
+
+	cases := []struct {
+		testName       string
+		accessTokens   []SystemAccessTokenSpec
+		expectedErrors int
+	}{
+		{"WithoutAccessTokens", nil, 0},
+		{"Valid", []SystemAccessTokenSpec{
+			token("monitoring", "monitoring-token", "stats"),
+			withPermission(token("cms", "cms-token", "cms", "policy_registry"), "rw"),
+		}, 0},
+		{"DuplicatedName", []SystemAccessTokenSpec{token("a", "a-token", "stats"), token("a", "b-token", "stats")}, 1},
+		{"DuplicatedSecretName", []SystemAccessTokenSpec{token("a", "token", "stats"), token("b", "token", "stats")}, 1},
+		{"EmptyName", []SystemAccessTokenSpec{token("", "token", "stats")}, 1},
+		{"InvalidSecretName", []SystemAccessTokenSpec{token("a", "Token_A", "stats")}, 1},
+		{"WithoutScopes", []SystemAccessTokenSpec{token("a", "token")}, 1},
+		{"UnsupportedScope", []SystemAccessTokenSpec{token("a", "token", "stats", "unknown")}, 1},
+		{"UnsupportedPermission", []SystemAccessTokenSpec{withPermission(token("a", "token", "stats"), "admin")}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.System = &SystemSpec{AccessTokens: tc.accessTokens}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestZyncDatabaseMaintenanceValidation(t *testing.T) {
+	cases := []struct {
+		testName       string
+		schedule       *string
+		expectedErrors int
+	}{
+		{"WithoutSchedule", nil, 0},
+		{"WithSchedule", &[]string{"30 2 * * *"}[0], 0},
+		{"WithPredefinedSchedule", &[]string{"@weekly"}[0], 0},
+		{"WithTooFewFields", &[]string{"30 2 * *"}[0], 1},
+		{"WithEmptySchedule", &[]string{""}[0], 1},
+		{"WithPredefinedScheduleAndFields", &[]string{"@weekly 2"}[0], 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Zync = &ZyncSpec{
+				DatabaseMaintenance: &ZyncDatabaseMaintenanceSpec{Schedule: tc.schedule},
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestImageRegistryOverrideValidation(t *testing.T) {
+	validPrefix := "mirrors/3scale"
+	taggedPrefix := "mirrors/3scale:latest"
+
+	cases := []struct {
+		testName       string
+		overrideSpec   *ImageRegistryOverrideSpec
+		expectedErrors int
+	}{
+		{"WithoutOverride", nil, 0},
+		{"WithHostAndPort", &ImageRegistryOverrideSpec{Host: "mirror.example.com:5000", RepositoryPrefix: &validPrefix}, 0},
+		{"WithEmptyHost", &ImageRegistryOverrideSpec{Host: ""}, 1},
+		{"WithDigestHost", &ImageRegistryOverrideSpec{Host: "mirror.example.com@sha256:abcdef"}, 1},
+		{"WithTaggedPrefix", &ImageRegistryOverrideSpec{Host: "mirror.example.com", RepositoryPrefix: &taggedPrefix}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.ImageRegistryOverride = tc.overrideSpec
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestImagesValidation(t *testing.T) {
+	digestImage := "registry.redhat.io/3scale-amp2/zync-rhel8@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	taggedImage := "quay.io/3scale/zync:hotfix"
+	invalidDigestImage := "quay.io/3scale/zync@sha256:abcdef"
+	emptyTagImage := "quay.io/3scale/zync:"
+
+	cases := []struct {
+		testName       string
+		set            func(*APIManager)
+		expectedErrors int
+	}{
+		{"WithoutImages", func(*APIManager) {}, 0},
+		{"WithDigest", func(apimanager *APIManager) { apimanager.Spec.Zync = &ZyncSpec{Image: &digestImage} }, 0},
+		{"WithTag", func(apimanager *APIManager) { apimanager.Spec.Zync = &ZyncSpec{PostgreSQLImage: &taggedImage} }, 0},
+		{"WithInvalidDigest", func(apimanager *APIManager) { apimanager.Spec.Backend = &BackendSpec{Image: &invalidDigestImage} }, 1},
+		{"WithEmptyTag", func(apimanager *APIManager) {
+			apimanager.Spec.System = &SystemSpec{
+				MemcachedImage: &emptyTagImage,
+				DatabaseSpec:   &SystemDatabaseSpec{MySQL: &SystemMySQLSpec{Image: &emptyTagImage}},
+			}
+		}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			tc.set(apimanager)
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestPodDisruptionBudgetValidation(t *testing.T) {
+	intOrStr := func(value intstr.IntOrString) *intstr.IntOrString { return &value }
+
+	cases := []struct {
+		testName       string
+		pdbSpec        *PodDisruptionBudgetSpec
+		expectedErrors int
+	}{
+		{"WithoutPDB", nil, 0},
+		{"WithoutPolicies", &PodDisruptionBudgetSpec{Enabled: true}, 0},
+		{"WithMinAvailable", &PodDisruptionBudgetSpec{Enabled: true, ApicastProduction: &PodDisruptionBudgetPolicySpec{MinAvailable: intOrStr(intstr.FromInt(2))}}, 0},
+		{"WithMaxUnavailablePercentage", &PodDisruptionBudgetSpec{Enabled: true, ZyncQue: &PodDisruptionBudgetPolicySpec{MaxUnavailable: intOrStr(intstr.FromString("25%"))}}, 0},
+		{"WithBothSet", &PodDisruptionBudgetSpec{Enabled: true, Zync: &PodDisruptionBudgetPolicySpec{
+			MinAvailable: intOrStr(intstr.FromInt(1)), MaxUnavailable: intOrStr(intstr.FromInt(1)),
+		}}, 1},
+		{"WithNegativeValue", &PodDisruptionBudgetSpec{Enabled: true, BackendWorker: &PodDisruptionBudgetPolicySpec{MinAvailable: intOrStr(intstr.FromInt(-1))}}, 1},
+		{"WithInvalidPercentage", &PodDisruptionBudgetSpec{Enabled: true, BackendListener: &PodDisruptionBudgetPolicySpec{MaxUnavailable: intOrStr(intstr.FromString("half"))}}, 1},
+		{"WithPercentageOverHundred", &PodDisruptionBudgetSpec{Enabled: true, ApicastStaging: &PodDisruptionBudgetPolicySpec{MinAvailable: intOrStr(intstr.FromString("150%"))}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.PodDisruptionBudget = tc.pdbSpec
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestUnreachableTolerationSecondsValidation(t *testing.T) {
+	seconds := func(value int64) *int64 { return &value }
+
+	cases := []struct {
+		testName       string
+		modify         func(*APIManager)
+		expectedErrors int
+	}{
+		{"WithoutSeconds", func(apimanager *APIManager) {}, 0},
+		{"WithGlobalSeconds", func(apimanager *APIManager) { apimanager.Spec.UnreachableTolerationSeconds = seconds(300) }, 0},
+		{"WithGlobalMinimum", func(apimanager *APIManager) {
+			apimanager.Spec.UnreachableTolerationSeconds = seconds(MinUnreachableTolerationSeconds)
+		}, 0},
+		{"WithGlobalBelowMinimum", func(apimanager *APIManager) { apimanager.Spec.UnreachableTolerationSeconds = seconds(5) }, 1},
+		{"WithComponentOverride", func(apimanager *APIManager) {
+			apimanager.Spec.Backend = &BackendSpec{ListenerSpec: &BackendListenerSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{UnreachableTolerationSeconds: seconds(60)}}}
+		}, 0},
+		{"WithComponentsBelowMinimum", func(apimanager *APIManager) {
+			apimanager.Spec.Apicast = &ApicastSpec{ProductionSpec: &ApicastProductionSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{UnreachableTolerationSeconds: seconds(0)}}}
+			apimanager.Spec.Zync = &ZyncSpec{QueSpec: &ZyncQueSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{UnreachableTolerationSeconds: seconds(-1)}}}
+		}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			tc.modify(apimanager)
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestRouteHostsValidation(t *testing.T) {
+	longLabel := strings.Repeat("a", 60)
+	longDomain := strings.Repeat(longLabel+".", 4) + "com"
+
+	cases := []struct {
+		testName       string
+		wildcardDomain string
+		tenantName     string
+		expectedErrors int
+	}{
+		{"WithShortDomain", "example.com", "", 0},
+		{"WithTrailingDot", "example.com.", "", 0},
+		{"WithLongTenantLabel", "example.com", longLabel, 4},
+		{"WithLongDomain", longDomain, "", 6},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.WildcardDomain = tc.wildcardDomain
+			if tc.tenantName != "" {
+				apimanager.Spec.TenantName = &tc.tenantName
+			}
+			fieldErrors := apimanager.ValidateRouteHosts()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestSetDefaultsNormalizesWildcardDomain(t *testing.T) {
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.WildcardDomain = "Example.COM."
+
+	if _, err := apimanager.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+	if apimanager.Spec.WildcardDomain != "example.com" {
+		t.Errorf("unexpected wildcardDomain: %s", apimanager.Spec.WildcardDomain)
+	}
+	if hosts := apimanager.DefaultRouteHosts(); hosts[3] != "master.example.com" {
+		t.Errorf("unexpected master host: %s", hosts[3])
+	}
+}
+
+func TestMasterRouteDisabledDefaultRouteHosts(t *testing.T) {
+	falseValue := false
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.System = &SystemSpec{
+		MasterRoute: &SystemMasterRouteSpec{Enabled: &falseValue},
+	}
+
+	if apimanager.IsSystemMasterRouteEnabled() {
+		t.Fatal("expected master route to be disabled")
+	}
+	for _, host := range apimanager.DefaultRouteHosts() {
+		if strings.HasPrefix(host, "master.") {
+			t.Errorf("unexpected master host: %s", host)
+		}
+	}
+}
+
+func TestServiceAccountImagePullSecrets(t *testing.T) {
+	defaults := []v1.LocalObjectReference{{Name: "threescale-registry-auth"}}
+	mirror := v1.LocalObjectReference{Name: "mirror-auth"}
+	replace := ImagePullSecretsPolicyReplace
+	merge := ImagePullSecretsPolicyMerge
+
+	cases := []struct {
+		name     string
+		secrets  []v1.LocalObjectReference
+		policy   *string
+		expected []v1.LocalObjectReference
+	}{
+		{"nil secrets", nil, nil, defaults},
+		{"nil secrets merged", nil, &merge, defaults},
+		{"empty secrets replaced", []v1.LocalObjectReference{}, nil, []v1.LocalObjectReference{}},
+		{"empty secrets merged", []v1.LocalObjectReference{}, &merge, defaults},
+		{"replaced by default", []v1.LocalObjectReference{mirror}, nil, []v1.LocalObjectReference{mirror}},
+		{"replaced", []v1.LocalObjectReference{mirror}, &replace, []v1.LocalObjectReference{mirror}},
+		{"merged", []v1.LocalObjectReference{mirror}, &merge, []v1.LocalObjectReference{defaults[0], mirror}},
+		{"merged overlapping", []v1.LocalObjectReference{mirror, defaults[0]}, &merge, []v1.LocalObjectReference{defaults[0], mirror}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.ImagePullSecrets = tc.secrets
+			apimanager.Spec.ImagePullSecretsPolicy = tc.policy
+
+			result := apimanager.ServiceAccountImagePullSecrets(defaults)
+			if !reflect.DeepEqual(result, tc.expected) {
+				subT.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestComponentImagePullSecrets(t *testing.T) {
+	defaults := []v1.LocalObjectReference{{Name: "threescale-registry-auth"}}
+	global := []v1.LocalObjectReference{{Name: "global-auth"}}
+	gateway := v1.LocalObjectReference{Name: "gateway-auth"}
+	merge := ImagePullSecretsPolicyMerge
+
+	cases := []struct {
+		name             string
+		componentSecrets []v1.LocalObjectReference
+		policy           *string
+		expected         []v1.LocalObjectReference
+	}{
+		{"not set", nil, nil, nil},
+		{"not set merged", nil, &merge, nil},
+		{"replaced", []v1.LocalObjectReference{gateway}, nil, []v1.LocalObjectReference{gateway}},
+		{"merged", []v1.LocalObjectReference{gateway}, &merge, []v1.LocalObjectReference{defaults[0], gateway}},
+		{"empty replaced", []v1.LocalObjectReference{}, nil, []v1.LocalObjectReference{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			// The global image pull secrets are overridden by the component ones
+			apimanager.Spec.ImagePullSecrets = global
+			apimanager.Spec.ImagePullSecretsPolicy = tc.policy
+
+			result := apimanager.ComponentImagePullSecrets(defaults, tc.componentSecrets)
+			if !reflect.DeepEqual(result, tc.expected) {
+				subT.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestComponentMonitoringEnabled(t *testing.T) {
+	falseValue := false
+
+	cases := []struct {
+		testName           string
+		monitoringSpec     *MonitoringSpec
+		expectedMetrics    bool
+		expectedMonitoring bool
+		expectedRules      bool
+	}{
+		{"WithoutMonitoring", nil, true, false, false},
+		{"MonitoringDisabled", &MonitoringSpec{Enabled: false}, true, false, false},
+		{"MonitoringEnabled", &MonitoringSpec{Enabled: true}, true, true, true},
+		{"RulesDisabled", &MonitoringSpec{Enabled: true, EnablePrometheusRules: &falseValue}, true, true, false},
+		{"ZyncDisabled", &MonitoringSpec{Enabled: true, Zync: &falseValue}, false, false, false},
+		{"ZyncDisabledWithoutMonitoring", &MonitoringSpec{Zync: &falseValue}, false, false, false},
+		{"OtherComponentDisabled", &MonitoringSpec{Enabled: true, Backend: &falseValue}, true, true, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Monitoring = tc.monitoringSpec
+
+			if res := apimanager.IsComponentMetricsEnabled("zync"); res != tc.expectedMetrics {
+				subT.Errorf("metrics: expected %t, got %t", tc.expectedMetrics, res)
+			}
+			if res := apimanager.IsComponentMonitoringEnabled("zync"); res != tc.expectedMonitoring {
+				subT.Errorf("monitoring: expected %t, got %t", tc.expectedMonitoring, res)
+			}
+			if res := apimanager.IsComponentPrometheusRulesEnabled("zync"); res != tc.expectedRules {
+				subT.Errorf("prometheus rules: expected %t, got %t", tc.expectedRules, res)
+			}
+			// Monitoring objects shared by all the components follow the global setting
+			if res := apimanager.IsComponentMonitoringEnabled(""); res != apimanager.IsMonitoringEnabled() {
+				subT.Errorf("shared monitoring: expected %t, got %t", apimanager.IsMonitoringEnabled(), res)
+			}
+		})
+	}
+}
+
+func gatewayOnlyAPIManagerTest() *APIManager {
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.GatewayOnly = &GatewayOnlySpec{
+		PortalEndpointSecretRef: v1.LocalObjectReference{Name: "portal-endpoint"},
+	}
+	return apimanager
+}
+
+func TestGatewayOnlyValidation(t *testing.T) {
+	standby := APIManagerModeStandby
+
+	cases := []struct {
+		testName       string
+		mutate         func(*APIManager)
+		expectedErrors int
+	}{
+		{"WithPortalEndpointSecret", func(a *APIManager) {}, 0},
+		{"WithoutPortalEndpointSecret", func(a *APIManager) { a.Spec.GatewayOnly.PortalEndpointSecretRef.Name = "" }, 1},
+		{"WithSystem", func(a *APIManager) { a.Spec.System = &SystemSpec{} }, 1},
+		{"WithBackendAndZync", func(a *APIManager) {
+			a.Spec.Backend = &BackendSpec{}
+			a.Spec.Zync = &ZyncSpec{}
+		}, 2},
+		{"WithStandbyMode", func(a *APIManager) { a.Spec.Mode = &standby }, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := gatewayOnlyAPIManagerTest()
+			tc.mutate(apimanager)
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func externalBackendAPIManagerTest() *APIManager {
+	trueValue := true
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.ExternalBackend = &ExternalBackendSpec{
+		ListenerEndpoint:     "http://backend-listener.example.com:3000",
+		InternalAPISecretRef: v1.LocalObjectReference{Name: "backend-internal-api"},
+	}
+	apimanager.Spec.ExternalComponents = &ExternalComponentsSpec{
+		Backend: &ExternalBackendComponents{Redis: &trueValue},
+	}
+	return apimanager
+}
+
+func TestExternalBackendValidation(t *testing.T) {
+	redisImage := "redis"
+
+	cases := []struct {
+		testName       string
+		mutate         func(*APIManager)
+		expectedErrors int
+	}{
+		{"WithExternalBackendRedis", func(a *APIManager) {}, 0},
+		{"WithoutInternalAPISecret", func(a *APIManager) { a.Spec.ExternalBackend.InternalAPISecretRef.Name = "" }, 1},
+		{"WithInternalBackendRedis", func(a *APIManager) { a.Spec.ExternalComponents = nil }, 1},
+		{"WithBackendRedisSettings", func(a *APIManager) {
+			a.Spec.Backend = &BackendSpec{
+				RedisImage:     &redisImage,
+				RedisResources: &v1.ResourceRequirements{},
+			}
+		}, 2},
+		{"WithGatewayOnly", func(a *APIManager) {
+			a.Spec.GatewayOnly = &GatewayOnlySpec{
+				PortalEndpointSecretRef: v1.LocalObjectReference{Name: "portal-endpoint"},
+			}
+			a.Spec.ExternalComponents = nil
+		}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := externalBackendAPIManagerTest()
+			tc.mutate(apimanager)
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestExternalBackendRouteHosts(t *testing.T) {
+	apimanager := externalBackendAPIManagerTest()
+
+	if _, err := apimanager.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedHosts := []string{
+		"api-3scale-apicast-production.test.3scale.com",
+		"api-3scale-apicast-staging.test.3scale.com",
+		"master.test.3scale.com",
+		"3scale.test.3scale.com",
+		"3scale-admin.test.3scale.com",
+	}
+	if diff := cmp.Diff(expectedHosts, apimanager.DefaultRouteHosts()); diff != "" {
+		t.Errorf("unexpected route hosts (-want +got):\n%s", diff)
+	}
+
+	if endpoint := apimanager.ExternalBackendRouteEndpoint(); endpoint != apimanager.Spec.ExternalBackend.ListenerEndpoint {
+		t.Errorf("expected the listener endpoint as route endpoint, got %s", endpoint)
+	}
+}
+
+func TestTopologyChangeValidation(t *testing.T) {
+	cases := []struct {
+		testName       string
+		apimanager     *APIManager
+		status         APIManagerStatus
+		expectedErrors int
+	}{
+		{"NewFullInstall", minimumAPIManagerTest(), APIManagerStatus{}, 0},
+		{"NewGatewayOnlyInstall", gatewayOnlyAPIManagerTest(), APIManagerStatus{}, 0},
+		{"SameTopology", gatewayOnlyAPIManagerTest(), APIManagerStatus{Topology: TopologyGatewayOnly}, 0},
+		{"FullToGatewayOnly", gatewayOnlyAPIManagerTest(), APIManagerStatus{Topology: TopologyFull}, 1},
+		{"GatewayOnlyToFull", minimumAPIManagerTest(), APIManagerStatus{Topology: TopologyGatewayOnly}, 1},
+		{"LegacyFullToGatewayOnly", gatewayOnlyAPIManagerTest(), APIManagerStatus{
+			Conditions: common.Conditions{{Type: APIManagerAvailableConditionType, Status: v1.ConditionTrue}},
+		}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			tc.apimanager.Status = tc.status
+			fieldErrors := tc.apimanager.ValidateTopologyChange()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestGatewayOnlySetDefaults(t *testing.T) {
+	apimanager := gatewayOnlyAPIManagerTest()
+
+	if _, err := apimanager.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+	if apimanager.Spec.Apicast == nil {
+		t.Error("expected apicast defaults")
+	}
+	if apimanager.Spec.System != nil || apimanager.Spec.Backend != nil || apimanager.Spec.Zync != nil {
+		t.Error("unexpected control plane defaults in gateway only mode")
+	}
+	if apimanager.Topology() != TopologyGatewayOnly {
+		t.Errorf("unexpected topology: %s", apimanager.Topology())
+	}
+
+	expectedHosts := []string{
+		"api-3scale-apicast-production.test.3scale.com",
+		"api-3scale-apicast-staging.test.3scale.com",
+	}
+	if diff := cmp.Diff(expectedHosts, apimanager.DefaultRouteHosts()); diff != "" {
+		t.Errorf("unexpected route hosts (-want +got):\n%s", diff)
+	}
+}
+
+func TestGrafanaDashboardLabelsValidation(t *testing.T) {
+	cases := []struct {
+		testName        string
+		dashboardLabels map[string]string
+		expectedErrors  int
+	}{
+		{"WithoutLabels", nil, 0},
+		{"WithValidLabels", map[string]string{"grafana": "dashboards", "example.com/team": "api"}, 0},
+		{"WithInvalidKey", map[string]string{"invalid key": "dashboards"}, 1},
+		{"WithInvalidValue", map[string]string{"grafana": "invalid value"}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Monitoring = &MonitoringSpec{
+				Enabled: true,
+				Grafana: &GrafanaSpec{DashboardLabels: tc.dashboardLabels},
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestPrometheusRuleOverridesValidation(t *testing.T) {
+	severity := "info"
+	emptySeverity := ""
+	threshold := "10.5"
+	invalidThreshold := "ten"
+
+	cases := []struct {
+		testName       string
+		overrides      []PrometheusRuleOverride
+		expectedErrors int
+	}{
+		{"WithoutOverrides", nil, 0},
+		{"WithValidOverrides", []PrometheusRuleOverride{
+			{AlertName: "ThreescaleApicastJobDown", Severity: &severity},
+			{AlertName: "ThreescaleZyncQueScheduledJobCountHigh", Threshold: &threshold},
+		}, 0},
+		{"WithoutAlertName", []PrometheusRuleOverride{{Severity: &severity}}, 1},
+		{"WithDuplicatedAlertName", []PrometheusRuleOverride{
+			{AlertName: "ThreescaleApicastJobDown", Severity: &severity},
+			{AlertName: "ThreescaleApicastJobDown", Threshold: &threshold},
+		}, 1},
+		{"WithEmptySeverity", []PrometheusRuleOverride{{AlertName: "ThreescaleApicastJobDown", Severity: &emptySeverity}}, 1},
+		{"WithInvalidThreshold", []PrometheusRuleOverride{{AlertName: "ThreescaleApicastJobDown", Threshold: &invalidThreshold}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Monitoring = &MonitoringSpec{
+				Enabled:         true,
+				PrometheusRules: &PrometheusRulesSpec{Overrides: tc.overrides},
+			}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestExtraEnvValidation(t *testing.T) {
+	secretRef := &v1.EnvVarSource{
+		SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "zync-flags"},
+			Key:                  "workers",
+		},
+	}
+
+	cases := []struct {
+		testName       string
+		env            []v1.EnvVar
+		expectedErrors int
+	}{
+		{"WithoutEnv", nil, 0},
+		{"WithValidEnv", []v1.EnvVar{
+			{Name: "RAILS_LOG_LEVEL", Value: "debug"},
+			{Name: "ZYNC_QUE_WORKERS", ValueFrom: secretRef},
+		}, 0},
+		{"WithoutName", []v1.EnvVar{{Value: "debug"}}, 1},
+		{"WithInvalidName", []v1.EnvVar{{Name: "1=A", Value: "debug"}}, 1},
+		{"WithReservedName", []v1.EnvVar{{Name: "DATABASE_URL", Value: "postgresql://db"}}, 1},
+		{"WithDuplicatedName", []v1.EnvVar{
+			{Name: "RAILS_LOG_LEVEL", Value: "debug"},
+			{Name: "RAILS_LOG_LEVEL", Value: "info"},
+		}, 1},
+		{"WithValueAndValueFrom", []v1.EnvVar{{Name: "ZYNC_QUE_WORKERS", Value: "2", ValueFrom: secretRef}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Zync = &ZyncSpec{QueSpec: &ZyncQueSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{Env: tc.env}}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+
+	// Operator managed env vars are reserved per component
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.System = &SystemSpec{AppSpec: &SystemAppSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{Env: []v1.EnvVar{{Name: "RAILS_LOG_LEVEL", Value: "debug"}}}}}
+	fieldErrors := apimanager.Validate()
+	if len(fieldErrors) != 1 || fieldErrors[0].Type != field.ErrorTypeForbidden || !strings.Contains(fieldErrors[0].Detail, "DATABASE_URL") {
+		t.Errorf("Expected the reserved names error, got %v", fieldErrors)
+	}
+
+	apimanager = minimumAPIManagerTest()
+	apimanager.Spec.System = &SystemSpec{SphinxSpec: &SystemSphinxSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{Env: []v1.EnvVar{{Name: "THINKING_SPHINX_PID_FILE", Value: "/tmp/searchd.pid"}}}}}
+	fieldErrors = apimanager.Validate()
+	if len(fieldErrors) != 1 || fieldErrors[0].Field != "spec.system.sphinxSpec.env[0].name" {
+		t.Errorf("Expected the sphinx reserved name error, got %v", fieldErrors)
+	}
+}
+
+func TestSidecarsValidation(t *testing.T) {
+	proxy := v1.Container{Name: "cloud-sql-proxy", Image: "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.1.0"}
+	credentials := v1.Volume{Name: "sql-credentials", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "sql"}}}
+
+	cases := []struct {
+		testName       string
+		containers     []v1.Container
+		volumes        []v1.Volume
+		expectedErrors int
+	}{
+		{"WithoutSidecars", nil, nil, 0},
+		{"WithValidSidecars", []v1.Container{proxy}, []v1.Volume{credentials}, 0},
+		{"WithoutName", []v1.Container{{Image: proxy.Image}}, nil, 1},
+		{"WithInvalidName", []v1.Container{{Name: "Proxy", Image: proxy.Image}}, nil, 1},
+		{"WithDuplicatedName", []v1.Container{proxy, proxy}, nil, 1},
+		{"WithoutImage", []v1.Container{{Name: "proxy"}}, nil, 1},
+		{"WithDuplicatedVolumeName", []v1.Container{proxy}, []v1.Volume{credentials, credentials}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.System = &SystemSpec{AppSpec: &SystemAppSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{Sidecars: tc.containers, SidecarVolumes: tc.volumes}}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+
+	apimanager := minimumAPIManagerTest()
+	apimanager.Spec.Zync = &ZyncSpec{AppSpec: &ZyncAppSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{InitContainers: []v1.Container{proxy, {Name: "proxy"}}}}}
+	fieldErrors := apimanager.Validate()
+	if len(fieldErrors) != 1 || fieldErrors[0].Field != "spec.zync.appSpec.initContainers[1].image" {
+		t.Errorf("Expected the init container image error, got %v", fieldErrors)
+	}
+}
+
+func TestHorizontalPodAutoscalerValidation(t *testing.T) {
+	var (
+		replicas    int64 = 2
+		minReplicas int32 = 3
+		zero        int32 = 0
+	)
+
+	cases := []struct {
+		testName       string
+		replicas       *int64
+		hpa            *HorizontalPodAutoscalerSpec
+		expectedErrors int
+	}{
+		{"WithoutHPA", &replicas, nil, 0},
+		{"WithValidHPA", nil, &HorizontalPodAutoscalerSpec{MinReplicas: &minReplicas, MaxReplicas: 3}, 0},
+		{"WithReplicas", &replicas, &HorizontalPodAutoscalerSpec{MaxReplicas: 3}, 1},
+		{"WithMaxLowerThanMin", nil, &HorizontalPodAutoscalerSpec{MinReplicas: &minReplicas, MaxReplicas: 2}, 1},
+		{"WithoutMax", nil, &HorizontalPodAutoscalerSpec{}, 1},
+		{"WithZeroTarget", nil, &HorizontalPodAutoscalerSpec{MaxReplicas: 3, CPUTargetUtilization: &zero}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Apicast = &ApicastSpec{ProductionSpec: &ApicastProductionSpec{Replicas: tc.replicas, HPA: tc.hpa}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestRuntimeTuningValidation(t *testing.T) {
+	var (
+		arenas     int32 = 2
+		zeroArenas int32 = 0
+		slots      int64 = 600000
+		zeroSlots  int64 = 0
+	)
+	factor := "1.25"
+	lowFactor := "1"
+	enabled := true
+
+	cases := []struct {
+		testName       string
+		tuning         *RubyRuntimeTuningSpec
+		env            []v1.EnvVar
+		expectedErrors int
+	}{
+		{"WithoutTuning", nil, []v1.EnvVar{{Name: "MALLOC_ARENA_MAX", Value: "2"}}, 0},
+		{"WithValidTuning", &RubyRuntimeTuningSpec{MallocArenaMax: &arenas, GCHeapGrowthFactor: &factor, GCHeapInitSlots: &slots, RubyYJIT: &enabled}, nil, 0},
+		{"WithZeroArenas", &RubyRuntimeTuningSpec{MallocArenaMax: &zeroArenas}, nil, 1},
+		{"WithZeroSlots", &RubyRuntimeTuningSpec{GCHeapInitSlots: &zeroSlots}, nil, 1},
+		{"WithLowGrowthFactor", &RubyRuntimeTuningSpec{GCHeapGrowthFactor: &lowFactor}, nil, 1},
+		{"WithTunedEnv", &RubyRuntimeTuningSpec{MallocArenaMax: &arenas}, []v1.EnvVar{{Name: "MALLOC_ARENA_MAX", Value: "4"}}, 1},
+		{"WithOtherTunedEnv", &RubyRuntimeTuningSpec{MallocArenaMax: &arenas}, []v1.EnvVar{{Name: "RUBY_YJIT_ENABLE", Value: "1"}}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.System = &SystemSpec{SidekiqSpec: &SystemSidekiqSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{Env: tc.env}, RuntimeTuning: tc.tuning}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestDatabaseSecurityContextsValidation(t *testing.T) {
+	withFSGroup := &v1.PodSecurityContext{FSGroup: &[]int64{1000650000}[0]}
+	withoutFSGroup := &v1.PodSecurityContext{RunAsUser: &[]int64{1000650000}[0]}
+	trueVal := true
+
+	cases := []struct {
+		testName       string
+		set            func(*APIManager)
+		expectedErrors int
+	}{
+		{"WithoutSecurityContexts", func(apimanager *APIManager) {}, 0},
+		{"WithFSGroup", func(apimanager *APIManager) {
+			apimanager.Spec.Backend = &BackendSpec{RedisPodSecurityContext: withFSGroup}
+			apimanager.Spec.System = &SystemSpec{
+				RedisPodSecurityContext: withFSGroup,
+				DatabaseSpec:            &SystemDatabaseSpec{MySQL: &SystemMySQLSpec{PodSecurityContext: withFSGroup}},
+			}
+			apimanager.Spec.Zync = &ZyncSpec{DatabasePodSecurityContext: withFSGroup, DatabaseStorage: &ZyncDatabaseStorageSpec{}}
+		}, 0},
+		{"WithoutFSGroup", func(apimanager *APIManager) {
+			apimanager.Spec.Backend = &BackendSpec{RedisPodSecurityContext: withoutFSGroup}
+			apimanager.Spec.System = &SystemSpec{
+				RedisPodSecurityContext: withoutFSGroup,
+				DatabaseSpec:            &SystemDatabaseSpec{PostgreSQL: &SystemPostgreSQLSpec{PodSecurityContext: withoutFSGroup}},
+			}
+			apimanager.Spec.Zync = &ZyncSpec{DatabasePodSecurityContext: withoutFSGroup, DatabaseStorage: &ZyncDatabaseStorageSpec{}}
+		}, 4},
+		{"ZyncDatabaseWithEphemeralStorage", func(apimanager *APIManager) {
+			apimanager.Spec.Zync = &ZyncSpec{DatabasePodSecurityContext: withoutFSGroup}
+		}, 0},
+		{"ExternalDatabases", func(apimanager *APIManager) {
+			apimanager.Spec.ExternalComponents = &ExternalComponentsSpec{
+				System: &ExternalSystemComponents{Redis: &trueVal, Database: &trueVal},
+			}
+			apimanager.Spec.System = &SystemSpec{
+				RedisPodSecurityContext: withoutFSGroup,
+				DatabaseSpec:            &SystemDatabaseSpec{MySQL: &SystemMySQLSpec{PodSecurityContext: withoutFSGroup}},
+			}
+		}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			tc.set(apimanager)
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Fatalf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+			for _, fieldError := range fieldErrors {
+				if fieldError.Type != field.ErrorTypeRequired || !strings.HasSuffix(fieldError.Field, "fsGroup") {
+					subT.Errorf("Expected the fsGroup required error, got %v", fieldError)
+				}
+			}
+		})
+	}
+}
+
+func TestDatabaseBackupValidation(t *testing.T) {
+	cases := []struct {
+		testName       string
+		backup         *DatabaseBackupSpec
+		expectedErrors int
+	}{
+		{"Default", &DatabaseBackupSpec{Enabled: true}, 0},
+		{"WithPVC", &DatabaseBackupSpec{Enabled: true, PersistentVolumeClaim: &DatabaseBackupPVCSpec{}}, 0},
+		{"WithS3", &DatabaseBackupSpec{Enabled: true, S3: &DatabaseBackupS3Spec{SecretRef: v1.LocalObjectReference{Name: "backup-s3"}}}, 0},
+		{"WithPVCAndS3", &DatabaseBackupSpec{
+			Enabled:               true,
+			PersistentVolumeClaim: &DatabaseBackupPVCSpec{},
+			S3:                    &DatabaseBackupS3Spec{SecretRef: v1.LocalObjectReference{Name: "backup-s3"}},
+		}, 1},
+		{"WithS3WithoutSecretName", &DatabaseBackupSpec{Enabled: true, S3: &DatabaseBackupS3Spec{}}, 1},
+		{"WithZeroRetention", &DatabaseBackupSpec{Enabled: true, Retention: &[]int32{0}[0]}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Databases = &DatabasesSpec{Backup: tc.backup}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestApicastPreStopCommandValidation(t *testing.T) {
+	var delay int64 = 15
+	preStopCommand := []string{"sleep", "10"}
+
+	cases := []struct {
+		testName       string
+		apicastSpec    *ApicastSpec
+		expectedErrors int
+	}{
+		{"WithPreStopCommand", &ApicastSpec{
+			ProductionSpec: &ApicastProductionSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{PreStopCommand: preStopCommand}},
+			StagingSpec:    &ApicastStagingSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{PreStopCommand: preStopCommand}},
+		}, 0},
+		{"WithLBDeregistrationDelay", &ApicastSpec{
+			ProductionSpec: &ApicastProductionSpec{LBDeregistrationDelaySeconds: &delay},
+		}, 0},
+		{"WithConflictingProduction", &ApicastSpec{
+			ProductionSpec: &ApicastProductionSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{PreStopCommand: preStopCommand}, LBDeregistrationDelaySeconds: &delay},
+		}, 1},
+		{"WithConflictingStaging", &ApicastSpec{
+			StagingSpec: &ApicastStagingSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{PreStopCommand: preStopCommand}, LBDeregistrationDelaySeconds: &delay},
+		}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Apicast = tc.apicastSpec
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestDNSValidation(t *testing.T) {
+	nonePolicy := v1.DNSNone
+	clusterFirstPolicy := v1.DNSClusterFirst
+	ndots := "2"
+
+	cases := []struct {
+		testName       string
+		dnsPolicy      *v1.DNSPolicy
+		dnsConfig      *v1.PodDNSConfig
+		expectedErrors int
+	}{
+		{"WithoutDNS", nil, nil, 0},
+		{"WithSearches", &clusterFirstPolicy, &v1.PodDNSConfig{
+			Searches: []string{"db.example.internal"},
+			Options:  []v1.PodDNSConfigOption{{Name: "ndots", Value: &ndots}},
+		}, 0},
+		{"WithNoneAndNameservers", &nonePolicy, &v1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}}, 0},
+		{"WithNoneWithoutNameservers", &nonePolicy, nil, 1},
+		{"WithInvalidNameserver", nil, &v1.PodDNSConfig{Nameservers: []string{"dns.example.internal"}}, 1},
+		{"WithoutOptionName", nil, &v1.PodDNSConfig{Options: []v1.PodDNSConfigOption{{Value: &ndots}}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Zync = &ZyncSpec{QueSpec: &ZyncQueSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{DNSPolicy: tc.dnsPolicy, DNSConfig: tc.dnsConfig}}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestHostAliasesValidation(t *testing.T) {
+	cases := []struct {
+		testName       string
+		hostAliases    []v1.HostAlias
+		expectedErrors int
+	}{
+		{"WithoutHostAliases", nil, 0},
+		{"WithValidHostAliases", []v1.HostAlias{{IP: "10.0.0.20", Hostnames: []string{"sso.example.internal", "idp.example.internal"}}}, 0},
+		{"WithInvalidIP", []v1.HostAlias{{IP: "sso", Hostnames: []string{"sso.example.internal"}}}, 1},
+		{"WithoutHostnames", []v1.HostAlias{{IP: "10.0.0.20"}}, 1},
+		{"WithInvalidHostname", []v1.HostAlias{{IP: "10.0.0.20", Hostnames: []string{"SSO_host"}}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.Apicast = &ApicastSpec{ProductionSpec: &ApicastProductionSpec{PodTemplateOverridesSpec: PodTemplateOverridesSpec{HostAliases: tc.hostAliases}}}
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestIsRestrictedPodSecurityStandard(t *testing.T) {
+	restricted := PodSecurityStandardRestricted
+	privileged := PodSecurityStandardPrivileged
+
+	cases := []struct {
+		testName            string
+		podSecurityStandard *string
+		expected            bool
+	}{
+		{"NotSet", nil, false},
+		{"Restricted", &restricted, true},
+		{"Privileged", &privileged, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.PodSecurityStandard = tc.podSecurityStandard
+			if apimanager.IsRestrictedPodSecurityStandard() != tc.expected {
+				subT.Errorf("Expected %t, got %t", tc.expected, apimanager.IsRestrictedPodSecurityStandard())
+			}
+		})
+	}
+}
+
+func TestImagePullPolicy(t *testing.T) {
+	always := v1.PullAlways
+	never := v1.PullNever
+
+	cases := []struct {
+		testName        string
+		globalPolicy    *v1.PullPolicy
+		componentPolicy *v1.PullPolicy
+		expected        *v1.PullPolicy
+	}{
+		{"NotSet", nil, nil, nil},
+		{"Global", &always, nil, &always},
+		{"Component", nil, &never, &never},
+		{"ComponentOverridesGlobal", &always, &never, &never},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.ImagePullPolicy = tc.globalPolicy
+			if diff := cmp.Diff(tc.expected, apimanager.ImagePullPolicy(tc.componentPolicy)); diff != "" {
+				subT.Errorf("Unexpected image pull policy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountsValidation(t *testing.T) {
+	validName := "threescale-workloads"
+	invalidName := "Threescale_Workloads"
+	ampName := AmpServiceAccountName
+
+	cases := []struct {
+		testName        string
+		serviceAccounts *ServiceAccountsSpec
+		expectedErrors  int
+	}{
+		{"WithoutServiceAccounts", nil, 0},
+		{"WithValidName", &ServiceAccountsSpec{Amp: &ServiceAccountSpec{Name: &validName}}, 0},
+		{"WithInvalidName", &ServiceAccountsSpec{ZyncQue: &ServiceAccountSpec{Name: &invalidName}}, 1},
+		{"WithSharedName", &ServiceAccountsSpec{ZyncQue: &ServiceAccountSpec{Name: &ampName}}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := minimumAPIManagerTest()
+			apimanager.Spec.ServiceAccounts = tc.serviceAccounts
+			fieldErrors := apimanager.Validate()
+			if len(fieldErrors) != tc.expectedErrors {
+				subT.Errorf("Expected %d errors, got %d: %v", tc.expectedErrors, len(fieldErrors), fieldErrors)
+			}
+		})
+	}
+}
+
+func TestServiceAccountName(t *testing.T) {
+	name := "threescale-workloads"
+	annotations := map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/threescale"}
+
+	apimanager := minimumAPIManagerTest()
+	if apimanager.ServiceAccountName(AmpServiceAccountName) != AmpServiceAccountName {
+		t.Errorf("Expected default name %s, got %s", AmpServiceAccountName, apimanager.ServiceAccountName(AmpServiceAccountName))
+	}
+
+	apimanager.Spec.ServiceAccounts = &ServiceAccountsSpec{Amp: &ServiceAccountSpec{Name: &name, Annotations: annotations}}
+	if apimanager.ServiceAccountName(AmpServiceAccountName) != name {
+		t.Errorf("Expected name %s, got %s", name, apimanager.ServiceAccountName(AmpServiceAccountName))
+	}
+	if !reflect.DeepEqual(apimanager.ServiceAccountAnnotations(AmpServiceAccountName), annotations) {
+		t.Errorf("Unexpected annotations %v", apimanager.ServiceAccountAnnotations(AmpServiceAccountName))
+	}
+	if apimanager.ServiceAccountName(ZyncQueServiceAccountName) != ZyncQueServiceAccountName {
+		t.Errorf("Expected default name %s, got %s", ZyncQueServiceAccountName, apimanager.ServiceAccountName(ZyncQueServiceAccountName))
+	}
+	if apimanager.ServiceAccountAnnotations(ZyncQueServiceAccountName) != nil {
+		t.Errorf("Unexpected annotations %v", apimanager.ServiceAccountAnnotations(ZyncQueServiceAccountName))
+	}
+}
+
+func TestReferencedSecretNames(t *testing.T) {
+	apimanager := minimumAPIManagerTest()
+	if names := apimanager.ReferencedSecretNames(); len(names) != 0 {
+		t.Errorf("Expected no referenced secrets, got %v", names)
+	}
+
+	apimanager.Spec.System = &SystemSpec{
+		InboundEmail: &SystemInboundEmailSpec{CredentialsSecretRef: v1.LocalObjectReference{Name: "inbound-email"}},
+	}
+	apimanager.Spec.Apicast = &ApicastSpec{
+		ProductionSpec: &ApicastProductionSpec{
+			ClientTLS: &APIcastClientTLSSpec{CASecretRef: &v1.LocalObjectReference{Name: "client-ca"}},
+		},
+	}
+	expected := []string{"client-ca", "inbound-email"}
+	if names := apimanager.ReferencedSecretNames(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected referenced secrets %v, got %v", expected, names)
+	}
+}
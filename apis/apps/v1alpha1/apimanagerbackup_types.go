@@ -92,6 +92,19 @@ type APIManagerBackupStatus struct {
 	// PersistentVolumeClaim is used as the backup data destination
 	// +optional
 	BackupPersistentVolumeClaimName *string `json:"backupPersistentVolumeClaimName,omitempty"`
+
+	// SHA-256 checksums of the backed up artifacts. The checksums of the
+	// files of each artifact are stored along with the backup data
+	// +optional
+	Checksums []APIManagerBackupArtifactChecksum `json:"checksums,omitempty"`
+}
+
+// APIManagerBackupArtifactChecksum defines the checksum of a backed up artifact
+type APIManagerBackupArtifactChecksum struct {
+	// Artifact name. I.e. secrets, configmaps, apimanager or system-filestorage-pvc
+	Artifact string `json:"artifact"`
+	// SHA256 checksum of the list of the artifact file checksums
+	SHA256 string `json:"sha256"`
 }
 
 // +kubebuilder:object:root=true
@@ -30,6 +30,17 @@ type APIManagerRestoreSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	RestoreSource APIManagerRestoreSource `json:"restoreSource"`
+
+	// Verify performs a restore rehearsal instead of a restore. The backup
+	// data checksums are verified and the backed up objects are checked to
+	// be restorable, without modifying the installation
+	// +optional
+	Verify *bool `json:"verify,omitempty"`
+
+	// JobsTemplate sets the scheduling settings of the pods of the restore jobs.
+	// The APIManager is restored by the jobs, so its settings do not apply
+	// +optional
+	JobsTemplate *JobsTemplateSpec `json:"jobsTemplate,omitempty"`
 }
 
 // APIManagerRestoreSource defines the backup data restore source
@@ -75,6 +86,20 @@ type APIManagerRestoreStatus struct {
 	// Restore completion time. It is represented in RFC3339 form and is in UTC.
 	// +optional
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Result of the restore rehearsal. Only set when verify is enabled
+	// +optional
+	Verification *APIManagerRestoreVerificationStatus `json:"verification,omitempty"`
+}
+
+// APIManagerRestoreVerificationStatus defines the result of a restore rehearsal
+type APIManagerRestoreVerificationStatus struct {
+	// Set to true when the backup data has been verified
+	Verified bool `json:"verified"`
+	// Verified artifacts or the reason of the verification failure,
+	// naming the failed artifact
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -103,6 +128,10 @@ func (a *APIManagerRestore) MainStepsCompleted() bool {
 	return a.Status.MainStepsCompleted != nil && *a.Status.MainStepsCompleted
 }
 
+func (a *APIManagerRestore) VerifyOnly() bool {
+	return a.Spec.Verify != nil && *a.Spec.Verify
+}
+
 // +kubebuilder:object:root=true
 
 // APIManagerRestoreList contains a list of APIManagerRestore
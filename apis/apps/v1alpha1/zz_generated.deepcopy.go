@@ -25,6 +25,7 @@ import (
 	"github.com/3scale/3scale-operator/pkg/common"
 	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -81,6 +82,21 @@ func (in *APIManagerBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIManagerBackupArtifactChecksum) DeepCopyInto(out *APIManagerBackupArtifactChecksum) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerBackupArtifactChecksum.
+func (in *APIManagerBackupArtifactChecksum) DeepCopy() *APIManagerBackupArtifactChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(APIManagerBackupArtifactChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIManagerBackupDestination) DeepCopyInto(out *APIManagerBackupDestination) {
 	*out = *in
@@ -180,6 +196,11 @@ func (in *APIManagerBackupStatus) DeepCopyInto(out *APIManagerBackupStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Checksums != nil {
+		in, out := &in.Checksums, &out.Checksums
+		*out = make([]APIManagerBackupArtifactChecksum, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerBackupStatus.
@@ -200,6 +221,13 @@ func (in *APIManagerCommonSpec) DeepCopyInto(out *APIManagerCommonSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.TenantName != nil {
 		in, out := &in.TenantName, &out.TenantName
 		*out = new(string)
@@ -220,6 +248,46 @@ func (in *APIManagerCommonSpec) DeepCopyInto(out *APIManagerCommonSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImagePullSecretsPolicy != nil {
+		in, out := &in.ImagePullSecretsPolicy, &out.ImagePullSecretsPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageRegistryOverride != nil {
+		in, out := &in.ImageRegistryOverride, &out.ImageRegistryOverride
+		*out = new(ImageRegistryOverrideSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerminationMessagePolicy != nil {
+		in, out := &in.TerminationMessagePolicy, &out.TerminationMessagePolicy
+		*out = new(v1.TerminationMessagePolicy)
+		**out = **in
+	}
+	if in.PodSecurityStandard != nil {
+		in, out := &in.PodSecurityStandard, &out.PodSecurityStandard
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(v1.PullPolicy)
+		**out = **in
+	}
+	if in.UnreachableTolerationSeconds != nil {
+		in, out := &in.UnreachableTolerationSeconds, &out.UnreachableTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.JobsTemplate != nil {
+		in, out := &in.JobsTemplate, &out.JobsTemplate
+		*out = new(JobsTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = new(ServiceAccountsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerCommonSpec.
@@ -347,6 +415,16 @@ func (in *APIManagerRestoreSource) DeepCopy() *APIManagerRestoreSource {
 func (in *APIManagerRestoreSpec) DeepCopyInto(out *APIManagerRestoreSpec) {
 	*out = *in
 	in.RestoreSource.DeepCopyInto(&out.RestoreSource)
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JobsTemplate != nil {
+		in, out := &in.JobsTemplate, &out.JobsTemplate
+		*out = new(JobsTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerRestoreSpec.
@@ -385,6 +463,11 @@ func (in *APIManagerRestoreStatus) DeepCopyInto(out *APIManagerRestoreStatus) {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(APIManagerRestoreVerificationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerRestoreStatus.
@@ -397,6 +480,21 @@ func (in *APIManagerRestoreStatus) DeepCopy() *APIManagerRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIManagerRestoreVerificationStatus) DeepCopyInto(out *APIManagerRestoreVerificationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerRestoreVerificationStatus.
+func (in *APIManagerRestoreVerificationStatus) DeepCopy() *APIManagerRestoreVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIManagerRestoreVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIManagerSpec) DeepCopyInto(out *APIManagerSpec) {
 	*out = *in
@@ -431,16 +529,46 @@ func (in *APIManagerSpec) DeepCopyInto(out *APIManagerSpec) {
 		*out = new(ExternalComponentsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalBackend != nil {
+		in, out := &in.ExternalBackend, &out.ExternalBackend
+		*out = new(ExternalBackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PodDisruptionBudget != nil {
 		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
 		*out = new(PodDisruptionBudgetSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Monitoring != nil {
 		in, out := &in.Monitoring, &out.Monitoring
 		*out = new(MonitoringSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Shutdown != nil {
+		in, out := &in.Shutdown, &out.Shutdown
+		*out = new(ShutdownSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayOnly != nil {
+		in, out := &in.GatewayOnly, &out.GatewayOnly
+		*out = new(GatewayOnlySpec)
+		**out = **in
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = new(DatabasesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerSpec.
@@ -464,6 +592,60 @@ func (in *APIManagerStatus) DeepCopyInto(out *APIManagerStatus) {
 		}
 	}
 	in.Deployments.DeepCopyInto(&out.Deployments)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[string]ComponentStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Shutdown != nil {
+		in, out := &in.Shutdown, &out.Shutdown
+		*out = new(ShutdownStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Standby != nil {
+		in, out := &in.Standby, &out.Standby
+		*out = new(StandbyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendListenerRequestLogging != nil {
+		in, out := &in.BackendListenerRequestLogging, &out.BackendListenerRequestLogging
+		*out = new(RequestLoggingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminSSO != nil {
+		in, out := &in.AdminSSO, &out.AdminSSO
+		*out = new(AdminSSOStatus)
+		**out = **in
+	}
+	if in.AccessTokens != nil {
+		in, out := &in.AccessTokens, &out.AccessTokens
+		*out = make([]AccessTokenStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileStorageMigration != nil {
+		in, out := &in.FileStorageMigration, &out.FileStorageMigration
+		*out = new(FileStorageMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseBackup != nil {
+		in, out := &in.DatabaseBackup, &out.DatabaseBackup
+		*out = new(DatabaseBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIManagerStatus.
@@ -476,6 +658,56 @@ func (in *APIManagerStatus) DeepCopy() *APIManagerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIcastAWSLoadBalancerSpec) DeepCopyInto(out *APIcastAWSLoadBalancerSpec) {
+	*out = *in
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetGroupBindingName != nil {
+		in, out := &in.TargetGroupBindingName, &out.TargetGroupBindingName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIcastAWSLoadBalancerSpec.
+func (in *APIcastAWSLoadBalancerSpec) DeepCopy() *APIcastAWSLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastAWSLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIcastClientTLSSpec) DeepCopyInto(out *APIcastClientTLSSpec) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.VerifyDepth != nil {
+		in, out := &in.VerifyDepth, &out.VerifyDepth
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIcastClientTLSSpec.
+func (in *APIcastClientTLSSpec) DeepCopy() *APIcastClientTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastClientTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIcastOpenTracingSpec) DeepCopyInto(out *APIcastOpenTracingSpec) {
 	*out = *in
@@ -506,25 +738,111 @@ func (in *APIcastOpenTracingSpec) DeepCopy() *APIcastOpenTracingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIcastWarmupSpec) DeepCopyInto(out *APIcastWarmupSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]APIcastWarmupTargetSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIcastWarmupSpec.
+func (in *APIcastWarmupSpec) DeepCopy() *APIcastWarmupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastWarmupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIcastWarmupTargetSpec) DeepCopyInto(out *APIcastWarmupTargetSpec) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIcastWarmupTargetSpec.
+func (in *APIcastWarmupTargetSpec) DeepCopy() *APIcastWarmupTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastWarmupTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTokenStatus) DeepCopyInto(out *AccessTokenStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenStatus.
+func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminSSOStatus) DeepCopyInto(out *AdminSSOStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminSSOStatus.
+func (in *AdminSSOStatus) DeepCopy() *AdminSSOStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminSSOStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApicastProductionSpec) DeepCopyInto(out *ApicastProductionSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -595,6 +913,31 @@ func (in *ApicastProductionSpec) DeepCopyInto(out *ApicastProductionSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ClientTLS != nil {
+		in, out := &in.ClientTLS, &out.ClientTLS
+		*out = new(APIcastClientTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Warmup != nil {
+		in, out := &in.Warmup, &out.Warmup
+		*out = new(APIcastWarmupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LBDeregistrationDelaySeconds != nil {
+		in, out := &in.LBDeregistrationDelaySeconds, &out.LBDeregistrationDelaySeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]v1.PodReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.AWSLoadBalancer != nil {
+		in, out := &in.AWSLoadBalancer, &out.AWSLoadBalancer
+		*out = new(APIcastAWSLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastProductionSpec.
@@ -660,23 +1003,17 @@ func (in *ApicastSpec) DeepCopy() *ApicastSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApicastStagingSpec) DeepCopyInto(out *ApicastStagingSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -741,6 +1078,26 @@ func (in *ApicastStagingSpec) DeepCopyInto(out *ApicastStagingSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Warmup != nil {
+		in, out := &in.Warmup, &out.Warmup
+		*out = new(APIcastWarmupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LBDeregistrationDelaySeconds != nil {
+		in, out := &in.LBDeregistrationDelaySeconds, &out.LBDeregistrationDelaySeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]v1.PodReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.AWSLoadBalancer != nil {
+		in, out := &in.AWSLoadBalancer, &out.AWSLoadBalancer
+		*out = new(APIcastAWSLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastStagingSpec.
@@ -756,23 +1113,17 @@ func (in *ApicastStagingSpec) DeepCopy() *ApicastStagingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendCronSpec) DeepCopyInto(out *BackendCronSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -790,31 +1141,55 @@ func (in *BackendCronSpec) DeepCopy() *BackendCronSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendListenerRequestLoggingSpec) DeepCopyInto(out *BackendListenerRequestLoggingSpec) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendListenerRequestLoggingSpec.
+func (in *BackendListenerRequestLoggingSpec) DeepCopy() *BackendListenerRequestLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendListenerRequestLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendListenerSpec) DeepCopyInto(out *BackendListenerSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestLogging != nil {
+		in, out := &in.RequestLogging, &out.RequestLogging
+		*out = new(BackendListenerRequestLoggingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendListenerSpec.
@@ -835,6 +1210,20 @@ func (in *BackendRedisPersistentVolumeClaimSpec) DeepCopyInto(out *BackendRedisP
 		*out = new(string)
 		**out = **in
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendRedisPersistentVolumeClaimSpec.
@@ -865,6 +1254,13 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(BackendRedisPersistentVolumeClaimSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisNodeSelector != nil {
+		in, out := &in.RedisNodeSelector, &out.RedisNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.RedisAffinity != nil {
 		in, out := &in.RedisAffinity, &out.RedisAffinity
 		*out = new(v1.Affinity)
@@ -877,11 +1273,33 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RedisTopologySpreadConstraints != nil {
+		in, out := &in.RedisTopologySpreadConstraints, &out.RedisTopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RedisPriorityClassName != nil {
+		in, out := &in.RedisPriorityClassName, &out.RedisPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
 	if in.RedisResources != nil {
 		in, out := &in.RedisResources, &out.RedisResources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisPodSecurityContext != nil {
+		in, out := &in.RedisPodSecurityContext, &out.RedisPodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisSecurityContext != nil {
+		in, out := &in.RedisSecurityContext, &out.RedisSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ListenerSpec != nil {
 		in, out := &in.ListenerSpec, &out.ListenerSpec
 		*out = new(BackendListenerSpec)
@@ -912,23 +1330,17 @@ func (in *BackendSpec) DeepCopy() *BackendSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendWorkerSpec) DeepCopyInto(out *BackendWorkerSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -946,6 +1358,21 @@ func (in *BackendWorkerSpec) DeepCopy() *BackendWorkerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomEnvironmentSpec) DeepCopyInto(out *CustomEnvironmentSpec) {
 	*out = *in
@@ -986,6 +1413,125 @@ func (in *CustomPolicySpec) DeepCopy() *CustomPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseBackupPVCSpec) DeepCopyInto(out *DatabaseBackupPVCSpec) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(PersistentVolumeClaimResources)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseBackupPVCSpec.
+func (in *DatabaseBackupPVCSpec) DeepCopy() *DatabaseBackupPVCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseBackupPVCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseBackupS3Spec) DeepCopyInto(out *DatabaseBackupS3Spec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseBackupS3Spec.
+func (in *DatabaseBackupS3Spec) DeepCopy() *DatabaseBackupS3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseBackupS3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseBackupSpec) DeepCopyInto(out *DatabaseBackupSpec) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(DatabaseBackupPVCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(DatabaseBackupS3Spec)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseBackupSpec.
+func (in *DatabaseBackupSpec) DeepCopy() *DatabaseBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseBackupStatus) DeepCopyInto(out *DatabaseBackupStatus) {
+	*out = *in
+	if in.JobNames != nil {
+		in, out := &in.JobNames, &out.JobNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseBackupStatus.
+func (in *DatabaseBackupStatus) DeepCopy() *DatabaseBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabasesSpec) DeepCopyInto(out *DatabasesSpec) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(DatabaseBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabasesSpec.
+func (in *DatabasesSpec) DeepCopy() *DatabasesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabasesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeprecatedSystemS3Spec) DeepCopyInto(out *DeprecatedSystemS3Spec) {
 	*out = *in
@@ -1010,6 +1556,11 @@ func (in *ExternalBackendComponents) DeepCopyInto(out *ExternalBackendComponents
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RedisCASecretRef != nil {
+		in, out := &in.RedisCASecretRef, &out.RedisCASecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalBackendComponents.
@@ -1022,6 +1573,32 @@ func (in *ExternalBackendComponents) DeepCopy() *ExternalBackendComponents {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalBackendSpec) DeepCopyInto(out *ExternalBackendSpec) {
+	*out = *in
+	if in.RouteEndpoint != nil {
+		in, out := &in.RouteEndpoint, &out.RouteEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	out.InternalAPISecretRef = in.InternalAPISecretRef
+	if in.ConnectivityCheckEnabled != nil {
+		in, out := &in.ConnectivityCheckEnabled, &out.ConnectivityCheckEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalBackendSpec.
+func (in *ExternalBackendSpec) DeepCopy() *ExternalBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalComponentsSpec) DeepCopyInto(out *ExternalComponentsSpec) {
 	*out = *in
@@ -1060,6 +1637,11 @@ func (in *ExternalSystemComponents) DeepCopyInto(out *ExternalSystemComponents)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RedisCASecretRef != nil {
+		in, out := &in.RedisCASecretRef, &out.RedisCASecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.Database != nil {
 		in, out := &in.Database, &out.Database
 		*out = new(bool)
@@ -1098,142 +1680,1026 @@ func (in *ExternalZyncComponents) DeepCopy() *ExternalZyncComponents {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HighAvailabilitySpec) DeepCopyInto(out *HighAvailabilitySpec) {
+func (in *ExternalZyncSpec) DeepCopyInto(out *ExternalZyncSpec) {
 	*out = *in
-	if in.ExternalZyncDatabaseEnabled != nil {
-		in, out := &in.ExternalZyncDatabaseEnabled, &out.ExternalZyncDatabaseEnabled
-		*out = new(bool)
-		**out = **in
-	}
+	out.SecretRef = in.SecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilitySpec.
-func (in *HighAvailabilitySpec) DeepCopy() *HighAvailabilitySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalZyncSpec.
+func (in *ExternalZyncSpec) DeepCopy() *ExternalZyncSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HighAvailabilitySpec)
+	out := new(ExternalZyncSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+func (in *FileStorageMigrationStatus) DeepCopyInto(out *FileStorageMigrationStatus) {
 	*out = *in
-	if in.EnablePrometheusRules != nil {
-		in, out := &in.EnablePrometheusRules, &out.EnablePrometheusRules
-		*out = new(bool)
-		**out = **in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
-func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileStorageMigrationStatus.
+func (in *FileStorageMigrationStatus) DeepCopy() *FileStorageMigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitoringSpec)
+	out := new(FileStorageMigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeClaimBackupDestination) DeepCopyInto(out *PersistentVolumeClaimBackupDestination) {
+func (in *GatewayOnlySpec) DeepCopyInto(out *GatewayOnlySpec) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(PersistentVolumeClaimResources)
-		(*in).DeepCopyInto(*out)
+	out.PortalEndpointSecretRef = in.PortalEndpointSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayOnlySpec.
+func (in *GatewayOnlySpec) DeepCopy() *GatewayOnlySpec {
+	if in == nil {
+		return nil
 	}
-	if in.VolumeName != nil {
-		in, out := &in.VolumeName, &out.VolumeName
+	out := new(GatewayOnlySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+	*out = *in
+	if in.DatasourceName != nil {
+		in, out := &in.DatasourceName, &out.DatasourceName
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageClass != nil {
-		in, out := &in.StorageClass, &out.StorageClass
-		*out = new(string)
-		**out = **in
+	if in.DashboardLabels != nil {
+		in, out := &in.DashboardLabels, &out.DashboardLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimBackupDestination.
-func (in *PersistentVolumeClaimBackupDestination) DeepCopy() *PersistentVolumeClaimBackupDestination {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
+func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeClaimBackupDestination)
+	out := new(GrafanaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeClaimResources) DeepCopyInto(out *PersistentVolumeClaimResources) {
+func (in *HighAvailabilitySpec) DeepCopyInto(out *HighAvailabilitySpec) {
 	*out = *in
-	out.Requests = in.Requests.DeepCopy()
+	if in.ExternalZyncDatabaseEnabled != nil {
+		in, out := &in.ExternalZyncDatabaseEnabled, &out.ExternalZyncDatabaseEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResources.
-func (in *PersistentVolumeClaimResources) DeepCopy() *PersistentVolumeClaimResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilitySpec.
+func (in *HighAvailabilitySpec) DeepCopy() *HighAvailabilitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeClaimResources)
+	out := new(HighAvailabilitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeClaimRestoreSource) DeepCopyInto(out *PersistentVolumeClaimRestoreSource) {
+func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
 	*out = *in
-	out.ClaimSource = in.ClaimSource
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUTargetUtilization != nil {
+		in, out := &in.CPUTargetUtilization, &out.CPUTargetUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryTargetUtilization != nil {
+		in, out := &in.MemoryTargetUtilization, &out.MemoryTargetUtilization
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimRestoreSource.
-func (in *PersistentVolumeClaimRestoreSource) DeepCopy() *PersistentVolumeClaimRestoreSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
+func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeClaimRestoreSource)
+	out := new(HorizontalPodAutoscalerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+func (in *ImageRegistryOverrideSpec) DeepCopyInto(out *ImageRegistryOverrideSpec) {
 	*out = *in
+	if in.RepositoryPrefix != nil {
+		in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
-func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryOverrideSpec.
+func (in *ImageRegistryOverrideSpec) DeepCopy() *ImageRegistryOverrideSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodDisruptionBudgetSpec)
+	out := new(ImageRegistryOverrideSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
+func (in *InitContainerSpec) DeepCopyInto(out *InitContainerSpec) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int64)
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitContainerSpec.
+func (in *InitContainerSpec) DeepCopy() *InitContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InitContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobsTemplateSpec) DeepCopyInto(out *JobsTemplateSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobsTemplateSpec.
+func (in *JobsTemplateSpec) DeepCopy() *JobsTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobsTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsSpec) DeepCopyInto(out *MetricsSpec) {
+	*out = *in
+	if in.Statsd != nil {
+		in, out := &in.Statsd, &out.Statsd
+		*out = new(StatsdSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsSpec.
+func (in *MetricsSpec) DeepCopy() *MetricsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+	*out = *in
+	if in.EnablePrometheusRules != nil {
+		in, out := &in.EnablePrometheusRules, &out.EnablePrometheusRules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DatabaseExporters != nil {
+		in, out := &in.DatabaseExporters, &out.DatabaseExporters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Apicast != nil {
+		in, out := &in.Apicast, &out.Apicast
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.System != nil {
+		in, out := &in.System, &out.System
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Zync != nil {
+		in, out := &in.Zync, &out.Zync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecordingRules != nil {
+		in, out := &in.RecordingRules, &out.RecordingRules
+		*out = new(RecordingRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(GrafanaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(PrometheusRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
+func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimBackupDestination) DeepCopyInto(out *PersistentVolumeClaimBackupDestination) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(PersistentVolumeClaimResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeName != nil {
+		in, out := &in.VolumeName, &out.VolumeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimBackupDestination.
+func (in *PersistentVolumeClaimBackupDestination) DeepCopy() *PersistentVolumeClaimBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimResources) DeepCopyInto(out *PersistentVolumeClaimResources) {
+	*out = *in
+	out.Requests = in.Requests.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResources.
+func (in *PersistentVolumeClaimResources) DeepCopy() *PersistentVolumeClaimResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimRestoreSource) DeepCopyInto(out *PersistentVolumeClaimRestoreSource) {
+	*out = *in
+	out.ClaimSource = in.ClaimSource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimRestoreSource.
+func (in *PersistentVolumeClaimRestoreSource) DeepCopy() *PersistentVolumeClaimRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetPolicySpec) DeepCopyInto(out *PodDisruptionBudgetPolicySpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetPolicySpec.
+func (in *PodDisruptionBudgetPolicySpec) DeepCopy() *PodDisruptionBudgetPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.ApicastProduction != nil {
+		in, out := &in.ApicastProduction, &out.ApicastProduction
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApicastStaging != nil {
+		in, out := &in.ApicastStaging, &out.ApicastStaging
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendListener != nil {
+		in, out := &in.BackendListener, &out.BackendListener
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendWorker != nil {
+		in, out := &in.BackendWorker, &out.BackendWorker
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendCron != nil {
+		in, out := &in.BackendCron, &out.BackendCron
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemApp != nil {
+		in, out := &in.SystemApp, &out.SystemApp
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemSidekiq != nil {
+		in, out := &in.SystemSidekiq, &out.SystemSidekiq
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zync != nil {
+		in, out := &in.Zync, &out.Zync
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZyncQue != nil {
+		in, out := &in.ZyncQue, &out.ZyncQue
+		*out = new(PodDisruptionBudgetPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacementSpec) DeepCopyInto(out *PodPlacementSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacementSpec.
+func (in *PodPlacementSpec) DeepCopy() *PodPlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplateOverridesSpec) DeepCopyInto(out *PodTemplateOverridesSpec) {
+	*out = *in
+	in.PodPlacementSpec.DeepCopyInto(&out.PodPlacementSpec)
+	if in.UnreachableTolerationSeconds != nil {
+		in, out := &in.UnreachableTolerationSeconds, &out.UnreachableTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SidecarVolumes != nil {
+		in, out := &in.SidecarVolumes, &out.SidecarVolumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreStopCommand != nil {
+		in, out := &in.PreStopCommand, &out.PreStopCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSPolicy != nil {
+		in, out := &in.DNSPolicy, &out.DNSPolicy
+		*out = new(v1.DNSPolicy)
+		**out = **in
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]v1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(v1.PullPolicy)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplateOverridesSpec.
+func (in *PodTemplateOverridesSpec) DeepCopy() *PodTemplateOverridesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplateOverridesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeSpec) DeepCopyInto(out *ProbeSpec) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeSpec.
+func (in *ProbeSpec) DeepCopy() *ProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbesSpec) DeepCopyInto(out *ProbesSpec) {
+	*out = *in
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesSpec.
+func (in *ProbesSpec) DeepCopy() *ProbesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleOverride) DeepCopyInto(out *PrometheusRuleOverride) {
+	*out = *in
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(string)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleOverride.
+func (in *PrometheusRuleOverride) DeepCopy() *PrometheusRuleOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRulesSpec) DeepCopyInto(out *PrometheusRulesSpec) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]PrometheusRuleOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRulesSpec.
+func (in *PrometheusRulesSpec) DeepCopy() *PrometheusRulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRulesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordingRulesSpec) DeepCopyInto(out *RecordingRulesSpec) {
+	*out = *in
+	if in.ApicastAvailabilityObjective != nil {
+		in, out := &in.ApicastAvailabilityObjective, &out.ApicastAvailabilityObjective
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendAvailabilityObjective != nil {
+		in, out := &in.BackendAvailabilityObjective, &out.BackendAvailabilityObjective
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRulesSpec.
+func (in *RecordingRulesSpec) DeepCopy() *RecordingRulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordingRulesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestLoggingStatus) DeepCopyInto(out *RequestLoggingStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestLoggingStatus.
+func (in *RequestLoggingStatus) DeepCopy() *RequestLoggingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestLoggingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RubyRuntimeTuningSpec) DeepCopyInto(out *RubyRuntimeTuningSpec) {
+	*out = *in
+	if in.MallocArenaMax != nil {
+		in, out := &in.MallocArenaMax, &out.MallocArenaMax
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GCHeapGrowthFactor != nil {
+		in, out := &in.GCHeapGrowthFactor, &out.GCHeapGrowthFactor
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCHeapInitSlots != nil {
+		in, out := &in.GCHeapInitSlots, &out.GCHeapInitSlots
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RubyYJIT != nil {
+		in, out := &in.RubyYJIT, &out.RubyYJIT
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RubyRuntimeTuningSpec.
+func (in *RubyRuntimeTuningSpec) DeepCopy() *RubyRuntimeTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RubyRuntimeTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountSpec) DeepCopyInto(out *ServiceAccountSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSpec.
+func (in *ServiceAccountSpec) DeepCopy() *ServiceAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountsSpec) DeepCopyInto(out *ServiceAccountsSpec) {
+	*out = *in
+	if in.Amp != nil {
+		in, out := &in.Amp, &out.Amp
+		*out = new(ServiceAccountSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZyncQue != nil {
+		in, out := &in.ZyncQue, &out.ZyncQue
+		*out = new(ServiceAccountSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountsSpec.
+func (in *ServiceAccountsSpec) DeepCopy() *ServiceAccountsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownSpec) DeepCopyInto(out *ShutdownSpec) {
+	*out = *in
+	if in.BackendQueuesDrainTimeoutSeconds != nil {
+		in, out := &in.BackendQueuesDrainTimeoutSeconds, &out.BackendQueuesDrainTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeadlineSeconds != nil {
+		in, out := &in.DeadlineSeconds, &out.DeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownSpec.
+func (in *ShutdownSpec) DeepCopy() *ShutdownSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownStatus) DeepCopyInto(out *ShutdownStatus) {
+	*out = *in
+	in.StageStartTime.DeepCopyInto(&out.StageStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownStatus.
+func (in *ShutdownStatus) DeepCopy() *ShutdownStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandbyStatus) DeepCopyInto(out *StandbyStatus) {
+	*out = *in
+	if in.ScaledDownComponents != nil {
+		in, out := &in.ScaledDownComponents, &out.ScaledDownComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandbyStatus.
+func (in *StandbyStatus) DeepCopy() *StandbyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StandbyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatsdSpec) DeepCopyInto(out *StatsdSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatsdSpec.
+func (in *StatsdSpec) DeepCopy() *StatsdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StatsdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAccessTokenSpec) DeepCopyInto(out *SystemAccessTokenSpec) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permission != nil {
+		in, out := &in.Permission, &out.Permission
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAccessTokenSpec.
+func (in *SystemAccessTokenSpec) DeepCopy() *SystemAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAdminSSOSpec) DeepCopyInto(out *SystemAdminSSOSpec) {
+	*out = *in
+	out.ClientCredentialsSecretRef = in.ClientCredentialsSecretRef
+	if in.AutoProvision != nil {
+		in, out := &in.AutoProvision, &out.AutoProvision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAdminSSOSpec.
+func (in *SystemAdminSSOSpec) DeepCopy() *SystemAdminSSOSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAdminSSOSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
+	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeTuning != nil {
+		in, out := &in.RuntimeTuning, &out.RuntimeTuning
+		*out = new(RubyRuntimeTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MasterContainerResources != nil {
 		in, out := &in.MasterContainerResources, &out.MasterContainerResources
 		*out = new(v1.ResourceRequirements)
@@ -1261,6 +2727,41 @@ func (in *SystemAppSpec) DeepCopy() *SystemAppSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemCORSSpec) DeepCopyInto(out *SystemCORSSpec) {
+	*out = *in
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHeaders != nil {
+		in, out := &in.AllowedHeaders, &out.AllowedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowCredentials != nil {
+		in, out := &in.AllowCredentials, &out.AllowCredentials
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemCORSSpec.
+func (in *SystemCORSSpec) DeepCopy() *SystemCORSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemCORSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemDatabaseSpec) DeepCopyInto(out *SystemDatabaseSpec) {
 	*out = *in
@@ -1286,6 +2787,26 @@ func (in *SystemDatabaseSpec) DeepCopy() *SystemDatabaseSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemDeveloperPortalSpec) DeepCopyInto(out *SystemDeveloperPortalSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemDeveloperPortalSpec.
+func (in *SystemDeveloperPortalSpec) DeepCopy() *SystemDeveloperPortalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemDeveloperPortalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemFileStorageSpec) DeepCopyInto(out *SystemFileStorageSpec) {
 	*out = *in
@@ -1316,6 +2837,52 @@ func (in *SystemFileStorageSpec) DeepCopy() *SystemFileStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemInboundEmailSpec) DeepCopyInto(out *SystemInboundEmailSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SSL != nil {
+		in, out := &in.SSL, &out.SSL
+		*out = new(bool)
+		**out = **in
+	}
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemInboundEmailSpec.
+func (in *SystemInboundEmailSpec) DeepCopy() *SystemInboundEmailSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemInboundEmailSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemMasterRouteSpec) DeepCopyInto(out *SystemMasterRouteSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMasterRouteSpec.
+func (in *SystemMasterRouteSpec) DeepCopy() *SystemMasterRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemMasterRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemMySQLPVCSpec) DeepCopyInto(out *SystemMySQLPVCSpec) {
 	*out = *in
@@ -1334,6 +2901,20 @@ func (in *SystemMySQLPVCSpec) DeepCopyInto(out *SystemMySQLPVCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMySQLPVCSpec.
@@ -1349,6 +2930,7 @@ func (in *SystemMySQLPVCSpec) DeepCopy() *SystemMySQLPVCSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemMySQLSpec) DeepCopyInto(out *SystemMySQLSpec) {
 	*out = *in
+	in.PodPlacementSpec.DeepCopyInto(&out.PodPlacementSpec)
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(string)
@@ -1359,23 +2941,26 @@ func (in *SystemMySQLSpec) DeepCopyInto(out *SystemMySQLSpec) {
 		*out = new(SystemMySQLPVCSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedMemorySizeLimit != nil {
+		in, out := &in.SharedMemorySizeLimit, &out.SharedMemorySizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMySQLSpec.
@@ -1406,6 +2991,20 @@ func (in *SystemPVCSpec) DeepCopyInto(out *SystemPVCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemPVCSpec.
@@ -1436,6 +3035,20 @@ func (in *SystemPostgreSQLPVCSpec) DeepCopyInto(out *SystemPostgreSQLPVCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemPostgreSQLPVCSpec.
@@ -1451,33 +3064,32 @@ func (in *SystemPostgreSQLPVCSpec) DeepCopy() *SystemPostgreSQLPVCSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemPostgreSQLSpec) DeepCopyInto(out *SystemPostgreSQLSpec) {
 	*out = *in
+	in.PodPlacementSpec.DeepCopyInto(&out.PodPlacementSpec)
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(string)
-		**out = **in
-	}
-	if in.PersistentVolumeClaimSpec != nil {
-		in, out := &in.PersistentVolumeClaimSpec, &out.PersistentVolumeClaimSpec
-		*out = new(SystemPostgreSQLPVCSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+		**out = **in
+	}
+	if in.PersistentVolumeClaimSpec != nil {
+		in, out := &in.PersistentVolumeClaimSpec, &out.PersistentVolumeClaimSpec
+		*out = new(SystemPostgreSQLPVCSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemPostgreSQLSpec.
@@ -1498,6 +3110,20 @@ func (in *SystemRedisPersistentVolumeClaimSpec) DeepCopyInto(out *SystemRedisPer
 		*out = new(string)
 		**out = **in
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRedisPersistentVolumeClaimSpec.
@@ -1529,22 +3155,21 @@ func (in *SystemS3Spec) DeepCopy() *SystemS3Spec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RuntimeTuning != nil {
+		in, out := &in.RuntimeTuning, &out.RuntimeTuning
+		*out = new(RubyRuntimeTuningSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -1576,6 +3201,18 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheStore != nil {
+		in, out := &in.CacheStore, &out.CacheStore
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemcachedNodeSelector != nil {
+		in, out := &in.MemcachedNodeSelector, &out.MemcachedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.MemcachedAffinity != nil {
 		in, out := &in.MemcachedAffinity, &out.MemcachedAffinity
 		*out = new(v1.Affinity)
@@ -1588,11 +3225,33 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MemcachedTopologySpreadConstraints != nil {
+		in, out := &in.MemcachedTopologySpreadConstraints, &out.MemcachedTopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemcachedPriorityClassName != nil {
+		in, out := &in.MemcachedPriorityClassName, &out.MemcachedPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
 	if in.MemcachedResources != nil {
 		in, out := &in.MemcachedResources, &out.MemcachedResources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemcachedPodSecurityContext != nil {
+		in, out := &in.MemcachedPodSecurityContext, &out.MemcachedPodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemcachedSecurityContext != nil {
+		in, out := &in.MemcachedSecurityContext, &out.MemcachedSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RedisImage != nil {
 		in, out := &in.RedisImage, &out.RedisImage
 		*out = new(string)
@@ -1603,6 +3262,13 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(SystemRedisPersistentVolumeClaimSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisNodeSelector != nil {
+		in, out := &in.RedisNodeSelector, &out.RedisNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.RedisAffinity != nil {
 		in, out := &in.RedisAffinity, &out.RedisAffinity
 		*out = new(v1.Affinity)
@@ -1615,11 +3281,33 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RedisTopologySpreadConstraints != nil {
+		in, out := &in.RedisTopologySpreadConstraints, &out.RedisTopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RedisPriorityClassName != nil {
+		in, out := &in.RedisPriorityClassName, &out.RedisPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
 	if in.RedisResources != nil {
 		in, out := &in.RedisResources, &out.RedisResources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisPodSecurityContext != nil {
+		in, out := &in.RedisPodSecurityContext, &out.RedisPodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisSecurityContext != nil {
+		in, out := &in.RedisSecurityContext, &out.RedisSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FileStorageSpec != nil {
 		in, out := &in.FileStorageSpec, &out.FileStorageSpec
 		*out = new(SystemFileStorageSpec)
@@ -1645,6 +3333,38 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(SystemSphinxSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdminSSO != nil {
+		in, out := &in.AdminSSO, &out.AdminSSO
+		*out = new(SystemAdminSSOSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessTokens != nil {
+		in, out := &in.AccessTokens, &out.AccessTokens
+		*out = make([]SystemAccessTokenSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(SystemCORSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeveloperPortal != nil {
+		in, out := &in.DeveloperPortal, &out.DeveloperPortal
+		*out = new(SystemDeveloperPortalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InboundEmail != nil {
+		in, out := &in.InboundEmail, &out.InboundEmail
+		*out = new(SystemInboundEmailSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterRoute != nil {
+		in, out := &in.MasterRoute, &out.MasterRoute
+		*out = new(SystemMasterRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
@@ -1660,17 +3380,16 @@ func (in *SystemSpec) DeepCopy() *SystemSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemSphinxSpec) DeepCopyInto(out *SystemSphinxSpec) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -1689,31 +3408,101 @@ func (in *SystemSphinxSpec) DeepCopy() *SystemSphinxSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadFailure) DeepCopyInto(out *WorkloadFailure) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadFailure.
+func (in *WorkloadFailure) DeepCopy() *WorkloadFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
+	*out = *in
+	if in.LastFailure != nil {
+		in, out := &in.LastFailure, &out.LastFailure
+		*out = new(WorkloadFailure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]WorkloadZone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
+func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadZone) DeepCopyInto(out *WorkloadZone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadZone.
+func (in *WorkloadZone) DeepCopy() *WorkloadZone {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZyncAppSpec) DeepCopyInto(out *ZyncAppSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.WaitInitContainer != nil {
+		in, out := &in.WaitInitContainer, &out.WaitInitContainer
+		*out = new(InitContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeTuning != nil {
+		in, out := &in.RuntimeTuning, &out.RuntimeTuning
+		*out = new(RubyRuntimeTuningSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ForceSSL != nil {
+		in, out := &in.ForceSSL, &out.ForceSSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustedProxies != nil {
+		in, out := &in.TrustedProxies, &out.TrustedProxies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncAppSpec.
@@ -1726,31 +3515,165 @@ func (in *ZyncAppSpec) DeepCopy() *ZyncAppSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncDatabaseMaintenanceSpec) DeepCopyInto(out *ZyncDatabaseMaintenanceSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.Repack != nil {
+		in, out := &in.Repack, &out.Repack
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncDatabaseMaintenanceSpec.
+func (in *ZyncDatabaseMaintenanceSpec) DeepCopy() *ZyncDatabaseMaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncDatabaseMaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncDatabaseSpec) DeepCopyInto(out *ZyncDatabaseSpec) {
+	*out = *in
+	if in.SSLCASecretRef != nil {
+		in, out := &in.SSLCASecretRef, &out.SSLCASecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.SSLMode != nil {
+		in, out := &in.SSLMode, &out.SSLMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncDatabaseSpec.
+func (in *ZyncDatabaseSpec) DeepCopy() *ZyncDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncDatabaseStorageSpec) DeepCopyInto(out *ZyncDatabaseStorageSpec) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeName != nil {
+		in, out := &in.VolumeName, &out.VolumeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncDatabaseStorageSpec.
+func (in *ZyncDatabaseStorageSpec) DeepCopy() *ZyncDatabaseStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncDatabaseStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncQueServiceAccountTokenSpec) DeepCopyInto(out *ZyncQueServiceAccountTokenSpec) {
+	*out = *in
+	if in.Legacy != nil {
+		in, out := &in.Legacy, &out.Legacy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncQueServiceAccountTokenSpec.
+func (in *ZyncQueServiceAccountTokenSpec) DeepCopy() *ZyncQueServiceAccountTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncQueServiceAccountTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZyncQueSpec) DeepCopyInto(out *ZyncQueSpec) {
 	*out = *in
+	in.PodTemplateOverridesSpec.DeepCopyInto(&out.PodTemplateOverridesSpec)
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RuntimeTuning != nil {
+		in, out := &in.RuntimeTuning, &out.RuntimeTuning
+		*out = new(RubyRuntimeTuningSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ZyncQueServiceAccountTokenSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerCount != nil {
+		in, out := &in.WorkerCount, &out.WorkerCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncQueSpec.
@@ -1776,6 +3699,13 @@ func (in *ZyncSpec) DeepCopyInto(out *ZyncSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DatabaseNodeSelector != nil {
+		in, out := &in.DatabaseNodeSelector, &out.DatabaseNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.DatabaseAffinity != nil {
 		in, out := &in.DatabaseAffinity, &out.DatabaseAffinity
 		*out = new(v1.Affinity)
@@ -1788,11 +3718,53 @@ func (in *ZyncSpec) DeepCopyInto(out *ZyncSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DatabaseTopologySpreadConstraints != nil {
+		in, out := &in.DatabaseTopologySpreadConstraints, &out.DatabaseTopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DatabasePriorityClassName != nil {
+		in, out := &in.DatabasePriorityClassName, &out.DatabasePriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabasePodSecurityContext != nil {
+		in, out := &in.DatabasePodSecurityContext, &out.DatabasePodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSecurityContext != nil {
+		in, out := &in.DatabaseSecurityContext, &out.DatabaseSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DatabaseResources != nil {
 		in, out := &in.DatabaseResources, &out.DatabaseResources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DatabaseSharedMemorySizeLimit != nil {
+		in, out := &in.DatabaseSharedMemorySizeLimit, &out.DatabaseSharedMemorySizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DatabaseStorage != nil {
+		in, out := &in.DatabaseStorage, &out.DatabaseStorage
+		*out = new(ZyncDatabaseStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(ZyncDatabaseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseMaintenance != nil {
+		in, out := &in.DatabaseMaintenance, &out.DatabaseMaintenance
+		*out = new(ZyncDatabaseMaintenanceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AppSpec != nil {
 		in, out := &in.AppSpec, &out.AppSpec
 		*out = new(ZyncAppSpec)
@@ -1803,6 +3775,11 @@ func (in *ZyncSpec) DeepCopyInto(out *ZyncSpec) {
 		*out = new(ZyncQueSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalZync != nil {
+		in, out := &in.ExternalZync, &out.ExternalZync
+		*out = new(ExternalZyncSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncSpec.
@@ -0,0 +1,1930 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/RHsyseng/operator-utils/pkg/olm"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+)
+
+// APIManagerSpec defines the desired state of APIManager.
+// The settings are grouped by concern: workloads, networking, storage,
+// monitoring and security
+type APIManagerSpec struct {
+	// Wildcard domain as configured in the API Manager object
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Wildcard Domain",xDescriptors="urn:alm:descriptor:com.tectonic.ui:label"
+	WildcardDomain string `json:"wildcardDomain"`
+	// +optional
+	AppLabel *string `json:"appLabel,omitempty"`
+	// Labels added to all the resources and pods generated by the operator,
+	// e.g. for chargeback. The labels set by the operator and the component
+	// labels take precedence. Removed keys are not removed from the existing objects
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// +optional
+	TenantName *string `json:"tenantName,omitempty"`
+	// Mode is the disaster recovery mode of the APIManager. In standby mode,
+	// the components writing to the databases (backend-cron, system-sidekiq and zync-que)
+	// are scaled down until the APIManager is switched to active. Defaults to active
+	// +kubebuilder:validation:Enum=active;standby
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+	// Hibernated scales down all the components to zero replicas, e.g. to park development
+	// installs overnight. The persistent volumes and the secrets are kept. The replicas
+	// the components had are restored when it is unset
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+	// GatewayOnly deploys only the apicast gateways, connected to a 3scale
+	// control plane managed outside of the APIManager. When set, system, backend,
+	// zync and their databases are not deployed. It cannot be set or unset on existing installs
+	// +optional
+	GatewayOnly *GatewayOnlySpec `json:"gatewayOnly,omitempty"`
+	// Workloads configures the images, the scheduling and the resources
+	// of the stateless components
+	// +optional
+	Workloads *WorkloadsSpec `json:"workloads,omitempty"`
+	// Networking configures how the components are exposed and how
+	// they reach the components managed outside of the APIManager
+	// +optional
+	Networking *NetworkingSpec `json:"networking,omitempty"`
+	// Storage configures the file storage and the databases, internal or external
+	// +optional
+	Storage *StorageSpec `json:"storage,omitempty"`
+	// Monitoring configures the monitoring resources and the metrics sinks
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// Security configures the authentication and the access to the admin portal and APIs
+	// +optional
+	Security *SecuritySpec `json:"security,omitempty"`
+	// Databases configures the operations run by the operator
+	// on the internal databases
+	// +optional
+	Databases *DatabasesSpec `json:"databases,omitempty"`
+}
+
+// GatewayOnlySpec defines the remote control plane the apicast
+// gateways of a gateway only APIManager are connected to
+type GatewayOnlySpec struct {
+	// PortalEndpointSecretRef references the secret holding the remote admin portal
+	// endpoint, including an access token, in the `AdminPortalURL` key.
+	// I.e. https://<access-token>@<tenant>-admin.<remote-wildcard-domain>
+	PortalEndpointSecretRef v1.LocalObjectReference `json:"portalEndpointSecretRef"`
+}
+
+// WorkloadsSpec defines the settings of the stateless components
+type WorkloadsSpec struct {
+	// +optional
+	ImageStreamTagImportInsecure *bool `json:"imageStreamTagImportInsecure,omitempty"`
+	// +optional
+	ResourceRequirementsEnabled *bool `json:"resourceRequirementsEnabled,omitempty"`
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// ImagePullSecretsPolicy defines how the image pull secrets are combined with the
+	// default ones in the managed service accounts. Replace uses only the image pull
+	// secrets when set. Merge appends them to the defaults, deduplicated by name.
+	// Defaults to Replace
+	// +kubebuilder:validation:Enum=Replace;Merge
+	// +optional
+	ImagePullSecretsPolicy *string `json:"imagePullSecretsPolicy,omitempty"`
+	// ImageRegistryOverride pulls the default images from a mirrored registry.
+	// Images explicitly set in the component specs are not rewritten
+	// +optional
+	ImageRegistryOverride *ImageRegistryOverrideSpec `json:"imageRegistryOverride,omitempty"`
+	// TerminationMessagePolicy of the containers of the components. With FallbackToLogsOnError,
+	// the last lines of the log are the termination message of the failed containers
+	// not writing to the termination message file. Defaults to FallbackToLogsOnError
+	// +kubebuilder:validation:Enum=File;FallbackToLogsOnError
+	// +optional
+	TerminationMessagePolicy *v1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+	// PodSecurityStandard the security contexts of the workloads comply with. With restricted, the unset
+	// fields of the security contexts are defaulted to comply with the restricted Pod Security Standard.
+	// The pods run as non-root with the RuntimeDefault seccomp profile, and the containers drop all the
+	// capabilities and do not allow privilege escalation. With privileged or when not set, the security
+	// contexts are not defaulted, e.g. on clusters where setting the seccomp profile is not allowed
+	// +kubebuilder:validation:Enum=restricted;privileged
+	// +optional
+	PodSecurityStandard *string `json:"podSecurityStandard,omitempty"`
+	// ImagePullPolicy of the containers of all the workloads, e.g. Always on development clusters using
+	// floating image tags. Overridden by the image pull policy of the component specs. When not set,
+	// the images are pulled if not present, except for zync-que which always pulls them
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// UnreachableTolerationSeconds is how long the pods stay bound to a not-ready or unreachable
+	// node before being evicted. The not-ready and unreachable tolerations are added to the pods
+	// along with the tolerations of the components. It can be overridden per component.
+	// When not set, the cluster default is used
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	UnreachableTolerationSeconds *int64 `json:"unreachableTolerationSeconds,omitempty"`
+	// JobsTemplate overrides the scheduling settings of the pods of the jobs created by the operator.
+	// When not set, the jobs inherit the settings of the component they belong to
+	// +optional
+	JobsTemplate *JobsTemplateSpec `json:"jobsTemplate,omitempty"`
+	// ServiceAccounts configures the service accounts created by the operator, e.g. to annotate
+	// them to access S3 or external databases with workload identity instead of static credentials
+	// +optional
+	ServiceAccounts *ServiceAccountsSpec `json:"serviceAccounts,omitempty"`
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// +optional
+	Shutdown *ShutdownSpec `json:"shutdown,omitempty"`
+	// +optional
+	Apicast *ApicastSpec `json:"apicast,omitempty"`
+	// +optional
+	Backend *BackendSpec `json:"backend,omitempty"`
+	// +optional
+	System *SystemSpec `json:"system,omitempty"`
+	// +optional
+	Zync *ZyncSpec `json:"zync,omitempty"`
+}
+
+// JobsTemplateSpec defines the scheduling settings of the pods of the
+// jobs created by the operator. Set fields take precedence over the
+// settings inherited from the components
+type JobsTemplateSpec struct {
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+}
+
+// ServiceAccountsSpec configures the service accounts created by the operator
+type ServiceAccountsSpec struct {
+	// Amp is the service account of all the workloads but zync-que
+	// +optional
+	Amp *ServiceAccountSpec `json:"amp,omitempty"`
+	// ZyncQue is the service account of zync-que
+	// +optional
+	ZyncQue *ServiceAccountSpec `json:"zyncQue,omitempty"`
+}
+
+// ServiceAccountSpec configures a service account created by the operator
+type ServiceAccountSpec struct {
+	// Name of the service account. The pods are rolled out to run as the renamed service
+	// account. The service account previously created is not removed.
+	// Defaults to amp, or zync-que-sa for zync-que
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Annotations of the service account, e.g. eks.amazonaws.com/role-arn to assume
+	// an IAM role with IRSA. Annotations removed from the list are kept in the service account
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ImageRegistryOverrideSpec defines the registry mirroring the default images
+type ImageRegistryOverrideSpec struct {
+	// Host is the registry host, with optional port, replacing
+	// the registry of the default images. I.e. "mirror.example.com:5000"
+	Host string `json:"host"`
+	// RepositoryPrefix is prepended to the repository of the default images
+	// +optional
+	RepositoryPrefix *string `json:"repositoryPrefix,omitempty"`
+}
+
+type PodDisruptionBudgetSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Disruption policies of the components. When not set, maxUnavailable is 1
+	// +optional
+	ApicastProduction *PodDisruptionBudgetPolicySpec `json:"apicastProduction,omitempty"`
+	// +optional
+	ApicastStaging *PodDisruptionBudgetPolicySpec `json:"apicastStaging,omitempty"`
+	// +optional
+	BackendListener *PodDisruptionBudgetPolicySpec `json:"backendListener,omitempty"`
+	// +optional
+	BackendWorker *PodDisruptionBudgetPolicySpec `json:"backendWorker,omitempty"`
+	// +optional
+	BackendCron *PodDisruptionBudgetPolicySpec `json:"backendCron,omitempty"`
+	// +optional
+	SystemApp *PodDisruptionBudgetPolicySpec `json:"systemApp,omitempty"`
+	// +optional
+	SystemSidekiq *PodDisruptionBudgetPolicySpec `json:"systemSidekiq,omitempty"`
+	// +optional
+	Zync *PodDisruptionBudgetPolicySpec `json:"zync,omitempty"`
+	// +optional
+	ZyncQue *PodDisruptionBudgetPolicySpec `json:"zyncQue,omitempty"`
+}
+
+// PodDisruptionBudgetPolicySpec is the disruption policy of the pods of a component.
+// Only one of minAvailable and maxUnavailable can be set
+type PodDisruptionBudgetPolicySpec struct {
+	// MinAvailable is the number or percentage of pods that must remain available
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that can be unavailable
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// HorizontalPodAutoscalerSpec scales the pods of a component on their resource usage
+type HorizontalPodAutoscalerSpec struct {
+	// MinReplicas is the lower limit of the replicas. Defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of the replicas
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// CPUTargetUtilization is the target average CPU utilization of the pods, as a percentage
+	// of the requested CPU. Defaults to 80 when no target is set
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CPUTargetUtilization *int32 `json:"cpuTargetUtilization,omitempty"`
+	// MemoryTargetUtilization is the target average memory utilization of the pods, as a percentage
+	// of the requested memory
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MemoryTargetUtilization *int32 `json:"memoryTargetUtilization,omitempty"`
+}
+
+// ShutdownSpec configures the ordered shutdown of the components
+// run when the APIManager is deleted
+type ShutdownSpec struct {
+	// Enabled adds a finalizer to the APIManager. On deletion, traffic accepting
+	// components are stopped first, then workers once the backend queues are drained,
+	// and finally databases.
+	Enabled bool `json:"enabled,omitempty"`
+	// BackendQueuesDrainTimeoutSeconds is the maximum time waiting for the
+	// backend-worker queues to be drained. Defaults to 300 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackendQueuesDrainTimeoutSeconds *int64 `json:"backendQueuesDrainTimeoutSeconds,omitempty"`
+	// DeadlineSeconds is the maximum duration of the whole shutdown sequence,
+	// counted from the deletion request. When exceeded, the APIManager is
+	// deleted regardless of the remaining stages. Defaults to 900 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DeadlineSeconds *int64 `json:"deadlineSeconds,omitempty"`
+}
+
+// RubyRuntimeTuningSpec tunes the memory allocator and the garbage collector of the
+// Ruby processes of a component, mapped to the well known env vars
+type RubyRuntimeTuningSpec struct {
+	// MallocArenaMax sets MALLOC_ARENA_MAX, the maximum number of glibc malloc arenas.
+	// Low values, like 2, reduce the memory fragmentation of multithreaded processes
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MallocArenaMax *int32 `json:"mallocArenaMax,omitempty"`
+	// GCHeapGrowthFactor sets RUBY_GC_HEAP_GROWTH_FACTOR, the factor the heap grows by
+	// when more slots are needed, i.e. 1.25. Must be greater than 1
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	GCHeapGrowthFactor *string `json:"gcHeapGrowthFactor,omitempty"`
+	// GCHeapInitSlots sets RUBY_GC_HEAP_INIT_SLOTS, the number of heap slots allocated on boot
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	GCHeapInitSlots *int64 `json:"gcHeapInitSlots,omitempty"`
+	// RubyYJIT sets RUBY_YJIT_ENABLE, enabling the YJIT compiler.
+	// Ignored by images running Ruby versions without YJIT support
+	// +optional
+	RubyYJIT *bool `json:"rubyYJIT,omitempty"`
+}
+
+// ProbesSpec tunes the probes of the containers of a component
+type ProbesSpec struct {
+	// +optional
+	Liveness *ProbeSpec `json:"liveness,omitempty"`
+	// +optional
+	Readiness *ProbeSpec `json:"readiness,omitempty"`
+	// Startup adds a startup probe checking the liveness probe endpoint. The liveness
+	// and readiness probes are not run until it succeeds. Defaults to a period of
+	// 10 seconds and a failure threshold of 30
+	// +optional
+	Startup *ProbeSpec `json:"startup,omitempty"`
+}
+
+// ProbeSpec overrides the timing of a probe. Unset fields keep the default values
+type ProbeSpec struct {
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// InitContainerSpec overrides an init container managed by the operator. Unset fields keep the default values
+type InitContainerSpec struct {
+	// Disabled removes the init container from the pods
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+	// Image of the init container. The image is no longer updated by the image change trigger when set
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// Command of the init container
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// TimeoutSeconds after which the init container fails, so the pod is restarted. No timeout by default
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// PodPlacementSpec defines where the pods of a component are scheduled
+type PodPlacementSpec struct {
+	// NodeSelector of the pods. Only the nodes with matching labels are eligible
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// TopologySpreadConstraints of the pods, e.g. to spread them across zones
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName of the pods. When not set, the cluster default priority is used
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+}
+
+// PodTemplateOverridesSpec defines the overrides of the pod template of a component
+type PodTemplateOverridesSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// UnreachableTolerationSeconds overrides spec.workloads.unreachableTolerationSeconds for the pods
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	UnreachableTolerationSeconds *int64 `json:"unreachableTolerationSeconds,omitempty"`
+	// Labels added to the pods and services of the component.
+	// Labels set by the operator take precedence
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations added to the deployment configs, pods and services of the component.
+	// Annotations set by the operator take precedence
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// PodSecurityContext of the pods. When not set, the pod security context
+	// is defaulted by the SecurityContextConstraints admission
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of every container of the pods, init containers included
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+	// Env vars added to the containers after the env vars managed by the operator.
+	// The names of the env vars managed by the operator are not allowed
+	// +optional
+	Env []v1.EnvVar `json:"env,omitempty"`
+	// Sidecars are containers added to the pods after the containers managed by the operator,
+	// e.g. log shippers or SQL proxies. The names must not clash with the containers managed by the operator.
+	// The schema is not generated, the containers are validated when the pods are created
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Sidecars []v1.Container `json:"sidecars,omitempty"`
+	// SidecarVolumes are volumes added to the pods, to be mounted by the sidecars
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SidecarVolumes []v1.Volume `json:"sidecarVolumes,omitempty"`
+	// InitContainers are added to the pods after the init containers managed by the operator.
+	// The schema is not generated, the containers are validated when the pods are created
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	InitContainers []v1.Container `json:"initContainers,omitempty"`
+	// TerminationGracePeriodSeconds of the pods. Defaults to 30 seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// PreStopCommand is run in the containers before they are stopped, e.g. to drain the in-flight
+	// requests or jobs. The command runs within the termination grace period
+	// +optional
+	PreStopCommand []string `json:"preStopCommand,omitempty"`
+	// DNSPolicy of the pods. Defaults to ClusterFirst
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *v1.DNSPolicy `json:"dnsPolicy,omitempty"`
+	// DNSConfig of the pods, e.g. to add search domains or to tune the ndots option.
+	// It is merged with the DNS configuration generated from the DNSPolicy
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// HostAliases are added to the hosts file of the pods, e.g. to resolve the hostnames
+	// that are not in the cluster DNS
+	// +optional
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+	// RuntimeClassName of the pods, e.g. to run them with a sandboxed container runtime.
+	// When not set, the default container runtime is used
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// ImagePullPolicy of the containers of the pods. Overrides the global image pull policy of the APIManager.
+	// When neither is set, the images are pulled if not present, except for zync-que which always pulls them
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// ImagePullSecrets of the pods, e.g. to pull the images of the component from a different private
+	// registry. Overrides spec.imagePullSecrets and is combined with the default image pull secrets
+	// according to the image pull secrets policy. Set in the zync-que service account for zync-que
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+// ApicastSpec defines the settings of the apicast gateways
+type ApicastSpec struct {
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	ManagementAPI *string `json:"managementAPI,omitempty"`
+	// +optional
+	OpenSSLVerify *bool `json:"openSSLVerify,omitempty"`
+	// +optional
+	ResponseCodes *bool `json:"responseCodes,omitempty"`
+	// +optional
+	RegistryURL *string `json:"registryURL,omitempty"`
+	// +optional
+	Production *ApicastProductionSpec `json:"production,omitempty"`
+	// +optional
+	Staging *ApicastStagingSpec `json:"staging,omitempty"`
+}
+
+type ApicastProductionSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// HPA scales the pods with a HorizontalPodAutoscaler instead of a fixed number
+	// of replicas. Replicas cannot be set along with it
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Workers *int32 `json:"workers,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+	LogLevel *string `json:"logLevel,omitempty"` // APICAST_LOG_LEVEL
+	// CustomPolicies specifies an array of defined custome policies to be loaded
+	// +optional
+	CustomPolicies []CustomPolicySpec `json:"customPolicies,omitempty"`
+	// OpenTracing contains the OpenTracing integration configuration
+	// with APIcast in the production environment.
+	// +optional
+	OpenTracing *APIcastOpenTracingSpec `json:"openTracing,omitempty"`
+	// CustomEnvironments specifies an array of defined custom environments to be loaded
+	// +optional
+	CustomEnvironments []CustomEnvironmentSpec `json:"customEnvironments,omitempty"` // APICAST_ENVIRONMENT
+	// Warmup sends requests to the gateway before the pod is marked as ready,
+	// so rollouts do not route traffic to cold pods
+	// +optional
+	Warmup *APIcastWarmupSpec `json:"warmup,omitempty"`
+}
+
+type ApicastStagingSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+	LogLevel *string `json:"logLevel,omitempty"` // APICAST_LOG_LEVEL
+	// CustomPolicies specifies an array of defined custome policies to be loaded
+	// +optional
+	CustomPolicies []CustomPolicySpec `json:"customPolicies,omitempty"`
+	// OpenTracing contains the OpenTracing integration configuration
+	// with APIcast in the staging environment.
+	// +optional
+	OpenTracing *APIcastOpenTracingSpec `json:"openTracing,omitempty"`
+	// CustomEnvironments specifies an array of defined custom environments to be loaded
+	// +optional
+	CustomEnvironments []CustomEnvironmentSpec `json:"customEnvironments,omitempty"` // APICAST_ENVIRONMENT
+	// Warmup sends requests to the gateway before the pod is marked as ready,
+	// so rollouts do not route traffic to cold pods
+	// +optional
+	Warmup *APIcastWarmupSpec `json:"warmup,omitempty"`
+}
+
+// CustomPolicySpec contains or has reference to an APIcast custom policy
+type CustomPolicySpec struct {
+	// Name specifies the name of the custom policy
+	Name string `json:"name"`
+	// Version specifies the name of the custom policy
+	Version string `json:"version"`
+	// SecretRef specifies the secret holding the custom policy metadata and lua code
+	SecretRef *v1.LocalObjectReference `json:"secretRef"`
+}
+
+// CustomEnvironmentSpec contains or has reference to an APIcast custom environment
+type CustomEnvironmentSpec struct {
+	SecretRef *v1.LocalObjectReference `json:"secretRef"`
+}
+
+type APIcastOpenTracingSpec struct {
+	// Enabled controls whether OpenTracing integration with APIcast is enabled.
+	// By default it is not enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	// TracingLibrary controls which OpenTracing library is loaded. At the moment
+	// the only supported tracer is `jaeger`. If not set, `jaeger` will be used.
+	TracingLibrary *string `json:"tracingLibrary,omitempty"`
+	// TracingConfigSecretRef contains a secret reference the OpenTracing configuration.
+	// Each supported tracing library provides a default configuration file
+	// that is used if TracingConfig is not specified.
+	// +optional
+	TracingConfigSecretRef *v1.LocalObjectReference `json:"tracingConfigSecretRef,omitempty"`
+}
+
+// APIcastWarmupSpec configures the requests sent to the local gateway from the
+// readiness probe. The pod is not ready until all of them get a response, which
+// loads the gateway configuration of the hosts and resolves the upstream connections.
+// Failed requests are retried on the next probe, they never restart the container
+type APIcastWarmupSpec struct {
+	// Enabled turns on the warm-up. Defaults to false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Targets are the requests sent to the gateway
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Targets []APIcastWarmupTargetSpec `json:"targets"`
+	// TimeoutSeconds bounds each warm-up request. Defaults to 5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// APIcastWarmupTargetSpec defines a warm-up request
+type APIcastWarmupTargetSpec struct {
+	// Host sent in the Host header, usually the public host of a product
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	Host string `json:"host"`
+	// Path requested. Defaults to /
+	// +kubebuilder:validation:Pattern=`^/[a-zA-Z0-9._~!&()*+,;=:@%/?-]*$`
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// BackendSpec defines the settings of the backend components
+type BackendSpec struct {
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	Listener *BackendListenerSpec `json:"listener,omitempty"`
+	// +optional
+	Worker *BackendWorkerSpec `json:"worker,omitempty"`
+	// +optional
+	Cron *BackendCronSpec `json:"cron,omitempty"`
+}
+
+type BackendListenerSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// RequestLogging temporarily enables the request logging of backend-listener.
+	// The operator disables it once the TTL has expired
+	// +optional
+	RequestLogging *BackendListenerRequestLoggingSpec `json:"requestLogging,omitempty"`
+}
+
+type BackendListenerRequestLoggingSpec struct {
+	// Enabled turns on the request logging. Set back to false by the operator when the TTL expires
+	Enabled bool `json:"enabled"`
+	// SamplingRate is the percentage of requests logged. Defaults to 100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingRate *int32 `json:"samplingRate,omitempty"`
+	// TTLSeconds is the time the request logging is kept enabled. Defaults to 3600
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTLSeconds *int64 `json:"ttlSeconds,omitempty"`
+}
+
+type BackendWorkerSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type BackendCronSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// SystemSpec defines the settings of the system components
+type SystemSpec struct {
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// CacheStore selects the system rails cache store.
+	// When redis is selected, system-memcache is not deployed and system-redis is used for caching.
+	// Defaults to memcached
+	// +kubebuilder:validation:Enum=memcached;redis
+	// +optional
+	CacheStore *string `json:"cacheStore,omitempty"`
+	// +optional
+	App *SystemAppSpec `json:"app,omitempty"`
+	// +optional
+	Sidekiq *SystemSidekiqSpec `json:"sidekiq,omitempty"`
+	// +optional
+	Sphinx *SystemSphinxSpec `json:"sphinx,omitempty"`
+	// Memcached configures system-memcache. Does not take effect when
+	// the cache store is redis
+	// +optional
+	Memcached *MemcachedSpec `json:"memcached,omitempty"`
+	// DeveloperPortal configures the developer portal of the tenants.
+	// When not set, the developer portal is enabled
+	// +optional
+	DeveloperPortal *SystemDeveloperPortalSpec `json:"developerPortal,omitempty"`
+	// InboundEmail configures the mailbox the support replies and bounced
+	// emails are fetched from. When not set, it is configured in the admin portal
+	// +optional
+	InboundEmail *SystemInboundEmailSpec `json:"inboundEmail,omitempty"`
+}
+
+type SystemAppSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
+	// +optional
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
+	// +optional
+	MasterContainerResources *v1.ResourceRequirements `json:"masterContainerResources,omitempty"`
+	// +optional
+	ProviderContainerResources *v1.ResourceRequirements `json:"providerContainerResources,omitempty"`
+	// +optional
+	DeveloperContainerResources *v1.ResourceRequirements `json:"developerContainerResources,omitempty"`
+}
+
+type SystemSidekiqSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
+	// +optional
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type SystemSphinxSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Probes tunes the liveness probe and adds a startup probe to the containers.
+	// Unset values keep the default probes. The sphinx containers have no readiness probe
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// MemcachedSpec defines the settings of system-memcache
+type MemcachedSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the memcached pods
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the memcached container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// SystemDeveloperPortalSpec defines whether the developer portal is served
+type SystemDeveloperPortalSpec struct {
+	// Enabled serves the developer portal. When disabled, the system-developer
+	// container, service and routes are removed. Defaults to true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SystemInboundEmailSpec defines the mailbox system fetches
+// the inbound emails from
+type SystemInboundEmailSpec struct {
+	// Protocol used to fetch the emails
+	// +kubebuilder:validation:Enum=imap;pop3
+	Protocol string `json:"protocol"`
+	// Host of the mail server
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// Port of the mail server. Defaults to the well-known port of the protocol:
+	// 993 (imap) and 995 (pop3) with SSL, 143 (imap) and 110 (pop3) without it
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// SSL connects to the mail server over TLS. Defaults to true
+	// +optional
+	SSL *bool `json:"ssl,omitempty"`
+	// CredentialsSecretRef references the secret holding the mailbox
+	// credentials in the `username` and `password` keys
+	CredentialsSecretRef v1.LocalObjectReference `json:"credentialsSecretRef"`
+}
+
+// ZyncSpec defines the settings of the zync components
+type ZyncSpec struct {
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	App *ZyncAppSpec `json:"app,omitempty"`
+	// +optional
+	Que *ZyncQueSpec `json:"que,omitempty"`
+}
+
+type ZyncAppSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// WaitInitContainer overrides the init container waiting for the dependencies of the component,
+	// e.g. to disable it when the default wait loop cannot reach them
+	// +optional
+	WaitInitContainer *InitContainerSpec `json:"waitInitContainer,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
+	// +optional
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type ZyncQueSpec struct {
+	PodTemplateOverridesSpec `json:",inline"`
+
+	// Replicas of the DeploymentConfig. When not set, replicas are only set
+	// on creation, so they can be managed externally, e.g. by a HorizontalPodAutoscaler
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// Probes tunes the liveness and readiness probes and adds a startup probe
+	// to the containers. Unset values keep the default probes
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// RuntimeTuning tunes the memory allocator and the garbage collector of the Ruby processes.
+	// Unset values keep the defaults of the image
+	// +optional
+	RuntimeTuning *RubyRuntimeTuningSpec `json:"runtimeTuning,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// ServiceAccountToken configures the credentials zync-que uses to
+	// manage routes through the API server
+	// +optional
+	ServiceAccountToken *ZyncQueServiceAccountTokenSpec `json:"serviceAccountToken,omitempty"`
+	// WorkerCount is the number of que workers processing the jobs in each pod. Defaults to 10
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkerCount *int32 `json:"workerCount,omitempty"` // QUE_WORKER_COUNT
+	// PollingInterval is the number of seconds the idle que workers wait
+	// before polling the jobs queue again. Defaults to 5
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"` // QUE_POLL_INTERVAL
+}
+
+// ZyncQueServiceAccountTokenSpec configures the projected ServiceAccount
+// token mounted into the zync-que pods
+type ZyncQueServiceAccountTokenSpec struct {
+	// Legacy makes zync-que use the ServiceAccount token automounted
+	// by the cluster instead of a projected token. Needed on clusters
+	// without the kube-root-ca.crt ConfigMap
+	// +optional
+	Legacy *bool `json:"legacy,omitempty"`
+	// Audience of the projected token. Defaults to the API server audience
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+	// ExpirationSeconds is the requested validity of the projected token.
+	// The kubelet refreshes it before it expires. Defaults to 3600
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// NetworkingSpec defines how the components are exposed and how they
+// reach the components managed outside of the APIManager
+type NetworkingSpec struct {
+	// +optional
+	Apicast *ApicastNetworkingSpec `json:"apicast,omitempty"`
+	// SystemMasterRoute configures the external route of the master admin portal.
+	// When not set, the master route is created
+	// +optional
+	SystemMasterRoute *SystemMasterRouteSpec `json:"systemMasterRoute,omitempty"`
+	// +optional
+	Zync *ZyncNetworkingSpec `json:"zync,omitempty"`
+	// ExternalBackend configures system and apicast to use a backend managed outside
+	// of the APIManager. The backend-listener, backend-worker and backend-cron objects
+	// are not deployed and the backend redis must be external. Objects deployed before
+	// switching to an external backend are left in place but they are no longer reconciled
+	// +optional
+	ExternalBackend *ExternalBackendSpec `json:"externalBackend,omitempty"`
+	// ExternalZync configures system to use a zync managed outside of the APIManager.
+	// The zync, zync-que and zync-database objects are not deployed. Objects
+	// deployed before switching to an external zync are left in place
+	// but they are no longer reconciled
+	// +optional
+	ExternalZync *ExternalZyncSpec `json:"externalZync,omitempty"`
+}
+
+// ApicastNetworkingSpec defines the TLS, proxies and load balancing
+// settings of the apicast gateways
+type ApicastNetworkingSpec struct {
+	// +optional
+	Production *ApicastProductionNetworkingSpec `json:"production,omitempty"`
+	// +optional
+	Staging *ApicastStagingNetworkingSpec `json:"staging,omitempty"`
+}
+
+// ApicastProductionNetworkingSpec defines the TLS, proxies and load
+// balancing settings of the apicast production gateway
+type ApicastProductionNetworkingSpec struct {
+	// HttpsPort controls on which port APIcast should start listening for HTTPS connections.
+	// If this clashes with HTTP port it will be used only for HTTPS.
+	// Enable TLS at APIcast pod level setting either `httpsPort` or `httpsCertificateSecretRef` fields or both.
+	// +optional
+	HTTPSPort *int32 `json:"httpsPort,omitempty"` // APICAST_HTTPS_PORT
+	// HTTPSVerifyDepth defines the maximum length of the client certificate chain.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HTTPSVerifyDepth *int64 `json:"httpsVerifyDepth,omitempty"` // APICAST_HTTPS_VERIFY_DEPTH
+	// HTTPSCertificateSecretRef references secret containing the X.509 certificate in the PEM format and the X.509 certificate secret key.
+	// Enable TLS at APIcast pod level setting either `httpsPort` or `httpsCertificateSecretRef` fields or both.
+	// +optional
+	HTTPSCertificateSecretRef *v1.LocalObjectReference `json:"httpsCertificateSecretRef,omitempty"`
+	// ClientTLS enables the verification of the client certificates
+	// against a CA bundle. Requires TLS at APIcast pod level to be enabled.
+	// +optional
+	ClientTLS *APIcastClientTLSSpec `json:"clientTLS,omitempty"`
+	// AllProxy specifies a HTTP(S) proxy to be used for connecting to services if
+	// a protocol-specific proxy is not specified. Authentication is not supported.
+	// Format is <scheme>://<host>:<port>
+	// +optional
+	AllProxy *string `json:"allProxy,omitempty"` // ALL_PROXY
+	// HTTPProxy specifies a HTTP(S) Proxy to be used for connecting to HTTP services.
+	// Authentication is not supported. Format is <scheme>://<host>:<port>
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty"` // HTTP_PROXY
+	// HTTPSProxy specifies a HTTP(S) Proxy to be used for connecting to HTTPS services.
+	// Authentication is not supported. Format is <scheme>://<host>:<port>
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"` // HTTPS_PROXY
+	// NoProxy specifies a comma-separated list of hostnames and domain
+	// names for which the requests should not be proxied. Setting to a single
+	// * character, which matches all hosts, effectively disables the proxy.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"` // NO_PROXY
+	// LBDeregistrationDelaySeconds delays the termination of the pods with a preStop hook,
+	// so external load balancers deregister them while the gateway still serves traffic.
+	// The termination grace period is extended by the delay. Defaults to no delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	LBDeregistrationDelaySeconds *int64 `json:"lbDeregistrationDelaySeconds,omitempty"`
+	// ReadinessGates of the pods, i.e. conditions set by external load balancer controllers
+	// once the pod is registered
+	// +optional
+	ReadinessGates []v1.PodReadinessGate `json:"readinessGates,omitempty"`
+	// AWSLoadBalancer annotates the service for the AWS load balancer controller
+	// +optional
+	AWSLoadBalancer *APIcastAWSLoadBalancerSpec `json:"awsLoadBalancer,omitempty"`
+}
+
+// ApicastStagingNetworkingSpec defines the TLS, proxies and load
+// balancing settings of the apicast staging gateway
+type ApicastStagingNetworkingSpec struct {
+	// HttpsPort controls on which port APIcast should start listening for HTTPS connections.
+	// If this clashes with HTTP port it will be used only for HTTPS.
+	// Enable TLS at APIcast pod level setting either `httpsPort` or `httpsCertificateSecretRef` fields or both.
+	// +optional
+	HTTPSPort *int32 `json:"httpsPort,omitempty"` // APICAST_HTTPS_PORT
+	// HTTPSVerifyDepth defines the maximum length of the client certificate chain.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HTTPSVerifyDepth *int64 `json:"httpsVerifyDepth,omitempty"` // APICAST_HTTPS_VERIFY_DEPTH
+	// HTTPSCertificateSecretRef references secret containing the X.509 certificate in the PEM format and the X.509 certificate secret key.
+	// Enable TLS at APIcast pod level setting either `httpsPort` or `httpsCertificateSecretRef` fields or both.
+	// +optional
+	HTTPSCertificateSecretRef *v1.LocalObjectReference `json:"httpsCertificateSecretRef,omitempty"`
+	// AllProxy specifies a HTTP(S) proxy to be used for connecting to services if
+	// a protocol-specific proxy is not specified. Authentication is not supported.
+	// Format is <scheme>://<host>:<port>
+	// +optional
+	AllProxy *string `json:"allProxy,omitempty"` // ALL_PROXY
+	// HTTPProxy specifies a HTTP(S) Proxy to be used for connecting to HTTP services.
+	// Authentication is not supported. Format is <scheme>://<host>:<port>
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty"` // HTTP_PROXY
+	// HTTPSProxy specifies a HTTP(S) Proxy to be used for connecting to HTTPS services.
+	// Authentication is not supported. Format is <scheme>://<host>:<port>
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"` // HTTPS_PROXY
+	// NoProxy specifies a comma-separated list of hostnames and domain
+	// names for which the requests should not be proxied. Setting to a single
+	// * character, which matches all hosts, effectively disables the proxy.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"` // NO_PROXY
+	// LBDeregistrationDelaySeconds delays the termination of the pods with a preStop hook,
+	// so external load balancers deregister them while the gateway still serves traffic.
+	// The termination grace period is extended by the delay. Defaults to no delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	LBDeregistrationDelaySeconds *int64 `json:"lbDeregistrationDelaySeconds,omitempty"`
+	// ReadinessGates of the pods, i.e. conditions set by external load balancer controllers
+	// once the pod is registered
+	// +optional
+	ReadinessGates []v1.PodReadinessGate `json:"readinessGates,omitempty"`
+	// AWSLoadBalancer annotates the service for the AWS load balancer controller
+	// +optional
+	AWSLoadBalancer *APIcastAWSLoadBalancerSpec `json:"awsLoadBalancer,omitempty"`
+}
+
+// APIcastClientTLSSpec configures the verification of the client certificates
+type APIcastClientTLSSpec struct {
+	// CASecretRef references the secret containing the PEM encoded CA bundle
+	// in the `ca.crt` key. Client certificates not issued by any of the CAs are rejected
+	CASecretRef *v1.LocalObjectReference `json:"caSecretRef"`
+	// VerifyDepth defines the maximum length of the client certificate chain.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	VerifyDepth *int64 `json:"verifyDepth,omitempty"` // APICAST_HTTPS_VERIFY_DEPTH
+}
+
+// APIcastAWSLoadBalancerSpec configures the target group of the AWS load balancer
+// controller. The deregistration delay of the target group is set to the
+// lbDeregistrationDelaySeconds of the gateway
+type APIcastAWSLoadBalancerSpec struct {
+	// TargetType of the target group, ip or instance. Defaults to ip
+	// +kubebuilder:validation:Enum=ip;instance
+	// +optional
+	TargetType *string `json:"targetType,omitempty"`
+	// TargetGroupBindingName adds the target health readiness gate of the TargetGroupBinding,
+	// so the pods are not ready until they are healthy in the target group
+	// +optional
+	TargetGroupBindingName *string `json:"targetGroupBindingName,omitempty"`
+}
+
+// SystemMasterRouteSpec defines whether the master admin portal is exposed
+type SystemMasterRouteSpec struct {
+	// Enabled exposes the master admin portal in the master route. When disabled,
+	// the master route is removed and the master admin portal is only reachable
+	// in the cluster through the system-master service. Defaults to true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ZyncNetworkingSpec defines how zync handles the requests forwarded by proxies
+type ZyncNetworkingSpec struct {
+	// ForceSSL makes zync generate https URLs and treat incoming requests
+	// as secure. Useful when TLS is terminated before reaching zync,
+	// for example by a service mesh.
+	// +optional
+	ForceSSL *bool `json:"forceSSL,omitempty"` // FORCE_SSL
+	// TrustedProxies specifies the list of CIDRs of the proxies whose
+	// X-Forwarded-* headers are trusted by zync
+	// +optional
+	TrustedProxies []string `json:"trustedProxies,omitempty"` // TRUSTED_PROXIES
+}
+
+// ExternalBackendSpec configures the connection of system and apicast to an external backend
+type ExternalBackendSpec struct {
+	// ListenerEndpoint is the URL of the external backend-listener
+	// system and apicast connect to. I.e. http://backend-listener.example.com:3000
+	// +kubebuilder:validation:Pattern=`^https?://`
+	ListenerEndpoint string `json:"listenerEndpoint"`
+	// RouteEndpoint is the public URL of the external backend-listener the gateways
+	// deployed outside of the cluster are configured with. Defaults to the listener endpoint
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RouteEndpoint *string `json:"routeEndpoint,omitempty"`
+	// InternalAPISecretRef references the secret containing the credentials
+	// of the backend internal API in the username and password keys
+	InternalAPISecretRef v1.LocalObjectReference `json:"internalAPISecretRef"`
+	// ConnectivityCheckEnabled periodically checks the listener endpoint is reachable.
+	// The result is reported in the BackendReachable condition
+	// +optional
+	ConnectivityCheckEnabled *bool `json:"connectivityCheckEnabled,omitempty"`
+}
+
+// ExternalZyncSpec configures the connection of system to an external zync
+type ExternalZyncSpec struct {
+	// Endpoint is the URL of the external zync API
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint"`
+	// SecretRef references the secret containing the zync authentication
+	// token in the ZYNC_AUTHENTICATION_TOKEN key
+	SecretRef v1.LocalObjectReference `json:"secretRef"`
+}
+
+// StorageSpec defines the file storage and the databases of the APIManager
+type StorageSpec struct {
+	// +optional
+	SystemFileStorage *SystemFileStorageSpec `json:"systemFileStorage,omitempty"`
+	// +optional
+	SystemDatabase *SystemDatabaseSpec `json:"systemDatabase,omitempty"`
+	// SystemRedis configures the internal system redis
+	// +optional
+	SystemRedis *RedisSpec `json:"systemRedis,omitempty"`
+	// BackendRedis configures the internal backend redis
+	// +optional
+	BackendRedis *RedisSpec `json:"backendRedis,omitempty"`
+	// ZyncDatabase configures the zync database, internal or external
+	// +optional
+	ZyncDatabase *ZyncDatabaseSpec `json:"zyncDatabase,omitempty"`
+	// +optional
+	ExternalComponents *ExternalComponentsSpec `json:"externalComponents,omitempty"`
+	// +optional
+	HighAvailability *HighAvailabilitySpec `json:"highAvailability,omitempty"`
+}
+
+type SystemFileStorageSpec struct {
+	// Union type. Only one of the fields can be set.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimSpec `json:"persistentVolumeClaim,omitempty"`
+	// +optional
+	// Deprecated
+	DeprecatedS3 *DeprecatedSystemS3Spec `json:"amazonSimpleStorageService,omitempty"`
+	// +optional
+	S3 *SystemS3Spec `json:"simpleStorageService,omitempty"`
+}
+
+// PersistentVolumeClaimSpec defines the PersistentVolumeClaim of a component
+type PersistentVolumeClaimSpec struct {
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources represents the minimum resources the volume should have.
+	// Ignored when VolumeName field is set
+	// +optional
+	Resources *PersistentVolumeClaimResources `json:"resources,omitempty"`
+	// VolumeName is the binding reference to the PersistentVolume backing this claim.
+	// +optional
+	VolumeName *string `json:"volumeName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PersistentVolumeClaimResources defines the resources requested by a PersistentVolumeClaim
+type PersistentVolumeClaimResources struct {
+	// Storage Resource requests to be used on the PersistentVolumeClaim.
+	// To learn more about resource requests see:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Requests resource.Quantity `json:"requests"`
+}
+
+type DeprecatedSystemS3Spec struct {
+	// Deprecated
+	AWSBucket string `json:"awsBucket"`
+	// Deprecated
+	AWSRegion string `json:"awsRegion"`
+	// Deprecated
+	AWSCredentials v1.LocalObjectReference `json:"awsCredentialsSecret"`
+}
+
+type SystemS3Spec struct {
+	ConfigurationSecretRef v1.LocalObjectReference `json:"configurationSecretRef"`
+}
+
+type SystemDatabaseSpec struct {
+	// Union type. Only one of the fields can be set
+	// +optional
+	MySQL *SystemMySQLSpec `json:"mysql,omitempty"`
+	// +optional
+	PostgreSQL *SystemPostgreSQLSpec `json:"postgresql,omitempty"`
+}
+
+type SystemMySQLSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimSpec `json:"persistentVolumeClaim,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user the database runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+	// SharedMemorySizeLimit mounts a memory backed volume of the given size
+	// at /dev/shm. When not set the container runtime default is used
+	// +optional
+	SharedMemorySizeLimit *resource.Quantity `json:"sharedMemorySizeLimit,omitempty"`
+}
+
+type SystemPostgreSQLSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimSpec `json:"persistentVolumeClaim,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user the database runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// RedisSpec defines the settings of an internal redis
+type RedisSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// +optional
+	PersistentVolumeClaim *RedisPersistentVolumeClaimSpec `json:"persistentVolumeClaim,omitempty"`
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// PodSecurityContext of the redis pods. The fsGroup is mandatory, so the
+	// PersistentVolumeClaim data is writable by the user redis runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the redis container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// RedisPersistentVolumeClaimSpec defines the PersistentVolumeClaim of an internal redis
+type RedisPersistentVolumeClaimSpec struct {
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Annotations added to the PersistentVolumeClaim.
+	// Annotations set by other tools are kept.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels added to the PersistentVolumeClaim.
+	// Labels set by the operator cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ZyncDatabaseSpec defines the settings of the zync database
+type ZyncDatabaseSpec struct {
+	PodPlacementSpec `json:",inline"`
+
+	// Image of the internal zync database
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// PodSecurityContext of the internal zync database pods. The fsGroup is mandatory when
+	// the data is stored in a PersistentVolumeClaim, so it is writable by the user the database runs as
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext of the internal zync database container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+	// Resources of the internal zync database container
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// SharedMemorySizeLimit mounts a memory backed volume of the given size
+	// at /dev/shm of the internal zync database. When not set the container runtime default is used
+	// +optional
+	SharedMemorySizeLimit *resource.Quantity `json:"sharedMemorySizeLimit,omitempty"`
+	// PersistentVolumeClaim stores the data of the internal zync database in a PersistentVolumeClaim.
+	// When not set the data is stored in an ephemeral volume
+	// +optional
+	PersistentVolumeClaim *ZyncDatabasePersistentVolumeClaimSpec `json:"persistentVolumeClaim,omitempty"`
+	// Maintenance configures the periodic maintenance of the internal zync database
+	// +optional
+	Maintenance *ZyncDatabaseMaintenanceSpec `json:"maintenance,omitempty"`
+	// Connection configures the connection to an external zync database
+	// +optional
+	Connection *ZyncDatabaseConnectionSpec `json:"connection,omitempty"`
+}
+
+// ZyncDatabasePersistentVolumeClaimSpec configures the PersistentVolumeClaim of the internal zync database
+type ZyncDatabasePersistentVolumeClaimSpec struct {
+	// Size requested for the volume. Increasing it expands the volume when the
+	// storage class allows volume expansion. Defaults to 1Gi
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// StorageClassName of the volume. The cluster default storage class is used when not set.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// VolumeName is the binding reference to the PersistentVolume backing this claim.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	VolumeName *string `json:"volumeName,omitempty"`
+	// AccessModes of the volume. Defaults to ReadWriteOnce.
+	// Cannot be changed once the PersistentVolumeClaim is created
+	// +optional
+	AccessModes []v1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
+// ZyncDatabaseMaintenanceSpec configures a CronJob vacuuming and analyzing
+// the internal zync database, which accumulates dead tuples from the que jobs
+type ZyncDatabaseMaintenanceSpec struct {
+	// Enabled deploys the maintenance CronJob. Defaults to false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Schedule of the maintenance in Cron format. Defaults to "0 3 * * 0"
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// Repack also runs pg_repack to reclaim the space of bloated tables when it
+	// is available in the database image. Defaults to false
+	// +optional
+	Repack *bool `json:"repack,omitempty"`
+}
+
+// ZyncDatabaseConnectionSpec configures the connection of zync and zync-que to an
+// external zync database
+type ZyncDatabaseConnectionSpec struct {
+	// SSLCASecretRef references the secret containing the PEM encoded CA bundle
+	// the database server certificate is verified against, in the `ca.crt` key.
+	// The client certificate and key can be provided in the `tls.crt` and
+	// `tls.key` keys. Changes of the secret roll out zync and zync-que
+	// +optional
+	SSLCASecretRef *v1.LocalObjectReference `json:"sslCASecretRef,omitempty"`
+	// SSLMode is the libpq SSL mode of the connection. Defaults to verify-full
+	// when SSLCASecretRef is set
+	// +kubebuilder:validation:Enum=disable;allow;prefer;require;verify-ca;verify-full
+	// +optional
+	SSLMode *string `json:"sslMode,omitempty"` // DATABASE_SSL_MODE
+}
+
+type ExternalComponentsSpec struct {
+	// +optional
+	System *ExternalSystemComponents `json:"system,omitempty"`
+	// +optional
+	Backend *ExternalBackendComponents `json:"backend,omitempty"`
+	// +optional
+	Zync *ExternalZyncComponents `json:"zync,omitempty"`
+}
+
+type ExternalSystemComponents struct {
+	// +optional
+	Redis *bool `json:"redis,omitempty"`
+	// RedisCASecretRef references the secret with the CA certificate, in the ca.crt key,
+	// of the external system redis when it is reached through rediss:// URLs
+	// +optional
+	RedisCASecretRef *v1.LocalObjectReference `json:"redisCASecretRef,omitempty"`
+	// +optional
+	Database *bool `json:"database,omitempty"`
+}
+
+type ExternalBackendComponents struct {
+	// +optional
+	Redis *bool `json:"redis,omitempty"`
+	// RedisCASecretRef references the secret with the CA certificate, in the ca.crt key,
+	// of the external backend redis when it is reached through rediss:// URLs
+	// +optional
+	RedisCASecretRef *v1.LocalObjectReference `json:"redisCASecretRef,omitempty"`
+}
+
+type ExternalZyncComponents struct {
+	// +optional
+	Database *bool `json:"database,omitempty"`
+}
+
+type HighAvailabilitySpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// +optional
+	ExternalZyncDatabaseEnabled *bool `json:"externalZyncDatabaseEnabled,omitempty"`
+}
+
+// DatabasesSpec configures the operations run on the internal databases
+type DatabasesSpec struct {
+	// Backup dumps the internal databases before upgrades
+	// +optional
+	Backup *DatabaseBackupSpec `json:"backup,omitempty"`
+}
+
+// DatabaseBackupSpec configures the logical backup of the internal system
+// and zync databases taken before upgrading to a new 3scale release.
+// External databases are not backed up
+type DatabaseBackupSpec struct {
+	// Enabled runs a dump job for each internal database when the 3scale
+	// release changes. The components are only upgraded once all the dumps completed
+	Enabled bool `json:"enabled,omitempty"`
+	// PersistentVolumeClaim stores the dumps in a PersistentVolumeClaim
+	// created by the operator. Union type with S3, only one of the fields can be set.
+	// The dumps are stored in a PersistentVolumeClaim when none is set
+	// +optional
+	PersistentVolumeClaim *DatabaseBackupPVCSpec `json:"persistentVolumeClaim,omitempty"`
+	// S3 uploads the dumps to an S3 compatible bucket
+	// +optional
+	S3 *DatabaseBackupS3Spec `json:"s3,omitempty"`
+	// Retention is the number of dumps kept for each database. Defaults to 3
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Retention *int32 `json:"retention,omitempty"`
+}
+
+// DatabaseBackupPVCSpec defines the PersistentVolumeClaim the dumps are stored in
+type DatabaseBackupPVCSpec struct {
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources represents the minimum resources the volume should have. Defaults to 10Gi
+	// +optional
+	Resources *PersistentVolumeClaimResources `json:"resources,omitempty"`
+}
+
+// DatabaseBackupS3Spec defines the bucket the dumps are uploaded to
+type DatabaseBackupS3Spec struct {
+	// SecretRef references the secret with the bucket, the endpoint and the credentials,
+	// in the format of the system file storage S3 configuration secret
+	SecretRef v1.LocalObjectReference `json:"secretRef"`
+}
+
+// MonitoringSpec defines the monitoring resources and the metrics sinks
+type MonitoringSpec struct {
+	// Enabled deploys the monitoring resources. Defaults to false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	EnablePrometheusRules *bool `json:"enablePrometheusRules,omitempty"`
+	// DatabaseExporters deploys prometheus exporters for the internal
+	// backend-redis, system-redis, system database and zync-database instances.
+	// External databases are not monitored.
+	// +optional
+	DatabaseExporters *bool `json:"databaseExporters,omitempty"`
+	// Apicast enables the monitoring resources of apicast. Defaults to true
+	// +optional
+	Apicast *bool `json:"apicast,omitempty"`
+	// Backend enables the monitoring of backend. When false, the backend
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	Backend *bool `json:"backend,omitempty"`
+	// System enables the monitoring of system. When false, the system
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	System *bool `json:"system,omitempty"`
+	// Zync enables the monitoring of zync. When false, the zync
+	// monitoring resources are removed and the pods do not expose metrics.
+	// Defaults to true
+	// +optional
+	Zync *bool `json:"zync,omitempty"`
+	// RecordingRules adds SLO-style availability and latency recording rules
+	// of the gateways, requires the PrometheusRules to be enabled
+	// +optional
+	RecordingRules *RecordingRulesSpec `json:"recordingRules,omitempty"`
+	// Grafana configures the datasource and the labels of the grafana dashboards
+	// +optional
+	Grafana *GrafanaSpec `json:"grafana,omitempty"`
+	// PrometheusRules overrides the severity and the threshold of the alerts
+	// generated by the operator, or disables them
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Metrics configures metrics sinks other than prometheus scraping
+	// +optional
+	Metrics *MetricsSpec `json:"metrics,omitempty"`
+}
+
+// PrometheusRulesSpec tunes the alerts of the PrometheusRules generated by the operator
+type PrometheusRulesSpec struct {
+	// Overrides of the alerts, matched by alert name
+	// +optional
+	Overrides []PrometheusRuleOverride `json:"overrides,omitempty"`
+}
+
+// PrometheusRuleOverride tunes a single alert
+type PrometheusRuleOverride struct {
+	// AlertName is the name of the alert, e.g. ThreescaleApicastJobDown
+	AlertName string `json:"alertName"`
+	// Severity replaces the severity label of the alert
+	// +optional
+	Severity *string `json:"severity,omitempty"`
+	// Threshold replaces the number the alert expression is compared to.
+	// Only applies to alerts whose expression ends with a single comparison to a number
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+	// Disabled removes the alert from the PrometheusRule
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// GrafanaSpec configures the grafana dashboards to match the grafana instance
+// importing them
+type GrafanaSpec struct {
+	// DatasourceName is the name of the prometheus datasource selected by
+	// default in the dashboards. Defaults to Prometheus
+	// +optional
+	DatasourceName *string `json:"datasourceName,omitempty"`
+	// DashboardLabels are the labels matched by the dashboardLabelSelector of
+	// the grafana instance. They replace the default monitoring-key: middleware label
+	// +optional
+	DashboardLabels map[string]string `json:"dashboardLabels,omitempty"`
+}
+
+// RecordingRulesSpec configures the recording rules of the apicast production
+// and backend-listener gateways. The success ratio and latency percentiles
+// are recorded per namespace, and the error budget burn rate alerts are derived
+// from the availability objectives
+type RecordingRulesSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// ApicastAvailabilityObjective is the percentage of apicast production
+	// requests expected not to fail with 5XX status codes. Defaults to 99.5
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	// +optional
+	ApicastAvailabilityObjective *string `json:"apicastAvailabilityObjective,omitempty"`
+	// BackendAvailabilityObjective is the percentage of backend-listener
+	// requests expected not to fail with 5XX status codes. Defaults to 99.5
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	// +optional
+	BackendAvailabilityObjective *string `json:"backendAvailabilityObjective,omitempty"`
+}
+
+type MetricsSpec struct {
+	// Statsd makes system and backend emit runtime metrics to a statsd server.
+	// Independent of spec.monitoring.enabled
+	// +optional
+	Statsd *StatsdSpec `json:"statsd,omitempty"`
+}
+
+type StatsdSpec struct {
+	// Host of the statsd server
+	Host string `json:"host"`
+	// Port of the statsd server. Defaults to 8125
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to send the metrics. Defaults to udp
+	// +kubebuilder:validation:Enum=udp;tcp
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+	// Prefix prepended to the metric names
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// SecuritySpec defines the authentication and the access
+// to the admin portal and APIs
+type SecuritySpec struct {
+	// AdminSSO configures the OpenID Connect single sign-on
+	// of the default tenant admin portal
+	// +optional
+	AdminSSO *SystemAdminSSOSpec `json:"adminSSO,omitempty"`
+	// AccessTokens are the access tokens the operator creates for the
+	// default tenant admin user, i.e. read-only tokens for integrations
+	// +optional
+	AccessTokens []SystemAccessTokenSpec `json:"accessTokens,omitempty"`
+	// CORS configures the cross-origin resource sharing headers
+	// of the admin and master APIs. When not set, CORS is disabled
+	// +optional
+	CORS *SystemCORSSpec `json:"cors,omitempty"`
+}
+
+// SystemAdminSSOSpec defines the identity provider the default tenant
+// admin portal users sign in with, i.e. a RH-SSO realm
+type SystemAdminSSOSpec struct {
+	// IssuerURL is the OpenID Connect issuer URL of the identity provider.
+	// I.e. https://sso.example.com/auth/realms/3scale
+	IssuerURL string `json:"issuerURL"`
+	// ClientCredentialsSecretRef references the secret holding the client
+	// credentials in the `clientID` and `clientSecret` keys
+	ClientCredentialsSecretRef v1.LocalObjectReference `json:"clientCredentialsSecretRef"`
+	// AutoProvision approves the admin portal users signing in
+	// for the first time. Defaults to false
+	// +optional
+	AutoProvision *bool `json:"autoProvision,omitempty"`
+	// Published makes the provider available in the admin portal
+	// login page. Defaults to true
+	// +optional
+	Published *bool `json:"published,omitempty"`
+}
+
+// SystemAccessTokenSpec defines an access token of the default tenant admin user.
+// The token value is stored in the `token` key of a secret owned by the APIManager
+type SystemAccessTokenSpec struct {
+	// Name of the access token. Unique among the access tokens
+	Name string `json:"name"`
+	// Scopes of the access token
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+	// Permission of the access token, ro (read-only) or rw (read-write). Defaults to ro
+	// +kubebuilder:validation:Enum=ro;rw
+	// +optional
+	Permission *string `json:"permission,omitempty"`
+	// SecretName is the secret the access token value is stored in
+	SecretName string `json:"secretName"`
+}
+
+// SystemCORSSpec defines the cross-origin requests allowed
+// on the admin and master APIs
+type SystemCORSSpec struct {
+	// AllowedOrigins are the origins allowed to send cross-origin requests.
+	// "*" allows any origin
+	// +kubebuilder:validation:MinItems=1
+	AllowedOrigins []string `json:"allowedOrigins"`
+	// AllowedHeaders are the request headers allowed in cross-origin requests.
+	// Defaults to any header
+	// +optional
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+	// MaxAge is the number of seconds the preflight responses can be cached
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAge *int32 `json:"maxAge,omitempty"`
+	// AllowCredentials allows cross-origin requests with credentials.
+	// Not allowed together with the "*" origin. Defaults to false
+	// +optional
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+}
+
+// APIManagerStatus defines the observed state of APIManager
+type APIManagerStatus struct {
+	// Current state of the APIManager resource.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+	// APIManager Deployment Configs
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Deployments",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podStatuses"
+	Deployments olm.DeploymentStatus `json:"deployments"`
+	// Components reports the replicas, readiness and deployed image
+	// of the DeploymentConfig of each component, by DeploymentConfig name
+	// +optional
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+	// Workloads reports the components whose containers have been
+	// terminated with failures (OOMKilled, CrashLoopBackOff),
+	// and the zone distribution of the multi-replica components
+	// +optional
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+	// Shutdown reports the progress of the ordered shutdown
+	// while the APIManager is being deleted
+	// +optional
+	Shutdown *ShutdownStatus `json:"shutdown,omitempty"`
+	// Standby reports the components kept scaled down by the standby mode
+	// and the progress of the activation
+	// +optional
+	Standby *StandbyStatus `json:"standby,omitempty"`
+	// BackendListenerRequestLogging reports when the backend-listener
+	// request logging was enabled and when it expires
+	// +optional
+	BackendListenerRequestLogging *RequestLoggingStatus `json:"backendListenerRequestLogging,omitempty"`
+	// AdminSSO reports the authentication provider configured
+	// in the default tenant for the admin portal single sign-on
+	// +optional
+	AdminSSO *AdminSSOStatus `json:"adminSSO,omitempty"`
+	// AccessTokens reports the access tokens created by the
+	// operator in the default tenant
+	// +optional
+	AccessTokens []AccessTokenStatus `json:"accessTokens,omitempty"`
+	// Hosts lists the hosts of the default routes computed
+	// from the wildcard domain and the tenant name
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+	// DeveloperPortal reports whether the developer portal is Enabled or Disabled
+	// +optional
+	DeveloperPortal string `json:"developerPortal,omitempty"`
+	// MasterEndpoint reports the endpoint the master admin portal is reachable at.
+	// The master route URL, or the internal system-master service URL
+	// when the master route is disabled
+	// +optional
+	MasterEndpoint string `json:"masterEndpoint,omitempty"`
+	// ZyncMode reports whether system uses the Internal zync deployed
+	// by the operator or an External zync
+	// +optional
+	ZyncMode string `json:"zyncMode,omitempty"`
+	// Topology reports whether the APIManager deploys the Full 3scale
+	// installation or only the gateways (GatewayOnly)
+	// +optional
+	Topology string `json:"topology,omitempty"`
+	// FileStorageMigration reports the progress of the migration
+	// of the system file storage from the PVC to S3
+	// +optional
+	FileStorageMigration *FileStorageMigrationStatus `json:"fileStorageMigration,omitempty"`
+	// ExternalBackendEndpoint reports the listener endpoint of the external
+	// backend system and apicast are connected to
+	// +optional
+	ExternalBackendEndpoint string `json:"externalBackendEndpoint,omitempty"`
+	// ThreescaleVersion is the 3scale release the components are deployed with.
+	// It is updated once the pre-upgrade database backup, when enabled, has completed
+	// +optional
+	ThreescaleVersion string `json:"threescaleVersion,omitempty"`
+	// DatabaseBackup reports the progress of the last pre-upgrade
+	// backup of the internal databases
+	// +optional
+	DatabaseBackup *DatabaseBackupStatus `json:"databaseBackup,omitempty"`
+}
+
+// StandbyStatus defines the observed state of the standby mode
+type StandbyStatus struct {
+	// ScaledDownComponents are the DeploymentConfigs kept with zero replicas.
+	// On activation, they are brought up one by one in dependency order
+	// +optional
+	ScaledDownComponents []string `json:"scaledDownComponents,omitempty"`
+	// ZyncResyncPending is set until zync domains have been resynchronized after activation
+	// +optional
+	ZyncResyncPending bool `json:"zyncResyncPending,omitempty"`
+}
+
+// DatabaseBackupStatus defines the observed state of the pre-upgrade database backup
+type DatabaseBackupStatus struct {
+	// Phase of the backup: Running, Completed or Failed.
+	// The upgrade is halted until the backup has completed
+	Phase string `json:"phase"`
+	// ThreescaleVersion is the 3scale release being upgraded to
+	ThreescaleVersion string `json:"threescaleVersion"`
+	// JobNames are the names of the jobs dumping the internal databases
+	// +optional
+	JobNames []string `json:"jobNames,omitempty"`
+	// StartTime is the time the backup started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time all the database dumps completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message holds the reason of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FileStorageMigrationStatus defines the observed state of the system file storage migration
+type FileStorageMigrationStatus struct {
+	// Phase of the migration: Syncing, Completed or Failed.
+	// The file storage is only switched to S3 once the files have been synced
+	Phase string `json:"phase"`
+	// ConfigurationSecretName is the S3 configuration secret of the target bucket
+	ConfigurationSecretName string `json:"configurationSecretName"`
+	// JobName is the name of the job syncing the files
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+	// StartTime is the time the migration was requested
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the file storage was switched to S3
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Files found in the system-storage PVC by the last sync
+	// +optional
+	Files int64 `json:"files,omitempty"`
+	// UploadedFiles is the number of files uploaded by the last sync.
+	// Files already in the bucket with the same content are skipped
+	// +optional
+	UploadedFiles int64 `json:"uploadedFiles,omitempty"`
+	// Message holds the reason of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+	// PVCRetained is set while the system-storage PVC is kept after the migration
+	// +optional
+	PVCRetained bool `json:"pvcRetained,omitempty"`
+}
+
+// RequestLoggingStatus defines the observed state of a temporary request logging
+type RequestLoggingStatus struct {
+	// StartTime is the time the request logging was enabled
+	StartTime metav1.Time `json:"startTime"`
+	// ExpirationTime is the time the request logging is disabled by the operator
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// AdminSSOStatus defines the observed state of the admin portal single sign-on
+type AdminSSOStatus struct {
+	// ProviderID is the ID of the authentication provider in the default tenant
+	ProviderID int64 `json:"providerID"`
+	// Created is true when the authentication provider was created by the operator.
+	// Only created providers are removed when the single sign-on is disabled
+	// +optional
+	Created bool `json:"created,omitempty"`
+	// CredentialsHash is the hash of the client credentials last configured in the provider
+	// +optional
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+}
+
+// AccessTokenStatus defines the observed state of an access token of the default tenant
+type AccessTokenStatus struct {
+	// Name of the access token
+	Name string `json:"name"`
+	// ID of the access token in the default tenant
+	ID int64 `json:"id"`
+	// SecretName is the secret holding the access token value
+	SecretName string `json:"secretName"`
+}
+
+// ShutdownStatus defines the observed state of the ordered shutdown
+type ShutdownStatus struct {
+	// Stage currently being run
+	Stage string `json:"stage"`
+	// StageStartTime is the time the current stage started
+	StageStartTime metav1.Time `json:"stageStartTime"`
+}
+
+// ComponentStatus defines the observed state of the DeploymentConfig of an APIManager component
+type ComponentStatus struct {
+	// DesiredReplicas is the number of replicas of the DeploymentConfig
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// AvailableReplicas is the number of available pods of the DeploymentConfig
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// Ready is true when the DeploymentConfig is available and its latest rollout is complete
+	Ready bool `json:"ready"`
+	// Degraded is true when the latest rollout failed or the containers are crash-looping
+	// +optional
+	Degraded bool `json:"degraded,omitempty"`
+	// Image of the first container of the DeploymentConfig pod template
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version is the 3scale release the component is labeled with
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// WorkloadStatus defines the observed failures and zone distribution of an APIManager component
+type WorkloadStatus struct {
+	// Name of the component's DeploymentConfig
+	Name string `json:"name"`
+	// LastFailure is the most recent container failure observed in the component's pods
+	// +optional
+	LastFailure *WorkloadFailure `json:"lastFailure,omitempty"`
+	// Zones is the distribution of the scheduled pods per topology zone.
+	// Only reported for components with more than one replica
+	// +optional
+	Zones []WorkloadZone `json:"zones,omitempty"`
+}
+
+// WorkloadZone describes the pods of a component scheduled in a topology zone
+type WorkloadZone struct {
+	// Name of the zone
+	Name string `json:"name"`
+	// Pods is the number of pods scheduled in the zone
+	Pods int32 `json:"pods"`
+}
+
+// WorkloadFailure describes a container failure
+type WorkloadFailure struct {
+	// Reason of the failure: OOMKilled, CrashLoopBackOff
+	Reason string `json:"reason"`
+	// Count is the number of restarts of the failing container
+	Count int32 `json:"count"`
+	// Timestamp of the last container termination
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+	// Container name
+	Container string `json:"container"`
+	// Message is the termination message of the last container termination
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// APIManager is the Schema for the apimanagers API
+// +kubebuilder:resource:path=apimanagers,scope=Namespaced
+// +operator-sdk:csv:customresourcedefinitions:displayName="APIManager"
+// +operator-sdk:csv:customresourcedefinitions:resources={{"DeploymentConfig","apps.openshift.io/v1"}}
+// +operator-sdk:csv:customresourcedefinitions:resources={{"PersistentVolumeClaim","v1"}}
+// +operator-sdk:csv:customresourcedefinitions:resources={{"Service","v1"}}
+// +operator-sdk:csv:customresourcedefinitions:resources={{"Route","route.openshift.io/v1"}}
+// +operator-sdk:csv:customresourcedefinitions:resources={{"ImageStream","image.openshift.io/v1"}}
+type APIManager struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Spec APIManagerSpec `json:"spec,omitempty"`
+
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Status APIManagerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIManagerList contains a list of APIManager
+type APIManagerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIManager `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&APIManager{}, &APIManagerList{})
+}
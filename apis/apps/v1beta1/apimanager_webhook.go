@@ -0,0 +1,17 @@
+package v1beta1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Hub = &APIManager{}
+
+// Hub marks v1beta1 as the version every other APIManager version converts to and from
+func (*APIManager) Hub() {}
+
+// SetupWebhookWithManager registers the APIManager conversion webhook.
+// The webhook is served at /convert once all the APIManager versions are in the manager scheme
+func (apimanager *APIManager) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(apimanager).Complete()
+}
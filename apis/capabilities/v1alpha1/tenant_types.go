@@ -49,6 +49,15 @@ type TenantStatus struct {
 
 	TenantId int64 `json:"tenantId"`
 	AdminId  int64 `json:"adminId"`
+
+	// AdminHost is the host of the tenant admin portal, computed from
+	// the organization name and the master URL before creating the tenant
+	// +optional
+	AdminHost string `json:"adminHost,omitempty"`
+	// DeveloperHost is the host of the tenant developer portal, computed from
+	// the organization name and the master URL before creating the tenant
+	// +optional
+	DeveloperHost string `json:"developerHost,omitempty"`
 }
 
 // +kubebuilder:object:root=true
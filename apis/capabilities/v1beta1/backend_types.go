@@ -24,7 +24,6 @@ import (
 	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -48,6 +47,16 @@ const (
 	// BackendFailedConditionType indicates that an error occurred during synchronization.
 	// The operator will retry.
 	BackendFailedConditionType common.ConditionType = "Failed"
+
+	// BackendQuotaExceededConditionType indicates that the backend has not been created
+	// because the provider account backends quota has been reached.
+	// The operator will retry.
+	BackendQuotaExceededConditionType common.ConditionType = "QuotaExceeded"
+
+	// BackendAccessDeniedConditionType indicates that the backend references a provider account secret
+	// from other namespace and no ProviderAccountGrant allows the backend namespace.
+	// The operator will retry.
+	BackendAccessDeniedConditionType common.ConditionType = "AccessDenied"
 )
 
 var (
@@ -99,9 +108,10 @@ type BackendSpec struct {
 	// +optional
 	Methods map[string]MethodSpec `json:"methods,omitempty"`
 
-	// ProviderAccountRef references account provider credentials
+	// ProviderAccountRef references account provider credentials.
+	// Secrets from other namespaces require a ProviderAccountGrant in the secret namespace.
 	// +optional
-	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
 }
 
 // BackendStatus defines the observed state of Backend
@@ -120,6 +130,11 @@ type BackendStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
+	// LastSyncDuration is the duration of the last synchronization of the spec into 3scale.
+	// Not compared to detect status changes, it is refreshed together with the rest of the status.
+	// +optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+
 	// Current state of the 3scale backend.
 	// Conditions represent the latest available observations of an object's state
 	// +optional
@@ -264,6 +279,10 @@ func (backend *Backend) Validate() field.ErrorList {
 			errors = append(errors, field.Invalid(mappingRulesIdxFldPath, spec.MetricMethodRef, "mappingrule does not have valid metric or method reference."))
 		}
 	}
+
+	errors = append(errors, ValidateMappingRulesPositions(backend.Spec.MappingRules, mappingRulesFldPath)...)
+	errors = append(errors, ValidateMetricsMethodsText(backend.Spec.Metrics, backend.Spec.Methods, specFldPath)...)
+	errors = append(errors, backend.validatePortaConstraints(specFldPath)...)
 	return errors
 }
 
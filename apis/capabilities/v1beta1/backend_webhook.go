@@ -0,0 +1,49 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var backendlog = logf.Log.WithName("backend-webhook")
+
+// SetupWebhookWithManager registers the Backend validating webhook
+func (backend *Backend) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(backend).Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-capabilities-3scale-net-v1beta1-backend,mutating=false,failurePolicy=fail,groups=capabilities.3scale.net,resources=backends,versions=v1beta1,name=vbackend.capabilities.3scale.net
+
+var _ webhook.Validator = &Backend{}
+
+// ValidateCreate implements webhook.Validator
+func (backend *Backend) ValidateCreate() error {
+	return backend.validateAdmission()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (backend *Backend) ValidateUpdate(old runtime.Object) error {
+	return backend.validateAdmission()
+}
+
+// ValidateDelete implements webhook.Validator
+func (backend *Backend) ValidateDelete() error {
+	return nil
+}
+
+// validateAdmission runs the same validation as the reconciler, once defaults are applied,
+// so invalid backends are rejected before any call to the 3scale API
+func (backend *Backend) validateAdmission() error {
+	defaulted := backend.DeepCopy()
+	defaulted.SetDefaults(backendlog)
+
+	errors := defaulted.Validate()
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("Backend").GroupKind(), backend.Name, errors)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	GatewayDomainKind = "GatewayDomain"
+
+	// GatewayDomainInvalidConditionType represents that the combination of configuration
+	// in the GatewayDomainSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	GatewayDomainInvalidConditionType common.ConditionType = "Invalid"
+
+	// GatewayDomainOrphanConditionType represents that the configuration in the GatewayDomainSpec
+	// contains reference to non existing resource.
+	// This is (should be) a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	// Example: the GatewayDomainSpec references non existing product resource
+	GatewayDomainOrphanConditionType common.ConditionType = "Orphan"
+
+	// GatewayDomainReadyConditionType indicates the gateway domain has been successfully synchronized.
+	// Steady state
+	GatewayDomainReadyConditionType common.ConditionType = "Ready"
+
+	// GatewayDomainFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	GatewayDomainFailedConditionType common.ConditionType = "Failed"
+
+	// GatewayDomainHostCollisionConditionType indicates the hostname is already
+	// served by a route not managed by the gateway domain, i.e. a zync managed route.
+	GatewayDomainHostCollisionConditionType common.ConditionType = "HostCollision"
+)
+
+// GatewayDomainCertManagerIssuerSpec references the cert-manager issuer used to
+// request the route certificate
+type GatewayDomainCertManagerIssuerSpec struct {
+	// Name of the issuer
+	Name string `json:"name"`
+
+	// Kind of the issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+}
+
+// GatewayDomainTLSSpec defines the TLS configuration of the gateway domain route.
+// Only one of the certificate sources can be set.
+type GatewayDomainTLSSpec struct {
+	// SecretRef references a kubernetes.io/tls secret holding the route certificate
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// CertManagerIssuer references the cert-manager issuer requesting the route certificate
+	// +optional
+	CertManagerIssuer *GatewayDomainCertManagerIssuerSpec `json:"certManagerIssuer,omitempty"`
+}
+
+// GatewayDomainSpec defines the desired state of GatewayDomain
+type GatewayDomainSpec struct {
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+
+	// product CR metadata.name
+	ProductCRName string `json:"productCRName"`
+
+	// Hostname of the vanity API domain served by the APIcast production gateway
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Hostname string `json:"hostname"`
+
+	// TLS configuration of the route. When not set, the route is not secured.
+	// +optional
+	TLS *GatewayDomainTLSSpec `json:"tls,omitempty"`
+}
+
+// GatewayDomainStatus defines the observed state of GatewayDomain
+type GatewayDomainStatus struct {
+	// The id of the product the domain is registered on
+	// +optional
+	ProductID *int64 `json:"productId,omitempty"`
+
+	// ProviderAccountHost contains the 3scale account's provider URL
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// RouteName is the name of the managed route
+	// +optional
+	RouteName string `json:"routeName,omitempty"`
+
+	// PreviousProductionPublicBaseURL is the product production public base URL
+	// before registering the domain. It is restored when the domain is deleted.
+	// +optional
+	PreviousProductionPublicBaseURL *string `json:"previousProductionPublicBaseURL,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed GatewayDomain Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the gateway domain resource.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (o *GatewayDomainStatus) Equals(other *GatewayDomainStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(o.ProductID, other.ProductID) {
+		diff := cmp.Diff(o.ProductID, other.ProductID)
+		logger.V(1).Info("ProductID not equal", "difference", diff)
+		return false
+	}
+
+	if o.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(o.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if o.RouteName != other.RouteName {
+		diff := cmp.Diff(o.RouteName, other.RouteName)
+		logger.V(1).Info("RouteName not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(o.PreviousProductionPublicBaseURL, other.PreviousProductionPublicBaseURL) {
+		diff := cmp.Diff(o.PreviousProductionPublicBaseURL, other.PreviousProductionPublicBaseURL)
+		logger.V(1).Info("PreviousProductionPublicBaseURL not equal", "difference", diff)
+		return false
+	}
+
+	if o.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(o.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := o.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.hostname",name="Hostname",type=string
+// +kubebuilder:printcolumn:JSONPath=".status.conditions[?(@.type=='Ready')].status",name=Ready,type=string
+
+// GatewayDomain is the Schema for the gatewaydomains API
+type GatewayDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayDomainSpec   `json:"spec,omitempty"`
+	Status GatewayDomainStatus `json:"status,omitempty"`
+}
+
+// PublicBaseURL returns the production public base URL served by the gateway domain
+func (g *GatewayDomain) PublicBaseURL() string {
+	if g.Spec.TLS != nil {
+		return fmt.Sprintf("https://%s:443", g.Spec.Hostname)
+	}
+	return fmt.Sprintf("http://%s:80", g.Spec.Hostname)
+}
+
+func (g *GatewayDomain) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	if g.Spec.TLS != nil && g.Spec.TLS.SecretRef != nil && g.Spec.TLS.CertManagerIssuer != nil {
+		tlsFldPath := field.NewPath("spec").Child("tls")
+		errors = append(errors, field.Invalid(tlsFldPath, g.Spec.TLS, "only one of secretRef or certManagerIssuer can be set."))
+	}
+
+	return errors
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayDomainList contains a list of GatewayDomain
+type GatewayDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayDomain `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayDomain{}, &GatewayDomainList{})
+}
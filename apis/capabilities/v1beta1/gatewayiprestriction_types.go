@@ -0,0 +1,223 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"net"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	GatewayIPRestrictionKind = "GatewayIPRestriction"
+
+	// GatewayIPRestrictionModeAllow only accepts the requests from the listed CIDRs
+	GatewayIPRestrictionModeAllow = "Allow"
+	// GatewayIPRestrictionModeDeny rejects the requests from the listed CIDRs
+	GatewayIPRestrictionModeDeny = "Deny"
+
+	// GatewayIPRestrictionInvalidConditionType represents that the combination of configuration
+	// in the GatewayIPRestrictionSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	GatewayIPRestrictionInvalidConditionType common.ConditionType = "Invalid"
+
+	// GatewayIPRestrictionOrphanConditionType represents that the configuration in the GatewayIPRestrictionSpec
+	// contains reference to non existing resource.
+	// This is (should be) a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	// Example: the GatewayIPRestrictionSpec references non existing product resource
+	GatewayIPRestrictionOrphanConditionType common.ConditionType = "Orphan"
+
+	// GatewayIPRestrictionReadyConditionType indicates the rules have been successfully synchronized.
+	// Steady state
+	GatewayIPRestrictionReadyConditionType common.ConditionType = "Ready"
+
+	// GatewayIPRestrictionFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	GatewayIPRestrictionFailedConditionType common.ConditionType = "Failed"
+)
+
+// GatewayIPRestrictionClientIPSources are the supported sources of the client IP
+var GatewayIPRestrictionClientIPSources = []string{"X-Forwarded-For", "X-Real-IP", "last_caller"}
+
+// GatewayIPRestrictionSpec defines the desired state of GatewayIPRestriction
+type GatewayIPRestrictionSpec struct {
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+
+	// product CR metadata.name. The rules are added to the policy chain of the product.
+	// When not set, the rules apply to all the products served by the gateways
+	// loading the gateway wide rules custom environment.
+	// +optional
+	ProductCRName *string `json:"productCRName,omitempty"`
+
+	// Mode of the rules. Allow only accepts the requests from the CIDRs,
+	// Deny rejects the requests from the CIDRs
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Mode string `json:"mode"`
+
+	// CIDRs are the IP addresses or CIDR ranges of the clients, i.e. 10.0.0.0/8
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []string `json:"cidrs"`
+
+	// ClientIPSources are the sources the client IP is read from, in order.
+	// X-Forwarded-For, X-Real-IP or last_caller. Defaults to last_caller
+	// +optional
+	ClientIPSources []string `json:"clientIPSources,omitempty"`
+}
+
+// GatewayIPRestrictionStatus defines the observed state of GatewayIPRestriction
+type GatewayIPRestrictionStatus struct {
+	// The id of the product the rules are added to
+	// +optional
+	ProductID *int64 `json:"productId,omitempty"`
+
+	// ProviderAccountHost contains the 3scale account's provider URL
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// SecretName is the custom environment secret holding the gateway wide rules
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// AppliedRules is the number of CIDRs applied
+	// +optional
+	AppliedRules int32 `json:"appliedRules,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed GatewayIPRestriction Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the gateway IP restriction resource.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (o *GatewayIPRestrictionStatus) Equals(other *GatewayIPRestrictionStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(o.ProductID, other.ProductID) {
+		diff := cmp.Diff(o.ProductID, other.ProductID)
+		logger.V(1).Info("ProductID not equal", "difference", diff)
+		return false
+	}
+
+	if o.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(o.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if o.SecretName != other.SecretName {
+		diff := cmp.Diff(o.SecretName, other.SecretName)
+		logger.V(1).Info("SecretName not equal", "difference", diff)
+		return false
+	}
+
+	if o.AppliedRules != other.AppliedRules {
+		diff := cmp.Diff(o.AppliedRules, other.AppliedRules)
+		logger.V(1).Info("AppliedRules not equal", "difference", diff)
+		return false
+	}
+
+	if o.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(o.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := o.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.mode",name="Mode",type=string
+// +kubebuilder:printcolumn:JSONPath=".status.appliedRules",name="Rules",type=integer
+// +kubebuilder:printcolumn:JSONPath=".status.conditions[?(@.type=='Ready')].status",name=Ready,type=string
+
+// GatewayIPRestriction is the Schema for the gatewayiprestrictions API
+type GatewayIPRestriction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayIPRestrictionSpec   `json:"spec,omitempty"`
+	Status GatewayIPRestrictionStatus `json:"status,omitempty"`
+}
+
+// IsGatewayWide returns true when the rules apply to all the products
+func (g *GatewayIPRestriction) IsGatewayWide() bool {
+	return g.Spec.ProductCRName == nil
+}
+
+func (g *GatewayIPRestriction) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+	specFldPath := field.NewPath("spec")
+
+	cidrsFldPath := specFldPath.Child("cidrs")
+	for idx, cidr := range g.Spec.CIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil && net.ParseIP(cidr) == nil {
+			errors = append(errors, field.Invalid(cidrsFldPath.Index(idx), cidr, "not an IP address or CIDR range"))
+		}
+	}
+
+	clientIPSourcesFldPath := specFldPath.Child("clientIPSources")
+	for idx, source := range g.Spec.ClientIPSources {
+		valid := false
+		for _, supported := range GatewayIPRestrictionClientIPSources {
+			valid = valid || source == supported
+		}
+		if !valid {
+			errors = append(errors, field.NotSupported(clientIPSourcesFldPath.Index(idx), source, GatewayIPRestrictionClientIPSources))
+		}
+	}
+
+	if g.IsGatewayWide() && g.Spec.ProviderAccountRef != nil {
+		errors = append(errors, field.Invalid(specFldPath.Child("providerAccountRef"), g.Spec.ProviderAccountRef, "gateway wide rules are not added to the 3scale account, only product rules."))
+	}
+
+	return errors
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayIPRestrictionList contains a list of GatewayIPRestriction
+type GatewayIPRestrictionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayIPRestriction `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayIPRestriction{}, &GatewayIPRestrictionList{})
+}
@@ -0,0 +1,199 @@
+package v1beta1
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// PortaTextConstraint mirrors the validation of a text attribute by the 3scale API.
+// The same constraints are checked when reconciling and at admission time
+type PortaTextConstraint struct {
+	// Required rejects empty values
+	Required bool
+	// MaxLength is the maximum length in characters. Zero means no limit
+	MaxLength int
+	// Pattern, when set, must match the whole value
+	Pattern *regexp.Regexp
+	// PatternDescription is the error detail when the pattern does not match
+	PatternDescription string
+	// Values, when set, are the only allowed values
+	Values []string
+}
+
+var (
+	// PortaSystemNameConstraint validates product, backend and application plan system names
+	PortaSystemNameConstraint = PortaTextConstraint{
+		Required:           true,
+		MaxLength:          255,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`),
+		PatternDescription: "only letters, numbers, underscores and hyphens are allowed.",
+	}
+
+	// PortaNameConstraint validates product and backend names
+	PortaNameConstraint = PortaTextConstraint{
+		Required:  true,
+		MaxLength: 255,
+	}
+
+	// PortaPlanNameConstraint validates application plan names
+	PortaPlanNameConstraint = PortaTextConstraint{
+		MaxLength: 255,
+	}
+
+	// PortaLimitPeriodConstraint validates the period of the application plan limits
+	PortaLimitPeriodConstraint = PortaTextConstraint{
+		Required: true,
+		Values:   []string{"eternity", "year", "month", "week", "day", "hour", "minute"},
+	}
+
+	// PortaCredentialsLocationConstraint validates where apicast reads the credentials from
+	PortaCredentialsLocationConstraint = PortaTextConstraint{
+		Values: []string{"headers", "query", "authorization"},
+	}
+
+	// PortaOIDCIssuerTypeConstraint validates the type of the OIDC issuer
+	PortaOIDCIssuerTypeConstraint = PortaTextConstraint{
+		Required: true,
+		Values:   []string{"keycloak", "rest"},
+	}
+)
+
+// Validate checks the value against the constraint
+func (c PortaTextConstraint) Validate(fldPath *field.Path, value string) field.ErrorList {
+	errors := field.ErrorList{}
+
+	if value == "" {
+		if c.Required {
+			errors = append(errors, field.Required(fldPath, "must not be empty."))
+		}
+		return errors
+	}
+
+	if c.MaxLength > 0 && utf8.RuneCountInString(value) > c.MaxLength {
+		errors = append(errors, field.TooLong(fldPath, value, c.MaxLength))
+	}
+
+	if c.Pattern != nil && !c.Pattern.MatchString(value) {
+		errors = append(errors, field.Invalid(fldPath, value, c.PatternDescription))
+	}
+
+	if len(c.Values) > 0 {
+		found := false
+		for _, allowed := range c.Values {
+			if value == allowed {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errors = append(errors, field.NotSupported(fldPath, value, c.Values))
+		}
+	}
+
+	return errors
+}
+
+// ValidateOptional checks the value against the constraint when set
+func (c PortaTextConstraint) ValidateOptional(fldPath *field.Path, value *string) field.ErrorList {
+	if value == nil {
+		return field.ErrorList{}
+	}
+	return c.Validate(fldPath, *value)
+}
+
+// validatePortaConstraints checks the product attributes the 3scale API would reject
+func (product *Product) validatePortaConstraints(specFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+
+	errors = append(errors, PortaNameConstraint.Validate(specFldPath.Child("name"), product.Spec.Name)...)
+	errors = append(errors, PortaSystemNameConstraint.Validate(specFldPath.Child("systemName"), product.Spec.SystemName)...)
+
+	applicationPlansFldPath := specFldPath.Child("applicationPlans")
+	for _, planSystemName := range sortedApplicationPlanKeys(product.Spec.ApplicationPlans) {
+		planFldPath := applicationPlansFldPath.Key(planSystemName)
+		planSpec := product.Spec.ApplicationPlans[planSystemName]
+		errors = append(errors, PortaSystemNameConstraint.Validate(planFldPath, planSystemName)...)
+		errors = append(errors, PortaPlanNameConstraint.ValidateOptional(planFldPath.Child("name"), planSpec.Name)...)
+		for idx, limitSpec := range planSpec.Limits {
+			errors = append(errors, PortaLimitPeriodConstraint.Validate(planFldPath.Child("limits").Index(idx).Child("period"), limitSpec.Period)...)
+		}
+	}
+
+	if product.Spec.Deployment != nil {
+		errors = append(errors, product.Spec.Deployment.validatePortaConstraints(specFldPath.Child("deployment"))...)
+	}
+
+	return errors
+}
+
+// validatePortaConstraints checks exactly one deployment option and authentication mode
+// are set. The remote calls cannot be built otherwise
+func (d *ProductDeploymentSpec) validatePortaConstraints(deploymentFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+
+	var authentication *AuthenticationSpec
+	var authenticationFldPath *field.Path
+	switch {
+	case d.ApicastHosted != nil && d.ApicastSelfManaged != nil:
+		return append(errors, field.Invalid(deploymentFldPath, "apicastHosted, apicastSelfManaged", "only one deployment option can be set."))
+	case d.ApicastHosted != nil:
+		authentication = d.ApicastHosted.Authentication
+		authenticationFldPath = deploymentFldPath.Child("apicastHosted").Child("authentication")
+	case d.ApicastSelfManaged != nil:
+		authentication = d.ApicastSelfManaged.Authentication
+		authenticationFldPath = deploymentFldPath.Child("apicastSelfManaged").Child("authentication")
+	default:
+		return append(errors, field.Required(deploymentFldPath, "one of apicastHosted or apicastSelfManaged must be set."))
+	}
+
+	if authentication == nil {
+		return errors
+	}
+
+	modes := []string{}
+	if authentication.UserKeyAuthentication != nil {
+		modes = append(modes, "userkey")
+		errors = append(errors, PortaCredentialsLocationConstraint.ValidateOptional(
+			authenticationFldPath.Child("userkey").Child("credentials"), authentication.UserKeyAuthentication.CredentialsLoc)...)
+	}
+	if authentication.AppKeyAppIDAuthentication != nil {
+		modes = append(modes, "appKeyAppID")
+		errors = append(errors, PortaCredentialsLocationConstraint.ValidateOptional(
+			authenticationFldPath.Child("appKeyAppID").Child("credentials"), authentication.AppKeyAppIDAuthentication.CredentialsLoc)...)
+	}
+	if authentication.OIDC != nil {
+		modes = append(modes, "oidc")
+		oidcFldPath := authenticationFldPath.Child("oidc")
+		errors = append(errors, PortaCredentialsLocationConstraint.ValidateOptional(oidcFldPath.Child("credentials"), authentication.OIDC.CredentialsLoc)...)
+		errors = append(errors, PortaOIDCIssuerTypeConstraint.Validate(oidcFldPath.Child("issuerType"), authentication.OIDC.IssuerType)...)
+	}
+
+	if len(modes) == 0 {
+		errors = append(errors, field.Required(authenticationFldPath, "one of userkey, appKeyAppID or oidc must be set."))
+	} else if len(modes) > 1 {
+		errors = append(errors, field.Invalid(authenticationFldPath, strings.Join(modes, ", "), "only one authentication mode can be set."))
+	}
+
+	return errors
+}
+
+// validatePortaConstraints checks the backend attributes the 3scale API would reject
+func (backend *Backend) validatePortaConstraints(specFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+	errors = append(errors, PortaNameConstraint.Validate(specFldPath.Child("name"), backend.Spec.Name)...)
+	errors = append(errors, PortaSystemNameConstraint.Validate(specFldPath.Child("systemName"), backend.Spec.SystemName)...)
+	return errors
+}
+
+func sortedApplicationPlanKeys(plans map[string]ApplicationPlanSpec) []string {
+	keys := make([]string, 0, len(plans))
+	for key := range plans {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,216 @@
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestPortaTextConstraint(t *testing.T) {
+	fldPath := field.NewPath("spec").Child("field")
+
+	cases := []struct {
+		name          string
+		constraint    PortaTextConstraint
+		value         string
+		expectedError string
+	}{
+		{"system name", PortaSystemNameConstraint, "my_product-1", ""},
+		{"system name uppercase", PortaSystemNameConstraint, "MyProduct", ""},
+		{"system name empty", PortaSystemNameConstraint, "", "spec.field: Required value"},
+		{"system name with dot", PortaSystemNameConstraint, "my.product", "spec.field: Invalid value"},
+		{"system name with space", PortaSystemNameConstraint, "my product", "spec.field: Invalid value"},
+		{"system name with slash", PortaSystemNameConstraint, "my/product", "spec.field: Invalid value"},
+		{"system name unicode", PortaSystemNameConstraint, "prodüct", "spec.field: Invalid value"},
+		{"system name at limit", PortaSystemNameConstraint, strings.Repeat("a", 255), ""},
+		{"system name too long", PortaSystemNameConstraint, strings.Repeat("a", 256), "spec.field: Too long"},
+		{"name", PortaNameConstraint, "My Product 🚀", ""},
+		{"name empty", PortaNameConstraint, "", "spec.field: Required value"},
+		{"name at limit", PortaNameConstraint, strings.Repeat("请", 255), ""},
+		{"name too long", PortaNameConstraint, strings.Repeat("a", 256), "spec.field: Too long"},
+		{"plan name empty", PortaPlanNameConstraint, "", ""},
+		{"plan name too long", PortaPlanNameConstraint, strings.Repeat("a", 256), "spec.field: Too long"},
+		{"limit period", PortaLimitPeriodConstraint, "eternity", ""},
+		{"limit period empty", PortaLimitPeriodConstraint, "", "spec.field: Required value"},
+		{"limit period unknown", PortaLimitPeriodConstraint, "decade", "spec.field: Unsupported value"},
+		{"limit period case", PortaLimitPeriodConstraint, "Month", "spec.field: Unsupported value"},
+		{"credentials location", PortaCredentialsLocationConstraint, "authorization", ""},
+		{"credentials location empty", PortaCredentialsLocationConstraint, "", ""},
+		{"credentials location unknown", PortaCredentialsLocationConstraint, "body", "spec.field: Unsupported value"},
+		{"issuer type", PortaOIDCIssuerTypeConstraint, "rest", ""},
+		{"issuer type empty", PortaOIDCIssuerTypeConstraint, "", "spec.field: Required value"},
+		{"issuer type unknown", PortaOIDCIssuerTypeConstraint, "okta", "spec.field: Unsupported value"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			errors := tc.constraint.Validate(fldPath, tc.value)
+			assertFieldErrors(subT, errors, tc.expectedError)
+		})
+	}
+}
+
+func TestPortaTextConstraintValidateOptional(t *testing.T) {
+	fldPath := field.NewPath("spec").Child("field")
+
+	if errors := PortaOIDCIssuerTypeConstraint.ValidateOptional(fldPath, nil); len(errors) > 0 {
+		t.Errorf("unexpected errors for an unset value: %s", errors.ToAggregate().Error())
+	}
+
+	value := "okta"
+	assertFieldErrors(t, PortaOIDCIssuerTypeConstraint.ValidateOptional(fldPath, &value), "spec.field: Unsupported value")
+}
+
+func TestProductPortaConstraints(t *testing.T) {
+	stringPtr := func(value string) *string { return &value }
+
+	cases := []struct {
+		name          string
+		modify        func(*Product)
+		expectedError string
+	}{
+		{"default", func(product *Product) {}, ""},
+		{"invalid system name", func(product *Product) { product.Spec.SystemName = "product.a" }, "spec.systemName: Invalid value"},
+		{"name too long", func(product *Product) { product.Spec.Name = strings.Repeat("a", 256) }, "spec.name: Too long"},
+		{"invalid plan system name", func(product *Product) {
+			product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{"plan a": {}}
+		}, "spec.applicationPlans[plan a]: Invalid value"},
+		{"plan name too long", func(product *Product) {
+			product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{"plan": {Name: stringPtr(strings.Repeat("a", 256))}}
+		}, "spec.applicationPlans[plan].name: Too long"},
+		{"invalid limit period", func(product *Product) {
+			product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{"plan": {Limits: []LimitSpec{
+				{Period: "month", Value: 1, MetricMethodRef: MetricMethodRefSpec{SystemName: "hits"}},
+				{Period: "decade", Value: 1, MetricMethodRef: MetricMethodRefSpec{SystemName: "hits"}},
+			}}}
+		}, "spec.applicationPlans[plan].limits[1].period: Unsupported value"},
+		{"hosted userkey", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastHosted: &ApicastHostedSpec{
+				Authentication: &AuthenticationSpec{UserKeyAuthentication: &UserKeyAuthenticationSpec{CredentialsLoc: stringPtr("query")}},
+			}}
+		}, ""},
+		{"hosted without authentication", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastHosted: &ApicastHostedSpec{}}
+		}, ""},
+		{"empty deployment", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{}
+		}, "spec.deployment: Required value"},
+		{"both deployment options", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastHosted: &ApicastHostedSpec{}, ApicastSelfManaged: &ApicastSelfManagedSpec{}}
+		}, "spec.deployment: Invalid value"},
+		{"empty authentication", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastSelfManaged: &ApicastSelfManagedSpec{Authentication: &AuthenticationSpec{}}}
+		}, "spec.deployment.apicastSelfManaged.authentication: Required value"},
+		{"several authentication modes", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastSelfManaged: &ApicastSelfManagedSpec{Authentication: &AuthenticationSpec{
+				UserKeyAuthentication:     &UserKeyAuthenticationSpec{},
+				AppKeyAppIDAuthentication: &AppKeyAppIDAuthenticationSpec{},
+			}}}
+		}, "spec.deployment.apicastSelfManaged.authentication: Invalid value"},
+		{"invalid appid credentials location", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastHosted: &ApicastHostedSpec{Authentication: &AuthenticationSpec{
+				AppKeyAppIDAuthentication: &AppKeyAppIDAuthenticationSpec{CredentialsLoc: stringPtr("body")},
+			}}}
+		}, "spec.deployment.apicastHosted.authentication.appKeyAppID.credentials: Unsupported value"},
+		{"invalid oidc issuer type", func(product *Product) {
+			product.Spec.Deployment = &ProductDeploymentSpec{ApicastSelfManaged: &ApicastSelfManagedSpec{Authentication: &AuthenticationSpec{
+				OIDC: &OIDCSpec{IssuerType: "okta", IssuerEndpoint: "https://example.com"},
+			}}}
+		}, "spec.deployment.apicastSelfManaged.authentication.oidc.issuerType: Unsupported value"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			product := defaultTestingProduct()
+			tc.modify(&product)
+			assertFieldErrors(subT, product.Validate(), tc.expectedError)
+		})
+	}
+}
+
+func TestBackendPortaConstraints(t *testing.T) {
+	cases := []struct {
+		name          string
+		backend       BackendSpec
+		expectedError string
+	}{
+		{"default", BackendSpec{Name: "Backend A"}, ""},
+		{"system name from name", BackendSpec{Name: "Backend.A"}, ""},
+		{"name without alphanumeric characters", BackendSpec{Name: "..."}, "spec.systemName: Required value"},
+		{"invalid system name", BackendSpec{Name: "Backend A", SystemName: "backend a"}, "spec.systemName: Invalid value"},
+		{"system name too long", BackendSpec{Name: "Backend A", SystemName: strings.Repeat("a", 256)}, "spec.systemName: Too long"},
+		{"name too long", BackendSpec{Name: strings.Repeat("a", 256)}, "spec.name: Too long"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			backend := Backend{Spec: tc.backend}
+			backend.SetDefaults(getv1beta1TestLogger())
+			assertFieldErrors(subT, backend.Validate(), tc.expectedError)
+		})
+	}
+}
+
+func TestCapabilitiesAdmissionValidation(t *testing.T) {
+	t.Run("ProductDefaults", func(subT *testing.T) {
+		// hits metric and system name are set by the defaults
+		product := &Product{ObjectMeta: metav1.ObjectMeta{Name: "product"}, Spec: ProductSpec{Name: "Product A"}}
+		if err := product.ValidateCreate(); err != nil {
+			subT.Errorf("unexpected error: %v", err)
+		}
+		if product.Spec.SystemName != "" {
+			subT.Error("admission validation must not modify the product")
+		}
+	})
+
+	t.Run("ProductInvalid", func(subT *testing.T) {
+		product := &Product{ObjectMeta: metav1.ObjectMeta{Name: "product"}, Spec: ProductSpec{Name: "Product A", SystemName: "product.a"}}
+		err := product.ValidateUpdate(product.DeepCopy())
+		if !apierrors.IsInvalid(err) {
+			subT.Fatalf("expected invalid error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "spec.systemName") {
+			subT.Errorf("expected spec.systemName field path in: %v", err)
+		}
+	})
+
+	t.Run("BackendInvalid", func(subT *testing.T) {
+		backend := &Backend{ObjectMeta: metav1.ObjectMeta{Name: "backend"}, Spec: BackendSpec{Name: strings.Repeat("a", 256)}}
+		err := backend.ValidateCreate()
+		if !apierrors.IsInvalid(err) {
+			subT.Fatalf("expected invalid error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "spec.name") {
+			subT.Errorf("expected spec.name field path in: %v", err)
+		}
+	})
+
+	t.Run("Delete", func(subT *testing.T) {
+		product := &Product{Spec: ProductSpec{SystemName: "product.a"}}
+		backend := &Backend{Spec: BackendSpec{SystemName: "backend.a"}}
+		if product.ValidateDelete() != nil || backend.ValidateDelete() != nil {
+			subT.Error("deletion must always be allowed")
+		}
+	})
+}
+
+func assertFieldErrors(t *testing.T, errors field.ErrorList, expectedError string) {
+	t.Helper()
+
+	if expectedError == "" {
+		if len(errors) > 0 {
+			t.Errorf("unexpected errors: %s", errors.ToAggregate().Error())
+		}
+		return
+	}
+
+	if len(errors) == 0 {
+		t.Fatalf("expected error '%s'", expectedError)
+	}
+	if !strings.Contains(errors.ToAggregate().Error(), expectedError) {
+		t.Errorf("expected error '%s', got '%s'", expectedError, errors.ToAggregate().Error())
+	}
+}
@@ -21,12 +21,13 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -58,6 +59,26 @@ const (
 	// ProductFailedConditionType indicates that an error occurred during synchronization.
 	// The operator will retry.
 	ProductFailedConditionType common.ConditionType = "Failed"
+
+	// ProductQuotaExceededConditionType indicates that the product has not been created
+	// because the provider account products quota has been reached.
+	// The operator will retry.
+	ProductQuotaExceededConditionType common.ConditionType = "QuotaExceeded"
+
+	// ProductAccessDeniedConditionType indicates that the product references a provider account secret
+	// from other namespace and no ProviderAccountGrant allows the product namespace.
+	// The operator will retry.
+	ProductAccessDeniedConditionType common.ConditionType = "AccessDenied"
+)
+
+const (
+	// BackendUsageRewritePathStrip removes the usage path from the path forwarded to the backend.
+	// 3scale default behavior
+	BackendUsageRewritePathStrip = "strip"
+	// BackendUsageRewritePathKeep forwards the request path including the usage path
+	BackendUsageRewritePathKeep = "keep"
+	// BackendUsageRewritePathReplace replaces the usage path with the configured path
+	BackendUsageRewritePathReplace = "replace"
 )
 
 var (
@@ -198,11 +219,170 @@ type MappingRuleSpec struct {
 	Increment       int    `json:"increment"`
 	// +optional
 	Last *bool `json:"last,omitempty"`
+	// Position of the mapping rule, starting from 1.
+	// When set in any mapping rule, it must be set in all of them,
+	// with unique and contiguous values.
+	// When not set, mapping rules are positioned following the list order.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Position *int `json:"position,omitempty"`
+}
+
+// SortMappingRules returns the mapping rules in the desired order.
+// Mapping rules are sorted by position when set, otherwise list order is kept.
+func SortMappingRules(mappingRules []MappingRuleSpec) []MappingRuleSpec {
+	sorted := make([]MappingRuleSpec, len(mappingRules))
+	copy(sorted, mappingRules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position == nil || sorted[j].Position == nil {
+			return false
+		}
+		return *sorted[i].Position < *sorted[j].Position
+	})
+	return sorted
+}
+
+// ValidateMappingRulesPositions checks mapping rule positions are either
+// not set at all, or set in all the mapping rules with values from 1 to N
+func ValidateMappingRulesPositions(mappingRules []MappingRuleSpec, mappingRulesFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+
+	positionsSet := 0
+	for _, spec := range mappingRules {
+		if spec.Position != nil {
+			positionsSet++
+		}
+	}
+
+	if positionsSet == 0 {
+		return errors
+	}
+
+	positionIdxMap := map[int]int{}
+	for idx, spec := range mappingRules {
+		positionFldPath := mappingRulesFldPath.Index(idx).Child("position")
+		if spec.Position == nil {
+			errors = append(errors, field.Required(positionFldPath, "position must be set in all mapping rules when set in any of them."))
+			continue
+		}
+
+		if *spec.Position < 1 || *spec.Position > len(mappingRules) {
+			errors = append(errors, field.Invalid(positionFldPath, *spec.Position, fmt.Sprintf("position must be contiguous, from 1 to %d.", len(mappingRules))))
+			continue
+		}
+
+		if otherIdx, ok := positionIdxMap[*spec.Position]; ok {
+			errors = append(errors, field.Duplicate(positionFldPath, fmt.Sprintf("position %d already used by mapping rule %d", *spec.Position, otherIdx)))
+			continue
+		}
+		positionIdxMap[*spec.Position] = idx
+	}
+
+	return errors
+}
+
+const (
+	// MetricFriendlyNameMaxLength is the maximum length of metric and method friendly names accepted by 3scale
+	MetricFriendlyNameMaxLength = 255
+	// MetricUnitMaxLength is the maximum length of metric units accepted by 3scale
+	MetricUnitMaxLength = 255
+	// MetricDescriptionMaxLength is the maximum length of metric and method descriptions accepted by 3scale
+	MetricDescriptionMaxLength = 65535
+)
+
+// ValidateMetricsMethodsText checks metric and method texts are not empty
+// and within the 3scale length limits. Lengths are counted in characters,
+// once the texts are normalized as they are sent to 3scale
+func ValidateMetricsMethodsText(metrics map[string]MetricSpec, methods map[string]MethodSpec, specFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+
+	validateText := func(fldPath *field.Path, value string, maxLength int, required bool) {
+		length := helper.TextLength(value)
+		if required && length == 0 {
+			errors = append(errors, field.Required(fldPath, "must not be empty or only whitespace."))
+		}
+		if length > maxLength {
+			errors = append(errors, field.TooLong(fldPath, value, maxLength))
+		}
+	}
+
+	metricsFldPath := specFldPath.Child("metrics")
+	for _, systemName := range sortedMetricKeys(metrics) {
+		metricFldPath := metricsFldPath.Key(systemName)
+		validateText(metricFldPath.Child("friendlyName"), metrics[systemName].Name, MetricFriendlyNameMaxLength, true)
+		validateText(metricFldPath.Child("unit"), metrics[systemName].Unit, MetricUnitMaxLength, true)
+		validateText(metricFldPath.Child("description"), metrics[systemName].Description, MetricDescriptionMaxLength, false)
+	}
+
+	methodsFldPath := specFldPath.Child("methods")
+	for _, systemName := range sortedMethodKeys(methods) {
+		methodFldPath := methodsFldPath.Key(systemName)
+		validateText(methodFldPath.Child("friendlyName"), methods[systemName].Name, MetricFriendlyNameMaxLength, true)
+		validateText(methodFldPath.Child("description"), methods[systemName].Description, MetricDescriptionMaxLength, false)
+	}
+
+	return errors
+}
+
+func sortedMetricKeys(metrics map[string]MetricSpec) []string {
+	keys := make([]string, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedMethodKeys(methods map[string]MethodSpec) []string {
+	keys := make([]string, 0, len(methods))
+	for key := range methods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // BackendUsageSpec defines the desired state of Product's Backend Usages
 type BackendUsageSpec struct {
 	Path string `json:"path"`
+
+	// RewritePath defines how the usage path is rewritten in the path forwarded to the backend.
+	// The usage path is stripped by default
+	// +optional
+	RewritePath *BackendUsageRewritePathSpec `json:"rewritePath,omitempty"`
+}
+
+// BackendUsageRewritePathSpec defines the path rewriting of the backend usage
+type BackendUsageRewritePathSpec struct {
+	// Strategy defines whether the usage path is stripped, kept or replaced
+	// +kubebuilder:validation:Enum=strip;keep;replace
+	Strategy string `json:"strategy"`
+
+	// ReplaceWith is the path replacing the usage path. Required with replace strategy
+	// +optional
+	ReplaceWith *string `json:"replaceWith,omitempty"`
+}
+
+// RewritePathStrategy returns the path rewriting strategy of the usage
+func (b *BackendUsageSpec) RewritePathStrategy() string {
+	if b.RewritePath == nil || b.RewritePath.Strategy == "" {
+		return BackendUsageRewritePathStrip
+	}
+	return b.RewritePath.Strategy
+}
+
+// RewritePathPrefix returns the path prepended to the request path once the usage path
+// has been stripped. Empty when the strategy is equivalent to the default strip
+func (b *BackendUsageSpec) RewritePathPrefix() string {
+	switch b.RewritePathStrategy() {
+	case BackendUsageRewritePathKeep:
+		return strings.TrimSuffix(b.Path, "/")
+	case BackendUsageRewritePathReplace:
+		if b.RewritePath.ReplaceWith != nil {
+			return strings.TrimSuffix(*b.RewritePath.ReplaceWith, "/")
+		}
+	}
+	return ""
 }
 
 // SecuritySpec defines the desired state of Authentication Security
@@ -914,9 +1094,16 @@ type ProductSpec struct {
 	// +optional
 	ApplicationPlans map[string]ApplicationPlanSpec `json:"applicationPlans,omitempty"`
 
-	// ProviderAccountRef references account provider credentials
+	// DefaultPlan is the system_name of the application plan
+	// new applications are subscribed to by default.
+	// It must reference one of the application plans.
+	// +optional
+	DefaultPlan *string `json:"defaultPlan,omitempty"`
+
+	// ProviderAccountRef references account provider credentials.
+	// Secrets from other namespaces require a ProviderAccountGrant in the secret namespace.
 	// +optional
-	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
 
 	// Policies holds the product's policy chain
 	// +optional
@@ -1077,6 +1264,21 @@ type ProductStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
+	// ApplicationPlans reflects the remote state of the application plans
+	// Map: system_name -> ApplicationPlanStatus
+	// +optional
+	ApplicationPlans map[string]ApplicationPlanStatus `json:"applicationPlans,omitempty"`
+
+	// BackendUsages reflects how the path rewriting of the backend usages is implemented
+	// Map: backend system_name -> BackendUsageStatus
+	// +optional
+	BackendUsages map[string]BackendUsageStatus `json:"backendUsages,omitempty"`
+
+	// LastSyncDuration is the duration of the last synchronization of the spec into 3scale.
+	// Not compared to detect status changes, it is refreshed together with the rest of the status.
+	// +optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+
 	// Current state of the 3scale product.
 	// Conditions represent the latest available observations of an object's state
 	// +optional
@@ -1085,6 +1287,32 @@ type ProductStatus struct {
 	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
 }
 
+// ApplicationPlanStatus defines the observed state of an application plan
+type ApplicationPlanStatus struct {
+	// ID of the application plan in 3scale
+	ID int64 `json:"id"`
+
+	// State of the application plan in 3scale: published or hidden
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Default is true when the application plan is the default plan of the product
+	// +optional
+	Default bool `json:"default,omitempty"`
+}
+
+// BackendUsageStatus defines the observed state of a backend usage
+type BackendUsageStatus struct {
+	// Path of the backend usage
+	Path string `json:"path"`
+
+	// RewritePath is the path rewriting strategy of the backend usage
+	RewritePath string `json:"rewritePath"`
+
+	// Materialization describes the 3scale configuration implementing the path rewriting
+	Materialization string `json:"materialization"`
+}
+
 func (p *ProductStatus) Equals(other *ProductStatus, logger logr.Logger) bool {
 	if !reflect.DeepEqual(p.ID, other.ID) {
 		diff := cmp.Diff(p.ID, other.ID)
@@ -1110,6 +1338,18 @@ func (p *ProductStatus) Equals(other *ProductStatus, logger logr.Logger) bool {
 		return false
 	}
 
+	if !reflect.DeepEqual(p.ApplicationPlans, other.ApplicationPlans) {
+		diff := cmp.Diff(p.ApplicationPlans, other.ApplicationPlans)
+		logger.V(1).Info("ApplicationPlans not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(p.BackendUsages, other.BackendUsages) {
+		diff := cmp.Diff(p.BackendUsages, other.BackendUsages)
+		logger.V(1).Info("BackendUsages not equal", "difference", diff)
+		return false
+	}
+
 	// Marshalling sorts by condition type
 	currentMarshaledJSON, _ := p.Conditions.MarshalJSON()
 	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
@@ -1293,6 +1533,15 @@ func (product *Product) Validate() field.ErrorList {
 		}
 	}
 
+	errors = append(errors, ValidateMappingRulesPositions(product.Spec.MappingRules, mappingRulesFldPath)...)
+
+	// Check default plan references an existing application plan
+	if product.Spec.DefaultPlan != nil {
+		if _, ok := product.Spec.ApplicationPlans[*product.Spec.DefaultPlan]; !ok {
+			errors = append(errors, field.Invalid(specFldPath.Child("defaultPlan"), *product.Spec.DefaultPlan, "defaultPlan does not reference an existing application plan."))
+		}
+	}
+
 	// Check application plan limits local metricOrMethod ref exists
 	for planSystemName, planSpec := range product.Spec.ApplicationPlans {
 		planFldPath := applicationPlansFldPath.Key(planSystemName)
@@ -1362,6 +1611,61 @@ func (product *Product) Validate() field.ErrorList {
 		}
 	}
 
+	errors = append(errors, ValidateMetricsMethodsText(product.Spec.Metrics, product.Spec.Methods, specFldPath)...)
+	errors = append(errors, product.validateBackendUsagesRewritePath(specFldPath.Child("backendUsages"))...)
+	errors = append(errors, product.validatePortaConstraints(specFldPath)...)
+
+	return errors
+}
+
+// validateBackendUsagesRewritePath checks the path rewriting of the backend usages.
+// Requests matching a rewritten usage path are routed before the 3scale default routing,
+// so the path of a rewritten usage cannot be a prefix of the path of another usage
+func (product *Product) validateBackendUsagesRewritePath(backendUsagesFldPath *field.Path) field.ErrorList {
+	errors := field.ErrorList{}
+
+	systemNames := make([]string, 0, len(product.Spec.BackendUsages))
+	for systemName := range product.Spec.BackendUsages {
+		systemNames = append(systemNames, systemName)
+	}
+	sort.Strings(systemNames)
+
+	for _, systemName := range systemNames {
+		usage := product.Spec.BackendUsages[systemName]
+		if usage.RewritePath == nil {
+			continue
+		}
+
+		rewritePathFldPath := backendUsagesFldPath.Key(systemName).Child("rewritePath")
+		replaceWith := usage.RewritePath.ReplaceWith
+		if usage.RewritePath.Strategy == BackendUsageRewritePathReplace {
+			if replaceWith == nil {
+				errors = append(errors, field.Required(rewritePathFldPath.Child("replaceWith"), "replaceWith is required with replace strategy."))
+			} else if !strings.HasPrefix(*replaceWith, "/") {
+				errors = append(errors, field.Invalid(rewritePathFldPath.Child("replaceWith"), *replaceWith, "replaceWith must start with '/'."))
+			}
+		} else if replaceWith != nil {
+			errors = append(errors, field.Invalid(rewritePathFldPath.Child("replaceWith"), *replaceWith, "replaceWith is only allowed with replace strategy."))
+		}
+
+		// Equivalent to the default strip, no routing rule generated
+		if usage.RewritePathPrefix() == "" {
+			continue
+		}
+
+		usagePath := strings.TrimSuffix(usage.Path, "/")
+		for _, otherSystemName := range systemNames {
+			if otherSystemName == systemName {
+				continue
+			}
+			otherPath := strings.TrimSuffix(product.Spec.BackendUsages[otherSystemName].Path, "/")
+			if otherPath == usagePath || strings.HasPrefix(otherPath, usagePath+"/") {
+				errors = append(errors, field.Invalid(rewritePathFldPath, usage.Path,
+					fmt.Sprintf("path rewriting conflicts with backend usage '%s' path '%s'.", otherSystemName, product.Spec.BackendUsages[otherSystemName].Path)))
+			}
+		}
+	}
+
 	return errors
 }
 
@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -124,6 +125,26 @@ func TestValidateProductPlanLimitUnkonwnRef(t *testing.T) {
 	}
 }
 
+func TestValidateProductDefaultPlanUnknownRef(t *testing.T) {
+	product := defaultTestingProduct()
+	product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{
+		"plan01": ApplicationPlanSpec{},
+	}
+
+	defaultPlan := "plan01"
+	product.Spec.DefaultPlan = &defaultPlan
+	errors := product.Validate()
+	if len(errors) > 0 {
+		t.Errorf("unexpected validation error: %s", errors.ToAggregate().Error())
+	}
+
+	defaultPlan = "unknownPlan"
+	errors = product.Validate()
+	if len(errors) == 0 || !strings.Contains(errors.ToAggregate().Error(), "defaultPlan does not reference an existing application plan.") {
+		t.Error("validation passes and defaultPlan does not reference an existing application plan.")
+	}
+}
+
 func TestValidateProductPlanPricingRuleUnkonwnRef(t *testing.T) {
 	product := defaultTestingProduct()
 
@@ -260,3 +281,156 @@ func TestValidateProductHappyPath(t *testing.T) {
 		t.Errorf("product validation fails: %s", errors.ToAggregate().Error())
 	}
 }
+
+func TestValidateProductMappingRulePositions(t *testing.T) {
+	one, two, three := 1, 2, 3
+
+	cases := []struct {
+		testName      string
+		positions     []*int
+		expectedError string
+	}{
+		{"positions not set", []*int{nil, nil}, ""},
+		{"positions set", []*int{&two, &one}, ""},
+		{"position missing", []*int{&one, nil}, "position must be set in all mapping rules"},
+		{"position duplicated", []*int{&one, &one}, "Duplicate value"},
+		{"position not contiguous", []*int{&one, &three}, "position must be contiguous"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			product := defaultTestingProduct()
+			product.Spec.MappingRules = []MappingRuleSpec{}
+			for idx, position := range tc.positions {
+				product.Spec.MappingRules = append(product.Spec.MappingRules, MappingRuleSpec{
+					HTTPMethod:      "GET",
+					Pattern:         fmt.Sprintf("/pets/%d", idx),
+					MetricMethodRef: "hits",
+					Position:        position,
+				})
+			}
+
+			errors := product.Validate()
+			if tc.expectedError == "" && len(errors) > 0 {
+				subT.Errorf("unexpected validation error: %s", errors.ToAggregate().Error())
+			}
+			if tc.expectedError != "" && (len(errors) == 0 || !strings.Contains(errors.ToAggregate().Error(), tc.expectedError)) {
+				subT.Errorf("expected validation error containing '%s', got: %v", tc.expectedError, errors.ToAggregate())
+			}
+		})
+	}
+}
+
+func TestValidateProductMetricsMethodsText(t *testing.T) {
+	cases := []struct {
+		testName      string
+		metric        MetricSpec
+		method        MethodSpec
+		expectedError string
+	}{
+		{"unicode", MetricSpec{Name: "Rocket 🚀", Unit: "次", Description: "请求次数"}, MethodSpec{Name: "日本語", Description: "🚀"}, ""},
+		{"friendly name at limit", MetricSpec{Name: strings.Repeat("🚀", MetricFriendlyNameMaxLength), Unit: "hit"}, MethodSpec{Name: "method"}, ""},
+		{"metric friendly name too long", MetricSpec{Name: strings.Repeat("请", MetricFriendlyNameMaxLength+1), Unit: "hit"}, MethodSpec{Name: "method"}, "spec.metrics[metric].friendlyName: Too long"},
+		{"metric unit empty", MetricSpec{Name: "metric", Unit: " "}, MethodSpec{Name: "method"}, "spec.metrics[metric].unit: Required value"},
+		{"method friendly name empty", MetricSpec{Name: "metric", Unit: "hit"}, MethodSpec{Name: "  "}, "spec.methods[method].friendlyName: Required value"},
+		{"method description too long", MetricSpec{Name: "metric", Unit: "hit"}, MethodSpec{Name: "method", Description: strings.Repeat("a", MetricDescriptionMaxLength+1)}, "spec.methods[method].description: Too long"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			product := defaultTestingProduct()
+			product.Spec.Metrics["metric"] = tc.metric
+			product.Spec.Methods = map[string]MethodSpec{"method": tc.method}
+
+			errors := product.Validate()
+			if tc.expectedError == "" && len(errors) > 0 {
+				subT.Errorf("unexpected validation error: %s", errors.ToAggregate().Error())
+			}
+			if tc.expectedError != "" && (len(errors) == 0 || !strings.Contains(errors.ToAggregate().Error(), tc.expectedError)) {
+				subT.Errorf("expected validation error containing '%s', got: %v", tc.expectedError, errors.ToAggregate())
+			}
+		})
+	}
+}
+
+func TestSortMappingRules(t *testing.T) {
+	one, two, three := 1, 2, 3
+
+	mappingRules := []MappingRuleSpec{
+		{HTTPMethod: "GET", Pattern: "/c", Position: &three},
+		{HTTPMethod: "GET", Pattern: "/a", Position: &one},
+		{HTTPMethod: "GET", Pattern: "/b", Position: &two},
+	}
+
+	sorted := SortMappingRules(mappingRules)
+	for idx, expectedPattern := range []string{"/a", "/b", "/c"} {
+		if sorted[idx].Pattern != expectedPattern {
+			t.Errorf("mapping rule %d: expected pattern %s, got %s", idx, expectedPattern, sorted[idx].Pattern)
+		}
+	}
+
+	// list order is kept when positions are not set
+	unsorted := []MappingRuleSpec{{Pattern: "/c"}, {Pattern: "/a"}, {Pattern: "/b"}}
+	sorted = SortMappingRules(unsorted)
+	for idx := range unsorted {
+		if sorted[idx].Pattern != unsorted[idx].Pattern {
+			t.Errorf("mapping rule %d: expected pattern %s, got %s", idx, unsorted[idx].Pattern, sorted[idx].Pattern)
+		}
+	}
+}
+
+func TestValidateProductBackendUsagesRewritePath(t *testing.T) {
+	replaceWith := "/v2"
+	invalidReplaceWith := "v2"
+
+	cases := []struct {
+		testName       string
+		backendUsages  map[string]BackendUsageSpec
+		expectedErrors int
+	}{
+		{"DefaultStrip", map[string]BackendUsageSpec{
+			"backendA": {Path: "/"},
+			"backendB": {Path: "/b"},
+		}, 0},
+		{"Keep", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathKeep}},
+			"backendB": {Path: "/b"},
+		}, 0},
+		{"KeepRootPath", map[string]BackendUsageSpec{
+			"backendA": {Path: "/", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathKeep}},
+			"backendB": {Path: "/b"},
+		}, 0},
+		{"Replace", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathReplace, ReplaceWith: &replaceWith}},
+		}, 0},
+		{"ReplaceWithoutPath", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathReplace}},
+		}, 1},
+		{"ReplaceWithRelativePath", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathReplace, ReplaceWith: &invalidReplaceWith}},
+		}, 1},
+		{"ReplaceWithAndKeep", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathKeep, ReplaceWith: &replaceWith}},
+		}, 1},
+		{"ConflictingPrefix", map[string]BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathKeep}},
+			"backendB": {Path: "/a/b"},
+			"backendC": {Path: "/ab"},
+		}, 1},
+		{"ConflictingRootPath", map[string]BackendUsageSpec{
+			"backendA": {Path: "/", RewritePath: &BackendUsageRewritePathSpec{Strategy: BackendUsageRewritePathReplace, ReplaceWith: &replaceWith}},
+			"backendB": {Path: "/b"},
+		}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			product := defaultTestingProduct()
+			product.Spec.BackendUsages = tc.backendUsages
+			errors := product.Validate()
+			if len(errors) != tc.expectedErrors {
+				subT.Errorf("expected %d errors, got %d: %v", tc.expectedErrors, len(errors), errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var productlog = logf.Log.WithName("product-webhook")
+
+// SetupWebhookWithManager registers the Product validating webhook
+func (product *Product) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(product).Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-capabilities-3scale-net-v1beta1-product,mutating=false,failurePolicy=fail,groups=capabilities.3scale.net,resources=products,versions=v1beta1,name=vproduct.capabilities.3scale.net
+
+var _ webhook.Validator = &Product{}
+
+// ValidateCreate implements webhook.Validator
+func (product *Product) ValidateCreate() error {
+	return product.validateAdmission()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (product *Product) ValidateUpdate(old runtime.Object) error {
+	return product.validateAdmission()
+}
+
+// ValidateDelete implements webhook.Validator
+func (product *Product) ValidateDelete() error {
+	return nil
+}
+
+// validateAdmission runs the same validation as the reconciler, once defaults are applied,
+// so invalid products are rejected before any call to the 3scale API
+func (product *Product) validateAdmission() error {
+	defaulted := product.DeepCopy()
+	defaulted.SetDefaults(productlog)
+
+	errors := defaulted.Validate()
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("Product").GroupKind(), product.Name, errors)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ProviderAccountGrantKind = "ProviderAccountGrant"
+)
+
+// ProviderAccountReference references account provider credentials secret.
+// The secret can live in another namespace when a ProviderAccountGrant
+// in that namespace allows the namespace of the referencing resource.
+type ProviderAccountReference struct {
+	// Name of the provider account secret
+	Name string `json:"name"`
+
+	// Namespace of the provider account secret.
+	// Defaults to the namespace of the referencing resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NewProviderAccountReference returns the provider account reference of a local object reference
+func NewProviderAccountReference(ref *corev1.LocalObjectReference) *ProviderAccountReference {
+	if ref == nil {
+		return nil
+	}
+	return &ProviderAccountReference{Name: ref.Name}
+}
+
+// LocalObjectReference returns the reference of the secret in the namespace of the referencing resource
+func (p *ProviderAccountReference) LocalObjectReference() *corev1.LocalObjectReference {
+	if p == nil {
+		return nil
+	}
+	return &corev1.LocalObjectReference{Name: p.Name}
+}
+
+// IsCrossNamespace tells whether the secret lives in other namespace than the given one
+func (p *ProviderAccountReference) IsCrossNamespace(ns string) bool {
+	return p != nil && p.Namespace != "" && p.Namespace != ns
+}
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ProviderAccountGrantSpec defines the desired state of ProviderAccountGrant
+type ProviderAccountGrantSpec struct {
+	// SecretRef references the provider account secret granted.
+	// The secret must live in the namespace of the grant.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+
+	// Namespaces allowed to reference the provider account secret
+	// +kubebuilder:validation:MinItems=1
+	Namespaces []string `json:"namespaces"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.spec.secretRef.name`
+
+// ProviderAccountGrant is the Schema for the provideraccountgrants API
+type ProviderAccountGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProviderAccountGrantSpec `json:"spec,omitempty"`
+}
+
+// Allows tells whether the grant allows resources of the given namespace
+// to reference the given secret
+func (g *ProviderAccountGrant) Allows(secretName, ns string) bool {
+	if g.Spec.SecretRef.Name != secretName {
+		return false
+	}
+
+	for _, allowed := range g.Spec.Namespaces {
+		if allowed == ns {
+			return true
+		}
+	}
+
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderAccountGrantList contains a list of ProviderAccountGrant
+type ProviderAccountGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderAccountGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProviderAccountGrant{}, &ProviderAccountGrantList{})
+}
@@ -24,6 +24,7 @@ package v1beta1
 import (
 	"github.com/3scale/3scale-operator/pkg/common"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -338,6 +339,21 @@ func (in *ApplicationPlanSpec) DeepCopy() *ApplicationPlanSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationPlanStatus) DeepCopyInto(out *ApplicationPlanStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationPlanStatus.
+func (in *ApplicationPlanStatus) DeepCopy() *ApplicationPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
@@ -453,7 +469,7 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 	}
 	if in.ProviderAccountRef != nil {
 		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(ProviderAccountReference)
 		**out = **in
 	}
 }
@@ -476,6 +492,11 @@ func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(common.Conditions, len(*in))
@@ -495,9 +516,34 @@ func (in *BackendStatus) DeepCopy() *BackendStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendUsageRewritePathSpec) DeepCopyInto(out *BackendUsageRewritePathSpec) {
+	*out = *in
+	if in.ReplaceWith != nil {
+		in, out := &in.ReplaceWith, &out.ReplaceWith
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsageRewritePathSpec.
+func (in *BackendUsageRewritePathSpec) DeepCopy() *BackendUsageRewritePathSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendUsageRewritePathSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendUsageSpec) DeepCopyInto(out *BackendUsageSpec) {
 	*out = *in
+	if in.RewritePath != nil {
+		in, out := &in.RewritePath, &out.RewritePath
+		*out = new(BackendUsageRewritePathSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsageSpec.
@@ -510,6 +556,21 @@ func (in *BackendUsageSpec) DeepCopy() *BackendUsageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendUsageStatus) DeepCopyInto(out *BackendUsageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsageStatus.
+func (in *BackendUsageStatus) DeepCopy() *BackendUsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendUsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomPolicyDefinition) DeepCopyInto(out *CustomPolicyDefinition) {
 	*out = *in
@@ -891,6 +952,288 @@ func (in *DeveloperUserStatus) DeepCopy() *DeveloperUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomain) DeepCopyInto(out *GatewayDomain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomain.
+func (in *GatewayDomain) DeepCopy() *GatewayDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomainCertManagerIssuerSpec) DeepCopyInto(out *GatewayDomainCertManagerIssuerSpec) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomainCertManagerIssuerSpec.
+func (in *GatewayDomainCertManagerIssuerSpec) DeepCopy() *GatewayDomainCertManagerIssuerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomainCertManagerIssuerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomainList) DeepCopyInto(out *GatewayDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomainList.
+func (in *GatewayDomainList) DeepCopy() *GatewayDomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomainSpec) DeepCopyInto(out *GatewayDomainSpec) {
+	*out = *in
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(GatewayDomainTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomainSpec.
+func (in *GatewayDomainSpec) DeepCopy() *GatewayDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomainStatus) DeepCopyInto(out *GatewayDomainStatus) {
+	*out = *in
+	if in.ProductID != nil {
+		in, out := &in.ProductID, &out.ProductID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreviousProductionPublicBaseURL != nil {
+		in, out := &in.PreviousProductionPublicBaseURL, &out.PreviousProductionPublicBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomainStatus.
+func (in *GatewayDomainStatus) DeepCopy() *GatewayDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayDomainTLSSpec) DeepCopyInto(out *GatewayDomainTLSSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.CertManagerIssuer != nil {
+		in, out := &in.CertManagerIssuer, &out.CertManagerIssuer
+		*out = new(GatewayDomainCertManagerIssuerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayDomainTLSSpec.
+func (in *GatewayDomainTLSSpec) DeepCopy() *GatewayDomainTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayDomainTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayIPRestriction) DeepCopyInto(out *GatewayIPRestriction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayIPRestriction.
+func (in *GatewayIPRestriction) DeepCopy() *GatewayIPRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayIPRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayIPRestriction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayIPRestrictionList) DeepCopyInto(out *GatewayIPRestrictionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayIPRestriction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayIPRestrictionList.
+func (in *GatewayIPRestrictionList) DeepCopy() *GatewayIPRestrictionList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayIPRestrictionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayIPRestrictionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayIPRestrictionSpec) DeepCopyInto(out *GatewayIPRestrictionSpec) {
+	*out = *in
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ProductCRName != nil {
+		in, out := &in.ProductCRName, &out.ProductCRName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientIPSources != nil {
+		in, out := &in.ClientIPSources, &out.ClientIPSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayIPRestrictionSpec.
+func (in *GatewayIPRestrictionSpec) DeepCopy() *GatewayIPRestrictionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayIPRestrictionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayIPRestrictionStatus) DeepCopyInto(out *GatewayIPRestrictionStatus) {
+	*out = *in
+	if in.ProductID != nil {
+		in, out := &in.ProductID, &out.ProductID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayIPRestrictionStatus.
+func (in *GatewayIPRestrictionStatus) DeepCopy() *GatewayIPRestrictionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayIPRestrictionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayResponseSpec) DeepCopyInto(out *GatewayResponseSpec) {
 	*out = *in
@@ -990,6 +1333,11 @@ func (in *MappingRuleSpec) DeepCopyInto(out *MappingRuleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingRuleSpec.
@@ -1419,7 +1767,7 @@ func (in *ProductSpec) DeepCopyInto(out *ProductSpec) {
 		in, out := &in.BackendUsages, &out.BackendUsages
 		*out = make(map[string]BackendUsageSpec, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.Metrics != nil {
@@ -1443,9 +1791,14 @@ func (in *ProductSpec) DeepCopyInto(out *ProductSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.DefaultPlan != nil {
+		in, out := &in.DefaultPlan, &out.DefaultPlan
+		*out = new(string)
+		**out = **in
+	}
 	if in.ProviderAccountRef != nil {
 		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(ProviderAccountReference)
 		**out = **in
 	}
 	if in.Policies != nil {
@@ -1480,6 +1833,25 @@ func (in *ProductStatus) DeepCopyInto(out *ProductStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ApplicationPlans != nil {
+		in, out := &in.ApplicationPlans, &out.ApplicationPlans
+		*out = make(map[string]ApplicationPlanStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackendUsages != nil {
+		in, out := &in.BackendUsages, &out.BackendUsages
+		*out = make(map[string]BackendUsageStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(common.Conditions, len(*in))
@@ -1499,6 +1871,100 @@ func (in *ProductStatus) DeepCopy() *ProductStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountGrant) DeepCopyInto(out *ProviderAccountGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountGrant.
+func (in *ProviderAccountGrant) DeepCopy() *ProviderAccountGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderAccountGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountGrantList) DeepCopyInto(out *ProviderAccountGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderAccountGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountGrantList.
+func (in *ProviderAccountGrantList) DeepCopy() *ProviderAccountGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderAccountGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountGrantSpec) DeepCopyInto(out *ProviderAccountGrantSpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountGrantSpec.
+func (in *ProviderAccountGrantSpec) DeepCopy() *ProviderAccountGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountReference) DeepCopyInto(out *ProviderAccountReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountReference.
+func (in *ProviderAccountReference) DeepCopy() *ProviderAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyConfigPromote) DeepCopyInto(out *ProxyConfigPromote) {
 	*out = *in
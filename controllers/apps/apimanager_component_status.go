@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"sort"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// componentVersionLabel is the metering label holding the 3scale release of the component
+const componentVersionLabel = "rht.comp_ver"
+
+// componentsStatus reports the replicas, readiness and deployed image of the expected DeploymentConfigs.
+// Missing DeploymentConfigs are reported not ready. The degraded names are the crash-looping components.
+func componentsStatus(deployments []appsv1.DeploymentConfig, deploymentNames []string, crashLooping []string) map[string]appsv1alpha1.ComponentStatus {
+	if len(deploymentNames) == 0 {
+		return nil
+	}
+
+	existing := map[string]*appsv1.DeploymentConfig{}
+	for idx := range deployments {
+		existing[deployments[idx].Name] = &deployments[idx]
+	}
+
+	degraded := map[string]bool{}
+	for _, name := range crashLooping {
+		degraded[name] = true
+	}
+
+	components := map[string]appsv1alpha1.ComponentStatus{}
+	for _, name := range deploymentNames {
+		dc, ok := existing[name]
+		if !ok {
+			components[name] = appsv1alpha1.ComponentStatus{}
+			continue
+		}
+
+		component := appsv1alpha1.ComponentStatus{
+			DesiredReplicas:   dc.Spec.Replicas,
+			AvailableReplicas: dc.Status.AvailableReplicas,
+			Ready:             helper.IsDeploymentConfigAvailable(dc) && isDeploymentConfigRolledOut(dc),
+			Degraded:          degraded[name] || isDeploymentConfigRolloutFailed(dc),
+		}
+		if dc.Spec.Template != nil {
+			if len(dc.Spec.Template.Spec.Containers) > 0 {
+				component.Image = dc.Spec.Template.Spec.Containers[0].Image
+			}
+			component.Version = dc.Spec.Template.Labels[componentVersionLabel]
+		}
+		components[name] = component
+	}
+
+	return components
+}
+
+// isDeploymentConfigRolledOut returns true when the latest version of the
+// DeploymentConfig has been observed and all its replicas are updated and available
+func isDeploymentConfigRolledOut(dc *appsv1.DeploymentConfig) bool {
+	return dc.Status.ObservedGeneration >= dc.Generation &&
+		dc.Status.UpdatedReplicas >= dc.Spec.Replicas &&
+		dc.Status.AvailableReplicas >= dc.Spec.Replicas
+}
+
+// isDeploymentConfigRolloutFailed returns true when the latest rollout of the
+// DeploymentConfig failed, i.e. its progress deadline was exceeded
+func isDeploymentConfigRolloutFailed(dc *appsv1.DeploymentConfig) bool {
+	for _, condition := range dc.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == v1.ConditionFalse {
+			return true
+		}
+	}
+	return false
+}
+
+// progressingComponents returns the sorted names of the components not ready nor degraded
+func progressingComponents(components map[string]appsv1alpha1.ComponentStatus) []string {
+	var names []string
+	for name, component := range components {
+		if !component.Ready && !component.Degraded {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// degradedComponents returns the sorted names of the degraded components
+func degradedComponents(components map[string]appsv1alpha1.ComponentStatus) []string {
+	var names []string
+	for name, component := range components {
+		if component.Degraded {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newlyDegradedComponents returns the sorted names of the components
+// degraded in the current status but not in the previous one
+func newlyDegradedComponents(previous, current map[string]appsv1alpha1.ComponentStatus) []string {
+	var names []string
+	for _, name := range degradedComponents(current) {
+		if !previous[name].Degraded {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func componentTestDeploymentConfig(name string, replicas, available int32, conditions ...appsv1.DeploymentCondition) appsv1.DeploymentConfig {
+	return appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 2},
+		Spec: appsv1.DeploymentConfigSpec{
+			Replicas: replicas,
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{componentVersionLabel: "2.13"}},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: name, Image: name + "@sha256:0123"}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentConfigStatus{
+			ObservedGeneration: 2,
+			Replicas:           replicas,
+			UpdatedReplicas:    available,
+			AvailableReplicas:  available,
+			Conditions:         conditions,
+		},
+	}
+}
+
+func TestComponentsStatus(t *testing.T) {
+	available := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: v1.ConditionTrue}
+	unavailable := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: v1.ConditionFalse}
+	rolloutFailed := appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: v1.ConditionFalse, Reason: "ProgressDeadlineExceeded"}
+
+	deployments := []appsv1.DeploymentConfig{
+		componentTestDeploymentConfig("apicast-production", 2, 2, available),
+		// available with maxUnavailable while rolling out
+		componentTestDeploymentConfig("backend-listener", 2, 1, available),
+		componentTestDeploymentConfig("system-app", 1, 0, unavailable, rolloutFailed),
+		componentTestDeploymentConfig("system-sidekiq", 1, 1, available),
+	}
+	names := []string{"apicast-production", "backend-listener", "system-app", "system-sidekiq", "zync"}
+
+	components := componentsStatus(deployments, names, []string{"system-sidekiq"})
+
+	expected := map[string]appsv1alpha1.ComponentStatus{
+		"apicast-production": {DesiredReplicas: 2, AvailableReplicas: 2, Ready: true, Image: "apicast-production@sha256:0123", Version: "2.13"},
+		"backend-listener":   {DesiredReplicas: 2, AvailableReplicas: 1, Image: "backend-listener@sha256:0123", Version: "2.13"},
+		"system-app":         {DesiredReplicas: 1, Degraded: true, Image: "system-app@sha256:0123", Version: "2.13"},
+		"system-sidekiq":     {DesiredReplicas: 1, AvailableReplicas: 1, Ready: true, Degraded: true, Image: "system-sidekiq@sha256:0123", Version: "2.13"},
+		"zync":               {},
+	}
+	if !reflect.DeepEqual(components, expected) {
+		t.Fatalf("unexpected components status: %s", cmp.Diff(expected, components))
+	}
+
+	if progressing := progressingComponents(components); !reflect.DeepEqual(progressing, []string{"backend-listener", "zync"}) {
+		t.Errorf("unexpected progressing components: %v", progressing)
+	}
+	if degraded := degradedComponents(components); !reflect.DeepEqual(degraded, []string{"system-app", "system-sidekiq"}) {
+		t.Errorf("unexpected degraded components: %v", degraded)
+	}
+
+	progressing := progressingCondition(components)
+	if progressing.Status != v1.ConditionTrue || progressing.Message != "components not ready: backend-listener, zync" {
+		t.Errorf("unexpected progressing condition: %v", progressing)
+	}
+	degraded := degradedCondition(components)
+	if degraded.Status != v1.ConditionTrue || degraded.Message != "components degraded: system-app, system-sidekiq" {
+		t.Errorf("unexpected degraded condition: %v", degraded)
+	}
+}
+
+func TestComponentsStatusRolledOut(t *testing.T) {
+	available := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: v1.ConditionTrue}
+
+	dc := componentTestDeploymentConfig("system-app", 1, 1, available)
+	// new version not observed yet by the DeploymentConfig controller
+	dc.Generation = 3
+
+	components := componentsStatus([]appsv1.DeploymentConfig{dc}, []string{"system-app"}, nil)
+	if components["system-app"].Ready {
+		t.Error("expected component with a pending rollout not to be ready")
+	}
+
+	dc.Status.ObservedGeneration = 3
+	components = componentsStatus([]appsv1.DeploymentConfig{dc}, []string{"system-app"}, nil)
+	if !components["system-app"].Ready {
+		t.Error("expected rolled out component to be ready")
+	}
+
+	if condition := progressingCondition(components); condition.Status != v1.ConditionFalse {
+		t.Errorf("unexpected progressing condition: %v", condition)
+	}
+	if condition := degradedCondition(components); condition.Status != v1.ConditionFalse {
+		t.Errorf("unexpected degraded condition: %v", condition)
+	}
+}
+
+func TestNewlyDegradedComponents(t *testing.T) {
+	previous := map[string]appsv1alpha1.ComponentStatus{
+		"system-app":     {Degraded: true},
+		"system-sidekiq": {Ready: true},
+	}
+	current := map[string]appsv1alpha1.ComponentStatus{
+		"system-app":     {Degraded: true},
+		"system-sidekiq": {Degraded: true},
+		"zync":           {Degraded: true},
+	}
+
+	names := newlyDegradedComponents(previous, current)
+	if !reflect.DeepEqual(names, []string{"system-sidekiq", "zync"}) {
+		t.Errorf("unexpected newly degraded components: %v", names)
+	}
+
+	if names := newlyDegradedComponents(nil, nil); len(names) != 0 {
+		t.Errorf("unexpected newly degraded components: %v", names)
+	}
+}
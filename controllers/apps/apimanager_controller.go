@@ -19,14 +19,21 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	appsv1 "github.com/openshift/api/apps/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -40,9 +47,25 @@ import (
 	"github.com/3scale/3scale-operator/version"
 )
 
+// APIManagerWaitRequeueDelay is the delay to reconcile again an APIManager
+// waiting for some resource, i.e. a referenced secret not created yet
+const APIManagerWaitRequeueDelay = 10 * time.Second
+
+// OperatorUserAgent is the user agent of the operator requests.
+// The API server records it as the field manager of the fields written by the operator
+const OperatorUserAgent = "3scale-operator"
+
 // APIManagerReconciler reconciles a APIManager object
 type APIManagerReconciler struct {
 	*reconcilers.BaseReconciler
+
+	// APIManagerSelector limits the reconciled APIManagers to the ones matching it.
+	// Every APIManager is reconciled when nil.
+	APIManagerSelector labels.Selector
+
+	// DebugContainersPodsClient attaches the ephemeral debug containers requested on the APIManagers.
+	// The debug containers are disabled when nil.
+	DebugContainersPodsClient corev1client.PodsGetter
 }
 
 // blank assignment to verify that APIManagerReconciler implements reconcile.Reconciler
@@ -51,7 +74,9 @@ var _ reconcile.Reconciler = &APIManagerReconciler{}
 // +kubebuilder:rbac:groups=apps.3scale.net,namespace=placeholder,resources=apimanagers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps.3scale.net,namespace=placeholder,resources=apimanagers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps.3scale.net,namespace=placeholder,resources=apimanagers/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.3scale.net,namespace=placeholder,resources=apicasts,verbs=get
 // +kubebuilder:rbac:groups=core,namespace=placeholder,resources=pods;services;services/finalizers;replicationcontrollers;endpoints;persistentvolumeclaims;events;configmaps;secrets;serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,namespace=placeholder,resources=pods/ephemeralcontainers,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,namespace=placeholder,resources=deployments;daemonsets;replicasets;statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,namespace=placeholder,resources=deployments/finalizers,verbs=update
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,namespace=placeholder,resources=roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
@@ -62,9 +87,13 @@ var _ reconcile.Reconciler = &APIManagerReconciler{}
 // +kubebuilder:rbac:groups=route.openshift.io,namespace=placeholder,resources=routes/status,verbs=get
 // +kubebuilder:rbac:groups=apps.openshift.io,namespace=placeholder,resources=deploymentconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,namespace=placeholder,resources=podmonitors;servicemonitors;prometheusrules,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=integreatly.org,namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get
 
 func (r *APIManagerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	_ = context.Background()
@@ -80,15 +109,45 @@ func (r *APIManagerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	}
 	if instance == nil {
 		logger.Info("resource not found. Ignoring since object must have been deleted")
+		operator.DefaultOptionsCache.Invalidate(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	// Owned objects of APIManagers claimed by other operator instances must never be touched
+	if !handlers.APIManagerSelectorMatches(r.APIManagerSelector, instance.GetLabels()) {
+		logger.Info("resource does not match the operator APIManager selector. Ignoring", "selector", r.APIManagerSelector.String())
+		return ctrl.Result{}, nil
+	}
+
+	if instance.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(instance, operator.APIManagerShutdownFinalizer) {
+			return r.reconcileShutdown(instance)
+		}
 		return ctrl.Result{}, nil
 	}
 
+	// Owned objects are left as they are, e.g. while being changed manually, only the status is reported
+	if operator.IsReconciliationPaused(instance) {
+		logger.Info("reconciliation paused, only the status is reconciled", "annotation", operator.ReconciliationPausedAnnotation)
+		return r.reconcileAPIManagerStatus(instance)
+	}
+
 	err = r.validateCR(instance)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	res, err := r.setAPIManagerDefaults(instance)
+	res, err := r.reconcileShutdownFinalizer(instance)
+	if err != nil {
+		logger.Error(err, "Error updating shutdown finalizer")
+		return ctrl.Result{}, err
+	}
+	if res.Requeue {
+		logger.Info("Shutdown finalizer updated for APIManager resource")
+		return res, nil
+	}
+
+	res, err = r.setAPIManagerDefaults(instance)
 	if err != nil {
 		logger.Error(err, "Error")
 		return ctrl.Result{}, err
@@ -98,6 +157,36 @@ func (r *APIManagerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return res, nil
 	}
 
+	res, err = r.reconcileAPIcastImport(instance)
+	if err != nil {
+		logger.Error(err, "Error importing APIcast")
+		return ctrl.Result{}, err
+	}
+	if res.Requeue {
+		logger.Info("APIcast import processed for APIManager resource")
+		return res, nil
+	}
+
+	res, err = r.reconcileMinimalSpecExport(instance)
+	if err != nil {
+		logger.Error(err, "Error exporting minimal spec")
+		return ctrl.Result{}, err
+	}
+	if res.Requeue {
+		logger.Info("Minimal spec export processed for APIManager resource")
+		return res, nil
+	}
+
+	res, err = r.reconcileSupportReport(instance)
+	if err != nil {
+		logger.Error(err, "Error generating support report")
+		return ctrl.Result{}, err
+	}
+	if res.Requeue {
+		logger.Info("Support report processed for APIManager resource")
+		return res, nil
+	}
+
 	specResult, specErr := r.reconcileAPIManagerLogic(instance)
 	if specErr != nil && specResult.Requeue {
 		logger.Info("Reconciling not finished. Requeueing.")
@@ -111,6 +200,11 @@ func (r *APIManagerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	}
 
 	if specErr != nil {
+		if helper.IsWaitError(specErr) {
+			// Not retried with backoff, the resource is expected to be created soon
+			logger.Info("Waiting to reconcile", "reason", specErr.Error())
+			return ctrl.Result{RequeueAfter: APIManagerWaitRequeueDelay}, nil
+		}
 		return ctrl.Result{}, specErr
 	}
 
@@ -119,18 +213,42 @@ func (r *APIManagerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return statusResult, nil
 	}
 
-	return ctrl.Result{}, nil
+	requeueAfter := statusResult.RequeueAfter
+	if specResult.RequeueAfter > 0 && (requeueAfter == 0 || specResult.RequeueAfter < requeueAfter) {
+		requeueAfter = specResult.RequeueAfter
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *APIManagerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ownedPredicate := handlers.APIManagerOwnedSelectorPredicate(r.Client(), r.APIManagerSelector, r.Logger().WithName("APIManagerSelector"))
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&appsv1alpha1.APIManager{}).
-		Owns(&appsv1.DeploymentConfig{}).
-		Owns(&policyv1beta1.PodDisruptionBudget{}).
+		For(&appsv1alpha1.APIManager{}, builder.WithPredicates(handlers.APIManagerSelectorPredicate(r.APIManagerSelector))).
+		Owns(&appsv1.DeploymentConfig{}, builder.WithPredicates(ownedPredicate)).
+		Owns(&policyv1beta1.PodDisruptionBudget{}, builder.WithPredicates(ownedPredicate)).
+		Owns(&autoscalingv2beta2.HorizontalPodAutoscaler{}, builder.WithPredicates(ownedPredicate)).
 		Watches(&source.Kind{Type: &routev1.Route{}}, &handler.EnqueueRequestsFromMapFunc{
-			ToRequests: &handlers.APIManagerRoutesEventMapper{
+			ToRequests: &handlers.APIManagerSelectorMapper{
+				Mapper: &handlers.APIManagerRoutesEventMapper{
+					K8sClient: r.Client(),
+					Logger:    r.Logger().WithName("APIManagerRoutesHandler"),
+				},
 				K8sClient: r.Client(),
-				Logger:    r.Logger().WithName("APIManagerRoutesHandler"),
+				Selector:  r.APIManagerSelector,
+				Logger:    r.Logger().WithName("APIManagerSelector"),
+			},
+		}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: &handlers.APIManagerSelectorMapper{
+				Mapper: &handlers.APIManagerSecretEventMapper{
+					K8sClient: r.Client(),
+					Logger:    r.Logger().WithName("APIManagerSecretHandler"),
+				},
+				K8sClient: r.Client(),
+				Selector:  r.APIManagerSelector,
+				Logger:    r.Logger().WithName("APIManagerSelector"),
 			},
 		}).
 		Complete(r)
@@ -143,6 +261,16 @@ func (r *APIManagerReconciler) validateCR(cr *appsv1alpha1.APIManager) error {
 
 	fieldError = append(fieldError, r.validateApicastTLSCertificates(cr)...)
 
+	// Installs with hosts exceeding the DNS limits are rejected. Existing installs
+	// keep being reconciled and get a warning condition instead
+	if len(cr.Status.Conditions) == 0 {
+		fieldError = append(fieldError, cr.ValidateRouteHosts()...)
+	}
+
+	// Switching an existing install to or from the gateway only mode would
+	// leave the control plane orphaned or deploy it next to the remote one
+	fieldError = append(fieldError, cr.ValidateTopologyChange()...)
+
 	if len(fieldError) > 0 {
 		return fieldError.ToAggregate()
 	}
@@ -183,62 +311,129 @@ func (r *APIManagerReconciler) setAPIManagerDefaults(cr *appsv1alpha1.APIManager
 	return ctrl.Result{Requeue: updated}, err
 }
 
-func (r *APIManagerReconciler) reconcileAPIManagerLogic(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
-	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
-	imageReconciler := operator.NewAMPImagesReconciler(baseAPIManagerLogicReconciler)
-	result, err := imageReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
+// reconcileShutdownFinalizer adds the shutdown finalizer when the ordered shutdown
+// is enabled, and removes it otherwise
+func (r *APIManagerReconciler) reconcileShutdownFinalizer(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	hasFinalizer := controllerutil.ContainsFinalizer(cr, operator.APIManagerShutdownFinalizer)
+	if cr.IsOrderedShutdownEnabled() == hasFinalizer {
+		return ctrl.Result{}, nil
 	}
 
-	dependencyReconciler := r.dependencyReconcilerForComponents(cr, baseAPIManagerLogicReconciler)
-	result, err = dependencyReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
+	if hasFinalizer {
+		controllerutil.RemoveFinalizer(cr, operator.APIManagerShutdownFinalizer)
+	} else {
+		controllerutil.AddFinalizer(cr, operator.APIManagerShutdownFinalizer)
 	}
 
-	backendReconciler := operator.NewBackendReconciler(baseAPIManagerLogicReconciler)
-	result, err = backendReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
-	}
+	err := r.Client().Update(context.TODO(), cr)
+	return ctrl.Result{Requeue: true}, err
+}
 
-	memcachedReconciler := operator.NewMemcachedReconciler(baseAPIManagerLogicReconciler)
-	result, err = memcachedReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
-	}
+func (r *APIManagerReconciler) reconcileShutdown(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
+	return operator.NewShutdownReconciler(baseAPIManagerLogicReconciler).Reconcile()
+}
 
-	systemReconciler := operator.NewSystemReconciler(baseAPIManagerLogicReconciler)
-	result, err = systemReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
-	}
+func (r *APIManagerReconciler) reconcileAPIcastImport(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
+	return operator.NewAPIcastImportReconciler(baseAPIManagerLogicReconciler).Reconcile()
+}
 
-	zyncReconciler := operator.NewZyncReconciler(baseAPIManagerLogicReconciler)
-	result, err = zyncReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
-	}
+func (r *APIManagerReconciler) reconcileMinimalSpecExport(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
+	return operator.NewMinimalSpecExportReconciler(baseAPIManagerLogicReconciler).Reconcile()
+}
+
+func (r *APIManagerReconciler) reconcileSupportReport(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
+	return operator.NewSupportReportReconciler(baseAPIManagerLogicReconciler).Reconcile()
+}
+
+// hibernationPausedSubReconcilers are the sub-reconcilers relying on running
+// components, which are paused while the APIManager is hibernated
+var hibernationPausedSubReconcilers = []string{
+	"file-storage-migration",
+	"developer-portal",
+	"master-route",
+	"standby",
+	"admin-sso",
+	"access-tokens",
+}
+
+func (r *APIManagerReconciler) reconcileAPIManagerLogic(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
+	baseAPIManagerLogicReconciler := operator.NewBaseAPIManagerLogicReconciler(r.BaseReconciler, cr)
 
-	apicastReconciler := operator.NewApicastReconciler(baseAPIManagerLogicReconciler)
-	result, err = apicastReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
+	// Sub-reconcilers are run in order, each one timed by name
+	type namedSubReconciler struct {
+		name       string
+		reconciler subReconciler
+	}
+
+	var subReconcilers []namedSubReconciler
+	if cr.IsGatewayOnly() {
+		// The control plane is managed outside of the APIManager, only the gateways are deployed
+		subReconcilers = []namedSubReconciler{
+			{"images", operator.NewAMPImagesReconciler(baseAPIManagerLogicReconciler)},
+			{"apicast", operator.NewApicastReconciler(baseAPIManagerLogicReconciler)},
+			{"monitoring", operator.NewGenericMonitoringReconciler(baseAPIManagerLogicReconciler)},
+			{"prometheus-rule-overrides", operator.NewPrometheusRuleOverridesReconciler(baseAPIManagerLogicReconciler)},
+			{"debug-containers", operator.NewDebugContainersReconciler(baseAPIManagerLogicReconciler, r.DebugContainersPodsClient)},
+		}
+	} else {
+		subReconcilers = []namedSubReconciler{
+			// The internal databases are dumped before the images and deployments are upgraded
+			{"database-backup", operator.NewDatabaseBackupReconciler(baseAPIManagerLogicReconciler)},
+			{"images", operator.NewAMPImagesReconciler(baseAPIManagerLogicReconciler)},
+			{"dependencies", r.dependencyReconcilerForComponents(cr, baseAPIManagerLogicReconciler)},
+			// Expired request logging is disabled before the backend env vars are reconciled
+			{"backend-request-logging", operator.NewBackendRequestLoggingReconciler(baseAPIManagerLogicReconciler)},
+			{"backend", operator.NewBackendReconciler(baseAPIManagerLogicReconciler)},
+			{"external-backend", operator.NewExternalBackendReconciler(baseAPIManagerLogicReconciler)},
+			{"memcached", operator.NewMemcachedReconciler(baseAPIManagerLogicReconciler)},
+			{"system", operator.NewSystemReconciler(baseAPIManagerLogicReconciler)},
+			// The migration job is based on the reconciled system-sidekiq
+			{"file-storage-migration", operator.NewFileStorageMigrationReconciler(baseAPIManagerLogicReconciler)},
+			{"zync", operator.NewZyncReconciler(baseAPIManagerLogicReconciler)},
+			// The developer portal and master routes are created by zync
+			{"developer-portal", operator.NewDeveloperPortalReconciler(baseAPIManagerLogicReconciler)},
+			{"master-route", operator.NewMasterRouteReconciler(baseAPIManagerLogicReconciler)},
+			{"apicast", operator.NewApicastReconciler(baseAPIManagerLogicReconciler)},
+			{"monitoring", operator.NewGenericMonitoringReconciler(baseAPIManagerLogicReconciler)},
+			{"database-exporters", operator.NewDatabaseExportersReconciler(baseAPIManagerLogicReconciler)},
+			{"prometheus-rule-overrides", operator.NewPrometheusRuleOverridesReconciler(baseAPIManagerLogicReconciler)},
+			// Standby mode is reconciled once the components have been reconciled
+			{"standby", operator.NewStandbyReconciler(baseAPIManagerLogicReconciler)},
+			// The admin SSO is configured through the admin API, once system is up
+			{"admin-sso", operator.NewAdminSSOReconciler(baseAPIManagerLogicReconciler)},
+			{"access-tokens", operator.NewAccessTokensReconciler(baseAPIManagerLogicReconciler)},
+			{"debug-containers", operator.NewDebugContainersReconciler(baseAPIManagerLogicReconciler, r.DebugContainersPodsClient)},
+		}
 	}
 
-	genericMonitoringReconciler := operator.NewGenericMonitoringReconciler(baseAPIManagerLogicReconciler)
-	result, err = genericMonitoringReconciler.Reconcile()
-	if err != nil || result.Requeue {
-		return result, err
+	result := reconcile.Result{}
+	for _, sub := range subReconcilers {
+		// The sub-reconcilers relying on running components resume once the APIManager is woken up
+		if cr.IsHibernated() && helper.ArrayContains(hibernationPausedSubReconcilers, sub.name) {
+			continue
+		}
+
+		subResult, err := r.timedReconcile(cr, sub.name, sub.reconciler)
+		if err != nil || subResult.Requeue {
+			return subResult, err
+		}
+
+		// The earliest delayed requeue is kept: request logging expiration, standby activation, admin SSO retries, debug containers expiration
+		if subResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || subResult.RequeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = subResult.RequeueAfter
+		}
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *APIManagerReconciler) reconcileAPIManagerStatus(cr *appsv1alpha1.APIManager) (reconcile.Result, error) {
 	statusReconciler := NewAPIManagerStatusReconciler(r.BaseReconciler, cr)
-	res, err := statusReconciler.Reconcile()
+	res, err := r.timedReconcile(cr, "status", statusReconciler)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("Failed to update APIManager status: %w", err)
 	}
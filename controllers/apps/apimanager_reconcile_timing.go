@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// SlowSubReconcilerThresholdEnvVar sets the duration from which a single
+	// sub-reconciler run is reported in a warning event. Go duration format, i.e. 30s
+	SlowSubReconcilerThresholdEnvVar = "APIMANAGER_SLOW_SUBRECONCILER_THRESHOLD"
+	// DefaultSlowSubReconcilerThreshold is used when the env var is not set or not valid
+	DefaultSlowSubReconcilerThreshold = 10 * time.Second
+)
+
+// SubReconcilerDurationMetric exposes the duration of each APIManager sub-reconciler run
+var SubReconcilerDurationMetric = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "threescale_apimanager_subreconciler_duration_seconds",
+		Help:    "Duration of the APIManager sub-reconcilers runs",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	},
+	[]string{"namespace", "subreconciler"},
+)
+
+// subReconciler is implemented by the APIManager logic reconcilers
+type subReconciler interface {
+	Reconcile() (reconcile.Result, error)
+}
+
+// timedReconcile runs the sub-reconciler recording its duration.
+// Runs exceeding the slow threshold are reported in a warning event.
+func (r *APIManagerReconciler) timedReconcile(cr *appsv1alpha1.APIManager, name string, sub subReconciler) (reconcile.Result, error) {
+	start := time.Now()
+	result, err := sub.Reconcile()
+	duration := time.Since(start)
+
+	SubReconcilerDurationMetric.WithLabelValues(cr.Namespace, name).Observe(duration.Seconds())
+	r.Logger().V(1).Info("sub-reconciler finished", "apimanager", cr.Name, "subreconciler", name, "duration", duration.String())
+
+	if threshold := slowSubReconcilerThreshold(); duration > threshold {
+		r.EventRecorder().Eventf(cr, v1.EventTypeWarning, "SlowReconcile",
+			"%s sub-reconciler took %s, exceeding the %s threshold", name, duration.Round(time.Millisecond), threshold)
+	}
+
+	return result, err
+}
+
+func slowSubReconcilerThreshold() time.Duration {
+	threshold, err := time.ParseDuration(helper.GetEnvVar(SlowSubReconcilerThresholdEnvVar, ""))
+	if err != nil || threshold <= 0 {
+		return DefaultSlowSubReconcilerThreshold
+	}
+
+	return threshold
+}
@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type sleepingSubReconciler struct {
+	sleep time.Duration
+}
+
+func (s *sleepingSubReconciler) Reconcile() (reconcile.Result, error) {
+	time.Sleep(s.sleep)
+	return reconcile.Result{}, nil
+}
+
+func TestSubReconcilerDurationMetric(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(SubReconcilerDurationMetric); err != nil {
+		t.Fatal(err)
+	}
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: "operator-unittest"},
+	}
+	cl := fake.NewFakeClient()
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(100)
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl, logf.Log.WithName("timing_test"), clientset.Discovery(), recorder)
+	r := &APIManagerReconciler{BaseReconciler: baseReconciler}
+
+	_, err := r.timedReconcile(apimanager, "zync", &sleepingSubReconciler{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricFamilies) != 1 || metricFamilies[0].GetName() != "threescale_apimanager_subreconciler_duration_seconds" {
+		t.Fatalf("unexpected metric families: %v", metricFamilies)
+	}
+
+	labels := map[string]string{}
+	for _, label := range metricFamilies[0].GetMetric()[0].GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	if labels["namespace"] != "operator-unittest" || labels["subreconciler"] != "zync" {
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+	if count := metricFamilies[0].GetMetric()[0].GetHistogram().GetSampleCount(); count != 1 {
+		t.Fatalf("expected 1 observation, got %d", count)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		t.Fatalf("unexpected event: %s", event)
+	default:
+	}
+}
+
+func TestSlowSubReconcilerEvent(t *testing.T) {
+	os.Setenv(SlowSubReconcilerThresholdEnvVar, "1ms")
+	defer os.Unsetenv(SlowSubReconcilerThresholdEnvVar)
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: "operator-unittest"},
+	}
+	cl := fake.NewFakeClient()
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(100)
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl, logf.Log.WithName("timing_test"), clientset.Discovery(), recorder)
+	r := &APIManagerReconciler{BaseReconciler: baseReconciler}
+
+	_, err := r.timedReconcile(apimanager, "apicast", &sleepingSubReconciler{sleep: 5 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "SlowReconcile") || !strings.Contains(event, "apicast") {
+			t.Fatalf("unexpected event: %s", event)
+		}
+	default:
+		t.Fatal("expected SlowReconcile event")
+	}
+}
+
+func TestSlowSubReconcilerThreshold(t *testing.T) {
+	cases := []struct {
+		testName string
+		envValue string
+		expected time.Duration
+	}{
+		{"unset", "", DefaultSlowSubReconcilerThreshold},
+		{"invalid", "foo", DefaultSlowSubReconcilerThreshold},
+		{"negative", "-1s", DefaultSlowSubReconcilerThreshold},
+		{"valid", "30s", 30 * time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			os.Setenv(SlowSubReconcilerThresholdEnvVar, tc.envValue)
+			defer os.Unsetenv(SlowSubReconcilerThresholdEnvVar)
+
+			if threshold := slowSubReconcilerThreshold(); threshold != tc.expected {
+				subT.Fatalf("expected %s, got %s", tc.expected, threshold)
+			}
+		})
+	}
+}
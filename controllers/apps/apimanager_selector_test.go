@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/operator"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestAPIManagerSelectorDisjointWrites(t *testing.T) {
+	namespace := "operator-unittest"
+
+	newAPIManager := func(name, shard string) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"shard": shard},
+			},
+			Spec: appsv1alpha1.APIManagerSpec{
+				APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{WildcardDomain: "example.com"},
+				// Enabling the ordered shutdown makes the first reconcile add the finalizer
+				Shutdown: &appsv1alpha1.ShutdownSpec{Enabled: true},
+			},
+		}
+	}
+
+	if err := appsv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	apimanagerA := newAPIManager("apimanager-a", "a")
+	apimanagerB := newAPIManager("apimanager-b", "b")
+	cl := fake.NewFakeClient(apimanagerA, apimanagerB)
+
+	// Two operator instances sharing the cluster, each one claiming one shard
+	newInstance := func(selector string) *APIManagerReconciler {
+		parsedSelector, err := labels.Parse(selector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl,
+			logf.Log.WithName(selector), fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(100))
+		return &APIManagerReconciler{BaseReconciler: baseReconciler, APIManagerSelector: parsedSelector}
+	}
+
+	instances := map[string]*APIManagerReconciler{
+		"a": newInstance("shard=a"),
+		"b": newInstance("shard=b"),
+	}
+
+	latest := func(name string) *appsv1alpha1.APIManager {
+		apimanager := &appsv1alpha1.APIManager{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, apimanager); err != nil {
+			t.Fatal(err)
+		}
+		return apimanager
+	}
+
+	for _, apimanager := range []*appsv1alpha1.APIManager{apimanagerA, apimanagerB} {
+		for shard, instance := range instances {
+			before := latest(apimanager.Name).ResourceVersion
+
+			_, err := instance.Reconcile(ctrl.Request{NamespacedName: client.ObjectKey{Name: apimanager.Name, Namespace: namespace}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			written := latest(apimanager.Name).ResourceVersion != before
+			owned := apimanager.Labels["shard"] == shard
+			if written != owned {
+				t.Errorf("instance '%s' reconciling '%s': expected written %t, got %t", shard, apimanager.Name, owned, written)
+			}
+		}
+	}
+
+	for _, name := range []string{apimanagerA.Name, apimanagerB.Name} {
+		if !controllerutil.ContainsFinalizer(latest(name), operator.APIManagerShutdownFinalizer) {
+			t.Errorf("expected '%s' to be reconciled by its instance", name)
+		}
+	}
+}
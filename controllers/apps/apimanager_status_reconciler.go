@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/3scale/3scale-operator/apis/apps/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/operator"
 	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"github.com/RHsyseng/operator-utils/pkg/olm"
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/go-logr/logr"
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	v1 "k8s.io/api/core/v1"
@@ -21,6 +27,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// MonitoringCRDsRecheckPeriod is the delay to look up again the monitoring CRDs
+// when some of them were not installed
+const MonitoringCRDsRecheckPeriod = 5 * time.Minute
+
 type APIManagerStatusReconciler struct {
 	*reconcilers.BaseReconciler
 	apimanagerResource *appsv1alpha1.APIManager
@@ -43,16 +53,25 @@ func (s *APIManagerStatusReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, fmt.Errorf("failed to calculate status: %w", err)
 	}
 
+	result := reconcile.Result{}
+	if newStatus.Conditions.IsTrueFor(appsv1alpha1.APIManagerMonitoringPartiallyAvailableConditionType) {
+		// Look up the missing monitoring CRDs again later
+		result.RequeueAfter = MonitoringCRDsRecheckPeriod
+	}
+
 	equalStatus := s.apimanagerResource.Status.Equals(newStatus, s.logger)
 	s.logger.V(1).Info("Status", "status is different", !equalStatus)
 	if equalStatus {
 		// Steady state
 		s.logger.V(1).Info("Status was not updated")
-		return reconcile.Result{}, nil
+		return result, nil
 	}
 
-	s.apimanagerResource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.apimanagerResource)
+	previousComponents := s.apimanagerResource.Status.Components
+	_, updateErr := s.StatusWriter().Write(s.apimanagerResource, func(common.KubernetesObject) error {
+		s.apimanagerResource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -62,7 +81,13 @@ func (s *APIManagerStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 		return reconcile.Result{}, fmt.Errorf("Failed to update status: %w", updateErr)
 	}
-	return reconcile.Result{}, nil
+
+	// Events are emitted once the transition is recorded in the status
+	for _, name := range newlyDegradedComponents(previousComponents, newStatus.Components) {
+		s.EventRecorder().Eventf(s.apimanagerResource, v1.EventTypeWarning, "ComponentDegraded", "component %s is degraded", name)
+	}
+
+	return result, nil
 }
 
 func (s *APIManagerStatusReconciler) calculateStatus() (*appsv1alpha1.APIManagerStatus, error) {
@@ -81,12 +106,211 @@ func (s *APIManagerStatusReconciler) calculateStatus() (*appsv1alpha1.APIManager
 	}
 	newStatus.Conditions.SetCondition(availableCondition)
 
+	if cacheStoreWarningCondition := s.systemCacheStoreWarningCondition(); cacheStoreWarningCondition != nil {
+		newStatus.Conditions.SetCondition(*cacheStoreWarningCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerSystemCacheStoreWarningConditionType)
+	}
+
 	deploymentStatus := olm.GetDeploymentConfigStatus(deployments)
 	newStatus.Deployments = deploymentStatus
 
+	workloads, clusterZones, err := s.workloadsStatus(deployments)
+	if err != nil {
+		return nil, err
+	}
+	newStatus.Workloads = workloads
+	newStatus.Components = componentsStatus(deployments, s.expectedDeploymentNames(s.apimanagerResource), crashLoopingWorkloads(workloads, time.Now()))
+	newStatus.Conditions.SetCondition(progressingCondition(newStatus.Components))
+	newStatus.Conditions.SetCondition(degradedCondition(newStatus.Components))
+	// A failed pre-upgrade database backup halts the upgrade
+	if s.apimanagerResource.IsDatabaseBackupFailed() {
+		newStatus.Conditions.SetCondition(databaseBackupFailedCondition(s.apimanagerResource.Status.DatabaseBackup))
+	}
+	newStatus.Shutdown = s.apimanagerResource.Status.Shutdown.DeepCopy()
+	newStatus.Standby = s.apimanagerResource.Status.Standby.DeepCopy()
+	newStatus.FileStorageMigration = s.apimanagerResource.Status.FileStorageMigration.DeepCopy()
+	newStatus.ThreescaleVersion = s.apimanagerResource.Status.ThreescaleVersion
+	newStatus.DatabaseBackup = s.apimanagerResource.Status.DatabaseBackup.DeepCopy()
+	newStatus.BackendListenerRequestLogging = s.apimanagerResource.Status.BackendListenerRequestLogging.DeepCopy()
+	newStatus.AdminSSO = s.apimanagerResource.Status.AdminSSO.DeepCopy()
+	newStatus.Hosts = s.apimanagerResource.DefaultRouteHosts()
+	newStatus.DeveloperPortal = s.apimanagerResource.Status.DeveloperPortal
+	newStatus.MasterEndpoint = s.apimanagerResource.Status.MasterEndpoint
+	newStatus.ZyncMode = s.apimanagerResource.ZyncMode()
+	newStatus.Topology = s.apimanagerResource.Topology()
+	if s.apimanagerResource.IsExternalBackend() {
+		newStatus.ExternalBackendEndpoint = s.apimanagerResource.Spec.ExternalBackend.ListenerEndpoint
+	}
+
+	if operator.IsReconciliationPaused(s.apimanagerResource) {
+		newStatus.Conditions.SetCondition(reconciliationPausedCondition())
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerReconciliationPausedConditionType)
+	}
+
+	if hibernatedCondition := hibernatedCondition(s.apimanagerResource, newStatus.Components); hibernatedCondition != nil {
+		newStatus.Conditions.SetCondition(*hibernatedCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerHibernatedConditionType)
+	}
+
+	if routeHostsWarningCondition := s.routeHostsWarningCondition(); routeHostsWarningCondition != nil {
+		newStatus.Conditions.SetCondition(*routeHostsWarningCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerRouteHostsWarningConditionType)
+	}
+
+	if crashLoopingCondition := s.workloadCrashLoopingCondition(workloads); crashLoopingCondition != nil {
+		newStatus.Conditions.SetCondition(*crashLoopingCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerWorkloadCrashLoopingConditionType)
+	}
+
+	if singleZoneCondition := s.singleZoneWorkloadsCondition(workloads, clusterZones); singleZoneCondition != nil {
+		newStatus.Conditions.SetCondition(*singleZoneCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerSingleZoneWorkloadsConditionType)
+	}
+
+	inboundEmailCondition, err := s.systemInboundEmailSecretMissingCondition()
+	if err != nil {
+		return nil, err
+	}
+	if inboundEmailCondition != nil {
+		newStatus.Conditions.SetCondition(*inboundEmailCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerSystemInboundEmailSecretMissingConditionType)
+	}
+
+	if deprecatedAPIVersionCondition := s.deprecatedAPIVersionCondition(); deprecatedAPIVersionCondition != nil {
+		newStatus.Conditions.SetCondition(*deprecatedAPIVersionCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerDeprecatedAPIVersionConditionType)
+	}
+
+	monitoringCondition, err := s.monitoringPartiallyAvailableCondition()
+	if err != nil {
+		return nil, err
+	}
+	if monitoringCondition != nil {
+		newStatus.Conditions.SetCondition(*monitoringCondition)
+	} else {
+		newStatus.Conditions.RemoveCondition(appsv1alpha1.APIManagerMonitoringPartiallyAvailableConditionType)
+	}
+
 	return newStatus, nil
 }
 
+// monitoringPartiallyAvailableCondition lists the monitoring kinds required by the APIManager
+// that are not supported in the cluster. Each kind is looked up independently,
+// so the resources of the supported kinds can still be created.
+func (s *APIManagerStatusReconciler) monitoringPartiallyAvailableCondition() (*common.Condition, error) {
+	if !s.apimanagerResource.IsMonitoringEnabled() {
+		return nil, nil
+	}
+
+	kinds := []struct {
+		name     string
+		required bool
+		exists   func() (bool, error)
+	}{
+		{grafanav1alpha1.GrafanaDashboardKind, true, s.HasGrafanaDashboards},
+		{monitoringv1.ServiceMonitorsKind, true, s.HasServiceMonitors},
+		{monitoringv1.PodMonitorsKind, true, s.HasPodMonitors},
+		{monitoringv1.PrometheusRuleKind, s.apimanagerResource.IsPrometheusRulesEnabled(), s.HasPrometheusRules},
+	}
+
+	unsupported := []string{}
+	for _, kind := range kinds {
+		if !kind.required {
+			continue
+		}
+		exists, err := kind.exists()
+		if err != nil {
+			s.logger.Error(err, "monitoring kind discovery failed", "kind", kind.name)
+		}
+		if err != nil || !exists {
+			unsupported = append(unsupported, kind.name)
+		}
+	}
+
+	if len(unsupported) == 0 {
+		return nil, nil
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerMonitoringPartiallyAvailableConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("MonitoringCRDsNotFound"),
+		Message: fmt.Sprintf("unsupported monitoring kinds, related resources not created: %s", strings.Join(unsupported, ", ")),
+	}, nil
+}
+
+// workloadsStatus inspects the container statuses and the zone distribution of the APIManager pods.
+// Pods are read with a single label selector list,
+// only the failures and the zones of multi-replica components are kept to keep the status small.
+// The number of zones of the cluster is returned as well.
+func (s *APIManagerStatusReconciler) workloadsStatus(deployments []appsv1.DeploymentConfig) ([]appsv1alpha1.WorkloadStatus, int, error) {
+	if s.apimanagerResource.Spec.AppLabel == nil {
+		return nil, 0, nil
+	}
+
+	listOps := []client.ListOption{
+		client.InNamespace(s.apimanagerResource.Namespace),
+		client.MatchingLabels{"app": *s.apimanagerResource.Spec.AppLabel},
+	}
+
+	podList := &v1.PodList{}
+	err := s.Client().List(context.TODO(), podList, listOps...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Failed to list pods: %w", err)
+	}
+
+	deploymentNames := s.expectedDeploymentNames(s.apimanagerResource)
+	workloads := workloadsStatus(podList.Items, deploymentNames)
+	updateWorkloadFailuresMetric(s.apimanagerResource.Namespace, deploymentNames, workloads)
+
+	// Nodes are read with the API reader, the zones are cached for NodeZonesCacheTTL
+	nodeZones, err := clusterNodeZones.get(context.TODO(), s.APIClientReader(), scheduledNodeNames(podList.Items), time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+	workloads = addWorkloadZones(workloads, workloadZones(podList.Items, deployments, nodeZones))
+
+	return workloads, distinctZones(nodeZones), nil
+}
+
+// singleZoneWorkloadsCondition returns a warning condition when all the replicas of some
+// critical component are scheduled in a single zone, so a zone failure takes it down
+func (s *APIManagerStatusReconciler) singleZoneWorkloadsCondition(workloads []appsv1alpha1.WorkloadStatus, clusterZones int) *common.Condition {
+	singleZone := singleZoneWorkloads(workloads, clusterZones)
+	if len(singleZone) == 0 {
+		return nil
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerSingleZoneWorkloadsConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("ReplicasInSingleZone"),
+		Message: fmt.Sprintf("all replicas scheduled in a single zone: %s. Configure pod anti-affinity on the %s topology key in the component affinity to spread them", strings.Join(singleZone, ", "), v1.LabelZoneFailureDomainStable),
+	}
+}
+
+func (s *APIManagerStatusReconciler) workloadCrashLoopingCondition(workloads []appsv1alpha1.WorkloadStatus) *common.Condition {
+	crashLooping := crashLoopingWorkloads(workloads, time.Now())
+	if len(crashLooping) == 0 {
+		return nil
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerWorkloadCrashLoopingConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("ContainersRestarting"),
+		Message: fmt.Sprintf("containers restarted more than %d times in the last %s: %s", WorkloadCrashLoopThreshold, WorkloadCrashLoopWindow, strings.Join(crashLooping, ", ")),
+	}
+}
+
 func (s *APIManagerStatusReconciler) expectedDeploymentNames(instance *appsv1alpha1.APIManager) []string {
 	var systemDatabaseType component.SystemDatabaseType
 	var externalRedisDatabases bool
@@ -112,6 +336,10 @@ func (s *APIManagerStatusReconciler) expectedDeploymentNames(instance *appsv1alp
 		SystemDatabaseType:     systemDatabaseType,
 		ExternalRedisDatabases: externalRedisDatabases,
 		ExternalZyncDatabase:   externalZyncDatabase,
+		ExternalZync:           instance.IsExternalZync(),
+		SystemCacheStoreRedis:  instance.IsSystemCacheStoreRedis(),
+		GatewayOnly:            instance.IsGatewayOnly(),
+		ExternalBackend:        instance.IsExternalBackend(),
 	}
 
 	return deploymentLister.DeploymentNames()
@@ -181,16 +409,186 @@ func (s *APIManagerStatusReconciler) apimanagerAvailableCondition(existingDeploy
 	return newAvailableCondition, nil
 }
 
-func (s *APIManagerStatusReconciler) defaultRoutesReady() (bool, error) {
-	wildcardDomain := s.apimanagerResource.Spec.WildcardDomain
-	expectedRouteHosts := []string{
-		fmt.Sprintf("backend-%s.%s", *s.apimanagerResource.Spec.TenantName, wildcardDomain),                // Backend Listener route
-		fmt.Sprintf("api-%s-apicast-production.%s", *s.apimanagerResource.Spec.TenantName, wildcardDomain), // Apicast Production default tenant Route
-		fmt.Sprintf("api-%s-apicast-staging.%s", *s.apimanagerResource.Spec.TenantName, wildcardDomain),    // Apicast Staging default tenant Route
-		fmt.Sprintf("master.%s", wildcardDomain),                                                           // System's Master Portal Route
-		fmt.Sprintf("%s.%s", *s.apimanagerResource.Spec.TenantName, wildcardDomain),                        // System's default tenant Developer Portal Route
-		fmt.Sprintf("%s-admin.%s", *s.apimanagerResource.Spec.TenantName, wildcardDomain),                  // System's default tenant Admin Portal Route
+// progressingCondition is true while some component DeploymentConfig is missing or rolling out
+func progressingCondition(components map[string]appsv1alpha1.ComponentStatus) common.Condition {
+	progressing := progressingComponents(components)
+	if len(progressing) == 0 {
+		return common.Condition{
+			Type:   appsv1alpha1.APIManagerProgressingConditionType,
+			Status: v1.ConditionFalse,
+		}
+	}
+
+	return common.Condition{
+		Type:    appsv1alpha1.APIManagerProgressingConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("ComponentsNotReady"),
+		Message: fmt.Sprintf("components not ready: %s", strings.Join(progressing, ", ")),
+	}
+}
+
+// degradedCondition is true when the latest rollout of some component failed or its containers are crash-looping
+func degradedCondition(components map[string]appsv1alpha1.ComponentStatus) common.Condition {
+	degraded := degradedComponents(components)
+	if len(degraded) == 0 {
+		return common.Condition{
+			Type:   appsv1alpha1.APIManagerDegradedConditionType,
+			Status: v1.ConditionFalse,
+		}
+	}
+
+	return common.Condition{
+		Type:    appsv1alpha1.APIManagerDegradedConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("ComponentsDegraded"),
+		Message: fmt.Sprintf("components degraded: %s", strings.Join(degraded, ", ")),
+	}
+}
+
+// reconciliationPausedCondition reports the objects owned by the APIManager are not reconciled
+func reconciliationPausedCondition() common.Condition {
+	return common.Condition{
+		Type:    appsv1alpha1.APIManagerReconciliationPausedConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("PausedByAnnotation"),
+		Message: fmt.Sprintf("the owned objects are not reconciled while the '%s' annotation is set to 'true'", operator.ReconciliationPausedAnnotation),
+	}
+}
+
+// hibernatedCondition reports the components still running while the APIManager is hibernated
+func hibernatedCondition(apimanager *appsv1alpha1.APIManager, components map[string]appsv1alpha1.ComponentStatus) *common.Condition {
+	if !apimanager.IsHibernated() {
+		return nil
+	}
+
+	running := []string{}
+	for name, componentStatus := range components {
+		if componentStatus.AvailableReplicas > 0 {
+			running = append(running, name)
+		}
+	}
+	if len(running) == 0 {
+		return &common.Condition{
+			Type:    appsv1alpha1.APIManagerHibernatedConditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  common.ConditionReason("Hibernated"),
+			Message: "all the components are scaled down",
+		}
+	}
+	sort.Strings(running)
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerHibernatedConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("ScalingDown"),
+		Message: fmt.Sprintf("components still running: %s", strings.Join(running, ", ")),
+	}
+}
+
+// databaseBackupFailedCondition reports the upgrade halted by a failed pre-upgrade database backup
+func databaseBackupFailedCondition(backup *appsv1alpha1.DatabaseBackupStatus) common.Condition {
+	return common.Condition{
+		Type:    appsv1alpha1.APIManagerDegradedConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("DatabaseBackupFailed"),
+		Message: fmt.Sprintf("upgrade to %s halted, the database backup failed: %s", backup.ThreescaleVersion, backup.Message),
+	}
+}
+
+// systemCacheStoreWarningCondition returns a warning condition when the redis cache store
+// is used with an internal system-redis whose memory limit is below the recommended minimum
+func (s *APIManagerStatusReconciler) systemCacheStoreWarningCondition() *common.Condition {
+	if !s.apimanagerResource.IsSystemCacheStoreRedis() || s.apimanagerResource.IsExternal(appsv1alpha1.SystemRedis) {
+		return nil
+	}
+
+	redisResources := s.apimanagerResource.Spec.System.RedisResources
+	if redisResources == nil {
+		return nil
+	}
+
+	memoryLimit, ok := redisResources.Limits[v1.ResourceMemory]
+	minMemoryLimit := component.SystemCacheRedisMinMemoryLimit()
+	if !ok || memoryLimit.Cmp(minMemoryLimit) >= 0 {
+		return nil
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerSystemCacheStoreWarningConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("UndersizedSystemRedis"),
+		Message: fmt.Sprintf("system-redis memory limit %s is below the recommended %s for the redis cache store", memoryLimit.String(), minMemoryLimit.String()),
+	}
+}
+
+// deprecatedAPIVersionCondition returns a condition when clients other than the operator
+// write the APIManager through the deprecated v1alpha1 API.
+// The clients are the field managers recorded by the API server
+func (s *APIManagerStatusReconciler) deprecatedAPIVersionCondition() *common.Condition {
+	managers := []string{}
+	for _, entry := range s.apimanagerResource.GetManagedFields() {
+		if entry.APIVersion != appsv1alpha1.GroupVersion.String() || entry.Manager == OperatorUserAgent {
+			continue
+		}
+		if !helper.ArrayContains(managers, entry.Manager) {
+			managers = append(managers, entry.Manager)
+		}
+	}
+	if len(managers) == 0 {
+		return nil
 	}
+	sort.Strings(managers)
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerDeprecatedAPIVersionConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("V1alpha1InUse"),
+		Message: fmt.Sprintf("%s is deprecated, use %s instead. Clients using it: %s", appsv1alpha1.GroupVersion.String(), appsv1beta1.GroupVersion.String(), strings.Join(managers, ", ")),
+	}
+}
+
+// routeHostsWarningCondition returns a warning condition when some default
+// route hosts exceed the DNS length limits
+func (s *APIManagerStatusReconciler) routeHostsWarningCondition() *common.Condition {
+	fieldErrors := s.apimanagerResource.ValidateRouteHosts()
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerRouteHostsWarningConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("HostExceedsDNSLimits"),
+		Message: fieldErrors.ToAggregate().Error(),
+	}
+}
+
+// systemInboundEmailSecretMissingCondition returns a condition when the system
+// inbound email credentials secret does not exist
+func (s *APIManagerStatusReconciler) systemInboundEmailSecretMissingCondition() (*common.Condition, error) {
+	if !s.apimanagerResource.IsSystemInboundEmailEnabled() {
+		return nil, nil
+	}
+
+	secretName := s.apimanagerResource.Spec.System.InboundEmail.CredentialsSecretRef.Name
+	err := s.Client().Get(context.TODO(), types.NamespacedName{Namespace: s.apimanagerResource.Namespace, Name: secretName}, &v1.Secret{})
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return &common.Condition{
+		Type:    appsv1alpha1.APIManagerSystemInboundEmailSecretMissingConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  common.ConditionReason("SecretNotFound"),
+		Message: fmt.Sprintf("inbound email credentials secret '%s' not found", secretName),
+	}, nil
+}
+
+func (s *APIManagerStatusReconciler) defaultRoutesReady() (bool, error) {
+	expectedRouteHosts := s.apimanagerResource.DefaultRouteHosts()
 
 	listOps := []client.ListOption{
 		client.InNamespace(s.apimanagerResource.Namespace),
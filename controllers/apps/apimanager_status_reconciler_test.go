@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestMonitoringPartiallyAvailableCondition(t *testing.T) {
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: "operator-unittest"},
+		Spec: appsv1alpha1.APIManagerSpec{
+			Monitoring: &appsv1alpha1.MonitoringSpec{Enabled: true},
+		},
+	}
+
+	if err := appsv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	cl := fake.NewFakeClient(apimanager)
+	clientset := fakeclientset.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: monitoringv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{
+				{Name: monitoringv1.PrometheusRuleName, Namespaced: true, Kind: monitoringv1.PrometheusRuleKind},
+				{Name: monitoringv1.PodMonitorName, Namespaced: true, Kind: monitoringv1.PodMonitorsKind},
+				{Name: monitoringv1.ServiceMonitorName, Namespaced: true, Kind: monitoringv1.ServiceMonitorsKind},
+			},
+		},
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl, logf.Log.WithName("status_test"), clientset.Discovery(), record.NewFakeRecorder(100))
+	statusReconciler := NewAPIManagerStatusReconciler(baseReconciler, apimanager)
+
+	condition, err := statusReconciler.monitoringPartiallyAvailableCondition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if condition == nil {
+		t.Fatal("expected condition for the missing GrafanaDashboard kind")
+	}
+	expectedMessage := "unsupported monitoring kinds, related resources not created: GrafanaDashboard"
+	if condition.Message != expectedMessage {
+		t.Fatalf("expected message '%s', got '%s'", expectedMessage, condition.Message)
+	}
+
+	// CRDs installed later are picked up on the next lookup
+	clientset.Resources = append(clientset.Resources, &metav1.APIResourceList{
+		GroupVersion: grafanav1alpha1.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{
+			{Name: "grafanadashboards", Namespaced: true, Kind: grafanav1alpha1.GrafanaDashboardKind},
+		},
+	})
+	condition, err = statusReconciler.monitoringPartiallyAvailableCondition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if condition != nil {
+		t.Fatalf("unexpected condition: %v", condition)
+	}
+}
+
+func TestDeprecatedAPIVersionCondition(t *testing.T) {
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-apimanager",
+			Namespace: "operator-unittest",
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: OperatorUserAgent, Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "apps.3scale.net/v1alpha1"},
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "apps.3scale.net/v1beta1"},
+			},
+		},
+	}
+
+	cl := fake.NewFakeClient()
+	clientset := fakeclientset.NewSimpleClientset()
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl, logf.Log.WithName("status_test"), clientset.Discovery(), record.NewFakeRecorder(100))
+	statusReconciler := NewAPIManagerStatusReconciler(baseReconciler, apimanager)
+
+	// Writes of the operator and through v1beta1 are expected
+	if condition := statusReconciler.deprecatedAPIVersionCondition(); condition != nil {
+		t.Fatalf("unexpected condition: %v", condition)
+	}
+
+	apimanager.ManagedFields = append(apimanager.ManagedFields,
+		metav1.ManagedFieldsEntry{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply, APIVersion: "apps.3scale.net/v1alpha1"},
+		metav1.ManagedFieldsEntry{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "apps.3scale.net/v1alpha1"},
+		metav1.ManagedFieldsEntry{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "apps.3scale.net/v1alpha1"},
+	)
+	condition := statusReconciler.deprecatedAPIVersionCondition()
+	if condition == nil {
+		t.Fatal("expected condition for the v1alpha1 clients")
+	}
+	expectedMessage := "apps.3scale.net/v1alpha1 is deprecated, use apps.3scale.net/v1beta1 instead. Clients using it: argocd-controller, kubectl"
+	if condition.Message != expectedMessage {
+		t.Fatalf("expected message '%s', got '%s'", expectedMessage, condition.Message)
+	}
+}
+
+func TestHibernatedCondition(t *testing.T) {
+	apimanager := &appsv1alpha1.APIManager{}
+	components := map[string]appsv1alpha1.ComponentStatus{
+		"backend-listener": {DesiredReplicas: 0, AvailableReplicas: 1},
+		"system-app":       {DesiredReplicas: 0, AvailableReplicas: 0},
+	}
+
+	if condition := hibernatedCondition(apimanager, components); condition != nil {
+		t.Fatalf("unexpected condition: %v", condition)
+	}
+
+	apimanager.Spec.Hibernated = true
+	condition := hibernatedCondition(apimanager, components)
+	if condition == nil || condition.Reason != "ScalingDown" || condition.Message != "components still running: backend-listener" {
+		t.Fatalf("unexpected condition: %v", condition)
+	}
+
+	components["backend-listener"] = appsv1alpha1.ComponentStatus{}
+	condition = hibernatedCondition(apimanager, components)
+	if condition == nil || condition.Reason != "Hibernated" {
+		t.Fatalf("unexpected condition: %v", condition)
+	}
+}
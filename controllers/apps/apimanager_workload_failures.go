@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"sort"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// WorkloadCrashLoopThreshold is the number of container restarts
+	// from which a recently failed component is considered crash-looping
+	WorkloadCrashLoopThreshold = 5
+	// WorkloadCrashLoopWindow is the time window the last failure must be
+	// within for a component to be considered crash-looping
+	WorkloadCrashLoopWindow = time.Hour
+
+	workloadFailureReasonOOMKilled        = "OOMKilled"
+	workloadFailureReasonCrashLoopBackOff = "CrashLoopBackOff"
+
+	// pods created from a DeploymentConfig template are labeled with the DC name
+	deploymentConfigPodLabel = "deploymentConfig"
+)
+
+// WorkloadFailuresMetric exposes, per APIManager component, the restart count
+// of the last failing container. It is 0 when no failure is observed.
+var WorkloadFailuresMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "threescale_apimanager_workload_failures",
+		Help: "Restart count of the last failing container (OOMKilled, CrashLoopBackOff) of APIManager components",
+	},
+	[]string{"namespace", "workload"},
+)
+
+// workloadsStatus aggregates the container failures of the given pods
+// by DeploymentConfig. Only the expected DeploymentConfigs having failures are reported.
+// Returned list is sorted by name.
+func workloadsStatus(pods []v1.Pod, deploymentNames []string) []appsv1alpha1.WorkloadStatus {
+	expected := map[string]bool{}
+	for _, name := range deploymentNames {
+		expected[name] = true
+	}
+
+	lastFailures := map[string]*appsv1alpha1.WorkloadFailure{}
+	for idx := range pods {
+		dcName := pods[idx].Labels[deploymentConfigPodLabel]
+		if !expected[dcName] {
+			continue
+		}
+
+		for _, containerStatus := range pods[idx].Status.ContainerStatuses {
+			failure := containerFailure(containerStatus)
+			if failure == nil {
+				continue
+			}
+			if current, ok := lastFailures[dcName]; !ok || isMoreRecentFailure(failure, current) {
+				lastFailures[dcName] = failure
+			}
+		}
+	}
+
+	var workloads []appsv1alpha1.WorkloadStatus
+	for dcName, failure := range lastFailures {
+		workloads = append(workloads, appsv1alpha1.WorkloadStatus{Name: dcName, LastFailure: failure})
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].Name < workloads[j].Name })
+
+	return workloads
+}
+
+// containerFailure returns the failure of the container, nil when it is healthy.
+// OOMKilled takes precedence over CrashLoopBackOff as it is more informative.
+func containerFailure(containerStatus v1.ContainerStatus) *appsv1alpha1.WorkloadFailure {
+	lastTerminated := containerStatus.LastTerminationState.Terminated
+
+	failure := &appsv1alpha1.WorkloadFailure{
+		Count:     containerStatus.RestartCount,
+		Container: containerStatus.Name,
+	}
+	if lastTerminated != nil {
+		failure.Timestamp = lastTerminated.FinishedAt
+		// With FallbackToLogsOnError, the last lines of the log when nothing was written
+		// to the termination message file
+		failure.Message = lastTerminated.Message
+	}
+
+	switch {
+	case lastTerminated != nil && lastTerminated.Reason == workloadFailureReasonOOMKilled:
+		failure.Reason = workloadFailureReasonOOMKilled
+	case containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == workloadFailureReasonCrashLoopBackOff:
+		failure.Reason = workloadFailureReasonCrashLoopBackOff
+	default:
+		return nil
+	}
+
+	return failure
+}
+
+func isMoreRecentFailure(a, b *appsv1alpha1.WorkloadFailure) bool {
+	if !a.Timestamp.Equal(&b.Timestamp) {
+		return b.Timestamp.Before(&a.Timestamp)
+	}
+	if a.Count != b.Count {
+		return a.Count > b.Count
+	}
+	return a.Container < b.Container
+}
+
+// crashLoopingWorkloads returns the names of the workloads whose last failure
+// happened within the crash-loop window and restarted more than the threshold.
+// Container restart counts are not bounded to the window,
+// so this is an approximation of "restarted more than N times in the last hour".
+func crashLoopingWorkloads(workloads []appsv1alpha1.WorkloadStatus, now time.Time) []string {
+	windowStart := metav1.NewTime(now.Add(-WorkloadCrashLoopWindow))
+
+	var names []string
+	for _, workload := range workloads {
+		failure := workload.LastFailure
+		if failure == nil || failure.Count <= WorkloadCrashLoopThreshold {
+			continue
+		}
+		// CrashLoopBackOff containers without termination timestamp are failing right now
+		if failure.Timestamp.IsZero() || !failure.Timestamp.Before(&windowStart) {
+			names = append(names, workload.Name)
+		}
+	}
+
+	return names
+}
+
+func updateWorkloadFailuresMetric(namespace string, deploymentNames []string, workloads []appsv1alpha1.WorkloadStatus) {
+	counts := map[string]int32{}
+	for _, workload := range workloads {
+		if workload.LastFailure != nil {
+			counts[workload.Name] = workload.LastFailure.Count
+		}
+	}
+
+	for _, name := range deploymentNames {
+		WorkloadFailuresMetric.WithLabelValues(namespace, name).Set(float64(counts[name]))
+	}
+}
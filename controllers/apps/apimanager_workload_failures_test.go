@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func workloadTestPod(dcName string, containerStatuses ...v1.ContainerStatus) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{deploymentConfigPodLabel: dcName},
+		},
+		Status: v1.PodStatus{ContainerStatuses: containerStatuses},
+	}
+}
+
+func oomKilledContainerStatus(name string, restarts int32, finishedAt time.Time) v1.ContainerStatus {
+	return v1.ContainerStatus{
+		Name:         name,
+		RestartCount: restarts,
+		LastTerminationState: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", FinishedAt: metav1.NewTime(finishedAt)},
+		},
+	}
+}
+
+func TestWorkloadsStatus(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	earlier := now.Add(-10 * time.Minute)
+
+	crashLoopStatus := v1.ContainerStatus{
+		Name:         "system-master",
+		RestartCount: 3,
+		State: v1.ContainerState{
+			Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+		},
+		LastTerminationState: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{Reason: "Error", Message: "rake aborted!\nActiveRecord::PendingMigrationError", FinishedAt: metav1.NewTime(earlier)},
+		},
+	}
+	healthyStatus := v1.ContainerStatus{Name: "backend-listener", RestartCount: 1}
+
+	pods := []v1.Pod{
+		workloadTestPod("system-sidekiq", oomKilledContainerStatus("system-sidekiq", 2, earlier)),
+		workloadTestPod("system-sidekiq", oomKilledContainerStatus("system-sidekiq", 7, now)),
+		workloadTestPod("system-app", crashLoopStatus),
+		workloadTestPod("backend-listener", healthyStatus),
+		workloadTestPod("not-expected", oomKilledContainerStatus("other", 9, now)),
+	}
+
+	expected := []appsv1alpha1.WorkloadStatus{
+		{
+			Name: "system-app",
+			LastFailure: &appsv1alpha1.WorkloadFailure{
+				Reason: "CrashLoopBackOff", Count: 3, Timestamp: metav1.NewTime(earlier), Container: "system-master",
+				Message: "rake aborted!\nActiveRecord::PendingMigrationError",
+			},
+		},
+		{
+			Name: "system-sidekiq",
+			LastFailure: &appsv1alpha1.WorkloadFailure{
+				Reason: "OOMKilled", Count: 7, Timestamp: metav1.NewTime(now), Container: "system-sidekiq",
+			},
+		},
+	}
+
+	workloads := workloadsStatus(pods, []string{"backend-listener", "system-app", "system-sidekiq"})
+	if !reflect.DeepEqual(expected, workloads) {
+		t.Fatal(cmp.Diff(expected, workloads))
+	}
+}
+
+func TestCrashLoopingWorkloads(t *testing.T) {
+	now := time.Now()
+
+	workloads := []appsv1alpha1.WorkloadStatus{
+		{Name: "recent-over-threshold", LastFailure: &appsv1alpha1.WorkloadFailure{Count: WorkloadCrashLoopThreshold + 1, Timestamp: metav1.NewTime(now.Add(-time.Minute))}},
+		{Name: "recent-under-threshold", LastFailure: &appsv1alpha1.WorkloadFailure{Count: WorkloadCrashLoopThreshold, Timestamp: metav1.NewTime(now.Add(-time.Minute))}},
+		{Name: "old-over-threshold", LastFailure: &appsv1alpha1.WorkloadFailure{Count: WorkloadCrashLoopThreshold + 1, Timestamp: metav1.NewTime(now.Add(-2 * time.Hour))}},
+		{Name: "no-failure"},
+	}
+
+	crashLooping := crashLoopingWorkloads(workloads, now)
+	if !reflect.DeepEqual([]string{"recent-over-threshold"}, crashLooping) {
+		t.Fatalf("unexpected crash-looping workloads: %v", crashLooping)
+	}
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodeZonesCacheTTL bounds how long the zones of the cluster nodes are kept
+// before listing the nodes again. Nodes are read without informer,
+// so the operator does not watch all the nodes of the cluster.
+const NodeZonesCacheTTL = 10 * time.Minute
+
+// singleZoneCriticalWorkloads are the components reported when
+// all their replicas are scheduled in a single zone
+var singleZoneCriticalWorkloads = []string{
+	component.BackendListenerName,
+	component.ApicastProductionName,
+	component.SystemAppDeploymentName,
+}
+
+// nodeZonesCache keeps the node name -> zone index shared by the reconciles
+type nodeZonesCache struct {
+	mutex      sync.Mutex
+	zones      map[string]string
+	expiration time.Time
+}
+
+var clusterNodeZones = &nodeZonesCache{}
+
+// get returns the zones of the cluster nodes. The nodes are listed again when the cache
+// expired or when some of the given node names is not known yet.
+func (c *nodeZonesCache) get(ctx context.Context, reader client.Reader, nodeNames []string, now time.Time) (map[string]string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	refresh := c.zones == nil || now.After(c.expiration)
+	for _, nodeName := range nodeNames {
+		if _, ok := c.zones[nodeName]; !ok {
+			refresh = true
+			break
+		}
+	}
+
+	if refresh {
+		nodeList := &v1.NodeList{}
+		if err := reader.List(ctx, nodeList); err != nil {
+			return nil, fmt.Errorf("Failed to list nodes: %w", err)
+		}
+
+		c.zones = make(map[string]string, len(nodeList.Items))
+		for idx := range nodeList.Items {
+			c.zones[nodeList.Items[idx].Name] = nodeZone(&nodeList.Items[idx])
+		}
+		c.expiration = now.Add(NodeZonesCacheTTL)
+	}
+
+	return c.zones, nil
+}
+
+func nodeZone(node *v1.Node) string {
+	if zone, ok := node.Labels[v1.LabelZoneFailureDomainStable]; ok {
+		return zone
+	}
+	return node.Labels[v1.LabelZoneFailureDomain]
+}
+
+// distinctZones returns the number of zones of the cluster nodes
+func distinctZones(nodeZones map[string]string) int {
+	zones := map[string]bool{}
+	for _, zone := range nodeZones {
+		if zone != "" {
+			zones[zone] = true
+		}
+	}
+	return len(zones)
+}
+
+// scheduledNodeNames returns the nodes the given pods are scheduled on
+func scheduledNodeNames(pods []v1.Pod) []string {
+	var nodeNames []string
+	for idx := range pods {
+		if pods[idx].Spec.NodeName != "" {
+			nodeNames = append(nodeNames, pods[idx].Spec.NodeName)
+		}
+	}
+	return nodeNames
+}
+
+// workloadZones aggregates the scheduled pods of the multi-replica DeploymentConfigs per zone.
+// Terminating pods and pods on nodes without zone label are not counted.
+// Zones are sorted by name.
+func workloadZones(pods []v1.Pod, deployments []appsv1.DeploymentConfig, nodeZones map[string]string) map[string][]appsv1alpha1.WorkloadZone {
+	multiReplica := map[string]bool{}
+	for idx := range deployments {
+		if deployments[idx].Spec.Replicas > 1 {
+			multiReplica[deployments[idx].Name] = true
+		}
+	}
+
+	podsPerZone := map[string]map[string]int32{}
+	for idx := range pods {
+		dcName := pods[idx].Labels[deploymentConfigPodLabel]
+		if !multiReplica[dcName] || pods[idx].DeletionTimestamp != nil {
+			continue
+		}
+
+		zone := nodeZones[pods[idx].Spec.NodeName]
+		if zone == "" {
+			continue
+		}
+
+		if podsPerZone[dcName] == nil {
+			podsPerZone[dcName] = map[string]int32{}
+		}
+		podsPerZone[dcName][zone]++
+	}
+
+	result := map[string][]appsv1alpha1.WorkloadZone{}
+	for dcName, zones := range podsPerZone {
+		for zone, count := range zones {
+			result[dcName] = append(result[dcName], appsv1alpha1.WorkloadZone{Name: zone, Pods: count})
+		}
+		sort.Slice(result[dcName], func(i, j int) bool { return result[dcName][i].Name < result[dcName][j].Name })
+	}
+
+	return result
+}
+
+// addWorkloadZones merges the zone distribution into the workloads status.
+// Returned list is sorted by name.
+func addWorkloadZones(workloads []appsv1alpha1.WorkloadStatus, zones map[string][]appsv1alpha1.WorkloadZone) []appsv1alpha1.WorkloadStatus {
+	found := map[string]bool{}
+	for idx := range workloads {
+		workloads[idx].Zones = zones[workloads[idx].Name]
+		found[workloads[idx].Name] = true
+	}
+
+	for dcName, workloadZones := range zones {
+		if !found[dcName] {
+			workloads = append(workloads, appsv1alpha1.WorkloadStatus{Name: dcName, Zones: workloadZones})
+		}
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].Name < workloads[j].Name })
+
+	return workloads
+}
+
+// singleZoneWorkloads returns the critical workloads having all their replicas in a single zone.
+// Nothing is reported on clusters with a single zone.
+func singleZoneWorkloads(workloads []appsv1alpha1.WorkloadStatus, clusterZones int) []string {
+	if clusterZones < 2 {
+		return nil
+	}
+
+	critical := map[string]bool{}
+	for _, name := range singleZoneCriticalWorkloads {
+		critical[name] = true
+	}
+
+	var names []string
+	for _, workload := range workloads {
+		if critical[workload.Name] && len(workload.Zones) == 1 {
+			names = append(names, workload.Name)
+		}
+	}
+
+	return names
+}
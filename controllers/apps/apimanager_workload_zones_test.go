@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func zoneTestPod(dcName, nodeName string) v1.Pod {
+	pod := workloadTestPod(dcName)
+	pod.Spec.NodeName = nodeName
+	return pod
+}
+
+func zoneTestDC(name string, replicas int32) appsv1.DeploymentConfig {
+	return appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       appsv1.DeploymentConfigSpec{Replicas: replicas},
+	}
+}
+
+func TestWorkloadZones(t *testing.T) {
+	nodeZones := map[string]string{"node-a": "zone-a", "node-b": "zone-b", "node-c": ""}
+
+	terminating := zoneTestPod("backend-listener", "node-a")
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	pods := []v1.Pod{
+		zoneTestPod("backend-listener", "node-b"),
+		zoneTestPod("backend-listener", "node-a"),
+		zoneTestPod("backend-listener", "node-b"),
+		terminating,
+		zoneTestPod("system-app", "node-a"),
+		zoneTestPod("system-app", "node-a"),
+		zoneTestPod("system-app", "node-c"),
+		zoneTestPod("system-app", ""),
+		zoneTestPod("zync", "node-a"),
+	}
+	deployments := []appsv1.DeploymentConfig{
+		zoneTestDC("backend-listener", 3),
+		zoneTestDC("system-app", 4),
+		zoneTestDC("zync", 1),
+	}
+
+	zones := workloadZones(pods, deployments, nodeZones)
+
+	expected := map[string][]appsv1alpha1.WorkloadZone{
+		"backend-listener": {{Name: "zone-a", Pods: 1}, {Name: "zone-b", Pods: 2}},
+		"system-app":       {{Name: "zone-a", Pods: 2}},
+	}
+	if diff := cmp.Diff(expected, zones); diff != "" {
+		t.Errorf("unexpected zones (-want +got):\n%s", diff)
+	}
+
+	workloads := addWorkloadZones([]appsv1alpha1.WorkloadStatus{
+		{Name: "system-sidekiq", LastFailure: &appsv1alpha1.WorkloadFailure{Reason: "OOMKilled"}},
+		{Name: "system-app", LastFailure: &appsv1alpha1.WorkloadFailure{Reason: "CrashLoopBackOff"}},
+	}, zones)
+
+	expectedWorkloads := []appsv1alpha1.WorkloadStatus{
+		{Name: "backend-listener", Zones: expected["backend-listener"]},
+		{Name: "system-app", LastFailure: &appsv1alpha1.WorkloadFailure{Reason: "CrashLoopBackOff"}, Zones: expected["system-app"]},
+		{Name: "system-sidekiq", LastFailure: &appsv1alpha1.WorkloadFailure{Reason: "OOMKilled"}},
+	}
+	if diff := cmp.Diff(expectedWorkloads, workloads); diff != "" {
+		t.Errorf("unexpected workloads (-want +got):\n%s", diff)
+	}
+}
+
+func TestSingleZoneWorkloads(t *testing.T) {
+	workloads := []appsv1alpha1.WorkloadStatus{
+		{Name: "apicast-production", Zones: []appsv1alpha1.WorkloadZone{{Name: "zone-a", Pods: 1}, {Name: "zone-b", Pods: 1}}},
+		{Name: "backend-listener", Zones: []appsv1alpha1.WorkloadZone{{Name: "zone-a", Pods: 2}}},
+		{Name: "backend-worker", Zones: []appsv1alpha1.WorkloadZone{{Name: "zone-a", Pods: 2}}},
+		{Name: "system-app", LastFailure: &appsv1alpha1.WorkloadFailure{Reason: "OOMKilled"}},
+	}
+
+	if got := singleZoneWorkloads(workloads, 1); len(got) != 0 {
+		t.Errorf("single zone cluster: unexpected workloads reported: %v", got)
+	}
+
+	if diff := cmp.Diff([]string{"backend-listener"}, singleZoneWorkloads(workloads, 3)); diff != "" {
+		t.Errorf("unexpected single zone workloads (-want +got):\n%s", diff)
+	}
+}
+
+func TestNodeZonesCache(t *testing.T) {
+	node := func(name string, labels map[string]string) *v1.Node {
+		return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	cl := fake.NewFakeClientWithScheme(scheme.Scheme,
+		node("node-a", map[string]string{v1.LabelZoneFailureDomainStable: "zone-a", v1.LabelZoneFailureDomain: "legacy"}),
+		node("node-b", map[string]string{v1.LabelZoneFailureDomain: "zone-b"}),
+	)
+
+	now := time.Now()
+	cache := &nodeZonesCache{}
+	zones, err := cache.get(context.TODO(), cl, []string{"node-a"}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"node-a": "zone-a", "node-b": "zone-b"}, zones); diff != "" {
+		t.Errorf("unexpected node zones (-want +got):\n%s", diff)
+	}
+
+	err = cl.Create(context.TODO(), node("node-c", map[string]string{v1.LabelZoneFailureDomainStable: "zone-c"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// cached while not expired and all the nodes are known
+	zones, err = cache.get(context.TODO(), cl, []string{"node-a", "node-b"}, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := zones["node-c"]; ok {
+		t.Error("expected node zones to be cached")
+	}
+
+	// unknown node refreshes the cache
+	zones, err = cache.get(context.TODO(), cl, []string{"node-c"}, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zones["node-c"] != "zone-c" || distinctZones(zones) != 3 {
+		t.Errorf("unexpected node zones: %v", zones)
+	}
+}
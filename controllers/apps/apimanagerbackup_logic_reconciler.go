@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -17,6 +18,7 @@ import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/backup"
 	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 )
 
@@ -174,6 +176,11 @@ func (r *APIManagerBackupLogicReconciler) reconcileBackupInPVCDestination() (rec
 		return res, err
 	}
 
+	res, err = r.reconcileBackupChecksumsToPVCJob()
+	if res.Requeue || err != nil {
+		return res, err
+	}
+
 	return res, err
 }
 
@@ -259,6 +266,37 @@ func (r *APIManagerBackupLogicReconciler) reconcileBackupSystemFileStoragePVCToP
 	return r.reconcileJob(desired)
 }
 
+// reconcileBackupChecksumsToPVCJob stores the artifact checksums in the backup data
+// and sets the checksum of each artifact reported by the job in the status
+func (r *APIManagerBackupLogicReconciler) reconcileBackupChecksumsToPVCJob() (reconcile.Result, error) {
+	desired := r.apiManagerBackup.BackupChecksumsToPVCJob()
+	if desired == nil {
+		return reconcile.Result{}, nil
+	}
+
+	res, err := r.reconcileJob(desired)
+	if res.Requeue || err != nil {
+		return res, err
+	}
+
+	if len(r.cr.Status.Checksums) > 0 {
+		return reconcile.Result{}, nil
+	}
+
+	message, err := helper.JobTerminationMessage(r.Client(), desired)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	checksums, err := backup.ParseArtifactChecksums(message)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("job '%s' checksums: %w", desired.Name, err)
+	}
+
+	r.cr.Status.Checksums = checksums
+	err = r.UpdateResourceStatus(r.cr)
+	return reconcile.Result{Requeue: true}, err
+}
+
 func (r *APIManagerBackupLogicReconciler) reconcileBackupCompletion() (reconcile.Result, error) {
 	if !r.cr.BackupCompleted() {
 		// TODO make this more robust only setting it in case all substeps have been completed?
@@ -331,6 +369,7 @@ func (r *APIManagerBackupLogicReconciler) reconcileJobsCleanup() (reconcile.Resu
 		r.apiManagerBackup.BackupSecretsAndConfigMapsToPVCJob(),
 		r.apiManagerBackup.BackupAPIManagerCustomResourceToPVCJob(),
 		r.apiManagerBackup.BackupSystemFileStoragePVCToPVCJob(),
+		r.apiManagerBackup.BackupChecksumsToPVCJob(),
 	}
 
 	existingJobFound := false
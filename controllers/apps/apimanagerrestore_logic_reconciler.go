@@ -75,7 +75,11 @@ func (r *APIManagerRestoreLogicReconciler) reconcileMainSteps() (reconcile.Resul
 		return result, err
 	}
 
-	result, err = r.reconcileRestoreFromPVCSource()
+	if r.cr.VerifyOnly() {
+		result, err = r.reconcileVerifyBackupFromPVCSource()
+	} else {
+		result, err = r.reconcileRestoreFromPVCSource()
+	}
 	if result.Requeue || err != nil {
 		return result, err
 	}
@@ -173,6 +177,71 @@ func (r *APIManagerRestoreLogicReconciler) reconcileRestoreFromPVCSource() (reco
 	return res, err
 }
 
+// reconcileVerifyBackupFromPVCSource performs the restore rehearsal. Only the
+// verification job is run, the installation is not modified
+func (r *APIManagerRestoreLogicReconciler) reconcileVerifyBackupFromPVCSource() (reconcile.Result, error) {
+	res, err := r.reconcileRestoreJobsPermissions()
+	if res.Requeue || err != nil {
+		return res, err
+	}
+
+	return r.reconcileVerifyBackupFromPVCJob()
+}
+
+func (r *APIManagerRestoreLogicReconciler) reconcileVerifyBackupFromPVCJob() (reconcile.Result, error) {
+	if r.cr.Status.Verification != nil {
+		return reconcile.Result{}, nil
+	}
+
+	desired := r.apiManagerRestore.VerifyBackupFromPVCJob()
+	if desired == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.setOwnerReference(desired); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	existing := &batchv1.Job{}
+	err := r.GetResource(types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+
+	if errors.IsNotFound(err) {
+		err := r.CreateResource(desired)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+	}
+
+	// Unlike the restore jobs, the verification job is not retried,
+	// so a failed pod is the verification result
+	if existing.Status.Succeeded == 0 && existing.Status.Failed == 0 {
+		r.Logger().Info("Job has still not finished", "Job Name", desired.Name, "Actively running Pods", existing.Status.Active)
+		return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+	}
+
+	message, err := helper.JobTerminationMessage(r.Client(), existing)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	verified := existing.Status.Succeeded > 0
+	if !verified && message == "" {
+		message = fmt.Sprintf("job '%s' failed", desired.Name)
+	}
+	r.Logger().Info("Backup verification finished", "Verified", verified, "Message", message)
+
+	r.cr.Status.Verification = &appsv1alpha1.APIManagerRestoreVerificationStatus{
+		Verified: verified,
+		Message:  message,
+	}
+	err = r.UpdateResourceStatus(r.cr)
+	return reconcile.Result{Requeue: true}, err
+}
+
 func (r *APIManagerRestoreLogicReconciler) setOwnerReference(obj common.KubernetesObject) error {
 	err := controllerutil.SetControllerReference(r.cr, obj, r.BaseReconciler.Scheme())
 	if err != nil {
@@ -514,6 +583,7 @@ func (r *APIManagerRestoreLogicReconciler) reconcileJobsCleanup() (reconcile.Res
 		r.apiManagerRestore.RestoreSystemFileStoragePVCFromPVCJob(),
 		r.apiManagerRestore.CreateAPIManagerSharedSecretJob(),
 		r.apiManagerRestore.ZyncResyncDomainsJob(),
+		r.apiManagerRestore.VerifyBackupFromPVCJob(),
 	}
 
 	existingJobFound := false
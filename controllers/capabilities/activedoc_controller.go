@@ -177,7 +177,7 @@ func (r *ActiveDocReconciler) checkExternalRefs(resource *capabilitiesv1beta1.Ac
 
 	// Check product referenced by the ActiveDoc Spec is valid
 	if resource.Spec.ProductSystemName != nil {
-		productList, err := controllerhelper.ProductList(resource.Namespace, r.Client(), providerAccountHost, logger)
+		productList, err := controllerhelper.ProductList(resource.Namespace, r.Client(), r.APIClientReader(), providerAccountHost, logger)
 		if err != nil {
 			return fmt.Errorf("ActiveDocReconciler.checkExternalRefs: %w", err)
 		}
@@ -61,8 +61,10 @@ func (s *ActiveDocStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.resource.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
-	s.resource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.resource)
+	_, updateErr := s.StatusWriter().Write(s.resource, func(common.KubernetesObject) error {
+		s.resource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -163,7 +165,7 @@ func (s *ActiveDocStatusReconciler) getReferencedProduct() (*corev1.LocalObjectR
 		return nil, nil
 	}
 
-	productList, err := controllerhelper.ProductList(s.resource.Namespace, s.Client(), s.providerAccountHost, s.logger)
+	productList, err := controllerhelper.ProductList(s.resource.Namespace, s.Client(), s.APIClientReader(), s.providerAccountHost, s.logger)
 	if err != nil {
 		return nil, fmt.Errorf("ActiveDocStatusReconciler.getReferencedProduct: %w", err)
 	}
@@ -182,7 +182,7 @@ func (s *ActiveDocThreescaleReconciler) getDesiredProductIDFromCR() (*int64, err
 	// Getting product ID from Product CR status field. It should be fine as product CR is required to be in "Ready" status.
 	// Another alternative would be fetch the list of 3scale products,
 	// filter by systemname and get the ID
-	productList, err := controllerhelper.ProductList(s.resource.Namespace, s.Client(), s.providerAccountHost, s.logger)
+	productList, err := controllerhelper.ProductList(s.resource.Namespace, s.Client(), s.APIClientReader(), s.providerAccountHost, s.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +34,7 @@ type applicationPlanReconciler struct {
 	backendRemoteIndex  *controllerhelper.BackendAPIRemoteIndex
 	planEntity          *controllerhelper.ApplicationPlanEntity
 	threescaleAPIClient *threescaleapi.ThreeScaleClient
+	providerAccountHost string
 	logger              logr.Logger
 }
 
@@ -44,6 +45,7 @@ func newApplicationPlanReconciler(b *reconcilers.BaseReconciler,
 	productEntity *controllerhelper.ProductEntity,
 	backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex,
 	planEntity *controllerhelper.ApplicationPlanEntity,
+	providerAccountHost string,
 	logger logr.Logger,
 ) *applicationPlanReconciler {
 
@@ -55,6 +57,7 @@ func newApplicationPlanReconciler(b *reconcilers.BaseReconciler,
 		productEntity:       productEntity,
 		backendRemoteIndex:  backendRemoteIndex,
 		planEntity:          planEntity,
+		providerAccountHost: providerAccountHost,
 		logger:              logger.WithValues("Plan", systemName),
 	}
 }
@@ -149,11 +152,16 @@ func (a *applicationPlanReconciler) syncLimits(_ interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error sync plan [%s] limits: %w", a.systemName, err)
 	}
+	deleteTasks := make([]func() error, 0, len(undesiredLimits))
 	for idx := range undesiredLimits {
-		err := a.planEntity.DeleteLimit(undesiredLimits[idx].Element.MetricID, undesiredLimits[idx].Element.ID)
-		if err != nil {
-			return err
-		}
+		limit := undesiredLimits[idx].Element
+		deleteTasks = append(deleteTasks, func() error {
+			return a.planEntity.DeleteLimit(limit.MetricID, limit.ID)
+		})
+	}
+	err = controllerhelper.RunProviderAccountTasks(a.providerAccountHost, deleteTasks)
+	if err != nil {
+		return err
 	}
 
 	// item is not updated, either created or deleted.
@@ -163,6 +171,7 @@ func (a *applicationPlanReconciler) syncLimits(_ interface{}) error {
 		return fmt.Errorf("Error sync plan [%s] limits: %w", a.systemName, err)
 	}
 
+	createTasks := make([]func() error, 0, len(desiredLimits))
 	for idx := range desiredLimits {
 		params := threescaleapi.Params{
 			"period": desiredLimits[idx].Period,
@@ -174,13 +183,12 @@ func (a *applicationPlanReconciler) syncLimits(_ interface{}) error {
 			return err
 		}
 
-		err = a.planEntity.CreateLimit(metricID, params)
-		if err != nil {
-			return err
-		}
+		createTasks = append(createTasks, func() error {
+			return a.planEntity.CreateLimit(metricID, params)
+		})
 	}
 
-	return nil
+	return controllerhelper.RunProviderAccountTasks(a.providerAccountHost, createTasks)
 }
 
 func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
@@ -199,11 +207,16 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error sync plan [%s] pricing rules: %w", a.systemName, err)
 	}
+	deleteTasks := make([]func() error, 0, len(undesiredRules))
 	for idx := range undesiredRules {
-		err := a.planEntity.DeletePricingRule(undesiredRules[idx].Element.MetricID, undesiredRules[idx].Element.ID)
-		if err != nil {
-			return err
-		}
+		rule := undesiredRules[idx].Element
+		deleteTasks = append(deleteTasks, func() error {
+			return a.planEntity.DeletePricingRule(rule.MetricID, rule.ID)
+		})
+	}
+	err = controllerhelper.RunProviderAccountTasks(a.providerAccountHost, deleteTasks)
+	if err != nil {
+		return err
 	}
 
 	// item is not updated, either created or deleted.
@@ -213,6 +226,7 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 		return fmt.Errorf("Error sync plan [%s] pricing rules: %w", a.systemName, err)
 	}
 
+	createTasks := make([]func() error, 0, len(desiredRules))
 	for idx := range desiredRules {
 		params := threescaleapi.Params{
 			"min":           strconv.Itoa(desiredRules[idx].From),
@@ -225,13 +239,12 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 			return err
 		}
 
-		err = a.planEntity.CreatePricingRule(metricID, params)
-		if err != nil {
-			return err
-		}
+		createTasks = append(createTasks, func() error {
+			return a.planEntity.CreatePricingRule(metricID, params)
+		})
 	}
 
-	return nil
+	return controllerhelper.RunProviderAccountTasks(a.providerAccountHost, createTasks)
 }
 
 func (a *applicationPlanReconciler) computeUnDesiredLimits(
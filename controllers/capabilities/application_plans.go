@@ -53,7 +53,7 @@ func (t *ProductThreescaleReconciler) syncApplicationPlans(_ interface{}) error
 		planEntity := controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), existingMap[systemName], t.threescaleAPIClient, t.logger)
 		// desired spec
 		planSpec := t.resource.Spec.ApplicationPlans[systemName]
-		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.logger)
+		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.providerAccountHost, t.logger)
 		err := reconciler.Reconcile()
 		if err != nil {
 			return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
@@ -81,7 +81,7 @@ func (t *ProductThreescaleReconciler) syncApplicationPlans(_ interface{}) error
 		// interface to remote entity
 		planEntity := controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), obj.Element, t.threescaleAPIClient, t.logger)
 
-		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.logger)
+		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.providerAccountHost, t.logger)
 		err = reconciler.Reconcile()
 		if err != nil {
 			return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
@@ -90,3 +90,26 @@ func (t *ProductThreescaleReconciler) syncApplicationPlans(_ interface{}) error
 
 	return nil
 }
+
+func (t *ProductThreescaleReconciler) syncDefaultApplicationPlan(_ interface{}) error {
+	if t.resource.Spec.DefaultPlan == nil {
+		return nil
+	}
+
+	existingList, err := t.productEntity.ApplicationPlans()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] default plan: %w", t.resource.Spec.SystemName, err)
+	}
+
+	for _, existing := range existingList.Plans {
+		if existing.Element.SystemName == *t.resource.Spec.DefaultPlan {
+			err := t.productEntity.SetDefaultApplicationPlan(existing.Element.ID)
+			if err != nil {
+				return fmt.Errorf("Error sync product [%s] default plan [%s]: %w", t.resource.Spec.SystemName, *t.resource.Spec.DefaultPlan, err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Error sync product [%s]: default plan [%s] not found", t.resource.Spec.SystemName, *t.resource.Spec.DefaultPlan)
+}
@@ -55,6 +55,8 @@ var _ reconcile.Reconciler = &BackendReconciler{}
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=backends,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=backends/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=backends/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=capabilities.3scale.net,resources=provideraccountgrants,verbs=get;list
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get
 
 func (r *BackendReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	_ = context.Background()
@@ -102,6 +104,8 @@ func (r *BackendReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			return ctrl.Result{}, err
 		}
 
+		deleteSyncDuration(backend, capabilitiesv1beta1.BackendKind)
+
 		controllerutil.RemoveFinalizer(backend, backendFinalizer)
 		err = r.UpdateResource(backend)
 		if err != nil {
@@ -126,30 +130,33 @@ func (r *BackendReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), backend.GetNamespace(), backend.Spec.ProviderAccountRef, r.Logger())
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	// Retrieve ownersReference of tenant CR that owns the Backend CR
-	tenantCR, err := controllerhelper.RetrieveTenantCR(providerAccount, r.Client(), r.Logger(), backend.Namespace)
-	if err != nil {
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), backend.GetNamespace(), backend.Spec.ProviderAccountRef, r.Logger())
+	// Access denied is reported in the status
+	if err != nil && !helper.IsAccessDeniedError(err) {
 		return ctrl.Result{}, err
 	}
 
-	// If tenant CR is found, set it's ownersReference as ownerReference in the BackendCR CR
-	if tenantCR != nil {
-		updated, err := r.EnsureOwnerReference(tenantCR, backend)
+	if providerAccount != nil {
+		// Retrieve ownersReference of tenant CR that owns the Backend CR
+		tenantCR, err := controllerhelper.RetrieveTenantCR(providerAccount, r.Client(), r.Logger(), backend.Namespace)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
-		if updated {
-			err := r.Client().Update(r.Context(), backend)
+		// If tenant CR is found, set it's ownersReference as ownerReference in the BackendCR CR
+		if tenantCR != nil {
+			updated, err := r.EnsureOwnerReference(tenantCR, backend)
 			if err != nil {
 				return ctrl.Result{}, err
 			}
-			return ctrl.Result{Requeue: true}, nil
+
+			if updated {
+				err := r.Client().Update(r.Context(), backend)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{Requeue: true}, nil
+			}
 		}
 	}
 
@@ -178,6 +185,20 @@ func (r *BackendReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	if reconcileErr != nil {
+		if helper.IsQuotaExceededError(reconcileErr) {
+			// On quota exceeded error, retry later as the quota might be raised
+			reqLogger.Info("ERROR", "quota exceeded error", reconcileErr)
+			r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "QuotaExceeded", "%v", reconcileErr)
+			return ctrl.Result{RequeueAfter: providerAccountQuotaRecheckPeriod}, nil
+		}
+
+		if helper.IsAccessDeniedError(reconcileErr) {
+			// On access denied error, retry later as the access might be granted
+			reqLogger.Info("ERROR", "access denied error", reconcileErr)
+			r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "AccessDenied", "%v", reconcileErr)
+			return ctrl.Result{RequeueAfter: providerAccountGrantRecheckPeriod}, nil
+		}
+
 		if helper.IsInvalidSpecError(reconcileErr) {
 			// On Validation error, no need to retry as spec is not valid and needs to be changed
 			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
@@ -191,7 +212,7 @@ func (r *BackendReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	reqLogger.Info("END", "error", reconcileErr)
-	return ctrl.Result{}, nil
+	return providerAccountGrantResult(backend.Namespace, backend.Spec.ProviderAccountRef), nil
 }
 
 func (r *BackendReconciler) reconcile(backendResource *capabilitiesv1beta1.Backend) (*BackendStatusReconciler, error) {
@@ -203,12 +224,18 @@ func (r *BackendReconciler) reconcile(backendResource *capabilitiesv1beta1.Backe
 		return statusReconciler, err
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), backendResource.Namespace, backendResource.Spec.ProviderAccountRef, logger)
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), backendResource.Namespace, backendResource.Spec.ProviderAccountRef, logger)
 	if err != nil {
 		statusReconciler := NewBackendStatusReconciler(r.BaseReconciler, backendResource, nil, "", err)
 		return statusReconciler, err
 	}
 
+	err = checkBackendQuota(r.Client(), backendResource, providerAccount)
+	if err != nil {
+		statusReconciler := NewBackendStatusReconciler(r.BaseReconciler, backendResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
 	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
 	if err != nil {
 		statusReconciler := NewBackendStatusReconciler(r.BaseReconciler, backendResource, nil, providerAccount.AdminURLStr, err)
@@ -222,8 +249,10 @@ func (r *BackendReconciler) reconcile(backendResource *capabilitiesv1beta1.Backe
 	}
 
 	reconciler := NewThreescaleReconciler(r.BaseReconciler, backendResource, threescaleAPIClient, backendRemoteIndex, providerAccount)
+	start := time.Now()
 	backendAPIEntity, err := reconciler.Reconcile()
 	statusReconciler := NewBackendStatusReconciler(r.BaseReconciler, backendResource, backendAPIEntity, providerAccount.AdminURLStr, err)
+	statusReconciler.syncDuration = recordSyncDuration(backendResource, capabilitiesv1beta1.BackendKind, start)
 	return statusReconciler, err
 }
 
@@ -291,12 +320,17 @@ func (r *BackendReconciler) removeBackendFrom3scale(backend *capabilitiesv1beta1
 		return nil
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), backend.Namespace, backend.Spec.ProviderAccountRef, logger)
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), backend.Namespace, backend.Spec.ProviderAccountRef, logger)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info("backend not deleted from 3scale, provider account not found")
 			return nil
 		}
+		if helper.IsAccessDeniedError(err) {
+			logger.Info("backend not deleted from 3scale, provider account access denied")
+			r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "AccessDenied", "backend not deleted from 3scale: %v", err)
+			return nil
+		}
 		return err
 	}
 
@@ -318,9 +352,9 @@ func (r *BackendReconciler) fetchTenantProductCRs(productsCRsList *capabilitiesv
 	logger := r.Logger().WithValues("backend", client.ObjectKey{Name: backendResource.Name, Namespace: backendResource.Namespace})
 
 	var productsList []capabilitiesv1beta1.Product
-	backendProviderAccount, err := controllerhelper.LookupProviderAccount(r.Client(), backendResource.Namespace, backendResource.Spec.ProviderAccountRef, logger)
+	backendProviderAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), backendResource.Namespace, backendResource.Spec.ProviderAccountRef, logger)
 
-	if apierrors.IsNotFound(err) {
+	if apierrors.IsNotFound(err) || helper.IsAccessDeniedError(err) {
 		logger.Info("could not look up for products of the same tenant. Tenant not found")
 		return nil, nil
 	}
@@ -330,7 +364,7 @@ func (r *BackendReconciler) fetchTenantProductCRs(productsCRsList *capabilitiesv
 	}
 
 	for _, productCR := range productsCRsList.Items {
-		productProviderAccount, err := controllerhelper.LookupProviderAccount(r.Client(), productCR.Namespace, productCR.Spec.ProviderAccountRef, logger)
+		productProviderAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), productCR.Namespace, productCR.Spec.ProviderAccountRef, logger)
 		if err != nil {
 			// skip product CR if productProviderAccount is not found
 			continue
@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type BackendStatusReconciler struct {
@@ -21,7 +22,9 @@ type BackendStatusReconciler struct {
 	backendAPIEntity    *controllerhelper.BackendAPIEntity
 	providerAccountHost string
 	syncError           error
-	logger              logr.Logger
+	// syncDuration is only set when the spec has been synchronized
+	syncDuration *metav1.Duration
+	logger       logr.Logger
 }
 
 func NewBackendStatusReconciler(b *reconcilers.BaseReconciler, backendResource *capabilitiesv1beta1.Backend, backendAPIEntity *controllerhelper.BackendAPIEntity, providerAccountHost string, syncError error) *BackendStatusReconciler {
@@ -57,8 +60,10 @@ func (s *BackendStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.backendResource.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
-	s.backendResource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.backendResource)
+	_, updateErr := s.StatusWriter().Write(s.backendResource, func(common.KubernetesObject) error {
+		s.backendResource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -82,10 +87,17 @@ func (s *BackendStatusReconciler) calculateStatus() *capabilitiesv1beta1.Backend
 
 	newStatus.ObservedGeneration = s.backendResource.Status.ObservedGeneration
 
+	newStatus.LastSyncDuration = s.backendResource.Status.LastSyncDuration
+	if s.syncDuration != nil {
+		newStatus.LastSyncDuration = s.syncDuration
+	}
+
 	newStatus.Conditions = s.backendResource.Status.Conditions.Copy()
 	newStatus.Conditions.SetCondition(s.syncCondition())
 	newStatus.Conditions.SetCondition(s.invalidCondition())
 	newStatus.Conditions.SetCondition(s.failedCondition())
+	newStatus.Conditions.SetCondition(s.quotaExceededCondition())
+	newStatus.Conditions.SetCondition(s.accessDeniedCondition())
 
 	return newStatus
 }
@@ -131,3 +143,31 @@ func (s *BackendStatusReconciler) failedCondition() common.Condition {
 
 	return condition
 }
+
+func (s *BackendStatusReconciler) quotaExceededCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.BackendQuotaExceededConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsQuotaExceededError(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
+
+func (s *BackendStatusReconciler) accessDeniedCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.BackendAccessDeniedConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsAccessDeniedError(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
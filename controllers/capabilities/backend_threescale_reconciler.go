@@ -12,6 +12,7 @@ import (
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 )
 
 type BackendThreescaleReconciler struct {
@@ -185,14 +186,7 @@ func (t *BackendThreescaleReconciler) syncMethods(_ interface{}) error {
 
 func (t *BackendThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabilitiesv1beta1.MethodSpec) error {
 	for systemName, method := range desiredNewMap {
-		params := threescaleapi.Params{
-			"friendly_name": method.Name,
-			"system_name":   systemName,
-		}
-		if len(method.Description) > 0 {
-			params["description"] = method.Description
-		}
-		err := t.backendAPIEntity.CreateMethod(params)
+		err := t.backendAPIEntity.CreateMethod(methodCreateParams(systemName, method))
 		if err != nil {
 			return err
 		}
@@ -212,7 +206,7 @@ func (t *BackendThreescaleReconciler) deleteNotDesiredMethodsFrom3scale(notDesir
 
 // valid for metrics and methods as long as 3scale ensures system_names are unique among methods and metrics
 func (t *BackendThreescaleReconciler) deleteExternalMetricReferences(notDesiredMetrics []string) error {
-	productList, err := controllerhelper.ProductList(t.backendResource.Namespace, t.Client(), t.providerAccount.AdminURLStr, t.logger)
+	productList, err := controllerhelper.ProductList(t.backendResource.Namespace, t.Client(), t.APIClientReader(), t.providerAccount.AdminURLStr, t.logger)
 	if err != nil {
 		return fmt.Errorf("deleteExternalMetricReferences: %w", err)
 	}
@@ -296,15 +290,7 @@ func (t *BackendThreescaleReconciler) deleteExternalMetricReferencesOnProduct(no
 
 func (t *BackendThreescaleReconciler) reconcileMatchedMethods(matchedMap map[string]methodData) error {
 	for _, data := range matchedMap {
-		params := threescaleapi.Params{}
-		if data.spec.Name != data.item.Name {
-			params["friendly_name"] = data.spec.Name
-		}
-
-		if data.spec.Description != data.item.Description {
-			params["description"] = data.spec.Description
-		}
-
+		params := methodUpdateParams(data.spec, data.item)
 		if len(params) > 0 {
 			err := t.backendAPIEntity.UpdateMethod(data.item.ID, params)
 			if err != nil {
@@ -395,15 +381,7 @@ func (t *BackendThreescaleReconciler) syncMetrics(_ interface{}) error {
 
 func (t *BackendThreescaleReconciler) createNewMetrics(desiredNewMap map[string]capabilitiesv1beta1.MetricSpec) error {
 	for systemName, metric := range desiredNewMap {
-		params := threescaleapi.Params{
-			"friendly_name": metric.Name,
-			"unit":          metric.Unit,
-			"system_name":   systemName,
-		}
-		if len(metric.Description) > 0 {
-			params["description"] = metric.Description
-		}
-		err := t.backendAPIEntity.CreateMetric(params)
+		err := t.backendAPIEntity.CreateMetric(metricCreateParams(systemName, metric))
 		if err != nil {
 			return err
 		}
@@ -423,19 +401,7 @@ func (t *BackendThreescaleReconciler) deleteNotDesiredMetricsFrom3scale(notDesir
 
 func (t *BackendThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]metricData) error {
 	for _, data := range matchedMap {
-		params := threescaleapi.Params{}
-		if data.spec.Name != data.item.Name {
-			params["friendly_name"] = data.spec.Name
-		}
-
-		if data.spec.Unit != data.item.Unit {
-			params["unit"] = data.spec.Unit
-		}
-
-		if data.spec.Description != data.item.Description {
-			params["description"] = data.spec.Description
-		}
-
+		params := metricUpdateParams(data.spec, data.item)
 		if len(params) > 0 {
 			err := t.backendAPIEntity.UpdateMetric(data.item.ID, params)
 			if err != nil {
@@ -450,7 +416,7 @@ func (t *BackendThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[str
 func (t *BackendThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	desiredKeys := make([]string, 0, len(t.backendResource.Spec.MappingRules))
 	desiredMap := map[string]capabilitiesv1beta1.MappingRuleSpec{}
-	for _, spec := range t.backendResource.Spec.MappingRules {
+	for _, spec := range capabilitiesv1beta1.SortMappingRules(t.backendResource.Spec.MappingRules) {
 		key := fmt.Sprintf("%s:%s", spec.HTTPMethod, spec.Pattern)
 		desiredKeys = append(desiredKeys, key)
 		desiredMap[key] = spec
@@ -494,7 +460,8 @@ func (t *BackendThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	// In order of definition in the custom resource. Create or update
 	// the MappingRule being processed depending on whether it already exists
 	// in the 3scale API. Specified 'position' attribute of the MappingRule
-	// always corresponds to the position in the CR's MappingRules array.
+	// always corresponds to the position in the CR's MappingRules array,
+	// once sorted by the MappingRule 'position' field when set.
 	// Even though when creating/updating a MappingRule the existing MappingRule
 	// positions in 3scale change, we always compare the desired keys with the
 	// existing MappingRule positions at this point. We do not refetch the list.
@@ -509,7 +476,20 @@ func (t *BackendThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	// unmodified MappingRules happens temporarily during the reconciliation that
 	// is not an issue due to changes are not effective until the user promotes
 	// the configuration
+	if mappingRulesOrderDrifted(desiredKeys, existingMap) {
+		t.logger.Info("syncMappingRules: mapping rules order differs from spec, re-positioning")
+		t.EventRecorder().Eventf(t.backendResource, corev1.EventTypeWarning, "MappingRulesOrderDrift", "backend [%s] mapping rules order differs from spec, re-positioning", t.backendResource.Spec.SystemName)
+	}
+
+	// Metrics and methods are read once, before the concurrent calls
+	_, err = t.backendAPIEntity.MetricsAndMethods()
+	if err != nil {
+		return fmt.Errorf("Error sync backend [%s] mappingrules: %w", t.backendResource.Spec.SystemName, err)
+	}
+
 	t.logger.V(1).Info("syncMappingRules", "desiredKeys", desiredKeys)
+	attributeTasks := []func() error{}
+	positionTasks := []func() error{}
 	for desiredIdxZeroBased, desiredKey := range desiredKeys {
 		desiredMappingRule := desiredMap[desiredKey]
 		// We define the position sent to System starting from one (one-based array)
@@ -521,31 +501,41 @@ func (t *BackendThreescaleReconciler) syncMappingRules(_ interface{}) error {
 		if existingMappingRule, ok := existingMap[desiredKey]; ok {
 			// Reconcile MappingRule
 			t.logger.V(1).Info("syncMappingRules", "desiredMappingRuleToReconcile", desiredKey, "position", desiredIdx)
-			err := t.reconcileMappingRuleWithPosition(desiredMappingRule, desiredIdx, existingMappingRule)
-			if err != nil {
-				return fmt.Errorf("Error sync backend [%s] mappingrules: %w", t.backendResource.Spec.SystemName, err)
+			task := func() error {
+				return t.reconcileMappingRuleWithPosition(desiredMappingRule, desiredIdx, existingMappingRule)
+			}
+			// Updates keeping the position do not shift other rules
+			if existingMappingRule.Position == desiredIdx {
+				attributeTasks = append(attributeTasks, task)
+			} else {
+				positionTasks = append(positionTasks, task)
 			}
 		} else {
 			// Create MappingRule
 			t.logger.V(1).Info("syncMappingRules", "desiredMappingRuleToCreate", desiredKey, "position", desiredIdx)
-			err := t.createNewMappingRuleWithPosition(desiredMappingRule, desiredIdx)
-			if err != nil {
-				return fmt.Errorf("Error sync backend [%s] mappingrules: %w", t.backendResource.Spec.SystemName, err)
-			}
+			positionTasks = append(positionTasks, func() error {
+				return t.createNewMappingRuleWithPosition(desiredMappingRule, desiredIdx)
+			})
 		}
 	}
 
+	err = syncMappingRuleTasks(t.providerAccount.AdminURLStr, attributeTasks, positionTasks)
+	if err != nil {
+		return fmt.Errorf("Error sync backend [%s] mappingrules: %w", t.backendResource.Spec.SystemName, err)
+	}
+
 	return nil
 }
 
 func (t *BackendThreescaleReconciler) processNotDesiredMappingRules(notDesiredList []threescaleapi.MappingRuleItem) error {
-	for _, mappingRule := range notDesiredList {
-		err := t.backendAPIEntity.DeleteMappingRule(mappingRule.ID)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	tasks := make([]func() error, 0, len(notDesiredList))
+	for idx := range notDesiredList {
+		mappingRuleID := notDesiredList[idx].ID
+		tasks = append(tasks, func() error {
+			return t.backendAPIEntity.DeleteMappingRule(mappingRuleID)
+		})
+	}
+	return controllerhelper.RunProviderAccountTasks(t.providerAccount.AdminURLStr, tasks)
 }
 
 func (t *BackendThreescaleReconciler) getExistingMappingRules() (map[string]threescaleapi.MappingRuleItem, error) {
@@ -1,8 +1,12 @@
 package controllers
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
 	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
@@ -11,6 +15,12 @@ import (
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 )
 
+const (
+	// backendUsagesRoutingPolicyName is the APIcast policy implementing the backend usages path rewriting
+	backendUsagesRoutingPolicyName    = "routing"
+	backendUsagesRoutingPolicyVersion = "builtin"
+)
+
 type backendUsageData struct {
 	item threescaleapi.BackendAPIUsageItem
 	spec capabilitiesv1beta1.BackendUsageSpec
@@ -146,3 +156,96 @@ func (t *ProductThreescaleReconciler) createNewBackendUsage(matchedList []newBac
 
 	return nil
 }
+
+// backendUsageRoutingRule returns the path condition and the replaced path of the routing rule
+// implementing the path rewriting of the usage. The path is first stripped like
+// the 3scale default routing and then the rewrite prefix is prepended.
+// Returns false when the usage uses the default strip behavior
+func backendUsageRoutingRule(usage capabilitiesv1beta1.BackendUsageSpec) (string, string, bool) {
+	prefix := usage.RewritePathPrefix()
+	if prefix == "" {
+		return "", "", false
+	}
+
+	usagePath := strings.TrimSuffix(usage.Path, "/")
+	if usagePath == "" {
+		return "^/", fmt.Sprintf("%s{{original_request.path}}", prefix), true
+	}
+
+	quotedPath := regexp.QuoteMeta(usagePath)
+	condition := fmt.Sprintf("^(%s/.*|%s/?)", quotedPath, quotedPath)
+	replacePath := fmt.Sprintf("%s{{original_request.path | remove_first: '%s'}}", prefix, usagePath)
+	return condition, replacePath, true
+}
+
+// backendUsageMaterialization describes how the path rewriting of the usage is implemented in 3scale
+func backendUsageMaterialization(usage capabilitiesv1beta1.BackendUsageSpec) string {
+	condition, replacePath, ok := backendUsageRoutingRule(usage)
+	if !ok {
+		return "usage path stripped by the 3scale default routing"
+	}
+
+	return fmt.Sprintf("%s policy rule: path matching '%s' forwarded as '%s'", backendUsagesRoutingPolicyName, condition, replacePath)
+}
+
+// backendUsagesRoutingPolicy returns the routing policy implementing the path rewriting of the backend usages.
+// Returns nil when all the usages use the default strip behavior.
+// The policy is owned by the operator: it is part of the desired policy chain and changes made in 3scale are reverted
+func backendUsagesRoutingPolicy(usages map[string]capabilitiesv1beta1.BackendUsageSpec, findBackend func(string) (*controllerhelper.BackendAPIEntity, bool)) (*threescaleapi.PolicyConfig, error) {
+	systemNames := make([]string, 0, len(usages))
+	for systemName := range usages {
+		systemNames = append(systemNames, systemName)
+	}
+	sort.Strings(systemNames)
+
+	rules := []interface{}{}
+	for _, systemName := range systemNames {
+		condition, replacePath, ok := backendUsageRoutingRule(usages[systemName])
+		if !ok {
+			continue
+		}
+
+		backend, ok := findBackend(systemName)
+		if !ok {
+			return nil, fmt.Errorf("Backend SystemName %s not found in 3scale backend index", systemName)
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"url":        backend.PrivateEndpoint(),
+			"owner_id":   backend.ID(),
+			"owner_type": "BackendApi",
+			"condition": map[string]interface{}{
+				"operations": []interface{}{
+					map[string]interface{}{
+						"match": "path",
+						"op":    "matches",
+						"value": condition,
+					},
+				},
+			},
+			"replace_path": replacePath,
+		})
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Marshal and unmarshal to get the same types as the policies read from 3scale
+	rawConfiguration, err := json.Marshal(map[string]interface{}{"rules": rules})
+	if err != nil {
+		return nil, err
+	}
+	var configuration map[string]interface{}
+	err = json.Unmarshal(rawConfiguration, &configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &threescaleapi.PolicyConfig{
+		Name:          backendUsagesRoutingPolicyName,
+		Version:       backendUsagesRoutingPolicyVersion,
+		Enabled:       true,
+		Configuration: configuration,
+	}, nil
+}
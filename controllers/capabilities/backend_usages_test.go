@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestBackendUsagesRoutingPolicy(t *testing.T) {
+	replaceWith := "/v2/"
+	backends := map[string]*controllerhelper.BackendAPIEntity{}
+	for id, systemName := range []string{"backendA", "backendB", "backendC"} {
+		backends[systemName] = controllerhelper.NewBackendAPIEntity(&threescaleapi.BackendApi{
+			Element: threescaleapi.BackendApiItem{
+				ID:              int64(id + 1),
+				SystemName:      systemName,
+				PrivateEndpoint: "https://" + systemName + ".example.com/api",
+			},
+		}, nil, logf.Log)
+	}
+	findBackend := func(systemName string) (*controllerhelper.BackendAPIEntity, bool) {
+		backend, ok := backends[systemName]
+		return backend, ok
+	}
+
+	t.Run("DefaultStrip", func(subT *testing.T) {
+		policy, err := backendUsagesRoutingPolicy(map[string]capabilitiesv1beta1.BackendUsageSpec{
+			"backendA": {Path: "/a"},
+		}, findBackend)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if policy != nil {
+			subT.Errorf("unexpected routing policy: %v", policy)
+		}
+	})
+
+	t.Run("KeepAndReplace", func(subT *testing.T) {
+		policy, err := backendUsagesRoutingPolicy(map[string]capabilitiesv1beta1.BackendUsageSpec{
+			"backendA": {Path: "/a", RewritePath: &capabilitiesv1beta1.BackendUsageRewritePathSpec{Strategy: capabilitiesv1beta1.BackendUsageRewritePathKeep}},
+			"backendB": {Path: "/b", RewritePath: &capabilitiesv1beta1.BackendUsageRewritePathSpec{Strategy: capabilitiesv1beta1.BackendUsageRewritePathReplace, ReplaceWith: &replaceWith}},
+			"backendC": {Path: "/c"},
+		}, findBackend)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if policy == nil || policy.Name != backendUsagesRoutingPolicyName {
+			subT.Fatalf("unexpected routing policy: %v", policy)
+		}
+
+		rules := policy.Configuration["rules"].([]interface{})
+		if len(rules) != 2 {
+			subT.Fatalf("expected 2 rules, got %d", len(rules))
+		}
+
+		expected := []struct {
+			url         string
+			ownerID     float64
+			condition   string
+			replacePath string
+		}{
+			{"https://backendA.example.com/api", 1, "^(/a/.*|/a/?)", "/a{{original_request.path | remove_first: '/a'}}"},
+			{"https://backendB.example.com/api", 2, "^(/b/.*|/b/?)", "/v2{{original_request.path | remove_first: '/b'}}"},
+		}
+		for idx, expectedRule := range expected {
+			rule := rules[idx].(map[string]interface{})
+			operation := rule["condition"].(map[string]interface{})["operations"].([]interface{})[0].(map[string]interface{})
+			if rule["url"] != expectedRule.url || rule["owner_id"] != expectedRule.ownerID ||
+				operation["value"] != expectedRule.condition || rule["replace_path"] != expectedRule.replacePath {
+				subT.Errorf("unexpected rule %d: %v", idx, rule)
+			}
+		}
+	})
+
+	t.Run("BackendNotFound", func(subT *testing.T) {
+		_, err := backendUsagesRoutingPolicy(map[string]capabilitiesv1beta1.BackendUsageSpec{
+			"unknown": {Path: "/a", RewritePath: &capabilitiesv1beta1.BackendUsageRewritePathSpec{Strategy: capabilitiesv1beta1.BackendUsageRewritePathKeep}},
+		}, findBackend)
+		if err == nil {
+			subT.Error("expected error")
+		}
+	})
+}
+
+func TestInsertBeforeAPIcastPolicy(t *testing.T) {
+	policies := []threescaleapi.PolicyConfig{{Name: "cors"}, {Name: "apicast"}}
+
+	result := insertBeforeAPIcastPolicy(policies, threescaleapi.PolicyConfig{Name: backendUsagesRoutingPolicyName})
+
+	names := []string{}
+	for _, policy := range result {
+		names = append(names, policy.Name)
+	}
+	if len(names) != 3 || names[0] != "cors" || names[1] != backendUsagesRoutingPolicyName || names[2] != "apicast" {
+		t.Errorf("unexpected policy chain: %v", names)
+	}
+	if len(policies) != 2 || policies[1].Name != "apicast" {
+		t.Errorf("original policy chain modified: %v", policies)
+	}
+}
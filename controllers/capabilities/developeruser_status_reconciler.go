@@ -68,8 +68,10 @@ func (s *DeveloperUserStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.userCR.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
-	s.userCR.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.userCR)
+	_, updateErr := s.StatusWriter().Write(s.userCR, func(common.KubernetesObject) error {
+		s.userCR.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -0,0 +1,289 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+)
+
+// GatewayDomainReconciler reconciles a GatewayDomain object
+type GatewayDomainReconciler struct {
+	*reconcilers.BaseReconciler
+}
+
+const gatewayDomainFinalizer = "gatewaydomain.capabilities.3scale.net/finalizer"
+
+// blank assignment to verify that GatewayDomainReconciler implements reconcile.Reconciler
+var _ reconcile.Reconciler = &GatewayDomainReconciler{}
+
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewaydomains,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewaydomains/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewaydomains/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *GatewayDomainReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	_ = context.Background()
+	reqLogger := r.Logger().WithValues("gatewaydomain", req.NamespacedName)
+	reqLogger.Info("Reconcile GatewayDomain", "Operator version", version.Version)
+
+	// Fetch the GatewayDomain instance
+	gatewayDomain := &capabilitiesv1beta1.GatewayDomain{}
+	err := r.Client().Get(r.Context(), req.NamespacedName, gatewayDomain)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request
+		return ctrl.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(gatewayDomain, "", "  ")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if gatewayDomain.GetDeletionTimestamp() != nil && controllerutil.ContainsFinalizer(gatewayDomain, gatewayDomainFinalizer) {
+		err = r.removeGatewayDomain(gatewayDomain, reqLogger)
+		if err != nil {
+			r.EventRecorder().Eventf(gatewayDomain, corev1.EventTypeWarning, "Failed to delete gateway domain", "%v", err)
+			return ctrl.Result{}, err
+		}
+
+		controllerutil.RemoveFinalizer(gatewayDomain, gatewayDomainFinalizer)
+		err = r.UpdateResource(gatewayDomain)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Ignore deleted resource, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if gatewayDomain.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(gatewayDomain, gatewayDomainFinalizer) {
+		controllerutil.AddFinalizer(gatewayDomain, gatewayDomainFinalizer)
+		err = r.UpdateResource(gatewayDomain)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcileSpec(gatewayDomain, reqLogger)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return ctrl.Result{}, fmt.Errorf("Failed to reconcile gateway domain: %v. Failed to update gateway domain status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return ctrl.Result{}, fmt.Errorf("Failed to update gateway domain status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(gatewayDomain, corev1.EventTypeWarning, "Invalid GatewayDomain Spec", "%v", reconcileErr)
+			return ctrl.Result{}, nil
+		}
+
+		if helper.IsOrphanSpecError(reconcileErr) {
+			// On Orphan spec error, retry
+			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(gatewayDomain, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+		return ctrl.Result{}, reconcileErr
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *GatewayDomainReconciler) reconcileSpec(gatewayDomain *capabilitiesv1beta1.GatewayDomain, logger logr.Logger) (*GatewayDomainStatusReconciler, error) {
+	err := r.validateSpec(gatewayDomain)
+	if err != nil {
+		return NewGatewayDomainStatusReconciler(r.BaseReconciler, gatewayDomain, "", nil, err), err
+	}
+
+	product, err := r.referencedProduct(gatewayDomain)
+	if err != nil {
+		return NewGatewayDomainStatusReconciler(r.BaseReconciler, gatewayDomain, "", nil, err), err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), gatewayDomain.Namespace, gatewayDomainProviderAccountRef(gatewayDomain, product), logger)
+	if err != nil {
+		return NewGatewayDomainStatusReconciler(r.BaseReconciler, gatewayDomain, "", nil, err), err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return NewGatewayDomainStatusReconciler(r.BaseReconciler, gatewayDomain, providerAccount.AdminURLStr, nil, err), err
+	}
+
+	reconciler := NewGatewayDomainThreescaleReconciler(r.BaseReconciler, gatewayDomain, product, threescaleAPIClient, logger)
+	result, err := reconciler.Reconcile()
+
+	return NewGatewayDomainStatusReconciler(r.BaseReconciler, gatewayDomain, providerAccount.AdminURLStr, result, err), err
+}
+
+func (r *GatewayDomainReconciler) validateSpec(gatewayDomain *capabilitiesv1beta1.GatewayDomain) error {
+	errors := field.ErrorList{}
+	errors = append(errors, gatewayDomain.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
+
+// referencedProduct returns the product CR referenced by the gateway domain.
+// The product must be synchronized and must not manage the production public base URL itself.
+func (r *GatewayDomainReconciler) referencedProduct(gatewayDomain *capabilitiesv1beta1.GatewayDomain) (*capabilitiesv1beta1.Product, error) {
+	productFldPath := field.NewPath("spec").Child("productCRName")
+
+	product := &capabilitiesv1beta1.Product{}
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: gatewayDomain.Spec.ProductCRName, Namespace: gatewayDomain.Namespace}, product)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, &helper.SpecFieldError{
+				ErrorType:      helper.OrphanError,
+				FieldErrorList: field.ErrorList{field.Invalid(productFldPath, gatewayDomain.Spec.ProductCRName, "product not found")},
+			}
+		}
+		return nil, err
+	}
+
+	if !product.Status.Conditions.IsTrueFor(capabilitiesv1beta1.ProductSyncedConditionType) || product.Status.ID == nil {
+		return nil, &helper.SpecFieldError{
+			ErrorType:      helper.OrphanError,
+			FieldErrorList: field.ErrorList{field.Invalid(productFldPath, gatewayDomain.Spec.ProductCRName, "product not synced")},
+		}
+	}
+
+	// Both would fight for the same remote attribute
+	if product.Spec.ProdPublicBaseURL() != nil {
+		return nil, &helper.SpecFieldError{
+			ErrorType:      helper.InvalidError,
+			FieldErrorList: field.ErrorList{field.Invalid(productFldPath, gatewayDomain.Spec.ProductCRName, "product already sets the production public base URL")},
+		}
+	}
+
+	return product, nil
+}
+
+// removeGatewayDomain deletes the route and restores the product production public base URL
+func (r *GatewayDomainReconciler) removeGatewayDomain(gatewayDomain *capabilitiesv1beta1.GatewayDomain, logger logr.Logger) error {
+	route := &routev1.Route{}
+	err := r.Client().Get(r.Context(), client.ObjectKey{Name: gatewayDomainRouteName(gatewayDomain), Namespace: gatewayDomain.Namespace}, route)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		err = r.DeleteResource(route)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Attempt to restore the remote config only if the domain was registered
+	if gatewayDomain.Status.ProductID == nil || gatewayDomain.Status.PreviousProductionPublicBaseURL == nil {
+		logger.Info("remote product config not restored, domain was not registered")
+		return nil
+	}
+
+	// The product may already be deleted
+	product := &capabilitiesv1beta1.Product{}
+	err = r.Client().Get(r.Context(), types.NamespacedName{Name: gatewayDomain.Spec.ProductCRName, Namespace: gatewayDomain.Namespace}, product)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		product = nil
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), gatewayDomain.Namespace, gatewayDomainProviderAccountRef(gatewayDomain, product), logger)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("remote product config not restored, provider account not found")
+			return nil
+		}
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	reconciler := NewGatewayDomainThreescaleReconciler(r.BaseReconciler, gatewayDomain, nil, threescaleAPIClient, logger)
+	return reconciler.Unregister()
+}
+
+// gatewayDomainProviderAccountRef returns the gateway domain provider account reference,
+// defaulting to the product one
+func gatewayDomainProviderAccountRef(gatewayDomain *capabilitiesv1beta1.GatewayDomain, product *capabilitiesv1beta1.Product) *capabilitiesv1beta1.ProviderAccountReference {
+	if gatewayDomain.Spec.ProviderAccountRef == nil && product != nil {
+		return product.Spec.ProviderAccountRef
+	}
+	return capabilitiesv1beta1.NewProviderAccountReference(gatewayDomain.Spec.ProviderAccountRef)
+}
+
+func (r *GatewayDomainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&capabilitiesv1beta1.GatewayDomain{}).
+		Owns(&routev1.Route{}).
+		Complete(r)
+}
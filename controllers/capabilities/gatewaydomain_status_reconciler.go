@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type GatewayDomainStatusReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.GatewayDomain
+	providerAccountHost string
+	syncResult          *GatewayDomainSyncResult
+	reconcileError      error
+	logger              logr.Logger
+}
+
+func NewGatewayDomainStatusReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.GatewayDomain, providerAccountHost string, syncResult *GatewayDomainSyncResult, reconcileError error) *GatewayDomainStatusReconciler {
+	return &GatewayDomainStatusReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		providerAccountHost: providerAccountHost,
+		syncResult:          syncResult,
+		reconcileError:      reconcileError,
+		logger:              b.Logger().WithValues("Status Reconciler", resource.Name),
+	}
+}
+
+func (s *GatewayDomainStatusReconciler) Reconcile() (reconcile.Result, error) {
+	s.logger.V(1).Info("START")
+
+	newStatus := s.calculateStatus()
+
+	equalStatus := s.resource.Status.Equals(newStatus, s.logger)
+	s.logger.V(1).Info("Status", "status is different", !equalStatus)
+	s.logger.V(1).Info("Status", "generation is different", s.resource.Generation != s.resource.Status.ObservedGeneration)
+	if equalStatus && s.resource.Generation == s.resource.Status.ObservedGeneration {
+		// Steady state
+		s.logger.V(1).Info("Status steady state, status was not updated")
+		return reconcile.Result{}, nil
+	}
+
+	// Save the generation number we acted on, otherwise we might wrongfully indicate
+	// that we've seen a spec update when we retry.
+	newStatus.ObservedGeneration = s.resource.Generation
+
+	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.resource.Status.ObservedGeneration, newStatus.ObservedGeneration))
+
+	_, updateErr := s.StatusWriter().Write(s.resource, func(common.KubernetesObject) error {
+		s.resource.Status = *newStatus
+		return nil
+	})
+	if updateErr != nil {
+		// Ignore conflicts, resource might just be outdated.
+		if apierrors.IsConflict(updateErr) {
+			s.logger.Info("Failed to update status: resource might just be outdated")
+			return reconcile.Result{Requeue: true}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update status: %w", updateErr)
+	}
+	return reconcile.Result{}, nil
+}
+
+func (s *GatewayDomainStatusReconciler) calculateStatus() *capabilitiesv1beta1.GatewayDomainStatus {
+	newStatus := &capabilitiesv1beta1.GatewayDomainStatus{}
+
+	// Keep the last observed state when the domain could not be synchronized,
+	// the previous public base URL is needed to restore the product on deletion.
+	newStatus.ProductID = s.resource.Status.ProductID
+	newStatus.RouteName = s.resource.Status.RouteName
+	newStatus.PreviousProductionPublicBaseURL = s.resource.Status.PreviousProductionPublicBaseURL
+	if s.syncResult != nil {
+		newStatus.ProductID = s.syncResult.ProductID
+		if s.syncResult.RouteName != "" {
+			newStatus.RouteName = s.syncResult.RouteName
+		}
+		newStatus.PreviousProductionPublicBaseURL = s.syncResult.PreviousProductionPublicBaseURL
+	}
+
+	newStatus.ProviderAccountHost = s.providerAccountHost
+
+	newStatus.ObservedGeneration = s.resource.Status.ObservedGeneration
+
+	newStatus.Conditions = s.resource.Status.Conditions.Copy()
+	newStatus.Conditions.SetCondition(s.readyCondition())
+	newStatus.Conditions.SetCondition(s.orphanCondition())
+	newStatus.Conditions.SetCondition(s.invalidCondition())
+	newStatus.Conditions.SetCondition(s.hostCollisionCondition())
+	newStatus.Conditions.SetCondition(s.failedCondition())
+
+	return newStatus
+}
+
+func (s *GatewayDomainStatusReconciler) readyCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.GatewayDomainReadyConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if s.reconcileError == nil {
+		condition.Status = corev1.ConditionTrue
+	}
+
+	return condition
+}
+
+func (s *GatewayDomainStatusReconciler) orphanCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.GatewayDomainOrphanConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsOrphanSpecError(s.reconcileError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.reconcileError.Error()
+	}
+
+	return condition
+}
+
+func (s *GatewayDomainStatusReconciler) invalidCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.GatewayDomainInvalidConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsInvalidSpecError(s.reconcileError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.reconcileError.Error()
+	}
+
+	return condition
+}
+
+func (s *GatewayDomainStatusReconciler) hostCollisionCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.GatewayDomainHostCollisionConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	var collisionErr *GatewayDomainHostCollisionError
+	if errors.As(s.reconcileError, &collisionErr) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = collisionErr.Error()
+		if collisionErr.ZyncManaged {
+			condition.Reason = common.ConditionReason("ZyncManagedRoute")
+		}
+	}
+
+	return condition
+}
+
+func (s *GatewayDomainStatusReconciler) failedCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.GatewayDomainFailedConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	// This condition could be activated together with other conditions
+	if s.reconcileError != nil {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.reconcileError.Error()
+	}
+
+	return condition
+}
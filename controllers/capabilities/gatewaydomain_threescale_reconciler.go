@@ -0,0 +1,310 @@
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	gatewayDomainLabel = "capabilities.3scale.net/gatewaydomain"
+
+	certManagerIssuerNameAnnotation = "cert-manager.io/issuer-name"
+	certManagerIssuerKindAnnotation = "cert-manager.io/issuer-kind"
+)
+
+// GatewayDomainHostCollisionError is returned when the gateway domain hostname
+// is already served by a route not managed by the gateway domain
+type GatewayDomainHostCollisionError struct {
+	Host        string
+	RouteName   string
+	ZyncManaged bool
+}
+
+func (e *GatewayDomainHostCollisionError) Error() string {
+	if e.ZyncManaged {
+		return fmt.Sprintf("host %s already served by zync managed route %s", e.Host, e.RouteName)
+	}
+	return fmt.Sprintf("host %s already served by route %s", e.Host, e.RouteName)
+}
+
+// GatewayDomainSyncResult holds the observed state of a synchronized gateway domain
+type GatewayDomainSyncResult struct {
+	ProductID                       *int64
+	RouteName                       string
+	PreviousProductionPublicBaseURL *string
+}
+
+type GatewayDomainThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.GatewayDomain
+	product             *capabilitiesv1beta1.Product
+	threescaleAPIClient *threescaleapi.ThreeScaleClient
+	logger              logr.Logger
+}
+
+func NewGatewayDomainThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.GatewayDomain, product *capabilitiesv1beta1.Product, threescaleAPIClient *threescaleapi.ThreeScaleClient, logger logr.Logger) *GatewayDomainThreescaleReconciler {
+	return &GatewayDomainThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		product:             product,
+		threescaleAPIClient: threescaleAPIClient,
+		logger:              logger.WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+// Reconcile ensures the route serving the gateway domain and registers
+// the domain as the product production public base URL
+func (t *GatewayDomainThreescaleReconciler) Reconcile() (*GatewayDomainSyncResult, error) {
+	result := &GatewayDomainSyncResult{
+		ProductID:                       t.product.Status.ID,
+		PreviousProductionPublicBaseURL: t.resource.Status.PreviousProductionPublicBaseURL,
+	}
+
+	err := t.checkHostCollision()
+	if err != nil {
+		return result, err
+	}
+
+	err = t.reconcileRoute()
+	if err != nil {
+		return result, err
+	}
+	result.RouteName = gatewayDomainRouteName(t.resource)
+
+	proxy, err := t.threescaleAPIClient.ProductProxy(*t.product.Status.ID)
+	if err != nil {
+		return result, fmt.Errorf("gateway domain [%s] get product proxy: %w", t.resource.Spec.Hostname, err)
+	}
+
+	publicBaseURL := t.resource.PublicBaseURL()
+	if helper.SetURLDefaultPort(proxy.Element.Endpoint) == helper.SetURLDefaultPort(publicBaseURL) {
+		return result, nil
+	}
+
+	if result.PreviousProductionPublicBaseURL == nil {
+		previous := proxy.Element.Endpoint
+		result.PreviousProductionPublicBaseURL = &previous
+	}
+
+	t.logger.V(1).Info("UpdateProductProxy", "endpoint", publicBaseURL)
+	_, err = t.threescaleAPIClient.UpdateProductProxy(*t.product.Status.ID, threescaleapi.Params{"endpoint": publicBaseURL})
+	if err != nil {
+		return result, fmt.Errorf("gateway domain [%s] update product proxy: %w", t.resource.Spec.Hostname, err)
+	}
+
+	return result, nil
+}
+
+// Unregister restores the product production public base URL
+// when it is still the one set by the gateway domain
+func (t *GatewayDomainThreescaleReconciler) Unregister() error {
+	productID := *t.resource.Status.ProductID
+
+	proxy, err := t.threescaleAPIClient.ProductProxy(productID)
+	if err != nil {
+		if threescaleapi.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("gateway domain [%s] get product proxy: %w", t.resource.Spec.Hostname, err)
+	}
+
+	if helper.SetURLDefaultPort(proxy.Element.Endpoint) != helper.SetURLDefaultPort(t.resource.PublicBaseURL()) {
+		t.logger.Info("product public base URL changed, not restored", "endpoint", proxy.Element.Endpoint)
+		return nil
+	}
+
+	_, err = t.threescaleAPIClient.UpdateProductProxy(productID, threescaleapi.Params{"endpoint": *t.resource.Status.PreviousProductionPublicBaseURL})
+	if err != nil && !threescaleapi.IsNotFound(err) {
+		return fmt.Errorf("gateway domain [%s] restore product proxy: %w", t.resource.Spec.Hostname, err)
+	}
+
+	return nil
+}
+
+func (t *GatewayDomainThreescaleReconciler) checkHostCollision() error {
+	routeList := &routev1.RouteList{}
+	err := t.Client().List(t.Context(), routeList, client.InNamespace(t.resource.Namespace))
+	if err != nil {
+		return fmt.Errorf("Failed to list routes: %w", err)
+	}
+
+	return gatewayDomainHostCollision(t.resource, routeList.Items)
+}
+
+func (t *GatewayDomainThreescaleReconciler) reconcileRoute() error {
+	desired, err := t.desiredRoute()
+	if err != nil {
+		return err
+	}
+
+	err = t.SetOwnerReference(t.resource, desired)
+	if err != nil {
+		return err
+	}
+
+	return t.ReconcileResource(&routev1.Route{}, desired, gatewayDomainRouteMutator)
+}
+
+func (t *GatewayDomainThreescaleReconciler) desiredRoute() (*routev1.Route, error) {
+	route := &routev1.Route{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Route",
+			APIVersion: "route.openshift.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gatewayDomainRouteName(t.resource),
+			Namespace: t.resource.Namespace,
+			Labels:    map[string]string{gatewayDomainLabel: t.resource.Name},
+		},
+		Spec: routev1.RouteSpec{
+			Host: t.resource.Spec.Hostname,
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: "apicast-production",
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromString("gateway"),
+			},
+		},
+	}
+
+	tlsSpec := t.resource.Spec.TLS
+	if tlsSpec == nil {
+		return route, nil
+	}
+
+	route.Spec.TLS = &routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationEdge,
+		InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+	}
+
+	if tlsSpec.CertManagerIssuer != nil {
+		// cert-manager fills in the route certificate
+		route.Annotations = map[string]string{certManagerIssuerNameAnnotation: tlsSpec.CertManagerIssuer.Name}
+		if tlsSpec.CertManagerIssuer.Kind != nil {
+			route.Annotations[certManagerIssuerKindAnnotation] = *tlsSpec.CertManagerIssuer.Kind
+		}
+	}
+
+	if tlsSpec.SecretRef != nil {
+		secret := &corev1.Secret{}
+		err := t.Client().Get(t.Context(), client.ObjectKey{Name: tlsSpec.SecretRef.Name, Namespace: t.resource.Namespace}, secret)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				secretFldPath := field.NewPath("spec").Child("tls").Child("secretRef")
+				return nil, &helper.SpecFieldError{
+					ErrorType:      helper.OrphanError,
+					FieldErrorList: field.ErrorList{field.Invalid(secretFldPath, tlsSpec.SecretRef.Name, "secret not found")},
+				}
+			}
+			return nil, err
+		}
+
+		route.Spec.TLS.Certificate = string(secret.Data[corev1.TLSCertKey])
+		route.Spec.TLS.Key = string(secret.Data[corev1.TLSPrivateKeyKey])
+		route.Spec.TLS.CACertificate = string(secret.Data["ca.crt"])
+	}
+
+	return route, nil
+}
+
+func gatewayDomainRouteName(gatewayDomain *capabilitiesv1beta1.GatewayDomain) string {
+	return fmt.Sprintf("gatewaydomain-%s", gatewayDomain.Name)
+}
+
+// gatewayDomainHostCollision returns an error when some route
+// not managed by the gateway domain serves the same host
+func gatewayDomainHostCollision(gatewayDomain *capabilitiesv1beta1.GatewayDomain, routes []routev1.Route) error {
+	for idx := range routes {
+		route := &routes[idx]
+		if route.Spec.Host != gatewayDomain.Spec.Hostname || route.Name == gatewayDomainRouteName(gatewayDomain) {
+			continue
+		}
+
+		return &GatewayDomainHostCollisionError{
+			Host:        gatewayDomain.Spec.Hostname,
+			RouteName:   route.Name,
+			ZyncManaged: isZyncManagedRoute(route),
+		}
+	}
+
+	return nil
+}
+
+// isZyncManagedRoute returns true when the route is owned by zync-que
+func isZyncManagedRoute(route *routev1.Route) bool {
+	for _, ref := range route.GetOwnerReferences() {
+		if ref.Kind == "DeploymentConfig" && ref.Name == component.ZyncQueDeploymentName {
+			return true
+		}
+	}
+	return false
+}
+
+func gatewayDomainRouteMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*routev1.Route)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *routev1.Route", existingObj)
+	}
+	desired, ok := desiredObj.(*routev1.Route)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *routev1.Route", desiredObj)
+	}
+
+	updated := helper.EnsureObjectMeta(existing, desired)
+
+	if existing.Spec.Host != desired.Spec.Host {
+		existing.Spec.Host = desired.Spec.Host
+		updated = true
+	}
+
+	if !reflect.DeepEqual(existing.Spec.To, desired.Spec.To) {
+		existing.Spec.To = desired.Spec.To
+		updated = true
+	}
+
+	if !reflect.DeepEqual(existing.Spec.Port, desired.Spec.Port) {
+		existing.Spec.Port = desired.Spec.Port
+		updated = true
+	}
+
+	if desired.Spec.TLS == nil || existing.Spec.TLS == nil {
+		if !reflect.DeepEqual(existing.Spec.TLS, desired.Spec.TLS) {
+			existing.Spec.TLS = desired.Spec.TLS
+			updated = true
+		}
+		return updated, nil
+	}
+
+	// Certificates issued by cert-manager are not part of the desired state
+	_, certManaged := desired.Annotations[certManagerIssuerNameAnnotation]
+	desiredTLS := desired.Spec.TLS.DeepCopy()
+	if certManaged {
+		desiredTLS.Certificate = existing.Spec.TLS.Certificate
+		desiredTLS.Key = existing.Spec.TLS.Key
+		desiredTLS.CACertificate = existing.Spec.TLS.CACertificate
+	}
+
+	if !reflect.DeepEqual(existing.Spec.TLS, desiredTLS) {
+		existing.Spec.TLS = desiredTLS
+		updated = true
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGatewayDomainHostCollision(t *testing.T) {
+	gatewayDomain := &capabilitiesv1beta1.GatewayDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: "vanity", Namespace: "3scale"},
+		Spec:       capabilitiesv1beta1.GatewayDomainSpec{Hostname: "api.example.com"},
+	}
+
+	zyncRoute := routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "zync-3scale-api-abcde",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "DeploymentConfig", Name: component.ZyncQueDeploymentName},
+			},
+		},
+		Spec: routev1.RouteSpec{Host: "api.example.com"},
+	}
+
+	cases := []struct {
+		testName        string
+		routes          []routev1.Route
+		expectedErr     bool
+		expectedZyncErr bool
+	}{
+		{"no routes", nil, false, false},
+		{"own route", []routev1.Route{
+			{ObjectMeta: metav1.ObjectMeta{Name: "gatewaydomain-vanity"}, Spec: routev1.RouteSpec{Host: "api.example.com"}},
+		}, false, false},
+		{"other host", []routev1.Route{
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: routev1.RouteSpec{Host: "other.example.com"}},
+		}, false, false},
+		{"unmanaged route", []routev1.Route{
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: routev1.RouteSpec{Host: "api.example.com"}},
+		}, true, false},
+		{"zync managed route", []routev1.Route{zyncRoute}, true, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			err := gatewayDomainHostCollision(gatewayDomain, tc.routes)
+			if (err != nil) != tc.expectedErr {
+				subT.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil {
+				return
+			}
+
+			var collisionErr *GatewayDomainHostCollisionError
+			if !errors.As(err, &collisionErr) {
+				subT.Fatalf("unexpected error type: %T", err)
+			}
+			if collisionErr.ZyncManaged != tc.expectedZyncErr {
+				subT.Errorf("zync managed: expected %t, got %t", tc.expectedZyncErr, collisionErr.ZyncManaged)
+			}
+		})
+	}
+}
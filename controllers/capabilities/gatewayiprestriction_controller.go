@@ -0,0 +1,339 @@
+/*
+Copyright 2020 Red Hat.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+)
+
+// GatewayIPRestrictionReconciler reconciles a GatewayIPRestriction object
+type GatewayIPRestrictionReconciler struct {
+	*reconcilers.BaseReconciler
+}
+
+const (
+	gatewayIPRestrictionFinalizer = "gatewayiprestriction.capabilities.3scale.net/finalizer"
+
+	// gatewayIPRestrictionSyncPeriod is the period the rules are synchronized at,
+	// restoring them when the policy chain is changed by other means
+	gatewayIPRestrictionSyncPeriod = 30 * time.Second
+)
+
+// blank assignment to verify that GatewayIPRestrictionReconciler implements reconcile.Reconciler
+var _ reconcile.Reconciler = &GatewayIPRestrictionReconciler{}
+
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewayiprestrictions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewayiprestrictions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=gatewayiprestrictions/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *GatewayIPRestrictionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	_ = context.Background()
+	reqLogger := r.Logger().WithValues("gatewayiprestriction", req.NamespacedName)
+	reqLogger.Info("Reconcile GatewayIPRestriction", "Operator version", version.Version)
+
+	// Fetch the GatewayIPRestriction instance
+	gatewayIPRestriction := &capabilitiesv1beta1.GatewayIPRestriction{}
+	err := r.Client().Get(r.Context(), req.NamespacedName, gatewayIPRestriction)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request
+		return ctrl.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(gatewayIPRestriction, "", "  ")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if gatewayIPRestriction.GetDeletionTimestamp() != nil && controllerutil.ContainsFinalizer(gatewayIPRestriction, gatewayIPRestrictionFinalizer) {
+		err = r.removeGatewayIPRestriction(gatewayIPRestriction, reqLogger)
+		if err != nil {
+			r.EventRecorder().Eventf(gatewayIPRestriction, corev1.EventTypeWarning, "Failed to delete gateway IP restriction", "%v", err)
+			return ctrl.Result{}, err
+		}
+
+		controllerutil.RemoveFinalizer(gatewayIPRestriction, gatewayIPRestrictionFinalizer)
+		err = r.UpdateResource(gatewayIPRestriction)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Ignore deleted resource, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if gatewayIPRestriction.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(gatewayIPRestriction, gatewayIPRestrictionFinalizer) {
+		controllerutil.AddFinalizer(gatewayIPRestriction, gatewayIPRestrictionFinalizer)
+		err = r.UpdateResource(gatewayIPRestriction)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcileSpec(gatewayIPRestriction, reqLogger)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return ctrl.Result{}, fmt.Errorf("Failed to reconcile gateway IP restriction: %v. Failed to update gateway IP restriction status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return ctrl.Result{}, fmt.Errorf("Failed to update gateway IP restriction status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(gatewayIPRestriction, corev1.EventTypeWarning, "Invalid GatewayIPRestriction Spec", "%v", reconcileErr)
+			return ctrl.Result{}, nil
+		}
+
+		if helper.IsOrphanSpecError(reconcileErr) {
+			// On Orphan spec error, retry
+			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(gatewayIPRestriction, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+		return ctrl.Result{}, reconcileErr
+	}
+
+	// The policy chain is not watched, resync periodically
+	return ctrl.Result{RequeueAfter: gatewayIPRestrictionSyncPeriod}, nil
+}
+
+func (r *GatewayIPRestrictionReconciler) reconcileSpec(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction, logger logr.Logger) (*GatewayIPRestrictionStatusReconciler, error) {
+	err := r.validateSpec(gatewayIPRestriction)
+	if err != nil {
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, "", nil, err), err
+	}
+
+	err = r.removePreviousRules(gatewayIPRestriction, logger)
+	if err != nil {
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, gatewayIPRestriction.Status.ProviderAccountHost, nil, err), err
+	}
+
+	if gatewayIPRestriction.IsGatewayWide() {
+		result, err := reconcileGatewayIPRestrictionEnvironment(r.BaseReconciler, gatewayIPRestriction)
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, "", result, err), err
+	}
+
+	product, err := r.referencedProduct(gatewayIPRestriction)
+	if err != nil {
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, "", nil, err), err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), gatewayIPRestriction.Namespace, gatewayIPRestrictionProviderAccountRef(gatewayIPRestriction, product), logger)
+	if err != nil {
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, "", nil, err), err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, providerAccount.AdminURLStr, nil, err), err
+	}
+
+	reconciler := NewGatewayIPRestrictionThreescaleReconciler(r.BaseReconciler, gatewayIPRestriction, product, threescaleAPIClient, logger)
+	result, err := reconciler.Reconcile()
+
+	return NewGatewayIPRestrictionStatusReconciler(r.BaseReconciler, gatewayIPRestriction, providerAccount.AdminURLStr, result, err), err
+}
+
+func (r *GatewayIPRestrictionReconciler) validateSpec(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction) error {
+	errors := field.ErrorList{}
+	errors = append(errors, gatewayIPRestriction.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
+
+// referencedProduct returns the synchronized product CR referenced by the gateway IP restriction
+func (r *GatewayIPRestrictionReconciler) referencedProduct(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction) (*capabilitiesv1beta1.Product, error) {
+	productFldPath := field.NewPath("spec").Child("productCRName")
+	productCRName := *gatewayIPRestriction.Spec.ProductCRName
+
+	product := &capabilitiesv1beta1.Product{}
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: productCRName, Namespace: gatewayIPRestriction.Namespace}, product)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, &helper.SpecFieldError{
+				ErrorType:      helper.OrphanError,
+				FieldErrorList: field.ErrorList{field.Invalid(productFldPath, productCRName, "product not found")},
+			}
+		}
+		return nil, err
+	}
+
+	if !product.Status.Conditions.IsTrueFor(capabilitiesv1beta1.ProductSyncedConditionType) || product.Status.ID == nil {
+		return nil, &helper.SpecFieldError{
+			ErrorType:      helper.OrphanError,
+			FieldErrorList: field.ErrorList{field.Invalid(productFldPath, productCRName, "product not synced")},
+		}
+	}
+
+	return product, nil
+}
+
+// removePreviousRules removes the rules from the previous target
+// when the product CR name of the gateway IP restriction has changed
+func (r *GatewayIPRestrictionReconciler) removePreviousRules(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction, logger logr.Logger) error {
+	if gatewayIPRestriction.Status.SecretName != "" && !gatewayIPRestriction.IsGatewayWide() {
+		_, err := reconcileGatewayIPRestrictionEnvironment(r.BaseReconciler, gatewayIPRestriction)
+		return err
+	}
+
+	if gatewayIPRestriction.Status.ProductID == nil {
+		return nil
+	}
+
+	productList := &capabilitiesv1beta1.ProductList{}
+	err := r.Client().List(r.Context(), productList, client.InNamespace(gatewayIPRestriction.Namespace))
+	if err != nil {
+		return fmt.Errorf("Failed to list products: %w", err)
+	}
+
+	for idx := range productList.Items {
+		product := &productList.Items[idx]
+		if product.Status.ID == nil || *product.Status.ID != *gatewayIPRestriction.Status.ProductID {
+			continue
+		}
+
+		if !gatewayIPRestriction.IsGatewayWide() && product.Name == *gatewayIPRestriction.Spec.ProductCRName {
+			// Same target
+			return nil
+		}
+
+		logger.Info("removing rules from the previous product", "product", product.Name)
+		return r.syncProductRules(gatewayIPRestriction, product, logger)
+	}
+
+	return nil
+}
+
+// removeGatewayIPRestriction removes the rules added by the gateway IP restriction
+func (r *GatewayIPRestrictionReconciler) removeGatewayIPRestriction(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction, logger logr.Logger) error {
+	if gatewayIPRestriction.IsGatewayWide() {
+		_, err := reconcileGatewayIPRestrictionEnvironment(r.BaseReconciler, gatewayIPRestriction)
+		return err
+	}
+
+	// Attempt to remove the rules only if they were added
+	if gatewayIPRestriction.Status.ProductID == nil {
+		logger.Info("product policy chain not updated, rules were not added")
+		return nil
+	}
+
+	// The product may already be deleted
+	product := &capabilitiesv1beta1.Product{}
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: *gatewayIPRestriction.Spec.ProductCRName, Namespace: gatewayIPRestriction.Namespace}, product)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("product policy chain not updated, product not found")
+			return nil
+		}
+		return err
+	}
+
+	if product.Status.ID == nil {
+		logger.Info("product policy chain not updated, product not synced")
+		return nil
+	}
+
+	return r.syncProductRules(gatewayIPRestriction, product, logger)
+}
+
+// syncProductRules merges the rules of the gateway IP restrictions targeting the product
+// into the product policy chain.
+func (r *GatewayIPRestrictionReconciler) syncProductRules(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction, product *capabilitiesv1beta1.Product, logger logr.Logger) error {
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), gatewayIPRestriction.Namespace, gatewayIPRestrictionProviderAccountRef(gatewayIPRestriction, product), logger)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("product policy chain not updated, provider account not found")
+			return nil
+		}
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	reconciler := NewGatewayIPRestrictionThreescaleReconciler(r.BaseReconciler, gatewayIPRestriction, product, threescaleAPIClient, logger)
+	_, err = reconciler.Reconcile()
+	return err
+}
+
+// gatewayIPRestrictionProviderAccountRef returns the gateway IP restriction provider account reference,
+// defaulting to the product one
+func gatewayIPRestrictionProviderAccountRef(gatewayIPRestriction *capabilitiesv1beta1.GatewayIPRestriction, product *capabilitiesv1beta1.Product) *capabilitiesv1beta1.ProviderAccountReference {
+	if gatewayIPRestriction.Spec.ProviderAccountRef == nil && product != nil {
+		return product.Spec.ProviderAccountRef
+	}
+	return capabilitiesv1beta1.NewProviderAccountReference(gatewayIPRestriction.Spec.ProviderAccountRef)
+}
+
+func (r *GatewayIPRestrictionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&capabilitiesv1beta1.GatewayIPRestriction{}).
+		Complete(r)
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GatewayIPRestrictionSecretName is the custom environment secret holding the gateway wide rules.
+	// It must be added to the APIManager apicast custom environments.
+	GatewayIPRestrictionSecretName = "gateway-ip-restrictions"
+	// GatewayIPRestrictionSecretKey is the custom environment file name
+	GatewayIPRestrictionSecretKey = "gateway-ip-restrictions.lua"
+)
+
+// gatewayIPRestrictionEnvironment is the APIcast custom environment enforcing the gateway wide rules.
+// APIcast loads custom environments once on boot. Instead, the environment re-reads the rules
+// appended to its own file periodically, so rule changes are applied without redeploying APIcast
+// when kubelet refreshes the mounted secret.
+const gatewayIPRestrictionEnvironment = `local cjson = require('cjson')
+local policy = require('apicast.policy')
+local PolicyChain = require('apicast.policy_chain')
+local policy_chain = context.policy_chain
+
+local rules_file = debug.getinfo(1, 'S').source:sub(2)
+local reload_interval = 10
+
+local function load_checks()
+  local file = io.open(rules_file)
+  if not file then return nil end
+  local content = file:read('*a')
+  file:close()
+
+  local rules = content:match('%%-%%-%%[==%%[RULES(.-)%%]==%%]')
+  if not rules then return nil end
+
+  local ok, configs = pcall(cjson.decode, rules)
+  if not ok then return nil end
+
+  local checks = {}
+  for i, config in ipairs(configs) do
+    checks[i] = PolicyChain.load_policy('ip_check', 'builtin', config)
+  end
+  return checks
+end
+
+local _M = policy.new('gateway_ip_restrictions', 'builtin')
+
+local checks = load_checks() or {}
+local loaded_at = ngx.now()
+
+function _M:access(context)
+  if ngx.now() - loaded_at > reload_interval then
+    checks = load_checks() or checks
+    loaded_at = ngx.now()
+  end
+
+  for _, check in ipairs(checks) do
+    check:access(context)
+  end
+end
+
+policy_chain:insert(_M.new(), 1)
+
+return {
+  policy_chain = policy_chain,
+}
+
+--[==[RULES
+%s
+]==]
+`
+
+// gatewayIPRestrictionEnvironmentSecret returns the custom environment secret
+// enforcing the rules of the gateway wide IP restrictions
+func gatewayIPRestrictionEnvironmentSecret(namespace string, restrictions []capabilitiesv1beta1.GatewayIPRestriction) (*corev1.Secret, error) {
+	configs := []map[string]interface{}{}
+	for _, policy := range gatewayIPRestrictionPolicies(restrictions) {
+		configs = append(configs, policy.Configuration)
+	}
+
+	rules, err := json.MarshalIndent(configs, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      GatewayIPRestrictionSecretName,
+			Labels:    map[string]string{"app": "3scale-operator"},
+		},
+		StringData: map[string]string{
+			GatewayIPRestrictionSecretKey: fmt.Sprintf(gatewayIPRestrictionEnvironment, rules),
+		},
+		Type: corev1.SecretTypeOpaque,
+	}, nil
+}
+
+// reconcileGatewayIPRestrictionEnvironment writes the rules of all the gateway wide IP restrictions
+// to the custom environment secret. When the resource is being deleted, its rules are removed.
+// The secret is not owned by any resource, it is kept with no rules
+// when the last gateway wide IP restriction is deleted, as APIcast may still load it.
+func reconcileGatewayIPRestrictionEnvironment(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.GatewayIPRestriction) (*GatewayIPRestrictionSyncResult, error) {
+	result := &GatewayIPRestrictionSyncResult{}
+
+	restrictions, err := gatewayIPRestrictions(b.Context(), b.Client(), resource.Namespace, "")
+	if err != nil {
+		return result, err
+	}
+
+	desired, err := gatewayIPRestrictionEnvironmentSecret(resource.Namespace, restrictions)
+	if err != nil {
+		return result, err
+	}
+
+	mutator := reconcilers.DeploymentSecretMutator(reconcilers.SecretReconcileField(GatewayIPRestrictionSecretKey))
+	err = b.ReconcileResource(&corev1.Secret{}, desired, mutator)
+	if err != nil {
+		return result, fmt.Errorf("gateway IP restriction [%s] reconcile custom environment secret: %w", resource.Name, err)
+	}
+
+	result.SecretName = GatewayIPRestrictionSecretName
+	if resource.GetDeletionTimestamp() == nil {
+		result.AppliedRules = int32(len(resource.Spec.CIDRs))
+	}
+
+	return result, nil
+}
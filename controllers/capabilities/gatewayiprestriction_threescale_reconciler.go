@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const ipCheckPolicyName = "ip_check"
+
+// GatewayIPRestrictionSyncResult holds the observed state of synchronized gateway IP restriction rules
+type GatewayIPRestrictionSyncResult struct {
+	ProductID    *int64
+	SecretName   string
+	AppliedRules int32
+}
+
+type GatewayIPRestrictionThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.GatewayIPRestriction
+	product             *capabilitiesv1beta1.Product
+	threescaleAPIClient *threescaleapi.ThreeScaleClient
+	logger              logr.Logger
+}
+
+func NewGatewayIPRestrictionThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.GatewayIPRestriction, product *capabilitiesv1beta1.Product, threescaleAPIClient *threescaleapi.ThreeScaleClient, logger logr.Logger) *GatewayIPRestrictionThreescaleReconciler {
+	return &GatewayIPRestrictionThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		product:             product,
+		threescaleAPIClient: threescaleAPIClient,
+		logger:              logger.WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+// Reconcile merges the rules of all the gateway IP restrictions targeting the product
+// into the product policy chain.
+// When the resource is being deleted, its rules are removed from the policy chain.
+func (t *GatewayIPRestrictionThreescaleReconciler) Reconcile() (*GatewayIPRestrictionSyncResult, error) {
+	result := &GatewayIPRestrictionSyncResult{ProductID: t.product.Status.ID}
+
+	restrictions, err := gatewayIPRestrictions(t.Context(), t.Client(), t.resource.Namespace, t.product.Name)
+	if err != nil {
+		return result, err
+	}
+
+	productID := *t.product.Status.ID
+	existing, err := t.threescaleAPIClient.Policies(productID)
+	if err != nil {
+		return result, fmt.Errorf("gateway IP restriction [%s] get product policies: %w", t.resource.Name, err)
+	}
+
+	declared := convertProductPolicies(t.product.Spec.Policies)
+	desired := &threescaleapi.PoliciesConfigList{
+		Policies: mergeIPRestrictionPolicies(existing.Policies, declared.Policies, gatewayIPRestrictionPolicies(restrictions)),
+	}
+
+	if !reflect.DeepEqual(desired, existing) {
+		diff := cmp.Diff(desired, existing)
+		t.logger.V(1).Info("syncPolicies", "policies not equal", diff)
+		_, err = t.threescaleAPIClient.UpdatePolicies(productID, desired)
+		if err != nil {
+			return result, fmt.Errorf("gateway IP restriction [%s] update product policies: %w", t.resource.Name, err)
+		}
+	}
+
+	if t.resource.GetDeletionTimestamp() == nil {
+		result.AppliedRules = int32(len(t.resource.Spec.CIDRs))
+	}
+
+	return result, nil
+}
+
+// gatewayIPRestrictions returns the valid gateway IP restrictions of the namespace targeting the product,
+// or the gateway wide ones when the product CR name is empty.
+// Resources being deleted are left out.
+// Sorted by name, the rules keep a stable order in the policy chain.
+func gatewayIPRestrictions(ctx context.Context, cl client.Client, namespace, productCRName string) ([]capabilitiesv1beta1.GatewayIPRestriction, error) {
+	list := &capabilitiesv1beta1.GatewayIPRestrictionList{}
+	err := cl.List(ctx, list, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list gateway IP restrictions: %w", err)
+	}
+
+	restrictions := []capabilitiesv1beta1.GatewayIPRestriction{}
+	for idx := range list.Items {
+		restriction := list.Items[idx]
+		if restriction.GetDeletionTimestamp() != nil || len(restriction.Validate()) > 0 {
+			continue
+		}
+
+		if productCRName == "" && restriction.IsGatewayWide() ||
+			productCRName != "" && !restriction.IsGatewayWide() && *restriction.Spec.ProductCRName == productCRName {
+			restrictions = append(restrictions, restriction)
+		}
+	}
+
+	sort.Slice(restrictions, func(i, j int) bool { return restrictions[i].Name < restrictions[j].Name })
+
+	return restrictions, nil
+}
+
+func gatewayIPRestrictionPolicies(restrictions []capabilitiesv1beta1.GatewayIPRestriction) []threescaleapi.PolicyConfig {
+	policies := []threescaleapi.PolicyConfig{}
+	for idx := range restrictions {
+		policies = append(policies, gatewayIPRestrictionPolicy(&restrictions[idx]))
+	}
+	return policies
+}
+
+// gatewayIPRestrictionPolicy returns the ip_check policy enforcing the gateway IP restriction rules.
+// The configuration holds the types returned by the 3scale API to be comparable.
+func gatewayIPRestrictionPolicy(restriction *capabilitiesv1beta1.GatewayIPRestriction) threescaleapi.PolicyConfig {
+	checkType := "whitelist"
+	if restriction.Spec.Mode == capabilitiesv1beta1.GatewayIPRestrictionModeDeny {
+		checkType = "blacklist"
+	}
+
+	configuration := map[string]interface{}{
+		"check_type": checkType,
+		"ips":        stringsToInterfaces(restriction.Spec.CIDRs),
+	}
+
+	if len(restriction.Spec.ClientIPSources) > 0 {
+		configuration["client_ip_sources"] = stringsToInterfaces(restriction.Spec.ClientIPSources)
+	}
+
+	return threescaleapi.PolicyConfig{
+		Name:          ipCheckPolicyName,
+		Version:       "builtin",
+		Enabled:       true,
+		Configuration: configuration,
+	}
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
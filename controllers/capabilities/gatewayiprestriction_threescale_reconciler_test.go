@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMergeIPRestrictionPolicies(t *testing.T) {
+	ipCheck := func(checkType string, ips ...string) threescaleapi.PolicyConfig {
+		return threescaleapi.PolicyConfig{
+			Name:          ipCheckPolicyName,
+			Version:       "builtin",
+			Enabled:       true,
+			Configuration: map[string]interface{}{"check_type": checkType, "ips": stringsToInterfaces(ips)},
+		}
+	}
+	cors := threescaleapi.PolicyConfig{Name: "cors", Version: "builtin", Enabled: true}
+	apicast := threescaleapi.PolicyConfig{Name: "apicast", Version: "builtin", Enabled: true}
+	declared := ipCheck("whitelist", "10.0.0.0/8")
+	added := ipCheck("blacklist", "192.0.2.0/24")
+	updated := ipCheck("blacklist", "192.0.2.0/24", "198.51.100.7")
+
+	cases := []struct {
+		testName     string
+		existing     []threescaleapi.PolicyConfig
+		restrictions []threescaleapi.PolicyConfig
+		expected     []threescaleapi.PolicyConfig
+	}{
+		{"add", []threescaleapi.PolicyConfig{cors, apicast}, []threescaleapi.PolicyConfig{added},
+			[]threescaleapi.PolicyConfig{added, cors, apicast}},
+		{"update", []threescaleapi.PolicyConfig{added, cors, apicast}, []threescaleapi.PolicyConfig{updated},
+			[]threescaleapi.PolicyConfig{updated, cors, apicast}},
+		{"remove", []threescaleapi.PolicyConfig{added, cors, apicast}, nil,
+			[]threescaleapi.PolicyConfig{cors, apicast}},
+		{"declared kept", []threescaleapi.PolicyConfig{added, cors, declared, apicast}, nil,
+			[]threescaleapi.PolicyConfig{cors, declared, apicast}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			result := mergeIPRestrictionPolicies(tc.existing, []threescaleapi.PolicyConfig{cors, declared, apicast}, tc.restrictions)
+			if !reflect.DeepEqual(result, tc.expected) {
+				subT.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestGatewayIPRestrictionPolicy(t *testing.T) {
+	restriction := &capabilitiesv1beta1.GatewayIPRestriction{
+		Spec: capabilitiesv1beta1.GatewayIPRestrictionSpec{
+			Mode:            capabilitiesv1beta1.GatewayIPRestrictionModeDeny,
+			CIDRs:           []string{"192.0.2.0/24"},
+			ClientIPSources: []string{"X-Forwarded-For"},
+		},
+	}
+
+	policy := gatewayIPRestrictionPolicy(restriction)
+	expected := map[string]interface{}{
+		"check_type":        "blacklist",
+		"ips":               []interface{}{"192.0.2.0/24"},
+		"client_ip_sources": []interface{}{"X-Forwarded-For"},
+	}
+	if policy.Name != ipCheckPolicyName || !policy.Enabled || !reflect.DeepEqual(policy.Configuration, expected) {
+		t.Errorf("unexpected policy: %v", policy)
+	}
+
+	restriction.Spec.Mode = capabilitiesv1beta1.GatewayIPRestrictionModeAllow
+	restriction.Spec.ClientIPSources = nil
+	policy = gatewayIPRestrictionPolicy(restriction)
+	if policy.Configuration["check_type"] != "whitelist" {
+		t.Errorf("unexpected check type: %v", policy.Configuration["check_type"])
+	}
+	if _, ok := policy.Configuration["client_ip_sources"]; ok {
+		t.Error("unexpected client IP sources")
+	}
+}
+
+func TestGatewayIPRestrictions(t *testing.T) {
+	productName := "product1"
+	restriction := func(name string, productCRName *string, cidr string) *capabilitiesv1beta1.GatewayIPRestriction {
+		return &capabilitiesv1beta1.GatewayIPRestriction{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "3scale"},
+			Spec: capabilitiesv1beta1.GatewayIPRestrictionSpec{
+				ProductCRName: productCRName,
+				Mode:          capabilitiesv1beta1.GatewayIPRestrictionModeDeny,
+				CIDRs:         []string{cidr},
+			},
+		}
+	}
+
+	deleted := restriction("deleted", &productName, "192.0.2.1")
+	deleted.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	objs := []runtime.Object{
+		restriction("product-b", &productName, "192.0.2.2"),
+		restriction("product-a", &productName, "192.0.2.3"),
+		restriction("invalid", &productName, "not-an-ip"),
+		restriction("gateway", nil, "192.0.2.4"),
+		deleted,
+	}
+
+	// Not registered in the shared scheme, where other tests register the types in a different group
+	s := runtime.NewScheme()
+	err := capabilitiesv1beta1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+
+	restrictions, err := gatewayIPRestrictions(context.TODO(), cl, "3scale", productName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restrictions) != 2 || restrictions[0].Name != "product-a" || restrictions[1].Name != "product-b" {
+		t.Errorf("unexpected product restrictions: %v", restrictions)
+	}
+
+	restrictions, err = gatewayIPRestrictions(context.TODO(), cl, "3scale", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restrictions) != 1 || restrictions[0].Name != "gateway" {
+		t.Errorf("unexpected gateway wide restrictions: %v", restrictions)
+	}
+
+	secret, err := gatewayIPRestrictionEnvironmentSecret("3scale", restrictions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	environment := secret.StringData[GatewayIPRestrictionSecretKey]
+	if !strings.Contains(environment, `"192.0.2.4"`) || !strings.Contains(environment, "--[==[RULES") {
+		t.Errorf("unexpected custom environment: %s", environment)
+	}
+}
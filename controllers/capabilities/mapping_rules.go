@@ -6,15 +6,18 @@ import (
 	"strconv"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 func (t *ProductThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	desiredKeys := make([]string, 0, len(t.resource.Spec.MappingRules))
 	desiredMap := map[string]capabilitiesv1beta1.MappingRuleSpec{}
-	for _, spec := range t.resource.Spec.MappingRules {
+	for _, spec := range capabilitiesv1beta1.SortMappingRules(t.resource.Spec.MappingRules) {
 		key := fmt.Sprintf("%s:%s", spec.HTTPMethod, spec.Pattern)
 		desiredKeys = append(desiredKeys, key)
 		desiredMap[key] = spec
@@ -59,7 +62,8 @@ func (t *ProductThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	// In order of definition in the custom resource. Create or update
 	// the MappingRule being processed depending on whether it already exists
 	// in the 3scale API. Specified 'position' attribute of the MappingRule
-	// always corresponds to the position in the CR's MappingRules array.
+	// always corresponds to the position in the CR's MappingRules array,
+	// once sorted by the MappingRule 'position' field when set.
 	// Even though when creating/updating a MappingRule the existing MappingRule
 	// positions in 3scale change, we always compare the desired keys with the
 	// existing MappingRule positions at this point. We do not refetch the list.
@@ -74,7 +78,20 @@ func (t *ProductThreescaleReconciler) syncMappingRules(_ interface{}) error {
 	// unmodified MappingRules happens temporarily during the reconciliation that
 	// is not an issue due to changes are not effective until the user promotes
 	// the configuration
+	if mappingRulesOrderDrifted(desiredKeys, existingMap) {
+		t.logger.Info("syncMappingRules: mapping rules order differs from spec, re-positioning")
+		t.EventRecorder().Eventf(t.resource, corev1.EventTypeWarning, "MappingRulesOrderDrift", "product [%s] mapping rules order differs from spec, re-positioning", t.resource.Spec.SystemName)
+	}
+
+	// Metrics and methods are read once, before the concurrent calls
+	_, err = t.productEntity.MetricsAndMethods()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] mappingrules: %w", t.resource.Spec.SystemName, err)
+	}
+
 	t.logger.V(1).Info("syncMappingRules", "desiredKeys", desiredKeys)
+	attributeTasks := []func() error{}
+	positionTasks := []func() error{}
 	for desiredIdxZeroBased, desiredKey := range desiredKeys {
 		desiredMappingRule := desiredMap[desiredKey]
 		// We define the position sent to System starting from one (one-based array)
@@ -86,31 +103,71 @@ func (t *ProductThreescaleReconciler) syncMappingRules(_ interface{}) error {
 		if existingMappingRule, ok := existingMap[desiredKey]; ok {
 			// Reconcile MappingRule
 			t.logger.V(1).Info("syncMappingRules", "desiredMappingRuleToReconcile", desiredKey, "position", desiredIdx)
-			err := t.reconcileMappingRuleWithPosition(desiredMappingRule, desiredIdx, existingMappingRule)
-			if err != nil {
-				return fmt.Errorf("Error sync product [%s] mappingrules: %w", t.resource.Spec.SystemName, err)
+			task := func() error {
+				return t.reconcileMappingRuleWithPosition(desiredMappingRule, desiredIdx, existingMappingRule)
+			}
+			// Updates keeping the position do not shift other rules
+			if existingMappingRule.Position == desiredIdx {
+				attributeTasks = append(attributeTasks, task)
+			} else {
+				positionTasks = append(positionTasks, task)
 			}
 		} else {
 			// Create MappingRule
 			t.logger.V(1).Info("syncMappingRules", "desiredMappingRuleToCreate", desiredKey, "position", desiredIdx)
-			err := t.createNewMappingRuleWithPosition(desiredMappingRule, desiredIdx)
-			if err != nil {
-				return fmt.Errorf("Error sync product [%s] mappingrules: %w", t.resource.Spec.SystemName, err)
-			}
+			positionTasks = append(positionTasks, func() error {
+				return t.createNewMappingRuleWithPosition(desiredMappingRule, desiredIdx)
+			})
 		}
 	}
 
+	err = syncMappingRuleTasks(t.providerAccountHost, attributeTasks, positionTasks)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] mappingrules: %w", t.resource.Spec.SystemName, err)
+	}
+
 	return nil
 }
 
-func (t *ProductThreescaleReconciler) processNotDesiredMappingRules(notDesiredList []threescaleapi.MappingRuleItem) error {
-	for _, mappingRule := range notDesiredList {
-		err := t.productEntity.DeleteMappingRule(mappingRule.ID)
-		if err != nil {
-			return err
+// syncMappingRuleTasks runs the updates keeping the mapping rule position concurrently.
+// Creates and updates changing the position shift the other rules,
+// hence they run sequentially in ascending position order afterwards.
+// All the tasks are run even when some of them fail, the next sync
+// computes the remaining changes from the remote state again.
+func syncMappingRuleTasks(providerAccountHost string, attributeTasks, positionTasks []func() error) error {
+	errs := []error{controllerhelper.RunProviderAccountTasks(providerAccountHost, attributeTasks)}
+	for _, task := range positionTasks {
+		errs = append(errs, task())
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// mappingRulesOrderDrifted returns true when the existing mapping rules
+// are not in the same relative order as the desired ones
+func mappingRulesOrderDrifted(desiredKeys []string, existingMap map[string]threescaleapi.MappingRuleItem) bool {
+	lastPosition := -1
+	for _, desiredKey := range desiredKeys {
+		existing, ok := existingMap[desiredKey]
+		if !ok {
+			continue
+		}
+		if existing.Position < lastPosition {
+			return true
 		}
+		lastPosition = existing.Position
 	}
-	return nil
+	return false
+}
+
+func (t *ProductThreescaleReconciler) processNotDesiredMappingRules(notDesiredList []threescaleapi.MappingRuleItem) error {
+	tasks := make([]func() error, 0, len(notDesiredList))
+	for idx := range notDesiredList {
+		mappingRuleID := notDesiredList[idx].ID
+		tasks = append(tasks, func() error {
+			return t.productEntity.DeleteMappingRule(mappingRuleID)
+		})
+	}
+	return controllerhelper.RunProviderAccountTasks(t.providerAccountHost, tasks)
 }
 
 func (t *ProductThreescaleReconciler) getExistingMappingRules() (map[string]threescaleapi.MappingRuleItem, error) {
@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var mappingRuleIDPathRegexp = regexp.MustCompile(`/mapping_rules/(\d+)\.json$`)
+
+// fakeMappingRulesServer emulates the porta mapping rules endpoints of a product.
+// Creating or moving a mapping rule to a position shifts the following rules
+type fakeMappingRulesServer struct {
+	mutex sync.Mutex
+	// ordered by position
+	rules  []threescaleapi.MappingRuleItem
+	nextID int64
+	// number of create, update and delete calls
+	writes int
+	// patterns whose next create fails
+	failCreate map[string]bool
+}
+
+func newFakeMappingRulesServer(patterns ...string) *fakeMappingRulesServer {
+	f := &fakeMappingRulesServer{nextID: 1, failCreate: map[string]bool{}}
+	for _, pattern := range patterns {
+		f.insert(threescaleapi.MappingRuleItem{HTTPMethod: "GET", Pattern: pattern, MetricID: 1, Delta: 1}, len(f.rules)+1)
+	}
+	return f
+}
+
+func (f *fakeMappingRulesServer) insert(rule threescaleapi.MappingRuleItem, position int) {
+	if rule.ID == 0 {
+		rule.ID = f.nextID
+		f.nextID++
+	}
+	idx := position - 1
+	if idx < 0 || idx > len(f.rules) {
+		idx = len(f.rules)
+	}
+	f.rules = append(f.rules, threescaleapi.MappingRuleItem{})
+	copy(f.rules[idx+1:], f.rules[idx:])
+	f.rules[idx] = rule
+	f.resetPositions()
+}
+
+func (f *fakeMappingRulesServer) remove(id int64) (threescaleapi.MappingRuleItem, bool) {
+	for idx := range f.rules {
+		if f.rules[idx].ID == id {
+			rule := f.rules[idx]
+			f.rules = append(f.rules[:idx], f.rules[idx+1:]...)
+			f.resetPositions()
+			return rule, true
+		}
+	}
+	return threescaleapi.MappingRuleItem{}, false
+}
+
+func (f *fakeMappingRulesServer) resetPositions() {
+	for idx := range f.rules {
+		f.rules[idx].Position = idx + 1
+	}
+}
+
+func (f *fakeMappingRulesServer) patterns() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	result := make([]string, 0, len(f.rules))
+	for _, rule := range f.rules {
+		result = append(result, fmt.Sprintf("%s:%d", rule.Pattern, rule.Delta))
+	}
+	return result
+}
+
+func (f *fakeMappingRulesServer) writeCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.writes
+}
+
+func (f *fakeMappingRulesServer) roundTrip(req *http.Request) *http.Response {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	response := func(status int, obj interface{}) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody(obj))),
+		}
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return response(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	applyForm := func(rule *threescaleapi.MappingRuleItem) {
+		if value := req.Form.Get("pattern"); value != "" {
+			rule.Pattern = value
+		}
+		if value := req.Form.Get("http_method"); value != "" {
+			rule.HTTPMethod = value
+		}
+		if value, err := strconv.ParseInt(req.Form.Get("metric_id"), 10, 64); err == nil {
+			rule.MetricID = value
+		}
+		if value, err := strconv.Atoi(req.Form.Get("delta")); err == nil {
+			rule.Delta = value
+		}
+		if value, err := strconv.ParseBool(req.Form.Get("last")); err == nil {
+			rule.Last = value
+		}
+	}
+	position := func() int {
+		value, err := strconv.Atoi(req.Form.Get("position"))
+		if err != nil {
+			return 0
+		}
+		return value
+	}
+
+	path := req.URL.Path
+	switch {
+	case req.Method == http.MethodGet && regexp.MustCompile(`/metrics\.json$`).MatchString(path):
+		return response(http.StatusOK, &threescaleapi.MetricJSONList{Metrics: []threescaleapi.MetricJSON{
+			{Element: threescaleapi.MetricItem{ID: 1, Name: "Hits", SystemName: "hits", Unit: "hit"}},
+		}})
+	case req.Method == http.MethodGet && regexp.MustCompile(`/mapping_rules\.json$`).MatchString(path):
+		list := &threescaleapi.MappingRuleJSONList{}
+		for _, rule := range f.rules {
+			list.MappingRules = append(list.MappingRules, threescaleapi.MappingRuleJSON{Element: rule})
+		}
+		return response(http.StatusOK, list)
+	case req.Method == http.MethodPost && regexp.MustCompile(`/mapping_rules\.json$`).MatchString(path):
+		f.writes++
+		rule := threescaleapi.MappingRuleItem{}
+		applyForm(&rule)
+		if f.failCreate[rule.Pattern] {
+			delete(f.failCreate, rule.Pattern)
+			return response(http.StatusInternalServerError, map[string]string{"error": "transient failure"})
+		}
+		f.insert(rule, position())
+		return response(http.StatusCreated, &threescaleapi.MappingRuleJSON{Element: rule})
+	}
+
+	match := mappingRuleIDPathRegexp.FindStringSubmatch(path)
+	if match == nil {
+		return response(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+	id, _ := strconv.ParseInt(match[1], 10, 64)
+
+	switch req.Method {
+	case http.MethodPut:
+		f.writes++
+		rule, ok := f.remove(id)
+		if !ok {
+			return response(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		newPosition := rule.Position
+		if value := position(); value > 0 {
+			newPosition = value
+		}
+		applyForm(&rule)
+		f.insert(rule, newPosition)
+		return response(http.StatusOK, &threescaleapi.MappingRuleJSON{Element: rule})
+	case http.MethodDelete:
+		f.writes++
+		if _, ok := f.remove(id); !ok {
+			return response(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		return response(http.StatusOK, map[string]string{})
+	}
+
+	return response(http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+}
+
+func newMappingRulesTestReconciler(t *testing.T, server *fakeMappingRulesServer, mappingRules []capabilitiesv1beta1.MappingRuleSpec) *ProductThreescaleReconciler {
+	t.Helper()
+
+	product := &capabilitiesv1beta1.Product{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: capabilitiesv1beta1.ProductSpec{
+			Name:         "test",
+			SystemName:   "test",
+			MappingRules: mappingRules,
+		},
+	}
+
+	adminPortal, err := threescaleapi.NewAdminPortalFromStr("https://3scale-admin.test.3scale.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+	threescaleAPIClient := threescaleapi.NewThreeScale(adminPortal, "token", NewTestClient(server.roundTrip))
+
+	log := logf.Log.WithName("mapping_rules_test")
+	cl := fake.NewFakeClient()
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl, log, fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(100))
+
+	reconciler := NewProductThreescaleReconciler(baseReconciler, product, threescaleAPIClient, nil, "https://3scale-admin.test.3scale.net")
+	productObj := &threescaleapi.Product{Element: threescaleapi.ProductItem{ID: 3, SystemName: "test"}}
+	reconciler.productEntity = controllerhelper.NewProductEntity(productObj, threescaleAPIClient, log)
+	return reconciler
+}
+
+func TestSyncMappingRulesConverges(t *testing.T) {
+	desired := []capabilitiesv1beta1.MappingRuleSpec{}
+	expected := []string{}
+	for idx := 0; idx < 30; idx++ {
+		pattern := fmt.Sprintf("/r%02d", idx)
+		increment := 1
+		if idx%3 == 0 {
+			increment = 2
+		}
+		desired = append(desired, capabilitiesv1beta1.MappingRuleSpec{
+			HTTPMethod:      "GET",
+			Pattern:         pattern,
+			MetricMethodRef: "hits",
+			Increment:       increment,
+		})
+		expected = append(expected, fmt.Sprintf("%s:%d", pattern, increment))
+	}
+
+	cases := []struct {
+		testName   string
+		existing   []string
+		failCreate []string
+	}{
+		{"empty", nil, nil},
+		{"reversed with obsolete rules", []string{"/r20", "/obsolete1", "/r10", "/r03", "/obsolete2", "/r00"}, nil},
+		{"transient failures", []string{"/r20", "/obsolete1", "/r10"}, []string{"/r05", "/r25"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			server := newFakeMappingRulesServer(tc.existing...)
+			for _, pattern := range tc.failCreate {
+				server.failCreate[pattern] = true
+			}
+
+			err := newMappingRulesTestReconciler(subT, server, desired).syncMappingRules(nil)
+			if len(tc.failCreate) == 0 && err != nil {
+				subT.Fatal(err)
+			}
+			if len(tc.failCreate) > 0 {
+				if err == nil {
+					subT.Fatal("expected sync error")
+				}
+				// The failures do not prevent the other changes
+				if len(server.patterns()) != len(expected)-len(tc.failCreate) {
+					subT.Fatalf("unexpected mapping rules after the failed sync: %v", server.patterns())
+				}
+				// The next sync resumes from the remote state
+				err = newMappingRulesTestReconciler(subT, server, desired).syncMappingRules(nil)
+				if err != nil {
+					subT.Fatal(err)
+				}
+			}
+
+			if got := server.patterns(); fmt.Sprint(got) != fmt.Sprint(expected) {
+				subT.Fatalf("mapping rules did not converge. Expected: %v, got: %v", expected, got)
+			}
+
+			writes := server.writeCount()
+			err = newMappingRulesTestReconciler(subT, server, desired).syncMappingRules(nil)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if server.writeCount() != writes {
+				subT.Errorf("unexpected changes on a converged sync: %d", server.writeCount()-writes)
+			}
+		})
+	}
+}
@@ -88,14 +88,7 @@ func (t *ProductThreescaleReconciler) syncMethods(_ interface{}) error {
 
 func (t *ProductThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabilitiesv1beta1.MethodSpec) error {
 	for systemName, method := range desiredNewMap {
-		params := threescaleapi.Params{
-			"friendly_name": method.Name,
-			"system_name":   systemName,
-		}
-		if len(method.Description) > 0 {
-			params["description"] = method.Description
-		}
-		err := t.productEntity.CreateMethod(params)
+		err := t.productEntity.CreateMethod(methodCreateParams(systemName, method))
 		if err != nil {
 			return err
 		}
@@ -115,15 +108,7 @@ func (t *ProductThreescaleReconciler) processNotDesiredMethods(notDesiredMap map
 
 func (t *ProductThreescaleReconciler) reconcileMatchedMethods(matchedMap map[string]methodData) error {
 	for _, data := range matchedMap {
-		params := threescaleapi.Params{}
-		if data.spec.Name != data.item.Name {
-			params["friendly_name"] = data.spec.Name
-		}
-
-		if data.spec.Description != data.item.Description {
-			params["description"] = data.spec.Description
-		}
-
+		params := methodUpdateParams(data.spec, data.item)
 		if len(params) > 0 {
 			err := t.productEntity.UpdateMethod(data.item.ID, params)
 			if err != nil {
@@ -134,3 +119,30 @@ func (t *ProductThreescaleReconciler) reconcileMatchedMethods(matchedMap map[str
 
 	return nil
 }
+
+// methodCreateParams returns the params of a new method.
+// Texts are normalized, so they are stored as they will be compared
+func methodCreateParams(systemName string, method capabilitiesv1beta1.MethodSpec) threescaleapi.Params {
+	params := threescaleapi.Params{
+		"friendly_name": helper.NormalizeText(method.Name),
+		"system_name":   systemName,
+	}
+	if description := helper.NormalizeText(method.Description); len(description) > 0 {
+		params["description"] = description
+	}
+	return params
+}
+
+// methodUpdateParams returns the method fields out of sync, empty when there is nothing to update
+func methodUpdateParams(spec capabilitiesv1beta1.MethodSpec, item threescaleapi.MethodItem) threescaleapi.Params {
+	params := threescaleapi.Params{}
+	if !helper.TextEquals(spec.Name, item.Name) {
+		params["friendly_name"] = helper.NormalizeText(spec.Name)
+	}
+
+	if !helper.TextEquals(spec.Description, item.Description) {
+		params["description"] = helper.NormalizeText(spec.Description)
+	}
+
+	return params
+}
@@ -101,19 +101,7 @@ func (t *ProductThreescaleReconciler) processNotDesiredMetrics(notDesiredMap map
 
 func (t *ProductThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]metricData) error {
 	for _, data := range matchedMap {
-		params := threescaleapi.Params{}
-		if data.spec.Name != data.item.Name {
-			params["friendly_name"] = data.spec.Name
-		}
-
-		if data.spec.Unit != data.item.Unit {
-			params["unit"] = data.spec.Unit
-		}
-
-		if data.spec.Description != data.item.Description {
-			params["description"] = data.spec.Description
-		}
-
+		params := metricUpdateParams(data.spec, data.item)
 		if len(params) > 0 {
 			err := t.productEntity.UpdateMetric(data.item.ID, params)
 			if err != nil {
@@ -127,18 +115,43 @@ func (t *ProductThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[str
 
 func (t *ProductThreescaleReconciler) createNewMetrics(desiredNewMap map[string]capabilitiesv1beta1.MetricSpec) error {
 	for systemName, metric := range desiredNewMap {
-		params := threescaleapi.Params{
-			"friendly_name": metric.Name,
-			"unit":          metric.Unit,
-			"system_name":   systemName,
-		}
-		if len(metric.Description) > 0 {
-			params["description"] = metric.Description
-		}
-		err := t.productEntity.CreateMetric(params)
+		err := t.productEntity.CreateMetric(metricCreateParams(systemName, metric))
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// metricCreateParams returns the params of a new metric.
+// Texts are normalized, so they are stored as they will be compared
+func metricCreateParams(systemName string, metric capabilitiesv1beta1.MetricSpec) threescaleapi.Params {
+	params := threescaleapi.Params{
+		"friendly_name": helper.NormalizeText(metric.Name),
+		"unit":          helper.NormalizeText(metric.Unit),
+		"system_name":   systemName,
+	}
+	if description := helper.NormalizeText(metric.Description); len(description) > 0 {
+		params["description"] = description
+	}
+	return params
+}
+
+// metricUpdateParams returns the metric fields out of sync, empty when there is nothing to update.
+// Texts are compared normalized, as 3scale may return an equivalent form of the sent value
+func metricUpdateParams(spec capabilitiesv1beta1.MetricSpec, item threescaleapi.MetricItem) threescaleapi.Params {
+	params := threescaleapi.Params{}
+	if !helper.TextEquals(spec.Name, item.Name) {
+		params["friendly_name"] = helper.NormalizeText(spec.Name)
+	}
+
+	if !helper.TextEquals(spec.Unit, item.Unit) {
+		params["unit"] = helper.NormalizeText(spec.Unit)
+	}
+
+	if !helper.TextEquals(spec.Description, item.Description) {
+		params["description"] = helper.NormalizeText(spec.Description)
+	}
+
+	return params
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+func TestMetricUpdateParams(t *testing.T) {
+	cases := []struct {
+		name     string
+		spec     capabilitiesv1beta1.MetricSpec
+		item     threescaleapi.MetricItem
+		expected threescaleapi.Params
+	}{
+		{
+			"emojiInSync",
+			capabilitiesv1beta1.MetricSpec{Name: "Rocket 🚀", Unit: "hit", Description: "🚀 launches"},
+			threescaleapi.MetricItem{Name: "Rocket 🚀", Unit: "hit", Description: "🚀 launches"},
+			threescaleapi.Params{},
+		},
+		{
+			"cjkInSync",
+			capabilitiesv1beta1.MetricSpec{Name: "请求次数", Unit: "次", Description: "日本語の説明"},
+			threescaleapi.MetricItem{Name: "请求次数", Unit: "次", Description: "日本語の説明"},
+			threescaleapi.Params{},
+		},
+		{
+			"equivalentFormsInSync",
+			capabilitiesv1beta1.MetricSpec{Name: "cafe\u0301 ", Unit: "hit", Description: "line\r\nbreak\n"},
+			threescaleapi.MetricItem{Name: "caf\u00e9", Unit: "hit", Description: "line\nbreak"},
+			threescaleapi.Params{},
+		},
+		{
+			"changed",
+			capabilitiesv1beta1.MetricSpec{Name: "请求次数 ", Unit: "hit", Description: "new 🚀"},
+			threescaleapi.MetricItem{Name: "请求", Unit: "hit", Description: "old"},
+			threescaleapi.Params{"friendly_name": "请求次数", "description": "new 🚀"},
+		},
+		{
+			"descriptionRemoved",
+			capabilitiesv1beta1.MetricSpec{Name: "Hits", Unit: "hit"},
+			threescaleapi.MetricItem{Name: "Hits", Unit: "hit", Description: "old"},
+			threescaleapi.Params{"description": ""},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			params := metricUpdateParams(tc.spec, tc.item)
+			if !reflect.DeepEqual(params, tc.expected) {
+				subT.Errorf("expected %v, got %v", tc.expected, params)
+			}
+		})
+	}
+}
+
+func TestMethodUpdateParams(t *testing.T) {
+	spec := capabilitiesv1beta1.MethodSpec{Name: "Método 🚀", Description: "获取用户"}
+
+	params := methodUpdateParams(spec, threescaleapi.MethodItem{Name: "Método 🚀", Description: "获取用户"})
+	if len(params) != 0 {
+		t.Errorf("unexpected update of equivalent method: %v", params)
+	}
+
+	params = methodUpdateParams(spec, threescaleapi.MethodItem{Name: "Método", Description: "获取用户"})
+	if !reflect.DeepEqual(params, threescaleapi.Params{"friendly_name": "Método 🚀"}) {
+		t.Errorf("unexpected method update: %v", params)
+	}
+}
+
+func TestMetricMethodCreateParams(t *testing.T) {
+	metricParams := metricCreateParams("rocket", capabilitiesv1beta1.MetricSpec{Name: " Rocket 🚀 ", Unit: "hit"})
+	expected := threescaleapi.Params{"friendly_name": "Rocket 🚀", "unit": "hit", "system_name": "rocket"}
+	if !reflect.DeepEqual(metricParams, expected) {
+		t.Errorf("expected %v, got %v", expected, metricParams)
+	}
+
+	methodParams := methodCreateParams("users", capabilitiesv1beta1.MethodSpec{Name: "用户", Description: "cafe\u0301"})
+	expected = threescaleapi.Params{"friendly_name": "用户", "system_name": "users", "description": "caf\u00e9"}
+	if !reflect.DeepEqual(methodParams, expected) {
+		t.Errorf("expected %v, got %v", expected, methodParams)
+	}
+}
@@ -115,7 +115,7 @@ func (p *OpenAPIBackendReconciler) desired() (*capabilitiesv1beta1.Backend, erro
 			SystemName:         systemName,
 			PrivateBaseURL:     privateBaseURL,
 			Description:        description,
-			ProviderAccountRef: p.openapiCR.Spec.ProviderAccountRef,
+			ProviderAccountRef: capabilitiesv1beta1.NewProviderAccountReference(p.openapiCR.Spec.ProviderAccountRef),
 		},
 	}
 
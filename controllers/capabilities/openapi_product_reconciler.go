@@ -113,7 +113,7 @@ func (p *OpenAPIProductReconciler) desired() (*capabilitiesv1beta1.Product, erro
 			Name:               name,
 			SystemName:         systemName,
 			Description:        description,
-			ProviderAccountRef: p.openapiCR.Spec.ProviderAccountRef,
+			ProviderAccountRef: capabilitiesv1beta1.NewProviderAccountReference(p.openapiCR.Spec.ProviderAccountRef),
 		},
 	}
 
@@ -60,8 +60,10 @@ func (s *OpenAPIStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.resource.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
-	s.resource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.resource)
+	_, updateErr := s.StatusWriter().Write(s.resource, func(common.KubernetesObject) error {
+		s.resource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 	corev1 "k8s.io/api/core/v1"
@@ -51,6 +52,8 @@ var _ reconcile.Reconciler = &ProductReconciler{}
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=products,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=products/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=capabilities.3scale.net,namespace=placeholder,resources=products/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=capabilities.3scale.net,resources=provideraccountgrants,verbs=get;list
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get
 
 func (r *ProductReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	_ = context.Background()
@@ -89,6 +92,8 @@ func (r *ProductReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			return ctrl.Result{}, err
 		}
 
+		deleteSyncDuration(product, capabilitiesv1beta1.ProductKind)
+
 		controllerutil.RemoveFinalizer(product, productFinalizer)
 		err = r.UpdateResource(product)
 		if err != nil {
@@ -111,30 +116,33 @@ func (r *ProductReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), product.GetNamespace(), product.Spec.ProviderAccountRef, r.Logger())
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	// Retrieve ownersReference of tenant CR that owns the Backend CR
-	tenantCR, err := controllerhelper.RetrieveTenantCR(providerAccount, r.Client(), r.Logger(), product.Namespace)
-	if err != nil {
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), product.GetNamespace(), product.Spec.ProviderAccountRef, r.Logger())
+	// Access denied is reported in the status
+	if err != nil && !helper.IsAccessDeniedError(err) {
 		return ctrl.Result{}, err
 	}
 
-	// If tenant CR is found, set it's ownersReference as ownerReference in the BackendCR CR
-	if tenantCR != nil {
-		updated, err := r.EnsureOwnerReference(tenantCR, product)
+	if providerAccount != nil {
+		// Retrieve ownersReference of tenant CR that owns the Backend CR
+		tenantCR, err := controllerhelper.RetrieveTenantCR(providerAccount, r.Client(), r.Logger(), product.Namespace)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
-		if updated {
-			err := r.Client().Update(r.Context(), product)
+		// If tenant CR is found, set it's ownersReference as ownerReference in the BackendCR CR
+		if tenantCR != nil {
+			updated, err := r.EnsureOwnerReference(tenantCR, product)
 			if err != nil {
 				return ctrl.Result{}, err
 			}
-			return ctrl.Result{Requeue: true}, nil
+
+			if updated {
+				err := r.Client().Update(r.Context(), product)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{Requeue: true}, nil
+			}
 		}
 	}
 
@@ -163,6 +171,20 @@ func (r *ProductReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	if reconcileErr != nil {
+		if helper.IsQuotaExceededError(reconcileErr) {
+			// On quota exceeded error, retry later as the quota might be raised
+			reqLogger.Info("ERROR", "quota exceeded error", reconcileErr)
+			r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "QuotaExceeded", "%v", reconcileErr)
+			return ctrl.Result{RequeueAfter: providerAccountQuotaRecheckPeriod}, nil
+		}
+
+		if helper.IsAccessDeniedError(reconcileErr) {
+			// On access denied error, retry later as the access might be granted
+			reqLogger.Info("ERROR", "access denied error", reconcileErr)
+			r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "AccessDenied", "%v", reconcileErr)
+			return ctrl.Result{RequeueAfter: providerAccountGrantRecheckPeriod}, nil
+		}
+
 		if helper.IsInvalidSpecError(reconcileErr) {
 			// On Validation error, no need to retry as spec is not valid and needs to be changed
 			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
@@ -182,7 +204,7 @@ func (r *ProductReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	reqLogger.Info("END", "error", reconcileErr)
-	return ctrl.Result{}, reconcileErr
+	return providerAccountGrantResult(product.Namespace, product.Spec.ProviderAccountRef), nil
 }
 
 func (r *ProductReconciler) reconcile(productResource *capabilitiesv1beta1.Product) (*ProductStatusReconciler, error) {
@@ -194,12 +216,18 @@ func (r *ProductReconciler) reconcile(productResource *capabilitiesv1beta1.Produ
 		return statusReconciler, err
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), productResource.Namespace, productResource.Spec.ProviderAccountRef, logger)
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), productResource.Namespace, productResource.Spec.ProviderAccountRef, logger)
 	if err != nil {
 		statusReconciler := NewProductStatusReconciler(r.BaseReconciler, productResource, nil, "", err)
 		return statusReconciler, err
 	}
 
+	err = checkProductQuota(r.Client(), productResource, providerAccount)
+	if err != nil {
+		statusReconciler := NewProductStatusReconciler(r.BaseReconciler, productResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
 	err = r.checkExternalRefs(productResource, providerAccount)
 	logger.Info("checkExternalRefs", "err", err)
 	if err != nil {
@@ -219,9 +247,11 @@ func (r *ProductReconciler) reconcile(productResource *capabilitiesv1beta1.Produ
 		return statusReconciler, err
 	}
 
-	reconciler := NewProductThreescaleReconciler(r.BaseReconciler, productResource, threescaleAPIClient, backendRemoteIndex)
+	reconciler := NewProductThreescaleReconciler(r.BaseReconciler, productResource, threescaleAPIClient, backendRemoteIndex, providerAccount.AdminURLStr)
+	start := time.Now()
 	productEntity, err := reconciler.Reconcile()
 	statusReconciler := NewProductStatusReconciler(r.BaseReconciler, productResource, productEntity, providerAccount.AdminURLStr, err)
+	statusReconciler.syncDuration = recordSyncDuration(productResource, capabilitiesv1beta1.ProductKind, start)
 	return statusReconciler, err
 }
 
@@ -243,7 +273,7 @@ func (r *ProductReconciler) checkExternalRefs(resource *capabilitiesv1beta1.Prod
 	logger := r.Logger().WithValues("product", resource.Name)
 	errors := field.ErrorList{}
 
-	backendList, err := controllerhelper.BackendList(resource.Namespace, r.Client(), providerAccount.AdminURLStr, logger)
+	backendList, err := controllerhelper.BackendList(resource.Namespace, r.Client(), r.APIClientReader(), providerAccount.AdminURLStr, logger)
 	if err != nil {
 		return fmt.Errorf("checking backend usage references: %w", err)
 	}
@@ -391,12 +421,17 @@ func (r *ProductReconciler) removeProductFrom3scale(product *capabilitiesv1beta1
 		return nil
 	}
 
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), product.Namespace, product.Spec.ProviderAccountRef, logger)
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), product.Namespace, product.Spec.ProviderAccountRef, logger)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info("product not deleted from 3scale, provider account not found")
 			return nil
 		}
+		if helper.IsAccessDeniedError(err) {
+			logger.Info("product not deleted from 3scale, provider account access denied")
+			r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "AccessDenied", "product not deleted from 3scale: %v", err)
+			return nil
+		}
 		return err
 	}
 
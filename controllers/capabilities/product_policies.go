@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/google/go-cmp/cmp"
 )
@@ -17,6 +19,22 @@ func (t *ProductThreescaleReconciler) syncPolicies(_ interface{}) error {
 
 	desired := t.convertResourcePolicies()
 
+	routingPolicy, err := backendUsagesRoutingPolicy(t.resource.Spec.BackendUsages, t.backendRemoteIndex.FindBySystemName)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+	if routingPolicy != nil {
+		desired.Policies = insertBeforeAPIcastPolicy(desired.Policies, *routingPolicy)
+	}
+
+	// Keep the rules of the gateway IP restrictions targeting the product,
+	// otherwise both controllers would fight for the policy chain
+	ipRestrictions, err := gatewayIPRestrictions(t.Context(), t.Client(), t.resource.Namespace, t.resource.Name)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+	desired.Policies = append(gatewayIPRestrictionPolicies(ipRestrictions), desired.Policies...)
+
 	// Compare Go unmarshalled objects (not byte arrays)
 	// resilient to serialization differences like map key order differences or quotes.
 	// Policies order matters. If order does not match, will be updated
@@ -34,11 +52,15 @@ func (t *ProductThreescaleReconciler) syncPolicies(_ interface{}) error {
 
 // Convert Policies from []capabilitiesv1beta1.PolicyConfig to *threescaleapi.PoliciesConfigList to be comparable
 func (t *ProductThreescaleReconciler) convertResourcePolicies() *threescaleapi.PoliciesConfigList {
+	return convertProductPolicies(t.resource.Spec.Policies)
+}
+
+func convertProductPolicies(crdPolicies []capabilitiesv1beta1.PolicyConfig) *threescaleapi.PoliciesConfigList {
 	policies := &threescaleapi.PoliciesConfigList{
 		Policies: []threescaleapi.PolicyConfig{},
 	}
 
-	for _, crdPolicy := range t.resource.Spec.Policies {
+	for _, crdPolicy := range crdPolicies {
 		var configuration map[string]interface{}
 		// CRD validation ensures no error happens
 		// "configuration` type is object
@@ -59,3 +81,43 @@ func (t *ProductThreescaleReconciler) convertResourcePolicies() *threescaleapi.P
 
 	return policies
 }
+
+// insertBeforeAPIcastPolicy adds the policy right before the apicast policy,
+// so it runs after the policies configured in the product spec
+func insertBeforeAPIcastPolicy(policies []threescaleapi.PolicyConfig, policy threescaleapi.PolicyConfig) []threescaleapi.PolicyConfig {
+	for idx := range policies {
+		if policies[idx].Name == "apicast" {
+			result := append([]threescaleapi.PolicyConfig{}, policies[:idx]...)
+			result = append(result, policy)
+			return append(result, policies[idx:]...)
+		}
+	}
+
+	return append(policies, policy)
+}
+
+// mergeIPRestrictionPolicies replaces the ip_check policies added by gateway IP restrictions
+// with the given restriction policies, leaving the rest of the policy chain untouched.
+// ip_check policies declared in the product spec are not managed by gateway IP restrictions.
+// The restriction policies go first, requests are rejected before running any other policy.
+func mergeIPRestrictionPolicies(policies, declared, restrictions []threescaleapi.PolicyConfig) []threescaleapi.PolicyConfig {
+	result := append([]threescaleapi.PolicyConfig{}, restrictions...)
+
+	for idx := range policies {
+		if policies[idx].Name == ipCheckPolicyName && !containsPolicy(declared, policies[idx]) {
+			continue
+		}
+		result = append(result, policies[idx])
+	}
+
+	return result
+}
+
+func containsPolicy(policies []threescaleapi.PolicyConfig, policy threescaleapi.PolicyConfig) bool {
+	for idx := range policies {
+		if reflect.DeepEqual(policies[idx], policy) {
+			return true
+		}
+	}
+	return false
+}
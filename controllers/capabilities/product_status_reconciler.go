@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type ProductStatusReconciler struct {
@@ -21,7 +22,9 @@ type ProductStatusReconciler struct {
 	entity              *controllerhelper.ProductEntity
 	providerAccountHost string
 	syncError           error
-	logger              logr.Logger
+	// syncDuration is only set when the spec has been synchronized
+	syncDuration *metav1.Duration
+	logger       logr.Logger
 }
 
 func NewProductStatusReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.Product, entity *controllerhelper.ProductEntity, providerAccountHost string, syncError error) *ProductStatusReconciler {
@@ -57,8 +60,10 @@ func (s *ProductStatusReconciler) Reconcile() (reconcile.Result, error) {
 
 	s.logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", s.resource.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
-	s.resource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.resource)
+	_, updateErr := s.StatusWriter().Write(s.resource, func(common.KubernetesObject) error {
+		s.resource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -82,15 +87,76 @@ func (s *ProductStatusReconciler) calculateStatus() *capabilitiesv1beta1.Product
 
 	newStatus.ObservedGeneration = s.resource.Status.ObservedGeneration
 
+	newStatus.ApplicationPlans = s.applicationPlansStatus()
+
+	newStatus.BackendUsages = s.backendUsagesStatus()
+
+	newStatus.LastSyncDuration = s.resource.Status.LastSyncDuration
+	if s.syncDuration != nil {
+		newStatus.LastSyncDuration = s.syncDuration
+	}
+
 	newStatus.Conditions = s.resource.Status.Conditions.Copy()
 	newStatus.Conditions.SetCondition(s.syncCondition())
 	newStatus.Conditions.SetCondition(s.orphanCondition())
 	newStatus.Conditions.SetCondition(s.invalidCondition())
 	newStatus.Conditions.SetCondition(s.failedCondition())
+	newStatus.Conditions.SetCondition(s.quotaExceededCondition())
+	newStatus.Conditions.SetCondition(s.accessDeniedCondition())
 
 	return newStatus
 }
 
+func (s *ProductStatusReconciler) applicationPlansStatus() map[string]capabilitiesv1beta1.ApplicationPlanStatus {
+	if s.entity == nil {
+		// Keep the last observed state
+		return s.resource.Status.ApplicationPlans
+	}
+
+	planList, err := s.entity.ApplicationPlans()
+	if err != nil {
+		s.logger.Error(err, "Failed reading application plans, keeping last observed state")
+		return s.resource.Status.ApplicationPlans
+	}
+
+	if len(planList.Plans) == 0 {
+		return nil
+	}
+
+	plansStatus := make(map[string]capabilitiesv1beta1.ApplicationPlanStatus, len(planList.Plans))
+	for _, plan := range planList.Plans {
+		plansStatus[plan.Element.SystemName] = capabilitiesv1beta1.ApplicationPlanStatus{
+			ID:      plan.Element.ID,
+			State:   plan.Element.State,
+			Default: s.syncError == nil && s.resource.Spec.DefaultPlan != nil && *s.resource.Spec.DefaultPlan == plan.Element.SystemName,
+		}
+	}
+
+	return plansStatus
+}
+
+func (s *ProductStatusReconciler) backendUsagesStatus() map[string]capabilitiesv1beta1.BackendUsageStatus {
+	if s.syncError != nil {
+		// Keep the last observed state
+		return s.resource.Status.BackendUsages
+	}
+
+	if len(s.resource.Spec.BackendUsages) == 0 {
+		return nil
+	}
+
+	usagesStatus := make(map[string]capabilitiesv1beta1.BackendUsageStatus, len(s.resource.Spec.BackendUsages))
+	for systemName, usage := range s.resource.Spec.BackendUsages {
+		usagesStatus[systemName] = capabilitiesv1beta1.BackendUsageStatus{
+			Path:            usage.Path,
+			RewritePath:     usage.RewritePathStrategy(),
+			Materialization: backendUsageMaterialization(usage),
+		}
+	}
+
+	return usagesStatus
+}
+
 func (s *ProductStatusReconciler) syncCondition() common.Condition {
 	condition := common.Condition{
 		Type:   capabilitiesv1beta1.ProductSyncedConditionType,
@@ -146,3 +212,31 @@ func (s *ProductStatusReconciler) failedCondition() common.Condition {
 
 	return condition
 }
+
+func (s *ProductStatusReconciler) quotaExceededCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductQuotaExceededConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsQuotaExceededError(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
+
+func (s *ProductStatusReconciler) accessDeniedCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductAccessDeniedConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if helper.IsAccessDeniedError(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
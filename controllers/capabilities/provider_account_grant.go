@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"time"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// providerAccountGrantRecheckPeriod is the delay to check again the resources referencing
+// provider account secrets from other namespaces. The operator only watches its own namespace,
+// so grants created or revoked in the secret namespace, as well as secret updates,
+// are picked up on the next check.
+const providerAccountGrantRecheckPeriod = 2 * time.Minute
+
+// providerAccountGrantResult returns the result of a successful reconcile of a resource
+// with the given provider account reference
+func providerAccountGrantResult(ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference) ctrl.Result {
+	if providerAccountRef.IsCrossNamespace(ns) {
+		return ctrl.Result{RequeueAfter: providerAccountGrantRecheckPeriod}
+	}
+	return ctrl.Result{}
+}
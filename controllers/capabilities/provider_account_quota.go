@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"time"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// providerAccountQuotaRecheckPeriod is the delay to retry the resources blocked by
+// the provider account quota. Raising the quota in the provider account secret is
+// picked up on the next retry.
+const providerAccountQuotaRecheckPeriod = 2 * time.Minute
+
+// checkProductQuota returns QuotaExceededError when the product has not been created yet
+// and the provider account has already reached the products quota.
+// Products already created in 3scale are always kept in sync.
+func checkProductQuota(cl client.Client, product *capabilitiesv1beta1.Product, providerAccount *controllerhelper.ProviderAccount) error {
+	count, err := controllerhelper.ProviderAccountProductCount(product.Namespace, cl, providerAccount.AdminURLStr)
+	if err != nil {
+		return err
+	}
+	controllerhelper.ProviderAccountResourcesMetric.WithLabelValues(product.Namespace, providerAccount.AdminURLStr, capabilitiesv1beta1.ProductKind).Set(float64(count))
+
+	if product.Status.ID != nil {
+		return nil
+	}
+
+	quota, err := controllerhelper.ProviderAccountQuota(providerAccount, controllerhelper.ProviderAccountMaxProductsAnnotation, controllerhelper.MaxProductsPerProviderAccountEnvVar)
+	if err != nil {
+		return err
+	}
+
+	if quota != nil && count >= *quota {
+		return &helper.QuotaExceededError{Kind: capabilitiesv1beta1.ProductKind, Quota: *quota, ProviderAccount: providerAccount.AdminURLStr}
+	}
+
+	return nil
+}
+
+// checkBackendQuota returns QuotaExceededError when the backend has not been created yet
+// and the provider account has already reached the backends quota.
+// Backends already created in 3scale are always kept in sync.
+func checkBackendQuota(cl client.Client, backend *capabilitiesv1beta1.Backend, providerAccount *controllerhelper.ProviderAccount) error {
+	count, err := controllerhelper.ProviderAccountBackendCount(backend.Namespace, cl, providerAccount.AdminURLStr)
+	if err != nil {
+		return err
+	}
+	controllerhelper.ProviderAccountResourcesMetric.WithLabelValues(backend.Namespace, providerAccount.AdminURLStr, capabilitiesv1beta1.BackendKind).Set(float64(count))
+
+	if backend.Status.ID != nil {
+		return nil
+	}
+
+	quota, err := controllerhelper.ProviderAccountQuota(providerAccount, controllerhelper.ProviderAccountMaxBackendsAnnotation, controllerhelper.MaxBackendsPerProviderAccountEnvVar)
+	if err != nil {
+		return err
+	}
+
+	if quota != nil && count >= *quota {
+		return &helper.QuotaExceededError{Kind: capabilitiesv1beta1.BackendKind, Quota: *quota, ProviderAccount: providerAccount.AdminURLStr}
+	}
+
+	return nil
+}
@@ -85,7 +85,7 @@ func (r *ProxyConfigPromoteReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 	}
 
 	// get providerAccountRef from product
-	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), proxyConfigPromote.GetNamespace(), product.Spec.ProviderAccountRef, r.Logger())
+	providerAccount, err := controllerhelper.LookupProviderAccountReference(r.Client(), r.APIClientReader(), proxyConfigPromote.GetNamespace(), product.Spec.ProviderAccountRef, r.Logger())
 	if err != nil {
 		return ctrl.Result{}, err
 	}
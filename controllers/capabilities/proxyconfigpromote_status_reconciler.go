@@ -52,8 +52,10 @@ func (s *ProxyConfigPromoteStatusReconciler) Reconcile() (reconcile.Result, erro
 		return reconcile.Result{}, nil
 	}
 
-	s.resource.Status = *newStatus
-	updateErr := s.Client().Status().Update(s.Context(), s.resource)
+	_, updateErr := s.StatusWriter().Write(s.resource, func(common.KubernetesObject) error {
+		s.resource.Status = *newStatus
+		return nil
+	})
 	if updateErr != nil {
 		// Ignore conflicts, resource might just be outdated.
 		if errors.IsConflict(updateErr) {
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncDurationMetric exposes the duration of the last synchronization
+// of each custom resource spec into 3scale
+var SyncDurationMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "threescale_capabilities_sync_duration_seconds",
+		Help: "Duration of the last synchronization of the custom resource into 3scale",
+	},
+	[]string{"namespace", "kind", "name"},
+)
+
+// recordSyncDuration updates the sync duration metric of the custom resource
+// and returns the duration reported in its status
+func recordSyncDuration(obj metav1.Object, kind string, start time.Time) *metav1.Duration {
+	elapsed := time.Since(start)
+	SyncDurationMetric.WithLabelValues(obj.GetNamespace(), kind, obj.GetName()).Set(elapsed.Seconds())
+	return &metav1.Duration{Duration: elapsed.Round(time.Millisecond)}
+}
+
+// deleteSyncDuration removes the sync duration metric of the deleted custom resource
+func deleteSyncDuration(obj metav1.Object, kind string) {
+	SyncDurationMetric.DeleteLabelValues(obj.GetNamespace(), kind, obj.GetName())
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
+	"strings"
 
 	porta_client_pkg "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/go-logr/logr"
@@ -16,6 +18,7 @@ import (
 
 	apiv1alpha1 "github.com/3scale/3scale-operator/apis/capabilities/v1alpha1"
 	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 )
 
@@ -44,7 +47,16 @@ func NewTenantInternalReconciler(b *reconcilers.BaseReconciler, tenantR *apiv1al
 // - Have active admin user
 // - Have secret with tenant's access_token
 func (r *TenantInternalReconciler) Run() (ctrl.Result, error) {
-	res, err := r.reconcileTenant()
+	res, err := r.reconcileHosts()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if res.Requeue {
+		return res, nil
+	}
+
+	res, err = r.reconcileTenant()
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -65,6 +77,69 @@ func (r *TenantInternalReconciler) Run() (ctrl.Result, error) {
 	return ctrl.Result{}, err
 }
 
+// This method reports the tenant portal hosts in the status and checks them
+// against the DNS length limits. Tenants whose hosts would be rejected by the
+// routers are not created. Already existing tenants only get a warning event
+func (r *TenantInternalReconciler) reconcileHosts() (ctrl.Result, error) {
+	adminHost, developerHost, err := tenantHosts(r.tenantR)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	newStatus := r.tenantR.Status.DeepCopy()
+	newStatus.AdminHost = adminHost
+	newStatus.DeveloperHost = developerHost
+	updated, err := r.reconcileStatus(newStatus)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if updated {
+		// requeue to have a new run with the updated tenant resource
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	reasons := []string{}
+	for _, host := range []string{adminHost, developerHost} {
+		if reason := helper.HostLengthError(host); reason != "" {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", host, reason))
+		}
+	}
+
+	if len(reasons) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	message := strings.Join(reasons, "; ")
+	r.EventRecorder().Eventf(r.tenantR, v1.EventTypeWarning, "InvalidHost", "Tenant hosts exceed the DNS length limits: %s", message)
+	if r.tenantR.Status.TenantId == 0 {
+		return ctrl.Result{}, fmt.Errorf("tenant not created, hosts exceed the DNS length limits: %s", message)
+	}
+
+	r.logger.Info("WARNING: tenant hosts exceed the DNS length limits", "hosts", message)
+	return ctrl.Result{}, nil
+}
+
+// tenantHosts returns the admin and developer portal hosts of the tenant.
+// The superdomain is taken from the master URL, removing its first label
+func tenantHosts(tenantR *apiv1alpha1.Tenant) (string, string, error) {
+	masterURL, err := url.Parse(tenantR.Spec.SystemMasterUrl)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing systemMasterUrl: %w", err)
+	}
+
+	masterHostParts := strings.SplitN(helper.NormalizeDomain(masterURL.Hostname()), ".", 2)
+	if len(masterHostParts) != 2 {
+		return "", "", fmt.Errorf("systemMasterUrl host '%s' has no superdomain", masterURL.Hostname())
+	}
+
+	subdomain := helper.TenantSubdomain(tenantR.Spec.OrganizationName)
+	adminHost := fmt.Sprintf("%s-admin.%s", subdomain, masterHostParts[1])
+	developerHost := fmt.Sprintf("%s.%s", subdomain, masterHostParts[1])
+
+	return adminHost, developerHost, nil
+}
+
 // This method makes sure that tenant exists, otherwise it will create one
 // On method completion:
 // * tenant will exist
@@ -92,8 +167,10 @@ func (r *TenantInternalReconciler) reconcileTenant() (ctrl.Result, error) {
 		// Early update status with the new tenantID
 		newStatus := &apiv1alpha1.TenantStatus{
 			// reset adminID. It could keep old stale value
-			AdminId:  0,
-			TenantId: tenantDef.Signup.Account.ID,
+			AdminId:       0,
+			TenantId:      tenantDef.Signup.Account.ID,
+			AdminHost:     r.tenantR.Status.AdminHost,
+			DeveloperHost: r.tenantR.Status.DeveloperHost,
 		}
 
 		updated, err := r.reconcileStatus(newStatus)
@@ -144,8 +221,10 @@ func (r *TenantInternalReconciler) reconcileAdminUser() (ctrl.Result, error) {
 	}
 
 	newStatus := &apiv1alpha1.TenantStatus{
-		AdminId:  *adminUser.Element.ID,
-		TenantId: r.tenantR.Status.TenantId,
+		AdminId:       *adminUser.Element.ID,
+		TenantId:      r.tenantR.Status.TenantId,
+		AdminHost:     r.tenantR.Status.AdminHost,
+		DeveloperHost: r.tenantR.Status.DeveloperHost,
 	}
 
 	updated, err := r.reconcileStatus(newStatus)
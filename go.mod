@@ -11,6 +11,7 @@ require (
 	github.com/go-logr/logr v0.1.0
 	github.com/go-playground/validator/v10 v10.2.0
 	github.com/google/go-cmp v0.4.0
+	github.com/google/gofuzz v1.1.0
 	github.com/google/uuid v1.1.1
 	github.com/integr8ly/grafana-operator/v3 v3.6.0
 	github.com/luci/go-render v0.0.0-20160219211803-9a04cc21af0f
@@ -23,6 +24,7 @@ require (
 	github.com/spf13/viper v1.4.0
 	github.com/stretchr/testify v1.5.1
 	golang.org/x/mod v0.5.1
+	golang.org/x/text v0.3.3
 	gopkg.in/yaml.v2 v2.3.0
 	k8s.io/api v0.18.6
 	k8s.io/apimachinery v0.18.6
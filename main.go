@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"runtime"
 
@@ -32,21 +33,26 @@ import (
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	controllerruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/3scale/3scale-operator/apis/apps/v1beta1"
 	capabilitiesv1alpha1 "github.com/3scale/3scale-operator/apis/capabilities/v1alpha1"
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
 	appscontroller "github.com/3scale/3scale-operator/controllers/apps"
 	capabilitiescontroller "github.com/3scale/3scale-operator/controllers/capabilities"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"github.com/3scale/3scale-operator/version"
 	// +kubebuilder:scaffold:imports
@@ -67,6 +73,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(appsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(appsv1beta1.AddToScheme(scheme))
 	utilruntime.Must(capabilitiesv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(capabilitiesv1beta1.AddToScheme(scheme))
 	utilruntime.Must(routev1.AddToScheme(scheme))
@@ -83,6 +90,10 @@ func init() {
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
+	var apimanagerSelectorFlag string
+	var enableCapabilitiesWebhooks bool
+	var enableConversionWebhook bool
+	var enableDebugContainers bool
 
 	// https://v1-2-x.sdk.operatorframework.io/docs/building-operators/golang/references/logging/#a-simple-example
 	// Add the zap logger flag set to the CLI. The flag set must
@@ -94,6 +105,18 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&apimanagerSelectorFlag, "apimanager-selector", "",
+		"Label selector limiting the APIManagers reconciled by this operator instance. "+
+			"Operator instances with disjoint selectors can run side by side.")
+	flag.BoolVar(&enableCapabilitiesWebhooks, "enable-capabilities-webhooks", false,
+		"Enable the validating webhooks of the Product and Backend custom resources. "+
+			"Requires the webhook server certificates to be mounted.")
+	flag.BoolVar(&enableConversionWebhook, "enable-conversion-webhook", true,
+		"Enable the APIManager conversion webhook between apps.3scale.net/v1alpha1 and v1beta1. "+
+			"Requires the webhook server certificates to be mounted.")
+	flag.BoolVar(&enableDebugContainers, "enable-debug-containers", false,
+		"Enable attaching ephemeral debug containers to the component pods "+
+			"with the apps.3scale.net/debug APIManager annotation.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&loggerOpts)))
@@ -106,13 +129,23 @@ func main() {
 		os.Exit(1)
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	apimanagerSelector, err := labels.Parse(apimanagerSelectorFlag)
+	if err != nil {
+		setupLog.Error(err, "Failed to parse APIManager selector")
+		os.Exit(1)
+	}
+
+	restConfig := ctrl.GetConfigOrDie()
+	// Identifies the operator in the field managers of the APIManagers
+	restConfig.UserAgent = appscontroller.OperatorUserAgent
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Namespace:          namespace,
 		Scheme:             scheme,
 		MetricsBindAddress: metricsAddr,
 		Port:               9443,
 		LeaderElection:     enableLeaderElection,
-		LeaderElectionID:   "82355b9c.3scale.net",
+		LeaderElectionID:   leaderElectionID(apimanagerSelector),
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -124,12 +157,23 @@ func main() {
 		setupLog.Error(err, "unable to create discovery client")
 		os.Exit(1)
 	}
+	var debugContainersPodsClient corev1client.PodsGetter
+	if enableDebugContainers {
+		clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create debug containers client")
+			os.Exit(1)
+		}
+		debugContainersPodsClient = clientset.CoreV1()
+	}
 	if err = (&appscontroller.APIManagerReconciler{
 		BaseReconciler: reconcilers.NewBaseReconciler(
 			context.Background(), mgr.GetClient(), mgr.GetScheme(), mgr.GetAPIReader(),
 			ctrl.Log.WithName("controllers").WithName("APIManager"),
 			discoveryClientAPIManager,
 			mgr.GetEventRecorderFor("APIManager")),
+		APIManagerSelector:        apimanagerSelector,
+		DebugContainersPodsClient: debugContainersPodsClient,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "APIManager")
 		os.Exit(1)
@@ -332,6 +376,57 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "ProxyConfigPromote")
 		os.Exit(1)
 	}
+
+	discoveryClientGatewayDomain, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create discovery client")
+		os.Exit(1)
+	}
+
+	if err = (&capabilitiescontroller.GatewayDomainReconciler{
+		BaseReconciler: reconcilers.NewBaseReconciler(
+			context.Background(), mgr.GetClient(), mgr.GetScheme(), mgr.GetAPIReader(),
+			ctrl.Log.WithName("controllers").WithName("GatewayDomain"),
+			discoveryClientGatewayDomain,
+			mgr.GetEventRecorderFor("GatewayDomain")),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GatewayDomain")
+		os.Exit(1)
+	}
+
+	discoveryClientGatewayIPRestriction, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create discovery client")
+		os.Exit(1)
+	}
+
+	if err = (&capabilitiescontroller.GatewayIPRestrictionReconciler{
+		BaseReconciler: reconcilers.NewBaseReconciler(
+			context.Background(), mgr.GetClient(), mgr.GetScheme(), mgr.GetAPIReader(),
+			ctrl.Log.WithName("controllers").WithName("GatewayIPRestriction"),
+			discoveryClientGatewayIPRestriction,
+			mgr.GetEventRecorderFor("GatewayIPRestriction")),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GatewayIPRestriction")
+		os.Exit(1)
+	}
+
+	if enableCapabilitiesWebhooks {
+		if err = (&capabilitiesv1beta1.Product{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Product")
+			os.Exit(1)
+		}
+		if err = (&capabilitiesv1beta1.Backend{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Backend")
+			os.Exit(1)
+		}
+	}
+	if enableConversionWebhook {
+		if err = (&appsv1beta1.APIManager{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "APIManager")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
@@ -341,6 +436,20 @@ func main() {
 	}
 }
 
+// leaderElectionID returns the leader election lock name.
+// Operator instances sharded by APIManager selector get their own lock,
+// otherwise only one of them would be active.
+func leaderElectionID(apimanagerSelector labels.Selector) string {
+	const defaultLeaderElectionID = "82355b9c.3scale.net"
+	if apimanagerSelector.Empty() {
+		return defaultLeaderElectionID
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(apimanagerSelector.String()))
+	return fmt.Sprintf("%x.%s", h.Sum32(), defaultLeaderElectionID)
+}
+
 // getWatchNamespace returns the Namespace the operator should be watching for changes
 func getWatchNamespace() (string, error) {
 	// WatchNamespaceEnvVar is the constant for env variable WATCH_NAMESPACE
@@ -363,6 +472,10 @@ func printVersion() {
 
 func registerThreescaleMetricsIntoControllerRuntimeMetricsRegistry() {
 	register3scaleVersionInfoMetric()
+	registerAPIManagerWorkloadFailuresMetric()
+	registerProviderAccountResourcesMetric()
+	registerAPIManagerSubReconcilerDurationMetric()
+	registerCapabilitiesSyncDurationMetric()
 }
 
 func register3scaleVersionInfoMetric() {
@@ -379,3 +492,19 @@ func register3scaleVersionInfoMetric() {
 	// Register custom metrics with the global prometheus registry
 	controllerruntimemetrics.Registry.MustRegister(threeScaleVersionInfo)
 }
+
+func registerAPIManagerWorkloadFailuresMetric() {
+	controllerruntimemetrics.Registry.MustRegister(appscontroller.WorkloadFailuresMetric)
+}
+
+func registerProviderAccountResourcesMetric() {
+	controllerruntimemetrics.Registry.MustRegister(controllerhelper.ProviderAccountResourcesMetric)
+}
+
+func registerAPIManagerSubReconcilerDurationMetric() {
+	controllerruntimemetrics.Registry.MustRegister(appscontroller.SubReconcilerDurationMetric)
+}
+
+func registerCapabilitiesSyncDurationMetric() {
+	controllerruntimemetrics.Registry.MustRegister(capabilitiescontroller.SyncDurationMetric)
+}
@@ -0,0 +1,93 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/grafanadashboards"
+)
+
+var grafanaDashboardsNamespace string
+
+var grafanaDashboardsDatasourceName string
+
+var grafanaDashboardsLabels map[string]string
+
+// Compatibility with Openshift <4.9
+var grafanaDashboardsCompatPre49 bool
+
+// grafanaDashboardsCmd represents the grafanadashboards command
+var grafanaDashboardsCmd = &cobra.Command{
+	Use:   getGrafanaDashboardsUsage(),
+	Short: getGrafanaDashboardsShortDescription(),
+	Long:  getGrafanaDashboardsLongDescription(),
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGrafanaDashboardsCommand,
+}
+
+func getGrafanaDashboardsUsage() string {
+	return "grafanadashboards <dashboard-name>"
+}
+
+func getGrafanaDashboardsShortDescription() string {
+	return "generate grafana dashboard serialized resource"
+}
+
+func getGrafanaDashboardsLongDescription() string {
+	return "generate grafana dashboard serialized resource"
+}
+
+func runGrafanaDashboardsCommand(cmd *cobra.Command, args []string) error {
+	// Check factory items names do not conflict
+	factoryMap := map[string]grafanadashboards.GrafanaDashboardFactory{}
+	for _, factoryBuilder := range grafanadashboards.GrafanaDashboardFactories {
+		factory := factoryBuilder()
+		if _, ok := factoryMap[factory.Type()]; ok {
+			return fmt.Errorf("GrafanaDashboard factory %s already exists", factory.Type())
+		}
+		factoryMap[factory.Type()] = factory
+	}
+
+	dashboardName := args[0]
+	factory, ok := factoryMap[dashboardName]
+	if !ok {
+		return fmt.Errorf("Factory %s not found", dashboardName)
+	}
+
+	options := component.DefaultGrafanaDashboardOptions()
+	options.DatasourceName = grafanaDashboardsDatasourceName
+	if len(grafanaDashboardsLabels) > 0 {
+		options.Labels = grafanaDashboardsLabels
+	}
+
+	grafanaDashboardObj := factory.GrafanaDashboard(grafanaDashboardsCompatPre49, grafanaDashboardsNamespace, options)
+
+	serializer := json.NewSerializerWithOptions(json.DefaultMetaFactory, nil, nil,
+		json.SerializerOptions{Yaml: true, Pretty: true, Strict: true})
+	return serializer.Encode(grafanaDashboardObj, os.Stdout)
+}
+
+func init() {
+	grafanaDashboardsCmd.PersistentFlags().StringVar(&grafanaDashboardsNamespace, "namespace", "", "Namespace to be used when generating the grafana dashboards")
+	grafanaDashboardsCmd.PersistentFlags().StringVar(&grafanaDashboardsDatasourceName, "datasource-name", component.DefaultGrafanaDatasourceName, "Name of the prometheus datasource selected by default in the dashboards")
+	grafanaDashboardsCmd.PersistentFlags().StringToStringVar(&grafanaDashboardsLabels, "labels", nil, "Labels matched by the grafana dashboard label selector. Defaults to monitoring-key=middleware")
+	grafanaDashboardsCmd.PersistentFlags().BoolVar(&grafanaDashboardsCompatPre49, "compat", false, "Generate dashboards compatible with Openshift releases prior to 4.9")
+	grafanaDashboardsCmd.MarkFlagRequired("namespace")
+	rootCmd.AddCommand(grafanaDashboardsCmd)
+}
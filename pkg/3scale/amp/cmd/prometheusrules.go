@@ -65,7 +65,10 @@ func runPrometheusRulesCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("Factory %s not found", prName)
 	}
 
-	prometheusRulesObj := factory.PrometheusRule(compatPre49, prometheusRulesNamespace)
+	prometheusRulesObj, err := factory.PrometheusRule(compatPre49, prometheusRulesNamespace)
+	if err != nil {
+		return err
+	}
 
 	serializer := json.NewSerializerWithOptions(json.DefaultMetaFactory, nil, nil,
 		json.SerializerOptions{Yaml: true, Pretty: true, Strict: true})
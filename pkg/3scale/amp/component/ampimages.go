@@ -223,7 +223,8 @@ func (ampImages *AmpImages) DeploymentsServiceAccount() *v1.ServiceAccount {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "amp",
+			Name:        ampImages.Options.ServiceAccountName,
+			Annotations: ampImages.Options.ServiceAccountAnnotations,
 		},
 		ImagePullSecrets: ampImages.Options.ImagePullSecrets,
 	}
@@ -17,6 +17,8 @@ type AmpImagesOptions struct {
 	SystemMemcachedImage        string `validate:"required"`
 	InsecureImportPolicy        bool
 	ImagePullSecrets            []v1.LocalObjectReference `validate:"required"`
+	ServiceAccountName          string                    `validate:"required"`
+	ServiceAccountAnnotations   map[string]string         `validate:"-"`
 }
 
 func NewAmpImagesOptions() *AmpImagesOptions {
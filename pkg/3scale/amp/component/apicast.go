@@ -11,6 +11,8 @@ import (
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	appsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,7 +34,23 @@ const (
 	HTTPSCertificatesMountPath  = "/var/run/secrets/tls"
 	HTTPSCertificatesVolumeName = "https-certificates"
 
+	ClientTLSCASecretKey              = "ca.crt"
+	ClientTLSCAMountPath              = "/var/run/secrets/client-tls-ca"
+	ClientTLSCAVolumeName             = "client-tls-ca"
+	ClientTLSCAHashAnnotation         = "apps.3scale.net/client-tls-ca-hash"
+	ClientTLSEnvironmentConfigMapName = "apicast-production-client-tls"
+	ClientTLSEnvironmentConfigMapKey  = "client-tls.lua"
+	ClientTLSEnvironmentMountPath     = "/opt/app-root/src/client-tls"
+	ClientTLSEnvironmentVolumeName    = "client-tls-environment"
+
 	APIcastEnvironmentConfigMapName = "apicast-environment"
+
+	// ApicastPortalEndpointSecretKey is the key of the remote admin portal
+	// endpoint in the gateway only portal endpoint secret
+	ApicastPortalEndpointSecretKey = "AdminPortalURL"
+	// ApicastPortalEndpointHashAnnotation rolls out the gateways when the remote
+	// admin portal endpoint changes, as the env var is only read on startup
+	ApicastPortalEndpointHashAnnotation = "apps.3scale.net/portal-endpoint-hash"
 )
 
 const (
@@ -58,8 +76,9 @@ func (apicast *Apicast) StagingService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ApicastStagingName,
-			Labels: apicast.Options.CommonStagingLabels,
+			Name:        ApicastStagingName,
+			Labels:      helper.MergeMapsStringString(apicast.Options.StagingCustomLabels, apicast.Options.CommonStagingLabels),
+			Annotations: apicastServiceAnnotations(apicast.Options.StagingCustomAnnotations, apicast.Options.StagingLoadBalancer),
 		},
 		Spec: v1.ServiceSpec{
 			Ports:    apicast.stagingServicePorts(),
@@ -75,8 +94,9 @@ func (apicast *Apicast) ProductionService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ApicastProductionName,
-			Labels: apicast.Options.CommonProductionLabels,
+			Name:        ApicastProductionName,
+			Labels:      helper.MergeMapsStringString(apicast.Options.ProductionCustomLabels, apicast.Options.CommonProductionLabels),
+			Annotations: apicastServiceAnnotations(apicast.Options.ProductionCustomAnnotations, apicast.Options.ProductionLoadBalancer),
 		},
 		Spec: v1.ServiceSpec{
 			Ports:    apicast.productionServicePorts(),
@@ -85,8 +105,46 @@ func (apicast *Apicast) ProductionService() *v1.Service {
 	}
 }
 
+// StagingRoute exposes the staging gateway in gateway only mode.
+// Otherwise, the gateway routes are created by zync
+func (apicast *Apicast) StagingRoute() *routev1.Route {
+	return apicast.gatewayRoute(ApicastStagingName, apicast.Options.CommonStagingLabels)
+}
+
+// ProductionRoute exposes the production gateway in gateway only mode.
+// Otherwise, the gateway routes are created by zync
+func (apicast *Apicast) ProductionRoute() *routev1.Route {
+	return apicast.gatewayRoute(ApicastProductionName, apicast.Options.CommonProductionLabels)
+}
+
+func (apicast *Apicast) gatewayRoute(name string, labels map[string]string) *routev1.Route {
+	return &routev1.Route{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Route",
+			APIVersion: "route.openshift.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: routev1.RouteSpec{
+			Host: "api-" + apicast.Options.GatewayOnly.TenantName + "-" + name + "." + apicast.Options.GatewayOnly.WildcardDomain,
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: name,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromString("gateway"),
+			},
+			TLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationEdge,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyAllow},
+		},
+	}
+}
+
 func (apicast *Apicast) StagingDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{APIVersion: "apps.openshift.io/v1", Kind: "DeploymentConfig"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        ApicastStagingName,
@@ -135,7 +193,7 @@ func (apicast *Apicast) StagingDeploymentConfig() *appsv1.DeploymentConfig {
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      apicast.Options.StagingPodTemplateLabels,
-					Annotations: apicast.podAnnotations(),
+					Annotations: apicast.podAnnotations(apicast.Options.StagingCustomAnnotations),
 				},
 				Spec: v1.PodSpec{
 					Affinity:           apicast.Options.StagingAffinity,
@@ -160,25 +218,28 @@ func (apicast *Apicast) StagingDeploymentConfig() *appsv1.DeploymentConfig {
 								TimeoutSeconds:      5,
 								PeriodSeconds:       10,
 							},
-							ReadinessProbe: &v1.Probe{
-								Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{
-									Path: "/status/ready",
-									Port: intstr.FromInt(8090),
-								}},
-								InitialDelaySeconds: 15,
-								TimeoutSeconds:      5,
-								PeriodSeconds:       30,
-							},
+							ReadinessProbe: apicastReadinessProbe(apicast.Options.StagingWarmup, apicast.Options.StagingHTTPSPort),
 						},
 					},
 				},
 			},
 		},
 	}
+
+	applyProbesOptions(dc.Spec.Template, apicast.Options.StagingProbes)
+	applyExtraEnv(dc.Spec.Template, apicast.Options.StagingExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, apicast.Options.StagingTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, apicast.Options.StagingPodTemplateOverrides)
+	applyAPIcastLoadBalancer(dc.Spec.Template, apicast.Options.StagingLoadBalancer)
+	applySecurityContextOptions(dc.Spec.Template, apicast.Options.StagingSecurityContext)
+	applyInitContainers(dc, apicast.Options.StagingInitContainers)
+	applySidecars(dc.Spec.Template, apicast.Options.StagingSidecars)
+
+	return dc
 }
 
 func (apicast *Apicast) ProductionDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{APIVersion: "apps.openshift.io/v1", Kind: "DeploymentConfig"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        ApicastProductionName,
@@ -228,7 +289,7 @@ func (apicast *Apicast) ProductionDeploymentConfig() *appsv1.DeploymentConfig {
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      apicast.Options.ProductionPodTemplateLabels,
-					Annotations: apicast.podAnnotations(),
+					Annotations: apicast.productionPodAnnotations(),
 				},
 				Spec: v1.PodSpec{
 					Affinity:           apicast.Options.ProductionAffinity,
@@ -266,31 +327,48 @@ func (apicast *Apicast) ProductionDeploymentConfig() *appsv1.DeploymentConfig {
 								TimeoutSeconds:      5,
 								PeriodSeconds:       10,
 							},
-							ReadinessProbe: &v1.Probe{
-								Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{
-									Path: "/status/ready",
-									Port: intstr.FromInt(8090),
-								}},
-								InitialDelaySeconds: 15,
-								TimeoutSeconds:      5,
-								PeriodSeconds:       30,
-							},
+							ReadinessProbe: apicastReadinessProbe(apicast.Options.ProductionWarmup, apicast.Options.ProductionHTTPSPort),
 						},
 					},
 				},
 			},
 		},
 	}
+
+	applyProbesOptions(dc.Spec.Template, apicast.Options.ProductionProbes)
+	applyExtraEnv(dc.Spec.Template, apicast.Options.ProductionExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, apicast.Options.ProductionTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, apicast.Options.ProductionPodTemplateOverrides)
+	applyAPIcastLoadBalancer(dc.Spec.Template, apicast.Options.ProductionLoadBalancer)
+	applySecurityContextOptions(dc.Spec.Template, apicast.Options.ProductionSecurityContext)
+	applyInitContainers(dc, apicast.Options.ProductionInitContainers)
+	applySidecars(dc.Spec.Template, apicast.Options.ProductionSidecars)
+
+	return dc
 }
 
 func (apicast *Apicast) buildApicastCommonEnv() []v1.EnvVar {
-	result := []v1.EnvVar{
-		helper.EnvVarFromSecret("THREESCALE_PORTAL_ENDPOINT", "system-master-apicast", SystemSecretSystemMasterApicastProxyConfigsEndpointFieldName),
-		helper.EnvVarFromSecret("BACKEND_ENDPOINT_OVERRIDE", BackendSecretBackendListenerSecretName, BackendSecretBackendListenerServiceEndpointFieldName),
+	var result []v1.EnvVar
+	if apicast.Options.GatewayOnly != nil {
+		// The backend endpoint is read from the remote configuration
+		result = append(result, helper.EnvVarFromSecret("THREESCALE_PORTAL_ENDPOINT", apicast.Options.GatewayOnly.PortalEndpointSecretName, ApicastPortalEndpointSecretKey))
+	} else if apicast.Options.ExternalBackendEndpoint != nil {
+		result = append(result,
+			helper.EnvVarFromSecret("THREESCALE_PORTAL_ENDPOINT", "system-master-apicast", SystemSecretSystemMasterApicastProxyConfigsEndpointFieldName),
+			helper.EnvVarFromValue("BACKEND_ENDPOINT_OVERRIDE", *apicast.Options.ExternalBackendEndpoint),
+		)
+	} else {
+		result = append(result,
+			helper.EnvVarFromSecret("THREESCALE_PORTAL_ENDPOINT", "system-master-apicast", SystemSecretSystemMasterApicastProxyConfigsEndpointFieldName),
+			helper.EnvVarFromSecret("BACKEND_ENDPOINT_OVERRIDE", BackendSecretBackendListenerSecretName, BackendSecretBackendListenerServiceEndpointFieldName),
+		)
+	}
+
+	result = append(result,
 		helper.EnvVarFromConfigMap("APICAST_MANAGEMENT_API", APIcastEnvironmentConfigMapName, "APICAST_MANAGEMENT_API"),
 		helper.EnvVarFromConfigMap("OPENSSL_VERIFY", APIcastEnvironmentConfigMapName, "OPENSSL_VERIFY"),
 		helper.EnvVarFromConfigMap("APICAST_RESPONSE_CODES", APIcastEnvironmentConfigMapName, "APICAST_RESPONSE_CODES"),
-	}
+	)
 
 	if apicast.Options.ExtendedMetrics {
 		result = append(result, helper.EnvVarFromValue("APICAST_EXTENDED_METRICS", "true"))
@@ -400,6 +478,10 @@ func (apicast *Apicast) buildApicastProductionEnv() []v1.EnvVar {
 		}
 	}
 
+	if apicast.Options.ProductionClientTLSCASecretName != nil {
+		customEnvPaths = append(customEnvPaths, path.Join(ClientTLSEnvironmentMountPath, ClientTLSEnvironmentConfigMapKey))
+	}
+
 	if len(customEnvPaths) > 0 {
 		// Sort customenvPaths to ensure deterministic reconciliation
 		sort.Strings(customEnvPaths)
@@ -458,42 +540,55 @@ func (apicast *Apicast) EnvironmentConfigMap() *v1.ConfigMap {
 	}
 }
 
-func (apicast *Apicast) StagingPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
+// ProductionClientTLSConfigMap holds the APIcast environment file
+// adding the TLS validation policy, with the CA bundle as whitelist
+func (apicast *Apicast) ProductionClientTLSConfigMap() *v1.ConfigMap {
+	return &v1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ApicastStagingName,
-			Labels: apicast.Options.CommonStagingLabels,
+			Name:   ClientTLSEnvironmentConfigMapName,
+			Labels: apicast.Options.CommonProductionLabels,
 		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": ApicastStagingName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
+		Data: map[string]string{
+			ClientTLSEnvironmentConfigMapKey: fmt.Sprintf(clientTLSEnvironmentTemplate, path.Join(ClientTLSCAMountPath, ClientTLSCASecretKey)),
 		},
 	}
 }
 
+const clientTLSEnvironmentTemplate = `local PolicyChain = require('apicast.policy_chain')
+local policy_chain = context.policy_chain
+
+local file = assert(io.open('%s'))
+local bundle = file:read('*a')
+file:close()
+
+local whitelist = {}
+for certificate in bundle:gmatch('%%-%%-%%-%%-%%-BEGIN CERTIFICATE%%-%%-%%-%%-%%-.-%%-%%-%%-%%-%%-END CERTIFICATE%%-%%-%%-%%-%%-') do
+  table.insert(whitelist, { pem_certificate = certificate })
+end
+
+if not arg then -- {arg} is defined only when executed as standalone script
+  policy_chain:insert(PolicyChain.load_policy('tls_validation', 'builtin', { whitelist = whitelist }), 1)
+end
+
+return {
+  policy_chain = policy_chain
+}
+`
+
+func (apicast *Apicast) StagingPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
+	return podDisruptionBudget(ApicastStagingName, apicast.Options.CommonStagingLabels, apicast.Options.StagingPodDisruptionBudget)
+}
+
 func (apicast *Apicast) ProductionPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   ApicastProductionName,
-			Labels: apicast.Options.CommonProductionLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": ApicastProductionName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(ApicastProductionName, apicast.Options.CommonProductionLabels, apicast.Options.ProductionPodDisruptionBudget)
+}
+
+func (apicast *Apicast) ProductionHorizontalPodAutoscaler() *autoscalingv2beta2.HorizontalPodAutoscaler {
+	return horizontalPodAutoscaler(ApicastProductionName, apicast.Options.CommonProductionLabels, apicast.Options.ProductionHPA)
 }
 
 func (apicast *Apicast) productionVolumeMounts() []v1.VolumeMount {
@@ -530,6 +625,21 @@ func (apicast *Apicast) productionVolumeMounts() []v1.VolumeMount {
 		})
 	}
 
+	if apicast.Options.ProductionClientTLSCASecretName != nil {
+		volumeMounts = append(volumeMounts,
+			v1.VolumeMount{
+				Name:      ClientTLSCAVolumeName,
+				MountPath: ClientTLSCAMountPath,
+				ReadOnly:  true,
+			},
+			v1.VolumeMount{
+				Name:      ClientTLSEnvironmentVolumeName,
+				MountPath: ClientTLSEnvironmentMountPath,
+				ReadOnly:  true,
+			},
+		)
+	}
+
 	return volumeMounts
 }
 
@@ -639,6 +749,35 @@ func (apicast *Apicast) productionVolumes() []v1.Volume {
 		})
 	}
 
+	if apicast.Options.ProductionClientTLSCASecretName != nil {
+		volumes = append(volumes,
+			v1.Volume{
+				Name: ClientTLSCAVolumeName,
+				VolumeSource: v1.VolumeSource{
+					Secret: &v1.SecretVolumeSource{
+						SecretName: *apicast.Options.ProductionClientTLSCASecretName,
+						Items: []v1.KeyToPath{
+							v1.KeyToPath{
+								Key:  ClientTLSCASecretKey,
+								Path: ClientTLSCASecretKey,
+							},
+						},
+					},
+				},
+			},
+			v1.Volume{
+				Name: ClientTLSEnvironmentVolumeName,
+				VolumeSource: v1.VolumeSource{
+					ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: v1.LocalObjectReference{
+							Name: ClientTLSEnvironmentConfigMapName,
+						},
+					},
+				},
+			},
+		)
+	}
+
 	return volumes
 }
 
@@ -699,7 +838,7 @@ func (apicast *Apicast) stagingVolumes() []v1.Volume {
 }
 
 func (apicast *Apicast) productionDeploymentConfigAnnotations() map[string]string {
-	annotations := map[string]string{}
+	annotations := helper.MergeMapsStringString(apicast.Options.ProductionCustomAnnotations)
 
 	for _, customPolicy := range apicast.Options.ProductionCustomPolicies {
 		annotations[customPolicy.AnnotationKey()] = customPolicy.AnnotationValue()
@@ -723,7 +862,7 @@ func (apicast *Apicast) productionDeploymentConfigAnnotations() map[string]strin
 }
 
 func (apicast *Apicast) stagingDeploymentConfigAnnotations() map[string]string {
-	annotations := map[string]string{}
+	annotations := helper.MergeMapsStringString(apicast.Options.StagingCustomAnnotations)
 
 	for _, customPolicy := range apicast.Options.StagingCustomPolicies {
 		annotations[customPolicy.AnnotationKey()] = customPolicy.AnnotationValue()
@@ -806,16 +945,33 @@ func (apicast *Apicast) stagingServicePorts() []v1.ServicePort {
 	return ports
 }
 
-func (apicast *Apicast) podAnnotations() map[string]string {
-	annotations := map[string]string{
+func (apicast *Apicast) productionPodAnnotations() map[string]string {
+	annotations := apicast.podAnnotations(apicast.Options.ProductionCustomAnnotations)
+
+	// The CA bundle is only read on startup, rollout when it changes
+	if apicast.Options.ProductionClientTLSCASecretName != nil {
+		annotations[ClientTLSCAHashAnnotation] = apicast.Options.ProductionClientTLSCAHash
+	}
+
+	return annotations
+}
+
+// podAnnotations returns the pod annotations. The annotations set
+// by the operator override the custom annotations
+func (apicast *Apicast) podAnnotations(customAnnotations map[string]string) map[string]string {
+	annotations := helper.MergeMapsStringString(customAnnotations, map[string]string{
 		"prometheus.io/scrape": "true",
 		"prometheus.io/port":   "9421",
-	}
+	})
 
 	for key, val := range apicast.Options.AdditionalPodAnnotations {
 		annotations[key] = val
 	}
 
+	if apicast.Options.GatewayOnly != nil {
+		annotations[ApicastPortalEndpointHashAnnotation] = apicast.Options.GatewayOnly.PortalEndpointHash
+	}
+
 	return annotations
 }
 
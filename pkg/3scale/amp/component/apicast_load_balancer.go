@@ -0,0 +1,98 @@
+package component
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// APIcastDefaultTerminationGracePeriodSeconds is the termination grace period of the pods when not set
+	APIcastDefaultTerminationGracePeriodSeconds int64 = 30
+
+	APIcastAWSLoadBalancerTargetTypeIP       = "ip"
+	APIcastAWSLoadBalancerTargetTypeInstance = "instance"
+
+	// Service annotations read by the AWS load balancer controller
+	APIcastAWSLoadBalancerTargetTypeAnnotation            = "service.beta.kubernetes.io/aws-load-balancer-nlb-target-type"
+	APIcastAWSLoadBalancerTargetGroupAttributesAnnotation = "service.beta.kubernetes.io/aws-load-balancer-target-group-attributes"
+
+	// APIcastAWSTargetHealthReadinessGatePrefix is the prefix of the pod readiness gate
+	// set by the AWS load balancer controller once the pod is healthy in the target group
+	// of the TargetGroupBinding named after the prefix
+	APIcastAWSTargetHealthReadinessGatePrefix = "target-health.elbv2.k8s.aws/"
+)
+
+// APIcastLoadBalancerServiceAnnotations are the service annotations managed
+// by the operator for the external load balancers
+var APIcastLoadBalancerServiceAnnotations = []string{
+	APIcastAWSLoadBalancerTargetTypeAnnotation,
+	APIcastAWSLoadBalancerTargetGroupAttributesAnnotation,
+}
+
+// APIcastLoadBalancer coordinates the termination of the gateway pods with
+// the external load balancers, which lag behind the pod readiness
+type APIcastLoadBalancer struct {
+	// DeregistrationDelaySeconds the termination of the pods is delayed, so
+	// the load balancers deregister them while the gateway still serves traffic
+	DeregistrationDelaySeconds int64
+	ReadinessGates             []v1.PodReadinessGate
+	AWS                        *APIcastAWSLoadBalancer
+}
+
+// APIcastAWSLoadBalancer configures the service for the AWS load balancer controller
+type APIcastAWSLoadBalancer struct {
+	TargetType             string
+	TargetGroupBindingName string
+}
+
+// applyAPIcastLoadBalancer adds the readiness gates and the deregistration
+// preStop delay to the gateway pod template
+func applyAPIcastLoadBalancer(template *v1.PodTemplateSpec, lb *APIcastLoadBalancer) {
+	if lb == nil {
+		return
+	}
+
+	template.Spec.ReadinessGates = append(template.Spec.ReadinessGates, lb.ReadinessGates...)
+	if lb.AWS != nil && lb.AWS.TargetGroupBindingName != "" {
+		template.Spec.ReadinessGates = append(template.Spec.ReadinessGates, v1.PodReadinessGate{
+			ConditionType: v1.PodConditionType(APIcastAWSTargetHealthReadinessGatePrefix + lb.AWS.TargetGroupBindingName),
+		})
+	}
+
+	if lb.DeregistrationDelaySeconds > 0 {
+		// The gateway keeps serving traffic during the delay
+		template.Spec.Containers[0].Lifecycle = &v1.Lifecycle{
+			PreStop: &v1.Handler{Exec: &v1.ExecAction{
+				Command: []string{"sleep", strconv.FormatInt(lb.DeregistrationDelaySeconds, 10)},
+			}},
+		}
+		// The delay is part of the grace period
+		gracePeriod := APIcastDefaultTerminationGracePeriodSeconds
+		if template.Spec.TerminationGracePeriodSeconds != nil {
+			gracePeriod = *template.Spec.TerminationGracePeriodSeconds
+		}
+		gracePeriod += lb.DeregistrationDelaySeconds
+		template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	}
+}
+
+// apicastServiceAnnotations returns the service annotations, adding the
+// load balancer annotations to the custom annotations
+func apicastServiceAnnotations(customAnnotations map[string]string, lb *APIcastLoadBalancer) map[string]string {
+	if lb == nil || lb.AWS == nil {
+		return customAnnotations
+	}
+
+	// The custom annotations map is shared with the pods
+	annotations := map[string]string{}
+	for key, val := range customAnnotations {
+		annotations[key] = val
+	}
+	annotations[APIcastAWSLoadBalancerTargetTypeAnnotation] = lb.AWS.TargetType
+	if lb.DeregistrationDelaySeconds > 0 {
+		annotations[APIcastAWSLoadBalancerTargetGroupAttributesAnnotation] = fmt.Sprintf("deregistration_delay.timeout_seconds=%d", lb.DeregistrationDelaySeconds)
+	}
+	return annotations
+}
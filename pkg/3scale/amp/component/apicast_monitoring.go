@@ -8,9 +8,6 @@ import (
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
-
-	"github.com/3scale/3scale-operator/pkg/assets"
-	"github.com/3scale/3scale-operator/pkg/common"
 )
 
 func (apicast *Apicast) ApicastProductionPodMonitor() *monitoringv1.PodMonitor {
@@ -52,40 +49,20 @@ func (apicast *Apicast) ApicastStagingPodMonitor() *monitoringv1.PodMonitor {
 }
 
 func (apicast *Apicast) ApicastMainAppGrafanaDashboard(sumRate string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		apicast.Options.Namespace, sumRate,
-	}
-
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "apicast-mainapp",
-			Labels: apicast.monitoringLabels(),
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/apicast-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/apicast-grafana-dashboard-1.json", apicast.Options.Namespace),
-		},
+	data := &grafanaDashboardTemplateData{
+		Namespace: apicast.Options.Namespace,
+		SumRate:   sumRate,
+		SLO:       apicast.Options.SLO,
 	}
+	return grafanaDashboard("apicast-mainapp", "apicast-grafana-dashboard-1.json", apicast.Options.CommonLabels, apicast.Options.GrafanaDashboard, data)
 }
 
 func (apicast *Apicast) ApicastServicesGrafanaDashboard(sumRate string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		apicast.Options.Namespace, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "apicast-services",
-			Labels: apicast.monitoringLabels(),
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/apicast-grafana-dashboard-2.json.tpl", data),
-			Name: fmt.Sprintf("%s/apicast-grafana-dashboard-2.json", apicast.Options.Namespace),
-		},
+	data := &grafanaDashboardTemplateData{
+		Namespace: apicast.Options.Namespace,
+		SumRate:   sumRate,
 	}
+	return grafanaDashboard("apicast-services", "apicast-grafana-dashboard-2.json", apicast.Options.CommonLabels, apicast.Options.GrafanaDashboard, data)
 }
 
 func (apicast *Apicast) ApicastPrometheusRules() *monitoringv1.PrometheusRule {
@@ -175,15 +152,19 @@ func (apicast *Apicast) ApicastPrometheusRules() *monitoringv1.PrometheusRule {
 	}
 }
 
-func (apicast *Apicast) monitoringLabels() map[string]string {
-	labels := make(map[string]string)
-
-	for key, value := range apicast.Options.CommonLabels {
-		labels[key] = value
+// ApicastSLOPrometheusRules returns the availability and latency recording rules
+// of apicast production, and the error budget burn rate alerts
+func (apicast *Apicast) ApicastSLOPrometheusRules() *monitoringv1.PrometheusRule {
+	gateway := &sloGateway{
+		name:                 "apicast_production",
+		alertPrefix:          "ThreescaleApicast",
+		description:          "apicast-production",
+		selector:             fmt.Sprintf(`namespace="%s",pod=~"apicast-production.*"`, apicast.Options.Namespace),
+		responsesMetric:      "apicast_status",
+		errorsSelector:       `status=~"5.."`,
+		durationBucketMetric: "total_response_time_seconds_bucket",
 	}
-
-	labels["monitoring-key"] = common.MonitoringKey
-	return labels
+	return sloPrometheusRules("apicast-slo", apicast.Options.Namespace, apicast.prometheusRulesMonitoringLabels(), gateway, apicast.Options.SLO)
 }
 
 func (apicast *Apicast) prometheusRulesMonitoringLabels() map[string]string {
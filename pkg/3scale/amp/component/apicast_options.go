@@ -73,12 +73,61 @@ type ApicastOptions struct {
 	CommonProductionLabels         map[string]string `validate:"required"`
 	StagingPodTemplateLabels       map[string]string `validate:"required"`
 	ProductionPodTemplateLabels    map[string]string `validate:"required"`
+	StagingCustomLabels            map[string]string `validate:"-"`
+	StagingCustomAnnotations       map[string]string `validate:"-"`
+	ProductionCustomLabels         map[string]string `validate:"-"`
+	ProductionCustomAnnotations    map[string]string `validate:"-"`
 	ProductionAffinity             *v1.Affinity      `validate:"-"`
 	ProductionTolerations          []v1.Toleration   `validate:"-"`
 	StagingAffinity                *v1.Affinity      `validate:"-"`
 	StagingTolerations             []v1.Toleration   `validate:"-"`
 	ProductionWorkers              *int32            `validate:"-"`
 
+	// Pod template settings overridden in the APIManager
+	StagingPodTemplateOverrides    PodTemplateOverridesOptions `validate:"-"`
+	ProductionPodTemplateOverrides PodTemplateOverridesOptions `validate:"-"`
+
+	// Security contexts of the pods. Not set by default
+	ProductionSecurityContext *SecurityContextOptions `validate:"-"`
+	StagingSecurityContext    *SecurityContextOptions `validate:"-"`
+
+	// Replicas not managed by the operator are only set on creation
+	ProductionReplicasManaged bool
+	StagingReplicasManaged    bool
+
+	// Autoscaling of the production pods. Replicas are not managed by the operator when set
+	ProductionHPA *HorizontalPodAutoscalerOptions `validate:"omitempty"`
+
+	// Disruption policies of the pods. The default policy is used when not set
+	ProductionPodDisruptionBudget *PodDisruptionBudgetOptions `validate:"-"`
+	StagingPodDisruptionBudget    *PodDisruptionBudgetOptions `validate:"-"`
+
+	// Probes tuning of the containers. The default probes are used when not set
+	ProductionProbes *ProbesOptions `validate:"omitempty"`
+	StagingProbes    *ProbesOptions `validate:"omitempty"`
+
+	// Extra env vars appended to the operator managed env vars of the containers
+	ProductionExtraEnv []v1.EnvVar `validate:"-"`
+	StagingExtraEnv    []v1.EnvVar `validate:"-"`
+
+	// Sidecar containers and volumes appended to the pods
+	ProductionSidecars *SidecarsOptions `validate:"-"`
+	StagingSidecars    *SidecarsOptions `validate:"-"`
+
+	// Init containers customized and appended to the pods
+	ProductionInitContainers *InitContainersOptions `validate:"-"`
+	StagingInitContainers    *InitContainersOptions `validate:"-"`
+
+	// Termination of the pods. The default grace period and no preStop hook are used when not set
+	ProductionTermination *TerminationOptions `validate:"omitempty"`
+	StagingTermination    *TerminationOptions `validate:"omitempty"`
+
+	// Recording rules of apicast production. Nil when the recording rules are disabled
+	SLO *SLOOptions `validate:"omitempty"`
+
+	// Datasource and selector labels of the grafana dashboards. The defaults are used when not set
+	GrafanaDashboard *GrafanaDashboardOptions `validate:"omitempty"`
+
 	// Used for monitoring objects
 	// Those objects are namespaced. However, objects includes labels, rules and expressions
 	// that need namespace filtering because they are "global" once imported
@@ -104,6 +153,16 @@ type ApicastOptions struct {
 	StagingHTTPSVerifyDepth              *int64  `validate:"-"`
 	StagingHTTPSCertificateSecretName    *string `validate:"-"`
 
+	ProductionClientTLSCASecretName *string `validate:"-"`
+	ProductionClientTLSCAHash       string  `validate:"-"`
+
+	ProductionWarmup *APIcastWarmup `validate:"-"`
+	StagingWarmup    *APIcastWarmup `validate:"-"`
+
+	// External load balancers coordination. Nil when not configured
+	ProductionLoadBalancer *APIcastLoadBalancer `validate:"-"`
+	StagingLoadBalancer    *APIcastLoadBalancer `validate:"-"`
+
 	ProductionAllProxy   *string
 	ProductionHTTPProxy  *string
 	ProductionHTTPSProxy *string
@@ -114,6 +173,26 @@ type ApicastOptions struct {
 	StagingNoProxy       *string
 
 	AdditionalPodAnnotations map[string]string `validate:"required"`
+
+	// Remote control plane the gateways are connected to. The control plane
+	// deployed by the operator is used when nil
+	GatewayOnly *ApicastGatewayOnlyOptions `validate:"omitempty"`
+
+	// Listener endpoint of the external backend the gateways report to.
+	// The backend deployed by the operator is used when nil
+	ExternalBackendEndpoint *string `validate:"-"`
+}
+
+// ApicastGatewayOnlyOptions configures the gateways connected to
+// a control plane managed outside of the APIManager
+type ApicastGatewayOnlyOptions struct {
+	// Secret containing the remote admin portal endpoint
+	PortalEndpointSecretName string `validate:"required"`
+	PortalEndpointHash       string `validate:"required"`
+
+	// Used for the hosts of the gateway routes, created by zync otherwise
+	TenantName     string `validate:"required"`
+	WildcardDomain string `validate:"required"`
 }
 
 func NewApicastOptions() *ApicastOptions {
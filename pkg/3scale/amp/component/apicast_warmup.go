@@ -0,0 +1,84 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	APIcastWarmupDefaultTimeoutSeconds int32 = 5
+	// APIcastWarmupDoneFile is created once all the warm-up requests got a response,
+	// so they are not sent again on the next readiness probes
+	APIcastWarmupDoneFile = "/tmp/apicast-warmup-done"
+
+	apicastReadinessProbeTimeoutSeconds int32 = 5
+)
+
+// APIcastWarmupTarget is a request sent to the gateway before the pod is ready
+type APIcastWarmupTarget struct {
+	Host string
+	Path string
+}
+
+type APIcastWarmup struct {
+	Targets        []APIcastWarmupTarget
+	TimeoutSeconds int32
+}
+
+// apicastReadinessProbe returns the readiness probe of the gateway container.
+// With warm-up, the probe runs the warm-up requests once the gateway is ready.
+// Any failure fails the probe, so the requests are retried on the next one
+// and the container is never restarted because of them.
+func apicastReadinessProbe(warmup *APIcastWarmup, httpsPort *int32) *v1.Probe {
+	probe := &v1.Probe{
+		Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{
+			Path: "/status/ready",
+			Port: intstr.FromInt(8090),
+		}},
+		InitialDelaySeconds: 15,
+		TimeoutSeconds:      apicastReadinessProbeTimeoutSeconds,
+		PeriodSeconds:       30,
+	}
+
+	if warmup == nil || len(warmup.Targets) == 0 {
+		return probe
+	}
+
+	probe.Handler = v1.Handler{Exec: &v1.ExecAction{
+		Command: []string{"sh", "-c", apicastWarmupScript(warmup, httpsPort)},
+	}}
+	// Bounded by the timeout of every request
+	probe.TimeoutSeconds = apicastReadinessProbeTimeoutSeconds + warmup.TimeoutSeconds*int32(len(warmup.Targets))
+	// Probe more often while warming up
+	probe.PeriodSeconds = 10
+
+	return probe
+}
+
+func apicastWarmupScript(warmup *APIcastWarmup, httpsPort *int32) string {
+	// Gateway port serves HTTPS when the HTTPS port clashes with it
+	gatewayURL := "http://127.0.0.1:8080"
+	curlFlags := ""
+	if httpsPort != nil && *httpsPort == 8080 {
+		gatewayURL = "https://127.0.0.1:8080"
+		curlFlags = " --insecure"
+	}
+
+	commands := []string{
+		fmt.Sprintf("curl --fail --silent --output /dev/null --max-time %d http://127.0.0.1:8090/status/ready || exit 1", apicastReadinessProbeTimeoutSeconds),
+		fmt.Sprintf("test -f %s && exit 0", APIcastWarmupDoneFile),
+	}
+
+	// Any HTTP response is valid, the gateway configuration of the host has been loaded
+	for _, target := range warmup.Targets {
+		commands = append(commands, fmt.Sprintf("curl --silent --output /dev/null%s --max-time %d --header 'Host: %s' '%s%s' || exit 1",
+			curlFlags, warmup.TimeoutSeconds, target.Host, gatewayURL, target.Path))
+	}
+
+	commands = append(commands, fmt.Sprintf("touch %s", APIcastWarmupDoneFile))
+
+	return strings.Join(commands, "; ")
+}
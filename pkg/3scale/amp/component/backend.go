@@ -28,6 +28,10 @@ const (
 	BackendSecretBackendRedisStorageSentinelRoleFieldName  = "REDIS_STORAGE_SENTINEL_ROLE"
 	BackendSecretBackendRedisQueuesSentinelHostsFieldName  = "REDIS_QUEUES_SENTINEL_HOSTS"
 	BackendSecretBackendRedisQueuesSentinelRoleFieldName   = "REDIS_QUEUES_SENTINEL_ROLE"
+	BackendSecretBackendRedisStorageUsernameFieldName      = "REDIS_STORAGE_USERNAME"
+	BackendSecretBackendRedisStoragePasswordFieldName      = "REDIS_STORAGE_PASSWORD"
+	BackendSecretBackendRedisQueuesUsernameFieldName       = "REDIS_QUEUES_USERNAME"
+	BackendSecretBackendRedisQueuesPasswordFieldName       = "REDIS_QUEUES_PASSWORD"
 )
 
 const (
@@ -45,6 +49,11 @@ const (
 const (
 	BackendWorkerMetricsPort   = 9421
 	BackendListenerMetricsPort = 9394
+
+	BackendWorkerMetricsPortEnvVarName      = "CONFIG_WORKER_PROMETHEUS_METRICS_PORT"
+	BackendWorkerMetricsEnabledEnvVarName   = "CONFIG_WORKER_PROMETHEUS_METRICS_ENABLED"
+	BackendListenerMetricsPortEnvVarName    = "CONFIG_LISTENER_PROMETHEUS_METRICS_PORT"
+	BackendListenerMetricsEnabledEnvVarName = "CONFIG_LISTENER_PROMETHEUS_METRICS_ENABLED"
 )
 
 var (
@@ -61,14 +70,15 @@ func NewBackend(options *BackendOptions) *Backend {
 }
 
 func (backend *Backend) WorkerDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendWorkerName,
-			Labels: backend.Options.CommonWorkerLabels,
+			Name:        BackendWorkerName,
+			Labels:      backend.Options.CommonWorkerLabels,
+			Annotations: backend.Options.WorkerCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Strategy: appsv1.DeploymentStrategy{
@@ -102,7 +112,8 @@ func (backend *Backend) WorkerDeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": BackendWorkerName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: backend.Options.WorkerPodTemplateLabels,
+					Labels:      backend.Options.WorkerPodTemplateLabels,
+					Annotations: backend.Options.WorkerCustomAnnotations,
 				},
 				Spec: v1.PodSpec{
 					Affinity:    backend.Options.WorkerAffinity,
@@ -133,17 +144,28 @@ func (backend *Backend) WorkerDeploymentConfig() *appsv1.DeploymentConfig {
 					ServiceAccountName: "amp"}},
 		},
 	}
+
+	applyRedisCA(dc.Spec.Template, backend.Options.RedisCASecretName, BackendRedisCAVolumeName)
+	applyExtraEnv(dc.Spec.Template, backend.Options.WorkerExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, backend.Options.WorkerTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, backend.Options.WorkerPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, backend.Options.WorkerSecurityContext)
+	applyInitContainers(dc, backend.Options.WorkerInitContainers)
+	applySidecars(dc.Spec.Template, backend.Options.WorkerSidecars)
+
+	return dc
 }
 
 func (backend *Backend) CronDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendCronName,
-			Labels: backend.Options.CommonCronLabels,
+			Name:        BackendCronName,
+			Labels:      backend.Options.CommonCronLabels,
+			Annotations: backend.Options.CronCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Strategy: appsv1.DeploymentStrategy{
@@ -177,7 +199,8 @@ func (backend *Backend) CronDeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": BackendCronName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: backend.Options.CronPodTemplateLabels,
+					Labels:      backend.Options.CronPodTemplateLabels,
+					Annotations: backend.Options.CronCustomAnnotations,
 				},
 				Spec: v1.PodSpec{
 					Affinity:    backend.Options.CronAffinity,
@@ -208,17 +231,28 @@ func (backend *Backend) CronDeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	applyRedisCA(dc.Spec.Template, backend.Options.RedisCASecretName, BackendRedisCAVolumeName)
+	applyExtraEnv(dc.Spec.Template, backend.Options.CronExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, backend.Options.CronTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, backend.Options.CronPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, backend.Options.CronSecurityContext)
+	applyInitContainers(dc, backend.Options.CronInitContainers)
+	applySidecars(dc.Spec.Template, backend.Options.CronSidecars)
+
+	return dc
 }
 
 func (backend *Backend) ListenerDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendListenerName,
-			Labels: backend.Options.CommonListenerLabels,
+			Name:        BackendListenerName,
+			Labels:      backend.Options.CommonListenerLabels,
+			Annotations: backend.Options.ListenerCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Strategy: appsv1.DeploymentStrategy{
@@ -252,7 +286,8 @@ func (backend *Backend) ListenerDeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": BackendListenerName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: backend.Options.ListenerPodTemplateLabels,
+					Labels:      backend.Options.ListenerPodTemplateLabels,
+					Annotations: backend.Options.ListenerCustomAnnotations,
 				},
 				Spec: v1.PodSpec{
 					Affinity:    backend.Options.ListenerAffinity,
@@ -297,6 +332,17 @@ func (backend *Backend) ListenerDeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	applyProbesOptions(dc.Spec.Template, backend.Options.ListenerProbes)
+	applyRedisCA(dc.Spec.Template, backend.Options.RedisCASecretName, BackendRedisCAVolumeName)
+	applyExtraEnv(dc.Spec.Template, backend.Options.ListenerExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, backend.Options.ListenerTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, backend.Options.ListenerPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, backend.Options.ListenerSecurityContext)
+	applyInitContainers(dc, backend.Options.ListenerInitContainers)
+	applySidecars(dc.Spec.Template, backend.Options.ListenerSidecars)
+
+	return dc
 }
 
 func (backend *Backend) ListenerService() *v1.Service {
@@ -306,8 +352,9 @@ func (backend *Backend) ListenerService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendListenerName,
-			Labels: backend.Options.CommonListenerLabels,
+			Name:        BackendListenerName,
+			Labels:      helper.MergeMapsStringString(backend.Options.ListenerCustomLabels, backend.Options.CommonListenerLabels),
+			Annotations: backend.Options.ListenerCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
@@ -369,7 +416,7 @@ func (backend *Backend) EnvironmentConfigMap() *v1.ConfigMap {
 }
 
 func (backend *Backend) buildBackendCommonEnv() []v1.EnvVar {
-	return []v1.EnvVar{
+	result := []v1.EnvVar{
 		helper.EnvVarFromSecret("CONFIG_REDIS_PROXY", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageURLFieldName),
 		helper.EnvVarFromSecret("CONFIG_REDIS_SENTINEL_HOSTS", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageSentinelHostsFieldName),
 		helper.EnvVarFromSecret("CONFIG_REDIS_SENTINEL_ROLE", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageSentinelRoleFieldName),
@@ -378,6 +425,23 @@ func (backend *Backend) buildBackendCommonEnv() []v1.EnvVar {
 		helper.EnvVarFromSecret("CONFIG_QUEUES_SENTINEL_ROLE", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisQueuesSentinelRoleFieldName),
 		helper.EnvVarFromConfigMap("RACK_ENV", "backend-environment", "RACK_ENV"),
 	}
+	result = append(result, backend.redisCredentialsEnvVars()...)
+	return result
+}
+
+// redisCredentialsEnvVars returns the ACL credentials and the CA certificate of the
+// backend redis. The credentials are optional, redis is accessed without ACL user
+// or with the password of the URL when they are not set
+func (backend *Backend) redisCredentialsEnvVars() []v1.EnvVar {
+	result := []v1.EnvVar{
+		helper.EnvVarFromSecretOptional(BackendConfigRedisUsernameEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageUsernameFieldName),
+		helper.EnvVarFromSecretOptional(BackendConfigRedisPasswordEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStoragePasswordFieldName),
+		helper.EnvVarFromSecretOptional(BackendConfigQueuesUsernameEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisQueuesUsernameFieldName),
+		helper.EnvVarFromSecretOptional(BackendConfigQueuesPasswordEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisQueuesPasswordFieldName),
+	}
+	result = append(result, redisCAFileEnvVars(backend.Options.RedisCASecretName, BackendRedisCAVolumeName,
+		BackendConfigRedisCAFileEnvVarName, BackendConfigQueuesCAFileEnvVarName)...)
+	return result
 }
 
 func (backend *Backend) buildBackendWorkerEnv() []v1.EnvVar {
@@ -390,10 +454,11 @@ func (backend *Backend) buildBackendWorkerEnv() []v1.EnvVar {
 
 	if backend.Options.WorkerMetrics {
 		result = append(result,
-			v1.EnvVar{Name: "CONFIG_WORKER_PROMETHEUS_METRICS_PORT", Value: BackendWorkerMetricsPortStr},
-			v1.EnvVar{Name: "CONFIG_WORKER_PROMETHEUS_METRICS_ENABLED", Value: "true"},
+			v1.EnvVar{Name: BackendWorkerMetricsPortEnvVarName, Value: BackendWorkerMetricsPortStr},
+			v1.EnvVar{Name: BackendWorkerMetricsEnabledEnvVarName, Value: "true"},
 		)
 	}
+	result = append(result, StatsdEnvVars(backend.Options.Statsd)...)
 
 	return result
 }
@@ -401,6 +466,7 @@ func (backend *Backend) buildBackendWorkerEnv() []v1.EnvVar {
 func (backend *Backend) buildBackendCronEnv() []v1.EnvVar {
 	result := []v1.EnvVar{}
 	result = append(result, backend.buildBackendCommonEnv()...)
+	result = append(result, StatsdEnvVars(backend.Options.Statsd)...)
 	return result
 }
 
@@ -415,10 +481,12 @@ func (backend *Backend) buildBackendListenerEnv() []v1.EnvVar {
 
 	if backend.Options.ListenerMetrics {
 		result = append(result,
-			v1.EnvVar{Name: "CONFIG_LISTENER_PROMETHEUS_METRICS_PORT", Value: BackendListenerMetricsPortStr},
-			v1.EnvVar{Name: "CONFIG_LISTENER_PROMETHEUS_METRICS_ENABLED", Value: "true"},
+			v1.EnvVar{Name: BackendListenerMetricsPortEnvVarName, Value: BackendListenerMetricsPortStr},
+			v1.EnvVar{Name: BackendListenerMetricsEnabledEnvVarName, Value: "true"},
 		)
 	}
+	result = append(result, StatsdEnvVars(backend.Options.Statsd)...)
+	result = append(result, BackendRequestLoggingEnvVars(backend.Options.ListenerRequestLogging)...)
 	return result
 }
 
@@ -459,60 +527,15 @@ func (backend *Backend) ListenerSecret() *v1.Secret {
 }
 
 func (backend *Backend) WorkerPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendWorkerName,
-			Labels: backend.Options.CommonWorkerLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": BackendWorkerName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(BackendWorkerName, backend.Options.CommonWorkerLabels, backend.Options.WorkerPodDisruptionBudget)
 }
 
 func (backend *Backend) CronPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "backend-cron",
-			Labels: backend.Options.CommonCronLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": "backend-cron"},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget("backend-cron", backend.Options.CommonCronLabels, backend.Options.CronPodDisruptionBudget)
 }
 
 func (backend *Backend) ListenerPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   BackendListenerName,
-			Labels: backend.Options.CommonListenerLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": BackendListenerName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(BackendListenerName, backend.Options.CommonListenerLabels, backend.Options.ListenerPodDisruptionBudget)
 }
 
 func (backend *Backend) listenerPorts() []v1.ContainerPort {
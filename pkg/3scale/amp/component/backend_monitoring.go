@@ -3,8 +3,6 @@ package component
 import (
 	"fmt"
 
-	"github.com/3scale/3scale-operator/pkg/assets"
-	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/coreos/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
@@ -51,21 +49,12 @@ func (backend *Backend) BackendWorkerPodMonitor() *monitoringv1.PodMonitor {
 }
 
 func (backend *Backend) BackendGrafanaDashboard(sumRate string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		backend.Options.Namespace, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "backend",
-			Labels: backend.monitoringLabels(),
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/backend-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/backend-grafana-dashboard-1.json", backend.Options.Namespace),
-		},
+	data := &grafanaDashboardTemplateData{
+		Namespace: backend.Options.Namespace,
+		SumRate:   sumRate,
+		SLO:       backend.Options.SLO,
 	}
+	return grafanaDashboard("backend", "backend-grafana-dashboard-1.json", backend.Options.CommonLabels, backend.Options.GrafanaDashboard, data)
 }
 
 func (backend *Backend) BackendWorkerPrometheusRules() *monitoringv1.PrometheusRule {
@@ -164,15 +153,19 @@ func (backend *Backend) BackendListenerPrometheusRules() *monitoringv1.Prometheu
 	}
 }
 
-func (backend *Backend) monitoringLabels() map[string]string {
-	labels := make(map[string]string)
-
-	for key, value := range backend.Options.CommonLabels {
-		labels[key] = value
+// BackendListenerSLOPrometheusRules returns the availability and latency recording rules
+// of backend-listener, and the error budget burn rate alerts
+func (backend *Backend) BackendListenerSLOPrometheusRules() *monitoringv1.PrometheusRule {
+	gateway := &sloGateway{
+		name:                 "backend_listener",
+		alertPrefix:          "ThreescaleBackendListener",
+		description:          "backend-listener",
+		selector:             fmt.Sprintf(`namespace="%s"`, backend.Options.Namespace),
+		responsesMetric:      "apisonator_listener_response_codes",
+		errorsSelector:       `resp_code="5xx"`,
+		durationBucketMetric: "apisonator_listener_response_times_seconds_bucket",
 	}
-
-	labels["monitoring-key"] = common.MonitoringKey
-	return labels
+	return sloPrometheusRules("backend-listener-slo", backend.Options.Namespace, backend.prometheusRulesMonitoringLabels(), gateway, backend.Options.SLO)
 }
 
 func (backend *Backend) prometheusRulesMonitoringLabels() map[string]string {
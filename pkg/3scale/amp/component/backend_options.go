@@ -17,26 +17,86 @@ type BackendOptions struct {
 	ListenerReplicas             int32
 	WorkerReplicas               int32
 	CronReplicas                 int32
-	SystemBackendUsername        string            `validate:"required"`
-	SystemBackendPassword        string            `validate:"required"`
-	TenantName                   string            `validate:"required"`
-	WildcardDomain               string            `validate:"required"`
-	ListenerAffinity             *v1.Affinity      `validate:"-"`
-	ListenerTolerations          []v1.Toleration   `validate:"-"`
-	WorkerAffinity               *v1.Affinity      `validate:"-"`
-	WorkerTolerations            []v1.Toleration   `validate:"-"`
-	CronAffinity                 *v1.Affinity      `validate:"-"`
-	CronTolerations              []v1.Toleration   `validate:"-"`
-	CommonLabels                 map[string]string `validate:"required"`
-	CommonListenerLabels         map[string]string `validate:"required"`
-	CommonWorkerLabels           map[string]string `validate:"required"`
-	CommonCronLabels             map[string]string `validate:"required"`
-	ListenerPodTemplateLabels    map[string]string `validate:"required"`
-	WorkerPodTemplateLabels      map[string]string `validate:"required"`
-	CronPodTemplateLabels        map[string]string `validate:"required"`
+	SystemBackendUsername        string                  `validate:"required"`
+	SystemBackendPassword        string                  `validate:"required"`
+	TenantName                   string                  `validate:"required"`
+	WildcardDomain               string                  `validate:"required"`
+	ListenerAffinity             *v1.Affinity            `validate:"-"`
+	ListenerTolerations          []v1.Toleration         `validate:"-"`
+	WorkerAffinity               *v1.Affinity            `validate:"-"`
+	WorkerTolerations            []v1.Toleration         `validate:"-"`
+	CronAffinity                 *v1.Affinity            `validate:"-"`
+	CronTolerations              []v1.Toleration         `validate:"-"`
+	ListenerSecurityContext      *SecurityContextOptions `validate:"-"`
+	WorkerSecurityContext        *SecurityContextOptions `validate:"-"`
+	CronSecurityContext          *SecurityContextOptions `validate:"-"`
+	CommonLabels                 map[string]string       `validate:"required"`
+	CommonListenerLabels         map[string]string       `validate:"required"`
+	CommonWorkerLabels           map[string]string       `validate:"required"`
+	CommonCronLabels             map[string]string       `validate:"required"`
+	ListenerPodTemplateLabels    map[string]string       `validate:"required"`
+	WorkerPodTemplateLabels      map[string]string       `validate:"required"`
+	CronPodTemplateLabels        map[string]string       `validate:"required"`
+	ListenerCustomLabels         map[string]string       `validate:"-"`
+	ListenerCustomAnnotations    map[string]string       `validate:"-"`
+	WorkerCustomAnnotations      map[string]string       `validate:"-"`
+	CronCustomAnnotations        map[string]string       `validate:"-"`
 	WorkerMetrics                bool
 	ListenerMetrics              bool
 
+	// Pod template settings overridden in the APIManager
+	ListenerPodTemplateOverrides PodTemplateOverridesOptions `validate:"-"`
+	WorkerPodTemplateOverrides   PodTemplateOverridesOptions `validate:"-"`
+	CronPodTemplateOverrides     PodTemplateOverridesOptions `validate:"-"`
+
+	// Replicas not managed by the operator are only set on creation
+	ListenerReplicasManaged bool
+	WorkerReplicasManaged   bool
+	CronReplicasManaged     bool
+
+	// Disruption policies of the pods. The default policy is used when not set
+	ListenerPodDisruptionBudget *PodDisruptionBudgetOptions `validate:"-"`
+	WorkerPodDisruptionBudget   *PodDisruptionBudgetOptions `validate:"-"`
+	CronPodDisruptionBudget     *PodDisruptionBudgetOptions `validate:"-"`
+
+	// Probes tuning of the containers. The default probes are used when not set
+	ListenerProbes *ProbesOptions `validate:"omitempty"`
+
+	// Extra env vars appended to the operator managed env vars of the containers
+	ListenerExtraEnv []v1.EnvVar `validate:"-"`
+	WorkerExtraEnv   []v1.EnvVar `validate:"-"`
+	CronExtraEnv     []v1.EnvVar `validate:"-"`
+
+	// Sidecar containers and volumes appended to the pods
+	ListenerSidecars *SidecarsOptions `validate:"-"`
+	WorkerSidecars   *SidecarsOptions `validate:"-"`
+	CronSidecars     *SidecarsOptions `validate:"-"`
+
+	// Init containers customized and appended to the pods
+	ListenerInitContainers *InitContainersOptions `validate:"-"`
+	WorkerInitContainers   *InitContainersOptions `validate:"-"`
+	CronInitContainers     *InitContainersOptions `validate:"-"`
+
+	// Termination of the pods. The default grace period and no preStop hook are used when not set
+	ListenerTermination *TerminationOptions `validate:"omitempty"`
+	WorkerTermination   *TerminationOptions `validate:"omitempty"`
+	CronTermination     *TerminationOptions `validate:"omitempty"`
+
+	// Statsd metrics sink. Independent of the prometheus metrics
+	Statsd *StatsdOptions `validate:"omitempty"`
+
+	// Listener request logging. Nil when disabled
+	ListenerRequestLogging *BackendRequestLoggingOptions `validate:"omitempty"`
+
+	// Secret with the CA certificate of the backend redis. Empty when not set
+	RedisCASecretName string `validate:"-"`
+
+	// Recording rules of backend-listener. Nil when the recording rules are disabled
+	SLO *SLOOptions `validate:"omitempty"`
+
+	// Datasource and selector labels of the grafana dashboards. The defaults are used when not set
+	GrafanaDashboard *GrafanaDashboardOptions `validate:"omitempty"`
+
 	// Used for monitoring objects
 	// Those objects are namespaced. However, objects includes labels, rules and expressions
 	// that need namespace filtering because they are "global" once imported
@@ -0,0 +1,39 @@
+package component
+
+import (
+	"strconv"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	BackendRequestLoggersEnvVarName             = "CONFIG_REQUEST_LOGGERS"
+	BackendRequestLoggersSamplingRateEnvVarName = "CONFIG_REQUEST_LOGGERS_SAMPLING_RATE"
+
+	BackendRequestLoggersJSON = "json"
+)
+
+// BackendRequestLoggingEnvVarNames are the env vars configuring the backend-listener request logging
+var BackendRequestLoggingEnvVarNames = []string{
+	BackendRequestLoggersEnvVarName,
+	BackendRequestLoggersSamplingRateEnvVarName,
+}
+
+// BackendRequestLoggingOptions configures the backend-listener request logging
+type BackendRequestLoggingOptions struct {
+	// SamplingRate is the percentage of requests logged
+	SamplingRate int32 `validate:"min=1,max=100"`
+}
+
+// BackendRequestLoggingEnvVars returns the request logging env vars. Empty when the request logging is disabled
+func BackendRequestLoggingEnvVars(opts *BackendRequestLoggingOptions) []v1.EnvVar {
+	if opts == nil {
+		return nil
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromValue(BackendRequestLoggersEnvVarName, BackendRequestLoggersJSON),
+		helper.EnvVarFromValue(BackendRequestLoggersSamplingRateEnvVarName, strconv.Itoa(int(opts.SamplingRate))),
+	}
+}
@@ -0,0 +1,475 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"github.com/coreos/prometheus-operator/pkg/apis/monitoring"
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	BackendRedisExporterName     = "backend-redis-exporter"
+	SystemRedisExporterName      = "system-redis-exporter"
+	SystemMySQLExporterName      = "system-mysql-exporter"
+	SystemPostgreSQLExporterName = "system-postgresql-exporter"
+	ZyncDatabaseExporterName     = "zync-database-exporter"
+
+	redisExporterPort      = 9121
+	mysqlExporterPort      = 9104
+	postgresqlExporterPort = 9187
+)
+
+// DatabaseExporter is a prometheus exporter deployed next to an internal
+// database. The exporter runs in its own deployment connecting to the
+// database service, so enabling or disabling it does not roll out the database.
+type DatabaseExporter struct {
+	Options *DatabaseExportersOptions
+
+	name        string
+	component   string
+	element     string
+	alertPrefix string
+	image       string
+	port        int32
+	env         []v1.EnvVar
+	rules       func(alertPrefix, job, namespace string) []monitoringv1.Rule
+}
+
+func NewBackendRedisExporter(options *DatabaseExportersOptions) *DatabaseExporter {
+	return &DatabaseExporter{
+		Options:     options,
+		name:        BackendRedisExporterName,
+		component:   "backend",
+		element:     "redis-exporter",
+		alertPrefix: "ThreescaleBackendRedis",
+		image:       options.RedisExporterImage,
+		port:        redisExporterPort,
+		env: []v1.EnvVar{
+			helper.EnvVarFromSecret("REDIS_ADDR", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageURLFieldName),
+		},
+		rules: redisExporterRules,
+	}
+}
+
+func NewSystemRedisExporter(options *DatabaseExportersOptions) *DatabaseExporter {
+	return &DatabaseExporter{
+		Options:     options,
+		name:        SystemRedisExporterName,
+		component:   "system",
+		element:     "redis-exporter",
+		alertPrefix: "ThreescaleSystemRedis",
+		image:       options.RedisExporterImage,
+		port:        redisExporterPort,
+		env: []v1.EnvVar{
+			helper.EnvVarFromSecret("REDIS_ADDR", SystemSecretSystemRedisSecretName, SystemSecretSystemRedisURLFieldName),
+		},
+		rules: redisExporterRules,
+	}
+}
+
+func NewSystemMySQLExporter(options *DatabaseExportersOptions) *DatabaseExporter {
+	return &DatabaseExporter{
+		Options:     options,
+		name:        SystemMySQLExporterName,
+		component:   "system",
+		element:     "mysql-exporter",
+		alertPrefix: "ThreescaleSystemMySQL",
+		image:       options.MySQLExporterImage,
+		port:        mysqlExporterPort,
+		env: []v1.EnvVar{
+			helper.EnvVarFromSecret("DB_USER", SystemSecretSystemDatabaseSecretName, SystemSecretSystemDatabaseUserFieldName),
+			helper.EnvVarFromSecret("DB_PASSWORD", SystemSecretSystemDatabaseSecretName, SystemSecretSystemDatabasePasswordFieldName),
+			// Credentials are expanded from the env vars above
+			helper.EnvVarFromValue("DATA_SOURCE_NAME", "$(DB_USER):$(DB_PASSWORD)@(system-mysql:3306)/"),
+		},
+		rules: mysqlExporterRules,
+	}
+}
+
+func NewSystemPostgreSQLExporter(options *DatabaseExportersOptions) *DatabaseExporter {
+	return &DatabaseExporter{
+		Options:     options,
+		name:        SystemPostgreSQLExporterName,
+		component:   "system",
+		element:     "postgresql-exporter",
+		alertPrefix: "ThreescaleSystemPostgreSQL",
+		image:       options.PostgreSQLExporterImage,
+		port:        postgresqlExporterPort,
+		env: []v1.EnvVar{
+			helper.EnvVarFromValue("DATA_SOURCE_URI", fmt.Sprintf("system-postgresql:5432/%s?sslmode=disable", options.SystemDatabaseName)),
+			helper.EnvVarFromSecret("DATA_SOURCE_USER", SystemSecretSystemDatabaseSecretName, SystemSecretSystemDatabaseUserFieldName),
+			helper.EnvVarFromSecret("DATA_SOURCE_PASS", SystemSecretSystemDatabaseSecretName, SystemSecretSystemDatabasePasswordFieldName),
+		},
+		rules: postgresqlExporterRules,
+	}
+}
+
+func NewZyncDatabaseExporter(options *DatabaseExportersOptions) *DatabaseExporter {
+	return &DatabaseExporter{
+		Options:     options,
+		name:        ZyncDatabaseExporterName,
+		component:   "zync",
+		element:     "database-exporter",
+		alertPrefix: "ThreescaleZyncDatabase",
+		image:       options.PostgreSQLExporterImage,
+		port:        postgresqlExporterPort,
+		env: []v1.EnvVar{
+			helper.EnvVarFromValue("DATA_SOURCE_URI", "zync-database:5432/zync_production?sslmode=disable"),
+			helper.EnvVarFromValue("DATA_SOURCE_USER", "zync"),
+			helper.EnvVarFromSecret("DATA_SOURCE_PASS", ZyncSecretName, ZyncSecretDatabasePasswordFieldName),
+		},
+		rules: zyncDatabaseExporterRules,
+	}
+}
+
+func (e *DatabaseExporter) Name() string {
+	return e.name
+}
+
+func (e *DatabaseExporter) DeploymentConfig() *appsv1.DeploymentConfig {
+	return &appsv1.DeploymentConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DeploymentConfig",
+			APIVersion: "apps.openshift.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   e.name,
+			Labels: e.labels(),
+		},
+		Spec: appsv1.DeploymentConfigSpec{
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.DeploymentStrategyTypeRolling,
+			},
+			Triggers: appsv1.DeploymentTriggerPolicies{
+				appsv1.DeploymentTriggerPolicy{
+					Type: appsv1.DeploymentTriggerOnConfigChange,
+				},
+			},
+			Replicas: 1,
+			Selector: map[string]string{"deploymentConfig": e.name},
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: e.podTemplateLabels(),
+				},
+				Spec: v1.PodSpec{
+					ServiceAccountName: "amp", //TODO make this configurable via flag
+					Containers: []v1.Container{
+						v1.Container{
+							Name:  e.name,
+							Image: e.image,
+							Ports: []v1.ContainerPort{
+								v1.ContainerPort{
+									Name:          "metrics",
+									ContainerPort: e.port,
+									Protocol:      v1.ProtocolTCP,
+								},
+							},
+							Env:       e.env,
+							Resources: e.Options.ResourceRequirements,
+							LivenessProbe: &v1.Probe{
+								Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{
+									Port: intstr.FromInt(int(e.port)),
+								}},
+								InitialDelaySeconds: 10,
+								PeriodSeconds:       10,
+							},
+							ReadinessProbe: &v1.Probe{
+								Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{
+									Path: "/metrics",
+									Port: intstr.FromInt(int(e.port)),
+								}},
+								InitialDelaySeconds: 10,
+								TimeoutSeconds:      5,
+								PeriodSeconds:       30,
+							},
+							ImagePullPolicy: v1.PullIfNotPresent,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *DatabaseExporter) Service() *v1.Service {
+	return &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   e.name,
+			Labels: e.labels(),
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				v1.ServicePort{
+					Name:       "metrics",
+					Protocol:   v1.ProtocolTCP,
+					Port:       e.port,
+					TargetPort: intstr.FromString("metrics"),
+				},
+			},
+			Selector: map[string]string{"deploymentConfig": e.name},
+		},
+	}
+}
+
+func (e *DatabaseExporter) ServiceMonitor() *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   e.name,
+			Labels: e.labels(),
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Endpoints: []monitoringv1.Endpoint{{
+				Port:   "metrics",
+				Path:   "/metrics",
+				Scheme: "http",
+			}},
+			Selector: metav1.LabelSelector{
+				MatchLabels: e.labels(),
+			},
+		},
+	}
+}
+
+func (e *DatabaseExporter) PrometheusRules() *monitoringv1.PrometheusRule {
+	return &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1.PrometheusRuleKind,
+			APIVersion: fmt.Sprintf("%s/%s", monitoring.GroupName, monitoringv1.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   e.name,
+			Labels: e.prometheusRulesMonitoringLabels(),
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name:  fmt.Sprintf("%s/%s.rules", e.Options.Namespace, e.name),
+					Rules: e.rules(e.alertPrefix, e.name, e.Options.Namespace),
+				},
+			},
+		},
+	}
+}
+
+func (e *DatabaseExporter) commonLabels() map[string]string {
+	labels := make(map[string]string)
+
+	for key, value := range e.Options.CommonLabels {
+		labels[key] = value
+	}
+
+	labels["threescale_component"] = e.component
+	return labels
+}
+
+func (e *DatabaseExporter) labels() map[string]string {
+	labels := e.commonLabels()
+	labels["threescale_component_element"] = e.element
+	return labels
+}
+
+func (e *DatabaseExporter) podTemplateLabels() map[string]string {
+	labels := e.labels()
+	labels["deploymentConfig"] = e.name
+	return labels
+}
+
+func (e *DatabaseExporter) prometheusRulesMonitoringLabels() map[string]string {
+	labels := e.commonLabels()
+	labels["prometheus"] = "application-monitoring"
+	labels["role"] = "alert-rules"
+	return labels
+}
+
+func databaseExporterJobDownRule(alertPrefix, job, namespace string) monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Alert: fmt.Sprintf("%sExporterJobDown", alertPrefix),
+		Annotations: map[string]string{
+			"sop_url":     ThreescalePrometheusJobDownURL,
+			"summary":     "Job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+			"description": "Job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+		},
+		Expr: intstr.FromString(fmt.Sprintf(`up{job="%s",namespace="%s"} == 0`, job, namespace)),
+		For:  "1m",
+		Labels: map[string]string{
+			"severity": "critical",
+		},
+	}
+}
+
+func redisExporterRules(alertPrefix, job, namespace string) []monitoringv1.Rule {
+	return []monitoringv1.Rule{
+		databaseExporterJobDownRule(alertPrefix, job, namespace),
+		{
+			Alert: fmt.Sprintf("%sDown", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+				"description": "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`redis_up{job="%s",namespace="%s"} == 0`, job, namespace)),
+			For:  "1m",
+			Labels: map[string]string{
+				"severity": "critical",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sMemoryHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 90% of its max memory",
+				"description": "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 90% of its max memory",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`redis_memory_used_bytes{job="%[1]s",namespace="%[2]s"} / (redis_memory_max_bytes{job="%[1]s",namespace="%[2]s"} > 0) > 0.9`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sConnectionsHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} has more than 80% of max clients connected",
+				"description": "Redis monitored by job {{ $labels.job }} on {{ $labels.namespace }} has more than 80% of max clients connected",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`redis_connected_clients{job="%[1]s",namespace="%[2]s"} / redis_config_maxclients{job="%[1]s",namespace="%[2]s"} > 0.8`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sReplicationBroken", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "Redis replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} lost the link to its master",
+				"description": "Redis replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} lost the link to its master",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`redis_master_link_up{job="%s",namespace="%s"} == 0`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+	}
+}
+
+func mysqlExporterRules(alertPrefix, job, namespace string) []monitoringv1.Rule {
+	return []monitoringv1.Rule{
+		databaseExporterJobDownRule(alertPrefix, job, namespace),
+		{
+			Alert: fmt.Sprintf("%sDown", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "MySQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+				"description": "MySQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`mysql_up{job="%s",namespace="%s"} == 0`, job, namespace)),
+			For:  "1m",
+			Labels: map[string]string{
+				"severity": "critical",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sConnectionsHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "MySQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 80% of max connections",
+				"description": "MySQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 80% of max connections",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`mysql_global_status_threads_connected{job="%[1]s",namespace="%[2]s"} / mysql_global_variables_max_connections{job="%[1]s",namespace="%[2]s"} > 0.8`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sReplicationBroken", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "MySQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is not replicating",
+				"description": "MySQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is not replicating",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`mysql_slave_status_slave_io_running{job="%[1]s",namespace="%[2]s"} == 0 or mysql_slave_status_slave_sql_running{job="%[1]s",namespace="%[2]s"} == 0`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sReplicationLagHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "MySQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is more than 5 minutes behind its master",
+				"description": "MySQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is more than 5 minutes behind its master",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`mysql_slave_status_seconds_behind_master{job="%s",namespace="%s"} > 300`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+	}
+}
+
+func postgresqlExporterRules(alertPrefix, job, namespace string) []monitoringv1.Rule {
+	return []monitoringv1.Rule{
+		databaseExporterJobDownRule(alertPrefix, job, namespace),
+		{
+			Alert: fmt.Sprintf("%sDown", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+				"description": "PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is DOWN",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`pg_up{job="%s",namespace="%s"} == 0`, job, namespace)),
+			For:  "1m",
+			Labels: map[string]string{
+				"severity": "critical",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sConnectionsHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 80% of max connections",
+				"description": "PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} is using more than 80% of max connections",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`sum(pg_stat_activity_count{job="%[1]s",namespace="%[2]s"}) by (namespace,job) / max(pg_settings_max_connections{job="%[1]s",namespace="%[2]s"}) by (namespace,job) > 0.8`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+		{
+			Alert: fmt.Sprintf("%sReplicationLagHigh", alertPrefix),
+			Annotations: map[string]string{
+				"summary":     "PostgreSQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is more than 5 minutes behind its primary",
+				"description": "PostgreSQL replica monitored by job {{ $labels.job }} on {{ $labels.namespace }} is more than 5 minutes behind its primary",
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`pg_replication_lag{job="%s",namespace="%s"} > 300`, job, namespace)),
+			For:  "5m",
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+	}
+}
+
+// zyncDatabaseExporterRules adds the detection of the dead tuples left by the
+// constant insertion and deletion of que jobs
+func zyncDatabaseExporterRules(alertPrefix, job, namespace string) []monitoringv1.Rule {
+	return append(postgresqlExporterRules(alertPrefix, job, namespace), monitoringv1.Rule{
+		Alert: fmt.Sprintf("%sTableBloatHigh", alertPrefix),
+		Annotations: map[string]string{
+			"summary":     "Table {{ $labels.relname }} of PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} has more than 20% of dead tuples",
+			"description": "Table {{ $labels.relname }} of PostgreSQL monitored by job {{ $labels.job }} on {{ $labels.namespace }} has more than 20% of dead tuples. Enable or reschedule the zync database maintenance",
+		},
+		Expr: intstr.FromString(fmt.Sprintf(`pg_stat_user_tables_n_dead_tup{job="%[1]s",namespace="%[2]s"} > 10000 and pg_stat_user_tables_n_dead_tup{job="%[1]s",namespace="%[2]s"} / (pg_stat_user_tables_n_live_tup{job="%[1]s",namespace="%[2]s"} + pg_stat_user_tables_n_dead_tup{job="%[1]s",namespace="%[2]s"}) > 0.2`, job, namespace)),
+		For:  "30m",
+		Labels: map[string]string{
+			"severity": "warning",
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package component
+
+import (
+	"github.com/go-playground/validator/v10"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+type DatabaseExportersOptions struct {
+	Namespace               string                  `validate:"required"`
+	RedisExporterImage      string                  `validate:"required"`
+	MySQLExporterImage      string                  `validate:"required"`
+	PostgreSQLExporterImage string                  `validate:"required"`
+	ResourceRequirements    v1.ResourceRequirements `validate:"-"`
+
+	// System PostgreSQL database name. Only used when system database is PostgreSQL
+	SystemDatabaseName string
+
+	CommonLabels map[string]string `validate:"required"`
+}
+
+func NewDatabaseExportersOptions() *DatabaseExportersOptions {
+	return &DatabaseExportersOptions{}
+}
+
+func (d *DatabaseExportersOptions) Validate() error {
+	validate := validator.New()
+	return validate.Struct(d)
+}
+
+func DefaultDatabaseExporterResourceRequirements() v1.ResourceRequirements {
+	return v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("100m"),
+			v1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("25m"),
+			v1.ResourceMemory: resource.MustParse("32Mi"),
+		},
+	}
+}
@@ -12,24 +12,43 @@ type DeploymentsLister struct {
 	SystemDatabaseType     SystemDatabaseType
 	ExternalRedisDatabases bool
 	ExternalZyncDatabase   bool
+	ExternalZync           bool
+	SystemCacheStoreRedis  bool
+	// Only the gateways are deployed, the control plane is managed outside of the APIManager
+	GatewayOnly bool
+	// The backend and its redis are managed outside of the APIManager
+	ExternalBackend bool
 }
 
 func (d *DeploymentsLister) DeploymentNames() []string {
+	if d.GatewayOnly {
+		return []string{ApicastStagingName, ApicastProductionName}
+	}
+
 	var deployments []string
 	deployments = append(deployments,
 		ApicastStagingName,
 		ApicastProductionName,
-		BackendListenerName,
-		BackendWorkerName,
-		BackendCronName,
-		SystemMemcachedDeploymentName,
+	)
+
+	if !d.ExternalBackend {
+		deployments = append(deployments, BackendListenerName, BackendWorkerName, BackendCronName)
+	}
+
+	deployments = append(deployments,
 		SystemAppDeploymentName,
 		SystemSidekiqName,
 		SystemSphinxDeploymentName,
-		ZyncName,
-		ZyncQueDeploymentName,
 	)
 
+	if !d.ExternalZync {
+		deployments = append(deployments, ZyncName, ZyncQueDeploymentName)
+	}
+
+	if !d.SystemCacheStoreRedis {
+		deployments = append(deployments, SystemMemcachedDeploymentName)
+	}
+
 	switch d.SystemDatabaseType {
 	case SystemDatabaseTypeInternalMySQL:
 		deployments = append(deployments, SystemMySQLDeploymentName)
@@ -38,13 +57,13 @@ func (d *DeploymentsLister) DeploymentNames() []string {
 	}
 
 	if !d.ExternalRedisDatabases {
-		deployments = append(deployments,
-			BackendRedisDeploymentName,
-			SystemRedisDeploymentName,
-		)
+		if !d.ExternalBackend {
+			deployments = append(deployments, BackendRedisDeploymentName)
+		}
+		deployments = append(deployments, SystemRedisDeploymentName)
 	}
 
-	if !d.ExternalZyncDatabase {
+	if !d.ExternalZyncDatabase && !d.ExternalZync {
 		deployments = append(deployments, ZyncDatabaseDeploymentName)
 	}
 
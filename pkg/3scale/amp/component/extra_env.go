@@ -0,0 +1,209 @@
+package component
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ExtraEnvAnnotation lists the extra env vars set in the containers of the pod
+	// template, comma separated, so the env vars removed from the APIManager are
+	// also removed from the existing containers
+	ExtraEnvAnnotation = "apps.3scale.net/extra-env"
+)
+
+// Env vars managed by the operator, per component. They cannot be set as
+// extra env vars. Keep in sync with the env vars of the component containers
+var (
+	ApicastReservedEnvVarNames = []string{
+		"THREESCALE_PORTAL_ENDPOINT",
+		"BACKEND_ENDPOINT_OVERRIDE",
+		"APICAST_MANAGEMENT_API",
+		"OPENSSL_VERIFY",
+		"APICAST_RESPONSE_CODES",
+		"APICAST_EXTENDED_METRICS",
+		"APICAST_CONFIGURATION_LOADER",
+		"APICAST_CONFIGURATION_CACHE",
+		"THREESCALE_DEPLOYMENT_ENV",
+		"APICAST_WORKERS",
+		"APICAST_LOG_LEVEL",
+		"OPENTRACING_TRACER",
+		"OPENTRACING_CONFIG",
+		"APICAST_ENVIRONMENT",
+		"APICAST_HTTPS_PORT",
+		"APICAST_HTTPS_VERIFY_DEPTH",
+		"APICAST_HTTPS_CERTIFICATE",
+		"APICAST_HTTPS_CERTIFICATE_KEY",
+		"ALL_PROXY",
+		"HTTP_PROXY",
+		"HTTPS_PROXY",
+		"NO_PROXY",
+	}
+
+	BackendReservedEnvVarNames = append([]string{
+		"CONFIG_REDIS_PROXY",
+		"CONFIG_REDIS_SENTINEL_HOSTS",
+		"CONFIG_REDIS_SENTINEL_ROLE",
+		"CONFIG_QUEUES_MASTER_NAME",
+		"CONFIG_QUEUES_SENTINEL_HOSTS",
+		"CONFIG_QUEUES_SENTINEL_ROLE",
+		BackendConfigRedisUsernameEnvVarName,
+		BackendConfigRedisPasswordEnvVarName,
+		BackendConfigRedisCAFileEnvVarName,
+		BackendConfigQueuesUsernameEnvVarName,
+		BackendConfigQueuesPasswordEnvVarName,
+		BackendConfigQueuesCAFileEnvVarName,
+		"RACK_ENV",
+		"CONFIG_EVENTS_HOOK",
+		"CONFIG_EVENTS_HOOK_SHARED_SECRET",
+		"PUMA_WORKERS",
+		"CONFIG_INTERNAL_API_USER",
+		"CONFIG_INTERNAL_API_PASSWORD",
+		BackendWorkerMetricsPortEnvVarName,
+		BackendWorkerMetricsEnabledEnvVarName,
+		BackendListenerMetricsPortEnvVarName,
+		BackendListenerMetricsEnabledEnvVarName,
+		BackendRequestLoggersEnvVarName,
+		BackendRequestLoggersSamplingRateEnvVarName,
+	}, StatsdEnvVarNames...)
+
+	SystemReservedEnvVarNames = append([]string{
+		// system-environment ConfigMap keys
+		"RAILS_ENV",
+		"FORCE_SSL",
+		"THREESCALE_SUPERDOMAIN",
+		"PROVIDER_PLAN",
+		"APICAST_REGISTRY_URL",
+		"RAILS_LOG_TO_STDOUT",
+		"RAILS_LOG_LEVEL",
+		"THINKING_SPHINX_PORT",
+		"THREESCALE_SANDBOX_PROXY_OPENSSL_VERIFY_MODE",
+		"SSL_CERT_DIR",
+		"FILE_UPLOAD_STORAGE",
+
+		"DATABASE_URL",
+		"MASTER_DOMAIN",
+		"MASTER_USER",
+		"MASTER_PASSWORD",
+		"ADMIN_ACCESS_TOKEN",
+		"USER_LOGIN",
+		"USER_PASSWORD",
+		"USER_EMAIL",
+		"TENANT_NAME",
+		"THINKING_SPHINX_ADDRESS",
+		"THINKING_SPHINX_CONFIGURATION_FILE",
+		"THINKING_SPHINX_PID_FILE",
+		"EVENTS_SHARED_SECRET",
+		"RECAPTCHA_PUBLIC_KEY",
+		"RECAPTCHA_PRIVATE_KEY",
+		"SECRET_KEY_BASE",
+		SystemMemcacheServersEnvVarName,
+		SystemCacheStoreEnvVarName,
+		SystemCacheRedisURLEnvVarName,
+		SystemCacheRedisNamespaceEnvVarName,
+		"REDIS_URL",
+		"REDIS_NAMESPACE",
+		"REDIS_SENTINEL_HOSTS",
+		"REDIS_SENTINEL_ROLE",
+		"BACKEND_REDIS_URL",
+		"BACKEND_REDIS_SENTINEL_HOSTS",
+		"BACKEND_REDIS_SENTINEL_ROLE",
+		SystemRedisUsernameEnvVarName,
+		SystemRedisPasswordEnvVarName,
+		SystemRedisCAFileEnvVarName,
+		SystemBackendRedisUsernameEnvVarName,
+		SystemBackendRedisPasswordEnvVarName,
+		SystemBackendRedisCAFileEnvVarName,
+		"APICAST_BACKEND_ROOT_ENDPOINT",
+		"BACKEND_ROUTE",
+		"SMTP_ADDRESS",
+		"SMTP_USER_NAME",
+		"SMTP_PASSWORD",
+		"SMTP_DOMAIN",
+		"SMTP_PORT",
+		"SMTP_AUTHENTICATION",
+		"SMTP_OPENSSL_VERIFY_MODE",
+		"NOREPLY_EMAIL",
+		"APICAST_ACCESS_TOKEN",
+		SystemZyncEndpointEnvVarName,
+		SystemZyncAuthenticationTokenEnvVarName,
+		SystemInboundEmailProtocolEnvVarName,
+		SystemInboundEmailHostEnvVarName,
+		SystemInboundEmailPortEnvVarName,
+		SystemInboundEmailSSLEnvVarName,
+		SystemInboundEmailUsernameEnvVarName,
+		SystemInboundEmailPasswordEnvVarName,
+		"CONFIG_INTERNAL_API_USER",
+		"CONFIG_INTERNAL_API_PASSWORD",
+		AwsAccessKeyID,
+		AwsSecretAccessKey,
+		AwsBucket,
+		AwsRegion,
+		AwsProtocol,
+		AwsHostname,
+		AwsPathStyle,
+		SystemSecretSystemAppUserSessionTTLFieldName,
+		SystemDeveloperPortalEnabledEnvVarName,
+		SystemAppPrometheusExporterPortEnvVarName,
+	}, StatsdEnvVarNames...)
+
+	ZyncReservedEnvVarNames = []string{
+		"RAILS_LOG_TO_STDOUT",
+		"RAILS_ENV",
+		"DATABASE_URL",
+		"SECRET_KEY_BASE",
+		"ZYNC_AUTHENTICATION_TOKEN",
+		"POD_NAME",
+		"POD_NAMESPACE",
+		ZyncDatabaseSSLCAEnvVarName,
+		ZyncDatabaseSSLCertEnvVarName,
+		ZyncDatabaseSSLKeyEnvVarName,
+		ZyncDatabaseSSLModeEnvVarName,
+		ZyncDatabaseSchemaSearchPathEnvVarName,
+		ZyncForceSSLEnvVarName,
+		ZyncTrustedProxiesEnvVarName,
+		ZyncQueWorkerCountEnvVarName,
+		ZyncQuePollingIntervalEnvVarName,
+	}
+)
+
+// ExtraEnvNames returns the names of the extra env vars listed in the ExtraEnvAnnotation value
+func ExtraEnvNames(annotation string) []string {
+	var names []string
+	for _, name := range strings.Split(annotation, ",") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// applyExtraEnv appends the extra env vars to the env vars of the containers of the pod template
+func applyExtraEnv(template *v1.PodTemplateSpec, env []v1.EnvVar) {
+	if len(env) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(env))
+	for _, envVar := range env {
+		names = append(names, envVar.Name)
+	}
+
+	for idx := range template.Spec.Containers {
+		container := &template.Spec.Containers[idx]
+		for _, envVar := range env {
+			container.Env = append(container.Env, *envVar.DeepCopy())
+		}
+	}
+
+	// The annotations map may be shared with the custom annotations options
+	annotations := map[string]string{}
+	for key, val := range template.Annotations {
+		annotations[key] = val
+	}
+	annotations[ExtraEnvAnnotation] = strings.Join(names, ",")
+	template.Annotations = annotations
+
+	addPodTemplateOverridesHash(template, "env", env)
+}
@@ -3,8 +3,6 @@ package component
 import (
 	"fmt"
 
-	"github.com/3scale/3scale-operator/pkg/assets"
-	"github.com/3scale/3scale-operator/pkg/common"
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,46 +32,20 @@ const (
 	ThreescaleSystemApp5XXRequestsHighURL              = "https://github.com/3scale/3scale-Operations/blob/master/sops/alerts/system_app_5xx_requests_high.adoc"
 )
 
-func KubernetesResourcesByNamespaceGrafanaDashboard(sumRate, ns, appLabel string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		ns, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "kubernetes-resources-by-namespace",
-			Labels: map[string]string{
-				"monitoring-key": common.MonitoringKey,
-				"app":            appLabel,
-			},
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/kubernetes-resources-by-namespace-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/kubernetes-resources-by-namespace-grafana-dashboard-1.json", ns),
-		},
+func KubernetesResourcesByNamespaceGrafanaDashboard(sumRate, ns, appLabel string, options *GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+	data := &grafanaDashboardTemplateData{
+		Namespace: ns,
+		SumRate:   sumRate,
 	}
+	return grafanaDashboard("kubernetes-resources-by-namespace", "kubernetes-resources-by-namespace-grafana-dashboard-1.json", map[string]string{"app": appLabel}, options, data)
 }
 
-func KubernetesResourcesByPodGrafanaDashboard(sumRate, ns, appLabel string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		ns, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "kubernetes-resources-by-pod",
-			Labels: map[string]string{
-				"monitoring-key": common.MonitoringKey,
-				"app":            appLabel,
-			},
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/kubernetes-resources-by-pod-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/kubernetes-resources-by-pod-grafana-dashboard-1.json", ns),
-		},
+func KubernetesResourcesByPodGrafanaDashboard(sumRate, ns, appLabel string, options *GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+	data := &grafanaDashboardTemplateData{
+		Namespace: ns,
+		SumRate:   sumRate,
 	}
+	return grafanaDashboard("kubernetes-resources-by-pod", "kubernetes-resources-by-pod-grafana-dashboard-1.json", map[string]string{"app": appLabel}, options, data)
 }
 
 func KubeStateMetricsPrometheusRules(sumRate, ns, appLabel string) *monitoringv1.PrometheusRule {
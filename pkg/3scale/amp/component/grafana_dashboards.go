@@ -0,0 +1,68 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/3scale/3scale-operator/pkg/assets"
+	"github.com/3scale/3scale-operator/pkg/common"
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DefaultGrafanaDatasourceName = "Prometheus"
+)
+
+// GrafanaDashboardOptions configures the datasource referenced by the grafana
+// dashboards and the labels matched by the grafana dashboard label selector
+type GrafanaDashboardOptions struct {
+	DatasourceName string            `validate:"required"`
+	Labels         map[string]string `validate:"required"`
+}
+
+func DefaultGrafanaDashboardOptions() *GrafanaDashboardOptions {
+	return &GrafanaDashboardOptions{
+		DatasourceName: DefaultGrafanaDatasourceName,
+		Labels: map[string]string{
+			"monitoring-key": common.MonitoringKey,
+		},
+	}
+}
+
+// grafanaDashboardTemplateData is the data available in the grafana dashboard templates
+type grafanaDashboardTemplateData struct {
+	Namespace, SumRate, DatasourceName string
+	SLO                                *SLOOptions
+}
+
+// grafanaDashboard builds the dashboard from the template asset. The dashboard
+// labels are the common labels of the component and the selector labels
+func grafanaDashboard(name, asset string, commonLabels map[string]string, options *GrafanaDashboardOptions, data *grafanaDashboardTemplateData) *grafanav1alpha1.GrafanaDashboard {
+	if options == nil {
+		options = DefaultGrafanaDashboardOptions()
+	}
+	data.DatasourceName = options.DatasourceName
+
+	labels := make(map[string]string)
+	for key, value := range commonLabels {
+		labels[key] = value
+	}
+	for key, value := range options.Labels {
+		labels[key] = value
+	}
+
+	return &grafanav1alpha1.GrafanaDashboard{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       grafanav1alpha1.GrafanaDashboardKind,
+			APIVersion: grafanav1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: grafanav1alpha1.GrafanaDashboardSpec{
+			Json: assets.TemplateAsset(fmt.Sprintf("monitoring/%s.tpl", asset), data),
+			Name: fmt.Sprintf("%s/%s", data.Namespace, asset),
+		},
+	}
+}
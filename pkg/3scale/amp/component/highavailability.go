@@ -53,6 +53,10 @@ func (ha *HighAvailability) BackendRedisSecret() *v1.Secret {
 			BackendSecretBackendRedisStorageSentinelRoleFieldName:  ha.Options.BackendRedisStorageSentinelRole,
 			BackendSecretBackendRedisQueuesSentinelHostsFieldName:  ha.Options.BackendRedisQueuesSentinelHosts,
 			BackendSecretBackendRedisQueuesSentinelRoleFieldName:   ha.Options.BackendRedisQueuesSentinelRole,
+			BackendSecretBackendRedisStorageUsernameFieldName:      ha.Options.BackendRedisStorageUsername,
+			BackendSecretBackendRedisStoragePasswordFieldName:      ha.Options.BackendRedisStoragePassword,
+			BackendSecretBackendRedisQueuesUsernameFieldName:       ha.Options.BackendRedisQueuesUsername,
+			BackendSecretBackendRedisQueuesPasswordFieldName:       ha.Options.BackendRedisQueuesPassword,
 		},
 		Type: v1.SecretTypeOpaque,
 	}
@@ -73,6 +77,8 @@ func (ha *HighAvailability) SystemRedisSecret() *v1.Secret {
 			SystemSecretSystemRedisSentinelHosts: ha.Options.SystemRedisSentinelsHosts,
 			SystemSecretSystemRedisSentinelRole:  ha.Options.SystemRedisSentinelsRole,
 			SystemSecretSystemRedisNamespace:     ha.Options.SystemRedisNamespace,
+			SystemSecretSystemRedisUsername:      ha.Options.SystemRedisUsername,
+			SystemSecretSystemRedisPassword:      ha.Options.SystemRedisPassword,
 		},
 		Type: v1.SecretTypeOpaque,
 	}
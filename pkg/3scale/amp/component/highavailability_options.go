@@ -15,6 +15,14 @@ type HighAvailabilityOptions struct {
 	SystemRedisSentinelsRole         string
 	SystemRedisNamespace             string
 
+	// ACL credentials of the external redis. Optional, used by Redis 6 deployments
+	BackendRedisStorageUsername string
+	BackendRedisStoragePassword string
+	BackendRedisQueuesUsername  string
+	BackendRedisQueuesPassword  string
+	SystemRedisUsername         string
+	SystemRedisPassword         string
+
 	BackendRedisLabels   map[string]string `validate:"required"`
 	SystemRedisLabels    map[string]string `validate:"required"`
 	SystemDatabaseLabels map[string]string `validate:"required"`
@@ -0,0 +1,77 @@
+package component
+
+import (
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultHPACPUTargetUtilization is the target CPU utilization percentage when no target is set
+	DefaultHPACPUTargetUtilization int32 = 80
+)
+
+// HorizontalPodAutoscalerOptions scales the pods of a DeploymentConfig on their resource usage
+type HorizontalPodAutoscalerOptions struct {
+	MinReplicas             int32  `validate:"min=1"`
+	MaxReplicas             int32  `validate:"gtefield=MinReplicas"`
+	CPUTargetUtilization    *int32 `validate:"omitempty,min=1"`
+	MemoryTargetUtilization *int32 `validate:"omitempty,min=1"`
+}
+
+// horizontalPodAutoscaler returns the HPA scaling the DeploymentConfig. The spec is
+// only set when the options are set, the HPA is meant to be deleted otherwise
+func horizontalPodAutoscaler(dcName string, labels map[string]string, opts *HorizontalPodAutoscalerOptions) *autoscalingv2beta2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: "autoscaling/v2beta2",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   dcName,
+			Labels: labels,
+		},
+	}
+
+	if opts == nil {
+		return hpa
+	}
+
+	minReplicas := opts.MinReplicas
+	hpa.Spec = autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+			APIVersion: "apps.openshift.io/v1",
+			Kind:       "DeploymentConfig",
+			Name:       dcName,
+		},
+		MinReplicas: &minReplicas,
+		MaxReplicas: opts.MaxReplicas,
+	}
+
+	cpuTarget := opts.CPUTargetUtilization
+	if cpuTarget == nil && opts.MemoryTargetUtilization == nil {
+		defaultTarget := DefaultHPACPUTargetUtilization
+		cpuTarget = &defaultTarget
+	}
+	if cpuTarget != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceUtilizationMetric(v1.ResourceCPU, *cpuTarget))
+	}
+	if opts.MemoryTargetUtilization != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceUtilizationMetric(v1.ResourceMemory, *opts.MemoryTargetUtilization))
+	}
+
+	return hpa
+}
+
+func resourceUtilizationMetric(name v1.ResourceName, utilization int32) autoscalingv2beta2.MetricSpec {
+	return autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.ResourceMetricSourceType,
+		Resource: &autoscalingv2beta2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:               autoscalingv2beta2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// applyImagePullPolicy sets the image pull policy of the containers of the pod template,
+// init containers included. The pod template is not changed when the policy is not set
+func applyImagePullPolicy(template *v1.PodTemplateSpec, policy *v1.PullPolicy) {
+	if policy == nil {
+		return
+	}
+
+	for idx := range template.Spec.InitContainers {
+		template.Spec.InitContainers[idx].ImagePullPolicy = *policy
+	}
+	for idx := range template.Spec.Containers {
+		template.Spec.Containers[idx].ImagePullPolicy = *policy
+	}
+}
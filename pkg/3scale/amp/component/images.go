@@ -43,3 +43,15 @@ func ZyncPostgreSQLImageURL() string {
 func OCCLIImageURL() string {
 	return "quay.io/openshift/origin-cli:4.7"
 }
+
+func RedisExporterImageURL() string {
+	return "quay.io/oliver006/redis_exporter:v1.24.0"
+}
+
+func MySQLExporterImageURL() string {
+	return "quay.io/prometheus/mysqld-exporter:v0.13.0"
+}
+
+func PostgreSQLExporterImageURL() string {
+	return "quay.io/prometheuscommunity/postgres-exporter:v0.9.0"
+}
@@ -0,0 +1,72 @@
+package component
+
+import (
+	"strconv"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// InitContainersOptions customize the init containers of a component
+type InitContainersOptions struct {
+	// WaitDisabled removes the init container waiting for the dependencies of the component
+	WaitDisabled       bool
+	WaitImage          *string
+	WaitCommand        []string
+	WaitTimeoutSeconds *int32
+	// Containers are appended after the init containers managed by the operator
+	Containers []v1.Container
+}
+
+// applyInitContainers customizes the wait init container, the first init container of the pods,
+// and appends the init containers of the options. The wait init container is no longer updated
+// by the image change trigger when its image is overridden
+func applyInitContainers(dc *appsv1.DeploymentConfig, opts *InitContainersOptions) {
+	if opts == nil {
+		return
+	}
+
+	podSpec := &dc.Spec.Template.Spec
+	if len(podSpec.InitContainers) > 0 {
+		wait := &podSpec.InitContainers[0]
+		if opts.WaitDisabled || opts.WaitImage != nil {
+			removeImageChangeTriggerContainer(dc, wait.Name)
+		}
+
+		if opts.WaitDisabled {
+			podSpec.InitContainers = podSpec.InitContainers[1:]
+		} else {
+			if opts.WaitImage != nil {
+				wait.Image = *opts.WaitImage
+			}
+			if len(opts.WaitCommand) > 0 {
+				wait.Command = append([]string{}, opts.WaitCommand...)
+			}
+			if opts.WaitTimeoutSeconds != nil {
+				wait.Command = append([]string{"timeout", strconv.Itoa(int(*opts.WaitTimeoutSeconds))}, wait.Command...)
+			}
+		}
+	}
+
+	for idx := range opts.Containers {
+		podSpec.InitContainers = append(podSpec.InitContainers, *opts.Containers[idx].DeepCopy())
+	}
+
+	addPodTemplateOverridesHash(dc.Spec.Template, "initContainers", opts)
+}
+
+func removeImageChangeTriggerContainer(dc *appsv1.DeploymentConfig, containerName string) {
+	for idx := range dc.Spec.Triggers {
+		params := dc.Spec.Triggers[idx].ImageChangeParams
+		if params == nil {
+			continue
+		}
+		containerNames := []string{}
+		for _, name := range params.ContainerNames {
+			if name != containerName {
+				containerNames = append(containerNames, name)
+			}
+		}
+		params.ContainerNames = containerNames
+	}
+}
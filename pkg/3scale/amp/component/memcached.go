@@ -22,7 +22,7 @@ func NewMemcached(options *MemcachedOptions) *Memcached {
 }
 
 func (m *Memcached) DeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
@@ -71,9 +71,12 @@ func (m *Memcached) DeploymentConfig() *appsv1.DeploymentConfig {
 					Labels: m.Options.PodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
-					Affinity:           m.Options.Affinity,
-					Tolerations:        m.Options.Tolerations,
-					ServiceAccountName: "amp", //TODO make this configurable via flag
+					NodeSelector:              m.Options.NodeSelector,
+					Affinity:                  m.Options.Affinity,
+					Tolerations:               m.Options.Tolerations,
+					TopologySpreadConstraints: m.Options.TopologySpreadConstraints,
+					PriorityClassName:         m.Options.PriorityClassName,
+					ServiceAccountName:        "amp", //TODO make this configurable via flag
 					Containers: []v1.Container{
 						v1.Container{
 							Name:    "memcache",
@@ -115,4 +118,9 @@ func (m *Memcached) DeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	applyImagePullPolicy(dc.Spec.Template, m.Options.ImagePullPolicy)
+	applySecurityContextOptions(dc.Spec.Template, m.Options.SecurityContext)
+
+	return dc
 }
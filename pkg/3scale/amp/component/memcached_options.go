@@ -10,8 +10,14 @@ type MemcachedOptions struct {
 	ImageTag             string                  `validate:"required"`
 	ResourceRequirements v1.ResourceRequirements `validate:"-"`
 
-	Affinity    *v1.Affinity    `validate:"-"`
-	Tolerations []v1.Toleration `validate:"-"`
+	NodeSelector              map[string]string             `validate:"-"`
+	Affinity                  *v1.Affinity                  `validate:"-"`
+	Tolerations               []v1.Toleration               `validate:"-"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `validate:"-"`
+	PriorityClassName         string                        `validate:"-"`
+	ImagePullPolicy           *v1.PullPolicy                `validate:"-"`
+
+	SecurityContext *SecurityContextOptions `validate:"-"`
 
 	DeploymentLabels  map[string]string `validate:"required"`
 	PodTemplateLabels map[string]string `validate:"required"`
@@ -1,5 +1,47 @@
 package component
 
+import (
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
 const (
 	PDB_MAX_UNAVAILABLE_POD_NUMBER = 1
 )
+
+// PodDisruptionBudgetOptions is the disruption policy of the pods of a DeploymentConfig.
+// Only one of MinAvailable and MaxUnavailable is set
+type PodDisruptionBudgetOptions struct {
+	MinAvailable   *intstr.IntOrString
+	MaxUnavailable *intstr.IntOrString
+}
+
+// podDisruptionBudget returns the PDB of the pods of the DeploymentConfig. When the options
+// are not set, a single pod can be unavailable
+func podDisruptionBudget(dcName string, labels map[string]string, opts *PodDisruptionBudgetOptions) *v1beta1.PodDisruptionBudget {
+	pdb := &v1beta1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   dcName,
+			Labels: labels,
+		},
+		Spec: v1beta1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"deploymentConfig": dcName},
+			},
+		},
+	}
+
+	if opts == nil {
+		pdb.Spec.MaxUnavailable = &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER}
+		return pdb
+	}
+
+	pdb.Spec.MinAvailable = opts.MinAvailable
+	pdb.Spec.MaxUnavailable = opts.MaxUnavailable
+	return pdb
+}
@@ -0,0 +1,60 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// PodTemplateOverridesHashAnnotation holds the hash of the env vars, sidecars, init containers, termination,
+// probes and Ruby runtime tuning set in the APIManager. These parts of the existing pod templates are
+// defaulted by the API server, so they are only reconciled when the hash changes
+const PodTemplateOverridesHashAnnotation = "apps.3scale.net/pod-template-overrides-hash"
+
+// PodTemplateOverridesOptions are the pod template settings of a component
+// overridden in the APIManager
+type PodTemplateOverridesOptions struct {
+	NodeSelector              map[string]string             `validate:"-"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `validate:"-"`
+	PriorityClassName         string                        `validate:"-"`
+	DNSPolicy                 v1.DNSPolicy                  `validate:"-"`
+	DNSConfig                 *v1.PodDNSConfig              `validate:"-"`
+	HostAliases               []v1.HostAlias                `validate:"-"`
+	RuntimeClassName          *string                       `validate:"-"`
+	ImagePullPolicy           *v1.PullPolicy                `validate:"-"`
+	ImagePullSecrets          []v1.LocalObjectReference     `validate:"-"`
+}
+
+// applyPodTemplateOverridesOptions sets the overridden settings on the pod template.
+// The image pull policy is only set on the containers already in the pod template
+func applyPodTemplateOverridesOptions(template *v1.PodTemplateSpec, opts PodTemplateOverridesOptions) {
+	template.Spec.NodeSelector = opts.NodeSelector
+	template.Spec.TopologySpreadConstraints = opts.TopologySpreadConstraints
+	template.Spec.PriorityClassName = opts.PriorityClassName
+	template.Spec.DNSPolicy = opts.DNSPolicy
+	template.Spec.DNSConfig = opts.DNSConfig
+	template.Spec.HostAliases = opts.HostAliases
+	template.Spec.RuntimeClassName = opts.RuntimeClassName
+	template.Spec.ImagePullSecrets = opts.ImagePullSecrets
+	applyImagePullPolicy(template, opts.ImagePullPolicy)
+}
+
+// addPodTemplateOverridesHash folds the options of an overridden part of the pod template
+// into the PodTemplateOverridesHashAnnotation
+func addPodTemplateOverridesHash(template *v1.PodTemplateSpec, part string, opts interface{}) {
+	h := fnv.New32a()
+	h.Write([]byte(template.Annotations[PodTemplateOverridesHashAnnotation]))
+	h.Write([]byte(part))
+	data, _ := json.Marshal(opts)
+	h.Write(data)
+
+	// The annotations map may be shared with the custom annotations options
+	annotations := map[string]string{}
+	for key, val := range template.Annotations {
+		annotations[key] = val
+	}
+	annotations[PodTemplateOverridesHashAnnotation] = fmt.Sprint(h.Sum32())
+	template.Annotations = annotations
+}
@@ -0,0 +1,80 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// Default timing of the startup probes. The containers have 5 minutes to start
+	DefaultStartupProbePeriodSeconds    int32 = 10
+	DefaultStartupProbeFailureThreshold int32 = 30
+)
+
+// ProbeOptions overrides the timing of a probe. Unset fields keep the default values
+type ProbeOptions struct {
+	InitialDelaySeconds *int32 `validate:"omitempty,min=0"`
+	PeriodSeconds       *int32 `validate:"omitempty,min=1"`
+	TimeoutSeconds      *int32 `validate:"omitempty,min=1"`
+	FailureThreshold    *int32 `validate:"omitempty,min=1"`
+}
+
+// ProbesOptions tunes the probes of the containers of a component
+type ProbesOptions struct {
+	Liveness  *ProbeOptions `validate:"omitempty"`
+	Readiness *ProbeOptions `validate:"omitempty"`
+	// Startup adds a startup probe using the handler of the liveness probe
+	Startup *ProbeOptions `validate:"omitempty"`
+}
+
+func (p *ProbeOptions) apply(probe *v1.Probe) {
+	if p == nil || probe == nil {
+		return
+	}
+
+	if p.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *p.InitialDelaySeconds
+	}
+	if p.PeriodSeconds != nil {
+		probe.PeriodSeconds = *p.PeriodSeconds
+	}
+	if p.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *p.TimeoutSeconds
+	}
+	if p.FailureThreshold != nil {
+		probe.FailureThreshold = *p.FailureThreshold
+	}
+}
+
+// startupProbe returns the startup probe of a container with the given liveness probe
+func (p *ProbesOptions) startupProbe(liveness *v1.Probe) *v1.Probe {
+	if p.Startup == nil || liveness == nil {
+		return nil
+	}
+
+	probe := &v1.Probe{
+		Handler:          *liveness.Handler.DeepCopy(),
+		TimeoutSeconds:   liveness.TimeoutSeconds,
+		PeriodSeconds:    DefaultStartupProbePeriodSeconds,
+		FailureThreshold: DefaultStartupProbeFailureThreshold,
+	}
+	p.Startup.apply(probe)
+	return probe
+}
+
+// applyProbesOptions tunes the probes of the containers of the pod template.
+// The default probes are kept when the options are not set
+func applyProbesOptions(template *v1.PodTemplateSpec, opts *ProbesOptions) {
+	if opts == nil {
+		return
+	}
+
+	for idx := range template.Spec.Containers {
+		container := &template.Spec.Containers[idx]
+		// The startup probe is built from the default liveness probe handler and timeout
+		container.StartupProbe = opts.startupProbe(container.LivenessProbe)
+		opts.Liveness.apply(container.LivenessProbe)
+		opts.Readiness.apply(container.ReadinessProbe)
+	}
+
+	addPodTemplateOverridesHash(template, "probes", opts)
+}
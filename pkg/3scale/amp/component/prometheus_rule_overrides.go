@@ -0,0 +1,132 @@
+package component
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// PrometheusRuleOverridesHashAnnotation is set on the PrometheusRules
+	// having some overridden alert, so overrides changes are detected
+	PrometheusRuleOverridesHashAnnotation = "apps.3scale.net/prometheus-rule-overrides-hash"
+)
+
+var (
+	// Trailing comparison to a number, e.g. "> 5" or "== 0"
+	alertThresholdRegexp = regexp.MustCompile(`(?s)^(.*?)(==|!=|>=|<=|>|<)(\s*)(-?[0-9]+(?:\.[0-9]+)?)\s*$`)
+	// Set operators combining several comparisons
+	alertSetOperatorRegexp = regexp.MustCompile(`\b(and|or|unless)\b`)
+)
+
+// PrometheusRuleOverride tunes the alerting rule of the same name
+type PrometheusRuleOverride struct {
+	Severity  *string
+	Threshold *string
+	Disabled  bool
+}
+
+// OverrideAlertThreshold replaces the number the alert expression is compared to.
+// Only expressions ending with a single comparison to a number are supported.
+func OverrideAlertThreshold(expr, threshold string) (string, error) {
+	if alertSetOperatorRegexp.MatchString(expr) {
+		return "", fmt.Errorf("threshold not supported, the expression combines several comparisons")
+	}
+
+	match := alertThresholdRegexp.FindStringSubmatch(expr)
+	if match == nil {
+		return "", fmt.Errorf("threshold not supported, the expression does not end with a comparison to a number")
+	}
+
+	return match[1] + match[2] + match[3] + threshold, nil
+}
+
+// ApplyPrometheusRuleOverrides applies the overrides, by alert name, to the
+// alerting rules of the PrometheusRule. Disabled alerts are removed.
+// Overrides not applicable to the rule are skipped and reported in the
+// returned error, the rest of the overrides are applied.
+func ApplyPrometheusRuleOverrides(prometheusRule *monitoringv1.PrometheusRule, overrides map[string]PrometheusRuleOverride) error {
+	var overridden []string
+	var errs []string
+
+	groups := []monitoringv1.RuleGroup{}
+	for _, group := range prometheusRule.Spec.Groups {
+		rules := []monitoringv1.Rule{}
+		for _, rule := range group.Rules {
+			override, ok := overrides[rule.Alert]
+			if rule.Alert == "" || !ok {
+				rules = append(rules, rule)
+				continue
+			}
+
+			overridden = append(overridden, rule.Alert)
+			if override.Disabled {
+				continue
+			}
+
+			if override.Severity != nil {
+				labels := map[string]string{}
+				for key, value := range rule.Labels {
+					labels[key] = value
+				}
+				labels["severity"] = *override.Severity
+				rule.Labels = labels
+			}
+
+			if override.Threshold != nil {
+				expr, err := OverrideAlertThreshold(rule.Expr.String(), *override.Threshold)
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("%s: %s", rule.Alert, err.Error()))
+				} else {
+					rule.Expr = intstr.FromString(expr)
+				}
+			}
+
+			rules = append(rules, rule)
+		}
+
+		// Groups left without rules are removed
+		if len(rules) > 0 {
+			group.Rules = rules
+			groups = append(groups, group)
+		}
+	}
+
+	if len(overridden) == 0 {
+		return nil
+	}
+
+	prometheusRule.Spec.Groups = groups
+
+	if prometheusRule.Annotations == nil {
+		prometheusRule.Annotations = map[string]string{}
+	}
+	prometheusRule.Annotations[PrometheusRuleOverridesHashAnnotation] = prometheusRuleOverridesHash(overridden, overrides)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func prometheusRuleOverridesHash(alerts []string, overrides map[string]PrometheusRuleOverride) string {
+	sort.Strings(alerts)
+
+	h := fnv.New32a()
+	for _, alert := range alerts {
+		override := overrides[alert]
+		fmt.Fprintf(h, "%s;%t;", alert, override.Disabled)
+		if override.Severity != nil {
+			fmt.Fprintf(h, "severity=%s;", *override.Severity)
+		}
+		if override.Threshold != nil {
+			fmt.Fprintf(h, "threshold=%s;", *override.Threshold)
+		}
+	}
+	return fmt.Sprint(h.Sum32())
+}
@@ -3,6 +3,7 @@ package component
 import (
 	"fmt"
 
+	"github.com/3scale/3scale-operator/pkg/helper"
 	appsv1 "github.com/openshift/api/apps/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	v1 "k8s.io/api/core/v1"
@@ -106,18 +107,26 @@ func (redis *Redis) buildDeploymentConfigTriggers() appsv1.DeploymentTriggerPoli
 }
 
 func (redis *Redis) buildPodTemplateSpec() *v1.PodTemplateSpec {
-	return &v1.PodTemplateSpec{
+	template := &v1.PodTemplateSpec{
 		Spec: v1.PodSpec{
-			Affinity:           redis.Options.BackendRedisAffinity,
-			Tolerations:        redis.Options.BackendRedisTolerations,
-			ServiceAccountName: "amp", //TODO make this configurable via flag
-			Volumes:            redis.buildPodVolumes(),
-			Containers:         redis.buildPodContainers(),
+			NodeSelector:              redis.Options.BackendRedisNodeSelector,
+			Affinity:                  redis.Options.BackendRedisAffinity,
+			Tolerations:               redis.Options.BackendRedisTolerations,
+			TopologySpreadConstraints: redis.Options.BackendRedisTopologySpreadConstraints,
+			PriorityClassName:         redis.Options.BackendRedisPriorityClassName,
+			ServiceAccountName:        "amp", //TODO make this configurable via flag
+			Volumes:                   redis.buildPodVolumes(),
+			Containers:                redis.buildPodContainers(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Labels: redis.Options.BackendRedisPodTemplateLabels,
 		},
 	}
+
+	applyImagePullPolicy(template, redis.Options.BackendRedisImagePullPolicy)
+	applySecurityContextOptions(template, redis.Options.BackendRedisSecurityContext)
+
+	return template
 }
 
 func (redis *Redis) buildPodVolumes() []v1.Volume {
@@ -359,8 +368,9 @@ func (redis *Redis) BackendPVC() *v1.PersistentVolumeClaim {
 
 func (redis *Redis) buildPVCObjectMeta() metav1.ObjectMeta {
 	return metav1.ObjectMeta{
-		Name:   backendRedisStorageVolumeName,
-		Labels: redis.Options.BackendRedisLabels,
+		Name:        backendRedisStorageVolumeName,
+		Labels:      helper.MergeMapsStringString(redis.Options.BackendRedisPVCLabels, redis.Options.BackendRedisLabels),
+		Annotations: redis.Options.BackendRedisPVCAnnotations,
 	}
 }
 
@@ -440,7 +450,7 @@ func (redis *Redis) BackendRedisSecret() *v1.Secret {
 ////// Begin System Redis
 
 func (redis *Redis) SystemDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
@@ -478,9 +488,12 @@ func (redis *Redis) SystemDeploymentConfig() *appsv1.DeploymentConfig {
 					Labels: redis.Options.SystemRedisPodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
-					Affinity:           redis.Options.SystemRedisAffinity,
-					Tolerations:        redis.Options.SystemRedisTolerations,
-					ServiceAccountName: "amp", //TODO make this configurable via flag
+					NodeSelector:              redis.Options.SystemRedisNodeSelector,
+					Affinity:                  redis.Options.SystemRedisAffinity,
+					Tolerations:               redis.Options.SystemRedisTolerations,
+					TopologySpreadConstraints: redis.Options.SystemRedisTopologySpreadConstraints,
+					PriorityClassName:         redis.Options.SystemRedisPriorityClassName,
+					ServiceAccountName:        "amp", //TODO make this configurable via flag
 					Volumes: []v1.Volume{
 						v1.Volume{
 							Name: "system-redis-storage",
@@ -545,6 +558,11 @@ func (redis *Redis) SystemDeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	applyImagePullPolicy(dc.Spec.Template, redis.Options.SystemRedisImagePullPolicy)
+	applySecurityContextOptions(dc.Spec.Template, redis.Options.SystemRedisSecurityContext)
+
+	return dc
 }
 
 func (redis *Redis) SystemService() *v1.Service {
@@ -578,8 +596,9 @@ func (redis *Redis) SystemPVC() *v1.PersistentVolumeClaim {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-redis-storage",
-			Labels: redis.Options.SystemRedisLabels,
+			Name:        "system-redis-storage",
+			Labels:      helper.MergeMapsStringString(redis.Options.SystemRedisPVCLabels, redis.Options.SystemRedisLabels),
+			Annotations: redis.Options.SystemRedisPVCAnnotations,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{
@@ -0,0 +1,108 @@
+package component
+
+import (
+	"path"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	BackendRedisCAVolumeName = "backend-redis-ca"
+	SystemRedisCAVolumeName  = "system-redis-ca"
+	RedisCAMountPath         = "/etc/redis-ca"
+	RedisCASecretKey         = "ca.crt"
+)
+
+const (
+	BackendConfigRedisUsernameEnvVarName  = "CONFIG_REDIS_USERNAME"
+	BackendConfigRedisPasswordEnvVarName  = "CONFIG_REDIS_PASSWORD"
+	BackendConfigRedisCAFileEnvVarName    = "CONFIG_REDIS_CA_FILE"
+	BackendConfigQueuesUsernameEnvVarName = "CONFIG_QUEUES_USERNAME"
+	BackendConfigQueuesPasswordEnvVarName = "CONFIG_QUEUES_PASSWORD"
+	BackendConfigQueuesCAFileEnvVarName   = "CONFIG_QUEUES_CA_FILE"
+)
+
+const (
+	SystemRedisUsernameEnvVarName        = "REDIS_USERNAME"
+	SystemRedisPasswordEnvVarName        = "REDIS_PASSWORD"
+	SystemRedisCAFileEnvVarName          = "REDIS_CA_FILE"
+	SystemBackendRedisUsernameEnvVarName = "BACKEND_REDIS_USERNAME"
+	SystemBackendRedisPasswordEnvVarName = "BACKEND_REDIS_PASSWORD"
+	SystemBackendRedisCAFileEnvVarName   = "BACKEND_REDIS_CA_FILE"
+)
+
+// BackendRedisCredentialsEnvVarNames are the env vars of the backend pods set from
+// the ACL credentials and the CA certificate of the backend redis
+var BackendRedisCredentialsEnvVarNames = []string{
+	BackendConfigRedisUsernameEnvVarName,
+	BackendConfigRedisPasswordEnvVarName,
+	BackendConfigRedisCAFileEnvVarName,
+	BackendConfigQueuesUsernameEnvVarName,
+	BackendConfigQueuesPasswordEnvVarName,
+	BackendConfigQueuesCAFileEnvVarName,
+}
+
+// SystemRedisCredentialsEnvVarNames are the env vars of the system pods set from
+// the ACL credentials and the CA certificates of the system and backend redis
+var SystemRedisCredentialsEnvVarNames = []string{
+	SystemRedisUsernameEnvVarName,
+	SystemRedisPasswordEnvVarName,
+	SystemRedisCAFileEnvVarName,
+	SystemBackendRedisUsernameEnvVarName,
+	SystemBackendRedisPasswordEnvVarName,
+	SystemBackendRedisCAFileEnvVarName,
+}
+
+// RedisCAFile returns the path of the CA certificate mounted from the given volume
+func RedisCAFile(volumeName string) string {
+	return path.Join(RedisCAMountPath, volumeName, RedisCASecretKey)
+}
+
+// redisCAFileEnvVars returns the CA file env vars. None when the CA secret is not set
+func redisCAFileEnvVars(secretName, volumeName string, envVarNames ...string) []v1.EnvVar {
+	if secretName == "" {
+		return nil
+	}
+
+	result := []v1.EnvVar{}
+	for _, envVarName := range envVarNames {
+		result = append(result, helper.EnvVarFromValue(envVarName, RedisCAFile(volumeName)))
+	}
+	return result
+}
+
+// applyRedisCA mounts the redis CA secret in the containers and init containers of the
+// pod template. The init containers wait for redis, so they connect to it as well
+func applyRedisCA(template *v1.PodTemplateSpec, secretName, volumeName string) {
+	if secretName == "" {
+		return
+	}
+
+	template.Spec.Volumes = append(template.Spec.Volumes, v1.Volume{
+		Name: volumeName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: secretName,
+				Items: []v1.KeyToPath{
+					{Key: RedisCASecretKey, Path: RedisCASecretKey},
+				},
+				DefaultMode: &[]int32{0644}[0],
+			},
+		},
+	})
+
+	volumeMount := v1.VolumeMount{
+		Name:      volumeName,
+		MountPath: path.Join(RedisCAMountPath, volumeName),
+		ReadOnly:  true,
+	}
+	for idx := range template.Spec.InitContainers {
+		container := &template.Spec.InitContainers[idx]
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+	}
+	for idx := range template.Spec.Containers {
+		container := &template.Spec.Containers[idx]
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+	}
+}
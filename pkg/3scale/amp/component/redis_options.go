@@ -17,11 +17,26 @@ type RedisOptions struct {
 	InsecureImportPolicy                      *bool                    `validate:"required"`
 	BackendRedisPVCStorageClass               *string
 	SystemRedisPVCStorageClass                *string
-
-	BackendRedisAffinity    *v1.Affinity    `validate:"-"`
-	BackendRedisTolerations []v1.Toleration `validate:"-"`
-	SystemRedisAffinity     *v1.Affinity    `validate:"-"`
-	SystemRedisTolerations  []v1.Toleration `validate:"-"`
+	BackendRedisPVCAnnotations                map[string]string
+	BackendRedisPVCLabels                     map[string]string
+	SystemRedisPVCAnnotations                 map[string]string
+	SystemRedisPVCLabels                      map[string]string
+
+	BackendRedisNodeSelector              map[string]string             `validate:"-"`
+	BackendRedisAffinity                  *v1.Affinity                  `validate:"-"`
+	BackendRedisTolerations               []v1.Toleration               `validate:"-"`
+	BackendRedisTopologySpreadConstraints []v1.TopologySpreadConstraint `validate:"-"`
+	BackendRedisPriorityClassName         string                        `validate:"-"`
+	BackendRedisImagePullPolicy           *v1.PullPolicy                `validate:"-"`
+	SystemRedisNodeSelector               map[string]string             `validate:"-"`
+	SystemRedisAffinity                   *v1.Affinity                  `validate:"-"`
+	SystemRedisTolerations                []v1.Toleration               `validate:"-"`
+	SystemRedisTopologySpreadConstraints  []v1.TopologySpreadConstraint `validate:"-"`
+	SystemRedisPriorityClassName          string                        `validate:"-"`
+	SystemRedisImagePullPolicy            *v1.PullPolicy                `validate:"-"`
+
+	BackendRedisSecurityContext *SecurityContextOptions `validate:"-"`
+	SystemRedisSecurityContext  *SecurityContextOptions `validate:"-"`
 
 	SystemCommonLabels            map[string]string `validate:"required"`
 	SystemRedisLabels             map[string]string `validate:"required"`
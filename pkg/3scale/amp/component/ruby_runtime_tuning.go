@@ -0,0 +1,68 @@
+package component
+
+import (
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	MallocArenaMaxEnvVarName         = "MALLOC_ARENA_MAX"
+	RubyGCHeapGrowthFactorEnvVarName = "RUBY_GC_HEAP_GROWTH_FACTOR"
+	RubyGCHeapInitSlotsEnvVarName    = "RUBY_GC_HEAP_INIT_SLOTS"
+	RubyYJITEnableEnvVarName         = "RUBY_YJIT_ENABLE"
+)
+
+// RubyRuntimeTuningEnvVarNames are the env vars set by the Ruby runtime tuning
+var RubyRuntimeTuningEnvVarNames = []string{
+	MallocArenaMaxEnvVarName,
+	RubyGCHeapGrowthFactorEnvVarName,
+	RubyGCHeapInitSlotsEnvVarName,
+	RubyYJITEnableEnvVarName,
+}
+
+// RubyRuntimeTuningOptions tunes the memory allocator and the garbage collector
+// of the Ruby processes of a component. Unset fields keep the defaults of the image
+type RubyRuntimeTuningOptions struct {
+	MallocArenaMax     *int32  `validate:"omitempty,min=1"`
+	GCHeapGrowthFactor *string `validate:"omitempty,numeric"`
+	GCHeapInitSlots    *int64  `validate:"omitempty,min=1"`
+	RubyYJIT           *bool
+}
+
+// EnvVars returns the env vars of the set options
+func (r *RubyRuntimeTuningOptions) EnvVars() []v1.EnvVar {
+	env := []v1.EnvVar{}
+	if r.MallocArenaMax != nil {
+		env = append(env, v1.EnvVar{Name: MallocArenaMaxEnvVarName, Value: strconv.FormatInt(int64(*r.MallocArenaMax), 10)})
+	}
+	if r.GCHeapGrowthFactor != nil {
+		env = append(env, v1.EnvVar{Name: RubyGCHeapGrowthFactorEnvVarName, Value: *r.GCHeapGrowthFactor})
+	}
+	if r.GCHeapInitSlots != nil {
+		env = append(env, v1.EnvVar{Name: RubyGCHeapInitSlotsEnvVarName, Value: strconv.FormatInt(*r.GCHeapInitSlots, 10)})
+	}
+	if r.RubyYJIT != nil {
+		value := "0"
+		if *r.RubyYJIT {
+			value = "1"
+		}
+		env = append(env, v1.EnvVar{Name: RubyYJITEnableEnvVarName, Value: value})
+	}
+	return env
+}
+
+// applyRubyRuntimeTuningOptions sets the Ruby runtime tuning env vars in the containers of the pod template
+func applyRubyRuntimeTuningOptions(template *v1.PodTemplateSpec, opts *RubyRuntimeTuningOptions) {
+	if opts == nil {
+		return
+	}
+
+	env := opts.EnvVars()
+	for idx := range template.Spec.Containers {
+		container := &template.Spec.Containers[idx]
+		container.Env = append(container.Env, env...)
+	}
+
+	addPodTemplateOverridesHash(template, "rubyRuntimeTuning", opts)
+}
@@ -3,6 +3,8 @@ package component
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
 )
 
 const (
@@ -13,8 +15,38 @@ const (
 	AwsProtocol        = "AWS_PROTOCOL"
 	AwsHostname        = "AWS_HOSTNAME"
 	AwsPathStyle       = "AWS_PATH_STYLE"
+
+	// SystemFileUploadStorageEnvVarName is set to "s3" in the system-environment
+	// ConfigMap when the file storage is S3
+	SystemFileUploadStorageEnvVarName = "FILE_UPLOAD_STORAGE"
 )
 
+// SystemS3FileStorageEnvVarNames are the env vars of the system containers only
+// set when the file storage is S3
+var SystemS3FileStorageEnvVarNames = []string{
+	SystemFileUploadStorageEnvVarName,
+	AwsAccessKeyID,
+	AwsSecretAccessKey,
+	AwsBucket,
+	AwsRegion,
+	AwsProtocol,
+	AwsHostname,
+	AwsPathStyle,
+}
+
+// S3ConfigurationEnvVars returns the env vars read from the S3 configuration secret
+func S3ConfigurationEnvVars(configurationSecretName string) []v1.EnvVar {
+	return []v1.EnvVar{
+		helper.EnvVarFromSecret(AwsAccessKeyID, configurationSecretName, AwsAccessKeyID),
+		helper.EnvVarFromSecret(AwsSecretAccessKey, configurationSecretName, AwsSecretAccessKey),
+		helper.EnvVarFromSecret(AwsBucket, configurationSecretName, AwsBucket),
+		helper.EnvVarFromSecret(AwsRegion, configurationSecretName, AwsRegion),
+		helper.EnvVarFromSecretOptional(AwsProtocol, configurationSecretName, AwsProtocol),
+		helper.EnvVarFromSecretOptional(AwsHostname, configurationSecretName, AwsHostname),
+		helper.EnvVarFromSecretOptional(AwsPathStyle, configurationSecretName, AwsPathStyle),
+	}
+}
+
 type S3 struct {
 	Options *S3Options
 }
@@ -0,0 +1,116 @@
+package component
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// SecretHashAnnotation holds the hash of the secret keys read by the env vars of
+	// the pods. Env vars are only read when the containers start, so the pods are
+	// rolled out when the secrets change
+	SecretHashAnnotation = "apps.3scale.net/secret-hash"
+
+	// SecretHashSkipAnnotation set to "true" on a secret excludes it from the secret hash,
+	// for secrets whose rollouts are managed externally
+	SecretHashSkipAnnotation = "apps.3scale.net/skip-secret-hash"
+)
+
+// SecretReference is a secret read by the env vars of a pod
+type SecretReference struct {
+	Name string
+	// Keys are the referenced keys, sorted. Nil when the whole secret is loaded with envFrom
+	Keys []string
+}
+
+// PodSecretReferences returns the secrets referenced by the env vars of the containers
+// and init containers of the pod, sorted by name
+func PodSecretReferences(podSpec *v1.PodSpec) []SecretReference {
+	keys := map[string]map[string]bool{}
+	allKeys := map[string]bool{}
+
+	containers := append(append([]v1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				allKeys[envFrom.SecretRef.Name] = true
+			}
+		}
+		for _, envVar := range container.Env {
+			if envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef == nil {
+				continue
+			}
+			ref := envVar.ValueFrom.SecretKeyRef
+			if keys[ref.Name] == nil {
+				keys[ref.Name] = map[string]bool{}
+			}
+			keys[ref.Name][ref.Key] = true
+		}
+	}
+
+	result := []SecretReference{}
+	for name := range allKeys {
+		result = append(result, SecretReference{Name: name})
+	}
+	for name, secretKeys := range keys {
+		if allKeys[name] {
+			continue
+		}
+		ref := SecretReference{Name: name, Keys: []string{}}
+		for key := range secretKeys {
+			ref.Keys = append(ref.Keys, key)
+		}
+		sort.Strings(ref.Keys)
+		result = append(result, ref)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+// SecretHash returns the hash of the referenced keys of the secrets. Missing secrets
+// are hashed by name only, so the hash changes when they are created
+func SecretHash(refs []SecretReference, secrets map[string]*v1.Secret) string {
+	h := fnv.New32a()
+	for _, ref := range refs {
+		h.Write([]byte(ref.Name))
+		h.Write([]byte{0})
+		secret, ok := secrets[ref.Name]
+		if !ok || secret == nil {
+			continue
+		}
+
+		keys := ref.Keys
+		if keys == nil {
+			keys = []string{}
+			for key := range secret.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+		}
+		for _, key := range keys {
+			value, ok := secret.Data[key]
+			if !ok {
+				continue
+			}
+			// The key is hashed, so moving a value between keys changes the hash
+			h.Write([]byte(key))
+			h.Write([]byte{0})
+			h.Write(value)
+			h.Write([]byte{0})
+		}
+	}
+	return fmt.Sprint(h.Sum32())
+}
+
+// PodSecretNames returns the names of the secrets referenced by the env vars of the pod
+func PodSecretNames(podSpec *v1.PodSpec) []string {
+	result := []string{}
+	for _, ref := range PodSecretReferences(podSpec) {
+		result = append(result, ref.Name)
+	}
+	return result
+}
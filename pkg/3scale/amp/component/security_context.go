@@ -0,0 +1,37 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SecurityContextOptions overrides the security context of the pods of a component,
+// e.g. to comply with restricted SecurityContextConstraints or PodSecurity admission
+type SecurityContextOptions struct {
+	// PodSecurityContext is the security context of the pod
+	PodSecurityContext *v1.PodSecurityContext
+	// SecurityContext is set in every container, init containers included
+	SecurityContext *v1.SecurityContext
+}
+
+// applySecurityContextOptions sets the security contexts of the pod template.
+// The pod template is not changed when the options are not set
+func applySecurityContextOptions(template *v1.PodTemplateSpec, opts *SecurityContextOptions) {
+	if opts == nil {
+		return
+	}
+
+	if opts.PodSecurityContext != nil {
+		template.Spec.SecurityContext = opts.PodSecurityContext.DeepCopy()
+	}
+
+	if opts.SecurityContext == nil {
+		return
+	}
+
+	for idx := range template.Spec.InitContainers {
+		template.Spec.InitContainers[idx].SecurityContext = opts.SecurityContext.DeepCopy()
+	}
+	for idx := range template.Spec.Containers {
+		template.Spec.Containers[idx].SecurityContext = opts.SecurityContext.DeepCopy()
+	}
+}
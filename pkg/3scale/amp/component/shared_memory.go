@@ -0,0 +1,44 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	SharedMemoryVolumeName = "shm"
+	SharedMemoryMountPath  = "/dev/shm"
+)
+
+// sharedMemoryVolumes returns the memory backed volume replacing the
+// container runtime default /dev/shm. Nothing is returned when sizeLimit is not set
+func sharedMemoryVolumes(sizeLimit *resource.Quantity) []v1.Volume {
+	if sizeLimit == nil {
+		return nil
+	}
+
+	return []v1.Volume{
+		{
+			Name: SharedMemoryVolumeName,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{
+					Medium:    v1.StorageMediumMemory,
+					SizeLimit: sizeLimit,
+				},
+			},
+		},
+	}
+}
+
+func sharedMemoryVolumeMounts(sizeLimit *resource.Quantity) []v1.VolumeMount {
+	if sizeLimit == nil {
+		return nil
+	}
+
+	return []v1.VolumeMount{
+		{
+			Name:      SharedMemoryVolumeName,
+			MountPath: SharedMemoryMountPath,
+		},
+	}
+}
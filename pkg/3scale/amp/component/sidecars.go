@@ -0,0 +1,63 @@
+package component
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// SidecarContainersAnnotation lists the sidecar containers of the pod template, comma separated,
+	// so the sidecars removed from the APIManager are also removed from the existing pod templates
+	SidecarContainersAnnotation = "apps.3scale.net/sidecar-containers"
+	// SidecarVolumesAnnotation lists the sidecar volumes of the pod template, comma separated
+	SidecarVolumesAnnotation = "apps.3scale.net/sidecar-volumes"
+)
+
+// SidecarsOptions are the containers and volumes added to the pods of a component
+type SidecarsOptions struct {
+	Containers []v1.Container
+	Volumes    []v1.Volume
+}
+
+// SidecarNames returns the names listed in the value of a sidecar annotation
+func SidecarNames(annotation string) []string {
+	var names []string
+	for _, name := range strings.Split(annotation, ",") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// applySidecars appends the sidecar containers and volumes to the pod template.
+// It is applied last, so the options of the component containers are not set in the sidecars
+func applySidecars(template *v1.PodTemplateSpec, opts *SidecarsOptions) {
+	if opts == nil {
+		return
+	}
+
+	containerNames := make([]string, 0, len(opts.Containers))
+	for idx := range opts.Containers {
+		template.Spec.Containers = append(template.Spec.Containers, *opts.Containers[idx].DeepCopy())
+		containerNames = append(containerNames, opts.Containers[idx].Name)
+	}
+
+	volumeNames := make([]string, 0, len(opts.Volumes))
+	for idx := range opts.Volumes {
+		template.Spec.Volumes = append(template.Spec.Volumes, *opts.Volumes[idx].DeepCopy())
+		volumeNames = append(volumeNames, opts.Volumes[idx].Name)
+	}
+
+	// The annotations map may be shared with the custom annotations options
+	annotations := map[string]string{}
+	for key, val := range template.Annotations {
+		annotations[key] = val
+	}
+	annotations[SidecarContainersAnnotation] = strings.Join(containerNames, ",")
+	annotations[SidecarVolumesAnnotation] = strings.Join(volumeNames, ",")
+	template.Annotations = annotations
+
+	addPodTemplateOverridesHash(template, "sidecars", opts)
+}
@@ -0,0 +1,147 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/coreos/prometheus-operator/pkg/apis/monitoring"
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	DefaultSLOAvailabilityObjective = "99.5"
+)
+
+// SLOOptions configures the recording rules of a gateway
+type SLOOptions struct {
+	// AvailabilityObjective is the percentage of requests expected
+	// not to fail with 5XX status codes
+	AvailabilityObjective string `validate:"required"`
+}
+
+func DefaultSLOOptions() *SLOOptions {
+	return &SLOOptions{
+		AvailabilityObjective: DefaultSLOAvailabilityObjective,
+	}
+}
+
+// Windows of the recorded success ratios
+var sloSuccessRatioWindows = []string{"5m", "30m", "1h", "6h"}
+
+// Recorded latency percentiles
+var sloLatencyQuantiles = []string{"95", "99"}
+
+// sloBurnRateAlert fires when the error ratio of both windows consumes the
+// error budget faster than the factor. The short window resets the alert
+// soon after the errors stop
+type sloBurnRateAlert struct {
+	name        string
+	longWindow  string
+	shortWindow string
+	factor      string
+	forDuration string
+	severity    string
+}
+
+var sloBurnRateAlerts = []sloBurnRateAlert{
+	// 2% of a 30 days error budget consumed in one hour
+	{"ErrorBudgetFastBurn", "1h", "5m", "14.4", "2m", "critical"},
+	// 5% of a 30 days error budget consumed in six hours
+	{"ErrorBudgetSlowBurn", "6h", "30m", "6", "15m", "warning"},
+}
+
+// sloGateway describes the metrics the recording rules of a gateway are computed from
+type sloGateway struct {
+	// Name of the gateway in the recorded series
+	name string
+	// Prefix of the alert names
+	alertPrefix string
+	// Description of the gateway in the alerts
+	description string
+	// Selector of the series of the gateway
+	selector string
+	// Counter of the responses
+	responsesMetric string
+	// Selector of the 5XX responses
+	errorsSelector string
+	// Histogram of the response times
+	durationBucketMetric string
+}
+
+// SLOSuccessRatioRecord returns the name of the success ratio series recorded for the window.
+// The series are aggregated by namespace, so the series of several APIManagers
+// scraped by the same prometheus do not collide
+func SLOSuccessRatioRecord(gateway, window string) string {
+	return fmt.Sprintf("namespace:threescale_%s_requests:success_ratio_rate%s", gateway, window)
+}
+
+// SLOLatencyRecord returns the name of the latency percentile series recorded over 5 minutes
+func SLOLatencyRecord(gateway, quantile string) string {
+	return fmt.Sprintf("namespace:threescale_%s_request_duration_seconds:p%s_rate5m", gateway, quantile)
+}
+
+func sloPrometheusRules(name, namespace string, labels map[string]string, gateway *sloGateway, options *SLOOptions) *monitoringv1.PrometheusRule {
+	if options == nil {
+		options = DefaultSLOOptions()
+	}
+
+	return &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1.PrometheusRuleKind,
+			APIVersion: fmt.Sprintf("%s/%s", monitoring.GroupName, monitoringv1.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name:  fmt.Sprintf("%s/%s.rules", namespace, name),
+					Rules: gateway.rules(namespace, options),
+				},
+			},
+		},
+	}
+}
+
+func (g *sloGateway) rules(namespace string, options *SLOOptions) []monitoringv1.Rule {
+	rules := []monitoringv1.Rule{}
+
+	for _, window := range sloSuccessRatioWindows {
+		rules = append(rules, monitoringv1.Rule{
+			Record: SLOSuccessRatioRecord(g.name, window),
+			Expr: intstr.FromString(fmt.Sprintf(`1 - (sum by (namespace) (rate(%s{%s,%s}[%s])) / sum by (namespace) (rate(%s{%s}[%s])))`,
+				g.responsesMetric, g.selector, g.errorsSelector, window, g.responsesMetric, g.selector, window)),
+		})
+	}
+
+	for _, quantile := range sloLatencyQuantiles {
+		rules = append(rules, monitoringv1.Rule{
+			Record: SLOLatencyRecord(g.name, quantile),
+			Expr: intstr.FromString(fmt.Sprintf(`histogram_quantile(0.%s, sum by (namespace, le) (rate(%s{%s}[5m])))`,
+				quantile, g.durationBucketMetric, g.selector)),
+		})
+	}
+
+	for _, alert := range sloBurnRateAlerts {
+		threshold := fmt.Sprintf("(%s * (100 - %s) / 100)", alert.factor, options.AvailabilityObjective)
+		rules = append(rules, monitoringv1.Rule{
+			Alert: g.alertPrefix + alert.name,
+			Annotations: map[string]string{
+				"summary":     fmt.Sprintf("%s on {{ $labels.namespace }} is consuming its error budget too fast", g.description),
+				"description": fmt.Sprintf("The %s error ratio over the last %s and %s is more than %s times the ratio allowed by the %s%% availability objective", g.description, alert.longWindow, alert.shortWindow, alert.factor, options.AvailabilityObjective),
+			},
+			Expr: intstr.FromString(fmt.Sprintf(`(1 - %s{namespace="%s"}) > %s and (1 - %s{namespace="%s"}) > %s`,
+				SLOSuccessRatioRecord(g.name, alert.longWindow), namespace, threshold,
+				SLOSuccessRatioRecord(g.name, alert.shortWindow), namespace, threshold)),
+			For: alert.forDuration,
+			Labels: map[string]string{
+				"severity": alert.severity,
+			},
+		})
+	}
+
+	return rules
+}
@@ -0,0 +1,57 @@
+package component
+
+import (
+	"strconv"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	StatsdHostEnvVarName     = "STATSD_HOST"
+	StatsdPortEnvVarName     = "STATSD_PORT"
+	StatsdProtocolEnvVarName = "STATSD_PROTOCOL"
+	StatsdPrefixEnvVarName   = "STATSD_PREFIX"
+)
+
+const (
+	StatsdProtocolUDP = "udp"
+	StatsdProtocolTCP = "tcp"
+
+	DefaultStatsdPort     int32 = 8125
+	DefaultStatsdProtocol       = StatsdProtocolUDP
+)
+
+// StatsdEnvVarNames are the env vars configuring the statsd metrics sink
+var StatsdEnvVarNames = []string{
+	StatsdHostEnvVarName,
+	StatsdPortEnvVarName,
+	StatsdProtocolEnvVarName,
+	StatsdPrefixEnvVarName,
+}
+
+// StatsdOptions configures the statsd metrics sink of system and backend
+type StatsdOptions struct {
+	Host     string `validate:"required"`
+	Port     int32  `validate:"min=1,max=65535"`
+	Protocol string `validate:"oneof=udp tcp"`
+	Prefix   string
+}
+
+// StatsdEnvVars returns the statsd env vars. Empty when the sink is not configured
+func StatsdEnvVars(opts *StatsdOptions) []v1.EnvVar {
+	if opts == nil {
+		return nil
+	}
+
+	result := []v1.EnvVar{
+		helper.EnvVarFromValue(StatsdHostEnvVarName, opts.Host),
+		helper.EnvVarFromValue(StatsdPortEnvVarName, strconv.Itoa(int(opts.Port))),
+		helper.EnvVarFromValue(StatsdProtocolEnvVarName, opts.Protocol),
+	}
+	if opts.Prefix != "" {
+		result = append(result, helper.EnvVarFromValue(StatsdPrefixEnvVarName, opts.Prefix))
+	}
+
+	return result
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/3scale/3scale-operator/pkg/helper"
 	appsv1 "github.com/openshift/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -28,6 +29,33 @@ const (
 	SystemSecretSystemMemcachedServersFieldName = "SERVERS"
 )
 
+const (
+	SystemCacheStoreMemcached = "memcached"
+	SystemCacheStoreRedis     = "redis"
+
+	SystemMemcacheServersEnvVarName     = "MEMCACHE_SERVERS"
+	SystemCacheStoreEnvVarName          = "CACHE_STORE"
+	SystemCacheRedisURLEnvVarName       = "CACHE_REDIS_URL"
+	SystemCacheRedisNamespaceEnvVarName = "CACHE_REDIS_NAMESPACE"
+
+	// SystemCacheRedisNamespace keeps cache keys apart from the system-redis data keys
+	SystemCacheRedisNamespace = "system-cache"
+)
+
+// SystemCacheRedisMinMemoryLimit is the minimum recommended memory limit
+// of the internal system-redis when used as cache store
+func SystemCacheRedisMinMemoryLimit() resource.Quantity {
+	return resource.MustParse("1Gi")
+}
+
+// SystemCacheStoreEnvVarNames are the env vars depending on the selected cache store
+var SystemCacheStoreEnvVarNames = []string{
+	SystemMemcacheServersEnvVarName,
+	SystemCacheStoreEnvVarName,
+	SystemCacheRedisURLEnvVarName,
+	SystemCacheRedisNamespaceEnvVarName,
+}
+
 const (
 	SystemSecretSystemRecaptchaSecretName          = "system-recaptcha"
 	SystemSecretSystemRecaptchaPublicKeyFieldName  = "PUBLIC_KEY"
@@ -46,6 +74,8 @@ const (
 	SystemSecretSystemRedisNamespace     = "NAMESPACE"
 	SystemSecretSystemRedisSentinelHosts = "SENTINEL_HOSTS"
 	SystemSecretSystemRedisSentinelRole  = "SENTINEL_ROLE"
+	SystemSecretSystemRedisUsername      = "REDIS_USERNAME"
+	SystemSecretSystemRedisPassword      = "REDIS_PASSWORD"
 )
 
 const (
@@ -97,6 +127,10 @@ const (
 	SystemAppMasterContainerName    = "system-master"
 	SystemAppProviderContainerName  = "system-provider"
 	SystemAppDeveloperContainerName = "system-developer"
+
+	SystemDeveloperServiceName = "system-developer"
+	SystemMasterServiceName    = "system-master"
+	SystemMasterServicePort    = 3000
 )
 
 const (
@@ -111,6 +145,26 @@ const (
 	SystemAppDeveloperContainerMetricsPortName    = "dev-metrics"
 )
 
+const (
+	// SystemDeveloperPortalEnabledEnvVarName tells system whether the developer portal is served.
+	// It is only set when the developer portal is disabled
+	SystemDeveloperPortalEnabledEnvVarName = "DEVELOPER_PORTAL_ENABLED"
+)
+
+const (
+	// SystemZyncEndpointEnvVarName is the zync endpoint read by zync.yml.
+	// It is only set when an external zync is used
+	SystemZyncEndpointEnvVarName            = "ZYNC_ENDPOINT"
+	SystemZyncAuthenticationTokenEnvVarName = "ZYNC_AUTHENTICATION_TOKEN"
+)
+
+const (
+	SystemBackendRootEndpointEnvVarName      = "APICAST_BACKEND_ROOT_ENDPOINT"
+	SystemBackendRouteEnvVarName             = "BACKEND_ROUTE"
+	SystemBackendInternalAPIUserEnvVarName   = "CONFIG_INTERNAL_API_USER"
+	SystemBackendInternalAPIPasswdEnvVarName = "CONFIG_INTERNAL_API_PASSWORD"
+)
+
 type System struct {
 	Options *SystemOptions
 }
@@ -179,7 +233,11 @@ func (system *System) SystemRedisEnvVars() []v1.EnvVar {
 		helper.EnvVarFromSecret("REDIS_NAMESPACE", SystemSecretSystemRedisSecretName, SystemSecretSystemRedisNamespace),
 		helper.EnvVarFromSecret("REDIS_SENTINEL_HOSTS", SystemSecretSystemRedisSecretName, SystemSecretSystemRedisSentinelHosts),
 		helper.EnvVarFromSecret("REDIS_SENTINEL_ROLE", SystemSecretSystemRedisSecretName, SystemSecretSystemRedisSentinelRole),
+		// ACL credentials are optional, the password of the URL is used when not set
+		helper.EnvVarFromSecretOptional(SystemRedisUsernameEnvVarName, SystemSecretSystemRedisSecretName, SystemSecretSystemRedisUsername),
+		helper.EnvVarFromSecretOptional(SystemRedisPasswordEnvVarName, SystemSecretSystemRedisSecretName, SystemSecretSystemRedisPassword),
 	)
+	result = append(result, redisCAFileEnvVars(system.Options.SystemRedisCASecretName, SystemRedisCAVolumeName, SystemRedisCAFileEnvVarName)...)
 
 	return result
 }
@@ -212,15 +270,12 @@ func (system *System) buildSystemBaseEnv() []v1.EnvVar {
 		helper.EnvVarFromSecret("RECAPTCHA_PRIVATE_KEY", SystemSecretSystemRecaptchaSecretName, SystemSecretSystemRecaptchaPrivateKeyFieldName),
 
 		helper.EnvVarFromSecret("SECRET_KEY_BASE", SystemSecretSystemAppSecretName, SystemSecretSystemAppSecretKeyBaseFieldName),
-
-		helper.EnvVarFromSecret("MEMCACHE_SERVERS", SystemSecretSystemMemcachedSecretName, SystemSecretSystemMemcachedServersFieldName),
 	)
 
+	result = append(result, system.cacheStoreEnvVars()...)
 	result = append(result, system.SystemRedisEnvVars()...)
 	result = append(result, system.BackendRedisEnvVars()...)
-	bckListenerApicastRouteEnv := helper.EnvVarFromSecret("APICAST_BACKEND_ROOT_ENDPOINT", BackendSecretBackendListenerSecretName, BackendSecretBackendListenerRouteEndpointFieldName)
-	bckListenerRouteEnv := helper.EnvVarFromValue("BACKEND_ROUTE", system.Options.BackendServiceEndpoint)
-	result = append(result, bckListenerApicastRouteEnv, bckListenerRouteEnv)
+	result = append(result, system.backendEnvVars()...)
 
 	smtpEnvSecretEnvs := system.getSystemSMTPEnvsFromSMTPSecret()
 	result = append(result, smtpEnvSecretEnvs...)
@@ -229,33 +284,71 @@ func (system *System) buildSystemBaseEnv() []v1.EnvVar {
 	result = append(result, apicastAccessToken)
 
 	// Add zync secret to envvars sources
-	zyncAuthTokenVar := helper.EnvVarFromSecret("ZYNC_AUTHENTICATION_TOKEN", "zync", "ZYNC_AUTHENTICATION_TOKEN")
-	result = append(result, zyncAuthTokenVar)
+	result = append(result, system.zyncEnvVars()...)
 
-	// Add backend internal api data to envvars sources
-	systemBackendInternalAPIUser := helper.EnvVarFromSecret("CONFIG_INTERNAL_API_USER", "backend-internal-api", "username")
-	systemBackendInternalAPIPass := helper.EnvVarFromSecret("CONFIG_INTERNAL_API_PASSWORD", "backend-internal-api", "password")
-	result = append(result, systemBackendInternalAPIUser, systemBackendInternalAPIPass)
+	// Add inbound email settings to envvars sources
+	result = append(result, system.inboundEmailEnvVars()...)
 
 	if system.Options.S3FileStorageOptions != nil {
-		result = append(result,
-			helper.EnvVarFromConfigMap("FILE_UPLOAD_STORAGE", "system-environment", "FILE_UPLOAD_STORAGE"),
-			helper.EnvVarFromSecret(AwsAccessKeyID, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsAccessKeyID),
-			helper.EnvVarFromSecret(AwsSecretAccessKey, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsSecretAccessKey),
-			helper.EnvVarFromSecret(AwsBucket, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsBucket),
-			helper.EnvVarFromSecret(AwsRegion, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsRegion),
-			helper.EnvVarFromSecretOptional(AwsProtocol, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsProtocol),
-			helper.EnvVarFromSecretOptional(AwsHostname, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsHostname),
-			helper.EnvVarFromSecretOptional(AwsPathStyle, system.Options.S3FileStorageOptions.ConfigurationSecretName, AwsPathStyle),
-		)
+		result = append(result, helper.EnvVarFromConfigMap(SystemFileUploadStorageEnvVarName, "system-environment", SystemFileUploadStorageEnvVarName))
+		result = append(result, S3ConfigurationEnvVars(system.Options.S3FileStorageOptions.ConfigurationSecretName)...)
 	}
 
 	return result
 }
 
+// backendEnvVars returns the backend endpoints and the backend internal API credentials
+func (system *System) backendEnvVars() []v1.EnvVar {
+	if system.Options.ExternalBackend != nil {
+		return []v1.EnvVar{
+			helper.EnvVarFromValue(SystemBackendRootEndpointEnvVarName, system.Options.ExternalBackend.RouteEndpoint),
+			helper.EnvVarFromValue(SystemBackendRouteEnvVarName, system.Options.BackendServiceEndpoint),
+			helper.EnvVarFromSecret(SystemBackendInternalAPIUserEnvVarName, system.Options.ExternalBackend.InternalAPISecretName, BackendSecretInternalApiUsernameFieldName),
+			helper.EnvVarFromSecret(SystemBackendInternalAPIPasswdEnvVarName, system.Options.ExternalBackend.InternalAPISecretName, BackendSecretInternalApiPasswordFieldName),
+		}
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromSecret(SystemBackendRootEndpointEnvVarName, BackendSecretBackendListenerSecretName, BackendSecretBackendListenerRouteEndpointFieldName),
+		helper.EnvVarFromValue(SystemBackendRouteEnvVarName, system.Options.BackendServiceEndpoint),
+		helper.EnvVarFromSecret(SystemBackendInternalAPIUserEnvVarName, BackendSecretInternalApiSecretName, BackendSecretInternalApiUsernameFieldName),
+		helper.EnvVarFromSecret(SystemBackendInternalAPIPasswdEnvVarName, BackendSecretInternalApiSecretName, BackendSecretInternalApiPasswordFieldName),
+	}
+}
+
+func (system *System) zyncEnvVars() []v1.EnvVar {
+	if system.Options.ExternalZync != nil {
+		return []v1.EnvVar{
+			helper.EnvVarFromSecret(SystemZyncAuthenticationTokenEnvVarName, system.Options.ExternalZync.SecretName, ZyncSecretAuthenticationTokenFieldName),
+			helper.EnvVarFromValue(SystemZyncEndpointEnvVarName, system.Options.ExternalZync.Endpoint),
+		}
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromSecret(SystemZyncAuthenticationTokenEnvVarName, ZyncSecretName, ZyncSecretAuthenticationTokenFieldName),
+	}
+}
+
+func (system *System) cacheStoreEnvVars() []v1.EnvVar {
+	if system.Options.CacheStore == SystemCacheStoreRedis {
+		return []v1.EnvVar{
+			helper.EnvVarFromValue(SystemCacheStoreEnvVarName, SystemCacheStoreRedis),
+			helper.EnvVarFromSecret(SystemCacheRedisURLEnvVarName, SystemSecretSystemRedisSecretName, SystemSecretSystemRedisURLFieldName),
+			helper.EnvVarFromValue(SystemCacheRedisNamespaceEnvVarName, SystemCacheRedisNamespace),
+		}
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromSecret(SystemMemcacheServersEnvVarName, SystemSecretSystemMemcachedSecretName, SystemSecretSystemMemcachedServersFieldName),
+	}
+}
+
 func (system *System) buildAppEnv() []v1.EnvVar {
 	result := []v1.EnvVar{}
 	result = append(result, helper.EnvVarFromSecret(SystemSecretSystemAppUserSessionTTLFieldName, SystemSecretSystemAppSecretName, SystemSecretSystemAppUserSessionTTLFieldName))
+	if system.Options.DeveloperPortalDisabled {
+		result = append(result, helper.EnvVarFromValue(SystemDeveloperPortalEnabledEnvVarName, "false"))
+	}
 	return result
 }
 
@@ -265,6 +358,7 @@ func (system *System) buildAppMasterContainerEnv() []v1.EnvVar {
 		result = append(result, helper.EnvVarFromValue(SystemAppPrometheusExporterPortEnvVarName, strconv.Itoa(SystemAppMasterContainerPrometheusPort)))
 	}
 	result = append(result, system.buildAppEnv()...)
+	result = append(result, StatsdEnvVars(system.Options.Statsd)...)
 
 	return result
 }
@@ -275,6 +369,7 @@ func (system *System) buildAppProviderContainerEnv() []v1.EnvVar {
 		result = append(result, helper.EnvVarFromValue(SystemAppPrometheusExporterPortEnvVarName, strconv.Itoa(SystemAppProviderContainerPrometheusPort)))
 	}
 	result = append(result, system.buildAppEnv()...)
+	result = append(result, StatsdEnvVars(system.Options.Statsd)...)
 
 	return result
 }
@@ -285,6 +380,7 @@ func (system *System) buildAppDeveloperContainerEnv() []v1.EnvVar {
 		result = append(result, helper.EnvVarFromValue(SystemAppPrometheusExporterPortEnvVarName, strconv.Itoa(SystemAppDeveloperContainerPrometheusPort)))
 	}
 	result = append(result, system.buildAppEnv()...)
+	result = append(result, StatsdEnvVars(system.Options.Statsd)...)
 
 	return result
 }
@@ -294,6 +390,7 @@ func (system *System) buildSystemSidekiqContainerEnv() []v1.EnvVar {
 	if system.Options.SideKiqMetrics {
 		result = append(result, helper.EnvVarFromValue(SystemSidekiqPrometheusExporterPortEnvVarName, strconv.Itoa(SystemSidekiqMetricsPort)))
 	}
+	result = append(result, StatsdEnvVars(system.Options.Statsd)...)
 
 	return result
 }
@@ -320,11 +417,15 @@ func (system *System) buildSystemAppPostHookEnv() []v1.EnvVar {
 }
 
 func (system *System) BackendRedisEnvVars() []v1.EnvVar {
-	return []v1.EnvVar{
+	result := []v1.EnvVar{
 		helper.EnvVarFromSecret("BACKEND_REDIS_URL", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageURLFieldName),
 		helper.EnvVarFromSecret("BACKEND_REDIS_SENTINEL_HOSTS", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageSentinelHostsFieldName),
 		helper.EnvVarFromSecret("BACKEND_REDIS_SENTINEL_ROLE", BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageSentinelRoleFieldName),
+		helper.EnvVarFromSecretOptional(SystemBackendRedisUsernameEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStorageUsernameFieldName),
+		helper.EnvVarFromSecretOptional(SystemBackendRedisPasswordEnvVarName, BackendSecretBackendRedisSecretName, BackendSecretBackendRedisStoragePasswordFieldName),
 	}
+	result = append(result, redisCAFileEnvVars(system.Options.BackendRedisCASecretName, BackendRedisCAVolumeName, SystemBackendRedisCAFileEnvVarName)...)
+	return result
 }
 
 func (system *System) EnvironmentConfigMap() *v1.ConfigMap {
@@ -352,7 +453,7 @@ func (system *System) EnvironmentConfigMap() *v1.ConfigMap {
 	}
 
 	if system.Options.S3FileStorageOptions != nil {
-		res.Data["FILE_UPLOAD_STORAGE"] = "s3"
+		res.Data[SystemFileUploadStorageEnvVarName] = "s3"
 	}
 
 	return res
@@ -505,6 +606,13 @@ func (system *System) appPodVolumes() []v1.Volume {
 		},
 	}
 
+	if system.Options.CORS != nil {
+		systemConfigVolume.ConfigMap.Items = append(systemConfigVolume.ConfigMap.Items, v1.KeyToPath{
+			Key:  SystemCORSConfigFileName,
+			Path: SystemCORSConfigFileName,
+		})
+	}
+
 	res = append(res, systemConfigVolume)
 	return res
 }
@@ -514,18 +622,31 @@ func (system *System) volumeNamesForSystemAppPreHookPod() []string {
 	if system.Options.PvcFileStorageOptions != nil {
 		res = append(res, SystemFileStoragePVCName)
 	}
+	if system.Options.SystemRedisCASecretName != "" {
+		res = append(res, SystemRedisCAVolumeName)
+	}
+	if system.Options.BackendRedisCASecretName != "" {
+		res = append(res, BackendRedisCAVolumeName)
+	}
 	return res
 }
 
+// applyRedisCAs mounts the CA certificates of the system and backend redis in the pod template
+func (system *System) applyRedisCAs(template *v1.PodTemplateSpec) {
+	applyRedisCA(template, system.Options.SystemRedisCASecretName, SystemRedisCAVolumeName)
+	applyRedisCA(template, system.Options.BackendRedisCASecretName, BackendRedisCAVolumeName)
+}
+
 func (system *System) AppDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   SystemAppDeploymentName,
-			Labels: system.Options.CommonAppLabels,
+			Name:        SystemAppDeploymentName,
+			Labels:      system.Options.CommonAppLabels,
+			Annotations: system.Options.AppCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Strategy: appsv1.DeploymentStrategy{
@@ -567,7 +688,7 @@ func (system *System) AppDeploymentConfig() *appsv1.DeploymentConfig {
 					Type: appsv1.DeploymentTriggerOnImageChange,
 					ImageChangeParams: &appsv1.DeploymentTriggerImageChangeParams{
 						Automatic:      true,
-						ContainerNames: []string{SystemAppProviderContainerName, SystemAppDeveloperContainerName, SystemAppMasterContainerName},
+						ContainerNames: system.appContainerNames(),
 						From: v1.ObjectReference{
 							Kind: "ImageStreamTag",
 							Name: fmt.Sprintf("amp-system:%s", system.Options.ImageTag)}}},
@@ -576,7 +697,8 @@ func (system *System) AppDeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": SystemAppDeploymentName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: system.Options.AppPodTemplateLabels,
+					Labels:      system.Options.AppPodTemplateLabels,
+					Annotations: system.appPodAnnotations(),
 				},
 				Spec: v1.PodSpec{
 					Affinity:    system.Options.AppAffinity,
@@ -715,6 +837,37 @@ func (system *System) AppDeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	if system.Options.DeveloperPortalDisabled {
+		containers := []v1.Container{}
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			if container.Name != SystemAppDeveloperContainerName {
+				containers = append(containers, container)
+			}
+		}
+		dc.Spec.Template.Spec.Containers = containers
+	}
+
+	applyProbesOptions(dc.Spec.Template, system.Options.AppProbes)
+	applyRubyRuntimeTuningOptions(dc.Spec.Template, system.Options.AppRuntimeTuning)
+	system.applyRedisCAs(dc.Spec.Template)
+	applyExtraEnv(dc.Spec.Template, system.Options.AppExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, system.Options.AppTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, system.Options.AppPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, system.Options.AppSecurityContext)
+	applyInitContainers(dc, system.Options.AppInitContainers)
+	applySidecars(dc.Spec.Template, system.Options.AppSidecars)
+
+	return dc
+}
+
+// appContainerNames returns the system-app containers. The developer
+// container is not deployed when the developer portal is disabled
+func (system *System) appContainerNames() []string {
+	if system.Options.DeveloperPortalDisabled {
+		return []string{SystemAppProviderContainerName, SystemAppMasterContainerName}
+	}
+	return []string{SystemAppProviderContainerName, SystemAppDeveloperContainerName, SystemAppMasterContainerName}
 }
 
 func (system *System) FileStorageVolume() v1.Volume {
@@ -770,14 +923,15 @@ func (system *System) SidekiqPodVolumes() []v1.Volume {
 }
 
 func (system *System) SidekiqDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   SystemSidekiqName,
-			Labels: system.Options.CommonSidekiqLabels,
+			Name:        SystemSidekiqName,
+			Labels:      system.Options.CommonSidekiqLabels,
+			Annotations: system.Options.SidekiqCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Strategy: appsv1.DeploymentStrategy{
@@ -811,7 +965,8 @@ func (system *System) SidekiqDeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": SystemSidekiqName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: system.Options.SidekiqPodTemplateLabels,
+					Labels:      system.Options.SidekiqPodTemplateLabels,
+					Annotations: system.inboundEmailPodAnnotations(system.Options.SidekiqCustomAnnotations),
 				},
 				Spec: v1.PodSpec{
 					Affinity:    system.Options.SidekiqAffinity,
@@ -845,6 +1000,17 @@ func (system *System) SidekiqDeploymentConfig() *appsv1.DeploymentConfig {
 				}},
 		},
 	}
+
+	applyRubyRuntimeTuningOptions(dc.Spec.Template, system.Options.SidekiqRuntimeTuning)
+	system.applyRedisCAs(dc.Spec.Template)
+	applyExtraEnv(dc.Spec.Template, system.Options.SidekiqExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, system.Options.SidekiqTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, system.Options.SidekiqPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, system.Options.SidekiqSecurityContext)
+	applyInitContainers(dc, system.Options.SidekiqInitContainers)
+	applySidecars(dc.Spec.Template, system.Options.SidekiqSidecars)
+
+	return dc
 }
 
 func (system *System) systemStorageVolumeMount(readOnly bool) v1.VolumeMount {
@@ -914,8 +1080,9 @@ func (system *System) SharedStorage() *v1.PersistentVolumeClaim {
 			Kind:       "PersistentVolumeClaim",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-storage",
-			Labels: system.Options.CommonAppLabels,
+			Name:        "system-storage",
+			Labels:      helper.MergeMapsStringString(system.Options.PvcFileStorageOptions.Labels, system.Options.CommonAppLabels),
+			Annotations: system.Options.PvcFileStorageOptions.Annotations,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			StorageClassName: system.Options.PvcFileStorageOptions.StorageClass,
@@ -939,8 +1106,9 @@ func (system *System) ProviderService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-provider",
-			Labels: system.Options.ProviderUILabels,
+			Name:        "system-provider",
+			Labels:      helper.MergeMapsStringString(system.Options.AppCustomLabels, system.Options.ProviderUILabels),
+			Annotations: system.Options.AppCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
@@ -963,15 +1131,16 @@ func (system *System) MasterService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-master",
-			Labels: system.Options.MasterUILabels,
+			Name:        SystemMasterServiceName,
+			Labels:      helper.MergeMapsStringString(system.Options.AppCustomLabels, system.Options.MasterUILabels),
+			Annotations: system.Options.AppCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
 				v1.ServicePort{
 					Name:       "http",
 					Protocol:   v1.ProtocolTCP,
-					Port:       3000,
+					Port:       SystemMasterServicePort,
 					TargetPort: intstr.FromString("master"),
 				},
 			},
@@ -987,8 +1156,9 @@ func (system *System) DeveloperService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-developer",
-			Labels: system.Options.DeveloperUILabels,
+			Name:        SystemDeveloperServiceName,
+			Labels:      helper.MergeMapsStringString(system.Options.AppCustomLabels, system.Options.DeveloperUILabels),
+			Annotations: system.Options.AppCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
@@ -1011,8 +1181,9 @@ func (system *System) SphinxService() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-sphinx",
-			Labels: system.Options.SphinxLabels,
+			Name:        "system-sphinx",
+			Labels:      helper.MergeMapsStringString(system.Options.SphinxCustomLabels, system.Options.SphinxLabels),
+			Annotations: system.Options.SphinxCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
@@ -1080,8 +1251,21 @@ func (system *System) SMTPSecret() *v1.Secret {
 	return res
 }
 
+// appPodAnnotations returns the system-app pod annotations. The annotations
+// set by the operator override the custom annotations
+func (system *System) appPodAnnotations() map[string]string {
+	annotations := system.Options.AppCustomAnnotations
+	if system.Options.CORS != nil {
+		annotations = helper.MergeMapsStringString(annotations, map[string]string{
+			SystemCORSHashAnnotation: SystemCORSConfHash(system.Options.CORS),
+		})
+	}
+
+	return system.inboundEmailPodAnnotations(annotations)
+}
+
 func (system *System) SystemConfigMap() *v1.ConfigMap {
-	return &v1.ConfigMap{
+	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   "system",
 			Labels: system.Options.CommonLabels,
@@ -1096,11 +1280,29 @@ func (system *System) SystemConfigMap() *v1.ConfigMap {
 			"service_discovery.yml": system.getSystemServiceDiscoveryData(),
 		},
 	}
+
+	if system.Options.CORS != nil {
+		cm.Data[SystemCORSConfigFileName] = SystemCORSConfData(system.Options.CORS)
+	}
+
+	return cm
 }
 
+// SystemLegacyZyncConfData is the zync.yml deployed before the zync endpoint
+// could be configured. It is upgraded by the operator
+const SystemLegacyZyncConfData = `production:
+  endpoint: 'http://zync:8080'
+  authentication:
+    token: "<%= ENV.fetch('ZYNC_AUTHENTICATION_TOKEN') %>"
+  connect_timeout: 5
+  send_timeout: 5
+  receive_timeout: 10
+  root_url:
+`
+
 func (system *System) getSystemZyncConfData() string {
 	return `production:
-  endpoint: 'http://zync:8080'
+  endpoint: "<%= ENV.fetch('ZYNC_ENDPOINT', 'http://zync:8080') %>"
   authentication:
     token: "<%= ENV.fetch('ZYNC_AUTHENTICATION_TOKEN') %>"
   connect_timeout: 5
@@ -1134,14 +1336,15 @@ func (system *System) getSystemServiceDiscoveryData() string {
 }
 
 func (system *System) SphinxDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   SystemSphinxDeploymentName,
-			Labels: system.Options.SphinxLabels,
+			Name:        SystemSphinxDeploymentName,
+			Labels:      system.Options.SphinxLabels,
+			Annotations: system.Options.SphinxCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Triggers: appsv1.DeploymentTriggerPolicies{
@@ -1183,7 +1386,8 @@ func (system *System) SphinxDeploymentConfig() *appsv1.DeploymentConfig {
 			},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: system.Options.SphinxPodTemplateLabels,
+					Labels:      system.Options.SphinxPodTemplateLabels,
+					Annotations: system.Options.SphinxCustomAnnotations,
 				},
 				Spec: v1.PodSpec{
 					Affinity:           system.Options.SphinxAffinity,
@@ -1238,44 +1442,25 @@ func (system *System) SphinxDeploymentConfig() *appsv1.DeploymentConfig {
 			},
 		},
 	}
+
+	applyProbesOptions(dc.Spec.Template, system.Options.SphinxProbes)
+	applyRedisCA(dc.Spec.Template, system.Options.SystemRedisCASecretName, SystemRedisCAVolumeName)
+	applyExtraEnv(dc.Spec.Template, system.Options.SphinxExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, system.Options.SphinxTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, system.Options.SphinxPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, system.Options.SphinxSecurityContext)
+	applyInitContainers(dc, system.Options.SphinxInitContainers)
+	applySidecars(dc.Spec.Template, system.Options.SphinxSidecars)
+
+	return dc
 }
 
 func (system *System) AppPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-app",
-			Labels: system.Options.CommonAppLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": SystemAppDeploymentName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(SystemAppDeploymentName, system.Options.CommonAppLabels, system.Options.AppPodDisruptionBudget)
 }
 
 func (system *System) SidekiqPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system-sidekiq",
-			Labels: system.Options.CommonSidekiqLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": "system-sidekiq"},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget("system-sidekiq", system.Options.CommonSidekiqLabels, system.Options.SidekiqPodDisruptionBudget)
 }
 
 func (system *System) sideKiqPorts() []v1.ContainerPort {
@@ -0,0 +1,18 @@
+package component
+
+const (
+	// SystemAccessTokenSecretKey is the key of the access token value in the access token secrets
+	SystemAccessTokenSecretKey = "token"
+
+	SystemAccessTokenPermissionReadOnly  = "ro"
+	SystemAccessTokenPermissionReadWrite = "rw"
+)
+
+// SystemAccessTokenScopes are the scopes of the admin portal access tokens
+var SystemAccessTokenScopes = []string{
+	"account_management",
+	"stats",
+	"finance",
+	"cms",
+	"policy_registry",
+}
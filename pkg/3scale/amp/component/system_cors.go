@@ -0,0 +1,63 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+const (
+	// SystemCORSConfigFileName is the system config map key holding the CORS configuration.
+	// It is read by system from the system extra configs
+	SystemCORSConfigFileName = "cors.yml"
+	// SystemCORSHashAnnotation rolls out system-app when the CORS configuration changes,
+	// as it is only read on startup
+	SystemCORSHashAnnotation = "apps.3scale.net/system-cors-hash"
+)
+
+// SystemCORSResources are the system API paths the CORS headers are served on
+var SystemCORSResources = []string{
+	"/admin/api/*",
+	"/master/api/*",
+}
+
+// SystemCORSOptions configures the CORS headers of the admin and master APIs
+type SystemCORSOptions struct {
+	AllowedOrigins   []string `validate:"required,min=1,dive,required"`
+	AllowedHeaders   []string `validate:"dive,required"`
+	MaxAge           *int32   `validate:"omitempty,min=0"`
+	AllowCredentials bool
+}
+
+// SystemCORSConfData returns the cors.yml content
+func SystemCORSConfData(opts *SystemCORSOptions) string {
+	var b strings.Builder
+	b.WriteString("production:\n")
+	b.WriteString("  enabled: true\n")
+	b.WriteString("  allow:\n")
+	fmt.Fprintf(&b, "    - origins: %s\n", yamlFlowSequence(opts.AllowedOrigins))
+	fmt.Fprintf(&b, "      resources: %s\n", yamlFlowSequence(SystemCORSResources))
+	if len(opts.AllowedHeaders) > 0 {
+		fmt.Fprintf(&b, "      headers: %s\n", yamlFlowSequence(opts.AllowedHeaders))
+	}
+	if opts.MaxAge != nil {
+		fmt.Fprintf(&b, "      max_age: %d\n", *opts.MaxAge)
+	}
+	fmt.Fprintf(&b, "      credentials: %t\n", opts.AllowCredentials)
+	return b.String()
+}
+
+// SystemCORSConfHash returns the hash of the cors.yml content
+func SystemCORSConfHash(opts *SystemCORSOptions) string {
+	h := fnv.New32a()
+	h.Write([]byte(SystemCORSConfData(opts)))
+	return fmt.Sprint(h.Sum32())
+}
+
+// yamlFlowSequence renders the values as a YAML flow sequence of quoted strings.
+// JSON arrays of strings are valid YAML
+func yamlFlowSequence(values []string) string {
+	res, _ := json.Marshal(values)
+	return string(res)
+}
@@ -0,0 +1,91 @@
+package component
+
+import (
+	"strconv"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	SystemInboundEmailProtocolIMAP = "imap"
+	SystemInboundEmailProtocolPOP3 = "pop3"
+
+	SystemInboundEmailUsernameSecretKey = "username"
+	SystemInboundEmailPasswordSecretKey = "password"
+
+	// SystemInboundEmailHashAnnotation rolls out system-app and system-sidekiq when
+	// the credentials secret changes, as the env vars are only read on startup
+	SystemInboundEmailHashAnnotation = "apps.3scale.net/system-inbound-email-hash"
+)
+
+const (
+	SystemInboundEmailProtocolEnvVarName = "INBOUND_EMAIL_PROTOCOL"
+	SystemInboundEmailHostEnvVarName     = "INBOUND_EMAIL_HOST"
+	SystemInboundEmailPortEnvVarName     = "INBOUND_EMAIL_PORT"
+	SystemInboundEmailSSLEnvVarName      = "INBOUND_EMAIL_SSL"
+	SystemInboundEmailUsernameEnvVarName = "INBOUND_EMAIL_USERNAME"
+	SystemInboundEmailPasswordEnvVarName = "INBOUND_EMAIL_PASSWORD"
+)
+
+// SystemInboundEmailEnvVarNames are the env vars set from the inbound email options
+var SystemInboundEmailEnvVarNames = []string{
+	SystemInboundEmailProtocolEnvVarName,
+	SystemInboundEmailHostEnvVarName,
+	SystemInboundEmailPortEnvVarName,
+	SystemInboundEmailSSLEnvVarName,
+	SystemInboundEmailUsernameEnvVarName,
+	SystemInboundEmailPasswordEnvVarName,
+}
+
+// SystemInboundEmailOptions configures the mailbox system fetches the inbound emails from
+type SystemInboundEmailOptions struct {
+	Protocol string `validate:"required,oneof=imap pop3"`
+	Host     string `validate:"required"`
+	Port     int32  `validate:"min=1,max=65535"`
+	SSL      bool
+	// Secret containing the mailbox credentials
+	SecretName string `validate:"required"`
+	Hash       string `validate:"required"`
+}
+
+// DefaultSystemInboundEmailPort returns the well-known port of the protocol
+func DefaultSystemInboundEmailPort(protocol string, ssl bool) int32 {
+	switch {
+	case protocol == SystemInboundEmailProtocolPOP3 && ssl:
+		return 995
+	case protocol == SystemInboundEmailProtocolPOP3:
+		return 110
+	case ssl:
+		return 993
+	default:
+		return 143
+	}
+}
+
+func (system *System) inboundEmailEnvVars() []v1.EnvVar {
+	opts := system.Options.InboundEmail
+	if opts == nil {
+		return nil
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromValue(SystemInboundEmailProtocolEnvVarName, opts.Protocol),
+		helper.EnvVarFromValue(SystemInboundEmailHostEnvVarName, opts.Host),
+		helper.EnvVarFromValue(SystemInboundEmailPortEnvVarName, strconv.Itoa(int(opts.Port))),
+		helper.EnvVarFromValue(SystemInboundEmailSSLEnvVarName, strconv.FormatBool(opts.SSL)),
+		helper.EnvVarFromSecret(SystemInboundEmailUsernameEnvVarName, opts.SecretName, SystemInboundEmailUsernameSecretKey),
+		helper.EnvVarFromSecret(SystemInboundEmailPasswordEnvVarName, opts.SecretName, SystemInboundEmailPasswordSecretKey),
+	}
+}
+
+// inboundEmailPodAnnotations adds the credentials hash to the pod annotations
+func (system *System) inboundEmailPodAnnotations(annotations map[string]string) map[string]string {
+	if system.Options.InboundEmail == nil {
+		return annotations
+	}
+
+	return helper.MergeMapsStringString(annotations, map[string]string{
+		SystemInboundEmailHashAnnotation: system.Options.InboundEmail.Hash,
+	})
+}
@@ -8,9 +8,6 @@ import (
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
-
-	"github.com/3scale/3scale-operator/pkg/assets"
-	"github.com/3scale/3scale-operator/pkg/common"
 )
 
 func (system *System) SystemSidekiqPodMonitor() *monitoringv1.PodMonitor {
@@ -33,7 +30,7 @@ func (system *System) SystemSidekiqPodMonitor() *monitoringv1.PodMonitor {
 }
 
 func (system *System) SystemAppPodMonitor() *monitoringv1.PodMonitor {
-	return &monitoringv1.PodMonitor{
+	podMonitor := &monitoringv1.PodMonitor{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   "system-app",
 			Labels: system.Options.CommonAppLabels,
@@ -76,24 +73,26 @@ func (system *System) SystemAppPodMonitor() *monitoringv1.PodMonitor {
 			},
 		},
 	}
+	if system.Options.DeveloperPortalDisabled {
+		// The developer container is not deployed
+		endpoints := []monitoringv1.PodMetricsEndpoint{}
+		for _, endpoint := range podMonitor.Spec.PodMetricsEndpoints {
+			if endpoint.Port != SystemAppDeveloperContainerMetricsPortName {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+		podMonitor.Spec.PodMetricsEndpoints = endpoints
+	}
+
+	return podMonitor
 }
 
 func (system *System) SystemGrafanaDashboard(sumRate string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		system.Options.Namespace, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "system",
-			Labels: system.monitoringLabels(),
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/system-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/system-grafana-dashboard-1.json", system.Options.Namespace),
-		},
+	data := &grafanaDashboardTemplateData{
+		Namespace: system.Options.Namespace,
+		SumRate:   sumRate,
 	}
+	return grafanaDashboard("system", "system-grafana-dashboard-1.json", system.Options.CommonLabels, system.Options.GrafanaDashboard, data)
 }
 
 func (system *System) SystemAppPrometheusRules() *monitoringv1.PrometheusRule {
@@ -179,17 +178,6 @@ func (system *System) SystemSidekiqPrometheusRules() *monitoringv1.PrometheusRul
 	}
 }
 
-func (system *System) monitoringLabels() map[string]string {
-	labels := make(map[string]string)
-
-	for key, value := range system.Options.CommonLabels {
-		labels[key] = value
-	}
-
-	labels["monitoring-key"] = common.MonitoringKey
-	return labels
-}
-
 func (system *System) prometheusRulesMonitoringLabels() map[string]string {
 	labels := make(map[string]string)
 
@@ -99,8 +99,9 @@ func (mysql *SystemMysql) PersistentVolumeClaim() *v1.PersistentVolumeClaim {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "mysql-storage",
-			Labels: mysql.Options.DeploymentLabels,
+			Name:        "mysql-storage",
+			Labels:      helper.MergeMapsStringString(mysql.Options.PVCLabels, mysql.Options.DeploymentLabels),
+			Annotations: mysql.Options.PVCAnnotations,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{
@@ -118,7 +119,7 @@ func (mysql *SystemMysql) PersistentVolumeClaim() *v1.PersistentVolumeClaim {
 }
 
 func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
@@ -155,10 +156,13 @@ func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
 					Labels: mysql.Options.PodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
-					Affinity:           mysql.Options.Affinity,
-					Tolerations:        mysql.Options.Tolerations,
-					ServiceAccountName: "amp", //TODO make this configurable via flag
-					Volumes: []v1.Volume{
+					NodeSelector:              mysql.Options.NodeSelector,
+					Affinity:                  mysql.Options.Affinity,
+					Tolerations:               mysql.Options.Tolerations,
+					TopologySpreadConstraints: mysql.Options.TopologySpreadConstraints,
+					PriorityClassName:         mysql.Options.PriorityClassName,
+					ServiceAccountName:        "amp", //TODO make this configurable via flag
+					Volumes: append([]v1.Volume{
 						v1.Volume{
 							Name: "mysql-storage",
 							VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
@@ -174,7 +178,7 @@ func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
 							VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
 								LocalObjectReference: v1.LocalObjectReference{
 									Name: "mysql-main-conf"}}}},
-					},
+					}, sharedMemoryVolumes(mysql.Options.SharedMemorySizeLimit)...),
 					Containers: []v1.Container{
 						v1.Container{
 							Name:  "system-mysql",
@@ -196,7 +200,7 @@ func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
 								helper.EnvVarFromValue("MYSQL_DEFAULTS_FILE", "/etc/my-extra/my.cnf"),
 							},
 							Resources: mysql.Options.ContainerResourceRequirements,
-							VolumeMounts: []v1.VolumeMount{
+							VolumeMounts: append([]v1.VolumeMount{
 								v1.VolumeMount{
 									Name:      "mysql-storage",
 									ReadOnly:  false,
@@ -209,7 +213,7 @@ func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
 									Name:      "mysql-main-conf",
 									ReadOnly:  false,
 									MountPath: "/etc/my-extra"},
-							},
+							}, sharedMemoryVolumeMounts(mysql.Options.SharedMemorySizeLimit)...),
 							LivenessProbe: &v1.Probe{
 								Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{
 									Port: intstr.IntOrString{
@@ -240,6 +244,11 @@ func (mysql *SystemMysql) DeploymentConfig() *appsv1.DeploymentConfig {
 			},
 		},
 	}
+
+	applyImagePullPolicy(dc.Spec.Template, mysql.Options.ImagePullPolicy)
+	applySecurityContextOptions(dc.Spec.Template, mysql.Options.SecurityContext)
+
+	return dc
 }
 
 // Each database is responsible to create the needed secrets for the other components
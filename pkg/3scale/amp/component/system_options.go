@@ -28,10 +28,13 @@ type PVCFileStorageOptions struct {
 	StorageClass    *string
 	VolumeName      *string
 	StorageRequests resource.Quantity `validate:"required"`
+	Annotations     map[string]string
+	Labels          map[string]string
 }
 
 type SystemOptions struct {
 	MemcachedServers                       string  `validate:"required"`
+	CacheStore                             string  `validate:"oneof=memcached redis"`
 	EventHooksURL                          string  `validate:"required"`
 	ApicastSystemMasterProxyConfigEndpoint string  `validate:"required"`
 	AdminEmail                             *string `validate:"required"`
@@ -51,6 +54,42 @@ type SystemOptions struct {
 	AppReplicas     *int32 `validate:"required"`
 	SidekiqReplicas *int32 `validate:"required"`
 
+	// Replicas not managed by the operator are only set on creation
+	AppReplicasManaged     bool
+	SidekiqReplicasManaged bool
+
+	// Disruption policies of the pods. The default policy is used when not set
+	AppPodDisruptionBudget     *PodDisruptionBudgetOptions `validate:"-"`
+	SidekiqPodDisruptionBudget *PodDisruptionBudgetOptions `validate:"-"`
+
+	// Probes tuning of the containers. The default probes are used when not set
+	AppProbes    *ProbesOptions `validate:"omitempty"`
+	SphinxProbes *ProbesOptions `validate:"omitempty"`
+
+	// Ruby runtime tuning of the containers. The defaults of the image are used when not set
+	AppRuntimeTuning     *RubyRuntimeTuningOptions `validate:"omitempty"`
+	SidekiqRuntimeTuning *RubyRuntimeTuningOptions `validate:"omitempty"`
+
+	// Extra env vars appended to the operator managed env vars of the containers
+	AppExtraEnv     []v1.EnvVar `validate:"-"`
+	SidekiqExtraEnv []v1.EnvVar `validate:"-"`
+	SphinxExtraEnv  []v1.EnvVar `validate:"-"`
+
+	// Sidecar containers and volumes appended to the pods
+	AppSidecars     *SidecarsOptions `validate:"-"`
+	SidekiqSidecars *SidecarsOptions `validate:"-"`
+	SphinxSidecars  *SidecarsOptions `validate:"-"`
+
+	// Init containers customized and appended to the pods
+	AppInitContainers     *InitContainersOptions `validate:"-"`
+	SidekiqInitContainers *InitContainersOptions `validate:"-"`
+	SphinxInitContainers  *InitContainersOptions `validate:"-"`
+
+	// Termination of the pods. The default grace period and no preStop hook are used when not set
+	AppTermination     *TerminationOptions `validate:"omitempty"`
+	SidekiqTermination *TerminationOptions `validate:"omitempty"`
+	SphinxTermination  *TerminationOptions `validate:"omitempty"`
+
 	AdminAccessToken    string  `validate:"required"`
 	AdminPassword       string  `validate:"required"`
 	AdminUsername       string  `validate:"required"`
@@ -75,6 +114,15 @@ type SystemOptions struct {
 	SphinxAffinity     *v1.Affinity    `validate:"-"`
 	SphinxTolerations  []v1.Toleration `validate:"-"`
 
+	// Pod template settings overridden in the APIManager
+	AppPodTemplateOverrides     PodTemplateOverridesOptions `validate:"-"`
+	SidekiqPodTemplateOverrides PodTemplateOverridesOptions `validate:"-"`
+	SphinxPodTemplateOverrides  PodTemplateOverridesOptions `validate:"-"`
+
+	AppSecurityContext     *SecurityContextOptions `validate:"-"`
+	SidekiqSecurityContext *SecurityContextOptions `validate:"-"`
+	SphinxSecurityContext  *SecurityContextOptions `validate:"-"`
+
 	CommonLabels             map[string]string `validate:"required"`
 	CommonAppLabels          map[string]string `validate:"required"`
 	AppPodTemplateLabels     map[string]string `validate:"required"`
@@ -87,13 +135,44 @@ type SystemOptions struct {
 	SphinxPodTemplateLabels  map[string]string `validate:"required"`
 	MemcachedLabels          map[string]string `validate:"required"`
 	SMTPLabels               map[string]string `validate:"required"`
+	AppCustomLabels          map[string]string `validate:"-"`
+	AppCustomAnnotations     map[string]string `validate:"-"`
+	SidekiqCustomAnnotations map[string]string `validate:"-"`
+	SphinxCustomLabels       map[string]string `validate:"-"`
+	SphinxCustomAnnotations  map[string]string `validate:"-"`
 	SideKiqMetrics           bool
 	AppMetrics               bool
 
+	// Statsd metrics sink. Independent of the prometheus metrics
+	Statsd *StatsdOptions `validate:"omitempty"`
+
+	// CORS headers of the admin and master APIs. Disabled when nil
+	CORS *SystemCORSOptions `validate:"omitempty"`
+
+	// DeveloperPortalDisabled removes the system-developer container
+	// and tells system the developer portal is not served
+	DeveloperPortalDisabled bool
+
+	// External zync system connects to. The zync deployed by the operator is used when nil
+	ExternalZync *SystemExternalZyncOptions `validate:"omitempty"`
+
+	// External backend system connects to. The backend deployed by the operator is used when nil
+	ExternalBackend *SystemExternalBackendOptions `validate:"omitempty"`
+
+	// Mailbox the inbound emails are fetched from. Configured in the admin portal when nil
+	InboundEmail *SystemInboundEmailOptions `validate:"omitempty"`
+
+	// Secrets with the CA certificates of the system and backend redis. Empty when not set
+	SystemRedisCASecretName  string `validate:"-"`
+	BackendRedisCASecretName string `validate:"-"`
+
 	IncludeOracleOptionalSettings bool
 
 	BackendServiceEndpoint string `validate:"required"`
 
+	// Datasource and selector labels of the grafana dashboards. The defaults are used when not set
+	GrafanaDashboard *GrafanaDashboardOptions `validate:"omitempty"`
+
 	// Used for monitoring objects
 	// Those objects are namespaced. However, objects includes labels, rules and expressions
 	// that need namespace filtering because they are "global" once imported
@@ -101,6 +180,21 @@ type SystemOptions struct {
 	Namespace string `validate:"required"`
 }
 
+// SystemExternalZyncOptions configures the connection of system to an external zync
+type SystemExternalZyncOptions struct {
+	Endpoint string `validate:"required"`
+	// Secret containing the zync authentication token
+	SecretName string `validate:"required"`
+}
+
+// SystemExternalBackendOptions configures the connection of system to an external backend
+type SystemExternalBackendOptions struct {
+	// Public endpoint of the backend-listener the gateways are configured with
+	RouteEndpoint string `validate:"required"`
+	// Secret containing the backend internal API credentials
+	InternalAPISecretName string `validate:"required"`
+}
+
 func NewSystemOptions() *SystemOptions {
 	return &SystemOptions{}
 }
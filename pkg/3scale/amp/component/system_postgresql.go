@@ -58,8 +58,9 @@ func (p *SystemPostgreSQL) DataPersistentVolumeClaim() *v1.PersistentVolumeClaim
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "postgresql-data",
-			Labels: p.Options.DeploymentLabels,
+			Name:        "postgresql-data",
+			Labels:      helper.MergeMapsStringString(p.Options.PVCLabels, p.Options.DeploymentLabels),
+			Annotations: p.Options.PVCAnnotations,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{
@@ -77,7 +78,7 @@ func (p *SystemPostgreSQL) DataPersistentVolumeClaim() *v1.PersistentVolumeClaim
 }
 
 func (p *SystemPostgreSQL) DeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
@@ -114,9 +115,12 @@ func (p *SystemPostgreSQL) DeploymentConfig() *appsv1.DeploymentConfig {
 					Labels: p.Options.PodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
-					Affinity:           p.Options.Affinity,
-					Tolerations:        p.Options.Tolerations,
-					ServiceAccountName: "amp", //TODO make this configurable via flag
+					NodeSelector:              p.Options.NodeSelector,
+					Affinity:                  p.Options.Affinity,
+					Tolerations:               p.Options.Tolerations,
+					TopologySpreadConstraints: p.Options.TopologySpreadConstraints,
+					PriorityClassName:         p.Options.PriorityClassName,
+					ServiceAccountName:        "amp", //TODO make this configurable via flag
 					Volumes: []v1.Volume{
 						v1.Volume{
 							Name: "postgresql-data",
@@ -185,6 +189,11 @@ func (p *SystemPostgreSQL) DeploymentConfig() *appsv1.DeploymentConfig {
 			},
 		},
 	}
+
+	applyImagePullPolicy(dc.Spec.Template, p.Options.ImagePullPolicy)
+	applySecurityContextOptions(dc.Spec.Template, p.Options.SecurityContext)
+
+	return dc
 }
 
 // Each database is responsible to create the needed secrets for the other components
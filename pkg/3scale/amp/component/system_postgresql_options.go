@@ -18,12 +18,20 @@ type SystemPostgreSQLOptions struct {
 	DatabaseURL                   string                  `validate:"required"`
 	PVCStorageClass               *string
 	PVCVolumeName                 *string
-	PVCStorageRequests            resource.Quantity `validate:"required"`
-	Affinity                      *v1.Affinity      `validate:"-"`
-	Tolerations                   []v1.Toleration   `validate:"-"`
-	CommonLabels                  map[string]string `validate:"required"`
-	DeploymentLabels              map[string]string `validate:"required"`
-	PodTemplateLabels             map[string]string `validate:"required"`
+	PVCAnnotations                map[string]string
+	PVCLabels                     map[string]string
+	PVCStorageRequests            resource.Quantity             `validate:"required"`
+	NodeSelector                  map[string]string             `validate:"-"`
+	Affinity                      *v1.Affinity                  `validate:"-"`
+	Tolerations                   []v1.Toleration               `validate:"-"`
+	TopologySpreadConstraints     []v1.TopologySpreadConstraint `validate:"-"`
+	PriorityClassName             string                        `validate:"-"`
+	ImagePullPolicy               *v1.PullPolicy                `validate:"-"`
+	CommonLabels                  map[string]string             `validate:"required"`
+	DeploymentLabels              map[string]string             `validate:"required"`
+	PodTemplateLabels             map[string]string             `validate:"required"`
+
+	SecurityContext *SecurityContextOptions `validate:"-"`
 }
 
 func NewSystemPostgreSQLOptions() *SystemPostgreSQLOptions {
@@ -0,0 +1,37 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// TerminationOptions configures the termination of the pods of a component
+type TerminationOptions struct {
+	GracePeriodSeconds *int64 `validate:"omitempty,min=0"`
+	// PreStopCommand is run in the containers managed by the operator before they are stopped
+	PreStopCommand []string `validate:"-"`
+}
+
+// applyTerminationOptions sets the termination grace period of the pod template
+// and the preStop hook of the containers
+func applyTerminationOptions(template *v1.PodTemplateSpec, opts *TerminationOptions) {
+	if opts == nil {
+		return
+	}
+
+	if opts.GracePeriodSeconds != nil {
+		gracePeriod := *opts.GracePeriodSeconds
+		template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	}
+
+	if len(opts.PreStopCommand) > 0 {
+		for idx := range template.Spec.Containers {
+			template.Spec.Containers[idx].Lifecycle = &v1.Lifecycle{
+				PreStop: &v1.Handler{Exec: &v1.ExecAction{
+					Command: append([]string{}, opts.PreStopCommand...),
+				}},
+			}
+		}
+	}
+
+	addPodTemplateOverridesHash(template, "termination", opts)
+}
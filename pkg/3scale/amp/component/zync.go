@@ -2,6 +2,8 @@ package component
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"k8s.io/api/policy/v1beta1"
 
@@ -32,6 +34,37 @@ const (
 	ZyncQueMetricsPort = 9394
 )
 
+const (
+	ZyncForceSSLEnvVarName       = "FORCE_SSL"
+	ZyncTrustedProxiesEnvVarName = "TRUSTED_PROXIES"
+)
+
+const (
+	ZyncQueWorkerCountEnvVarName     = "QUE_WORKER_COUNT"
+	ZyncQuePollingIntervalEnvVarName = "QUE_POLL_INTERVAL"
+	// ZyncQueWorkerCountLabel is set in the zync-que pods and added to their metrics,
+	// so the queue throughput can be normalized per worker
+	ZyncQueWorkerCountLabel = "threescale_que_worker_count"
+
+	DefaultZyncQueWorkerCount     int32 = 10
+	DefaultZyncQuePollingInterval int32 = 5
+)
+
+const (
+	ZyncQueServiceAccountName = "zync-que-sa"
+	// ZyncQueServiceAccountTokenVolumeName holds the projected token, the cluster CA
+	// and the namespace. It is mounted where the in-cluster kubernetes client
+	// of zync looks for the ServiceAccount credentials.
+	ZyncQueServiceAccountTokenVolumeName = "zync-que-sa-token"
+	ZyncQueServiceAccountTokenMountPath  = "/var/run/secrets/kubernetes.io/serviceaccount"
+	ZyncQueServiceAccountTokenPath       = "token"
+	ZyncQueRootCAConfigMapName           = "kube-root-ca.crt"
+	ZyncQueRootCAConfigMapKey            = "ca.crt"
+	// ZyncQueLegacyTokenSecretsCleanedAnnotation is set in the zync-que ServiceAccount
+	// once its legacy token secrets have been removed
+	ZyncQueLegacyTokenSecretsCleanedAnnotation = "apps.3scale.net/legacy-token-secrets-cleaned"
+)
+
 type Zync struct {
 	Options *ZyncOptions
 }
@@ -67,12 +100,31 @@ func (zync *Zync) QueServiceAccount() *v1.ServiceAccount {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "zync-que-sa",
+			Name:        zync.Options.ZyncQueServiceAccountName,
+			Labels:      zync.Options.ZyncQueCustomLabels,
+			Annotations: zync.queServiceAccountAnnotations(),
 		},
 		ImagePullSecrets: zync.Options.ZyncQueServiceAccountImagePullSecrets,
 	}
 }
 
+// queServiceAccountAnnotations returns the custom annotations of zync-que
+// along with the annotations set for its service account
+func (zync *Zync) queServiceAccountAnnotations() map[string]string {
+	if len(zync.Options.ZyncQueServiceAccountAnnotations) == 0 {
+		return zync.Options.ZyncQueCustomAnnotations
+	}
+
+	annotations := map[string]string{}
+	for key, value := range zync.Options.ZyncQueCustomAnnotations {
+		annotations[key] = value
+	}
+	for key, value := range zync.Options.ZyncQueServiceAccountAnnotations {
+		annotations[key] = value
+	}
+	return annotations
+}
+
 func (zync *Zync) QueRoleBinding() *rbacv1.RoleBinding {
 	return &rbacv1.RoleBinding{
 		TypeMeta: metav1.TypeMeta{
@@ -85,7 +137,7 @@ func (zync *Zync) QueRoleBinding() *rbacv1.RoleBinding {
 		Subjects: []rbacv1.Subject{
 			rbacv1.Subject{
 				Kind: "ServiceAccount",
-				Name: "zync-que-sa",
+				Name: zync.Options.ZyncQueServiceAccountName,
 			},
 		},
 		RoleRef: rbacv1.RoleRef{
@@ -164,14 +216,15 @@ func (zync *Zync) QueRole() *rbacv1.Role {
 }
 
 func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ZyncName,
-			Labels: zync.Options.CommonZyncLabels,
+			Name:        ZyncName,
+			Labels:      zync.Options.CommonZyncLabels,
+			Annotations: zync.Options.ZyncCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Triggers: appsv1.DeploymentTriggerPolicies{
@@ -197,16 +250,14 @@ func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
 			Selector: map[string]string{"deploymentConfig": ZyncName},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: zync.Options.ZyncPodTemplateLabels,
-					Annotations: map[string]string{
-						"prometheus.io/port":   "9393",
-						"prometheus.io/scrape": "true",
-					},
+					Labels:      zync.Options.ZyncPodTemplateLabels,
+					Annotations: zync.podAnnotations(zync.Options.ZyncCustomAnnotations, "9393"),
 				},
 				Spec: v1.PodSpec{
 					Affinity:           zync.Options.ZyncAffinity,
 					Tolerations:        zync.Options.ZyncTolerations,
 					ServiceAccountName: "amp",
+					Volumes:            zync.databaseTLSVolumes(),
 					InitContainers: []v1.Container{
 						v1.Container{
 							Name:  "zync-db-svc",
@@ -215,7 +266,7 @@ func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
 								"bash",
 								"-c",
 								"bundle exec sh -c \"until rake boot:db; do sleep $SLEEP_SECONDS; done\"",
-							}, Env: []v1.EnvVar{
+							}, Env: append([]v1.EnvVar{
 								v1.EnvVar{
 									Name:  "SLEEP_SECONDS",
 									Value: "1",
@@ -231,7 +282,8 @@ func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
 										},
 									},
 								},
-							},
+							}, append(zync.databaseTLSEnvVars(), zync.databaseSchemaEnvVars()...)...),
+							VolumeMounts: zync.databaseTLSVolumeMounts(),
 						},
 					},
 					Containers: []v1.Container{
@@ -239,7 +291,7 @@ func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
 							Name:  ZyncName,
 							Image: "amp-zync:latest",
 							Ports: zync.zyncPorts(),
-							Env:   zync.commonZyncEnvVars(),
+							Env:   zync.zyncEnvVars(),
 							LivenessProbe: &v1.Probe{
 								Handler: v1.Handler{
 									HTTPGet: &v1.HTTPGetAction{
@@ -268,17 +320,50 @@ func (zync *Zync) DeploymentConfig() *appsv1.DeploymentConfig {
 								SuccessThreshold:    1,
 								FailureThreshold:    3,
 							},
-							Resources: zync.Options.ContainerResourceRequirements,
+							Resources:    zync.Options.ContainerResourceRequirements,
+							VolumeMounts: zync.databaseTLSVolumeMounts(),
 						},
 					},
 				},
 			},
 		},
 	}
+
+	applyProbesOptions(dc.Spec.Template, zync.Options.ZyncProbes)
+	applyRubyRuntimeTuningOptions(dc.Spec.Template, zync.Options.ZyncRuntimeTuning)
+	applyExtraEnv(dc.Spec.Template, zync.Options.ZyncExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, zync.Options.ZyncTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, zync.Options.ZyncPodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, zync.Options.ZyncSecurityContext)
+	applyInitContainers(dc, zync.Options.ZyncInitContainers)
+	applySidecars(dc.Spec.Template, zync.Options.ZyncSidecars)
+
+	return dc
 }
 
-func (zync *Zync) commonZyncEnvVars() []v1.EnvVar {
+func (zync *Zync) zyncEnvVars() []v1.EnvVar {
+	result := zync.commonZyncEnvVars()
+
+	if zync.Options.ZyncForceSSL != nil {
+		result = append(result, helper.EnvVarFromValue(ZyncForceSSLEnvVarName, strconv.FormatBool(*zync.Options.ZyncForceSSL)))
+	}
+
+	if len(zync.Options.ZyncTrustedProxies) > 0 {
+		result = append(result, helper.EnvVarFromValue(ZyncTrustedProxiesEnvVarName, strings.Join(zync.Options.ZyncTrustedProxies, ",")))
+	}
+
+	return result
+}
+
+func (zync *Zync) queWorkerEnvVars() []v1.EnvVar {
 	return []v1.EnvVar{
+		helper.EnvVarFromValue(ZyncQueWorkerCountEnvVarName, strconv.FormatInt(int64(zync.Options.ZyncQueWorkerCount), 10)),
+		helper.EnvVarFromValue(ZyncQuePollingIntervalEnvVarName, strconv.FormatInt(int64(zync.Options.ZyncQuePollingInterval), 10)),
+	}
+}
+
+func (zync *Zync) commonZyncEnvVars() []v1.EnvVar {
+	return append([]v1.EnvVar{
 		helper.EnvVarFromValue("RAILS_LOG_TO_STDOUT", "true"),
 		helper.EnvVarFromValue("RAILS_ENV", "production"),
 		helper.EnvVarFromSecret("DATABASE_URL", "zync", "DATABASE_URL"),
@@ -302,17 +387,18 @@ func (zync *Zync) commonZyncEnvVars() []v1.EnvVar {
 				},
 			},
 		},
-	}
+	}, append(zync.databaseTLSEnvVars(), zync.databaseSchemaEnvVars()...)...)
 }
 func (zync *Zync) QueDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ZyncQueDeploymentName,
-			Labels: zync.Options.CommonZyncQueLabels,
+			Name:        ZyncQueDeploymentName,
+			Labels:      zync.Options.CommonZyncQueLabels,
+			Annotations: zync.Options.ZyncQueCustomAnnotations,
 		},
 		Spec: appsv1.DeploymentConfigSpec{
 			Replicas: zync.Options.ZyncQueReplicas,
@@ -353,23 +439,22 @@ func (zync *Zync) QueDeploymentConfig() *appsv1.DeploymentConfig {
 			},
 			Template: &v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						"prometheus.io/port":   "9394",
-						"prometheus.io/scrape": "true",
-					},
-					Labels: zync.Options.ZyncQuePodTemplateLabels,
+					Annotations: zync.podAnnotations(zync.Options.ZyncQueCustomAnnotations, "9394"),
+					Labels:      zync.Options.ZyncQuePodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
 					Affinity:                      zync.Options.ZyncQueAffinity,
 					Tolerations:                   zync.Options.ZyncQueTolerations,
-					ServiceAccountName:            "zync-que-sa",
+					ServiceAccountName:            ZyncQueServiceAccountName,
+					AutomountServiceAccountToken:  zync.queAutomountServiceAccountToken(),
+					Volumes:                       zync.queVolumes(),
 					RestartPolicy:                 v1.RestartPolicyAlways,
 					TerminationGracePeriodSeconds: &[]int64{30}[0],
 					Containers: []v1.Container{
 						v1.Container{
 							Name:            "que",
 							Command:         []string{"/usr/bin/bash"},
-							Args:            []string{"-c", "bundle exec rake 'que[--worker-count 10]'"},
+							Args:            []string{"-c", "bundle exec rake \"que[--worker-count ${QUE_WORKER_COUNT} --poll-interval ${QUE_POLL_INTERVAL}]\""},
 							Image:           "amp-zync:latest",
 							ImagePullPolicy: v1.PullAlways,
 							LivenessProbe: &v1.Probe{
@@ -389,18 +474,96 @@ func (zync *Zync) QueDeploymentConfig() *appsv1.DeploymentConfig {
 							Ports: []v1.ContainerPort{
 								v1.ContainerPort{Name: "metrics", ContainerPort: ZyncQueMetricsPort, Protocol: v1.ProtocolTCP},
 							},
-							Resources: zync.Options.QueContainerResourceRequirements,
-							Env:       zync.commonZyncEnvVars(),
+							Resources:    zync.Options.QueContainerResourceRequirements,
+							Env:          append(zync.commonZyncEnvVars(), zync.queWorkerEnvVars()...),
+							VolumeMounts: zync.queVolumeMounts(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	applyProbesOptions(dc.Spec.Template, zync.Options.ZyncQueProbes)
+	applyRubyRuntimeTuningOptions(dc.Spec.Template, zync.Options.ZyncQueRuntimeTuning)
+	applyExtraEnv(dc.Spec.Template, zync.Options.ZyncQueExtraEnv)
+	applyTerminationOptions(dc.Spec.Template, zync.Options.ZyncQueTermination)
+	applyPodTemplateOverridesOptions(dc.Spec.Template, zync.Options.ZyncQuePodTemplateOverrides)
+	applySecurityContextOptions(dc.Spec.Template, zync.Options.ZyncQueSecurityContext)
+	applyInitContainers(dc, zync.Options.ZyncQueInitContainers)
+	applySidecars(dc.Spec.Template, zync.Options.ZyncQueSidecars)
+
+	return dc
+}
+
+func (zync *Zync) queAutomountServiceAccountToken() *bool {
+	if zync.Options.ZyncQueServiceAccountTokenLegacy {
+		return nil
+	}
+	return &[]bool{false}[0]
+}
+
+func (zync *Zync) queVolumes() []v1.Volume {
+	if zync.Options.ZyncQueServiceAccountTokenLegacy {
+		return zync.databaseTLSVolumes()
+	}
+
+	// Explicit defaults so the volume matches the one stored in the cluster
+	return append([]v1.Volume{
+		{
+			Name: ZyncQueServiceAccountTokenVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Projected: &v1.ProjectedVolumeSource{
+					DefaultMode: &[]int32{420}[0],
+					Sources: []v1.VolumeProjection{
+						{
+							ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+								Audience:          zync.Options.ZyncQueServiceAccountTokenAudience,
+								ExpirationSeconds: &[]int64{zync.Options.ZyncQueServiceAccountTokenExpirationSeconds}[0],
+								Path:              ZyncQueServiceAccountTokenPath,
+							},
+						},
+						{
+							ConfigMap: &v1.ConfigMapProjection{
+								LocalObjectReference: v1.LocalObjectReference{Name: ZyncQueRootCAConfigMapName},
+								Items: []v1.KeyToPath{
+									{Key: ZyncQueRootCAConfigMapKey, Path: ZyncQueRootCAConfigMapKey},
+								},
+							},
+						},
+						{
+							DownwardAPI: &v1.DownwardAPIProjection{
+								Items: []v1.DownwardAPIVolumeFile{
+									{
+										Path:     "namespace",
+										FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.namespace"},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+	}, zync.databaseTLSVolumes()...)
+}
+
+func (zync *Zync) queVolumeMounts() []v1.VolumeMount {
+	if zync.Options.ZyncQueServiceAccountTokenLegacy {
+		return zync.databaseTLSVolumeMounts()
 	}
+
+	return append([]v1.VolumeMount{
+		{
+			Name:      ZyncQueServiceAccountTokenVolumeName,
+			MountPath: ZyncQueServiceAccountTokenMountPath,
+			ReadOnly:  true,
+		},
+	}, zync.databaseTLSVolumeMounts()...)
 }
 
 func (zync *Zync) DatabaseDeploymentConfig() *appsv1.DeploymentConfig {
-	return &appsv1.DeploymentConfig{
+	dc := &appsv1.DeploymentConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DeploymentConfig",
 			APIVersion: "apps.openshift.io/v1",
@@ -438,10 +601,13 @@ func (zync *Zync) DatabaseDeploymentConfig() *appsv1.DeploymentConfig {
 					Labels: zync.Options.ZyncDatabasePodTemplateLabels,
 				},
 				Spec: v1.PodSpec{
-					Affinity:           zync.Options.ZyncDatabaseAffinity,
-					Tolerations:        zync.Options.ZyncDatabaseTolerations,
-					RestartPolicy:      v1.RestartPolicyAlways,
-					ServiceAccountName: "amp",
+					NodeSelector:              zync.Options.ZyncDatabaseNodeSelector,
+					Affinity:                  zync.Options.ZyncDatabaseAffinity,
+					Tolerations:               zync.Options.ZyncDatabaseTolerations,
+					TopologySpreadConstraints: zync.Options.ZyncDatabaseTopologySpreadConstraints,
+					PriorityClassName:         zync.Options.ZyncDatabasePriorityClassName,
+					RestartPolicy:             v1.RestartPolicyAlways,
+					ServiceAccountName:        "amp",
 					Containers: []v1.Container{
 						v1.Container{
 							Name:  "postgresql",
@@ -451,12 +617,12 @@ func (zync *Zync) DatabaseDeploymentConfig() *appsv1.DeploymentConfig {
 									ContainerPort: 5432,
 									Protocol:      v1.ProtocolTCP},
 							},
-							VolumeMounts: []v1.VolumeMount{
+							VolumeMounts: append([]v1.VolumeMount{
 								v1.VolumeMount{
-									Name:      "zync-database-data",
+									Name:      ZyncDatabaseDataVolumeName,
 									MountPath: "/var/lib/pgsql/data",
 								},
-							},
+							}, sharedMemoryVolumeMounts(zync.Options.ZyncDatabaseSharedMemorySizeLimit)...),
 							ImagePullPolicy: v1.PullIfNotPresent,
 							Env: []v1.EnvVar{
 								v1.EnvVar{
@@ -498,20 +664,21 @@ func (zync *Zync) DatabaseDeploymentConfig() *appsv1.DeploymentConfig {
 							Resources: zync.Options.DatabaseContainerResourceRequirements,
 						},
 					},
-					Volumes: []v1.Volume{
+					Volumes: append([]v1.Volume{
 						v1.Volume{
-							Name: "zync-database-data",
-							VolumeSource: v1.VolumeSource{
-								EmptyDir: &v1.EmptyDirVolumeSource{
-									Medium: v1.StorageMediumDefault,
-								},
-							},
+							Name:         ZyncDatabaseDataVolumeName,
+							VolumeSource: zync.databaseDataVolumeSource(),
 						},
-					},
+					}, sharedMemoryVolumes(zync.Options.ZyncDatabaseSharedMemorySizeLimit)...),
 				},
 			},
 		},
 	}
+
+	applyImagePullPolicy(dc.Spec.Template, zync.Options.ZyncDatabaseImagePullPolicy)
+	applySecurityContextOptions(dc.Spec.Template, zync.Options.ZyncDatabaseSecurityContext)
+
+	return dc
 }
 
 func (zync *Zync) Service() *v1.Service {
@@ -521,8 +688,9 @@ func (zync *Zync) Service() *v1.Service {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ZyncName,
-			Labels: zync.Options.CommonZyncLabels,
+			Name:        ZyncName,
+			Labels:      helper.MergeMapsStringString(zync.Options.ZyncCustomLabels, zync.Options.CommonZyncLabels),
+			Annotations: zync.Options.ZyncCustomAnnotations,
 		},
 		Spec: v1.ServiceSpec{
 			Ports: []v1.ServicePort{
@@ -563,41 +731,11 @@ func (zync *Zync) DatabaseService() *v1.Service {
 }
 
 func (zync *Zync) ZyncPodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   ZyncName,
-			Labels: zync.Options.CommonZyncLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": ZyncName},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(ZyncName, zync.Options.CommonZyncLabels, zync.Options.ZyncPodDisruptionBudget)
 }
 
 func (zync *Zync) QuePodDisruptionBudget() *v1beta1.PodDisruptionBudget {
-	return &v1beta1.PodDisruptionBudget{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "PodDisruptionBudget",
-			APIVersion: "policy/v1beta1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "zync-que",
-			Labels: zync.Options.CommonZyncQueLabels,
-		},
-		Spec: v1beta1.PodDisruptionBudgetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"deploymentConfig": "zync-que"},
-			},
-			MaxUnavailable: &intstr.IntOrString{IntVal: PDB_MAX_UNAVAILABLE_POD_NUMBER},
-		},
-	}
+	return podDisruptionBudget(ZyncQueDeploymentName, zync.Options.CommonZyncQueLabels, zync.Options.ZyncQuePodDisruptionBudget)
 }
 
 func (zync *Zync) zyncPorts() []v1.ContainerPort {
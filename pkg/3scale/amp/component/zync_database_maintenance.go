@@ -0,0 +1,108 @@
+package component
+
+import (
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ZyncDatabaseMaintenanceCronJobName     = "zync-database-maintenance"
+	DefaultZyncDatabaseMaintenanceSchedule = "0 3 * * 0"
+)
+
+// ZyncDatabaseMaintenanceOptions configures the maintenance of the internal zync database
+type ZyncDatabaseMaintenanceOptions struct {
+	Image    string `validate:"required"`
+	Schedule string `validate:"required"`
+	Repack   bool
+	// Scheduling settings of the job pods, inherited from the zync database
+	JobPodTemplate *helper.JobPodTemplateOptions `validate:"-"`
+}
+
+// DatabaseMaintenanceCronJob vacuums and analyzes the internal zync database.
+// The que jobs constantly insert and delete rows, leaving dead tuples behind
+func (zync *Zync) DatabaseMaintenanceCronJob() *batchv1beta1.CronJob {
+	opts := zync.Options.DatabaseMaintenance
+	if opts == nil {
+		// Only used to delete the CronJob
+		opts = &ZyncDatabaseMaintenanceOptions{Schedule: DefaultZyncDatabaseMaintenanceSchedule}
+	}
+
+	cronJob := &batchv1beta1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CronJob",
+			APIVersion: "batch/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ZyncDatabaseMaintenanceCronJobName,
+			Labels: zync.databaseMaintenanceLabels(),
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule:                   opts.Schedule,
+			ConcurrencyPolicy:          batchv1beta1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: &[]int32{1}[0],
+			FailedJobsHistoryLimit:     &[]int32{3}[0],
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: &[]int32{1}[0],
+					Template: v1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: zync.databaseMaintenanceLabels(),
+						},
+						Spec: v1.PodSpec{
+							RestartPolicy:      v1.RestartPolicyNever,
+							ServiceAccountName: "amp",
+							Containers: []v1.Container{
+								{
+									Name:    ZyncDatabaseMaintenanceCronJobName,
+									Image:   opts.Image,
+									Command: []string{"/bin/bash", "-c", "-e"},
+									Args:    []string{zyncDatabaseMaintenanceScript(opts.Repack)},
+									Env: []v1.EnvVar{
+										helper.EnvVarFromValue("PGHOST", ZyncDatabaseDeploymentName),
+										helper.EnvVarFromValue("PGPORT", "5432"),
+										helper.EnvVarFromValue("PGUSER", "zync"),
+										helper.EnvVarFromValue("PGDATABASE", "zync_production"),
+										helper.EnvVarFromSecret("PGPASSWORD", ZyncSecretName, ZyncSecretDatabasePasswordFieldName),
+									},
+									ImagePullPolicy: v1.PullIfNotPresent,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helper.ApplyJobPodTemplateOptions(&cronJob.Spec.JobTemplate.Spec.Template.Spec, opts.JobPodTemplate)
+	return cronJob
+}
+
+func zyncDatabaseMaintenanceScript(repack bool) string {
+	script := "psql -v ON_ERROR_STOP=1 -c 'VACUUM (VERBOSE, ANALYZE)'\n"
+	if repack {
+		script += `if command -v pg_repack >/dev/null 2>&1; then
+  pg_repack --no-superuser-check --no-order --dbname="${PGDATABASE}"
+else
+  echo "pg_repack not available in the image, skipping"
+fi
+`
+	}
+	return script
+}
+
+func (zync *Zync) databaseMaintenanceLabels() map[string]string {
+	labels := make(map[string]string)
+
+	for key, value := range zync.Options.CommonLabels {
+		labels[key] = value
+	}
+
+	labels["threescale_component_element"] = "database-maintenance"
+	return labels
+}
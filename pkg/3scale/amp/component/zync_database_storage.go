@@ -0,0 +1,78 @@
+package component
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ZyncDatabaseDataVolumeName is the data volume of the internal zync database,
+	// and the name of its PersistentVolumeClaim when the storage is persistent
+	ZyncDatabaseDataVolumeName = "zync-database-data"
+)
+
+func DefaultZyncDatabaseStorageSize() resource.Quantity {
+	return resource.MustParse("1Gi")
+}
+
+// ZyncDatabaseStorageOptions configures the PersistentVolumeClaim of the internal zync database
+type ZyncDatabaseStorageOptions struct {
+	Size             resource.Quantity               `validate:"-"`
+	StorageClassName *string                         `validate:"-"`
+	VolumeName       *string                         `validate:"-"`
+	AccessModes      []v1.PersistentVolumeAccessMode `validate:"required"`
+}
+
+// DatabasePersistentVolumeClaim returns the data PersistentVolumeClaim of the
+// internal zync database. Only deployed when the storage options are set
+func (zync *Zync) DatabasePersistentVolumeClaim() *v1.PersistentVolumeClaim {
+	opts := zync.Options.DatabaseStorage
+	if opts == nil {
+		return nil
+	}
+
+	volumeName := ""
+	if opts.VolumeName != nil {
+		volumeName = *opts.VolumeName
+	}
+
+	return &v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ZyncDatabaseDataVolumeName,
+			Labels: zync.Options.CommonZyncDatabaseLabels,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: opts.AccessModes,
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: opts.Size,
+				},
+			},
+			StorageClassName: opts.StorageClassName,
+			VolumeName:       volumeName,
+		},
+	}
+}
+
+// databaseDataVolumeSource returns the PersistentVolumeClaim when the storage
+// options are set, an ephemeral volume otherwise
+func (zync *Zync) databaseDataVolumeSource() v1.VolumeSource {
+	if zync.Options.DatabaseStorage == nil {
+		return v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{
+				Medium: v1.StorageMediumDefault,
+			},
+		}
+	}
+
+	return v1.VolumeSource{
+		PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+			ClaimName: ZyncDatabaseDataVolumeName,
+		},
+	}
+}
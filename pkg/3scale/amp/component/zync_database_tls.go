@@ -0,0 +1,125 @@
+package component
+
+import (
+	"path"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	ZyncDatabaseTLSVolumeName    = "zync-database-tls"
+	ZyncDatabaseTLSMountPath     = "/var/run/secrets/zync-database-tls"
+	ZyncDatabaseTLSCASecretKey   = "ca.crt"
+	ZyncDatabaseTLSCertSecretKey = "tls.crt"
+	ZyncDatabaseTLSKeySecretKey  = "tls.key"
+	// ZyncDatabaseTLSHashAnnotation rolls out zync and zync-que when the TLS secret
+	// changes, as the certificates are only read when connecting
+	ZyncDatabaseTLSHashAnnotation = "apps.3scale.net/zync-database-tls-hash"
+	DefaultZyncDatabaseSSLMode    = "verify-full"
+)
+
+const (
+	ZyncDatabaseSSLCAEnvVarName   = "DATABASE_SSL_CA"
+	ZyncDatabaseSSLCertEnvVarName = "DATABASE_SSL_CERT"
+	ZyncDatabaseSSLKeyEnvVarName  = "DATABASE_SSL_KEY"
+	ZyncDatabaseSSLModeEnvVarName = "DATABASE_SSL_MODE"
+)
+
+// ZyncDatabaseTLSEnvVarNames are the env vars set from the database TLS options
+var ZyncDatabaseTLSEnvVarNames = []string{
+	ZyncDatabaseSSLCAEnvVarName,
+	ZyncDatabaseSSLCertEnvVarName,
+	ZyncDatabaseSSLKeyEnvVarName,
+	ZyncDatabaseSSLModeEnvVarName,
+}
+
+// ZyncDatabaseTLSOptions configures the TLS connection to an external zync database
+type ZyncDatabaseTLSOptions struct {
+	SecretName string `validate:"required"`
+	SSLMode    string `validate:"required"`
+	// ClientCertificate is set when the secret holds the client certificate and key
+	ClientCertificate bool
+	Hash              string `validate:"required"`
+}
+
+func (zync *Zync) databaseTLSEnvVars() []v1.EnvVar {
+	opts := zync.Options.DatabaseTLS
+	if opts == nil {
+		return nil
+	}
+
+	result := []v1.EnvVar{
+		helper.EnvVarFromValue(ZyncDatabaseSSLCAEnvVarName, path.Join(ZyncDatabaseTLSMountPath, ZyncDatabaseTLSCASecretKey)),
+	}
+	if opts.ClientCertificate {
+		result = append(result,
+			helper.EnvVarFromValue(ZyncDatabaseSSLCertEnvVarName, path.Join(ZyncDatabaseTLSMountPath, ZyncDatabaseTLSCertSecretKey)),
+			helper.EnvVarFromValue(ZyncDatabaseSSLKeyEnvVarName, path.Join(ZyncDatabaseTLSMountPath, ZyncDatabaseTLSKeySecretKey)),
+		)
+	}
+	result = append(result, helper.EnvVarFromValue(ZyncDatabaseSSLModeEnvVarName, opts.SSLMode))
+
+	return result
+}
+
+func (zync *Zync) databaseTLSVolumes() []v1.Volume {
+	opts := zync.Options.DatabaseTLS
+	if opts == nil {
+		return nil
+	}
+
+	items := []v1.KeyToPath{
+		{Key: ZyncDatabaseTLSCASecretKey, Path: ZyncDatabaseTLSCASecretKey},
+	}
+	if opts.ClientCertificate {
+		items = append(items,
+			v1.KeyToPath{Key: ZyncDatabaseTLSCertSecretKey, Path: ZyncDatabaseTLSCertSecretKey},
+			v1.KeyToPath{Key: ZyncDatabaseTLSKeySecretKey, Path: ZyncDatabaseTLSKeySecretKey},
+		)
+	}
+
+	// Explicit default mode so the volume matches the one stored in the cluster.
+	// libpq refuses client keys readable by others
+	return []v1.Volume{
+		{
+			Name: ZyncDatabaseTLSVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName:  opts.SecretName,
+					Items:       items,
+					DefaultMode: &[]int32{0640}[0],
+				},
+			},
+		},
+	}
+}
+
+func (zync *Zync) databaseTLSVolumeMounts() []v1.VolumeMount {
+	if zync.Options.DatabaseTLS == nil {
+		return nil
+	}
+
+	return []v1.VolumeMount{
+		{
+			Name:      ZyncDatabaseTLSVolumeName,
+			MountPath: ZyncDatabaseTLSMountPath,
+			ReadOnly:  true,
+		},
+	}
+}
+
+// podAnnotations returns the pod annotations. The annotations set
+// by the operator override the custom annotations
+func (zync *Zync) podAnnotations(customAnnotations map[string]string, metricsPort string) map[string]string {
+	annotations := helper.MergeMapsStringString(customAnnotations, map[string]string{
+		"prometheus.io/port":   metricsPort,
+		"prometheus.io/scrape": "true",
+	})
+
+	if zync.Options.DatabaseTLS != nil {
+		annotations[ZyncDatabaseTLSHashAnnotation] = zync.Options.DatabaseTLS.Hash
+	}
+
+	return annotations
+}
@@ -0,0 +1,156 @@
+package component
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+const (
+	// ZyncDatabaseSchemaSearchPathEnvVarName is the schema search path of the
+	// zync database connection, also used by the migrations
+	ZyncDatabaseSchemaSearchPathEnvVarName = "DATABASE_SCHEMA_SEARCH_PATH"
+
+	// PostgreSQL truncates identifiers longer than 63 bytes
+	zyncDatabaseIdentifierMaxLength = 63
+)
+
+var zyncDatabaseSchemaRegexp = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_$]*|"[^"]+")$`)
+
+// ZyncDatabaseURL holds the parts of the zync DATABASE_URL checked by the operator
+type ZyncDatabaseURL struct {
+	Password    string
+	HasPassword bool
+	// Database is empty when the url does not set it, the server default is used then
+	Database string
+	// SchemaSearchPath is the comma separated schema search path, from either the
+	// schema_search_path or the options query parameters. Empty when not set
+	SchemaSearchPath string
+}
+
+// ParseZyncDatabaseURL parses and validates the zync DATABASE_URL. Query
+// parameters not listed below are left to the database client:
+//   - dbname: database name, same as the path of the url
+//   - schema_search_path: comma separated schema search path
+//   - options: command-line options sent to the server, where the search path is
+//     set with "-c search_path=<schemas>"
+func ParseZyncDatabaseURL(rawURL string) (*ZyncDatabaseURL, error) {
+	databaseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ZyncDatabaseURL{}
+	if databaseURL.User != nil {
+		result.Password, result.HasPassword = databaseURL.User.Password()
+	}
+
+	if port := databaseURL.Port(); port != "" {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil || portNumber < 1 || portNumber > 65535 {
+			return nil, fmt.Errorf("invalid port '%s'", port)
+		}
+	}
+
+	query, err := url.ParseQuery(databaseURL.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	result.Database, err = zyncDatabaseName(databaseURL.Path, query.Get("dbname"))
+	if err != nil {
+		return nil, err
+	}
+
+	result.SchemaSearchPath, err = zyncDatabaseSchemaSearchPath(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func zyncDatabaseName(urlPath, dbnameParam string) (string, error) {
+	database := strings.TrimPrefix(urlPath, "/")
+	if strings.Contains(database, "/") {
+		return "", fmt.Errorf("invalid database name '%s'", database)
+	}
+	if dbnameParam != "" {
+		if database != "" && database != dbnameParam {
+			return "", fmt.Errorf("database name '%s' does not match dbname parameter '%s'", database, dbnameParam)
+		}
+		database = dbnameParam
+	}
+	if len(database) > zyncDatabaseIdentifierMaxLength {
+		return "", fmt.Errorf("database name '%s' longer than %d characters", database, zyncDatabaseIdentifierMaxLength)
+	}
+	return database, nil
+}
+
+func zyncDatabaseSchemaSearchPath(query url.Values) (string, error) {
+	searchPath := query.Get("schema_search_path")
+
+	optionsSearchPath := ""
+	fields := strings.Fields(query.Get("options"))
+	for idx := 0; idx < len(fields); idx++ {
+		option := fields[idx]
+		switch {
+		case option == "-c" && idx+1 < len(fields):
+			idx++
+			option = fields[idx]
+		case strings.HasPrefix(option, "-c"):
+			option = strings.TrimPrefix(option, "-c")
+		case strings.HasPrefix(option, "--"):
+			option = strings.TrimPrefix(option, "--")
+		default:
+			continue
+		}
+		if strings.HasPrefix(option, "search_path=") {
+			optionsSearchPath = strings.TrimPrefix(option, "search_path=")
+		}
+	}
+
+	if optionsSearchPath != "" {
+		if searchPath != "" && normalizeZyncDatabaseSchemaSearchPath(searchPath) != normalizeZyncDatabaseSchemaSearchPath(optionsSearchPath) {
+			return "", fmt.Errorf("schema_search_path parameter '%s' does not match search_path option '%s'", searchPath, optionsSearchPath)
+		}
+		searchPath = optionsSearchPath
+	}
+
+	if searchPath == "" {
+		return "", nil
+	}
+
+	searchPath = normalizeZyncDatabaseSchemaSearchPath(searchPath)
+	for _, schema := range strings.Split(searchPath, ",") {
+		if !zyncDatabaseSchemaRegexp.MatchString(schema) || len(schema) > zyncDatabaseIdentifierMaxLength+2 {
+			return "", fmt.Errorf("invalid schema '%s' in search path '%s'", schema, searchPath)
+		}
+	}
+	return searchPath, nil
+}
+
+// normalizeZyncDatabaseSchemaSearchPath removes the spaces around the schemas
+func normalizeZyncDatabaseSchemaSearchPath(searchPath string) string {
+	schemas := strings.Split(searchPath, ",")
+	for idx := range schemas {
+		schemas[idx] = strings.TrimSpace(schemas[idx])
+	}
+	return strings.Join(schemas, ",")
+}
+
+func (zync *Zync) databaseSchemaEnvVars() []v1.EnvVar {
+	if zync.Options.DatabaseSchemaSearchPath == "" {
+		return nil
+	}
+
+	return []v1.EnvVar{
+		helper.EnvVarFromValue(ZyncDatabaseSchemaSearchPathEnvVarName, zync.Options.DatabaseSchemaSearchPath),
+	}
+}
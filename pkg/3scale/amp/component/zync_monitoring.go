@@ -8,9 +8,6 @@ import (
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
-
-	"github.com/3scale/3scale-operator/pkg/assets"
-	"github.com/3scale/3scale-operator/pkg/common"
 )
 
 func (zync *Zync) ZyncPodMonitor() *monitoringv1.PodMonitor {
@@ -47,26 +44,17 @@ func (zync *Zync) ZyncQuePodMonitor() *monitoringv1.PodMonitor {
 			Selector: metav1.LabelSelector{
 				MatchLabels: zync.Options.CommonZyncQueLabels,
 			},
+			PodTargetLabels: []string{ZyncQueWorkerCountLabel},
 		},
 	}
 }
 
 func (zync *Zync) ZyncGrafanaDashboard(sumRate string) *grafanav1alpha1.GrafanaDashboard {
-	data := &struct {
-		Namespace, SumRate string
-	}{
-		zync.Options.Namespace, sumRate,
-	}
-	return &grafanav1alpha1.GrafanaDashboard{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "zync",
-			Labels: zync.monitoringLabels(),
-		},
-		Spec: grafanav1alpha1.GrafanaDashboardSpec{
-			Json: assets.TemplateAsset("monitoring/zync-grafana-dashboard-1.json.tpl", data),
-			Name: fmt.Sprintf("%s/zync-grafana-dashboard-1.json", zync.Options.Namespace),
-		},
+	data := &grafanaDashboardTemplateData{
+		Namespace: zync.Options.Namespace,
+		SumRate:   sumRate,
 	}
+	return grafanaDashboard("zync", "zync-grafana-dashboard-1.json", zync.Options.CommonLabels, zync.Options.GrafanaDashboard, data)
 }
 
 func (zync *Zync) ZyncPrometheusRules() *monitoringv1.PrometheusRule {
@@ -191,17 +179,6 @@ func (zync *Zync) ZyncQuePrometheusRules() *monitoringv1.PrometheusRule {
 	}
 }
 
-func (zync *Zync) monitoringLabels() map[string]string {
-	labels := make(map[string]string)
-
-	for key, value := range zync.Options.CommonLabels {
-		labels[key] = value
-	}
-
-	labels["monitoring-key"] = common.MonitoringKey
-	return labels
-}
-
 func (zync *Zync) prometheusRulesMonitoringLabels() map[string]string {
 	labels := make(map[string]string)
 
@@ -1,7 +1,7 @@
 package component
 
 import (
-	"fmt"
+	"net/url"
 
 	oprand "github.com/3scale/3scale-operator/pkg/crypto/rand"
 	"github.com/go-playground/validator/v10"
@@ -23,12 +23,77 @@ type ZyncOptions struct {
 	ZyncReplicas                          int32
 	ZyncQueReplicas                       int32
 
-	ZyncAffinity            *v1.Affinity    `validate:"-"`
-	ZyncTolerations         []v1.Toleration `validate:"-"`
-	ZyncQueAffinity         *v1.Affinity    `validate:"-"`
-	ZyncQueTolerations      []v1.Toleration `validate:"-"`
-	ZyncDatabaseAffinity    *v1.Affinity    `validate:"-"`
-	ZyncDatabaseTolerations []v1.Toleration `validate:"-"`
+	// Que workers of each zync-que pod and idle polling interval in seconds
+	ZyncQueWorkerCount     int32 `validate:"min=1"`
+	ZyncQuePollingInterval int32 `validate:"min=1"`
+
+	// Replicas not managed by the operator are only set on creation
+	ZyncReplicasManaged    bool
+	ZyncQueReplicasManaged bool
+
+	// Disruption policies of the pods. The default policy is used when not set
+	ZyncPodDisruptionBudget    *PodDisruptionBudgetOptions `validate:"-"`
+	ZyncQuePodDisruptionBudget *PodDisruptionBudgetOptions `validate:"-"`
+
+	// Probes tuning of the containers. The default probes are used when not set
+	ZyncProbes    *ProbesOptions `validate:"omitempty"`
+	ZyncQueProbes *ProbesOptions `validate:"omitempty"`
+
+	// Ruby runtime tuning of the containers. The defaults of the image are used when not set
+	ZyncRuntimeTuning    *RubyRuntimeTuningOptions `validate:"omitempty"`
+	ZyncQueRuntimeTuning *RubyRuntimeTuningOptions `validate:"omitempty"`
+
+	// Extra env vars appended to the operator managed env vars of the containers
+	ZyncExtraEnv    []v1.EnvVar `validate:"-"`
+	ZyncQueExtraEnv []v1.EnvVar `validate:"-"`
+
+	// Sidecar containers and volumes appended to the pods
+	ZyncSidecars    *SidecarsOptions `validate:"-"`
+	ZyncQueSidecars *SidecarsOptions `validate:"-"`
+
+	// Init containers customized and appended to the pods
+	ZyncInitContainers    *InitContainersOptions `validate:"-"`
+	ZyncQueInitContainers *InitContainersOptions `validate:"-"`
+
+	// Termination of the pods. The default grace period and no preStop hook are used when not set
+	ZyncTermination    *TerminationOptions `validate:"omitempty"`
+	ZyncQueTermination *TerminationOptions `validate:"omitempty"`
+
+	ZyncAffinity                          *v1.Affinity                  `validate:"-"`
+	ZyncTolerations                       []v1.Toleration               `validate:"-"`
+	ZyncQueAffinity                       *v1.Affinity                  `validate:"-"`
+	ZyncQueTolerations                    []v1.Toleration               `validate:"-"`
+	ZyncDatabaseNodeSelector              map[string]string             `validate:"-"`
+	ZyncDatabaseAffinity                  *v1.Affinity                  `validate:"-"`
+	ZyncDatabaseTolerations               []v1.Toleration               `validate:"-"`
+	ZyncDatabaseTopologySpreadConstraints []v1.TopologySpreadConstraint `validate:"-"`
+
+	ZyncDatabasePriorityClassName string         `validate:"-"`
+	ZyncDatabaseImagePullPolicy   *v1.PullPolicy `validate:"-"`
+
+	// Pod template settings overridden in the APIManager
+	ZyncPodTemplateOverrides    PodTemplateOverridesOptions `validate:"-"`
+	ZyncQuePodTemplateOverrides PodTemplateOverridesOptions `validate:"-"`
+
+	ZyncSecurityContext         *SecurityContextOptions `validate:"-"`
+	ZyncQueSecurityContext      *SecurityContextOptions `validate:"-"`
+	ZyncDatabaseSecurityContext *SecurityContextOptions `validate:"-"`
+
+	ZyncDatabaseSharedMemorySizeLimit *resource.Quantity `validate:"-"`
+
+	// DatabaseStorage is set when the internal database data is stored in a PersistentVolumeClaim
+	DatabaseStorage *ZyncDatabaseStorageOptions `validate:"omitempty"`
+
+	ZyncForceSSL       *bool    `validate:"-"`
+	ZyncTrustedProxies []string `validate:"-"`
+
+	// Schema search path of an external database, from the database url
+	DatabaseSchemaSearchPath string `validate:"-"`
+
+	// DatabaseTLS is set when the connection to an external database uses TLS
+	DatabaseTLS *ZyncDatabaseTLSOptions
+	// DatabaseMaintenance is set when the internal database maintenance is enabled
+	DatabaseMaintenance *ZyncDatabaseMaintenanceOptions
 
 	CommonLabels                  map[string]string `validate:"required"`
 	CommonZyncLabels              map[string]string `validate:"required"`
@@ -37,9 +102,23 @@ type ZyncOptions struct {
 	ZyncPodTemplateLabels         map[string]string `validate:"required"`
 	ZyncQuePodTemplateLabels      map[string]string `validate:"required"`
 	ZyncDatabasePodTemplateLabels map[string]string `validate:"required"`
+	ZyncCustomLabels              map[string]string `validate:"-"`
+	ZyncCustomAnnotations         map[string]string `validate:"-"`
+	ZyncQueCustomLabels           map[string]string `validate:"-"`
+	ZyncQueCustomAnnotations      map[string]string `validate:"-"`
 	ZyncMetrics                   bool
 
 	ZyncQueServiceAccountImagePullSecrets []v1.LocalObjectReference `validate:"required"`
+	ZyncQueServiceAccountName             string                    `validate:"required"`
+	ZyncQueServiceAccountAnnotations      map[string]string         `validate:"-"`
+
+	// ZyncQueServiceAccountTokenLegacy keeps the ServiceAccount token automounted by the cluster
+	ZyncQueServiceAccountTokenLegacy            bool
+	ZyncQueServiceAccountTokenAudience          string `validate:"-"`
+	ZyncQueServiceAccountTokenExpirationSeconds int64  `validate:"min=600"`
+
+	// Datasource and selector labels of the grafana dashboards. The defaults are used when not set
+	GrafanaDashboard *GrafanaDashboardOptions `validate:"omitempty"`
 
 	// Used for monitoring objects
 	// Those objects are namespaced. However, objects includes labels, rules and expressions
@@ -69,8 +148,20 @@ func DefaultZyncAuthenticationToken() string {
 	return oprand.String(16)
 }
 
+// DefaultZyncQueServiceAccountTokenExpirationSeconds is the validity requested for
+// the projected zync-que token. The kubelet refreshes it at 80% of its lifetime
+const DefaultZyncQueServiceAccountTokenExpirationSeconds int64 = 3600
+
+// DefaultZyncDatabaseURL returns the internal database URL. The password is
+// percent-encoded, as it may contain URL reserved characters
 func DefaultZyncDatabaseURL(password string) string {
-	return fmt.Sprintf("postgresql://zync:%s@zync-database:5432/zync_production", password)
+	databaseURL := &url.URL{
+		Scheme: "postgresql",
+		User:   url.UserPassword("zync", password),
+		Host:   "zync-database:5432",
+		Path:   "/zync_production",
+	}
+	return databaseURL.String()
 }
 
 func DefaultZyncContainerResourceRequirements() v1.ResourceRequirements {
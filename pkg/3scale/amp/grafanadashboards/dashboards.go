@@ -0,0 +1,86 @@
+package grafanadashboards
+
+import (
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+func init() {
+	GrafanaDashboardFactories = append(GrafanaDashboardFactories,
+		newGrafanaDashboardFactoryBuilder("apicast-mainapp", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return apicast(ns, options).ApicastMainAppGrafanaDashboard(sumRate)
+		}),
+		newGrafanaDashboardFactoryBuilder("apicast-services", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return apicast(ns, options).ApicastServicesGrafanaDashboard(sumRate)
+		}),
+		newGrafanaDashboardFactoryBuilder("backend", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return component.NewBackend(&component.BackendOptions{
+				CommonLabels:     commonLabels("backend"),
+				GrafanaDashboard: options,
+				Namespace:        ns,
+			}).BackendGrafanaDashboard(sumRate)
+		}),
+		newGrafanaDashboardFactoryBuilder("system", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return component.NewSystem(&component.SystemOptions{
+				CommonLabels:     commonLabels("system"),
+				GrafanaDashboard: options,
+				Namespace:        ns,
+			}).SystemGrafanaDashboard(sumRate)
+		}),
+		newGrafanaDashboardFactoryBuilder("zync", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return component.NewZync(&component.ZyncOptions{
+				CommonLabels:     commonLabels("zync"),
+				GrafanaDashboard: options,
+				Namespace:        ns,
+			}).ZyncGrafanaDashboard(sumRate)
+		}),
+		newGrafanaDashboardFactoryBuilder("kubernetes-resources-by-namespace", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return component.KubernetesResourcesByNamespaceGrafanaDashboard(sumRate, ns, appsv1alpha1.Default3scaleAppLabel, options)
+		}),
+		newGrafanaDashboardFactoryBuilder("kubernetes-resources-by-pod", func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+			return component.KubernetesResourcesByPodGrafanaDashboard(sumRate, ns, appsv1alpha1.Default3scaleAppLabel, options)
+		}),
+	)
+}
+
+// grafanaDashboardFactory generates one of the dashboards. The dashboards only
+// need the namespace, the common labels and the dashboard options of the component
+type grafanaDashboardFactory struct {
+	name      string
+	dashboard func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard
+}
+
+func newGrafanaDashboardFactoryBuilder(name string, dashboard func(sumRate, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard) GrafanaDashboardFactoryBuilder {
+	return func() GrafanaDashboardFactory {
+		return &grafanaDashboardFactory{name: name, dashboard: dashboard}
+	}
+}
+
+func (g *grafanaDashboardFactory) Type() string {
+	return g.name
+}
+
+func (g *grafanaDashboardFactory) GrafanaDashboard(compatPre49 bool, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard {
+	sumRate := "sum_irate"
+	if compatPre49 {
+		sumRate = "sum_rate"
+	}
+	return g.dashboard(sumRate, ns, options)
+}
+
+func apicast(ns string, options *component.GrafanaDashboardOptions) *component.Apicast {
+	return component.NewApicast(&component.ApicastOptions{
+		CommonLabels:     commonLabels("apicast"),
+		GrafanaDashboard: options,
+		Namespace:        ns,
+	})
+}
+
+func commonLabels(threescaleComponent string) map[string]string {
+	return map[string]string{
+		"app":                  appsv1alpha1.Default3scaleAppLabel,
+		"threescale_component": threescaleComponent,
+	}
+}
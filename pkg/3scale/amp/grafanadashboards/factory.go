@@ -0,0 +1,17 @@
+package grafanadashboards
+
+import (
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+type GrafanaDashboardFactory interface {
+	GrafanaDashboard(compatPre49 bool, ns string, options *component.GrafanaDashboardOptions) *grafanav1alpha1.GrafanaDashboard
+	Type() string
+}
+
+type GrafanaDashboardFactoryBuilder = func() GrafanaDashboardFactory
+
+// GrafanaDashboardFactories is a list of grafanadashboard factories
+var GrafanaDashboardFactories []GrafanaDashboardFactoryBuilder
@@ -23,40 +23,39 @@ func (a *AmpImagesOptionsProvider) GetAmpImagesOptions() (*component.AmpImagesOp
 	a.ampImagesOptions.AmpRelease = product.ThreescaleRelease
 	a.ampImagesOptions.InsecureImportPolicy = *a.apimanager.Spec.ImageStreamTagImportInsecure
 
-	a.ampImagesOptions.ApicastImage = ApicastImageURL()
+	a.ampImagesOptions.ApicastImage = a.apimanager.DefaultImageURL(ApicastImageURL())
 	if a.apimanager.Spec.Apicast != nil && a.apimanager.Spec.Apicast.Image != nil {
 		a.ampImagesOptions.ApicastImage = *a.apimanager.Spec.Apicast.Image
 	}
 
-	a.ampImagesOptions.BackendImage = BackendImageURL()
+	a.ampImagesOptions.BackendImage = a.apimanager.DefaultImageURL(BackendImageURL())
 	if a.apimanager.Spec.Backend != nil && a.apimanager.Spec.Backend.Image != nil {
 		a.ampImagesOptions.BackendImage = *a.apimanager.Spec.Backend.Image
 	}
 
-	a.ampImagesOptions.SystemImage = SystemImageURL()
+	a.ampImagesOptions.SystemImage = a.apimanager.DefaultImageURL(SystemImageURL())
 	if a.apimanager.Spec.System != nil && a.apimanager.Spec.System.Image != nil {
 		a.ampImagesOptions.SystemImage = *a.apimanager.Spec.System.Image
 	}
 
-	a.ampImagesOptions.ZyncImage = ZyncImageURL()
+	a.ampImagesOptions.ZyncImage = a.apimanager.DefaultImageURL(ZyncImageURL())
 	if a.apimanager.Spec.Zync != nil && a.apimanager.Spec.Zync.Image != nil {
 		a.ampImagesOptions.ZyncImage = *a.apimanager.Spec.Zync.Image
 	}
 
-	a.ampImagesOptions.ZyncDatabasePostgreSQLImage = ZyncPostgreSQLImageURL()
+	a.ampImagesOptions.ZyncDatabasePostgreSQLImage = a.apimanager.DefaultImageURL(ZyncPostgreSQLImageURL())
 	if a.apimanager.Spec.Zync != nil && a.apimanager.Spec.Zync.PostgreSQLImage != nil {
 		a.ampImagesOptions.ZyncDatabasePostgreSQLImage = *a.apimanager.Spec.Zync.PostgreSQLImage
 	}
 
-	a.ampImagesOptions.SystemMemcachedImage = SystemMemcachedImageURL()
+	a.ampImagesOptions.SystemMemcachedImage = a.apimanager.DefaultImageURL(SystemMemcachedImageURL())
 	if a.apimanager.Spec.System != nil && a.apimanager.Spec.System.MemcachedImage != nil {
 		a.ampImagesOptions.SystemMemcachedImage = *a.apimanager.Spec.System.MemcachedImage
 	}
 
-	a.ampImagesOptions.ImagePullSecrets = component.AmpImagesDefaultImagePullSecrets()
-	if a.apimanager.Spec.ImagePullSecrets != nil {
-		a.ampImagesOptions.ImagePullSecrets = a.apimanager.Spec.ImagePullSecrets
-	}
+	a.ampImagesOptions.ImagePullSecrets = a.apimanager.ServiceAccountImagePullSecrets(component.AmpImagesDefaultImagePullSecrets())
+	a.ampImagesOptions.ServiceAccountName = a.apimanager.ServiceAccountName(appsv1alpha1.AmpServiceAccountName)
+	a.ampImagesOptions.ServiceAccountAnnotations = a.apimanager.ServiceAccountAnnotations(appsv1alpha1.AmpServiceAccountName)
 
 	err := a.ampImagesOptions.Validate()
 	return a.ampImagesOptions, err
@@ -7,6 +7,7 @@ import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	v1 "k8s.io/api/core/v1"
@@ -34,6 +35,7 @@ func defaultAmpImageOptions() *component.AmpImagesOptions {
 		SystemMemcachedImage:        SystemMemcachedImageURL(),
 		InsecureImportPolicy:        insecureImportPolicy,
 		ImagePullSecrets:            component.AmpImagesDefaultImagePullSecrets(),
+		ServiceAccountName:          appsv1alpha1.AmpServiceAccountName,
 	}
 }
 
@@ -138,6 +140,29 @@ func TestGetAmpImagesOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{
+			"image registry override",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.ImageRegistryOverride = &appsv1alpha1.ImageRegistryOverrideSpec{Host: "mirror.example.com:5000"}
+				apimanager.Spec.Apicast = &appsv1alpha1.ApicastSpec{Image: &tmpApicastImage}
+				return apimanager
+			},
+			func() *component.AmpImagesOptions {
+				opts := defaultAmpImageOptions()
+				override := func(image string) string {
+					return helper.OverrideImageRegistry(image, "mirror.example.com:5000", "")
+				}
+				// Explicit component images are not rewritten
+				opts.ApicastImage = tmpApicastImage
+				opts.BackendImage = override(opts.BackendImage)
+				opts.SystemImage = override(opts.SystemImage)
+				opts.ZyncImage = override(opts.ZyncImage)
+				opts.ZyncDatabasePostgreSQLImage = override(opts.ZyncDatabasePostgreSQLImage)
+				opts.SystemMemcachedImage = override(opts.SystemMemcachedImage)
+				return opts
+			},
+		},
 		{
 			"custom image pull secrets",
 			func() *appsv1alpha1.APIManager {
@@ -153,6 +178,39 @@ func TestGetAmpImagesOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{
+			"merged image pull secrets",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.ImagePullSecrets = testAmpImagesCustomImagePullSecrets()
+				apimanager.Spec.ImagePullSecretsPolicy = &[]string{appsv1alpha1.ImagePullSecretsPolicyMerge}[0]
+				return apimanager
+			},
+			func() *component.AmpImagesOptions {
+				opts := defaultAmpImageOptions()
+				opts.ImagePullSecrets = append(component.AmpImagesDefaultImagePullSecrets(), testAmpImagesCustomImagePullSecrets()...)
+				return opts
+			},
+		},
+		{
+			"custom service account",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.ServiceAccounts = &appsv1alpha1.ServiceAccountsSpec{
+					Amp: &appsv1alpha1.ServiceAccountSpec{
+						Name:        &[]string{"threescale-workloads"}[0],
+						Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/threescale"},
+					},
+				}
+				return apimanager
+			},
+			func() *component.AmpImagesOptions {
+				opts := defaultAmpImageOptions()
+				opts.ServiceAccountName = "threescale-workloads"
+				opts.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/threescale"}
+				return opts
+			},
+		},
 	}
 
 	for _, tc := range cases {
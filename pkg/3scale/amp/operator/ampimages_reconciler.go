@@ -24,50 +24,62 @@ func (r *AMPImagesReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
-	// backend IS
-	err = r.ReconcileImagestream(ampImages.BackendImageStream(), reconcilers.GenericImageStreamMutator)
+	// apicast IS
+	err = r.ReconcileImagestream(ampImages.APICastImageStream(), reconcilers.GenericImageStreamMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	// zync IS
-	err = r.ReconcileImagestream(ampImages.ZyncImageStream(), reconcilers.GenericImageStreamMutator)
+	// The control plane images are not used in gateway only mode
+	if !r.apiManager.IsGatewayOnly() {
+		err = r.reconcileControlPlaneImageStreams(ampImages)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	err = r.ReconcileServiceAccount(ampImages.DeploymentsServiceAccount(), reconcilers.ServiceAccountImagePullPolicyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	// apicast IS
-	err = r.ReconcileImagestream(ampImages.APICastImageStream(), reconcilers.GenericImageStreamMutator)
+	return reconcile.Result{}, nil
+}
+
+func (r *AMPImagesReconciler) reconcileControlPlaneImageStreams(ampImages *component.AmpImages) error {
+	// backend IS
+	err := r.ReconcileImagestream(ampImages.BackendImageStream(), reconcilers.GenericImageStreamMutator)
 	if err != nil {
-		return reconcile.Result{}, err
+		return err
+	}
+
+	// zync IS
+	err = r.ReconcileImagestream(ampImages.ZyncImageStream(), reconcilers.GenericImageStreamMutator)
+	if err != nil {
+		return err
 	}
 
 	// system IS
 	err = r.ReconcileImagestream(ampImages.SystemImageStream(), reconcilers.GenericImageStreamMutator)
 	if err != nil {
-		return reconcile.Result{}, err
+		return err
 	}
 
 	if !r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase) {
 		// zync db postresql IS
 		err = r.ReconcileImagestream(ampImages.ZyncDatabasePostgreSQLImageStream(), reconcilers.GenericImageStreamMutator)
 		if err != nil {
-			return reconcile.Result{}, err
+			return err
 		}
 	}
 
 	// system memcached IS
 	err = r.ReconcileImagestream(ampImages.SystemMemcachedImageStream(), reconcilers.GenericImageStreamMutator)
 	if err != nil {
-		return reconcile.Result{}, err
-	}
-
-	err = r.ReconcileServiceAccount(ampImages.DeploymentsServiceAccount(), reconcilers.ServiceAccountImagePullPolicyMutator)
-	if err != nil {
-		return reconcile.Result{}, err
+		return err
 	}
 
-	return reconcile.Result{}, nil
+	return nil
 }
 
 func AmpImages(apimanager *appsv1alpha1.APIManager) (*component.AmpImages, error) {
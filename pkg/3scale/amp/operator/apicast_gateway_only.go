@@ -0,0 +1,78 @@
+package operator
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (a *ApicastOptionsProvider) setGatewayOnlyOptions() error {
+	if !a.apimanager.IsGatewayOnly() {
+		return nil
+	}
+
+	opts := &component.ApicastGatewayOnlyOptions{
+		PortalEndpointSecretName: a.apimanager.Spec.GatewayOnly.PortalEndpointSecretRef.Name,
+		TenantName:               *a.apimanager.Spec.TenantName,
+		WildcardDomain:           a.apimanager.Spec.WildcardDomain,
+	}
+
+	secret, err := a.secretSource.CachedSecret(opts.PortalEndpointSecretName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// The secret watch requeues the APIManager once it is created
+			return &helper.WaitError{Err: fmt.Errorf("gateway only portal endpoint secret '%s' not found", opts.PortalEndpointSecretName)}
+		}
+		return err
+	}
+
+	portalEndpoint := secret.Data[component.ApicastPortalEndpointSecretKey]
+	if len(portalEndpoint) == 0 {
+		return fmt.Errorf("Secret field '%s' is required in secret '%s'", component.ApicastPortalEndpointSecretKey, opts.PortalEndpointSecretName)
+	}
+
+	h := fnv.New32a()
+	h.Write(portalEndpoint)
+	opts.PortalEndpointHash = fmt.Sprint(h.Sum32())
+
+	a.apicastOptions.GatewayOnly = opts
+	return nil
+}
+
+// apicastPortalEndpointMutator reconciles the control plane endpoints env vars
+// and the remote portal endpoint hash of the apicast pods
+func apicastPortalEndpointMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	changed := false
+
+	for _, envVar := range []string{"THREESCALE_PORTAL_ENDPOINT", "BACKEND_ENDPOINT_OVERRIDE"} {
+		tmpChanged := reconcilers.DeploymentConfigEnvVarReconciler(desired, existing, envVar)
+		changed = changed || tmpChanged
+	}
+
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, component.ApicastPortalEndpointHashAnnotation)
+			changed = true
+		}
+		return changed, nil
+	}
+
+	if !existingOk || existingVal != desiredVal {
+		if existing.Spec.Template.Annotations == nil {
+			existing.Spec.Template.Annotations = map[string]string{}
+		}
+		existing.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation] = desiredVal
+		changed = true
+	}
+
+	return changed, nil
+}
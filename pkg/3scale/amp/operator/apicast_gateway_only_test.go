@@ -0,0 +1,163 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const portalEndpointTestSecretName = "portal-endpoint"
+
+func gatewayOnlyTestApimanager() *appsv1alpha1.APIManager {
+	apimanager := basicApimanager()
+	apimanager.Spec.System = nil
+	apimanager.Spec.GatewayOnly = &appsv1alpha1.GatewayOnlySpec{
+		PortalEndpointSecretRef: v1.LocalObjectReference{Name: portalEndpointTestSecretName},
+	}
+	return apimanager
+}
+
+func portalEndpointTestSecret(endpoint string) *v1.Secret {
+	return GetTestSecret(namespace, portalEndpointTestSecretName, map[string]string{
+		component.ApicastPortalEndpointSecretKey: endpoint,
+	})
+}
+
+func findEnvVar(envVars []v1.EnvVar, name string) (v1.EnvVar, bool) {
+	for _, envVar := range envVars {
+		if envVar.Name == name {
+			return envVar, true
+		}
+	}
+	return v1.EnvVar{}, false
+}
+
+func TestApicastGatewayOnlyOptions(t *testing.T) {
+	t.Run("MissingSecret", func(subT *testing.T) {
+		_, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient())
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+	})
+
+	t.Run("MissingSecretKey", func(subT *testing.T) {
+		secret := GetTestSecret(namespace, portalEndpointTestSecretName, map[string]string{"other": "value"})
+		_, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(secret))
+		if err == nil || helper.IsWaitError(err) {
+			subT.Fatalf("expected error, got %v", err)
+		}
+	})
+
+	t.Run("DeploymentConfigs", func(subT *testing.T) {
+		apicast, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(portalEndpointTestSecret("https://token@3scale-admin.example.com")))
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for _, dc := range []string{"staging", "production"} {
+			deploymentConfig := apicast.StagingDeploymentConfig()
+			if dc == "production" {
+				deploymentConfig = apicast.ProductionDeploymentConfig()
+			}
+			container := deploymentConfig.Spec.Template.Spec.Containers[0]
+
+			portalEndpoint, ok := findEnvVar(container.Env, "THREESCALE_PORTAL_ENDPOINT")
+			if !ok {
+				subT.Fatalf("%s: THREESCALE_PORTAL_ENDPOINT not found", dc)
+			}
+			if portalEndpoint.ValueFrom == nil || portalEndpoint.ValueFrom.SecretKeyRef == nil ||
+				portalEndpoint.ValueFrom.SecretKeyRef.Name != portalEndpointTestSecretName ||
+				portalEndpoint.ValueFrom.SecretKeyRef.Key != component.ApicastPortalEndpointSecretKey {
+				subT.Errorf("%s: unexpected THREESCALE_PORTAL_ENDPOINT source: %v", dc, portalEndpoint.ValueFrom)
+			}
+
+			if _, ok := findEnvVar(container.Env, "BACKEND_ENDPOINT_OVERRIDE"); ok {
+				subT.Errorf("%s: BACKEND_ENDPOINT_OVERRIDE not expected", dc)
+			}
+
+			if _, ok := deploymentConfig.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation]; !ok {
+				subT.Errorf("%s: portal endpoint hash annotation not found", dc)
+			}
+		}
+	})
+
+	t.Run("Routes", func(subT *testing.T) {
+		apicast, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(portalEndpointTestSecret("https://token@3scale-admin.example.com")))
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		expectedHosts := map[string]string{
+			apicast.StagingRoute().Spec.Host:    "api-" + tenantName + "-apicast-staging." + wildcardDomain,
+			apicast.ProductionRoute().Spec.Host: "api-" + tenantName + "-apicast-production." + wildcardDomain,
+		}
+		for host, expected := range expectedHosts {
+			if host != expected {
+				subT.Errorf("expected route host %s, got %s", expected, host)
+			}
+		}
+	})
+
+	t.Run("HashChangesWithEndpoint", func(subT *testing.T) {
+		apicastA, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(portalEndpointTestSecret("https://a@3scale-admin.example.com")))
+		if err != nil {
+			subT.Fatal(err)
+		}
+		apicastB, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(portalEndpointTestSecret("https://b@3scale-admin.example.com")))
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apicastA.Options.GatewayOnly.PortalEndpointHash == apicastB.Options.GatewayOnly.PortalEndpointHash {
+			subT.Error("expected portal endpoint hash to change")
+		}
+	})
+}
+
+func TestApicastPortalEndpointMutator(t *testing.T) {
+	fullApicast, err := Apicast(basicApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gatewayOnlyApicast, err := Apicast(gatewayOnlyTestApimanager(), fake.NewFakeClient(portalEndpointTestSecret("https://token@3scale-admin.example.com")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := fullApicast.ProductionDeploymentConfig()
+	desired := gatewayOnlyApicast.ProductionDeploymentConfig()
+
+	changed, err := apicastPortalEndpointMutator(desired, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change")
+	}
+	if _, ok := existing.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation]; !ok {
+		t.Error("expected portal endpoint hash annotation")
+	}
+
+	changed, err = apicastPortalEndpointMutator(desired, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("expected no change on second reconciliation")
+	}
+
+	changed, err = apicastPortalEndpointMutator(fullApicast.ProductionDeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change")
+	}
+	if _, ok := existing.Spec.Template.Annotations[component.ApicastPortalEndpointHashAnnotation]; ok {
+		t.Error("expected portal endpoint hash annotation to be removed")
+	}
+}
@@ -0,0 +1,455 @@
+package operator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	// APIcastImportAnnotation triggers a one-shot import of the referenced
+	// apicast-operator APIcast CR (same namespace) into the APIManager
+	APIcastImportAnnotation = "apps.3scale.net/apicast-import"
+	// APIcastImportModeAnnotation selects what to do with the import result.
+	// "configmap" (default) writes a suggested patch into a ConfigMap.
+	// "apply" writes the result into the APIManager spec.
+	APIcastImportModeAnnotation = "apps.3scale.net/apicast-import-mode"
+
+	APIcastImportModeConfigMap = "configmap"
+	APIcastImportModeApply     = "apply"
+
+	APIcastImportConfigMapPatchKey          = "apimanager-patch.yaml"
+	APIcastImportConfigMapUnmappedFieldsKey = "unmapped-fields"
+
+	apicastImportEnvironmentProduction = "production"
+	apicastImportEnvironmentStaging    = "staging"
+)
+
+// APIcastGVK is the GroupVersionKind of the apicast-operator APIcast CR
+var APIcastGVK = schema.GroupVersionKind{Group: "apps.3scale.net", Version: "v1alpha1", Kind: "APIcast"}
+
+// apicastImportSourceSpec mirrors the subset of the apicast-operator APIcast spec
+// that has an equivalent in the APIManager apicast specs
+type apicastImportSourceSpec struct {
+	Replicas                       *int64                               `json:"replicas,omitempty"`
+	Image                          *string                              `json:"image,omitempty"`
+	DeploymentEnvironment          *string                              `json:"deploymentEnvironment,omitempty"`
+	LogLevel                       *string                              `json:"logLevel,omitempty"`
+	ResponseCodesIncluded          *bool                                `json:"responseCodesIncluded,omitempty"`
+	ManagementAPIScope             *string                              `json:"managementAPIScope,omitempty"`
+	OpenSSLPeerVerificationEnabled *bool                                `json:"openSSLPeerVerificationEnabled,omitempty"`
+	Resources                      *v1.ResourceRequirements             `json:"resources,omitempty"`
+	Workers                        *int32                               `json:"workers,omitempty"`
+	CustomPolicies                 []appsv1alpha1.CustomPolicySpec      `json:"customPolicies,omitempty"`
+	OpenTracing                    *appsv1alpha1.APIcastOpenTracingSpec `json:"openTracing,omitempty"`
+	CustomEnvironments             []appsv1alpha1.CustomEnvironmentSpec `json:"customEnvironments,omitempty"`
+	HTTPSPort                      *int32                               `json:"httpsPort,omitempty"`
+	HTTPSVerifyDepth               *int64                               `json:"httpsVerifyDepth,omitempty"`
+	HTTPSCertificateSecretRef      *v1.LocalObjectReference             `json:"httpsCertificateSecretRef,omitempty"`
+	AllProxy                       *string                              `json:"allProxy,omitempty"`
+	HTTPProxy                      *string                              `json:"httpProxy,omitempty"`
+	HTTPSProxy                     *string                              `json:"httpsProxy,omitempty"`
+	NoProxy                        *string                              `json:"noProxy,omitempty"`
+}
+
+// apicastImportMappedFields are the APIcast spec fields that are translated.
+// Any other field present in the source spec is reported as unmapped.
+var apicastImportMappedFields = map[string]bool{
+	"replicas":                       true,
+	"image":                          true,
+	"deploymentEnvironment":          true,
+	"logLevel":                       true,
+	"responseCodesIncluded":          true,
+	"managementAPIScope":             true,
+	"openSSLPeerVerificationEnabled": true,
+	"resources":                      true,
+	"workers":                        true,
+	"customPolicies":                 true,
+	"openTracing":                    true,
+	"customEnvironments":             true,
+	"httpsPort":                      true,
+	"httpsVerifyDepth":               true,
+	"httpsCertificateSecretRef":      true,
+	"allProxy":                       true,
+	"httpProxy":                      true,
+	"httpsProxy":                     true,
+	"noProxy":                        true,
+}
+
+// APIcastImportResult holds the translation of an APIcast CR spec
+// into APIManager apicast fields
+type APIcastImportResult struct {
+	// Environment is the APIManager apicast environment targeted: production or staging
+	Environment string
+	// Apicast holds only the translated fields. Either ProductionSpec or
+	// StagingSpec is set, depending on Environment
+	Apicast *appsv1alpha1.ApicastSpec
+	// UnmappedFields lists the source spec fields without equivalent in the APIManager
+	UnmappedFields []string
+}
+
+// TranslateAPIcastSpec translates the spec of an apicast-operator APIcast CR
+// into the corresponding APIManager apicast fields.
+func TranslateAPIcastSpec(spec map[string]interface{}) (*APIcastImportResult, error) {
+	rawSpec, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &apicastImportSourceSpec{}
+	err = json.Unmarshal(rawSpec, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode APIcast spec: %w", err)
+	}
+
+	result := &APIcastImportResult{
+		Environment:    apicastImportEnvironmentProduction,
+		Apicast:        &appsv1alpha1.ApicastSpec{},
+		UnmappedFields: []string{},
+	}
+
+	for key := range spec {
+		if !apicastImportMappedFields[key] {
+			result.UnmappedFields = append(result.UnmappedFields, key)
+		}
+	}
+
+	if source.DeploymentEnvironment != nil {
+		switch *source.DeploymentEnvironment {
+		case apicastImportEnvironmentProduction, apicastImportEnvironmentStaging:
+			result.Environment = *source.DeploymentEnvironment
+		default:
+			return nil, fmt.Errorf("unknown APIcast deploymentEnvironment '%s'", *source.DeploymentEnvironment)
+		}
+	}
+
+	// Fields shared by both environments in the APIManager
+	result.Apicast.Image = source.Image
+	result.Apicast.IncludeResponseCodes = source.ResponseCodesIncluded
+	result.Apicast.ApicastManagementAPI = source.ManagementAPIScope
+	result.Apicast.OpenSSLVerify = source.OpenSSLPeerVerificationEnabled
+
+	if result.Environment == apicastImportEnvironmentProduction {
+		result.Apicast.ProductionSpec = &appsv1alpha1.ApicastProductionSpec{
+			Replicas:                  source.Replicas,
+			Resources:                 source.Resources,
+			Workers:                   source.Workers,
+			LogLevel:                  source.LogLevel,
+			CustomPolicies:            source.CustomPolicies,
+			OpenTracing:               source.OpenTracing,
+			CustomEnvironments:        source.CustomEnvironments,
+			HTTPSPort:                 source.HTTPSPort,
+			HTTPSVerifyDepth:          source.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef: source.HTTPSCertificateSecretRef,
+			AllProxy:                  source.AllProxy,
+			HTTPProxy:                 source.HTTPProxy,
+			HTTPSProxy:                source.HTTPSProxy,
+			NoProxy:                   source.NoProxy,
+		}
+	} else {
+		result.Apicast.StagingSpec = &appsv1alpha1.ApicastStagingSpec{
+			Replicas:                  source.Replicas,
+			Resources:                 source.Resources,
+			LogLevel:                  source.LogLevel,
+			CustomPolicies:            source.CustomPolicies,
+			OpenTracing:               source.OpenTracing,
+			CustomEnvironments:        source.CustomEnvironments,
+			HTTPSPort:                 source.HTTPSPort,
+			HTTPSVerifyDepth:          source.HTTPSVerifyDepth,
+			HTTPSCertificateSecretRef: source.HTTPSCertificateSecretRef,
+			AllProxy:                  source.AllProxy,
+			HTTPProxy:                 source.HTTPProxy,
+			HTTPSProxy:                source.HTTPSProxy,
+			NoProxy:                   source.NoProxy,
+		}
+		// Workers cannot be configured on the APIManager staging environment
+		if source.Workers != nil {
+			result.UnmappedFields = append(result.UnmappedFields, "workers")
+		}
+	}
+
+	sort.Strings(result.UnmappedFields)
+
+	return result, nil
+}
+
+// ApplyTo writes the translated fields into the APIManager.
+// Fields not set in the import result are left untouched.
+func (r *APIcastImportResult) ApplyTo(apimanager *appsv1alpha1.APIManager) {
+	if apimanager.Spec.Apicast == nil {
+		apimanager.Spec.Apicast = &appsv1alpha1.ApicastSpec{}
+	}
+	dst := apimanager.Spec.Apicast
+
+	if r.Apicast.Image != nil {
+		dst.Image = r.Apicast.Image
+	}
+	if r.Apicast.IncludeResponseCodes != nil {
+		dst.IncludeResponseCodes = r.Apicast.IncludeResponseCodes
+	}
+	if r.Apicast.ApicastManagementAPI != nil {
+		dst.ApicastManagementAPI = r.Apicast.ApicastManagementAPI
+	}
+	if r.Apicast.OpenSSLVerify != nil {
+		dst.OpenSSLVerify = r.Apicast.OpenSSLVerify
+	}
+
+	if src := r.Apicast.ProductionSpec; src != nil {
+		if dst.ProductionSpec == nil {
+			dst.ProductionSpec = &appsv1alpha1.ApicastProductionSpec{}
+		}
+		env := dst.ProductionSpec
+		if src.Replicas != nil {
+			env.Replicas = src.Replicas
+		}
+		if src.Resources != nil {
+			env.Resources = src.Resources
+		}
+		if src.Workers != nil {
+			env.Workers = src.Workers
+		}
+		if src.LogLevel != nil {
+			env.LogLevel = src.LogLevel
+		}
+		if src.CustomPolicies != nil {
+			env.CustomPolicies = src.CustomPolicies
+		}
+		if src.OpenTracing != nil {
+			env.OpenTracing = src.OpenTracing
+		}
+		if src.CustomEnvironments != nil {
+			env.CustomEnvironments = src.CustomEnvironments
+		}
+		if src.HTTPSPort != nil {
+			env.HTTPSPort = src.HTTPSPort
+		}
+		if src.HTTPSVerifyDepth != nil {
+			env.HTTPSVerifyDepth = src.HTTPSVerifyDepth
+		}
+		if src.HTTPSCertificateSecretRef != nil {
+			env.HTTPSCertificateSecretRef = src.HTTPSCertificateSecretRef
+		}
+		if src.AllProxy != nil {
+			env.AllProxy = src.AllProxy
+		}
+		if src.HTTPProxy != nil {
+			env.HTTPProxy = src.HTTPProxy
+		}
+		if src.HTTPSProxy != nil {
+			env.HTTPSProxy = src.HTTPSProxy
+		}
+		if src.NoProxy != nil {
+			env.NoProxy = src.NoProxy
+		}
+	}
+
+	if src := r.Apicast.StagingSpec; src != nil {
+		if dst.StagingSpec == nil {
+			dst.StagingSpec = &appsv1alpha1.ApicastStagingSpec{}
+		}
+		env := dst.StagingSpec
+		if src.Replicas != nil {
+			env.Replicas = src.Replicas
+		}
+		if src.Resources != nil {
+			env.Resources = src.Resources
+		}
+		if src.LogLevel != nil {
+			env.LogLevel = src.LogLevel
+		}
+		if src.CustomPolicies != nil {
+			env.CustomPolicies = src.CustomPolicies
+		}
+		if src.OpenTracing != nil {
+			env.OpenTracing = src.OpenTracing
+		}
+		if src.CustomEnvironments != nil {
+			env.CustomEnvironments = src.CustomEnvironments
+		}
+		if src.HTTPSPort != nil {
+			env.HTTPSPort = src.HTTPSPort
+		}
+		if src.HTTPSVerifyDepth != nil {
+			env.HTTPSVerifyDepth = src.HTTPSVerifyDepth
+		}
+		if src.HTTPSCertificateSecretRef != nil {
+			env.HTTPSCertificateSecretRef = src.HTTPSCertificateSecretRef
+		}
+		if src.AllProxy != nil {
+			env.AllProxy = src.AllProxy
+		}
+		if src.HTTPProxy != nil {
+			env.HTTPProxy = src.HTTPProxy
+		}
+		if src.HTTPSProxy != nil {
+			env.HTTPSProxy = src.HTTPSProxy
+		}
+		if src.NoProxy != nil {
+			env.NoProxy = src.NoProxy
+		}
+	}
+}
+
+// PatchYAML returns the import result as a merge patch for the APIManager
+func (r *APIcastImportResult) PatchYAML() (string, error) {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"apicast": r.Apicast,
+		},
+	}
+	out, err := yaml.Marshal(patch)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func APIcastImportConfigMapName(apicastName string) string {
+	return fmt.Sprintf("%s-apicast-import", apicastName)
+}
+
+type APIcastImportReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewAPIcastImportReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *APIcastImportReconciler {
+	return &APIcastImportReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+// Reconcile runs the APIcast import when the APIManager is annotated with
+// APIcastImportAnnotation. The annotation is removed once processed,
+// so the import is only run once per annotation.
+func (r *APIcastImportReconciler) Reconcile() (reconcile.Result, error) {
+	apicastName, ok := r.apiManager.Annotations[APIcastImportAnnotation]
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	mode := APIcastImportModeConfigMap
+	if val, ok := r.apiManager.Annotations[APIcastImportModeAnnotation]; ok {
+		mode = val
+	}
+
+	logger := r.Logger().WithValues("apicast", apicastName, "mode", mode)
+
+	result, err := r.importAPIcast(apicastName)
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "APIcastImportError", "APIcast '%s' import failed: %s", apicastName, err.Error())
+		logger.Error(err, "APIcast import failed")
+		return r.removeImportAnnotations()
+	}
+
+	switch mode {
+	case APIcastImportModeApply:
+		result.ApplyTo(r.apiManager)
+	case APIcastImportModeConfigMap:
+		err = r.reconcileImportConfigMap(apicastName, result)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	default:
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "APIcastImportError", "unknown APIcast import mode '%s'", mode)
+		return r.removeImportAnnotations()
+	}
+
+	msg := fmt.Sprintf("APIcast '%s' imported into the %s environment", apicastName, result.Environment)
+	if len(result.UnmappedFields) > 0 {
+		msg = fmt.Sprintf("%s. Unmapped fields: %s", msg, strings.Join(result.UnmappedFields, ", "))
+	}
+	r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "APIcastImported", msg)
+	logger.Info(msg)
+
+	return r.removeImportAnnotations()
+}
+
+func (r *APIcastImportReconciler) importAPIcast(name string) (*APIcastImportResult, error) {
+	apicast := &unstructured.Unstructured{}
+	apicast.SetGroupVersionKind(APIcastGVK)
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, apicast)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("APIcast '%s' not found", name)
+		}
+		return nil, err
+	}
+
+	spec, _, err := unstructured.NestedMap(apicast.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+
+	return TranslateAPIcastSpec(spec)
+}
+
+func (r *APIcastImportReconciler) reconcileImportConfigMap(apicastName string, result *APIcastImportResult) error {
+	patch, err := result.PatchYAML()
+	if err != nil {
+		return err
+	}
+
+	desired := &v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: APIcastImportConfigMapName(apicastName),
+			Labels: map[string]string{
+				"app":                  *r.apiManager.Spec.AppLabel,
+				"threescale_component": "apicast",
+			},
+		},
+		Data: map[string]string{
+			APIcastImportConfigMapPatchKey:          patch,
+			APIcastImportConfigMapUnmappedFieldsKey: strings.Join(result.UnmappedFields, "\n"),
+		},
+	}
+
+	return r.ReconcileConfigMap(desired, apicastImportConfigMapMutator)
+}
+
+func (r *APIcastImportReconciler) removeImportAnnotations() (reconcile.Result, error) {
+	delete(r.apiManager.Annotations, APIcastImportAnnotation)
+	delete(r.apiManager.Annotations, APIcastImportModeAnnotation)
+	err := r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{Requeue: true}, nil
+}
+
+func apicastImportConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+
+	update := false
+	for _, key := range []string{APIcastImportConfigMapPatchKey, APIcastImportConfigMapUnmappedFieldsKey} {
+		fieldUpdated := reconcilers.ConfigMapReconcileField(desired, existing, key)
+		update = update || fieldUpdated
+	}
+
+	return update, nil
+}
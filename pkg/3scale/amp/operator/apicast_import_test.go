@@ -0,0 +1,218 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+func TestTranslateAPIcastSpec(t *testing.T) {
+	replicas := int64(3)
+	workers := int32(4)
+	logLevel := "debug"
+	image := "quay.io/3scale/apicast:custom"
+	managementAPI := "status"
+	trueValue := true
+	falseValue := false
+	httpsPort := int32(8443)
+	httpsVerifyDepth := int64(2)
+	allProxy := "http://all.example.com"
+	httpProxy := "http://http.example.com"
+	httpsProxy := "https://https.example.com"
+	noProxy := "localhost,example.com"
+	tracingLibrary := "jaeger"
+
+	fullSpec := func(env string) map[string]interface{} {
+		return map[string]interface{}{
+			"adminPortalCredentialsRef":      map[string]interface{}{"name": "portal"},
+			"deploymentEnvironment":          env,
+			"replicas":                       int64(3),
+			"image":                          image,
+			"logLevel":                       logLevel,
+			"workers":                        int64(4),
+			"responseCodesIncluded":          true,
+			"managementAPIScope":             managementAPI,
+			"openSSLPeerVerificationEnabled": false,
+			"cacheConfigurationSeconds":      int64(300),
+			"resources": map[string]interface{}{
+				"limits": map[string]interface{}{"cpu": "1", "memory": "128Mi"},
+			},
+			"customPolicies": []interface{}{
+				map[string]interface{}{"name": "mypolicy", "version": "1.0", "secretRef": map[string]interface{}{"name": "policysecret"}},
+			},
+			"openTracing": map[string]interface{}{
+				"enabled":                true,
+				"tracingLibrary":         tracingLibrary,
+				"tracingConfigSecretRef": map[string]interface{}{"name": "tracingsecret"},
+			},
+			"customEnvironments": []interface{}{
+				map[string]interface{}{"secretRef": map[string]interface{}{"name": "envsecret"}},
+			},
+			"httpsPort":                 int64(8443),
+			"httpsVerifyDepth":          int64(2),
+			"httpsCertificateSecretRef": map[string]interface{}{"name": "certsecret"},
+			"allProxy":                  allProxy,
+			"httpProxy":                 httpProxy,
+			"httpsProxy":                httpsProxy,
+			"noProxy":                   noProxy,
+		}
+	}
+
+	resources := &v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("1"),
+			v1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	}
+	customPolicies := []appsv1alpha1.CustomPolicySpec{
+		{Name: "mypolicy", Version: "1.0", SecretRef: &v1.LocalObjectReference{Name: "policysecret"}},
+	}
+	openTracing := &appsv1alpha1.APIcastOpenTracingSpec{
+		Enabled:                &trueValue,
+		TracingLibrary:         &tracingLibrary,
+		TracingConfigSecretRef: &v1.LocalObjectReference{Name: "tracingsecret"},
+	}
+	customEnvironments := []appsv1alpha1.CustomEnvironmentSpec{
+		{SecretRef: &v1.LocalObjectReference{Name: "envsecret"}},
+	}
+	certificateSecretRef := &v1.LocalObjectReference{Name: "certsecret"}
+
+	cases := []struct {
+		testName       string
+		spec           map[string]interface{}
+		expectedResult *APIcastImportResult
+	}{
+		{"Empty", map[string]interface{}{},
+			&APIcastImportResult{
+				Environment: "production",
+				Apicast: &appsv1alpha1.ApicastSpec{
+					ProductionSpec: &appsv1alpha1.ApicastProductionSpec{},
+				},
+				UnmappedFields: []string{},
+			},
+		},
+		{"Production", fullSpec("production"),
+			&APIcastImportResult{
+				Environment: "production",
+				Apicast: &appsv1alpha1.ApicastSpec{
+					Image:                &image,
+					IncludeResponseCodes: &trueValue,
+					ApicastManagementAPI: &managementAPI,
+					OpenSSLVerify:        &falseValue,
+					ProductionSpec: &appsv1alpha1.ApicastProductionSpec{
+						Replicas:                  &replicas,
+						Resources:                 resources,
+						Workers:                   &workers,
+						LogLevel:                  &logLevel,
+						CustomPolicies:            customPolicies,
+						OpenTracing:               openTracing,
+						CustomEnvironments:        customEnvironments,
+						HTTPSPort:                 &httpsPort,
+						HTTPSVerifyDepth:          &httpsVerifyDepth,
+						HTTPSCertificateSecretRef: certificateSecretRef,
+						AllProxy:                  &allProxy,
+						HTTPProxy:                 &httpProxy,
+						HTTPSProxy:                &httpsProxy,
+						NoProxy:                   &noProxy,
+					},
+				},
+				UnmappedFields: []string{"adminPortalCredentialsRef", "cacheConfigurationSeconds"},
+			},
+		},
+		{"Staging", fullSpec("staging"),
+			&APIcastImportResult{
+				Environment: "staging",
+				Apicast: &appsv1alpha1.ApicastSpec{
+					Image:                &image,
+					IncludeResponseCodes: &trueValue,
+					ApicastManagementAPI: &managementAPI,
+					OpenSSLVerify:        &falseValue,
+					StagingSpec: &appsv1alpha1.ApicastStagingSpec{
+						Replicas:                  &replicas,
+						Resources:                 resources,
+						LogLevel:                  &logLevel,
+						CustomPolicies:            customPolicies,
+						OpenTracing:               openTracing,
+						CustomEnvironments:        customEnvironments,
+						HTTPSPort:                 &httpsPort,
+						HTTPSVerifyDepth:          &httpsVerifyDepth,
+						HTTPSCertificateSecretRef: certificateSecretRef,
+						AllProxy:                  &allProxy,
+						HTTPProxy:                 &httpProxy,
+						HTTPSProxy:                &httpsProxy,
+						NoProxy:                   &noProxy,
+					},
+				},
+				UnmappedFields: []string{"adminPortalCredentialsRef", "cacheConfigurationSeconds", "workers"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			result, err := TranslateAPIcastSpec(tc.spec)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			// Quantities hold a cached string representation; compare semantically
+			if !cmp.Equal(tc.expectedResult, result, cmp.Comparer(resourceQuantityEqual)) {
+				subT.Fatal(cmp.Diff(tc.expectedResult, result, cmp.Comparer(resourceQuantityEqual)))
+			}
+		})
+	}
+}
+
+func TestTranslateAPIcastSpecUnknownEnvironment(t *testing.T) {
+	_, err := TranslateAPIcastSpec(map[string]interface{}{"deploymentEnvironment": "sandbox"})
+	if err == nil {
+		t.Fatal("expected error for unknown deploymentEnvironment")
+	}
+}
+
+func TestAPIcastImportResultApplyTo(t *testing.T) {
+	replicas := int64(5)
+	logLevel := "info"
+	existingLogLevel := "warn"
+	existingReplicas := int64(1)
+	existingWorkers := int32(2)
+
+	apimanager := basicApimanager()
+	apimanager.Spec.Apicast.ProductionSpec = &appsv1alpha1.ApicastProductionSpec{
+		Replicas: &existingReplicas,
+		Workers:  &existingWorkers,
+		LogLevel: &existingLogLevel,
+	}
+	existingStaging := apimanager.Spec.Apicast.StagingSpec
+
+	result := &APIcastImportResult{
+		Environment: "production",
+		Apicast: &appsv1alpha1.ApicastSpec{
+			ProductionSpec: &appsv1alpha1.ApicastProductionSpec{
+				Replicas: &replicas,
+				LogLevel: &logLevel,
+			},
+		},
+	}
+	result.ApplyTo(apimanager)
+
+	expected := &appsv1alpha1.ApicastProductionSpec{
+		Replicas: &replicas,
+		Workers:  &existingWorkers,
+		LogLevel: &logLevel,
+	}
+	if !reflect.DeepEqual(expected, apimanager.Spec.Apicast.ProductionSpec) {
+		t.Fatal(cmp.Diff(expected, apimanager.Spec.Apicast.ProductionSpec))
+	}
+	if apimanager.Spec.Apicast.StagingSpec != existingStaging {
+		t.Fatal("staging spec should not be modified when importing into production")
+	}
+}
+
+func resourceQuantityEqual(a, b resource.Quantity) bool {
+	return a.Cmp(b) == 0
+}
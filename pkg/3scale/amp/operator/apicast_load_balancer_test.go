@@ -0,0 +1,171 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApicastLoadBalancer(t *testing.T) {
+	apicastLB := func(subT *testing.T, delay *int64, tgbName *string) *component.Apicast {
+		apimanager := basicApimanagerTestApicastOptions()
+		for _, spec := range []struct {
+			delay          **int64
+			readinessGates *[]v1.PodReadinessGate
+			aws            **appsv1alpha1.APIcastAWSLoadBalancerSpec
+		}{
+			{&apimanager.Spec.Apicast.ProductionSpec.LBDeregistrationDelaySeconds, &apimanager.Spec.Apicast.ProductionSpec.ReadinessGates, &apimanager.Spec.Apicast.ProductionSpec.AWSLoadBalancer},
+			{&apimanager.Spec.Apicast.StagingSpec.LBDeregistrationDelaySeconds, &apimanager.Spec.Apicast.StagingSpec.ReadinessGates, &apimanager.Spec.Apicast.StagingSpec.AWSLoadBalancer},
+		} {
+			*spec.delay = delay
+			if tgbName != nil {
+				*spec.readinessGates = []v1.PodReadinessGate{{ConditionType: "example.com/registered"}}
+				*spec.aws = &appsv1alpha1.APIcastAWSLoadBalancerSpec{TargetGroupBindingName: tgbName}
+			}
+		}
+
+		apicast, err := Apicast(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		return apicast
+	}
+
+	apicastDCs := func(apicast *component.Apicast) []*appsv1.DeploymentConfig {
+		return []*appsv1.DeploymentConfig{apicast.ProductionDeploymentConfig(), apicast.StagingDeploymentConfig()}
+	}
+
+	apicastServices := func(apicast *component.Apicast) []*v1.Service {
+		return []*v1.Service{apicast.ProductionService(), apicast.StagingService()}
+	}
+
+	delay := int64(20)
+	tgbName := "apicast-tgb"
+
+	t.Run("Default", func(subT *testing.T) {
+		apicast := apicastLB(subT, nil, nil)
+		for _, dc := range apicastDCs(apicast) {
+			podSpec := dc.Spec.Template.Spec
+			if podSpec.ReadinessGates != nil || podSpec.TerminationGracePeriodSeconds != nil || podSpec.Containers[0].Lifecycle != nil {
+				subT.Errorf("%s: unexpected load balancer settings", dc.Name)
+			}
+		}
+		for _, service := range apicastServices(apicast) {
+			for _, annotation := range component.APIcastLoadBalancerServiceAnnotations {
+				if _, ok := service.Annotations[annotation]; ok {
+					subT.Errorf("%s: unexpected annotation %s", service.Name, annotation)
+				}
+			}
+		}
+	})
+
+	t.Run("Configured", func(subT *testing.T) {
+		apicast := apicastLB(subT, &delay, &tgbName)
+		for _, dc := range apicastDCs(apicast) {
+			podSpec := dc.Spec.Template.Spec
+			expectedGates := []v1.PodReadinessGate{
+				{ConditionType: "example.com/registered"},
+				{ConditionType: "target-health.elbv2.k8s.aws/apicast-tgb"},
+			}
+			if !reflect.DeepEqual(podSpec.ReadinessGates, expectedGates) {
+				subT.Errorf("%s: unexpected readiness gates: %v", dc.Name, podSpec.ReadinessGates)
+			}
+
+			lifecycle := podSpec.Containers[0].Lifecycle
+			if lifecycle == nil || lifecycle.PreStop == nil || lifecycle.PreStop.Exec == nil ||
+				!reflect.DeepEqual(lifecycle.PreStop.Exec.Command, []string{"sleep", "20"}) {
+				subT.Errorf("%s: unexpected lifecycle: %v", dc.Name, lifecycle)
+			}
+			if podSpec.TerminationGracePeriodSeconds == nil || *podSpec.TerminationGracePeriodSeconds != 50 {
+				subT.Errorf("%s: unexpected termination grace period: %v", dc.Name, podSpec.TerminationGracePeriodSeconds)
+			}
+		}
+
+		for _, service := range apicastServices(apicast) {
+			expected := map[string]string{
+				component.APIcastAWSLoadBalancerTargetTypeAnnotation:            component.APIcastAWSLoadBalancerTargetTypeIP,
+				component.APIcastAWSLoadBalancerTargetGroupAttributesAnnotation: "deregistration_delay.timeout_seconds=20",
+			}
+			for key, val := range expected {
+				if service.Annotations[key] != val {
+					subT.Errorf("%s: expected annotation %s=%s, got %v", service.Name, key, val, service.Annotations)
+				}
+			}
+		}
+	})
+
+	cases := []struct {
+		testName        string
+		existingDelay   *int64
+		desiredDelay    *int64
+		expectedChanged bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"NothingToReconcileWithDelay", &delay, &delay, false},
+		{"DelayAdded", nil, &delay, true},
+		{"DelayRemoved", &delay, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existingDCs := apicastDCs(apicastLB(subT, tc.existingDelay, nil))
+			desiredDCs := apicastDCs(apicastLB(subT, tc.desiredDelay, nil))
+			for idx := range desiredDCs {
+				changed, err := apicastLoadBalancerMutator(desiredDCs[idx], existingDCs[idx])
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if changed != tc.expectedChanged {
+					subT.Errorf("%s: expected changed %t, got %t", existingDCs[idx].Name, tc.expectedChanged, changed)
+				}
+				if !reflect.DeepEqual(existingDCs[idx].Spec.Template.Spec, desiredDCs[idx].Spec.Template.Spec) {
+					subT.Errorf("%s: pod spec not reconciled", existingDCs[idx].Name)
+				}
+			}
+		})
+	}
+
+	t.Run("ManualHookIsKept", func(subT *testing.T) {
+		existing := apicastLB(subT, nil, nil).ProductionDeploymentConfig()
+		manualHook := &v1.Lifecycle{PreStop: &v1.Handler{Exec: &v1.ExecAction{Command: []string{"/bin/drain.sh"}}}}
+		existing.Spec.Template.Spec.Containers[0].Lifecycle = manualHook
+		existing.Spec.Template.Spec.TerminationGracePeriodSeconds = &[]int64{120}[0]
+
+		changed, err := apicastLoadBalancerMutator(apicastLB(subT, nil, nil).ProductionDeploymentConfig(), existing)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if changed || existing.Spec.Template.Spec.Containers[0].Lifecycle != manualHook {
+			subT.Error("expected the manual preStop hook to be kept")
+		}
+	})
+
+	t.Run("ServiceAnnotationsMutator", func(subT *testing.T) {
+		existing := apicastLB(subT, nil, nil).ProductionService()
+		existing.Annotations = map[string]string{"custom": "value"}
+		desired := apicastLB(subT, &delay, &tgbName).ProductionService()
+
+		changed, err := apicastServiceMutator(existing, desired)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !changed || existing.Annotations[component.APIcastAWSLoadBalancerTargetTypeAnnotation] != component.APIcastAWSLoadBalancerTargetTypeIP {
+			subT.Errorf("expected the load balancer annotations to be added, got %v", existing.Annotations)
+		}
+
+		changed, err = apicastServiceMutator(existing, apicastLB(subT, nil, nil).ProductionService())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		expected := map[string]string{"custom": "value"}
+		if !changed || !reflect.DeepEqual(existing.Annotations, expected) {
+			subT.Errorf("expected the load balancer annotations to be removed, got %v", existing.Annotations)
+		}
+	})
+}
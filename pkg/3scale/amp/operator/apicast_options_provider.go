@@ -53,6 +53,8 @@ func (a *ApicastOptionsProvider) GetApicastOptions() (*component.ApicastOptions,
 	a.apicastOptions.ProductionWorkers = a.apimanager.Spec.Apicast.ProductionSpec.Workers
 	a.apicastOptions.ProductionLogLevel = a.apimanager.Spec.Apicast.ProductionSpec.LogLevel
 	a.apicastOptions.StagingLogLevel = a.apimanager.Spec.Apicast.StagingSpec.LogLevel
+	a.apicastOptions.SLO = apicastSLOOptions(a.apimanager)
+	a.apicastOptions.GrafanaDashboard = grafanaDashboardOptions(a.apimanager)
 
 	a.apicastOptions.ProductionHTTPSPort = a.apimanager.Spec.Apicast.ProductionSpec.HTTPSPort
 	a.apicastOptions.ProductionHTTPSVerifyDepth = a.apimanager.Spec.Apicast.ProductionSpec.HTTPSVerifyDepth
@@ -81,7 +83,17 @@ func (a *ApicastOptionsProvider) GetApicastOptions() (*component.ApicastOptions,
 
 	a.setResourceRequirementsOptions()
 	a.setNodeAffinityAndTolerationsOptions()
+	a.setCustomLabelsAndAnnotationsOptions()
+	a.setPodTemplateOverridesOptions()
+	a.setSecurityContextOptions()
+	a.setProbesOptions()
+	a.setExtraEnvOptions()
+	a.setSidecarsOptions()
+	a.setInitContainersOptions()
+	a.setTerminationOptions()
+	a.setHorizontalPodAutoscalerOptions()
 	a.setReplicas()
+	a.setPodDisruptionBudgetOptions()
 
 	err := a.setCustomPolicies()
 	if err != nil {
@@ -98,7 +110,23 @@ func (a *ApicastOptionsProvider) GetApicastOptions() (*component.ApicastOptions,
 		return nil, err
 	}
 
+	err = a.setClientTLS()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.setGatewayOnlyOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	if a.apimanager.IsExternalBackend() {
+		a.apicastOptions.ExternalBackendEndpoint = &a.apimanager.Spec.ExternalBackend.ListenerEndpoint
+	}
+
 	a.setProxyConfigurations()
+	a.setWarmup()
+	a.setLoadBalancer()
 
 	// Pod Annotations. Used to rollout apicast deployment if any secrets/configmap changes
 	a.apicastOptions.AdditionalPodAnnotations = a.additionalPodAnnotations()
@@ -134,14 +162,79 @@ func (a *ApicastOptionsProvider) setResourceRequirementsOptions() {
 
 func (a *ApicastOptionsProvider) setNodeAffinityAndTolerationsOptions() {
 	a.apicastOptions.StagingAffinity = a.apimanager.Spec.Apicast.StagingSpec.Affinity
-	a.apicastOptions.StagingTolerations = a.apimanager.Spec.Apicast.StagingSpec.Tolerations
+	a.apicastOptions.StagingTolerations = componentTolerations(a.apimanager, a.apimanager.Spec.Apicast.StagingSpec.Tolerations, a.apimanager.Spec.Apicast.StagingSpec.UnreachableTolerationSeconds)
 	a.apicastOptions.ProductionAffinity = a.apimanager.Spec.Apicast.ProductionSpec.Affinity
-	a.apicastOptions.ProductionTolerations = a.apimanager.Spec.Apicast.ProductionSpec.Tolerations
+	a.apicastOptions.ProductionTolerations = componentTolerations(a.apimanager, a.apimanager.Spec.Apicast.ProductionSpec.Tolerations, a.apimanager.Spec.Apicast.ProductionSpec.UnreachableTolerationSeconds)
 }
 
+// setCustomLabelsAndAnnotationsOptions sets the custom labels and annotations of the
+// component. The custom labels are already part of the pod template labels
+func (a *ApicastOptionsProvider) setCustomLabelsAndAnnotationsOptions() {
+	a.apicastOptions.StagingCustomLabels = a.apimanager.Spec.Apicast.StagingSpec.Labels
+	a.apicastOptions.StagingCustomAnnotations = a.apimanager.Spec.Apicast.StagingSpec.Annotations
+	a.apicastOptions.ProductionCustomLabels = a.apimanager.Spec.Apicast.ProductionSpec.Labels
+	a.apicastOptions.ProductionCustomAnnotations = a.apimanager.Spec.Apicast.ProductionSpec.Annotations
+}
+
+func (a *ApicastOptionsProvider) setPodTemplateOverridesOptions() {
+	a.apicastOptions.StagingPodTemplateOverrides = podTemplateOverridesOptions(a.apimanager, &a.apimanager.Spec.Apicast.StagingSpec.PodTemplateOverridesSpec)
+	a.apicastOptions.ProductionPodTemplateOverrides = podTemplateOverridesOptions(a.apimanager, &a.apimanager.Spec.Apicast.ProductionSpec.PodTemplateOverridesSpec)
+}
+
+func (a *ApicastOptionsProvider) setSecurityContextOptions() {
+	stagingSpec := a.apimanager.Spec.Apicast.StagingSpec
+	a.apicastOptions.StagingSecurityContext = securityContextOptions(stagingSpec.PodSecurityContext, stagingSpec.SecurityContext)
+	productionSpec := a.apimanager.Spec.Apicast.ProductionSpec
+	a.apicastOptions.ProductionSecurityContext = securityContextOptions(productionSpec.PodSecurityContext, productionSpec.SecurityContext)
+}
+
+func (a *ApicastOptionsProvider) setProbesOptions() {
+	a.apicastOptions.StagingProbes = probesOptions(a.apimanager.Spec.Apicast.StagingSpec.Probes)
+	a.apicastOptions.ProductionProbes = probesOptions(a.apimanager.Spec.Apicast.ProductionSpec.Probes)
+}
+
+func (a *ApicastOptionsProvider) setExtraEnvOptions() {
+	a.apicastOptions.StagingExtraEnv = a.apimanager.Spec.Apicast.StagingSpec.Env
+	a.apicastOptions.ProductionExtraEnv = a.apimanager.Spec.Apicast.ProductionSpec.Env
+}
+
+func (a *ApicastOptionsProvider) setSidecarsOptions() {
+	a.apicastOptions.StagingSidecars = sidecarsOptions(a.apimanager.Spec.Apicast.StagingSpec.Sidecars, a.apimanager.Spec.Apicast.StagingSpec.SidecarVolumes)
+	a.apicastOptions.ProductionSidecars = sidecarsOptions(a.apimanager.Spec.Apicast.ProductionSpec.Sidecars, a.apimanager.Spec.Apicast.ProductionSpec.SidecarVolumes)
+}
+
+func (a *ApicastOptionsProvider) setInitContainersOptions() {
+	a.apicastOptions.StagingInitContainers = initContainersOptions(nil, a.apimanager.Spec.Apicast.StagingSpec.InitContainers)
+	a.apicastOptions.ProductionInitContainers = initContainersOptions(a.apimanager.Spec.Apicast.ProductionSpec.WaitInitContainer, a.apimanager.Spec.Apicast.ProductionSpec.InitContainers)
+}
+
+func (a *ApicastOptionsProvider) setTerminationOptions() {
+	a.apicastOptions.StagingTermination = terminationOptions(a.apimanager.Spec.Apicast.StagingSpec.TerminationGracePeriodSeconds, a.apimanager.Spec.Apicast.StagingSpec.PreStopCommand)
+	a.apicastOptions.ProductionTermination = terminationOptions(a.apimanager.Spec.Apicast.ProductionSpec.TerminationGracePeriodSeconds, a.apimanager.Spec.Apicast.ProductionSpec.PreStopCommand)
+}
+
+// setReplicas skips the production replicas when the HPA is enabled, so the operator
+// does not fight the autoscaler. The DeploymentConfig is created with the minimum replicas
 func (a *ApicastOptionsProvider) setReplicas() {
-	a.apicastOptions.ProductionReplicas = int32(*a.apimanager.Spec.Apicast.ProductionSpec.Replicas)
-	a.apicastOptions.StagingReplicas = int32(*a.apimanager.Spec.Apicast.StagingSpec.Replicas)
+	if hpa := a.apicastOptions.ProductionHPA; hpa != nil {
+		a.apicastOptions.ProductionReplicas, a.apicastOptions.ProductionReplicasManaged = hpa.MinReplicas, false
+	} else {
+		a.apicastOptions.ProductionReplicas, a.apicastOptions.ProductionReplicasManaged = replicasOptions(a.apimanager.Spec.Apicast.ProductionSpec.Replicas)
+	}
+	a.apicastOptions.StagingReplicas, a.apicastOptions.StagingReplicasManaged = replicasOptions(a.apimanager.Spec.Apicast.StagingSpec.Replicas)
+}
+
+func (a *ApicastOptionsProvider) setHorizontalPodAutoscalerOptions() {
+	a.apicastOptions.ProductionHPA = horizontalPodAutoscalerOptions(a.apimanager.Spec.Apicast.ProductionSpec.HPA)
+}
+
+func (a *ApicastOptionsProvider) setPodDisruptionBudgetOptions() {
+	pdbSpec := a.apimanager.Spec.PodDisruptionBudget
+	if pdbSpec == nil {
+		return
+	}
+	a.apicastOptions.ProductionPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.ApicastProduction)
+	a.apicastOptions.StagingPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.ApicastStaging)
 }
 
 func (a *ApicastOptionsProvider) commonLabels() map[string]string {
@@ -164,7 +257,8 @@ func (a *ApicastOptionsProvider) commonProductionLabels() map[string]string {
 }
 
 func (a *ApicastOptionsProvider) stagingPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("apicast-staging", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(a.apimanager.Spec.Apicast.StagingSpec.Labels, helper.MeteringLabels("apicast-staging", helper.ApplicationType))
 
 	for k, v := range a.commonStagingLabels() {
 		labels[k] = v
@@ -176,7 +270,8 @@ func (a *ApicastOptionsProvider) stagingPodTemplateLabels() map[string]string {
 }
 
 func (a *ApicastOptionsProvider) productionPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("apicast-production", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(a.apimanager.Spec.Apicast.ProductionSpec.Labels, helper.MeteringLabels("apicast-production", helper.ApplicationType))
 
 	for k, v := range a.commonProductionLabels() {
 		labels[k] = v
@@ -403,6 +498,59 @@ func (a *ApicastOptionsProvider) customEnvironmentSecret(nn types.NamespacedName
 	return secret, nil
 }
 
+func (a *ApicastOptionsProvider) setClientTLS() error {
+	clientTLSSpec := a.apimanager.Spec.Apicast.ProductionSpec.ClientTLS
+	if clientTLSSpec == nil {
+		return nil
+	}
+
+	// CR Validation ensures secret name is not nil
+	namespacedName := types.NamespacedName{
+		Name:      clientTLSSpec.CASecretRef.Name,
+		Namespace: a.apimanager.Namespace,
+	}
+
+	caBundle, err := a.clientTLSCABundle(namespacedName)
+	if err != nil {
+		fieldErrors := field.ErrorList{}
+		caSecretRefFldPath := field.NewPath("spec").
+			Child("apicast").
+			Child("productionSpec").
+			Child("clientTLS").
+			Child("caSecretRef")
+		fieldErrors = append(fieldErrors, field.Invalid(caSecretRefFldPath, clientTLSSpec.CASecretRef.Name, err.Error()))
+		return fieldErrors.ToAggregate()
+	}
+
+	a.apicastOptions.ProductionClientTLSCASecretName = &clientTLSSpec.CASecretRef.Name
+	h := fnv.New32a()
+	h.Write(caBundle)
+	a.apicastOptions.ProductionClientTLSCAHash = fmt.Sprint(h.Sum32())
+
+	if clientTLSSpec.VerifyDepth != nil {
+		a.apicastOptions.ProductionHTTPSVerifyDepth = clientTLSSpec.VerifyDepth
+	}
+
+	return nil
+}
+
+func (a *ApicastOptionsProvider) clientTLSCABundle(nn types.NamespacedName) ([]byte, error) {
+	secret := &v1.Secret{}
+	err := a.client.Get(context.TODO(), nn, secret)
+
+	if err != nil {
+		// NotFoundError is also an error, it is required to exist
+		return nil, err
+	}
+
+	caBundle, ok := secret.Data[component.ClientTLSCASecretKey]
+	if !ok || len(caBundle) == 0 {
+		return nil, fmt.Errorf("Required secret key, %s not found", component.ClientTLSCASecretKey)
+	}
+
+	return caBundle, nil
+}
+
 func (a *ApicastOptionsProvider) setProxyConfigurations() {
 	a.setStagingProxyConfigurations()
 	a.setProductionProxyConfigurations()
@@ -423,6 +571,62 @@ func (a *ApicastOptionsProvider) setProductionProxyConfigurations() {
 	a.apicastOptions.ProductionNoProxy = a.apimanager.Spec.Apicast.ProductionSpec.NoProxy
 }
 
+func (a *ApicastOptionsProvider) setWarmup() {
+	a.apicastOptions.ProductionWarmup = apicastWarmup(a.apimanager.Spec.Apicast.ProductionSpec.Warmup)
+	a.apicastOptions.StagingWarmup = apicastWarmup(a.apimanager.Spec.Apicast.StagingSpec.Warmup)
+}
+
+func apicastWarmup(warmupSpec *appsv1alpha1.APIcastWarmupSpec) *component.APIcastWarmup {
+	if !warmupSpec.IsEnabled() || len(warmupSpec.Targets) == 0 {
+		return nil
+	}
+
+	warmup := &component.APIcastWarmup{TimeoutSeconds: component.APIcastWarmupDefaultTimeoutSeconds}
+	if warmupSpec.TimeoutSeconds != nil {
+		warmup.TimeoutSeconds = *warmupSpec.TimeoutSeconds
+	}
+
+	for _, targetSpec := range warmupSpec.Targets {
+		target := component.APIcastWarmupTarget{Host: targetSpec.Host, Path: "/"}
+		if targetSpec.Path != nil {
+			target.Path = *targetSpec.Path
+		}
+		warmup.Targets = append(warmup.Targets, target)
+	}
+
+	return warmup
+}
+
+func (a *ApicastOptionsProvider) setLoadBalancer() {
+	productionSpec := a.apimanager.Spec.Apicast.ProductionSpec
+	stagingSpec := a.apimanager.Spec.Apicast.StagingSpec
+	a.apicastOptions.ProductionLoadBalancer = apicastLoadBalancer(productionSpec.LBDeregistrationDelaySeconds, productionSpec.ReadinessGates, productionSpec.AWSLoadBalancer)
+	a.apicastOptions.StagingLoadBalancer = apicastLoadBalancer(stagingSpec.LBDeregistrationDelaySeconds, stagingSpec.ReadinessGates, stagingSpec.AWSLoadBalancer)
+}
+
+func apicastLoadBalancer(deregistrationDelaySeconds *int64, readinessGates []v1.PodReadinessGate, awsSpec *appsv1alpha1.APIcastAWSLoadBalancerSpec) *component.APIcastLoadBalancer {
+	if deregistrationDelaySeconds == nil && len(readinessGates) == 0 && awsSpec == nil {
+		return nil
+	}
+
+	lb := &component.APIcastLoadBalancer{ReadinessGates: readinessGates}
+	if deregistrationDelaySeconds != nil {
+		lb.DeregistrationDelaySeconds = *deregistrationDelaySeconds
+	}
+
+	if awsSpec != nil {
+		lb.AWS = &component.APIcastAWSLoadBalancer{TargetType: component.APIcastAWSLoadBalancerTargetTypeIP}
+		if awsSpec.TargetType != nil {
+			lb.AWS.TargetType = *awsSpec.TargetType
+		}
+		if awsSpec.TargetGroupBindingName != nil {
+			lb.AWS.TargetGroupBindingName = *awsSpec.TargetGroupBindingName
+		}
+	}
+
+	return lb
+}
+
 func (a *ApicastOptionsProvider) additionalPodAnnotations() map[string]string {
 	annotations := map[string]string{
 		APIcastEnvironmentCMAnnotation: a.envConfigMapHash(),
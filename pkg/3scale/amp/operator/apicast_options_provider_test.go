@@ -148,12 +148,15 @@ func defaultApicastOptions() *component.ApicastOptions {
 		StagingResourceRequirements:    component.DefaultStagingResourceRequirements(),
 		ProductionReplicas:             int32(productionReplicaCount),
 		StagingReplicas:                int32(stagingReplicaCount),
+		ProductionReplicasManaged:      true,
+		StagingReplicasManaged:         true,
 		CommonLabels:                   testApicastCommonLabels(),
 		CommonStagingLabels:            testApicastStagingLabels(),
 		CommonProductionLabels:         testApicastProductionLabels(),
 		StagingPodTemplateLabels:       testApicastStagingPodLabels(),
 		ProductionPodTemplateLabels:    testApicastProductionPodLabels(),
 		Namespace:                      namespace,
+		GrafanaDashboard:               component.DefaultGrafanaDashboardOptions(),
 		ProductionTracingConfig:        &component.APIcastTracingConfig{TracingLibrary: component.APIcastDefaultTracingLibrary},
 		StagingTracingConfig:           &component.APIcastTracingConfig{TracingLibrary: component.APIcastDefaultTracingLibrary},
 		AdditionalPodAnnotations:       map[string]string{APIcastEnvironmentCMAnnotation: "788712912"},
@@ -210,6 +213,55 @@ func TestGetApicastOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"WithTopologySpreadConstraints",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.Apicast.ProductionSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("apicast-production")
+				apimanager.Spec.Apicast.StagingSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("apicast-staging")
+				return apimanager
+			},
+			func() *component.ApicastOptions {
+				opts := defaultApicastOptions()
+				opts.ProductionPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("apicast-production")
+				opts.StagingPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("apicast-staging")
+				return opts
+			},
+		},
+		{"WithCustomLabelsAndAnnotations",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.Apicast.ProductionSpec.Labels = getTestCustomLabels("apicast-production")
+				apimanager.Spec.Apicast.ProductionSpec.Annotations = getTestCustomAnnotations("apicast-production")
+				apimanager.Spec.Apicast.StagingSpec.Labels = getTestCustomLabels("apicast-staging")
+				apimanager.Spec.Apicast.StagingSpec.Annotations = getTestCustomAnnotations("apicast-staging")
+				return apimanager
+			},
+			func() *component.ApicastOptions {
+				opts := defaultApicastOptions()
+				opts.ProductionCustomLabels = getTestCustomLabels("apicast-production")
+				opts.ProductionCustomAnnotations = getTestCustomAnnotations("apicast-production")
+				opts.ProductionPodTemplateLabels["custom-label"] = "apicast-production"
+				opts.StagingCustomLabels = getTestCustomLabels("apicast-staging")
+				opts.StagingCustomAnnotations = getTestCustomAnnotations("apicast-staging")
+				opts.StagingPodTemplateLabels["custom-label"] = "apicast-staging"
+				return opts
+			},
+		},
+		{"WithUnreachableTolerationSeconds",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.UnreachableTolerationSeconds = &[]int64{120}[0]
+				apimanager.Spec.Apicast.ProductionSpec.Tolerations = testApicastProductionTolerations()
+				apimanager.Spec.Apicast.StagingSpec.UnreachableTolerationSeconds = &[]int64{60}[0]
+				return apimanager
+			},
+			func() *component.ApicastOptions {
+				opts := defaultApicastOptions()
+				opts.ProductionTolerations = helper.WithUnreachableTolerations(testApicastProductionTolerations(), &[]int64{120}[0])
+				opts.StagingTolerations = helper.WithUnreachableTolerations(nil, &[]int64{60}[0])
+				return opts
+			},
+		},
 		{"WithAPIcastCustomResourceRequirements",
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerTestApicastOptions()
@@ -239,6 +291,71 @@ func TestGetApicastOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"WithWarmup",
+			func() *appsv1alpha1.APIManager {
+				trueValue := true
+				path := "/health"
+				timeout := int32(10)
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.Apicast.ProductionSpec.Warmup = &appsv1alpha1.APIcastWarmupSpec{
+					Enabled:        &trueValue,
+					Targets:        []appsv1alpha1.APIcastWarmupTargetSpec{{Host: "api.example.com", Path: &path}},
+					TimeoutSeconds: &timeout,
+				}
+				apimanager.Spec.Apicast.StagingSpec.Warmup = &appsv1alpha1.APIcastWarmupSpec{
+					Enabled: &trueValue,
+					Targets: []appsv1alpha1.APIcastWarmupTargetSpec{{Host: "api-staging.example.com"}},
+				}
+				return apimanager
+			},
+			func() *component.ApicastOptions {
+				opts := defaultApicastOptions()
+				opts.ProductionWarmup = &component.APIcastWarmup{
+					Targets:        []component.APIcastWarmupTarget{{Host: "api.example.com", Path: "/health"}},
+					TimeoutSeconds: 10,
+				}
+				opts.StagingWarmup = &component.APIcastWarmup{
+					Targets:        []component.APIcastWarmupTarget{{Host: "api-staging.example.com", Path: "/"}},
+					TimeoutSeconds: component.APIcastWarmupDefaultTimeoutSeconds,
+				}
+				return opts
+			},
+		},
+		{"WithLoadBalancer",
+			func() *appsv1alpha1.APIManager {
+				delay := int64(20)
+				tgbName := "apicast-production"
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.Apicast.ProductionSpec.LBDeregistrationDelaySeconds = &delay
+				apimanager.Spec.Apicast.ProductionSpec.AWSLoadBalancer = &appsv1alpha1.APIcastAWSLoadBalancerSpec{TargetGroupBindingName: &tgbName}
+				apimanager.Spec.Apicast.StagingSpec.ReadinessGates = []v1.PodReadinessGate{{ConditionType: "example.com/registered"}}
+				return apimanager
+			},
+			func() *component.ApicastOptions {
+				opts := defaultApicastOptions()
+				opts.ProductionLoadBalancer = &component.APIcastLoadBalancer{
+					DeregistrationDelaySeconds: 20,
+					AWS: &component.APIcastAWSLoadBalancer{
+						TargetType:             component.APIcastAWSLoadBalancerTargetTypeIP,
+						TargetGroupBindingName: "apicast-production",
+					},
+				}
+				opts.StagingLoadBalancer = &component.APIcastLoadBalancer{
+					ReadinessGates: []v1.PodReadinessGate{{ConditionType: "example.com/registered"}},
+				}
+				return opts
+			},
+		},
+		{"WithWarmupDisabled",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestApicastOptions()
+				apimanager.Spec.Apicast.ProductionSpec.Warmup = &appsv1alpha1.APIcastWarmupSpec{
+					Targets: []appsv1alpha1.APIcastWarmupTargetSpec{{Host: "api.example.com"}},
+				}
+				return apimanager
+			},
+			defaultApicastOptions,
+		},
 	}
 
 	for _, tc := range cases {
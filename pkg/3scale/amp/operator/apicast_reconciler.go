@@ -49,6 +49,19 @@ func ApicastEnvCMMutator(existingObj, desiredObj common.KubernetesObject) (bool,
 	return update, nil
 }
 
+func ApicastClientTLSCMMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	return reconcilers.ConfigMapReconcileField(desired, existing, component.ClientTLSEnvironmentConfigMapKey), nil
+}
+
 type ApicastReconciler struct {
 	*BaseAPIManagerLogicReconciler
 }
@@ -69,8 +82,17 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
+		reconcilers.DeploymentConfigRuntimeClassMutator,
+		reconcilers.DeploymentConfigDNSMutator,
+		reconcilers.DeploymentConfigHostAliasesMutator,
+		reconcilers.DeploymentConfigImagePullSecretsMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigPodTemplateAnnotationsMutator,
 		apicastLogLevelEnvVarMutator,
 		apicastTracingConfigEnvVarsMutator,
 		apicastEnvironmentEnvVarMutator,
@@ -83,10 +105,13 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		apicastCustomEnvAnnotationsMutator,     // Should be always after volume mutator
 		portsMutator,
 		apicastPodTemplateEnvConfigMapAnnotationsMutator,
+		apicastWarmupReadinessProbeMutator,
+		apicastLoadBalancerMutator,
+		apicastPortalEndpointMutator,
 	}
 
 	if value, found := r.apiManager.ObjectMeta.Annotations[disableApicastStagingReplicaReconciler]; !found || value != "true" {
-		stagingMutators = append(stagingMutators, reconcilers.DeploymentConfigReplicasMutator)
+		stagingMutators = append(stagingMutators, replicasMutator(apicast.Options.StagingReplicasManaged))
 	}
 
 	// Staging DC
@@ -100,8 +125,17 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
+		reconcilers.DeploymentConfigRuntimeClassMutator,
+		reconcilers.DeploymentConfigDNSMutator,
+		reconcilers.DeploymentConfigHostAliasesMutator,
+		reconcilers.DeploymentConfigImagePullSecretsMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigPodTemplateAnnotationsMutator,
 		apicastProductionWorkersEnvVarMutator,
 		apicastLogLevelEnvVarMutator,
 		apicastTracingConfigEnvVarsMutator,
@@ -115,10 +149,14 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		apicastCustomEnvAnnotationsMutator,     // Should be always after volume
 		portsMutator,
 		apicastPodTemplateEnvConfigMapAnnotationsMutator,
+		apicastPodTemplateClientTLSAnnotationsMutator,
+		apicastWarmupReadinessProbeMutator,
+		apicastLoadBalancerMutator,
+		apicastPortalEndpointMutator,
 	}
 
 	if value, found := r.apiManager.ObjectMeta.Annotations[disableApicastProductionReplicaReconciler]; !found || value != "true" {
-		productionMutators = append(productionMutators, reconcilers.DeploymentConfigReplicasMutator)
+		productionMutators = append(productionMutators, replicasMutator(apicast.Options.ProductionReplicasManaged))
 	}
 
 	// Production DC
@@ -143,12 +181,35 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	// Gateway Routes. Created by zync unless the control plane is managed outside of the APIManager
+	if r.apiManager.IsGatewayOnly() {
+		err = r.ReconcileRoute(apicast.StagingRoute(), reconcilers.CreateOnlyMutator)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		err = r.ReconcileRoute(apicast.ProductionRoute(), reconcilers.CreateOnlyMutator)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	// Environment ConfigMap
 	err = r.ReconcileConfigMap(apicast.EnvironmentConfigMap(), ApicastEnvCMMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// Production client TLS environment ConfigMap
+	clientTLSConfigMap := apicast.ProductionClientTLSConfigMap()
+	if r.apiManager.Spec.Apicast.ProductionSpec.ClientTLS == nil {
+		common.TagObjectToDelete(clientTLSConfigMap)
+	}
+	err = r.ReconcileConfigMap(clientTLSConfigMap, ApicastClientTLSCMMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Staging PDB
 	err = r.ReconcilePodDisruptionBudget(apicast.StagingPodDisruptionBudget(), reconcilers.GenericPDBMutator)
 	if err != nil {
@@ -161,6 +222,16 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	// Production HPA
+	productionHPA := apicast.ProductionHorizontalPodAutoscaler()
+	if apicast.Options.ProductionHPA == nil {
+		common.TagObjectToDelete(productionHPA)
+	}
+	err = r.ReconcileHorizontalPodAutoscaler(productionHPA, reconcilers.GenericHPAMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	sumRate, err := helper.SumRateForOpenshiftVersion(r.Context(), r.Client())
 	if err != nil {
 		return reconcile.Result{}, err
@@ -181,6 +252,15 @@ func (r *ApicastReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	sloRules := apicast.ApicastSLOPrometheusRules()
+	if !r.apiManager.IsRecordingRulesEnabled() {
+		common.TagObjectToDelete(sloRules)
+	}
+	err = r.ReconcilePrometheusRules(sloRules, reconcilers.GenericPrometheusRuleMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	err = r.ReconcilePodMonitor(apicast.ApicastProductionPodMonitor(), reconcilers.CreateOnlyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -206,7 +286,36 @@ func getApiCastServiceMutator(apiManagerAnnotations map[string]string) reconcile
 	if disableApicastPortReconcile == "true" {
 		return reconcilers.CreateOnlyMutator
 	}
-	return reconcilers.ServicePortMutator
+	return apicastServiceMutator
+}
+
+// apicastServiceMutator reconciles the ports and the load balancer annotations of the service
+func apicastServiceMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	update, err := reconcilers.ServicePortMutator(existingObj, desiredObj)
+	if err != nil {
+		return false, err
+	}
+
+	existing := existingObj.(*v1.Service)
+	desired := desiredObj.(*v1.Service)
+	for _, annotation := range component.APIcastLoadBalancerServiceAnnotations {
+		desiredVal, desiredOk := desired.Annotations[annotation]
+		existingVal, existingOk := existing.Annotations[annotation]
+
+		switch {
+		case !desiredOk && existingOk:
+			delete(existing.Annotations, annotation)
+			update = true
+		case desiredOk && (!existingOk || existingVal != desiredVal):
+			if existing.Annotations == nil {
+				existing.Annotations = map[string]string{}
+			}
+			existing.Annotations[annotation] = desiredVal
+			update = true
+		}
+	}
+
+	return update, nil
 }
 
 func apicastProductionWorkersEnvVarMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
@@ -349,15 +458,21 @@ func apicastVolumeMountsMutator(desired, existing *appsv1.DeploymentConfig) (boo
 		}
 	}
 
-	// Check for existing volumeMounts associated to the TLS port that is no longer desired
-	// Only the volumeMount associated to the TLS port is deleted. The operator still allows manually arbitrary mounted volumes
-	existingIdx := helper.FindVolumeMountByName(existingContainer.VolumeMounts, component.HTTPSCertificatesVolumeName)
-	desiredIdx := helper.FindVolumeMountByName(desiredContainer.VolumeMounts, component.HTTPSCertificatesVolumeName)
-	if desiredIdx < 0 && existingIdx >= 0 {
-		// volumeMount exists in existing and does not exist in desired => Remove from the list
-		// shift all of the elements at the right of the deleting index by one to the left
-		existingContainer.VolumeMounts = append(existingContainer.VolumeMounts[:existingIdx], existingContainer.VolumeMounts[existingIdx+1:]...)
-		changed = true
+	// Check for existing volumeMounts associated to the TLS port or the client TLS that are no longer desired
+	// Only the volumeMounts associated to the TLS port and client TLS are deleted. The operator still allows manually arbitrary mounted volumes
+	for _, volumeName := range []string{
+		component.HTTPSCertificatesVolumeName,
+		component.ClientTLSCAVolumeName,
+		component.ClientTLSEnvironmentVolumeName,
+	} {
+		existingIdx := helper.FindVolumeMountByName(existingContainer.VolumeMounts, volumeName)
+		desiredIdx := helper.FindVolumeMountByName(desiredContainer.VolumeMounts, volumeName)
+		if desiredIdx < 0 && existingIdx >= 0 {
+			// volumeMount exists in existing and does not exist in desired => Remove from the list
+			// shift all of the elements at the right of the deleting index by one to the left
+			existingContainer.VolumeMounts = append(existingContainer.VolumeMounts[:existingIdx], existingContainer.VolumeMounts[existingIdx+1:]...)
+			changed = true
+		}
 	}
 
 	return changed, nil
@@ -378,7 +493,8 @@ func apicastVolumesMutator(desired, existing *appsv1.DeploymentConfig) (bool, er
 		if existingIdx < 0 {
 			existingSpec.Volumes = append(existingSpec.Volumes, desiredSpec.Volumes[desiredIdx])
 			changed = true
-		} else if !helper.VolumeFromSecretEqual(existingSpec.Volumes[existingIdx], desiredSpec.Volumes[desiredIdx]) {
+		} else if !helper.VolumeFromSecretEqual(existingSpec.Volumes[existingIdx], desiredSpec.Volumes[desiredIdx]) &&
+			!helper.VolumeFromConfigMapEqual(existingSpec.Volumes[existingIdx], desiredSpec.Volumes[desiredIdx]) {
 			existingSpec.Volumes[existingIdx] = desiredSpec.Volumes[desiredIdx]
 			changed = true
 		}
@@ -432,15 +548,21 @@ func apicastVolumesMutator(desired, existing *appsv1.DeploymentConfig) (bool, er
 		}
 	}
 
-	// Check for existing volume associated to the TLS port that is no longer desired
-	// Only the volume associated to the TLS port is deleted. The operator still allows manually arbitrary mounted volumes
-	existingIdx := helper.FindVolumeByName(existingSpec.Volumes, component.HTTPSCertificatesVolumeName)
-	desiredIdx := helper.FindVolumeByName(desiredSpec.Volumes, component.HTTPSCertificatesVolumeName)
-	if desiredIdx < 0 && existingIdx >= 0 {
-		// volume exists in existing and does not exist in desired => Remove from the list
-		// shift all of the elements at the right of the deleting index by one to the left
-		existingSpec.Volumes = append(existingSpec.Volumes[:existingIdx], existingSpec.Volumes[existingIdx+1:]...)
-		changed = true
+	// Check for existing volumes associated to the TLS port or the client TLS that are no longer desired
+	// Only the volumes associated to the TLS port and client TLS are deleted. The operator still allows manually arbitrary mounted volumes
+	for _, volumeName := range []string{
+		component.HTTPSCertificatesVolumeName,
+		component.ClientTLSCAVolumeName,
+		component.ClientTLSEnvironmentVolumeName,
+	} {
+		existingIdx := helper.FindVolumeByName(existingSpec.Volumes, volumeName)
+		desiredIdx := helper.FindVolumeByName(desiredSpec.Volumes, volumeName)
+		if desiredIdx < 0 && existingIdx >= 0 {
+			// volume exists in existing and does not exist in desired => Remove from the list
+			// shift all of the elements at the right of the deleting index by one to the left
+			existingSpec.Volumes = append(existingSpec.Volumes[:existingIdx], existingSpec.Volumes[existingIdx+1:]...)
+			changed = true
+		}
 	}
 
 	return changed, nil
@@ -538,6 +660,102 @@ func apicastPodTemplateEnvConfigMapAnnotationsMutator(desired, existing *appsv1.
 	return updated, nil
 }
 
+func apicastPodTemplateClientTLSAnnotationsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	// Only reconcile the pod annotation regarding the client TLS CA bundle hash
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[component.ClientTLSCAHashAnnotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[component.ClientTLSCAHashAnnotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, component.ClientTLSCAHashAnnotation)
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if existingOk && existingVal == desiredVal {
+		return false, nil
+	}
+
+	if existing.Spec.Template.Annotations == nil {
+		existing.Spec.Template.Annotations = map[string]string{}
+	}
+	existing.Spec.Template.Annotations[component.ClientTLSCAHashAnnotation] = desiredVal
+	return true, nil
+}
+
+// apicastWarmupReadinessProbeMutator reconciles the readiness probe when the warm-up is
+// enabled or disabled. Otherwise, the probe is not reconciled as it was never reconciled
+// before the warm-up was available.
+func apicastWarmupReadinessProbeMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	desiredProbe := desired.Spec.Template.Spec.Containers[0].ReadinessProbe
+	existingProbe := existing.Spec.Template.Spec.Containers[0].ReadinessProbe
+
+	desiredWarmup := desiredProbe != nil && desiredProbe.Exec != nil
+	existingWarmup := existingProbe != nil && existingProbe.Exec != nil
+
+	if !desiredWarmup && !existingWarmup {
+		return false, nil
+	}
+
+	if desiredWarmup && existingWarmup &&
+		reflect.DeepEqual(desiredProbe.Exec, existingProbe.Exec) &&
+		desiredProbe.TimeoutSeconds == existingProbe.TimeoutSeconds &&
+		desiredProbe.PeriodSeconds == existingProbe.PeriodSeconds {
+		return false, nil
+	}
+
+	existing.Spec.Template.Spec.Containers[0].ReadinessProbe = desiredProbe
+	return true, nil
+}
+
+// apicastLoadBalancerMutator reconciles the readiness gates of the pods, and the
+// deregistration delay hook with the termination grace period. The hook and the
+// grace period are only reconciled when the delay is set or removed, so the
+// ones set manually before the delay was available are kept
+func apicastLoadBalancerMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+	desiredSpec := &desired.Spec.Template.Spec
+	existingSpec := &existing.Spec.Template.Spec
+
+	if (len(desiredSpec.ReadinessGates) > 0 || len(existingSpec.ReadinessGates) > 0) &&
+		!reflect.DeepEqual(desiredSpec.ReadinessGates, existingSpec.ReadinessGates) {
+		existingSpec.ReadinessGates = desiredSpec.ReadinessGates
+		update = true
+	}
+
+	desiredLifecycle := desiredSpec.Containers[0].Lifecycle
+	existingLifecycle := existingSpec.Containers[0].Lifecycle
+	if !isAPIcastDeregistrationHook(desiredLifecycle) && !isAPIcastDeregistrationHook(existingLifecycle) {
+		return update, nil
+	}
+
+	if !reflect.DeepEqual(desiredLifecycle, existingLifecycle) {
+		existingSpec.Containers[0].Lifecycle = desiredLifecycle
+		update = true
+	}
+
+	// The grace period is defaulted by the API server
+	if terminationGracePeriodSeconds(desiredSpec) != terminationGracePeriodSeconds(existingSpec) {
+		existingSpec.TerminationGracePeriodSeconds = desiredSpec.TerminationGracePeriodSeconds
+		update = true
+	}
+
+	return update, nil
+}
+
+func isAPIcastDeregistrationHook(lifecycle *v1.Lifecycle) bool {
+	return lifecycle != nil && lifecycle.PreStop != nil && lifecycle.PreStop.Exec != nil &&
+		len(lifecycle.PreStop.Exec.Command) == 2 && lifecycle.PreStop.Exec.Command[0] == "sleep"
+}
+
+func terminationGracePeriodSeconds(podSpec *v1.PodSpec) int64 {
+	if podSpec.TerminationGracePeriodSeconds == nil {
+		return component.APIcastDefaultTerminationGracePeriodSeconds
+	}
+	return *podSpec.TerminationGracePeriodSeconds
+}
+
 func Apicast(apimanager *appsv1alpha1.APIManager, cl client.Client) (*component.Apicast, error) {
 	optsProvider := NewApicastOptionsProvider(apimanager, cl)
 	opts, err := optsProvider.GetApicastOptions()
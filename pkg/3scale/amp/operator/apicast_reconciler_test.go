@@ -15,6 +15,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,6 +27,7 @@ import (
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 )
 
@@ -907,3 +909,170 @@ func apiManagerCreator(disableSyncAnnotation string, disableSyncAnnotationValue
 		},
 	}
 }
+
+func TestApicastReconcilerClientTLSParts(t *testing.T) {
+	var (
+		name                       = "example-apimanager"
+		namespace                  = "operator-unittest"
+		wildcardDomain             = "test.3scale.net"
+		log                        = logf.Log.WithName("operator_test")
+		appLabel                   = "someLabel"
+		tenantName                 = "someTenant"
+		apicastManagementAPI       = "disabled"
+		trueValue                  = true
+		oneValue             int64 = 1
+		caSecretName               = "myclientcasecret"
+	)
+
+	apicastOptions := &component.ApicastOptions{
+		StagingTracingConfig:            &component.APIcastTracingConfig{},
+		ProductionTracingConfig:         &component.APIcastTracingConfig{},
+		ProductionClientTLSCASecretName: &caSecretName,
+		ProductionClientTLSCAHash:       "previousHash",
+	}
+	existingProdDC := component.NewApicast(apicastOptions).ProductionDeploymentConfig()
+	existingProdDC.Namespace = namespace
+
+	caSecret := &v1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: caSecretName, Namespace: namespace},
+		Data: map[string][]byte{
+			component.ClientTLSCASecretKey: []byte("some rotated CA bundle"),
+		},
+		Type: v1.SecretTypeOpaque,
+	}
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{
+				AppLabel:                     &appLabel,
+				ImageStreamTagImportInsecure: &trueValue,
+				WildcardDomain:               wildcardDomain,
+				TenantName:                   &tenantName,
+				ResourceRequirementsEnabled:  &trueValue,
+			},
+			Apicast: &appsv1alpha1.ApicastSpec{
+				ApicastManagementAPI: &apicastManagementAPI,
+				OpenSSLVerify:        &trueValue,
+				IncludeResponseCodes: &trueValue,
+				StagingSpec: &appsv1alpha1.ApicastStagingSpec{
+					Replicas: &oneValue,
+				},
+				ProductionSpec: &appsv1alpha1.ApicastProductionSpec{
+					Replicas:                  &oneValue,
+					HTTPSCertificateSecretRef: &v1.LocalObjectReference{Name: "mycertsecret"},
+					ClientTLS: &appsv1alpha1.APIcastClientTLSSpec{
+						CASecretRef: &v1.LocalObjectReference{Name: caSecretName},
+					},
+				},
+			},
+		},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager, existingProdDC, caSecret}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := configv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	ctx := context.TODO()
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	apicastReconciler := NewApicastReconciler(baseAPIManagerLogicReconciler)
+	_, err = apicastReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dcNamespacedName := types.NamespacedName{Name: component.ApicastProductionName, Namespace: namespace}
+	cmNamespacedName := types.NamespacedName{Name: component.ClientTLSEnvironmentConfigMapName, Namespace: namespace}
+
+	existing := &appsv1.DeploymentConfig{}
+	err = cl.Get(context.TODO(), dcNamespacedName, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// - CA bundle rotation updates the pod template hash annotation
+	hash, ok := existing.Spec.Template.Annotations[component.ClientTLSCAHashAnnotation]
+	if !ok || hash == "previousHash" {
+		t.Fatalf("client TLS CA hash annotation not updated: %v", existing.Spec.Template.Annotations)
+	}
+
+	// - Client TLS environment ConfigMap created
+	err = cl.Get(context.TODO(), cmNamespacedName, &v1.ConfigMap{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Disable client TLS
+	apimanager.Spec.Apicast.ProductionSpec.ClientTLS = nil
+	_, err = apicastReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing = &appsv1.DeploymentConfig{}
+	err = cl.Get(context.TODO(), dcNamespacedName, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := existing.Spec.Template.Annotations[component.ClientTLSCAHashAnnotation]; ok {
+		t.Fatal("client TLS CA hash annotation found. Should have been deleted")
+	}
+
+	for idx := range existing.Spec.Template.Spec.Volumes {
+		volumeName := existing.Spec.Template.Spec.Volumes[idx].Name
+		if volumeName == component.ClientTLSCAVolumeName || volumeName == component.ClientTLSEnvironmentVolumeName {
+			t.Fatalf("client TLS volume %s found. Should have been deleted", volumeName)
+		}
+	}
+
+	for idx := range existing.Spec.Template.Spec.Containers[0].VolumeMounts {
+		volumeName := existing.Spec.Template.Spec.Containers[0].VolumeMounts[idx].Name
+		if volumeName == component.ClientTLSCAVolumeName || volumeName == component.ClientTLSEnvironmentVolumeName {
+			t.Fatalf("client TLS volumemount %s found. Should have been deleted", volumeName)
+		}
+	}
+
+	if helper.FindEnvVar(existing.Spec.Template.Spec.Containers[0].Env, "APICAST_ENVIRONMENT") >= 0 {
+		t.Fatal("APICAST_ENVIRONMENT env var found. Should have been deleted")
+	}
+
+	err = cl.Get(context.TODO(), cmNamespacedName, &v1.ConfigMap{})
+	if !errors.IsNotFound(err) {
+		t.Fatalf("client TLS environment ConfigMap should have been deleted: %v", err)
+	}
+}
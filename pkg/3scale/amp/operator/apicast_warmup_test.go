@@ -0,0 +1,119 @@
+package operator
+
+import (
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApicastWarmupReadinessProbe(t *testing.T) {
+	apicastDCs := func(subT *testing.T, enabled bool, httpsPort *int32) []*appsv1.DeploymentConfig {
+		path := "/health?deep=1"
+		timeout := int32(10)
+		apimanager := basicApimanagerTestApicastOptions()
+		apimanager.Spec.Apicast.ProductionSpec.HTTPSPort = httpsPort
+		apimanager.Spec.Apicast.StagingSpec.HTTPSPort = httpsPort
+		for _, warmup := range []**appsv1alpha1.APIcastWarmupSpec{&apimanager.Spec.Apicast.ProductionSpec.Warmup, &apimanager.Spec.Apicast.StagingSpec.Warmup} {
+			*warmup = &appsv1alpha1.APIcastWarmupSpec{
+				Enabled: &enabled,
+				Targets: []appsv1alpha1.APIcastWarmupTargetSpec{
+					{Host: "api.example.com", Path: &path},
+					{Host: "other.example.com:8080"},
+				},
+				TimeoutSeconds: &timeout,
+			}
+		}
+
+		apicast, err := Apicast(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		return []*appsv1.DeploymentConfig{apicast.ProductionDeploymentConfig(), apicast.StagingDeploymentConfig()}
+	}
+
+	t.Run("Disabled", func(subT *testing.T) {
+		for _, dc := range apicastDCs(subT, false, nil) {
+			probe := dc.Spec.Template.Spec.Containers[0].ReadinessProbe
+			if probe.Exec != nil || probe.HTTPGet == nil || probe.HTTPGet.Path != "/status/ready" {
+				subT.Errorf("%s: unexpected readiness probe: %v", dc.Name, probe)
+			}
+		}
+	})
+
+	t.Run("Enabled", func(subT *testing.T) {
+		for _, dc := range apicastDCs(subT, true, nil) {
+			container := dc.Spec.Template.Spec.Containers[0]
+			if container.Lifecycle != nil || container.LivenessProbe.Exec != nil {
+				subT.Errorf("%s: warm-up must only affect readiness", dc.Name)
+			}
+
+			probe := container.ReadinessProbe
+			if probe.Exec == nil || len(probe.Exec.Command) != 3 {
+				subT.Fatalf("%s: unexpected readiness probe: %v", dc.Name, probe)
+			}
+			// 5s for the status check and 10s per target
+			if probe.TimeoutSeconds != 25 {
+				subT.Errorf("%s: unexpected probe timeout %d", dc.Name, probe.TimeoutSeconds)
+			}
+
+			script := probe.Exec.Command[2]
+			expectedCommands := []string{
+				"curl --fail --silent --output /dev/null --max-time 5 http://127.0.0.1:8090/status/ready || exit 1",
+				"test -f " + component.APIcastWarmupDoneFile + " && exit 0",
+				"curl --silent --output /dev/null --max-time 10 --header 'Host: api.example.com' 'http://127.0.0.1:8080/health?deep=1' || exit 1",
+				"curl --silent --output /dev/null --max-time 10 --header 'Host: other.example.com:8080' 'http://127.0.0.1:8080/' || exit 1",
+				"touch " + component.APIcastWarmupDoneFile,
+			}
+			if script != strings.Join(expectedCommands, "; ") {
+				subT.Errorf("%s: unexpected warm-up script: %s", dc.Name, script)
+			}
+		}
+	})
+
+	t.Run("EnabledWithHTTPSOnGatewayPort", func(subT *testing.T) {
+		httpsPort := int32(8080)
+		for _, dc := range apicastDCs(subT, true, &httpsPort) {
+			script := dc.Spec.Template.Spec.Containers[0].ReadinessProbe.Exec.Command[2]
+			if !strings.Contains(script, "--insecure --max-time 10 --header 'Host: api.example.com' 'https://127.0.0.1:8080/health?deep=1'") {
+				subT.Errorf("%s: unexpected warm-up script: %s", dc.Name, script)
+			}
+		}
+	})
+
+	cases := []struct {
+		testName        string
+		existingEnabled bool
+		desiredEnabled  bool
+		expectedChanged bool
+	}{
+		{"NothingToReconcileDisabled", false, false, false},
+		{"NothingToReconcileEnabled", true, true, false},
+		{"WarmupEnabled", false, true, true},
+		{"WarmupDisabled", true, false, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existingDCs := apicastDCs(subT, tc.existingEnabled, nil)
+			desiredDCs := apicastDCs(subT, tc.desiredEnabled, nil)
+			for idx := range desiredDCs {
+				changed, err := apicastWarmupReadinessProbeMutator(desiredDCs[idx], existingDCs[idx])
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if changed != tc.expectedChanged {
+					subT.Errorf("%s: expected changed %t, got %t", existingDCs[idx].Name, tc.expectedChanged, changed)
+				}
+				existingProbe := existingDCs[idx].Spec.Template.Spec.Containers[0].ReadinessProbe
+				if (existingProbe.Exec != nil) != tc.desiredEnabled {
+					subT.Errorf("%s: unexpected readiness probe: %v", existingDCs[idx].Name, existingProbe)
+				}
+			}
+		})
+	}
+}
@@ -42,7 +42,16 @@ func (o *OperatorBackendOptionsProvider) GetBackendOptions() (*component.Backend
 
 	o.setResourceRequirementsOptions()
 	o.setNodeAffinityAndTolerationsOptions()
+	o.setCustomLabelsAndAnnotationsOptions()
+	o.setPodTemplateOverridesOptions()
+	o.setSecurityContextOptions()
+	o.setProbesOptions()
+	o.setExtraEnvOptions()
+	o.setSidecarsOptions()
+	o.setInitContainersOptions()
+	o.setTerminationOptions()
 	o.setReplicas()
+	o.setPodDisruptionBudgetOptions()
 
 	o.backendOptions.CommonLabels = o.commonLabels()
 	o.backendOptions.CommonListenerLabels = o.commonListenerLabels()
@@ -52,8 +61,13 @@ func (o *OperatorBackendOptionsProvider) GetBackendOptions() (*component.Backend
 	o.backendOptions.WorkerPodTemplateLabels = o.workerPodTemplateLabels()
 	o.backendOptions.CronPodTemplateLabels = o.cronPodTemplateLabels()
 
-	o.backendOptions.WorkerMetrics = true
-	o.backendOptions.ListenerMetrics = true
+	o.backendOptions.WorkerMetrics = o.apimanager.IsComponentMetricsEnabled("backend")
+	o.backendOptions.ListenerMetrics = o.apimanager.IsComponentMetricsEnabled("backend")
+	o.backendOptions.Statsd = statsdOptions(o.apimanager)
+	o.backendOptions.ListenerRequestLogging = backendRequestLoggingOptions(o.apimanager)
+	o.backendOptions.SLO = backendSLOOptions(o.apimanager)
+	o.backendOptions.RedisCASecretName = o.apimanager.BackendRedisCASecretName()
+	o.backendOptions.GrafanaDashboard = grafanaDashboardOptions(o.apimanager)
 	o.backendOptions.Namespace = o.apimanager.Namespace
 
 	err = o.backendOptions.Validate()
@@ -134,17 +148,79 @@ func (o *OperatorBackendOptionsProvider) setResourceRequirementsOptions() {
 
 func (o *OperatorBackendOptionsProvider) setNodeAffinityAndTolerationsOptions() {
 	o.backendOptions.ListenerAffinity = o.apimanager.Spec.Backend.ListenerSpec.Affinity
-	o.backendOptions.ListenerTolerations = o.apimanager.Spec.Backend.ListenerSpec.Tolerations
+	o.backendOptions.ListenerTolerations = componentTolerations(o.apimanager, o.apimanager.Spec.Backend.ListenerSpec.Tolerations, o.apimanager.Spec.Backend.ListenerSpec.UnreachableTolerationSeconds)
 	o.backendOptions.WorkerAffinity = o.apimanager.Spec.Backend.WorkerSpec.Affinity
-	o.backendOptions.WorkerTolerations = o.apimanager.Spec.Backend.WorkerSpec.Tolerations
+	o.backendOptions.WorkerTolerations = componentTolerations(o.apimanager, o.apimanager.Spec.Backend.WorkerSpec.Tolerations, o.apimanager.Spec.Backend.WorkerSpec.UnreachableTolerationSeconds)
 	o.backendOptions.CronAffinity = o.apimanager.Spec.Backend.CronSpec.Affinity
-	o.backendOptions.CronTolerations = o.apimanager.Spec.Backend.CronSpec.Tolerations
+	o.backendOptions.CronTolerations = componentTolerations(o.apimanager, o.apimanager.Spec.Backend.CronSpec.Tolerations, o.apimanager.Spec.Backend.CronSpec.UnreachableTolerationSeconds)
+}
+
+// setCustomLabelsAndAnnotationsOptions sets the custom labels and annotations of the
+// component. The custom labels are already part of the pod template labels
+func (o *OperatorBackendOptionsProvider) setCustomLabelsAndAnnotationsOptions() {
+	o.backendOptions.ListenerCustomLabels = o.apimanager.Spec.Backend.ListenerSpec.Labels
+	o.backendOptions.ListenerCustomAnnotations = o.apimanager.Spec.Backend.ListenerSpec.Annotations
+	o.backendOptions.WorkerCustomAnnotations = o.apimanager.Spec.Backend.WorkerSpec.Annotations
+	o.backendOptions.CronCustomAnnotations = o.apimanager.Spec.Backend.CronSpec.Annotations
+}
+
+func (o *OperatorBackendOptionsProvider) setPodTemplateOverridesOptions() {
+	o.backendOptions.ListenerPodTemplateOverrides = podTemplateOverridesOptions(o.apimanager, &o.apimanager.Spec.Backend.ListenerSpec.PodTemplateOverridesSpec)
+	o.backendOptions.WorkerPodTemplateOverrides = podTemplateOverridesOptions(o.apimanager, &o.apimanager.Spec.Backend.WorkerSpec.PodTemplateOverridesSpec)
+	o.backendOptions.CronPodTemplateOverrides = podTemplateOverridesOptions(o.apimanager, &o.apimanager.Spec.Backend.CronSpec.PodTemplateOverridesSpec)
+}
+
+func (o *OperatorBackendOptionsProvider) setSecurityContextOptions() {
+	listenerSpec := o.apimanager.Spec.Backend.ListenerSpec
+	o.backendOptions.ListenerSecurityContext = securityContextOptions(listenerSpec.PodSecurityContext, listenerSpec.SecurityContext)
+	workerSpec := o.apimanager.Spec.Backend.WorkerSpec
+	o.backendOptions.WorkerSecurityContext = securityContextOptions(workerSpec.PodSecurityContext, workerSpec.SecurityContext)
+	cronSpec := o.apimanager.Spec.Backend.CronSpec
+	o.backendOptions.CronSecurityContext = securityContextOptions(cronSpec.PodSecurityContext, cronSpec.SecurityContext)
+}
+
+func (o *OperatorBackendOptionsProvider) setProbesOptions() {
+	o.backendOptions.ListenerProbes = probesOptions(o.apimanager.Spec.Backend.ListenerSpec.Probes)
+}
+
+func (o *OperatorBackendOptionsProvider) setExtraEnvOptions() {
+	o.backendOptions.ListenerExtraEnv = o.apimanager.Spec.Backend.ListenerSpec.Env
+	o.backendOptions.WorkerExtraEnv = o.apimanager.Spec.Backend.WorkerSpec.Env
+	o.backendOptions.CronExtraEnv = o.apimanager.Spec.Backend.CronSpec.Env
+}
+
+func (o *OperatorBackendOptionsProvider) setSidecarsOptions() {
+	o.backendOptions.ListenerSidecars = sidecarsOptions(o.apimanager.Spec.Backend.ListenerSpec.Sidecars, o.apimanager.Spec.Backend.ListenerSpec.SidecarVolumes)
+	o.backendOptions.WorkerSidecars = sidecarsOptions(o.apimanager.Spec.Backend.WorkerSpec.Sidecars, o.apimanager.Spec.Backend.WorkerSpec.SidecarVolumes)
+	o.backendOptions.CronSidecars = sidecarsOptions(o.apimanager.Spec.Backend.CronSpec.Sidecars, o.apimanager.Spec.Backend.CronSpec.SidecarVolumes)
+}
+
+func (o *OperatorBackendOptionsProvider) setInitContainersOptions() {
+	o.backendOptions.ListenerInitContainers = initContainersOptions(nil, o.apimanager.Spec.Backend.ListenerSpec.InitContainers)
+	o.backendOptions.WorkerInitContainers = initContainersOptions(o.apimanager.Spec.Backend.WorkerSpec.WaitInitContainer, o.apimanager.Spec.Backend.WorkerSpec.InitContainers)
+	o.backendOptions.CronInitContainers = initContainersOptions(o.apimanager.Spec.Backend.CronSpec.WaitInitContainer, o.apimanager.Spec.Backend.CronSpec.InitContainers)
+}
+
+func (o *OperatorBackendOptionsProvider) setTerminationOptions() {
+	o.backendOptions.ListenerTermination = terminationOptions(o.apimanager.Spec.Backend.ListenerSpec.TerminationGracePeriodSeconds, o.apimanager.Spec.Backend.ListenerSpec.PreStopCommand)
+	o.backendOptions.WorkerTermination = terminationOptions(o.apimanager.Spec.Backend.WorkerSpec.TerminationGracePeriodSeconds, o.apimanager.Spec.Backend.WorkerSpec.PreStopCommand)
+	o.backendOptions.CronTermination = terminationOptions(o.apimanager.Spec.Backend.CronSpec.TerminationGracePeriodSeconds, o.apimanager.Spec.Backend.CronSpec.PreStopCommand)
 }
 
 func (o *OperatorBackendOptionsProvider) setReplicas() {
-	o.backendOptions.ListenerReplicas = int32(*o.apimanager.Spec.Backend.ListenerSpec.Replicas)
-	o.backendOptions.WorkerReplicas = int32(*o.apimanager.Spec.Backend.WorkerSpec.Replicas)
-	o.backendOptions.CronReplicas = int32(*o.apimanager.Spec.Backend.CronSpec.Replicas)
+	o.backendOptions.ListenerReplicas, o.backendOptions.ListenerReplicasManaged = replicasOptions(o.apimanager.Spec.Backend.ListenerSpec.Replicas)
+	o.backendOptions.WorkerReplicas, o.backendOptions.WorkerReplicasManaged = replicasOptions(o.apimanager.Spec.Backend.WorkerSpec.Replicas)
+	o.backendOptions.CronReplicas, o.backendOptions.CronReplicasManaged = replicasOptions(o.apimanager.Spec.Backend.CronSpec.Replicas)
+}
+
+func (o *OperatorBackendOptionsProvider) setPodDisruptionBudgetOptions() {
+	pdbSpec := o.apimanager.Spec.PodDisruptionBudget
+	if pdbSpec == nil {
+		return
+	}
+	o.backendOptions.ListenerPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.BackendListener)
+	o.backendOptions.WorkerPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.BackendWorker)
+	o.backendOptions.CronPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.BackendCron)
 }
 
 func (o *OperatorBackendOptionsProvider) commonLabels() map[string]string {
@@ -173,7 +249,8 @@ func (o *OperatorBackendOptionsProvider) commonCronLabels() map[string]string {
 }
 
 func (o *OperatorBackendOptionsProvider) listenerPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("backend-listener", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(o.apimanager.Spec.Backend.ListenerSpec.Labels, helper.MeteringLabels("backend-listener", helper.ApplicationType))
 
 	for k, v := range o.commonListenerLabels() {
 		labels[k] = v
@@ -185,7 +262,8 @@ func (o *OperatorBackendOptionsProvider) listenerPodTemplateLabels() map[string]
 }
 
 func (o *OperatorBackendOptionsProvider) workerPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("backend-worker", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(o.apimanager.Spec.Backend.WorkerSpec.Labels, helper.MeteringLabels("backend-worker", helper.ApplicationType))
 
 	for k, v := range o.commonWorkerLabels() {
 		labels[k] = v
@@ -197,7 +275,8 @@ func (o *OperatorBackendOptionsProvider) workerPodTemplateLabels() map[string]st
 }
 
 func (o *OperatorBackendOptionsProvider) cronPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("backend-cron", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(o.apimanager.Spec.Backend.CronSpec.Labels, helper.MeteringLabels("backend-cron", helper.ApplicationType))
 
 	for k, v := range o.commonCronLabels() {
 		labels[k] = v
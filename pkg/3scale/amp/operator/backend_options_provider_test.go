@@ -193,6 +193,9 @@ func defaultBackendOptions(opts *component.BackendOptions) *component.BackendOpt
 		ListenerReplicas:             int32(listenerReplicaCount),
 		WorkerReplicas:               int32(workerReplicaCount),
 		CronReplicas:                 int32(cronReplicaCount),
+		ListenerReplicasManaged:      true,
+		WorkerReplicasManaged:        true,
+		CronReplicasManaged:          true,
 		SystemBackendUsername:        component.DefaultSystemBackendUsername(),
 		SystemBackendPassword:        opts.SystemBackendPassword,
 		TenantName:                   tenantName,
@@ -208,6 +211,7 @@ func defaultBackendOptions(opts *component.BackendOptions) *component.BackendOpt
 		WorkerMetrics:                true,
 		ListenerMetrics:              true,
 		Namespace:                    opts.Namespace,
+		GrafanaDashboard:             component.DefaultGrafanaDashboardOptions(),
 	}
 }
 
@@ -289,6 +293,47 @@ func TestGetBackendOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"WithTopologySpreadConstraints", nil, nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestBackendOptions()
+				apimanager.Spec.Backend.ListenerSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-listener")
+				apimanager.Spec.Backend.WorkerSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-worker")
+				apimanager.Spec.Backend.CronSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-cron")
+				return apimanager
+			},
+			func(in *component.BackendOptions) *component.BackendOptions {
+				opts := defaultBackendOptions(in)
+
+				opts.ListenerPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-listener")
+				opts.WorkerPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-worker")
+				opts.CronPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("backend-cron")
+				return opts
+			},
+		},
+		{"WithCustomLabelsAndAnnotations", nil, nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerTestBackendOptions()
+				apimanager.Spec.Backend.ListenerSpec.Labels = getTestCustomLabels("backend-listener")
+				apimanager.Spec.Backend.ListenerSpec.Annotations = getTestCustomAnnotations("backend-listener")
+				apimanager.Spec.Backend.WorkerSpec.Labels = getTestCustomLabels("backend-worker")
+				apimanager.Spec.Backend.WorkerSpec.Annotations = getTestCustomAnnotations("backend-worker")
+				apimanager.Spec.Backend.CronSpec.Labels = getTestCustomLabels("backend-cron")
+				apimanager.Spec.Backend.CronSpec.Annotations = getTestCustomAnnotations("backend-cron")
+				return apimanager
+			},
+			func(in *component.BackendOptions) *component.BackendOptions {
+				opts := defaultBackendOptions(in)
+
+				opts.ListenerCustomLabels = getTestCustomLabels("backend-listener")
+				opts.ListenerCustomAnnotations = getTestCustomAnnotations("backend-listener")
+				opts.ListenerPodTemplateLabels["custom-label"] = "backend-listener"
+				opts.WorkerCustomAnnotations = getTestCustomAnnotations("backend-worker")
+				opts.WorkerPodTemplateLabels["custom-label"] = "backend-worker"
+				opts.CronCustomAnnotations = getTestCustomAnnotations("backend-cron")
+				opts.CronPodTemplateLabels["custom-label"] = "backend-cron"
+				return opts
+			},
+		},
 		{"WithBackendCustomResourceRequirements", nil, nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerTestBackendOptions()
@@ -3,6 +3,7 @@ package operator
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
@@ -27,16 +28,22 @@ func NewBackendReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReco
 }
 
 func (r *BackendReconciler) Reconcile() (reconcile.Result, error) {
+	// System and apicast connect to the external backend. Objects deployed before
+	// switching to the external backend, monitoring included, are left in place
+	if r.apiManager.IsExternalBackend() {
+		return reconcile.Result{}, nil
+	}
+
 	backend, err := Backend(r.apiManager, r.Client())
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// Cron DC
-	cronConfigMutator := reconcilers.GenericBackendMutators()
+	cronConfigMutator := append(reconcilers.GenericBackendMutators(), statsdEnvVarsMutator, backendRedisCredentialsMutator)
 
 	if value, found := r.apiManager.ObjectMeta.Annotations[disableCronReplicasReconciler]; !found || value != "true" {
-		cronConfigMutator = append(cronConfigMutator, reconcilers.DeploymentConfigReplicasMutator)
+		cronConfigMutator = append(cronConfigMutator, replicasMutator(backend.Options.CronReplicasManaged))
 	}
 
 	err = r.ReconcileDeploymentConfig(backend.CronDeploymentConfig(), reconcilers.DeploymentConfigMutator(cronConfigMutator...))
@@ -45,10 +52,10 @@ func (r *BackendReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// Listener DC
-	listenerConfigMutator := reconcilers.GenericBackendMutators()
+	listenerConfigMutator := append(reconcilers.GenericBackendMutators(), statsdEnvVarsMutator, backendRequestLoggingEnvVarsMutator, componentMetricsMutator, backendRedisCredentialsMutator)
 
 	if value, found := r.apiManager.ObjectMeta.Annotations[disableBackendListenerReplicasReconciler]; !found || value != "true" {
-		listenerConfigMutator = append(listenerConfigMutator, reconcilers.DeploymentConfigReplicasMutator)
+		listenerConfigMutator = append(listenerConfigMutator, replicasMutator(backend.Options.ListenerReplicasManaged))
 	}
 
 	err = r.ReconcileDeploymentConfig(backend.ListenerDeploymentConfig(), reconcilers.DeploymentConfigMutator(listenerConfigMutator...))
@@ -69,10 +76,10 @@ func (r *BackendReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// Worker DC
-	workerConfigMutator := reconcilers.GenericBackendMutators()
+	workerConfigMutator := append(reconcilers.GenericBackendMutators(), statsdEnvVarsMutator, componentMetricsMutator, backendRedisCredentialsMutator)
 
 	if value, found := r.apiManager.ObjectMeta.Annotations[disableBackendWorkerReplicasReconciler]; !found || value != "true" {
-		workerConfigMutator = append(workerConfigMutator, reconcilers.DeploymentConfigReplicasMutator)
+		workerConfigMutator = append(workerConfigMutator, replicasMutator(backend.Options.WorkerReplicasManaged))
 	}
 
 	err = r.ReconcileDeploymentConfig(backend.WorkerDeploymentConfig(), reconcilers.DeploymentConfigMutator(workerConfigMutator...))
@@ -146,14 +153,26 @@ func (r *BackendReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	sloRules := backend.BackendListenerSLOPrometheusRules()
+	if !r.apiManager.IsRecordingRulesEnabled() {
+		common.TagObjectToDelete(sloRules)
+	}
+	err = r.ReconcilePrometheusRules(sloRules, reconcilers.GenericPrometheusRuleMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return reconcile.Result{}, nil
 }
 
 func Backend(apimanager *appsv1alpha1.APIManager, client client.Client) (*component.Backend, error) {
-	optsProvider := NewOperatorBackendOptionsProvider(apimanager, apimanager.Namespace, client)
-	opts, err := optsProvider.GetBackendOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(apimanager, "backend", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewOperatorBackendOptionsProvider(apimanager, apimanager.Namespace, client)
+		opts, err := optsProvider.GetBackendOptions()
+		return opts, optsProvider.secretSource, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return component.NewBackend(opts), nil
+	return component.NewBackend(opts.(*component.BackendOptions)), nil
 }
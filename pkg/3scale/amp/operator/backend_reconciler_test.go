@@ -2,12 +2,14 @@ package operator
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
+	"github.com/google/go-cmp/cmp"
 	appsv1 "github.com/openshift/api/apps/v1"
 	configv1 "github.com/openshift/api/config/v1"
 	imagev1 "github.com/openshift/api/image/v1"
@@ -283,3 +285,86 @@ func confirmReplicasWhenAnnotationPresent(apiManager *appsv1alpha1.APIManager, d
 
 	return true
 }
+
+func TestBackendReconcilerTopologySpreadConstraints(t *testing.T) {
+	var (
+		log                 = logf.Log.WithName("operator_test")
+		oneValue      int64 = 1
+		listenerDCKey       = types.NamespacedName{Name: "backend-listener", Namespace: namespace}
+		constraints         = getTestTopologySpreadConstraints("backend-listener")
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanager()
+	apimanager.Spec.Backend = &appsv1alpha1.BackendSpec{
+		ListenerSpec: &appsv1alpha1.BackendListenerSpec{PodTemplateOverridesSpec: appsv1alpha1.PodTemplateOverridesSpec{PodPlacementSpec: appsv1alpha1.PodPlacementSpec{TopologySpreadConstraints: constraints}}, Replicas: &oneValue},
+		WorkerSpec:   &appsv1alpha1.BackendWorkerSpec{Replicas: &oneValue},
+		CronSpec:     &appsv1alpha1.BackendCronSpec{Replicas: &oneValue},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+	backendReconciler := NewBackendReconciler(baseAPIManagerLogicReconciler)
+
+	reconcileAndCheck := func(subT *testing.T, expected []v1.TopologySpreadConstraint) {
+		_, err := backendReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, listenerDCKey, dc); err != nil {
+			subT.Fatal(err)
+		}
+		if !reflect.DeepEqual(dc.Spec.Template.Spec.TopologySpreadConstraints, expected) {
+			subT.Errorf("unexpected topology spread constraints: %s", cmp.Diff(dc.Spec.Template.Spec.TopologySpreadConstraints, expected))
+		}
+	}
+
+	t.Run("Create", func(subT *testing.T) {
+		reconcileAndCheck(subT, constraints)
+	})
+
+	t.Run("DriftCorrection", func(subT *testing.T) {
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, listenerDCKey, dc); err != nil {
+			subT.Fatal(err)
+		}
+		dc.Spec.Template.Spec.TopologySpreadConstraints[0].MaxSkew = 5
+		dc.Spec.Template.Spec.TopologySpreadConstraints[0].WhenUnsatisfiable = v1.ScheduleAnyway
+		if err := cl.Update(ctx, dc); err != nil {
+			subT.Fatal(err)
+		}
+
+		reconcileAndCheck(subT, constraints)
+	})
+
+	t.Run("Unset", func(subT *testing.T) {
+		apimanager.Spec.Backend.ListenerSpec.TopologySpreadConstraints = nil
+		reconcileAndCheck(subT, nil)
+	})
+}
@@ -0,0 +1,106 @@
+package operator
+
+import (
+	"fmt"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclock "k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+func backendRequestLoggingOptions(apimanager *appsv1alpha1.APIManager) *component.BackendRequestLoggingOptions {
+	if !apimanager.IsBackendListenerRequestLoggingEnabled() {
+		return nil
+	}
+
+	return &component.BackendRequestLoggingOptions{
+		SamplingRate: apimanager.BackendListenerRequestLoggingSamplingRate(),
+	}
+}
+
+// backendRequestLoggingEnvVarsMutator reconciles the request logging env vars of all the containers
+func backendRequestLoggingEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, envVar := range component.BackendRequestLoggingEnvVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
+// BackendRequestLoggingReconciler tracks the TTL of the backend-listener
+// request logging. Once expired, the request logging is disabled in the
+// APIManager spec, so debug logging is never left enabled.
+type BackendRequestLoggingReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	clock kubeclock.Clock
+}
+
+func NewBackendRequestLoggingReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *BackendRequestLoggingReconciler {
+	return &BackendRequestLoggingReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		clock:                         &kubeclock.RealClock{},
+	}
+}
+
+func (r *BackendRequestLoggingReconciler) Reconcile() (reconcile.Result, error) {
+	status := r.apiManager.Status.BackendListenerRequestLogging
+
+	if !r.apiManager.IsBackendListenerRequestLoggingEnabled() {
+		if status == nil {
+			return reconcile.Result{}, nil
+		}
+
+		r.apiManager.Status.BackendListenerRequestLogging = nil
+		err := r.UpdateResourceStatus(r.apiManager)
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	// Serialized times have seconds precision
+	now := r.clock.Now().Truncate(time.Second)
+
+	if status == nil {
+		status = &appsv1alpha1.RequestLoggingStatus{StartTime: metav1.Time{Time: now}}
+	}
+	expirationTime := metav1.Time{Time: status.StartTime.Add(r.apiManager.BackendListenerRequestLoggingTTL())}
+
+	if !now.Before(expirationTime.Time) {
+		r.apiManager.Spec.Backend.ListenerSpec.RequestLogging.Enabled = false
+		err := r.UpdateResource(r.apiManager)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		msg := fmt.Sprintf("backend-listener request logging disabled, TTL of %s expired", r.apiManager.BackendListenerRequestLoggingTTL())
+		r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "RequestLoggingExpired", msg)
+		r.Logger().Info(msg)
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	// TTL updates while enabled move the expiration time
+	if r.apiManager.Status.BackendListenerRequestLogging == nil || !r.apiManager.Status.BackendListenerRequestLogging.ExpirationTime.Equal(&expirationTime) {
+		if r.apiManager.Status.BackendListenerRequestLogging == nil {
+			msg := fmt.Sprintf("backend-listener request logging enabled until %s", expirationTime.UTC().Format(time.RFC3339))
+			r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "RequestLoggingEnabled", msg)
+			r.Logger().Info(msg)
+		}
+
+		status.ExpirationTime = expirationTime
+		r.apiManager.Status.BackendListenerRequestLogging = status
+		err := r.UpdateResourceStatus(r.apiManager)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: expirationTime.Sub(now)}, nil
+}
@@ -0,0 +1,189 @@
+package operator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	"k8s.io/apimachinery/pkg/types"
+	kubeclock "k8s.io/apimachinery/pkg/util/clock"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestBackendRequestLoggingEnvVars(t *testing.T) {
+	samplingRate := int32(10)
+
+	cases := []struct {
+		testName    string
+		spec        *appsv1alpha1.BackendListenerRequestLoggingSpec
+		expectedEnv map[string]string
+	}{
+		{"Unset", nil, map[string]string{}},
+		{"Disabled", &appsv1alpha1.BackendListenerRequestLoggingSpec{Enabled: false}, map[string]string{}},
+		{"Defaults", &appsv1alpha1.BackendListenerRequestLoggingSpec{Enabled: true},
+			map[string]string{
+				component.BackendRequestLoggersEnvVarName:             component.BackendRequestLoggersJSON,
+				component.BackendRequestLoggersSamplingRateEnvVarName: "100",
+			},
+		},
+		{"Sampled", &appsv1alpha1.BackendListenerRequestLoggingSpec{Enabled: true, SamplingRate: &samplingRate},
+			map[string]string{
+				component.BackendRequestLoggersEnvVarName:             component.BackendRequestLoggersJSON,
+				component.BackendRequestLoggersSamplingRateEnvVarName: "10",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := basicApimanager()
+			apimanager.Spec.Backend.ListenerSpec.RequestLogging = tc.spec
+
+			backend, err := Backend(apimanager, fake.NewFakeClient())
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			env := backend.ListenerDeploymentConfig().Spec.Template.Spec.Containers[0].Env
+			for _, name := range component.BackendRequestLoggingEnvVarNames {
+				idx := helper.FindEnvVar(env, name)
+				expected, ok := tc.expectedEnv[name]
+				if !ok {
+					if idx >= 0 {
+						subT.Errorf("unexpected env var %s", name)
+					}
+					continue
+				}
+				if idx < 0 {
+					subT.Errorf("env var %s not found", name)
+					continue
+				}
+				if env[idx].Value != expected {
+					subT.Errorf("env var %s: expected '%s', got '%s'", name, expected, env[idx].Value)
+				}
+			}
+
+			// Only the listener logs requests
+			workerEnv := backend.WorkerDeploymentConfig().Spec.Template.Spec.Containers[0].Env
+			if helper.FindEnvVar(workerEnv, component.BackendRequestLoggersEnvVarName) >= 0 {
+				subT.Error("unexpected request logging env var in backend-worker")
+			}
+		})
+	}
+}
+
+func TestBackendRequestLoggingReconcilerTTL(t *testing.T) {
+	var (
+		log = logf.Log.WithName("operator_test")
+		ttl = int64(600)
+	)
+
+	apimanager := basicApimanager()
+	apimanager.Spec.Backend.ListenerSpec.RequestLogging = &appsv1alpha1.BackendListenerRequestLoggingSpec{
+		Enabled:    true,
+		TTLSeconds: &ttl,
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	cl := fake.NewFakeClient(apimanager)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, cl, log, clientset.Discovery(), recorder)
+	startTime := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+	fakeClock := kubeclock.NewFakeClock(startTime)
+
+	newReconciler := func() *BackendRequestLoggingReconciler {
+		existing := &appsv1alpha1.APIManager{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: apimanager.Name, Namespace: apimanager.Namespace}, existing); err != nil {
+			t.Fatal(err)
+		}
+		reconciler := NewBackendRequestLoggingReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, existing))
+		reconciler.clock = fakeClock
+		return reconciler
+	}
+
+	// Enabling records the period and requeues on expiration
+	reconciler := newReconciler()
+	res, err := reconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RequeueAfter != 10*time.Minute {
+		t.Errorf("expected requeue after the TTL, got %s", res.RequeueAfter)
+	}
+	status := reconciler.apiManager.Status.BackendListenerRequestLogging
+	if status == nil || !status.StartTime.Time.Equal(startTime) || !status.ExpirationTime.Time.Equal(startTime.Add(10*time.Minute)) {
+		t.Fatalf("unexpected request logging status: %v", status)
+	}
+	assertRequestLoggingEvent(t, recorder, "RequestLoggingEnabled")
+
+	// Before the expiration, the request logging is kept
+	fakeClock.Step(9 * time.Minute)
+	reconciler = newReconciler()
+	res, err = reconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Requeue || res.RequeueAfter != time.Minute {
+		t.Errorf("expected requeue after the remaining TTL, got %v", res)
+	}
+	if !reconciler.apiManager.IsBackendListenerRequestLoggingEnabled() {
+		t.Fatal("expected request logging to be kept enabled before the TTL expires")
+	}
+
+	// On expiration, the request logging is disabled in the spec
+	fakeClock.Step(time.Minute)
+	reconciler = newReconciler()
+	res, err = reconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Requeue {
+		t.Error("expected requeue once the request logging is disabled")
+	}
+	if newReconciler().apiManager.IsBackendListenerRequestLoggingEnabled() {
+		t.Fatal("expected request logging to be disabled after the TTL expired")
+	}
+	assertRequestLoggingEvent(t, recorder, "RequestLoggingExpired")
+
+	// Then the status is cleared
+	reconciler = newReconciler()
+	if _, err = reconciler.Reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if newReconciler().apiManager.Status.BackendListenerRequestLogging != nil {
+		t.Error("expected request logging status to be cleared")
+	}
+
+	// Nothing left to do
+	res, err = newReconciler().Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Requeue || res.RequeueAfter != 0 {
+		t.Errorf("unexpected result once disabled: %v", res)
+	}
+}
+
+func assertRequestLoggingEvent(t *testing.T, recorder *record.FakeRecorder, reason string) {
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, reason) {
+			t.Errorf("expected %s event, got '%s'", reason, event)
+		}
+	default:
+		t.Errorf("expected %s event", reason)
+	}
+}
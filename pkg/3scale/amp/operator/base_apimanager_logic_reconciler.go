@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
@@ -14,9 +15,11 @@ import (
 	appsv1 "github.com/openshift/api/apps/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -35,9 +38,12 @@ type baseAPIManagerLogicReconcilerCRDAvailabilityCache struct {
 	serviceMonitorCRDAvailable   *bool
 }
 
+// NewBaseAPIManagerLogicReconciler returns the reconciler of one APIManager reconcile.
+// Secrets are read once through a snapshot shared by all the options providers
+// of the reconcile, and refreshed when written by the reconcile
 func NewBaseAPIManagerLogicReconciler(b *reconcilers.BaseReconciler, apiManager *appsv1alpha1.APIManager) *BaseAPIManagerLogicReconciler {
 	return &BaseAPIManagerLogicReconciler{
-		BaseReconciler:       b,
+		BaseReconciler:       b.WithClient(helper.NewSecretSnapshotClient(b.Client())),
 		apiManager:           apiManager,
 		logger:               b.Logger().WithValues("APIManager Controller", apiManager.Name),
 		crdAvailabilityCache: &baseAPIManagerLogicReconcilerCRDAvailabilityCache{},
@@ -48,19 +54,72 @@ func (r *BaseAPIManagerLogicReconciler) NamespacedNameWithAPIManagerNamespace(ob
 	return types.NamespacedName{Namespace: r.apiManager.GetNamespace(), Name: obj.GetName()}
 }
 
+// ReconcilePodDisruptionBudget reconciles the PDB of the pods of the DeploymentConfig
+// with the same name. A warning event is emitted when the PDB blocks the eviction of
+// all the current replicas, as the nodes running them could not be drained. The PDB is
+// kept, so it is not deleted and created again while the replicas are scaled
 func (r *BaseAPIManagerLogicReconciler) ReconcilePodDisruptionBudget(desired *v1beta1.PodDisruptionBudget, mutatefn reconcilers.MutateFn) error {
 	if !r.apiManager.IsPDBEnabled() {
 		common.TagObjectToDelete(desired)
+	} else {
+		blocks, err := r.podDisruptionBudgetBlocksEviction(desired)
+		if err != nil {
+			return err
+		}
+		if blocks {
+			r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "PodDisruptionBudgetBlocksEviction",
+				"PodDisruptionBudget '%s' blocks the eviction of all the replicas", desired.Name)
+		}
 	}
 	return r.ReconcileResource(&v1beta1.PodDisruptionBudget{}, desired, mutatefn)
 }
 
+// ReconcileHorizontalPodAutoscaler reconciles the HPA of the DeploymentConfig with the same name
+func (r *BaseAPIManagerLogicReconciler) ReconcileHorizontalPodAutoscaler(desired *autoscalingv2beta2.HorizontalPodAutoscaler, mutatefn reconcilers.MutateFn) error {
+	return r.ReconcileResource(&autoscalingv2beta2.HorizontalPodAutoscaler{}, desired, mutatefn)
+}
+
+func (r *BaseAPIManagerLogicReconciler) podDisruptionBudgetBlocksEviction(pdb *v1beta1.PodDisruptionBudget) (bool, error) {
+	dc := &appsv1.DeploymentConfig{}
+	err := r.Client().Get(r.Context(), r.NamespacedNameWithAPIManagerNamespace(pdb), dc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return reconcilers.PodDisruptionBudgetBlocksEviction(pdb, dc.Spec.Replicas), nil
+}
+
 func (r *BaseAPIManagerLogicReconciler) ReconcileImagestream(desired *imagev1.ImageStream, mutatefn reconcilers.MutateFn) error {
 	return r.ReconcileResource(&imagev1.ImageStream{}, desired, mutatefn)
 }
 
+// ReconcileDeploymentConfig reconciles the DeploymentConfig of a component. The pod template settings
+// managed for all the components and the pod template overrides set in the APIManager are reconciled
+// on top of the given mutator. The security contexts are defaulted to the restricted Pod Security Standard
+// unless it is disabled in the APIManager, and the service account is the one named in the APIManager.
+// While the APIManager is hibernated or the component is scaled down for standby, the replicas are
+// scaled down to zero
 func (r *BaseAPIManagerLogicReconciler) ReconcileDeploymentConfig(desired *appsv1.DeploymentConfig, mutatefn reconcilers.MutateFn) error {
-	return r.ReconcileResource(&appsv1.DeploymentConfig{}, desired, mutatefn)
+	scaledDown := r.isScaledDown(desired.Name)
+	if scaledDown {
+		if err := r.hibernateDesiredReplicas(desired); err != nil {
+			return err
+		}
+	}
+	if desired.Spec.Template != nil {
+		desired.Spec.Template.Spec.ServiceAccountName = r.apiManager.ServiceAccountName(desired.Spec.Template.Spec.ServiceAccountName)
+		helper.SetTerminationMessagePolicy(&desired.Spec.Template.Spec, r.apiManager.ContainerTerminationMessagePolicy())
+		if r.apiManager.IsRestrictedPodSecurityStandard() {
+			helper.SetRestrictedSecurityContexts(desired.Spec.Template)
+		}
+	}
+	if err := r.setSecretHashAnnotation(desired); err != nil {
+		return err
+	}
+	return r.ReconcileResource(&appsv1.DeploymentConfig{}, desired, deploymentConfigMutateFn(scaledDown, mutatefn))
 }
 
 func (r *BaseAPIManagerLogicReconciler) ReconcileService(desired *v1.Service, mutateFn reconcilers.MutateFn) error {
@@ -98,7 +157,9 @@ func (r *BaseAPIManagerLogicReconciler) ReconcileRoleBinding(desired *rbacv1.Rol
 func (r *BaseAPIManagerLogicReconciler) ReconcileGrafanaDashboard(desired *grafanav1alpha1.GrafanaDashboard, mutateFn reconcilers.MutateFn) error {
 	kindExists, err := r.HasGrafanaDashboards()
 	if err != nil {
-		return err
+		// Monitoring CRDs are optional, do not block the rest of the components
+		r.logger.Error(err, "GrafanaDashboard kind discovery failed, skipping", "name", desired.Name)
+		return nil
 	}
 
 	if !kindExists {
@@ -110,7 +171,7 @@ func (r *BaseAPIManagerLogicReconciler) ReconcileGrafanaDashboard(desired *grafa
 		return nil
 	}
 
-	if !r.apiManager.IsMonitoringEnabled() {
+	if !r.apiManager.IsComponentMonitoringEnabled(monitoringComponentName(desired)) {
 		common.TagObjectToDelete(desired)
 	}
 	return r.ReconcileResource(&grafanav1alpha1.GrafanaDashboard{}, desired, mutateFn)
@@ -119,7 +180,9 @@ func (r *BaseAPIManagerLogicReconciler) ReconcileGrafanaDashboard(desired *grafa
 func (r *BaseAPIManagerLogicReconciler) ReconcilePrometheusRules(desired *monitoringv1.PrometheusRule, mutateFn reconcilers.MutateFn) error {
 	kindExists, err := r.HasPrometheusRules()
 	if err != nil {
-		return err
+		// Monitoring CRDs are optional, do not block the rest of the components
+		r.logger.Error(err, "PrometheusRule kind discovery failed, skipping", "name", desired.Name)
+		return nil
 	}
 
 	if !kindExists {
@@ -131,16 +194,26 @@ func (r *BaseAPIManagerLogicReconciler) ReconcilePrometheusRules(desired *monito
 		return nil
 	}
 
-	if !r.apiManager.IsPrometheusRulesEnabled() {
+	// While hibernated, the alerts would fire on the scaled down components
+	if !r.apiManager.IsComponentPrometheusRulesEnabled(monitoringComponentName(desired)) || r.apiManager.IsHibernated() {
 		common.TagObjectToDelete(desired)
 	}
-	return r.ReconcileResource(&monitoringv1.PrometheusRule{}, desired, mutateFn)
+
+	if overrides := prometheusRuleOverrides(r.apiManager); len(overrides) > 0 {
+		// Overrides not applied are reported by the PrometheusRuleOverridesReconciler
+		if err := component.ApplyPrometheusRuleOverrides(desired, overrides); err != nil {
+			r.logger.Info("some alert overrides not applied", "name", desired.Name, "reason", err.Error())
+		}
+	}
+	return r.ReconcileResource(&monitoringv1.PrometheusRule{}, desired, prometheusRuleOverridesMutator(mutateFn))
 }
 
 func (r *BaseAPIManagerLogicReconciler) ReconcileServiceMonitor(desired *monitoringv1.ServiceMonitor, mutateFn reconcilers.MutateFn) error {
 	kindExists, err := r.HasServiceMonitors()
 	if err != nil {
-		return err
+		// Monitoring CRDs are optional, do not block the rest of the components
+		r.logger.Error(err, "ServiceMonitor kind discovery failed, skipping", "name", desired.Name)
+		return nil
 	}
 
 	if !kindExists {
@@ -152,7 +225,7 @@ func (r *BaseAPIManagerLogicReconciler) ReconcileServiceMonitor(desired *monitor
 		return nil
 	}
 
-	if !r.apiManager.IsMonitoringEnabled() {
+	if !r.apiManager.IsComponentMonitoringEnabled(monitoringComponentName(desired)) {
 		common.TagObjectToDelete(desired)
 	}
 	return r.ReconcileResource(&monitoringv1.ServiceMonitor{}, desired, mutateFn)
@@ -161,7 +234,9 @@ func (r *BaseAPIManagerLogicReconciler) ReconcileServiceMonitor(desired *monitor
 func (r *BaseAPIManagerLogicReconciler) ReconcilePodMonitor(desired *monitoringv1.PodMonitor, mutateFn reconcilers.MutateFn) error {
 	kindExists, err := r.HasPodMonitors()
 	if err != nil {
-		return err
+		// Monitoring CRDs are optional, do not block the rest of the components
+		r.logger.Error(err, "PodMonitor kind discovery failed, skipping", "name", desired.Name)
+		return nil
 	}
 
 	if !kindExists {
@@ -173,14 +248,21 @@ func (r *BaseAPIManagerLogicReconciler) ReconcilePodMonitor(desired *monitoringv
 		return nil
 	}
 
-	if !r.apiManager.IsMonitoringEnabled() {
+	if !r.apiManager.IsComponentMonitoringEnabled(monitoringComponentName(desired)) {
 		common.TagObjectToDelete(desired)
 	}
 	return r.ReconcileResource(&monitoringv1.PodMonitor{}, desired, mutateFn)
 }
 
+// monitoringComponentName returns the component the monitoring object belongs to,
+// empty for the monitoring objects shared by all the components
+func monitoringComponentName(obj common.KubernetesObject) string {
+	return obj.GetLabels()["threescale_component"]
+}
+
 func (r *BaseAPIManagerLogicReconciler) ReconcileResource(obj, desired common.KubernetesObject, mutatefn reconcilers.MutateFn) error {
 	desired.SetNamespace(r.apiManager.GetNamespace())
+	r.setCustomLabels(desired)
 
 	// Secrets are managed by users so they do not get APIManager-based
 	// owned references. In case we want to react to changes to secrets
@@ -505,3 +505,72 @@ func TestBaseAPIManagerLogicReconcilerHasServiceMonitors(t *testing.T) {
 		t.Fatalf("Unexpected exists value received. Expected: %t, got: %t", false, exists)
 	}
 }
+
+// secretReadsClient counts the secret reads done against the wrapped client
+type secretReadsClient struct {
+	client.Client
+	reads   int
+	secrets map[client.ObjectKey]bool
+}
+
+func (c *secretReadsClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if _, ok := obj.(*v1.Secret); ok {
+		c.reads++
+		c.secrets[key] = true
+	}
+	return c.Client.Get(ctx, key, obj)
+}
+
+func TestBaseAPIManagerLogicReconcilerSecretReads(t *testing.T) {
+	buildOptions := func(cl client.Client) error {
+		apimanager := basicApimanager()
+		if _, err := NewApicastOptionsProvider(apimanager, cl).GetApicastOptions(); err != nil {
+			return err
+		}
+		if _, err := NewOperatorBackendOptionsProvider(apimanager, namespace, cl).GetBackendOptions(); err != nil {
+			return err
+		}
+		if _, err := NewSystemOptionsProvider(apimanager, namespace, cl).GetSystemOptions(); err != nil {
+			return err
+		}
+		if _, err := NewZyncOptionsProvider(apimanager, namespace, cl).GetZyncOptions(); err != nil {
+			return err
+		}
+		if _, err := NewRedisOptionsProvider(apimanager, namespace, cl).GetRedisOptions(); err != nil {
+			return err
+		}
+		_, err := NewSystemMysqlOptionsProvider(apimanager, namespace, cl).GetMysqlOptions()
+		return err
+	}
+
+	newReconciler := func(cl client.Client) *BaseAPIManagerLogicReconciler {
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, scheme.Scheme, cl,
+			logf.Log.WithName("operator_test"), fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(10000))
+		return NewBaseAPIManagerLogicReconciler(baseReconciler, basicApimanager())
+	}
+
+	direct := &secretReadsClient{Client: fake.NewFakeClient(), secrets: map[client.ObjectKey]bool{}}
+	if err := buildOptions(direct); err != nil {
+		t.Fatal(err)
+	}
+
+	counting := &secretReadsClient{Client: fake.NewFakeClient(), secrets: map[client.ObjectKey]bool{}}
+	if err := buildOptions(newReconciler(counting).Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	if counting.reads != len(counting.secrets) {
+		t.Errorf("expected each secret to be read once, got %d reads of %d secrets", counting.reads, len(counting.secrets))
+	}
+	if counting.reads >= direct.reads {
+		t.Errorf("expected less secret reads than the %d direct reads, got %d", direct.reads, counting.reads)
+	}
+
+	// Each reconcile reads the secrets again
+	if err := buildOptions(newReconciler(counting).Client()); err != nil {
+		t.Fatal(err)
+	}
+	if counting.reads != 2*len(counting.secrets) {
+		t.Errorf("expected each secret to be read once per reconcile, got %d reads of %d secrets", counting.reads, len(counting.secrets))
+	}
+}
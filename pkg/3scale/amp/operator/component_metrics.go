@@ -0,0 +1,45 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+var (
+	// componentMetricsPortNames are the container ports exposing the metrics of backend, system and zync
+	componentMetricsPortNames = []string{
+		"metrics",
+		component.SystemAppMasterContainerMetricsPortName,
+		component.SystemAppProviderContainerMetricsPortName,
+		component.SystemAppDeveloperContainerMetricsPortName,
+	}
+
+	// componentMetricsEnvVarNames are the env vars enabling the metrics of backend and system
+	componentMetricsEnvVarNames = []string{
+		component.BackendWorkerMetricsPortEnvVarName,
+		component.BackendWorkerMetricsEnabledEnvVarName,
+		component.BackendListenerMetricsPortEnvVarName,
+		component.BackendListenerMetricsEnabledEnvVarName,
+		component.SystemAppPrometheusExporterPortEnvVarName,
+	}
+)
+
+// componentMetricsMutator reconciles the metrics ports and env vars of all the containers,
+// so the pods are rolled out when the component monitoring is enabled or disabled
+func componentMetricsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, portName := range componentMetricsPortNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersPortReconciler(desired, existing, portName)
+		update = update || tmpUpdate
+	}
+
+	for _, envVar := range componentMetricsEnvVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
@@ -0,0 +1,176 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "github.com/openshift/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestComponentMetrics(t *testing.T) {
+	falseValue := false
+
+	cases := []struct {
+		testName        string
+		monitoringSpec  *appsv1alpha1.MonitoringSpec
+		expectedZync    bool
+		expectedBackend bool
+		expectedSystem  bool
+	}{
+		{"Default", nil, true, true, true},
+		{"ZyncDisabled", &appsv1alpha1.MonitoringSpec{Enabled: true, Zync: &falseValue}, false, true, true},
+		{"BackendDisabled", &appsv1alpha1.MonitoringSpec{Enabled: true, Backend: &falseValue}, true, false, true},
+		{"SystemDisabled", &appsv1alpha1.MonitoringSpec{Enabled: true, System: &falseValue}, true, true, false},
+	}
+
+	hasMetricsPort := func(dc *appsv1.DeploymentConfig, portName string) bool {
+		_, ok := helper.FindContainerPortByName(dc.Spec.Template.Spec.Containers[0].Ports, portName)
+		return ok
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := basicApimanager()
+			apimanager.Spec.Monitoring = tc.monitoringSpec
+			cl := fake.NewFakeClient()
+
+			zync, err := Zync(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if res := hasMetricsPort(zync.DeploymentConfig(), "metrics"); res != tc.expectedZync {
+				subT.Errorf("zync metrics port: expected %t, got %t", tc.expectedZync, res)
+			}
+
+			backend, err := Backend(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			listener := backend.ListenerDeploymentConfig()
+			if res := hasMetricsPort(listener, "metrics"); res != tc.expectedBackend {
+				subT.Errorf("backend-listener metrics port: expected %t, got %t", tc.expectedBackend, res)
+			}
+			envIdx := helper.FindEnvVar(listener.Spec.Template.Spec.Containers[0].Env, component.BackendListenerMetricsEnabledEnvVarName)
+			if res := envIdx >= 0; res != tc.expectedBackend {
+				subT.Errorf("backend-listener metrics env var: expected %t, got %t", tc.expectedBackend, res)
+			}
+
+			system, err := System(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if res := hasMetricsPort(system.SidekiqDeploymentConfig(), "metrics"); res != tc.expectedSystem {
+				subT.Errorf("system-sidekiq metrics port: expected %t, got %t", tc.expectedSystem, res)
+			}
+			if res := hasMetricsPort(system.AppDeploymentConfig(), component.SystemAppMasterContainerMetricsPortName); res != tc.expectedSystem {
+				subT.Errorf("system-app metrics port: expected %t, got %t", tc.expectedSystem, res)
+			}
+		})
+	}
+}
+
+func TestComponentMetricsMutator(t *testing.T) {
+	falseValue := false
+
+	apimanager := basicApimanager()
+	existingZync, err := Zync(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apimanager.Spec.Monitoring = &appsv1alpha1.MonitoringSpec{Enabled: true, Zync: &falseValue}
+	desiredZync, err := Zync(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := existingZync.DeploymentConfig()
+	update, err := componentMetricsMutator(desiredZync.DeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Error("expected update when zync metrics are disabled")
+	}
+	if _, ok := helper.FindContainerPortByName(existing.Spec.Template.Spec.Containers[0].Ports, "metrics"); ok {
+		t.Error("expected zync metrics port to be removed")
+	}
+}
+
+func TestComponentMonitoringResourcesRemoved(t *testing.T) {
+	var (
+		log        = logf.Log.WithName("operator_test")
+		falseValue = false
+	)
+
+	apimanager := basicApimanager()
+	apimanager.Spec.Monitoring = &appsv1alpha1.MonitoringSpec{Enabled: true}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	cl := fake.NewFakeClient(apimanager)
+	clientset := fakeclientset.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: monitoringv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{
+				{Name: monitoringv1.PrometheusRuleName, Namespaced: true, Kind: monitoringv1.PrometheusRuleKind},
+				{Name: monitoringv1.PodMonitorName, Namespaced: true, Kind: monitoringv1.PodMonitorsKind},
+			},
+		},
+	}
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, cl, log, clientset.Discovery(), record.NewFakeRecorder(10000))
+
+	reconcileZyncMonitoring := func() {
+		zync, err := Zync(apimanager, cl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+		if err := reconciler.ReconcilePodMonitor(zync.ZyncPodMonitor(), reconcilers.CreateOnlyMutator); err != nil {
+			t.Fatal(err)
+		}
+		if err := reconciler.ReconcilePrometheusRules(zync.ZyncPrometheusRules(), reconcilers.CreateOnlyMutator); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reconcileZyncMonitoring()
+	podMonitorKey := types.NamespacedName{Name: "zync", Namespace: namespace}
+	if err := cl.Get(context.TODO(), podMonitorKey, &monitoringv1.PodMonitor{}); err != nil {
+		t.Fatalf("expected zync PodMonitor to be created: %v", err)
+	}
+
+	// Disabling zync monitoring removes the previously created resources
+	apimanager.Spec.Monitoring.Zync = &falseValue
+	reconcileZyncMonitoring()
+	err := cl.Get(context.TODO(), podMonitorKey, &monitoringv1.PodMonitor{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("expected zync PodMonitor to be removed, got %v", err)
+	}
+	rules := &monitoringv1.PrometheusRuleList{}
+	if err := cl.List(context.TODO(), rules); err != nil {
+		t.Fatal(err)
+	}
+	if len(rules.Items) != 0 {
+		t.Errorf("expected zync PrometheusRules to be removed, got %d", len(rules.Items))
+	}
+}
@@ -0,0 +1,23 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// setCustomLabels adds the APIManager custom labels to the desired object and,
+// for deployment configs, to the pod template.
+// The labels set by the operator and the component labels take precedence
+func (r *BaseAPIManagerLogicReconciler) setCustomLabels(desired common.KubernetesObject) {
+	if len(r.apiManager.Spec.Labels) == 0 {
+		return
+	}
+
+	desired.SetLabels(helper.MergeMapsStringString(r.apiManager.Spec.Labels, desired.GetLabels()))
+
+	if dc, ok := desired.(*appsv1.DeploymentConfig); ok && dc.Spec.Template != nil {
+		dc.Spec.Template.Labels = helper.MergeMapsStringString(r.apiManager.Spec.Labels, dc.Spec.Template.Labels)
+	}
+}
@@ -0,0 +1,89 @@
+package operator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestCustomLabels(t *testing.T) {
+	var (
+		appLabel = "someLabel"
+		log      = logf.Log.WithName("operator_test")
+	)
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{
+				AppLabel: &appLabel,
+				Labels:   map[string]string{"cost-center": "1234", "app": "other"},
+			},
+		},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	objs := []runtime.Object{apimanager}
+	cl := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, fake.NewFakeClient(objs...), log, clientset.Discovery(), record.NewFakeRecorder(10000))
+	r := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	desired := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "zync", Namespace: namespace, Labels: map[string]string{"app": appLabel}},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"deploymentConfig": "zync"}},
+			},
+		},
+	}
+	r.setCustomLabels(desired)
+
+	expectedLabels := map[string]string{"cost-center": "1234", "app": appLabel}
+	if !reflect.DeepEqual(desired.Labels, expectedLabels) {
+		t.Errorf("expected labels %v, got %v", expectedLabels, desired.Labels)
+	}
+	expectedPodLabels := map[string]string{"cost-center": "1234", "app": "other", "deploymentConfig": "zync"}
+	if !reflect.DeepEqual(desired.Spec.Template.Labels, expectedPodLabels) {
+		t.Errorf("expected pod template labels %v, got %v", expectedPodLabels, desired.Spec.Template.Labels)
+	}
+
+	service := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "zync", Namespace: namespace}}
+	r.setCustomLabels(service)
+	if service.Labels["cost-center"] != "1234" {
+		t.Errorf("expected custom labels on the service, got %v", service.Labels)
+	}
+
+	existing := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "zync", Namespace: namespace},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"deploymentConfig": "zync", "pod": "label"}},
+			},
+		},
+	}
+	changed, err := deploymentConfigMutateFn(false, reconcilers.CreateOnlyMutator)(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Template.Labels["cost-center"] != "1234" || existing.Spec.Template.Labels["pod"] != "label" {
+		t.Errorf("expected the custom labels to be merged into the pod template, got %v", existing.Spec.Template.Labels)
+	}
+}
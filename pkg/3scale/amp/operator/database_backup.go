@@ -0,0 +1,560 @@
+package operator
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	// DatabaseBackupPVCName is the PVC the dumps are stored in when no S3 bucket is configured
+	DatabaseBackupPVCName = "database-backup"
+
+	databaseBackupDir = "/backup"
+)
+
+// databaseBackupScript dumps the database to a hidden file renamed once complete,
+// so an interrupted dump is never taken as a backup.
+// The dump command writes to stdout
+const databaseBackupScript = `set -o pipefail
+rm -f "${BACKUP_DIR}/.${DATABASE_HOST}-"*
+name="${BACKUP_PREFIX}-$(date -u +%%Y%%m%%d%%H%%M%%S).%s"
+%s > "${BACKUP_DIR}/.${name}"
+mv "${BACKUP_DIR}/.${name}" "${BACKUP_DIR}/${name}"
+echo "dumped ${name}"
+`
+
+// databaseBackupPruneScript keeps the BACKUP_RETENTION most recent dumps of the volume
+const databaseBackupPruneScript = `ls -1t "${BACKUP_DIR}/${DATABASE_HOST}-"* | tail -n +$((BACKUP_RETENTION + 1)) | xargs -r rm -f
+`
+
+// databaseBackupUploadScript uploads the dumps to the bucket, then keeps the
+// BACKUP_RETENTION most recent dumps of the database in the bucket
+const databaseBackupUploadScript = `
+require 'aws-sdk-s3'
+
+host = ENV['DATABASE_HOST']
+termination_log = '/dev/termination-log'
+
+begin
+  options = { region: ENV['AWS_REGION'] }
+  unless ENV['AWS_HOSTNAME'].to_s.empty?
+    protocol = ENV['AWS_PROTOCOL'].to_s.empty? ? 'https' : ENV['AWS_PROTOCOL']
+    options[:endpoint] = "#{protocol}://#{ENV['AWS_HOSTNAME']}"
+  end
+  options[:force_path_style] = true if ENV['AWS_PATH_STYLE'] == 'true'
+  bucket = Aws::S3::Resource.new(options).bucket(ENV['AWS_BUCKET'])
+
+  Dir.glob(File.join(ENV['BACKUP_DIR'], "#{host}-*")).each do |path|
+    bucket.object(File.basename(path)).upload_file(path)
+    puts "uploaded #{File.basename(path)}"
+  end
+
+  backups = bucket.objects(prefix: "#{host}-").sort_by(&:last_modified).reverse
+  backups.drop(ENV['BACKUP_RETENTION'].to_i).each do |object|
+    object.delete
+    puts "removed #{object.key}"
+  end
+rescue StandardError => e
+  File.write(termination_log, "#{e.class}: #{e.message}")
+  exit 1
+end
+`
+
+const (
+	mysqlDumpCommand    = `MYSQL_PWD="${MYSQL_ROOT_PASSWORD}" mysqldump --host="${DATABASE_HOST}" --user=root --single-transaction --routines --databases "${MYSQL_DATABASE}" | gzip`
+	postgresDumpCommand = `PGPASSWORD="${POSTGRESQL_PASSWORD}" pg_dump --host="${DATABASE_HOST}" --username="${POSTGRESQL_USER}" --format=custom "${POSTGRESQL_DATABASE}"`
+)
+
+// DatabaseBackupJobName returns the name of the job dumping the database
+// of the given deployment before upgrading to the given release
+func DatabaseBackupJobName(deploymentName, release string) string {
+	return fmt.Sprintf("%s-backup-%s", deploymentName, strings.ReplaceAll(release, ".", "-"))
+}
+
+// databaseBackupTarget is an internal database dumped by a backup job
+type databaseBackupTarget struct {
+	// DeploymentName is the database deployment, also the database service host
+	DeploymentName string
+	// Image is the image the database is deployed with
+	Image string
+	// Env holds the database credentials
+	Env         []v1.EnvVar
+	DumpCommand string
+	Extension   string
+}
+
+// DatabaseBackupReconciler dumps the internal system and zync databases when the
+// 3scale release recorded in the APIManager status differs from the operator release.
+// The dumps run before the images and deployments are upgraded, the reconciliation
+// waits for them to complete. A failed dump halts the upgrade until the failed job
+// is deleted, to retry, or the backup disabled. While the APIManager is hibernated,
+// the upgrade waits for it to be woken up.
+// External databases are user managed and not backed up
+type DatabaseBackupReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewDatabaseBackupReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *DatabaseBackupReconciler {
+	return &DatabaseBackupReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *DatabaseBackupReconciler) Reconcile() (reconcile.Result, error) {
+	deployed, err := r.deployedThreescaleRelease()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if deployed == "" || deployed == product.ThreescaleRelease || !r.apiManager.IsDatabaseBackupEnabled() {
+		return reconcile.Result{}, r.recordThreescaleVersion()
+	}
+
+	status := r.apiManager.Status.DatabaseBackup
+	completed := status != nil && status.ThreescaleVersion == product.ThreescaleRelease && status.Phase == appsv1alpha1.DatabaseBackupPhaseCompleted
+	// The databases are scaled down, the upgrade waits for the APIManager to be woken up
+	if r.apiManager.IsHibernated() && !completed {
+		return reconcile.Result{}, &helper.WaitError{Err: fmt.Errorf("the database backup before the upgrade to %s waits for the APIManager to be woken up", product.ThreescaleRelease)}
+	}
+
+	if status == nil || status.ThreescaleVersion != product.ThreescaleRelease {
+		return r.startBackup(deployed)
+	}
+
+	return r.reconcileBackup(status.DeepCopy(), deployed)
+}
+
+// deployedThreescaleRelease returns the 3scale release the components are deployed with.
+// Before the release was recorded in the status, it is read from the system image tag.
+// Empty on new installations
+func (r *DatabaseBackupReconciler) deployedThreescaleRelease() (string, error) {
+	if r.apiManager.Status.ThreescaleVersion != "" {
+		return r.apiManager.Status.ThreescaleVersion, nil
+	}
+
+	systemApp := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: component.SystemAppDeploymentName, Namespace: r.apiManager.Namespace}, systemApp)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	for _, trigger := range systemApp.Spec.Triggers {
+		if trigger.Type == appsv1.DeploymentTriggerOnImageChange && trigger.ImageChangeParams != nil {
+			name := trigger.ImageChangeParams.From.Name
+			return name[strings.LastIndex(name, ":")+1:], nil
+		}
+	}
+	return "", nil
+}
+
+func (r *DatabaseBackupReconciler) startBackup(deployed string) (reconcile.Result, error) {
+	if r.apiManager.IsExternal(appsv1alpha1.SystemDatabase) {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DatabaseBackupSkipped", "the external system database is user managed, it is not backed up before the upgrade to %s", product.ThreescaleRelease)
+	}
+	if r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase) {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DatabaseBackupSkipped", "the external zync database is user managed, it is not backed up before the upgrade to %s", product.ThreescaleRelease)
+	}
+
+	targets, err := r.backupTargets()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The jobs of a previous backup would be left behind
+	if previous := r.apiManager.Status.DatabaseBackup; previous != nil {
+		for _, jobName := range previous.JobNames {
+			err := r.deleteJob(jobName)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	now := metav1.Now()
+	status := &appsv1alpha1.DatabaseBackupStatus{
+		Phase:             appsv1alpha1.DatabaseBackupPhaseRunning,
+		ThreescaleVersion: product.ThreescaleRelease,
+		StartTime:         &now,
+	}
+	for _, target := range targets {
+		status.JobNames = append(status.JobNames, DatabaseBackupJobName(target.DeploymentName, product.ThreescaleRelease))
+	}
+	err = r.writeBackupStatus(status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DatabaseBackupStarted", "backing up the internal databases before the upgrade from %s to %s", deployed, product.ThreescaleRelease)
+	return r.reconcileBackup(status, deployed)
+}
+
+// reconcileBackup runs the dump jobs one after the other, so the backup volume
+// is never mounted by two jobs
+func (r *DatabaseBackupReconciler) reconcileBackup(status *appsv1alpha1.DatabaseBackupStatus, deployed string) (reconcile.Result, error) {
+	targets, err := r.backupTargets()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var uploadImage string
+	backupSpec := r.apiManager.Spec.Databases.Backup
+	if backupSpec.S3 != nil {
+		err := validateS3ConfigurationSecret(backupSpec.S3.SecretRef.Name, r.apiManager.Namespace, r.Client())
+		if err != nil {
+			return r.backupFailed(status, err.Error())
+		}
+
+		// The dumps are uploaded with the system image resolved in system-sidekiq
+		sidekiq := &appsv1.DeploymentConfig{}
+		err = r.GetResource(types.NamespacedName{Name: component.SystemSidekiqName, Namespace: r.apiManager.Namespace}, sidekiq)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return reconcile.Result{}, &helper.WaitError{Err: fmt.Errorf("the database backup upload waits for '%s'", component.SystemSidekiqName)}
+			}
+			return reconcile.Result{}, err
+		}
+		if sidekiq.Spec.Template != nil && len(sidekiq.Spec.Template.Spec.Containers) > 0 {
+			uploadImage = sidekiq.Spec.Template.Spec.Containers[0].Image
+		}
+	} else {
+		err := r.ReconcileResource(&v1.PersistentVolumeClaim{}, DatabaseBackupPVC(r.apiManager), reconcilers.CreateOnlyMutator)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	for _, target := range targets {
+		err := r.waitForDatabase(target.DeploymentName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		jobName := DatabaseBackupJobName(target.DeploymentName, status.ThreescaleVersion)
+		err = r.ReconcileResource(&batchv1.Job{}, DatabaseBackupJob(jobName, r.apiManager, target, deployed, uploadImage), reconcilers.CreateOnlyMutator)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		job := &batchv1.Job{}
+		err = r.GetResource(types.NamespacedName{Name: jobName, Namespace: r.apiManager.Namespace}, job)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if job.Status.Succeeded > 0 {
+			continue
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+				msg, err := helper.JobTerminationMessage(r.Client(), job)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				if msg == "" {
+					msg = condition.Message
+				}
+				return r.backupFailed(status, fmt.Sprintf("job '%s' failed: %s", job.Name, msg))
+			}
+		}
+
+		// Retried once the failed job has been deleted
+		if status.Phase != appsv1alpha1.DatabaseBackupPhaseRunning {
+			status.Phase = appsv1alpha1.DatabaseBackupPhaseRunning
+			status.Message = ""
+			err := r.writeBackupStatus(status)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		return reconcile.Result{}, &helper.WaitError{Err: fmt.Errorf("waiting for the database backup job '%s' to complete", jobName)}
+	}
+
+	if status.Phase != appsv1alpha1.DatabaseBackupPhaseCompleted {
+		now := metav1.Now()
+		status.Phase = appsv1alpha1.DatabaseBackupPhaseCompleted
+		status.CompletionTime = &now
+		status.Message = ""
+		err := r.writeBackupStatus(status)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DatabaseBackupCompleted", "the internal databases have been backed up, upgrading to %s", status.ThreescaleVersion)
+	}
+
+	return reconcile.Result{}, r.recordThreescaleVersion()
+}
+
+// backupTargets returns the internal databases deployed. The dumps use the
+// image the database is currently deployed with, and the credentials resolved
+// by the options providers
+func (r *DatabaseBackupReconciler) backupTargets() ([]databaseBackupTarget, error) {
+	targets := []databaseBackupTarget{}
+
+	if r.apiManager.IsSystemMysqlEnabled() {
+		mysql, err := SystemMySQL(r.apiManager, r.Client())
+		if err != nil {
+			return nil, err
+		}
+		target, err := r.backupTarget(mysql.DeploymentConfig(), mysqlDumpCommand, "sql.gz")
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			targets = append(targets, *target)
+		}
+	}
+
+	if r.apiManager.IsSystemPostgreSQLEnabled() {
+		postgresql, err := SystemPostgreSQL(r.apiManager, r.Client())
+		if err != nil {
+			return nil, err
+		}
+		target, err := r.backupTarget(postgresql.DeploymentConfig(), postgresDumpCommand, "dump")
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			targets = append(targets, *target)
+		}
+	}
+
+	if !r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase) {
+		zync, err := Zync(r.apiManager, r.Client())
+		if err != nil {
+			return nil, err
+		}
+		target, err := r.backupTarget(zync.DatabaseDeploymentConfig(), postgresDumpCommand, "dump")
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			targets = append(targets, *target)
+		}
+	}
+
+	return targets, nil
+}
+
+// backupTarget returns nil when the database is not deployed, there is nothing to back up
+func (r *DatabaseBackupReconciler) backupTarget(desired *appsv1.DeploymentConfig, dumpCommand, extension string) (*databaseBackupTarget, error) {
+	existing := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: desired.Name, Namespace: r.apiManager.Namespace}, existing)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if existing.Spec.Template == nil || len(existing.Spec.Template.Spec.Containers) == 0 ||
+		desired.Spec.Template == nil || len(desired.Spec.Template.Spec.Containers) == 0 {
+		return nil, nil
+	}
+
+	return &databaseBackupTarget{
+		DeploymentName: desired.Name,
+		Image:          existing.Spec.Template.Spec.Containers[0].Image,
+		Env:            desired.Spec.Template.Spec.Containers[0].Env,
+		DumpCommand:    dumpCommand,
+		Extension:      extension,
+	}, nil
+}
+
+// waitForDatabase restores the replicas of a database left scaled down by the hibernation,
+// as the deployments are woken up after the backup. The dump waits for the database to be available
+func (r *DatabaseBackupReconciler) waitForDatabase(deploymentName string) error {
+	dc := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: deploymentName, Namespace: r.apiManager.Namespace}, dc)
+	if err != nil {
+		return err
+	}
+
+	update, err := wakeUpReplicasMutator(nil, dc)
+	if err != nil {
+		return err
+	}
+	if update {
+		err := r.UpdateResource(dc)
+		if err != nil {
+			return err
+		}
+	}
+
+	if dc.Status.AvailableReplicas == 0 {
+		return &helper.WaitError{Err: fmt.Errorf("the database backup waits for '%s' to be available", deploymentName)}
+	}
+	return nil
+}
+
+func (r *DatabaseBackupReconciler) backupFailed(status *appsv1alpha1.DatabaseBackupStatus, msg string) (reconcile.Result, error) {
+	if status.Phase != appsv1alpha1.DatabaseBackupPhaseFailed || status.Message != msg {
+		status.Phase = appsv1alpha1.DatabaseBackupPhaseFailed
+		status.Message = msg
+		err := r.writeBackupStatus(status)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DatabaseBackupFailed", "upgrade to %s halted, the database backup failed: %s", status.ThreescaleVersion, msg)
+	}
+
+	return reconcile.Result{}, &helper.WaitError{Err: fmt.Errorf("upgrade to %s halted, the database backup failed: %s", status.ThreescaleVersion, msg)}
+}
+
+func (r *DatabaseBackupReconciler) recordThreescaleVersion() error {
+	if r.apiManager.Status.ThreescaleVersion == product.ThreescaleRelease {
+		return nil
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.ThreescaleVersion = product.ThreescaleRelease
+		return nil
+	})
+	return err
+}
+
+// writeBackupStatus writes a copy of the status, as the status is changed by the caller
+// after being written and the status writer compares it with the previous one
+func (r *DatabaseBackupReconciler) writeBackupStatus(status *appsv1alpha1.DatabaseBackupStatus) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.DatabaseBackup = status.DeepCopy()
+		return nil
+	})
+	return err
+}
+
+// DatabaseBackupPVC is the volume the dumps are stored in when no S3 bucket is configured.
+// It is kept when the backup is disabled
+func DatabaseBackupPVC(apimanager *appsv1alpha1.APIManager) *v1.PersistentVolumeClaim {
+	var storageClassName *string
+	storageRequests := resource.MustParse(appsv1alpha1.DefaultDatabaseBackupPVCSize)
+	if pvcSpec := apimanager.Spec.Databases.Backup.PersistentVolumeClaim; pvcSpec != nil {
+		storageClassName = pvcSpec.StorageClassName
+		if pvcSpec.Resources != nil {
+			storageRequests = pvcSpec.Resources.Requests
+		}
+	}
+
+	return &v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "PersistentVolumeClaim",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DatabaseBackupPVCName,
+			Labels: map[string]string{
+				"app": *apimanager.Spec.AppLabel,
+			},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			StorageClassName: storageClassName,
+			AccessModes: []v1.PersistentVolumeAccessMode{
+				v1.ReadWriteOnce,
+			},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: storageRequests,
+				},
+			},
+		},
+	}
+}
+
+// DatabaseBackupJob dumps the database of the target with the database image.
+// With an upload image, the dump is written to a scratch volume by an init container
+// and uploaded to the S3 bucket of the APIManager backup spec. Otherwise it is
+// written to the backup PVC
+func DatabaseBackupJob(name string, apimanager *appsv1alpha1.APIManager, target databaseBackupTarget, deployed, uploadImage string) *batchv1.Job {
+	var backoffLimit int32 = 1
+
+	backupEnv := []v1.EnvVar{
+		helper.EnvVarFromValue("DATABASE_HOST", target.DeploymentName),
+		helper.EnvVarFromValue("BACKUP_DIR", databaseBackupDir),
+		helper.EnvVarFromValue("BACKUP_PREFIX", fmt.Sprintf("%s-%s", target.DeploymentName, deployed)),
+		helper.EnvVarFromValue("BACKUP_RETENTION", fmt.Sprintf("%d", apimanager.DatabaseBackupRetention())),
+	}
+
+	dump := v1.Container{
+		Name:                     "database-backup",
+		Image:                    target.Image,
+		Command:                  []string{"/bin/bash", "-c", "-e"},
+		Args:                     []string{fmt.Sprintf(databaseBackupScript, target.Extension, target.DumpCommand)},
+		Env:                      append(append([]v1.EnvVar{}, target.Env...), backupEnv...),
+		VolumeMounts:             []v1.VolumeMount{{Name: "backup", MountPath: databaseBackupDir}},
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+		ImagePullPolicy:          v1.PullIfNotPresent,
+	}
+
+	podSpec := v1.PodSpec{
+		RestartPolicy:      v1.RestartPolicyNever,
+		ServiceAccountName: apimanager.ServiceAccountName(appsv1alpha1.AmpServiceAccountName),
+	}
+	if uploadImage != "" {
+		upload := v1.Container{
+			Name:                     "database-backup-upload",
+			Image:                    uploadImage,
+			Command:                  []string{"bundle", "exec", "ruby", "-e", databaseBackupUploadScript},
+			Env:                      append(backupEnv, component.S3ConfigurationEnvVars(apimanager.Spec.Databases.Backup.S3.SecretRef.Name)...),
+			VolumeMounts:             []v1.VolumeMount{{Name: "backup", MountPath: databaseBackupDir, ReadOnly: true}},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+		}
+		podSpec.InitContainers = []v1.Container{dump}
+		podSpec.Containers = []v1.Container{upload}
+		podSpec.Volumes = []v1.Volume{{
+			Name:         "backup",
+			VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+		}}
+	} else {
+		dump.Args[0] += databaseBackupPruneScript
+		podSpec.Containers = []v1.Container{dump}
+		podSpec.Volumes = []v1.Volume{{
+			Name: "backup",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: DatabaseBackupPVCName},
+			},
+		}}
+	}
+	helper.ApplyJobPodTemplateOptions(&podSpec, apimanager.Spec.JobsTemplate.JobPodTemplateOptions())
+	template := v1.PodTemplateSpec{Spec: podSpec}
+	if apimanager.IsRestrictedPodSecurityStandard() {
+		helper.SetRestrictedSecurityContexts(&template)
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": *apimanager.Spec.AppLabel,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template:     template,
+		},
+	}
+}
@@ -0,0 +1,298 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestDatabaseBackupJob(t *testing.T) {
+	apimanager := basicApimanager()
+	apimanager.Spec.Databases = &appsv1alpha1.DatabasesSpec{
+		Backup: &appsv1alpha1.DatabaseBackupSpec{
+			Enabled: true,
+			S3:      &appsv1alpha1.DatabaseBackupS3Spec{SecretRef: v1.LocalObjectReference{Name: "s3-config"}},
+		},
+	}
+	target := databaseBackupTarget{
+		DeploymentName: component.ZyncDatabaseDeploymentName,
+		Image:          "postgresql:10",
+		Env:            []v1.EnvVar{helper.EnvVarFromValue("POSTGRESQL_USER", "zync")},
+		DumpCommand:    postgresDumpCommand,
+		Extension:      "dump",
+	}
+
+	job := DatabaseBackupJob("backup", apimanager, target, "2.12", "system:2.12")
+
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.InitContainers) != 1 || podSpec.InitContainers[0].Image != "postgresql:10" {
+		t.Fatalf("expected the dump init container, got %v", podSpec.InitContainers)
+	}
+	if helper.FindEnvVar(podSpec.InitContainers[0].Env, "POSTGRESQL_USER") < 0 {
+		t.Error("expected the database credentials in the dump container")
+	}
+	if len(podSpec.Containers) != 1 || podSpec.Containers[0].Image != "system:2.12" {
+		t.Fatalf("expected the upload container, got %v", podSpec.Containers)
+	}
+	upload := podSpec.Containers[0]
+	for _, expected := range component.S3ConfigurationEnvVars("s3-config") {
+		if helper.FindEnvVar(upload.Env, expected.Name) < 0 {
+			t.Errorf("expected env var %s", expected.Name)
+		}
+	}
+	if helper.FindEnvVar(upload.Env, "POSTGRESQL_USER") >= 0 {
+		t.Error("unexpected database credentials in the upload container")
+	}
+	if len(podSpec.Volumes) != 1 || podSpec.Volumes[0].EmptyDir == nil {
+		t.Errorf("expected an emptyDir backup volume, got %v", podSpec.Volumes)
+	}
+
+	apimanager.Spec.Databases.Backup.S3 = nil
+	job = DatabaseBackupJob("backup", apimanager, target, "2.12", "")
+
+	podSpec = job.Spec.Template.Spec
+	if len(podSpec.InitContainers) != 0 || len(podSpec.Containers) != 1 {
+		t.Fatalf("expected a single dump container, got %v", podSpec.Containers)
+	}
+	if len(podSpec.Volumes) != 1 || podSpec.Volumes[0].PersistentVolumeClaim == nil ||
+		podSpec.Volumes[0].PersistentVolumeClaim.ClaimName != DatabaseBackupPVCName {
+		t.Errorf("expected the backup PVC volume, got %v", podSpec.Volumes)
+	}
+}
+
+func TestDatabaseBackupReconciler(t *testing.T) {
+	log := logf.Log.WithName("operator_test")
+
+	deploymentConfig := func(name, image string, triggers ...appsv1.DeploymentTriggerPolicy) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: appsv1.DeploymentConfigSpec{
+				Triggers: triggers,
+				Template: &v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: []v1.Container{{Name: name, Image: image}}},
+				},
+			},
+			Status: appsv1.DeploymentConfigStatus{AvailableReplicas: 1},
+		}
+	}
+
+	deployedObjects := func() []runtime.Object {
+		return []runtime.Object{
+			deploymentConfig(component.SystemAppDeploymentName, "system:2.12", appsv1.DeploymentTriggerPolicy{
+				Type: appsv1.DeploymentTriggerOnImageChange,
+				ImageChangeParams: &appsv1.DeploymentTriggerImageChangeParams{
+					From: v1.ObjectReference{Kind: "ImageStreamTag", Name: "amp-system:2.12"},
+				},
+			}),
+			deploymentConfig(component.SystemMySQLDeploymentName, "mysql:2.12"),
+			deploymentConfig(component.ZyncDatabaseDeploymentName, "postgresql:2.12"),
+		}
+	}
+
+	newReconciler := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, objs ...runtime.Object) (*DatabaseBackupReconciler, client.Client) {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		clientAPIReader := fake.NewFakeClient(objs...)
+		clientset := fakeclientset.NewSimpleClientset()
+		recorder := record.NewFakeRecorder(10000)
+
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+		return NewDatabaseBackupReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)), cl
+	}
+
+	newAPIManager := func(backupEnabled bool) *appsv1alpha1.APIManager {
+		apimanager := basicApimanager()
+		apimanager.Spec.Databases = &appsv1alpha1.DatabasesSpec{
+			Backup: &appsv1alpha1.DatabaseBackupSpec{Enabled: backupEnabled},
+		}
+		return apimanager
+	}
+
+	setJobStatus := func(subT *testing.T, cl client.Client, name string, mutate func(*batchv1.Job)) {
+		job := &batchv1.Job{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		mutate(job)
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+	}
+
+	t.Run("new installation", func(subT *testing.T) {
+		apimanager := newAPIManager(true)
+		backupReconciler, _ := newReconciler(subT, apimanager)
+
+		_, err := backupReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.ThreescaleVersion != product.ThreescaleRelease {
+			subT.Errorf("expected version %s, got %s", product.ThreescaleRelease, apimanager.Status.ThreescaleVersion)
+		}
+		if apimanager.Status.DatabaseBackup != nil {
+			subT.Errorf("unexpected backup: %v", apimanager.Status.DatabaseBackup)
+		}
+	})
+
+	t.Run("backup disabled", func(subT *testing.T) {
+		apimanager := newAPIManager(false)
+		backupReconciler, _ := newReconciler(subT, apimanager, deployedObjects()...)
+
+		_, err := backupReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.ThreescaleVersion != product.ThreescaleRelease {
+			subT.Errorf("expected version %s, got %s", product.ThreescaleRelease, apimanager.Status.ThreescaleVersion)
+		}
+	})
+
+	t.Run("upgrade", func(subT *testing.T) {
+		apimanager := newAPIManager(true)
+		backupReconciler, cl := newReconciler(subT, apimanager, deployedObjects()...)
+
+		_, err := backupReconciler.Reconcile()
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+		status := apimanager.Status.DatabaseBackup
+		if status == nil || status.Phase != appsv1alpha1.DatabaseBackupPhaseRunning || len(status.JobNames) != 2 {
+			subT.Fatalf("unexpected status: %v", status)
+		}
+		if apimanager.Status.ThreescaleVersion != "" {
+			subT.Error("unexpected version recorded during the backup")
+		}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: DatabaseBackupPVCName, Namespace: namespace}, &v1.PersistentVolumeClaim{})
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for _, jobName := range status.JobNames {
+			setJobStatus(subT, cl, jobName, func(job *batchv1.Job) { job.Status.Succeeded = 1 })
+			_, err = backupReconciler.Reconcile()
+		}
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.DatabaseBackup.Phase != appsv1alpha1.DatabaseBackupPhaseCompleted {
+			subT.Errorf("unexpected status: %v", apimanager.Status.DatabaseBackup)
+		}
+		if apimanager.Status.ThreescaleVersion != product.ThreescaleRelease {
+			subT.Errorf("expected version %s, got %s", product.ThreescaleRelease, apimanager.Status.ThreescaleVersion)
+		}
+	})
+
+	t.Run("failed job halts the upgrade", func(subT *testing.T) {
+		apimanager := newAPIManager(true)
+		backupReconciler, cl := newReconciler(subT, apimanager, deployedObjects()...)
+
+		_, err := backupReconciler.Reconcile()
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+
+		jobName := DatabaseBackupJobName(component.SystemMySQLDeploymentName, product.ThreescaleRelease)
+		setJobStatus(subT, cl, jobName, func(job *batchv1.Job) {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+		})
+
+		_, err = backupReconciler.Reconcile()
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+		if !apimanager.IsDatabaseBackupFailed() {
+			subT.Errorf("unexpected status: %v", apimanager.Status.DatabaseBackup)
+		}
+		if apimanager.Status.ThreescaleVersion != "" {
+			subT.Error("unexpected version recorded after the failed backup")
+		}
+	})
+
+	t.Run("external databases are skipped", func(subT *testing.T) {
+		apimanager := newAPIManager(true)
+		apimanager.Spec.ExternalComponents = &appsv1alpha1.ExternalComponentsSpec{
+			System: &appsv1alpha1.ExternalSystemComponents{Database: &[]bool{true}[0]},
+			Zync:   &appsv1alpha1.ExternalZyncComponents{Database: &[]bool{true}[0]},
+		}
+		backupReconciler, _ := newReconciler(subT, apimanager, deployedObjects()...)
+
+		_, err := backupReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		status := apimanager.Status.DatabaseBackup
+		if status == nil || status.Phase != appsv1alpha1.DatabaseBackupPhaseCompleted || len(status.JobNames) != 0 {
+			subT.Errorf("unexpected status: %v", status)
+		}
+		if apimanager.Status.ThreescaleVersion != product.ThreescaleRelease {
+			subT.Errorf("expected version %s, got %s", product.ThreescaleRelease, apimanager.Status.ThreescaleVersion)
+		}
+	})
+
+	t.Run("hibernated upgrade", func(subT *testing.T) {
+		apimanager := newAPIManager(true)
+		apimanager.Spec.Hibernated = true
+		objs := deployedObjects()
+		for _, obj := range objs[1:] {
+			dc := obj.(*appsv1.DeploymentConfig)
+			dc.Annotations = map[string]string{HibernatedReplicasAnnotation: "1"}
+			dc.Status.AvailableReplicas = 0
+		}
+		backupReconciler, cl := newReconciler(subT, apimanager, objs...)
+
+		_, err := backupReconciler.Reconcile()
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+		if apimanager.Status.DatabaseBackup != nil {
+			subT.Fatalf("unexpected backup while hibernated: %v", apimanager.Status.DatabaseBackup)
+		}
+
+		// Woken up, the databases are scaled up before being dumped
+		apimanager.Spec.Hibernated = false
+		_, err = backupReconciler.Reconcile()
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+		mysql := &appsv1.DeploymentConfig{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: component.SystemMySQLDeploymentName, Namespace: namespace}, mysql)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if _, ok := mysql.Annotations[HibernatedReplicasAnnotation]; ok || mysql.Spec.Replicas != 1 {
+			subT.Errorf("expected woken up database, got %d replicas and annotations %v", mysql.Spec.Replicas, mysql.Annotations)
+		}
+		jobName := DatabaseBackupJobName(component.SystemMySQLDeploymentName, product.ThreescaleRelease)
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: jobName, Namespace: namespace}, &batchv1.Job{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("expected the job to wait for the database, got %v", err)
+		}
+	})
+}
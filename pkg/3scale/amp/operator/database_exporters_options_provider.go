@@ -0,0 +1,66 @@
+package operator
+
+import (
+	"fmt"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type DatabaseExportersOptionsProvider struct {
+	apimanager *appsv1alpha1.APIManager
+	namespace  string
+	client     client.Client
+	options    *component.DatabaseExportersOptions
+}
+
+func NewDatabaseExportersOptionsProvider(apimanager *appsv1alpha1.APIManager, namespace string, client client.Client) *DatabaseExportersOptionsProvider {
+	return &DatabaseExportersOptionsProvider{
+		apimanager: apimanager,
+		namespace:  namespace,
+		client:     client,
+		options:    component.NewDatabaseExportersOptions(),
+	}
+}
+
+func (d *DatabaseExportersOptionsProvider) GetDatabaseExportersOptions() (*component.DatabaseExportersOptions, error) {
+	d.options.Namespace = d.namespace
+	d.options.RedisExporterImage = d.apimanager.DefaultImageURL(RedisExporterImageURL())
+	d.options.MySQLExporterImage = d.apimanager.DefaultImageURL(MySQLExporterImageURL())
+	d.options.PostgreSQLExporterImage = d.apimanager.DefaultImageURL(PostgreSQLExporterImageURL())
+	d.options.CommonLabels = d.commonLabels()
+
+	d.setResourceRequirementsOptions()
+
+	if d.apimanager.IsSystemPostgreSQLEnabled() {
+		// The database name is only available in the system-database secret URL
+		postgresqlOptions, err := NewSystemPostgresqlOptionsProvider(d.apimanager, d.namespace, d.client).GetSystemPostgreSQLOptions()
+		if err != nil {
+			return nil, fmt.Errorf("GetDatabaseExportersOptions reading system postgresql options: %w", err)
+		}
+		d.options.SystemDatabaseName = postgresqlOptions.DatabaseName
+	}
+
+	err := d.options.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("GetDatabaseExportersOptions validating: %w", err)
+	}
+	return d.options, nil
+}
+
+func (d *DatabaseExportersOptionsProvider) setResourceRequirementsOptions() {
+	if *d.apimanager.Spec.ResourceRequirementsEnabled {
+		d.options.ResourceRequirements = component.DefaultDatabaseExporterResourceRequirements()
+	} else {
+		d.options.ResourceRequirements = v1.ResourceRequirements{}
+	}
+}
+
+func (d *DatabaseExportersOptionsProvider) commonLabels() map[string]string {
+	return map[string]string{
+		"app": *d.apimanager.Spec.AppLabel,
+	}
+}
@@ -0,0 +1,114 @@
+package operator
+
+import (
+	"reflect"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DatabaseExportersReconciler reconciles the prometheus exporters
+// of the internal databases. Exporters of external or disabled
+// databases are removed.
+type DatabaseExportersReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewDatabaseExportersReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *DatabaseExportersReconciler {
+	return &DatabaseExportersReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *DatabaseExportersReconciler) Reconcile() (reconcile.Result, error) {
+	opts, err := NewDatabaseExportersOptionsProvider(r.apiManager, r.apiManager.Namespace, r.Client()).GetDatabaseExportersOptions()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	exportersEnabled := r.apiManager.IsDatabaseExportersEnabled()
+
+	exporters := []struct {
+		exporter      *component.DatabaseExporter
+		componentName string
+		enabled       bool
+	}{
+		{component.NewBackendRedisExporter(opts), "backend", !r.apiManager.IsExternal(appsv1alpha1.BackendRedis)},
+		{component.NewSystemRedisExporter(opts), "system", !r.apiManager.IsExternal(appsv1alpha1.SystemRedis)},
+		{component.NewSystemMySQLExporter(opts), "system", r.apiManager.IsSystemMysqlEnabled()},
+		{component.NewSystemPostgreSQLExporter(opts), "system", r.apiManager.IsSystemPostgreSQLEnabled()},
+		{component.NewZyncDatabaseExporter(opts), "zync", !r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase)},
+	}
+
+	for _, e := range exporters {
+		enabled := exportersEnabled && e.enabled && r.apiManager.IsComponentMetricsEnabled(e.componentName)
+		err = r.reconcileExporter(e.exporter, enabled)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *DatabaseExportersReconciler) reconcileExporter(exporter *component.DatabaseExporter, enabled bool) error {
+	dc := exporter.DeploymentConfig()
+	service := exporter.Service()
+	serviceMonitor := exporter.ServiceMonitor()
+	prometheusRule := exporter.PrometheusRules()
+
+	if !enabled {
+		for _, obj := range []common.KubernetesObject{dc, service, serviceMonitor, prometheusRule} {
+			common.TagObjectToDelete(obj)
+		}
+	}
+
+	dcMutator := reconcilers.DeploymentConfigMutator(
+		reconcilers.DeploymentConfigContainerResourcesMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		databaseExporterContainerMutator,
+	)
+	err := r.ReconcileDeploymentConfig(dc, dcMutator)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileService(service, reconcilers.CreateOnlyMutator)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileServiceMonitor(serviceMonitor, reconcilers.CreateOnlyMutator)
+	if err != nil {
+		return err
+	}
+
+	return r.ReconcilePrometheusRules(prometheusRule, reconcilers.CreateOnlyMutator)
+}
+
+// databaseExporterContainerMutator reconciles the exporter image and
+// the connection settings of the exporter container
+func databaseExporterContainerMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	desiredContainer := &desired.Spec.Template.Spec.Containers[0]
+	existingContainer := &existing.Spec.Template.Spec.Containers[0]
+
+	if existingContainer.Image != desiredContainer.Image {
+		existingContainer.Image = desiredContainer.Image
+		update = true
+	}
+
+	if !reflect.DeepEqual(existingContainer.Env, desiredContainer.Env) {
+		existingContainer.Env = desiredContainer.Env
+		update = true
+	}
+
+	return update, nil
+}
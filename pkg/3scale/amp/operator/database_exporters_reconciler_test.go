@@ -0,0 +1,180 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func testDatabaseExportersAPIManager(databaseExporters bool, externalComponents *appsv1alpha1.ExternalComponentsSpec) (*appsv1alpha1.APIManager, error) {
+	var (
+		appLabel       = "someLabel"
+		trueValue      = true
+		wildcardDomain = "test.3scale.net"
+		tenantName     = "someTenant"
+	)
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-apimanager",
+			Namespace: "operator-unittest",
+		},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{
+				AppLabel:                     &appLabel,
+				ImageStreamTagImportInsecure: &trueValue,
+				ResourceRequirementsEnabled:  &trueValue,
+				WildcardDomain:               wildcardDomain,
+				TenantName:                   &tenantName,
+			},
+			Monitoring: &appsv1alpha1.MonitoringSpec{
+				Enabled:           true,
+				DatabaseExporters: &databaseExporters,
+			},
+			ExternalComponents: externalComponents,
+		},
+	}
+	_, err := apimanager.SetDefaults()
+	return apimanager, err
+}
+
+func TestDatabaseExportersReconciler(t *testing.T) {
+	var (
+		namespace = "operator-unittest"
+		trueValue = true
+		log       = logf.Log.WithName("operator_test")
+	)
+
+	cases := []struct {
+		testName           string
+		databaseExporters  bool
+		externalComponents *appsv1alpha1.ExternalComponentsSpec
+		expectedExporters  []string
+		deletedExporters   []string
+	}{
+		{"disabled", false, nil, nil, []string{
+			component.BackendRedisExporterName,
+			component.SystemRedisExporterName,
+			component.SystemMySQLExporterName,
+			component.SystemPostgreSQLExporterName,
+			component.ZyncDatabaseExporterName,
+		}},
+		{"enabled", true, nil, []string{
+			component.BackendRedisExporterName,
+			component.SystemRedisExporterName,
+			component.SystemMySQLExporterName,
+			component.ZyncDatabaseExporterName,
+		}, []string{
+			component.SystemPostgreSQLExporterName,
+		}},
+		{"external databases", true, &appsv1alpha1.ExternalComponentsSpec{
+			System: &appsv1alpha1.ExternalSystemComponents{Database: &trueValue},
+			Zync:   &appsv1alpha1.ExternalZyncComponents{Database: &trueValue},
+		}, []string{
+			component.BackendRedisExporterName,
+			component.SystemRedisExporterName,
+		}, []string{
+			component.SystemMySQLExporterName,
+			component.SystemPostgreSQLExporterName,
+			component.ZyncDatabaseExporterName,
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager, err := testDatabaseExportersAPIManager(tc.databaseExporters, tc.externalComponents)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			s := scheme.Scheme
+			s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+			if err := appsv1.AddToScheme(s); err != nil {
+				subT.Fatal(err)
+			}
+			if err := monitoringv1.AddToScheme(s); err != nil {
+				subT.Fatal(err)
+			}
+
+			// All the exporters exist before reconciling,
+			// the disabled ones must be removed.
+			objs := []runtime.Object{}
+			for _, name := range tc.deletedExporters {
+				objs = append(objs, &appsv1.DeploymentConfig{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+				objs = append(objs, &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+			}
+
+			cl := fake.NewFakeClient(objs...)
+			clientAPIReader := fake.NewFakeClient(objs...)
+			clientset := fakeclientset.NewSimpleClientset()
+			recorder := record.NewFakeRecorder(10000)
+
+			baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+			baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+			_, err = NewDatabaseExportersReconciler(baseAPIManagerLogicReconciler).Reconcile()
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			for _, name := range tc.expectedExporters {
+				for _, obj := range []runtime.Object{&appsv1.DeploymentConfig{}, &v1.Service{}} {
+					err = cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+					if err != nil {
+						subT.Errorf("error fetching %T %s: %v", obj, name, err)
+					}
+				}
+			}
+
+			for _, name := range tc.deletedExporters {
+				for _, obj := range []runtime.Object{&appsv1.DeploymentConfig{}, &v1.Service{}} {
+					err = cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+					if !errors.IsNotFound(err) {
+						subT.Errorf("expected %T %s to be deleted, got: %v", obj, name, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestZyncDatabaseExporterRules(t *testing.T) {
+	opts := &component.DatabaseExportersOptions{Namespace: "operator-unittest"}
+
+	alerts := map[string]bool{}
+	for _, group := range component.NewZyncDatabaseExporter(opts).PrometheusRules().Spec.Groups {
+		for _, rule := range group.Rules {
+			alerts[rule.Alert] = true
+		}
+	}
+
+	for _, alert := range []string{"ThreescaleZyncDatabaseConnectionsHigh", "ThreescaleZyncDatabaseTableBloatHigh"} {
+		if !alerts[alert] {
+			t.Errorf("expected alert %s", alert)
+		}
+	}
+
+	for _, group := range component.NewSystemPostgreSQLExporter(opts).PrometheusRules().Spec.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert == "ThreescaleSystemPostgreSQLTableBloatHigh" {
+				t.Error("unexpected table bloat alert for the system database")
+			}
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package operator
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DebugContainersAnnotation requests ephemeral debug containers to be
+	// attached to the running pods of the listed components.
+	// The value is a comma separated list of component=image pairs, where the component
+	// is the DeploymentConfig name, e.g. "backend-worker=registry/debug-image:tag"
+	DebugContainersAnnotation = "apps.3scale.net/debug"
+
+	// DebugContainersTTLAnnotation sets how long the debug containers run, as a duration.
+	// Defaults to DefaultDebugContainersTTL
+	DebugContainersTTLAnnotation = "apps.3scale.net/debug-ttl"
+
+	// DebugContainersExpiresAtAnnotation is set by the operator when the debug
+	// request is first processed. The debug annotations are removed once expired.
+	DebugContainersExpiresAtAnnotation = "apps.3scale.net/debug-expires-at"
+
+	DefaultDebugContainersTTL = time.Hour
+	MaxDebugContainersTTL     = 24 * time.Hour
+
+	// Pods created after the debug request was processed, e.g. on rollouts,
+	// get the debug containers on the next check
+	debugContainersRequeueDelay = time.Minute
+
+	debugContainerNamePrefix = "debugger-"
+)
+
+// DebugContainersReconciler attaches the ephemeral debug containers requested
+// with DebugContainersAnnotation. The containers share the process namespace
+// of the component container and exit when the request expires.
+type DebugContainersReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	// podsClient is nil when the debug containers are not enabled in the operator
+	podsClient corev1client.PodsGetter
+}
+
+func NewDebugContainersReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler, podsClient corev1client.PodsGetter) *DebugContainersReconciler {
+	return &DebugContainersReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		podsClient:                    podsClient,
+	}
+}
+
+func (r *DebugContainersReconciler) Reconcile() (reconcile.Result, error) {
+	val, ok := r.apiManager.Annotations[DebugContainersAnnotation]
+	if !ok {
+		if _, ok := r.apiManager.Annotations[DebugContainersExpiresAtAnnotation]; ok {
+			return r.removeDebugAnnotations()
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if r.podsClient == nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DebugContainersDisabled",
+			"'%s' annotation ignored, debug containers are not enabled in the operator", DebugContainersAnnotation)
+		return r.removeDebugAnnotations()
+	}
+
+	images, err := ParseDebugContainersAnnotation(val)
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DebugContainersError", "invalid '%s' annotation: %s", DebugContainersAnnotation, err.Error())
+		return r.removeDebugAnnotations()
+	}
+
+	ttl, err := ParseDebugContainersTTL(r.apiManager.Annotations[DebugContainersTTLAnnotation])
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DebugContainersError", "invalid '%s' annotation: %s", DebugContainersTTLAnnotation, err.Error())
+		return r.removeDebugAnnotations()
+	}
+
+	expiresAtVal, ok := r.apiManager.Annotations[DebugContainersExpiresAtAnnotation]
+	if !ok {
+		r.apiManager.Annotations[DebugContainersExpiresAtAnnotation] = time.Now().Add(ttl).UTC().Format(time.RFC3339)
+		err = r.UpdateResource(r.apiManager)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expiresAtVal)
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DebugContainersError", "invalid '%s' annotation: %s", DebugContainersExpiresAtAnnotation, err.Error())
+		return r.removeDebugAnnotations()
+	}
+
+	remaining := time.Until(expiresAt)
+	if remaining <= 0 {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DebugContainersExpired", "debug containers expired at %s", expiresAtVal)
+		return r.removeDebugAnnotations()
+	}
+
+	components := make([]string, 0, len(images))
+	for component := range images {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	for _, component := range components {
+		err = r.reconcileComponentDebugContainers(component, images[component], expiresAt)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if remaining > debugContainersRequeueDelay {
+		remaining = debugContainersRequeueDelay
+	}
+	return reconcile.Result{RequeueAfter: remaining}, nil
+}
+
+func (r *DebugContainersReconciler) reconcileComponentDebugContainers(component, image string, expiresAt time.Time) error {
+	podList := &v1.PodList{}
+	listOps := []client.ListOption{
+		client.InNamespace(r.apiManager.Namespace),
+		client.MatchingLabels{
+			"deploymentConfig": component,
+			"app":              *r.apiManager.Spec.AppLabel,
+		},
+	}
+	err := r.Client().List(r.Context(), podList, listOps...)
+	if err != nil {
+		return fmt.Errorf("failed to list %s pods: %w", component, err)
+	}
+
+	name := DebugContainerName(image, expiresAt)
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if pod.Status.Phase != v1.PodRunning || len(pod.Spec.Containers) == 0 || hasEphemeralContainer(pod, name) {
+			continue
+		}
+
+		// The running time is bound by the request expiration
+		seconds := int64(time.Until(expiresAt).Seconds())
+		if seconds <= 0 {
+			return nil
+		}
+
+		ephemeralContainers := &v1.EphemeralContainers{
+			ObjectMeta:          metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace, ResourceVersion: pod.ResourceVersion},
+			EphemeralContainers: append(pod.Spec.EphemeralContainers, debugContainer(name, image, pod.Spec.Containers[0].Name, seconds)),
+		}
+
+		_, err = r.podsClient.Pods(pod.Namespace).UpdateEphemeralContainers(r.Context(), pod.Name, ephemeralContainers, metav1.UpdateOptions{})
+		if err != nil {
+			r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "DebugContainersError", "failed to attach debug container to pod '%s': %s", pod.Name, err.Error())
+			return fmt.Errorf("failed to attach debug container to pod %s: %w", pod.Name, err)
+		}
+
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "DebugContainerCreated",
+			"debug container '%s' with image '%s' attached to pod '%s' until %s", name, image, pod.Name, expiresAt.UTC().Format(time.RFC3339))
+		r.Logger().Info("debug container attached", "pod", pod.Name, "container", name, "image", image)
+	}
+
+	return nil
+}
+
+func (r *DebugContainersReconciler) removeDebugAnnotations() (reconcile.Result, error) {
+	delete(r.apiManager.Annotations, DebugContainersAnnotation)
+	delete(r.apiManager.Annotations, DebugContainersTTLAnnotation)
+	delete(r.apiManager.Annotations, DebugContainersExpiresAtAnnotation)
+	err := r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{Requeue: true}, nil
+}
+
+// ParseDebugContainersAnnotation parses the DebugContainersAnnotation value
+// into the debug image of each component
+func ParseDebugContainersAnnotation(val string) (map[string]string, error) {
+	images := map[string]string{}
+	for _, entry := range strings.Split(val, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("'%s' is not in the component=image format", entry)
+		}
+
+		component := strings.TrimSpace(parts[0])
+		if errs := validation.IsDNS1123Label(component); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid component '%s': %s", component, strings.Join(errs, ", "))
+		}
+		if _, ok := images[component]; ok {
+			return nil, fmt.Errorf("component '%s' listed more than once", component)
+		}
+
+		images[component] = strings.TrimSpace(parts[1])
+	}
+
+	if len(images) == 0 {
+		return nil, fmt.Errorf("no component listed")
+	}
+
+	return images, nil
+}
+
+// ParseDebugContainersTTL parses the DebugContainersTTLAnnotation value.
+// DefaultDebugContainersTTL is returned when empty
+func ParseDebugContainersTTL(val string) (time.Duration, error) {
+	if val == "" {
+		return DefaultDebugContainersTTL, nil
+	}
+
+	ttl, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 || ttl > MaxDebugContainersTTL {
+		return 0, fmt.Errorf("'%s' out of range, must be positive and at most %s", val, MaxDebugContainersTTL)
+	}
+
+	return ttl, nil
+}
+
+// DebugContainerName is unique per image and request, as ephemeral containers
+// cannot be removed from the pod once added
+func DebugContainerName(image string, expiresAt time.Time) string {
+	h := fnv.New32a()
+	h.Write([]byte(image))
+	h.Write([]byte(expiresAt.UTC().Format(time.RFC3339)))
+	return fmt.Sprintf("%s%x", debugContainerNamePrefix, h.Sum32())
+}
+
+func debugContainer(name, image, targetContainerName string, seconds int64) v1.EphemeralContainer {
+	return v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			Command:                  []string{"sleep", fmt.Sprint(seconds)},
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			Stdin:                    true,
+			TTY:                      true,
+		},
+		// Shares the process namespace of the component container
+		TargetContainerName: targetContainerName,
+	}
+}
+
+func hasEphemeralContainer(pod *v1.Pod, name string) bool {
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
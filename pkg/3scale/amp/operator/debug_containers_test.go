@@ -0,0 +1,177 @@
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestParseDebugContainersAnnotation(t *testing.T) {
+	images, err := ParseDebugContainersAnnotation(" backend-worker=registry/debug:1 , zync-que=registry/other:2,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 || images[component.BackendWorkerName] != "registry/debug:1" || images[component.ZyncQueDeploymentName] != "registry/other:2" {
+		t.Errorf("unexpected images: %v", images)
+	}
+
+	for _, val := range []string{"", "backend-worker", "backend-worker=", "Backend_Worker=registry/debug:1", "backend-worker=a,backend-worker=b"} {
+		if _, err := ParseDebugContainersAnnotation(val); err == nil {
+			t.Errorf("expected error parsing '%s'", val)
+		}
+	}
+}
+
+func TestParseDebugContainersTTL(t *testing.T) {
+	cases := []struct {
+		val      string
+		expected time.Duration
+		err      bool
+	}{
+		{"", DefaultDebugContainersTTL, false},
+		{"30m", 30 * time.Minute, false},
+		{"0s", 0, true},
+		{"-1h", 0, true},
+		{"48h", 0, true},
+		{"tomorrow", 0, true},
+	}
+
+	for _, tc := range cases {
+		ttl, err := ParseDebugContainersTTL(tc.val)
+		if tc.err != (err != nil) {
+			t.Errorf("'%s': unexpected error: %v", tc.val, err)
+		}
+		if !tc.err && ttl != tc.expected {
+			t.Errorf("'%s': expected %s, got %s", tc.val, tc.expected, ttl)
+		}
+	}
+}
+
+func debugContainersTestReconciler(apimanager *appsv1alpha1.APIManager, podsClient corev1client.PodsGetter, objs ...runtime.Object) (*DebugContainersReconciler, *record.FakeRecorder) {
+	log := logf.Log.WithName("operator_test")
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+
+	objs = append(objs, apimanager)
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, fakeclientset.NewSimpleClientset().Discovery(), recorder)
+	return NewDebugContainersReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager), podsClient), recorder
+}
+
+func TestDebugContainersReconciler(t *testing.T) {
+	t.Run("Disabled", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Annotations = map[string]string{DebugContainersAnnotation: "backend-worker=registry/debug:1"}
+
+		reconciler, recorder := debugContainersTestReconciler(apimanager, nil)
+		result, err := reconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !result.Requeue {
+			subT.Error("expected requeue")
+		}
+		if _, ok := apimanager.Annotations[DebugContainersAnnotation]; ok {
+			subT.Errorf("expected '%s' annotation to be removed", DebugContainersAnnotation)
+		}
+		if len(recorder.Events) != 1 {
+			subT.Errorf("expected one event, got %d", len(recorder.Events))
+		}
+	})
+
+	t.Run("Expired", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Annotations = map[string]string{
+			DebugContainersAnnotation:          "backend-worker=registry/debug:1",
+			DebugContainersExpiresAtAnnotation: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+		}
+
+		reconciler, _ := debugContainersTestReconciler(apimanager, fakeclientset.NewSimpleClientset().CoreV1())
+		if _, err := reconciler.Reconcile(); err != nil {
+			subT.Fatal(err)
+		}
+		for _, annotation := range []string{DebugContainersAnnotation, DebugContainersExpiresAtAnnotation} {
+			if _, ok := apimanager.Annotations[annotation]; ok {
+				subT.Errorf("expected '%s' annotation to be removed", annotation)
+			}
+		}
+	})
+
+	t.Run("Attach", func(subT *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		apimanager := basicApimanager()
+		apimanager.Annotations = map[string]string{
+			DebugContainersAnnotation:          "backend-worker=registry/debug:1",
+			DebugContainersExpiresAtAnnotation: expiresAt.Format(time.RFC3339),
+		}
+
+		newPod := func(name string, phase v1.PodPhase, ephemeralContainers ...v1.EphemeralContainer) *v1.Pod {
+			return &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{"deploymentConfig": component.BackendWorkerName, "app": appLabel},
+				},
+				Spec: v1.PodSpec{
+					Containers:          []v1.Container{{Name: component.BackendWorkerName}},
+					EphemeralContainers: ephemeralContainers,
+				},
+				Status: v1.PodStatus{Phase: phase},
+			}
+		}
+		name := DebugContainerName("registry/debug:1", expiresAt)
+		attached := v1.EphemeralContainer{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: name}}
+
+		clientset := fakeclientset.NewSimpleClientset()
+		var updated []*v1.EphemeralContainers
+		clientset.PrependReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "ephemeralcontainers" {
+				return false, nil, nil
+			}
+			obj := action.(k8stesting.UpdateAction).GetObject().(*v1.EphemeralContainers)
+			updated = append(updated, obj)
+			return true, obj, nil
+		})
+
+		reconciler, _ := debugContainersTestReconciler(apimanager, clientset.CoreV1(),
+			newPod("backend-worker-1-a", v1.PodRunning),
+			newPod("backend-worker-1-b", v1.PodPending),
+			newPod("backend-worker-1-c", v1.PodRunning, attached),
+		)
+		result, err := reconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if result.RequeueAfter == 0 || result.RequeueAfter > debugContainersRequeueDelay {
+			subT.Errorf("unexpected requeue after: %s", result.RequeueAfter)
+		}
+
+		if len(updated) != 1 || updated[0].Name != "backend-worker-1-a" {
+			subT.Fatalf("expected debug container attached to backend-worker-1-a only, got %v", updated)
+		}
+		containers := updated[0].EphemeralContainers
+		if len(containers) != 1 || containers[0].Name != name || containers[0].Image != "registry/debug:1" ||
+			containers[0].TargetContainerName != component.BackendWorkerName {
+			subT.Errorf("unexpected ephemeral containers: %v", containers)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package operator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/common"
+)
+
+const (
+	ExternalBackendReasonReachable   = "Reachable"
+	ExternalBackendReasonUnreachable = "Unreachable"
+
+	externalBackendCheckTimeout  = 5 * time.Second
+	externalBackendRequeueDelay  = time.Minute
+	externalBackendStatusURLPath = "/status"
+)
+
+// ExternalBackendReconciler reports whether the listener endpoint of the external
+// backend is reachable in the BackendReachable condition. The endpoint is checked
+// periodically while the connectivity checks are enabled.
+type ExternalBackendReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	checkEndpoint func(endpoint string) error
+}
+
+func NewExternalBackendReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *ExternalBackendReconciler {
+	return &ExternalBackendReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		checkEndpoint:                 checkExternalBackendEndpoint,
+	}
+}
+
+func (r *ExternalBackendReconciler) Reconcile() (reconcile.Result, error) {
+	if !r.apiManager.IsExternalBackendConnectivityCheckEnabled() {
+		_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+			r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerBackendReachableConditionType)
+			return nil
+		})
+		return reconcile.Result{}, err
+	}
+
+	endpoint := r.apiManager.Spec.ExternalBackend.ListenerEndpoint
+	condition := common.Condition{
+		Type:   appsv1alpha1.APIManagerBackendReachableConditionType,
+		Status: v1.ConditionTrue,
+		Reason: ExternalBackendReasonReachable,
+	}
+	if err := r.checkEndpoint(endpoint); err != nil {
+		r.Logger().Info("external backend not reachable", "endpoint", endpoint, "error", err.Error())
+		condition.Status = v1.ConditionFalse
+		condition.Reason = ExternalBackendReasonUnreachable
+		condition.Message = err.Error()
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.Conditions.SetCondition(condition)
+		return nil
+	})
+	return reconcile.Result{RequeueAfter: externalBackendRequeueDelay}, err
+}
+
+// checkExternalBackendEndpoint requests the status of the backend-listener
+func checkExternalBackendEndpoint(endpoint string) error {
+	httpClient := &http.Client{Timeout: externalBackendCheckTimeout}
+	resp, err := httpClient.Get(strings.TrimSuffix(endpoint, "/") + externalBackendStatusURLPath)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
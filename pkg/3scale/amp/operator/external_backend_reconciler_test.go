@@ -0,0 +1,111 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestExternalBackendReconciler(t *testing.T) {
+	log := logf.Log.WithName("operator_test")
+
+	reconcile := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, checkErr error) *appsv1alpha1.APIManager {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		cl := fake.NewFakeClient(apimanager)
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, cl, log, fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(10))
+
+		checked := false
+		reconciler := NewExternalBackendReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager))
+		reconciler.checkEndpoint = func(endpoint string) error {
+			checked = true
+			if endpoint != externalBackendTestListenerEndpoint {
+				subT.Errorf("unexpected endpoint %s", endpoint)
+			}
+			return checkErr
+		}
+
+		result, err := reconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		enabled := apimanager.IsExternalBackendConnectivityCheckEnabled()
+		if checked != enabled {
+			subT.Errorf("expected endpoint checked %t, got %t", enabled, checked)
+		}
+		if enabled && result.RequeueAfter == 0 {
+			subT.Error("expected the connectivity check to be requeued")
+		}
+
+		existing := &appsv1alpha1.APIManager{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: apimanagerName, Namespace: namespace}, existing); err != nil {
+			subT.Fatal(err)
+		}
+		return existing
+	}
+
+	withChecks := func() *appsv1alpha1.APIManager {
+		trueValue := true
+		apimanager := externalBackendTestApimanager()
+		apimanager.Spec.ExternalBackend.ConnectivityCheckEnabled = &trueValue
+		return apimanager
+	}
+
+	t.Run("Reachable", func(subT *testing.T) {
+		existing := reconcile(subT, withChecks(), nil)
+		if !existing.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerBackendReachableConditionType) {
+			subT.Errorf("expected BackendReachable condition, got %v", existing.Status.Conditions)
+		}
+	})
+
+	t.Run("Unreachable", func(subT *testing.T) {
+		existing := reconcile(subT, withChecks(), errors.New("connection refused"))
+		condition := existing.Status.Conditions.GetCondition(appsv1alpha1.APIManagerBackendReachableConditionType)
+		if condition == nil || !existing.Status.Conditions.IsFalseFor(appsv1alpha1.APIManagerBackendReachableConditionType) ||
+			condition.Reason != ExternalBackendReasonUnreachable || condition.Message != "connection refused" {
+			subT.Errorf("unexpected BackendReachable condition: %v", condition)
+		}
+	})
+
+	t.Run("ChecksDisabled", func(subT *testing.T) {
+		apimanager := externalBackendTestApimanager()
+		apimanager.Status.Conditions.SetCondition(common.Condition{
+			Type:   appsv1alpha1.APIManagerBackendReachableConditionType,
+			Status: v1.ConditionTrue,
+		})
+		existing := reconcile(subT, apimanager, nil)
+		if existing.Status.Conditions.GetCondition(appsv1alpha1.APIManagerBackendReachableConditionType) != nil {
+			subT.Error("expected BackendReachable condition to be removed")
+		}
+	})
+}
+
+func TestCheckExternalBackendEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	if err := checkExternalBackendEndpoint(server.URL + "/"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkExternalBackendEndpoint(server.URL + "/other"); err == nil {
+		t.Error("expected unexpected status code error")
+	}
+}
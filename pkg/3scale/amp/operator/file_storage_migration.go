@@ -0,0 +1,415 @@
+package operator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	// FileStorageMigrationAnnotation triggers the migration of the system file storage
+	// from the system-storage PVC to the S3 bucket configured in the named secret
+	FileStorageMigrationAnnotation = "apps.3scale.net/file-storage-migration"
+
+	// FileStorageMigrationCleanupAnnotation triggers the removal of the system-storage
+	// PVC retained after a completed migration
+	FileStorageMigrationCleanupAnnotation = "apps.3scale.net/file-storage-migration-cleanup"
+
+	fileStorageMigrationRequeueDelay = 10 * time.Second
+)
+
+// fileStorageMigrationScript uploads the files of the system-storage volume
+// missing or different in the bucket, so a failed sync can be resumed by running
+// it again. The counts are reported in the termination message
+const fileStorageMigrationScript = `
+require 'aws-sdk-s3'
+require 'digest/md5'
+require 'json'
+
+root = '/opt/system/public/system'
+termination_log = '/dev/termination-log'
+
+begin
+  options = { region: ENV['AWS_REGION'] }
+  unless ENV['AWS_HOSTNAME'].to_s.empty?
+    protocol = ENV['AWS_PROTOCOL'].to_s.empty? ? 'https' : ENV['AWS_PROTOCOL']
+    options[:endpoint] = "#{protocol}://#{ENV['AWS_HOSTNAME']}"
+  end
+  options[:force_path_style] = true if ENV['AWS_PATH_STYLE'] == 'true'
+  bucket = Aws::S3::Resource.new(options).bucket(ENV['AWS_BUCKET'])
+
+  existing = {}
+  bucket.objects.each { |object| existing[object.key] = [object.size, object.etag.delete('"')] }
+
+  files = 0
+  uploaded = 0
+  Dir.glob(File.join(root, '**', '*'), File::FNM_DOTMATCH).sort.each do |path|
+    next unless File.file?(path)
+
+    files += 1
+    key = path.sub("#{root}/", '')
+    size, etag = existing[key]
+    # The etag of multipart uploads is not the MD5 of the content, only the size is compared
+    next if size == File.size(path) && (etag.include?('-') || etag == Digest::MD5.file(path).hexdigest)
+
+    bucket.object(key).upload_file(path)
+    uploaded += 1
+    puts "uploaded #{key}"
+  end
+
+  File.write(termination_log, JSON.generate(files: files, uploadedFiles: uploaded))
+rescue StandardError => e
+  File.write(termination_log, "#{e.class}: #{e.message}")
+  exit 1
+end
+`
+
+func FileStorageMigrationJobName(apimanagerName string) string {
+	return fmt.Sprintf("%s-file-storage-migration", apimanagerName)
+}
+
+// fileStorageMigrationResult is the termination message of a successful sync
+type fileStorageMigrationResult struct {
+	Files         int64 `json:"files"`
+	UploadedFiles int64 `json:"uploadedFiles"`
+}
+
+// FileStorageMigrationReconciler migrates the system file storage from the
+// system-storage PVC to S3 when the APIManager is annotated with
+// FileStorageMigrationAnnotation. The files are synced by a job, then the file
+// storage of the APIManager is switched to S3, which rolls out system.
+// The file storage is not switched when the sync fails.
+// The PVC is kept until the APIManager is annotated with FileStorageMigrationCleanupAnnotation.
+type FileStorageMigrationReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewFileStorageMigrationReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *FileStorageMigrationReconciler {
+	return &FileStorageMigrationReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *FileStorageMigrationReconciler) Reconcile() (reconcile.Result, error) {
+	if _, ok := r.apiManager.Annotations[FileStorageMigrationCleanupAnnotation]; ok {
+		return r.reconcileCleanup()
+	}
+
+	secretName, ok := r.apiManager.Annotations[FileStorageMigrationAnnotation]
+	if !ok {
+		return reconcile.Result{}, r.reconcileCancelled()
+	}
+
+	status := r.apiManager.Status.FileStorageMigration
+	if status == nil || status.Phase != appsv1alpha1.FileStorageMigrationPhaseSyncing || status.ConfigurationSecretName != secretName {
+		return r.startMigration(secretName)
+	}
+
+	return r.reconcileSync(status.DeepCopy())
+}
+
+func (r *FileStorageMigrationReconciler) startMigration(secretName string) (reconcile.Result, error) {
+	status := r.apiManager.Status.FileStorageMigration
+	if !r.usesPVCFileStorage() {
+		// Already migrated
+		if status != nil && status.Phase == appsv1alpha1.FileStorageMigrationPhaseCompleted && status.ConfigurationSecretName == secretName {
+			return r.removeAnnotation(FileStorageMigrationAnnotation)
+		}
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "FileStorageMigrationError", "'%s' annotation ignored, the system file storage is not a PVC", FileStorageMigrationAnnotation)
+		return r.removeAnnotation(FileStorageMigrationAnnotation)
+	}
+
+	err := validateS3ConfigurationSecret(secretName, r.apiManager.Namespace, r.Client())
+	if err != nil {
+		return r.migrationFailed(&appsv1alpha1.FileStorageMigrationStatus{ConfigurationSecretName: secretName}, err.Error())
+	}
+
+	// The job of a previous migration would be taken as the sync of this one
+	jobName := FileStorageMigrationJobName(r.apiManager.Name)
+	err = r.deleteJob(jobName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := metav1.Now()
+	status = &appsv1alpha1.FileStorageMigrationStatus{
+		Phase:                   appsv1alpha1.FileStorageMigrationPhaseSyncing,
+		ConfigurationSecretName: secretName,
+		JobName:                 jobName,
+		StartTime:               &now,
+	}
+	err = r.writeMigrationStatus(status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "FileStorageMigrationStarted", "syncing the system-storage PVC to the S3 bucket of the '%s' secret", secretName)
+	return r.reconcileSync(status)
+}
+
+func (r *FileStorageMigrationReconciler) reconcileSync(status *appsv1alpha1.FileStorageMigrationStatus) (reconcile.Result, error) {
+	if !r.usesPVCFileStorage() {
+		return r.migrationFailed(status, "the system file storage was changed during the migration")
+	}
+
+	// The job reads the file storage volume and the system image from system-sidekiq
+	sidekiq := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: component.SystemSidekiqName, Namespace: r.apiManager.Namespace}, sidekiq)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{RequeueAfter: fileStorageMigrationRequeueDelay}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	err = r.ReconcileResource(&batchv1.Job{}, FileStorageMigrationJob(status.JobName, r.apiManager, sidekiq, status.ConfigurationSecretName), reconcilers.CreateOnlyMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	job := &batchv1.Job{}
+	err = r.GetResource(types.NamespacedName{Name: status.JobName, Namespace: r.apiManager.Namespace}, job)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if job.Status.Succeeded > 0 {
+		return r.switchToS3(status, job)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			msg, err := helper.JobTerminationMessage(r.Client(), job)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if msg == "" {
+				msg = condition.Message
+			}
+			return r.migrationFailed(status, fmt.Sprintf("job '%s' failed: %s", job.Name, msg))
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: fileStorageMigrationRequeueDelay}, nil
+}
+
+// switchToS3 switches the file storage of the APIManager to S3 once the files have been synced.
+// The file storage and the annotation are updated at once, so the PVC based file storage is kept
+// when the update fails
+func (r *FileStorageMigrationReconciler) switchToS3(status *appsv1alpha1.FileStorageMigrationStatus, job *batchv1.Job) (reconcile.Result, error) {
+	msg, err := helper.JobTerminationMessage(r.Client(), job)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	result := fileStorageMigrationResult{}
+	if msg != "" {
+		if err := json.Unmarshal([]byte(msg), &result); err != nil {
+			r.Logger().Info(fmt.Sprintf("unexpected file storage migration job termination message: %s", msg))
+		}
+	}
+
+	r.apiManager.Spec.System.FileStorageSpec = &appsv1alpha1.SystemFileStorageSpec{
+		S3: &appsv1alpha1.SystemS3Spec{
+			ConfigurationSecretRef: v1.LocalObjectReference{Name: status.ConfigurationSecretName},
+		},
+	}
+	delete(r.apiManager.Annotations, FileStorageMigrationAnnotation)
+	err = r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := metav1.Now()
+	status.Phase = appsv1alpha1.FileStorageMigrationPhaseCompleted
+	status.CompletionTime = &now
+	status.Files = result.Files
+	status.UploadedFiles = result.UploadedFiles
+	status.Message = ""
+	status.PVCRetained = true
+	err = r.writeMigrationStatus(status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "FileStorageMigrated", "system file storage switched to the S3 bucket of the '%s' secret. The '%s' PVC is kept until the APIManager is annotated with '%s'", status.ConfigurationSecretName, component.SystemFileStoragePVCName, FileStorageMigrationCleanupAnnotation)
+	return reconcile.Result{Requeue: true}, nil
+}
+
+// reconcileCancelled handles the removal of the migration annotation during the sync.
+// The job is removed and the PVC based file storage kept
+func (r *FileStorageMigrationReconciler) reconcileCancelled() error {
+	status := r.apiManager.Status.FileStorageMigration
+	if status == nil || status.Phase != appsv1alpha1.FileStorageMigrationPhaseSyncing {
+		return nil
+	}
+
+	status = status.DeepCopy()
+
+	// The file storage was switched but the status could not be written
+	if fileStorage := r.apiManager.Spec.System.FileStorageSpec; fileStorage != nil && fileStorage.S3 != nil &&
+		fileStorage.S3.ConfigurationSecretRef.Name == status.ConfigurationSecretName {
+		now := metav1.Now()
+		status.Phase = appsv1alpha1.FileStorageMigrationPhaseCompleted
+		status.CompletionTime = &now
+		status.PVCRetained = true
+		return r.writeMigrationStatus(status)
+	}
+
+	err := r.deleteJob(status.JobName)
+	if err != nil {
+		return err
+	}
+
+	status.Phase = appsv1alpha1.FileStorageMigrationPhaseFailed
+	status.Message = fmt.Sprintf("migration cancelled, the '%s' annotation was removed", FileStorageMigrationAnnotation)
+	return r.writeMigrationStatus(status)
+}
+
+// reconcileCleanup removes the system-storage PVC retained after a completed migration
+func (r *FileStorageMigrationReconciler) reconcileCleanup() (reconcile.Result, error) {
+	val := r.apiManager.Annotations[FileStorageMigrationCleanupAnnotation]
+	if val != "true" {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "FileStorageMigrationError", "unexpected '%s' annotation value '%s'", FileStorageMigrationCleanupAnnotation, val)
+		return r.removeAnnotation(FileStorageMigrationCleanupAnnotation)
+	}
+
+	status := r.apiManager.Status.FileStorageMigration
+	if status == nil || status.Phase != appsv1alpha1.FileStorageMigrationPhaseCompleted || r.usesPVCFileStorage() {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "FileStorageMigrationError", "'%s' annotation ignored, the '%s' PVC is only removed after a completed migration", FileStorageMigrationCleanupAnnotation, component.SystemFileStoragePVCName)
+		return r.removeAnnotation(FileStorageMigrationCleanupAnnotation)
+	}
+
+	if status.PVCRetained {
+		pvc := &v1.PersistentVolumeClaim{}
+		err := r.GetResource(types.NamespacedName{Name: component.SystemFileStoragePVCName, Namespace: r.apiManager.Namespace}, pvc)
+		if err == nil {
+			err = r.DeleteResource(pvc)
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		err = r.deleteJob(status.JobName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		status = status.DeepCopy()
+		status.PVCRetained = false
+		err = r.writeMigrationStatus(status)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "FileStorageMigrationCleanedUp", "the '%s' PVC has been removed", component.SystemFileStoragePVCName)
+	}
+
+	return r.removeAnnotation(FileStorageMigrationCleanupAnnotation)
+}
+
+func (r *FileStorageMigrationReconciler) migrationFailed(status *appsv1alpha1.FileStorageMigrationStatus, msg string) (reconcile.Result, error) {
+	status.Phase = appsv1alpha1.FileStorageMigrationPhaseFailed
+	status.Message = msg
+	err := r.writeMigrationStatus(status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "FileStorageMigrationFailed", "system file storage migration failed, the PVC file storage is kept: %s", msg)
+	return r.removeAnnotation(FileStorageMigrationAnnotation)
+}
+
+// usesPVCFileStorage returns whether system stores the files in the system-storage PVC.
+// The deprecated S3 spec is ignored
+func (r *FileStorageMigrationReconciler) usesPVCFileStorage() bool {
+	fileStorage := r.apiManager.Spec.System.FileStorageSpec
+	return fileStorage == nil || fileStorage.S3 == nil
+}
+
+func (r *FileStorageMigrationReconciler) writeMigrationStatus(status *appsv1alpha1.FileStorageMigrationStatus) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.FileStorageMigration = status
+		return nil
+	})
+	return err
+}
+
+func (r *FileStorageMigrationReconciler) removeAnnotation(annotation string) (reconcile.Result, error) {
+	delete(r.apiManager.Annotations, annotation)
+	err := r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{Requeue: true}, nil
+}
+
+// FileStorageMigrationJob syncs the system-storage PVC to the S3 bucket of the given
+// configuration secret using the system-sidekiq pod template, which holds the file
+// storage volume and the resolved system image. The volume is mounted read only
+func FileStorageMigrationJob(name string, apimanager *appsv1alpha1.APIManager, sidekiq *appsv1.DeploymentConfig, configurationSecretName string) *batchv1.Job {
+	var backoffLimit int32 = 3
+
+	podSpec := v1.PodSpec{}
+	if sidekiq.Spec.Template != nil {
+		sidekiq.Spec.Template.Spec.DeepCopyInto(&podSpec)
+	}
+	podSpec.RestartPolicy = v1.RestartPolicyNever
+	if len(podSpec.Containers) > 0 {
+		container := podSpec.Containers[0]
+		container.Name = "file-storage-migration"
+		container.Command = []string{"bundle", "exec", "ruby", "-e", fileStorageMigrationScript}
+		container.Args = nil
+		container.LivenessProbe = nil
+		container.ReadinessProbe = nil
+		container.StartupProbe = nil
+
+		env := []v1.EnvVar{}
+		for _, envVar := range container.Env {
+			if !helper.ArrayContains(component.SystemS3FileStorageEnvVarNames, envVar.Name) {
+				env = append(env, envVar)
+			}
+		}
+		container.Env = append(env, component.S3ConfigurationEnvVars(configurationSecretName)...)
+
+		for idx := range container.VolumeMounts {
+			if container.VolumeMounts[idx].Name == component.SystemFileStoragePVCName {
+				container.VolumeMounts[idx].ReadOnly = true
+			}
+		}
+		podSpec.Containers = []v1.Container{container}
+	}
+	helper.ApplyJobPodTemplateOptions(&podSpec, apimanager.Spec.JobsTemplate.JobPodTemplateOptions())
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": *apimanager.Spec.AppLabel,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+		},
+	}
+}
@@ -0,0 +1,313 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestFileStorageMigrationJob(t *testing.T) {
+	apimanager := basicApimanager()
+	system, err := System(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := FileStorageMigrationJob("migration", apimanager, system.SidekiqDeploymentConfig(), "s3-config")
+
+	containers := job.Spec.Template.Spec.Containers
+	if len(containers) != 1 {
+		t.Fatalf("expected one container, got %d", len(containers))
+	}
+	container := containers[0]
+	if container.LivenessProbe != nil || container.ReadinessProbe != nil {
+		t.Error("unexpected probes")
+	}
+
+	for _, expected := range component.S3ConfigurationEnvVars("s3-config") {
+		idx := helper.FindEnvVar(container.Env, expected.Name)
+		if idx < 0 {
+			t.Errorf("expected env var %s", expected.Name)
+		}
+	}
+	if idx := helper.FindEnvVar(container.Env, component.SystemFileUploadStorageEnvVarName); idx >= 0 {
+		t.Errorf("unexpected env var %s", component.SystemFileUploadStorageEnvVarName)
+	}
+
+	mounted := false
+	for _, mount := range container.VolumeMounts {
+		if mount.Name == component.SystemFileStoragePVCName {
+			mounted = true
+			if !mount.ReadOnly {
+				t.Error("expected read only file storage mount")
+			}
+		}
+	}
+	if !mounted {
+		t.Error("expected file storage mount")
+	}
+}
+
+func TestFileStorageMigrationJobPodTemplate(t *testing.T) {
+	apimanager := basicApimanager()
+	apimanager.Spec.System.SidekiqSpec.Tolerations = []v1.Toleration{{Key: "sidekiq", Operator: v1.TolerationOpExists}}
+	apimanager.Spec.JobsTemplate = &appsv1alpha1.JobsTemplateSpec{
+		NodeSelector: map[string]string{"node-role": "jobs"},
+	}
+	system, err := System(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := FileStorageMigrationJob("migration", apimanager, system.SidekiqDeploymentConfig(), "s3-config")
+
+	podSpec := job.Spec.Template.Spec
+	if podSpec.NodeSelector["node-role"] != "jobs" {
+		t.Errorf("expected the jobs template node selector, got %v", podSpec.NodeSelector)
+	}
+	if len(podSpec.Tolerations) != 1 || podSpec.Tolerations[0].Key != "sidekiq" {
+		t.Errorf("expected the system-sidekiq tolerations, got %v", podSpec.Tolerations)
+	}
+}
+
+func TestSystemFileStorageMutator(t *testing.T) {
+	sidekiqDC := func(apimanager *appsv1alpha1.APIManager) *appsv1.DeploymentConfig {
+		system, err := System(apimanager, fake.NewFakeClient())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return system.SidekiqDeploymentConfig()
+	}
+
+	pvcAPIManager := basicApimanager()
+	s3APIManager := basicApimanager()
+	s3APIManager.Spec.System.FileStorageSpec = &appsv1alpha1.SystemFileStorageSpec{
+		S3: &appsv1alpha1.SystemS3Spec{ConfigurationSecretRef: v1.LocalObjectReference{Name: "s3-config"}},
+	}
+
+	existing := sidekiqDC(pvcAPIManager)
+	desired := sidekiqDC(s3APIManager)
+
+	changed, err := systemFileStorageMutator(desired, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change")
+	}
+
+	for _, volume := range existing.Spec.Template.Spec.Volumes {
+		if volume.Name == component.SystemFileStoragePVCName {
+			t.Error("expected the file storage volume to be removed")
+		}
+	}
+	for _, container := range existing.Spec.Template.Spec.Containers {
+		if helper.FindEnvVar(container.Env, component.AwsBucket) < 0 {
+			t.Errorf("container %s: expected env var %s", container.Name, component.AwsBucket)
+		}
+	}
+
+	changed, err = systemFileStorageMutator(desired, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("unexpected change on second reconciliation")
+	}
+}
+
+func TestFileStorageMigrationReconciler(t *testing.T) {
+	var (
+		appLabel = "someLabel"
+		log      = logf.Log.WithName("operator_test")
+	)
+
+	s3Secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-config", Namespace: namespace},
+		Data: map[string][]byte{
+			component.AwsAccessKeyID:     []byte("key"),
+			component.AwsSecretAccessKey: []byte("secret"),
+			component.AwsBucket:          []byte("bucket"),
+			component.AwsRegion:          []byte("us-east-1"),
+		},
+	}
+
+	sidekiq := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: component.SystemSidekiqName, Namespace: namespace},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: component.SystemSidekiqName, Image: "system:latest"}}},
+			},
+		},
+	}
+
+	newReconciler := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, objs ...runtime.Object) (*FileStorageMigrationReconciler, client.Client) {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		clientAPIReader := fake.NewFakeClient(objs...)
+		clientset := fakeclientset.NewSimpleClientset()
+		recorder := record.NewFakeRecorder(10000)
+
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+		return NewFileStorageMigrationReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)), cl
+	}
+
+	newAPIManager := func(annotations map[string]string) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace, Annotations: annotations},
+			Spec: appsv1alpha1.APIManagerSpec{
+				APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+				System:               &appsv1alpha1.SystemSpec{},
+			},
+		}
+	}
+
+	t.Run("migration", func(subT *testing.T) {
+		apimanager := newAPIManager(map[string]string{FileStorageMigrationAnnotation: s3Secret.Name})
+		migrationReconciler, cl := newReconciler(subT, apimanager, s3Secret, sidekiq)
+
+		res, err := migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected requeue")
+		}
+		status := apimanager.Status.FileStorageMigration
+		if status == nil || status.Phase != appsv1alpha1.FileStorageMigrationPhaseSyncing {
+			subT.Fatalf("unexpected status: %v", status)
+		}
+		if apimanager.Spec.System.FileStorageSpec != nil {
+			subT.Fatal("unexpected file storage switch during the sync")
+		}
+
+		job := &batchv1.Job{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: FileStorageMigrationJobName(apimanager.Name), Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		job.Status.Succeeded = 1
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		status = apimanager.Status.FileStorageMigration
+		if status.Phase != appsv1alpha1.FileStorageMigrationPhaseCompleted || !status.PVCRetained {
+			subT.Errorf("unexpected status: %v", status)
+		}
+		fileStorage := apimanager.Spec.System.FileStorageSpec
+		if fileStorage == nil || fileStorage.S3 == nil || fileStorage.S3.ConfigurationSecretRef.Name != s3Secret.Name {
+			subT.Errorf("expected S3 file storage, got %v", fileStorage)
+		}
+		if _, ok := apimanager.Annotations[FileStorageMigrationAnnotation]; ok {
+			subT.Error("expected the migration annotation to be removed")
+		}
+	})
+
+	t.Run("failed job keeps the PVC file storage", func(subT *testing.T) {
+		apimanager := newAPIManager(map[string]string{FileStorageMigrationAnnotation: s3Secret.Name})
+		migrationReconciler, cl := newReconciler(subT, apimanager, s3Secret, sidekiq)
+
+		_, err := migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		job := &batchv1.Job{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: FileStorageMigrationJobName(apimanager.Name), Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		status := apimanager.Status.FileStorageMigration
+		if status.Phase != appsv1alpha1.FileStorageMigrationPhaseFailed {
+			subT.Errorf("unexpected status: %v", status)
+		}
+		if apimanager.Spec.System.FileStorageSpec != nil {
+			subT.Error("unexpected file storage switch")
+		}
+		if _, ok := apimanager.Annotations[FileStorageMigrationAnnotation]; ok {
+			subT.Error("expected the migration annotation to be removed")
+		}
+	})
+
+	t.Run("invalid configuration secret", func(subT *testing.T) {
+		apimanager := newAPIManager(map[string]string{FileStorageMigrationAnnotation: "unknown"})
+		migrationReconciler, _ := newReconciler(subT, apimanager, sidekiq)
+
+		_, err := migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		status := apimanager.Status.FileStorageMigration
+		if status == nil || status.Phase != appsv1alpha1.FileStorageMigrationPhaseFailed {
+			subT.Errorf("unexpected status: %v", status)
+		}
+	})
+
+	t.Run("cleanup", func(subT *testing.T) {
+		apimanager := newAPIManager(map[string]string{FileStorageMigrationCleanupAnnotation: "true"})
+		apimanager.Spec.System.FileStorageSpec = &appsv1alpha1.SystemFileStorageSpec{
+			S3: &appsv1alpha1.SystemS3Spec{ConfigurationSecretRef: v1.LocalObjectReference{Name: s3Secret.Name}},
+		}
+		apimanager.Status.FileStorageMigration = &appsv1alpha1.FileStorageMigrationStatus{
+			Phase:                   appsv1alpha1.FileStorageMigrationPhaseCompleted,
+			ConfigurationSecretName: s3Secret.Name,
+			JobName:                 FileStorageMigrationJobName(apimanager.Name),
+			PVCRetained:             true,
+		}
+		pvc := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: component.SystemFileStoragePVCName, Namespace: namespace}}
+		migrationReconciler, cl := newReconciler(subT, apimanager, pvc)
+
+		_, err := migrationReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: component.SystemFileStoragePVCName, Namespace: namespace}, &v1.PersistentVolumeClaim{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("expected the PVC to be removed, got %v", err)
+		}
+		if apimanager.Status.FileStorageMigration.PVCRetained {
+			subT.Error("unexpected retained PVC")
+		}
+		if _, ok := apimanager.Annotations[FileStorageMigrationCleanupAnnotation]; ok {
+			subT.Error("expected the cleanup annotation to be removed")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// grafanaDashboardOptions returns the datasource and the selector labels of the grafana dashboards
+func grafanaDashboardOptions(apimanager *appsv1alpha1.APIManager) *component.GrafanaDashboardOptions {
+	opts := component.DefaultGrafanaDashboardOptions()
+	if apimanager.Spec.Monitoring == nil || apimanager.Spec.Monitoring.Grafana == nil {
+		return opts
+	}
+
+	grafanaSpec := apimanager.Spec.Monitoring.Grafana
+	if grafanaSpec.DatasourceName != nil {
+		opts.DatasourceName = *grafanaSpec.DatasourceName
+	}
+	if len(grafanaSpec.DashboardLabels) > 0 {
+		opts.Labels = grafanaSpec.DashboardLabels
+	}
+	return opts
+}
@@ -0,0 +1,90 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGrafanaDashboards(t *testing.T) {
+	datasourceName := "my-prometheus"
+	dashboardLabels := map[string]string{"grafana": "dashboards"}
+
+	cases := []struct {
+		testName               string
+		monitoringSpec         *appsv1alpha1.MonitoringSpec
+		expectedDatasourceName string
+		expectedLabels         map[string]string
+	}{
+		{"Default", nil, component.DefaultGrafanaDatasourceName, map[string]string{"monitoring-key": common.MonitoringKey}},
+		{"EmptyGrafana", &appsv1alpha1.MonitoringSpec{Enabled: true, Grafana: &appsv1alpha1.GrafanaSpec{}},
+			component.DefaultGrafanaDatasourceName, map[string]string{"monitoring-key": common.MonitoringKey}},
+		{"CustomGrafana", &appsv1alpha1.MonitoringSpec{Enabled: true, Grafana: &appsv1alpha1.GrafanaSpec{
+			DatasourceName: &datasourceName, DashboardLabels: dashboardLabels}}, datasourceName, dashboardLabels},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := basicApimanager()
+			apimanager.Spec.Monitoring = tc.monitoringSpec
+			cl := fake.NewFakeClient()
+
+			apicast, err := Apicast(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			backend, err := Backend(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			system, err := System(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			zync, err := Zync(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			options := grafanaDashboardOptions(apimanager)
+			dashboards := []*grafanav1alpha1.GrafanaDashboard{
+				apicast.ApicastMainAppGrafanaDashboard("sum_irate"),
+				apicast.ApicastServicesGrafanaDashboard("sum_irate"),
+				backend.BackendGrafanaDashboard("sum_irate"),
+				system.SystemGrafanaDashboard("sum_irate"),
+				zync.ZyncGrafanaDashboard("sum_irate"),
+				component.KubernetesResourcesByNamespaceGrafanaDashboard("sum_irate", namespace, appLabel, options),
+				component.KubernetesResourcesByPodGrafanaDashboard("sum_irate", namespace, appLabel, options),
+			}
+
+			for _, dashboard := range dashboards {
+				if !strings.Contains(dashboard.Spec.Json, fmt.Sprintf(`"value": "%s"`, tc.expectedDatasourceName)) {
+					subT.Errorf("%s: datasource %s not selected", dashboard.Name, tc.expectedDatasourceName)
+				}
+				if dashboard.Labels["app"] != appLabel {
+					subT.Errorf("%s: expected app label %s, got %s", dashboard.Name, appLabel, dashboard.Labels["app"])
+				}
+				selectorLabels := map[string]string{}
+				for key := range tc.expectedLabels {
+					selectorLabels[key] = dashboard.Labels[key]
+				}
+				if !reflect.DeepEqual(selectorLabels, tc.expectedLabels) {
+					subT.Errorf("%s: expected labels %v, got %v", dashboard.Name, tc.expectedLabels, dashboard.Labels)
+				}
+				if tc.expectedLabels["monitoring-key"] == "" {
+					if _, ok := dashboard.Labels["monitoring-key"]; ok {
+						subT.Errorf("%s: unexpected monitoring-key label", dashboard.Name)
+					}
+				}
+			}
+		})
+	}
+}
@@ -129,3 +129,30 @@ func getTestTolerations(prefix string) []v1.Toleration {
 		},
 	}
 }
+
+func getTestTopologySpreadConstraints(prefix string) []v1.TopologySpreadConstraint {
+	return []v1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: v1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"deploymentConfig": prefix},
+			},
+		},
+	}
+}
+
+// getTestCustomLabels returns custom labels including one that conflicts with the operator labels
+func getTestCustomLabels(prefix string) map[string]string {
+	return map[string]string{
+		"custom-label":     prefix,
+		"deploymentConfig": "custom",
+	}
+}
+
+func getTestCustomAnnotations(prefix string) map[string]string {
+	return map[string]string{
+		"custom-annotation": prefix,
+	}
+}
@@ -0,0 +1,74 @@
+package operator
+
+import (
+	"strconv"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// HibernatedReplicasAnnotation keeps, in a DeploymentConfig scaled down by the hibernation or
+	// the standby mode, the replicas it had. They are restored when the APIManager is woken up or
+	// when the component is activated
+	HibernatedReplicasAnnotation = "apps.3scale.net/hibernated-replicas"
+)
+
+// isScaledDown tells whether the DeploymentConfig has to be kept with zero replicas, either because
+// the APIManager is hibernated or because the component is scaled down for standby
+func (r *BaseAPIManagerLogicReconciler) isScaledDown(name string) bool {
+	if r.apiManager.IsHibernated() {
+		return true
+	}
+	return helper.ArrayContains(StandbyComponents, name) && r.apiManager.IsScaledDownForStandby(name)
+}
+
+// hibernateDesiredReplicas scales down the desired DeploymentConfig. When it does not exist yet,
+// the desired replicas are kept in the hibernated replicas annotation, so they are restored on
+// wake up or activation also for the components whose replicas are not reconciled
+func (r *BaseAPIManagerLogicReconciler) hibernateDesiredReplicas(desired *appsv1.DeploymentConfig) error {
+	err := r.Client().Get(r.Context(), r.NamespacedNameWithAPIManagerNamespace(desired), &appsv1.DeploymentConfig{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if errors.IsNotFound(err) && desired.Spec.Replicas > 0 {
+		if desired.Annotations == nil {
+			desired.Annotations = map[string]string{}
+		}
+		desired.Annotations[HibernatedReplicasAnnotation] = strconv.Itoa(int(desired.Spec.Replicas))
+	}
+	desired.Spec.Replicas = 0
+	return nil
+}
+
+// hibernateReplicasMutator scales down the DeploymentConfig to zero replicas,
+// keeping the replicas it had in the hibernated replicas annotation
+func hibernateReplicasMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	if existing.Spec.Replicas == 0 {
+		return false, nil
+	}
+
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	existing.Annotations[HibernatedReplicasAnnotation] = strconv.Itoa(int(existing.Spec.Replicas))
+	existing.Spec.Replicas = 0
+	return true, nil
+}
+
+// wakeUpReplicasMutator restores the replicas kept in the hibernated replicas annotation
+func wakeUpReplicasMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	value, ok := existing.Annotations[HibernatedReplicasAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	delete(existing.Annotations, HibernatedReplicasAnnotation)
+	if replicas, err := strconv.ParseInt(value, 10, 32); err == nil {
+		existing.Spec.Replicas = int32(replicas)
+	}
+	return true, nil
+}
@@ -0,0 +1,127 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func hibernationTestDeploymentConfig(replicas int32) *appsv1.DeploymentConfig {
+	return &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend-listener", Namespace: "someNs"},
+		Spec:       appsv1.DeploymentConfigSpec{Replicas: replicas},
+	}
+}
+
+func TestHibernationMutateFn(t *testing.T) {
+	// Unmanaged replicas, e.g. scaled by a HorizontalPodAutoscaler
+	innerMutatefn := reconcilers.DeploymentConfigMutator(replicasMutator(false))
+	existing := hibernationTestDeploymentConfig(3)
+
+	changed, err := deploymentConfigMutateFn(true, innerMutatefn)(existing, hibernationTestDeploymentConfig(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Replicas != 0 {
+		t.Fatalf("expected scaled down replicas, got changed %t and %d replicas", changed, existing.Spec.Replicas)
+	}
+	if existing.Annotations[HibernatedReplicasAnnotation] != "3" {
+		t.Errorf("unexpected hibernated replicas annotation: %v", existing.Annotations)
+	}
+
+	changed, err = deploymentConfigMutateFn(true, innerMutatefn)(existing, hibernationTestDeploymentConfig(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("unexpected change while hibernated")
+	}
+
+	changed, err = deploymentConfigMutateFn(false, innerMutatefn)(existing, hibernationTestDeploymentConfig(defaultReplicas))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Replicas != 3 {
+		t.Fatalf("expected restored replicas, got changed %t and %d replicas", changed, existing.Spec.Replicas)
+	}
+	if _, ok := existing.Annotations[HibernatedReplicasAnnotation]; ok {
+		t.Errorf("unexpected hibernated replicas annotation: %v", existing.Annotations)
+	}
+}
+
+func TestHibernationMutateFnManagedReplicas(t *testing.T) {
+	// Replicas set in the APIManager take precedence over the restored ones
+	innerMutatefn := reconcilers.DeploymentConfigMutator(replicasMutator(true))
+	existing := hibernationTestDeploymentConfig(0)
+	existing.Annotations = map[string]string{HibernatedReplicasAnnotation: "3"}
+
+	changed, err := deploymentConfigMutateFn(false, innerMutatefn)(existing, hibernationTestDeploymentConfig(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Replicas != 2 {
+		t.Fatalf("expected replicas from the APIManager, got changed %t and %d replicas", changed, existing.Spec.Replicas)
+	}
+}
+
+func TestHibernationCreatedDeploymentConfig(t *testing.T) {
+	ctx := context.TODO()
+	apimanager := basicApimanager()
+	apimanager.Spec.Hibernated = true
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	objs := []runtime.Object{apimanager}
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, logf.Log.WithName("hibernation_test"), clientset.Discovery(), recorder)
+
+	// Components like the databases do not reconcile the replicas
+	reconcile := func() *appsv1.DeploymentConfig {
+		desired := hibernationTestDeploymentConfig(1)
+		desired.Namespace = namespace
+		desired.Spec.Template = &v1.PodTemplateSpec{
+			Spec: v1.PodSpec{Containers: []v1.Container{{Name: "system-memcache", Image: "memcached"}}},
+		}
+		if err := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager).ReconcileDeploymentConfig(desired, reconcilers.CreateOnlyMutator); err != nil {
+			t.Fatal(err)
+		}
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: namespace}, dc); err != nil {
+			t.Fatal(err)
+		}
+		return dc
+	}
+
+	dc := reconcile()
+	if dc.Spec.Replicas != 0 || dc.Annotations[HibernatedReplicasAnnotation] != "1" {
+		t.Fatalf("expected created scaled down, got %d replicas and annotations %v", dc.Spec.Replicas, dc.Annotations)
+	}
+
+	apimanager.Spec.Hibernated = false
+	dc = reconcile()
+	if dc.Spec.Replicas != 1 {
+		t.Errorf("expected restored replicas, got %d replicas", dc.Spec.Replicas)
+	}
+	if _, ok := dc.Annotations[HibernatedReplicasAnnotation]; ok {
+		t.Errorf("unexpected hibernated replicas annotation: %v", dc.Annotations)
+	}
+}
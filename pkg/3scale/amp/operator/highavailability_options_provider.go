@@ -1,6 +1,8 @@
 package operator
 
 import (
+	"fmt"
+
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/helper"
@@ -110,6 +112,30 @@ func (h *HighAvailabilityOptionsProvider) setBackendRedisOptions() error {
 			component.BackendSecretBackendRedisQueuesSentinelRoleFieldName,
 			component.DefaultBackendQueuesSentinelRole(),
 		},
+		{
+			&h.options.BackendRedisStorageUsername,
+			component.BackendSecretBackendRedisSecretName,
+			component.BackendSecretBackendRedisStorageUsernameFieldName,
+			"",
+		},
+		{
+			&h.options.BackendRedisStoragePassword,
+			component.BackendSecretBackendRedisSecretName,
+			component.BackendSecretBackendRedisStoragePasswordFieldName,
+			"",
+		},
+		{
+			&h.options.BackendRedisQueuesUsername,
+			component.BackendSecretBackendRedisSecretName,
+			component.BackendSecretBackendRedisQueuesUsernameFieldName,
+			"",
+		},
+		{
+			&h.options.BackendRedisQueuesPassword,
+			component.BackendSecretBackendRedisSecretName,
+			component.BackendSecretBackendRedisQueuesPasswordFieldName,
+			"",
+		},
 	}
 
 	for _, option := range casesWithDefault {
@@ -120,7 +146,16 @@ func (h *HighAvailabilityOptionsProvider) setBackendRedisOptions() error {
 		*option.field = val
 	}
 
-	return nil
+	err := validateRedisURLAndUsernameFieldsConsistency(component.BackendSecretBackendRedisSecretName,
+		component.BackendSecretBackendRedisStorageURLFieldName, h.options.BackendRedisStorageEndpoint,
+		component.BackendSecretBackendRedisStorageUsernameFieldName, h.options.BackendRedisStorageUsername)
+	if err != nil {
+		return err
+	}
+
+	return validateRedisURLAndUsernameFieldsConsistency(component.BackendSecretBackendRedisSecretName,
+		component.BackendSecretBackendRedisQueuesURLFieldName, h.options.BackendRedisQueuesEndpoint,
+		component.BackendSecretBackendRedisQueuesUsernameFieldName, h.options.BackendRedisQueuesUsername)
 }
 
 func (h *HighAvailabilityOptionsProvider) setSystemRedisOptions() error {
@@ -169,6 +204,18 @@ func (h *HighAvailabilityOptionsProvider) setSystemRedisOptions() error {
 			component.SystemSecretSystemRedisNamespace,
 			component.DefaultSystemRedisNamespace(),
 		},
+		{
+			&h.options.SystemRedisUsername,
+			component.SystemSecretSystemRedisSecretName,
+			component.SystemSecretSystemRedisUsername,
+			"",
+		},
+		{
+			&h.options.SystemRedisPassword,
+			component.SystemSecretSystemRedisSecretName,
+			component.SystemSecretSystemRedisPassword,
+			"",
+		},
 	}
 
 	for _, option := range casesWithDefault {
@@ -179,8 +226,23 @@ func (h *HighAvailabilityOptionsProvider) setSystemRedisOptions() error {
 		*option.field = val
 	}
 
-	return nil
+	return validateRedisURLAndUsernameFieldsConsistency(component.SystemSecretSystemRedisSecretName,
+		component.SystemSecretSystemRedisURLFieldName, h.options.SystemRedisURL,
+		component.SystemSecretSystemRedisUsername, h.options.SystemRedisUsername)
+}
 
+// validateRedisURLAndUsernameFieldsConsistency verifies the redis url can be parsed and,
+// when both are set, that the ACL username part of the url matches the username field.
+// The username part of the url is compared once percent-decoded
+func validateRedisURLAndUsernameFieldsConsistency(secretName, urlField, redisURL, usernameField, username string) error {
+	parsedURL, err := helper.ParseRedisURL(redisURL)
+	if err != nil {
+		return fmt.Errorf("GetHighAvailabilityOptions: error parsing provided '%s' field in '%s' secret: %w", urlField, secretName, err)
+	}
+	if parsedURL.Username != "" && username != "" && parsedURL.Username != username {
+		return fmt.Errorf("GetHighAvailabilityOptions: '%s' field in secret '%s' does not match username part in field '%s'. Inconsistency detected", usernameField, secretName, urlField)
+	}
+	return nil
 }
 
 func (h *HighAvailabilityOptionsProvider) setSystemDatabaseOptions() error {
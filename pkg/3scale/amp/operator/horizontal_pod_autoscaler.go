@@ -0,0 +1,25 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// horizontalPodAutoscalerOptions returns the autoscaling of a component, nil when not enabled
+func horizontalPodAutoscalerOptions(spec *appsv1alpha1.HorizontalPodAutoscalerSpec) *component.HorizontalPodAutoscalerOptions {
+	if spec == nil {
+		return nil
+	}
+
+	minReplicas := defaultReplicas
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+	}
+
+	return &component.HorizontalPodAutoscalerOptions{
+		MinReplicas:             minReplicas,
+		MaxReplicas:             spec.MaxReplicas,
+		CPUTargetUtilization:    spec.CPUTargetUtilization,
+		MemoryTargetUtilization: spec.MemoryTargetUtilization,
+	}
+}
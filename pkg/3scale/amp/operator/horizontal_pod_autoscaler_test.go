@@ -0,0 +1,81 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApicastProductionHorizontalPodAutoscaler(t *testing.T) {
+	var (
+		minReplicas int32 = 2
+		memory      int32 = 70
+	)
+
+	t.Run("Disabled", func(subT *testing.T) {
+		apicast, err := Apicast(basicApimanager(), fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apicast.Options.ProductionHPA != nil {
+			subT.Error("unexpected HPA options")
+		}
+		hpa := apicast.ProductionHorizontalPodAutoscaler()
+		if hpa.Name != component.ApicastProductionName || hpa.Spec.MaxReplicas != 0 {
+			subT.Errorf("unexpected HPA: %v", hpa)
+		}
+	})
+
+	t.Run("DefaultTarget", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Spec.Apicast.ProductionSpec.HPA = &appsv1alpha1.HorizontalPodAutoscalerSpec{MaxReplicas: 5}
+		apicast, err := Apicast(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		if apicast.Options.ProductionReplicasManaged || apicast.Options.ProductionReplicas != 1 {
+			subT.Error("expected production replicas not to be managed")
+		}
+
+		hpa := apicast.ProductionHorizontalPodAutoscaler()
+		if hpa.Spec.ScaleTargetRef.Kind != "DeploymentConfig" || hpa.Spec.ScaleTargetRef.Name != component.ApicastProductionName {
+			subT.Errorf("unexpected scale target: %v", hpa.Spec.ScaleTargetRef)
+		}
+		if *hpa.Spec.MinReplicas != 1 || hpa.Spec.MaxReplicas != 5 {
+			subT.Errorf("unexpected replicas limits: %d-%d", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+		}
+		if len(hpa.Spec.Metrics) != 1 || hpa.Spec.Metrics[0].Resource.Name != v1.ResourceCPU ||
+			*hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != component.DefaultHPACPUTargetUtilization {
+			subT.Errorf("unexpected metrics: %v", hpa.Spec.Metrics)
+		}
+	})
+
+	t.Run("MemoryTarget", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Spec.Apicast.ProductionSpec.HPA = &appsv1alpha1.HorizontalPodAutoscalerSpec{
+			MinReplicas:             &minReplicas,
+			MaxReplicas:             4,
+			MemoryTargetUtilization: &memory,
+		}
+		apicast, err := Apicast(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		if apicast.ProductionDeploymentConfig().Spec.Replicas != minReplicas {
+			subT.Error("expected the DeploymentConfig to be created with the minimum replicas")
+		}
+
+		metrics := apicast.ProductionHorizontalPodAutoscaler().Spec.Metrics
+		if len(metrics) != 1 || metrics[0].Type != autoscalingv2beta2.ResourceMetricSourceType ||
+			metrics[0].Resource.Name != v1.ResourceMemory || *metrics[0].Resource.Target.AverageUtilization != memory {
+			subT.Errorf("unexpected metrics: %v", metrics)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestImagePullPolicy(t *testing.T) {
+	apicastDC := func(production bool) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			apicast, err := Apicast(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			if production {
+				return apicast.ProductionDeploymentConfig(), nil
+			}
+			return apicast.StagingDeploymentConfig(), nil
+		}
+	}
+	zyncDC := func(dc func(*component.Zync) *appsv1.DeploymentConfig) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			zync, err := Zync(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return dc(zync), nil
+		}
+	}
+
+	cases := []struct {
+		testName string
+		set      func(*appsv1alpha1.APIManager, *v1.PullPolicy)
+		dc       func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error)
+	}{
+		{"apicast-production",
+			func(apimanager *appsv1alpha1.APIManager, val *v1.PullPolicy) {
+				apimanager.Spec.Apicast.ProductionSpec.ImagePullPolicy = val
+			},
+			apicastDC(true),
+		},
+		{"apicast-staging",
+			func(apimanager *appsv1alpha1.APIManager, val *v1.PullPolicy) {
+				apimanager.Spec.Apicast.StagingSpec.ImagePullPolicy = val
+			},
+			apicastDC(false),
+		},
+		{"zync",
+			func(apimanager *appsv1alpha1.APIManager, val *v1.PullPolicy) {
+				apimanager.Spec.Zync.AppSpec.ImagePullPolicy = val
+			},
+			zyncDC((*component.Zync).DeploymentConfig),
+		},
+		{"zync-que",
+			func(apimanager *appsv1alpha1.APIManager, val *v1.PullPolicy) {
+				apimanager.Spec.Zync.QueSpec.ImagePullPolicy = val
+			},
+			zyncDC((*component.Zync).QueDeploymentConfig),
+		},
+		// The zync database only follows the global image pull policy
+		{"zync-database",
+			nil,
+			zyncDC((*component.Zync).DatabaseDeploymentConfig),
+		},
+	}
+
+	type policyCase struct {
+		globalPolicy    *v1.PullPolicy
+		componentPolicy *v1.PullPolicy
+		expected        v1.PullPolicy
+	}
+	always := v1.PullAlways
+	never := v1.PullNever
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			policyCases := []policyCase{{&always, nil, v1.PullAlways}, {&never, nil, v1.PullNever}}
+			if tc.set != nil {
+				policyCases = append(policyCases, policyCase{&always, &never, v1.PullNever}, policyCase{nil, &always, v1.PullAlways})
+			}
+
+			for _, pc := range policyCases {
+				apimanager := basicApimanager()
+				apimanager.Spec.ImagePullPolicy = pc.globalPolicy
+				if pc.componentPolicy != nil {
+					tc.set(apimanager, pc.componentPolicy)
+				}
+
+				dc, err := tc.dc(apimanager)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				for _, container := range append(dc.Spec.Template.Spec.InitContainers, dc.Spec.Template.Spec.Containers...) {
+					if container.ImagePullPolicy != pc.expected {
+						subT.Errorf("container %s: expected image pull policy '%s', got '%s'", container.Name, pc.expected, container.ImagePullPolicy)
+					}
+				}
+			}
+		})
+	}
+}
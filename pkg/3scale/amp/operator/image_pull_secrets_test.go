@@ -0,0 +1,49 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestComponentImagePullSecrets(t *testing.T) {
+	gateway := []v1.LocalObjectReference{{Name: "gateway-auth"}}
+	que := []v1.LocalObjectReference{{Name: "que-auth"}}
+	global := []v1.LocalObjectReference{{Name: "global-auth"}}
+	merge := appsv1alpha1.ImagePullSecretsPolicyMerge
+
+	apimanager := basicApimanager()
+	apimanager.Spec.ImagePullSecrets = global
+	apimanager.Spec.ImagePullSecretsPolicy = &merge
+	apimanager.Spec.Apicast.ProductionSpec.ImagePullSecrets = gateway
+	apimanager.Spec.Zync.QueSpec.ImagePullSecrets = que
+
+	apicast, err := Apicast(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []v1.LocalObjectReference{{Name: "threescale-registry-auth"}, gateway[0]}
+	if secrets := apicast.ProductionDeploymentConfig().Spec.Template.Spec.ImagePullSecrets; !reflect.DeepEqual(secrets, expected) {
+		t.Errorf("apicast-production: expected image pull secrets %v, got %v", expected, secrets)
+	}
+	// The pods use the image pull secrets of the service account when not set in the component spec
+	if secrets := apicast.StagingDeploymentConfig().Spec.Template.Spec.ImagePullSecrets; secrets != nil {
+		t.Errorf("apicast-staging: unexpected image pull secrets %v", secrets)
+	}
+
+	zync, err := Zync(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []v1.LocalObjectReference{{Name: "threescale-registry-auth"}, que[0]}
+	if secrets := zync.QueServiceAccount().ImagePullSecrets; !reflect.DeepEqual(secrets, expected) {
+		t.Errorf("zync-que service account: expected image pull secrets %v, got %v", expected, secrets)
+	}
+	if secrets := zync.QueDeploymentConfig().Spec.Template.Spec.ImagePullSecrets; secrets != nil {
+		t.Errorf("zync-que: unexpected image pull secrets %v", secrets)
+	}
+}
@@ -44,3 +44,15 @@ func SystemPostgreSQLImageURL() string {
 func ZyncPostgreSQLImageURL() string {
 	return helper.GetEnvVar("RELATED_IMAGE_ZYNC_POSTGRESQL", component.ZyncPostgreSQLImageURL())
 }
+
+func RedisExporterImageURL() string {
+	return helper.GetEnvVar("RELATED_IMAGE_REDIS_EXPORTER", component.RedisExporterImageURL())
+}
+
+func MySQLExporterImageURL() string {
+	return helper.GetEnvVar("RELATED_IMAGE_MYSQL_EXPORTER", component.MySQLExporterImageURL())
+}
+
+func PostgreSQLExporterImageURL() string {
+	return helper.GetEnvVar("RELATED_IMAGE_POSTGRESQL_EXPORTER", component.PostgreSQLExporterImageURL())
+}
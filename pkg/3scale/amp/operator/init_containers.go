@@ -0,0 +1,26 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// initContainersOptions returns the init containers options of a component. Nil when
+// neither the wait init container is overridden nor init containers are added
+func initContainersOptions(wait *appsv1alpha1.InitContainerSpec, containers []v1.Container) *component.InitContainersOptions {
+	if wait == nil && len(containers) == 0 {
+		return nil
+	}
+
+	opts := &component.InitContainersOptions{Containers: containers}
+	if wait != nil {
+		opts.WaitDisabled = wait.Disabled != nil && *wait.Disabled
+		opts.WaitImage = wait.Image
+		opts.WaitCommand = wait.Command
+		opts.WaitTimeoutSeconds = wait.TimeoutSeconds
+	}
+
+	return opts
+}
@@ -30,6 +30,9 @@ func (m *MemcachedOptionsProvider) GetMemcachedOptions() (*component.MemcachedOp
 
 	m.setResourceRequirementsOptions()
 	m.setNodeAffinityAndTolerationsOptions()
+	m.setSecurityContextOptions()
+	m.setPriorityClassNameOptions()
+	m.setImagePullPolicyOptions()
 
 	err := m.memcachedOptions.Validate()
 	if err != nil {
@@ -54,8 +57,22 @@ func (m *MemcachedOptionsProvider) setResourceRequirementsOptions() {
 }
 
 func (m *MemcachedOptionsProvider) setNodeAffinityAndTolerationsOptions() {
+	m.memcachedOptions.NodeSelector = m.apimanager.Spec.System.MemcachedNodeSelector
 	m.memcachedOptions.Affinity = m.apimanager.Spec.System.MemcachedAffinity
-	m.memcachedOptions.Tolerations = m.apimanager.Spec.System.MemcachedTolerations
+	m.memcachedOptions.Tolerations = componentTolerations(m.apimanager, m.apimanager.Spec.System.MemcachedTolerations, nil)
+	m.memcachedOptions.TopologySpreadConstraints = m.apimanager.Spec.System.MemcachedTopologySpreadConstraints
+}
+
+func (m *MemcachedOptionsProvider) setSecurityContextOptions() {
+	m.memcachedOptions.SecurityContext = securityContextOptions(m.apimanager.Spec.System.MemcachedPodSecurityContext, m.apimanager.Spec.System.MemcachedSecurityContext)
+}
+
+func (m *MemcachedOptionsProvider) setPriorityClassNameOptions() {
+	m.memcachedOptions.PriorityClassName = helper.GetStringPointerValueOrDefault(m.apimanager.Spec.System.MemcachedPriorityClassName, "")
+}
+
+func (m *MemcachedOptionsProvider) setImagePullPolicyOptions() {
+	m.memcachedOptions.ImagePullPolicy = m.apimanager.Spec.ImagePullPolicy
 }
 
 func (m *MemcachedOptionsProvider) deploymentLabels() map[string]string {
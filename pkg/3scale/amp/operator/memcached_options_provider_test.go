@@ -109,6 +109,42 @@ func TestMemcachedOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"WithTopologySpreadConstraints",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.MemcachedTopologySpreadConstraints = getTestTopologySpreadConstraints("system-memcache")
+				return apimanager
+			},
+			func() *component.MemcachedOptions {
+				opts := defaultMemcachedOptions()
+				opts.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-memcache")
+				return opts
+			},
+		},
+		{"WithNodeSelector",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.MemcachedNodeSelector = map[string]string{"node-role": "memcached"}
+				return apimanager
+			},
+			func() *component.MemcachedOptions {
+				opts := defaultMemcachedOptions()
+				opts.NodeSelector = map[string]string{"node-role": "memcached"}
+				return opts
+			},
+		},
+		{"WithPriorityClassName",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.MemcachedPriorityClassName = &[]string{"memcached-priority"}[0]
+				return apimanager
+			},
+			func() *component.MemcachedOptions {
+				opts := defaultMemcachedOptions()
+				opts.PriorityClassName = "memcached-priority"
+				return opts
+			},
+		},
 		{"WithSystemMemcachedCustomResourceRequirements",
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanager()
@@ -3,6 +3,7 @@ package operator
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -28,10 +29,19 @@ func (r *MemcachedReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
 	)
-	err = r.ReconcileDeploymentConfig(memcached.DeploymentConfig(), mutator)
+	memcachedDC := memcached.DeploymentConfig()
+	if r.apiManager.IsSystemCacheStoreRedis() {
+		// system uses system-redis as cache store
+		common.TagObjectToDelete(memcachedDC)
+	}
+	err = r.ReconcileDeploymentConfig(memcachedDC, mutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
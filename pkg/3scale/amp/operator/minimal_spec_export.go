@@ -0,0 +1,143 @@
+package operator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	// MinimalSpecExportAnnotation triggers a one-shot export of the APIManager
+	// spec stripped of the fields holding default values
+	MinimalSpecExportAnnotation = "apps.3scale.net/export-minimal-spec"
+
+	MinimalSpecExportConfigMapManifestKey     = "apimanager.yaml"
+	MinimalSpecExportConfigMapNotMinimizedKey = "not-minimized-fields"
+)
+
+func MinimalSpecExportConfigMapName(apimanagerName string) string {
+	return fmt.Sprintf("%s-minimal-spec", apimanagerName)
+}
+
+// MinimalSpecManifestYAML returns a ready to commit APIManager manifest
+// holding only the given spec
+func MinimalSpecManifestYAML(apimanager *appsv1alpha1.APIManager, spec *appsv1alpha1.APIManagerSpec) (string, error) {
+	manifest := map[string]interface{}{
+		"apiVersion": appsv1alpha1.GroupVersion.String(),
+		"kind":       "APIManager",
+		"metadata": map[string]interface{}{
+			"name": apimanager.Name,
+		},
+		"spec": spec,
+	}
+	out, err := yaml.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+type MinimalSpecExportReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewMinimalSpecExportReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *MinimalSpecExportReconciler {
+	return &MinimalSpecExportReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+// Reconcile exports the minimal APIManager spec into a ConfigMap when the
+// APIManager is annotated with MinimalSpecExportAnnotation set to "true".
+// The annotation is removed once processed, so the export is only run once per annotation.
+func (r *MinimalSpecExportReconciler) Reconcile() (reconcile.Result, error) {
+	val, ok := r.apiManager.Annotations[MinimalSpecExportAnnotation]
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	if val != "true" {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "MinimalSpecExportError", "unexpected '%s' annotation value '%s'", MinimalSpecExportAnnotation, val)
+		return r.removeExportAnnotation()
+	}
+
+	minimalSpec, notMinimized, err := r.apiManager.MinimalSpec()
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "MinimalSpecExportError", "minimal spec export failed: %s", err.Error())
+		r.Logger().Error(err, "minimal spec export failed")
+		return r.removeExportAnnotation()
+	}
+
+	manifest, err := MinimalSpecManifestYAML(r.apiManager, minimalSpec)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	desired := &v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: MinimalSpecExportConfigMapName(r.apiManager.Name),
+			Labels: map[string]string{
+				"app": *r.apiManager.Spec.AppLabel,
+			},
+		},
+		Data: map[string]string{
+			MinimalSpecExportConfigMapManifestKey:     manifest,
+			MinimalSpecExportConfigMapNotMinimizedKey: strings.Join(notMinimized, "\n"),
+		},
+	}
+
+	err = r.ReconcileConfigMap(desired, minimalSpecExportConfigMapMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := fmt.Sprintf("Minimal spec exported into the '%s' ConfigMap", desired.Name)
+	if len(notMinimized) > 0 {
+		msg = fmt.Sprintf("%s. Not minimized fields: %s", msg, strings.Join(notMinimized, ", "))
+	}
+	r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "MinimalSpecExported", msg)
+	r.Logger().Info(msg)
+
+	return r.removeExportAnnotation()
+}
+
+func (r *MinimalSpecExportReconciler) removeExportAnnotation() (reconcile.Result, error) {
+	delete(r.apiManager.Annotations, MinimalSpecExportAnnotation)
+	err := r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{Requeue: true}, nil
+}
+
+func minimalSpecExportConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+
+	update := false
+	for _, key := range []string{MinimalSpecExportConfigMapManifestKey, MinimalSpecExportConfigMapNotMinimizedKey} {
+		fieldUpdated := reconcilers.ConfigMapReconcileField(desired, existing, key)
+		update = update || fieldUpdated
+	}
+
+	return update, nil
+}
@@ -0,0 +1,120 @@
+package operator
+
+import (
+	"context"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+// OptionsCache keeps the options computed by the options providers, so the
+// reconciles of unchanged APIManagers do not build them again. The options of
+// each provider are valid while the APIManager generation and the resource
+// versions of the secrets read to compute them are unchanged.
+// Cached options are shared between reconciles, they must not be modified.
+type OptionsCache struct {
+	mutex   sync.Mutex
+	entries map[optionsCacheKey]*optionsCacheEntry
+}
+
+type optionsCacheKey struct {
+	apimanager types.NamespacedName
+	provider   string
+}
+
+type optionsCacheEntry struct {
+	uid            types.UID
+	generation     int64
+	secretVersions map[string]string
+	options        interface{}
+}
+
+// DefaultOptionsCache is the cache shared by the APIManager reconciles
+var DefaultOptionsCache = NewOptionsCache()
+
+func NewOptionsCache() *OptionsCache {
+	return &OptionsCache{entries: map[optionsCacheKey]*optionsCacheEntry{}}
+}
+
+// GetOrCompute returns the cached options of the provider when still valid.
+// Otherwise, compute is called and its result cached along with the secrets
+// read by the returned secret source, which may be nil when no secret is read.
+// APIManagers not read from the cluster, without generation, are never cached.
+func (c *OptionsCache) GetOrCompute(apimanager *appsv1alpha1.APIManager, provider string, cl client.Client,
+	compute func() (interface{}, *helper.SecretSource, error)) (interface{}, error) {
+	if apimanager.Generation == 0 || apimanager.UID == "" {
+		options, _, err := compute()
+		return options, err
+	}
+
+	key := optionsCacheKey{
+		apimanager: types.NamespacedName{Name: apimanager.Name, Namespace: apimanager.Namespace},
+		provider:   provider,
+	}
+
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	c.mutex.Unlock()
+
+	if ok && entry.uid == apimanager.UID && entry.generation == apimanager.Generation {
+		unchanged, err := secretVersionsUnchanged(cl, apimanager.Namespace, entry.secretVersions)
+		if err != nil {
+			return nil, err
+		}
+		if unchanged {
+			return entry.options, nil
+		}
+	}
+
+	options, secretSource, err := compute()
+	if err != nil {
+		return nil, err
+	}
+
+	entry = &optionsCacheEntry{
+		uid:        apimanager.UID,
+		generation: apimanager.Generation,
+		options:    options,
+	}
+	if secretSource != nil {
+		entry.secretVersions = secretSource.ReadSecretVersions()
+	}
+
+	c.mutex.Lock()
+	c.entries[key] = entry
+	c.mutex.Unlock()
+
+	return options, nil
+}
+
+// Invalidate drops the cached options of the APIManager
+func (c *OptionsCache) Invalidate(apimanager types.NamespacedName) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key := range c.entries {
+		if key.apimanager == apimanager {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func secretVersionsUnchanged(cl client.Client, namespace string, versions map[string]string) (bool, error) {
+	for name, version := range versions {
+		secret := &v1.Secret{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, secret)
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+		if secret.ResourceVersion != version {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,166 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOptionsCache(t *testing.T) {
+	internalAPISecret := func(username string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: component.BackendSecretInternalApiSecretName, Namespace: namespace},
+			Data: map[string][]byte{
+				component.BackendSecretInternalApiUsernameFieldName: []byte(username),
+				component.BackendSecretInternalApiPasswordFieldName: []byte("password"),
+			},
+		}
+	}
+
+	setup := func() (*appsv1alpha1.APIManager, *OptionsCache, func(client.Client) (*component.BackendOptions, int)) {
+		apimanager := basicApimanager()
+		apimanager.UID = types.UID("someUID")
+		apimanager.Generation = 1
+
+		cache := NewOptionsCache()
+		computed := 0
+		backendOptions := func(cl client.Client) (*component.BackendOptions, int) {
+			opts, err := cache.GetOrCompute(apimanager, "backend", cl, func() (interface{}, *helper.SecretSource, error) {
+				computed++
+				optsProvider := NewOperatorBackendOptionsProvider(apimanager, namespace, cl)
+				opts, err := optsProvider.GetBackendOptions()
+				return opts, optsProvider.secretSource, err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return opts.(*component.BackendOptions), computed
+		}
+
+		return apimanager, cache, backendOptions
+	}
+
+	t.Run("Hit", func(subT *testing.T) {
+		_, _, backendOptions := setup()
+		cl := fake.NewFakeClient(internalAPISecret("someUser"))
+
+		first, _ := backendOptions(cl)
+		second, computed := backendOptions(cl)
+		if computed != 1 {
+			subT.Errorf("expected options to be computed once, computed %d times", computed)
+		}
+		if first != second {
+			subT.Error("expected cached options")
+		}
+	})
+
+	t.Run("GenerationChange", func(subT *testing.T) {
+		apimanager, _, backendOptions := setup()
+		cl := fake.NewFakeClient(internalAPISecret("someUser"))
+
+		backendOptions(cl)
+		apimanager.Generation = 2
+		apimanager.Spec.WildcardDomain = "other.3scale.net"
+		opts, computed := backendOptions(cl)
+		if computed != 2 {
+			subT.Errorf("expected options to be computed again, computed %d times", computed)
+		}
+		if opts.WildcardDomain != "other.3scale.net" {
+			subT.Errorf("unexpected wildcard domain: %s", opts.WildcardDomain)
+		}
+	})
+
+	t.Run("SecretChange", func(subT *testing.T) {
+		_, _, backendOptions := setup()
+		cl := fake.NewFakeClient(internalAPISecret("someUser"))
+
+		opts, _ := backendOptions(cl)
+		if opts.SystemBackendUsername != "someUser" {
+			subT.Fatalf("unexpected username: %s", opts.SystemBackendUsername)
+		}
+
+		secret := &v1.Secret{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: component.BackendSecretInternalApiSecretName, Namespace: namespace}, secret); err != nil {
+			subT.Fatal(err)
+		}
+		secret.Data[component.BackendSecretInternalApiUsernameFieldName] = []byte("otherUser")
+		if err := cl.Update(context.TODO(), secret); err != nil {
+			subT.Fatal(err)
+		}
+
+		opts, computed := backendOptions(cl)
+		if computed != 2 {
+			subT.Errorf("expected options to be computed again, computed %d times", computed)
+		}
+		if opts.SystemBackendUsername != "otherUser" {
+			subT.Errorf("expected username from the updated secret, got %s", opts.SystemBackendUsername)
+		}
+	})
+
+	t.Run("SecretCreated", func(subT *testing.T) {
+		_, _, backendOptions := setup()
+		cl := fake.NewFakeClient()
+
+		opts, _ := backendOptions(cl)
+		if opts.SystemBackendUsername != component.DefaultSystemBackendUsername() {
+			subT.Fatalf("expected default username, got %s", opts.SystemBackendUsername)
+		}
+
+		if err := cl.Create(context.TODO(), internalAPISecret("someUser")); err != nil {
+			subT.Fatal(err)
+		}
+
+		opts, _ = backendOptions(cl)
+		if opts.SystemBackendUsername != "someUser" {
+			subT.Errorf("expected username from the created secret, got %s", opts.SystemBackendUsername)
+		}
+	})
+
+	t.Run("Invalidate", func(subT *testing.T) {
+		apimanager, cache, backendOptions := setup()
+		cl := fake.NewFakeClient(internalAPISecret("someUser"))
+
+		backendOptions(cl)
+		cache.Invalidate(types.NamespacedName{Name: apimanager.Name, Namespace: apimanager.Namespace})
+		if _, computed := backendOptions(cl); computed != 2 {
+			subT.Errorf("expected options to be computed again, computed %d times", computed)
+		}
+	})
+}
+
+func BenchmarkBackend(b *testing.B) {
+	apimanager := basicApimanager()
+	cl := fake.NewFakeClient()
+
+	b.Run("Uncached", func(subB *testing.B) {
+		subB.ReportAllocs()
+		for i := 0; i < subB.N; i++ {
+			if _, err := Backend(apimanager, cl); err != nil {
+				subB.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Cached", func(subB *testing.B) {
+		cached := apimanager.DeepCopy()
+		cached.UID = types.UID("someUID")
+		cached.Generation = 1
+		defer DefaultOptionsCache.Invalidate(types.NamespacedName{Name: cached.Name, Namespace: cached.Namespace})
+
+		subB.ReportAllocs()
+		for i := 0; i < subB.N; i++ {
+			if _, err := Backend(cached, cl); err != nil {
+				subB.Fatal(err)
+			}
+		}
+	})
+}
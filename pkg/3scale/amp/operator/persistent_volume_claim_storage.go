@@ -0,0 +1,151 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	PersistentVolumeClaimSpecNotAppliedReason = "SpecNotApplied"
+)
+
+// ReconcilePersistentVolumeClaimStorage creates the PersistentVolumeClaim and applies the
+// storage size increases to the existing claim when its storage class allows volume expansion.
+// Sizes are never decreased. Changes of the immutable fields, and size increases that cannot
+// be applied, are reported in the PersistentVolumeClaimError condition instead of recreating the claim
+func (r *BaseAPIManagerLogicReconciler) ReconcilePersistentVolumeClaimStorage(desired *v1.PersistentVolumeClaim) error {
+	var problems []string
+	mutator := func(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+		existing, ok := existingObj.(*v1.PersistentVolumeClaim)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *v1.PersistentVolumeClaim", existingObj)
+		}
+		desired, ok := desiredObj.(*v1.PersistentVolumeClaim)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *v1.PersistentVolumeClaim", desiredObj)
+		}
+
+		var update bool
+		var err error
+		update, problems, err = r.persistentVolumeClaimStorageReconciler(desired, existing)
+		return update, err
+	}
+
+	err := r.ReconcilePersistentVolumeClaim(desired, mutator)
+	if err != nil {
+		return err
+	}
+
+	return r.setPersistentVolumeClaimProblems(desired.Name, problems)
+}
+
+func (r *BaseAPIManagerLogicReconciler) persistentVolumeClaimStorageReconciler(desired, existing *v1.PersistentVolumeClaim) (bool, []string, error) {
+	problems := []string{}
+
+	if desired.Spec.StorageClassName != nil &&
+		(existing.Spec.StorageClassName == nil || *existing.Spec.StorageClassName != *desired.Spec.StorageClassName) {
+		problems = append(problems, fmt.Sprintf("storageClassName cannot be changed to '%s'", *desired.Spec.StorageClassName))
+	}
+
+	if desired.Spec.VolumeName != "" && existing.Spec.VolumeName != desired.Spec.VolumeName {
+		problems = append(problems, fmt.Sprintf("volumeName cannot be changed to '%s'", desired.Spec.VolumeName))
+	}
+
+	if len(desired.Spec.AccessModes) > 0 && !reflect.DeepEqual(existing.Spec.AccessModes, desired.Spec.AccessModes) {
+		problems = append(problems, fmt.Sprintf("accessModes cannot be changed to %v", desired.Spec.AccessModes))
+	}
+
+	// The size of statically bound claims is the size of the volume
+	desiredSize, ok := desired.Spec.Resources.Requests[v1.ResourceStorage]
+	if !ok || desired.Spec.VolumeName != "" {
+		return false, problems, nil
+	}
+	existingSize := existing.Spec.Resources.Requests[v1.ResourceStorage]
+	if desiredSize.Cmp(existingSize) <= 0 {
+		return false, problems, nil
+	}
+
+	expandable, err := r.storageClassAllowsVolumeExpansion(existing.Spec.StorageClassName)
+	if err != nil {
+		return false, problems, err
+	}
+	if !expandable {
+		problems = append(problems, fmt.Sprintf("size cannot be increased to %s, the storage class does not allow volume expansion", desiredSize.String()))
+		return false, problems, nil
+	}
+
+	if existing.Spec.Resources.Requests == nil {
+		existing.Spec.Resources.Requests = v1.ResourceList{}
+	}
+	existing.Spec.Resources.Requests[v1.ResourceStorage] = desiredSize
+	return true, problems, nil
+}
+
+func (r *BaseAPIManagerLogicReconciler) storageClassAllowsVolumeExpansion(storageClassName *string) (bool, error) {
+	if storageClassName == nil || *storageClassName == "" {
+		return false, nil
+	}
+
+	// Storage classes are cluster scoped, they are read directly to avoid caching them all
+	storageClass := &storagev1.StorageClass{}
+	err := r.APIClientReader().Get(r.Context(), types.NamespacedName{Name: *storageClassName}, storageClass)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
+// setPersistentVolumeClaimProblems updates the problems of the given claim in the
+// PersistentVolumeClaimError condition, whose message lists the problems by claim
+func (r *BaseAPIManagerLogicReconciler) setPersistentVolumeClaimProblems(name string, problems []string) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		claimProblems := map[string]string{}
+		if condition := r.apiManager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerPersistentVolumeClaimErrorConditionType); condition != nil {
+			for _, entry := range strings.Split(condition.Message, "; ") {
+				parts := strings.SplitN(entry, ": ", 2)
+				if len(parts) == 2 {
+					claimProblems[parts[0]] = parts[1]
+				}
+			}
+		}
+
+		delete(claimProblems, name)
+		if len(problems) > 0 {
+			claimProblems[name] = strings.Join(problems, ", ")
+		}
+
+		if len(claimProblems) == 0 {
+			r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerPersistentVolumeClaimErrorConditionType)
+			return nil
+		}
+
+		entries := []string{}
+		for claim, msg := range claimProblems {
+			entries = append(entries, fmt.Sprintf("%s: %s", claim, msg))
+		}
+		sort.Strings(entries)
+
+		r.apiManager.Status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerPersistentVolumeClaimErrorConditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  PersistentVolumeClaimSpecNotAppliedReason,
+			Message: strings.Join(entries, "; "),
+		})
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,131 @@
+package operator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestReconcilePersistentVolumeClaimStorage(t *testing.T) {
+	var (
+		appLabel  = "someLabel"
+		log       = logf.Log.WithName("operator_test")
+		trueValue = true
+	)
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+		},
+	}
+
+	pvc := func(size, storageClassName string) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: component.ZyncDatabaseDataVolumeName, Namespace: namespace},
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(size)},
+				},
+				StorageClassName: &storageClassName,
+			},
+		}
+	}
+	expandable := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		AllowVolumeExpansion: &trueValue,
+	}
+	fixed := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "fixed"},
+	}
+
+	cases := []struct {
+		testName        string
+		existing        *v1.PersistentVolumeClaim
+		desired         *v1.PersistentVolumeClaim
+		expectedSize    string
+		expectedProblem string
+	}{
+		{"sizeIncreased", pvc("1Gi", "expandable"), pvc("2Gi", "expandable"), "2Gi", ""},
+		{"sizeDecreased", pvc("2Gi", "expandable"), pvc("1Gi", "expandable"), "2Gi", ""},
+		{"sizeNotExpandable", pvc("1Gi", "fixed"), pvc("2Gi", "fixed"), "1Gi", "size cannot be increased to 2Gi"},
+		{"storageClassChanged", pvc("1Gi", "fixed"), pvc("1Gi", "expandable"), "1Gi", "storageClassName cannot be changed to 'expandable'"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			s := scheme.Scheme
+			s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+			objs := []runtime.Object{apimanager.DeepCopy(), tc.existing, expandable, fixed}
+			cl := fake.NewFakeClient(objs...)
+			clientset := fakeclientset.NewSimpleClientset()
+			baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, fake.NewFakeClient(objs...), log, clientset.Discovery(), record.NewFakeRecorder(10000))
+			r := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager.DeepCopy())
+
+			if err := r.ReconcilePersistentVolumeClaimStorage(tc.desired); err != nil {
+				subT.Fatal(err)
+			}
+
+			existing := &v1.PersistentVolumeClaim{}
+			if err := cl.Get(context.TODO(), types.NamespacedName{Name: component.ZyncDatabaseDataVolumeName, Namespace: namespace}, existing); err != nil {
+				subT.Fatal(err)
+			}
+			size := existing.Spec.Resources.Requests[v1.ResourceStorage]
+			if size.Cmp(resource.MustParse(tc.expectedSize)) != 0 {
+				subT.Errorf("unexpected size. Expected: %s, got: %s", tc.expectedSize, size.String())
+			}
+
+			condition := r.apiManager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerPersistentVolumeClaimErrorConditionType)
+			if tc.expectedProblem == "" {
+				if condition != nil {
+					subT.Errorf("unexpected condition: %v", condition)
+				}
+				return
+			}
+			if condition == nil || !strings.Contains(condition.Message, component.ZyncDatabaseDataVolumeName+": "+tc.expectedProblem) {
+				subT.Errorf("expected problem '%s' in condition: %v", tc.expectedProblem, condition)
+			}
+		})
+	}
+}
+
+func TestZyncDatabasePersistentVolumeClaim(t *testing.T) {
+	storageClassName := "fast"
+	zync := component.NewZync(&component.ZyncOptions{})
+	if zync.DatabasePersistentVolumeClaim() != nil {
+		t.Error("unexpected PersistentVolumeClaim without storage options")
+	}
+
+	zync = component.NewZync(&component.ZyncOptions{
+		DatabaseStorage: &component.ZyncDatabaseStorageOptions{
+			Size:             resource.MustParse("5Gi"),
+			StorageClassName: &storageClassName,
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		},
+	})
+	pvc := zync.DatabasePersistentVolumeClaim()
+	if pvc == nil {
+		t.Fatal("expected PersistentVolumeClaim")
+	}
+	size := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if size.Cmp(resource.MustParse("5Gi")) != 0 || pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != storageClassName {
+		t.Errorf("unexpected PersistentVolumeClaim spec: %v", pvc.Spec)
+	}
+}
@@ -0,0 +1,19 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// podDisruptionBudgetOptions returns the disruption policy of a component,
+// nil when the default policy applies
+func podDisruptionBudgetOptions(policy *appsv1alpha1.PodDisruptionBudgetPolicySpec) *component.PodDisruptionBudgetOptions {
+	if policy == nil || (policy.MinAvailable == nil && policy.MaxUnavailable == nil) {
+		return nil
+	}
+
+	return &component.PodDisruptionBudgetOptions{
+		MinAvailable:   policy.MinAvailable,
+		MaxUnavailable: policy.MaxUnavailable,
+	}
+}
@@ -0,0 +1,179 @@
+package operator
+
+import (
+	"fmt"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// podTemplateOverrides are the parts of a pod template set in the APIManager
+// that are read before the mutators of the components run
+type podTemplateOverrides struct {
+	hash     string
+	extraEnv []string
+	sidecars *component.SidecarsOptions
+}
+
+// deploymentConfigMutateFn reconciles, on top of mutatefn, the parts of the DeploymentConfigs
+// managed for all the components: the replicas while scaled down, the pod template settings
+// reconciled by podTemplateMutator and the pod template overrides set in the APIManager
+func deploymentConfigMutateFn(scaledDown bool, mutatefn reconcilers.MutateFn) reconcilers.MutateFn {
+	replicasMutator := wakeUpReplicasMutator
+	if scaledDown {
+		replicasMutator = hibernateReplicasMutator
+	}
+	return func(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+		existing, ok := existingObj.(*appsv1.DeploymentConfig)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *appsv1.DeploymentConfig", existingObj)
+		}
+		desired, ok := desiredObj.(*appsv1.DeploymentConfig)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *appsv1.DeploymentConfig", desiredObj)
+		}
+
+		// Before mutatefn, so the replicas mutators of the components take over the restored replicas
+		update, err := replicasMutator(desired, existing)
+		if err != nil {
+			return false, err
+		}
+
+		// Read before mutatefn, the pod template annotations mutator sets the desired annotations.
+		// The sidecars are left out while mutatefn runs, as the mutators of the components only
+		// expect the containers and volumes managed by the operator
+		existingOverrides := removePodTemplateOverrides(existing.Spec.Template)
+		desiredOverrides := removePodTemplateOverrides(desired.Spec.Template)
+
+		tmpUpdate, err := mutatefn(existingObj, desiredObj)
+		if err != nil {
+			return false, err
+		}
+		update = update || tmpUpdate
+
+		tmpUpdate, err = podTemplateMutator(desired, existing)
+		if err != nil {
+			return false, err
+		}
+		update = update || tmpUpdate
+
+		tmpUpdate, err = podTemplateOverridesReconciler(desired, existing, desiredOverrides, existingOverrides)
+		if err != nil {
+			return false, err
+		}
+		return update || tmpUpdate, nil
+	}
+}
+
+// podTemplateMutator reconciles the pod template settings set by the operator for all the components:
+// the labels, the service account, the termination message and image pull policies of the containers
+// and the seccomp profile and secret hash annotations
+func podTemplateMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	if desired.Spec.Template == nil || existing.Spec.Template == nil {
+		return false, nil
+	}
+
+	update := false
+
+	for _, mutator := range []reconcilers.DCMutateFn{
+		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigServiceAccountNameMutator,
+		reconcilers.DeploymentConfigTerminationMessagePolicyMutator,
+		reconcilers.DeploymentConfigImagePullPolicyMutator,
+	} {
+		tmpUpdate, err := mutator(desired, existing)
+		if err != nil {
+			return false, err
+		}
+		update = update || tmpUpdate
+	}
+
+	// The pod template annotations mutator keeps the annotations not desired
+	for _, annotation := range []string{v1.SeccompPodAnnotationKey, component.SecretHashAnnotation} {
+		tmpUpdate := reconcilers.DeploymentConfigPodTemplateAnnotationReconciler(desired, existing, annotation)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
+// removePodTemplateOverrides removes the sidecars from the pod template and
+// returns them along with the pod template overrides hash and extra env vars
+func removePodTemplateOverrides(template *v1.PodTemplateSpec) podTemplateOverrides {
+	if template == nil {
+		return podTemplateOverrides{sidecars: &component.SidecarsOptions{}}
+	}
+
+	return podTemplateOverrides{
+		hash:     template.Annotations[component.PodTemplateOverridesHashAnnotation],
+		extraEnv: component.ExtraEnvNames(template.Annotations[component.ExtraEnvAnnotation]),
+		sidecars: removeSidecars(template),
+	}
+}
+
+// podTemplateOverridesReconciler restores the sidecars and, when the pod template overrides hash changes,
+// reconciles from the desired pod template the env vars, sidecars, init containers, termination, probes
+// and Ruby runtime tuning set in the APIManager. They are defaulted by the API server in the existing pod
+// template, so they are not compared while the hash is unchanged
+func podTemplateOverridesReconciler(desired, existing *appsv1.DeploymentConfig, desiredOverrides, existingOverrides podTemplateOverrides) (bool, error) {
+	restoreSidecars(desired.Spec.Template, desiredOverrides.sidecars)
+
+	if existingOverrides.hash == desiredOverrides.hash || desired.Spec.Template == nil || existing.Spec.Template == nil {
+		restoreSidecars(existing.Spec.Template, existingOverrides.sidecars)
+		return false, nil
+	}
+
+	restoreSidecars(existing.Spec.Template, desiredOverrides.sidecars)
+
+	existing.Spec.Template.Spec.InitContainers = desired.Spec.Template.Spec.InitContainers
+	// The overridden wait init container is removed from the image change trigger
+	if _, err := reconcilers.DeploymentConfigImageChangeTriggerContainerNamesMutator(desired, existing); err != nil {
+		return false, err
+	}
+
+	existing.Spec.Template.Spec.TerminationGracePeriodSeconds = desired.Spec.Template.Spec.TerminationGracePeriodSeconds
+
+	for desiredIdx := range desired.Spec.Template.Spec.Containers {
+		desiredContainer := &desired.Spec.Template.Spec.Containers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.Containers {
+			existingContainer := &existing.Spec.Template.Spec.Containers[existingIdx]
+			if existingContainer.Name != desiredContainer.Name {
+				continue
+			}
+
+			existingContainer.Lifecycle = desiredContainer.Lifecycle
+			probeTimingReconciler(desiredContainer.LivenessProbe, existingContainer.LivenessProbe)
+			probeTimingReconciler(desiredContainer.ReadinessProbe, existingContainer.ReadinessProbe)
+			if desiredContainer.StartupProbe == nil || existingContainer.StartupProbe == nil {
+				existingContainer.StartupProbe = desiredContainer.StartupProbe
+			} else {
+				probeTimingReconciler(desiredContainer.StartupProbe, existingContainer.StartupProbe)
+			}
+			break
+		}
+	}
+
+	// The operator managed env vars are reconciled by the mutators of each component
+	envVarNames := append([]string{}, component.RubyRuntimeTuningEnvVarNames...)
+	envVarNames = append(envVarNames, existingOverrides.extraEnv...)
+	envVarNames = append(envVarNames, desiredOverrides.extraEnv...)
+	for _, name := range envVarNames {
+		reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, name)
+	}
+
+	// The pod template annotations mutator keeps the annotations not desired
+	for _, annotation := range []string{
+		component.PodTemplateOverridesHashAnnotation,
+		component.ExtraEnvAnnotation,
+		component.SidecarContainersAnnotation,
+		component.SidecarVolumesAnnotation,
+	} {
+		reconcilers.DeploymentConfigPodTemplateAnnotationReconciler(desired, existing, annotation)
+	}
+
+	return true, nil
+}
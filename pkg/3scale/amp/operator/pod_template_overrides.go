@@ -0,0 +1,26 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+// podTemplateOverridesOptions returns the pod template settings of a component overridden in the APIManager.
+// The image pull policy and image pull secrets of the component fall back to the global ones
+func podTemplateOverridesOptions(apimanager *appsv1alpha1.APIManager, spec *appsv1alpha1.PodTemplateOverridesSpec) component.PodTemplateOverridesOptions {
+	opts := component.PodTemplateOverridesOptions{
+		NodeSelector:              spec.NodeSelector,
+		TopologySpreadConstraints: spec.TopologySpreadConstraints,
+		PriorityClassName:         helper.GetStringPointerValueOrDefault(spec.PriorityClassName, ""),
+		DNSConfig:                 spec.DNSConfig,
+		HostAliases:               spec.HostAliases,
+		RuntimeClassName:          spec.RuntimeClassName,
+		ImagePullPolicy:           apimanager.ImagePullPolicy(spec.ImagePullPolicy),
+		ImagePullSecrets:          apimanager.ComponentImagePullSecrets(component.AmpImagesDefaultImagePullSecrets(), spec.ImagePullSecrets),
+	}
+	if spec.DNSPolicy != nil {
+		opts.DNSPolicy = *spec.DNSPolicy
+	}
+	return opts
+}
@@ -0,0 +1,548 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// podTemplateOverridesTestDC is a DeploymentConfig of a component along with the
+// APIManager fields overriding its pod template. The optional fields are nil when
+// the component does not support them
+type podTemplateOverridesTestDC struct {
+	name          string
+	spec          func(*appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec
+	probes        func(*appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec
+	wait          func(*appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec
+	runtimeTuning func(*appsv1alpha1.APIManager) **appsv1alpha1.RubyRuntimeTuningSpec
+	dc            func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error)
+}
+
+func podTemplateOverridesTestDCs() []podTemplateOverridesTestDC {
+	apicastDC := func(dc func(*component.Apicast) *appsv1.DeploymentConfig) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			apicast, err := Apicast(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return dc(apicast), nil
+		}
+	}
+	backendDC := func(dc func(*component.Backend) *appsv1.DeploymentConfig) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			backend, err := Backend(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return dc(backend), nil
+		}
+	}
+	systemDC := func(dc func(*component.System) *appsv1.DeploymentConfig) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			system, err := System(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return dc(system), nil
+		}
+	}
+	zyncDC := func(dc func(*component.Zync) *appsv1.DeploymentConfig) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			zync, err := Zync(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return dc(zync), nil
+		}
+	}
+
+	return []podTemplateOverridesTestDC{
+		{
+			name: "apicast-production",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Apicast.ProductionSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec {
+				return &a.Spec.Apicast.ProductionSpec.Probes
+			},
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.Apicast.ProductionSpec.WaitInitContainer
+			},
+			dc: apicastDC((*component.Apicast).ProductionDeploymentConfig),
+		},
+		{
+			name: "apicast-staging",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Apicast.StagingSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.Apicast.StagingSpec.Probes },
+			dc:     apicastDC((*component.Apicast).StagingDeploymentConfig),
+		},
+		{
+			name: "backend-listener",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Backend.ListenerSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.Backend.ListenerSpec.Probes },
+			dc:     backendDC((*component.Backend).ListenerDeploymentConfig),
+		},
+		{
+			name: "backend-worker",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Backend.WorkerSpec.PodTemplateOverridesSpec
+			},
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.Backend.WorkerSpec.WaitInitContainer
+			},
+			dc: backendDC((*component.Backend).WorkerDeploymentConfig),
+		},
+		{
+			name: "backend-cron",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Backend.CronSpec.PodTemplateOverridesSpec
+			},
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.Backend.CronSpec.WaitInitContainer
+			},
+			dc: backendDC((*component.Backend).CronDeploymentConfig),
+		},
+		{
+			name: "system-app",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.System.AppSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.System.AppSpec.Probes },
+			runtimeTuning: func(a *appsv1alpha1.APIManager) **appsv1alpha1.RubyRuntimeTuningSpec {
+				return &a.Spec.System.AppSpec.RuntimeTuning
+			},
+			dc: systemDC((*component.System).AppDeploymentConfig),
+		},
+		{
+			name: "system-sidekiq",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.System.SidekiqSpec.PodTemplateOverridesSpec
+			},
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.System.SidekiqSpec.WaitInitContainer
+			},
+			runtimeTuning: func(a *appsv1alpha1.APIManager) **appsv1alpha1.RubyRuntimeTuningSpec {
+				return &a.Spec.System.SidekiqSpec.RuntimeTuning
+			},
+			dc: systemDC((*component.System).SidekiqDeploymentConfig),
+		},
+		{
+			name: "system-sphinx",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.System.SphinxSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.System.SphinxSpec.Probes },
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.System.SphinxSpec.WaitInitContainer
+			},
+			dc: systemDC((*component.System).SphinxDeploymentConfig),
+		},
+		{
+			name: "zync",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Zync.AppSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.Zync.AppSpec.Probes },
+			wait: func(a *appsv1alpha1.APIManager) **appsv1alpha1.InitContainerSpec {
+				return &a.Spec.Zync.AppSpec.WaitInitContainer
+			},
+			runtimeTuning: func(a *appsv1alpha1.APIManager) **appsv1alpha1.RubyRuntimeTuningSpec {
+				return &a.Spec.Zync.AppSpec.RuntimeTuning
+			},
+			dc: zyncDC((*component.Zync).DeploymentConfig),
+		},
+		{
+			name: "zync-que",
+			spec: func(a *appsv1alpha1.APIManager) *appsv1alpha1.PodTemplateOverridesSpec {
+				return &a.Spec.Zync.QueSpec.PodTemplateOverridesSpec
+			},
+			probes: func(a *appsv1alpha1.APIManager) **appsv1alpha1.ProbesSpec { return &a.Spec.Zync.QueSpec.Probes },
+			runtimeTuning: func(a *appsv1alpha1.APIManager) **appsv1alpha1.RubyRuntimeTuningSpec {
+				return &a.Spec.Zync.QueSpec.RuntimeTuning
+			},
+			dc: zyncDC((*component.Zync).QueDeploymentConfig),
+		},
+	}
+}
+
+func imageChangeTriggerContainerNames(dc *appsv1.DeploymentConfig) []string {
+	for _, trigger := range dc.Spec.Triggers {
+		if trigger.ImageChangeParams != nil {
+			return trigger.ImageChangeParams.ContainerNames
+		}
+	}
+	return nil
+}
+
+func TestPodTemplateOverrides(t *testing.T) {
+	var (
+		gracePeriod  int64 = 120
+		initialDelay int32 = 300
+		arenas       int32 = 2
+		waitTimeout  int32 = 60
+		trueValue          = true
+	)
+	waitImage := "registry.example.com/wait:latest"
+	preStopCommand := []string{"sleep", "10"}
+	extraEnv := v1.EnvVar{Name: "EXTRA_ENV_VAR", Value: "extra"}
+	sidecar := v1.Container{Name: "log-shipper", Image: "fluent/fluent-bit:2.1"}
+	sidecarVolume := v1.Volume{Name: "log-shipper-config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+		LocalObjectReference: v1.LocalObjectReference{Name: "log-shipper"},
+	}}}
+	initContainer := v1.Container{Name: "wait-for-proxy", Image: "registry.example.com/tools:latest"}
+
+	cases := []struct {
+		name      string
+		supported func(podTemplateOverridesTestDC) bool
+		override  func(*appsv1alpha1.APIManager, podTemplateOverridesTestDC)
+		validate  func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig)
+	}{
+		{
+			name: "Env",
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				testDC.spec(apimanager).Env = []v1.EnvVar{extraEnv}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				for idx, container := range dc.Spec.Template.Spec.Containers {
+					expectedEnv := append(append([]v1.EnvVar{}, defaultDC.Spec.Template.Spec.Containers[idx].Env...), extraEnv)
+					if !reflect.DeepEqual(container.Env, expectedEnv) {
+						t.Errorf("expected the extra env var after the operator managed env vars of %s, got %v", container.Name, container.Env)
+					}
+				}
+				if val := dc.Spec.Template.Annotations[component.ExtraEnvAnnotation]; val != extraEnv.Name {
+					t.Errorf("unexpected extra env annotation: '%s'", val)
+				}
+			},
+		},
+		{
+			name: "Sidecars",
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				testDC.spec(apimanager).Sidecars = []v1.Container{sidecar}
+				testDC.spec(apimanager).SidecarVolumes = []v1.Volume{sidecarVolume}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				containers := dc.Spec.Template.Spec.Containers
+				if len(containers) != len(defaultDC.Spec.Template.Spec.Containers)+1 || !reflect.DeepEqual(containers[len(containers)-1], sidecar) {
+					t.Errorf("expected the sidecar after the operator managed containers, got %v", containers)
+				}
+				volumes := dc.Spec.Template.Spec.Volumes
+				if len(volumes) != len(defaultDC.Spec.Template.Spec.Volumes)+1 || volumes[len(volumes)-1].Name != sidecarVolume.Name {
+					t.Errorf("expected the sidecar volume after the operator managed volumes, got %v", volumes)
+				}
+				if val := dc.Spec.Template.Annotations[component.SidecarContainersAnnotation]; val != sidecar.Name {
+					t.Errorf("unexpected sidecar containers annotation: '%s'", val)
+				}
+				if val := dc.Spec.Template.Annotations[component.SidecarVolumesAnnotation]; val != sidecarVolume.Name {
+					t.Errorf("unexpected sidecar volumes annotation: '%s'", val)
+				}
+			},
+		},
+		{
+			name: "InitContainers",
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				testDC.spec(apimanager).InitContainers = []v1.Container{initContainer}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				expected := append(append([]v1.Container{}, defaultDC.Spec.Template.Spec.InitContainers...), initContainer)
+				if !reflect.DeepEqual(dc.Spec.Template.Spec.InitContainers, expected) {
+					t.Errorf("expected the init container after the operator managed init containers, got %v", dc.Spec.Template.Spec.InitContainers)
+				}
+				if names := imageChangeTriggerContainerNames(dc); !reflect.DeepEqual(names, imageChangeTriggerContainerNames(defaultDC)) {
+					t.Errorf("unexpected image change trigger containers: %v", names)
+				}
+			},
+		},
+		{
+			name: "Termination",
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				testDC.spec(apimanager).TerminationGracePeriodSeconds = &gracePeriod
+				testDC.spec(apimanager).PreStopCommand = preStopCommand
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				if dc.Spec.Template.Spec.TerminationGracePeriodSeconds == nil || *dc.Spec.Template.Spec.TerminationGracePeriodSeconds != gracePeriod {
+					t.Errorf("unexpected termination grace period: %v", dc.Spec.Template.Spec.TerminationGracePeriodSeconds)
+				}
+				for _, container := range dc.Spec.Template.Spec.Containers {
+					if container.Lifecycle == nil || container.Lifecycle.PreStop == nil || container.Lifecycle.PreStop.Exec == nil ||
+						!reflect.DeepEqual(container.Lifecycle.PreStop.Exec.Command, preStopCommand) {
+						t.Errorf("expected the preStop command in the container %s, got %v", container.Name, container.Lifecycle)
+					}
+				}
+			},
+		},
+		{
+			name:      "Probes",
+			supported: func(testDC podTemplateOverridesTestDC) bool { return testDC.probes != nil },
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				*testDC.probes(apimanager) = &appsv1alpha1.ProbesSpec{
+					Liveness: &appsv1alpha1.ProbeSpec{InitialDelaySeconds: &initialDelay},
+					Startup:  &appsv1alpha1.ProbeSpec{},
+				}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				for idx, container := range dc.Spec.Template.Spec.Containers {
+					defaultContainer := defaultDC.Spec.Template.Spec.Containers[idx]
+					if defaultContainer.LivenessProbe == nil {
+						continue
+					}
+					if container.LivenessProbe.InitialDelaySeconds != initialDelay || container.LivenessProbe.PeriodSeconds != defaultContainer.LivenessProbe.PeriodSeconds {
+						t.Errorf("unexpected liveness probe timing of %s: %v", container.Name, container.LivenessProbe)
+					}
+					if !reflect.DeepEqual(container.ReadinessProbe, defaultContainer.ReadinessProbe) {
+						t.Errorf("expected the default readiness probe of %s to be kept", container.Name)
+					}
+					if container.StartupProbe == nil || !reflect.DeepEqual(container.StartupProbe.Handler, defaultContainer.LivenessProbe.Handler) {
+						t.Errorf("expected the startup probe of %s to check the liveness endpoint, got %v", container.Name, container.StartupProbe)
+					}
+				}
+			},
+		},
+		{
+			name:      "WaitInitContainerDisabled",
+			supported: func(testDC podTemplateOverridesTestDC) bool { return testDC.wait != nil },
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				*testDC.wait(apimanager) = &appsv1alpha1.InitContainerSpec{Disabled: &trueValue}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				if !reflect.DeepEqual(dc.Spec.Template.Spec.InitContainers, defaultDC.Spec.Template.Spec.InitContainers[1:]) {
+					t.Errorf("expected the wait init container to be removed, got %v", dc.Spec.Template.Spec.InitContainers)
+				}
+				wait := defaultDC.Spec.Template.Spec.InitContainers[0].Name
+				if names := imageChangeTriggerContainerNames(dc); helper.ArrayContains(names, wait) {
+					t.Errorf("unexpected image change trigger containers: %v", names)
+				}
+			},
+		},
+		{
+			name:      "WaitInitContainer",
+			supported: func(testDC podTemplateOverridesTestDC) bool { return testDC.wait != nil },
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				*testDC.wait(apimanager) = &appsv1alpha1.InitContainerSpec{Image: &waitImage, TimeoutSeconds: &waitTimeout}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				wait := dc.Spec.Template.Spec.InitContainers[0]
+				defaultWait := defaultDC.Spec.Template.Spec.InitContainers[0]
+				if wait.Image != waitImage {
+					t.Errorf("unexpected wait init container image: '%s'", wait.Image)
+				}
+				if expected := append([]string{"timeout", "60"}, defaultWait.Command...); !reflect.DeepEqual(wait.Command, expected) {
+					t.Errorf("expected the command %v, got %v", expected, wait.Command)
+				}
+				if names := imageChangeTriggerContainerNames(dc); helper.ArrayContains(names, defaultWait.Name) {
+					t.Errorf("unexpected image change trigger containers: %v", names)
+				}
+			},
+		},
+		{
+			name:      "RuntimeTuning",
+			supported: func(testDC podTemplateOverridesTestDC) bool { return testDC.runtimeTuning != nil },
+			override: func(apimanager *appsv1alpha1.APIManager, testDC podTemplateOverridesTestDC) {
+				*testDC.runtimeTuning(apimanager) = &appsv1alpha1.RubyRuntimeTuningSpec{MallocArenaMax: &arenas}
+			},
+			validate: func(t *testing.T, dc, defaultDC *appsv1.DeploymentConfig) {
+				for _, container := range dc.Spec.Template.Spec.Containers {
+					idx := helper.FindEnvVar(container.Env, component.MallocArenaMaxEnvVarName)
+					if idx < 0 || container.Env[idx].Value != "2" {
+						t.Errorf("expected the runtime tuning env var in the container %s", container.Name)
+					}
+					if helper.FindEnvVar(container.Env, component.RubyGCHeapInitSlotsEnvVarName) >= 0 {
+						t.Errorf("unexpected heap init slots env var in the container %s", container.Name)
+					}
+				}
+			},
+		},
+	}
+
+	// Component mutators set the desired pod template annotations
+	mutatefn := deploymentConfigMutateFn(false, reconcilers.DeploymentConfigMutator(reconcilers.DeploymentConfigPodTemplateAnnotationsMutator))
+
+	for _, testDC := range podTemplateOverridesTestDCs() {
+		for _, tc := range cases {
+			if tc.supported != nil && !tc.supported(testDC) {
+				continue
+			}
+
+			t.Run(testDC.name+"/"+tc.name, func(subT *testing.T) {
+				newDC := func(override bool) *appsv1.DeploymentConfig {
+					apimanager := basicApimanager()
+					if override {
+						tc.override(apimanager, testDC)
+					}
+					dc, err := testDC.dc(apimanager)
+					if err != nil {
+						subT.Fatal(err)
+					}
+					return dc
+				}
+
+				defaultDC := newDC(false)
+				if _, ok := defaultDC.Spec.Template.Annotations[component.PodTemplateOverridesHashAnnotation]; ok {
+					subT.Error("unexpected pod template overrides hash annotation")
+				}
+				desired := newDC(true)
+				if desired.Spec.Template.Annotations[component.PodTemplateOverridesHashAnnotation] == "" {
+					subT.Error("expected the pod template overrides hash annotation")
+				}
+				tc.validate(subT, desired, defaultDC)
+
+				// Overrides added to the existing DeploymentConfig
+				existing := newDC(false)
+				changed, err := mutatefn(existing, desired)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if !changed {
+					subT.Error("expected the overrides to be reconciled")
+				}
+				if diff := cmp.Diff(desired.Spec, existing.Spec, cmpopts.EquateEmpty()); diff != "" {
+					subT.Errorf("unexpected reconciled spec (-want +got):\n%s", diff)
+				}
+
+				// Unchanged overrides defaulted by the API server are kept
+				for idx := range existing.Spec.Template.Spec.Containers {
+					existing.Spec.Template.Spec.Containers[idx].TerminationMessagePath = v1.TerminationMessagePathDefault
+				}
+				changed, err = mutatefn(existing, newDC(true))
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if changed {
+					subT.Error("unexpected update of the unchanged overrides")
+				}
+				for _, container := range existing.Spec.Template.Spec.Containers {
+					if container.TerminationMessagePath != v1.TerminationMessagePathDefault {
+						subT.Errorf("expected the existing container %s to be kept", container.Name)
+					}
+				}
+
+				// Overrides removed from the APIManager
+				existing = newDC(true)
+				changed, err = mutatefn(existing, defaultDC)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if !changed {
+					subT.Error("expected the removed overrides to be reconciled")
+				}
+				if diff := cmp.Diff(defaultDC.Spec, existing.Spec, cmpopts.EquateEmpty()); diff != "" {
+					subT.Errorf("unexpected reconciled spec (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestProbesOptionsValidation(t *testing.T) {
+	var (
+		zero     int32 = 0
+		negative int32 = -1
+	)
+
+	cases := []struct {
+		testName string
+		probes   *appsv1alpha1.ProbesSpec
+		valid    bool
+	}{
+		{"ZeroPeriod", &appsv1alpha1.ProbesSpec{Liveness: &appsv1alpha1.ProbeSpec{PeriodSeconds: &zero}}, false},
+		{"ZeroTimeout", &appsv1alpha1.ProbesSpec{Readiness: &appsv1alpha1.ProbeSpec{TimeoutSeconds: &zero}}, false},
+		{"ZeroFailureThreshold", &appsv1alpha1.ProbesSpec{Startup: &appsv1alpha1.ProbeSpec{FailureThreshold: &zero}}, false},
+		{"NegativeInitialDelay", &appsv1alpha1.ProbesSpec{Liveness: &appsv1alpha1.ProbeSpec{InitialDelaySeconds: &negative}}, false},
+		{"ZeroInitialDelay", &appsv1alpha1.ProbesSpec{Liveness: &appsv1alpha1.ProbeSpec{InitialDelaySeconds: &zero}}, true},
+	}
+
+	for _, testDC := range podTemplateOverridesTestDCs() {
+		if testDC.probes == nil {
+			continue
+		}
+		for _, tc := range cases {
+			t.Run(testDC.name+"/"+tc.testName, func(subT *testing.T) {
+				apimanager := basicApimanager()
+				*testDC.probes(apimanager) = tc.probes
+				_, err := testDC.dc(apimanager)
+				if tc.valid && err != nil {
+					subT.Error(err)
+				}
+				if !tc.valid && err == nil {
+					subT.Error("expected validation error")
+				}
+			})
+		}
+	}
+}
+
+// The env vars of the component containers must be reserved, so they cannot be shadowed
+func TestReservedEnvVarNames(t *testing.T) {
+	apimanager := basicApimanager()
+	apimanager.Spec.Monitoring = &appsv1alpha1.MonitoringSpec{Enabled: true}
+
+	apicast, err := Apicast(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend, err := Backend(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	system, err := System(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	zync, err := Zync(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		dc       *appsv1.DeploymentConfig
+		reserved []string
+	}{
+		{apicast.ProductionDeploymentConfig(), component.ApicastReservedEnvVarNames},
+		{apicast.StagingDeploymentConfig(), component.ApicastReservedEnvVarNames},
+		{backend.ListenerDeploymentConfig(), component.BackendReservedEnvVarNames},
+		{backend.WorkerDeploymentConfig(), component.BackendReservedEnvVarNames},
+		{backend.CronDeploymentConfig(), component.BackendReservedEnvVarNames},
+		{system.AppDeploymentConfig(), component.SystemReservedEnvVarNames},
+		{system.SidekiqDeploymentConfig(), component.SystemReservedEnvVarNames},
+		{zync.DeploymentConfig(), component.ZyncReservedEnvVarNames},
+		{zync.QueDeploymentConfig(), component.ZyncReservedEnvVarNames},
+	}
+
+	for _, tc := range cases {
+		reserved := map[string]bool{}
+		for _, name := range tc.reserved {
+			reserved[name] = true
+		}
+		for _, container := range tc.dc.Spec.Template.Spec.Containers {
+			for _, envVar := range container.Env {
+				if !reserved[envVar.Name] {
+					t.Errorf("%s: env var %s of container %s not reserved", tc.dc.Name, envVar.Name, container.Name)
+				}
+			}
+		}
+	}
+
+	// The system-environment ConfigMap keys are loaded as env vars
+	for key := range system.EnvironmentConfigMap().Data {
+		found := false
+		for _, name := range component.SystemReservedEnvVarNames {
+			found = found || name == key
+		}
+		if !found {
+			t.Errorf("system-environment key %s not reserved", key)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPriorityClassName(t *testing.T) {
+	cases := []struct {
+		testName string
+		set      func(*appsv1alpha1.APIManager, *string)
+		dc       func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error)
+	}{
+		{"apicast-production",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Apicast.ProductionSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				apicast, err := Apicast(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return apicast.ProductionDeploymentConfig(), nil
+			},
+		},
+		{"apicast-staging",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Apicast.StagingSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				apicast, err := Apicast(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return apicast.StagingDeploymentConfig(), nil
+			},
+		},
+		{"backend-listener",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Backend.ListenerSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				backend, err := Backend(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return backend.ListenerDeploymentConfig(), nil
+			},
+		},
+		{"backend-worker",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Backend.WorkerSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				backend, err := Backend(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return backend.WorkerDeploymentConfig(), nil
+			},
+		},
+		{"backend-cron",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Backend.CronSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				backend, err := Backend(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return backend.CronDeploymentConfig(), nil
+			},
+		},
+		{"system-app",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.System.AppSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				system, err := System(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return system.AppDeploymentConfig(), nil
+			},
+		},
+		{"system-sidekiq",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.System.SidekiqSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				system, err := System(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return system.SidekiqDeploymentConfig(), nil
+			},
+		},
+		{"system-sphinx",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.System.SphinxSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				system, err := System(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return system.SphinxDeploymentConfig(), nil
+			},
+		},
+		{"zync",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Zync.AppSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				zync, err := Zync(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return zync.DeploymentConfig(), nil
+			},
+		},
+		{"zync-que",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Zync.QueSpec.PriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				zync, err := Zync(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return zync.QueDeploymentConfig(), nil
+			},
+		},
+		{"zync-database",
+			func(apimanager *appsv1alpha1.APIManager, val *string) {
+				apimanager.Spec.Zync.DatabasePriorityClassName = val
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				zync, err := Zync(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return zync.DatabaseDeploymentConfig(), nil
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			for _, expected := range []string{"", "high-priority"} {
+				apimanager := basicApimanager()
+				if expected != "" {
+					tc.set(apimanager, &expected)
+				}
+
+				dc, err := tc.dc(apimanager)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if dc.Spec.Template.Spec.PriorityClassName != expected {
+					subT.Errorf("expected priority class '%s', got '%s'", expected, dc.Spec.Template.Spec.PriorityClassName)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// probesOptions returns the probes tuning of a component. Nil when the probes are not tuned
+func probesOptions(spec *appsv1alpha1.ProbesSpec) *component.ProbesOptions {
+	if spec == nil {
+		return nil
+	}
+
+	return &component.ProbesOptions{
+		Liveness:  probeOptions(spec.Liveness),
+		Readiness: probeOptions(spec.Readiness),
+		Startup:   probeOptions(spec.Startup),
+	}
+}
+
+func probeOptions(spec *appsv1alpha1.ProbeSpec) *component.ProbeOptions {
+	if spec == nil {
+		return nil
+	}
+
+	return &component.ProbeOptions{
+		InitialDelaySeconds: spec.InitialDelaySeconds,
+		PeriodSeconds:       spec.PeriodSeconds,
+		TimeoutSeconds:      spec.TimeoutSeconds,
+		FailureThreshold:    spec.FailureThreshold,
+	}
+}
+
+// probeTimingReconciler reconciles the timing fields of the probe. Unset fields
+// are compared with the values defaulted by the API server
+func probeTimingReconciler(desired, existing *v1.Probe) bool {
+	if desired == nil || existing == nil {
+		return false
+	}
+
+	update := false
+
+	fields := []struct {
+		desired  int32
+		existing *int32
+		def      int32
+	}{
+		{desired.InitialDelaySeconds, &existing.InitialDelaySeconds, 0},
+		{desired.TimeoutSeconds, &existing.TimeoutSeconds, 1},
+		{desired.PeriodSeconds, &existing.PeriodSeconds, 10},
+		{desired.SuccessThreshold, &existing.SuccessThreshold, 1},
+		{desired.FailureThreshold, &existing.FailureThreshold, 3},
+	}
+
+	for _, field := range fields {
+		if probeFieldValue(field.desired, field.def) != probeFieldValue(*field.existing, field.def) {
+			*field.existing = field.desired
+			update = true
+		}
+	}
+
+	return update
+}
+
+func probeFieldValue(value, def int32) int32 {
+	if value == 0 {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,159 @@
+package operator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/prometheusrules"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	PrometheusRuleOverridesNotAppliedReason common.ConditionReason = "OverridesNotApplied"
+)
+
+// prometheusRuleOverrides returns the alert overrides, by alert name
+func prometheusRuleOverrides(apimanager *appsv1alpha1.APIManager) map[string]component.PrometheusRuleOverride {
+	overridesSpec := apimanager.PrometheusRuleOverrides()
+	if len(overridesSpec) == 0 {
+		return nil
+	}
+
+	overrides := map[string]component.PrometheusRuleOverride{}
+	for _, overrideSpec := range overridesSpec {
+		overrides[overrideSpec.AlertName] = component.PrometheusRuleOverride{
+			Severity:  overrideSpec.Severity,
+			Threshold: overrideSpec.Threshold,
+			Disabled:  overrideSpec.Disabled != nil && *overrideSpec.Disabled,
+		}
+	}
+	return overrides
+}
+
+// prometheusRuleOverridesMutator replaces the spec of the existing PrometheusRule
+// when the overrides applied to it changed. Otherwise, mutateFn is applied.
+// Hence, the PrometheusRules reconciled with the CreateOnlyMutator are only
+// updated when the overrides change.
+func prometheusRuleOverridesMutator(mutateFn reconcilers.MutateFn) reconcilers.MutateFn {
+	return func(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+		existing, ok := existingObj.(*monitoringv1.PrometheusRule)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *monitoringv1.PrometheusRule", existingObj)
+		}
+		desired, ok := desiredObj.(*monitoringv1.PrometheusRule)
+		if !ok {
+			return false, fmt.Errorf("%T is not a *monitoringv1.PrometheusRule", desiredObj)
+		}
+
+		existingHash := existing.Annotations[component.PrometheusRuleOverridesHashAnnotation]
+		desiredHash, ok := desired.Annotations[component.PrometheusRuleOverridesHashAnnotation]
+		if existingHash == desiredHash {
+			return mutateFn(existingObj, desiredObj)
+		}
+
+		existing.Spec = desired.Spec
+		if ok {
+			if existing.Annotations == nil {
+				existing.Annotations = map[string]string{}
+			}
+			existing.Annotations[component.PrometheusRuleOverridesHashAnnotation] = desiredHash
+		} else {
+			delete(existing.Annotations, component.PrometheusRuleOverridesHashAnnotation)
+		}
+		return true, nil
+	}
+}
+
+// PrometheusRuleOverridesReconciler checks the alert overrides against the
+// alerts generated by the PrometheusRule factories. Unknown alert names are
+// reported in warning events, and the overrides that cannot be applied in the
+// PrometheusRuleOverridesWarning condition.
+// The overrides are applied when each PrometheusRule is reconciled.
+type PrometheusRuleOverridesReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewPrometheusRuleOverridesReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *PrometheusRuleOverridesReconciler {
+	return &PrometheusRuleOverridesReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *PrometheusRuleOverridesReconciler) Reconcile() (reconcile.Result, error) {
+	overrides := prometheusRuleOverrides(r.apiManager)
+
+	var problems []string
+	if len(overrides) > 0 && r.apiManager.IsPrometheusRulesEnabled() {
+		alerts, err := PrometheusRuleAlerts(r.apiManager.Namespace)
+		if err != nil {
+			problems = append(problems, err.Error())
+		} else {
+			alertNames := make([]string, 0, len(overrides))
+			for alertName := range overrides {
+				alertNames = append(alertNames, alertName)
+			}
+			sort.Strings(alertNames)
+
+			for _, alertName := range alertNames {
+				rule, ok := alerts[alertName]
+				if !ok {
+					r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "UnknownAlertOverride", "alert '%s' overridden in spec.monitoring.prometheusRules not found", alertName)
+					continue
+				}
+
+				override := overrides[alertName]
+				if override.Disabled || override.Threshold == nil {
+					continue
+				}
+				if _, err := component.OverrideAlertThreshold(rule.Expr.String(), *override.Threshold); err != nil {
+					problems = append(problems, fmt.Sprintf("%s: %s", alertName, err.Error()))
+				}
+			}
+		}
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		if len(problems) == 0 {
+			r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType)
+			return nil
+		}
+		r.apiManager.Status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  PrometheusRuleOverridesNotAppliedReason,
+			Message: strings.Join(problems, "; "),
+		})
+		return nil
+	})
+	return reconcile.Result{}, err
+}
+
+// PrometheusRuleAlerts returns the alerting rules generated by the
+// PrometheusRule factories, by alert name
+func PrometheusRuleAlerts(ns string) (map[string]monitoringv1.Rule, error) {
+	alerts := map[string]monitoringv1.Rule{}
+	for _, factoryBuilder := range prometheusrules.PrometheusRuleFactories {
+		factory := factoryBuilder()
+		prometheusRule, err := factory.PrometheusRule(false, ns)
+		if err != nil {
+			return nil, fmt.Errorf("generating the %s prometheus rules: %w", factory.Type(), err)
+		}
+
+		for _, group := range prometheusRule.Spec.Groups {
+			for _, rule := range group.Rules {
+				if rule.Alert != "" {
+					alerts[rule.Alert] = rule
+				}
+			}
+		}
+	}
+	return alerts, nil
+}
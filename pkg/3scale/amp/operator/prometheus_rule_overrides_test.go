@@ -0,0 +1,262 @@
+package operator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func testPrometheusRule() *monitoringv1.PrometheusRule {
+	return &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "apicast"},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "apicast.rules",
+					Rules: []monitoringv1.Rule{
+						{
+							Alert:  "JobDown",
+							Expr:   intstr.FromString(`up{job="apicast"} == 0`),
+							Labels: map[string]string{"severity": "critical"},
+						},
+						{
+							Alert:  "ErrorRate",
+							Expr:   intstr.FromString(`sum(rate(apicast_status{status=~"^5.."}[1m])) / (sum(rate(apicast_status[1m])) > 0) * 100 > 5`),
+							Labels: map[string]string{"severity": "warning"},
+						},
+						{
+							Alert:  "BurnRate",
+							Expr:   intstr.FromString(`error_ratio:rate5m > 0.0144 and error_ratio:rate1h > 0.0144`),
+							Labels: map[string]string{"severity": "critical"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestOverrideAlertThreshold(t *testing.T) {
+	cases := []struct {
+		expr      string
+		threshold string
+		expected  string
+		err       bool
+	}{
+		{`up{job="apicast"} == 0`, "1", `up{job="apicast"} == 1`, false},
+		{`(redis_memory_max_bytes > 0) > 0.9`, "0.75", `(redis_memory_max_bytes > 0) > 0.75`, false},
+		{`sum(rate(errors[5m])) by (namespace,job) > 10000`, "2000", `sum(rate(errors[5m])) by (namespace,job) > 2000`, false},
+		{`error_ratio:rate5m > 0.0144 and error_ratio:rate1h > 0.0144`, "0.1", "", true},
+		{`histogram_quantile(0.99, sum(rate(latency_bucket[5m])) by (le))`, "1", "", true},
+	}
+
+	for _, tc := range cases {
+		expr, err := component.OverrideAlertThreshold(tc.expr, tc.threshold)
+		if tc.err != (err != nil) {
+			t.Errorf("'%s': unexpected error: %v", tc.expr, err)
+		}
+		if expr != tc.expected {
+			t.Errorf("'%s': expected '%s', got '%s'", tc.expr, tc.expected, expr)
+		}
+	}
+}
+
+func TestApplyPrometheusRuleOverrides(t *testing.T) {
+	critical := "critical"
+	info := "info"
+	threshold := "10"
+
+	t.Run("NotOverridden", func(subT *testing.T) {
+		prometheusRule := testPrometheusRule()
+		err := component.ApplyPrometheusRuleOverrides(prometheusRule, map[string]component.PrometheusRuleOverride{
+			"OtherAlert": {Disabled: true},
+		})
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if len(prometheusRule.Spec.Groups[0].Rules) != 3 {
+			subT.Errorf("unexpected rules: %v", prometheusRule.Spec.Groups[0].Rules)
+		}
+		if _, ok := prometheusRule.Annotations[component.PrometheusRuleOverridesHashAnnotation]; ok {
+			subT.Error("unexpected overrides hash annotation")
+		}
+	})
+
+	t.Run("Overridden", func(subT *testing.T) {
+		prometheusRule := testPrometheusRule()
+		err := component.ApplyPrometheusRuleOverrides(prometheusRule, map[string]component.PrometheusRuleOverride{
+			"JobDown":   {Severity: &info},
+			"ErrorRate": {Severity: &critical, Threshold: &threshold},
+			"BurnRate":  {Disabled: true},
+		})
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		rules := prometheusRule.Spec.Groups[0].Rules
+		if len(rules) != 2 {
+			subT.Fatalf("unexpected rules: %v", rules)
+		}
+		if rules[0].Labels["severity"] != info {
+			subT.Errorf("unexpected JobDown severity: %s", rules[0].Labels["severity"])
+		}
+		if rules[1].Labels["severity"] != critical || !strings.HasSuffix(rules[1].Expr.String(), "* 100 > 10") {
+			subT.Errorf("unexpected ErrorRate rule: %v", rules[1])
+		}
+		if _, ok := prometheusRule.Annotations[component.PrometheusRuleOverridesHashAnnotation]; !ok {
+			subT.Error("expected overrides hash annotation")
+		}
+
+		// The generated rule labels are not shared with the overridden rule
+		if testPrometheusRule().Spec.Groups[0].Rules[0].Labels["severity"] != critical {
+			subT.Error("unexpected generated rule change")
+		}
+	})
+
+	t.Run("AllDisabled", func(subT *testing.T) {
+		prometheusRule := testPrometheusRule()
+		err := component.ApplyPrometheusRuleOverrides(prometheusRule, map[string]component.PrometheusRuleOverride{
+			"JobDown":   {Disabled: true},
+			"ErrorRate": {Disabled: true},
+			"BurnRate":  {Disabled: true},
+		})
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if len(prometheusRule.Spec.Groups) != 0 {
+			subT.Errorf("expected empty groups to be removed: %v", prometheusRule.Spec.Groups)
+		}
+	})
+
+	t.Run("ThresholdNotApplicable", func(subT *testing.T) {
+		prometheusRule := testPrometheusRule()
+		err := component.ApplyPrometheusRuleOverrides(prometheusRule, map[string]component.PrometheusRuleOverride{
+			"JobDown":  {Severity: &info},
+			"BurnRate": {Threshold: &threshold},
+		})
+		if err == nil || !strings.Contains(err.Error(), "BurnRate") {
+			subT.Fatalf("expected BurnRate error, got %v", err)
+		}
+		if prometheusRule.Spec.Groups[0].Rules[0].Labels["severity"] != info {
+			subT.Error("expected the applicable overrides to be applied")
+		}
+	})
+}
+
+func TestPrometheusRuleOverridesMutator(t *testing.T) {
+	info := "info"
+	overrides := map[string]component.PrometheusRuleOverride{"JobDown": {Severity: &info}}
+
+	existing := testPrometheusRule()
+	desired := testPrometheusRule()
+	if err := component.ApplyPrometheusRuleOverrides(desired, overrides); err != nil {
+		t.Fatal(err)
+	}
+
+	mutator := prometheusRuleOverridesMutator(reconcilers.CreateOnlyMutator)
+
+	changed, err := mutator(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Groups[0].Rules[0].Labels["severity"] != info {
+		t.Fatal("expected the overrides to be applied to the existing rule")
+	}
+
+	changed, err = mutator(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("expected no change on second reconciliation")
+	}
+
+	// Removing the overrides restores the generated rule
+	changed, err = mutator(existing, testPrometheusRule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || existing.Spec.Groups[0].Rules[0].Labels["severity"] != "critical" {
+		t.Error("expected the generated rule to be restored")
+	}
+	if _, ok := existing.Annotations[component.PrometheusRuleOverridesHashAnnotation]; ok {
+		t.Error("expected overrides hash annotation to be removed")
+	}
+}
+
+func TestPrometheusRuleOverridesReconciler(t *testing.T) {
+	threshold := "10"
+	disabled := true
+
+	apimanager := basicApimanager()
+	apimanager.Spec.Monitoring = &appsv1alpha1.MonitoringSpec{
+		Enabled: true,
+		PrometheusRules: &appsv1alpha1.PrometheusRulesSpec{
+			Overrides: []appsv1alpha1.PrometheusRuleOverride{
+				{AlertName: "ThreescaleApicastJobDown", Disabled: &disabled},
+				{AlertName: "ThreescaleZyncQueScheduledJobCountHigh", Threshold: &threshold},
+				{AlertName: "UnknownAlert", Disabled: &disabled},
+			},
+		},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	cl := fake.NewFakeClient(apimanager)
+	clientAPIReader := fake.NewFakeClient(apimanager)
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, logf.Log.WithName("operator_test"), fakeclientset.NewSimpleClientset().Discovery(), recorder)
+	overridesReconciler := NewPrometheusRuleOverridesReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager))
+
+	if _, err := overridesReconciler.Reconcile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recorder.Events) != 1 {
+		t.Fatalf("expected one event, got %d", len(recorder.Events))
+	}
+	if event := <-recorder.Events; !strings.Contains(event, "UnknownAlert") {
+		t.Errorf("unexpected event: %s", event)
+	}
+	if apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType) {
+		t.Errorf("unexpected %s condition", appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType)
+	}
+
+	// The SLO burn rate alerts combine several comparisons
+	apimanager.Spec.Monitoring.PrometheusRules.Overrides = []appsv1alpha1.PrometheusRuleOverride{
+		{AlertName: "ThreescaleApicastErrorBudgetFastBurn", Threshold: &threshold},
+	}
+	if _, err := overridesReconciler.Reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if !apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType) {
+		t.Errorf("expected %s condition", appsv1alpha1.APIManagerPrometheusRuleOverridesWarningConditionType)
+	}
+}
+
+func TestPrometheusRuleAlerts(t *testing.T) {
+	alerts, err := PrometheusRuleAlerts(namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alertName := range []string{"ThreescaleApicastJobDown", "ThreescaleBackendWorkerJobDown", "ThreescaleZyncQueScheduledJobCountHigh"} {
+		if _, ok := alerts[alertName]; !ok {
+			t.Errorf("alert %s not found", alertName)
+		}
+	}
+}
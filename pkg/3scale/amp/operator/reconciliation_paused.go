@@ -0,0 +1,17 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+const (
+	// ReconciliationPausedAnnotation set to "true" stops the reconciliation of the objects
+	// owned by the APIManager, e.g. to change them manually during an incident.
+	// The APIManager status is still reported
+	ReconciliationPausedAnnotation = "apps.3scale.net/paused"
+)
+
+// IsReconciliationPaused tells whether the objects owned by the APIManager are left as they are
+func IsReconciliationPaused(apimanager *appsv1alpha1.APIManager) bool {
+	return apimanager.Annotations[ReconciliationPausedAnnotation] == "true"
+}
@@ -0,0 +1,30 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+func TestIsReconciliationPaused(t *testing.T) {
+	cases := []struct {
+		testName    string
+		annotations map[string]string
+		expected    bool
+	}{
+		{"no annotations", nil, false},
+		{"paused", map[string]string{ReconciliationPausedAnnotation: "true"}, true},
+		{"not paused", map[string]string{ReconciliationPausedAnnotation: "false"}, false},
+		{"unexpected value", map[string]string{ReconciliationPausedAnnotation: "yes"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := &appsv1alpha1.APIManager{}
+			apimanager.Annotations = tc.annotations
+			if got := IsReconciliationPaused(apimanager); got != tc.expected {
+				subT.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// apicastSLOOptions returns the recording rules options of apicast production, nil when disabled
+func apicastSLOOptions(apimanager *appsv1alpha1.APIManager) *component.SLOOptions {
+	if !apimanager.IsRecordingRulesEnabled() {
+		return nil
+	}
+	return sloOptions(apimanager.Spec.Monitoring.RecordingRules.ApicastAvailabilityObjective)
+}
+
+// backendSLOOptions returns the recording rules options of backend-listener, nil when disabled
+func backendSLOOptions(apimanager *appsv1alpha1.APIManager) *component.SLOOptions {
+	if !apimanager.IsRecordingRulesEnabled() {
+		return nil
+	}
+	return sloOptions(apimanager.Spec.Monitoring.RecordingRules.BackendAvailabilityObjective)
+}
+
+func sloOptions(availabilityObjective *string) *component.SLOOptions {
+	opts := component.DefaultSLOOptions()
+	if availabilityObjective != nil {
+		opts.AvailabilityObjective = *availabilityObjective
+	}
+	return opts
+}
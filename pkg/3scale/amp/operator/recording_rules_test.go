@@ -0,0 +1,135 @@
+package operator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRecordingRules(t *testing.T) {
+	falseValue := false
+	objective := "99.9"
+
+	cases := []struct {
+		testName          string
+		monitoringSpec    *appsv1alpha1.MonitoringSpec
+		expectedObjective string
+	}{
+		{"Default", nil, ""},
+		{"MonitoringWithoutRecordingRules", &appsv1alpha1.MonitoringSpec{Enabled: true}, ""},
+		{"PrometheusRulesDisabled", &appsv1alpha1.MonitoringSpec{Enabled: true, EnablePrometheusRules: &falseValue,
+			RecordingRules: &appsv1alpha1.RecordingRulesSpec{Enabled: true}}, ""},
+		{"DefaultObjectives", &appsv1alpha1.MonitoringSpec{Enabled: true,
+			RecordingRules: &appsv1alpha1.RecordingRulesSpec{Enabled: true}}, component.DefaultSLOAvailabilityObjective},
+		{"CustomObjectives", &appsv1alpha1.MonitoringSpec{Enabled: true,
+			RecordingRules: &appsv1alpha1.RecordingRulesSpec{Enabled: true, ApicastAvailabilityObjective: &objective, BackendAvailabilityObjective: &objective}}, objective},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := basicApimanager()
+			apimanager.Spec.Monitoring = tc.monitoringSpec
+			cl := fake.NewFakeClient()
+
+			apicast, err := Apicast(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			backend, err := Backend(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			for _, opts := range []*component.SLOOptions{apicast.Options.SLO, backend.Options.SLO} {
+				if tc.expectedObjective == "" {
+					if opts != nil {
+						subT.Errorf("expected recording rules to be disabled, got %v", opts)
+					}
+					continue
+				}
+				if opts == nil {
+					subT.Fatal("expected recording rules to be enabled")
+				}
+				if opts.AvailabilityObjective != tc.expectedObjective {
+					subT.Errorf("availability objective: expected %s, got %s", tc.expectedObjective, opts.AvailabilityObjective)
+				}
+			}
+
+			sloSeries := component.SLOSuccessRatioRecord("apicast_production", "5m")
+			if res := strings.Contains(apicast.ApicastMainAppGrafanaDashboard("").Spec.Json, sloSeries); res != (tc.expectedObjective != "") {
+				subT.Errorf("apicast dashboard recorded series: expected %t, got %t", tc.expectedObjective != "", res)
+			}
+			sloSeries = component.SLOSuccessRatioRecord("backend_listener", "5m")
+			if res := strings.Contains(backend.BackendGrafanaDashboard("").Spec.Json, sloSeries); res != (tc.expectedObjective != "") {
+				subT.Errorf("backend dashboard recorded series: expected %t, got %t", tc.expectedObjective != "", res)
+			}
+		})
+	}
+}
+
+func TestRecordingRulesNamespaced(t *testing.T) {
+	objective := "99.9"
+	apimanager := basicApimanager()
+	apimanager.Spec.Monitoring = &appsv1alpha1.MonitoringSpec{
+		Enabled:        true,
+		RecordingRules: &appsv1alpha1.RecordingRulesSpec{Enabled: true, ApicastAvailabilityObjective: &objective},
+	}
+	cl := fake.NewFakeClient()
+
+	apicast, err := Apicast(apimanager, cl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend, err := Backend(apimanager, cl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		rules             *monitoringv1.PrometheusRule
+		expectedObjective string
+	}{
+		{apicast.ApicastSLOPrometheusRules(), objective},
+		{backend.BackendListenerSLOPrometheusRules(), component.DefaultSLOAvailabilityObjective},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.rules.Name, func(subT *testing.T) {
+			if len(tc.rules.Spec.Groups) != 1 {
+				subT.Fatalf("expected 1 rule group, got %d", len(tc.rules.Spec.Groups))
+			}
+			group := tc.rules.Spec.Groups[0]
+			if group.Name != fmt.Sprintf("%s/%s.rules", namespace, tc.rules.Name) {
+				subT.Errorf("unexpected rule group name %s", group.Name)
+			}
+
+			alerts := 0
+			for _, rule := range group.Rules {
+				expr := rule.Expr.String()
+				if rule.Record != "" {
+					// Recorded series keep the namespace label to not collide with other APIManagers
+					if !strings.HasPrefix(rule.Record, "namespace:threescale_") || !strings.Contains(expr, "by (namespace") {
+						subT.Errorf("recording rule %s is not aggregated by namespace: %s", rule.Record, expr)
+					}
+					if !strings.Contains(expr, fmt.Sprintf(`namespace="%s"`, namespace)) {
+						subT.Errorf("recording rule %s does not filter the namespace: %s", rule.Record, expr)
+					}
+					continue
+				}
+				alerts++
+				if !strings.Contains(expr, fmt.Sprintf("(100 - %s)", tc.expectedObjective)) {
+					subT.Errorf("alert %s does not use the %s objective: %s", rule.Alert, tc.expectedObjective, expr)
+				}
+			}
+			if alerts != 2 {
+				subT.Errorf("expected 2 burn rate alerts, got %d", alerts)
+			}
+		})
+	}
+}
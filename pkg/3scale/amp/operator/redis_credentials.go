@@ -0,0 +1,68 @@
+package operator
+
+import (
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// backendRedisCredentialsMutator reconciles the ACL credentials and the CA certificate
+// of the backend redis in the backend pods, including the init containers waiting for redis
+func backendRedisCredentialsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return redisCredentialsReconciler(desired, existing, component.BackendRedisCredentialsEnvVarNames, component.BackendRedisCAVolumeName), nil
+}
+
+// systemRedisCredentialsMutator reconciles the ACL credentials and the CA certificates
+// of the system and backend redis in the system pods, including the system-app pre hook pod
+func systemRedisCredentialsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := redisCredentialsReconciler(desired, existing, component.SystemRedisCredentialsEnvVarNames, component.SystemRedisCAVolumeName, component.BackendRedisCAVolumeName)
+
+	for _, envVar := range component.SystemRedisCredentialsEnvVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigPreHookReconciler(desired, existing, envVar, "")
+		update = update || tmpUpdate
+	}
+	for _, volumeName := range []string{component.SystemRedisCAVolumeName, component.BackendRedisCAVolumeName} {
+		tmpUpdate := reconcilers.DeploymentConfigPreHookReconciler(desired, existing, "", volumeName)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
+func redisCredentialsReconciler(desired, existing *appsv1.DeploymentConfig, envVarNames []string, volumeNames ...string) bool {
+	update := false
+
+	for _, volumeName := range volumeNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersVolumeReconciler(desired, existing, volumeName)
+		update = update || tmpUpdate
+	}
+
+	for _, envVar := range envVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	// Init containers are fully managed by the operator
+	for desiredIdx := range desired.Spec.Template.Spec.InitContainers {
+		desiredContainer := &desired.Spec.Template.Spec.InitContainers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.InitContainers {
+			existingContainer := &existing.Spec.Template.Spec.InitContainers[existingIdx]
+			if existingContainer.Name != desiredContainer.Name {
+				continue
+			}
+			if !reflect.DeepEqual(existingContainer.Env, desiredContainer.Env) {
+				existingContainer.Env = desiredContainer.Env
+				update = true
+			}
+			if !reflect.DeepEqual(existingContainer.VolumeMounts, desiredContainer.VolumeMounts) {
+				existingContainer.VolumeMounts = desiredContainer.VolumeMounts
+				update = true
+			}
+		}
+	}
+
+	return update
+}
@@ -0,0 +1,156 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	backendRedisCATestSecretName = "backend-redis-ca"
+	systemRedisCATestSecretName  = "system-redis-ca"
+)
+
+func redisCATestApimanager() *appsv1alpha1.APIManager {
+	apimanager := basicApimanager()
+	apimanager.Spec.ExternalComponents = &appsv1alpha1.ExternalComponentsSpec{
+		Backend: &appsv1alpha1.ExternalBackendComponents{
+			Redis:            &[]bool{true}[0],
+			RedisCASecretRef: &v1.LocalObjectReference{Name: backendRedisCATestSecretName},
+		},
+		System: &appsv1alpha1.ExternalSystemComponents{
+			Redis:            &[]bool{true}[0],
+			RedisCASecretRef: &v1.LocalObjectReference{Name: systemRedisCATestSecretName},
+		},
+	}
+	return apimanager
+}
+
+// assertRedisCAMounted checks the volume of the CA secret and its mount in every container
+func assertRedisCAMounted(t *testing.T, dc *appsv1.DeploymentConfig, volumeName, secretName string) {
+	idx := helper.FindVolumeByName(dc.Spec.Template.Spec.Volumes, volumeName)
+	if idx < 0 {
+		t.Fatalf("%s: volume %s not found", dc.Name, volumeName)
+	}
+	if secret := dc.Spec.Template.Spec.Volumes[idx].Secret; secret == nil || secret.SecretName != secretName {
+		t.Errorf("%s: expected volume %s from secret %s", dc.Name, volumeName, secretName)
+	}
+
+	containers := append([]v1.Container{}, dc.Spec.Template.Spec.InitContainers...)
+	containers = append(containers, dc.Spec.Template.Spec.Containers...)
+	for _, container := range containers {
+		found := false
+		for _, mount := range container.VolumeMounts {
+			found = found || mount.Name == volumeName
+		}
+		if !found {
+			t.Errorf("%s/%s: volume mount %s not found", dc.Name, container.Name, volumeName)
+		}
+	}
+}
+
+func assertRedisCAFileEnvVar(t *testing.T, dc *appsv1.DeploymentConfig, envVarName, volumeName string) {
+	for _, container := range dc.Spec.Template.Spec.Containers {
+		envVar, ok := findEnvVar(container.Env, envVarName)
+		if expected := component.RedisCAFile(volumeName); !ok || envVar.Value != expected {
+			t.Errorf("%s/%s: expected %s=%s, got %v", dc.Name, container.Name, envVarName, expected, envVar)
+		}
+	}
+}
+
+func TestBackendRedisCredentials(t *testing.T) {
+	backend, err := Backend(redisCATestApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dc := range []*appsv1.DeploymentConfig{backend.ListenerDeploymentConfig(), backend.WorkerDeploymentConfig(), backend.CronDeploymentConfig()} {
+		assertRedisCAMounted(t, dc, component.BackendRedisCAVolumeName, backendRedisCATestSecretName)
+		assertRedisCAFileEnvVar(t, dc, component.BackendConfigRedisCAFileEnvVarName, component.BackendRedisCAVolumeName)
+		assertRedisCAFileEnvVar(t, dc, component.BackendConfigQueuesCAFileEnvVarName, component.BackendRedisCAVolumeName)
+
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			for envVarName, secretField := range map[string]string{
+				component.BackendConfigRedisUsernameEnvVarName:  component.BackendSecretBackendRedisStorageUsernameFieldName,
+				component.BackendConfigRedisPasswordEnvVarName:  component.BackendSecretBackendRedisStoragePasswordFieldName,
+				component.BackendConfigQueuesUsernameEnvVarName: component.BackendSecretBackendRedisQueuesUsernameFieldName,
+				component.BackendConfigQueuesPasswordEnvVarName: component.BackendSecretBackendRedisQueuesPasswordFieldName,
+			} {
+				envVar, ok := findEnvVar(container.Env, envVarName)
+				if !ok || envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef.Key != secretField ||
+					envVar.ValueFrom.SecretKeyRef.Optional == nil || !*envVar.ValueFrom.SecretKeyRef.Optional {
+					t.Errorf("%s/%s: expected %s from the optional %s field", dc.Name, container.Name, envVarName, secretField)
+				}
+			}
+		}
+	}
+}
+
+func TestSystemRedisCredentials(t *testing.T) {
+	system, err := System(redisCATestApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dc := range []*appsv1.DeploymentConfig{system.AppDeploymentConfig(), system.SidekiqDeploymentConfig()} {
+		assertRedisCAMounted(t, dc, component.SystemRedisCAVolumeName, systemRedisCATestSecretName)
+		assertRedisCAMounted(t, dc, component.BackendRedisCAVolumeName, backendRedisCATestSecretName)
+		assertRedisCAFileEnvVar(t, dc, component.SystemRedisCAFileEnvVarName, component.SystemRedisCAVolumeName)
+		assertRedisCAFileEnvVar(t, dc, component.SystemBackendRedisCAFileEnvVarName, component.BackendRedisCAVolumeName)
+	}
+
+	sphinx := system.SphinxDeploymentConfig()
+	assertRedisCAMounted(t, sphinx, component.SystemRedisCAVolumeName, systemRedisCATestSecretName)
+	assertRedisCAFileEnvVar(t, sphinx, component.SystemRedisCAFileEnvVarName, component.SystemRedisCAVolumeName)
+
+	preHook := system.AppDeploymentConfig().Spec.Strategy.RollingParams.Pre.ExecNewPod
+	for _, volumeName := range []string{component.SystemRedisCAVolumeName, component.BackendRedisCAVolumeName} {
+		if !helper.ArrayContains(preHook.Volumes, volumeName) {
+			t.Errorf("system-app pre hook: volume %s not found", volumeName)
+		}
+	}
+}
+
+func TestSystemRedisCredentialsMutator(t *testing.T) {
+	existingSystem, err := System(basicApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	desiredSystem, err := System(redisCATestApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := existingSystem.SidekiqDeploymentConfig()
+	desired := desiredSystem.SidekiqDeploymentConfig()
+	update, err := systemRedisCredentialsMutator(desired, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Fatal("expected the redis CA to be added")
+	}
+	assertRedisCAMounted(t, existing, component.SystemRedisCAVolumeName, systemRedisCATestSecretName)
+	assertRedisCAMounted(t, existing, component.BackendRedisCAVolumeName, backendRedisCATestSecretName)
+
+	// Removing the CA secret references unmounts the CA
+	update, err = systemRedisCredentialsMutator(existingSystem.SidekiqDeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Fatal("expected the redis CA to be removed")
+	}
+	if helper.FindVolumeByName(existing.Spec.Template.Spec.Volumes, component.SystemRedisCAVolumeName) >= 0 {
+		t.Error("system redis CA volume not removed")
+	}
+	if _, ok := findEnvVar(existing.Spec.Template.Spec.Containers[0].Env, component.SystemRedisCAFileEnvVarName); ok {
+		t.Error("system redis CA file env var not removed")
+	}
+}
@@ -36,12 +36,12 @@ func (r *RedisOptionsProvider) GetRedisOptions() (*component.RedisOptions, error
 	r.options.SystemImageTag = product.ThreescaleRelease
 	r.options.InsecureImportPolicy = r.apimanager.Spec.ImageStreamTagImportInsecure
 
-	r.options.BackendImage = BackendRedisImageURL()
+	r.options.BackendImage = r.apimanager.DefaultImageURL(BackendRedisImageURL())
 	if r.apimanager.Spec.Backend != nil && r.apimanager.Spec.Backend.RedisImage != nil {
 		r.options.BackendImage = *r.apimanager.Spec.Backend.RedisImage
 	}
 
-	r.options.SystemImage = SystemRedisImageURL()
+	r.options.SystemImage = r.apimanager.DefaultImageURL(SystemRedisImageURL())
 	if r.apimanager.Spec.System != nil && r.apimanager.Spec.System.RedisImage != nil {
 		r.options.SystemImage = *r.apimanager.Spec.System.RedisImage
 	}
@@ -55,6 +55,9 @@ func (r *RedisOptionsProvider) GetRedisOptions() (*component.RedisOptions, error
 
 	r.setResourceRequirementsOptions()
 	r.setNodeAffinityAndTolerationsOptions()
+	r.setSecurityContextOptions()
+	r.setPriorityClassNameOptions()
+	r.setImagePullPolicyOptions()
 
 	r.setPersistentVolumeClaimOptions()
 
@@ -179,18 +182,41 @@ func (r *RedisOptionsProvider) setPersistentVolumeClaimOptions() {
 	if r.apimanager.Spec.System != nil &&
 		r.apimanager.Spec.System.RedisPersistentVolumeClaimSpec != nil {
 		r.options.SystemRedisPVCStorageClass = r.apimanager.Spec.System.RedisPersistentVolumeClaimSpec.StorageClassName
+		r.options.SystemRedisPVCAnnotations = r.apimanager.Spec.System.RedisPersistentVolumeClaimSpec.Annotations
+		r.options.SystemRedisPVCLabels = r.apimanager.Spec.System.RedisPersistentVolumeClaimSpec.Labels
 	}
 	if r.apimanager.Spec.Backend != nil &&
 		r.apimanager.Spec.Backend.RedisPersistentVolumeClaimSpec != nil {
 		r.options.BackendRedisPVCStorageClass = r.apimanager.Spec.Backend.RedisPersistentVolumeClaimSpec.StorageClassName
+		r.options.BackendRedisPVCAnnotations = r.apimanager.Spec.Backend.RedisPersistentVolumeClaimSpec.Annotations
+		r.options.BackendRedisPVCLabels = r.apimanager.Spec.Backend.RedisPersistentVolumeClaimSpec.Labels
 	}
 }
 
 func (r *RedisOptionsProvider) setNodeAffinityAndTolerationsOptions() {
+	r.options.BackendRedisNodeSelector = r.apimanager.Spec.Backend.RedisNodeSelector
 	r.options.BackendRedisAffinity = r.apimanager.Spec.Backend.RedisAffinity
-	r.options.BackendRedisTolerations = r.apimanager.Spec.Backend.RedisTolerations
+	r.options.BackendRedisTolerations = componentTolerations(r.apimanager, r.apimanager.Spec.Backend.RedisTolerations, nil)
+	r.options.BackendRedisTopologySpreadConstraints = r.apimanager.Spec.Backend.RedisTopologySpreadConstraints
+	r.options.SystemRedisNodeSelector = r.apimanager.Spec.System.RedisNodeSelector
 	r.options.SystemRedisAffinity = r.apimanager.Spec.System.RedisAffinity
-	r.options.SystemRedisTolerations = r.apimanager.Spec.System.RedisTolerations
+	r.options.SystemRedisTolerations = componentTolerations(r.apimanager, r.apimanager.Spec.System.RedisTolerations, nil)
+	r.options.SystemRedisTopologySpreadConstraints = r.apimanager.Spec.System.RedisTopologySpreadConstraints
+}
+
+func (r *RedisOptionsProvider) setSecurityContextOptions() {
+	r.options.BackendRedisSecurityContext = securityContextOptions(r.apimanager.Spec.Backend.RedisPodSecurityContext, r.apimanager.Spec.Backend.RedisSecurityContext)
+	r.options.SystemRedisSecurityContext = securityContextOptions(r.apimanager.Spec.System.RedisPodSecurityContext, r.apimanager.Spec.System.RedisSecurityContext)
+}
+
+func (r *RedisOptionsProvider) setPriorityClassNameOptions() {
+	r.options.BackendRedisPriorityClassName = helper.GetStringPointerValueOrDefault(r.apimanager.Spec.Backend.RedisPriorityClassName, "")
+	r.options.SystemRedisPriorityClassName = helper.GetStringPointerValueOrDefault(r.apimanager.Spec.System.RedisPriorityClassName, "")
+}
+
+func (r *RedisOptionsProvider) setImagePullPolicyOptions() {
+	r.options.BackendRedisImagePullPolicy = r.apimanager.Spec.ImagePullPolicy
+	r.options.SystemRedisImagePullPolicy = r.apimanager.Spec.ImagePullPolicy
 }
 
 func (r *RedisOptionsProvider) systemCommonLabels() map[string]string {
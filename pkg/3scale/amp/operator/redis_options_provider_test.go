@@ -115,8 +115,8 @@ func testSystemRedisCustomResourceRequirements() *v1.ResourceRequirements {
 
 func testBackendRedisSecret() *v1.Secret {
 	data := map[string]string{
-		component.BackendSecretBackendRedisStorageURLFieldName:           "storageURLValue",
-		component.BackendSecretBackendRedisQueuesURLFieldName:            "queueURLValue",
+		component.BackendSecretBackendRedisStorageURLFieldName:           "redis://backend-storage:6379/0",
+		component.BackendSecretBackendRedisQueuesURLFieldName:            "redis://backend-queues:6379/1",
 		component.BackendSecretBackendRedisStorageSentinelHostsFieldName: "storageSentinelHostsValue",
 		component.BackendSecretBackendRedisStorageSentinelRoleFieldName:  "storageSentinelRoleValue",
 		component.BackendSecretBackendRedisQueuesSentinelHostsFieldName:  "queueSentinelHostsValue",
@@ -299,6 +299,48 @@ func TestGetRedisOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"WithTopologySpreadConstraints", nil, nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.RedisTopologySpreadConstraints = getTestTopologySpreadConstraints("system-redis")
+				apimanager.Spec.Backend.RedisTopologySpreadConstraints = getTestTopologySpreadConstraints("backend-redis")
+				return apimanager
+			},
+			func() *component.RedisOptions {
+				opts := defaultRedisOptions()
+				opts.SystemRedisTopologySpreadConstraints = getTestTopologySpreadConstraints("system-redis")
+				opts.BackendRedisTopologySpreadConstraints = getTestTopologySpreadConstraints("backend-redis")
+				return opts
+			},
+		},
+		{"WithNodeSelector", nil, nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.RedisNodeSelector = map[string]string{"node-role": "system-redis"}
+				apimanager.Spec.Backend.RedisNodeSelector = map[string]string{"node-role": "backend-redis"}
+				return apimanager
+			},
+			func() *component.RedisOptions {
+				opts := defaultRedisOptions()
+				opts.SystemRedisNodeSelector = map[string]string{"node-role": "system-redis"}
+				opts.BackendRedisNodeSelector = map[string]string{"node-role": "backend-redis"}
+				return opts
+			},
+		},
+		{"WithPriorityClassName", nil, nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanager()
+				apimanager.Spec.System.RedisPriorityClassName = &[]string{"system-redis-priority"}[0]
+				apimanager.Spec.Backend.RedisPriorityClassName = &[]string{"backend-redis-priority"}[0]
+				return apimanager
+			},
+			func() *component.RedisOptions {
+				opts := defaultRedisOptions()
+				opts.SystemRedisPriorityClassName = "system-redis-priority"
+				opts.BackendRedisPriorityClassName = "backend-redis-priority"
+				return opts
+			},
+		},
 		{"WithBackendRedisCustomResourceRequirements", nil, nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanager()
@@ -354,8 +396,8 @@ func TestGetRedisOptionsProvider(t *testing.T) {
 		{"WithBackendRedisSecret", testBackendRedisSecret(), nil, basicApimanager,
 			func() *component.RedisOptions {
 				opts := defaultRedisOptions()
-				opts.BackendStorageURL = "storageURLValue"
-				opts.BackendQueuesURL = "queueURLValue"
+				opts.BackendStorageURL = "redis://backend-storage:6379/0"
+				opts.BackendQueuesURL = "redis://backend-queues:6379/1"
 				opts.BackendRedisStorageSentinelHosts = "storageSentinelHostsValue"
 				opts.BackendRedisStorageSentinelRole = "storageSentinelRoleValue"
 				opts.BackendRedisQueuesSentinelHosts = "queueSentinelHostsValue"
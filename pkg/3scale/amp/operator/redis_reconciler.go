@@ -3,6 +3,7 @@ package operator
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	appsv1 "github.com/openshift/api/apps/v1"
 	imagev1 "github.com/openshift/api/image/v1"
@@ -63,7 +64,11 @@ func (r *RedisReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
 	)
 	err = r.ReconcileDeploymentConfig(r.DeploymentConfig(redis), dcMutator)
@@ -86,7 +91,7 @@ func (r *RedisReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// PVC
-	err = r.ReconcilePersistentVolumeClaim(r.PersistentVolumeClaim(redis), reconcilers.CreateOnlyMutator)
+	err = r.ReconcilePersistentVolumeClaimStorage(r.PersistentVolumeClaim(redis))
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -107,10 +112,13 @@ func (r *RedisReconciler) Reconcile() (reconcile.Result, error) {
 }
 
 func Redis(apimanager *appsv1alpha1.APIManager, client client.Client) (*component.Redis, error) {
-	optsProvider := NewRedisOptionsProvider(apimanager, apimanager.Namespace, client)
-	opts, err := optsProvider.GetRedisOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(apimanager, "redis", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewRedisOptionsProvider(apimanager, apimanager.Namespace, client)
+		opts, err := optsProvider.GetRedisOptions()
+		return opts, optsProvider.secretSource, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return component.NewRedis(opts), nil
+	return component.NewRedis(opts.(*component.RedisOptions)), nil
 }
@@ -10,6 +10,7 @@ import (
 	appsv1 "github.com/openshift/api/apps/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -127,3 +128,124 @@ func TestRedisBackendDCReconcilerCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisBackendPVCAnnotationsReconciler(t *testing.T) {
+	var (
+		appLabel       = "someLabel"
+		name           = "example-apimanager"
+		namespace      = "operator-unittest"
+		trueValue      = true
+		wildcardDomain = "test.3scale.net"
+		tenantName     = "someTenant"
+		log            = logf.Log.WithName("operator_test")
+	)
+
+	ctx := context.TODO()
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{
+				AppLabel:                     &appLabel,
+				ImageStreamTagImportInsecure: &trueValue,
+				ResourceRequirementsEnabled:  &trueValue,
+				WildcardDomain:               wildcardDomain,
+				TenantName:                   &tenantName,
+			},
+			Backend: &appsv1alpha1.BackendSpec{
+				RedisPersistentVolumeClaimSpec: &appsv1alpha1.BackendRedisPersistentVolumeClaimSpec{
+					Annotations: map[string]string{"backup.velero.io/backup-volumes": "backend-redis-storage"},
+					Labels:      map[string]string{"backup": "true"},
+				},
+			},
+		},
+	}
+	_, err := apimanager.SetDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The PVC already exists with annotations set by the cluster and other tools
+	existingPVC := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend-redis-storage",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				"pv.kubernetes.io/bind-completed": "yes",
+				"snapshot.storage.k8s.io/class":   "csi-snapclass",
+			},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}
+
+	objs := []runtime.Object{existingPVC}
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	_, err = NewBackendRedisDependencyReconciler(baseAPIManagerLogicReconciler).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvc := &v1.PersistentVolumeClaim{}
+	err = cl.Get(ctx, types.NamespacedName{Name: "backend-redis-storage", Namespace: namespace}, pvc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for key, value := range map[string]string{
+		"pv.kubernetes.io/bind-completed": "yes",
+		"snapshot.storage.k8s.io/class":   "csi-snapclass",
+		"backup.velero.io/backup-volumes": "backend-redis-storage",
+	} {
+		if pvc.Annotations[key] != value {
+			t.Errorf("annotation %s: expected '%s', got '%s'", key, value, pvc.Annotations[key])
+		}
+	}
+
+	if pvc.Labels["backup"] != "true" {
+		t.Errorf("expected 'backup' label, got labels: %v", pvc.Labels)
+	}
+	if pvc.Labels["app"] != appLabel {
+		t.Errorf("expected operator 'app' label, got labels: %v", pvc.Labels)
+	}
+
+	// Reconciling again must not update the PVC
+	resourceVersion := pvc.ResourceVersion
+	_, err = NewBackendRedisDependencyReconciler(baseAPIManagerLogicReconciler).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cl.Get(ctx, types.NamespacedName{Name: "backend-redis-storage", Namespace: namespace}, pvc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pvc.ResourceVersion != resourceVersion {
+		t.Errorf("unexpected PVC update: resource version %s, expected %s", pvc.ResourceVersion, resourceVersion)
+	}
+}
@@ -0,0 +1,33 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// defaultReplicas are set on creation when the replicas are not set in the APIManager
+const defaultReplicas int32 = 1
+
+// replicasOptions returns the replicas of a DeploymentConfig and whether they are managed
+// by the operator. Unset replicas are only set on creation, so they can be managed
+// externally, e.g. by a HorizontalPodAutoscaler
+func replicasOptions(replicas *int64) (int32, bool) {
+	if replicas == nil {
+		return defaultReplicas, false
+	}
+	return int32(*replicas), true
+}
+
+// replicasMutator returns the mutator of the DeploymentConfig replicas. Replicas not managed
+// by the operator are never mutated, even when scaled down to zero
+func replicasMutator(managed bool) reconcilers.DCMutateFn {
+	if managed {
+		return reconcilers.DeploymentConfigReplicasMutator
+	}
+	return unmanagedReplicasMutator
+}
+
+func unmanagedReplicasMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return false, nil
+}
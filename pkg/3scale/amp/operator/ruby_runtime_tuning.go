@@ -0,0 +1,20 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// rubyRuntimeTuningOptions returns the Ruby runtime tuning of a component. Nil when the runtime is not tuned
+func rubyRuntimeTuningOptions(spec *appsv1alpha1.RubyRuntimeTuningSpec) *component.RubyRuntimeTuningOptions {
+	if spec == nil {
+		return nil
+	}
+
+	return &component.RubyRuntimeTuningOptions{
+		MallocArenaMax:     spec.MallocArenaMax,
+		GCHeapGrowthFactor: spec.GCHeapGrowthFactor,
+		GCHeapInitSlots:    spec.GCHeapInitSlots,
+		RubyYJIT:           spec.RubyYJIT,
+	}
+}
@@ -0,0 +1,46 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// setSecretHashAnnotation sets the hash of the secrets read by the env vars of the
+// desired pod template, so the pods are rolled out when the secrets change.
+// Secrets annotated with component.SecretHashSkipAnnotation are not hashed
+func (r *BaseAPIManagerLogicReconciler) setSecretHashAnnotation(desired *appsv1.DeploymentConfig) error {
+	if desired.Spec.Template == nil {
+		return nil
+	}
+
+	refs := []component.SecretReference{}
+	secrets := map[string]*v1.Secret{}
+	for _, ref := range component.PodSecretReferences(&desired.Spec.Template.Spec) {
+		secret := &v1.Secret{}
+		err := r.GetResource(types.NamespacedName{Name: ref.Name, Namespace: r.apiManager.Namespace}, secret)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			if secret.Annotations[component.SecretHashSkipAnnotation] == "true" {
+				continue
+			}
+			secrets[ref.Name] = secret
+		}
+		refs = append(refs, ref)
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	if desired.Spec.Template.Annotations == nil {
+		desired.Spec.Template.Annotations = map[string]string{}
+	}
+	desired.Spec.Template.Annotations[component.SecretHashAnnotation] = component.SecretHash(refs, secrets)
+	return nil
+}
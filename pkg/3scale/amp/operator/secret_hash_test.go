@@ -0,0 +1,160 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestSecretHash(t *testing.T) {
+	podSpec := &v1.PodSpec{
+		InitContainers: []v1.Container{
+			{Name: "init", Env: []v1.EnvVar{helper.EnvVarFromSecret("DATABASE_URL", "zync", "DATABASE_URL")}},
+		},
+		Containers: []v1.Container{
+			{
+				Name: "zync",
+				Env: []v1.EnvVar{
+					helper.EnvVarFromSecret("DATABASE_URL", "zync", "DATABASE_URL"),
+					helper.EnvVarFromSecret("ZYNC_AUTHENTICATION_TOKEN", "zync", "ZYNC_AUTHENTICATION_TOKEN"),
+					helper.EnvVarFromValue("RAILS_ENV", "production"),
+				},
+				EnvFrom: []v1.EnvFromSource{
+					{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "extra"}}},
+				},
+			},
+		},
+	}
+
+	refs := component.PodSecretReferences(podSpec)
+	if len(refs) != 2 || refs[0].Name != "extra" || refs[0].Keys != nil || refs[1].Name != "zync" || len(refs[1].Keys) != 2 {
+		t.Fatalf("unexpected secret references: %v", refs)
+	}
+
+	secrets := func(databaseURL, unused, extra string) map[string]*v1.Secret {
+		return map[string]*v1.Secret{
+			"zync": {Data: map[string][]byte{
+				"DATABASE_URL":              []byte(databaseURL),
+				"ZYNC_AUTHENTICATION_TOKEN": []byte("token"),
+				"UNUSED":                    []byte(unused),
+			}},
+			"extra": {Data: map[string][]byte{"EXTRA": []byte(extra)}},
+		}
+	}
+
+	hash := component.SecretHash(refs, secrets("postgresql://zync:pass1@db/zync", "a", "x"))
+	if component.SecretHash(refs, secrets("postgresql://zync:pass1@db/zync", "a", "x")) != hash {
+		t.Error("expected a stable hash")
+	}
+	if component.SecretHash(refs, secrets("postgresql://zync:pass1@db/zync", "b", "x")) != hash {
+		t.Error("unexpected hash change on a key not read by the pods")
+	}
+	if component.SecretHash(refs, secrets("postgresql://zync:pass2@db/zync", "a", "x")) == hash {
+		t.Error("expected hash change on a referenced key")
+	}
+	if component.SecretHash(refs, secrets("postgresql://zync:pass1@db/zync", "a", "y")) == hash {
+		t.Error("expected hash change on a secret loaded with envFrom")
+	}
+	if component.SecretHash(refs, map[string]*v1.Secret{}) == hash {
+		t.Error("expected hash change on missing secrets")
+	}
+}
+
+func TestSecretHashAnnotation(t *testing.T) {
+	var (
+		appLabel = "someLabel"
+		log      = logf.Log.WithName("operator_test")
+	)
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+		},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "zync", Namespace: namespace},
+		Data:       map[string][]byte{"DATABASE_URL": []byte("postgresql://zync:pass1@db/zync")},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	objs := []runtime.Object{apimanager, secret}
+	cl := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, fake.NewFakeClient(objs...), log, clientset.Discovery(), record.NewFakeRecorder(10000))
+	r := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	desiredDC := func() *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "zync", Namespace: namespace},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: []v1.Container{
+						{Name: "zync", Env: []v1.EnvVar{helper.EnvVarFromSecret("DATABASE_URL", "zync", "DATABASE_URL")}},
+					}},
+				},
+			},
+		}
+	}
+
+	desired := desiredDC()
+	if err := r.setSecretHashAnnotation(desired); err != nil {
+		t.Fatal(err)
+	}
+	hash, ok := desired.Spec.Template.Annotations[component.SecretHashAnnotation]
+	if !ok {
+		t.Fatal("expected secret hash annotation")
+	}
+
+	// Written through the reconciler client, as the secrets are read once per reconcile otherwise
+	secret.Data["DATABASE_URL"] = []byte("postgresql://zync:pass2@db/zync")
+	if err := r.Client().Update(context.TODO(), secret); err != nil {
+		t.Fatal(err)
+	}
+	updated := desiredDC()
+	if err := r.setSecretHashAnnotation(updated); err != nil {
+		t.Fatal(err)
+	}
+	if updated.Spec.Template.Annotations[component.SecretHashAnnotation] == hash {
+		t.Error("expected secret hash change")
+	}
+
+	changed, err := podTemplateMutator(updated, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || desired.Spec.Template.Annotations[component.SecretHashAnnotation] != updated.Spec.Template.Annotations[component.SecretHashAnnotation] {
+		t.Errorf("secret hash annotation not updated: %v", desired.Spec.Template.Annotations)
+	}
+
+	// Skipped secrets are not hashed
+	secret.Annotations = map[string]string{component.SecretHashSkipAnnotation: "true"}
+	if err := r.Client().Update(context.TODO(), secret); err != nil {
+		t.Fatal(err)
+	}
+	skipped := desiredDC()
+	if err := r.setSecretHashAnnotation(skipped); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := skipped.Spec.Template.Annotations[component.SecretHashAnnotation]; ok {
+		t.Error("unexpected secret hash annotation")
+	}
+}
@@ -0,0 +1,175 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretUpdatePolicy(t *testing.T) {
+	systemSecret := func(secret func(*component.System) *v1.Secret) func(client.Client) (*v1.Secret, error) {
+		return func(cl client.Client) (*v1.Secret, error) {
+			system, err := System(basicApimanager(), cl)
+			if err != nil {
+				return nil, err
+			}
+			return secret(system), nil
+		}
+	}
+	backendSecret := func(secret func(*component.Backend) *v1.Secret) func(client.Client) (*v1.Secret, error) {
+		return func(cl client.Client) (*v1.Secret, error) {
+			backend, err := Backend(basicApimanager(), cl)
+			if err != nil {
+				return nil, err
+			}
+			return secret(backend), nil
+		}
+	}
+	redisSecret := func(secret func(*component.Redis) *v1.Secret) func(client.Client) (*v1.Secret, error) {
+		return func(cl client.Client) (*v1.Secret, error) {
+			redis, err := Redis(basicApimanager(), cl)
+			if err != nil {
+				return nil, err
+			}
+			return secret(redis), nil
+		}
+	}
+
+	cases := []struct {
+		secretName string
+		field      string
+		userValue  string
+		desired    func(client.Client) (*v1.Secret, error)
+	}{
+		{component.BackendSecretInternalApiSecretName, component.BackendSecretInternalApiPasswordFieldName, "userPassword",
+			backendSecret((*component.Backend).InternalAPISecretForSystem)},
+		{component.BackendSecretBackendListenerSecretName, component.BackendSecretBackendListenerRouteEndpointFieldName, "https://backend.example.com",
+			backendSecret((*component.Backend).ListenerSecret)},
+		{component.BackendSecretBackendRedisSecretName, component.BackendSecretBackendRedisStorageURLFieldName, "redis://redis.example.com:6379/0",
+			redisSecret((*component.Redis).BackendRedisSecret)},
+		{component.SystemSecretSystemRedisSecretName, component.SystemSecretSystemRedisURLFieldName, "redis://redis.example.com:6379/1",
+			redisSecret((*component.Redis).SystemRedisSecret)},
+		{component.SystemSecretSystemDatabaseSecretName, component.SystemSecretSystemDatabaseUserFieldName, "userDBUser",
+			func(cl client.Client) (*v1.Secret, error) {
+				mysql, err := SystemMySQL(basicApimanager(), cl)
+				if err != nil {
+					return nil, err
+				}
+				return mysql.SystemDatabaseSecret(), nil
+			}},
+		{component.SystemSecretSystemMemcachedSecretName, component.SystemSecretSystemMemcachedServersFieldName, "memcache.example.com:11211",
+			systemSecret((*component.System).MemcachedSecret)},
+		{component.SystemSecretSystemRecaptchaSecretName, component.SystemSecretSystemRecaptchaPublicKeyFieldName, "userPublicKey",
+			systemSecret((*component.System).RecaptchaSecret)},
+		{component.SystemSecretSystemEventsHookSecretName, component.SystemSecretSystemEventsHookPasswordFieldName, "userPassword",
+			systemSecret((*component.System).EventsHookSecret)},
+		{component.SystemSecretSystemAppSecretName, component.SystemSecretSystemAppSecretKeyBaseFieldName, "userSecretKeyBase",
+			systemSecret((*component.System).AppSecret)},
+		{component.SystemSecretSystemSeedSecretName, component.SystemSecretSystemSeedAdminPasswordFieldName, "userPassword",
+			systemSecret((*component.System).SeedSecret)},
+		{component.SystemSecretSystemMasterApicastSecretName, component.SystemSecretSystemMasterApicastProxyConfigsEndpointFieldName, "http://token@system.example.com/master/api/proxy/configs",
+			systemSecret((*component.System).MasterApicastSecret)},
+		{component.SystemSecretSystemSMTPSecretName, component.SystemSecretSystemSMTPAddressFieldName, "smtp.example.com",
+			systemSecret((*component.System).SMTPSecret)},
+		{component.ZyncSecretName, component.ZyncSecretKeyBaseFieldName, "userSecretKeyBase",
+			func(cl client.Client) (*v1.Secret, error) {
+				zync, err := Zync(basicApimanager(), cl)
+				if err != nil {
+					return nil, err
+				}
+				return zync.Secret(), nil
+			}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.secretName, func(subT *testing.T) {
+			cl := fake.NewFakeClient()
+			nn := types.NamespacedName{Name: tc.secretName, Namespace: namespace}
+
+			read := func() *v1.Secret {
+				existing := &v1.Secret{}
+				if err := cl.Get(context.TODO(), nn, existing); err != nil {
+					subT.Fatal(err)
+				}
+				return existing
+			}
+
+			// mutate applies the desired secret on the existing one, like the secret reconcile
+			mutate := func() (*v1.Secret, *v1.Secret) {
+				desired, err := tc.desired(cl)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				existing := read()
+				if _, err := reconcilers.DefaultsOnlySecretMutator(existing, desired); err != nil {
+					subT.Fatal(err)
+				}
+				existing.Data = helper.MergeSecretData(helper.GetSecretDataFromStringData(existing.StringData), existing.Data)
+				existing.StringData = nil
+				if err := cl.Update(context.TODO(), existing); err != nil {
+					subT.Fatal(err)
+				}
+				return desired, existing
+			}
+
+			// First create
+			desired, err := tc.desired(cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if _, ok := desired.StringData[tc.field]; !ok {
+				subT.Fatalf("expected field %s in the desired secret", tc.field)
+			}
+			created := desired.DeepCopy()
+			created.Namespace = namespace
+			created.Data = helper.GetSecretDataFromStringData(created.StringData)
+			created.StringData = nil
+			if err := cl.Create(context.TODO(), created); err != nil {
+				subT.Fatal(err)
+			}
+			generated := desired.StringData[tc.field]
+
+			// The generated value is kept
+			desired, existing := mutate()
+			if desired.StringData[tc.field] != generated || string(existing.Data[tc.field]) != generated {
+				subT.Errorf("expected generated value '%s' kept, got desired '%s' and existing '%s'", generated, desired.StringData[tc.field], existing.Data[tc.field])
+			}
+
+			// User edit
+			existing = read()
+			existing.Data[tc.field] = []byte(tc.userValue)
+			if err := cl.Update(context.TODO(), existing); err != nil {
+				subT.Fatal(err)
+			}
+			desired, existing = mutate()
+			if desired.StringData[tc.field] != tc.userValue || string(existing.Data[tc.field]) != tc.userValue {
+				subT.Errorf("expected user value '%s' kept, got desired '%s' and existing '%s'", tc.userValue, desired.StringData[tc.field], existing.Data[tc.field])
+			}
+
+			// Reset annotation
+			existing = read()
+			existing.Annotations = map[string]string{helper.SecretResetFieldAnnotation: tc.field}
+			if err := cl.Update(context.TODO(), existing); err != nil {
+				subT.Fatal(err)
+			}
+			desired, existing = mutate()
+			if desired.StringData[tc.field] == tc.userValue {
+				subT.Errorf("expected field %s generated again, got user value", tc.field)
+			}
+			if string(existing.Data[tc.field]) != desired.StringData[tc.field] {
+				subT.Errorf("expected reset value '%s', got '%s'", desired.StringData[tc.field], existing.Data[tc.field])
+			}
+			if _, ok := existing.Annotations[helper.SecretResetFieldAnnotation]; ok {
+				subT.Error("expected reset annotation removed")
+			}
+		})
+	}
+}
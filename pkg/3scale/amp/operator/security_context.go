@@ -0,0 +1,20 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// securityContextOptions returns the security context overrides of a component.
+// Nil is returned when none is set, so the pod templates are not changed
+func securityContextOptions(podSecurityContext *v1.PodSecurityContext, securityContext *v1.SecurityContext) *component.SecurityContextOptions {
+	if podSecurityContext == nil && securityContext == nil {
+		return nil
+	}
+
+	return &component.SecurityContextOptions{
+		PodSecurityContext: podSecurityContext,
+		SecurityContext:    securityContext,
+	}
+}
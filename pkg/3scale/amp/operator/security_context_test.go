@@ -0,0 +1,265 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecurityContext(t *testing.T) {
+	podSecurityContext := &v1.PodSecurityContext{
+		RunAsNonRoot: &[]bool{true}[0],
+		RunAsUser:    &[]int64{1000650000}[0],
+		FSGroup:      &[]int64{1000650000}[0],
+	}
+	securityContext := &v1.SecurityContext{
+		AllowPrivilegeEscalation: &[]bool{false}[0],
+		Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}},
+	}
+
+	apicastDC := func(production bool) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			apicast, err := Apicast(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			if production {
+				return apicast.ProductionDeploymentConfig(), nil
+			}
+			return apicast.StagingDeploymentConfig(), nil
+		}
+	}
+
+	backendDC := func(dcName string) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			backend, err := Backend(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return map[string]*appsv1.DeploymentConfig{
+				"listener": backend.ListenerDeploymentConfig(),
+				"worker":   backend.WorkerDeploymentConfig(),
+				"cron":     backend.CronDeploymentConfig(),
+			}[dcName], nil
+		}
+	}
+
+	systemDC := func(dcName string) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			system, err := System(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return map[string]*appsv1.DeploymentConfig{
+				"app":     system.AppDeploymentConfig(),
+				"sidekiq": system.SidekiqDeploymentConfig(),
+				"sphinx":  system.SphinxDeploymentConfig(),
+			}[dcName], nil
+		}
+	}
+
+	zyncDC := func(dcName string) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			zync, err := Zync(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			return map[string]*appsv1.DeploymentConfig{
+				"zync":     zync.DeploymentConfig(),
+				"que":      zync.QueDeploymentConfig(),
+				"database": zync.DatabaseDeploymentConfig(),
+			}[dcName], nil
+		}
+	}
+
+	redisDC := func(backend bool) func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+		return func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+			redis, err := Redis(apimanager, fake.NewFakeClient())
+			if err != nil {
+				return nil, err
+			}
+			if backend {
+				return redis.BackendDeploymentConfig(), nil
+			}
+			return redis.SystemDeploymentConfig(), nil
+		}
+	}
+
+	cases := []struct {
+		testName string
+		set      func(*appsv1alpha1.APIManager)
+		dc       func(*appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error)
+	}{
+		{"apicast-production",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Apicast.ProductionSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Apicast.ProductionSpec.SecurityContext = securityContext
+			},
+			apicastDC(true),
+		},
+		{"apicast-staging",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Apicast.StagingSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Apicast.StagingSpec.SecurityContext = securityContext
+			},
+			apicastDC(false),
+		},
+		{"backend-listener",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Backend.ListenerSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Backend.ListenerSpec.SecurityContext = securityContext
+			},
+			backendDC("listener"),
+		},
+		{"backend-worker",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Backend.WorkerSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Backend.WorkerSpec.SecurityContext = securityContext
+			},
+			backendDC("worker"),
+		},
+		{"backend-cron",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Backend.CronSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Backend.CronSpec.SecurityContext = securityContext
+			},
+			backendDC("cron"),
+		},
+		{"system-app",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.AppSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.System.AppSpec.SecurityContext = securityContext
+			},
+			systemDC("app"),
+		},
+		{"system-sidekiq",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.SidekiqSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.System.SidekiqSpec.SecurityContext = securityContext
+			},
+			systemDC("sidekiq"),
+		},
+		{"system-sphinx",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.SphinxSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.System.SphinxSpec.SecurityContext = securityContext
+			},
+			systemDC("sphinx"),
+		},
+		{"zync",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Zync.AppSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Zync.AppSpec.SecurityContext = securityContext
+			},
+			zyncDC("zync"),
+		},
+		{"zync-que",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Zync.QueSpec.PodSecurityContext = podSecurityContext
+				apimanager.Spec.Zync.QueSpec.SecurityContext = securityContext
+			},
+			zyncDC("que"),
+		},
+		{"zync-database",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Zync.DatabasePodSecurityContext = podSecurityContext
+				apimanager.Spec.Zync.DatabaseSecurityContext = securityContext
+			},
+			zyncDC("database"),
+		},
+		{"backend-redis",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.Backend.RedisPodSecurityContext = podSecurityContext
+				apimanager.Spec.Backend.RedisSecurityContext = securityContext
+			},
+			redisDC(true),
+		},
+		{"system-redis",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.RedisPodSecurityContext = podSecurityContext
+				apimanager.Spec.System.RedisSecurityContext = securityContext
+			},
+			redisDC(false),
+		},
+		{"system-memcache",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.MemcachedPodSecurityContext = podSecurityContext
+				apimanager.Spec.System.MemcachedSecurityContext = securityContext
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				memcached, err := Memcached(apimanager)
+				if err != nil {
+					return nil, err
+				}
+				return memcached.DeploymentConfig(), nil
+			},
+		},
+		{"system-mysql",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.DatabaseSpec = &appsv1alpha1.SystemDatabaseSpec{
+					MySQL: &appsv1alpha1.SystemMySQLSpec{PodSecurityContext: podSecurityContext, SecurityContext: securityContext},
+				}
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				mysql, err := SystemMySQL(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return mysql.DeploymentConfig(), nil
+			},
+		},
+		{"system-postgresql",
+			func(apimanager *appsv1alpha1.APIManager) {
+				apimanager.Spec.System.DatabaseSpec = &appsv1alpha1.SystemDatabaseSpec{
+					PostgreSQL: &appsv1alpha1.SystemPostgreSQLSpec{PodSecurityContext: podSecurityContext, SecurityContext: securityContext},
+				}
+			},
+			func(apimanager *appsv1alpha1.APIManager) (*appsv1.DeploymentConfig, error) {
+				postgresql, err := SystemPostgreSQL(apimanager, fake.NewFakeClient())
+				if err != nil {
+					return nil, err
+				}
+				return postgresql.DeploymentConfig(), nil
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			dc, err := tc.dc(basicApimanager())
+			if err != nil {
+				subT.Fatal(err)
+			}
+			podSpec := dc.Spec.Template.Spec
+			if podSpec.SecurityContext != nil {
+				subT.Errorf("unexpected pod security context: %v", podSpec.SecurityContext)
+			}
+			for _, container := range append(append([]v1.Container{}, podSpec.InitContainers...), podSpec.Containers...) {
+				if container.SecurityContext != nil {
+					subT.Errorf("%s: unexpected security context: %v", container.Name, container.SecurityContext)
+				}
+			}
+
+			apimanager := basicApimanager()
+			tc.set(apimanager)
+			dc, err = tc.dc(apimanager)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			podSpec = dc.Spec.Template.Spec
+			if !reflect.DeepEqual(podSpec.SecurityContext, podSecurityContext) {
+				subT.Errorf("expected pod security context %v, got %v", podSecurityContext, podSpec.SecurityContext)
+			}
+			for _, container := range append(append([]v1.Container{}, podSpec.InitContainers...), podSpec.Containers...) {
+				if !reflect.DeepEqual(container.SecurityContext, securityContext) {
+					subT.Errorf("%s: expected security context %v, got %v", container.Name, securityContext, container.SecurityContext)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// sharedMemoryVolumeMutator reconciles the /dev/shm volume of the database deployment configs
+func sharedMemoryVolumeMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return reconcilers.DeploymentConfigVolumeReconciler(desired, existing, component.SharedMemoryVolumeName), nil
+}
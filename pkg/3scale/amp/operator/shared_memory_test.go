@@ -0,0 +1,99 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSharedMemoryVolume(t *testing.T) {
+	databaseDCs := func(subT *testing.T, sizeLimit *resource.Quantity) []*appsv1.DeploymentConfig {
+		apimanager := basicApimanager()
+		apimanager.Spec.Zync.DatabaseSharedMemorySizeLimit = sizeLimit
+		apimanager.Spec.System.DatabaseSpec = &appsv1alpha1.SystemDatabaseSpec{
+			MySQL: &appsv1alpha1.SystemMySQLSpec{SharedMemorySizeLimit: sizeLimit},
+		}
+		cl := fake.NewFakeClient()
+
+		zync, err := Zync(apimanager, cl)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		systemMySQL, err := SystemMySQL(apimanager, cl)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		return []*appsv1.DeploymentConfig{zync.DatabaseDeploymentConfig(), systemMySQL.DeploymentConfig()}
+	}
+
+	assertSharedMemory := func(subT *testing.T, dc *appsv1.DeploymentConfig, sizeLimit *resource.Quantity) {
+		volumeIdx := helper.FindVolumeByName(dc.Spec.Template.Spec.Volumes, component.SharedMemoryVolumeName)
+		mountIdx := helper.FindVolumeMountByName(dc.Spec.Template.Spec.Containers[0].VolumeMounts, component.SharedMemoryVolumeName)
+		if sizeLimit == nil {
+			if volumeIdx >= 0 || mountIdx >= 0 {
+				subT.Errorf("%s: unexpected shared memory volume", dc.Name)
+			}
+			return
+		}
+
+		if volumeIdx < 0 || mountIdx < 0 {
+			subT.Fatalf("%s: shared memory volume not found", dc.Name)
+		}
+		emptyDir := dc.Spec.Template.Spec.Volumes[volumeIdx].EmptyDir
+		if emptyDir == nil || emptyDir.Medium != v1.StorageMediumMemory || emptyDir.SizeLimit == nil || emptyDir.SizeLimit.Cmp(*sizeLimit) != 0 {
+			subT.Errorf("%s: unexpected shared memory volume: %v", dc.Name, dc.Spec.Template.Spec.Volumes[volumeIdx])
+		}
+		if mountPath := dc.Spec.Template.Spec.Containers[0].VolumeMounts[mountIdx].MountPath; mountPath != component.SharedMemoryMountPath {
+			subT.Errorf("%s: unexpected shared memory mount path: %s", dc.Name, mountPath)
+		}
+	}
+
+	sizeLimit := resource.MustParse("256Mi")
+	otherSizeLimit := resource.MustParse("1Gi")
+
+	t.Run("Rendering", func(subT *testing.T) {
+		for _, dc := range databaseDCs(subT, nil) {
+			assertSharedMemory(subT, dc, nil)
+		}
+		for _, dc := range databaseDCs(subT, &sizeLimit) {
+			assertSharedMemory(subT, dc, &sizeLimit)
+		}
+	})
+
+	cases := []struct {
+		testName        string
+		existingLimit   *resource.Quantity
+		desiredLimit    *resource.Quantity
+		expectedChanged bool
+	}{
+		{"NothingToReconcile", &sizeLimit, &sizeLimit, false},
+		{"Added", nil, &sizeLimit, true},
+		{"Updated", &sizeLimit, &otherSizeLimit, true},
+		{"Removed", &sizeLimit, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existingDCs := databaseDCs(subT, tc.existingLimit)
+			desiredDCs := databaseDCs(subT, tc.desiredLimit)
+			for idx := range desiredDCs {
+				changed, err := sharedMemoryVolumeMutator(desiredDCs[idx], existingDCs[idx])
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if changed != tc.expectedChanged {
+					subT.Errorf("%s: expected changed %t, got %t", existingDCs[idx].Name, tc.expectedChanged, changed)
+				}
+				assertSharedMemory(subT, existingDCs[idx], tc.desiredLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package operator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+const (
+	// APIManagerShutdownFinalizer holds the APIManager deletion
+	// until the ordered shutdown has been completed
+	APIManagerShutdownFinalizer = "apps.3scale.net/ordered-shutdown"
+
+	ShutdownStageStoppingTraffic       = "StoppingTraffic"
+	ShutdownStageDrainingBackendQueues = "DrainingBackendQueues"
+	ShutdownStageStoppingWorkers       = "StoppingWorkers"
+	ShutdownStageStoppingDatabases     = "StoppingDatabases"
+
+	shutdownRequeueDelay = 10 * time.Second
+	shutdownRedisTimeout = 5 * time.Second
+)
+
+// BackendQueues are the redis lists holding the backend-worker pending jobs
+var BackendQueues = []string{"resque:queue:priority", "resque:queue:main", "resque:queue:stats"}
+
+type shutdownStage struct {
+	name string
+	// deployments scaled down in the stage
+	deployments []string
+}
+
+// shutdownStages lists the stages in order. Deployments not found are skipped,
+// hence all the optional deployments are listed.
+var shutdownStages = []shutdownStage{
+	{
+		name: ShutdownStageStoppingTraffic,
+		deployments: []string{
+			component.ApicastProductionName,
+			component.ApicastStagingName,
+			component.BackendListenerName,
+			component.SystemAppDeploymentName,
+			component.ZyncName,
+		},
+	},
+	{
+		name: ShutdownStageDrainingBackendQueues,
+	},
+	{
+		name: ShutdownStageStoppingWorkers,
+		deployments: []string{
+			component.BackendWorkerName,
+			component.BackendCronName,
+			component.SystemSidekiqName,
+			component.SystemSphinxDeploymentName,
+			component.ZyncQueDeploymentName,
+		},
+	},
+	{
+		name: ShutdownStageStoppingDatabases,
+		deployments: []string{
+			component.BackendRedisDeploymentName,
+			component.SystemRedisDeploymentName,
+			component.SystemMySQLDeploymentName,
+			component.SystemPostgreSQLDeploymentName,
+			component.SystemMemcachedDeploymentName,
+			component.ZyncDatabaseDeploymentName,
+		},
+	},
+}
+
+// ShutdownReconciler stops the APIManager components in order when the
+// APIManager is being deleted, then removes the APIManagerShutdownFinalizer.
+// The whole sequence is bounded by the shutdown deadline.
+type ShutdownReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	now                 func() time.Time
+	backendQueuesLength func(redisURL string) (int64, error)
+}
+
+func NewShutdownReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *ShutdownReconciler {
+	return &ShutdownReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		now:                           time.Now,
+		backendQueuesLength: func(redisURL string) (int64, error) {
+			return helper.RedisListsLength(redisURL, BackendQueues, shutdownRedisTimeout)
+		},
+	}
+}
+
+func (r *ShutdownReconciler) Reconcile() (reconcile.Result, error) {
+	now := r.now()
+
+	deadline := r.apiManager.GetDeletionTimestamp().Add(r.apiManager.ShutdownDeadline())
+	if now.After(deadline) {
+		msg := fmt.Sprintf("shutdown deadline of %s exceeded", r.apiManager.ShutdownDeadline())
+		r.EventRecorder().Event(r.apiManager, v1.EventTypeWarning, "ShutdownDeadlineExceeded", msg)
+		r.Logger().Info(msg)
+		return reconcile.Result{}, r.removeFinalizer()
+	}
+
+	stageIdx := 0
+	if r.apiManager.Status.Shutdown != nil {
+		for idx := range shutdownStages {
+			if shutdownStages[idx].name == r.apiManager.Status.Shutdown.Stage {
+				stageIdx = idx
+			}
+		}
+	}
+
+	for ; stageIdx < len(shutdownStages); stageIdx++ {
+		stage := shutdownStages[stageIdx]
+
+		var completed bool
+		var msg string
+		var err error
+		if stage.name == ShutdownStageDrainingBackendQueues {
+			completed, msg, err = r.drainBackendQueues(now)
+		} else {
+			completed, msg, err = r.scaleDown(stage.deployments)
+		}
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if !completed {
+			err = r.writeShutdownStatus(stage.name, msg, now)
+			return reconcile.Result{RequeueAfter: shutdownRequeueDelay}, err
+		}
+
+		r.Logger().Info("shutdown stage completed", "stage", stage.name)
+	}
+
+	r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "ShutdownCompleted", "all the components have been stopped")
+	return reconcile.Result{}, r.removeFinalizer()
+}
+
+// scaleDown sets the replicas of the deployments to zero.
+// It is completed when no pod is left running.
+func (r *ShutdownReconciler) scaleDown(deployments []string) (bool, string, error) {
+	pending := []string{}
+
+	for _, name := range deployments {
+		dc := &appsv1.DeploymentConfig{}
+		err := r.Client().Get(r.Context(), types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, dc)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, "", err
+		}
+
+		if dc.Spec.Replicas != 0 {
+			dc.Spec.Replicas = 0
+			err = r.UpdateResource(dc)
+			if err != nil {
+				return false, "", err
+			}
+		}
+
+		if dc.Status.Replicas != 0 {
+			pending = append(pending, name)
+		}
+	}
+
+	if len(pending) > 0 {
+		return false, fmt.Sprintf("waiting for %s to stop", strings.Join(pending, ", ")), nil
+	}
+
+	return true, "", nil
+}
+
+// drainBackendQueues waits for the backend-worker queues to be empty,
+// bounded by the backend queues drain timeout. The queues of an external
+// backend are not drained, its workers keep running.
+func (r *ShutdownReconciler) drainBackendQueues(now time.Time) (bool, string, error) {
+	if r.apiManager.IsExternalBackend() {
+		return true, "", nil
+	}
+
+	stageStart := now
+	if status := r.apiManager.Status.Shutdown; status != nil && status.Stage == ShutdownStageDrainingBackendQueues {
+		stageStart = status.StageStartTime.Time
+	}
+
+	timeout := r.apiManager.ShutdownBackendQueuesDrainTimeout()
+	if now.Sub(stageStart) >= timeout {
+		msg := fmt.Sprintf("backend queues not drained after %s", timeout)
+		r.EventRecorder().Event(r.apiManager, v1.EventTypeWarning, "BackendQueuesDrainTimeout", msg)
+		r.Logger().Info(msg)
+		return true, "", nil
+	}
+
+	secretSource := helper.NewSecretSource(r.Client(), r.apiManager.Namespace)
+	redisURL, err := secretSource.FieldValue(
+		component.BackendSecretBackendRedisSecretName,
+		component.BackendSecretBackendRedisQueuesURLFieldName,
+		component.DefaultBackendRedisQueuesURL())
+	if err != nil {
+		return false, "", err
+	}
+
+	// Queues that cannot be read are waited for until the timeout
+	length, err := r.backendQueuesLength(redisURL)
+	if err != nil {
+		return false, fmt.Sprintf("backend queues length unavailable: %s", err), nil
+	}
+
+	if length > 0 {
+		return false, fmt.Sprintf("%d jobs left in the backend queues", length), nil
+	}
+
+	return true, "", nil
+}
+
+func (r *ShutdownReconciler) writeShutdownStatus(stage, msg string, now time.Time) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		status := &r.apiManager.Status
+		if status.Shutdown == nil || status.Shutdown.Stage != stage {
+			status.Shutdown = &appsv1alpha1.ShutdownStatus{
+				Stage:          stage,
+				StageStartTime: metav1.NewTime(now),
+			}
+		}
+		status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerShuttingDownConditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  common.ConditionReason(stage),
+			Message: msg,
+		})
+		return nil
+	})
+	return err
+}
+
+func (r *ShutdownReconciler) removeFinalizer() error {
+	controllerutil.RemoveFinalizer(r.apiManager, APIManagerShutdownFinalizer)
+	return r.UpdateResource(r.apiManager)
+}
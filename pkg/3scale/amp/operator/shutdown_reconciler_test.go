@@ -0,0 +1,154 @@
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestShutdownReconciler(t *testing.T) {
+	var (
+		namespace    = "operator-unittest"
+		log          = logf.Log.WithName("operator_test")
+		deletionTime = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	runningDC := func(name string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       appsv1.DeploymentConfigSpec{Replicas: 1},
+			Status:     appsv1.DeploymentConfigStatus{Replicas: 1},
+		}
+	}
+	stoppedDC := func(name string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+
+	cases := []struct {
+		testName             string
+		elapsed              time.Duration
+		status               *appsv1alpha1.ShutdownStatus
+		queuesLength         int64
+		deploymentConfigs    []runtime.Object
+		expectedStage        string
+		expectedScaledDown   []string
+		expectedFinalizerSet bool
+	}{
+		{"stopping traffic", time.Minute, nil, 10, []runtime.Object{
+			runningDC(component.ApicastProductionName),
+			runningDC(component.BackendListenerName),
+			runningDC(component.BackendWorkerName),
+		}, ShutdownStageStoppingTraffic, []string{
+			component.ApicastProductionName,
+			component.BackendListenerName,
+		}, true},
+		{"draining backend queues", time.Minute, nil, 10, []runtime.Object{
+			stoppedDC(component.ApicastProductionName),
+			runningDC(component.BackendWorkerName),
+		}, ShutdownStageDrainingBackendQueues, nil, true},
+		{"drain timeout", 10 * time.Minute, &appsv1alpha1.ShutdownStatus{
+			Stage:          ShutdownStageDrainingBackendQueues,
+			StageStartTime: metav1.NewTime(deletionTime),
+		}, 10, []runtime.Object{
+			runningDC(component.BackendWorkerName),
+			runningDC(component.BackendRedisDeploymentName),
+		}, ShutdownStageStoppingWorkers, []string{component.BackendWorkerName}, true},
+		{"queues drained", time.Minute, nil, 0, []runtime.Object{
+			runningDC(component.BackendWorkerName),
+			runningDC(component.BackendRedisDeploymentName),
+		}, ShutdownStageStoppingWorkers, []string{component.BackendWorkerName}, true},
+		{"completed", time.Minute, nil, 0, []runtime.Object{
+			stoppedDC(component.BackendWorkerName),
+			stoppedDC(component.BackendRedisDeploymentName),
+		}, "", nil, false},
+		{"deadline exceeded", time.Hour, nil, 10, []runtime.Object{
+			runningDC(component.ApicastProductionName),
+		}, "", nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := &appsv1alpha1.APIManager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "example-apimanager",
+					Namespace:         namespace,
+					DeletionTimestamp: &metav1.Time{Time: deletionTime},
+					Finalizers:        []string{APIManagerShutdownFinalizer},
+				},
+				Spec: appsv1alpha1.APIManagerSpec{
+					Shutdown: &appsv1alpha1.ShutdownSpec{Enabled: true},
+				},
+				Status: appsv1alpha1.APIManagerStatus{Shutdown: tc.status},
+			}
+
+			s := scheme.Scheme
+			s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+			if err := appsv1.AddToScheme(s); err != nil {
+				subT.Fatal(err)
+			}
+
+			objs := append([]runtime.Object{apimanager}, tc.deploymentConfigs...)
+			cl := fake.NewFakeClient(objs...)
+			clientAPIReader := fake.NewFakeClient(objs...)
+			clientset := fakeclientset.NewSimpleClientset()
+			recorder := record.NewFakeRecorder(10000)
+
+			baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+			baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+			shutdownReconciler := NewShutdownReconciler(baseAPIManagerLogicReconciler)
+			shutdownReconciler.now = func() time.Time { return deletionTime.Add(tc.elapsed) }
+			shutdownReconciler.backendQueuesLength = func(string) (int64, error) { return tc.queuesLength, nil }
+
+			_, err := shutdownReconciler.Reconcile()
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			existing := &appsv1alpha1.APIManager{}
+			err = cl.Get(context.TODO(), types.NamespacedName{Name: apimanager.Name, Namespace: namespace}, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			if controllerutil.ContainsFinalizer(existing, APIManagerShutdownFinalizer) != tc.expectedFinalizerSet {
+				subT.Errorf("unexpected finalizers: %v", existing.Finalizers)
+			}
+
+			if tc.expectedStage != "" {
+				if existing.Status.Shutdown == nil || existing.Status.Shutdown.Stage != tc.expectedStage {
+					subT.Errorf("expected stage %s, got %v", tc.expectedStage, existing.Status.Shutdown)
+				}
+				if !existing.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerShuttingDownConditionType) {
+					subT.Errorf("expected %s condition", appsv1alpha1.APIManagerShuttingDownConditionType)
+				}
+			}
+
+			for _, name := range tc.expectedScaledDown {
+				dc := &appsv1.DeploymentConfig{}
+				err = cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, dc)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if dc.Spec.Replicas != 0 {
+					subT.Errorf("expected %s to be scaled down, got %d replicas", name, dc.Spec.Replicas)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// sidecarsOptions returns the sidecars of a component. Nil when no sidecar is set
+func sidecarsOptions(containers []v1.Container, volumes []v1.Volume) *component.SidecarsOptions {
+	if len(containers) == 0 && len(volumes) == 0 {
+		return nil
+	}
+
+	return &component.SidecarsOptions{
+		Containers: containers,
+		Volumes:    volumes,
+	}
+}
+
+// removeSidecars removes from the pod template the sidecars listed in its annotations.
+// The sidecars are appended to the pod template, so only the trailing containers
+// and volumes are removed
+func removeSidecars(template *v1.PodTemplateSpec) *component.SidecarsOptions {
+	removed := &component.SidecarsOptions{}
+	if template == nil {
+		return removed
+	}
+
+	containerNames := map[string]bool{}
+	for _, name := range component.SidecarNames(template.Annotations[component.SidecarContainersAnnotation]) {
+		containerNames[name] = true
+	}
+	containers := template.Spec.Containers
+	idx := len(containers)
+	for idx > 0 && containerNames[containers[idx-1].Name] {
+		idx--
+	}
+	removed.Containers = append(removed.Containers, containers[idx:]...)
+	template.Spec.Containers = containers[:idx]
+
+	volumeNames := map[string]bool{}
+	for _, name := range component.SidecarNames(template.Annotations[component.SidecarVolumesAnnotation]) {
+		volumeNames[name] = true
+	}
+	volumes := template.Spec.Volumes
+	idx = len(volumes)
+	for idx > 0 && volumeNames[volumes[idx-1].Name] {
+		idx--
+	}
+	removed.Volumes = append(removed.Volumes, volumes[idx:]...)
+	template.Spec.Volumes = volumes[:idx]
+
+	return removed
+}
+
+func restoreSidecars(template *v1.PodTemplateSpec, sidecars *component.SidecarsOptions) {
+	if template == nil {
+		return
+	}
+
+	template.Spec.Containers = append(template.Spec.Containers, sidecars.Containers...)
+	template.Spec.Volumes = append(template.Spec.Volumes, sidecars.Volumes...)
+}
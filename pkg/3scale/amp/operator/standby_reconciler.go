@@ -0,0 +1,236 @@
+package operator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+const (
+	StandbyReasonStandby    = "Standby"
+	StandbyReasonActivating = "Activating"
+
+	standbyRequeueDelay = 10 * time.Second
+)
+
+// StandbyComponents are the DeploymentConfigs scaled down in standby mode,
+// listed in the order they are brought up on activation
+var StandbyComponents = []string{
+	component.SystemSidekiqName,
+	component.ZyncQueDeploymentName,
+	component.BackendCronName,
+}
+
+func ZyncResyncJobName(apimanagerName string) string {
+	return fmt.Sprintf("%s-zync-resync", apimanagerName)
+}
+
+// StandbyReconciler keeps track of the standby mode in the APIManager status.
+// The components are scaled down by the options providers, based on the tracked status.
+// On activation, the components are brought up one at a time
+// and the zync domains are resynchronized.
+type StandbyReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewStandbyReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *StandbyReconciler {
+	return &StandbyReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *StandbyReconciler) Reconcile() (reconcile.Result, error) {
+	if r.apiManager.IsStandby() {
+		return reconcile.Result{}, r.reconcileStandby()
+	}
+
+	if r.apiManager.Status.Standby == nil {
+		return reconcile.Result{}, nil
+	}
+
+	return r.reconcileActivation()
+}
+
+func (r *StandbyReconciler) reconcileStandby() error {
+	// A job left by a previous activation would be taken as the resync of the next one
+	err := r.deleteJob(ZyncResyncJobName(r.apiManager.Name))
+	if err != nil {
+		return err
+	}
+
+	standby := &appsv1alpha1.StandbyStatus{
+		ScaledDownComponents: append([]string{}, StandbyComponents...),
+		ZyncResyncPending:    true,
+	}
+	msg := fmt.Sprintf("scaled down components: %s", strings.Join(StandbyComponents, ", "))
+	return r.writeStandbyStatus(standby, StandbyReasonStandby, msg)
+}
+
+func (r *StandbyReconciler) reconcileActivation() (reconcile.Result, error) {
+	standby := r.apiManager.Status.Standby.DeepCopy()
+
+	for _, name := range StandbyComponents {
+		if helper.ArrayContains(standby.ScaledDownComponents, name) {
+			// Components are brought up once the previous ones are ready
+			standby.ScaledDownComponents = helper.ArrayStringDifference(standby.ScaledDownComponents, []string{name})
+			err := r.writeStandbyStatus(standby, StandbyReasonActivating, fmt.Sprintf("starting %s", name))
+			return reconcile.Result{RequeueAfter: standbyRequeueDelay}, err
+		}
+
+		dc := &appsv1.DeploymentConfig{}
+		err := r.GetResource(types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, dc)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return reconcile.Result{}, err
+		}
+
+		if dc.Status.ObservedGeneration < dc.Generation || dc.Status.ReadyReplicas < dc.Spec.Replicas {
+			err = r.writeStandbyStatus(standby, StandbyReasonActivating, fmt.Sprintf("waiting for %s to be ready", name))
+			return reconcile.Result{RequeueAfter: standbyRequeueDelay}, err
+		}
+	}
+
+	if standby.ZyncResyncPending {
+		done, err := r.reconcileZyncResyncJob(ZyncResyncJobName(r.apiManager.Name))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !done {
+			err = r.writeStandbyStatus(standby, StandbyReasonActivating, "waiting for zync domains to be resynchronized")
+			return reconcile.Result{RequeueAfter: standbyRequeueDelay}, err
+		}
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.Standby = nil
+		r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerStandbyConditionType)
+		return nil
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "Activated", "all the components have been brought up")
+	return reconcile.Result{}, nil
+}
+
+// reconcileZyncResyncJob runs the zync domains resync job and returns whether it is finished.
+// A failed resync does not block the caller, it can be run again manually.
+func (r *BaseAPIManagerLogicReconciler) reconcileZyncResyncJob(name string) (bool, error) {
+	sidekiq := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: component.SystemSidekiqName, Namespace: r.apiManager.Namespace}, sidekiq)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = r.ReconcileResource(&batchv1.Job{}, ZyncResyncJob(name, r.apiManager, sidekiq), reconcilers.CreateOnlyMutator)
+	if err != nil {
+		return false, err
+	}
+
+	job := &batchv1.Job{}
+	err = r.GetResource(types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, job)
+	if err != nil {
+		return false, err
+	}
+
+	if job.Status.Succeeded > 0 {
+		return true, nil
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "ZyncResyncFailed", "job '%s' failed: %s", job.Name, condition.Message)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *BaseAPIManagerLogicReconciler) deleteJob(name string) error {
+	job := &batchv1.Job{}
+	err := r.GetResource(types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, job)
+	if err == nil {
+		err = r.DeleteResource(job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *StandbyReconciler) writeStandbyStatus(standby *appsv1alpha1.StandbyStatus, reason, msg string) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.Standby = standby
+		r.apiManager.Status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerStandbyConditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  common.ConditionReason(reason),
+			Message: msg,
+		})
+		return nil
+	})
+	return err
+}
+
+// ZyncResyncJob runs the zync domains resync rake task using the system-sidekiq
+// pod template, which holds the system configuration and the resolved image
+func ZyncResyncJob(name string, apimanager *appsv1alpha1.APIManager, sidekiq *appsv1.DeploymentConfig) *batchv1.Job {
+	var backoffLimit int32 = 3
+
+	podSpec := v1.PodSpec{}
+	if sidekiq.Spec.Template != nil {
+		sidekiq.Spec.Template.Spec.DeepCopyInto(&podSpec)
+	}
+	podSpec.RestartPolicy = v1.RestartPolicyNever
+	if len(podSpec.Containers) > 0 {
+		container := podSpec.Containers[0]
+		container.Name = "zync-resync"
+		container.Command = []string{"bash", "-c", "bundle exec rake zync:resync:domains"}
+		container.Args = nil
+		container.LivenessProbe = nil
+		container.ReadinessProbe = nil
+		podSpec.Containers = []v1.Container{container}
+	}
+	helper.ApplyJobPodTemplateOptions(&podSpec, apimanager.Spec.JobsTemplate.JobPodTemplateOptions())
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": *apimanager.Spec.AppLabel,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestStandbyReconciler(t *testing.T) {
+	var (
+		namespace = "operator-unittest"
+		appLabel  = "someLabel"
+		log       = logf.Log.WithName("operator_test")
+	)
+
+	readyDC := func(name string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: appsv1.DeploymentConfigSpec{
+				Replicas: 1,
+				Template: &v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: []v1.Container{{Name: name, Image: "system:latest"}}},
+				},
+			},
+			Status: appsv1.DeploymentConfigStatus{Replicas: 1, ReadyReplicas: 1},
+		}
+	}
+
+	newReconciler := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, objs ...runtime.Object) (*StandbyReconciler, client.Client) {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		clientAPIReader := fake.NewFakeClient(objs...)
+		clientset := fakeclientset.NewSimpleClientset()
+		recorder := record.NewFakeRecorder(10000)
+
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+		return NewStandbyReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)), cl
+	}
+
+	newAPIManager := func(mode string, standby *appsv1alpha1.StandbyStatus) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+			Spec: appsv1alpha1.APIManagerSpec{
+				APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+				Mode:                 &mode,
+			},
+			Status: appsv1alpha1.APIManagerStatus{Standby: standby},
+		}
+	}
+
+	t.Run("standby", func(subT *testing.T) {
+		apimanager := newAPIManager(appsv1alpha1.APIManagerModeStandby, nil)
+		standbyReconciler, _ := newReconciler(subT, apimanager)
+
+		_, err := standbyReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		if apimanager.Status.Standby == nil || len(apimanager.Status.Standby.ScaledDownComponents) != len(StandbyComponents) {
+			subT.Fatalf("unexpected standby status: %v", apimanager.Status.Standby)
+		}
+		if !apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerStandbyConditionType) {
+			subT.Errorf("expected %s condition", appsv1alpha1.APIManagerStandbyConditionType)
+		}
+		if !apimanager.IsScaledDownForStandby(component.BackendCronName) {
+			subT.Errorf("expected %s to be scaled down", component.BackendCronName)
+		}
+	})
+
+	t.Run("activation brings up components in order", func(subT *testing.T) {
+		apimanager := newAPIManager(appsv1alpha1.APIManagerModeActive, &appsv1alpha1.StandbyStatus{
+			ScaledDownComponents: append([]string{}, StandbyComponents...),
+			ZyncResyncPending:    true,
+		})
+		standbyReconciler, _ := newReconciler(subT, apimanager)
+
+		res, err := standbyReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected requeue")
+		}
+		if apimanager.IsScaledDownForStandby(component.SystemSidekiqName) {
+			subT.Errorf("expected %s to be started", component.SystemSidekiqName)
+		}
+		if !apimanager.IsScaledDownForStandby(component.ZyncQueDeploymentName) {
+			subT.Errorf("expected %s to be kept scaled down", component.ZyncQueDeploymentName)
+		}
+	})
+
+	t.Run("activation runs zync resync", func(subT *testing.T) {
+		apimanager := newAPIManager(appsv1alpha1.APIManagerModeActive, &appsv1alpha1.StandbyStatus{ZyncResyncPending: true})
+		standbyReconciler, cl := newReconciler(subT, apimanager,
+			readyDC(component.SystemSidekiqName),
+			readyDC(component.ZyncQueDeploymentName),
+			readyDC(component.BackendCronName),
+		)
+
+		_, err := standbyReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		job := &batchv1.Job{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: ZyncResyncJobName(apimanager.Name), Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.Standby == nil {
+			subT.Fatal("expected activation to wait for the zync resync job")
+		}
+
+		job.Status.Succeeded = 1
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = standbyReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.Standby != nil {
+			subT.Errorf("unexpected standby status: %v", apimanager.Status.Standby)
+		}
+		if apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerStandbyConditionType) {
+			subT.Errorf("unexpected %s condition", appsv1alpha1.APIManagerStandbyConditionType)
+		}
+	})
+}
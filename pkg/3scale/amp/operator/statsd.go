@@ -0,0 +1,46 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// statsdOptions returns the statsd metrics sink options, nil when not configured
+func statsdOptions(apimanager *appsv1alpha1.APIManager) *component.StatsdOptions {
+	if apimanager.Spec.Metrics == nil || apimanager.Spec.Metrics.Statsd == nil {
+		return nil
+	}
+
+	statsdSpec := apimanager.Spec.Metrics.Statsd
+	opts := &component.StatsdOptions{
+		Host:     statsdSpec.Host,
+		Port:     component.DefaultStatsdPort,
+		Protocol: component.DefaultStatsdProtocol,
+	}
+	if statsdSpec.Port != nil {
+		opts.Port = *statsdSpec.Port
+	}
+	if statsdSpec.Protocol != nil {
+		opts.Protocol = *statsdSpec.Protocol
+	}
+	if statsdSpec.Prefix != nil {
+		opts.Prefix = *statsdSpec.Prefix
+	}
+
+	return opts
+}
+
+// statsdEnvVarsMutator reconciles the statsd env vars of all the containers
+func statsdEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, envVar := range component.StatsdEnvVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
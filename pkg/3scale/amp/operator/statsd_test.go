@@ -0,0 +1,85 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestStatsdEnvVars(t *testing.T) {
+	protocol := component.StatsdProtocolTCP
+	prefix := "threescale"
+
+	cases := []struct {
+		testName    string
+		statsdSpec  *appsv1alpha1.StatsdSpec
+		expectedEnv map[string]string
+	}{
+		{"Unset", nil, map[string]string{}},
+		{"Defaults", &appsv1alpha1.StatsdSpec{Host: "statsd.example.com"},
+			map[string]string{
+				component.StatsdHostEnvVarName:     "statsd.example.com",
+				component.StatsdPortEnvVarName:     "8125",
+				component.StatsdProtocolEnvVarName: component.StatsdProtocolUDP,
+			},
+		},
+		{"Custom", &appsv1alpha1.StatsdSpec{Host: "10.0.0.1", Port: &[]int32{9125}[0], Protocol: &protocol, Prefix: &prefix},
+			map[string]string{
+				component.StatsdHostEnvVarName:     "10.0.0.1",
+				component.StatsdPortEnvVarName:     "9125",
+				component.StatsdProtocolEnvVarName: component.StatsdProtocolTCP,
+				component.StatsdPrefixEnvVarName:   "threescale",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager := basicApimanager()
+			if tc.statsdSpec != nil {
+				apimanager.Spec.Metrics = &appsv1alpha1.MetricsSpec{Statsd: tc.statsdSpec}
+			}
+			cl := fake.NewFakeClient()
+
+			system, err := System(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			backend, err := Backend(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			for _, dc := range []*appsv1.DeploymentConfig{
+				system.AppDeploymentConfig(),
+				system.SidekiqDeploymentConfig(),
+				backend.ListenerDeploymentConfig(),
+				backend.WorkerDeploymentConfig(),
+				backend.CronDeploymentConfig(),
+			} {
+				for _, container := range dc.Spec.Template.Spec.Containers {
+					for _, envVarName := range component.StatsdEnvVarNames {
+						idx := helper.FindEnvVar(container.Env, envVarName)
+						expectedValue, expected := tc.expectedEnv[envVarName]
+						if !expected {
+							if idx >= 0 {
+								subT.Errorf("%s/%s: unexpected env var %s", dc.Name, container.Name, envVarName)
+							}
+							continue
+						}
+						if idx < 0 {
+							subT.Errorf("%s/%s: env var %s not found", dc.Name, container.Name, envVarName)
+						} else if container.Env[idx].Value != expectedValue {
+							subT.Errorf("%s/%s: env var %s: expected '%s', got '%s'", dc.Name, container.Name, envVarName, expectedValue, container.Env[idx].Value)
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/product"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+)
+
+const (
+	// SupportReportAnnotation triggers a one-shot generation of the support report
+	// of the APIManager deployment
+	SupportReportAnnotation = "apps.3scale.net/generate-report"
+
+	// SupportReportGeneratedAtAnnotation is set on the report ConfigMap.
+	// The generation time is kept out of the report so the report content only
+	// changes when the deployment does.
+	SupportReportGeneratedAtAnnotation = "apps.3scale.net/report-generated-at"
+
+	SupportReportConfigMapReportKey = "report.yaml"
+
+	// SupportReportTimeout bounds the time spent gathering the report.
+	// Sections not gathered in time are listed as incomplete.
+	SupportReportTimeout = 30 * time.Second
+
+	// SupportReportMaxEvents is the maximum number of recent errors reported
+	SupportReportMaxEvents = 10
+
+	supportReportRedacted = "REDACTED"
+)
+
+// Option keys holding secret material, matched case insensitive against the key suffix
+var supportReportSensitiveKeyRegexp = regexp.MustCompile(`(?i)(password|token|secretkeybase|sharedsecret|secretaccesskey|accesskeyid|privatekey)$`)
+
+func SupportReportConfigMapName(apimanagerName string) string {
+	return fmt.Sprintf("%s-report", apimanagerName)
+}
+
+type SupportReport struct {
+	OperatorVersion   string                   `json:"operatorVersion"`
+	ThreescaleRelease string                   `json:"threescaleRelease"`
+	Components        []SupportReportComponent `json:"components,omitempty"`
+	Databases         []SupportReportDatabase  `json:"databases,omitempty"`
+	Storage           []SupportReportVolume    `json:"storage,omitempty"`
+	Monitoring        SupportReportMonitoring  `json:"monitoring"`
+	RecentErrors      []SupportReportEvent     `json:"recentErrors,omitempty"`
+	Options           map[string]interface{}   `json:"options,omitempty"`
+	// Errors holds the errors found gathering each report section
+	Errors map[string]string `json:"errors,omitempty"`
+	// Incomplete lists the report sections not gathered within SupportReportTimeout
+	Incomplete []string `json:"incomplete,omitempty"`
+}
+
+type SupportReportComponent struct {
+	Name              string   `json:"name"`
+	Images            []string `json:"images,omitempty"`
+	Replicas          int32    `json:"replicas"`
+	ReadyReplicas     int32    `json:"readyReplicas"`
+	AvailableReplicas int32    `json:"availableReplicas"`
+}
+
+type SupportReportDatabase struct {
+	Name     string `json:"name"`
+	External bool   `json:"external"`
+	Engine   string `json:"engine,omitempty"`
+}
+
+type SupportReportVolume struct {
+	Name         string `json:"name"`
+	StorageClass string `json:"storageClass,omitempty"`
+	Requested    string `json:"requested,omitempty"`
+	Capacity     string `json:"capacity,omitempty"`
+	Phase        string `json:"phase,omitempty"`
+}
+
+type SupportReportMonitoring struct {
+	Enabled           bool `json:"enabled"`
+	PrometheusRules   bool `json:"prometheusRules"`
+	DatabaseExporters bool `json:"databaseExporters"`
+}
+
+type SupportReportEvent struct {
+	Reason        string `json:"reason"`
+	Message       string `json:"message"`
+	Count         int32  `json:"count"`
+	LastTimestamp string `json:"lastTimestamp,omitempty"`
+}
+
+type SupportReportReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewSupportReportReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *SupportReportReconciler {
+	return &SupportReportReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+// Reconcile generates the support report into a ConfigMap when the
+// APIManager is annotated with SupportReportAnnotation set to "true".
+// The annotation is removed once processed, so the report is only generated once per annotation.
+func (r *SupportReportReconciler) Reconcile() (reconcile.Result, error) {
+	val, ok := r.apiManager.Annotations[SupportReportAnnotation]
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	if val != "true" {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "SupportReportError", "unexpected '%s' annotation value '%s'", SupportReportAnnotation, val)
+		return r.removeReportAnnotation()
+	}
+
+	report := r.Report()
+
+	out, err := yaml.Marshal(report)
+	if err != nil {
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeWarning, "SupportReportError", "support report generation failed: %s", err.Error())
+		r.Logger().Error(err, "support report generation failed")
+		return r.removeReportAnnotation()
+	}
+
+	desired := &v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: SupportReportConfigMapName(r.apiManager.Name),
+			Labels: map[string]string{
+				"app": *r.apiManager.Spec.AppLabel,
+			},
+			Annotations: map[string]string{
+				SupportReportGeneratedAtAnnotation: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string]string{
+			SupportReportConfigMapReportKey: string(out),
+		},
+	}
+
+	err = r.ReconcileConfigMap(desired, supportReportConfigMapMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := fmt.Sprintf("Support report generated into the '%s' ConfigMap", desired.Name)
+	if len(report.Incomplete) > 0 {
+		msg = fmt.Sprintf("%s. Incomplete sections: %s", msg, strings.Join(report.Incomplete, ", "))
+	}
+	r.EventRecorder().Event(r.apiManager, v1.EventTypeNormal, "SupportReportGenerated", msg)
+	r.Logger().Info(msg)
+
+	return r.removeReportAnnotation()
+}
+
+// Report gathers the support report. Sections failing to be gathered are
+// reported in the Errors field instead of failing the whole report.
+func (r *SupportReportReconciler) Report() *SupportReport {
+	ctx, cancel := context.WithTimeout(r.Context(), SupportReportTimeout)
+	defer cancel()
+
+	report := &SupportReport{
+		OperatorVersion:   version.Version,
+		ThreescaleRelease: product.ThreescaleRelease,
+		Monitoring: SupportReportMonitoring{
+			Enabled:           r.apiManager.IsMonitoringEnabled(),
+			PrometheusRules:   r.apiManager.IsPrometheusRulesEnabled(),
+			DatabaseExporters: r.apiManager.IsDatabaseExportersEnabled(),
+		},
+	}
+
+	sections := []struct {
+		name   string
+		gather func(context.Context, *SupportReport) error
+	}{
+		{"components", r.reportComponents},
+		{"databases", r.reportDatabases},
+		{"storage", r.reportStorage},
+		{"recentErrors", r.reportRecentErrors},
+		{"options", r.reportOptions},
+	}
+
+	for _, section := range sections {
+		if ctx.Err() != nil {
+			report.Incomplete = append(report.Incomplete, section.name)
+			continue
+		}
+
+		if err := section.gather(ctx, report); err != nil {
+			if ctx.Err() != nil {
+				report.Incomplete = append(report.Incomplete, section.name)
+				continue
+			}
+			if report.Errors == nil {
+				report.Errors = map[string]string{}
+			}
+			report.Errors[section.name] = err.Error()
+		}
+	}
+
+	return report
+}
+
+func (r *SupportReportReconciler) reportComponents(ctx context.Context, report *SupportReport) error {
+	dcList := &appsv1.DeploymentConfigList{}
+	err := r.APIClientReader().List(ctx, dcList, client.InNamespace(r.apiManager.Namespace))
+	if err != nil {
+		return err
+	}
+
+	for idx := range dcList.Items {
+		dc := &dcList.Items[idx]
+		if !metav1.IsControlledBy(dc, r.apiManager) {
+			continue
+		}
+
+		reportComponent := SupportReportComponent{
+			Name:              dc.Name,
+			Replicas:          dc.Spec.Replicas,
+			ReadyReplicas:     dc.Status.ReadyReplicas,
+			AvailableReplicas: dc.Status.AvailableReplicas,
+		}
+		if dc.Spec.Template != nil {
+			for _, container := range dc.Spec.Template.Spec.Containers {
+				reportComponent.Images = append(reportComponent.Images, container.Image)
+			}
+		}
+		report.Components = append(report.Components, reportComponent)
+	}
+
+	sort.Slice(report.Components, func(i, j int) bool {
+		return report.Components[i].Name < report.Components[j].Name
+	})
+
+	return nil
+}
+
+func (r *SupportReportReconciler) reportDatabases(ctx context.Context, report *SupportReport) error {
+	// No database is deployed nor used by the gateways
+	if r.apiManager.IsGatewayOnly() {
+		return nil
+	}
+
+	secretSource := helper.NewSecretSource(r.Client(), r.apiManager.Namespace)
+
+	systemDatabase := SupportReportDatabase{
+		Name:     "system-database",
+		External: r.apiManager.IsExternal(appsv1alpha1.SystemDatabase),
+		Engine:   "mysql",
+	}
+	if r.apiManager.IsSystemPostgreSQLEnabled() {
+		systemDatabase.Engine = "postgresql"
+	}
+	if systemDatabase.External {
+		databaseURL, err := secretSource.FieldValue(component.SystemSecretSystemDatabaseSecretName, component.SystemSecretSystemDatabaseURLFieldName, "")
+		if err != nil {
+			return err
+		}
+		systemDatabase.Engine = supportReportDatabaseEngine(databaseURL)
+	}
+
+	zyncDatabase := SupportReportDatabase{
+		Name:     "zync-database",
+		External: r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase),
+		Engine:   "postgresql",
+	}
+	if zyncDatabase.External {
+		databaseURL, err := secretSource.FieldValue(component.ZyncSecretName, component.ZyncSecretDatabaseURLFieldName, "")
+		if err != nil {
+			return err
+		}
+		zyncDatabase.Engine = supportReportDatabaseEngine(databaseURL)
+	}
+
+	report.Databases = []SupportReportDatabase{
+		{Name: "backend-redis", External: r.apiManager.IsExternal(appsv1alpha1.BackendRedis), Engine: "redis"},
+		systemDatabase,
+		{Name: "system-redis", External: r.apiManager.IsExternal(appsv1alpha1.SystemRedis), Engine: "redis"},
+		zyncDatabase,
+	}
+
+	return nil
+}
+
+// supportReportDatabaseEngine returns the database engine from the URL scheme
+// without exposing any other part of the URL
+func supportReportDatabaseEngine(databaseURL string) string {
+	parsed, err := url.Parse(databaseURL)
+	if err != nil || parsed.Scheme == "" {
+		return ""
+	}
+
+	switch parsed.Scheme {
+	case "mysql2":
+		return "mysql"
+	case "postgres":
+		return "postgresql"
+	default:
+		return parsed.Scheme
+	}
+}
+
+func (r *SupportReportReconciler) reportStorage(ctx context.Context, report *SupportReport) error {
+	pvcList := &v1.PersistentVolumeClaimList{}
+	err := r.APIClientReader().List(ctx, pvcList, client.InNamespace(r.apiManager.Namespace))
+	if err != nil {
+		return err
+	}
+
+	for idx := range pvcList.Items {
+		pvc := &pvcList.Items[idx]
+		if !metav1.IsControlledBy(pvc, r.apiManager) {
+			continue
+		}
+
+		volume := SupportReportVolume{
+			Name:  pvc.Name,
+			Phase: string(pvc.Status.Phase),
+		}
+		if pvc.Spec.StorageClassName != nil {
+			volume.StorageClass = *pvc.Spec.StorageClassName
+		}
+		if requested, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+			volume.Requested = requested.String()
+		}
+		if capacity, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+			volume.Capacity = capacity.String()
+		}
+		report.Storage = append(report.Storage, volume)
+	}
+
+	sort.Slice(report.Storage, func(i, j int) bool {
+		return report.Storage[i].Name < report.Storage[j].Name
+	})
+
+	return nil
+}
+
+func (r *SupportReportReconciler) reportRecentErrors(ctx context.Context, report *SupportReport) error {
+	eventList := &v1.EventList{}
+	err := r.APIClientReader().List(ctx, eventList, client.InNamespace(r.apiManager.Namespace))
+	if err != nil {
+		return err
+	}
+
+	events := []v1.Event{}
+	for _, event := range eventList.Items {
+		if event.Type == v1.EventTypeWarning && event.InvolvedObject.UID == r.apiManager.UID {
+			events = append(events, event)
+		}
+	}
+
+	// Newest first
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].LastTimestamp.Equal(&events[j].LastTimestamp) {
+			return events[i].Name < events[j].Name
+		}
+		return events[j].LastTimestamp.Before(&events[i].LastTimestamp)
+	})
+
+	if len(events) > SupportReportMaxEvents {
+		events = events[:SupportReportMaxEvents]
+	}
+
+	for _, event := range events {
+		reportEvent := SupportReportEvent{
+			Reason:  event.Reason,
+			Message: event.Message,
+			Count:   event.Count,
+		}
+		if !event.LastTimestamp.IsZero() {
+			reportEvent.LastTimestamp = event.LastTimestamp.UTC().Format(time.RFC3339)
+		}
+		report.RecentErrors = append(report.RecentErrors, reportEvent)
+	}
+
+	return nil
+}
+
+func (r *SupportReportReconciler) reportOptions(ctx context.Context, report *SupportReport) error {
+	providers := map[string]func() (interface{}, error){
+		"apicast": func() (interface{}, error) {
+			return NewApicastOptionsProvider(r.apiManager, r.Client()).GetApicastOptions()
+		},
+	}
+
+	if !r.apiManager.IsGatewayOnly() {
+		r.addControlPlaneOptionsProviders(providers)
+	}
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report.Options = map[string]interface{}{}
+	errs := []string{}
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		opts, err := providers[name]()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", name, err.Error()))
+			continue
+		}
+
+		redacted, err := redactedOptions(opts)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", name, err.Error()))
+			continue
+		}
+		report.Options[name] = redacted
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// addControlPlaneOptionsProviders adds the options providers of the control plane
+// components, only deployed when the APIManager is not gateway only
+func (r *SupportReportReconciler) addControlPlaneOptionsProviders(providers map[string]func() (interface{}, error)) {
+	ns := r.apiManager.Namespace
+	providers["backend"] = func() (interface{}, error) {
+		return NewOperatorBackendOptionsProvider(r.apiManager, ns, r.Client()).GetBackendOptions()
+	}
+	providers["memcached"] = func() (interface{}, error) {
+		return NewMemcachedOptionsProvider(r.apiManager).GetMemcachedOptions()
+	}
+	providers["system"] = func() (interface{}, error) {
+		return NewSystemOptionsProvider(r.apiManager, ns, r.Client()).GetSystemOptions()
+	}
+	providers["zync"] = func() (interface{}, error) {
+		return NewZyncOptionsProvider(r.apiManager, ns, r.Client()).GetZyncOptions()
+	}
+
+	if !r.apiManager.IsExternal(appsv1alpha1.BackendRedis) || !r.apiManager.IsExternal(appsv1alpha1.SystemRedis) {
+		providers["redis"] = func() (interface{}, error) {
+			return NewRedisOptionsProvider(r.apiManager, ns, r.Client()).GetRedisOptions()
+		}
+	}
+	if r.apiManager.IsSystemMysqlEnabled() {
+		providers["system-mysql"] = func() (interface{}, error) {
+			return NewSystemMysqlOptionsProvider(r.apiManager, ns, r.Client()).GetMysqlOptions()
+		}
+	}
+	if r.apiManager.IsSystemPostgreSQLEnabled() {
+		providers["system-postgresql"] = func() (interface{}, error) {
+			return NewSystemPostgresqlOptionsProvider(r.apiManager, ns, r.Client()).GetSystemPostgreSQLOptions()
+		}
+	}
+}
+
+// redactedOptions returns the generic representation of the given options
+// with the secret material redacted
+func redactedOptions(opts interface{}) (interface{}, error) {
+	raw, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(raw, &generic)
+	if err != nil {
+		return nil, err
+	}
+
+	return redactValue("", generic), nil
+}
+
+func redactValue(key string, value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for k, v := range typed {
+			typed[k] = redactValue(k, v)
+		}
+		return typed
+	case []interface{}:
+		for idx, v := range typed {
+			typed[idx] = redactValue(key, v)
+		}
+		return typed
+	case string:
+		if typed == "" {
+			return typed
+		}
+		if supportReportSensitiveKeyRegexp.MatchString(key) {
+			return supportReportRedacted
+		}
+		return redactURLCredentials(typed)
+	default:
+		return value
+	}
+}
+
+// redactURLCredentials redacts the user info of the URLs found in the
+// given comma separated list. Sentinel hosts are given in this format.
+func redactURLCredentials(value string) string {
+	if !strings.Contains(value, "://") {
+		return value
+	}
+
+	items := strings.Split(value, ",")
+	for idx, item := range items {
+		trimmed := strings.TrimSpace(item)
+		parsed, err := url.Parse(trimmed)
+		if err != nil {
+			if strings.Contains(trimmed, "@") {
+				items[idx] = supportReportRedacted
+			}
+			continue
+		}
+		if parsed.User == nil {
+			continue
+		}
+
+		if _, hasPassword := parsed.User.Password(); hasPassword {
+			parsed.User = url.UserPassword(parsed.User.Username(), supportReportRedacted)
+		} else {
+			parsed.User = url.User(supportReportRedacted)
+		}
+		items[idx] = parsed.String()
+	}
+
+	return strings.Join(items, ",")
+}
+
+func (r *SupportReportReconciler) removeReportAnnotation() (reconcile.Result, error) {
+	delete(r.apiManager.Annotations, SupportReportAnnotation)
+	err := r.UpdateResource(r.apiManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{Requeue: true}, nil
+}
+
+func supportReportConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+
+	// The generation time annotation is merged by the APIManager mutator
+	update := reconcilers.ConfigMapReconcileField(desired, existing, SupportReportConfigMapReportKey)
+
+	return update, nil
+}
@@ -0,0 +1,314 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+)
+
+const (
+	AccessTokensReasonConfigured       = "Configured"
+	AccessTokensReasonWaitingForSystem = "WaitingForSystem"
+	AccessTokensReasonAdminAPIError    = "AdminAPIError"
+
+	accessTokensRequeueDelay = 30 * time.Second
+)
+
+// AccessTokenAPIClient manages the access tokens of the default tenant admin user
+type AccessTokenAPIClient interface {
+	ReadAccessToken(id int64) (*controllerhelper.AccessToken, error)
+	CreateAccessToken(name, permission string, scopes []string) (*controllerhelper.AccessToken, error)
+	DeleteAccessToken(id int64) error
+}
+
+// AccessTokensReconciler creates the access tokens of the default tenant admin
+// user through the admin API, once system has seeded the default tenant, and
+// stores the token values in secrets owned by the APIManager.
+// The token value is only returned on creation, so tokens whose secret is lost
+// are replaced. Token values are never logged nor reported.
+type AccessTokensReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	apiClientFactory func(adminURL, token string) (AccessTokenAPIClient, error)
+}
+
+func NewAccessTokensReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *AccessTokensReconciler {
+	return &AccessTokensReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		apiClientFactory: func(adminURL, token string) (AccessTokenAPIClient, error) {
+			return controllerhelper.NewAccessTokenClient(adminURL, token, controllerhelper.PortaHTTPClient())
+		},
+	}
+}
+
+func (r *AccessTokensReconciler) Reconcile() (reconcile.Result, error) {
+	// The standby database is replicated from the active cluster
+	if r.apiManager.IsStandby() {
+		return reconcile.Result{}, nil
+	}
+
+	specs := r.apiManager.SystemAccessTokens()
+	if len(specs) == 0 && len(r.apiManager.Status.AccessTokens) == 0 {
+		if r.apiManager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerAccessTokensReadyConditionType) == nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, r.writeStatus(nil, "", "", "")
+	}
+
+	seeded, err := systemSeeded(r.BaseAPIManagerLogicReconciler)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !seeded {
+		return r.writeNotReady(r.apiManager.Status.AccessTokens, AccessTokensReasonWaitingForSystem, "waiting for system-app to seed the default tenant")
+	}
+
+	adminURL, token, err := defaultTenantAdminAPI(r.BaseAPIManagerLogicReconciler)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	apiClient, err := r.apiClientFactory(adminURL, token)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	status, err := r.reconcileAccessTokens(apiClient)
+	if err != nil {
+		return r.writeNotReady(status, AccessTokensReasonAdminAPIError, err.Error())
+	}
+
+	if len(specs) == 0 {
+		return reconcile.Result{}, r.writeStatus(nil, "", "", "")
+	}
+
+	msg := fmt.Sprintf("%d access tokens configured", len(status))
+	return reconcile.Result{}, r.writeStatus(status, v1.ConditionTrue, AccessTokensReasonConfigured, msg)
+}
+
+// reconcileAccessTokens returns the status of the access tokens, including
+// the changes done before an error
+func (r *AccessTokensReconciler) reconcileAccessTokens(apiClient AccessTokenAPIClient) ([]appsv1alpha1.AccessTokenStatus, error) {
+	current := map[string]appsv1alpha1.AccessTokenStatus{}
+	for _, status := range r.apiManager.Status.AccessTokens {
+		current[status.Name] = status
+	}
+
+	desired := map[string]bool{}
+	for _, spec := range r.apiManager.SystemAccessTokens() {
+		desired[spec.Name] = true
+	}
+
+	for name, status := range current {
+		if desired[name] {
+			continue
+		}
+		if err := r.revokeAccessToken(apiClient, status, true); err != nil {
+			return accessTokensStatus(current), err
+		}
+		delete(current, name)
+	}
+
+	for _, spec := range r.apiManager.SystemAccessTokens() {
+		status, exists := current[spec.Name]
+		if exists {
+			upToDate, err := r.accessTokenUpToDate(apiClient, status, spec)
+			if err != nil {
+				return accessTokensStatus(current), err
+			}
+			if upToDate {
+				continue
+			}
+
+			// The token value cannot be read back, the token is replaced
+			if err := r.revokeAccessToken(apiClient, status, status.SecretName != spec.SecretName); err != nil {
+				return accessTokensStatus(current), err
+			}
+			delete(current, spec.Name)
+		}
+
+		created, err := r.createAccessToken(apiClient, spec)
+		if err != nil {
+			return accessTokensStatus(current), err
+		}
+		current[spec.Name] = *created
+	}
+
+	return accessTokensStatus(current), nil
+}
+
+func (r *AccessTokensReconciler) accessTokenUpToDate(apiClient AccessTokenAPIClient, status appsv1alpha1.AccessTokenStatus, spec appsv1alpha1.SystemAccessTokenSpec) (bool, error) {
+	if status.SecretName != spec.SecretName {
+		return false, nil
+	}
+
+	secret, err := r.accessTokenSecret(spec.SecretName)
+	if err != nil {
+		return false, err
+	}
+	if secret == nil || len(secret.Data[component.SystemAccessTokenSecretKey]) == 0 {
+		return false, nil
+	}
+
+	token, err := apiClient.ReadAccessToken(status.ID)
+	if err != nil {
+		return false, fmt.Errorf("reading access token %s: %w", spec.Name, err)
+	}
+
+	return token != nil &&
+		token.Permission == accessTokenPermission(spec) &&
+		reflect.DeepEqual(sortedStrings(token.Scopes), sortedStrings(spec.Scopes)), nil
+}
+
+func (r *AccessTokensReconciler) createAccessToken(apiClient AccessTokenAPIClient, spec appsv1alpha1.SystemAccessTokenSpec) (*appsv1alpha1.AccessTokenStatus, error) {
+	// Fail before creating a token that could not be stored
+	if _, err := r.accessTokenSecret(spec.SecretName); err != nil {
+		return nil, err
+	}
+
+	token, err := apiClient.CreateAccessToken(spec.Name, accessTokenPermission(spec), spec.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("creating access token %s: %w", spec.Name, err)
+	}
+
+	if err := r.writeAccessTokenSecret(spec.SecretName, token.Value); err != nil {
+		// The token value would be lost
+		if deleteErr := apiClient.DeleteAccessToken(token.ID); deleteErr != nil {
+			r.Logger().Error(deleteErr, "revoking unstored access token", "name", spec.Name, "id", token.ID)
+		}
+		return nil, err
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "AccessTokenCreated", "access token %s created with ID %d", spec.Name, token.ID)
+	return &appsv1alpha1.AccessTokenStatus{Name: spec.Name, ID: token.ID, SecretName: spec.SecretName}, nil
+}
+
+// revokeAccessToken deletes the access token and, when deleteSecret is set, the secret holding it
+func (r *AccessTokensReconciler) revokeAccessToken(apiClient AccessTokenAPIClient, status appsv1alpha1.AccessTokenStatus, deleteSecret bool) error {
+	if err := apiClient.DeleteAccessToken(status.ID); err != nil {
+		return fmt.Errorf("revoking access token %s: %w", status.Name, err)
+	}
+
+	// Secrets replaced by others are kept
+	if deleteSecret {
+		secret := &v1.Secret{}
+		err := r.GetResource(types.NamespacedName{Name: status.SecretName, Namespace: r.apiManager.Namespace}, secret)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil && metav1.IsControlledBy(secret, r.apiManager) {
+			if err := r.DeleteResource(secret); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "AccessTokenRevoked", "access token %s with ID %d revoked", status.Name, status.ID)
+	return nil
+}
+
+// accessTokenSecret returns the secret, or nil when it does not exist.
+// Secrets not owned by the APIManager are never read nor overwritten
+func (r *AccessTokensReconciler) accessTokenSecret(name string) (*v1.Secret, error) {
+	secret := &v1.Secret{}
+	err := r.GetResource(types.NamespacedName{Name: name, Namespace: r.apiManager.Namespace}, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !metav1.IsControlledBy(secret, r.apiManager) {
+		return nil, fmt.Errorf("secret %s already exists and is not owned by the APIManager", name)
+	}
+
+	return secret, nil
+}
+
+func (r *AccessTokensReconciler) writeAccessTokenSecret(name, value string) error {
+	secret, err := r.accessTokenSecret(name)
+	if err != nil {
+		return err
+	}
+
+	if secret == nil {
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: r.apiManager.Namespace,
+				Labels:    map[string]string{"app": *r.apiManager.Spec.AppLabel, "threescale_component": "system"},
+			},
+			Data: map[string][]byte{component.SystemAccessTokenSecretKey: []byte(value)},
+			Type: v1.SecretTypeOpaque,
+		}
+		if err := r.SetOwnerReference(r.apiManager, secret); err != nil {
+			return err
+		}
+		return r.CreateResource(secret)
+	}
+
+	secret.Data = map[string][]byte{component.SystemAccessTokenSecretKey: []byte(value)}
+	return r.UpdateResource(secret)
+}
+
+func (r *AccessTokensReconciler) writeNotReady(status []appsv1alpha1.AccessTokenStatus, reason, msg string) (reconcile.Result, error) {
+	r.Logger().Info("access tokens not ready", "reason", reason, "message", msg)
+	err := r.writeStatus(status, v1.ConditionFalse, reason, msg)
+	return reconcile.Result{RequeueAfter: accessTokensRequeueDelay}, err
+}
+
+// writeStatus updates the access tokens status. The condition is removed when the status is empty
+func (r *AccessTokensReconciler) writeStatus(status []appsv1alpha1.AccessTokenStatus, conditionStatus v1.ConditionStatus, reason, msg string) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.AccessTokens = status
+		if conditionStatus == "" {
+			r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerAccessTokensReadyConditionType)
+			return nil
+		}
+		r.apiManager.Status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerAccessTokensReadyConditionType,
+			Status:  conditionStatus,
+			Reason:  common.ConditionReason(reason),
+			Message: msg,
+		})
+		return nil
+	})
+	return err
+}
+
+func accessTokensStatus(current map[string]appsv1alpha1.AccessTokenStatus) []appsv1alpha1.AccessTokenStatus {
+	if len(current) == 0 {
+		return nil
+	}
+
+	status := make([]appsv1alpha1.AccessTokenStatus, 0, len(current))
+	for _, accessToken := range current {
+		status = append(status, accessToken)
+	}
+	sort.Slice(status, func(i, j int) bool { return status[i].Name < status[j].Name })
+	return status
+}
+
+func accessTokenPermission(spec appsv1alpha1.SystemAccessTokenSpec) string {
+	if spec.Permission == nil {
+		return component.SystemAccessTokenPermissionReadOnly
+	}
+	return *spec.Permission
+}
+
+func sortedStrings(in []string) []string {
+	out := append([]string{}, in...)
+	sort.Strings(out)
+	return out
+}
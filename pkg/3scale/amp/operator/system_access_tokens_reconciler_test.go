@@ -0,0 +1,213 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeAccessTokenAPIClient keeps the access tokens in memory
+type fakeAccessTokenAPIClient struct {
+	tokens map[int64]*controllerhelper.AccessToken
+	nextID int64
+}
+
+func newFakeAccessTokenAPIClient() *fakeAccessTokenAPIClient {
+	return &fakeAccessTokenAPIClient{tokens: map[int64]*controllerhelper.AccessToken{}, nextID: 1}
+}
+
+func (f *fakeAccessTokenAPIClient) ReadAccessToken(id int64) (*controllerhelper.AccessToken, error) {
+	token, ok := f.tokens[id]
+	if !ok {
+		return nil, nil
+	}
+	res := *token
+	res.Value = ""
+	return &res, nil
+}
+
+func (f *fakeAccessTokenAPIClient) CreateAccessToken(name, permission string, scopes []string) (*controllerhelper.AccessToken, error) {
+	token := &controllerhelper.AccessToken{
+		ID:         f.nextID,
+		Name:       name,
+		Scopes:     scopes,
+		Permission: permission,
+		Value:      fmt.Sprintf("value-%d", f.nextID),
+	}
+	f.nextID++
+	f.tokens[token.ID] = token
+	res := *token
+	return &res, nil
+}
+
+func (f *fakeAccessTokenAPIClient) DeleteAccessToken(id int64) error {
+	delete(f.tokens, id)
+	return nil
+}
+
+func TestAccessTokensReconciler(t *testing.T) {
+	var (
+		log        = logf.Log.WithName("operator_test")
+		secretName = "monitoring-token"
+	)
+
+	setup := func(subT *testing.T, apiClient *fakeAccessTokenAPIClient, readyReplicas int32) (client.Client, func() *AccessTokensReconciler) {
+		apimanager := basicApimanager()
+		apimanager.Spec.System.AccessTokens = []appsv1alpha1.SystemAccessTokenSpec{
+			{Name: "monitoring", Scopes: []string{"stats"}, SecretName: secretName},
+		}
+
+		systemApp := &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: component.SystemAppDeploymentName, Namespace: namespace},
+			Status:     appsv1.DeploymentConfigStatus{Replicas: 1, ReadyReplicas: readyReplicas},
+		}
+		seedSecret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: component.SystemSecretSystemSeedSecretName, Namespace: namespace},
+			Data:       map[string][]byte{component.SystemSecretSystemSeedAdminAccessTokenFieldName: []byte("token")},
+		}
+
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		cl := fake.NewFakeClient(apimanager, systemApp, seedSecret)
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, cl, log, fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(10000))
+
+		newReconciler := func() *AccessTokensReconciler {
+			existing := &appsv1alpha1.APIManager{}
+			if err := cl.Get(context.TODO(), types.NamespacedName{Name: apimanagerName, Namespace: namespace}, existing); err != nil {
+				subT.Fatal(err)
+			}
+			reconciler := NewAccessTokensReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, existing))
+			reconciler.apiClientFactory = func(adminURL, token string) (AccessTokenAPIClient, error) {
+				if expected := "https://someTenant-admin.test.3scale.net"; adminURL != expected {
+					subT.Errorf("expected admin URL '%s', got '%s'", expected, adminURL)
+				}
+				return apiClient, nil
+			}
+			return reconciler
+		}
+
+		return cl, newReconciler
+	}
+
+	reconcile := func(subT *testing.T, newReconciler func() *AccessTokensReconciler) *appsv1alpha1.APIManager {
+		reconciler := newReconciler()
+		if _, err := reconciler.Reconcile(); err != nil {
+			subT.Fatal(err)
+		}
+		return newReconciler().apiManager
+	}
+
+	getSecret := func(subT *testing.T, cl client.Client) *v1.Secret {
+		secret := &v1.Secret{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: secretName, Namespace: namespace}, secret)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			subT.Fatal(err)
+		}
+		return secret
+	}
+
+	t.Run("LifeCycle", func(subT *testing.T) {
+		apiClient := newFakeAccessTokenAPIClient()
+		cl, newReconciler := setup(subT, apiClient, 1)
+
+		apimanager := reconcile(subT, newReconciler)
+		status := apimanager.Status.AccessTokens
+		if len(status) != 1 || status[0].Name != "monitoring" || status[0].SecretName != secretName {
+			subT.Fatalf("unexpected access tokens status: %v", status)
+		}
+		if !apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerAccessTokensReadyConditionType) {
+			subT.Error("expected AccessTokensReady condition")
+		}
+		token := apiClient.tokens[status[0].ID]
+		if token == nil || token.Permission != component.SystemAccessTokenPermissionReadOnly || len(token.Scopes) != 1 || token.Scopes[0] != "stats" {
+			subT.Fatalf("unexpected access token: %v", token)
+		}
+		secret := getSecret(subT, cl)
+		if secret == nil || string(secret.Data[component.SystemAccessTokenSecretKey]) != token.Value {
+			subT.Fatal("expected the access token value to be stored in the secret")
+		}
+		if !metav1.IsControlledBy(secret, apimanager) {
+			subT.Error("expected the secret to be owned by the APIManager")
+		}
+
+		// Up to date tokens are kept
+		reconcile(subT, newReconciler)
+		if len(apiClient.tokens) != 1 || apiClient.tokens[status[0].ID] == nil {
+			subT.Error("expected the access token to be kept")
+		}
+
+		// Deleting the secret replaces the token, as the value cannot be read back
+		if err := cl.Delete(context.TODO(), secret); err != nil {
+			subT.Fatal(err)
+		}
+		apimanager = reconcile(subT, newReconciler)
+		if len(apiClient.tokens) != 1 || apiClient.tokens[status[0].ID] != nil {
+			subT.Fatal("expected the access token to be replaced")
+		}
+		newID := apimanager.Status.AccessTokens[0].ID
+		secret = getSecret(subT, cl)
+		if secret == nil || string(secret.Data[component.SystemAccessTokenSecretKey]) != apiClient.tokens[newID].Value {
+			subT.Fatal("expected the new access token value to be stored in the secret")
+		}
+
+		// Removing the access token revokes it and deletes the secret
+		apimanager.Spec.System.AccessTokens = nil
+		if err := cl.Update(context.TODO(), apimanager); err != nil {
+			subT.Fatal(err)
+		}
+		apimanager = reconcile(subT, newReconciler)
+		if len(apiClient.tokens) != 0 {
+			subT.Error("expected the access token to be revoked")
+		}
+		if getSecret(subT, cl) != nil {
+			subT.Error("expected the access token secret to be deleted")
+		}
+		if apimanager.Status.AccessTokens != nil || apimanager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerAccessTokensReadyConditionType) != nil {
+			subT.Error("expected access tokens status to be cleared")
+		}
+	})
+
+	t.Run("WaitingForSystem", func(subT *testing.T) {
+		apiClient := newFakeAccessTokenAPIClient()
+		_, newReconciler := setup(subT, apiClient, 0)
+
+		res, err := newReconciler().Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected delayed requeue")
+		}
+		if len(apiClient.tokens) != 0 {
+			subT.Error("expected no access token to be created")
+		}
+		condition := newReconciler().apiManager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerAccessTokensReadyConditionType)
+		if condition == nil || condition.Status != v1.ConditionFalse || string(condition.Reason) != AccessTokensReasonWaitingForSystem {
+			subT.Errorf("unexpected condition: %v", condition)
+		}
+	})
+}
@@ -0,0 +1,281 @@
+package operator
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+const (
+	AdminSSOClientIDSecretKey     = "clientID"
+	AdminSSOClientSecretSecretKey = "clientSecret"
+
+	AdminSSOReasonConfigured         = "Configured"
+	AdminSSOReasonInvalidCredentials = "InvalidCredentialsSecret"
+	AdminSSOReasonWaitingForSystem   = "WaitingForSystem"
+	AdminSSOReasonAdminAPIError      = "AdminAPIError"
+	AdminSSOReasonVerificationFailed = "VerificationFailed"
+
+	adminSSORequeueDelay = 30 * time.Second
+)
+
+// AdminSSOAPIClient manages the admin portal authentication providers of the default tenant
+type AdminSSOAPIClient interface {
+	ListAuthenticationProviders() ([]controllerhelper.AuthenticationProvider, error)
+	ReadAuthenticationProvider(id int64) (*controllerhelper.AuthenticationProvider, error)
+	CreateAuthenticationProvider(params url.Values) (*controllerhelper.AuthenticationProvider, error)
+	UpdateAuthenticationProvider(id int64, params url.Values) (*controllerhelper.AuthenticationProvider, error)
+	DeleteAuthenticationProvider(id int64) error
+}
+
+// AdminSSOReconciler configures the admin portal single sign-on of the default
+// tenant through the admin API, once system has seeded the default tenant.
+// Authentication providers already configured for the same issuer are adopted,
+// and only the providers created by the operator are removed on disable.
+type AdminSSOReconciler struct {
+	*BaseAPIManagerLogicReconciler
+	apiClientFactory func(adminURL, token string) (AdminSSOAPIClient, error)
+}
+
+func NewAdminSSOReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *AdminSSOReconciler {
+	return &AdminSSOReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+		apiClientFactory: func(adminURL, token string) (AdminSSOAPIClient, error) {
+			return controllerhelper.NewAuthenticationProviderClient(adminURL, token, controllerhelper.PortaHTTPClient())
+		},
+	}
+}
+
+func (r *AdminSSOReconciler) Reconcile() (reconcile.Result, error) {
+	// The standby database is replicated from the active cluster
+	if r.apiManager.IsStandby() {
+		return reconcile.Result{}, nil
+	}
+
+	if !r.apiManager.IsSystemAdminSSOEnabled() {
+		return reconcile.Result{}, r.reconcileDisabled()
+	}
+
+	clientID, clientSecret, err := r.clientCredentials()
+	if err != nil {
+		return r.writeNotReady(r.apiManager.Status.AdminSSO, AdminSSOReasonInvalidCredentials, err.Error())
+	}
+
+	seeded, err := systemSeeded(r.BaseAPIManagerLogicReconciler)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !seeded {
+		return r.writeNotReady(r.apiManager.Status.AdminSSO, AdminSSOReasonWaitingForSystem, "waiting for system-app to seed the default tenant")
+	}
+
+	apiClient, err := r.apiClient()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	status, err := r.reconcileProvider(apiClient, clientID, clientSecret)
+	if err != nil {
+		return r.writeNotReady(status, AdminSSOReasonAdminAPIError, err.Error())
+	}
+
+	// Verify the provider as stored by system
+	provider, err := apiClient.ReadAuthenticationProvider(status.ProviderID)
+	if err != nil {
+		return r.writeNotReady(status, AdminSSOReasonAdminAPIError, err.Error())
+	}
+	if provider == nil || !adminSSOProviderUpToDate(provider, r.apiManager.Spec.System.AdminSSO, clientID) {
+		return r.writeNotReady(status, AdminSSOReasonVerificationFailed, fmt.Sprintf("authentication provider %d does not match the adminSSO spec", status.ProviderID))
+	}
+
+	msg := fmt.Sprintf("authentication provider %d configured for %s", status.ProviderID, provider.Site)
+	return reconcile.Result{}, r.writeStatus(status, v1.ConditionTrue, AdminSSOReasonConfigured, msg)
+}
+
+func (r *AdminSSOReconciler) reconcileProvider(apiClient AdminSSOAPIClient, clientID, clientSecret string) (*appsv1alpha1.AdminSSOStatus, error) {
+	spec := r.apiManager.Spec.System.AdminSSO
+	status := r.apiManager.Status.AdminSSO.DeepCopy()
+	credentialsHash := adminSSOCredentialsHash(clientID, clientSecret)
+	params := adminSSOProviderParams(spec, clientID, clientSecret)
+
+	var provider *controllerhelper.AuthenticationProvider
+	if status != nil {
+		var err error
+		provider, err = apiClient.ReadAuthenticationProvider(status.ProviderID)
+		if err != nil {
+			return status, err
+		}
+	}
+
+	if provider == nil {
+		// Providers configured manually for the same issuer are adopted
+		providers, err := apiClient.ListAuthenticationProviders()
+		if err != nil {
+			return status, err
+		}
+		for idx := range providers {
+			if providers[idx].Kind == controllerhelper.AuthenticationProviderKindKeycloak && providers[idx].Site == spec.IssuerURL {
+				provider = &providers[idx]
+				status = &appsv1alpha1.AdminSSOStatus{ProviderID: provider.ID}
+				break
+			}
+		}
+	}
+
+	if provider == nil {
+		params.Set("kind", controllerhelper.AuthenticationProviderKindKeycloak)
+		created, err := apiClient.CreateAuthenticationProvider(params)
+		if err != nil {
+			return nil, err
+		}
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "AdminSSOProviderCreated", "authentication provider %d created for %s", created.ID, spec.IssuerURL)
+		return &appsv1alpha1.AdminSSOStatus{ProviderID: created.ID, Created: true, CredentialsHash: credentialsHash}, nil
+	}
+
+	// The client secret is not returned by the API, rotations are tracked by hash
+	if status.CredentialsHash != credentialsHash || !adminSSOProviderUpToDate(provider, spec, clientID) {
+		if _, err := apiClient.UpdateAuthenticationProvider(provider.ID, params); err != nil {
+			return status, err
+		}
+		status.CredentialsHash = credentialsHash
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "AdminSSOProviderUpdated", "authentication provider %d updated", provider.ID)
+	}
+
+	return status, nil
+}
+
+func (r *AdminSSOReconciler) reconcileDisabled() error {
+	status := r.apiManager.Status.AdminSSO
+	if status != nil && status.Created {
+		apiClient, err := r.apiClient()
+		if err != nil {
+			return err
+		}
+		if err := apiClient.DeleteAuthenticationProvider(status.ProviderID); err != nil {
+			return fmt.Errorf("removing admin SSO authentication provider %d: %w", status.ProviderID, err)
+		}
+		r.EventRecorder().Eventf(r.apiManager, v1.EventTypeNormal, "AdminSSOProviderRemoved", "authentication provider %d removed", status.ProviderID)
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.AdminSSO = nil
+		r.apiManager.Status.Conditions.RemoveCondition(appsv1alpha1.APIManagerAdminSSOReadyConditionType)
+		return nil
+	})
+	return err
+}
+
+func (r *AdminSSOReconciler) clientCredentials() (string, string, error) {
+	secretSource := helper.NewSecretSource(r.Client(), r.apiManager.Namespace)
+	secretName := r.apiManager.Spec.System.AdminSSO.ClientCredentialsSecretRef.Name
+
+	clientID, err := secretSource.RequiredFieldValueFromRequiredSecret(secretName, AdminSSOClientIDSecretKey)
+	if err != nil {
+		return "", "", err
+	}
+	clientSecret, err := secretSource.RequiredFieldValueFromRequiredSecret(secretName, AdminSSOClientSecretSecretKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return clientID, clientSecret, nil
+}
+
+// systemSeeded tells whether system-app is ready, which happens once the
+// pre-deployment hook has seeded the default tenant
+func systemSeeded(r *BaseAPIManagerLogicReconciler) (bool, error) {
+	dc := &appsv1.DeploymentConfig{}
+	err := r.GetResource(types.NamespacedName{Name: component.SystemAppDeploymentName, Namespace: r.apiManager.Namespace}, dc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return dc.Status.ReadyReplicas > 0, nil
+}
+
+func (r *AdminSSOReconciler) apiClient() (AdminSSOAPIClient, error) {
+	adminURL, token, err := defaultTenantAdminAPI(r.BaseAPIManagerLogicReconciler)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiClientFactory(adminURL, token)
+}
+
+// defaultTenantAdminAPI returns the admin portal URL of the default tenant
+// and the admin access token from the system seed secret
+func defaultTenantAdminAPI(r *BaseAPIManagerLogicReconciler) (string, string, error) {
+	secretSource := helper.NewSecretSource(r.Client(), r.apiManager.Namespace)
+	token, err := secretSource.RequiredFieldValueFromRequiredSecret(component.SystemSecretSystemSeedSecretName, component.SystemSecretSystemSeedAdminAccessTokenFieldName)
+	if err != nil {
+		return "", "", err
+	}
+
+	adminURL := fmt.Sprintf("https://%s-admin.%s", *r.apiManager.Spec.TenantName, helper.NormalizeDomain(r.apiManager.Spec.WildcardDomain))
+	return adminURL, token, nil
+}
+
+func (r *AdminSSOReconciler) writeNotReady(status *appsv1alpha1.AdminSSOStatus, reason, msg string) (reconcile.Result, error) {
+	r.Logger().Info("admin SSO not ready", "reason", reason, "message", msg)
+	err := r.writeStatus(status, v1.ConditionFalse, reason, msg)
+	return reconcile.Result{RequeueAfter: adminSSORequeueDelay}, err
+}
+
+func (r *AdminSSOReconciler) writeStatus(status *appsv1alpha1.AdminSSOStatus, conditionStatus v1.ConditionStatus, reason, msg string) error {
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.AdminSSO = status
+		r.apiManager.Status.Conditions.SetCondition(common.Condition{
+			Type:    appsv1alpha1.APIManagerAdminSSOReadyConditionType,
+			Status:  conditionStatus,
+			Reason:  common.ConditionReason(reason),
+			Message: msg,
+		})
+		return nil
+	})
+	return err
+}
+
+func adminSSOProviderParams(spec *appsv1alpha1.SystemAdminSSOSpec, clientID, clientSecret string) url.Values {
+	return url.Values{
+		"client_id":                      []string{clientID},
+		"client_secret":                  []string{clientSecret},
+		"site":                           []string{spec.IssuerURL},
+		"published":                      []string{strconv.FormatBool(adminSSOPublished(spec))},
+		"automatically_approve_accounts": []string{strconv.FormatBool(adminSSOAutoProvision(spec))},
+	}
+}
+
+func adminSSOProviderUpToDate(provider *controllerhelper.AuthenticationProvider, spec *appsv1alpha1.SystemAdminSSOSpec, clientID string) bool {
+	return provider.ClientID == clientID &&
+		provider.Site == spec.IssuerURL &&
+		provider.Published == adminSSOPublished(spec) &&
+		provider.AutomaticallyApproveAccounts == adminSSOAutoProvision(spec)
+}
+
+func adminSSOPublished(spec *appsv1alpha1.SystemAdminSSOSpec) bool {
+	return spec.Published == nil || *spec.Published
+}
+
+func adminSSOAutoProvision(spec *appsv1alpha1.SystemAdminSSOSpec) bool {
+	return spec.AutoProvision != nil && *spec.AutoProvision
+}
+
+func adminSSOCredentialsHash(clientID, clientSecret string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(clientID+"\n"+clientSecret)))
+}
@@ -0,0 +1,233 @@
+package operator
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeAdminSSOAPIClient keeps the authentication providers in memory
+type fakeAdminSSOAPIClient struct {
+	providers     map[int64]*controllerhelper.AuthenticationProvider
+	clientSecrets map[int64]string
+	nextID        int64
+}
+
+func newFakeAdminSSOAPIClient() *fakeAdminSSOAPIClient {
+	return &fakeAdminSSOAPIClient{
+		providers:     map[int64]*controllerhelper.AuthenticationProvider{},
+		clientSecrets: map[int64]string{},
+		nextID:        1,
+	}
+}
+
+func (f *fakeAdminSSOAPIClient) ListAuthenticationProviders() ([]controllerhelper.AuthenticationProvider, error) {
+	var res []controllerhelper.AuthenticationProvider
+	for _, provider := range f.providers {
+		res = append(res, *provider)
+	}
+	return res, nil
+}
+
+func (f *fakeAdminSSOAPIClient) ReadAuthenticationProvider(id int64) (*controllerhelper.AuthenticationProvider, error) {
+	provider, ok := f.providers[id]
+	if !ok {
+		return nil, nil
+	}
+	res := *provider
+	return &res, nil
+}
+
+func (f *fakeAdminSSOAPIClient) CreateAuthenticationProvider(params url.Values) (*controllerhelper.AuthenticationProvider, error) {
+	provider := &controllerhelper.AuthenticationProvider{ID: f.nextID, Kind: params.Get("kind")}
+	f.nextID++
+	f.providers[provider.ID] = provider
+	return f.UpdateAuthenticationProvider(provider.ID, params)
+}
+
+func (f *fakeAdminSSOAPIClient) UpdateAuthenticationProvider(id int64, params url.Values) (*controllerhelper.AuthenticationProvider, error) {
+	provider := f.providers[id]
+	provider.ClientID = params.Get("client_id")
+	provider.Site = params.Get("site")
+	provider.Published, _ = strconv.ParseBool(params.Get("published"))
+	provider.AutomaticallyApproveAccounts, _ = strconv.ParseBool(params.Get("automatically_approve_accounts"))
+	f.clientSecrets[id] = params.Get("client_secret")
+	return f.ReadAuthenticationProvider(id)
+}
+
+func (f *fakeAdminSSOAPIClient) DeleteAuthenticationProvider(id int64) error {
+	delete(f.providers, id)
+	delete(f.clientSecrets, id)
+	return nil
+}
+
+func TestAdminSSOReconciler(t *testing.T) {
+	var (
+		log       = logf.Log.WithName("operator_test")
+		issuerURL = "https://sso.example.com/auth/realms/3scale"
+	)
+
+	credentialsSecret := func(clientID, clientSecret string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-sso", Namespace: namespace},
+			Data: map[string][]byte{
+				AdminSSOClientIDSecretKey:     []byte(clientID),
+				AdminSSOClientSecretSecretKey: []byte(clientSecret),
+			},
+		}
+	}
+
+	setup := func(subT *testing.T, apiClient *fakeAdminSSOAPIClient, objs ...runtime.Object) (client.Client, func() *AdminSSOReconciler) {
+		apimanager := basicApimanager()
+		apimanager.Spec.System.AdminSSO = &appsv1alpha1.SystemAdminSSOSpec{
+			IssuerURL:                  issuerURL,
+			ClientCredentialsSecretRef: v1.LocalObjectReference{Name: "admin-sso"},
+		}
+
+		systemApp := &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: component.SystemAppDeploymentName, Namespace: namespace},
+			Status:     appsv1.DeploymentConfigStatus{Replicas: 1, ReadyReplicas: 1},
+		}
+		seedSecret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: component.SystemSecretSystemSeedSecretName, Namespace: namespace},
+			Data:       map[string][]byte{component.SystemSecretSystemSeedAdminAccessTokenFieldName: []byte("token")},
+		}
+
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager, systemApp, seedSecret}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, cl, log, fakeclientset.NewSimpleClientset().Discovery(), record.NewFakeRecorder(10000))
+
+		newReconciler := func() *AdminSSOReconciler {
+			existing := &appsv1alpha1.APIManager{}
+			if err := cl.Get(context.TODO(), types.NamespacedName{Name: apimanagerName, Namespace: namespace}, existing); err != nil {
+				subT.Fatal(err)
+			}
+			reconciler := NewAdminSSOReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, existing))
+			reconciler.apiClientFactory = func(adminURL, token string) (AdminSSOAPIClient, error) {
+				if expected := "https://someTenant-admin.test.3scale.net"; adminURL != expected {
+					subT.Errorf("expected admin URL '%s', got '%s'", expected, adminURL)
+				}
+				return apiClient, nil
+			}
+			return reconciler
+		}
+
+		return cl, newReconciler
+	}
+
+	reconcile := func(subT *testing.T, newReconciler func() *AdminSSOReconciler) *appsv1alpha1.APIManager {
+		reconciler := newReconciler()
+		if _, err := reconciler.Reconcile(); err != nil {
+			subT.Fatal(err)
+		}
+		return newReconciler().apiManager
+	}
+
+	t.Run("LifeCycle", func(subT *testing.T) {
+		apiClient := newFakeAdminSSOAPIClient()
+		cl, newReconciler := setup(subT, apiClient, credentialsSecret("3scale-admin", "secret"))
+
+		apimanager := reconcile(subT, newReconciler)
+		status := apimanager.Status.AdminSSO
+		if status == nil || !status.Created {
+			subT.Fatalf("expected authentication provider to be created, got %v", status)
+		}
+		if !apimanager.Status.Conditions.IsTrueFor(appsv1alpha1.APIManagerAdminSSOReadyConditionType) {
+			subT.Error("expected AdminSSOReady condition")
+		}
+		provider := apiClient.providers[status.ProviderID]
+		if provider.Kind != controllerhelper.AuthenticationProviderKindKeycloak || provider.Site != issuerURL || provider.ClientID != "3scale-admin" || !provider.Published {
+			subT.Errorf("unexpected authentication provider: %v", provider)
+		}
+
+		// Secret rotation is pushed to the provider
+		if err := cl.Update(context.TODO(), credentialsSecret("3scale-admin", "rotated")); err != nil {
+			subT.Fatal(err)
+		}
+		apimanager = reconcile(subT, newReconciler)
+		if apiClient.clientSecrets[status.ProviderID] != "rotated" {
+			subT.Error("expected client secret to be rotated")
+		}
+		if apimanager.Status.AdminSSO.CredentialsHash == status.CredentialsHash {
+			subT.Error("expected credentials hash to be updated")
+		}
+
+		// Disabling removes the created provider
+		apimanager.Spec.System.AdminSSO = nil
+		if err := cl.Update(context.TODO(), apimanager); err != nil {
+			subT.Fatal(err)
+		}
+		apimanager = reconcile(subT, newReconciler)
+		if len(apiClient.providers) != 0 {
+			subT.Error("expected authentication provider to be removed")
+		}
+		if apimanager.Status.AdminSSO != nil || apimanager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerAdminSSOReadyConditionType) != nil {
+			subT.Error("expected admin SSO status to be cleared")
+		}
+	})
+
+	t.Run("AdoptedProviderIsKept", func(subT *testing.T) {
+		apiClient := newFakeAdminSSOAPIClient()
+		if _, err := apiClient.CreateAuthenticationProvider(url.Values{"kind": {controllerhelper.AuthenticationProviderKindKeycloak}, "site": {issuerURL}, "client_id": {"manual"}}); err != nil {
+			subT.Fatal(err)
+		}
+		cl, newReconciler := setup(subT, apiClient, credentialsSecret("3scale-admin", "secret"))
+
+		apimanager := reconcile(subT, newReconciler)
+		if status := apimanager.Status.AdminSSO; status == nil || status.Created || status.ProviderID != 1 {
+			subT.Fatalf("expected existing authentication provider to be adopted, got %v", status)
+		}
+		if apiClient.providers[1].ClientID != "3scale-admin" {
+			subT.Error("expected adopted provider to be updated")
+		}
+
+		apimanager.Spec.System.AdminSSO = nil
+		if err := cl.Update(context.TODO(), apimanager); err != nil {
+			subT.Fatal(err)
+		}
+		reconcile(subT, newReconciler)
+		if len(apiClient.providers) != 1 {
+			subT.Error("expected adopted authentication provider to be kept")
+		}
+	})
+
+	t.Run("MissingCredentials", func(subT *testing.T) {
+		_, newReconciler := setup(subT, newFakeAdminSSOAPIClient())
+
+		res, err := newReconciler().Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected delayed requeue")
+		}
+		condition := newReconciler().apiManager.Status.Conditions.GetCondition(appsv1alpha1.APIManagerAdminSSOReadyConditionType)
+		if condition == nil || condition.Status != v1.ConditionFalse || string(condition.Reason) != AdminSSOReasonInvalidCredentials {
+			subT.Errorf("unexpected condition: %v", condition)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+const systemConfigVolumeName = "system-config"
+
+func systemCORSOptions(apimanager *appsv1alpha1.APIManager) *component.SystemCORSOptions {
+	if !apimanager.IsSystemCORSEnabled() {
+		return nil
+	}
+
+	corsSpec := apimanager.Spec.System.CORS
+	opts := &component.SystemCORSOptions{
+		AllowedOrigins: corsSpec.AllowedOrigins,
+		AllowedHeaders: corsSpec.AllowedHeaders,
+		MaxAge:         corsSpec.MaxAge,
+	}
+	if corsSpec.AllowCredentials != nil {
+		opts.AllowCredentials = *corsSpec.AllowCredentials
+	}
+
+	return opts
+}
+
+// systemCORSConfigMapMutator reconciles the CORS configuration of the system config map.
+// Other keys are not reconciled, they may have been customized
+func systemCORSConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	desiredVal, desiredOk := desired.Data[component.SystemCORSConfigFileName]
+	existingVal, existingOk := existing.Data[component.SystemCORSConfigFileName]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Data, component.SystemCORSConfigFileName)
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if existingOk && existingVal == desiredVal {
+		return false, nil
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+	existing.Data[component.SystemCORSConfigFileName] = desiredVal
+	return true, nil
+}
+
+// systemCORSMutator reconciles the system config files mounted in system-app
+// and the CORS configuration hash, so system-app is rolled out when CORS changes
+func systemCORSMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	desiredIdx := helper.FindVolumeByName(desired.Spec.Template.Spec.Volumes, systemConfigVolumeName)
+	existingIdx := helper.FindVolumeByName(existing.Spec.Template.Spec.Volumes, systemConfigVolumeName)
+	if desiredIdx >= 0 && existingIdx >= 0 {
+		desiredCM := desired.Spec.Template.Spec.Volumes[desiredIdx].ConfigMap
+		existingCM := existing.Spec.Template.Spec.Volumes[existingIdx].ConfigMap
+		if desiredCM != nil && existingCM != nil && !reflect.DeepEqual(existingCM.Items, desiredCM.Items) {
+			existingCM.Items = desiredCM.Items
+			update = true
+		}
+	}
+
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[component.SystemCORSHashAnnotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[component.SystemCORSHashAnnotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, component.SystemCORSHashAnnotation)
+			update = true
+		}
+		return update, nil
+	}
+
+	if !existingOk || existingVal != desiredVal {
+		if existing.Spec.Template.Annotations == nil {
+			existing.Spec.Template.Annotations = map[string]string{}
+		}
+		existing.Spec.Template.Annotations[component.SystemCORSHashAnnotation] = desiredVal
+		update = true
+	}
+
+	return update, nil
+}
@@ -0,0 +1,189 @@
+package operator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func systemCORSTestSpec() *appsv1alpha1.SystemCORSSpec {
+	var maxAge int32 = 600
+	trueValue := true
+	return &appsv1alpha1.SystemCORSSpec{
+		AllowedOrigins:   []string{"https://a.example.com", "https://b.example.com"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		MaxAge:           &maxAge,
+		AllowCredentials: &trueValue,
+	}
+}
+
+func systemConfigMapItems(dc *appsv1.DeploymentConfig) []v1.KeyToPath {
+	idx := helper.FindVolumeByName(dc.Spec.Template.Spec.Volumes, systemConfigVolumeName)
+	if idx < 0 {
+		return nil
+	}
+	return dc.Spec.Template.Spec.Volumes[idx].ConfigMap.Items
+}
+
+func hasKeyToPath(items []v1.KeyToPath, key string) bool {
+	for _, item := range items {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSystemCORS(t *testing.T) {
+	t.Run("Disabled", func(subT *testing.T) {
+		system, err := System(basicApimanager(), fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		if _, ok := system.SystemConfigMap().Data[component.SystemCORSConfigFileName]; ok {
+			subT.Error("unexpected CORS configuration")
+		}
+		dc := system.AppDeploymentConfig()
+		if _, ok := dc.Spec.Template.Annotations[component.SystemCORSHashAnnotation]; ok {
+			subT.Error("unexpected CORS hash annotation")
+		}
+		if hasKeyToPath(systemConfigMapItems(dc), component.SystemCORSConfigFileName) {
+			subT.Error("unexpected CORS configuration mounted")
+		}
+	})
+
+	t.Run("Enabled", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Spec.System.CORS = systemCORSTestSpec()
+		system, err := System(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		expected := `production:
+  enabled: true
+  allow:
+    - origins: ["https://a.example.com","https://b.example.com"]
+      resources: ["/admin/api/*","/master/api/*"]
+      headers: ["Authorization","Content-Type"]
+      max_age: 600
+      credentials: true
+`
+		if data := system.SystemConfigMap().Data[component.SystemCORSConfigFileName]; data != expected {
+			subT.Errorf("unexpected CORS configuration:\n%s", data)
+		}
+		dc := system.AppDeploymentConfig()
+		if _, ok := dc.Spec.Template.Annotations[component.SystemCORSHashAnnotation]; !ok {
+			subT.Error("expected CORS hash annotation")
+		}
+		if !hasKeyToPath(systemConfigMapItems(dc), component.SystemCORSConfigFileName) {
+			subT.Error("expected CORS configuration mounted")
+		}
+	})
+
+	t.Run("Defaults", func(subT *testing.T) {
+		apimanager := basicApimanager()
+		apimanager.Spec.System.CORS = &appsv1alpha1.SystemCORSSpec{AllowedOrigins: []string{"*"}}
+		system, err := System(apimanager, fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		data := system.SystemConfigMap().Data[component.SystemCORSConfigFileName]
+		if strings.Contains(data, "headers:") || strings.Contains(data, "max_age:") {
+			subT.Errorf("unexpected optional CORS settings:\n%s", data)
+		}
+		if !strings.Contains(data, "credentials: false") {
+			subT.Errorf("expected credentials disabled:\n%s", data)
+		}
+	})
+}
+
+func TestSystemCORSMutators(t *testing.T) {
+	apimanager := basicApimanager()
+	apimanager.Spec.System.CORS = systemCORSTestSpec()
+	enabled, err := System(apimanager, fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	disabled, err := System(basicApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Add", func(subT *testing.T) {
+		existingCM := disabled.SystemConfigMap()
+		update, err := systemCORSConfigMapMutator(existingCM, enabled.SystemConfigMap())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update || existingCM.Data[component.SystemCORSConfigFileName] != enabled.SystemConfigMap().Data[component.SystemCORSConfigFileName] {
+			subT.Error("expected CORS configuration to be added")
+		}
+
+		existingDC := disabled.AppDeploymentConfig()
+		desiredDC := enabled.AppDeploymentConfig()
+		update, err = systemCORSMutator(desiredDC, existingDC)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update {
+			subT.Error("expected system-app update")
+		}
+		if existingDC.Spec.Template.Annotations[component.SystemCORSHashAnnotation] != desiredDC.Spec.Template.Annotations[component.SystemCORSHashAnnotation] {
+			subT.Error("expected CORS hash annotation to be added")
+		}
+		if !reflect.DeepEqual(systemConfigMapItems(existingDC), systemConfigMapItems(desiredDC)) {
+			subT.Error("expected CORS configuration to be mounted")
+		}
+	})
+
+	t.Run("Unchanged", func(subT *testing.T) {
+		update, err := systemCORSConfigMapMutator(enabled.SystemConfigMap(), enabled.SystemConfigMap())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update {
+			subT.Error("unexpected config map update")
+		}
+		update, err = systemCORSMutator(enabled.AppDeploymentConfig(), enabled.AppDeploymentConfig())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update {
+			subT.Error("unexpected system-app update")
+		}
+	})
+
+	t.Run("Remove", func(subT *testing.T) {
+		existingCM := enabled.SystemConfigMap()
+		update, err := systemCORSConfigMapMutator(existingCM, disabled.SystemConfigMap())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if _, ok := existingCM.Data[component.SystemCORSConfigFileName]; !update || ok {
+			subT.Error("expected CORS configuration to be removed")
+		}
+
+		existingDC := enabled.AppDeploymentConfig()
+		update, err = systemCORSMutator(disabled.AppDeploymentConfig(), existingDC)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if _, ok := existingDC.Spec.Template.Annotations[component.SystemCORSHashAnnotation]; !update || ok {
+			subT.Error("expected CORS hash annotation to be removed")
+		}
+		if hasKeyToPath(systemConfigMapItems(existingDC), component.SystemCORSConfigFileName) {
+			subT.Error("expected CORS configuration to be unmounted")
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// systemDeveloperPortalMutator reconciles the env var telling system the developer portal is disabled.
+// The developer container is reconciled by the system-app resource mutator
+func systemDeveloperPortalMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, component.SystemDeveloperPortalEnabledEnvVarName), nil
+}
+
+// systemAppPodMonitorMutator reconciles the scraped endpoints,
+// the developer container endpoints are dropped when the developer portal is disabled
+func systemAppPodMonitorMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*monitoringv1.PodMonitor)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PodMonitor", existingObj)
+	}
+	desired, ok := desiredObj.(*monitoringv1.PodMonitor)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PodMonitor", desiredObj)
+	}
+
+	if reflect.DeepEqual(existing.Spec.PodMetricsEndpoints, desired.Spec.PodMetricsEndpoints) {
+		return false, nil
+	}
+
+	existing.Spec.PodMetricsEndpoints = desired.Spec.PodMetricsEndpoints
+	return true, nil
+}
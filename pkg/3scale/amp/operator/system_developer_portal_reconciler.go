@@ -0,0 +1,99 @@
+package operator
+
+import (
+	"fmt"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+)
+
+const developerPortalRequeueDelay = 10 * time.Second
+
+func DeveloperPortalResyncJobName(apimanagerName string) string {
+	return fmt.Sprintf("%s-developer-portal-resync", apimanagerName)
+}
+
+// DeveloperPortalReconciler keeps track of the developer portal enablement in the APIManager status.
+// While the developer portal is disabled, the routes created by zync for the developer portal
+// are removed. When it is enabled again, the zync domains are resynchronized
+// so zync creates the developer portal routes again.
+type DeveloperPortalReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewDeveloperPortalReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *DeveloperPortalReconciler {
+	return &DeveloperPortalReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *DeveloperPortalReconciler) Reconcile() (reconcile.Result, error) {
+	if !r.apiManager.IsSystemDeveloperPortalEnabled() {
+		return reconcile.Result{}, r.reconcileDisabled()
+	}
+
+	if r.apiManager.Status.DeveloperPortal == appsv1alpha1.DeveloperPortalDisabled {
+		// The standby database is replicated from the active cluster,
+		// the zync domains are resynchronized on activation
+		if r.apiManager.IsStandby() {
+			return reconcile.Result{}, nil
+		}
+
+		done, err := r.reconcileZyncResyncJob(DeveloperPortalResyncJobName(r.apiManager.Name))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !done {
+			return reconcile.Result{RequeueAfter: developerPortalRequeueDelay}, nil
+		}
+	}
+
+	return reconcile.Result{}, r.writeStatus(appsv1alpha1.DeveloperPortalEnabled)
+}
+
+func (r *DeveloperPortalReconciler) reconcileDisabled() error {
+	// A job left by a previous enablement would be taken as the resync of the next one
+	err := r.deleteJob(DeveloperPortalResyncJobName(r.apiManager.Name))
+	if err != nil {
+		return err
+	}
+
+	routeList := &routev1.RouteList{}
+	err = r.Client().List(r.Context(), routeList, client.InNamespace(r.apiManager.Namespace))
+	if err != nil {
+		return fmt.Errorf("Failed to list routes: %w", err)
+	}
+
+	// zync creates a developer portal route for every tenant
+	for idx := range routeList.Items {
+		route := &routeList.Items[idx]
+		if route.Spec.To.Kind != "Service" || route.Spec.To.Name != component.SystemDeveloperServiceName {
+			continue
+		}
+		err = r.DeleteResource(route)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return r.writeStatus(appsv1alpha1.DeveloperPortalDisabled)
+}
+
+func (r *DeveloperPortalReconciler) writeStatus(state string) error {
+	if r.apiManager.Status.DeveloperPortal == state {
+		return nil
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.DeveloperPortal = state
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,161 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestDeveloperPortalReconciler(t *testing.T) {
+	var (
+		appLabel = "someLabel"
+		log      = logf.Log.WithName("operator_test")
+	)
+
+	route := func(name, serviceName string) *routev1.Route {
+		return &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: routev1.RouteSpec{
+				To: routev1.RouteTargetReference{Kind: "Service", Name: serviceName},
+			},
+		}
+	}
+
+	sidekiqDC := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: component.SystemSidekiqName, Namespace: namespace},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "system-sidekiq", Image: "system:latest"}}},
+			},
+		},
+	}
+
+	newReconciler := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, objs ...runtime.Object) (*DeveloperPortalReconciler, client.Client) {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+		if err := routev1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		clientAPIReader := fake.NewFakeClient(objs...)
+		clientset := fakeclientset.NewSimpleClientset()
+		recorder := record.NewFakeRecorder(10000)
+
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+		return NewDeveloperPortalReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)), cl
+	}
+
+	newAPIManager := func(enabled bool, state string) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+			Spec: appsv1alpha1.APIManagerSpec{
+				APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+				System: &appsv1alpha1.SystemSpec{
+					DeveloperPortal: &appsv1alpha1.SystemDeveloperPortalSpec{Enabled: &enabled},
+				},
+			},
+			Status: appsv1alpha1.APIManagerStatus{DeveloperPortal: state},
+		}
+	}
+
+	t.Run("enabled", func(subT *testing.T) {
+		apimanager := newAPIManager(true, "")
+		developerPortalReconciler, cl := newReconciler(subT, apimanager)
+
+		_, err := developerPortalReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.DeveloperPortal != appsv1alpha1.DeveloperPortalEnabled {
+			subT.Errorf("unexpected developer portal status: '%s'", apimanager.Status.DeveloperPortal)
+		}
+
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: DeveloperPortalResyncJobName(apimanager.Name), Namespace: namespace}, &batchv1.Job{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("unexpected resync job: %v", err)
+		}
+	})
+
+	t.Run("disabled removes the developer portal routes", func(subT *testing.T) {
+		apimanager := newAPIManager(false, appsv1alpha1.DeveloperPortalEnabled)
+		developerPortalReconciler, cl := newReconciler(subT, apimanager,
+			route("zync-3scale-developer", component.SystemDeveloperServiceName),
+			route("zync-3scale-provider", "system-provider"),
+		)
+
+		_, err := developerPortalReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.DeveloperPortal != appsv1alpha1.DeveloperPortalDisabled {
+			subT.Errorf("unexpected developer portal status: '%s'", apimanager.Status.DeveloperPortal)
+		}
+
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: "zync-3scale-developer", Namespace: namespace}, &routev1.Route{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("expected developer route to be removed: %v", err)
+		}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: "zync-3scale-provider", Namespace: namespace}, &routev1.Route{})
+		if err != nil {
+			subT.Errorf("expected provider route to be kept: %v", err)
+		}
+	})
+
+	t.Run("re-enabled resyncs zync domains", func(subT *testing.T) {
+		apimanager := newAPIManager(true, appsv1alpha1.DeveloperPortalDisabled)
+		developerPortalReconciler, cl := newReconciler(subT, apimanager, sidekiqDC.DeepCopy())
+
+		res, err := developerPortalReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected requeue")
+		}
+		if apimanager.Status.DeveloperPortal != appsv1alpha1.DeveloperPortalDisabled {
+			subT.Fatal("expected the status to wait for the zync resync job")
+		}
+
+		job := &batchv1.Job{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: DeveloperPortalResyncJobName(apimanager.Name), Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		job.Status.Succeeded = 1
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = developerPortalReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.DeveloperPortal != appsv1alpha1.DeveloperPortalEnabled {
+			subT.Errorf("unexpected developer portal status: '%s'", apimanager.Status.DeveloperPortal)
+		}
+	})
+}
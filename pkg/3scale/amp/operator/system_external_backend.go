@@ -0,0 +1,26 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+)
+
+// systemBackendEnvVarsMutator reconciles the backend endpoints and internal API credentials env vars,
+// so system-app and system-sidekiq are rolled out when switching to an external backend
+func systemBackendEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, envVar := range []string{
+		component.SystemBackendRootEndpointEnvVarName,
+		component.SystemBackendRouteEnvVarName,
+		component.SystemBackendInternalAPIUserEnvVarName,
+		component.SystemBackendInternalAPIPasswdEnvVarName,
+	} {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
@@ -0,0 +1,85 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	externalBackendTestListenerEndpoint = "http://backend-listener.example.com:3000"
+	externalBackendTestRouteEndpoint    = "https://backend.example.com"
+	externalBackendTestSecretName       = "external-backend-internal-api"
+)
+
+func externalBackendTestApimanager() *appsv1alpha1.APIManager {
+	routeEndpoint := externalBackendTestRouteEndpoint
+	apimanager := basicApimanager()
+	apimanager.Spec.ExternalBackend = &appsv1alpha1.ExternalBackendSpec{
+		ListenerEndpoint:     externalBackendTestListenerEndpoint,
+		RouteEndpoint:        &routeEndpoint,
+		InternalAPISecretRef: v1.LocalObjectReference{Name: externalBackendTestSecretName},
+	}
+	return apimanager
+}
+
+func externalBackendTestSecret() *v1.Secret {
+	return GetTestSecret(namespace, externalBackendTestSecretName, map[string]string{
+		component.BackendSecretInternalApiUsernameFieldName: "user",
+		component.BackendSecretInternalApiPasswordFieldName: "passwd",
+	})
+}
+
+func TestSystemExternalBackend(t *testing.T) {
+	t.Run("MissingSecret", func(subT *testing.T) {
+		_, err := System(externalBackendTestApimanager(), fake.NewFakeClient())
+		if err == nil {
+			subT.Fatal("expected missing external backend secret error")
+		}
+	})
+
+	t.Run("External", func(subT *testing.T) {
+		system, err := System(externalBackendTestApimanager(), fake.NewFakeClient(externalBackendTestSecret()))
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for _, dc := range []*appsv1.DeploymentConfig{system.AppDeploymentConfig(), system.SidekiqDeploymentConfig()} {
+			for _, container := range dc.Spec.Template.Spec.Containers {
+				rootEndpoint, ok := findEnvVar(container.Env, component.SystemBackendRootEndpointEnvVarName)
+				if !ok || rootEndpoint.Value != externalBackendTestRouteEndpoint {
+					subT.Errorf("%s/%s: expected backend root endpoint %s, got %v", dc.Name, container.Name, externalBackendTestRouteEndpoint, rootEndpoint)
+				}
+				route, ok := findEnvVar(container.Env, component.SystemBackendRouteEnvVarName)
+				if expected := externalBackendTestListenerEndpoint + "/internal/"; !ok || route.Value != expected {
+					subT.Errorf("%s/%s: expected backend route %s, got %v", dc.Name, container.Name, expected, route)
+				}
+				for _, envVar := range []string{component.SystemBackendInternalAPIUserEnvVarName, component.SystemBackendInternalAPIPasswdEnvVarName} {
+					credential, ok := findEnvVar(container.Env, envVar)
+					if !ok || credential.ValueFrom == nil || credential.ValueFrom.SecretKeyRef.Name != externalBackendTestSecretName {
+						subT.Errorf("%s/%s: expected %s from the %s secret", dc.Name, container.Name, envVar, externalBackendTestSecretName)
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestApicastExternalBackend(t *testing.T) {
+	apicast, err := Apicast(externalBackendTestApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dc := range []*appsv1.DeploymentConfig{apicast.StagingDeploymentConfig(), apicast.ProductionDeploymentConfig()} {
+		backendEndpoint, ok := findEnvVar(dc.Spec.Template.Spec.Containers[0].Env, "BACKEND_ENDPOINT_OVERRIDE")
+		if !ok || backendEndpoint.Value != externalBackendTestListenerEndpoint {
+			t.Errorf("%s: expected backend endpoint %s, got %v", dc.Name, externalBackendTestListenerEndpoint, backendEndpoint)
+		}
+	}
+}
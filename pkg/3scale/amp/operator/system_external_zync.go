@@ -0,0 +1,63 @@
+package operator
+
+import (
+	"fmt"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// systemZyncEnvVarsMutator reconciles the zync token and endpoint env vars,
+// so system-app and system-sidekiq are rolled out when switching to an external zync
+func systemZyncEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, envVar := range []string{
+		component.SystemZyncAuthenticationTokenEnvVarName,
+		component.SystemZyncEndpointEnvVarName,
+	} {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
+// systemZyncConfigMapMutator upgrades the zync.yml of the system config map
+// to read the zync endpoint from the env. Customized zync.yml files are not reconciled
+func systemZyncConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	if existing.Data["zync.yml"] != component.SystemLegacyZyncConfData {
+		return false, nil
+	}
+
+	existing.Data["zync.yml"] = desired.Data["zync.yml"]
+	return true, nil
+}
+
+// systemConfigMapMutator reconciles the keys of the system config map managed by the operator
+func systemConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	update := false
+
+	for _, mutator := range []reconcilers.MutateFn{systemCORSConfigMapMutator, systemZyncConfigMapMutator} {
+		tmpUpdate, err := mutator(existingObj, desiredObj)
+		if err != nil {
+			return false, err
+		}
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
@@ -0,0 +1,193 @@
+package operator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	externalZyncTestEndpoint   = "https://zync.example.com"
+	externalZyncTestSecretName = "external-zync"
+)
+
+func externalZyncTestApimanager() *appsv1alpha1.APIManager {
+	apimanager := basicApimanager()
+	apimanager.Spec.Zync.ExternalZync = &appsv1alpha1.ExternalZyncSpec{
+		Endpoint:  externalZyncTestEndpoint,
+		SecretRef: v1.LocalObjectReference{Name: externalZyncTestSecretName},
+	}
+	return apimanager
+}
+
+func externalZyncTestSecret() *v1.Secret {
+	return GetTestSecret(namespace, externalZyncTestSecretName, map[string]string{
+		component.ZyncSecretAuthenticationTokenFieldName: "token",
+	})
+}
+
+func TestSystemExternalZync(t *testing.T) {
+	t.Run("MissingSecret", func(subT *testing.T) {
+		_, err := System(externalZyncTestApimanager(), fake.NewFakeClient())
+		if err == nil {
+			subT.Fatal("expected missing external zync secret error")
+		}
+	})
+
+	t.Run("Internal", func(subT *testing.T) {
+		system, err := System(basicApimanager(), fake.NewFakeClient())
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for _, dc := range []*appsv1.DeploymentConfig{system.AppDeploymentConfig(), system.SidekiqDeploymentConfig()} {
+			env := dc.Spec.Template.Spec.Containers[0].Env
+			idx := helper.FindEnvVar(env, component.SystemZyncAuthenticationTokenEnvVarName)
+			if idx < 0 || env[idx].ValueFrom.SecretKeyRef.Name != component.ZyncSecretName {
+				subT.Errorf("%s: expected zync token from the %s secret", dc.Name, component.ZyncSecretName)
+			}
+			if helper.FindEnvVar(env, component.SystemZyncEndpointEnvVarName) >= 0 {
+				subT.Errorf("%s: unexpected zync endpoint env var", dc.Name)
+			}
+		}
+	})
+
+	t.Run("External", func(subT *testing.T) {
+		system, err := System(externalZyncTestApimanager(), fake.NewFakeClient(externalZyncTestSecret()))
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for _, dc := range []*appsv1.DeploymentConfig{system.AppDeploymentConfig(), system.SidekiqDeploymentConfig()} {
+			for _, container := range dc.Spec.Template.Spec.Containers {
+				idx := helper.FindEnvVar(container.Env, component.SystemZyncAuthenticationTokenEnvVarName)
+				if idx < 0 || container.Env[idx].ValueFrom.SecretKeyRef.Name != externalZyncTestSecretName {
+					subT.Errorf("%s/%s: expected zync token from the %s secret", dc.Name, container.Name, externalZyncTestSecretName)
+				}
+				idx = helper.FindEnvVar(container.Env, component.SystemZyncEndpointEnvVarName)
+				if idx < 0 || container.Env[idx].Value != externalZyncTestEndpoint {
+					subT.Errorf("%s/%s: expected zync endpoint %s", dc.Name, container.Name, externalZyncTestEndpoint)
+				}
+			}
+		}
+
+		if data := system.SystemConfigMap().Data["zync.yml"]; !strings.Contains(data, "ENV.fetch('ZYNC_ENDPOINT'") {
+			subT.Errorf("expected zync endpoint read from the env:\n%s", data)
+		}
+	})
+}
+
+func TestSystemExternalZyncMutators(t *testing.T) {
+	internal, err := System(basicApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, err := System(externalZyncTestApimanager(), fake.NewFakeClient(externalZyncTestSecret()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("LegacyZyncConfig", func(subT *testing.T) {
+		existingCM := internal.SystemConfigMap()
+		existingCM.Data["zync.yml"] = component.SystemLegacyZyncConfData
+		update, err := systemConfigMapMutator(existingCM, internal.SystemConfigMap())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update || existingCM.Data["zync.yml"] != internal.SystemConfigMap().Data["zync.yml"] {
+			subT.Error("expected legacy zync.yml to be upgraded")
+		}
+	})
+
+	t.Run("CustomizedZyncConfig", func(subT *testing.T) {
+		existingCM := internal.SystemConfigMap()
+		existingCM.Data["zync.yml"] = "custom"
+		update, err := systemConfigMapMutator(existingCM, internal.SystemConfigMap())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update || existingCM.Data["zync.yml"] != "custom" {
+			subT.Error("unexpected customized zync.yml update")
+		}
+	})
+
+	t.Run("SwitchToExternal", func(subT *testing.T) {
+		existingDC := internal.SidekiqDeploymentConfig()
+		update, err := systemZyncEnvVarsMutator(external.SidekiqDeploymentConfig(), existingDC)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update {
+			subT.Fatal("expected system-sidekiq update")
+		}
+		env := existingDC.Spec.Template.Spec.Containers[0].Env
+		if idx := helper.FindEnvVar(env, component.SystemZyncEndpointEnvVarName); idx < 0 || env[idx].Value != externalZyncTestEndpoint {
+			subT.Error("expected zync endpoint env var to be added")
+		}
+
+		update, err = systemZyncEnvVarsMutator(external.SidekiqDeploymentConfig(), existingDC)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update {
+			subT.Error("unexpected system-sidekiq update")
+		}
+	})
+}
+
+func TestZyncReconcilerExternalZync(t *testing.T) {
+	apimanager := externalZyncTestApimanager()
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	objs := []runtime.Object{apimanager, externalZyncTestSecret()}
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+	log := logf.Log.WithName("operator_test")
+
+	baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	reconciler := NewZyncReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager))
+	_, err := reconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		obj  runtime.Object
+	}{
+		{component.ZyncName, &appsv1.DeploymentConfig{}},
+		{component.ZyncQueDeploymentName, &appsv1.DeploymentConfig{}},
+		{component.ZyncDatabaseDeploymentName, &appsv1.DeploymentConfig{}},
+		{component.ZyncName, &v1.Service{}},
+		{component.ZyncSecretName, &v1.Secret{}},
+	}
+
+	for _, tc := range cases {
+		err := cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: tc.name}, tc.obj)
+		if err == nil {
+			t.Errorf("unexpected %T %s created", tc.obj, tc.name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package operator
+
+import (
+	"fmt"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+)
+
+// systemFileStorageMutator reconciles the file storage of the system pods, so the
+// file storage can be switched from the PVC to S3, e.g. by the file storage migration.
+// The system-app pre hook pod is reconciled as well
+func systemFileStorageMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := reconcilers.DeploymentConfigContainersVolumeReconciler(desired, existing, component.SystemFileStoragePVCName)
+
+	tmpUpdate := reconcilers.DeploymentConfigPreHookReconciler(desired, existing, "", component.SystemFileStoragePVCName)
+	update = update || tmpUpdate
+
+	for _, envVar := range component.SystemS3FileStorageEnvVarNames {
+		tmpUpdate = reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+
+		tmpUpdate = reconcilers.DeploymentConfigPreHookReconciler(desired, existing, envVar, "")
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
+// systemEnvironmentConfigMapMutator sets the file upload storage once the file storage
+// is S3. The other keys of the system-environment ConfigMap are only set on creation
+func systemEnvironmentConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*v1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.ConfigMap", desiredObj)
+	}
+
+	if _, ok := desired.Data[component.SystemFileUploadStorageEnvVarName]; !ok {
+		return false, nil
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+
+	return reconcilers.ConfigMapReconcileField(desired, existing, component.SystemFileUploadStorageEnvVarName), nil
+}
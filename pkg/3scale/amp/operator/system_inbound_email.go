@@ -0,0 +1,84 @@
+package operator
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (s *SystemOptionsProvider) setInboundEmailOptions() error {
+	if !s.apimanager.IsSystemInboundEmailEnabled() {
+		return nil
+	}
+
+	inboundEmailSpec := s.apimanager.Spec.System.InboundEmail
+	opts := &component.SystemInboundEmailOptions{
+		Protocol:   inboundEmailSpec.Protocol,
+		Host:       inboundEmailSpec.Host,
+		SSL:        inboundEmailSpec.SSL == nil || *inboundEmailSpec.SSL,
+		SecretName: inboundEmailSpec.CredentialsSecretRef.Name,
+	}
+	opts.Port = component.DefaultSystemInboundEmailPort(opts.Protocol, opts.SSL)
+	if inboundEmailSpec.Port != nil {
+		opts.Port = *inboundEmailSpec.Port
+	}
+
+	secret, err := s.secretSource.CachedSecret(opts.SecretName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// The secret watch requeues the APIManager once it is created
+			return &helper.WaitError{Err: fmt.Errorf("inbound email credentials secret '%s' not found", opts.SecretName)}
+		}
+		return err
+	}
+
+	h := fnv.New32a()
+	for _, key := range []string{component.SystemInboundEmailUsernameSecretKey, component.SystemInboundEmailPasswordSecretKey} {
+		if len(secret.Data[key]) == 0 {
+			return fmt.Errorf("Secret field '%s' is required in secret '%s'", key, opts.SecretName)
+		}
+		h.Write(secret.Data[key])
+	}
+	opts.Hash = fmt.Sprint(h.Sum32())
+
+	s.options.InboundEmail = opts
+	return nil
+}
+
+// systemInboundEmailMutator reconciles the inbound email env vars and the
+// credentials hash of the system-app and system-sidekiq pods
+func systemInboundEmailMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	changed := false
+
+	for _, envVar := range component.SystemInboundEmailEnvVarNames {
+		tmpChanged := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		changed = changed || tmpChanged
+	}
+
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, component.SystemInboundEmailHashAnnotation)
+			changed = true
+		}
+		return changed, nil
+	}
+
+	if !existingOk || existingVal != desiredVal {
+		if existing.Spec.Template.Annotations == nil {
+			existing.Spec.Template.Annotations = map[string]string{}
+		}
+		existing.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation] = desiredVal
+		changed = true
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,140 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const inboundEmailTestSecretName = "inbound-email"
+
+func inboundEmailTestApimanager(protocol string, port *int32, ssl *bool) *appsv1alpha1.APIManager {
+	apimanager := basicApimanager()
+	apimanager.Spec.System.InboundEmail = &appsv1alpha1.SystemInboundEmailSpec{
+		Protocol:             protocol,
+		Host:                 "mail.example.com",
+		Port:                 port,
+		SSL:                  ssl,
+		CredentialsSecretRef: v1.LocalObjectReference{Name: inboundEmailTestSecretName},
+	}
+	return apimanager
+}
+
+func inboundEmailTestSecret(password string) *v1.Secret {
+	return GetTestSecret(namespace, inboundEmailTestSecretName, map[string]string{
+		component.SystemInboundEmailUsernameSecretKey: "support",
+		component.SystemInboundEmailPasswordSecretKey: password,
+	})
+}
+
+func TestSystemInboundEmailOptions(t *testing.T) {
+	falseValue := false
+	var invalidPort int32 = 70000
+
+	t.Run("MissingSecret", func(subT *testing.T) {
+		_, err := System(inboundEmailTestApimanager(component.SystemInboundEmailProtocolIMAP, nil, nil), fake.NewFakeClient())
+		if !helper.IsWaitError(err) {
+			subT.Fatalf("expected wait error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidProtocol", func(subT *testing.T) {
+		_, err := System(inboundEmailTestApimanager("smtp", nil, nil), fake.NewFakeClient(inboundEmailTestSecret("pass")))
+		if err == nil || helper.IsWaitError(err) {
+			subT.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidPort", func(subT *testing.T) {
+		_, err := System(inboundEmailTestApimanager(component.SystemInboundEmailProtocolIMAP, &invalidPort, nil), fake.NewFakeClient(inboundEmailTestSecret("pass")))
+		if err == nil {
+			subT.Fatal("expected validation error")
+		}
+	})
+
+	cases := []struct {
+		testName     string
+		protocol     string
+		ssl          *bool
+		expectedPort int32
+	}{
+		{"IMAPS", component.SystemInboundEmailProtocolIMAP, nil, 993},
+		{"IMAP", component.SystemInboundEmailProtocolIMAP, &falseValue, 143},
+		{"POP3S", component.SystemInboundEmailProtocolPOP3, nil, 995},
+		{"POP3", component.SystemInboundEmailProtocolPOP3, &falseValue, 110},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			system, err := System(inboundEmailTestApimanager(tc.protocol, nil, tc.ssl), fake.NewFakeClient(inboundEmailTestSecret("pass")))
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if system.Options.InboundEmail.Port != tc.expectedPort {
+				subT.Errorf("expected port %d, got %d", tc.expectedPort, system.Options.InboundEmail.Port)
+			}
+
+			for _, dc := range []*appsv1.DeploymentConfig{system.AppDeploymentConfig(), system.SidekiqDeploymentConfig()} {
+				env := dc.Spec.Template.Spec.Containers[0].Env
+				for _, envVar := range component.SystemInboundEmailEnvVarNames {
+					if helper.FindEnvVar(env, envVar) < 0 {
+						subT.Errorf("%s: expected %s env var", dc.Name, envVar)
+					}
+				}
+				if _, ok := dc.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation]; !ok {
+					subT.Errorf("%s: expected inbound email hash annotation", dc.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestSystemInboundEmailMutator(t *testing.T) {
+	apimanager := inboundEmailTestApimanager(component.SystemInboundEmailProtocolIMAP, nil, nil)
+	disabled, err := System(basicApimanager(), fake.NewFakeClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled, err := System(apimanager, fake.NewFakeClient(inboundEmailTestSecret("pass")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := System(apimanager, fake.NewFakeClient(inboundEmailTestSecret("rotated")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := disabled.SidekiqDeploymentConfig()
+	update, err := systemInboundEmailMutator(enabled.SidekiqDeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update || helper.FindEnvVar(existing.Spec.Template.Spec.Containers[0].Env, component.SystemInboundEmailHostEnvVarName) < 0 {
+		t.Error("expected inbound email env vars to be added")
+	}
+
+	update, err = systemInboundEmailMutator(rotated.SidekiqDeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update || existing.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation] != rotated.Options.InboundEmail.Hash {
+		t.Error("expected credentials rotation to update the pod template")
+	}
+
+	update, err = systemInboundEmailMutator(disabled.SidekiqDeploymentConfig(), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update || helper.FindEnvVar(existing.Spec.Template.Spec.Containers[0].Env, component.SystemInboundEmailHostEnvVarName) >= 0 {
+		t.Error("expected inbound email env vars to be removed")
+	}
+	if _, ok := existing.Spec.Template.Annotations[component.SystemInboundEmailHashAnnotation]; ok {
+		t.Error("expected inbound email hash annotation to be removed")
+	}
+}
@@ -0,0 +1,131 @@
+package operator
+
+import (
+	"fmt"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
+)
+
+const masterRouteRequeueDelay = 10 * time.Second
+
+func MasterRouteResyncJobName(apimanagerName string) string {
+	return fmt.Sprintf("%s-master-route-resync", apimanagerName)
+}
+
+// MasterInternalEndpoint returns the URL of the system-master service
+// the master admin portal is reachable at from inside the cluster
+func MasterInternalEndpoint(namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", component.SystemMasterServiceName, namespace, component.SystemMasterServicePort)
+}
+
+// MasterRouteReconciler keeps track of the master admin portal endpoint in the APIManager status.
+// While the master route is disabled, the route created by zync for the master admin portal
+// is removed and the internal system-master service is reported. When it is enabled again,
+// the zync domains are resynchronized so zync creates the master route again.
+type MasterRouteReconciler struct {
+	*BaseAPIManagerLogicReconciler
+}
+
+func NewMasterRouteReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconciler) *MasterRouteReconciler {
+	return &MasterRouteReconciler{
+		BaseAPIManagerLogicReconciler: baseAPIManagerLogicReconciler,
+	}
+}
+
+func (r *MasterRouteReconciler) Reconcile() (reconcile.Result, error) {
+	internalEndpoint := MasterInternalEndpoint(r.apiManager.Namespace)
+
+	if !r.apiManager.IsSystemMasterRouteEnabled() {
+		return reconcile.Result{}, r.reconcileDisabled(internalEndpoint)
+	}
+
+	// Only the disabled master route reports the internal endpoint
+	if r.apiManager.Status.MasterEndpoint == internalEndpoint {
+		// The standby database is replicated from the active cluster,
+		// the zync domains are resynchronized on activation
+		if r.apiManager.IsStandby() {
+			return reconcile.Result{}, nil
+		}
+
+		done, err := r.reconcileZyncResyncJob(MasterRouteResyncJobName(r.apiManager.Name))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !done {
+			return reconcile.Result{RequeueAfter: masterRouteRequeueDelay}, nil
+		}
+	}
+
+	routes, err := r.masterRoutes()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The endpoint is reported once zync creates the route,
+	// the route events trigger a new reconciliation
+	endpoint := ""
+	if len(routes) > 0 {
+		endpoint = fmt.Sprintf("https://%s", routes[0].Spec.Host)
+	}
+
+	return reconcile.Result{}, r.writeStatus(endpoint)
+}
+
+func (r *MasterRouteReconciler) reconcileDisabled(internalEndpoint string) error {
+	// A job left by a previous enablement would be taken as the resync of the next one
+	err := r.deleteJob(MasterRouteResyncJobName(r.apiManager.Name))
+	if err != nil {
+		return err
+	}
+
+	routes, err := r.masterRoutes()
+	if err != nil {
+		return err
+	}
+
+	for idx := range routes {
+		err = r.DeleteResource(&routes[idx])
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return r.writeStatus(internalEndpoint)
+}
+
+// masterRoutes returns the routes to the system-master service
+func (r *MasterRouteReconciler) masterRoutes() ([]routev1.Route, error) {
+	routeList := &routev1.RouteList{}
+	err := r.Client().List(r.Context(), routeList, client.InNamespace(r.apiManager.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list routes: %w", err)
+	}
+
+	routes := []routev1.Route{}
+	for _, route := range routeList.Items {
+		if route.Spec.To.Kind == "Service" && route.Spec.To.Name == component.SystemMasterServiceName {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes, nil
+}
+
+func (r *MasterRouteReconciler) writeStatus(endpoint string) error {
+	if r.apiManager.Status.MasterEndpoint == endpoint {
+		return nil
+	}
+
+	_, err := r.StatusWriter().Write(r.apiManager, func(common.KubernetesObject) error {
+		r.apiManager.Status.MasterEndpoint = endpoint
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,169 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestMasterRouteReconciler(t *testing.T) {
+	var (
+		appLabel = "someLabel"
+		log      = logf.Log.WithName("operator_test")
+	)
+
+	route := func(name, host, serviceName string) *routev1.Route {
+		return &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: routev1.RouteSpec{
+				Host: host,
+				To:   routev1.RouteTargetReference{Kind: "Service", Name: serviceName},
+			},
+		}
+	}
+
+	sidekiqDC := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: component.SystemSidekiqName, Namespace: namespace},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "system-sidekiq", Image: "system:latest"}}},
+			},
+		},
+	}
+
+	newReconciler := func(subT *testing.T, apimanager *appsv1alpha1.APIManager, objs ...runtime.Object) (*MasterRouteReconciler, client.Client) {
+		s := scheme.Scheme
+		s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+		if err := appsv1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+		if err := routev1.AddToScheme(s); err != nil {
+			subT.Fatal(err)
+		}
+
+		objs = append([]runtime.Object{apimanager}, objs...)
+		cl := fake.NewFakeClient(objs...)
+		clientAPIReader := fake.NewFakeClient(objs...)
+		clientset := fakeclientset.NewSimpleClientset()
+		recorder := record.NewFakeRecorder(10000)
+
+		baseReconciler := reconcilers.NewBaseReconciler(context.TODO(), cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+		return NewMasterRouteReconciler(NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)), cl
+	}
+
+	newAPIManager := func(enabled bool, endpoint string) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-apimanager", Namespace: namespace},
+			Spec: appsv1alpha1.APIManagerSpec{
+				APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{AppLabel: &appLabel},
+				System: &appsv1alpha1.SystemSpec{
+					MasterRoute: &appsv1alpha1.SystemMasterRouteSpec{Enabled: &enabled},
+				},
+			},
+			Status: appsv1alpha1.APIManagerStatus{MasterEndpoint: endpoint},
+		}
+	}
+
+	internalEndpoint := MasterInternalEndpoint(namespace)
+
+	t.Run("enabled reports the master route", func(subT *testing.T) {
+		apimanager := newAPIManager(true, "")
+		masterRouteReconciler, cl := newReconciler(subT, apimanager,
+			route("zync-3scale-master", "master.example.com", component.SystemMasterServiceName),
+		)
+
+		_, err := masterRouteReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.MasterEndpoint != "https://master.example.com" {
+			subT.Errorf("unexpected master endpoint: '%s'", apimanager.Status.MasterEndpoint)
+		}
+
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: MasterRouteResyncJobName(apimanager.Name), Namespace: namespace}, &batchv1.Job{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("unexpected resync job: %v", err)
+		}
+	})
+
+	t.Run("disabled removes the master route", func(subT *testing.T) {
+		apimanager := newAPIManager(false, "https://master.example.com")
+		masterRouteReconciler, cl := newReconciler(subT, apimanager,
+			route("zync-3scale-master", "master.example.com", component.SystemMasterServiceName),
+			route("zync-3scale-provider", "3scale-admin.example.com", "system-provider"),
+		)
+
+		_, err := masterRouteReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.MasterEndpoint != internalEndpoint {
+			subT.Errorf("unexpected master endpoint: '%s'", apimanager.Status.MasterEndpoint)
+		}
+
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: "zync-3scale-master", Namespace: namespace}, &routev1.Route{})
+		if !errors.IsNotFound(err) {
+			subT.Errorf("expected master route to be removed: %v", err)
+		}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: "zync-3scale-provider", Namespace: namespace}, &routev1.Route{})
+		if err != nil {
+			subT.Errorf("expected provider route to be kept: %v", err)
+		}
+	})
+
+	t.Run("re-enabled resyncs zync domains", func(subT *testing.T) {
+		apimanager := newAPIManager(true, internalEndpoint)
+		masterRouteReconciler, cl := newReconciler(subT, apimanager, sidekiqDC.DeepCopy())
+
+		res, err := masterRouteReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if res.RequeueAfter == 0 {
+			subT.Error("expected requeue")
+		}
+		if apimanager.Status.MasterEndpoint != internalEndpoint {
+			subT.Fatal("expected the status to wait for the zync resync job")
+		}
+
+		job := &batchv1.Job{}
+		err = cl.Get(context.TODO(), types.NamespacedName{Name: MasterRouteResyncJobName(apimanager.Name), Namespace: namespace}, job)
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		job.Status.Succeeded = 1
+		if err := cl.Update(context.TODO(), job); err != nil {
+			subT.Fatal(err)
+		}
+		if err := cl.Create(context.TODO(), route("zync-3scale-master", "master.example.com", component.SystemMasterServiceName)); err != nil {
+			subT.Fatal(err)
+		}
+
+		_, err = masterRouteReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if apimanager.Status.MasterEndpoint != "https://master.example.com" {
+			subT.Errorf("unexpected master endpoint: '%s'", apimanager.Status.MasterEndpoint)
+		}
+	})
+}
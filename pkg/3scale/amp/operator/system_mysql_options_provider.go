@@ -46,6 +46,10 @@ func (s *SystemMysqlOptionsProvider) GetMysqlOptions() (*component.SystemMysqlOp
 	s.setResourceRequirementsOptions()
 	s.setPersistentVolumeClaimOptions()
 	s.setNodeAffinityAndTolerationsOptions()
+	s.setSharedMemoryOptions()
+	s.setSecurityContextOptions()
+	s.setPriorityClassNameOptions()
+	s.setImagePullPolicyOptions()
 
 	err = s.mysqlOptions.Validate()
 	if err != nil {
@@ -162,6 +166,8 @@ func (s *SystemMysqlOptionsProvider) setPersistentVolumeClaimOptions() {
 
 		s.mysqlOptions.PVCStorageClass = s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.StorageClassName
 		volumeName = s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.VolumeName
+		s.mysqlOptions.PVCAnnotations = s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.Annotations
+		s.mysqlOptions.PVCLabels = s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.Labels
 		if s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.Resources != nil {
 			storageRequests = s.apimanager.Spec.System.DatabaseSpec.MySQL.PersistentVolumeClaimSpec.Resources.Requests
 		}
@@ -172,10 +178,37 @@ func (s *SystemMysqlOptionsProvider) setPersistentVolumeClaimOptions() {
 }
 
 func (s *SystemMysqlOptionsProvider) setNodeAffinityAndTolerationsOptions() {
+	var tolerations []v1.Toleration
 	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.MySQL != nil {
+		s.mysqlOptions.NodeSelector = s.apimanager.Spec.System.DatabaseSpec.MySQL.NodeSelector
 		s.mysqlOptions.Affinity = s.apimanager.Spec.System.DatabaseSpec.MySQL.Affinity
-		s.mysqlOptions.Tolerations = s.apimanager.Spec.System.DatabaseSpec.MySQL.Tolerations
+		tolerations = s.apimanager.Spec.System.DatabaseSpec.MySQL.Tolerations
+		s.mysqlOptions.TopologySpreadConstraints = s.apimanager.Spec.System.DatabaseSpec.MySQL.TopologySpreadConstraints
 	}
+	s.mysqlOptions.Tolerations = componentTolerations(s.apimanager, tolerations, nil)
+}
+
+func (s *SystemMysqlOptionsProvider) setSharedMemoryOptions() {
+	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.MySQL != nil {
+		s.mysqlOptions.SharedMemorySizeLimit = s.apimanager.Spec.System.DatabaseSpec.MySQL.SharedMemorySizeLimit
+	}
+}
+
+func (s *SystemMysqlOptionsProvider) setSecurityContextOptions() {
+	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.MySQL != nil {
+		mysqlSpec := s.apimanager.Spec.System.DatabaseSpec.MySQL
+		s.mysqlOptions.SecurityContext = securityContextOptions(mysqlSpec.PodSecurityContext, mysqlSpec.SecurityContext)
+	}
+}
+
+func (s *SystemMysqlOptionsProvider) setPriorityClassNameOptions() {
+	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.MySQL != nil {
+		s.mysqlOptions.PriorityClassName = helper.GetStringPointerValueOrDefault(s.apimanager.Spec.System.DatabaseSpec.MySQL.PriorityClassName, "")
+	}
+}
+
+func (s *SystemMysqlOptionsProvider) setImagePullPolicyOptions() {
+	s.mysqlOptions.ImagePullPolicy = s.apimanager.Spec.ImagePullPolicy
 }
 
 func (s *SystemMysqlOptionsProvider) commonLabels() map[string]string {
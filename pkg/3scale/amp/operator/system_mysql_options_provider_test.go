@@ -179,7 +179,9 @@ func TestGetMysqlOptionsProvider(t *testing.T) {
 				apimanager := basicApimanager()
 				apimanager.Spec.System.DatabaseSpec = &appsv1alpha1.SystemDatabaseSpec{
 					MySQL: &appsv1alpha1.SystemMySQLSpec{
-						Affinity: testSystemMySQLAffinity(),
+						PodPlacementSpec: appsv1alpha1.PodPlacementSpec{
+							Affinity: testSystemMySQLAffinity(),
+						},
 					},
 				}
 				return apimanager
@@ -196,7 +198,9 @@ func TestGetMysqlOptionsProvider(t *testing.T) {
 				apimanager.Spec.System = &appsv1alpha1.SystemSpec{
 					DatabaseSpec: &appsv1alpha1.SystemDatabaseSpec{
 						MySQL: &appsv1alpha1.SystemMySQLSpec{
-							Tolerations: testSystemMySQLTolerations(),
+							PodPlacementSpec: appsv1alpha1.PodPlacementSpec{
+								Tolerations: testSystemMySQLTolerations(),
+							},
 						},
 					},
 				}
@@ -208,6 +212,24 @@ func TestGetMysqlOptionsProvider(t *testing.T) {
 				return expecteOpts
 			},
 		},
+		{"WithSharedMemorySizeLimit", nil,
+			func() *appsv1alpha1.APIManager {
+				sizeLimit := resource.MustParse("256Mi")
+				apimanager := basicApimanager()
+				apimanager.Spec.System.DatabaseSpec = &appsv1alpha1.SystemDatabaseSpec{
+					MySQL: &appsv1alpha1.SystemMySQLSpec{
+						SharedMemorySizeLimit: &sizeLimit,
+					},
+				}
+				return apimanager
+			},
+			func(opts *component.SystemMysqlOptions) *component.SystemMysqlOptions {
+				sizeLimit := resource.MustParse("256Mi")
+				expecteOpts := defaultSystemMysqlOptions(opts)
+				expecteOpts.SharedMemorySizeLimit = &sizeLimit
+				return expecteOpts
+			},
+		},
 		{"WithSystemMySQLCustomResourceRequirements", nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanager()
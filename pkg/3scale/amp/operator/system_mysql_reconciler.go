@@ -3,6 +3,7 @@ package operator
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -29,8 +30,13 @@ func (r *SystemMySQLReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		sharedMemoryVolumeMutator,
 	)
 	err = r.ReconcileDeploymentConfig(systemMySQL.DeploymentConfig(), dcMutator)
 	if err != nil {
@@ -56,7 +62,7 @@ func (r *SystemMySQLReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// PCV
-	err = r.ReconcilePersistentVolumeClaim(systemMySQL.PersistentVolumeClaim(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcilePersistentVolumeClaimStorage(systemMySQL.PersistentVolumeClaim())
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -71,10 +77,13 @@ func (r *SystemMySQLReconciler) Reconcile() (reconcile.Result, error) {
 }
 
 func SystemMySQL(apimanager *appsv1alpha1.APIManager, client client.Client) (*component.SystemMysql, error) {
-	optsProvider := NewSystemMysqlOptionsProvider(apimanager, apimanager.Namespace, client)
-	opts, err := optsProvider.GetMysqlOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(apimanager, "system-mysql", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewSystemMysqlOptionsProvider(apimanager, apimanager.Namespace, client)
+		opts, err := optsProvider.GetMysqlOptions()
+		return opts, optsProvider.secretSource, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return component.NewSystemMysql(opts), nil
+	return component.NewSystemMysql(opts.(*component.SystemMysqlOptions)), nil
 }
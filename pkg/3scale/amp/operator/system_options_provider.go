@@ -57,12 +57,29 @@ func (s *SystemOptionsProvider) GetSystemOptions() (*component.SystemOptions, er
 
 	s.setResourceRequirementsOptions()
 	s.setNodeAffinityAndTolerationsOptions()
+	s.setCustomLabelsAndAnnotationsOptions()
+	s.setPodTemplateOverridesOptions()
+	s.setSecurityContextOptions()
+	s.setProbesOptions()
+	s.setRuntimeTuningOptions()
+	s.setExtraEnvOptions()
+	s.setSidecarsOptions()
+	s.setInitContainersOptions()
+	s.setTerminationOptions()
 	s.setFileStorageOptions()
 	s.setReplicas()
-
-	s.options.SideKiqMetrics = true
-	s.options.AppMetrics = true
+	s.setPodDisruptionBudgetOptions()
+	s.setCacheStore()
+
+	s.options.SideKiqMetrics = s.apimanager.IsComponentMetricsEnabled("system")
+	s.options.AppMetrics = s.apimanager.IsComponentMetricsEnabled("system")
+	s.options.Statsd = statsdOptions(s.apimanager)
+	s.options.CORS = systemCORSOptions(s.apimanager)
+	s.options.DeveloperPortalDisabled = !s.apimanager.IsSystemDeveloperPortalEnabled()
+	s.options.SystemRedisCASecretName = s.apimanager.SystemRedisCASecretName()
+	s.options.BackendRedisCASecretName = s.apimanager.BackendRedisCASecretName()
 	s.options.IncludeOracleOptionalSettings = true
+	s.options.GrafanaDashboard = grafanaDashboardOptions(s.apimanager)
 
 	s.options.Namespace = s.namespace
 
@@ -115,6 +132,22 @@ func (s *SystemOptionsProvider) setSecretBasedOptions() error {
 		return err
 	}
 
+	err = s.setExternalZyncOptions()
+	if err != nil {
+		return fmt.Errorf("unable to create System external zync options - %s", err)
+	}
+
+	err = s.setExternalBackendOptions()
+	if err != nil {
+		return fmt.Errorf("unable to create System external backend options - %s", err)
+	}
+
+	err = s.setInboundEmailOptions()
+	if err != nil {
+		// Wrapped to keep the missing secret wait error
+		return fmt.Errorf("unable to create System inbound email options - %w", err)
+	}
+
 	return nil
 }
 
@@ -131,6 +164,53 @@ func (s *SystemOptionsProvider) setSystemMemcachedOptions() error {
 	return nil
 }
 
+func (s *SystemOptionsProvider) setExternalZyncOptions() error {
+	if !s.apimanager.IsExternalZync() {
+		return nil
+	}
+
+	externalZyncSpec := s.apimanager.Spec.Zync.ExternalZync
+	// The token is read so a missing secret is reported before system is rolled out
+	_, err := s.secretSource.RequiredFieldValueFromRequiredSecret(
+		externalZyncSpec.SecretRef.Name,
+		component.ZyncSecretAuthenticationTokenFieldName)
+	if err != nil {
+		return err
+	}
+
+	s.options.ExternalZync = &component.SystemExternalZyncOptions{
+		Endpoint:   externalZyncSpec.Endpoint,
+		SecretName: externalZyncSpec.SecretRef.Name,
+	}
+
+	return nil
+}
+
+func (s *SystemOptionsProvider) setExternalBackendOptions() error {
+	if !s.apimanager.IsExternalBackend() {
+		return nil
+	}
+
+	externalBackendSpec := s.apimanager.Spec.ExternalBackend
+	// The credentials are read so a missing secret is reported before system is rolled out
+	for _, field := range []string{
+		component.BackendSecretInternalApiUsernameFieldName,
+		component.BackendSecretInternalApiPasswordFieldName,
+	} {
+		_, err := s.secretSource.RequiredFieldValueFromRequiredSecret(externalBackendSpec.InternalAPISecretRef.Name, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.options.ExternalBackend = &component.SystemExternalBackendOptions{
+		RouteEndpoint:         s.apimanager.ExternalBackendRouteEndpoint(),
+		InternalAPISecretName: externalBackendSpec.InternalAPISecretRef.Name,
+	}
+
+	return nil
+}
+
 func (s *SystemOptionsProvider) setSystemRecaptchaOptions() error {
 	recaptchaPublicKey, err := s.secretSource.FieldValue(
 		component.SystemSecretSystemRecaptchaSecretName,
@@ -361,10 +441,7 @@ func (s *SystemOptionsProvider) setSystemSMTPOptions() error {
 }
 
 func (s *SystemOptionsProvider) setBackendOptions() error {
-	rawURL, err := s.secretSource.FieldValue(
-		component.BackendSecretBackendListenerSecretName,
-		component.BackendSecretBackendListenerServiceEndpointFieldName,
-		component.DefaultBackendServiceEndpoint())
+	rawURL, err := s.backendServiceEndpoint()
 	if err != nil {
 		return err
 	}
@@ -383,6 +460,19 @@ func (s *SystemOptionsProvider) setBackendOptions() error {
 	return nil
 }
 
+// backendServiceEndpoint returns the listener endpoint of the external backend
+// or the service endpoint of the backend-listener secret
+func (s *SystemOptionsProvider) backendServiceEndpoint() (string, error) {
+	if s.apimanager.IsExternalBackend() {
+		return s.apimanager.Spec.ExternalBackend.ListenerEndpoint, nil
+	}
+
+	return s.secretSource.FieldValue(
+		component.BackendSecretBackendListenerSecretName,
+		component.BackendSecretBackendListenerServiceEndpointFieldName,
+		component.DefaultBackendServiceEndpoint())
+}
+
 func (s *SystemOptionsProvider) setResourceRequirementsOptions() {
 	if *s.apimanager.Spec.ResourceRequirementsEnabled {
 		s.options.AppMasterContainerResourceRequirements = component.DefaultAppMasterContainerResourceRequirements()
@@ -420,11 +510,70 @@ func (s *SystemOptionsProvider) setResourceRequirementsOptions() {
 
 func (s *SystemOptionsProvider) setNodeAffinityAndTolerationsOptions() {
 	s.options.AppAffinity = s.apimanager.Spec.System.AppSpec.Affinity
-	s.options.AppTolerations = s.apimanager.Spec.System.AppSpec.Tolerations
+	s.options.AppTolerations = componentTolerations(s.apimanager, s.apimanager.Spec.System.AppSpec.Tolerations, s.apimanager.Spec.System.AppSpec.UnreachableTolerationSeconds)
 	s.options.SidekiqAffinity = s.apimanager.Spec.System.SidekiqSpec.Affinity
-	s.options.SidekiqTolerations = s.apimanager.Spec.System.SidekiqSpec.Tolerations
+	s.options.SidekiqTolerations = componentTolerations(s.apimanager, s.apimanager.Spec.System.SidekiqSpec.Tolerations, s.apimanager.Spec.System.SidekiqSpec.UnreachableTolerationSeconds)
 	s.options.SphinxAffinity = s.apimanager.Spec.System.SphinxSpec.Affinity
-	s.options.SphinxTolerations = s.apimanager.Spec.System.SphinxSpec.Tolerations
+	s.options.SphinxTolerations = componentTolerations(s.apimanager, s.apimanager.Spec.System.SphinxSpec.Tolerations, s.apimanager.Spec.System.SphinxSpec.UnreachableTolerationSeconds)
+}
+
+// setCustomLabelsAndAnnotationsOptions sets the custom labels and annotations of the
+// component. The custom labels are already part of the pod template labels
+func (s *SystemOptionsProvider) setCustomLabelsAndAnnotationsOptions() {
+	s.options.AppCustomLabels = s.apimanager.Spec.System.AppSpec.Labels
+	s.options.AppCustomAnnotations = s.apimanager.Spec.System.AppSpec.Annotations
+	s.options.SidekiqCustomAnnotations = s.apimanager.Spec.System.SidekiqSpec.Annotations
+	s.options.SphinxCustomLabels = s.apimanager.Spec.System.SphinxSpec.Labels
+	s.options.SphinxCustomAnnotations = s.apimanager.Spec.System.SphinxSpec.Annotations
+}
+
+func (s *SystemOptionsProvider) setPodTemplateOverridesOptions() {
+	s.options.AppPodTemplateOverrides = podTemplateOverridesOptions(s.apimanager, &s.apimanager.Spec.System.AppSpec.PodTemplateOverridesSpec)
+	s.options.SidekiqPodTemplateOverrides = podTemplateOverridesOptions(s.apimanager, &s.apimanager.Spec.System.SidekiqSpec.PodTemplateOverridesSpec)
+	s.options.SphinxPodTemplateOverrides = podTemplateOverridesOptions(s.apimanager, &s.apimanager.Spec.System.SphinxSpec.PodTemplateOverridesSpec)
+}
+
+func (s *SystemOptionsProvider) setSecurityContextOptions() {
+	appSpec := s.apimanager.Spec.System.AppSpec
+	s.options.AppSecurityContext = securityContextOptions(appSpec.PodSecurityContext, appSpec.SecurityContext)
+	sidekiqSpec := s.apimanager.Spec.System.SidekiqSpec
+	s.options.SidekiqSecurityContext = securityContextOptions(sidekiqSpec.PodSecurityContext, sidekiqSpec.SecurityContext)
+	sphinxSpec := s.apimanager.Spec.System.SphinxSpec
+	s.options.SphinxSecurityContext = securityContextOptions(sphinxSpec.PodSecurityContext, sphinxSpec.SecurityContext)
+}
+
+func (s *SystemOptionsProvider) setProbesOptions() {
+	s.options.AppProbes = probesOptions(s.apimanager.Spec.System.AppSpec.Probes)
+	s.options.SphinxProbes = probesOptions(s.apimanager.Spec.System.SphinxSpec.Probes)
+}
+
+func (s *SystemOptionsProvider) setRuntimeTuningOptions() {
+	s.options.AppRuntimeTuning = rubyRuntimeTuningOptions(s.apimanager.Spec.System.AppSpec.RuntimeTuning)
+	s.options.SidekiqRuntimeTuning = rubyRuntimeTuningOptions(s.apimanager.Spec.System.SidekiqSpec.RuntimeTuning)
+}
+
+func (s *SystemOptionsProvider) setExtraEnvOptions() {
+	s.options.AppExtraEnv = s.apimanager.Spec.System.AppSpec.Env
+	s.options.SidekiqExtraEnv = s.apimanager.Spec.System.SidekiqSpec.Env
+	s.options.SphinxExtraEnv = s.apimanager.Spec.System.SphinxSpec.Env
+}
+
+func (s *SystemOptionsProvider) setSidecarsOptions() {
+	s.options.AppSidecars = sidecarsOptions(s.apimanager.Spec.System.AppSpec.Sidecars, s.apimanager.Spec.System.AppSpec.SidecarVolumes)
+	s.options.SidekiqSidecars = sidecarsOptions(s.apimanager.Spec.System.SidekiqSpec.Sidecars, s.apimanager.Spec.System.SidekiqSpec.SidecarVolumes)
+	s.options.SphinxSidecars = sidecarsOptions(s.apimanager.Spec.System.SphinxSpec.Sidecars, s.apimanager.Spec.System.SphinxSpec.SidecarVolumes)
+}
+
+func (s *SystemOptionsProvider) setInitContainersOptions() {
+	s.options.AppInitContainers = initContainersOptions(nil, s.apimanager.Spec.System.AppSpec.InitContainers)
+	s.options.SidekiqInitContainers = initContainersOptions(s.apimanager.Spec.System.SidekiqSpec.WaitInitContainer, s.apimanager.Spec.System.SidekiqSpec.InitContainers)
+	s.options.SphinxInitContainers = initContainersOptions(s.apimanager.Spec.System.SphinxSpec.WaitInitContainer, s.apimanager.Spec.System.SphinxSpec.InitContainers)
+}
+
+func (s *SystemOptionsProvider) setTerminationOptions() {
+	s.options.AppTermination = terminationOptions(s.apimanager.Spec.System.AppSpec.TerminationGracePeriodSeconds, s.apimanager.Spec.System.AppSpec.PreStopCommand)
+	s.options.SidekiqTermination = terminationOptions(s.apimanager.Spec.System.SidekiqSpec.TerminationGracePeriodSeconds, s.apimanager.Spec.System.SidekiqSpec.PreStopCommand)
+	s.options.SphinxTermination = terminationOptions(s.apimanager.Spec.System.SphinxSpec.TerminationGracePeriodSeconds, s.apimanager.Spec.System.SphinxSpec.PreStopCommand)
 }
 
 func (s *SystemOptionsProvider) setFileStorageOptions() {
@@ -438,12 +587,16 @@ func (s *SystemOptionsProvider) setFileStorageOptions() {
 		// default to PVC
 		var storageClassName *string
 		var volumeName *string
+		var annotations map[string]string
+		var labels map[string]string
 		storageRequests := component.DefaultSharedStorageResources()
 		if s.apimanager.Spec.System != nil &&
 			s.apimanager.Spec.System.FileStorageSpec != nil &&
 			s.apimanager.Spec.System.FileStorageSpec.PVC != nil {
 			storageClassName = s.apimanager.Spec.System.FileStorageSpec.PVC.StorageClassName
 			volumeName = s.apimanager.Spec.System.FileStorageSpec.PVC.VolumeName
+			annotations = s.apimanager.Spec.System.FileStorageSpec.PVC.Annotations
+			labels = s.apimanager.Spec.System.FileStorageSpec.PVC.Labels
 			if s.apimanager.Spec.System.FileStorageSpec.PVC.Resources != nil {
 				storageRequests = s.apimanager.Spec.System.FileStorageSpec.PVC.Resources.Requests
 			}
@@ -453,15 +606,35 @@ func (s *SystemOptionsProvider) setFileStorageOptions() {
 			StorageClass:    storageClassName,
 			VolumeName:      volumeName,
 			StorageRequests: storageRequests,
+			Annotations:     annotations,
+			Labels:          labels,
 		}
 	}
 }
 
 func (s *SystemOptionsProvider) setReplicas() {
-	appSecReplicas := int32(*s.apimanager.Spec.System.AppSpec.Replicas)
-	s.options.AppReplicas = &appSecReplicas
-	sidekiqReplicas := int32(*s.apimanager.Spec.System.SidekiqSpec.Replicas)
+	appReplicas, appReplicasManaged := replicasOptions(s.apimanager.Spec.System.AppSpec.Replicas)
+	s.options.AppReplicas = &appReplicas
+	s.options.AppReplicasManaged = appReplicasManaged
+	sidekiqReplicas, sidekiqReplicasManaged := replicasOptions(s.apimanager.Spec.System.SidekiqSpec.Replicas)
 	s.options.SidekiqReplicas = &sidekiqReplicas
+	s.options.SidekiqReplicasManaged = sidekiqReplicasManaged
+}
+
+func (s *SystemOptionsProvider) setPodDisruptionBudgetOptions() {
+	pdbSpec := s.apimanager.Spec.PodDisruptionBudget
+	if pdbSpec == nil {
+		return
+	}
+	s.options.AppPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.SystemApp)
+	s.options.SidekiqPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.SystemSidekiq)
+}
+
+func (s *SystemOptionsProvider) setCacheStore() {
+	s.options.CacheStore = component.SystemCacheStoreMemcached
+	if s.apimanager.Spec.System.CacheStore != nil {
+		s.options.CacheStore = *s.apimanager.Spec.System.CacheStore
+	}
 }
 
 func (s *SystemOptionsProvider) commonLabels() map[string]string {
@@ -478,7 +651,8 @@ func (s *SystemOptionsProvider) commonAppLabels() map[string]string {
 }
 
 func (s *SystemOptionsProvider) appPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("system-app", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(s.apimanager.Spec.System.AppSpec.Labels, helper.MeteringLabels("system-app", helper.ApplicationType))
 
 	for k, v := range s.commonAppLabels() {
 		labels[k] = v
@@ -496,7 +670,8 @@ func (s *SystemOptionsProvider) commonSidekiqLabels() map[string]string {
 }
 
 func (s *SystemOptionsProvider) sidekiqPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("system-sidekiq", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(s.apimanager.Spec.System.SidekiqSpec.Labels, helper.MeteringLabels("system-sidekiq", helper.ApplicationType))
 
 	for k, v := range s.commonSidekiqLabels() {
 		labels[k] = v
@@ -544,7 +719,8 @@ func (s *SystemOptionsProvider) smtpLabels() map[string]string {
 }
 
 func (s *SystemOptionsProvider) sphinxPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("system-sphinx", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(s.apimanager.Spec.System.SphinxSpec.Labels, helper.MeteringLabels("system-sphinx", helper.ApplicationType))
 
 	for k, v := range s.sphinxLabels() {
 		labels[k] = v
@@ -324,6 +324,7 @@ func defaultSystemOptions(opts *component.SystemOptions) *component.SystemOption
 		SidekiqContainerResourceRequirements:      component.DefaultSidekiqContainerResourceRequirements(),
 		SphinxContainerResourceRequirements:       component.DefaultSphinxContainerResourceRequirements(),
 		MemcachedServers:                          component.DefaultMemcachedServers(),
+		CacheStore:                                component.SystemCacheStoreMemcached,
 		RecaptchaPublicKey:                        &recaptchaPublicKey,
 		RecaptchaPrivateKey:                       &recaptchaPrivateKey,
 		BackendSharedSecret:                       opts.BackendSharedSecret,
@@ -339,6 +340,8 @@ func defaultSystemOptions(opts *component.SystemOptions) *component.SystemOption
 		ApicastAccessToken:                        opts.ApicastAccessToken,
 		AppReplicas:                               &tmpSystemAppReplicas,
 		SidekiqReplicas:                           &tmpSystemSideKiqReplicas,
+		AppReplicasManaged:                        true,
+		SidekiqReplicasManaged:                    true,
 		AdminEmail:                                &tmpSystemAdminEmail,
 		UserSessionTTL:                            &tmpSystemUserSessionTTL,
 		PvcFileStorageOptions: &component.PVCFileStorageOptions{
@@ -371,6 +374,7 @@ func defaultSystemOptions(opts *component.SystemOptions) *component.SystemOption
 		IncludeOracleOptionalSettings: true,
 		BackendServiceEndpoint:        fmt.Sprintf("%s%s", component.DefaultBackendServiceEndpoint(), "/internal/"),
 		Namespace:                     opts.Namespace,
+		GrafanaDashboard:              component.DefaultGrafanaDashboardOptions(),
 	}
 
 	expectedOpts.ApicastSystemMasterProxyConfigEndpoint = component.DefaultApicastSystemMasterProxyConfigEndpoint(opts.ApicastAccessToken)
@@ -422,6 +426,19 @@ func TestGetSystemOptionsProvider(t *testing.T) {
 				return expectedOpts
 			},
 		},
+		{"WithRedisCacheStore",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestSystemOptions()
+				cacheStore := component.SystemCacheStoreRedis
+				apimanager.Spec.System.CacheStore = &cacheStore
+				return apimanager
+			}, nil, nil, nil, nil, nil, nil, nil,
+			func(opts *component.SystemOptions) *component.SystemOptions {
+				expectedOpts := defaultSystemOptions(opts)
+				expectedOpts.CacheStore = component.SystemCacheStoreRedis
+				return expectedOpts
+			},
+		},
 		{"WithRecaptchaSecret", basicApimanagerSpecTestSystemOptions,
 			nil, getRecaptchaSecret(), nil, nil, nil, nil, nil,
 			func(opts *component.SystemOptions) *component.SystemOptions {
@@ -553,6 +570,46 @@ func TestGetSystemOptionsProvider(t *testing.T) {
 				return expectedOpts
 			},
 		},
+		{"WithTopologySpreadConstraints",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestSystemOptions()
+				apimanager.Spec.System.AppSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-app")
+				apimanager.Spec.System.SidekiqSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-sidekiq")
+				apimanager.Spec.System.SphinxSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-sphinx")
+				return apimanager
+			}, nil, nil, nil, nil, nil, nil, nil,
+			func(opts *component.SystemOptions) *component.SystemOptions {
+				expectedOpts := defaultSystemOptions(opts)
+				expectedOpts.AppPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-app")
+				expectedOpts.SidekiqPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-sidekiq")
+				expectedOpts.SphinxPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("system-sphinx")
+				return expectedOpts
+			},
+		},
+		{"WithCustomLabelsAndAnnotations",
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestSystemOptions()
+				apimanager.Spec.System.AppSpec.Labels = getTestCustomLabels("system-app")
+				apimanager.Spec.System.AppSpec.Annotations = getTestCustomAnnotations("system-app")
+				apimanager.Spec.System.SidekiqSpec.Labels = getTestCustomLabels("system-sidekiq")
+				apimanager.Spec.System.SidekiqSpec.Annotations = getTestCustomAnnotations("system-sidekiq")
+				apimanager.Spec.System.SphinxSpec.Labels = getTestCustomLabels("system-sphinx")
+				apimanager.Spec.System.SphinxSpec.Annotations = getTestCustomAnnotations("system-sphinx")
+				return apimanager
+			}, nil, nil, nil, nil, nil, nil, nil,
+			func(opts *component.SystemOptions) *component.SystemOptions {
+				expectedOpts := defaultSystemOptions(opts)
+				expectedOpts.AppCustomLabels = getTestCustomLabels("system-app")
+				expectedOpts.AppCustomAnnotations = getTestCustomAnnotations("system-app")
+				expectedOpts.AppPodTemplateLabels["custom-label"] = "system-app"
+				expectedOpts.SidekiqCustomAnnotations = getTestCustomAnnotations("system-sidekiq")
+				expectedOpts.SidekiqPodTemplateLabels["custom-label"] = "system-sidekiq"
+				expectedOpts.SphinxCustomLabels = getTestCustomLabels("system-sphinx")
+				expectedOpts.SphinxCustomAnnotations = getTestCustomAnnotations("system-sphinx")
+				expectedOpts.SphinxPodTemplateLabels["custom-label"] = "system-sphinx"
+				return expectedOpts
+			},
+		},
 		{"WithSystemCustomResourceRequirements",
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerSpecTestSystemOptions()
@@ -45,6 +45,9 @@ func (s *SystemPostgresqlOptionsProvider) GetSystemPostgreSQLOptions() (*compone
 	s.setResourceRequirementsOptions()
 	s.setPersistentVolumeClaimOptions()
 	s.setNodeAffinityAndTolerationsOptions()
+	s.setSecurityContextOptions()
+	s.setPriorityClassNameOptions()
+	s.setImagePullPolicyOptions()
 
 	err = s.options.Validate()
 	if err != nil {
@@ -149,6 +152,8 @@ func (s *SystemPostgresqlOptionsProvider) setPersistentVolumeClaimOptions() {
 
 		s.options.PVCStorageClass = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.StorageClassName
 		volumeName = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.VolumeName
+		s.options.PVCAnnotations = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.Annotations
+		s.options.PVCLabels = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.Labels
 		if s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.Resources != nil {
 			storageRequests = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PersistentVolumeClaimSpec.Resources.Requests
 		}
@@ -159,10 +164,31 @@ func (s *SystemPostgresqlOptionsProvider) setPersistentVolumeClaimOptions() {
 }
 
 func (s *SystemPostgresqlOptionsProvider) setNodeAffinityAndTolerationsOptions() {
+	var tolerations []v1.Toleration
 	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.PostgreSQL != nil {
+		s.options.NodeSelector = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.NodeSelector
 		s.options.Affinity = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.Affinity
-		s.options.Tolerations = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.Tolerations
+		tolerations = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.Tolerations
+		s.options.TopologySpreadConstraints = s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.TopologySpreadConstraints
 	}
+	s.options.Tolerations = componentTolerations(s.apimanager, tolerations, nil)
+}
+
+func (s *SystemPostgresqlOptionsProvider) setSecurityContextOptions() {
+	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.PostgreSQL != nil {
+		postgreSQLSpec := s.apimanager.Spec.System.DatabaseSpec.PostgreSQL
+		s.options.SecurityContext = securityContextOptions(postgreSQLSpec.PodSecurityContext, postgreSQLSpec.SecurityContext)
+	}
+}
+
+func (s *SystemPostgresqlOptionsProvider) setPriorityClassNameOptions() {
+	if s.apimanager.Spec.System.DatabaseSpec != nil && s.apimanager.Spec.System.DatabaseSpec.PostgreSQL != nil {
+		s.options.PriorityClassName = helper.GetStringPointerValueOrDefault(s.apimanager.Spec.System.DatabaseSpec.PostgreSQL.PriorityClassName, "")
+	}
+}
+
+func (s *SystemPostgresqlOptionsProvider) setImagePullPolicyOptions() {
+	s.options.ImagePullPolicy = s.apimanager.Spec.ImagePullPolicy
 }
 
 func (s *SystemPostgresqlOptionsProvider) commonLabels() map[string]string {
@@ -172,7 +172,9 @@ func TestGetSystemPostgreSQLOptionsProvider(t *testing.T) {
 				apimanager.Spec.System = &appsv1alpha1.SystemSpec{
 					DatabaseSpec: &appsv1alpha1.SystemDatabaseSpec{
 						PostgreSQL: &appsv1alpha1.SystemPostgreSQLSpec{
-							Affinity: testSystemPostgreSQLAffinity(),
+							PodPlacementSpec: appsv1alpha1.PodPlacementSpec{
+								Affinity: testSystemPostgreSQLAffinity(),
+							},
 						},
 					},
 				}
@@ -190,7 +192,9 @@ func TestGetSystemPostgreSQLOptionsProvider(t *testing.T) {
 				apimanager.Spec.System = &appsv1alpha1.SystemSpec{
 					DatabaseSpec: &appsv1alpha1.SystemDatabaseSpec{
 						PostgreSQL: &appsv1alpha1.SystemPostgreSQLSpec{
-							Tolerations: testSystemPostgreSQLTolerations(),
+							PodPlacementSpec: appsv1alpha1.PodPlacementSpec{
+								Tolerations: testSystemPostgreSQLTolerations(),
+							},
 						},
 					},
 				}
@@ -3,6 +3,7 @@ package operator
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,7 +31,11 @@ func (r *SystemPostgreSQLReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
 	)
 	err = r.ReconcileDeploymentConfig(systemPostgreSQL.DeploymentConfig(), dcMutator)
@@ -45,7 +50,7 @@ func (r *SystemPostgreSQLReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// PVC
-	err = r.ReconcilePersistentVolumeClaim(systemPostgreSQL.DataPersistentVolumeClaim(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcilePersistentVolumeClaimStorage(systemPostgreSQL.DataPersistentVolumeClaim())
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -60,10 +65,13 @@ func (r *SystemPostgreSQLReconciler) Reconcile() (reconcile.Result, error) {
 }
 
 func SystemPostgreSQL(apimanager *appsv1alpha1.APIManager, client client.Client) (*component.SystemPostgreSQL, error) {
-	optsProvider := NewSystemPostgresqlOptionsProvider(apimanager, apimanager.Namespace, client)
-	opts, err := optsProvider.GetSystemPostgreSQLOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(apimanager, "system-postgresql", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewSystemPostgresqlOptionsProvider(apimanager, apimanager.Namespace, client)
+		opts, err := optsProvider.GetSystemPostgreSQLOptions()
+		return opts, optsProvider.secretSource, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return component.NewSystemPostgreSQL(opts), nil
+	return component.NewSystemPostgreSQL(opts.(*component.SystemPostgreSQLOptions)), nil
 }
@@ -65,7 +65,11 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// Developer Service
-	err = r.ReconcileService(system.DeveloperService(), reconcilers.CreateOnlyMutator)
+	developerService := system.DeveloperService()
+	if !r.apiManager.IsSystemDeveloperPortalEnabled() {
+		common.TagObjectToDelete(developerService)
+	}
+	err = r.ReconcileService(developerService, reconcilers.CreateOnlyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -77,7 +81,11 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// Memcached Service
-	err = r.ReconcileService(system.MemcachedService(), reconcilers.CreateOnlyMutator)
+	memcachedService := system.MemcachedService()
+	if r.apiManager.IsSystemCacheStoreRedis() {
+		common.TagObjectToDelete(memcachedService)
+	}
+	err = r.ReconcileService(memcachedService, reconcilers.CreateOnlyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -85,11 +93,31 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 	// SystemApp DC
 	systemAppDCMutator := reconcilers.DeploymentConfigMutator(
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
-		reconcilers.DeploymentConfigReplicasMutator,
+		reconcilers.DeploymentConfigImageChangeTriggerContainerNamesMutator,
+		replicasMutator(system.Options.AppReplicasManaged),
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
+		reconcilers.DeploymentConfigRuntimeClassMutator,
+		reconcilers.DeploymentConfigDNSMutator,
+		reconcilers.DeploymentConfigHostAliasesMutator,
+		reconcilers.DeploymentConfigImagePullSecretsMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigPodTemplateAnnotationsMutator,
 		r.systemAppDCResourceMutator,
+		systemCacheStoreEnvVarsMutator,
+		statsdEnvVarsMutator,
+		componentMetricsMutator,
+		systemCORSMutator,
+		systemDeveloperPortalMutator,
+		systemZyncEnvVarsMutator,
+		systemBackendEnvVarsMutator,
+		systemInboundEmailMutator,
+		systemFileStorageMutator,
+		systemRedisCredentialsMutator,
 	)
 
 	err = r.ReconcileDeploymentConfig(system.AppDeploymentConfig(), systemAppDCMutator)
@@ -100,11 +128,28 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 	// Sidekiq DC
 	sidekiqDCMutator := reconcilers.DeploymentConfigMutator(
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
-		reconcilers.DeploymentConfigReplicasMutator,
+		replicasMutator(system.Options.SidekiqReplicasManaged),
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
+		reconcilers.DeploymentConfigRuntimeClassMutator,
+		reconcilers.DeploymentConfigDNSMutator,
+		reconcilers.DeploymentConfigHostAliasesMutator,
+		reconcilers.DeploymentConfigImagePullSecretsMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigPodTemplateAnnotationsMutator,
+		systemCacheStoreEnvVarsMutator,
+		statsdEnvVarsMutator,
+		componentMetricsMutator,
+		systemZyncEnvVarsMutator,
+		systemBackendEnvVarsMutator,
+		systemInboundEmailMutator,
+		systemFileStorageMutator,
+		systemRedisCredentialsMutator,
 	)
 
 	err = r.ReconcileDeploymentConfig(system.SidekiqDeploymentConfig(), sidekiqDCMutator)
@@ -117,9 +162,19 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 		reconcilers.DeploymentConfigImageChangeTriggerMutator,
 		reconcilers.DeploymentConfigContainerResourcesMutator,
 		reconcilers.DeploymentConfigAffinityMutator,
+		reconcilers.DeploymentConfigNodeSelectorMutator,
 		reconcilers.DeploymentConfigTolerationsMutator,
+		reconcilers.DeploymentConfigSecurityContextMutator,
+		reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+		reconcilers.DeploymentConfigPriorityClassMutator,
+		reconcilers.DeploymentConfigRuntimeClassMutator,
+		reconcilers.DeploymentConfigDNSMutator,
+		reconcilers.DeploymentConfigHostAliasesMutator,
+		reconcilers.DeploymentConfigImagePullSecretsMutator,
 		reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+		reconcilers.DeploymentConfigPodTemplateAnnotationsMutator,
 		upgrade.SphinxSecretKeyEnvVarMutator,
+		systemRedisCredentialsMutator,
 	)
 	err = r.ReconcileDeploymentConfig(system.SphinxDeploymentConfig(), sphinxDCmutator)
 	if err != nil {
@@ -127,13 +182,13 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// System CM
-	err = r.ReconcileConfigMap(system.SystemConfigMap(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcileConfigMap(system.SystemConfigMap(), systemConfigMapMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// System CM
-	err = r.ReconcileConfigMap(system.EnvironmentConfigMap(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcileConfigMap(system.EnvironmentConfigMap(), systemEnvironmentConfigMapMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -197,7 +252,7 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
-	err = r.ReconcilePodMonitor(system.SystemAppPodMonitor(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcilePodMonitor(system.SystemAppPodMonitor(), systemAppPodMonitorMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -217,7 +272,12 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
-	err = r.ReconcilePrometheusRules(system.SystemSidekiqPrometheusRules(), reconcilers.CreateOnlyMutator)
+	sidekiqRules := system.SystemSidekiqPrometheusRules()
+	if r.apiManager.IsStandby() {
+		// Alerts would fire on the scaled down component
+		common.TagObjectToDelete(sidekiqRules)
+	}
+	err = r.ReconcilePrometheusRules(sidekiqRules, reconcilers.CreateOnlyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -228,12 +288,16 @@ func (r *SystemReconciler) Reconcile() (reconcile.Result, error) {
 func (r *SystemReconciler) validateS3StorageProvidedConfiguration() error {
 	// Nothing for reconcile.
 	// Check all required fields exist
-	awsCredentialsSecretName := r.apiManager.Spec.System.FileStorageSpec.S3.ConfigurationSecretRef.Name
+	return validateS3ConfigurationSecret(r.apiManager.Spec.System.FileStorageSpec.S3.ConfigurationSecretRef.Name, r.apiManager.Namespace, r.Client())
+}
+
+// validateS3ConfigurationSecret checks the required fields of the S3 configuration secret
+func validateS3ConfigurationSecret(awsCredentialsSecretName, namespace string, cl client.Client) error {
 	if awsCredentialsSecretName == "" {
 		return fmt.Errorf("no aws credentials provided")
 	}
 
-	awsSecret, err := helper.GetSecret(awsCredentialsSecretName, r.apiManager.Namespace, r.Client())
+	awsSecret, err := helper.GetSecret(awsCredentialsSecretName, namespace, cl)
 	if err != nil {
 		return err
 	}
@@ -269,12 +333,13 @@ func (r *SystemReconciler) systemAppDCResourceMutator(desired, existing *appsv1.
 
 	//
 	// Check containers
+	// The developer container is only deployed when the developer portal is enabled
 	//
-	if len(desired.Spec.Template.Spec.Containers) != 3 {
-		return false, fmt.Errorf(fmt.Sprintf("%s desired spec.template.spec.containers length changed to '%d', should be 3", desiredName, len(desired.Spec.Template.Spec.Containers)))
+	if len(desired.Spec.Template.Spec.Containers) != 2 && len(desired.Spec.Template.Spec.Containers) != 3 {
+		return false, fmt.Errorf(fmt.Sprintf("%s desired spec.template.spec.containers length changed to '%d', should be 2 or 3", desiredName, len(desired.Spec.Template.Spec.Containers)))
 	}
 
-	if len(existing.Spec.Template.Spec.Containers) != 3 {
+	if len(existing.Spec.Template.Spec.Containers) != len(desired.Spec.Template.Spec.Containers) {
 		r.Logger().Info(fmt.Sprintf("%s spec.template.spec.containers length changed to '%d', recreating dc", desiredName, len(existing.Spec.Template.Spec.Containers)))
 		existing.Spec.Template.Spec.Containers = desired.Spec.Template.Spec.Containers
 		update = true
@@ -284,7 +349,7 @@ func (r *SystemReconciler) systemAppDCResourceMutator(desired, existing *appsv1.
 	// Check containers resource requirements
 	//
 
-	for idx := 0; idx < 3; idx++ {
+	for idx := range desired.Spec.Template.Spec.Containers {
 		if !helper.CmpResources(&existing.Spec.Template.Spec.Containers[idx].Resources, &desired.Spec.Template.Spec.Containers[idx].Resources) {
 			diff := cmp.Diff(existing.Spec.Template.Spec.Containers[idx].Resources, desired.Spec.Template.Spec.Containers[idx].Resources, cmpopts.IgnoreUnexported(resource.Quantity{}))
 			r.Logger().Info(fmt.Sprintf("%s spec.template.spec.containers[%d].resources have changed: %s", desiredName, idx, diff))
@@ -296,11 +361,27 @@ func (r *SystemReconciler) systemAppDCResourceMutator(desired, existing *appsv1.
 	return update, nil
 }
 
+// systemCacheStoreEnvVarsMutator switches the cache store env vars
+// of all the containers when the cache store selection changes
+func systemCacheStoreEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := false
+
+	for _, envVar := range component.SystemCacheStoreEnvVarNames {
+		tmpUpdate := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		update = update || tmpUpdate
+	}
+
+	return update, nil
+}
+
 func System(cr *appsv1alpha1.APIManager, client client.Client) (*component.System, error) {
-	optsProvider := NewSystemOptionsProvider(cr, cr.Namespace, client)
-	opts, err := optsProvider.GetSystemOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(cr, "system", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewSystemOptionsProvider(cr, cr.Namespace, client)
+		opts, err := optsProvider.GetSystemOptions()
+		return opts, optsProvider.secretSource, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return component.NewSystem(opts), nil
+	return component.NewSystem(opts.(*component.SystemOptions)), nil
 }
@@ -6,6 +6,7 @@ import (
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
@@ -16,6 +17,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
@@ -117,3 +119,203 @@ func TestSystemReconcilerCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestSystemReconcilerCacheStoreSwitch(t *testing.T) {
+	var (
+		log = logf.Log.WithName("operator_test")
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestSystemOptions()
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := configv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+
+	reconcileWithCacheStore := func(cacheStore string) {
+		apimanager.Spec.System.CacheStore = &cacheStore
+		baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+		_, err := NewSystemReconciler(baseAPIManagerLogicReconciler).Reconcile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = NewMemcachedReconciler(baseAPIManagerLogicReconciler).Reconcile()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	memcachedExists := func() bool {
+		for _, obj := range []runtime.Object{&v1.Service{}, &appsv1.DeploymentConfig{}} {
+			err := cl.Get(ctx, types.NamespacedName{Name: "system-memcache", Namespace: namespace}, obj)
+			if err == nil {
+				continue
+			}
+			if errors.IsNotFound(err) {
+				return false
+			}
+			t.Fatal(err)
+		}
+		return true
+	}
+
+	assertSystemAppEnv := func(expectedEnv, unexpectedEnv string) {
+		dc := &appsv1.DeploymentConfig{}
+		err := cl.Get(ctx, types.NamespacedName{Name: "system-app", Namespace: namespace}, dc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			if helper.FindEnvVar(container.Env, expectedEnv) < 0 {
+				t.Errorf("container %s: env var %s not found", container.Name, expectedEnv)
+			}
+			if helper.FindEnvVar(container.Env, unexpectedEnv) >= 0 {
+				t.Errorf("container %s: unexpected env var %s found", container.Name, unexpectedEnv)
+			}
+		}
+	}
+
+	reconcileWithCacheStore(component.SystemCacheStoreMemcached)
+	if !memcachedExists() {
+		t.Fatal("system-memcache should be deployed with the memcached cache store")
+	}
+	assertSystemAppEnv(component.SystemMemcacheServersEnvVarName, component.SystemCacheStoreEnvVarName)
+
+	reconcileWithCacheStore(component.SystemCacheStoreRedis)
+	if memcachedExists() {
+		t.Fatal("system-memcache should be removed with the redis cache store")
+	}
+	assertSystemAppEnv(component.SystemCacheRedisURLEnvVarName, component.SystemMemcacheServersEnvVarName)
+
+	reconcileWithCacheStore(component.SystemCacheStoreMemcached)
+	if !memcachedExists() {
+		t.Fatal("system-memcache should be redeployed when switching back to memcached cache store")
+	}
+	assertSystemAppEnv(component.SystemMemcacheServersEnvVarName, component.SystemCacheRedisURLEnvVarName)
+}
+
+func TestSystemReconcilerDeveloperPortalSwitch(t *testing.T) {
+	var (
+		log = logf.Log.WithName("operator_test")
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestSystemOptions()
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := configv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+
+	reconcileWithDeveloperPortal := func(enabled bool) {
+		apimanager.Spec.System.DeveloperPortal = &appsv1alpha1.SystemDeveloperPortalSpec{Enabled: &enabled}
+		baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+		_, err := NewSystemReconciler(baseAPIManagerLogicReconciler).Reconcile()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertDeveloperPortal := func(enabled bool) {
+		dc := &appsv1.DeploymentConfig{}
+		err := cl.Get(ctx, types.NamespacedName{Name: component.SystemAppDeploymentName, Namespace: namespace}, dc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		developerContainerFound := false
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			if container.Name == component.SystemAppDeveloperContainerName {
+				developerContainerFound = true
+			}
+			if envFound := helper.FindEnvVar(container.Env, component.SystemDeveloperPortalEnabledEnvVarName) >= 0; envFound == enabled {
+				t.Errorf("container %s: env var %s found: %t, developer portal enabled: %t", container.Name, component.SystemDeveloperPortalEnabledEnvVarName, envFound, enabled)
+			}
+		}
+		if developerContainerFound != enabled {
+			t.Errorf("developer container found: %t, developer portal enabled: %t", developerContainerFound, enabled)
+		}
+
+		for _, trigger := range dc.Spec.Triggers {
+			if trigger.ImageChangeParams != nil && len(trigger.ImageChangeParams.ContainerNames) != len(dc.Spec.Template.Spec.Containers) {
+				t.Errorf("unexpected image change trigger containers: %v", trigger.ImageChangeParams.ContainerNames)
+			}
+		}
+
+		err = cl.Get(ctx, types.NamespacedName{Name: component.SystemDeveloperServiceName, Namespace: namespace}, &v1.Service{})
+		if err != nil && !errors.IsNotFound(err) {
+			t.Fatal(err)
+		}
+		if serviceFound := err == nil; serviceFound != enabled {
+			t.Errorf("developer service found: %t, developer portal enabled: %t", serviceFound, enabled)
+		}
+	}
+
+	reconcileWithDeveloperPortal(true)
+	assertDeveloperPortal(true)
+
+	reconcileWithDeveloperPortal(false)
+	assertDeveloperPortal(false)
+
+	reconcileWithDeveloperPortal(true)
+	assertDeveloperPortal(true)
+}
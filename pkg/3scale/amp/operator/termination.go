@@ -0,0 +1,18 @@
+package operator
+
+import (
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+// terminationOptions returns the termination options of a component. Nil when neither
+// the grace period nor the preStop command are set
+func terminationOptions(gracePeriodSeconds *int64, preStopCommand []string) *component.TerminationOptions {
+	if gracePeriodSeconds == nil && len(preStopCommand) == 0 {
+		return nil
+	}
+
+	return &component.TerminationOptions{
+		GracePeriodSeconds: gracePeriodSeconds,
+		PreStopCommand:     preStopCommand,
+	}
+}
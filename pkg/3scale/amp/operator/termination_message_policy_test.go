@@ -0,0 +1,130 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// terminationMessagePolicyTestDeploymentConfigs returns the DeploymentConfigs of all the components
+func terminationMessagePolicyTestDeploymentConfigs(apimanager *appsv1alpha1.APIManager, cl client.Client) ([]*appsv1.DeploymentConfig, error) {
+	apicast, err := Apicast(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := Backend(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+	system, err := System(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+	zync, err := Zync(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+	memcached, err := Memcached(apimanager)
+	if err != nil {
+		return nil, err
+	}
+	redis, err := Redis(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+	mysql, err := SystemMySQL(apimanager, cl)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*appsv1.DeploymentConfig{
+		apicast.StagingDeploymentConfig(),
+		apicast.ProductionDeploymentConfig(),
+		backend.ListenerDeploymentConfig(),
+		backend.WorkerDeploymentConfig(),
+		backend.CronDeploymentConfig(),
+		system.AppDeploymentConfig(),
+		system.SidekiqDeploymentConfig(),
+		system.SphinxDeploymentConfig(),
+		zync.DeploymentConfig(),
+		zync.QueDeploymentConfig(),
+		zync.DatabaseDeploymentConfig(),
+		memcached.DeploymentConfig(),
+		redis.BackendDeploymentConfig(),
+		redis.SystemDeploymentConfig(),
+		mysql.DeploymentConfig(),
+	}, nil
+}
+
+func TestTerminationMessagePolicy(t *testing.T) {
+	ctx := context.TODO()
+	log := logf.Log.WithName("operator_test")
+	filePolicy := v1.TerminationMessageReadFile
+
+	apimanager := basicApimanager()
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	cl := fake.NewFakeClient([]runtime.Object{apimanager}...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, cl, log, clientset.Discovery(), recorder)
+	r := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	// The first reconciliation creates the DeploymentConfigs,
+	// the second one updates the policy of the existing ones
+	cases := []struct {
+		testName       string
+		policy         *v1.TerminationMessagePolicy
+		expectedPolicy v1.TerminationMessagePolicy
+	}{
+		{"Default", nil, v1.TerminationMessageFallbackToLogsOnError},
+		{"File", &filePolicy, v1.TerminationMessageReadFile},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			apimanager.Spec.TerminationMessagePolicy = tc.policy
+
+			desiredDCs, err := terminationMessagePolicyTestDeploymentConfigs(apimanager, cl)
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			for _, desired := range desiredDCs {
+				// The policy is reconciled regardless of the component mutator
+				if err := r.ReconcileDeploymentConfig(desired, reconcilers.CreateOnlyMutator); err != nil {
+					subT.Fatal(err)
+				}
+
+				dc := &appsv1.DeploymentConfig{}
+				if err := cl.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: apimanager.Namespace}, dc); err != nil {
+					subT.Fatal(err)
+				}
+
+				podSpec := dc.Spec.Template.Spec
+				for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+					if container.TerminationMessagePolicy != tc.expectedPolicy {
+						subT.Errorf("%s: container %s: expected policy '%s', got '%s'", dc.Name, container.Name, tc.expectedPolicy, container.TerminationMessagePolicy)
+					}
+				}
+			}
+		})
+	}
+}
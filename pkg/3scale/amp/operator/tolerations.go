@@ -0,0 +1,19 @@
+package operator
+
+import (
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// componentTolerations returns the tolerations of a component including the not-ready
+// and unreachable tolerations. The component override takes precedence over the global
+// unreachable toleration seconds
+func componentTolerations(apimanager *appsv1alpha1.APIManager, tolerations []v1.Toleration, override *int64) []v1.Toleration {
+	seconds := apimanager.Spec.UnreachableTolerationSeconds
+	if override != nil {
+		seconds = override
+	}
+	return helper.WithUnreachableTolerations(tolerations, seconds)
+}
@@ -0,0 +1,120 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (z *ZyncOptionsProvider) setDatabaseTLSOptions() error {
+	if !z.apimanager.IsZyncDatabaseTLSEnabled() {
+		return nil
+	}
+
+	databaseSpec := z.apimanager.Spec.Zync.Database
+	opts := &component.ZyncDatabaseTLSOptions{
+		SecretName: databaseSpec.SSLCASecretRef.Name,
+		SSLMode:    helper.GetStringPointerValueOrDefault(databaseSpec.SSLMode, component.DefaultZyncDatabaseSSLMode),
+	}
+
+	secret := &v1.Secret{}
+	err := z.client.Get(context.TODO(), types.NamespacedName{Name: opts.SecretName, Namespace: z.apimanager.Namespace}, secret)
+	if err == nil {
+		opts.ClientCertificate, err = zyncDatabaseTLSClientCertificate(secret)
+	}
+	if err != nil {
+		// NotFoundError is also an error, it is required to exist
+		sslCASecretRefFldPath := field.NewPath("spec").
+			Child("zync").
+			Child("database").
+			Child("sslCASecretRef")
+		fieldErrors := field.ErrorList{field.Invalid(sslCASecretRefFldPath, opts.SecretName, err.Error())}
+		return fieldErrors.ToAggregate()
+	}
+
+	h := fnv.New32a()
+	for _, key := range []string{component.ZyncDatabaseTLSCASecretKey, component.ZyncDatabaseTLSCertSecretKey, component.ZyncDatabaseTLSKeySecretKey} {
+		h.Write(secret.Data[key])
+	}
+	opts.Hash = fmt.Sprint(h.Sum32())
+
+	z.zyncOptions.DatabaseTLS = opts
+	return nil
+}
+
+// zyncDatabaseTLSClientCertificate checks the TLS secret keys and returns whether
+// it holds a client certificate
+func zyncDatabaseTLSClientCertificate(secret *v1.Secret) (bool, error) {
+	if len(secret.Data[component.ZyncDatabaseTLSCASecretKey]) == 0 {
+		return false, fmt.Errorf("Required secret key, %s not found", component.ZyncDatabaseTLSCASecretKey)
+	}
+
+	hasCert := len(secret.Data[component.ZyncDatabaseTLSCertSecretKey]) > 0
+	hasKey := len(secret.Data[component.ZyncDatabaseTLSKeySecretKey]) > 0
+	if hasCert != hasKey {
+		return false, fmt.Errorf("secret keys %s and %s must be set together", component.ZyncDatabaseTLSCertSecretKey, component.ZyncDatabaseTLSKeySecretKey)
+	}
+
+	return hasCert, nil
+}
+
+// zyncDatabaseTLSMutator reconciles the database TLS volume, env vars and secret hash
+// of the zync and zync-que pods, including the zync database wait init container
+func zyncDatabaseTLSMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	changed := reconcilers.DeploymentConfigVolumeReconciler(desired, existing, component.ZyncDatabaseTLSVolumeName)
+
+	for _, envVar := range component.ZyncDatabaseTLSEnvVarNames {
+		tmpChanged := reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, envVar)
+		changed = changed || tmpChanged
+	}
+
+	// Init containers are fully managed by the operator
+	for desiredIdx := range desired.Spec.Template.Spec.InitContainers {
+		desiredContainer := &desired.Spec.Template.Spec.InitContainers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.InitContainers {
+			existingContainer := &existing.Spec.Template.Spec.InitContainers[existingIdx]
+			if existingContainer.Name != desiredContainer.Name {
+				continue
+			}
+			if !reflect.DeepEqual(existingContainer.Env, desiredContainer.Env) {
+				existingContainer.Env = desiredContainer.Env
+				changed = true
+			}
+			if !reflect.DeepEqual(existingContainer.VolumeMounts, desiredContainer.VolumeMounts) {
+				existingContainer.VolumeMounts = desiredContainer.VolumeMounts
+				changed = true
+			}
+		}
+	}
+
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, component.ZyncDatabaseTLSHashAnnotation)
+			changed = true
+		}
+		return changed, nil
+	}
+
+	if !existingOk || existingVal != desiredVal {
+		if existing.Spec.Template.Annotations == nil {
+			existing.Spec.Template.Annotations = map[string]string{}
+		}
+		existing.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation] = desiredVal
+		changed = true
+	}
+
+	return changed, nil
+}
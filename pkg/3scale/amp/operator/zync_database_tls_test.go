@@ -0,0 +1,203 @@
+package operator
+
+import (
+	"path"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const zyncDatabaseTLSTestSecretName = "zync-database-tls"
+
+func zyncDatabaseTLSTestSecret(data map[string]string) *v1.Secret {
+	return GetTestSecret(namespace, zyncDatabaseTLSTestSecretName, data)
+}
+
+func zyncDatabaseTLSTestApimanager(sslMode *string) *appsv1alpha1.APIManager {
+	apimanager := basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions()
+	apimanager.Spec.Zync.Database = &appsv1alpha1.ZyncDatabaseSpec{
+		SSLCASecretRef: &v1.LocalObjectReference{Name: zyncDatabaseTLSTestSecretName},
+		SSLMode:        sslMode,
+	}
+	return apimanager
+}
+
+func envVarValue(envVars []v1.EnvVar, name string) (string, bool) {
+	for _, envVar := range envVars {
+		if envVar.Name == name {
+			return envVar.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestZyncDatabaseTLSOptions(t *testing.T) {
+	verifyCA := "verify-ca"
+
+	cases := []struct {
+		testName                  string
+		tlsSecret                 *v1.Secret
+		sslMode                   *string
+		expectedError             bool
+		expectedSSLMode           string
+		expectedClientCertificate bool
+	}{
+		{"CAOnly", zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "ca"}), nil, false, "verify-full", false},
+		{"ClientCertificate", zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "ca", "tls.crt": "cert", "tls.key": "key"}), &verifyCA, false, "verify-ca", true},
+		{"MissingSecret", nil, nil, true, "", false},
+		{"MissingCA", zyncDatabaseTLSTestSecret(map[string]string{"tls.crt": "cert", "tls.key": "key"}), nil, true, "", false},
+		{"CertificateWithoutKey", zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "ca", "tls.crt": "cert"}), nil, true, "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			objs := []runtime.Object{getZyncSecretExternalDatabase(namespace)}
+			if tc.tlsSecret != nil {
+				objs = append(objs, tc.tlsSecret)
+			}
+			optsProvider := NewZyncOptionsProvider(zyncDatabaseTLSTestApimanager(tc.sslMode), namespace, fake.NewFakeClient(objs...))
+			opts, err := optsProvider.GetZyncOptions()
+			if tc.expectedError {
+				if err == nil {
+					subT.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			tlsOpts := opts.DatabaseTLS
+			if tlsOpts == nil {
+				subT.Fatal("expected database TLS options")
+			}
+			if tlsOpts.SecretName != zyncDatabaseTLSTestSecretName || tlsOpts.SSLMode != tc.expectedSSLMode || tlsOpts.ClientCertificate != tc.expectedClientCertificate {
+				subT.Errorf("unexpected database TLS options: %+v", tlsOpts)
+			}
+			if tlsOpts.Hash == "" {
+				subT.Error("expected database TLS hash")
+			}
+		})
+	}
+}
+
+func TestZyncDatabaseTLSDeploymentConfigs(t *testing.T) {
+	secret := zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "ca", "tls.crt": "cert", "tls.key": "key"})
+	zync, err := Zync(zyncDatabaseTLSTestApimanager(nil), fake.NewFakeClient(getZyncSecretExternalDatabase(namespace), secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedEnv := map[string]string{
+		component.ZyncDatabaseSSLCAEnvVarName:   path.Join(component.ZyncDatabaseTLSMountPath, "ca.crt"),
+		component.ZyncDatabaseSSLCertEnvVarName: path.Join(component.ZyncDatabaseTLSMountPath, "tls.crt"),
+		component.ZyncDatabaseSSLKeyEnvVarName:  path.Join(component.ZyncDatabaseTLSMountPath, "tls.key"),
+		component.ZyncDatabaseSSLModeEnvVarName: component.DefaultZyncDatabaseSSLMode,
+	}
+
+	for _, dc := range []*appsv1.DeploymentConfig{zync.DeploymentConfig(), zync.QueDeploymentConfig()} {
+		podSpec := dc.Spec.Template.Spec
+		containers := append(podSpec.InitContainers, podSpec.Containers...)
+		for _, container := range containers {
+			for name, expected := range expectedEnv {
+				if value, ok := envVarValue(container.Env, name); !ok || value != expected {
+					t.Errorf("%s: container %s: unexpected %s env var: '%s'", dc.Name, container.Name, name, value)
+				}
+			}
+			if helper.FindVolumeMountByName(container.VolumeMounts, component.ZyncDatabaseTLSVolumeName) < 0 {
+				t.Errorf("%s: container %s: expected database TLS volume mount", dc.Name, container.Name)
+			}
+		}
+		if helper.FindVolumeByName(podSpec.Volumes, component.ZyncDatabaseTLSVolumeName) < 0 {
+			t.Errorf("%s: expected database TLS volume", dc.Name)
+		}
+		if _, ok := dc.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation]; !ok {
+			t.Errorf("%s: expected database TLS hash annotation", dc.Name)
+		}
+	}
+}
+
+func TestZyncDatabaseTLSMutator(t *testing.T) {
+	zyncSecret := getZyncSecretExternalDatabase(namespace)
+	secret := zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "ca"})
+	enabled, err := Zync(zyncDatabaseTLSTestApimanager(nil), fake.NewFakeClient(zyncSecret, secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotatedSecret := zyncDatabaseTLSTestSecret(map[string]string{"ca.crt": "rotated-ca"})
+	rotated, err := Zync(zyncDatabaseTLSTestApimanager(nil), fake.NewFakeClient(zyncSecret, rotatedSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	disabled, err := Zync(basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions(), fake.NewFakeClient(zyncSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Add", func(subT *testing.T) {
+		existing := disabled.DeploymentConfig()
+		desired := enabled.DeploymentConfig()
+		update, err := zyncDatabaseTLSMutator(desired, existing)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update {
+			subT.Error("expected zync update")
+		}
+		if helper.FindVolumeByName(existing.Spec.Template.Spec.Volumes, component.ZyncDatabaseTLSVolumeName) < 0 {
+			subT.Error("expected database TLS volume to be added")
+		}
+		if _, ok := envVarValue(existing.Spec.Template.Spec.InitContainers[0].Env, component.ZyncDatabaseSSLCAEnvVarName); !ok {
+			subT.Error("expected database TLS env vars in the init container")
+		}
+		if existing.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation] != desired.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation] {
+			subT.Error("expected database TLS hash annotation to be added")
+		}
+	})
+
+	t.Run("Unchanged", func(subT *testing.T) {
+		update, err := zyncDatabaseTLSMutator(enabled.QueDeploymentConfig(), enabled.QueDeploymentConfig())
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update {
+			subT.Error("unexpected zync-que update")
+		}
+	})
+
+	t.Run("Rotated", func(subT *testing.T) {
+		existing := enabled.QueDeploymentConfig()
+		desired := rotated.QueDeploymentConfig()
+		update, err := zyncDatabaseTLSMutator(desired, existing)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update || existing.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation] != desired.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation] {
+			subT.Error("expected zync-que to be rolled out")
+		}
+	})
+
+	t.Run("Remove", func(subT *testing.T) {
+		existing := enabled.DeploymentConfig()
+		update, err := zyncDatabaseTLSMutator(disabled.DeploymentConfig(), existing)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if _, ok := existing.Spec.Template.Annotations[component.ZyncDatabaseTLSHashAnnotation]; !update || ok {
+			subT.Error("expected database TLS hash annotation to be removed")
+		}
+		if helper.FindVolumeByName(existing.Spec.Template.Spec.Volumes, component.ZyncDatabaseTLSVolumeName) >= 0 {
+			subT.Error("expected database TLS volume to be removed")
+		}
+		if _, ok := envVarValue(existing.Spec.Template.Spec.Containers[0].Env, component.ZyncDatabaseSSLModeEnvVarName); ok {
+			subT.Error("expected database TLS env vars to be removed")
+		}
+	})
+}
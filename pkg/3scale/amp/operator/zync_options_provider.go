@@ -3,6 +3,7 @@ package operator
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
@@ -40,9 +41,29 @@ func (z *ZyncOptionsProvider) GetZyncOptions() (*component.ZyncOptions, error) {
 		return nil, fmt.Errorf("GetZyncOptions reading secret options: %w", err)
 	}
 
+	err = z.setDatabaseTLSOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	z.setResourceRequirementsOptions()
 	z.setNodeAffinityAndTolerationsOptions()
+	z.setCustomLabelsAndAnnotationsOptions()
+	z.setPodTemplateOverridesOptions()
+	z.setSecurityContextOptions()
+	z.setProbesOptions()
+	z.setRuntimeTuningOptions()
+	z.setExtraEnvOptions()
+	z.setSidecarsOptions()
+	z.setInitContainersOptions()
+	z.setTerminationOptions()
+	z.setDatabaseSharedMemoryOptions()
+	z.setDatabaseStorageOptions()
 	z.setReplicas()
+	z.setPodDisruptionBudgetOptions()
+	z.setRailsProxyOptions()
+	z.setDatabaseMaintenanceOptions()
+	z.setQueWorkerOptions()
 
 	z.zyncOptions.CommonLabels = z.commonLabels()
 	z.zyncOptions.CommonZyncLabels = z.commonZyncLabels()
@@ -52,10 +73,13 @@ func (z *ZyncOptionsProvider) GetZyncOptions() (*component.ZyncOptions, error) {
 	z.zyncOptions.ZyncQuePodTemplateLabels = z.zyncQuePodTemplateLabels()
 	z.zyncOptions.ZyncDatabasePodTemplateLabels = z.zyncDatabasePodTemplateLabels()
 
-	z.zyncOptions.ZyncMetrics = true
+	z.zyncOptions.ZyncMetrics = z.apimanager.IsComponentMetricsEnabled("zync")
 
-	z.zyncOptions.ZyncQueServiceAccountImagePullSecrets = z.zyncQueServiceAccountImagePullSecrets()
+	z.setQueServiceAccountOptions()
+	z.setQueServiceAccountImagePullSecretsOptions()
+	z.setQueServiceAccountTokenOptions()
 
+	z.zyncOptions.GrafanaDashboard = grafanaDashboardOptions(z.apimanager)
 	z.zyncOptions.Namespace = z.apimanager.Namespace
 
 	err = z.zyncOptions.Validate()
@@ -142,26 +166,42 @@ func (z *ZyncOptionsProvider) setSecretBasedOptions() error {
 }
 
 // Verify that the password field and the database url fields in the zync secret
-// contain the same value
+// contain the same value. Query parameters of the url, like sslmode, are ignored.
+// The password part of the url is compared once percent-decoded.
+// The schema search path of an external database is passed to zync
 func (z *ZyncOptionsProvider) validateZyncDatabaseURLAndPasswordFieldsConsistency() error {
-	zyncDatabaseURL, err := url.Parse(z.zyncOptions.DatabaseURL)
+	zyncDatabaseURL, err := component.ParseZyncDatabaseURL(z.zyncOptions.DatabaseURL)
 	if err != nil {
-		return fmt.Errorf("GetZyncOptions: error parsing provided '%s' field in '%s' secret: %w", component.ZyncSecretName, component.ZyncSecretDatabaseURLFieldName, err)
+		return fmt.Errorf("GetZyncOptions: error parsing provided '%s' field in '%s' secret: %w", component.ZyncSecretDatabaseURLFieldName, component.ZyncSecretName, err)
 	}
-	zyncDatabaseURLUserInfo := zyncDatabaseURL.User
-	if zyncDatabaseURLUserInfo == nil {
-		return fmt.Errorf("GetZyncOptions: '%s' field in '%s' secret doesn't have required password part", component.ZyncSecretName, component.ZyncSecretDatabaseURLFieldName)
+	if !zyncDatabaseURL.HasPassword {
+		return fmt.Errorf("GetZyncOptions: '%s' field in '%s' secret doesn't have required password part", component.ZyncSecretDatabaseURLFieldName, component.ZyncSecretName)
 	}
-	zyncDatabaseURLPasswordPart, zyncDatabaseURLHasPassword := zyncDatabaseURL.User.Password()
-	if !zyncDatabaseURLHasPassword {
-		return fmt.Errorf("GetZyncOptions: '%s' field in '%s' secret doesn't have required password part", component.ZyncSecretName, component.ZyncSecretDatabaseURLFieldName)
-	}
-	if z.zyncOptions.DatabasePassword != zyncDatabaseURLPasswordPart {
+	if !z.zyncDatabasePasswordMatches(zyncDatabaseURL.Password) {
 		return fmt.Errorf("GetZyncOptions: '%s' field in secret '%s' does not match password part in field '%s'. Inconsistency detected", component.ZyncSecretDatabasePasswordFieldName, component.ZyncSecretName, component.ZyncSecretDatabaseURLFieldName)
 	}
+	if z.apimanager.IsExternal(appsv1alpha1.ZyncDatabase) {
+		z.zyncOptions.DatabaseSchemaSearchPath = zyncDatabaseURL.SchemaSearchPath
+	}
 	return nil
 }
 
+// zyncDatabasePasswordMatches compares the decoded password part of the database url
+// with the password field. The password field of an external database is not used
+// by any pod, so it may also hold the percent-encoded password copied from the url
+func (z *ZyncOptionsProvider) zyncDatabasePasswordMatches(decodedURLPassword string) bool {
+	if z.zyncOptions.DatabasePassword == decodedURLPassword {
+		return true
+	}
+
+	if !z.apimanager.IsExternal(appsv1alpha1.ZyncDatabase) {
+		return false
+	}
+
+	decodedPassword, err := url.PathUnescape(z.zyncOptions.DatabasePassword)
+	return err == nil && decodedPassword == decodedURLPassword
+}
+
 func (z *ZyncOptionsProvider) setResourceRequirementsOptions() {
 	if *z.apimanager.Spec.ResourceRequirementsEnabled {
 		z.zyncOptions.ContainerResourceRequirements = component.DefaultZyncContainerResourceRequirements()
@@ -189,16 +229,182 @@ func (z *ZyncOptionsProvider) setResourceRequirementsOptions() {
 
 func (z *ZyncOptionsProvider) setNodeAffinityAndTolerationsOptions() {
 	z.zyncOptions.ZyncAffinity = z.apimanager.Spec.Zync.AppSpec.Affinity
-	z.zyncOptions.ZyncTolerations = z.apimanager.Spec.Zync.AppSpec.Tolerations
+	z.zyncOptions.ZyncTolerations = componentTolerations(z.apimanager, z.apimanager.Spec.Zync.AppSpec.Tolerations, z.apimanager.Spec.Zync.AppSpec.UnreachableTolerationSeconds)
 	z.zyncOptions.ZyncQueAffinity = z.apimanager.Spec.Zync.QueSpec.Affinity
-	z.zyncOptions.ZyncQueTolerations = z.apimanager.Spec.Zync.QueSpec.Tolerations
+	z.zyncOptions.ZyncQueTolerations = componentTolerations(z.apimanager, z.apimanager.Spec.Zync.QueSpec.Tolerations, z.apimanager.Spec.Zync.QueSpec.UnreachableTolerationSeconds)
+	z.zyncOptions.ZyncDatabaseNodeSelector = z.apimanager.Spec.Zync.DatabaseNodeSelector
 	z.zyncOptions.ZyncDatabaseAffinity = z.apimanager.Spec.Zync.DatabaseAffinity
-	z.zyncOptions.ZyncDatabaseTolerations = z.apimanager.Spec.Zync.DatabaseTolerations
+	z.zyncOptions.ZyncDatabaseTolerations = componentTolerations(z.apimanager, z.apimanager.Spec.Zync.DatabaseTolerations, nil)
+	z.zyncOptions.ZyncDatabaseTopologySpreadConstraints = z.apimanager.Spec.Zync.DatabaseTopologySpreadConstraints
+}
+
+// setCustomLabelsAndAnnotationsOptions sets the custom labels and annotations of the
+// component. The custom labels are already part of the pod template labels
+func (z *ZyncOptionsProvider) setCustomLabelsAndAnnotationsOptions() {
+	z.zyncOptions.ZyncCustomLabels = z.apimanager.Spec.Zync.AppSpec.Labels
+	z.zyncOptions.ZyncCustomAnnotations = z.apimanager.Spec.Zync.AppSpec.Annotations
+	z.zyncOptions.ZyncQueCustomLabels = z.apimanager.Spec.Zync.QueSpec.Labels
+	z.zyncOptions.ZyncQueCustomAnnotations = z.apimanager.Spec.Zync.QueSpec.Annotations
+}
+
+func (z *ZyncOptionsProvider) setPodTemplateOverridesOptions() {
+	z.zyncOptions.ZyncPodTemplateOverrides = podTemplateOverridesOptions(z.apimanager, &z.apimanager.Spec.Zync.AppSpec.PodTemplateOverridesSpec)
+	z.zyncOptions.ZyncQuePodTemplateOverrides = podTemplateOverridesOptions(z.apimanager, &z.apimanager.Spec.Zync.QueSpec.PodTemplateOverridesSpec)
+	// zync-que image pull secrets are set in its service account
+	z.zyncOptions.ZyncQuePodTemplateOverrides.ImagePullSecrets = nil
+	z.zyncOptions.ZyncDatabasePriorityClassName = helper.GetStringPointerValueOrDefault(z.apimanager.Spec.Zync.DatabasePriorityClassName, "")
+	z.zyncOptions.ZyncDatabaseImagePullPolicy = z.apimanager.Spec.ImagePullPolicy
+}
+
+func (z *ZyncOptionsProvider) setSecurityContextOptions() {
+	appSpec := z.apimanager.Spec.Zync.AppSpec
+	z.zyncOptions.ZyncSecurityContext = securityContextOptions(appSpec.PodSecurityContext, appSpec.SecurityContext)
+	queSpec := z.apimanager.Spec.Zync.QueSpec
+	z.zyncOptions.ZyncQueSecurityContext = securityContextOptions(queSpec.PodSecurityContext, queSpec.SecurityContext)
+	z.zyncOptions.ZyncDatabaseSecurityContext = securityContextOptions(z.apimanager.Spec.Zync.DatabasePodSecurityContext, z.apimanager.Spec.Zync.DatabaseSecurityContext)
+}
+
+func (z *ZyncOptionsProvider) setProbesOptions() {
+	z.zyncOptions.ZyncProbes = probesOptions(z.apimanager.Spec.Zync.AppSpec.Probes)
+	z.zyncOptions.ZyncQueProbes = probesOptions(z.apimanager.Spec.Zync.QueSpec.Probes)
+}
+
+func (z *ZyncOptionsProvider) setRuntimeTuningOptions() {
+	z.zyncOptions.ZyncRuntimeTuning = rubyRuntimeTuningOptions(z.apimanager.Spec.Zync.AppSpec.RuntimeTuning)
+	z.zyncOptions.ZyncQueRuntimeTuning = rubyRuntimeTuningOptions(z.apimanager.Spec.Zync.QueSpec.RuntimeTuning)
+}
+
+func (z *ZyncOptionsProvider) setExtraEnvOptions() {
+	z.zyncOptions.ZyncExtraEnv = z.apimanager.Spec.Zync.AppSpec.Env
+	z.zyncOptions.ZyncQueExtraEnv = z.apimanager.Spec.Zync.QueSpec.Env
+}
+
+func (z *ZyncOptionsProvider) setSidecarsOptions() {
+	z.zyncOptions.ZyncSidecars = sidecarsOptions(z.apimanager.Spec.Zync.AppSpec.Sidecars, z.apimanager.Spec.Zync.AppSpec.SidecarVolumes)
+	z.zyncOptions.ZyncQueSidecars = sidecarsOptions(z.apimanager.Spec.Zync.QueSpec.Sidecars, z.apimanager.Spec.Zync.QueSpec.SidecarVolumes)
+}
+
+func (z *ZyncOptionsProvider) setInitContainersOptions() {
+	z.zyncOptions.ZyncInitContainers = initContainersOptions(z.apimanager.Spec.Zync.AppSpec.WaitInitContainer, z.apimanager.Spec.Zync.AppSpec.InitContainers)
+	z.zyncOptions.ZyncQueInitContainers = initContainersOptions(nil, z.apimanager.Spec.Zync.QueSpec.InitContainers)
+}
+
+func (z *ZyncOptionsProvider) setTerminationOptions() {
+	z.zyncOptions.ZyncTermination = terminationOptions(z.apimanager.Spec.Zync.AppSpec.TerminationGracePeriodSeconds, z.apimanager.Spec.Zync.AppSpec.PreStopCommand)
+	z.zyncOptions.ZyncQueTermination = terminationOptions(z.apimanager.Spec.Zync.QueSpec.TerminationGracePeriodSeconds, z.apimanager.Spec.Zync.QueSpec.PreStopCommand)
+}
+
+func (z *ZyncOptionsProvider) setDatabaseSharedMemoryOptions() {
+	z.zyncOptions.ZyncDatabaseSharedMemorySizeLimit = z.apimanager.Spec.Zync.DatabaseSharedMemorySizeLimit
+}
+
+func (z *ZyncOptionsProvider) setDatabaseStorageOptions() {
+	storageSpec := z.apimanager.Spec.Zync.DatabaseStorage
+	if storageSpec == nil {
+		return
+	}
+
+	opts := &component.ZyncDatabaseStorageOptions{
+		Size:             component.DefaultZyncDatabaseStorageSize(),
+		StorageClassName: storageSpec.StorageClassName,
+		VolumeName:       storageSpec.VolumeName,
+		AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+	}
+	if storageSpec.Size != nil {
+		opts.Size = *storageSpec.Size
+	}
+	if len(storageSpec.AccessModes) > 0 {
+		opts.AccessModes = storageSpec.AccessModes
+	}
+	z.zyncOptions.DatabaseStorage = opts
 }
 
 func (z *ZyncOptionsProvider) setReplicas() {
-	z.zyncOptions.ZyncReplicas = int32(*z.apimanager.Spec.Zync.AppSpec.Replicas)
-	z.zyncOptions.ZyncQueReplicas = int32(*z.apimanager.Spec.Zync.QueSpec.Replicas)
+	z.zyncOptions.ZyncReplicas, z.zyncOptions.ZyncReplicasManaged = replicasOptions(z.apimanager.Spec.Zync.AppSpec.Replicas)
+	z.zyncOptions.ZyncQueReplicas, z.zyncOptions.ZyncQueReplicasManaged = replicasOptions(z.apimanager.Spec.Zync.QueSpec.Replicas)
+}
+
+func (z *ZyncOptionsProvider) setPodDisruptionBudgetOptions() {
+	pdbSpec := z.apimanager.Spec.PodDisruptionBudget
+	if pdbSpec == nil {
+		return
+	}
+	z.zyncOptions.ZyncPodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.Zync)
+	z.zyncOptions.ZyncQuePodDisruptionBudget = podDisruptionBudgetOptions(pdbSpec.ZyncQue)
+}
+
+func (z *ZyncOptionsProvider) setRailsProxyOptions() {
+	z.zyncOptions.ZyncForceSSL = z.apimanager.Spec.Zync.AppSpec.ForceSSL
+	z.zyncOptions.ZyncTrustedProxies = z.apimanager.Spec.Zync.AppSpec.TrustedProxies
+}
+
+func (z *ZyncOptionsProvider) setDatabaseMaintenanceOptions() {
+	if !z.apimanager.IsZyncDatabaseMaintenanceEnabled() {
+		return
+	}
+
+	maintenanceSpec := z.apimanager.Spec.Zync.DatabaseMaintenance
+	z.zyncOptions.DatabaseMaintenance = &component.ZyncDatabaseMaintenanceOptions{
+		Image:    z.apimanager.DefaultImageURL(ZyncPostgreSQLImageURL()),
+		Schedule: helper.GetStringPointerValueOrDefault(maintenanceSpec.Schedule, component.DefaultZyncDatabaseMaintenanceSchedule),
+		Repack:   maintenanceSpec.Repack != nil && *maintenanceSpec.Repack,
+		JobPodTemplate: helper.MergeJobPodTemplateOptions(&helper.JobPodTemplateOptions{
+			NodeSelector:      z.zyncOptions.ZyncDatabaseNodeSelector,
+			Affinity:          z.zyncOptions.ZyncDatabaseAffinity,
+			Tolerations:       z.zyncOptions.ZyncDatabaseTolerations,
+			PriorityClassName: z.zyncOptions.ZyncDatabasePriorityClassName,
+		}, z.apimanager.Spec.JobsTemplate.JobPodTemplateOptions()),
+	}
+	if z.apimanager.Spec.Zync.PostgreSQLImage != nil {
+		z.zyncOptions.DatabaseMaintenance.Image = *z.apimanager.Spec.Zync.PostgreSQLImage
+	}
+}
+
+func (z *ZyncOptionsProvider) setQueWorkerOptions() {
+	z.zyncOptions.ZyncQueWorkerCount = component.DefaultZyncQueWorkerCount
+	if z.apimanager.Spec.Zync.QueSpec.WorkerCount != nil {
+		z.zyncOptions.ZyncQueWorkerCount = *z.apimanager.Spec.Zync.QueSpec.WorkerCount
+	}
+
+	z.zyncOptions.ZyncQuePollingInterval = component.DefaultZyncQuePollingInterval
+	if z.apimanager.Spec.Zync.QueSpec.PollingInterval != nil {
+		z.zyncOptions.ZyncQuePollingInterval = *z.apimanager.Spec.Zync.QueSpec.PollingInterval
+	}
+}
+
+func (z *ZyncOptionsProvider) setQueServiceAccountOptions() {
+	z.zyncOptions.ZyncQueServiceAccountName = z.apimanager.ServiceAccountName(appsv1alpha1.ZyncQueServiceAccountName)
+	z.zyncOptions.ZyncQueServiceAccountAnnotations = z.apimanager.ServiceAccountAnnotations(appsv1alpha1.ZyncQueServiceAccountName)
+}
+
+// setQueServiceAccountImagePullSecretsOptions sets the image pull secrets of the zync-que service account.
+// The image pull secrets of the que spec override spec.imagePullSecrets
+func (z *ZyncOptionsProvider) setQueServiceAccountImagePullSecretsOptions() {
+	defaults := component.DefaultZyncQueServiceAccountImagePullSecrets()
+	secrets := z.apimanager.ComponentImagePullSecrets(defaults, z.apimanager.Spec.Zync.QueSpec.ImagePullSecrets)
+	if secrets == nil {
+		secrets = z.apimanager.ServiceAccountImagePullSecrets(defaults)
+	}
+	z.zyncOptions.ZyncQueServiceAccountImagePullSecrets = secrets
+}
+
+func (z *ZyncOptionsProvider) setQueServiceAccountTokenOptions() {
+	z.zyncOptions.ZyncQueServiceAccountTokenExpirationSeconds = component.DefaultZyncQueServiceAccountTokenExpirationSeconds
+
+	tokenSpec := z.apimanager.Spec.Zync.QueSpec.ServiceAccountToken
+	if tokenSpec == nil {
+		return
+	}
+
+	if tokenSpec.Legacy != nil {
+		z.zyncOptions.ZyncQueServiceAccountTokenLegacy = *tokenSpec.Legacy
+	}
+	if tokenSpec.Audience != nil {
+		z.zyncOptions.ZyncQueServiceAccountTokenAudience = *tokenSpec.Audience
+	}
+	if tokenSpec.ExpirationSeconds != nil {
+		z.zyncOptions.ZyncQueServiceAccountTokenExpirationSeconds = *tokenSpec.ExpirationSeconds
+	}
 }
 
 func (z *ZyncOptionsProvider) commonLabels() map[string]string {
@@ -227,7 +433,8 @@ func (z *ZyncOptionsProvider) commonZyncDatabaseLabels() map[string]string {
 }
 
 func (z *ZyncOptionsProvider) zyncPodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("zync", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(z.apimanager.Spec.Zync.AppSpec.Labels, helper.MeteringLabels("zync", helper.ApplicationType))
 
 	for k, v := range z.commonZyncLabels() {
 		labels[k] = v
@@ -239,13 +446,15 @@ func (z *ZyncOptionsProvider) zyncPodTemplateLabels() map[string]string {
 }
 
 func (z *ZyncOptionsProvider) zyncQuePodTemplateLabels() map[string]string {
-	labels := helper.MeteringLabels("zync-que", helper.ApplicationType)
+	// The labels set by the operator override the custom labels
+	labels := helper.MergeMapsStringString(z.apimanager.Spec.Zync.QueSpec.Labels, helper.MeteringLabels("zync-que", helper.ApplicationType))
 
 	for k, v := range z.commonZyncQueLabels() {
 		labels[k] = v
 	}
 
 	labels["deploymentConfig"] = "zync-que"
+	labels[component.ZyncQueWorkerCountLabel] = strconv.FormatInt(int64(z.zyncOptions.ZyncQueWorkerCount), 10)
 
 	return labels
 }
@@ -261,11 +470,3 @@ func (z *ZyncOptionsProvider) zyncDatabasePodTemplateLabels() map[string]string
 
 	return labels
 }
-
-func (z *ZyncOptionsProvider) zyncQueServiceAccountImagePullSecrets() []v1.LocalObjectReference {
-	if z.apimanager.Spec.ImagePullSecrets != nil {
-		return z.apimanager.Spec.ImagePullSecrets
-	}
-
-	return component.DefaultZyncQueServiceAccountImagePullSecrets()
-}
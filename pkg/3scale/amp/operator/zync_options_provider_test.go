@@ -2,7 +2,9 @@ package operator
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,6 +12,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
@@ -78,6 +81,7 @@ func testZyncQuePodTemplateCommonLabels() map[string]string {
 		"threescale_component_element": "zync-que",
 		"deploymentConfig":             "zync-que",
 	}
+	labels[component.ZyncQueWorkerCountLabel] = "10"
 	addExpectedMeteringLabels(labels, "zync-que", helper.ApplicationType)
 
 	return labels
@@ -175,10 +179,14 @@ func getZyncSecret() *v1.Secret {
 }
 
 func getZyncSecretExternalDatabase(namespace string) *v1.Secret {
+	return getZyncSecretExternalDatabaseWithURL(namespace, zyncExternalDatabaseTestURL)
+}
+
+func getZyncSecretExternalDatabaseWithURL(namespace, databaseURL string) *v1.Secret {
 	data := map[string]string{
 		component.ZyncSecretKeyBaseFieldName:             zyncSecretKeyBasename,
 		component.ZyncSecretDatabasePasswordFieldName:    zyncDatabasePasswd,
-		component.ZyncSecretDatabaseURLFieldName:         zyncExternalDatabaseTestURL,
+		component.ZyncSecretDatabaseURLFieldName:         databaseURL,
 		component.ZyncSecretAuthenticationTokenFieldName: zyncAuthToken,
 	}
 	return GetTestSecret(namespace, component.ZyncSecretName, data)
@@ -218,6 +226,10 @@ func defaultZyncOptions(opts *component.ZyncOptions) *component.ZyncOptions {
 		SecretKeyBase:                         opts.SecretKeyBase,
 		ZyncReplicas:                          int32(zyncReplica),
 		ZyncQueReplicas:                       int32(zyncQueReplica),
+		ZyncReplicasManaged:                   true,
+		ZyncQueReplicasManaged:                true,
+		ZyncQueWorkerCount:                    component.DefaultZyncQueWorkerCount,
+		ZyncQuePollingInterval:                component.DefaultZyncQuePollingInterval,
 		CommonLabels:                          testZyncCommonLabels(),
 		CommonZyncLabels:                      testZyncZyncCommonLabels(),
 		CommonZyncQueLabels:                   testZyncQueCommonLabels(),
@@ -227,7 +239,11 @@ func defaultZyncOptions(opts *component.ZyncOptions) *component.ZyncOptions {
 		ZyncDatabasePodTemplateLabels:         testZyncDatabasePodTemplateCommonLabels(),
 		ZyncMetrics:                           true,
 		ZyncQueServiceAccountImagePullSecrets: component.DefaultZyncQueServiceAccountImagePullSecrets(),
+		ZyncQueServiceAccountName:             appsv1alpha1.ZyncQueServiceAccountName,
 		Namespace:                             opts.Namespace,
+		GrafanaDashboard:                      component.DefaultGrafanaDashboardOptions(),
+
+		ZyncQueServiceAccountTokenExpirationSeconds: component.DefaultZyncQueServiceAccountTokenExpirationSeconds,
 	}
 
 	expectedOpts.DatabaseURL = component.DefaultZyncDatabaseURL(expectedOpts.DatabasePassword)
@@ -237,6 +253,8 @@ func defaultZyncOptions(opts *component.ZyncOptions) *component.ZyncOptions {
 
 func TestGetZyncOptionsProvider(t *testing.T) {
 	falseValue := false
+	trueValue := true
+	zyncQuePDBMinAvailable := intstr.FromString("50%")
 
 	cases := []struct {
 		testName               string
@@ -283,6 +301,31 @@ func TestGetZyncOptionsProvider(t *testing.T) {
 				return opts
 			},
 		},
+		{"ZyncSecretWithExternalZyncURLQueryParameters", getZyncSecretExternalDatabaseWithURL(namespace, zyncExternalDatabaseTestURL+"?sslmode=verify-full"), basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions,
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.SecretKeyBase = zyncSecretKeyBasename
+				expectedOpts.DatabasePassword = zyncDatabasePasswd
+				expectedOpts.AuthenticationToken = zyncAuthToken
+				expectedOpts.DatabaseURL = zyncExternalDatabaseTestURL + "?sslmode=verify-full"
+				return expectedOpts
+			},
+		},
+		{"WithPodDisruptionBudgetPolicy", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.PodDisruptionBudget = &appsv1alpha1.PodDisruptionBudgetSpec{
+					Enabled: true,
+					ZyncQue: &appsv1alpha1.PodDisruptionBudgetPolicySpec{MinAvailable: &zyncQuePDBMinAvailable},
+				}
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncQuePodDisruptionBudget = &component.PodDisruptionBudgetOptions{MinAvailable: &zyncQuePDBMinAvailable}
+				return expectedOpts
+			},
+		},
 		{"WithAffinity", nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerSpecTestZyncOptions()
@@ -315,6 +358,42 @@ func TestGetZyncOptionsProvider(t *testing.T) {
 				return expectedOpts
 			},
 		},
+		{"WithTopologySpreadConstraints", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.AppSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("zync")
+				apimanager.Spec.Zync.QueSpec.TopologySpreadConstraints = getTestTopologySpreadConstraints("zync-que")
+				apimanager.Spec.Zync.DatabaseTopologySpreadConstraints = getTestTopologySpreadConstraints("zync-database")
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncPodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("zync")
+				expectedOpts.ZyncQuePodTemplateOverrides.TopologySpreadConstraints = getTestTopologySpreadConstraints("zync-que")
+				expectedOpts.ZyncDatabaseTopologySpreadConstraints = getTestTopologySpreadConstraints("zync-database")
+				return expectedOpts
+			},
+		},
+		{"WithCustomLabelsAndAnnotations", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.AppSpec.Labels = getTestCustomLabels("zync")
+				apimanager.Spec.Zync.AppSpec.Annotations = getTestCustomAnnotations("zync")
+				apimanager.Spec.Zync.QueSpec.Labels = getTestCustomLabels("zync-que")
+				apimanager.Spec.Zync.QueSpec.Annotations = getTestCustomAnnotations("zync-que")
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncCustomLabels = getTestCustomLabels("zync")
+				expectedOpts.ZyncCustomAnnotations = getTestCustomAnnotations("zync")
+				expectedOpts.ZyncPodTemplateLabels["custom-label"] = "zync"
+				expectedOpts.ZyncQueCustomLabels = getTestCustomLabels("zync-que")
+				expectedOpts.ZyncQueCustomAnnotations = getTestCustomAnnotations("zync-que")
+				expectedOpts.ZyncQuePodTemplateLabels["custom-label"] = "zync-que"
+				return expectedOpts
+			},
+		},
 		{"WithZyncCustomResourceRequirements", nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerSpecTestZyncOptions()
@@ -348,6 +427,79 @@ func TestGetZyncOptionsProvider(t *testing.T) {
 				return expectedOpts
 			},
 		},
+		{"WithDatabaseSharedMemorySizeLimit", nil,
+			func() *appsv1alpha1.APIManager {
+				sizeLimit := resource.MustParse("256Mi")
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.DatabaseSharedMemorySizeLimit = &sizeLimit
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				sizeLimit := resource.MustParse("256Mi")
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncDatabaseSharedMemorySizeLimit = &sizeLimit
+				return expectedOpts
+			},
+		},
+		{"WithQueServiceAccountToken", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.QueSpec.ServiceAccountToken = &appsv1alpha1.ZyncQueServiceAccountTokenSpec{
+					Audience:          &[]string{"zync"}[0],
+					ExpirationSeconds: &[]int64{600}[0],
+				}
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncQueServiceAccountTokenAudience = "zync"
+				expectedOpts.ZyncQueServiceAccountTokenExpirationSeconds = 600
+				return expectedOpts
+			},
+		},
+		{"WithLegacyQueServiceAccountToken", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.QueSpec.ServiceAccountToken = &appsv1alpha1.ZyncQueServiceAccountTokenSpec{Legacy: &trueValue}
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncQueServiceAccountTokenLegacy = true
+				return expectedOpts
+			},
+		},
+		{"WithRailsProxySettings", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.AppSpec.ForceSSL = &trueValue
+				apimanager.Spec.Zync.AppSpec.TrustedProxies = []string{"10.0.0.0/8", "172.16.0.0/12"}
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncForceSSL = &trueValue
+				expectedOpts.ZyncTrustedProxies = []string{"10.0.0.0/8", "172.16.0.0/12"}
+				return expectedOpts
+			},
+		},
+		{"WithQueWorkers", nil,
+			func() *appsv1alpha1.APIManager {
+				workerCount := int32(25)
+				pollingInterval := int32(1)
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.Zync.QueSpec.WorkerCount = &workerCount
+				apimanager.Spec.Zync.QueSpec.PollingInterval = &pollingInterval
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncQueWorkerCount = 25
+				expectedOpts.ZyncQuePollingInterval = 1
+				expectedOpts.ZyncQuePodTemplateLabels[component.ZyncQueWorkerCountLabel] = "25"
+				return expectedOpts
+			},
+		},
 		{"WithoutResourceRequirements", nil,
 			func() *appsv1alpha1.APIManager {
 				apimanager := basicApimanagerSpecTestZyncOptions()
@@ -360,6 +512,19 @@ func TestGetZyncOptionsProvider(t *testing.T) {
 				return expectedOpts
 			},
 		},
+		{"WithMergedImagePullSecrets", nil,
+			func() *appsv1alpha1.APIManager {
+				apimanager := basicApimanagerSpecTestZyncOptions()
+				apimanager.Spec.ImagePullSecrets = testZyncQueSACustomImagePullSecrets()
+				apimanager.Spec.ImagePullSecretsPolicy = &[]string{appsv1alpha1.ImagePullSecretsPolicyMerge}[0]
+				return apimanager
+			},
+			func(opts *component.ZyncOptions) *component.ZyncOptions {
+				expectedOpts := defaultZyncOptions(opts)
+				expectedOpts.ZyncQueServiceAccountImagePullSecrets = append(component.DefaultZyncQueServiceAccountImagePullSecrets(), testZyncQueSACustomImagePullSecrets()...)
+				return expectedOpts
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -381,3 +546,162 @@ func TestGetZyncOptionsProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestGetZyncOptionsProviderQueWorkersValidation(t *testing.T) {
+	zeroValue := int32(0)
+	for _, field := range []string{"workerCount", "pollingInterval"} {
+		t.Run(field, func(subT *testing.T) {
+			apimanager := basicApimanagerSpecTestZyncOptions()
+			if field == "workerCount" {
+				apimanager.Spec.Zync.QueSpec.WorkerCount = &zeroValue
+			} else {
+				apimanager.Spec.Zync.QueSpec.PollingInterval = &zeroValue
+			}
+
+			_, err := NewZyncOptionsProvider(apimanager, namespace, fake.NewFakeClient()).GetZyncOptions()
+			if err == nil {
+				subT.Errorf("expected validation error with %s below 1", field)
+			}
+		})
+	}
+}
+
+func TestZyncDatabaseURLPasswordEncoding(t *testing.T) {
+	encoded := func(password string) string {
+		return strings.TrimPrefix(url.UserPassword("", password).String(), ":")
+	}
+	externalURL := func(password string) string {
+		return fmt.Sprintf("postgresql://exampleuser:%s@databaseurl:5432/zync_production", encoded(password))
+	}
+	zyncSecret := func(password, databaseURL string) *v1.Secret {
+		data := map[string]string{
+			component.ZyncSecretKeyBaseFieldName:             zyncSecretKeyBasename,
+			component.ZyncSecretDatabasePasswordFieldName:    password,
+			component.ZyncSecretAuthenticationTokenFieldName: zyncAuthToken,
+		}
+		if databaseURL != "" {
+			data[component.ZyncSecretDatabaseURLFieldName] = databaseURL
+		}
+		return GetTestSecret(namespace, component.ZyncSecretName, data)
+	}
+
+	for _, password := range []string{"p@ssword", "pass:word", "100%pass", "pässwörd", "a:b@c%d/e"} {
+		cases := []struct {
+			testName          string
+			zyncSecret        *v1.Secret
+			apimanagerFactory func() *appsv1alpha1.APIManager
+			expectedError     bool
+		}{
+			{"InternalDefaultURL", zyncSecret(password, ""), basicApimanagerSpecTestZyncOptions, false},
+			{"InternalEncodedURL", zyncSecret(password, component.DefaultZyncDatabaseURL(password)), basicApimanagerSpecTestZyncOptions, false},
+			// The password field is the password of the internal database
+			{"InternalEncodedPasswordField", zyncSecret(encoded(password), component.DefaultZyncDatabaseURL(password)), basicApimanagerSpecTestZyncOptions, true},
+			{"ExternalRawPasswordField", zyncSecret(password, externalURL(password)), basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions, false},
+			{"ExternalEncodedPasswordField", zyncSecret(encoded(password), externalURL(password)), basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions, false},
+			{"ExternalMismatch", zyncSecret(password+"x", externalURL(password)), basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions, true},
+		}
+
+		for _, tc := range cases {
+			t.Run(fmt.Sprintf("%s/%s", tc.testName, password), func(subT *testing.T) {
+				optsProvider := NewZyncOptionsProvider(tc.apimanagerFactory(), namespace, fake.NewFakeClient(tc.zyncSecret))
+				opts, err := optsProvider.GetZyncOptions()
+				if tc.expectedError {
+					if err == nil {
+						subT.Error("expected error")
+					}
+					return
+				}
+				if err != nil {
+					subT.Fatal(err)
+				}
+
+				databaseURL, err := url.Parse(opts.DatabaseURL)
+				if err != nil {
+					subT.Fatal(err)
+				}
+				if urlPassword, _ := databaseURL.User.Password(); urlPassword != password {
+					subT.Errorf("unexpected database url password: '%s'", urlPassword)
+				}
+			})
+		}
+	}
+}
+
+func TestZyncDatabaseURLSchemaSearchPath(t *testing.T) {
+	databaseURL := func(suffix string) string {
+		return fmt.Sprintf("postgresql://exampleuser:%s@databaseurl%s", zyncDatabasePasswd, suffix)
+	}
+
+	cases := []struct {
+		testName           string
+		databaseURL        string
+		expectedSearchPath string
+		expectedError      bool
+	}{
+		{"Default", databaseURL(":5432/zync_production"), "", false},
+		{"NonDefaultPort", databaseURL(":15432/zync_production"), "", false},
+		{"NoPort", databaseURL("/zync_production"), "", false},
+		{"NonDefaultDatabase", databaseURL(":5432/shared_db?sslmode=require"), "", false},
+		{"DBNameParam", databaseURL(":5432?dbname=shared_db&sslmode=require"), "", false},
+		{"SchemaSearchPathParam", databaseURL(":5432/shared_db?schema_search_path=zync"), "zync", false},
+		{"SchemaSearchPathParamList", databaseURL(":5432/shared_db?schema_search_path=zync,%20public"), "zync,public", false},
+		{"SearchPathOption", databaseURL(":5432/shared_db?sslmode=verify-full&options=-c%20search_path%3Dzync"), "zync", false},
+		{"SearchPathOptionPlusEncoded", databaseURL(":5432/shared_db?options=-c+search_path%3Dzync,%22$user%22+-c+statement_timeout%3D5000"), `zync,"$user"`, false},
+		{"SearchPathOptionNoSpace", databaseURL(":5432/shared_db?options=-csearch_path%3Dzync"), "zync", false},
+		{"SearchPathLongOption", databaseURL(":5432/shared_db?options=--search_path%3Dzync"), "zync", false},
+		{"MatchingParamAndOption", databaseURL(":5432/shared_db?schema_search_path=zync&options=-c%20search_path%3Dzync"), "zync", false},
+		{"MismatchingParamAndOption", databaseURL(":5432/shared_db?schema_search_path=zync&options=-c%20search_path%3Dother"), "", true},
+		{"InvalidSchema", databaseURL(":5432/shared_db?schema_search_path=zync;drop"), "", true},
+		{"MismatchingDBNameParam", databaseURL(":5432/shared_db?dbname=other"), "", true},
+		{"InvalidDatabase", databaseURL(":5432/shared/db"), "", true},
+		{"LongDatabase", databaseURL(":5432/" + strings.Repeat("d", 64)), "", true},
+		{"InvalidPort", databaseURL(":70000/zync_production"), "", true},
+		{"InvalidQuery", databaseURL(":5432/zync_production?sslmode=%zz"), "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			client := fake.NewFakeClient(getZyncSecretExternalDatabaseWithURL(namespace, tc.databaseURL))
+			optsProvider := NewZyncOptionsProvider(basicApimanagerWithExternalZyncDatabaseSpecTestZyncOptions(), namespace, client)
+			opts, err := optsProvider.GetZyncOptions()
+			if tc.expectedError {
+				if err == nil {
+					subT.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if opts.DatabaseURL != tc.databaseURL {
+				subT.Errorf("unexpected database url: '%s'", opts.DatabaseURL)
+			}
+			if opts.DatabaseSchemaSearchPath != tc.expectedSearchPath {
+				subT.Errorf("expected schema search path '%s', got '%s'", tc.expectedSearchPath, opts.DatabaseSchemaSearchPath)
+			}
+
+			dc := component.NewZync(opts).DeploymentConfig()
+			for _, container := range append(dc.Spec.Template.Spec.InitContainers, dc.Spec.Template.Spec.Containers...) {
+				idx := helper.FindEnvVar(container.Env, component.ZyncDatabaseSchemaSearchPathEnvVarName)
+				if tc.expectedSearchPath == "" {
+					if idx >= 0 {
+						subT.Errorf("container %s: unexpected %s env var", container.Name, component.ZyncDatabaseSchemaSearchPathEnvVarName)
+					}
+				} else if idx < 0 || container.Env[idx].Value != tc.expectedSearchPath {
+					subT.Errorf("container %s: expected %s env var '%s'", container.Name, component.ZyncDatabaseSchemaSearchPathEnvVarName, tc.expectedSearchPath)
+				}
+			}
+		})
+	}
+
+	// The internal database does not use the schema search path
+	internalURL := component.DefaultZyncDatabaseURL(zyncDatabasePasswd) + "?schema_search_path=zync"
+	client := fake.NewFakeClient(getZyncSecretExternalDatabaseWithURL(namespace, internalURL))
+	opts, err := NewZyncOptionsProvider(basicApimanagerSpecTestZyncOptions(), namespace, client).GetZyncOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.DatabaseSchemaSearchPath != "" {
+		t.Errorf("unexpected schema search path: '%s'", opts.DatabaseSchemaSearchPath)
+	}
+}
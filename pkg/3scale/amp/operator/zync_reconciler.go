@@ -1,15 +1,32 @@
 package operator
 
 import (
+	"fmt"
+	"reflect"
+
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/common"
 	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "github.com/openshift/api/apps/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// openshiftTokenSecretAnnotation references, from an OpenShift dockercfg secret,
+// the token secret it has been generated from
+const openshiftTokenSecretAnnotation = "openshift.io/token-secret.name"
+
 type ZyncReconciler struct {
 	*BaseAPIManagerLogicReconciler
 }
@@ -21,6 +38,12 @@ func NewZyncReconciler(baseAPIManagerLogicReconciler *BaseAPIManagerLogicReconci
 }
 
 func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
+	// System connects to the external zync. Objects deployed before
+	// switching to the external zync are left in place
+	if r.apiManager.IsExternalZync() {
+		return reconcile.Result{}, nil
+	}
+
 	zync, err := Zync(r.apiManager, r.Client())
 	if err != nil {
 		return reconcile.Result{}, err
@@ -39,19 +62,27 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	// Zync Que RoleBinding
-	err = r.ReconcileRoleBinding(zync.QueRoleBinding(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcileRoleBinding(zync.QueRoleBinding(), reconcilers.RoleBindingSubjectsMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// Zync DC
-	err = r.ReconcileDeploymentConfig(zync.DeploymentConfig(), reconcilers.GenericZyncMutators())
+	zyncDCMutators := append(reconcilers.GenericZyncMutators(), replicasMutator(zync.Options.ZyncReplicasManaged), zyncRailsProxyEnvVarsMutator, componentMetricsMutator, zyncDatabaseTLSMutator, zyncDatabaseSchemaEnvVarMutator)
+	err = r.ReconcileDeploymentConfig(zync.DeploymentConfig(), reconcilers.DeploymentConfigMutator(zyncDCMutators...))
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// Zync Que DC
-	err = r.ReconcileDeploymentConfig(zync.QueDeploymentConfig(), reconcilers.GenericZyncMutators())
+	zyncQueDCMutators := append(reconcilers.GenericZyncMutators(), replicasMutator(zync.Options.ZyncQueReplicasManaged), zyncQueServiceAccountTokenMutator, zyncQueWorkerMutator, zyncDatabaseTLSMutator, zyncDatabaseSchemaEnvVarMutator)
+	err = r.ReconcileDeploymentConfig(zync.QueDeploymentConfig(), reconcilers.DeploymentConfigMutator(zyncQueDCMutators...))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Zync Que legacy SA token secrets
+	err = r.reconcileQueLegacyTokenSecrets(zync.Options.ZyncQueServiceAccountTokenLegacy)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -63,13 +94,29 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 	}
 
 	if !r.apiManager.IsExternal(appsv1alpha1.ZyncDatabase) {
+		// Zync DB PVC. Kept when the data is switched back to an ephemeral volume
+		if pvc := zync.DatabasePersistentVolumeClaim(); pvc != nil {
+			err = r.ReconcilePersistentVolumeClaimStorage(pvc)
+		} else {
+			err = r.setPersistentVolumeClaimProblems(component.ZyncDatabaseDataVolumeName, nil)
+		}
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
 		// Zync DB DC
 		zyncDBDCMutator := reconcilers.DeploymentConfigMutator(
 			reconcilers.DeploymentConfigImageChangeTriggerMutator,
 			reconcilers.DeploymentConfigContainerResourcesMutator,
 			reconcilers.DeploymentConfigAffinityMutator,
+			reconcilers.DeploymentConfigNodeSelectorMutator,
 			reconcilers.DeploymentConfigTolerationsMutator,
+			reconcilers.DeploymentConfigSecurityContextMutator,
+			reconcilers.DeploymentConfigTopologySpreadConstraintsMutator,
+			reconcilers.DeploymentConfigPriorityClassMutator,
 			reconcilers.DeploymentConfigPodTemplateLabelsMutator,
+			sharedMemoryVolumeMutator,
+			zyncDatabaseDataVolumeMutator,
 		)
 		err = r.ReconcileDeploymentConfig(zync.DatabaseDeploymentConfig(), zyncDBDCMutator)
 		if err != nil {
@@ -83,6 +130,26 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 		}
 	}
 
+	// Zync DB maintenance CronJob
+	maintenanceCronJob := zync.DatabaseMaintenanceCronJob()
+	maintenanceCronJob.Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName = r.apiManager.ServiceAccountName(appsv1alpha1.AmpServiceAccountName)
+	helper.SetTerminationMessagePolicy(&maintenanceCronJob.Spec.JobTemplate.Spec.Template.Spec, r.apiManager.ContainerTerminationMessagePolicy())
+	if r.apiManager.IsRestrictedPodSecurityStandard() {
+		helper.SetRestrictedSecurityContexts(&maintenanceCronJob.Spec.JobTemplate.Spec.Template)
+	}
+	if r.apiManager.IsHibernated() {
+		// The zync database is scaled down
+		maintenanceCronJob.Spec.Suspend = &[]bool{true}[0]
+	}
+	if !r.apiManager.IsZyncDatabaseMaintenanceEnabled() {
+		// Remove the jobs of the CronJob as well
+		common.TagToObjectDeleteWithPropagationPolicy(maintenanceCronJob, metav1.DeletePropagationBackground)
+	}
+	err = r.ReconcileResource(&batchv1beta1.CronJob{}, maintenanceCronJob, zyncDatabaseMaintenanceCronJobMutator)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Zync Secret
 	err = r.ReconcileSecret(zync.Secret(), reconcilers.DefaultsOnlySecretMutator)
 	if err != nil {
@@ -106,7 +173,7 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
-	err = r.ReconcilePodMonitor(zync.ZyncQuePodMonitor(), reconcilers.CreateOnlyMutator)
+	err = r.ReconcilePodMonitor(zync.ZyncQuePodMonitor(), zyncQuePodMonitorMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -126,7 +193,12 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 		return reconcile.Result{}, err
 	}
 
-	err = r.ReconcilePrometheusRules(zync.ZyncQuePrometheusRules(), reconcilers.CreateOnlyMutator)
+	queRules := zync.ZyncQuePrometheusRules()
+	if r.apiManager.IsStandby() {
+		// Alerts would fire on the scaled down component
+		common.TagObjectToDelete(queRules)
+	}
+	err = r.ReconcilePrometheusRules(queRules, reconcilers.CreateOnlyMutator)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -134,11 +206,272 @@ func (r *ZyncReconciler) Reconcile() (reconcile.Result, error) {
 	return reconcile.Result{}, nil
 }
 
+func zyncRailsProxyEnvVarsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	// Reconcile EnvVars related to the Rails SSL and trusted proxies settings
+	var changed bool
+
+	for _, envVar := range []string{
+		component.ZyncForceSSLEnvVarName,
+		component.ZyncTrustedProxiesEnvVarName,
+	} {
+		tmpChanged := reconcilers.DeploymentConfigEnvVarReconciler(desired, existing, envVar)
+		changed = changed || tmpChanged
+	}
+
+	return changed, nil
+}
+
+// zyncDatabaseSchemaEnvVarMutator reconciles the schema search path of the external
+// database. The init container env vars are reconciled by zyncDatabaseTLSMutator
+func zyncDatabaseSchemaEnvVarMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return reconcilers.DeploymentConfigContainersEnvVarReconciler(desired, existing, component.ZyncDatabaseSchemaSearchPathEnvVarName), nil
+}
+
+// zyncDatabaseDataVolumeMutator switches the data volume of the internal database
+// between the ephemeral volume and the PersistentVolumeClaim
+func zyncDatabaseDataVolumeMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	return reconcilers.DeploymentConfigVolumeReconciler(desired, existing, component.ZyncDatabaseDataVolumeName), nil
+}
+
+// zyncDatabaseMaintenanceCronJobMutator reconciles the schedule, the suspension and
+// the maintenance container of the zync database maintenance CronJob
+func zyncDatabaseMaintenanceCronJobMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*batchv1beta1.CronJob)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *batchv1beta1.CronJob", existingObj)
+	}
+	desired, ok := desiredObj.(*batchv1beta1.CronJob)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *batchv1beta1.CronJob", desiredObj)
+	}
+
+	update := false
+
+	if existing.Spec.Schedule != desired.Spec.Schedule {
+		existing.Spec.Schedule = desired.Spec.Schedule
+		update = true
+	}
+
+	existingSuspend := existing.Spec.Suspend != nil && *existing.Spec.Suspend
+	desiredSuspend := desired.Spec.Suspend != nil && *desired.Spec.Suspend
+	if existingSuspend != desiredSuspend {
+		existing.Spec.Suspend = &desiredSuspend
+		update = true
+	}
+
+	existingContainer := &existing.Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+	desiredContainer := &desired.Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+
+	if existingContainer.Image != desiredContainer.Image {
+		existingContainer.Image = desiredContainer.Image
+		update = true
+	}
+
+	if !reflect.DeepEqual(existingContainer.Args, desiredContainer.Args) {
+		existingContainer.Args = desiredContainer.Args
+		update = true
+	}
+
+	if !reflect.DeepEqual(existingContainer.Env, desiredContainer.Env) {
+		existingContainer.Env = desiredContainer.Env
+		update = true
+	}
+
+	if existingContainer.TerminationMessagePolicy != desiredContainer.TerminationMessagePolicy {
+		existingContainer.TerminationMessagePolicy = desiredContainer.TerminationMessagePolicy
+		update = true
+	}
+
+	existingPodSpec := &existing.Spec.JobTemplate.Spec.Template.Spec
+	desiredPodSpec := &desired.Spec.JobTemplate.Spec.Template.Spec
+
+	if !reflect.DeepEqual(existingPodSpec.NodeSelector, desiredPodSpec.NodeSelector) {
+		existingPodSpec.NodeSelector = desiredPodSpec.NodeSelector
+		update = true
+	}
+
+	if !reflect.DeepEqual(existingPodSpec.Affinity, desiredPodSpec.Affinity) {
+		existingPodSpec.Affinity = desiredPodSpec.Affinity
+		update = true
+	}
+
+	if !reflect.DeepEqual(existingPodSpec.Tolerations, desiredPodSpec.Tolerations) {
+		existingPodSpec.Tolerations = desiredPodSpec.Tolerations
+		update = true
+	}
+
+	if existingPodSpec.PriorityClassName != desiredPodSpec.PriorityClassName {
+		existingPodSpec.PriorityClassName = desiredPodSpec.PriorityClassName
+		update = true
+	}
+
+	if existingPodSpec.ServiceAccountName != desiredPodSpec.ServiceAccountName {
+		existingPodSpec.ServiceAccountName = desiredPodSpec.ServiceAccountName
+		existingPodSpec.DeprecatedServiceAccount = desiredPodSpec.ServiceAccountName
+		update = true
+	}
+
+	return update, nil
+}
+
+// zyncQueServiceAccountTokenMutator reconciles the projected ServiceAccount token
+// volume of zync-que, including switching from and to the legacy automounted token
+func zyncQueServiceAccountTokenMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	changed := false
+	existingSpec := &existing.Spec.Template.Spec
+	desiredSpec := &desired.Spec.Template.Spec
+
+	if !reflect.DeepEqual(existingSpec.AutomountServiceAccountToken, desiredSpec.AutomountServiceAccountToken) {
+		existingSpec.AutomountServiceAccountToken = desiredSpec.AutomountServiceAccountToken
+		changed = true
+	}
+
+	tmpChanged := reconcilers.DeploymentConfigVolumeReconciler(desired, existing, component.ZyncQueServiceAccountTokenVolumeName)
+	changed = changed || tmpChanged
+
+	return changed, nil
+}
+
+// zyncQueWorkerMutator reconciles the que workers settings. The args of the que
+// container are reconciled too, as they used to hardcode the worker count
+func zyncQueWorkerMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	changed := false
+
+	for _, envVar := range []string{
+		component.ZyncQueWorkerCountEnvVarName,
+		component.ZyncQuePollingIntervalEnvVarName,
+	} {
+		tmpChanged := reconcilers.DeploymentConfigEnvVarReconciler(desired, existing, envVar)
+		changed = changed || tmpChanged
+	}
+
+	existingContainer := &existing.Spec.Template.Spec.Containers[0]
+	desiredContainer := &desired.Spec.Template.Spec.Containers[0]
+	if !reflect.DeepEqual(existingContainer.Args, desiredContainer.Args) {
+		existingContainer.Args = desiredContainer.Args
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// zyncQuePodMonitorMutator reconciles the pod labels added to the zync-que metrics
+func zyncQuePodMonitorMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*monitoringv1.PodMonitor)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PodMonitor", existingObj)
+	}
+	desired, ok := desiredObj.(*monitoringv1.PodMonitor)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PodMonitor", desiredObj)
+	}
+
+	if reflect.DeepEqual(existing.Spec.PodTargetLabels, desired.Spec.PodTargetLabels) {
+		return false, nil
+	}
+
+	existing.Spec.PodTargetLabels = desired.Spec.PodTargetLabels
+	return true, nil
+}
+
+// reconcileQueLegacyTokenSecrets deletes the long-lived token secrets of the zync-que
+// ServiceAccount when projected tokens are used. It is done only once and tracked
+// with an annotation, as clusters still autogenerating those secrets would recreate them.
+func (r *ZyncReconciler) reconcileQueLegacyTokenSecrets(legacy bool) error {
+	serviceAccount := &v1.ServiceAccount{}
+	serviceAccountName := r.apiManager.ServiceAccountName(component.ZyncQueServiceAccountName)
+	err := r.GetResource(types.NamespacedName{Name: serviceAccountName, Namespace: r.apiManager.Namespace}, serviceAccount)
+	if err != nil {
+		return err
+	}
+
+	_, cleaned := serviceAccount.Annotations[component.ZyncQueLegacyTokenSecretsCleanedAnnotation]
+	if legacy {
+		if cleaned {
+			delete(serviceAccount.Annotations, component.ZyncQueLegacyTokenSecretsCleanedAnnotation)
+			return r.UpdateResource(serviceAccount)
+		}
+		return nil
+	}
+	if cleaned {
+		return nil
+	}
+
+	secrets, err := r.listSecrets()
+	if err != nil {
+		return err
+	}
+
+	// Token secrets used by OpenShift to generate the image pull secrets are kept
+	dockercfgTokenSecrets := map[string]bool{}
+	for idx := range secrets {
+		if secrets[idx].Type == v1.SecretTypeDockercfg {
+			dockercfgTokenSecrets[secrets[idx].Annotations[openshiftTokenSecretAnnotation]] = true
+		}
+	}
+
+	deleted := map[string]bool{}
+	for idx := range secrets {
+		secret := &secrets[idx]
+		if secret.Type != v1.SecretTypeServiceAccountToken ||
+			secret.Annotations[v1.ServiceAccountNameKey] != serviceAccountName ||
+			dockercfgTokenSecrets[secret.Name] {
+			continue
+		}
+
+		r.Logger().Info("Deleting zync-que legacy ServiceAccount token secret", "secret", secret.Name)
+		err = r.DeleteResource(secret)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		deleted[secret.Name] = true
+	}
+
+	secretRefs := []v1.ObjectReference{}
+	for _, ref := range serviceAccount.Secrets {
+		if !deleted[ref.Name] {
+			secretRefs = append(secretRefs, ref)
+		}
+	}
+	serviceAccount.Secrets = secretRefs
+
+	if serviceAccount.Annotations == nil {
+		serviceAccount.Annotations = map[string]string{}
+	}
+	serviceAccount.Annotations[component.ZyncQueLegacyTokenSecretsCleanedAnnotation] = "true"
+
+	return r.UpdateResource(serviceAccount)
+}
+
 func Zync(apimanager *appsv1alpha1.APIManager, client client.Client) (*component.Zync, error) {
-	optsProvider := NewZyncOptionsProvider(apimanager, apimanager.Namespace, client)
-	opts, err := optsProvider.GetZyncOptions()
+	opts, err := DefaultOptionsCache.GetOrCompute(apimanager, "zync", client, func() (interface{}, *helper.SecretSource, error) {
+		optsProvider := NewZyncOptionsProvider(apimanager, apimanager.Namespace, client)
+		opts, err := optsProvider.GetZyncOptions()
+		return opts, optsProvider.secretSource, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return component.NewZync(opts.(*component.ZyncOptions)), nil
+}
+
+// listSecrets returns the secrets of the APIManager namespace. They are listed as unstructured,
+// as the SecretList type is also registered in the scheme by the OpenShift image API
+// and the kind of the typed list is ambiguous
+func (r *ZyncReconciler) listSecrets() ([]v1.Secret, error) {
+	secretList := &unstructured.UnstructuredList{}
+	secretList.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("SecretList"))
+	err := r.Client().List(r.Context(), secretList, client.InNamespace(r.apiManager.Namespace))
 	if err != nil {
 		return nil, err
 	}
-	return component.NewZync(opts), nil
+
+	secrets := make([]v1.Secret, len(secretList.Items))
+	for idx := range secretList.Items {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(secretList.Items[idx].Object, &secrets[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
 }
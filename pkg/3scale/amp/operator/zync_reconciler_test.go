@@ -2,25 +2,31 @@ package operator
 
 import (
 	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	"k8s.io/api/policy/v1beta1"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/google/go-cmp/cmp"
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
@@ -253,3 +259,930 @@ func TestNewZyncReconcilerWithAllExternalDatabases(t *testing.T) {
 		})
 	}
 }
+
+func TestZyncReconcilerRailsProxyEnvVars(t *testing.T) {
+	var (
+		log            = logf.Log.WithName("operator_test")
+		falseValue     = false
+		trustedProxies = []string{"10.0.0.0/8", "172.16.0.0/12", "fd00::/8"}
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.Zync.AppSpec.ForceSSL = &falseValue
+	apimanager.Spec.Zync.AppSpec.TrustedProxies = trustedProxies
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+	_, err = zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zyncDC := &appsv1.DeploymentConfig{}
+	err = cl.Get(ctx, types.NamespacedName{Name: component.ZyncName, Namespace: namespace}, zyncDC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		envVarName    string
+		expectedValue string
+	}{
+		{component.ZyncForceSSLEnvVarName, "false"},
+		{component.ZyncTrustedProxiesEnvVarName, "10.0.0.0/8,172.16.0.0/12,fd00::/8"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.envVarName, func(subT *testing.T) {
+			env := zyncDC.Spec.Template.Spec.Containers[0].Env
+			idx := helper.FindEnvVar(env, tc.envVarName)
+			if idx < 0 {
+				subT.Fatalf("env var %s not found", tc.envVarName)
+			}
+			if env[idx].Value != tc.expectedValue {
+				subT.Errorf("env var %s: expected '%s', got '%s'", tc.envVarName, tc.expectedValue, env[idx].Value)
+			}
+		})
+	}
+
+	// zync-que does not get the rails proxy related env vars
+	zyncQueDC := &appsv1.DeploymentConfig{}
+	err = cl.Get(ctx, types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}, zyncQueDC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if helper.FindEnvVar(zyncQueDC.Spec.Template.Spec.Containers[0].Env, component.ZyncForceSSLEnvVarName) >= 0 {
+		t.Errorf("unexpected env var %s in zync-que", component.ZyncForceSSLEnvVarName)
+	}
+}
+
+func TestZyncQueServiceAccountToken(t *testing.T) {
+	var (
+		name                 = "example-apimanager"
+		namespace            = "operator-unittest"
+		wildcardDomain       = "test.3scale.net"
+		log                  = logf.Log.WithName("operator_test")
+		appLabel             = "someLabel"
+		tenantName           = "someTenant"
+		trueValue            = true
+		oneValue       int64 = 1
+	)
+
+	ctx := context.TODO()
+
+	apimanager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1alpha1.APIManagerSpec{
+			APIManagerCommonSpec: appsv1alpha1.APIManagerCommonSpec{
+				AppLabel:                     &appLabel,
+				ImageStreamTagImportInsecure: &trueValue,
+				WildcardDomain:               wildcardDomain,
+				TenantName:                   &tenantName,
+				ResourceRequirementsEnabled:  &trueValue,
+			},
+			Zync: &appsv1alpha1.ZyncSpec{
+				AppSpec: &appsv1alpha1.ZyncAppSpec{Replicas: &oneValue},
+				QueSpec: &appsv1alpha1.ZyncQueSpec{Replicas: &oneValue},
+			},
+			PodDisruptionBudget: &appsv1alpha1.PodDisruptionBudgetSpec{Enabled: true},
+		},
+	}
+
+	tokenSecret := func(secretName string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secretName,
+				Namespace:   namespace,
+				Annotations: map[string]string{v1.ServiceAccountNameKey: component.ZyncQueServiceAccountName},
+			},
+			Type: v1.SecretTypeServiceAccountToken,
+		}
+	}
+	dockercfgSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "zync-que-sa-dockercfg-abcde",
+			Namespace:   namespace,
+			Annotations: map[string]string{openshiftTokenSecretAnnotation: "zync-que-sa-token-dockercfg"},
+		},
+		Type: v1.SecretTypeDockercfg,
+	}
+	serviceAccount := &v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: component.ZyncQueServiceAccountName, Namespace: namespace},
+		Secrets: []v1.ObjectReference{
+			{Name: "zync-que-sa-token-legacy"},
+			{Name: "zync-que-sa-dockercfg-abcde"},
+		},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{
+		apimanager, serviceAccount, dockercfgSecret,
+		tokenSecret("zync-que-sa-token-legacy"), tokenSecret("zync-que-sa-token-dockercfg"),
+	}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := imagev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := routev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+	_, err := zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zyncQueDC := &appsv1.DeploymentConfig{}
+	err = cl.Get(ctx, types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}, zyncQueDC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	podSpec := zyncQueDC.Spec.Template.Spec
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Error("expected ServiceAccount token automount to be disabled")
+	}
+	volumeIdx := helper.FindVolumeByName(podSpec.Volumes, component.ZyncQueServiceAccountTokenVolumeName)
+	if volumeIdx < 0 || podSpec.Volumes[volumeIdx].Projected == nil {
+		t.Fatal("expected projected token volume")
+	}
+	tokenProjection := podSpec.Volumes[volumeIdx].Projected.Sources[0].ServiceAccountToken
+	if tokenProjection == nil || *tokenProjection.ExpirationSeconds != component.DefaultZyncQueServiceAccountTokenExpirationSeconds {
+		t.Errorf("unexpected token projection: %v", tokenProjection)
+	}
+	if helper.FindVolumeMountByName(podSpec.Containers[0].VolumeMounts, component.ZyncQueServiceAccountTokenVolumeName) < 0 {
+		t.Error("expected projected token volume mount")
+	}
+
+	err = cl.Get(ctx, types.NamespacedName{Name: "zync-que-sa-token-legacy", Namespace: namespace}, &v1.Secret{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("expected legacy token secret to be deleted, got %v", err)
+	}
+	err = cl.Get(ctx, types.NamespacedName{Name: "zync-que-sa-token-dockercfg", Namespace: namespace}, &v1.Secret{})
+	if err != nil {
+		t.Errorf("expected dockercfg token secret to be kept, got %v", err)
+	}
+
+	existingSA := &v1.ServiceAccount{}
+	err = cl.Get(ctx, types.NamespacedName{Name: component.ZyncQueServiceAccountName, Namespace: namespace}, existingSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := existingSA.Annotations[component.ZyncQueLegacyTokenSecretsCleanedAnnotation]; !ok {
+		t.Error("expected legacy token secrets cleaned annotation")
+	}
+	if len(existingSA.Secrets) != 1 || existingSA.Secrets[0].Name != "zync-que-sa-dockercfg-abcde" {
+		t.Errorf("unexpected ServiceAccount secrets: %v", existingSA.Secrets)
+	}
+}
+
+func TestZyncQueServiceAccountTokenMutator(t *testing.T) {
+	newQueDC := func(legacy bool) *appsv1.DeploymentConfig {
+		opts := &component.ZyncOptions{
+			ZyncQueServiceAccountTokenLegacy:            legacy,
+			ZyncQueServiceAccountTokenExpirationSeconds: component.DefaultZyncQueServiceAccountTokenExpirationSeconds,
+		}
+		return component.NewZync(opts).QueDeploymentConfig()
+	}
+
+	existing := newQueDC(true)
+	changed, err := zyncQueServiceAccountTokenMutator(newQueDC(false), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change switching to projected token")
+	}
+
+	changed, err = zyncQueServiceAccountTokenMutator(newQueDC(false), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("unexpected change with projected token already in place")
+	}
+
+	changed, err = zyncQueServiceAccountTokenMutator(newQueDC(true), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change switching to legacy token")
+	}
+	if existing.Spec.Template.Spec.AutomountServiceAccountToken != nil ||
+		helper.FindVolumeByName(existing.Spec.Template.Spec.Volumes, component.ZyncQueServiceAccountTokenVolumeName) >= 0 ||
+		helper.FindVolumeMountByName(existing.Spec.Template.Spec.Containers[0].VolumeMounts, component.ZyncQueServiceAccountTokenVolumeName) >= 0 {
+		t.Errorf("unexpected pod spec in legacy mode: %v", existing.Spec.Template.Spec)
+	}
+}
+
+func TestZyncReconcilerDatabaseMaintenance(t *testing.T) {
+	var (
+		log       = logf.Log.WithName("operator_test")
+		trueValue = true
+		schedule  = "30 2 * * *"
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.Zync.DatabaseMaintenance = &appsv1alpha1.ZyncDatabaseMaintenanceSpec{
+		Enabled:  &trueValue,
+		Schedule: &schedule,
+		Repack:   &trueValue,
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+	_, err = zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cronJobKey := types.NamespacedName{Name: component.ZyncDatabaseMaintenanceCronJobName, Namespace: namespace}
+	cronJob := &batchv1beta1.CronJob{}
+	err = cl.Get(ctx, cronJobKey, cronJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cronJob.Spec.Schedule != schedule {
+		t.Errorf("expected schedule '%s', got '%s'", schedule, cronJob.Spec.Schedule)
+	}
+	if cronJob.Spec.ConcurrencyPolicy != batchv1beta1.ForbidConcurrent {
+		t.Errorf("expected concurrent maintenance jobs to be forbidden")
+	}
+	args := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args
+	if len(args) != 1 || !strings.Contains(args[0], "VACUUM (VERBOSE, ANALYZE)") || !strings.Contains(args[0], "pg_repack") {
+		t.Errorf("unexpected maintenance script: %v", args)
+	}
+
+	// Disabling the maintenance removes the CronJob
+	apimanager.Spec.Zync.DatabaseMaintenance.Enabled = nil
+	_, err = zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cl.Get(ctx, cronJobKey, &batchv1beta1.CronJob{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("expected maintenance CronJob to be removed, got: %v", err)
+	}
+}
+
+func TestZyncReconcilerExternallyScaledReplicas(t *testing.T) {
+	var (
+		log                  = logf.Log.WithName("operator_test")
+		externalReplicas     = int32(5)
+		zyncDCKey            = types.NamespacedName{Name: component.ZyncName, Namespace: namespace}
+		zyncQueDCKey         = types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}
+		expectedZyncReplicas = int32(zyncReplica)
+	)
+
+	ctx := context.TODO()
+
+	// zync-que replicas are managed externally, e.g. by a HorizontalPodAutoscaler
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.Zync.QueSpec.Replicas = nil
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+
+	scale := func(key types.NamespacedName, replicas int32) {
+		t.Helper()
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, key, dc); err != nil {
+			t.Fatal(err)
+		}
+		dc.Spec.Replicas = replicas
+		if err := cl.Update(ctx, dc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reconcileAndCheck := func(key types.NamespacedName, expected int32) {
+		t.Helper()
+		if _, err := zyncReconciler.Reconcile(); err != nil {
+			t.Fatal(err)
+		}
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, key, dc); err != nil {
+			t.Fatal(err)
+		}
+		if dc.Spec.Replicas != expected {
+			t.Errorf("%s: expected %d replicas, got %d", key.Name, expected, dc.Spec.Replicas)
+		}
+	}
+
+	// Replicas are set on creation
+	reconcileAndCheck(zyncQueDCKey, 1)
+	reconcileAndCheck(zyncDCKey, expectedZyncReplicas)
+
+	// Externally scaled zync-que is not reverted
+	scale(zyncQueDCKey, externalReplicas)
+	reconcileAndCheck(zyncQueDCKey, externalReplicas)
+
+	// Managed zync replicas are reverted
+	scale(zyncDCKey, externalReplicas)
+	reconcileAndCheck(zyncDCKey, expectedZyncReplicas)
+
+	// zync-que scaled down to zero is left alone
+	scale(zyncQueDCKey, 0)
+	reconcileAndCheck(zyncQueDCKey, 0)
+
+	// zync-que scaled down for standby is brought up to the replicas it had on activation
+	standbyMode := appsv1alpha1.APIManagerModeStandby
+	activeMode := appsv1alpha1.APIManagerModeActive
+	scale(zyncQueDCKey, externalReplicas)
+	apimanager.Spec.Mode = &standbyMode
+	reconcileAndCheck(zyncQueDCKey, 0)
+	apimanager.Spec.Mode = &activeMode
+	apimanager.Status.Standby = &appsv1alpha1.StandbyStatus{ScaledDownComponents: []string{component.ZyncQueDeploymentName}}
+	reconcileAndCheck(zyncQueDCKey, 0)
+	apimanager.Status.Standby.ScaledDownComponents = nil
+	reconcileAndCheck(zyncQueDCKey, externalReplicas)
+
+	// Setting the replicas in the APIManager takes over the zync-que replicas
+	var queReplicas int64 = 3
+	scale(zyncQueDCKey, externalReplicas)
+	apimanager.Spec.Zync.QueSpec.Replicas = &queReplicas
+	reconcileAndCheck(zyncQueDCKey, int32(queReplicas))
+}
+
+func TestZyncReconcilerPodDisruptionBudgetPolicies(t *testing.T) {
+	var (
+		log           = logf.Log.WithName("operator_test")
+		halfOfPods    = intstr.FromString("50%")
+		allQuePods    = intstr.FromInt(int(zyncQueReplica))
+		twoPods       = intstr.FromInt(2)
+		zyncPDBKey    = types.NamespacedName{Name: component.ZyncName, Namespace: namespace}
+		zyncQuePDBKey = types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.PodDisruptionBudget = &appsv1alpha1.PodDisruptionBudgetSpec{
+		Enabled: true,
+		Zync:    &appsv1alpha1.PodDisruptionBudgetPolicySpec{MaxUnavailable: &halfOfPods},
+		// Blocks the eviction of all the zync-que replicas
+		ZyncQue: &appsv1alpha1.PodDisruptionBudgetPolicySpec{MinAvailable: &allQuePods},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+
+	_, err = zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pdb := &v1beta1.PodDisruptionBudget{}
+	if err := cl.Get(ctx, zyncPDBKey, pdb); err != nil {
+		t.Fatal(err)
+	}
+	if pdb.Spec.MinAvailable != nil || pdb.Spec.MaxUnavailable == nil || *pdb.Spec.MaxUnavailable != halfOfPods {
+		t.Errorf("unexpected zync PDB policy: %+v", pdb.Spec)
+	}
+
+	// The PDB blocking the eviction is kept, so it is not recreated when scaled
+	quePDB := &v1beta1.PodDisruptionBudget{}
+	if err := cl.Get(ctx, zyncQuePDBKey, quePDB); err != nil {
+		t.Fatalf("expected zync-que PDB to be created: %v", err)
+	}
+	if quePDB.Spec.MinAvailable == nil || *quePDB.Spec.MinAvailable != allQuePods {
+		t.Errorf("unexpected zync-que PDB policy: %+v", quePDB.Spec)
+	}
+	warned := false
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, "PodDisruptionBudgetBlocksEviction") {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Error("expected a warning event for the zync-que PDB blocking the eviction")
+	}
+
+	// Policy changes update the existing PDB
+	apimanager.Spec.PodDisruptionBudget.Zync = &appsv1alpha1.PodDisruptionBudgetPolicySpec{MinAvailable: &twoPods}
+	apimanager.Spec.PodDisruptionBudget.ZyncQue = &appsv1alpha1.PodDisruptionBudgetPolicySpec{MinAvailable: &twoPods}
+	_, err = zyncReconciler.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updatedPDB := &v1beta1.PodDisruptionBudget{}
+	if err := cl.Get(ctx, zyncPDBKey, updatedPDB); err != nil {
+		t.Fatal(err)
+	}
+	if updatedPDB.Spec.MaxUnavailable != nil || updatedPDB.Spec.MinAvailable == nil || *updatedPDB.Spec.MinAvailable != twoPods {
+		t.Errorf("unexpected zync PDB policy: %+v", updatedPDB.Spec)
+	}
+	if updatedPDB.ResourceVersion == pdb.ResourceVersion {
+		t.Error("expected zync PDB to be updated")
+	}
+
+	updatedQuePDB := &v1beta1.PodDisruptionBudget{}
+	if err := cl.Get(ctx, zyncQuePDBKey, updatedQuePDB); err != nil {
+		t.Fatal(err)
+	}
+	if updatedQuePDB.ResourceVersion == quePDB.ResourceVersion || updatedQuePDB.Spec.MinAvailable == nil || *updatedQuePDB.Spec.MinAvailable != twoPods {
+		t.Errorf("expected zync-que PDB to be updated: %+v", updatedQuePDB)
+	}
+}
+
+func TestZyncReconcilerUnreachableTolerationSeconds(t *testing.T) {
+	var (
+		log                 = logf.Log.WithName("operator_test")
+		globalSeconds       = int64(120)
+		queSeconds          = int64(60)
+		updatedSeconds      = int64(300)
+		userTolerations     = getTestTolerations("zync")
+		zyncDCKey           = types.NamespacedName{Name: component.ZyncName, Namespace: namespace}
+		zyncQueDCKey        = types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}
+		zyncDatabaseDCKey   = types.NamespacedName{Name: component.ZyncDatabaseDeploymentName, Namespace: namespace}
+		unreachableTaints   = []string{helper.TaintNodeNotReady, helper.TaintNodeUnreachable}
+		expectedTolerations = func(tolerations []v1.Toleration, seconds int64) []v1.Toleration {
+			expected := append([]v1.Toleration{}, tolerations...)
+			for _, key := range unreachableTaints {
+				expected = append(expected, v1.Toleration{
+					Key:               key,
+					Operator:          v1.TolerationOpExists,
+					Effect:            v1.TaintEffectNoExecute,
+					TolerationSeconds: &[]int64{seconds}[0],
+				})
+			}
+			return expected
+		}
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.UnreachableTolerationSeconds = &globalSeconds
+	apimanager.Spec.Zync.AppSpec.Tolerations = userTolerations
+	apimanager.Spec.Zync.QueSpec.UnreachableTolerationSeconds = &queSeconds
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+
+	reconcileAndCheck := func(subT *testing.T, expected map[types.NamespacedName][]v1.Toleration) {
+		_, err := zyncReconciler.Reconcile()
+		if err != nil {
+			subT.Fatal(err)
+		}
+
+		for key, expectedDCTolerations := range expected {
+			dc := &appsv1.DeploymentConfig{}
+			if err := cl.Get(ctx, key, dc); err != nil {
+				subT.Fatal(err)
+			}
+			if !reflect.DeepEqual(dc.Spec.Template.Spec.Tolerations, expectedDCTolerations) {
+				subT.Errorf("%s: unexpected tolerations: %s", key.Name, cmp.Diff(dc.Spec.Template.Spec.Tolerations, expectedDCTolerations))
+			}
+		}
+	}
+
+	t.Run("Create", func(subT *testing.T) {
+		reconcileAndCheck(subT, map[types.NamespacedName][]v1.Toleration{
+			// User tolerations come first
+			zyncDCKey:         expectedTolerations(userTolerations, globalSeconds),
+			zyncQueDCKey:      expectedTolerations(nil, queSeconds),
+			zyncDatabaseDCKey: expectedTolerations(nil, globalSeconds),
+		})
+	})
+
+	t.Run("Update", func(subT *testing.T) {
+		apimanager.Spec.UnreachableTolerationSeconds = &updatedSeconds
+		apimanager.Spec.Zync.QueSpec.UnreachableTolerationSeconds = nil
+		reconcileAndCheck(subT, map[types.NamespacedName][]v1.Toleration{
+			zyncDCKey:         expectedTolerations(userTolerations, updatedSeconds),
+			zyncQueDCKey:      expectedTolerations(nil, updatedSeconds),
+			zyncDatabaseDCKey: expectedTolerations(nil, updatedSeconds),
+		})
+	})
+
+	t.Run("Unset", func(subT *testing.T) {
+		apimanager.Spec.UnreachableTolerationSeconds = nil
+		reconcileAndCheck(subT, map[types.NamespacedName][]v1.Toleration{
+			zyncDCKey:    userTolerations,
+			zyncQueDCKey: nil,
+		})
+	})
+}
+
+func TestZyncReconcilerCustomLabelsAndAnnotations(t *testing.T) {
+	var (
+		log          = logf.Log.WithName("operator_test")
+		zyncDCKey    = types.NamespacedName{Name: component.ZyncName, Namespace: namespace}
+		zyncQueDCKey = types.NamespacedName{Name: component.ZyncQueDeploymentName, Namespace: namespace}
+		zyncSvcKey   = types.NamespacedName{Name: component.ZyncName, Namespace: namespace}
+		zyncQueSAKey = types.NamespacedName{Name: component.ZyncQueServiceAccountName, Namespace: namespace}
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.Zync.AppSpec.Labels = getTestCustomLabels("zync")
+	apimanager.Spec.Zync.AppSpec.Annotations = getTestCustomAnnotations("zync")
+	apimanager.Spec.Zync.QueSpec.Labels = getTestCustomLabels("zync-que")
+	apimanager.Spec.Zync.QueSpec.Annotations = getTestCustomAnnotations("zync-que")
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	err := appsv1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imagev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = routev1.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+
+	reconcile := func(subT *testing.T) {
+		if _, err := zyncReconciler.Reconcile(); err != nil {
+			subT.Fatal(err)
+		}
+	}
+
+	t.Run("Create", func(subT *testing.T) {
+		reconcile(subT)
+
+		for key, prefix := range map[types.NamespacedName]string{zyncDCKey: "zync", zyncQueDCKey: "zync-que"} {
+			dc := &appsv1.DeploymentConfig{}
+			if err := cl.Get(ctx, key, dc); err != nil {
+				subT.Fatal(err)
+			}
+			podLabels := dc.Spec.Template.Labels
+			if podLabels["custom-label"] != prefix {
+				subT.Errorf("%s: custom label not found in the pod template labels: %v", key.Name, podLabels)
+			}
+			// The labels set by the operator take precedence
+			if podLabels["deploymentConfig"] != key.Name {
+				subT.Errorf("%s: expected deploymentConfig label '%s', got '%s'", key.Name, key.Name, podLabels["deploymentConfig"])
+			}
+			if dc.Spec.Template.Annotations["custom-annotation"] != prefix {
+				subT.Errorf("%s: custom annotation not found in the pod template annotations: %v", key.Name, dc.Spec.Template.Annotations)
+			}
+		}
+
+		service := &v1.Service{}
+		if err := cl.Get(ctx, zyncSvcKey, service); err != nil {
+			subT.Fatal(err)
+		}
+		if service.Labels["custom-label"] != "zync" || service.Annotations["custom-annotation"] != "zync" {
+			subT.Errorf("custom labels and annotations not found in the service: %v %v", service.Labels, service.Annotations)
+		}
+
+		serviceAccount := &v1.ServiceAccount{}
+		if err := cl.Get(ctx, zyncQueSAKey, serviceAccount); err != nil {
+			subT.Fatal(err)
+		}
+		if serviceAccount.Labels["custom-label"] != "zync-que" || serviceAccount.Annotations["custom-annotation"] != "zync-que" {
+			subT.Errorf("custom labels and annotations not found in the service account: %v %v", serviceAccount.Labels, serviceAccount.Annotations)
+		}
+	})
+
+	t.Run("UpdateAnnotation", func(subT *testing.T) {
+		apimanager.Spec.Zync.AppSpec.Annotations = map[string]string{"custom-annotation": "updated"}
+		reconcile(subT)
+
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, zyncDCKey, dc); err != nil {
+			subT.Fatal(err)
+		}
+		if dc.Spec.Template.Annotations["custom-annotation"] != "updated" {
+			subT.Errorf("pod template annotation not updated: %v", dc.Spec.Template.Annotations)
+		}
+
+		service := &v1.Service{}
+		if err := cl.Get(ctx, zyncSvcKey, service); err != nil {
+			subT.Fatal(err)
+		}
+		if service.Annotations["custom-annotation"] != "updated" {
+			subT.Errorf("service annotation not updated: %v", service.Annotations)
+		}
+	})
+}
+
+func TestZyncReconcilerServiceAccounts(t *testing.T) {
+	var (
+		log         = logf.Log.WithName("operator_test")
+		ampName     = "threescale-workloads"
+		zyncQueName = "threescale-zync-que"
+		roleArn     = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/zync-que"}
+	)
+
+	ctx := context.TODO()
+
+	apimanager := basicApimanagerSpecTestZyncOptions()
+	apimanager.Spec.ServiceAccounts = &appsv1alpha1.ServiceAccountsSpec{
+		Amp:     &appsv1alpha1.ServiceAccountSpec{Name: &ampName},
+		ZyncQue: &appsv1alpha1.ServiceAccountSpec{Name: &zyncQueName, Annotations: roleArn},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{apimanager}
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanager)
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := imagev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := routev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoringv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := grafanav1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(objs...)
+	clientAPIReader := fake.NewFakeClient(objs...)
+	clientset := fakeclientset.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(10000)
+
+	baseReconciler := reconcilers.NewBaseReconciler(ctx, cl, s, clientAPIReader, log, clientset.Discovery(), recorder)
+	baseAPIManagerLogicReconciler := NewBaseAPIManagerLogicReconciler(baseReconciler, apimanager)
+
+	zyncReconciler := NewZyncReconciler(baseAPIManagerLogicReconciler)
+	if _, err := zyncReconciler.Reconcile(); err != nil {
+		t.Fatal(err)
+	}
+
+	serviceAccount := &v1.ServiceAccount{}
+	if err := cl.Get(ctx, types.NamespacedName{Name: zyncQueName, Namespace: namespace}, serviceAccount); err != nil {
+		t.Fatal(err)
+	}
+	if serviceAccount.Annotations["eks.amazonaws.com/role-arn"] != roleArn["eks.amazonaws.com/role-arn"] {
+		t.Errorf("role annotation not found in the service account: %v", serviceAccount.Annotations)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{}
+	if err := cl.Get(ctx, types.NamespacedName{Name: "zync-que-rolebinding", Namespace: namespace}, roleBinding); err != nil {
+		t.Fatal(err)
+	}
+	if len(roleBinding.Subjects) != 1 || roleBinding.Subjects[0].Name != zyncQueName {
+		t.Errorf("unexpected role binding subjects: %v", roleBinding.Subjects)
+	}
+
+	for dcName, expected := range map[string]string{component.ZyncName: ampName, component.ZyncQueDeploymentName: zyncQueName} {
+		dc := &appsv1.DeploymentConfig{}
+		if err := cl.Get(ctx, types.NamespacedName{Name: dcName, Namespace: namespace}, dc); err != nil {
+			t.Fatal(err)
+		}
+		if dc.Spec.Template.Spec.ServiceAccountName != expected {
+			t.Errorf("%s: expected service account %s, got %s", dcName, expected, dc.Spec.Template.Spec.ServiceAccountName)
+		}
+	}
+}
+
+func TestZyncQueWorkerMutator(t *testing.T) {
+	newQueDC := func(workerCount, pollingInterval int32) *appsv1.DeploymentConfig {
+		opts := &component.ZyncOptions{
+			ZyncQueWorkerCount:     workerCount,
+			ZyncQuePollingInterval: pollingInterval,
+		}
+		return component.NewZync(opts).QueDeploymentConfig()
+	}
+
+	// Deployed before the worker settings were configurable
+	existing := newQueDC(component.DefaultZyncQueWorkerCount, component.DefaultZyncQuePollingInterval)
+	container := &existing.Spec.Template.Spec.Containers[0]
+	container.Args = []string{"-c", "bundle exec rake 'que[--worker-count 10]'"}
+	container.Env = container.Env[:len(container.Env)-2]
+
+	changed, err := zyncQueWorkerMutator(newQueDC(component.DefaultZyncQueWorkerCount, component.DefaultZyncQuePollingInterval), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change of the hardcoded worker count")
+	}
+
+	changed, err = zyncQueWorkerMutator(newQueDC(25, 1), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected change of the worker settings")
+	}
+	for name, expected := range map[string]string{
+		component.ZyncQueWorkerCountEnvVarName:     "25",
+		component.ZyncQuePollingIntervalEnvVarName: "1",
+	} {
+		idx := helper.FindEnvVar(existing.Spec.Template.Spec.Containers[0].Env, name)
+		if idx < 0 || existing.Spec.Template.Spec.Containers[0].Env[idx].Value != expected {
+			t.Errorf("expected env var %s=%s: %v", name, expected, existing.Spec.Template.Spec.Containers[0].Env)
+		}
+	}
+
+	changed, err = zyncQueWorkerMutator(newQueDC(25, 1), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("unexpected change with the worker settings already in place")
+	}
+}
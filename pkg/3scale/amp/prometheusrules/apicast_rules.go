@@ -22,12 +22,12 @@ func (b *ApicastPrometheusRuleFactory) Type() string {
 	return "apicast"
 }
 
-func (b *ApicastPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (b *ApicastPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := apicastOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewApicast(options).ApicastPrometheusRules()
+	return component.NewApicast(options).ApicastPrometheusRules(), nil
 }
 
 func apicastOptions(ns string) (*component.ApicastOptions, error) {
@@ -0,0 +1,31 @@
+package prometheusrules
+
+import (
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+func init() {
+	PrometheusRuleFactories = append(PrometheusRuleFactories, NewApicastSLOPrometheusRuleFactory)
+}
+
+type ApicastSLOPrometheusRuleFactory struct {
+}
+
+func NewApicastSLOPrometheusRuleFactory() PrometheusRuleFactory {
+	return &ApicastSLOPrometheusRuleFactory{}
+}
+
+func (b *ApicastSLOPrometheusRuleFactory) Type() string {
+	return "apicast-slo"
+}
+
+func (b *ApicastSLOPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
+	options, err := apicastOptions(ns)
+	if err != nil {
+		return nil, err
+	}
+	options.SLO = component.DefaultSLOOptions()
+	return component.NewApicast(options).ApicastSLOPrometheusRules(), nil
+}
@@ -21,10 +21,10 @@ func (b *BackendListenerPrometheusRuleFactory) Type() string {
 	return "backend-listener"
 }
 
-func (b *BackendListenerPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (b *BackendListenerPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := backendOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewBackend(options).BackendListenerPrometheusRules()
+	return component.NewBackend(options).BackendListenerPrometheusRules(), nil
 }
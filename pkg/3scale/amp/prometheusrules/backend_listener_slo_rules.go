@@ -0,0 +1,31 @@
+package prometheusrules
+
+import (
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+func init() {
+	PrometheusRuleFactories = append(PrometheusRuleFactories, NewBackendListenerSLOPrometheusRuleFactory)
+}
+
+type BackendListenerSLOPrometheusRuleFactory struct {
+}
+
+func NewBackendListenerSLOPrometheusRuleFactory() PrometheusRuleFactory {
+	return &BackendListenerSLOPrometheusRuleFactory{}
+}
+
+func (b *BackendListenerSLOPrometheusRuleFactory) Type() string {
+	return "backend-listener-slo"
+}
+
+func (b *BackendListenerSLOPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
+	options, err := backendOptions(ns)
+	if err != nil {
+		return nil, err
+	}
+	options.SLO = component.DefaultSLOOptions()
+	return component.NewBackend(options).BackendListenerSLOPrometheusRules(), nil
+}
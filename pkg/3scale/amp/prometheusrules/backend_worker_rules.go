@@ -22,12 +22,12 @@ func (b *BackendWorkerPrometheusRuleFactory) Type() string {
 	return "backend-worker"
 }
 
-func (b *BackendWorkerPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (b *BackendWorkerPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := backendOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewBackend(options).BackendWorkerPrometheusRules()
+	return component.NewBackend(options).BackendWorkerPrometheusRules(), nil
 }
 
 func backendOptions(ns string) (*component.BackendOptions, error) {
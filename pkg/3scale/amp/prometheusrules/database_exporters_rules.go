@@ -0,0 +1,54 @@
+package prometheusrules
+
+import (
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+func init() {
+	PrometheusRuleFactories = append(PrometheusRuleFactories,
+		newDatabaseExporterPrometheusRuleFactoryBuilder(component.NewBackendRedisExporter),
+		newDatabaseExporterPrometheusRuleFactoryBuilder(component.NewSystemRedisExporter),
+		newDatabaseExporterPrometheusRuleFactoryBuilder(component.NewSystemMySQLExporter),
+		newDatabaseExporterPrometheusRuleFactoryBuilder(component.NewSystemPostgreSQLExporter),
+		newDatabaseExporterPrometheusRuleFactoryBuilder(component.NewZyncDatabaseExporter),
+	)
+}
+
+type DatabaseExporterPrometheusRuleFactory struct {
+	newExporter func(*component.DatabaseExportersOptions) *component.DatabaseExporter
+}
+
+func newDatabaseExporterPrometheusRuleFactoryBuilder(newExporter func(*component.DatabaseExportersOptions) *component.DatabaseExporter) PrometheusRuleFactoryBuilder {
+	return func() PrometheusRuleFactory {
+		return &DatabaseExporterPrometheusRuleFactory{newExporter: newExporter}
+	}
+}
+
+func (d *DatabaseExporterPrometheusRuleFactory) Type() string {
+	return d.newExporter(component.NewDatabaseExportersOptions()).Name()
+}
+
+func (d *DatabaseExporterPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
+	options, err := databaseExportersOptions(ns)
+	if err != nil {
+		return nil, err
+	}
+	return d.newExporter(options).PrometheusRules(), nil
+}
+
+func databaseExportersOptions(ns string) (*component.DatabaseExportersOptions, error) {
+	o := component.NewDatabaseExportersOptions()
+
+	// Required options for generating PrometheusRules
+	o.CommonLabels = map[string]string{"app": "3scale-api-management"}
+	o.Namespace = ns
+
+	// Required options for passing validation, but not needed for generating the prometheus rules
+	o.RedisExporterImage = "_"
+	o.MySQLExporterImage = "_"
+	o.PostgreSQLExporterImage = "_"
+
+	return o, o.Validate()
+}
@@ -23,12 +23,12 @@ func (s *SystemAppPrometheusRuleFactory) Type() string {
 	return "system-app"
 }
 
-func (s *SystemAppPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (s *SystemAppPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := systemOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewSystem(options).SystemAppPrometheusRules()
+	return component.NewSystem(options).SystemAppPrometheusRules(), nil
 }
 
 func systemOptions(ns string) (*component.SystemOptions, error) {
@@ -48,6 +48,7 @@ func systemOptions(ns string) (*component.SystemOptions, error) {
 	o.EventHooksURL = "_"
 	o.ApicastSystemMasterProxyConfigEndpoint = "_"
 	o.MemcachedServers = "_"
+	o.CacheStore = component.SystemCacheStoreMemcached
 	o.AdminEmail = &tmp
 	o.AppProviderContainerResourceRequirements = &corev1.ResourceRequirements{}
 	o.AppMasterContainerResourceRequirements = &corev1.ResourceRequirements{}
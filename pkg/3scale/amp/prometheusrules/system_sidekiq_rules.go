@@ -21,10 +21,10 @@ func (s *SystemSidekiqPrometheusRuleFactory) Type() string {
 	return "system-sidekiq"
 }
 
-func (s *SystemSidekiqPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (s *SystemSidekiqPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := systemOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewSystem(options).SystemSidekiqPrometheusRules()
+	return component.NewSystem(options).SystemSidekiqPrometheusRules(), nil
 }
@@ -21,10 +21,10 @@ func (s *ZyncQuePrometheusRuleFactory) Type() string {
 	return "zync-que"
 }
 
-func (s *ZyncQuePrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (s *ZyncQuePrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := zyncOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewZync(options).ZyncQuePrometheusRules()
+	return component.NewZync(options).ZyncQuePrometheusRules(), nil
 }
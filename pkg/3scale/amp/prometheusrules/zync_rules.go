@@ -22,12 +22,12 @@ func (s *ZyncPrometheusRuleFactory) Type() string {
 	return "zync"
 }
 
-func (s *ZyncPrometheusRuleFactory) PrometheusRule(_ bool, ns string) *monitoringv1.PrometheusRule {
+func (s *ZyncPrometheusRuleFactory) PrometheusRule(_ bool, ns string) (*monitoringv1.PrometheusRule, error) {
 	options, err := zyncOptions(ns)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return component.NewZync(options).ZyncPrometheusRules()
+	return component.NewZync(options).ZyncPrometheusRules(), nil
 }
 
 func zyncOptions(ns string) (*component.ZyncOptions, error) {
@@ -57,6 +57,10 @@ func zyncOptions(ns string) (*component.ZyncOptions, error) {
 
 	o.DatabaseURL = "_"
 	o.ZyncQueServiceAccountImagePullSecrets = component.DefaultZyncQueServiceAccountImagePullSecrets()
+	o.ZyncQueServiceAccountName = component.ZyncQueServiceAccountName
+	o.ZyncQueServiceAccountTokenExpirationSeconds = component.DefaultZyncQueServiceAccountTokenExpirationSeconds
+	o.ZyncQueWorkerCount = component.DefaultZyncQueWorkerCount
+	o.ZyncQuePollingInterval = component.DefaultZyncQuePollingInterval
 
 	return o, o.Validate()
 }
@@ -2551,7 +2551,222 @@
                 "align": false,
                 "alignLevel": null
             }
-        }
+        }{{ if .SLO }},
+        {
+            "collapsed": false,
+            "gridPos": {
+                "h": 1,
+                "w": 24,
+                "x": 0,
+                "y": 117
+            },
+            "id": 63,
+            "panels": [],
+            "title": "SLO (production)",
+            "type": "row"
+        },
+        {
+            "aliasColors": {},
+            "bars": false,
+            "dashLength": 10,
+            "dashes": false,
+            "datasource": "$datasource",
+            "fill": 1,
+            "gridPos": {
+                "h": 8,
+                "w": 12,
+                "x": 0,
+                "y": 118
+            },
+            "id": 64,
+            "legend": {
+                "avg": false,
+                "current": false,
+                "max": false,
+                "min": false,
+                "show": true,
+                "total": false,
+                "values": false
+            },
+            "lines": true,
+            "linewidth": 1,
+            "links": [],
+            "nullPointMode": "null",
+            "options": {},
+            "percentage": false,
+            "pointradius": 2,
+            "points": false,
+            "renderer": "flot",
+            "seriesOverrides": [],
+            "spaceLength": 10,
+            "stack": false,
+            "steppedLine": false,
+            "targets": [
+                {
+                    "expr": "namespace:threescale_apicast_production_requests:success_ratio_rate5m{namespace='$namespace'}",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "5m",
+                    "refId": "A"
+                },
+                {
+                    "expr": "namespace:threescale_apicast_production_requests:success_ratio_rate30m{namespace='$namespace'}",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "30m",
+                    "refId": "B"
+                },
+                {
+                    "expr": "namespace:threescale_apicast_production_requests:success_ratio_rate6h{namespace='$namespace'}",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "6h",
+                    "refId": "C"
+                },
+                {
+                    "expr": "{{ .SLO.AvailabilityObjective }} / 100",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "objective",
+                    "refId": "D"
+                }
+            ],
+            "thresholds": [],
+            "timeFrom": null,
+            "timeRegions": [],
+            "timeShift": null,
+            "title": "Success ratio",
+            "tooltip": {
+                "shared": true,
+                "sort": 2,
+                "value_type": "individual"
+            },
+            "type": "graph",
+            "xaxis": {
+                "buckets": null,
+                "mode": "time",
+                "name": null,
+                "show": true,
+                "values": []
+            },
+            "yaxes": [
+                {
+                    "decimals": null,
+                    "format": "percentunit",
+                    "label": null,
+                    "logBase": 1,
+                    "max": null,
+                    "min": null,
+                    "show": true
+                },
+                {
+                    "format": "short",
+                    "label": null,
+                    "logBase": 1,
+                    "max": null,
+                    "min": null,
+                    "show": false
+                }
+            ],
+            "yaxis": {
+                "align": false,
+                "alignLevel": null
+            }
+        },
+        {
+            "aliasColors": {},
+            "bars": false,
+            "dashLength": 10,
+            "dashes": false,
+            "datasource": "$datasource",
+            "fill": 1,
+            "gridPos": {
+                "h": 8,
+                "w": 12,
+                "x": 12,
+                "y": 118
+            },
+            "id": 65,
+            "legend": {
+                "avg": false,
+                "current": false,
+                "max": false,
+                "min": false,
+                "show": true,
+                "total": false,
+                "values": false
+            },
+            "lines": true,
+            "linewidth": 1,
+            "links": [],
+            "nullPointMode": "null",
+            "options": {},
+            "percentage": false,
+            "pointradius": 2,
+            "points": false,
+            "renderer": "flot",
+            "seriesOverrides": [],
+            "spaceLength": 10,
+            "stack": false,
+            "steppedLine": false,
+            "targets": [
+                {
+                    "expr": "namespace:threescale_apicast_production_request_duration_seconds:p95_rate5m{namespace='$namespace'}",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "p95",
+                    "refId": "A"
+                },
+                {
+                    "expr": "namespace:threescale_apicast_production_request_duration_seconds:p99_rate5m{namespace='$namespace'}",
+                    "format": "time_series",
+                    "intervalFactor": 1,
+                    "legendFormat": "p99",
+                    "refId": "B"
+                }
+            ],
+            "thresholds": [],
+            "timeFrom": null,
+            "timeRegions": [],
+            "timeShift": null,
+            "title": "Latency",
+            "tooltip": {
+                "shared": true,
+                "sort": 2,
+                "value_type": "individual"
+            },
+            "type": "graph",
+            "xaxis": {
+                "buckets": null,
+                "mode": "time",
+                "name": null,
+                "show": true,
+                "values": []
+            },
+            "yaxes": [
+                {
+                    "decimals": null,
+                    "format": "s",
+                    "label": null,
+                    "logBase": 1,
+                    "max": null,
+                    "min": null,
+                    "show": true
+                },
+                {
+                    "format": "short",
+                    "label": null,
+                    "logBase": 1,
+                    "max": null,
+                    "min": null,
+                    "show": false
+                }
+            ],
+            "yaxis": {
+                "align": false,
+                "alignLevel": null
+            }
+        }{{ end }}
     ],
     "refresh": "10s",
     "schemaVersion": 18,
@@ -2563,6 +2778,10 @@
     "templating": {
         "list": [
             {
+                "current": {
+                    "text": "{{ .DatasourceName }}",
+                    "value": "{{ .DatasourceName }}"
+                },
                 "hide": 0,
                 "includeAll": false,
                 "label": null,
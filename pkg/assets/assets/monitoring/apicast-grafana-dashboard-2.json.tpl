@@ -353,6 +353,10 @@
     "templating": {
         "list": [
             {
+                "current": {
+                    "text": "{{ .DatasourceName }}",
+                    "value": "{{ .DatasourceName }}"
+                },
                 "hide": 0,
                 "includeAll": false,
                 "label": null,
@@ -1642,6 +1642,10 @@
     "templating": {
       "list": [
         {
+          "current": {
+            "text": "{{ .DatasourceName }}",
+            "value": "{{ .DatasourceName }}"
+          },
           "hide": 0,
           "includeAll": false,
           "label": null,
@@ -1703,7 +1707,7 @@
           "auto": false,
           "auto_count": 30,
           "auto_min": "10s",
-          "datasource": "prometheus",
+          "datasource": "$datasource",
           "hide": 2,
           "includeAll": false,
           "label": null,
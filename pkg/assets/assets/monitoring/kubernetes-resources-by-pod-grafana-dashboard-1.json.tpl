@@ -1448,6 +1448,10 @@
     "templating": {
       "list": [
         {
+          "current": {
+            "text": "{{ .DatasourceName }}",
+            "value": "{{ .DatasourceName }}"
+          },
           "hide": 0,
           "includeAll": false,
           "label": null,
@@ -1530,7 +1534,7 @@
           "auto": false,
           "auto_count": 30,
           "auto_min": "10s",
-          "datasource": "prometheus",
+          "datasource": "$datasource",
           "hide": 2,
           "includeAll": false,
           "label": null,
@@ -2824,6 +2824,10 @@
   "templating": {
     "list": [
       {
+        "current": {
+          "text": "{{ .DatasourceName }}",
+          "value": "{{ .DatasourceName }}"
+        },
         "hide": 0,
         "includeAll": false,
         "label": null,
@@ -84,7 +84,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _monitoringApicastGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7b\x73\xdb\xb6\xb2\xff\xff\x7c\x0a\x5c\x36\xf7\xc4\xed\xc8\x89\x5e\x76\x6c\xcf\x64\xee\x24\x4d\x73\xdb\x99\xa4\xc7\x27\x75\x3a\xa7\x37\x93\x51\x61\x12\x96\x30\x06\x01\x16\x04\xfd\xa8\xeb\x7e\xf6\x3b\x04\x1f\xa2\x08\x80\xa2\x64\x4a\xa2\x24\x78\xe6\x9c\x46\xc4\x82\x04\x76\x17\x8b\xdf\x2e\x16\xc0\xc3\x3f\x00\x00\xc0\x81\x94\x32\x01\x05\x66\x34\x74\xce\x40\xf2\x50\x16\x10\x1c\x0a\xe7\x0c\x7c\xc9\x9f\xc4\x7f\x0f\x33\xbf\x24\xdd\x65\x84\x89\xf8\x89\x3a\x67\xa0\xd7\x51\x4b\x3d\x28\x60\xc8\x22\xee\x22\xe7\x0c\x38\x87\x87\xe0\x7f\x39\xbc\x82\x14\x82\xc3\x43\x47\x43\x8e\x28\xbc\x24\x31\xa9\xe0\x11\xd2\x94\x4f\xb0\x57\x51\x8a\x5d\x46\xbf\x67\x84\xf1\xf8\x5b\x7c\x7c\x09\x0f\xba\x1d\xd0\xef\xf5\x3a\xa0\x7f\x74\xd4\x01\xbd\x6f\x75\x9f\xa4\xd0\x97\x6d\x7b\x33\x65\x04\xf8\x27\x78\x43\x10\x17\xa1\x8e\x5e\xdc\x07\x92\xde\x83\xe1\xe4\x92\x41\xee\x39\x33\x34\x8f\xf9\xaf\xaf\xf2\x5f\x8f\xc9\x2b\x1c\xe4\x61\xa1\xf4\xcd\x19\x53\x24\x7e\xf2\x9c\x33\x40\x23\x42\xb2\x67\x1c\x06\x93\x0b\xc6\x88\xc0\x81\x73\x06\xba\xe9\x63\x82\xe9\x75\x2c\xa2\x2f\x5f\xd3\x07\x01\xa4\x88\x84\x33\x22\x9a\x15\x8f\xe3\x32\x42\x60\x10\xa2\xf8\x03\x57\x90\x84\x25\x9e\x39\x63\x8e\xbd\x73\x36\x2b\xf7\x29\xab\x0d\x12\xbd\x75\xce\x40\x7f\xa8\x29\xb8\x9b\x36\x76\xe6\xf9\x7d\xfc\x7c\x96\x47\xa5\x76\xe0\xb8\x81\xfd\x52\xdd\x42\xff\xbe\x96\x4a\x04\x16\x24\x91\x59\x10\x10\xec\x4a\xa1\x39\x65\x9a\x54\x4c\x9c\xdd\x4e\x05\x54\xf8\x70\x89\x55\x90\x60\x18\x4a\xdd\x91\xec\x28\xb7\xf0\x12\xca\xe7\x3a\x26\xc6\x7a\xf0\x01\xd1\xb1\x90\x0c\x2b\x77\x22\x2e\x45\xe6\xaa\xc5\xc1\xf1\xac\xf0\xb3\x44\x78\x85\x09\x51\xc5\x51\x43\x7e\x3a\x79\xc4\x02\xec\xf5\x17\x14\x60\x6f\xbe\x00\x07\xa7\xa5\xa7\x04\x8d\x11\xf5\xf4\xad\x83\x37\x63\x3d\x53\x64\xa9\x1b\x71\x8e\xa8\xa8\xa0\xf0\xe1\x5d\x55\x29\xa6\x15\xa5\xe1\x84\xdd\x9a\x8d\x88\x60\x02\x92\x8a\xda\x37\x90\x44\x53\x89\x56\xb2\x85\x60\x2a\x29\xd5\x2f\xc9\xa2\x5b\xec\x09\xcd\x28\x53\x46\x7a\x5e\x10\x1b\x89\x73\x86\xa9\xf8\xc8\xa4\x19\x94\x0f\xca\xba\xc2\x82\xdc\x98\x97\xdb\x13\x20\xee\x22\x2a\xe0\x18\x19\x14\x32\x88\x5f\xce\xa1\x87\xa3\xb8\x7e\x5f\x57\x6a\xd2\x65\x8e\xa8\x87\x38\x92\xa6\xf7\x8a\x30\x51\x6e\x56\x88\x38\x46\xe1\xbf\x6e\x10\xe7\xd8\x43\xda\xee\x85\x01\x74\x91\x79\x28\x85\x02\xba\xd7\x86\xaf\x87\x02\x05\x01\xf2\x3e\x60\x6a\xea\x9a\x80\x7c\x8c\x44\xa8\xcc\x68\x40\x3b\xab\xc9\x2a\xe8\x2e\x90\xdd\x09\x23\xff\x80\x43\x81\x0e\xe8\x18\xd3\xbb\xd1\x44\x88\x60\xe4\x32\x4a\x91\x2b\x39\xfd\x10\xcf\x20\xb2\xed\xaf\x9f\x3f\xcb\xff\xfd\xbc\x03\x02\xe6\xbd\xfe\xfb\x39\x0c\xb0\x0b\x43\x71\xf8\x0c\xd1\x9b\x17\xdf\x3d\x7f\xfc\xd2\xf3\xbf\x7e\xfb\x2d\xb8\xbc\x07\x07\xa1\x80\x02\xe9\x26\x25\xf9\xf9\x2b\xc6\x7d\x18\x8f\x01\x47\x60\x1f\x8d\x12\x06\x9a\x88\x31\x15\x88\xdf\x40\xf2\x1e\xba\x42\xce\x7f\x1a\xd3\x0d\xa6\x83\xf2\x7d\xfe\xee\x87\x87\xdf\x1f\x1e\x64\x43\x1e\x1f\x7f\x7f\x7c\x34\xbd\x9f\xa3\x2b\x39\x4f\x39\x6f\x1c\x85\xe0\x71\xe6\x89\x62\xac\x27\x1c\x85\x13\x46\x3c\x83\x29\xf7\xd1\x7b\xce\xfc\x99\x29\x70\xa6\xf4\x13\x1a\xa7\xfa\xac\xad\xfc\xcb\x04\x5f\x09\x53\xed\x74\x9a\xf8\xf1\xe2\xe2\x1c\x14\x24\x06\x0e\xc6\x84\x5d\x42\x52\x66\xbd\x23\xf2\x59\x57\x63\xb2\xc2\x09\xe4\x72\x2a\x35\x18\x8e\x90\x71\xa1\x8e\x1a\x90\xdb\x8c\x51\x36\x25\x61\xea\xe1\x1b\xec\x45\x90\x38\x95\xe6\x23\xa3\x97\x80\xa0\xdc\xd4\x3b\x78\x87\x0d\x96\xff\x32\x72\xaf\x13\x55\x57\x99\x22\x09\xfc\xd4\x7c\xc4\xfc\xab\x80\x44\x86\xda\xd5\xe6\x33\x37\x8f\x5f\xbe\x56\x76\xee\x1e\xde\xa1\x85\x46\xa3\x87\x5c\xec\x43\x09\x08\x34\x93\x14\x98\x1d\x31\xe1\x24\x16\x86\x69\x08\xc0\x4b\x44\x8c\xfd\x4b\x48\xd8\xf8\x2d\x0c\x51\xc5\x38\x4a\x26\xa0\x8a\x57\x24\x73\x50\x05\x41\x81\x8f\xea\x90\x52\xeb\x18\xd8\xb2\x43\x7d\xae\x34\x23\xf7\x66\x7d\x87\x04\x8f\xab\xe6\x7b\x59\xfe\x01\xdd\xe4\x0c\x30\x00\x76\x8b\x0e\xe7\xa1\x43\x6d\x41\x4d\x78\x38\x2c\x77\xde\xc2\x43\x0b\x0f\xa7\x4c\xdf\x57\x78\xf8\x2c\x60\xde\x0c\x28\x0c\x98\xd7\x69\x0d\x30\x0c\x98\x27\x61\xe1\x19\xd8\x4f\x9c\xf8\x4c\x72\xc0\xe2\xc4\xbd\xc6\x89\x8e\x91\x60\xd3\x88\xc9\xa2\x44\x8b\x12\xdb\x87\x12\x8d\x31\xc4\x3a\x28\xf1\xc4\xa2\x44\x8b\x12\x77\x19\x25\xa6\xf1\xc0\x51\x8c\xa5\xa2\x05\x83\x87\x1d\x90\xd4\x7a\xfd\xf7\xf3\xa3\x69\x2c\x71\xb3\x38\xf1\xe8\x3f\xff\x59\x0a\x0c\xd6\x9e\xbb\x56\xc5\xc1\x61\x5b\x38\x38\xac\xc1\xc1\xb7\x6d\xe4\xe0\xa0\x2d\x1c\x1c\xd4\xe0\xe0\xf7\x6d\xe4\x60\xbf\x2d\x1c\xec\xd7\xe0\xe0\xbb\x6d\x75\xe9\x12\x76\x03\x97\x79\xc8\xc6\xfe\xad\x4f\xd7\x76\x0f\x67\x09\xaf\x8e\xa3\x3f\x02\xa3\x51\xd8\x86\x3e\x5b\xaf\xae\x54\xd8\x3a\xaf\xce\x1c\xfb\xaf\xe1\xd6\x1d\x97\xdd\x00\xeb\xd6\x59\xb7\x6e\xca\xf4\xed\x75\xeb\x16\x07\x83\x32\xe0\xaf\x75\xe4\x5a\x15\xef\x5f\x9b\x5b\xf7\x74\x0e\x0e\xdb\xc9\xc1\xb5\xb9\x75\x4f\xe7\xe0\xa0\x9d\x1c\x5c\x9b\x5b\xf7\x74\x0e\xf6\xdb\xc9\xc1\x3d\x72\xeb\xec\x52\x9d\x75\xeb\x5a\xed\xe2\x58\xb7\xce\xba\x75\xab\x76\xeb\x4e\x0d\x5e\xdd\xc2\x1b\x36\xfa\x75\xd6\xea\x8e\xad\x53\x67\x9d\xba\x1d\x74\xea\xf2\x28\x7f\x14\x84\x82\x23\xe8\xdb\xc5\xba\x8d\xb3\x70\x8f\x57\xeb\x9a\x62\xe1\x1e\x2f\xd7\x35\xc5\x42\xbb\x5e\xb7\x62\xc7\xee\x73\x2a\xa8\x19\xe7\xce\xfa\x74\xd6\xa7\xd3\x93\x6c\xda\xbf\xb1\x3e\x9d\xf5\xe9\x56\xed\xd3\x9d\x18\x7c\xba\x85\xf3\x2f\x07\x35\x76\xe1\x1f\xd9\x5d\x3a\x9b\xf0\xe9\x00\x0c\xc1\x9f\x88\x33\xeb\xdb\xad\xda\xb7\xf3\x10\x11\x30\xdd\xaa\x83\x38\x67\x7c\x44\xd8\xd8\x84\x07\x67\x62\xfd\x24\x36\x4d\xaf\xff\x7e\x2e\x6b\xfd\xe5\x72\x2c\xfe\x82\x04\x71\xf1\x17\xf2\x11\x1f\xaf\x22\xfa\x1f\x93\xf7\xfc\xb5\xec\xec\x91\x9d\xdb\xdd\x9d\x3d\x52\x66\x21\x78\x09\x7c\x4c\xed\x52\x81\x85\x95\x2d\x87\x58\x4b\xc0\xca\xad\xef\x73\x7b\x61\xa5\x0b\xdd\x09\xba\xc0\x3e\x62\x91\xc1\xce\xb8\x31\xe6\x7c\x0b\xdd\xeb\x31\x67\x11\x35\x1d\xb7\x24\xa9\xce\x39\xba\xc2\x06\xd8\x93\x50\xfc\x1a\x8f\x21\x3d\xe0\x70\x33\x6c\xab\x8e\x1b\xe7\x9b\xfe\xe9\xa9\x3b\x3c\xd6\x8d\x68\x79\x14\x56\x7f\xf0\xaa\x03\x7a\xfd\xd3\x0e\x18\x76\x3b\xa0\xfb\xe2\xe4\x54\x7b\x1c\xd6\x37\xef\xfb\xc3\xd3\xa3\x52\xf4\xa5\x2c\x8e\xda\x98\xd7\x3c\x6c\x0b\xaa\x4b\x19\x55\x2a\x8e\x61\x24\x81\x8d\x46\xe0\x3e\xbc\xcb\x58\xd4\xeb\xea\xb0\xae\x8f\x69\x46\xa0\x2b\x4e\xb5\xd0\xa4\x2f\xf9\x6c\xf5\x21\x1e\x34\x06\x84\x34\x4b\xf9\x11\xf2\x6b\xc4\x43\x9d\x6a\x97\x0d\xdf\x5c\xb4\xaf\x9b\x40\x96\xcb\xcb\x9b\x03\xf7\x27\xd8\x93\x4a\x9d\xa1\x38\xbd\xc6\x25\x0b\x3d\x65\xf0\x58\x04\x28\x65\xc9\x19\x81\xaf\x0f\x83\x00\xd3\xf1\x45\x32\xaf\x95\xf1\x72\xa1\x74\xa1\x89\x21\x3b\xbe\x4d\xce\x3c\x40\x30\x20\xd0\x9d\xd1\x0e\xde\x64\x7a\xf3\x04\x93\x9b\x7d\x90\x43\x3a\xae\xfd\xc1\xfe\x82\xf6\xce\x87\x77\xef\xa0\x80\xe7\x19\x48\x57\x14\x5d\x75\x23\xd2\x7d\xcb\xc8\x2b\x0b\x24\xa6\xbc\x88\xdb\xa8\x35\x5d\x55\x1e\x06\x89\xc6\x98\xfe\x8a\x78\x88\x59\x6c\x61\x9d\xe3\x17\xfd\x17\xc3\xf2\xeb\x03\x16\x8a\xc4\xa2\x29\xaa\x90\x16\xbd\x67\x54\xfc\x82\xff\x94\xad\x3c\xea\xfe\xb7\x42\x95\x59\x44\xb5\xbe\x2c\x99\x53\x5d\xca\xe1\x23\x0c\x16\xd2\x9a\xab\x04\x70\xea\xd6\xd3\x72\x1a\x91\xf0\xcc\xf9\xf9\xe5\x1b\x23\x09\xcb\x5f\xb2\xa0\x80\xc3\x00\xf2\x6b\x92\x78\x38\x1a\x4b\x70\x85\x09\x99\x3d\xc9\x70\xd0\xeb\x80\x5e\xef\xa4\x03\x7a\x27\xa7\xb1\xf9\xee\x9d\x68\xcd\xf7\x55\x44\xaa\xfc\xde\xf8\x8b\xc5\xf7\x26\xaf\xed\x77\x3b\xa0\x77\x3a\xd0\xbe\xb0\x68\x2d\xab\xf1\x2a\xbc\x24\xf1\xbb\x23\x9f\xea\x64\xf9\xc4\xe5\xb8\x25\xdc\x36\x25\x8a\x3f\xdf\x81\x9b\xac\x3d\xae\xdf\x90\x57\xe5\xf4\x3a\xbd\x6e\xa7\xd7\xed\x2a\x6c\x9f\x7a\x57\xce\xa0\xab\x46\xd2\x0b\xfe\x93\xbe\x3c\x75\xa0\x2e\x98\x80\x04\xbc\x39\xff\x29\x66\x29\x48\xdd\xa9\x03\x12\xff\x98\xb0\x88\xab\xbe\x54\xea\xbd\x84\x98\x8e\x09\x0a\x05\x54\x62\x08\xd2\x34\x16\xc7\xf6\x89\x3a\xb6\x25\xcd\xa2\x63\x9b\xc5\x1e\x9c\xf3\x7a\xde\xb0\x2e\xb3\x6a\xf6\xa3\xcb\x8e\x6b\x59\xf9\xe7\x74\x8a\xc8\x22\x60\xeb\xc6\x98\x16\x41\x5a\x04\x39\xfb\x7c\x49\x04\xd9\x6f\x0a\x41\x1e\x59\x04\xa9\x7e\xd0\x22\xc8\x94\xca\x22\x48\x8b\x20\x77\x03\x41\x1e\x6f\x33\x82\x3c\xee\x76\x8e\x37\x82\x20\x8f\x27\x16\x3f\x16\x2b\x5b\xfc\x68\xf1\xe3\x4e\xe0\xc7\x61\x63\xf8\xb1\x9c\x6a\x6e\xf1\xa3\xc5\x8f\x39\x95\xc5\x8f\x16\x3f\xee\x06\x7e\xec\xf5\xb7\x19\x40\xc6\x62\xec\xf5\x37\x02\x21\x7b\x7d\x8b\x21\x67\x2a\x5b\x0c\x69\x31\xe4\x4e\x60\xc8\xe3\xc6\x30\xe4\xd0\x62\x48\xe5\x83\x16\x43\xa6\x54\x16\x43\x5a\x0c\xb9\x1b\x18\xb2\x3f\xdc\x66\x0c\xd9\x1f\x76\xe3\xff\x6d\x02\x43\xf6\x87\x16\x43\xce\x54\x5e\x02\x43\xee\xe6\x16\x9c\xc6\x8e\x55\x18\x9c\x54\x5a\x9f\x04\xa7\xd8\x7b\x14\x55\x30\x66\x8f\x55\xc8\x98\xbe\xbd\x5b\x6f\xf2\xdd\xd8\xb1\xcd\x47\xa1\x0b\x09\x1a\x5d\x42\xf7\x1a\x51\x6f\xe4\x42\x42\x9e\x70\x93\x22\xa2\x9e\xe4\x6a\xb6\x55\xbb\x0d\xa7\x70\x65\x6d\x2a\x5f\xa0\x13\x85\xbb\xbb\xcf\x66\x20\xa5\x0a\xde\x26\x52\x05\x52\xaa\x76\x9b\x8d\xdd\x66\xa3\x27\xd9\xf4\x96\x93\xda\xde\xf2\x0e\xf5\xb9\xd2\x82\xb4\x7c\x9b\xcd\xd2\x5b\xa5\x75\xcf\xab\x60\xda\xf0\xd5\x7c\x98\x36\x6c\x28\x9c\x74\x77\x8e\xf8\x27\x29\xb0\x81\x19\x81\xa8\xcd\xf4\x70\x18\x10\x78\x9f\x21\x18\xe2\x96\x23\x26\x20\x01\xbc\x88\x78\xff\xaa\x78\x0f\x48\x38\x4f\x5c\xbd\x99\x98\xb6\x13\x41\xaa\xce\x47\x40\xd5\xa1\x69\x03\xd1\x15\x8c\x88\x30\x7f\x16\x4c\xb5\x5d\x1b\x01\x9d\xd2\x48\x85\xaf\xa2\x88\x28\x96\xa3\x33\x05\x66\xfa\x86\x6a\x86\x7b\xda\x04\x19\xb3\xd3\x89\x27\xa7\x61\xd3\xf0\x62\x19\x0d\xe6\x34\xb3\xd3\xaf\xb1\xb1\x66\x66\xc8\xb7\xb8\x59\x5c\x63\xcc\x11\x2a\xdf\x6a\xaf\x50\x63\xea\x21\x83\x22\xcf\xd0\x65\xbe\xa0\x32\x30\x8b\x7f\x86\x8e\x2d\xd2\xe8\x6f\x7e\x78\xf3\xf6\x64\x70\x5a\xb7\xd9\x06\x1b\xa8\x34\xfb\xa4\xbb\xca\x46\x73\x25\xda\x68\x6c\xb0\x06\x77\x68\x1b\x7c\x5a\xd1\xe0\x85\x46\x51\x85\x97\x04\xf4\xbd\x77\x18\xc7\xb1\x73\x22\xd2\x70\xe7\x84\x71\xfc\x27\xa3\x62\x1e\x10\xaa\x17\x27\xe5\x28\x40\xc9\x4c\x18\x30\x25\x46\x9b\x14\xbe\xc3\x3c\xb9\x3f\x51\x7e\xbd\xc1\x08\x5a\xaf\xdb\x05\x87\xe0\x80\x05\x88\x72\x14\x8a\xfb\x51\x38\xf1\xb0\x2b\x46\x57\x1c\xa1\x91\x74\x15\xaa\x42\x69\xe9\x4d\x9b\xe0\x25\x50\x5e\xe0\xc2\x00\xba\x58\xdc\xd7\xa9\xfe\x5d\x6c\xac\x9a\xdf\xe6\xbf\x1a\x17\x24\xee\xdd\xaa\x9c\x8d\xb9\xee\x44\xad\xe3\x9e\x42\xe4\x81\x04\xf3\x83\xb8\xb1\x98\x51\x18\xb3\x0c\x48\xce\x83\x83\x98\xe9\xc6\x98\xdc\x25\xe4\xc9\x02\x5b\x1d\x70\xc1\x08\x81\x41\x88\x4c\x6b\x96\xf3\x2f\x4c\x68\x2a\x04\x74\x74\x34\x1f\x5b\xf4\xca\x50\x20\x80\x34\x59\xc5\xd3\x78\x66\x29\x2b\xcf\x99\xa7\xba\x5a\x29\xa7\x38\xbb\x5d\xd5\x02\xb0\x69\x7d\x37\x5b\xbb\x34\x2e\x10\x9b\x16\x80\xd3\x95\x5b\x0d\xf7\x16\x5a\x00\x4e\xd7\x91\x2b\x75\xd8\x2e\x00\x83\x55\x2d\x00\x0f\x0c\xc3\xe5\x78\xd1\xd1\xd2\xd8\xf2\xef\xa0\x2c\x39\xbb\xfc\x6b\x97\x7f\x73\x2a\xbb\xfc\x6b\x97\x7f\x57\xbe\xfc\x7b\x1d\x5d\xa2\x11\x47\x01\xc1\xae\x84\xe7\x2e\xa3\x82\x33\x42\x10\x4f\xcf\x25\x1d\x71\x04\xbd\xfb\x8c\xc4\x18\x0f\xd7\xbe\x42\x13\x20\xdf\xde\x45\xe0\x55\xad\xfe\x7e\x8a\x28\xc5\x74\x0c\x82\x0a\xb0\xb4\xd7\x4b\xbd\xf0\x66\xbc\x6e\xa4\x58\xff\x44\x9c\x85\xb1\xa4\x4d\x26\xcc\x08\xf6\x09\x4b\xea\x9e\x37\x8c\x25\x2b\xb6\x33\x6b\xc6\x82\x45\x93\x9a\x0f\x6e\x27\x9a\xb4\x38\xd1\xe2\xc4\x8d\xe2\xc4\x00\xb9\xcd\xe1\x43\x70\x08\x2c\x2e\x2d\xfc\x6d\x0c\x97\x7e\xa6\xf0\x06\x62\x12\x6b\x95\xc5\xa6\xe5\xca\x1b\xc4\xa6\x36\x8a\x69\x91\x67\xe9\x79\x15\xf2\x34\xee\x62\x69\x10\x7a\xbe\xb2\x61\x4c\xe5\x83\x7b\x00\x3c\x6d\x18\xd3\xc2\xd3\x16\xc0\x53\x97\x45\x54\x1c\x64\xff\x4f\x05\xc4\x14\xf1\x91\x8f\x7c\xc6\xef\x47\x51\x08\xc7\x68\x74\x79\x2f\x90\x11\x22\xea\x33\x79\x93\x24\x5e\xca\x3c\xb4\xc5\x1b\x57\x56\x85\x0d\xcf\x99\x17\x02\x0f\x87\x82\xe3\xcb\x48\x20\x0f\x30\x0a\x26\x2c\x14\x16\x24\x16\x2b\x6f\x23\x48\x6c\x28\x3c\xe9\x0d\x87\x70\x00\x2d\x48\xdc\x16\x90\xa8\xcb\x46\x6d\x18\x24\x56\x1c\x97\x63\xe3\x93\xbb\x0c\x13\x2d\x00\xb4\x00\x70\xa5\x00\xd0\x87\x77\x07\xd3\xad\xcc\x32\x72\x18\x30\x6f\x34\xc5\x82\x79\xc4\x30\x14\x90\x8b\x70\x24\xf7\x01\x2e\x06\x07\xbf\x1c\xe5\x1b\xbb\x02\xe6\x6d\xfa\xaa\xcd\x15\x6f\xd4\x5a\x25\x74\xfc\x08\xef\x64\x38\x11\x64\xd2\x48\x77\x3a\x1f\x01\x1f\xd3\x48\x20\x65\x9f\x9c\xc5\x90\x0b\x60\xc8\xdd\xdc\xeb\xfc\xca\x00\x67\x16\x4f\x74\x3d\xad\x34\x51\x49\xa2\xab\xb2\x67\x77\x85\x7b\x9d\x63\x00\xf5\x83\x1f\x88\x7b\xf3\xc6\xba\x98\xe4\xff\x10\x67\x66\x0a\xbb\x61\xda\x59\xdb\x9d\x85\x47\xba\xd2\x3d\xdd\x38\xbd\xb6\x35\xc1\xd5\x4c\xb6\x86\x8d\x33\xca\x64\x2b\x07\xc1\xa1\x66\x09\xac\x54\xe3\x03\xa6\xd7\xd5\xbb\x20\x0b\xd3\xb3\x81\x22\x96\x99\x94\xf3\x13\x5c\x8b\x44\x44\xfb\x90\xdd\x57\x96\x94\x5c\xb0\xac\x94\x54\xf3\xd7\xe1\xdb\x85\xf1\x8d\xef\x2b\x8a\xe8\x02\x82\x6f\xe4\x12\xff\x5d\x0b\x39\x2b\x1c\x0d\x91\x77\xa8\x0b\xe8\xaa\xfc\xdc\xc6\x1b\xfd\xcf\x99\x07\xa4\xf0\xc0\x81\x34\xee\x1d\x20\x15\xa8\x03\x22\x1a\xff\xf7\x5b\x00\xa9\x97\x38\x29\x92\x07\xd3\x48\x37\x66\xe5\xed\xaf\xf6\xe8\x88\xa4\xdc\x1e\x1d\xd1\xd8\x31\x0a\xa6\xa6\xee\xf1\xb9\x11\x86\x9d\xc6\x95\x76\xc6\x5e\xfb\xdf\xb8\x1f\xae\x5b\x3e\x58\xca\x0f\x3f\xae\x5e\x56\x90\x7e\xf8\x3a\x8f\x1c\xb3\x6e\xb8\x3d\xb7\x6c\xeb\xdc\xef\x35\x86\xbb\x37\x8b\x46\xe5\xb9\x01\x01\xf3\x76\xf7\x8c\xb2\xf3\xc5\x02\xe2\x0d\x40\x4e\x9d\x65\xb6\x90\xf3\xe9\x90\x73\x2b\xe1\xd5\x3e\x42\xca\xad\x3b\x8a\xac\x35\xa7\x85\xbc\xaa\xbe\x82\x33\x39\x89\xac\x3c\x4f\x1b\x0f\x0b\xc9\x4f\xef\xa9\xb2\x8f\xdf\x9f\x7f\x06\x9f\xc3\x18\x24\x18\x8c\x4f\xcd\xd3\x44\x76\x13\x96\x37\xb6\x3c\xf6\x6a\x30\x5f\xb2\xe5\xae\xdb\x93\x80\xed\xc2\xd6\xae\x21\x6b\xca\x3c\x34\xca\x31\xf2\x2c\xba\x3e\x9b\xe2\x6c\x37\x88\xd2\x60\x6f\x88\x5c\x46\xbd\x14\x69\x9f\x3d\x3c\x80\x17\xbf\x44\xfe\x27\x28\x10\x78\x7c\x2c\x00\xef\xbf\xeb\x1c\x21\xbc\x6a\xd4\x5d\x77\xd9\xab\x16\xea\x5e\xcb\xca\x57\xdb\xd1\xbb\x79\x76\xb2\xb1\x61\x0b\xd4\x6d\xe0\xd7\xd8\xe1\x1d\x0c\xfc\xb6\x07\xa6\x97\x0e\x28\xd5\xdf\xeb\xd0\x3c\x4c\xff\x77\xc4\x04\xdc\x18\x4c\x77\x65\x7e\xa9\xb6\x07\x1b\x44\xf0\x57\x85\xd4\xc4\x5e\x57\xcd\x4d\x5c\x1f\xc2\x3f\xe9\xcd\x57\x8a\x75\xe6\xbf\x59\x84\xff\x14\x84\x0f\xc7\x28\x55\x2b\xcd\xa8\xdc\x28\xfe\x77\x39\x93\x23\x41\xc3\xb2\x3a\xbe\xc1\x84\xdd\xfe\x88\xa0\x27\x3f\xa0\x7b\x45\x02\x8d\x34\xfa\xe8\x32\xcd\xf8\x93\x25\x1e\x0a\xdd\x5a\xd2\x5e\xa9\x63\x12\x8a\x7b\xb2\x18\x4e\x91\xb6\x30\xe6\xf3\x85\x1e\x5d\x81\xd4\x40\xa1\x29\x72\xff\xed\xb7\xdf\x7e\x3b\xfc\xf8\xf1\xf0\xdd\x3b\xf0\xe3\x8f\x67\xbe\x7f\x16\x1a\xbd\x83\x00\x0a\x81\x38\x9d\xf7\xfe\xcc\x7e\x4f\xb0\xe7\x21\xcd\xa1\xeb\xf5\x51\x48\xde\x1d\x13\x68\xce\x29\xe5\x36\xb5\x74\x84\x54\x00\x85\xe9\x6e\x36\xe3\xa9\xef\xcb\x32\xa7\x90\x3e\x60\x74\x9a\x12\xcf\xc7\x60\x64\x72\x92\x8b\xdc\x11\x70\xde\x71\x4c\x08\xf0\xd8\xad\xe9\x20\x75\x59\xe1\x33\xaf\x4c\x95\x2a\x88\x4d\x6e\x52\x03\xdf\x98\xb7\xa0\x54\xf9\x47\x53\xaa\x54\xc0\x34\xf2\x2f\x11\x37\xbd\x2b\x3b\xd3\x3e\x01\x90\x4d\x69\xc1\x27\xf4\x47\x84\x2a\x52\x8d\xac\x22\x2c\xa2\x08\x6f\xb7\x5f\x11\x40\x19\x20\xe5\xe4\x56\x15\x16\x51\x05\xdd\x59\x2f\x60\x55\xaa\x90\xc2\x0d\xf9\xb3\x29\x85\xf8\x80\x7d\x6c\xed\x82\xb6\xc2\xc2\xca\xf0\x6e\x7b\xed\x42\xa2\x06\xd6\x2a\x68\x2b\x2c\xac\x08\x3f\x6c\xb3\x55\x38\x57\x6e\xf8\xc8\x69\xb6\x49\x0b\xf4\x3e\x2b\x78\xaa\x12\xbc\xf4\x5e\x3e\x3c\x80\x17\x3f\x67\x6b\x0c\xe0\xf1\x51\x79\x70\x98\xdc\x87\x77\x78\x1d\x5d\x22\x4e\x91\x40\xe1\xa1\xcb\xfc\x20\x12\xe8\x90\xa3\x24\xaa\x11\x1e\x06\xcc\xfb\x9f\x1b\xc8\x0f\xa7\x4b\x17\xd3\x85\x8b\x7f\xc6\x05\x01\xf3\x5e\x3f\x1b\x8d\x5c\x64\xde\x1e\x5d\xd0\x3c\xf5\x5e\x96\x9c\xaa\xdd\xd6\x67\xab\x75\xad\x20\x80\x97\x2f\xbe\x7b\xd9\x8c\x04\x42\xc1\x31\x1d\x2f\x2b\x81\x99\x27\xca\x72\xcb\xbe\x2c\xda\xc1\x4b\x62\xf4\x7a\x31\x0d\x05\x94\x21\xb3\x0a\x1b\xb1\xf4\x9a\x5e\x8d\xe4\x39\x03\x45\x73\x1b\xd3\x0a\xdb\xa6\x66\xd3\x16\x33\xf3\x33\xe2\xa9\x1f\x20\x65\xe5\x32\x3e\xb3\x8f\x66\xaf\xc4\x61\x74\xe6\x9a\x16\x47\x4b\x06\x0f\x78\x09\x56\xa7\x1c\xbb\xa4\x18\x46\xd7\x6e\x9d\xe3\x94\x48\x5c\x6e\x47\xa9\xd1\xb5\xda\xdf\x51\xba\xac\x6a\xec\x92\x5a\x18\x1d\xad\x4d\xa5\xba\xd4\xcd\x65\xd1\x2f\xe1\xb6\x2b\xe9\x9c\x43\x1a\xc6\x6a\x60\x52\x82\x1c\xb0\x6a\x0b\x6d\xb6\x8b\x41\xc7\x6d\xb6\xcb\xea\x3a\xdc\x50\xb6\x4b\xfe\x6b\x2b\x92\x50\x4e\x2a\xf5\x58\xe6\x1b\x94\x0f\x06\x7c\x62\x12\xca\x47\xb9\x0b\xdf\xa6\x8b\xaf\x21\x99\xa4\xc6\x69\x4a\xe5\x0d\x7b\x36\x99\xa4\xad\xc9\x24\x36\x5d\x7c\xd9\xc8\x53\xcd\x13\x40\xea\xa0\xec\x0e\xc8\x5f\xf6\x5f\xaf\x9f\xdb\x64\xf0\x35\x23\xe4\x06\x92\xc1\x2b\xa7\x1f\x9b\x0f\xbe\x05\x08\x59\x8e\xdb\x36\x00\x46\x8b\x90\x95\x3e\xdb\x7c\xf0\xa5\xd1\xda\x69\x8d\x33\x37\xca\x17\xb9\x34\x03\xc5\x6d\x4a\x78\x9b\x53\xc2\x4f\x5f\xcd\xd7\x8b\xf2\xdd\xff\x16\xc5\xb7\x15\xc5\xdb\x94\xf0\xd2\xdf\xfc\x94\xf0\xb9\xd7\x2d\xd8\x8c\x70\x95\x6c\x05\x19\xe1\x15\xc8\x39\x27\xde\x8a\xc4\x8b\xd6\xa4\x7a\xad\x35\x29\xdc\x43\x6e\x82\x9c\x1b\xd4\x05\x9b\x1a\xde\xa4\x3a\xac\x35\x35\x7c\x95\xea\x60\x53\x41\xb5\x15\xf6\x2b\x41\x3c\xd5\x09\x9b\x23\x6e\xac\xd0\xee\x1c\xf1\x55\x18\x08\x9b\x29\xde\xa4\x3a\xd8\x4c\xf1\xcd\x6b\x81\xcd\x14\xcf\xa8\x6c\xa6\xb8\xcd\x14\xcf\x3e\xb1\x1b\xeb\x75\x2d\xce\x5d\x6b\x57\x1e\x78\x2a\xa6\x65\x04\xb4\x33\x12\x59\x5b\x2a\xf8\x5a\x46\xc7\x22\x89\xde\xcb\x48\x7f\x97\x24\xdf\xa6\x5c\x6f\x3b\x12\xd7\x98\xee\xdd\x96\x91\xf8\x04\xd9\xef\x92\xdc\xb7\x32\x9f\xbb\x72\x09\xd6\xa6\x74\x27\x04\x5b\x9e\xb0\xd2\x9a\xfc\x0d\x9b\xb0\xa2\xf4\x79\xf7\x52\xba\x7b\xdd\x61\xa5\x22\x27\x67\xc8\xd5\x3f\x59\x30\x37\x55\x3f\x23\x71\xcb\xf8\xb5\xcd\xdc\x5e\xfd\xfd\x3b\xbd\xee\x51\x0d\x19\x96\xb3\x81\x6c\xd6\x87\xbd\x3c\x67\xca\xf4\xed\xce\xd9\xc6\x1c\x0a\x54\x40\xd8\x34\x31\x3e\x23\x8e\x5c\x84\x6f\x52\x90\xad\xdc\x9c\x53\xcb\xcf\x59\xcb\xdd\x39\x8d\x26\x6e\x6f\xf5\xdd\x39\x9f\x12\x89\x81\xb7\x90\x7a\xc9\x08\x5c\x01\xcc\xb5\x59\xd7\x2b\xbf\xa1\xb1\x02\x4b\x4d\x87\xca\xdb\xa0\x15\x99\xd9\xe6\x1b\xff\x2d\xd4\x2d\x77\xba\xd2\x7e\xd8\x4b\x1a\x5b\x0c\x12\x7b\x75\x0e\x8b\x3e\xb1\x20\xd1\x82\xc4\x3d\x03\x89\x32\x54\xe5\x63\x61\x51\x62\xf6\xd7\x7a\x94\x78\x91\x8a\xcc\xc2\xc4\x6d\x84\x89\x16\x00\x6e\xbe\xcb\xdb\x0b\x00\xff\x51\xf8\x70\x6c\xc5\x62\x63\x94\xec\xbb\xca\x74\xc9\x09\xdd\x09\xf2\xe1\xaf\x88\x87\x98\xd1\x02\xac\x49\xb6\x67\xc8\x74\x45\xc8\xaf\x33\x6a\x01\xc7\xb3\xea\xeb\x24\xa9\x52\x05\x31\x39\xa9\xbd\x77\x66\x3e\x2e\x90\x1f\x10\x28\x30\x1d\xcf\x74\xd9\x21\x38\x14\xca\x78\xd0\xe1\x3b\x2c\x07\xaf\x0e\xb1\x61\xea\x92\xc8\x43\x6f\x48\x15\x8c\xa9\xd6\x17\xc7\x8f\x88\xc0\x15\xd5\x53\xdb\xe0\x18\x41\x2b\x98\xc5\x22\xba\x1c\x21\xe7\x8f\x08\xf1\x7b\x99\xeb\xc5\x99\x8f\xc4\x04\x45\xba\x11\x5d\x90\x93\x2e\x72\xcd\xd1\x18\xdd\x19\x56\xed\x9c\xf0\x1a\x07\x9f\x39\xf9\xe5\x9e\xba\x15\x9d\xc9\x2c\x6c\xa1\x33\x55\x46\x4b\xab\xa0\x44\x66\x4a\x9a\x19\x3a\x85\xb4\xba\x71\x9e\xeb\x91\x36\x95\xca\x11\xe8\x2e\x9d\x9c\x67\xf3\xf2\xb4\xc6\x20\xb1\xbd\x5a\xf2\x3a\x66\xa8\x31\xcd\x72\x72\xe4\xa3\x93\x4c\x5d\x05\xab\x7c\x49\x41\xbf\x34\x8c\x30\x18\xd4\x10\x11\xe4\x8a\x8a\x29\x1c\x2c\xcc\xf2\xc5\x98\x5e\x36\x84\x40\x35\x86\x60\x66\x78\xd4\x68\xc3\x82\x9a\xee\x46\xa1\x60\xfe\xba\xb5\x7c\x61\xe6\x07\x9c\x79\x91\x1b\xcb\x78\x2e\xdb\x0b\xa4\xeb\xd5\x72\x44\x6f\x30\x67\xd4\x8f\xbb\xfd\x04\x3d\x47\xf4\x66\x71\x0d\x37\xe9\x38\xa8\xcd\x6a\xb0\x10\xbb\x41\x6d\x96\x03\x3d\xdb\xeb\x37\xb9\x22\xe7\x7d\xa6\xcd\xa1\x80\x63\x73\x72\xe9\x6c\x83\x33\x5a\x7d\x6b\x17\x1b\x93\xd3\xce\x77\xcc\x4d\xd8\x96\x51\x99\x31\xf3\x8d\x39\x33\x3b\x63\xa2\x5e\x0d\x25\xc9\xb3\xd1\x08\x12\xa2\xe7\xee\xd7\x5a\x83\xb2\x6e\x44\x2c\x21\x46\x57\x98\x62\x91\xa0\xb4\x64\x3c\x8e\x12\xb7\xe3\x80\x8e\x31\xbd\x1b\x4d\x84\x08\x46\x2e\xa3\x14\x49\x39\x15\x33\x95\xea\xb8\xe5\xf2\xb9\xce\x1b\xaf\x6d\x3b\x0c\xbe\x51\x4d\xe8\x65\xa8\x9d\x19\x0c\x7d\x7e\x7c\x6d\xc8\xb5\x4e\x7e\x4d\x01\x9c\xce\x2b\x6e\x00\xc0\x55\x64\x20\x09\x38\x96\xa3\x23\xfc\x77\xd6\x73\xdd\x67\x2a\xe0\x97\x2c\xab\xae\x9c\x0e\xdf\x84\xb7\x1a\x82\x28\x44\x17\xc9\x07\x34\x31\xc3\xfc\x57\x32\x44\x1e\x33\xff\x00\x4b\x31\x17\x3c\x83\xab\x24\x30\xe2\x50\x76\x7b\xd8\x2b\x06\x02\x1c\xc1\xd2\xe7\x8e\xf2\x8a\x00\xbb\xd7\x32\x28\x57\x78\x51\x2a\x8e\x51\x16\x23\x52\x67\x17\xe7\xa8\x0c\xc4\x0b\x1e\x52\xfe\x68\xa0\x3e\xea\xf9\xe5\x27\x47\xca\x93\x9e\xfa\x68\xd0\x55\xa9\x94\x50\x47\x5f\x79\xd2\xf3\xa6\xc6\xe6\x6b\x91\x1f\xd8\x47\x23\xd3\xcc\x59\xaf\x45\xea\xe7\x8f\xd5\xcf\xab\x2d\xea\x0f\xd5\x47\xe5\x61\xea\xbc\x52\x9e\x0c\xba\xc5\x9e\x28\x42\xfc\x93\xc9\xb0\xa6\x93\x3b\x9d\x59\x28\x4b\xd9\xb8\x03\x12\xef\x13\xbc\x04\x6f\x32\xa7\xf3\xf1\xff\x03\x00\x00\xff\xff\xb8\x00\xd0\x1a\x4b\x4b\x01\x00")
+var _monitoringApicastGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\x6b\x73\xdb\xb8\xd5\xfe\xfe\xfe\x0a\xbc\xdc\xb4\xf1\xee\xd8\xb1\x75\x73\x6c\xcf\xec\x74\xec\x78\xd3\xdd\x99\x64\xe3\x26\xce\x4e\xdb\x4c\x46\x85\x49\x58\x62\x4d\x91\x2c\x09\x3a\xd6\x7a\xdd\xdf\x5e\x00\xbc\x88\x17\x80\xa2\x64\x4a\x22\xa5\xa3\x49\x6c\x8b\x38\x24\xc1\x73\xc3\x73\x0e\x0e\xc0\xc7\xff\x43\xec\xa3\x61\xdb\x76\x28\xa6\xa6\x63\xfb\xda\x19\x7a\x14\x07\x45\x83\x65\xfa\x94\x1d\xf9\x92\x1c\xe1\x9f\xc7\xcc\x37\x41\x77\x13\x98\x16\xfd\xc5\x66\xa4\x9d\xfd\x62\xab\x81\x29\xf6\x9d\xc0\xd3\x09\x23\xd0\x0e\x0e\xd0\x5f\x3d\x7c\x8b\x6d\x8c\x0e\x0e\x34\x09\x39\xb1\xf1\x8d\xc5\x49\xa9\x17\x10\x49\xfb\xd8\x34\x4a\x5a\x4d\xdd\xb1\xdf\x38\x96\xe3\xf1\x7b\x79\xa3\x1b\xbc\x77\xb4\x8f\xba\x9d\x0e\xfb\x31\x18\xec\xa3\xce\xf7\xb2\x5b\xda\x78\x22\xfa\x76\x3e\x63\x04\xfa\x33\x3a\xb7\x88\x47\x7d\x19\x3d\x9d\xba\x82\xde\xc0\xfe\xf8\xc6\xc1\x9e\xa1\x65\x68\x9e\x92\x6f\x5f\xc5\x5f\x4f\xe1\x25\x34\x62\x98\xb4\xf0\x6c\xda\xc8\x26\xf4\x17\x83\x1d\xb3\x03\xcb\x8a\x8f\x79\xd8\x1d\x5f\x3b\x8e\x45\x4d\x97\xb5\x1c\x45\x87\x2d\xd3\xbe\xe3\x22\xfa\xf2\x35\x3a\xe0\x62\x9b\x58\x7e\x46\x44\x59\xf1\x68\xba\x63\x59\xd8\xf5\x09\xbf\xc1\x2d\xb6\xfc\x1c\xcf\xd8\x9d\x4c\xe3\xca\xc9\xca\x7d\xc6\x6a\x85\x44\xbf\xb1\xe3\xdd\xbe\xa4\xe1\x61\xd6\xd9\xcc\xf1\x29\x3f\x9e\xe5\x51\xae\x1f\x26\xef\x60\x37\x77\x6e\xea\xf9\xbe\xe6\x5a\xa8\x49\xad\x50\x66\xae\x6b\x99\xba\x10\x9a\x96\xa7\x89\xc4\xe4\x39\xdf\x66\x02\x4a\xdd\x38\xc7\x2a\x6c\x99\xd8\x17\xba\x23\xd8\x91\xef\xe1\x0d\x16\xc7\x65\x4c\xe4\x7a\xf0\x8e\xd8\x23\x2a\x18\x96\x7f\x08\xde\x4a\xd4\xa7\xa6\x8d\xe3\x45\xea\x6b\x8e\xf0\xd6\xb4\xac\xa2\x38\x2a\xc8\x4f\x26\x0f\x2e\xc0\x4e\x77\x41\x01\x76\xe6\x0b\xb0\x77\x9a\x3b\x6a\x91\x11\xb1\x0d\x79\xef\xf0\xfd\x48\xce\x14\xd1\xaa\x07\x9e\x47\x6c\x5a\x42\x31\xc1\x0f\x65\xad\xa6\x5d\xd2\xea\x8f\x9d\x6f\x6a\x27\x42\x99\x17\xb0\x4a\xce\xbe\xc7\x56\x30\x93\x68\x29\x5b\x98\xc9\x0a\xca\xe2\x9d\x44\xd3\x37\xd3\xa0\x12\x2b\x2b\x58\x7a\xd2\xc0\x9d\xc4\x95\x63\xda\xf4\xbd\x23\xdc\xa0\x38\x90\xd7\x15\xc7\x4d\x9c\x79\xbe\x3f\x2e\x61\xba\x65\x53\x3c\x22\x0a\x85\x74\xf9\xc5\x3d\x6c\x98\x01\x3f\xbf\x2b\x6b\x55\xe9\x32\x93\x97\x41\x3c\x22\x5c\xef\xad\xe5\xd0\x7c\xb7\x7c\xe2\x99\xc4\xff\x70\x4f\x3c\xa6\xb4\x44\xfa\x78\xbe\x8b\x75\xa2\x36\x25\x9f\x62\xfd\x4e\x71\x77\x9f\x12\xd7\x25\xc6\x3b\xc6\x55\x05\x05\xc5\xde\x88\xd0\xac\xbb\x8c\x3f\x45\xfd\x14\xa7\x90\x07\x57\x3c\x8e\x1f\x4c\xf6\x3c\x4c\xc9\x9e\x3d\x32\xed\x87\xe1\x98\x52\x77\xc8\x46\x1a\x9b\xe8\x82\xd3\x8f\x7c\x04\x11\x7d\xff\xf1\xe5\x8b\xe4\xef\x97\xfb\xc8\x75\x8c\x1f\xff\xfb\x12\xbb\xcc\x43\xf9\xf4\xe0\x05\xb1\xef\x5f\xfd\xf0\xf2\xe9\x4b\x67\xf2\xf5\xfb\xef\xd1\xcd\x14\xed\xb1\x27\xa2\x44\x36\x28\x89\xdb\xdf\x3a\xde\x04\x73\x1b\x60\xfe\x6e\x42\x86\x21\x03\x55\xc4\x4c\x32\xc4\x63\xaa\xf9\x16\xeb\x54\x8c\x7f\x12\xd7\x2d\x08\x43\xa3\x7c\x9b\x5c\xfb\xf1\xf1\x5f\x8f\x8f\xa2\x23\x4f\x4f\xff\x7a\x7a\x52\x5d\xdf\x23\xb7\x62\x9c\xd2\xce\xb5\x02\xc1\x53\xe6\x48\xc1\x59\x8f\x3d\xc2\x2c\xce\x32\x14\xae\x7c\x42\xde\x7a\xce\x24\x33\x04\x66\x5a\x3f\x92\x51\xa4\xcf\xd2\x93\x3f\x8d\xcd\x5b\xaa\x3a\x3b\x1a\x26\x7e\xbe\xbe\xbe\x42\x29\x89\xa1\xbd\x91\xe5\xdc\x60\x2b\xcf\x7a\x66\xfb\xf1\xa8\x2b\x71\x59\xfe\x18\x7b\x62\x28\x55\x38\x0e\xdf\xf1\x68\xd1\x6a\x44\x93\xf0\x19\xc3\x78\x48\x32\x6d\xc3\xbc\x37\x8d\x80\xb9\x99\x52\xf7\x11\xd3\x0b\x40\x90\xef\xea\x03\x7e\x30\x15\x9e\xff\x26\xd0\xef\x42\x55\x2f\x32\x45\x10\x4c\x22\xf7\xc1\xf9\x57\x02\x89\x14\x67\x97\xbb\xcf\xc4\x3d\x7e\xf9\x5a\xfa\x70\x53\xfc\x40\x16\xb2\x46\x83\xe8\xe6\x04\x0b\x40\x20\x19\xa4\x04\xc9\xcc\x62\x58\x1f\xbd\xbc\x0b\x4a\xc8\x2c\x7c\x43\x2c\xe5\xf3\x85\x24\xce\xe8\x02\xfb\xa4\xc4\x8e\xc2\x01\xa8\xe4\x12\xe1\x18\x54\x42\x90\xe2\x63\xd1\xa4\x8a\xe7\x28\xd8\xb2\x45\xcf\x5c\xea\x46\xa6\x6a\x7d\x67\xd8\x6d\x54\x36\xde\x8b\xf6\x77\xe4\x3e\x61\x80\x02\xb0\x03\x3a\x9c\x87\x0e\xa5\x0d\x15\xe1\x61\x3f\xff\xf0\x00\x0f\x01\x1e\x26\xed\x3b\x0b\x0f\x5f\xb0\x5f\x19\x50\xc8\xbe\xef\x37\x06\x18\xb2\xce\x08\x58\xc8\xb4\x65\x27\x71\xe2\x0b\xc1\x01\xc0\x89\x3b\x8d\x13\x35\x25\xc1\xa6\x11\x13\xa0\x44\x40\x89\xcd\x43\x89\xca\x1c\x62\x15\x94\x78\x02\x28\x11\x50\xe2\x36\xa3\xc4\x28\x1f\x38\xe4\x58\x2a\x58\x30\x79\xb8\x8f\xc2\xb3\x58\xcb\x60\x96\x4b\xdc\x2c\x4e\x1c\xfc\xfd\xef\x4b\x81\xc1\xca\x63\xd7\xaa\x38\xd8\x6f\x0a\x07\xfb\x15\x38\x78\xd1\x44\x0e\xf6\x9a\xc2\xc1\x5e\x05\x0e\xbe\x69\x22\x07\xbb\x4d\xe1\x60\xb7\x02\x07\x2f\xdb\x1a\xd2\x85\xec\x66\x91\x1d\x1b\x33\x20\xf7\x0f\x31\x5d\xc3\x23\x9c\x25\xa2\x3a\x8f\xfc\xc7\x55\x3a\x85\x36\x3c\x33\x44\x75\xb9\xc6\xc6\x45\x75\xea\xdc\x7f\x85\xb0\xee\x38\x1f\x06\x40\x58\x07\x61\x5d\xd2\xde\xe2\xb0\x6e\x71\x30\x28\x12\xfe\xd2\x40\xae\x51\xf9\xfe\xb5\x85\x75\xcf\xe7\x60\xbf\x99\x1c\x5c\x5b\x58\xf7\x7c\x0e\xf6\x9a\xc9\xc1\xb5\x85\x75\xcf\xe7\x60\xb7\x99\x1c\xdc\xa1\xb0\x0e\xa6\xea\x20\xac\x6b\x74\x88\x03\x61\x1d\x84\x75\xab\x0e\xeb\x4e\x25\xbc\x5a\x6a\xc1\x46\xb7\xca\x5c\xdd\x31\x04\x75\x10\xd4\x6d\x61\x50\x97\x64\xf9\x03\xd7\xa7\x1e\xc1\x13\x98\xac\xdb\x38\x0b\x77\x78\xb6\xae\x2e\x16\xee\xf0\x74\x5d\x5d\x2c\x84\xf9\xba\x15\x07\x76\x9f\x23\x41\x65\x82\x3b\x88\xe9\x20\xa6\x93\x93\x6c\x3a\xbe\x81\x98\x0e\x62\xba\x55\xc7\x74\x27\x12\x5e\x2d\x55\x7f\xd9\xab\xb0\x0a\x7f\x00\xab\x74\x36\x11\xd3\x21\xec\xa3\xdf\x89\xe7\x40\x6c\xb7\xea\xd8\xce\x20\x16\xc5\xd1\x52\x1d\xd6\x7d\xc7\x1b\x32\x87\xaa\xc2\x83\x99\x5c\xbf\xc5\x5d\x13\xfb\x2a\xce\xfa\x43\xf7\x4c\xfa\x07\xe6\xbb\x84\xfc\x41\x26\xc4\x1b\xad\x22\xfb\xcf\xc9\x3b\x93\xb5\xac\xec\x11\x0f\xb7\xbd\x2b\x7b\x84\xcc\x7c\x74\x88\x98\x53\x81\xa9\x02\x80\x95\x0d\x87\x58\x4b\xc0\xca\xd6\x3f\x73\x73\x61\xa5\x8e\xf5\x31\xb9\x66\xd6\xe2\x04\x0a\x3f\xa3\x73\xcc\x79\xc1\x46\xb4\x91\xe7\x04\xb6\x6a\xbb\x25\x41\x75\xc5\xfc\xa8\xa9\x80\x3d\x21\xc5\x6f\xdc\x86\xe4\x80\x43\x8f\xb1\x6d\xd1\x6e\xb4\xef\xba\xa7\xa7\x7a\xff\x58\x66\xd1\x62\x2b\xac\x6e\xef\xf5\x3e\xc3\x8c\xa7\xfb\x7c\x25\x36\x3a\x7a\x75\x72\x2a\xdd\x0e\xeb\xbb\xb7\xdd\xfe\xe9\x20\x97\x7d\xc9\x8b\xa3\x32\xe6\x55\x9b\x6d\x4a\x75\x6d\xc7\x2e\x9c\x38\xc2\x81\x00\x36\x12\x81\x33\x3d\x8c\x59\xd4\x39\x92\x61\x5d\xa6\x87\x31\x81\xac\x39\xd2\x42\x95\xbe\x24\xa3\xd5\x3b\x6e\x34\x0a\x84\x94\xa5\x7c\x8f\xbd\x3b\xe2\xf9\x32\xd5\xce\x3b\xbe\xb9\x68\x5f\x36\x80\x2c\x57\x97\x37\x07\xee\xf3\xad\xd4\xb8\x52\xc7\x28\x4e\xae\x71\xe1\x44\x4f\x1e\x3c\xa6\x01\x4a\x5e\x72\x4a\xe0\x3b\xc1\xae\x6b\xda\xa3\xeb\x70\x5c\xcb\xe3\xe5\x54\xeb\x42\x03\x43\xbc\x7d\x9b\x18\x79\x10\x75\x10\x25\x0f\x4a\x3f\x78\x1f\xeb\xcd\x33\x5c\x6e\x7c\x43\x0f\xdb\xa3\xca\x37\xec\x2e\xe8\xef\x98\x8e\x5f\x32\xa3\xba\x8a\x41\x7a\x41\xd1\x8b\x61\x44\xb4\x6e\x99\x61\x14\x09\xe5\x35\xef\xa3\xd4\x75\x95\x45\x18\x56\xc0\x60\xf2\x6f\x4c\xb3\xf9\xfe\x6a\xec\x16\xc7\xaf\xba\xaf\xfa\xf9\xcb\xbb\x8e\x4f\x43\x8f\x56\x50\x85\xa8\xe9\xad\x63\xd3\x4f\xe6\xef\xa2\x97\x83\xa3\x3f\x15\xa8\x62\x8f\x58\x3c\x5f\xb4\xcc\x39\x5d\xc8\xe1\x3d\x76\x17\xd2\x9a\xdb\x10\x70\xca\xe6\xd3\x12\x1a\x1a\xf2\x4c\xfb\xf5\xf0\x5c\x49\xe2\x24\x17\x59\x50\xc0\x2c\xc0\xf0\xee\xac\x30\xc2\x91\x78\x02\x9e\x2b\xc8\xee\x64\xd8\xeb\x30\xef\xdd\x39\x61\x3f\x4e\x4e\xb9\xfb\xee\x9c\x48\xdd\xf7\x6d\x60\x95\xc5\xbd\xfc\x8e\xe9\xeb\x86\x97\xed\xb2\x01\xa1\x73\xda\x93\x5e\x30\xed\x2d\xcb\xf1\x2a\xdf\xdd\x90\x5d\x3b\x98\xd8\x32\x59\x3e\x73\x3a\x6e\x89\xb0\xad\x90\xc5\x9f\x1f\xc0\x8d\xd7\x9e\xd7\xaf\x29\xaa\xd2\x3a\xfb\x2c\xc4\x66\x5e\xa2\xc0\xf6\x59\x74\xa5\xf5\x8e\x8a\x99\xf4\x54\xfc\x24\x6f\x8f\x02\xa8\x6b\x9e\x50\x41\xe7\x57\xbf\x70\x96\xa2\x28\x9c\xda\xb3\xf8\x97\x31\x1b\xf9\x8b\xb1\x54\x14\xbd\xf8\xcc\xa7\x5b\x84\x27\xf3\xf3\x14\xc2\x35\xa6\x6d\xfb\xa4\x68\xdb\x82\x66\x51\xdb\x76\x78\x04\xa7\xfd\x38\xcf\xac\xf3\xac\xca\xde\x74\x59\xbb\x16\x27\xff\x1a\x0d\x11\x71\x06\x6c\xdd\x18\x13\x10\x24\x20\xc8\xec\xf1\x25\x11\x64\xb7\xb4\x27\x0b\x20\xc8\x01\x20\xc8\xe2\x0d\x01\x41\x46\x54\x80\x20\x01\x41\x6e\x07\x82\x3c\x6e\x33\x82\x3c\x3e\x62\xff\x36\x81\x20\x8f\xc7\x80\x1f\xd3\x27\x03\x7e\x04\xfc\xb8\x15\xf8\xb1\x5f\x1b\x7e\xcc\x97\x9a\x03\x7e\x04\xfc\x98\x50\x01\x7e\x04\xfc\xb8\x1d\xf8\xb1\xd3\x6d\x33\x80\xe4\x62\x64\xff\x37\x01\x21\x19\xe3\x00\x43\xa6\x4f\x06\x0c\x09\x18\x72\x2b\x30\xe4\x71\x6d\x18\xb2\x9f\x3f\x0a\x18\x12\x30\x64\x4c\x05\x18\x12\x30\xe4\x76\x60\xc8\x6e\xbf\xcd\x18\xb2\xcb\xc6\x75\xf6\x7f\x13\x18\x92\x31\x0e\x30\x64\xfa\xe4\x25\x30\xe4\x76\x2e\xc1\xa9\x6d\x5b\x85\xde\x49\xa9\xf7\x09\x71\x0a\xbc\x47\xb1\x08\xc6\x60\x5b\x85\xa8\xbd\xc5\x4b\x6f\x92\xd5\xd8\xdc\xe7\x13\x5f\x67\xc3\xd6\xf0\x86\xf5\x85\x3d\xf1\x90\x7d\xb1\x9e\xf1\x26\x45\x76\x09\xc1\xd5\x78\xa9\x76\x13\x76\xe1\x8a\xfb\x94\x7f\x81\x4e\xe0\x6f\xef\x3a\x9b\x9e\x90\x2a\xba\x08\xa5\x8a\x84\x54\x61\x99\x0d\x2c\xb3\x91\x93\x6c\x7a\xc9\x49\xe5\x68\x79\x8b\x9e\xb9\xd4\x83\x34\x7c\x99\xcd\xd2\x4b\xa5\x65\xc7\xcb\x60\x5a\xff\xf5\x7c\x98\xd6\xaf\x29\x9d\xf4\x70\x45\xbc\x8f\x42\x60\x3d\x35\x02\x29\x76\xd3\x30\x7d\xd7\xc2\xd3\x18\xc1\x58\x7a\x3e\x63\x22\xa8\x6e\x4d\x62\x19\x1f\x4a\xae\x23\xa8\x98\x9b\xd6\xe5\x6e\x62\xd6\x4f\x82\xed\xe2\x78\xc4\x3f\x5f\x15\x5a\x68\x90\x5b\x1c\x58\x54\x7d\xdb\xf0\xba\x42\xdb\xa5\x19\xd0\x19\x8d\x50\xf8\x32\x8a\xc0\x36\x85\x75\x46\xc0\x4c\xde\x51\x89\xb9\x47\x5d\x10\x39\x3b\x99\x78\x12\x1a\x67\x96\x5e\xcc\xa3\xc1\x84\x26\x3b\xfc\x2a\x3b\xab\x66\x86\xb8\x8a\x1e\xe7\x35\x46\x0c\x23\xe5\xdf\x6a\x5f\xa0\x66\xa3\x1c\x51\x28\x72\x86\x2e\x8e\x05\x0b\x86\x99\xfe\x28\x1e\x6c\x91\x4e\x7f\xf7\xd3\xf9\xc5\x49\xef\xb4\x6a\xb7\x15\x3e\xb0\xd0\xed\x93\xa3\x55\x76\xda\x2b\x64\x1b\x95\x1d\x96\xe0\x0e\x69\x87\x4f\x4b\x3a\xbc\x90\x15\x95\x44\x49\xe2\x62\x12\x9b\x77\x18\xa4\x65\xc1\x09\x8d\xd2\x9d\x6c\xc0\x32\x7f\x77\x6c\x3a\x0f\x08\x55\xcb\x93\x7a\xc4\x25\xe1\x48\xc8\x30\xb9\xbc\xf1\xd2\xf4\xc2\xf7\x27\x8a\xbb\xd7\x98\x41\x63\x5e\x02\x1d\xa0\x3d\xc7\x25\x36\xb3\x34\x3a\x1d\xfa\x63\xc3\xd4\xe9\xf0\x96\x59\xca\x50\x84\x0a\x65\xa9\xb4\xe8\x4d\x9b\xe8\x10\x15\x2e\xa0\x63\x46\x66\xd2\x69\x95\xd3\x7f\xe0\xce\xaa\xfe\x65\xfe\xab\x09\x41\xf8\xd3\xad\x2a\xd8\x98\x1b\x4e\x54\xda\xee\xc9\x27\x06\x0a\x31\x3f\xe2\x9d\x65\x5a\x83\x39\xcb\x90\xe0\x3c\xda\xe3\x4c\x57\xe6\xe4\x6e\x98\x2a\x89\x09\xb6\x2a\xe0\xc2\xb1\x2c\xec\xfa\x44\x35\x67\x39\xff\x85\x09\x75\xa5\x80\x06\x83\xf9\xd8\xa2\x93\x87\x02\x2e\xb6\xc3\x59\x3c\x49\x64\x16\xb1\xf2\xca\x31\x8a\xa1\x56\xc4\x29\x8f\xe1\x8b\x5a\x10\x58\x71\x02\x58\x35\xbf\x1b\xcf\x5d\x2a\x27\x88\x55\x13\xc0\xd1\xcc\xad\x84\x7b\x0b\x4d\x00\x47\xf3\xc8\xa5\x3a\x0c\x13\xc0\x19\xca\x3a\x27\x80\x7b\x0a\x73\x39\x5e\xd4\x5a\x6a\x9b\xfe\xed\xe5\x25\x07\xd3\xbf\x30\xfd\x9b\x50\xc1\xf4\x2f\x4c\xff\xa2\x55\x4f\xff\xde\x05\x37\x64\xc8\x40\xb2\x65\xea\x02\x9e\x33\x6d\xa7\x1e\x03\x26\xc4\x8b\xf6\x25\x65\x8d\xd8\x98\xc6\x24\xca\x7c\xb8\xf4\x12\x92\x04\x79\x7b\x27\x81\x57\x35\xfb\xfb\x31\xb0\x6d\xe6\xa0\xf9\x84\x82\x12\x2c\xed\xf4\x54\x2f\x9f\x4f\x5c\x33\x52\xac\xbe\x23\xce\xc2\x58\x12\x8a\x09\x63\x82\x5d\xc2\x92\xb2\xe3\x35\x63\xc9\x92\xe5\xcc\x12\x5b\x00\x34\x29\xb9\x61\x3b\xd1\x24\xe0\x44\xc0\x89\x1b\xc5\x89\x2e\xd1\xeb\xc3\x87\xe8\x00\x01\x2e\x4d\x7d\x36\x86\x4b\x3f\xdb\xf8\x1e\x9b\x16\xd7\x2a\xc0\xa6\xf9\x93\x37\x88\x4d\x21\x8b\x89\x00\x79\x66\x8f\x97\x21\x4f\xe5\x2a\x96\x1a\xa1\xe7\x6b\x48\x63\x16\x6e\xb8\x03\xc0\x13\xd2\x98\x00\x4f\x1b\x00\x4f\x75\x36\x60\xd2\xbd\xf8\xa7\x4d\x31\x7b\x08\x6f\x38\x21\x13\xc7\x9b\x0e\x03\x1f\x8f\xc8\xf0\x66\x4a\x89\x12\x22\xca\x2b\x79\xc3\x22\x5e\x9b\x59\x4f\x8b\x17\xae\xac\x0a\x1b\xf2\x89\x5d\x64\x98\x3e\xf5\xcc\x9b\x80\xf9\x14\xe4\xd8\x68\xcc\xac\x19\x40\x62\xfa\xe4\x36\x82\xc4\x9a\xd2\x93\x46\xbf\x8f\x7b\x18\x40\x62\x5b\x40\xa2\xac\x1a\xb5\x66\x90\x58\xb2\x5d\x0e\xe4\x27\xb7\x19\x26\x02\x00\x04\x00\xb8\x52\x00\xc8\xd4\x7c\x6f\xb6\x94\x59\x64\x0e\x19\xa6\x1b\xce\xb0\x60\x92\x31\x64\x7f\x78\xd4\x1f\x8a\x75\x80\x8b\xc1\xc1\x2f\x83\x64\x61\x17\xaf\xff\xdb\xf0\x62\xae\x15\x2f\xd4\x5a\x25\x74\x7c\x8f\x1f\x44\x3a\x11\xc5\xd2\x88\x56\x3a\x0f\xf8\x7b\x90\x18\x96\x2c\xac\x93\x03\x0c\xb9\x00\x86\xdc\xce\xb5\xce\xaf\x25\x7e\x67\xb9\x42\xd7\xd3\x52\x17\x15\x16\xba\x16\xd6\xec\xae\x70\xad\x33\x07\x50\x3f\x4d\x5c\x3a\x95\x22\xa7\x84\xe4\x9f\xfc\x3d\x7c\x4a\x0a\x58\x30\x1d\x9b\xd8\xea\xdf\x59\x38\x90\xb5\xee\xe8\xc2\xe9\xb5\xcd\x09\xae\x66\xb0\x55\x2c\x9c\x29\x0c\xb6\xc2\x08\x0e\x24\x53\x60\xb9\x33\x18\xa3\xef\xa4\xe8\x38\xa1\x4b\x0d\xcf\x0a\x0a\x2e\x33\x21\xe7\x67\x84\x16\xa1\x88\x76\xa1\xba\x2f\x2f\x29\x31\x61\x59\x2a\xa9\x44\x02\x17\x75\xbd\xcb\x1d\x26\xc6\x37\xbe\xae\x28\xb0\x17\x10\xfc\x9b\x3a\x04\xbf\x6d\x29\xe7\x02\x47\x7d\x62\x1c\xc8\x12\xba\x45\x7e\x5e\xb6\x70\x4b\x88\x2b\xc7\x40\x42\x78\x68\x4f\x38\xf7\x7d\x24\x14\x68\x1f\x05\x36\xff\xfd\x3d\xc2\xb6\x11\x06\x29\x82\x07\xb3\x4c\x37\x9f\xe8\xca\x5f\x14\xb6\x8e\x40\xb3\x7c\x16\x6c\x1d\x51\x62\x65\x15\xb7\x51\x50\x75\x75\x87\xf7\x8d\x50\xac\x34\x2e\xf5\x33\x1b\xdd\x38\x62\x3b\xe3\x70\xd9\xf4\xc1\x52\x71\xf8\x71\xf9\xb4\x82\x88\xc3\xd7\xb9\xe5\x18\x84\xe1\xb5\x86\xe1\xab\x0b\xbf\x61\xdf\xb2\xfc\xce\x9d\x6b\x49\x77\x6f\x16\x8d\x8a\x7d\x03\x58\x37\xb6\x77\x8f\xb2\xab\xc5\x12\xe2\x35\x40\x4e\x99\x67\x06\xc8\xf9\x7c\xc8\xd9\x4a\x78\xb5\x8b\x90\xb2\x75\x5b\x91\x35\x66\xb7\x90\xd7\xe5\xaf\xe0\x0c\x77\x22\xcb\x8f\xd3\xca\xcd\x42\x92\xdd\x7b\xca\xfc\xe3\x9b\xab\xcf\xe8\x33\x4f\xab\xa8\xe6\x06\x2b\xee\x26\xb2\x9d\xb0\xbc\xb6\xe9\xb1\xd7\xbd\xf9\x92\xcd\x3f\x3a\xec\x04\x0c\x13\x5b\xdb\x86\xac\x79\x0a\x76\x98\x60\xe4\x2c\xba\x3e\x9b\xe1\x6c\xdd\x0d\xa2\x64\xaf\x4f\xd8\x51\x23\x42\xda\x67\x8f\x8f\xe8\xd5\xa7\x60\xf2\x11\x53\x82\x9e\x9e\x52\xc0\xfb\xbf\x55\xb6\x10\x5e\x35\xea\xae\x3a\xed\x55\x09\x75\xaf\x65\xe6\xab\xe9\xe8\x5d\x3d\x3a\x41\x6e\x18\x01\x50\x87\xc4\xaf\x84\x60\x5b\x13\xbf\xcd\x81\xe9\xb9\x0d\x4a\xe5\xef\x75\xa8\x1f\xa6\xff\x2d\x60\x63\xe0\xc6\x60\xba\x2e\xea\x4b\xa5\x4f\xb0\x41\x04\x7f\x9b\x2a\x4d\xec\x1c\x15\x6b\x13\xd7\x87\xf0\x4f\x3a\xf3\x95\x62\x9d\xf5\x6f\x80\xf0\x9f\x83\xf0\x19\xe4\x88\xd4\x4a\x62\x95\x1b\xc5\xff\x3a\xaf\x27\x91\xb3\xac\x4a\x6c\xc0\x24\xfb\x33\xc1\x86\xb8\x81\xec\x12\x21\x34\x92\xe8\x23\xb3\x7f\x85\x0f\x65\xb7\xd2\x2b\x49\x7b\xa5\x81\x89\x4f\xa7\xd6\x62\x38\x45\xf8\x42\xce\xe7\x6b\x39\xba\x0a\x1f\x8e\x85\x1a\x33\xe4\xfe\x0f\xf6\x39\x78\xff\xfe\xe0\xf2\x12\xfd\xfc\xf3\xd9\x64\x72\xe6\x2b\xa3\x03\x17\x53\x16\x1e\xd8\xf3\xae\x1f\xfb\xef\xb1\x69\x18\x44\xb2\xe9\x7a\x75\x14\x92\x3c\x8e\x0a\x34\x27\x94\x62\x99\x5a\x64\x21\x25\x40\x61\xb6\x9a\x4d\xb9\xeb\xfb\xb2\xcc\x49\x95\x0f\x28\x83\xa6\x30\xf2\x51\x38\x99\x84\xe4\x3a\x09\x04\xb4\x4b\x8f\x8d\x11\xc8\x70\xbe\xa9\x36\x52\x17\x27\x7c\xf6\x4a\x4b\xa5\x52\x62\x13\x8b\xd4\xd0\x77\xea\x25\x28\x65\xf1\xd1\x8c\x2a\x12\xb0\x1d\x4c\x6e\x98\xd5\x29\xa8\xe2\x3d\xed\x43\x00\x59\x97\x16\x7c\x24\xff\x61\x9e\x58\x5d\x6a\x04\x8a\xb0\x88\x22\x5c\xb4\x5f\x11\x50\x1e\x20\x25\xe4\xa0\x0a\x8b\xa8\x82\x6c\xaf\x17\x41\xba\x0a\x55\x88\xe0\x86\xf8\x5a\x97\x42\xbc\x33\x27\x26\xf8\x05\xe9\x09\x0b\x2b\xc3\x65\x7b\xfd\x42\xa8\x06\xe0\x15\xa4\x27\x2c\xac\x08\x3f\xb5\xd9\x2b\x5c\x15\xde\xf0\x91\xd0\xb4\x49\x0b\xe4\x31\x6b\x42\xb1\xac\x12\x1c\x1a\x87\x7c\xee\xe1\xd7\x78\x8e\x01\x3d\x3d\x15\x0e\x1c\x84\xef\xc3\x3b\xe0\x75\x43\x9e\x4d\x28\xf1\x0f\x74\x67\xe2\x06\x94\x1c\x30\xf1\x8b\xac\x86\xcf\x2b\xe9\xff\x72\x8f\xbd\x83\xd9\xd4\xc5\x6c\xe2\xe2\xcf\xbc\x81\x4f\x5d\xbc\x18\x0e\x75\xa2\x5e\x1e\x9d\xd2\xbc\xe2\x7b\x59\x12\xaa\x66\x7b\x9f\x56\xeb\x5a\x4a\x00\x87\xaf\x7e\x38\xac\x47\x02\xbc\x10\xde\x1e\x2d\x2b\x81\xcc\x91\xc2\x74\xcb\xae\x4c\xda\xf1\x85\xf0\xea\xe9\x3a\x9f\x62\x91\x32\x2b\xf1\x11\x4b\xcf\xe9\x55\x28\x9e\x53\x50\xd4\xb7\x30\x2d\xb5\x6c\x2a\x5b\xb6\x18\xbb\x1f\xf6\x47\x18\x07\x08\x59\xe9\x8e\x97\x59\x47\xb3\x53\xe2\x50\x06\x73\x75\x8b\xa3\x21\xc6\x83\x0e\xd1\xea\x94\x63\x9b\x14\x43\x19\xda\xad\xd3\x4e\x2d\x81\xcb\xc1\x4a\x95\xa1\xd5\xee\x5a\xe9\xb2\xaa\xb1\x4d\x6a\xa1\x0c\xb4\x36\x55\xea\x52\xb5\x96\x45\x3e\x85\xdb\xac\xa2\x73\x0f\xdb\x3e\x57\x03\x95\x12\x24\x80\x55\xda\x08\xd5\x2e\x0a\x1d\x87\x6a\x97\x22\x49\xc3\xaa\x5d\x92\x6f\xad\x28\x42\x39\x29\xd5\x63\x51\x6f\x90\xdf\x18\xf0\x99\x45\x28\xef\xc5\x2a\x7c\x28\x17\x5f\x43\x31\x49\x85\xdd\x94\xf2\x0b\xf6\xa0\x98\xa4\xa9\xc5\x24\x50\x2e\xbe\x6c\xe6\xa9\xe2\x0e\x20\x55\x50\xf6\x3e\x4a\x2e\xf6\xff\x3f\xbe\x84\x62\xf0\x35\x23\xe4\x1a\x8a\xc1\x4b\x87\x1f\xa8\x07\x47\xcd\x47\xc8\xc2\x6e\x9b\x00\x18\x01\x21\xcf\x08\xa0\x1e\x3c\x75\x7c\x29\xb4\x76\x5a\x61\xcf\x8d\xfc\x8b\x5c\xea\x81\xe2\x50\x12\xde\xe4\x92\xf0\xd3\xd7\xf3\xf5\x22\xb7\x9f\x3c\xa0\xf8\xc6\xa2\x78\x28\x09\xcf\x7d\xe6\x97\x84\xcf\x7d\xdd\x02\x54\x84\x17\xc9\x56\x50\x11\x5e\x82\x9c\x13\xe2\x56\x14\x5e\x34\xa6\xd4\x6b\xad\x45\xe1\x8c\x31\x21\x72\xae\x51\x17\xa0\x34\xbc\x4e\x75\x58\x6b\x69\xf8\x2a\xd5\x01\x4a\x41\xa5\x27\xec\x56\x81\x78\xa4\x13\x50\x23\xae\x3c\xa1\xd9\x35\xe2\xab\x70\x10\x50\x29\x5e\xa7\x3a\x40\xa5\xf8\xe6\xb5\x00\x2a\xc5\x63\x2a\xa8\x14\x87\x4a\xf1\xf8\x16\xdb\x31\x5f\xd7\xe0\xda\xb5\x66\xd5\x81\x47\x62\x5a\x46\x40\x5b\x23\x91\xb5\x95\x82\xaf\xc5\x3a\x16\x29\xf4\x5e\x46\xfa\xdb\x24\xf9\x26\xd5\x7a\x83\x25\xae\xb1\xdc\xbb\x29\x96\xf8\x0c\xd9\x6f\x93\xdc\x5b\x59\xcf\x5d\x3a\x05\x0b\x25\xdd\x21\x41\xcb\x0b\x56\x1a\x53\xbf\x01\x05\x2b\x33\x82\xad\x2d\xe9\xee\x1c\xf5\x4b\x15\x39\xdc\x43\xae\xfa\xce\x82\x89\xab\xfa\x95\xd0\x6f\x8e\x77\x07\x95\xdb\xab\x7f\xff\x4e\xe7\x68\x50\x41\x86\xf9\x6a\x20\xa8\xfa\x80\x97\xe7\x24\xed\x2d\xaf\xd9\x36\x3d\x4c\x49\x0a\x61\xdb\xa1\xf3\x61\xa0\x57\x27\xe6\x7d\x04\xb2\x0b\x6f\xce\xa9\x14\xe7\xac\xe5\xdd\x39\xb5\x16\x6e\xb7\xfa\xdd\x39\x1f\x43\x89\xa1\x0b\x6c\x1b\xa1\x05\xae\x00\xe6\x42\xd5\xf5\xca\xdf\xd0\x58\x82\xa5\x66\xa6\x72\xe1\x36\xa2\x32\x5b\x3b\x52\xc6\x82\x00\x75\xb3\x9f\x06\xd7\x66\x03\x48\x2c\x07\x89\x9d\x2a\x9b\x45\x9f\x00\x48\x04\x90\xb8\x63\x20\x51\xa4\xaa\x26\x26\x05\x94\x18\x7f\x1a\x8f\x12\xaf\x23\x91\x01\x4c\x6c\x23\x4c\x04\x00\xb8\xf9\x47\x6e\x2f\x00\x7c\x7c\x44\xe6\x2d\x7a\xf5\xe9\xdd\x07\xf4\xd4\x8a\x0c\x6b\xa7\xc2\x92\xac\xe3\xfc\x9a\xac\x0a\x19\x56\xce\x81\x3d\xd7\x73\x8c\x40\xe7\x70\xa1\xf8\x62\x59\x48\xb2\x9e\xa1\x13\x85\x18\x3b\x32\xe7\x5e\x2e\xc6\x2a\x9b\x9f\xe4\xdf\x94\x09\xf8\x19\xf0\x73\xd2\xde\x62\xfc\x9c\x20\xe0\x33\x8e\xfe\x88\x28\x25\x1d\x46\x20\x78\x38\xf3\x41\x49\x99\xcf\x99\x1f\xe8\x3a\xf1\xfd\x21\xc3\xdc\xa6\xc3\x7f\x92\xc1\x44\xf1\xd2\x72\x25\x44\x5d\xcf\xfb\xc8\x07\x93\xa5\x20\x72\xe5\xc1\xbf\x46\x16\xf6\x8e\x1a\xca\x43\xd6\xb1\x0a\x25\x6f\xcd\x60\xe2\xf1\xb8\x99\x3c\x3c\xce\x07\x06\x45\x16\xbe\xa9\x83\x85\x62\xdf\xc8\x77\x1f\x5e\x9d\xdf\x63\x93\x21\x4e\xd3\x32\xe9\xf4\xc3\xcd\xbf\x09\x63\xdc\x3d\x2f\x14\x47\x87\xcc\xef\x28\x01\xf1\x7a\x78\xe1\xc4\xfd\xa9\x50\xbe\xd5\xbe\xe8\xf5\x53\xa8\x93\x48\xe8\x24\x04\xae\xed\x09\x5c\x17\x9d\xdf\x48\xaf\x82\x69\x45\xcc\x07\x71\x6e\xfc\xd9\x7c\x9c\x0b\x81\x5a\x79\xa0\x26\x6d\xa8\x1e\xa9\xe5\x4b\x9a\x20\x52\x83\x48\x2d\x69\xdf\x9d\x48\x6d\x68\x04\x02\x86\xd8\xf1\xee\xe2\x67\xee\xe9\xa0\xd1\xf1\x1a\xeb\x5f\x23\x03\x36\x19\x27\x4f\x1b\xce\xc9\xd3\xa5\xa2\xb6\xa6\xe3\xeb\x77\x8c\xe7\xb6\x3e\x05\x64\xbd\xbd\xc8\xba\x11\xd3\x46\x80\xa7\x33\x04\x0d\xc7\xd3\x8f\x8f\x88\x79\x3f\xf4\x14\x1e\x8a\x7a\xc0\x1d\x1d\xf7\x57\x9c\xc7\x9d\xa3\x58\xab\x34\x5f\x1f\x93\x09\xfe\x8d\x78\x3e\xf3\x45\xda\xac\x2e\x26\xdc\xdf\x8b\x13\x1b\xd8\xbb\x8b\xa9\x19\x46\xc9\x2a\xbb\x16\xae\xb5\x4f\xc9\x4b\x8b\xc6\x0d\x2d\x73\x73\x4a\x26\xae\xc5\xc6\x0c\x7b\x94\x79\x76\x86\xa9\x7c\x5a\xb0\x1e\xd9\x66\x68\x09\xf2\x54\x28\x10\x25\x0f\x34\xce\xf9\x5c\x26\x90\x9d\x6f\x0b\x80\xd4\x95\x01\xc2\xc8\xd5\x67\x55\xd1\x67\xbe\x91\x18\xbf\x84\x6c\x32\xc5\xb4\x75\x2b\x30\xc8\xb9\x55\x86\x5b\xcb\x55\x5a\x9b\x04\xcc\x93\x97\x9c\x1e\xf9\x38\x4d\x19\xa6\x08\xaa\x19\xf8\x94\x2d\x7f\xd7\xd8\xa8\xee\x4d\xc5\x38\xc9\x06\x2c\x42\xc7\x24\x90\x79\x9d\x94\x06\xc9\xa6\x0c\x3d\x36\xe6\x72\xc3\x92\x2d\x48\xd3\xfc\x3b\xd3\xfd\xec\x59\x9f\xa6\xb6\x5e\xf2\x30\xf1\x48\x91\x7a\x98\x32\xe7\x2b\xb5\x21\xeb\xb7\x48\xa8\x0a\x86\x96\x6b\x52\xa2\xe1\xd2\x5d\x02\x32\x5a\x96\xde\x72\x42\xaa\x60\x59\xf5\xca\x90\xaf\x55\xb3\x66\xf0\x4e\x26\x99\xaa\x0a\x56\x7a\x91\x94\x7e\x49\x18\xa1\x30\x59\x9f\x58\x44\xa7\x25\x50\x44\x50\x2d\xc4\xf2\xc5\x98\x9e\xf7\xd5\xfc\x53\x6a\x1e\x15\xfa\xb0\xa0\xa6\xeb\x81\x4f\x19\x46\x5c\xb3\x96\x2f\xcc\xfc\x59\x0c\x30\x97\xed\x29\xd2\xf5\x6a\x39\xb1\xef\x4d\xcf\xb1\x27\xfc\xb1\x9f\xa1\xe7\xec\x32\x8b\x6b\xb8\x4a\xc7\xc5\xa9\x95\x58\x2d\x28\x2b\xb3\x5b\x50\x57\x62\x39\xff\x48\xd8\x5e\xbd\xcb\x25\xdb\x39\x65\xfa\xec\x33\xcf\xa9\xde\x37\x25\xdb\xe1\x98\x56\xde\xdb\xc5\x6c\x72\xf6\xf0\xfb\xea\x2e\xb4\xc5\x2a\x63\x66\x9e\xab\x37\x1d\x8a\x99\x28\x57\x43\x41\xf2\x62\x38\x64\x7d\x91\x73\xf7\x6b\x25\xa3\xac\x9a\x03\x0d\x89\xc9\xad\x69\x9b\x34\xc4\x8f\xa1\x3d\x0e\xc3\xf0\x69\xcf\x66\xf2\x78\x18\x8e\x29\x75\xf9\xfa\x7c\x9b\x08\x39\xf9\x8a\x1c\x81\xaa\xe2\x54\x1c\x97\x15\x9a\x56\xf6\x1d\x8a\x18\xaf\x22\xf4\x52\x9c\x1d\x3b\x0c\xf9\xd6\x4f\x95\x21\xd7\x3a\xf9\x35\x03\x70\xb2\xe8\xbe\x06\x00\x57\xb2\xb8\x9e\xd9\xa6\xb0\x0e\xff\x6f\xf1\x93\xcb\x6e\x53\x02\xbf\x44\x5b\xf9\xc9\x91\xf9\x86\xbc\x95\x10\x04\x3e\xb9\x0e\x6f\x20\x49\x12\x27\xdf\x42\x13\x79\x8a\x23\x17\x53\x88\x39\x15\xb3\xdc\x86\x59\x1d\xcd\x76\xbe\x1d\x74\xd2\x09\x0d\x8d\x3a\xd1\x71\xad\x70\x09\x26\xa3\x3b\x91\x85\x4d\x5d\x28\x12\xc7\x30\x4e\x6b\x15\x47\x17\x6d\x90\x07\xe2\xa9\xd8\x2d\x39\xd4\x2b\x1e\xea\xe4\xab\x14\x8a\xc5\x1f\x5a\xa7\x78\xa8\x58\xdd\xa0\x75\x0a\x29\x9b\x6e\xe1\x48\xc7\x98\x39\x9b\xaf\x69\x7e\xf0\x94\x9e\x6a\xe4\xac\xd6\xa3\xe2\xed\x0b\xd5\x03\x5a\xa7\xd8\xa3\x6e\xbf\x78\x28\x6f\xa6\xda\xeb\xc2\x91\xde\x51\xfa\x49\x0a\x42\xfc\xdd\x11\x79\xec\x58\xf9\x66\x79\xb8\xc2\x9e\x74\x28\x8c\x8b\xd1\x21\x3a\x8f\xc3\xe1\xa7\xff\x01\x78\x4b\x5e\xba\x26\x66\x01\x00")
 
 func monitoringApicastGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func monitoringApicastGrafanaDashboard1JsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _monitoringApicastGrafanaDashboard2JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5b\x6f\xdb\x38\x16\x7e\x9f\x5f\x41\x70\x83\x4d\xba\x70\x5a\x3b\x19\xb7\x49\x80\x3e\x24\xb3\x9b\x9d\x01\xba\x33\xdd\x26\x33\xc0\x6e\x11\x78\x68\xe9\x58\x26\x42\x89\x0a\x49\x39\xf6\x04\xde\xdf\xbe\x10\x75\xb3\x24\x92\x96\xdb\x24\x4e\x3a\xe9\x53\x4c\x1e\x4a\x87\xe7\xf6\x7d\xbc\xa8\x77\xdf\x21\x84\x10\x26\x51\xc4\x15\x51\x94\x47\x12\x9f\xa0\xac\x51\x77\x30\x2a\x15\x3e\x41\x9f\xcb\x96\xf4\xdf\x5d\xed\x97\x96\x1b\x27\x94\xa9\x9f\x22\x7c\x82\x06\xbd\x76\xaf\x4f\x14\x91\x3c\x11\x1e\xe0\x13\x84\xf7\xf7\xd1\x3f\x05\x99\x90\x88\xa0\xfd\x7d\x6c\x10\x87\x88\x8c\x59\x2a\xaa\x44\x02\x86\xfe\x29\xf5\x1d\xbd\xd4\xe3\xd1\x0f\x9c\x71\x91\xbe\x4b\x04\x63\xb2\xd7\xef\xa1\x83\xc1\xa0\x87\x0e\x86\xc3\x1e\x1a\xbc\x32\xbd\x32\x22\xa1\xd6\xed\xb4\x32\x04\xfa\x2b\x3a\x65\x20\x94\x34\xc9\xab\x45\xac\xe5\x7d\x22\xa7\x63\x4e\x84\x8f\x6b\x32\xcb\xf2\xd7\x95\xfe\x6b\x99\x3d\x02\x83\x4f\x55\x6b\x6e\x38\x88\x40\xfd\xe4\xe3\x13\x14\x25\x8c\x15\x6d\x82\xc4\xd3\x4b\xce\x99\xa2\x31\x3e\x41\xfd\xbc\x99\xd1\xe8\x3a\x75\xd1\xe7\xab\xbc\x21\x26\x11\x30\x59\x73\x51\xdd\x3d\xd8\xe3\x8c\x91\x58\x42\xfa\x82\x09\x61\xb2\x61\x33\x1c\x08\xea\x7f\xe4\x75\xbf\x57\xa6\xb6\x78\xf4\x16\x9f\xa0\x83\xef\x0d\x1d\xf3\x4a\xd9\x5a\xfb\x22\x6d\xaf\xdb\xa8\xa1\x07\x4d\x15\x3c\x68\x8c\x5d\x99\xdf\x55\xa3\x47\x40\x0c\x24\x0d\x4e\x2c\x41\xcc\xa8\x07\x23\xea\x37\x5c\x85\x15\x55\xda\xd8\x78\xa7\x92\x41\xfb\xa8\xfc\xa5\xdd\xde\x1c\x93\xbb\x56\xf0\xdb\xca\xa9\x2b\xca\x36\xcc\x4b\x18\x25\x52\xc7\x9b\x36\x61\x73\x56\x63\xa2\xdb\x4d\x86\x4f\x63\xe7\x03\x44\x81\xd2\x46\x6e\x4e\x3c\xed\x05\xfb\xd0\xd5\x84\xda\x59\xf9\xd9\x10\x9c\x50\xc6\xda\x2e\xec\xe0\x73\x93\x0f\x53\xa7\x0f\x0e\x36\x74\xfa\x60\xbd\xd3\xdf\x36\xe7\xce\x20\x80\xc8\x37\x6b\x47\x66\x81\xd9\x28\xba\xd7\x4b\x84\x80\x48\x39\x24\x42\x32\x77\xf5\xd2\xc8\xd1\x2b\xa7\xfc\xd6\x5e\x78\x14\x57\x84\x39\x46\xcf\x08\x4b\x2a\x8f\x3a\xcd\xc2\x68\xa4\x25\xdb\x6f\xd2\x5d\xb7\xd4\x57\x86\xcc\x6c\x55\x87\xb2\x23\x2d\x2c\x1f\x39\x8d\xd4\xbf\xb8\x2e\x9d\xba\xa1\x19\x2b\x3c\x2e\x01\xa0\xa9\x4f\x0c\xc2\x83\x48\x91\x00\x2c\x01\x19\xa7\x0f\x17\xc4\xa7\x49\x3a\xfe\xc0\xd4\x6b\x8b\x65\x01\x91\x0f\x02\x74\xb9\x9e\x30\xae\x9a\x6a\x49\x10\x14\xe4\x2f\x33\x10\x82\xfa\x60\x9c\x9e\x8c\x89\x07\xf6\x54\x92\x8a\x78\xd7\x96\xb7\x4b\x05\x71\x0c\xfe\x07\x1a\xd9\xa6\xa6\x88\x08\x40\xc9\x16\x0a\x22\x23\x12\xea\x21\x30\x8f\xf5\x74\x64\x12\xee\x09\xa2\x60\x2f\x89\xa5\x12\x40\xc2\x91\x54\x44\x25\xf2\x2e\xad\x3b\x5a\xe9\xf7\xbb\x3b\xe5\xdf\xbb\x3d\x14\x73\xff\xfd\xff\x76\x49\x4c\x3d\x22\xd5\xfe\x0e\x44\xb3\xd7\x7f\xdb\xed\xa1\xaa\x78\xbd\xdf\x5d\xa9\x64\xbb\xcb\xcf\x83\xf0\xea\xd5\x2b\x34\x5e\xa0\xbd\xec\xc9\x26\x70\xd3\x2a\x4d\xb8\x08\xb3\x6a\xa9\x68\x08\xa3\xcc\xa8\x36\x61\x1a\x29\x10\x33\xc2\xce\x89\xa7\x34\x8e\x1a\x20\x00\x55\x89\x7a\x5e\x3e\xfb\xee\xee\xf7\xbb\xbb\x4c\x93\xe5\xf2\xf7\xe5\xd2\xf6\x02\x01\x13\x0d\x78\xf8\x14\xb7\x04\x96\xb5\x96\xa6\xab\xd5\x54\x80\x9c\x72\xe6\x1b\x03\x21\x9d\xdb\xb9\xe0\x61\x0d\x4b\x6b\xbd\x9f\x20\xc8\x83\xdc\x38\xf8\x62\x4a\x27\xca\x36\x3a\xc7\x92\x5f\x73\x67\xa2\x1f\x2f\x2f\x3f\xa2\x6c\xb6\xc8\xe3\x3e\x48\xb4\x17\x83\x40\x12\x3c\x1e\xf9\x28\x75\x7c\xd3\x1d\x58\x95\x88\x6e\x28\x6d\x72\x4a\x84\x86\x69\x4b\x81\x91\x5c\xa8\x76\x76\xa1\xb2\xb6\x8c\x0a\xe8\xa2\x91\x4f\x67\xd4\x4f\x08\xc3\xce\x32\x53\xc8\x6b\xb2\xd1\x54\x75\x4e\xe6\xd4\x82\x10\xe3\xc4\xbb\xce\x52\xa2\x6d\x27\x2d\x10\xe6\x65\x26\x35\xa9\x83\x6e\x59\x46\xbb\xcb\x6c\x59\x46\x3f\x5f\x39\x27\xb7\x20\x73\xd8\x28\x6b\x7d\xf0\x68\x48\x34\xd9\x30\x80\x19\xaa\x67\x91\x9c\xa6\xce\xb0\xa5\x05\x19\x03\xb3\xce\x2f\x13\xe1\xc1\x19\x91\xe0\xc8\xad\x0c\xa8\x1c\x8f\xc8\xb0\xca\x21\xb0\x62\xc7\x76\x96\xb5\xc7\x58\xcc\x52\xcd\x59\xc0\x4d\x6c\xad\x19\xcf\x61\xce\xce\xca\xb2\xb0\xc7\x3b\x61\x34\x70\xf1\x02\xdd\xff\x01\x66\xa5\x01\x2c\x8b\x81\x27\xc6\x22\x73\x72\x38\x7c\x78\x72\x68\xec\xe8\xca\x0e\x0f\x5f\xd8\xe1\x0b\x3b\xfc\x06\xd9\xe1\x94\x4a\xc5\x03\x41\xc2\xd1\x4d\x42\x22\x45\x19\xec\xed\xe4\xa6\xa4\x0c\xde\x0c\xfa\xfd\x1e\x2a\x19\xa4\x0e\x9f\x91\x00\x19\xf3\x48\xc2\x28\x27\x71\x29\xd1\x90\xa3\x0c\x8e\x1f\x82\x54\x32\x78\xb5\x65\x46\x79\x99\xce\x1b\x09\xb8\x49\x40\x2a\x64\xe1\x13\x68\x2d\xa7\xec\x8c\x76\x5f\xe4\x9c\x92\xde\xff\xf9\xfc\x53\x92\xe1\x8d\x5c\x74\xf6\x0c\x69\x7f\x3b\x12\xd1\x4c\xa2\xc4\x34\x7f\xb4\xb7\x73\x57\x05\xcb\x52\x4d\x51\xf5\xeb\x65\x49\xf0\x3c\x97\x04\x0e\xce\xb9\xb2\x2a\x58\xc7\x8e\xb1\x55\x60\xdb\xdc\xf8\x0b\xd6\x03\xcf\x7e\x0d\xf4\x74\xd7\x03\x1e\x11\xbe\xe5\xe5\x69\xd7\x47\xe2\xfb\x34\x0a\xec\xf9\x92\x0a\x7d\xe2\x49\xe4\x1b\x15\x68\xcc\xd4\xcb\x4f\x4b\x2c\x2f\x2b\x0f\x53\xfe\xf2\xee\xf0\xec\xfc\xed\xb1\x29\x7b\xf5\x23\x2e\x3c\x92\x95\x4a\x79\x63\x8c\x8b\x42\x6a\x0a\x61\x5e\x93\x14\x88\x98\x33\xa2\xe0\x17\x41\xa2\xc0\x08\x4f\x29\x22\xf3\x28\x63\xed\xfd\xd7\x43\x47\x65\xe1\x31\xf1\xa8\x5a\xb8\xeb\x9b\x4f\x14\xa9\xd0\x4b\xc9\xa2\x6e\x19\xc4\x3a\x6d\xb0\x3f\xe6\x4e\xba\x7b\xb1\x34\x05\xa2\x42\x12\x9b\x08\xfa\x94\xfa\xf0\x5f\x10\xfc\xac\x2c\xd2\x26\x2a\x3b\xa5\xc1\x94\xd1\x60\xaa\x7e\xc8\x83\xcf\xb0\xbe\xd0\x2b\xb2\xe1\xd1\x06\x2b\x32\x5b\x02\x1a\x16\x35\xe6\xd5\x89\x63\xe1\x21\x60\x06\x42\xc2\x7f\xdc\xf3\xba\x8f\x0d\xdc\x7b\xa5\xdf\xfb\x9b\xf0\xbb\xf5\xf4\xae\x70\xbc\x95\xda\x49\x45\x5c\x8b\x5e\x64\x26\x80\xb6\x3d\x30\xe3\x9e\x2f\x83\x87\xda\xef\x5d\x4b\xed\xba\x70\xb7\x4f\xb9\xe3\x32\x76\x96\x1b\x0c\xed\x8d\x17\x59\x43\xe6\xc1\x4d\x99\x99\x8b\x91\xa4\xbd\x3f\x16\x4b\x88\x2e\xe1\x5f\xf0\x2e\xb3\x33\xf1\xfc\xd4\x0a\x46\x5d\x13\x6c\x9e\x65\xc9\xcf\x49\x38\xd6\xcb\x69\x83\xbd\x72\x91\x0b\xfa\x87\x99\x8a\xe1\x85\x5d\x0d\xf7\xee\xe9\x1a\x8e\xe4\xa6\x02\x4e\x1a\xe0\xa4\x00\xeb\xbc\x14\x33\xaa\xca\x88\x5f\x8b\x93\x8b\xcc\x3c\x67\x39\xae\x62\x92\x28\xde\x74\xd4\x62\xbd\x95\x17\x2d\x2b\x3f\x5b\x42\x70\x7e\xfa\xf7\x7f\x1c\x9c\xbe\x10\x82\x15\xc1\x47\xdd\x3d\x7d\x2a\x8c\xe0\x6d\x6b\xaf\xf1\x9b\x67\x04\xdb\xdc\xf3\xb9\x07\x4e\xf0\x2d\xa3\xfd\xca\x9e\xd4\x0b\xec\xbf\xc0\x7e\xbb\xf7\xc9\xc1\xbe\xfe\xab\xb8\xd2\x27\x60\x22\x40\xa6\x50\x81\x07\xfd\xc2\x6c\x58\xa6\x28\x49\x7e\x03\x21\x29\xd7\xf7\x2c\x8f\x8a\x0e\xb5\x60\xf9\x5d\x44\x71\x5d\x48\x2b\x12\xd4\xcb\x1b\x3e\x94\x1a\x8c\x2b\xa5\x70\x5e\x7d\x70\xed\xe5\x0a\xc2\x98\x11\x95\x71\x89\x2f\xb8\x08\x9a\xdf\xcd\x34\xc5\x02\x8d\x3c\x96\xf8\x70\xca\x5c\xc7\x60\xee\x6d\x23\x1c\x26\x4c\x51\xc7\xf0\xe2\x1e\xa7\x15\x9f\x51\x1d\x40\x9a\xd8\xa2\xbb\x6f\x12\x10\x29\xbc\xe2\x58\xf0\x10\xd4\x14\x12\x63\xf0\x56\x7e\x32\x05\xaf\x80\x00\xe6\x96\x1d\x3f\x2c\xaf\x69\xfc\xab\x60\x17\x8b\xc8\x73\x4c\xa6\xba\x64\x5a\x4e\xc6\x15\xa8\xc6\x8d\x2a\xf6\x1b\x61\x89\x63\x63\xb5\x3a\x12\xb5\x00\x5f\x11\x49\x06\x43\x65\xfd\x30\xcf\x81\x01\xbd\xfe\xb9\x40\x38\x64\x07\x87\x59\xae\x4f\x7b\x40\x1b\x2b\x0c\xfa\xde\x57\x7c\xe1\x12\x8d\x4d\xfe\xe9\x1a\x66\xce\x87\xac\x44\x99\xd1\x14\x66\x83\xeb\xa1\x12\x18\x78\xca\xb1\xf1\x5f\x4a\x6e\x68\x7e\xb4\xa1\x0b\x50\x0b\xb2\x51\x1b\xb6\x51\x2d\x69\x3a\x68\xb2\x61\xfc\x7b\x89\x54\x3c\xdc\x42\xec\xaf\xb8\xc1\xb5\x77\xd2\x35\x45\x62\xc1\xfd\xc4\x4b\x83\x62\x6d\x72\xac\x88\x3e\x6e\x5a\x40\x34\xa3\x82\x47\x61\x6a\x97\xaf\x48\x0c\x88\x66\x5b\x4f\x89\xb5\xe6\x46\x9d\x4d\x8e\xcc\x66\xef\xae\xb2\x23\x7c\x6a\x3a\x4b\x45\x82\x14\x7b\xbb\x28\x5c\xc8\xde\x47\xd2\x56\x93\xef\xd9\x55\x78\x22\x69\xdb\x75\x29\x9e\x09\xc3\x84\x46\x54\x65\x9c\x29\x0b\xf3\x51\x76\x5c\x78\xef\x57\x2a\x96\xab\xeb\x37\xe3\x57\x26\xf7\x96\xa5\xae\xd4\xb4\x90\xde\x22\x33\xad\x1f\x4b\xa0\x4d\x98\xd1\x96\x0d\x59\x51\x2f\xd3\xd6\xc8\x3d\x50\xaf\xfc\x5c\xdd\xe4\x29\x45\x02\x1d\xa0\xf2\xdf\x85\x31\x8c\x1f\x08\xd9\x31\x41\xf7\xb9\x07\xe7\x19\x94\x99\xdb\x20\x90\x48\xb8\xcc\x5e\xb0\xf6\xb6\xd8\x03\xb1\xc2\xbc\x5e\x91\x98\xae\xc5\xb2\x54\xa6\x13\x88\x3d\xc9\xc4\x5e\x73\x58\x53\x75\xc9\x85\x54\x10\xea\x4f\x8a\x5c\xc9\x6f\x0a\xd8\x47\x4f\x7e\xc3\x77\x4f\xe8\xd1\xf1\xd9\x1e\x3b\x68\x7d\xfc\xa0\xcd\x31\xee\x19\xc4\x48\x55\xd7\x4c\x95\xe7\xa5\xae\xad\xfc\xdb\xee\x6a\xf7\x78\xb8\xb6\xec\x1d\x0f\x1f\x97\xba\x7f\xe5\x8e\x49\x75\x43\xed\xe1\x0a\x43\x57\x16\x7c\x6c\xba\x63\x52\x4a\x55\x16\x3e\x7e\x00\xa6\xde\xb1\x78\x59\x03\x00\xad\x0d\x82\xaf\x56\xb1\xb3\x19\xfb\xdd\x74\xec\x6f\x51\xc7\xa3\x6e\x3a\x1e\x6d\x53\xc7\x77\xdd\x74\x7c\xb7\x4d\x1d\x87\xdd\x74\x1c\xda\x74\xdc\x0c\x4a\x8f\x8f\x7b\xe8\x78\xd8\x43\xc7\xfd\x1e\x3a\xea\xf7\xd0\xbb\x7e\x0f\x19\x35\xb8\x8f\x25\x63\xf9\xab\xfe\x2d\xbd\xbe\x48\x5a\xdb\xa3\x9e\x64\x27\x47\x38\xe2\xb7\xfb\x83\xd5\xcb\xab\x58\xf1\xbc\x1d\xb7\x1e\x11\x53\xef\x1a\xea\x87\xdd\x05\x0c\x8f\x8a\x63\xb2\x76\xdd\xc3\xc3\xd6\x09\x71\xb5\x57\x5f\x36\x1d\xb6\x9b\x06\x61\xb3\x65\xd8\x6a\x19\xb4\x9b\x0e\xfb\x6d\xa9\xd6\xf5\xdc\x83\x56\xcb\x60\xe5\xff\x25\xb8\x5a\xb5\x47\x4a\x77\x6c\x35\xbd\x9b\x46\xed\xd7\xbf\x6d\xbf\xbe\xad\xd1\xc1\xf7\xed\xa6\xd6\x67\xfb\xef\x5a\x2d\x87\xfd\xd5\x99\xb4\x9c\xf8\x07\xd7\x1f\x68\xe0\xf2\xf8\xa3\x38\x0a\x6c\x6e\x46\xa2\x37\x28\x3b\x08\x41\x6f\xd0\x69\xc6\xe0\xd0\x45\x46\xce\x24\xfe\x6e\xf9\xff\x00\x00\x00\xff\xff\xcd\x32\x35\x17\x0f\x43\x00\x00")
+var _monitoringApicastGrafanaDashboard2JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x5b\x6f\xdb\x36\x14\x7e\xef\xaf\x10\xb4\x60\x4d\x87\xa4\xb5\x93\xb9\x49\x06\xf4\x21\xe9\x96\x75\x40\xd7\x75\x4d\x36\x60\x2b\x02\x8f\x96\x68\x9b\x08\x75\x29\x49\x25\xf6\x0c\xef\xb7\xef\x90\xba\x4b\x24\x2d\x77\x49\x9c\x74\x11\x90\xc2\xe2\x39\x14\x0f\xcf\xf5\x23\x29\x75\xf1\xc4\x81\xcb\x45\x61\x18\x09\x24\x48\x14\x72\xf7\x3b\x67\xa1\x1a\x15\x81\x12\x2e\xa0\xe5\x63\xd1\x22\xaf\x45\xed\x4e\xf1\x8d\x12\x42\xc5\x4f\x21\xb0\xf6\x77\xda\x54\x1f\x09\xc4\xa3\x84\x79\x18\x18\xdc\xdd\x5d\xe7\x47\x86\xc6\x28\x44\xce\xee\xae\xab\x61\xc7\x21\x1a\x51\xc9\x2a\x58\x82\x35\xf4\x29\xf1\x2d\x54\xe2\x45\xe1\xeb\x88\x46\x4c\x8e\xc5\x26\x23\xb4\xdd\xdb\x71\xf6\xfa\x7d\xf8\x67\x30\xd8\x71\xfa\xcf\x74\x43\x86\x28\x50\xb2\x1d\x97\x8a\x70\xbe\x76\x8e\x29\x66\x82\xeb\xf8\xc5\x3c\x56\xfc\x3e\xe2\xd3\x51\x84\x98\xef\xd6\x78\x96\xc5\xdd\x85\xfa\xb5\x4c\x1f\xe1\x62\x9f\x88\xd6\xdc\xdc\x49\x88\xc5\x4f\x3e\xb4\x85\x09\xa5\x79\x1b\x43\xf1\xf4\x3c\x8a\xa8\x20\x31\x50\x7a\x59\x33\x25\xe1\xa5\x34\xd1\xc7\x8b\xac\x21\x46\x21\xa6\xbc\x66\xa2\xba\x79\x5c\x2f\xa2\x14\xc5\x1c\xcb\x01\xc6\x88\xf2\x86\xce\x60\x24\xe2\xbf\x8f\xea\x76\x2f\x55\x6d\xb0\xe8\x35\xb4\xef\x7d\xab\x21\xcc\x4a\x61\x6b\xed\x73\xd9\x5e\xd7\x51\x43\x0e\x22\x05\xdc\x6b\xf4\xad\xcc\xef\xa2\x41\x61\x38\xc6\x48\x3a\xa7\xcb\x31\xbb\x22\x1e\x1e\xc2\x13\x1a\x3c\x82\x08\xa5\x6c\x77\xab\xe4\x71\x76\x9d\xe2\x4e\x99\xbd\xd9\x27\x33\x2d\x8b\xae\x4b\xa3\x56\x84\x6d\xa8\x17\x51\x82\xb8\xf2\x37\xa5\xc2\xe6\xac\x46\x48\xb5\xeb\x14\x2f\x7d\xe7\x2d\x0e\x27\x42\x29\xb9\x39\x71\x49\xc5\xe6\xae\xd5\x80\xda\xaa\xdc\x36\x18\xc7\x84\xd2\xb6\x09\x3b\xd8\x5c\x67\x43\x69\xf4\xfe\xde\x9a\x46\xef\xaf\x36\xfa\xcb\xe6\xdc\x29\x9e\xe0\xd0\xd7\x4b\x87\xae\x26\x7a\xa5\x28\xaa\x97\x30\x86\x43\x61\xe1\x08\xd0\xcc\x46\x25\xa1\x85\xca\xa7\xd1\xb5\x39\xf1\x08\xc8\x1c\xd4\xd2\xfb\x0a\xd1\xa4\xb4\xa8\x55\x2d\x10\xe6\x8a\xb3\x3d\x92\x22\x5d\x13\x5f\x68\x22\xb3\x95\x1d\x0a\x82\x4c\x2c\xef\x23\x12\x8a\x9f\x23\x95\x3a\x55\x43\xd3\x57\xa2\xb8\x28\x00\x4d\x79\x62\x0c\xbe\x15\x0a\x34\xc1\x06\x87\x8c\xe5\xc3\x19\xf2\x49\x22\xfb\xef\xe9\xa8\x26\x5f\x06\x7b\xf9\x98\x61\x95\xae\xc7\x34\x12\x4d\xb1\x20\x56\x09\xe6\xbf\x5c\x61\x06\x4e\x8b\xb5\xd3\xe3\x31\xf2\xb0\x39\x94\xb8\x40\xde\xa5\x61\x74\x2e\x70\x1c\x63\xff\x2d\x68\xd5\xc0\x21\x10\x9b\x60\x51\x4f\xb1\xf9\xd5\xf6\x4f\xd5\x05\xcf\x62\x35\x1d\x9e\x04\xdb\x0c\x09\xbc\x9d\xc4\x5c\x30\x8c\x82\x21\x88\x22\x12\xbe\x90\x79\x47\x09\xfd\xea\xe9\x56\xf1\xfb\xe9\x8e\x13\x47\xfe\xab\x7f\x9e\xa2\x98\x78\x88\x8b\xdd\x2d\x1c\x5e\x3d\xff\x06\x9a\xcb\xe4\x05\xfc\xe5\xcd\xd3\xe5\xc7\x7e\x70\xf1\xec\x99\x33\x9a\x3b\xdb\xe9\x93\x75\xc5\x4d\x89\x34\x8e\x58\x90\x66\x4b\x41\x02\x3c\x4c\x95\x6a\x62\x06\x6b\xc1\x20\x88\x9e\x22\x4f\xa8\x3a\xaa\x29\x01\x8a\x31\x0d\xd4\xd3\xe2\xd9\x8b\xc5\x5f\x8b\x45\x2a\xc9\x72\xf9\xd7\x72\x69\x1a\x80\xe1\xb1\x2a\x78\xee\xb1\xdb\x62\x58\xd6\x5a\x9a\xa6\x16\x53\x86\x21\x0c\xa9\xaf\x75\x04\x39\xb7\x53\x16\x05\xb5\x5a\x5a\xa3\x7e\xc0\x93\xcc\xc9\xb5\x9d\xcf\xa6\x64\x2c\x4c\xbd\xb3\x5a\xf2\x5b\x66\x4c\xe7\xcd\xf9\xf9\x7b\x27\x9d\xad\xe3\x41\x60\x71\x67\x1b\x02\x05\xac\x05\x08\xc4\x77\xa4\xe1\x9b\xe6\x80\x1c\x91\x57\x74\x4d\x6a\xe3\x53\xc4\x54\x99\x36\x24\x18\x1e\x31\xd1\x8e\x2e\x45\x52\xb9\x65\x98\x97\x2e\x12\xfa\xe4\x8a\xf8\x09\xa4\x23\x6b\x9a\xc9\xf9\x15\xd8\x68\x8a\x3a\x43\x33\x62\xa8\x10\xa3\xc4\xbb\x4c\x43\xa2\xad\x27\xc5\x10\x64\x69\x46\xaa\xd4\x02\xb7\x0c\xbd\xed\x69\xb6\x48\xa3\x1f\x2f\xac\x93\x9b\xa3\x19\x5e\x2b\x6a\x7d\xec\x91\x00\x29\xb0\xa1\x29\x66\x8a\xa5\x8c\x22\x90\x91\x35\x53\x55\xc1\x46\xd1\x08\x53\xe3\xfc\x52\x96\x68\x72\x82\x38\xb6\xc4\x56\x5a\xa8\x2c\x8f\x48\x6b\x95\x85\xa1\xa2\xc7\x76\x94\xb5\xfb\x18\xd4\x52\xce\x99\xe1\x4f\xb1\x31\x67\x3c\x84\x39\x5b\x33\xcb\xdc\xec\xef\x80\xf1\x26\x36\x5c\xa0\xe8\x6f\xf1\x55\xa1\x00\xc3\x62\xe0\x9e\xa1\xc8\x0c\x1c\x0e\x6e\x1f\x1c\x6a\x09\x5d\xd1\xe1\xfe\x23\x3a\x7c\x44\x87\x5f\x20\x3a\x9c\x12\x2e\x22\x28\xbd\xc1\xf0\x53\x82\x42\x41\x28\xde\xde\xca\x54\x09\xbf\x5f\xf4\x7b\x3d\x40\x7e\x39\x82\x54\xee\x33\x04\xec\x13\x83\x11\xa0\xd2\xa7\x20\x4e\x02\x0d\x3e\x4c\xcb\xf1\x6d\x80\x4a\x8a\x9f\x6d\x18\x51\x9e\xcb\x79\x3b\x50\x79\x20\x38\x84\x63\xc0\x13\xaa\xa7\x15\x53\x76\xae\x76\x9f\x65\x9c\x02\xde\xff\xff\xec\x53\x80\xe1\xb5\x4c\x74\xf2\x00\x61\x7f\xdb\x13\x9d\x2b\xee\x24\xba\xf9\x3b\xdb\x5b\x8b\xd2\x59\x96\x62\xea\x94\x77\x8f\x4b\x82\x87\xb9\x24\xb0\x60\xce\xca\xaa\x60\x15\x3a\x76\x8d\x0c\x9b\xc6\xc6\x9f\xb1\x1e\x78\xf0\x6b\xa0\xfb\xbb\x1e\xf0\x10\xf3\x0d\x83\x4b\xd2\x7b\xe4\xfb\x24\x9c\x98\xe3\x45\x32\x7d\x88\x92\xd0\xd7\x0a\xd0\x98\xa9\x97\x9d\x96\x18\x06\x2b\x0e\x53\xbe\x3a\xd8\x3f\x39\x7d\x79\xa4\x8b\x5e\xf5\x88\x33\x0f\xa5\xa9\x92\x7f\xd2\xfa\x45\xce\x35\xc5\x41\x96\x93\xa0\xe8\xc4\x11\x85\x12\xfa\x0b\x43\xe1\x44\x5b\x9e\x64\x45\x8e\xc2\x14\xb5\xf7\x9e\x0f\x2c\x99\x25\x82\x7a\x4a\xc4\xdc\x9e\xdf\xe4\x56\x79\x59\xbd\x04\xcf\xf3\x96\x86\xad\xd3\x06\xfb\x5d\xee\xa4\xdb\x17\x4b\x53\x8c\x44\x80\x62\x1d\x40\x97\x07\x66\x7f\x62\x16\x9d\x14\x49\x5a\x07\x65\xa7\x64\x32\x05\xc7\x9d\x8a\xd7\x99\xf3\x69\xd6\x17\x6a\x45\x36\x38\x5c\x63\x45\x66\x0a\x40\xcd\xa2\x46\xbf\x3a\xb1\x2c\x3c\x18\x84\x18\xe3\xf8\x0f\xfb\xbc\x6e\x62\x03\xf7\x46\xe1\xf7\xee\x3a\xf8\x6e\x35\xbc\xcb\x0d\x6f\x84\x76\xb0\xc2\xb1\x2d\x7a\x33\xae\x26\x00\x34\xed\x81\x69\xf7\x7c\x01\xe5\xdc\xd2\x7e\xef\x4a\x68\xd7\x05\xbb\x7d\xc8\x0c\x97\xa2\xb3\x4c\x61\xce\x36\xa8\x58\x35\xa4\x16\x5c\x17\x99\xd9\x10\x89\xa4\xbe\xc9\x97\x10\x5d\xdc\x3f\xc7\x5d\x7a\x63\xba\xb3\x63\x63\x31\xea\x1a\x60\xb3\x34\x4a\xde\x25\xc1\x48\x2d\xa7\x35\xfa\xca\x58\xce\xc8\xdf\x7a\x28\xe6\xce\xcd\x62\xd8\x77\x4f\x57\x60\x24\x3b\x14\xb0\xc2\x00\x2b\x04\x58\x65\xa5\x98\x12\x51\x78\xfc\xca\x3a\x39\x4f\xd5\x73\x92\xd5\x55\x17\x25\x22\x6a\x1a\x6a\xbe\x5a\xcb\xf3\x96\x96\x1f\x2c\x20\x38\x3d\xfe\xfe\x87\xbd\xe3\x47\x40\x50\x61\xbc\xd3\xdd\xd3\xfb\x82\x08\x5e\xb6\xf6\x1a\xbf\x78\x44\xb0\xc9\x3d\x9f\x1b\xc0\x04\x5f\x72\xb5\xaf\xec\x49\x3d\x96\xfd\xc7\xb2\xdf\xa6\xde\xbb\xb2\xaf\x7e\xe5\xaf\xf4\x41\xf8\xc8\xcd\x4e\x39\x54\xbf\x97\xab\xcd\xe5\xb2\x4a\xa2\xdf\x21\xbb\x41\xee\x93\x0a\x3b\xcc\x09\x62\x4e\xb3\x77\x11\xd9\x65\xce\x2d\xd0\xa4\x9e\xde\xdc\x7d\xae\x8a\x71\x29\x94\x9b\x65\x1f\xb7\x36\xb8\xc0\x41\x0c\xf5\x37\xc5\x12\x9f\xf1\x22\x68\x79\xc4\x66\x48\xa4\x02\xcf\xb2\x44\xe2\x3c\xff\xbe\xa8\xa2\xef\x20\x37\x3a\xe6\x8c\xa2\x36\xf8\xcc\xbd\xda\x59\x46\x63\xf5\xec\xad\x51\x9d\x97\x92\xd0\xa3\x89\x8f\x8f\xa9\xed\x80\xce\xbe\xa1\xe5\x06\x09\x24\x0d\x4b\xf7\xfc\x0d\x53\x23\x72\x50\x5c\x65\x69\x6b\x56\x3d\x45\xfe\x94\x60\x26\x0b\xbf\x1b\x43\x92\xc4\x62\x8a\x13\x6d\x58\x95\x1e\xa4\x0b\x2b\x06\x29\x5d\x06\x96\x6e\x2f\xd2\xe5\x97\x24\xfe\x8d\xd1\xb3\x79\xe8\x59\x26\x53\xbe\xfe\x5a\x4c\xc6\x16\x42\xda\x2d\x34\xfa\x7b\x66\x54\x13\x62\x5d\xe9\x49\x99\x8f\x6b\x14\x95\xd2\x2b\x9e\xf6\x2e\xaf\xbd\x5d\x9d\xac\xd6\xe1\x4e\xfd\xcb\x2d\x70\x82\xce\x3e\x5d\xdd\xcc\xfa\x90\x8a\x97\x69\x55\xa1\x57\xb8\xea\xca\x31\xc5\x9e\xb0\x1c\x49\x14\x9c\x6b\xaa\x5f\xf5\x59\xc3\x04\xf2\x5a\xb6\x5a\xad\x41\xd3\x41\x92\x35\xfd\xdf\x4b\xa0\xd6\x07\x1b\xf0\xfd\x8a\x19\x6c\xbb\x3a\x5d\x43\x04\xd2\x89\x9f\x78\xd2\x29\x56\x06\x47\x85\xf5\x6e\xc3\x02\x30\x32\x61\x51\x18\x48\xbd\xfc\x87\xc0\x80\xc7\x6c\x3c\x24\x56\xaa\x5b\x71\x77\x52\xb9\xbc\x34\x6a\xef\x2e\xb2\xc5\x7d\x6a\x32\x73\xf0\x25\x89\x0a\xba\x08\x9c\xf3\xde\x44\xd0\x96\x93\xdf\x31\x8b\x70\x4f\xc2\xb6\xeb\x26\x41\xca\x8c\xc7\x24\x24\x22\x45\x73\xa9\x9b\x0f\xd3\x83\xcc\x1b\x7f\xd9\x63\x59\x5d\x59\x6a\xbf\x7f\xb9\xb1\x28\xb5\x85\xa6\x01\x8e\xe7\x91\x69\xfc\x8c\x43\x71\x75\x45\x46\x1b\x56\x64\x09\xbd\x74\x9b\x36\x37\x00\xbd\xb2\x13\x7f\x9d\xa5\x20\x3c\x94\x83\xf2\x5f\x73\x65\x68\x3f\x5d\x32\xd7\x04\x45\xb3\x77\xce\x22\x28\x55\xb7\x86\x21\xe1\xf8\x3c\x1d\x60\xe5\x7b\x6c\xb7\x84\x0a\xb3\x7c\x05\x96\x5b\x59\xcb\x24\x4f\xa7\x22\x76\x2f\x03\x7b\xc5\x31\x52\x49\xe2\x73\x0e\xcb\x3a\xf5\xb1\x93\x2d\xf8\x75\x0e\x7b\xe7\xc1\xaf\xf9\x22\x4b\xf1\xdd\x69\x7d\x36\xfb\x8e\x62\xb3\xfb\x8f\xbc\xd6\xac\x71\x0f\xc0\x47\xca\xbc\xa6\xcb\x3c\x8f\x79\xad\x72\x6d\x76\xb5\x7b\x34\x58\x99\xf6\x80\xe5\x21\xed\x98\x94\xef\xce\xdd\x5e\x62\xe8\x8a\x82\x8f\x74\x6f\xbf\x14\x5c\xa5\x86\x8f\x6e\x01\xa9\x77\x4c\x5e\x46\x07\x68\x88\xa8\x71\x82\xff\x2c\x62\x67\x35\xf6\xba\xc9\xd8\xdb\xa0\x8c\x87\xdd\x64\x3c\xdc\xa4\x8c\x07\xdd\x64\x3c\xd8\xa4\x8c\x83\x6e\x32\x0e\x4c\x32\xae\x57\x4a\x8f\x8e\x76\x9c\xa3\x01\xfc\xf5\x76\x9c\x43\xf8\x3b\x80\x3f\xad\x04\x37\xb1\x64\x2c\xee\xea\x5f\xf9\xab\x57\x5c\x6b\xbb\xe7\xe3\xf4\x4c\xcb\x0d\xa3\xeb\xdd\x7e\xf5\xb5\x5a\x57\x44\x59\xbb\xdb\x7a\x04\x94\xf1\x4b\x5c\x3f\x86\xcf\xcb\xf0\x30\x3f\xc0\x6b\xe7\x3d\x77\xd0\x3a\xbb\x2e\x4f\x11\x8a\xa6\xfd\x76\x53\x3f\x68\xb6\x0c\x5a\x2d\xfd\x76\xd3\x7e\xaf\xcd\xd5\x7a\x71\x78\xaf\xd5\xd2\xaf\xfc\x8f\x09\x17\x55\x7d\x48\xb8\x63\xca\xe9\xdd\x24\x6a\x0f\xff\xb2\x3d\x7c\x5b\xa2\xbd\x6f\xdb\x4d\xad\xff\x50\xe0\xa0\xd5\xb2\xdf\xab\xce\xa4\x65\xc4\xbf\x23\xf5\xe9\x48\x0e\x3a\xca\x43\xca\xe6\x66\xa4\xf3\xc2\x49\x8f\x68\xe0\xc7\x71\x8a\xe0\x9c\xb3\x14\x9c\x71\xf7\xc9\xf2\x5f\x30\xf0\x99\xb3\xa9\x43\x00\x00")
 
 func monitoringApicastGrafanaDashboard2JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -124,7 +124,7 @@ func monitoringApicastGrafanaDashboard2JsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _monitoringBackendGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x93\x9b\x46\xba\xfe\x9e\x5f\xd1\x87\x64\x37\xe3\x9c\x19\x5b\xe8\x2e\x57\xb9\x4e\xf9\xba\xf1\xae\x9d\x9d\x75\x9c\x9c\xcd\x71\x5c\x5a\x46\xf4\x68\xd8\x41\x40\x00\x8d\x47\x9e\x9a\xfd\xed\xa7\x68\x10\x34\x97\xd6\xe8\x82\xa4\x06\x9e\x7c\x88\x47\x0d\x42\xcd\xdb\x97\xf7\x79\xde\x5b\xdf\x7d\x43\x88\xa2\x59\x96\xed\x6b\xbe\x61\x5b\x9e\xf2\x94\x04\x4d\x84\x28\xa6\xe1\xf9\xca\x53\xf2\x89\x7d\x22\x51\x2b\xbb\x72\x31\x37\x4c\xff\xad\xa5\x3c\x25\xea\x69\xd2\xaa\x6b\xbe\xe6\xd9\x73\x77\x42\x95\xa7\x44\x39\x3b\x23\x7f\x71\xb5\x4b\xcd\xd2\xc8\xd9\x99\xc2\xdd\x46\x2d\xed\xc2\x0c\x6e\xf1\xdd\x39\xe5\xda\xaf\x0c\xbd\xa0\xd5\x98\xd8\xd6\x4b\xdb\xb4\xdd\xe0\x99\xee\xf4\x42\x3b\x69\x9d\x92\xb6\xaa\x9e\x92\x76\xaf\x77\x4a\xd4\x47\xfc\xa3\x2d\x6d\xc6\x7e\xfb\x79\xf2\x3a\xe4\xcf\xe4\xb9\x49\x5d\xdf\xe3\xef\xf3\x17\x0e\xbb\x4f\xd7\xbc\xab\x0b\x5b\x73\x75\x25\xba\x76\xcf\xfe\xfd\xfc\x0d\x21\xf7\xc1\xed\x0a\xd5\x0d\x3f\xd3\x5b\x65\x6a\x51\xff\xad\xae\x3c\x25\xd6\xdc\x34\xc3\x16\x57\x73\xae\x3e\xda\xb6\xe9\x1b\x8e\xf2\x94\xb4\x58\xa3\x11\xdc\x32\x64\x7f\x9a\x86\x75\x1d\xc8\xf5\xd3\x67\xf6\xd1\xd1\x2c\x6a\x7a\xb1\x64\x97\x72\x55\x26\xb6\x69\x6a\x8e\x47\x83\x2f\x5e\x6a\xa6\x17\x8b\x41\x99\xba\x86\x7e\x6e\x27\x43\x13\xca\x2b\x23\xfe\x2f\xca\x53\xd2\xee\x72\x0d\xb7\xcb\xbe\x44\x9f\x17\xc1\xe7\xe5\x8b\xc6\xcf\x66\xfd\x6c\xc7\xf7\x71\xbd\xfb\x1c\xb7\xf9\x86\xcf\x64\xa0\xbc\x33\x3c\x9f\x5a\xd4\x4d\xa4\x19\xcb\xd2\xb5\xbf\x84\x52\x8c\x1e\x1d\xbf\x96\x66\x1a\x9a\xc7\x86\x90\xbd\x40\xf2\xcb\x17\x1a\x6b\x49\xbf\x6a\x30\x24\xef\xa8\x35\xf5\xd9\xeb\xb5\x52\xed\xb4\xe8\x76\x7e\xce\x7d\xc7\x7d\x8c\x6f\xb9\x34\x4c\x93\x17\x95\x58\x9a\xc3\x8c\x34\xd5\xf6\x03\xd2\x54\x8b\xa5\xd9\x19\xc5\x9f\x4d\x3a\xa5\x96\x9e\xfe\x29\xed\x66\x9a\x7d\x8f\x60\xf4\xe7\xae\x4b\x2d\xbf\xe0\xca\x4c\xbb\x2d\x6a\x35\xac\x82\x56\xef\xca\xfe\x92\x5f\x44\xbe\xed\x6b\x66\xc1\xdd\x37\x9a\x39\x4f\x84\x9a\x7b\x19\xd3\xb0\xd8\x55\xfe\x69\xac\xf1\x8b\xa1\xfb\xa9\xe9\x97\x99\xe2\xac\x29\x58\x1e\xe7\xb6\x61\xf9\xef\x6d\xb6\xb0\x59\x43\x32\x2c\xb6\x13\x6f\x37\xc9\x2f\x3a\xd4\x9d\x50\xcb\xd7\xa6\x34\x37\xd2\x4e\xf0\x28\x57\xd3\x8d\x79\xf0\x9d\x76\xba\x3d\x3f\x31\x5c\x6a\xe9\xd4\xa5\x6c\xdb\xb8\x34\x6d\x3f\xf9\x61\x8f\xba\x06\xf5\xfe\x7e\x43\x5d\xd7\xd0\x69\xa6\xd3\x9e\xa3\x4d\x68\xd1\xfc\xf3\x7c\x6d\x72\x9d\xfb\x15\xcf\xa7\x8e\x43\xf5\x77\x86\x95\xef\xb0\xaf\xb9\x53\xea\x7b\xdc\x0e\xca\xef\xa1\xc1\xe6\x72\xeb\xb0\xee\x79\xf3\xd9\x89\xab\xf9\xf4\x44\x73\x0c\xcf\xb6\x34\xdf\x76\xc7\x66\xb4\xd0\xc6\x2e\xf5\x1c\xdb\xf2\xe8\x78\x62\xeb\xd4\xbb\x0b\x76\x38\xd6\xc7\x67\xbf\x2b\xdf\xc5\x1f\x7e\x57\x4e\x5d\xfa\xc7\x9c\x7a\xfe\x38\x58\x8e\xcf\x7e\x57\xb4\xb9\x7f\x65\xbb\xc6\x57\xfa\xbb\x72\xff\x49\x9d\x7d\x7e\xc4\xef\x93\xc1\xa2\xb0\xdd\x99\x16\x4c\x36\xc5\x37\x66\x74\x1c\xca\x24\x7d\x8b\x61\xf9\xd4\xbd\x61\xf3\x46\x51\x67\xc5\xd7\xde\x68\x13\x9f\x6d\xcd\x6a\x2b\x75\x3d\x9c\xf6\x6f\xe2\x1f\x89\xbb\x93\x7e\x8c\x4b\x2f\xd9\x4e\xaa\x3c\x57\xe2\xe6\xfb\xd3\xe3\x48\xcb\xa5\x8e\x3c\xb2\x72\xa9\x23\x90\xd4\x8b\xe3\x4a\xca\xa5\x8e\xed\xfa\x72\x08\x2a\xec\x8b\x40\x4e\x2f\x8f\x3f\xa3\xd8\x84\x1f\xdb\xc1\x9f\x72\x08\x2c\xd3\x29\x81\xe4\x5e\x1d\x5f\x72\x2e\x75\x64\x93\x5b\xdc\x25\x81\xd4\x5e\x73\x52\x8b\xfe\xe2\xf0\xd3\x95\x4b\xbd\x2b\xdb\xd4\x73\xb8\x6a\x46\xdf\xb8\xf6\x8c\x03\x93\x71\xfb\x07\x3a\x8d\xf4\x63\xe6\x0b\x3f\x5f\x19\x97\x7e\xfe\x1b\x19\x84\x46\x22\xb1\x7a\xc4\xa1\x2e\xf1\xe8\xc4\xb6\x74\x72\x72\xb1\x58\xb6\x93\x40\xdc\x8f\x38\x18\x17\xc3\xd7\x3b\x1e\x4d\x68\x2e\x83\xa3\x19\x3c\xe1\x05\xeb\x8e\x53\xc2\x4b\x28\x31\x5e\x82\x41\xc3\xd2\x8d\x1b\x43\x9f\x6b\xa6\x92\x43\x15\xcb\x7b\x18\x6a\x4e\x3a\x70\xab\xdd\x1a\x19\x4c\x76\x31\x9f\x5c\x87\x1a\x94\x7f\xd7\x00\xfb\x44\x88\x22\x10\x47\x01\xfe\xcf\xdc\x5d\x8c\x89\x62\xec\xf3\xe9\x73\xae\x8b\x0b\xed\x96\xae\x50\xdc\x3a\x9d\x18\x33\x8d\x81\xe4\x96\x60\x5e\xba\xf4\x0f\x27\x33\x23\x4d\xed\x82\x9a\xb9\xde\x05\x17\xec\xe9\x0b\xcd\xa3\x69\x38\x1f\xe3\xbe\xdc\xed\x21\xf0\x4b\xff\x30\xf7\x8a\x0f\x2e\xde\xa4\x93\xde\x55\x6e\xff\x2c\xb7\x93\xb9\xe6\xc2\x7e\xe6\x96\xcb\x22\x3f\x15\x34\xd3\x98\x16\xe1\x5d\xd6\xfe\x8e\xde\xc4\x9d\xfe\x86\x7f\x68\x8d\xa9\x48\xaa\x61\x05\x17\xe9\xaa\xa5\x72\x91\x80\xa4\xbf\x9e\x39\xfe\xa2\x98\xbf\xff\x1f\x75\xed\xfc\x15\x10\x18\x10\x98\x35\x60\x80\xe7\xb0\x7b\x9e\xfd\xae\xb4\x6f\x6f\xe5\xd0\xfc\xed\x7f\xfe\x53\x1a\xc6\x92\x88\xa7\xdb\xea\xc8\x21\x9e\x6e\xab\x23\x0d\x4d\xe1\xc5\xd3\x95\x45\x3c\x5d\x69\xd8\x09\x2f\x9e\x91\x2c\xe2\x19\x49\x43\x41\x12\xf1\xf4\x64\xd9\x7b\x7a\xc2\xbd\xa7\x42\x5c\x23\x94\x3f\x09\x64\x0b\xb2\x01\xb2\x01\xb2\x51\x49\xb2\x91\xf5\x7b\x8c\x04\x5c\xa3\x53\x2a\xd7\x00\x6d\x00\x6d\xa0\xe3\x60\xdb\xf5\xc6\xa1\x52\xf1\xc6\xe1\x2e\xbd\x83\x1b\x84\x5c\x2c\xc8\x89\x49\x85\xaa\xfd\x8a\x6a\xfe\x4c\x73\xf6\xa7\xd6\xef\xee\xfe\x75\x77\x47\x4c\x4a\xee\xef\xff\x75\x7f\xbf\x06\xbb\x38\xa6\x86\x7f\xbe\x94\xdf\xc3\x2a\x3e\x78\x18\x09\x87\x87\x18\x56\x74\x8b\x07\xa5\x0f\xa5\xdf\x24\xa5\x3f\xd1\x5c\x3d\xf3\xe0\xa0\xe9\x5c\xd3\x75\xc3\x9a\xe6\x67\x4e\x70\xf1\x83\x3d\xb7\xf4\xcc\xc3\xe3\x9e\x4e\xa2\xd8\x97\xcc\x03\xe3\x90\x98\x6f\xdf\x3c\x7f\xf5\xba\xfd\x9c\x9f\xa3\xec\x2b\x3f\x4f\xb4\x70\x09\x7b\x7f\xa4\x46\x60\x79\xf5\x8a\xce\xa2\x75\xe4\x53\xd7\xb1\x4d\xcd\xa7\x7f\x77\x35\x6b\x9a\x62\x34\xc1\x56\x6d\x5b\xa1\x76\x6e\x3d\xee\x15\xac\x0f\xdb\xd1\x26\x86\xbf\xc8\xaf\xc1\x00\x91\x24\x9b\x9e\xef\x2d\x57\xda\x26\x08\xa6\x44\xeb\x68\x1e\xb1\x2c\x37\xfa\x94\x1e\x5e\xda\x30\x5f\xc4\xfb\x42\x5a\xb7\x5d\x19\xd3\x2b\xd3\x98\x5e\xf9\x2f\xa3\x71\x4e\x41\x84\x10\x04\xf5\x56\x82\xa0\x68\x7e\x0a\x81\x47\x16\x4d\x14\xc2\x05\x97\xde\x50\xd7\xa3\xbf\x89\xba\x09\x15\xbc\x6f\x15\xbc\x42\xd5\xee\xa2\x51\xa3\x57\x2f\x47\xb3\x16\xe9\xa4\xa0\xf5\x47\xc3\xf3\xed\xa9\xab\xcd\x84\xf3\x70\xa9\x40\xb3\x23\xa1\xdc\x3e\xcf\xed\x9b\xf9\x0d\x37\x79\xce\x6d\x38\x41\x7f\x9a\xcf\x2e\x18\x22\x4d\x89\x24\xba\xf8\xb3\xf1\x35\xab\x51\x95\x45\xfe\x67\x8a\x35\x22\xaf\x6a\xf8\x7d\xab\x50\x97\x14\x6a\x92\xbc\xb2\x13\x49\xce\x31\x0d\x3f\x9e\x61\x85\x7b\xf5\x22\x7c\xa3\x17\xd1\x7e\x1e\x4c\x7d\x5b\xc9\x5e\x2d\x16\xc6\x22\x27\x8c\x42\xf5\x52\x63\x4e\xa9\x0e\x72\xf2\x0c\xf7\xd3\x01\x48\x25\x48\xa5\x6c\x1a\x8d\x8f\x16\xab\x83\x3e\x3b\x2c\xa5\x74\xa9\x03\x42\x19\xb6\x83\x50\x82\x50\xf2\x0f\x3d\x3e\xa1\xec\x0d\x46\xdd\x37\x6d\x10\xca\xb8\xa1\x38\xdc\x26\x0f\x57\xf6\xc5\x28\x7b\xab\xd3\x09\x1a\xc9\x28\xeb\xa6\x7f\x77\xe2\x93\x22\x75\x0a\x36\x49\xc0\x26\x9b\xc9\x26\xdb\xbd\x9c\x3c\xc3\xbd\xb4\x05\x36\x09\x36\x29\x93\x36\xcb\x64\xd4\xd4\x41\x99\x1d\x8e\x4c\x7e\x60\xc2\x03\x97\x0c\xdb\xc1\x25\xc1\x25\xf9\x87\x1e\x9f\x4b\xbe\x69\x77\x47\xbd\x97\xe0\x92\x71\x43\x21\x97\x2c\x00\x2b\x7b\xe3\x92\x43\x70\xc9\x9a\x6b\xdf\xad\xa9\xe4\x0a\x65\x0a\x26\x49\xc0\x24\x9b\xc9\x24\x3b\x9d\x9c\x3c\xd9\x4e\xda\x07\x93\x04\x93\x94\x4a\x97\x89\x6a\x0e\xd4\x41\xa9\x1d\x23\xe4\xf5\x84\x89\xf1\x11\xd8\x65\xd8\x0e\x76\x09\x76\xc9\x3f\x54\x02\x76\xf9\x66\x34\xec\xb4\xc0\x2e\xe3\x86\x42\x76\x59\x00\x60\xf6\xc5\x2e\xfb\xab\x8b\x0d\x34\x8e\x5d\xd6\x59\x23\x97\x10\x01\xbb\x4a\xc1\x82\x71\x12\x30\xce\x66\x32\xce\xae\xa0\x94\x4b\xbf\x0d\xc6\x09\xc6\x29\x9b\x7e\xcb\xd7\x6a\xab\x83\x76\x3b\x7c\x3c\x2c\xd8\x26\xd8\x26\xd8\xa6\xc4\x6c\xf3\xc5\x70\x30\x78\x35\x02\xdb\x8c\x1b\x0a\xd9\x66\x01\x78\xd9\x1b\xdb\x5c\x5d\x6e\xa2\x91\x6c\xb3\x9e\xda\x78\xe7\xe8\x58\x30\xcd\xd4\x45\x30\xcd\x0d\x98\xe6\x21\x8e\x65\xe8\x8a\xc2\x55\x7b\x49\x36\xf9\x7a\x07\x33\x90\xb7\xc1\xf2\xb4\x34\x93\x3c\x3f\x7f\xab\xe4\x66\x57\x13\x4f\x69\xe8\x0a\xca\x15\xa9\x2a\xce\x69\x00\xa1\x2e\x49\x85\x1b\xd1\xb2\x1b\x6b\x8e\xb1\x75\xe9\x73\x76\x50\x8d\x1c\xd5\x07\xb5\xec\xa1\x39\xfb\x28\x7e\x5a\x8a\xd0\x1c\xc7\x34\x26\xec\xb0\x9f\xf1\x35\x5d\xc8\x22\xbe\x4c\xaf\x04\x82\x2c\xab\x4c\x6a\xd9\x82\x74\xe9\x25\x75\x5d\xea\x8e\x2f\x0d\xd3\xa7\xae\x84\x42\xcd\xf6\x50\x20\xe0\xb2\x0a\xad\x96\x2c\x60\xf9\x04\x2a\x12\x60\x59\xa5\x58\xcb\x10\x20\x75\x5d\x5b\x96\xb9\x18\xf6\x45\x20\xb4\xd7\x32\x09\xed\x86\x5a\xb2\x28\x95\xb0\x2f\x02\xa1\xbd\x91\x48\x68\x33\xea\xbb\xc6\x44\x12\xa9\x45\x9d\x11\x88\xed\x2f\x12\x89\xcd\xa3\xee\x8d\x31\xa1\x63\xdf\xbe\xa6\xb2\xec\x71\xe9\x3e\x09\x84\xf8\xa3\x7c\x42\x94\x4b\x7c\x22\xc1\xbd\x95\x49\x70\xbe\x26\xcb\x46\xc7\xba\x22\x10\xd9\x5f\x25\x12\xd9\xdc\xd3\xa6\x74\x6c\x1a\x33\x43\x16\xc9\xf1\x3d\x12\x08\xf0\x6f\x32\x09\xd0\x37\x4c\xe3\x2b\x43\x50\x92\xc8\x2f\xe9\x90\x40\x7c\xef\x24\x71\x7c\xc6\xd5\xe3\x79\x8b\x15\x8e\xad\xe2\xde\x02\xbe\xce\xc2\x4e\x36\xce\xd7\x59\x23\xdb\x6c\xde\x5f\x28\x32\xce\xb6\xcb\xcd\xaf\xc1\xc1\x55\xf1\x65\x58\x74\xf7\x09\x0f\x1a\x79\x8a\xd5\xce\xb2\x6a\xd0\x91\x56\x25\xc8\xaa\x31\xe7\x5b\x95\x20\xab\xc6\x1c\x76\xb5\xb3\xac\x70\xf2\x15\x77\xa1\x5c\xee\x82\x63\xb0\x08\xc8\x0b\xc8\x4b\xc5\xc9\x4b\x36\xb0\xa4\x27\x28\x59\xae\x0e\x56\x9f\x01\x81\xc0\x12\xd0\x90\x1d\xd5\xfa\x6e\x81\xa2\xe9\x38\x93\x3a\x44\x88\x1e\x30\x5f\x83\x09\x0f\x69\x1a\x61\x3b\xb4\x3f\xb4\x3f\xff\xd0\xe3\xa7\x69\xe0\x3c\xac\x75\xcc\xae\x05\xd0\x65\x5f\x69\x1a\xea\xa0\xbf\x12\x0d\x55\x28\x4f\x03\xba\x78\xbf\xd9\x1a\x62\xd5\x8a\x24\x0d\x76\x09\x49\x1a\xcd\x2b\x07\xd0\x17\xe4\x86\xa8\x03\x9c\x8c\x05\x96\x29\xb1\x66\x13\x05\xe6\xd7\x41\xc7\x1d\x90\x6f\x26\x62\x24\x7f\xa3\x0b\x30\xcf\xa8\x1d\xcc\x13\xcc\x93\x7f\x28\x98\x67\x35\x98\x67\x01\x9c\xd9\x1f\xf3\xac\x4d\xb5\x73\xe8\xe7\x58\xdc\x7b\xe6\xa0\xeb\xa8\x5b\xb0\x51\x02\x36\xda\x4c\x36\x3a\x10\x94\x43\x57\x07\x48\xa6\x07\x1b\xad\x86\xb6\x13\x66\x37\xd7\x41\xf3\x1d\xf2\xf0\xad\x50\x8c\xe4\x4d\x28\x46\x30\xd3\xb0\x1d\xcc\x14\xcc\x94\x7f\x28\x98\x69\x35\x98\x69\x01\xb4\xd9\x1b\x33\x1d\xae\xce\x6e\x01\x33\x6d\x84\xae\xde\xe1\xa8\xae\x35\x54\x2f\x58\x2a\x01\x4b\x6d\x26\x4b\x1d\x0a\x4a\xa8\xab\xc3\xd5\x27\x54\x80\xa5\x82\xa5\x4a\xa2\xf9\xea\xa5\xe9\x8e\xe2\x2f\x05\x23\x8d\xda\xc1\x48\xc1\x48\xf9\x87\x82\x91\x56\x83\x91\x16\xc0\x98\xfd\x31\xd2\xd5\xa7\xcb\x80\x91\xd6\x52\x2f\x97\xe1\x27\x05\xfb\x04\xfb\x04\xfb\x5c\xbe\x7d\xf0\x05\x51\x4d\x9b\xe1\xea\x13\x2b\xc0\x3e\xc1\x3e\x8f\xa9\xe5\x32\xf5\x75\xeb\xa0\xdf\x0e\xc7\x3b\xdf\x5a\x3e\x9d\xba\x61\xe0\xd0\x6b\x26\xc8\xf5\x6a\x42\x80\x81\x82\x81\xe6\x3a\x09\x06\x0a\x06\x2a\x07\x03\xcd\x43\x99\xfd\x31\xd0\x2e\x18\x68\x93\x74\xf3\xd6\xdc\x73\x03\x55\x0b\x16\x4a\xc0\x42\x9b\xc9\x42\x47\xa2\xea\x44\x43\x54\x27\x02\x0b\x95\x58\xd3\xa5\x0f\x2c\xa9\x83\xa6\x3b\x1c\x0b\x7d\xcd\x84\x07\xd6\x19\xb6\x83\x75\x82\x75\xf2\x0f\x05\xeb\xac\x06\xeb\x2c\x80\x2e\xfb\x63\x9d\xa8\x4e\xd4\x28\x5d\xbc\x35\xeb\x5c\xa1\x5a\xc1\x32\x09\x58\x66\x33\x59\xa6\xda\x12\x95\x27\x1a\xa2\x3c\x11\x68\xa6\xbc\xaa\x2d\x7b\xc4\x63\x1d\x74\xdb\xe1\x78\xe6\xfb\x50\x7a\x20\x9a\x61\x3b\x88\x26\x88\x26\xff\x50\x10\xcd\x6a\x10\xcd\x22\xf4\xb2\x3f\xa6\x89\x6a\x44\xcd\x52\xc7\x5b\x53\xcd\x55\xda\x15\x5c\x93\x80\x6b\x36\x94\x6b\xaa\xa2\xe2\x43\x43\x14\x1f\x02\xd7\x94\x57\xb9\x09\xce\xc5\xaf\x83\x8e\x3b\x1c\xe5\xfc\x39\x14\x22\xf9\xc8\x84\x08\xe6\x19\xb6\x83\x79\x82\x79\xf2\x0f\x05\xf3\xac\x08\xf3\x2c\xc0\x32\x7b\x63\x9e\x23\x54\x1b\x6a\xa4\x72\xde\x9a\x80\xae\xa1\x6b\xc1\x43\x09\x78\x68\x43\x79\x68\x5b\x54\x5e\x68\x84\xf2\x42\xe0\xa1\xd2\xab\xba\x7a\x29\xb9\x83\x33\x50\x70\xcf\xa8\x1d\xdc\x13\xdc\x93\x7f\x28\xb8\x67\x45\xb8\x67\x01\x7e\xd9\x1f\xf7\x44\x5d\xa1\x86\x29\xe4\x5d\x59\x27\xf8\x26\xf8\x26\xf8\xe6\xf2\xed\xd9\x0d\xa2\x82\x42\x23\x14\x14\x02\xdf\x94\x58\xbd\xf9\x5a\xcd\xb2\x47\x0e\x48\x36\x03\xd9\x81\x69\x86\xed\x60\x9a\x60\x9a\xfc\x43\xc1\x34\xab\xc2\x34\x0f\x58\x3f\x68\x84\xfa\x41\x4d\x52\xc5\xdb\xd3\x4c\xa1\x66\x05\xc7\x24\xe0\x98\x0d\xe5\x98\x1d\x51\xb9\xa0\x11\xca\x05\x81\x63\xca\xab\xd8\xe6\x9e\x36\xa5\x63\xd3\x98\x19\x35\xd3\x6f\x87\xa3\x9a\xbf\x04\x22\x24\xef\x98\x08\xc1\x38\xc3\x76\x30\x4e\x30\x4e\xfe\xa1\x60\x9c\x15\x61\x9c\x05\x38\x66\x7f\x8c\x13\xb5\x83\x1a\xa8\x98\xb7\x26\x9e\x0f\xea\x59\xf0\x4f\x02\xfe\xd9\x50\xfe\xd9\x15\xd5\x11\x1a\xa1\x8e\x10\xf8\xa7\xc4\x6a\xce\x37\x4c\xe3\x2b\x2b\x46\x5e\x2b\x2d\x77\x40\xfa\x99\x48\x10\xec\x33\x6c\x07\xfb\x04\xfb\xe4\x1f\x0a\xf6\x59\x11\xf6\x59\x80\x62\xf6\xc7\x3e\x51\x4f\xa8\x79\x6a\x79\x7b\xf2\xf9\x80\x96\x05\xf7\x24\xe0\x9e\x2b\xb8\xe7\xc4\x36\x4d\xcd\xf1\x18\x8a\x4a\xef\x01\xc2\xfd\x53\xcd\xec\x9f\xed\xee\x43\x24\xb0\x27\x28\xf0\xd3\xe3\x18\x8a\x66\x51\x33\x87\x31\xa3\x49\xfe\xbf\xb6\x7b\x4d\x5d\x6e\xfb\x5f\x4e\x27\xd7\xfe\xa2\x14\xbe\x56\x75\x29\xf5\x1a\xd2\xec\x16\x4b\xb3\x8b\x6a\x49\x60\xd4\xbb\xa9\xee\x2f\x6c\xa1\x8d\xff\x6d\x5f\x8c\x27\xf6\xdc\x12\xeb\xe5\x7b\xc2\xe9\xde\x60\x35\x0a\xb5\x6f\xa0\x6b\xc6\xe1\xcb\xef\x5b\x03\x07\xfd\xa8\x04\x35\xfe\xab\x7d\x91\xa3\xc2\xff\xb6\x2f\x48\x5a\x90\xe0\xbc\xe5\x72\x5e\x1b\x8c\x57\x3a\xc6\x5b\x5d\x3d\xfd\xb0\xe9\xbb\xdf\x16\xa0\x9e\xd5\x01\xad\xd0\xd3\xd0\xd3\x1b\xe8\x69\x77\x6e\x45\x2a\x76\x3d\x42\x1d\x11\xe9\x0f\xd4\xb1\x5d\xff\xaf\xf6\x45\xad\x68\xf4\xe1\x54\x78\x28\xbf\x40\x6b\xe7\x8d\xda\xe1\x88\xc0\x9c\x0d\xd5\xde\x64\xd5\x7e\x78\x63\x76\x6f\x30\xea\xbe\x69\xc3\x98\x1d\x37\x14\x1b\xb3\x0b\x70\xc9\xbe\x8c\xd9\xbd\xd5\x41\xe6\xf2\xdb\xb2\xa1\x68\x4b\xb6\x57\x0b\xf4\x66\xca\x4c\xbd\xbd\xfe\x84\x81\xba\x76\x06\xea\x3a\x33\xc4\x41\x2b\x27\xd0\x70\xdb\x5c\x1d\x80\x0a\x86\x08\x86\xb8\x7f\xc5\xf5\x93\xed\x1b\x97\x8b\xba\x29\xae\xc3\x31\xc4\x50\x7e\x60\x88\x60\x88\xe9\x97\x01\x43\x5c\x3e\xf8\xf0\x0c\xf1\xa2\x7b\x79\xd9\x6a\x81\x21\xc6\x0d\xc5\x0c\xb1\x00\x97\xec\x8d\x21\xae\x0e\x03\x6f\x02\x43\xac\xa7\xa2\xdd\x9a\x21\x0a\xf4\x26\x18\x22\x18\xe2\x11\x43\x98\x06\xc3\xdc\x9b\x85\xe1\x9a\x49\xa9\xbe\xa2\x10\x26\x97\x3a\x34\x1c\x0a\x9d\x3a\xa6\xbd\x98\x51\xcb\x7f\x69\x5b\x97\xc6\x94\xe3\x13\x13\xdb\xa1\xfa\xaf\x21\x9d\x4d\x8d\x6e\xe6\x1b\x4f\xd3\xbb\x92\x47\x4d\x3a\xf1\xf3\xaf\xcd\x2e\xfa\xf4\x96\xfd\xec\x85\x36\xb9\xa6\x96\x7e\x36\x71\x6d\x2b\xbd\x8e\x19\xde\xca\xdd\x92\x5b\xd2\xf7\xf9\x15\x7a\x6e\xeb\x1e\x39\xf9\x2e\xdb\xbf\x47\x1b\xc4\x67\x4d\xb4\xc9\x15\xfd\x68\xcc\xa8\x3d\xcf\xed\x04\x4c\xe9\xbe\xd0\x26\xd7\x53\x37\x9a\x34\x29\xb5\xc1\x2e\xff\x1a\x75\x3e\x3d\xde\x93\xa5\x99\x20\xd9\xc7\x95\x6f\xdf\xb4\xbb\xa3\xde\x4b\x7e\x21\xb8\xd3\x0b\xed\xa4\xdd\x19\x9c\x12\xb5\x3d\x3a\x25\xdd\xd6\x29\x69\x3d\x1e\x8e\xf8\xed\x56\xf9\xb6\x3d\x1a\x4d\xba\x7d\x25\xb7\xb1\xad\xa1\x8a\x8b\x96\x25\xb7\x28\x2d\xdb\xe2\xf5\xb6\x36\x67\x64\xf5\x2e\xb5\x24\x97\xef\xa7\xb6\x5a\xe9\x65\xb9\xbc\x50\xb0\x36\xb3\x60\x2c\xe6\x3a\xef\x02\x14\x99\x53\x7b\xfc\x1d\xef\xb5\x30\xc0\x4e\xb0\x65\x09\xd7\x51\x27\xb3\x8e\xfa\x0f\x2e\xa3\x82\xc2\x51\x86\xce\x66\xc2\x92\x50\x17\xc2\x84\x4e\x22\x48\x5e\xcf\x29\xab\xb0\xc0\x4c\x73\x1c\xc3\x9a\x7e\x0c\xe7\xa2\x5a\xd4\xbe\x42\xe7\x47\xd4\x25\x5c\x26\xc4\xb7\x09\x5b\x51\x85\x2b\x48\x4d\x16\x8d\x00\xe4\x2f\x1f\xc6\x40\xe3\xea\x87\xb5\x57\x28\xd5\x99\x76\xfb\x4a\xf3\xb5\xf3\xa5\xc5\x82\x9b\x1d\x79\x6b\xc9\xc4\xb6\xac\x70\x7f\x48\xdd\xf3\x31\xdc\x18\x52\x2b\xae\xd8\x94\x62\xce\xa7\x86\xf5\x2b\x75\x3d\xc3\x0e\xb4\x81\xd2\x7f\xdc\x7e\xdc\x4d\x1e\xe6\xd8\x9e\x7f\x69\xdc\xa6\x87\x21\x6a\x7c\x63\x5b\xcb\x6d\x5b\xe9\xb5\xfe\xc4\x5d\x77\x69\xfe\x3b\xac\x4d\xf8\x15\x26\xb3\xf7\x9a\xb3\x62\xac\x2e\x43\xa0\x91\x36\x10\x11\x7e\x1b\xfc\xe9\xc9\xf3\xcc\x05\x3b\xfe\xc2\x0a\x81\x57\x65\x6b\xf6\x1c\xcd\xbd\x36\x43\x23\x12\xd7\xcd\x4b\xc3\x34\x63\xf2\xc3\x76\xbd\x8e\x7a\x4a\x54\x75\x78\xca\xce\xd6\x24\xad\xc7\xea\x30\xb5\xeb\x5d\xce\xcd\x22\x33\x5f\xf0\x64\xfe\x39\xe1\x63\xda\xad\x53\xa2\x8e\x3a\xa9\x07\xac\xc4\xeb\xbe\x76\x61\x06\xcf\x99\xcf\xac\xf4\x0c\xd8\x08\x80\x5f\xcf\x2f\xe8\xd8\xa5\x8e\x69\x4c\x58\xd8\xfb\xc4\xb6\x7c\xd7\x36\x4d\xea\x8e\x3d\x5f\xf3\xe7\xde\xd8\xa5\x9a\xbe\x58\xde\xe2\x71\xf8\xfb\xfb\x04\x7e\x7f\x7f\x5a\xf8\x88\x67\xff\xf9\x3e\xa7\xd7\x1e\xff\xf0\xfd\xfd\xf6\xe1\x8d\x09\xe4\x5e\x89\xb8\x77\x36\x66\x29\xea\x69\x5b\x29\x82\xdf\x4a\xa7\xe5\x29\x85\xf8\x3b\x7b\x65\xe9\xa2\x99\x5b\x96\x61\x4d\x89\x63\xeb\x05\x21\xd7\x9e\x61\x4d\x4d\x1a\x48\x3a\xb9\xc6\xe6\x2b\xbf\x80\x87\xfc\x02\x66\x57\x57\x2f\x60\x3b\xc0\xe9\xca\xb3\xe2\xb5\xdb\x12\x2c\x8e\x87\x16\x2f\xbb\xf1\xa7\x68\xe7\xd5\x6e\xa6\xfb\x41\x22\xe7\xcb\x1d\xad\x00\x8a\x6c\x80\x52\x22\xb8\xb1\x29\x4a\x89\xc0\x0d\x50\xca\x2e\x28\xa5\x5f\x1a\x4a\x69\x17\xa1\x94\xd4\x94\x02\x4e\x29\x1d\xa7\x00\x87\x00\x87\x48\x86\x43\x1c\x3a\x29\x19\x7f\x90\x33\x52\x1f\xf0\x73\x54\x78\xf3\x8b\xa5\xdd\x68\x86\x19\xcc\x01\x40\x1c\x18\x5b\xea\x03\x63\x0a\xfc\x38\xdb\xe2\x98\x01\xac\x2d\xb0\xb6\x00\xe5\xa4\xfa\xd9\x3c\x94\xc3\x52\x40\x4f\x96\xff\xb7\x7c\xcd\xb0\xa8\x3b\x9e\xd1\x99\xed\x2e\xc6\x61\x1d\xc0\x8b\x85\x4f\x85\x20\xc3\xb1\xf5\x22\x48\x71\xf6\x49\x3b\xfb\xda\x3a\x1b\x7d\xfe\xef\xe4\xaf\x00\xe1\x5c\x2c\xc8\x89\x65\xeb\xf4\x51\x93\x8c\x2d\xcc\x97\xa2\x1b\x9e\xef\x1a\x17\x73\x9f\xea\xc4\xb6\xc8\x95\xed\xf9\x80\x25\x65\xc2\x92\x2d\xad\x2b\x7a\xb7\xab\x75\x34\xc0\x92\xdd\x60\xc9\xb0\x34\x58\xd2\x87\x79\xe5\xf0\xc0\x04\xc0\x03\xc0\xe3\xc0\xc0\x63\xa6\xdd\x9e\x78\xf3\xd9\x89\x4e\x4d\x5f\x0b\x6d\x1e\x8e\xad\x8f\x13\x0c\x12\xdb\x3a\x3c\x5f\x73\x7d\x6f\xcc\xe2\xd3\x4b\x81\x21\x9f\x7a\x71\x00\x96\x63\xeb\x8d\x82\x22\xef\xb5\x5b\x66\x10\x21\x4b\xb1\x92\x13\x53\xf3\x7c\xd2\x23\x33\xc3\x9a\xfb\xd4\x2b\x08\xf0\xa8\x1b\x26\xa9\x4e\xb6\xc9\x20\xa3\x76\x1f\x0e\x61\x1a\x0a\xea\x11\xa8\x5c\x16\x46\x09\xd9\x26\x81\x2a\x7f\x3d\x73\xfc\x45\x3e\x5c\x6c\x19\x2f\x9a\xbf\x52\xa7\x14\x15\xa2\x79\xe4\x6b\xf0\x96\x0f\x28\xd6\x75\x53\x55\x7a\xe9\xf6\x8d\x52\x55\xaa\xa2\xd8\x64\x4c\xa9\x39\xac\x81\x7f\x77\x45\xd3\x5e\xa9\x68\xd8\xfa\x38\x4b\x19\xbc\xb9\xfb\xde\x19\xd6\x75\x51\x86\x00\xa7\x90\x52\xed\x81\x90\xd9\x60\x3c\x88\x79\xeb\x1f\xba\xc1\xdc\x09\x05\x92\x8d\x65\xf7\x42\x29\x4d\x4a\x70\x2c\x6d\x34\x34\x73\xeb\xc1\xc1\x79\xb9\xfe\xe0\xd4\xd6\x1e\x36\xf7\xa8\x7e\x96\xb6\x3a\xa5\x85\xf4\x4a\x92\x9c\xba\x73\x5b\x27\x4c\xfe\xe4\x84\xed\x67\xa7\x84\x8d\xef\x29\x99\x5b\xc1\xbf\x8f\x88\x66\xe9\x21\x86\x65\x6f\x93\x18\xd6\x0c\x4e\x45\x21\xb5\xae\xdc\xd4\xba\xbd\xe7\xad\x55\x3a\xb9\x8e\x87\x9d\x28\xad\x56\x48\x65\xfa\x9b\x53\x99\xbc\x05\x91\x51\x99\x72\x2b\xa0\x36\x9e\xc9\x94\xc5\x60\x76\x48\xb6\x0f\x13\x68\xa8\xfe\x62\xf1\x21\x6f\xe0\x06\xbf\xd9\x85\xdf\x1c\xdf\xba\xb6\x6f\x5c\x13\xa6\x31\x3a\xb6\x5e\x89\x82\x01\xe7\xeb\xda\xde\xb6\x80\x2f\xad\x86\xc2\x17\xa0\x94\x74\x27\xa5\x2b\x01\x70\x88\xdc\xcd\x91\xe8\x0c\xb2\x76\xa2\x12\x57\x26\x6f\xa6\xd6\x6b\xd8\xfa\xd6\xa7\x2e\x63\xb9\xac\xa2\x5a\xab\x35\x18\xa9\xea\xa0\xc3\x7b\xe2\xc2\xfb\xce\x83\xe7\xbe\xcd\x64\x8a\x1e\x44\x6d\x2d\x8f\xe1\x78\x40\x75\xc5\xb7\xad\x50\x5f\xc8\xfc\x5c\x13\xf3\xc2\xeb\x2f\x5e\x84\xfd\xdc\xc3\xd7\x72\xfa\xab\xed\xc2\xa4\x0a\x04\x23\x22\x18\x91\xec\x33\x26\x60\xdb\x6d\xbe\x93\xbd\x94\x70\x97\xd4\xe4\xae\x9a\x0e\x68\x4a\xec\x41\x7d\x8d\xe1\x12\x85\x1a\x20\xc5\x74\x13\xc8\x83\x14\xd3\xca\xc3\xa1\x5c\x8a\xe9\xf6\x70\xa8\x53\x04\x87\x10\x04\x89\x20\xc8\x63\x01\x9e\x36\x00\x4f\x9d\x01\x0f\x42\x0e\x90\xcb\x5a\x1f\x2c\x05\xf3\x51\x25\xf0\x52\x3e\x97\x75\x7b\xc0\xd4\x2d\x02\x4c\xb0\x1f\xc1\x7e\x24\x27\x9c\x1a\x00\x4e\x55\x19\x4e\xd5\x36\x48\x50\x22\xf3\x11\x92\x66\x91\x34\x5b\x73\xfc\x93\x4b\x9a\xdd\x1e\xff\xf4\x60\x30\x3a\x3c\x02\x02\xc2\x11\x23\x9c\x3e\x10\x4e\x95\x11\x0e\xb2\x73\x91\x9d\x8b\xec\x5c\xb2\x52\xbf\x6f\x9e\x9d\x3b\x12\x84\xb4\xab\xed\x72\x0f\x83\x6b\x7c\x50\xbb\x44\xe9\xb9\x5b\x87\x12\xaa\x35\xd5\xa0\x32\x86\xc9\x23\x0d\x18\x69\xc0\x48\x03\xae\xf4\xd0\x20\x0d\x18\x69\xc0\x48\x03\x46\x1a\x30\xd2\x80\x65\xe6\x4c\x1b\xa7\x01\xb7\x5b\x79\x9b\x68\xc4\x99\x56\x9f\x2a\x09\xce\x94\xb4\x56\x2b\x11\x78\x73\xae\x34\x02\x55\x42\x46\x31\x32\x8a\x91\x51\x5c\xbd\x8c\xe2\xf5\x92\x75\xe5\xc5\x3c\x75\x4e\x2a\x6e\xab\xa2\x6a\x8a\xed\x61\xa2\xe4\x6a\x97\x54\xec\x52\xdd\xf0\x1e\x50\x5f\xe1\x3d\x2b\x74\x17\xd2\x89\xd7\xc4\xce\x88\x87\x10\x2f\xbf\x4e\xee\xe1\x6b\x86\x43\x24\x80\x10\xe1\xa0\x08\x07\x95\x3e\x58\x42\xa6\x74\xe2\x52\x76\xff\xa6\x84\x49\xd4\xd7\x9c\x2e\x51\x54\x04\x12\x89\x37\x01\x3b\x48\x24\xae\x3c\x10\xca\x26\x12\x6f\x0f\x84\x8a\x8f\xd4\x47\x5c\x28\xe2\x42\x91\x48\x0c\xa8\x53\x2a\xd4\x41\xb8\x02\x52\x88\xeb\x83\xa2\x60\x32\xaa\x04\x52\xca\xa5\x10\xef\x00\x95\xd4\x22\xa8\x04\x9b\x11\x6c\x46\x72\x02\x29\x99\x52\x88\x01\xa4\x90\x3c\x2c\x95\xc9\x08\xc9\xc3\x48\x1e\xae\x39\xf2\xc9\x26\x0f\xef\x80\x7c\x0a\x8b\xef\xc2\x48\x04\x23\x11\x92\x87\x81\x6d\x36\xc2\x36\x48\x1b\x46\xda\x30\xd2\x86\xc9\x4a\xcd\xbe\x71\xda\x70\x5b\x15\x85\xc0\x77\x92\xc8\x30\x84\xc0\xc7\x8d\x48\x1b\xe6\x2f\x55\x5f\x77\xca\x18\x05\x8f\x84\x61\x24\x0c\x23\x61\xb8\xd2\x43\x83\x84\x61\x24\x0c\x23\x61\x18\x09\xc3\x48\x18\x96\x99\x2d\x6d\x9e\x30\xdc\xce\xdb\x41\x23\xb6\xd4\x05\x5b\x42\xc2\xb0\x74\x09\xc3\xf5\x25\x49\xc7\xb7\x02\xee\x1b\x1c\x21\x55\x18\xa9\xc2\x47\x47\x11\x55\x41\x3b\xb5\x4e\x15\x6e\x8b\x4a\x3b\x76\x38\x23\x60\xed\x52\x85\xbf\xd8\xee\xf5\x83\xa5\x2e\xa2\x9b\x56\x68\x2f\x24\x0b\xaf\x89\x9b\x11\xff\x20\x5c\x80\x9d\x56\xee\xe1\x6b\x86\x3f\x24\xde\x66\x04\x7e\x22\xf0\x53\xfa\xe0\x08\x99\x92\x85\x4b\xda\xff\x9b\x12\x1e\x51\x5f\x63\xba\x44\xd1\x10\x48\x17\xde\x04\xee\x20\x5d\xb8\xf2\x50\x28\x97\x2e\xbc\x3d\x14\x1a\x14\x41\x21\x44\x82\x22\x12\x14\xe9\xc2\x00\x3b\xcb\x0b\xd2\x86\xc9\xd4\x2a\x5c\x01\x09\xc3\x99\x65\x28\x37\x8e\x82\xd9\xa8\x12\x58\x29\x9f\x30\xbc\x3d\x58\x1a\x16\x81\x25\xd8\x8d\x60\x37\x92\x13\x4a\xc9\x94\x30\x0c\x28\xb5\x11\x94\xaa\x6d\x70\xa1\x44\x66\x23\xa4\x0c\x23\x65\xb8\xe6\xd8\x27\x97\x32\xbc\x3d\xf6\x29\x2c\xb0\x0b\x43\x11\x0c\x45\x48\x19\x06\xba\x59\x5e\x40\xd2\xb0\xc4\x78\x07\x49\xc3\x55\x0a\x83\xdf\x3c\x69\xb8\x23\x0a\x83\xef\x26\x3d\x47\x18\x7c\xdc\x88\xa4\x61\xfe\x52\x1d\xb4\xa7\x8c\x11\xf1\x48\x1b\x46\xda\x30\xd2\x86\x2b\x3d\x34\x48\x1b\x46\xda\x30\xd2\x86\x91\x36\x8c\xb4\x61\x99\xf9\xd2\xe6\x69\xc3\xdd\xbc\x2d\x34\xe2\x4b\x2a\xf8\x12\xd2\x86\xa5\x4b\x1b\xae\x33\x4d\x3a\xbe\x25\x70\xdf\xf0\x88\x25\x0e\x3b\xb6\x8e\xb4\xe1\x66\x62\x20\x09\x50\x44\x55\xd0\x4e\xad\xd3\x86\xbb\x82\xd2\x8e\x49\xa9\x92\x95\x49\xc3\x79\x95\x72\x58\x25\x34\x71\x39\x12\x44\x0a\x55\x10\xbb\x65\x85\x02\x8a\xb7\x99\x97\xe7\xbf\x90\x5f\x02\xc6\xbb\x63\x02\x70\x75\x40\xea\xe6\x46\xfd\xee\xa0\x78\xba\xf4\x93\xf9\x22\x88\x45\x2c\x01\xb9\xd6\x09\x84\x4a\x64\xb4\xaf\xca\x42\x95\x12\x29\x5a\xb6\x4e\xc7\x31\xe6\x4b\xa3\xc5\xa7\x09\x6e\x9c\x38\xf3\xc8\x9a\xe6\xd1\x89\x6d\xe9\x11\x72\x7c\x7a\x77\x47\x1e\xff\x3c\x9f\x7d\xd0\x7c\x4a\xee\xef\x39\x20\xf9\x9f\x5d\xad\x6c\xe5\x80\xc8\xd5\xf6\xf7\x15\x20\xb2\x34\x13\xfc\x31\x81\x67\xac\x11\x60\x67\x93\x1c\x63\xc2\x9c\xc6\xb5\xcb\x85\x30\x7b\xdd\x62\xc8\xa0\x76\xdb\xeb\x61\xcc\x52\x0a\xd3\x74\x0f\xab\xee\x4c\xc3\xf3\xa9\xf5\xa0\x79\x24\xbe\x0d\xf8\xb4\x1c\x7c\xda\xeb\x89\x26\x5b\x07\x00\x95\x54\x14\xa0\x6e\xbb\xe6\x39\x52\x72\x7c\x73\x69\x89\x3b\x02\x80\xb0\x94\x40\x58\x54\x86\x11\x50\x18\x50\x18\x50\x38\xdd\xc9\x66\x42\xe1\x7e\x5b\x84\x4e\xd6\x34\xb7\x56\x11\x0a\x97\x52\x5e\x18\x20\x78\x93\x69\x26\x8c\xbc\xee\x01\x04\x13\x80\x60\x94\x1a\x07\xfc\x05\xfc\x05\xfc\x05\xfc\x05\xfc\x8d\xee\x3e\x08\xfc\x1d\x08\x23\x1c\xfb\xf5\x85\xbf\x65\x57\x28\x07\x00\x5e\x63\xa2\xa9\xa2\x89\x56\x58\x5f\x12\x00\x18\x00\xb8\x5a\xbb\x01\x20\x30\x20\x30\x01\x04\x06\x04\x06\x04\xae\x18\x04\x1e\x16\x23\x93\x35\xcf\xe8\xa9\x5b\xb8\xed\x3f\xe6\xb6\xaf\x1d\x14\xc8\x4e\x58\xa1\x98\x8c\x7c\x0f\x80\x6e\x2f\xb9\x02\x25\x6a\x8b\xaf\x50\x52\x26\xee\x15\x9d\x01\x05\x7c\xbb\x23\xbe\xd5\xa6\x34\x1a\xbd\x94\x72\x44\x78\x2e\x87\x49\x27\xae\xcd\x26\x7d\x1a\x55\xaf\x44\xaa\x57\xf6\x97\x1f\xa9\xa6\xb3\xd7\x4e\x7f\x2d\x04\x0b\xdc\xeb\x4e\x6c\x33\xb3\xe3\xea\xd4\x9b\x08\xe7\x4a\x89\x20\xd8\xf3\x17\xe6\x2a\xcd\xce\x36\xa0\x40\x52\x1f\xd3\xc8\x22\xdc\x29\x68\x82\x11\x7f\xfb\xed\xb7\xdf\xce\xde\xbf\x3f\x7b\xf5\x8a\xfc\xf8\xe3\xd3\xd9\xec\xa9\x97\xc1\x9c\x8e\xe6\xfb\xd4\xb5\x8a\x9f\xb5\xdc\x08\xaf\x0c\x5d\xa7\xd6\xc3\x19\xfe\x71\xb7\xf2\xc0\x6d\x29\x50\xdb\x8d\xd6\x42\x4e\x19\x27\xb5\x13\x3f\xef\xf2\x42\x5c\xa6\x75\x06\x3e\x87\x58\x38\x3f\x31\x83\x0b\x1f\x63\x58\xa9\xbc\x72\x0d\xd3\x24\xba\xfd\x25\x33\x3b\x83\xdb\x7e\x71\xd3\xe4\x3d\x2b\x42\x56\x1c\x91\x7c\x9b\x2d\x03\x57\x8c\x98\x53\x22\xb6\xe6\xb3\x8b\x2c\x4b\x9b\x5b\x06\x07\x8c\x36\x93\xfe\x07\xfa\xc7\x9c\xe6\x2a\x11\x34\x65\x00\x5e\xc8\x33\x00\xe4\x4f\xcd\x1c\x82\x97\xe5\x0e\x41\xa4\xf6\xd8\xc7\xcd\x06\xe2\x9d\x31\x33\x9a\xba\x0e\x5e\x1d\x7f\x1d\x84\xe2\x6f\xea\x2a\x78\x2d\xc3\x2a\x38\xb7\x75\xf9\xa4\x9f\xc6\xe7\x5b\x09\xff\x89\xfe\xe4\xee\x8e\x3c\xfe\x69\x69\xa9\x23\xf7\xf7\xb9\x86\xb3\x8e\x37\xd1\x4c\x7a\x76\x3d\xbf\xa0\xae\x45\x7d\xea\x9d\x4d\xec\x99\x33\xf7\xe9\x99\x4b\x43\x0a\xe5\x9d\x39\xb6\xfe\x3f\x37\x9a\x7b\x96\x18\x00\x13\xf3\xdf\x9f\x83\x0b\x8e\xad\x3f\xfb\x6e\x3c\x9e\xd0\x6c\xe9\x57\x6e\xc4\x9d\xac\x94\x0f\xbd\xda\x24\x1a\x63\x4e\x2c\x4f\x1e\xff\xf0\x64\x73\xb9\x78\xbe\x6b\x58\xd3\xf5\xe4\x12\xfd\xc5\x19\x16\xeb\x6f\x42\xd6\x2e\x4c\x9a\x35\x1e\x7b\xbe\xc6\xc8\x73\x6e\x65\x6d\x68\x57\x16\x96\x66\x48\xb5\xef\x50\xba\x2e\x5d\xdf\x62\xb9\x0c\xc7\x6e\x84\x98\x98\xac\x27\xb6\x9b\xaa\x00\x56\x3b\x71\xbe\x28\x43\x9c\x52\x4f\x5e\xf2\x84\x60\xc8\xd3\x35\xf2\xf6\xbc\x82\x4c\x86\xb5\x9a\x21\xcc\x57\x58\x3f\xcd\x1a\xf0\xd7\x87\x71\x55\x3e\xec\x91\x64\x1e\x84\xdd\x3c\x92\x5b\x96\x00\x72\x35\xcb\x0b\x06\x21\x3f\x04\x31\x70\xca\x34\xc3\x5b\xd9\x14\x6f\xe5\x37\xe4\x58\x0e\xc6\xa1\xa0\x9e\x8f\xda\x4d\x4e\x8b\xdb\x7f\x8c\x5d\xe2\xee\xa8\x5a\x6e\x25\xdc\x93\xfb\x73\x4f\x0e\x05\xc5\x83\xd4\x6e\x52\xd7\x10\x0e\xca\xca\x39\x28\xb7\x2e\xfa\x9f\xb9\x52\x97\xec\x6c\xf8\x41\xe1\x07\x95\xd0\xfa\x0d\x3f\x28\xfc\xa0\xf0\x83\x96\x3a\x04\xf0\x83\x6e\x33\x08\xf0\x83\xc2\x0f\x0a\x3f\x28\xfc\xa0\xf0\x83\x56\xd3\x14\x2e\x93\x1d\x1a\x7e\xd0\x52\xc5\x09\x3f\x68\xe3\x86\x1c\x7e\xd0\x12\x85\x09\x3f\x68\xc3\x06\x1c\x7e\x50\xf8\x41\xe1\x07\x95\xd0\x0f\x3a\x12\x55\x9d\xee\x25\x26\xf0\xda\xf9\x41\xcb\x2f\xb5\x07\x0f\x68\xa9\x1e\xd0\x91\xa8\x3c\x75\x0f\x29\x9a\xf0\x80\xd6\xa0\x34\x1f\x7c\x9f\xf0\x7d\x4a\x68\xf1\x86\xef\x13\xbe\x4f\xf8\x3e\x4b\x1d\x02\xf8\x3e\xb7\x19\x04\xf8\x3e\xe1\xfb\x84\xef\x13\xbe\x4f\xf8\x3e\xab\x69\xfe\x96\xc9\xf6\x0c\xdf\x67\xa9\xe2\x84\xef\xb3\x71\x43\x0e\xdf\x67\x89\xc2\x84\xef\xb3\x61\x03\x0e\xdf\x27\x7c\x9f\xf0\x7d\xca\xe7\xfb\xec\xb4\x44\xc7\x8c\xf5\x0e\x79\xe2\xee\x81\x7d\x9f\xfb\x38\x67\x01\xde\xcf\x32\xbd\x9f\x9d\x96\xe8\x5c\xb2\x5e\x72\x38\x2f\xbc\x9f\xf0\x7e\x56\xf8\x5c\x06\xf8\x3f\xe1\xff\x94\xd0\xea\x0d\xff\x27\xfc\x9f\xf0\x7f\x96\x3a\x04\xf0\x7f\x6e\x33\x08\xf0\x7f\xc2\xff\x09\xff\x27\xfc\x9f\xf0\x7f\x56\xd3\x04\x2e\x93\xfd\x19\xfe\xcf\x52\xc5\x09\xff\x67\xe3\x86\x1c\xfe\xcf\x12\x85\x09\xff\x67\xc3\x06\x1c\xfe\x4f\xf8\x3f\xe1\xff\x94\xd0\xff\xa9\x0a\xce\x99\x5f\xf3\x94\xf9\x3a\x1d\xb2\xf9\x9e\xce\x6c\x77\x81\x03\xe3\x1f\x9a\x31\x82\x03\xe3\x13\x7f\x39\xfc\x92\xd2\x1e\x0c\x5f\x95\x45\x29\xe5\xa1\xed\x09\x86\x9b\xb1\xad\x22\x82\x95\x17\x0b\x7f\x05\x78\x23\x9b\xa0\xb7\x53\x12\xff\xc6\x7f\x3d\xfb\x1e\x67\xb2\x97\x79\x26\x3b\xbf\xbd\xef\x06\x00\x71\x2c\x3b\x9b\xf2\x00\x79\x4d\x3c\x96\xbd\xa3\x0a\x8e\x65\x57\x7b\xdd\xf5\x30\x63\x29\x11\x73\xfd\xc3\xaa\xb4\x7d\x9c\x9a\x00\xbc\xb9\xde\x7c\x13\x1d\xd4\xde\xeb\x01\x71\xca\x8f\x38\xb7\x5d\xe0\xed\xcc\x95\xba\x9c\x78\x00\x60\x0b\x60\x0b\x60\x0b\x60\x9b\x7d\x13\x00\x5b\xee\xa1\x47\x01\xb6\x6d\xd1\x71\x60\xbd\x35\x8d\xa1\x55\x04\xb6\xe5\x96\xc1\x03\xa4\x5d\x6f\xa6\x89\x0e\xf7\xea\x0d\x00\x69\x01\x69\x2b\xb3\xee\x01\x66\x8f\x01\x66\x1d\x5b\x07\x94\x05\x94\x05\x94\x5d\xa7\x93\xcd\x84\xb2\x1d\x61\x45\xe7\x43\x9e\x6c\x7b\x60\x28\x5b\x76\x56\x33\xc0\xec\x7a\x73\x4d\x58\xa7\x19\x27\xd5\x02\xcc\x56\x69\xe5\x03\xce\xc2\x36\x0b\x40\x0b\x40\x9b\x7b\x13\x00\x5a\xee\xa1\x47\x01\xb4\x5d\x41\x99\x9e\xc1\x7a\x70\xb6\x86\x61\xaa\xa8\xb7\x53\x2a\x8a\xed\x0a\xea\xed\xa0\xda\x4e\x85\xab\xed\x54\x65\x6d\x1f\xab\x14\x0e\xa7\xdb\x50\x09\xa7\xa0\x5b\x85\xe8\x6d\x29\xd2\xa3\x27\xe1\x57\xb0\x18\x8e\x4e\x27\x21\x8a\xdb\x78\x0c\x50\x12\x47\xaa\x61\x68\x6a\x49\x10\x29\x0a\xe3\x44\x63\x81\xda\x38\xa5\x8d\xc3\xf6\x0b\x02\x15\x72\x4a\x1c\x06\x54\xc8\x41\x85\x1c\x54\xc8\x29\xb5\x42\xce\x56\xe6\xe3\x3d\x5b\x8f\x6b\x5d\xff\x26\x12\x73\x79\x02\x96\x54\xa2\xa5\x94\xc0\x39\xe2\xec\xdc\xa4\xc0\x4d\x53\xc6\xf4\x50\x35\x6e\x9a\x22\xcf\x52\xca\xdc\xc8\xbd\x46\x1a\x36\xa2\x52\xd4\xb1\xe1\x3d\x00\xbb\x39\x15\x51\xca\x86\xfd\x87\x52\x36\x75\x28\x65\xd3\x13\x94\xb2\x51\xfb\x89\x0d\x7b\xff\x41\x6f\x89\x47\xb2\x6a\xa9\x89\xf0\x30\xee\xd5\xc3\xd8\x13\xd4\xcd\x51\xfb\x9c\xef\x0d\x3e\xc6\xaa\xf9\x18\xb7\xdd\x27\x3a\x99\x2b\x75\xc9\x6f\x86\x2b\x13\xae\x4c\x29\x6d\xd5\x70\x65\x4a\x31\x0c\x70\x65\x4a\x32\x10\x70\x65\xca\x31\x0e\x70\x65\x4a\x31\x0c\x70\x65\xc2\x95\x09\x57\x26\x5c\x99\x70\x65\x36\xce\xed\x05\x57\x66\xfd\xc6\x14\xae\xcc\x72\xe5\x09\x57\x66\xdd\x46\x14\xae\x4c\xb8\x32\xe1\xca\x94\xd4\x95\x29\xaa\xb1\xdc\x4f\xd2\xee\x6b\xe7\xca\xdc\x4b\x29\x3a\x38\x31\xcb\x75\x62\x8a\x8a\x31\xf7\x91\x28\x09\x27\x66\x0d\x2a\xda\xc1\x7d\x09\xf7\xa5\x94\xf6\x69\xb8\x2f\xa5\x18\x06\xb8\x2f\x25\x19\x08\xb8\x2f\xe5\x18\x07\xb8\x2f\xa5\x18\x06\xb8\x2f\xe1\xbe\x84\xfb\x12\xee\x4b\xb8\x2f\x1b\xe7\xea\x82\xfb\xb2\x7e\x63\x0a\xf7\x65\xb9\xf2\x84\xfb\xb2\x6e\x23\x0a\xf7\x25\xdc\x97\x70\x5f\xca\xe9\xbe\xec\x8b\x4e\xd2\xea\x1f\xf2\x88\xd8\x03\xbb\x2f\xf7\x74\xfc\x00\x1c\x98\xa5\x3a\x30\xfb\xa2\xa3\xb7\xfa\x38\x4d\x16\x0e\xcc\x5a\x9c\x62\x00\x17\x26\x5c\x98\x52\xda\xa8\xe1\xc2\x94\x62\x18\xe0\xc2\x94\x64\x20\xe0\xc2\x94\x63\x1c\xe0\xc2\x94\x62\x18\xe0\xc2\x84\x0b\x13\x2e\x4c\xb8\x30\xe1\xc2\x6c\x9c\xbb\x0b\x2e\xcc\xfa\x8d\x29\x5c\x98\xe5\xca\x13\x2e\xcc\xba\x8d\x28\x5c\x98\x70\x61\xc2\x85\x29\xa7\x0b\x73\x20\x3c\x41\x3d\xfe\xdc\x98\x13\x27\x7f\xa2\xfe\x17\xdb\xbd\x6e\xd8\x49\xe8\xfd\xcd\xe7\x8c\xe8\x24\xf4\xc4\x13\x0d\xd7\x62\xda\xb5\xf8\x90\x4b\x71\x5d\xd7\x61\x3b\xdd\xbe\x89\xeb\xb0\x2a\xcb\x51\xca\xd3\xc9\x0d\x57\xf3\x29\x87\x2a\xad\x70\xab\x18\xbb\x74\x42\x8d\x9b\x08\x58\x8e\xd9\x7c\x2b\x07\xc9\x7d\xea\xcd\x3e\x3f\x3a\xf0\x09\xe5\x8e\xad\x17\x9c\x4f\xce\x99\x03\x4a\x06\x6c\x5b\x9e\x35\xfe\x21\x94\x39\x79\xa1\x59\x7a\xb8\x22\x77\x82\x73\x4d\x3d\x70\x9c\x33\xd4\xe5\xd0\x4a\x32\xc1\x5e\x38\x7b\x3d\x8a\x5c\x69\x29\x35\x84\x73\xfc\x10\xec\xe7\x30\xf2\x3a\xc3\x8b\xa1\xa8\x80\xfc\x10\xf0\x02\xf0\xa2\x51\xf0\x82\x19\x0d\x66\x86\x5f\x0f\x7c\x41\x1c\x5b\x27\x55\x40\x18\x1f\x23\xb1\x03\x62\x94\x65\x15\x02\x8c\x90\x12\x46\x1c\xc4\xc4\x34\x14\x86\x22\xf7\x13\x75\xb3\xf7\x28\x79\xce\xa0\x55\xb5\xb3\x46\x60\xa0\xda\x74\xc6\x09\xcb\xca\xc1\x42\x25\x31\x84\xdc\x7a\x69\x0f\x32\x97\xea\x72\xc6\x50\x95\xa0\x6a\xad\x2c\x61\x95\x41\xaa\xb0\x85\x95\x02\x54\x61\x0b\xab\x30\x88\xad\x33\x92\x19\x89\xdc\xb3\x7d\x18\xc3\xea\x88\x64\x86\x40\x32\x47\x47\x32\x30\xba\xc1\xe8\x56\x61\x2c\x03\xbc\x92\xee\xa6\x74\x78\xe5\x10\x46\xb7\x6e\x4b\x78\x48\xf8\x28\x51\x6b\xb5\x33\xba\x95\x5b\x5a\x1f\xe6\xb6\x35\xe7\x9a\xc8\x61\x3b\x68\x01\xa4\xd6\x10\xa4\xca\x64\x6e\x2b\xa7\x1a\x7e\x95\xe0\x29\x0c\x6d\xc7\x40\xa7\x30\xb4\x95\x02\x4e\x61\x68\xab\x30\x70\xad\x35\x86\x11\x39\xa9\x07\x2a\x30\x4c\x0d\x31\x8c\x4c\x86\xb6\xe6\x61\x18\x98\xd8\x60\x62\xab\x30\x8a\x01\x52\x49\x77\x53\x3a\xa4\x72\x10\x13\x9b\xda\x11\x41\x86\x43\x1e\x5e\x79\x68\x13\x5b\xd9\xe5\x5f\x61\x64\x5b\x73\xb6\x89\x3c\xc1\x03\x9c\x48\x59\x47\x80\x2a\x93\x91\xad\xac\x8a\xad\x55\x82\xa8\x30\xb3\x1d\x03\xa1\xc2\xcc\x56\x0a\x40\x85\x99\xad\xc2\xe0\xb5\xce\x28\xa6\x2d\x72\x4b\x0f\xba\x40\x31\x35\x44\x31\x32\x99\xd9\x9a\x88\x62\xea\x65\x68\xab\x48\x81\x0a\x98\xd9\xca\x41\x31\x40\x2a\xe9\x6e\x1e\x1f\xa9\x7c\x13\x3d\x36\x58\x73\xc1\x72\x52\xd8\x11\x36\xe1\xd8\x28\xde\xe4\x8a\xce\xb4\x5f\xa9\xeb\x45\x2a\x62\x18\x36\xfb\x8b\x70\x59\xe8\x9a\x7b\x1d\xde\xe9\x6b\xd3\x64\xd8\x95\xb0\x1a\x73\x24\xb8\xe5\x46\xac\xc4\x3f\xe5\xd3\x99\x63\x6a\xbe\x61\x25\xbb\xbf\x62\x1a\x9e\xcf\xcd\x9b\xbb\x22\x48\x70\x57\xa4\x0b\xce\x5d\x7b\x46\xfd\x2b\x3a\x17\xf9\x5b\xb8\x1b\x8a\x86\x5e\xb9\x32\xd8\x92\xe0\x81\x8d\x61\x4d\xcc\xb9\x4e\x9f\x9b\x45\x70\xa1\x78\x06\x28\xb3\xb9\xe9\x1b\x05\xb7\x47\x2b\x4b\x29\xc0\x65\x29\xfd\xcf\x97\x48\x56\xfe\x98\x53\x37\x40\x57\x8a\x53\xf4\x76\xdc\x58\xa9\xa9\xd6\x29\x0d\xe6\x1a\x5f\xb4\x51\xf1\xae\x0d\xe7\x17\xd7\xfc\x79\x61\x4d\x0a\x3a\xb7\xdc\x51\xb8\xce\x65\x97\x74\x6a\x8a\x99\xbf\x46\x52\xcd\xbc\xbc\x68\x8c\xa2\x59\xf1\xb9\x50\x8b\x67\x8b\x78\x0b\xc6\x2f\x77\x5b\xa9\xa3\xa8\xc4\xda\x52\xd9\x60\x30\x0b\xbf\xc4\x8d\x25\xf7\x22\xbc\x40\xd2\xf8\x26\x5b\x9b\x73\x5d\xd1\xac\x27\x9c\x64\xdb\xe0\x36\x0e\x7e\x6a\xad\xf8\x8d\x35\x67\xcd\x64\xee\xf9\xf6\xac\xdc\x19\x13\x09\xe0\x79\xb6\x04\x7b\xfc\xc6\xdf\x8d\xc7\x9a\x69\x16\x4f\x82\x87\xa9\x10\x43\xa0\x97\x86\x65\x44\xa0\x37\x9c\x07\xe3\x50\xa5\x25\x15\x5e\x0d\xeb\xd2\xe6\x40\x55\x01\xa6\x5a\xa2\xcb\xc7\x3f\x7c\x7f\x7f\x4a\x32\x78\xe9\xc1\xd9\x98\xd1\xa8\xf1\x64\x14\x96\x5e\x5c\x67\x83\x59\xf1\xdd\x87\xb6\x99\xfd\x48\xe1\x81\x6d\xea\xc9\xc9\xe3\x1f\x1e\x15\x41\xd1\x27\xeb\x4f\xc5\x08\x4f\xf1\xcf\xf7\xb5\x29\x9b\x73\xde\x3f\x96\x6f\xa7\xa4\xaf\xe6\xc4\x10\xb4\x15\xdf\x1c\x4d\xf4\x50\x4e\xdc\x85\xb9\x47\x3f\x86\x0f\x4a\x71\x46\xf6\x6f\x00\x8a\xee\x43\x3d\x67\xb0\x91\x89\x34\xdc\x65\x08\x49\x15\xcb\xfe\x72\xa6\x2e\xe1\x9b\xe2\xdb\x51\x9b\x92\xfa\x9a\x63\x4c\xae\x19\x6d\x8b\xbe\x1c\x89\x72\xbc\xc4\xd8\xfc\x2e\xa3\xf4\x12\xe5\x10\xeb\x6e\xf6\xa1\xc3\x7f\x50\x67\xc9\xdf\x3d\xee\x6f\x95\xff\xd0\x69\xf1\x57\x38\x90\xd9\xe6\xfe\x56\xf5\x70\xf9\x7d\x5e\xbe\x43\xc0\x08\xf2\xbb\x9f\xf8\x57\xf8\x07\xf7\xf9\x07\xf3\xbf\xd2\xee\xf2\x1f\x92\x53\x17\x94\x81\xce\xf7\x77\xd9\x97\x94\xf8\xbe\xda\x8c\x7e\x29\x11\x38\x59\xc2\xf8\xec\x96\x47\x9e\x90\x10\xaa\x90\x27\xe4\x45\x84\x52\xd8\x37\x6e\x12\xc8\xf3\xcd\xfd\x37\xff\x1f\x00\x00\xff\xff\x02\x29\x90\x15\x01\xd9\x03\x00")
+var _monitoringBackendGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\x6b\x77\xdb\x36\xb6\xfd\xde\x5f\x81\xab\x76\x26\x4e\xaf\x9d\x88\x7a\x2b\x6b\x75\xdd\x15\xe7\x31\xcd\x4c\xd2\x66\x92\xb4\x77\xe6\xa6\x59\x1a\x5a\xa2\x6d\x4e\x28\x52\x25\x29\xc7\x6e\x96\xe7\xb7\x5f\x10\x7c\x81\x0f\xe8\x49\x51\x20\xb9\xfb\xa1\xb1\x00\x0a\x02\x0f\x1e\x67\xef\x73\x70\x0e\xbe\x7e\x43\x48\x4b\x35\x4d\xcb\x55\x5d\xdd\x32\x9d\xd6\x13\xf2\x95\x16\xd1\x42\x43\x77\x5c\xfa\xe9\x23\xfb\x44\x82\x52\x56\x73\xb1\xd4\x0d\xf7\x95\x49\x2b\x95\xd3\xb8\x74\xa6\xba\xaa\x63\x2d\xed\xa9\x46\x2b\x5a\x67\x67\xe4\x2f\xb6\x7a\xa9\x9a\x2a\x39\x3b\x6b\x71\x8f\x69\xa6\x7a\x61\x78\x8f\xb8\xf6\x52\xe3\xca\xaf\xf5\x59\x4e\xa9\x3e\xb5\xcc\x67\x96\x61\xd9\x5e\x9b\xf6\xd5\x85\x7a\xd2\x3e\x25\x1d\x45\xa1\xff\xeb\xf7\x4f\x89\xf2\x90\x6f\xda\x54\xe7\xec\xb7\x9f\xc6\xaf\x43\xfe\x4c\x9e\x1a\x9a\xed\x3a\xfc\x73\xee\xdd\x82\x3d\x37\x53\x9d\xeb\x0b\x4b\xb5\x67\xad\xa0\xee\x9e\xfd\xfb\x89\xfe\xff\xde\x7b\xbc\xa5\xcd\x74\x37\xd5\xdb\xd6\x95\xa9\xb9\xaf\x66\xb4\xc4\x5c\x1a\x86\x5f\x62\xab\x8b\xeb\x0f\x96\x65\xb8\xfa\x82\x96\xb7\x59\xa1\xee\x3d\x32\x62\x7f\x1a\xba\xf9\xd9\x93\xeb\xc7\x4f\xec\xe3\x42\x35\x35\xc3\x89\x24\x1b\xca\xb5\x35\xb5\x0c\x43\x5d\x38\x9a\xf7\xc5\x4b\xd5\x70\x22\x31\xd0\x1f\xd0\x67\x6f\xad\x78\x68\x7c\x79\xa5\xc4\xff\x85\x7e\xee\xf4\xb8\x82\xdb\xb0\x2f\xc1\xe7\x3b\xef\x73\xf8\xa2\x51\xdb\xac\x9f\x9d\xe8\x39\xae\x77\x9f\xa2\x32\x57\x77\x99\x0c\x5a\xaf\xe9\x94\xd0\x4c\xcd\x8e\xa5\x19\xc9\xd2\xb6\xbe\xf8\x52\x0c\x9a\x8e\x5e\x4b\x35\x74\xd5\x61\x43\xc8\x5e\x20\xfe\xe5\x0b\x95\x95\x24\x5f\xd5\x1b\x92\xd7\x9a\x79\xe5\xb2\xd7\x6b\x27\xca\xb5\xbc\xc7\xf9\x39\xf7\x1d\xf7\x31\x7a\xe4\x52\x37\x0c\x5e\x54\x62\x69\x8e\x52\xd2\x54\x3a\x6b\xa4\xa9\xe4\x4b\xb3\x3b\x8e\x3e\x1b\xda\x95\x66\xce\x92\x3f\xa5\xde\x5c\xa5\xdf\xc3\x1b\xfd\xa5\x6d\x6b\xa6\x9b\x53\x33\x57\x6f\xf3\x4a\x75\x33\xa7\xd4\xb9\xb6\xbe\x64\x17\x91\x4b\x57\x83\x91\xf3\xf4\x8d\x6a\x2c\x63\xa1\x66\x5e\x86\xce\x5b\x56\xcb\xb7\xc6\x0a\xbf\xe8\x33\x37\x31\xfd\x52\x53\x9c\x15\x79\xcb\xe3\xad\xa5\x9b\xee\x1b\x8b\x2d\x6c\x56\x10\x0f\x8b\xb5\x88\xb6\x9b\xf8\x17\x17\x1a\x1d\x3a\xd3\x55\xaf\xb4\xcc\x48\x2f\xbc\xa6\x6c\x75\xa6\x2f\xbd\xef\x74\x92\xe5\xd9\x89\x41\x65\x39\xd3\x6c\x8d\x6d\x1b\x97\x86\xe5\xc6\x3f\xec\x68\xb6\xae\x39\x3f\xdf\x68\x36\x9d\x07\x5a\xaa\xd3\xce\x42\x9d\x6a\x79\xf3\xcf\x71\xd5\xe9\xe7\xcc\xaf\xd0\xd5\xb0\x58\x68\xb3\xd7\x54\x26\x99\x3a\x57\xb5\xaf\x34\x37\x5e\xe7\xde\x7f\xf1\x2c\xf0\x36\x97\xdb\x05\xeb\x9e\xb3\x9c\x9f\xd8\xaa\xab\x9d\xa8\x0b\xdd\xb1\x4c\xd5\xb5\xec\x89\x11\x2c\xb4\x89\xad\x39\x0b\x2a\x26\x6d\x32\xa5\x52\x74\xbe\x7a\x3b\x1c\xeb\xe3\x0f\xbf\xb5\xbe\x8b\x3e\xfc\xd6\x3a\xb5\xb5\xdf\xe9\x50\xba\x13\x6f\x39\xd2\x3a\x75\xe9\x5e\x5b\xb6\xfe\x07\xad\xba\xff\xa8\xcc\x3f\x3d\xe4\xf7\x49\x6f\x51\x58\xf6\x5c\xf5\x26\x1b\x5d\xdb\x73\x6d\xe2\xcb\x24\xf9\x08\x15\xab\x66\xdf\xb0\x79\xd3\x52\xe6\xf9\x75\x2f\xd5\xa9\xcb\xb6\x66\xa5\x9d\xa8\xf7\xa7\xfd\xcb\xe8\x47\xa2\xee\x24\x9b\xb1\xb5\x4b\xb6\x93\xb6\x9e\xb6\xa2\xe2\xfb\xd3\xe3\x48\xcb\xd6\x16\xf2\xc8\x8a\x76\x46\x20\xa9\xf3\xe3\x4a\x8a\x76\xcc\xb2\x5d\x39\x04\xe5\xf7\x45\x20\xa7\x67\xc7\x9f\x51\x6c\xc2\x4f\x2c\xef\x4f\x39\x04\x96\xea\x94\x40\x72\xcf\x8f\x2f\x39\x3a\xb2\xb2\xc9\x2d\xea\x92\x40\x6a\x2f\x38\xa9\x05\x7f\x71\xf8\x89\x7e\x9d\x6a\x66\x63\x96\xc1\x55\x73\xed\xa5\x6d\xcd\x39\x30\x19\x95\xbf\xd3\xae\x02\xfd\x98\xfa\xc2\xfb\x6b\xfd\xd2\xcd\x7e\x23\x85\xd0\x48\x20\x56\x87\x50\x9d\x4a\x1c\x8d\xc2\xe8\x19\x39\xb9\xb8\x0b\xcb\x89\x27\xee\x87\x1c\x8c\x8b\xe0\x2b\x87\x54\x9c\x6b\xd5\x66\x70\x34\x85\x27\x1c\x6f\xdd\x71\x4a\x38\x84\x12\x93\x10\x0c\xea\xe6\x4c\xbf\xd1\x67\x4b\x2a\xff\x0c\xaa\x08\x9f\x61\xa8\x39\xee\xc0\xad\x7a\xab\xa7\x30\xd9\xc5\x72\xfa\xd9\xd7\xa0\xfc\xbb\x7a\xd8\x27\x40\x14\x9e\x38\x72\xf0\x7f\xea\xe9\x7c\x4c\x14\x61\x9f\x8f\x9f\x32\x5d\xbc\x53\x6f\xb5\x15\x8a\x7b\xa6\x4d\xf5\xb9\xca\x40\x72\x5b\x30\x2f\xa9\x94\x17\xa9\x19\x69\xa8\x17\x9a\x91\xe9\x9d\x57\x61\x5d\x9d\xab\x8e\x96\x84\xf3\x11\xee\xcb\x3c\xee\x03\xbf\xe4\x0f\x73\xaf\xb8\x76\xf1\xc6\x9d\xa4\xdf\x4a\xef\x9f\xc5\x76\x32\x53\x9c\xdb\xcf\xcc\x72\xb9\xcb\x4e\x05\x4a\x22\xae\xf2\xf0\x2e\x2b\x7f\xad\xdd\x44\x9d\xfe\x86\x6f\xb4\xc6\x54\x24\x51\xb0\x82\x8b\xf4\x38\x8c\x5e\x00\x17\xf1\x48\xfa\x8b\xf9\xc2\xbd\xcb\x2e\x28\xaf\xea\xff\x34\xdb\xca\xd6\x80\xc0\x10\x10\x98\xf5\x30\xc0\x59\xb0\x67\x68\x45\xe7\xf6\x56\x0e\xcd\xdf\xf9\xc7\x3f\xa4\x61\x2c\xb1\x78\x7a\xed\xae\x1c\xe2\xa1\x1d\x91\x86\xa6\xf0\xe2\xe9\xc9\x22\x9e\x9e\x34\xec\x84\x17\xcf\x58\x16\xf1\x8c\xa5\xa1\x20\xb1\x78\xfa\xb2\xec\x3d\x7d\xe1\xde\x53\x21\xae\xe1\xcb\x9f\x78\xb2\x05\xd9\x00\xd9\x00\xd9\xa8\x24\xd9\x48\xfb\x3d\xc6\x02\xae\xd1\x2d\x94\x6b\x80\x36\x10\xd0\x86\x89\xb7\xed\x3a\x13\x5f\xa9\x38\x13\x7f\x97\xde\xc3\x0d\x42\xa8\x5a\x3a\x31\x34\xa1\x6a\xbf\xd6\x54\x77\xae\x2e\x0e\xa7\xd6\xbf\x7e\xfd\xd7\xd7\xaf\xc4\xd0\xc8\xfd\xfd\xbf\xee\xef\x37\x60\x17\xc7\xd4\xf0\x4f\x43\xf9\xad\x57\xf1\x5e\x63\xc4\x1f\x1e\xa2\x9b\xc1\x23\x0e\x94\x3e\x94\x7e\x93\x94\xfe\x54\xb5\x67\xa9\x86\xbd\xa2\xb7\xea\x6c\xa6\x9b\x57\xd9\x99\xe3\x55\xbe\xb3\x96\xe6\x2c\xd5\x78\xd4\xd3\x69\x70\xf6\x25\xd5\x60\x74\x24\xe6\xdb\x97\x4f\x9f\xbf\xe8\x3c\xe5\xe7\x28\xfb\xca\xfb\xa9\xea\x2f\x61\xe7\xf7\xc4\x08\x84\xb5\xd7\xda\x3c\x58\x47\x74\xfb\x5a\x58\x06\xdd\x87\x7f\xb6\x55\xf3\x2a\xc1\x68\xbc\xad\xda\x32\x7d\xed\xdc\x7e\xd4\xcf\x59\x1f\x16\xdd\x77\x75\xf7\x2e\xbb\x06\x3d\x44\x12\x6f\x7a\xae\x13\xae\xb4\x6d\x10\x4c\x81\xd6\xd1\x2c\x62\x09\x37\xfa\x84\x1e\x0e\x6d\x98\xe7\xd1\xbe\x90\xd4\x6d\xd7\xfa\xd5\x35\x9d\x0f\xd7\xee\xb3\x60\x9c\x13\x10\xc1\x07\x41\x91\x98\x72\x41\x50\x30\x3f\x85\xc0\x23\x8d\x26\x72\xe1\x82\x4d\xa7\xa3\xed\x68\xff\x14\x75\x13\x2a\xf8\xd0\x2a\x78\x85\xaa\xdd\x47\xa3\x06\xaf\x5e\x8c\x66\xcd\xd3\x49\x5e\xe9\x8f\x74\x80\x2d\xaa\x1b\xe7\xc2\x79\x18\x2a\xd0\xf4\x48\xb4\x6e\x9f\x66\xf6\xcd\xec\x86\x1b\xb7\x73\xeb\x4f\xd0\x9f\x96\xf3\x0b\x86\x48\x13\x22\x09\x2a\xdf\x7b\xa7\x42\x52\x55\x77\xd9\x9f\xc9\xd7\x88\xbc\xaa\xe1\xf7\xad\x5c\x5d\x92\xab\x49\xb2\xca\x4e\x24\xb9\x85\xa1\xbb\xd1\x0c\xcb\xdd\xab\xef\xfc\x37\x3a\x0f\xf6\x73\x6f\xea\x5b\xad\x74\x6d\xbe\x30\xee\x32\xc2\xc8\x55\x2f\x35\xe6\x94\xca\x30\x23\x4f\x7f\x3f\x1d\x82\x54\x82\x54\xca\xa6\xd1\xf8\xd3\x62\x75\xd0\x67\xe5\x52\x4a\x2a\x3d\x10\x4a\xbf\x1c\x84\x12\x84\x92\x6f\xf4\xf8\x84\xb2\x3f\x1c\xf7\x5e\x76\x40\x28\xa3\x82\xfc\xe3\x36\x59\xb8\x72\x28\x46\xd9\x5f\x1d\x4e\xd0\x48\x46\x59\x37\xfd\xbb\x17\x9f\x14\xa9\x53\xb0\x49\x56\x05\x36\xd9\x3c\x36\xd9\xe9\x67\xe4\xe9\xef\xa5\x71\xbf\xc1\x26\xc1\x26\x25\xd0\x66\xa9\x88\x9a\x3a\x28\xb3\xf2\xc8\xe4\x3b\x26\x3c\x70\x49\xbf\x1c\x5c\x12\x5c\x92\x6f\xf4\xf8\x5c\xf2\x65\xa7\x37\xee\x3f\x03\x97\x8c\x0a\x72\xb9\x64\x0e\x58\x39\x18\x97\x8c\x7a\x07\x2e\x59\x53\xed\xbb\x33\x95\x5c\xa1\x4c\xc1\x24\x59\x15\x98\x64\xf3\x98\x64\xb7\x9b\x91\x27\xdb\x49\x07\x60\x92\x04\x4c\x52\x26\x5d\x26\xca\x39\x50\x07\xa5\x76\x8c\x23\xaf\x27\x4c\x8c\x0f\xc1\x2e\xfd\x72\xb0\x4b\xb0\x4b\xbe\x51\x09\xd8\xe5\xcb\xf1\xa8\xdb\x06\xbb\x8c\x0a\x72\xd9\x65\x0e\x80\x39\x14\xbb\x1c\xac\x4e\x36\xd0\x38\x76\x59\x67\x8d\x5c\xc0\x09\xd8\x55\x0a\x16\x8c\x93\x55\x81\x71\x36\x8f\x71\xf6\x04\xa9\x5c\x06\x31\x1f\x02\xe3\x04\xe3\x94\x44\xbf\x65\x73\xb5\xd5\x41\xbb\x95\x7f\x1e\x16\x6c\x13\x6c\x33\xf5\x8a\xf1\x04\x05\xdb\x3c\x3e\xdb\x3c\x1f\x0d\x87\xcf\xc7\x60\x9b\x51\x41\x2e\xdb\xcc\x01\x2f\x07\x63\x9b\xab\xd3\x4d\x34\x92\x6d\xd6\x53\x1b\xef\x7d\x3a\x16\x4c\x33\x51\x09\xa6\xc9\x55\xae\x63\x9a\x65\x5c\xcb\xd0\x13\x1d\x57\xed\xc7\xd1\xe4\x9b\x5d\xcc\x40\x5e\x79\xcb\xd3\x54\x0d\xf2\xf4\xed\xab\x56\x66\x76\x35\xf1\x96\x86\x9e\x20\x5d\x91\xa2\xe0\x9e\x06\x02\x42\x5d\x8c\x0a\xd7\x83\x65\x37\xa1\x95\x3b\xa7\x3e\x67\x17\xd5\xc8\x91\x7d\x50\x4d\x5f\x9a\x23\xd2\xd6\x02\x6a\x52\x9e\xd0\x16\x54\x49\x4c\xd9\x65\x3f\x93\xcf\xda\x9d\x2c\xe2\x4b\xf5\x4a\x20\xc8\xa2\xd2\xa4\x16\x2d\x48\xda\x41\xba\xec\x68\xc3\x74\x5b\xa6\x2d\x4b\x28\xd4\x74\x0f\x05\x02\x2e\x2a\xd1\x6a\xc1\x02\x96\x4f\xa0\x22\x01\x16\x95\x8a\xb5\x08\x01\xd2\x01\xb7\x64\x99\x8b\x7e\x5f\x04\x42\x7b\x21\x93\xd0\x6e\xa8\xa2\x96\x45\x68\xac\x2f\x02\xa1\xbd\x94\x48\x68\x73\xcd\xb5\xf5\xa9\x24\x52\x0b\x3a\x23\x10\xdb\x5f\x24\x12\x1b\x95\xc4\x8d\x3e\xd5\x26\xae\xf5\x59\x93\x65\x8f\x4b\xf6\x49\x20\xc4\x1f\xe5\x13\xa2\x5c\xe2\x13\x09\xee\x95\x4c\x82\x73\x55\x59\x36\x3a\xd6\x15\x81\xc8\xfe\x2a\x91\xc8\x96\x0e\xa5\x70\xb4\xc9\xb9\x2e\x8b\xe4\xf8\x1e\x09\x04\xf8\x37\x99\x04\xe8\xea\x86\xfe\x07\x43\x50\x92\xc8\x2f\xee\x90\x40\x7c\xaf\x25\x71\x7c\x46\xd9\xe3\x79\x8b\x15\xae\xad\xe2\xde\x02\xbe\xce\xdc\x4e\x36\xce\xd7\x59\x23\xdb\x6c\xd6\x5f\x28\x32\xce\x72\x57\x12\xe3\xe2\xaa\xa8\x10\x16\xdd\xa8\x54\x52\x8b\x6e\x23\x6f\xb1\xda\x5b\x56\x0d\xba\xd2\xaa\x00\x59\x35\xe6\x7e\xab\x02\x64\xd5\x98\xcb\xae\xf6\x96\x15\x6e\xbe\xe2\x2a\x8a\xe5\x2e\xb8\x06\x2b\xee\x30\xc8\x0b\xc8\x0b\xa9\x24\x79\x49\x1f\x2c\xe9\x0b\x52\x96\x2b\xc3\xd5\x77\x40\xe0\x60\x09\x01\x0d\xd9\x4f\xad\xef\x77\x50\x34\x79\xce\xa4\x0e\x27\x44\x4b\x8c\xd7\x60\xc2\x43\x98\x86\x5f\x0e\xed\x0f\xed\xcf\x37\x7a\xfc\x30\x0d\xdc\x87\xb5\x89\xd9\x35\x07\xba\x1c\x2a\x4c\x43\x19\x0e\x56\xa2\xa1\x0a\xc5\x69\x40\x17\x1f\x36\x5a\x43\xac\x5a\x11\xa4\xc1\xaa\x10\xa4\xd1\xbc\x74\x00\x03\x41\x6c\x88\x32\xc4\xcd\x58\x04\x2c\x53\x5e\xcd\x26\x3a\x98\x5f\x07\x1d\x57\x22\xdf\x8c\xc5\x48\xfe\x46\xc5\x08\xe6\xe9\x97\x83\x79\x82\x79\xf2\x8d\x82\x79\x56\x83\x79\xe6\xc0\x99\xc3\x31\xcf\xda\x64\x3b\x87\x7e\x8e\xc4\x7d\x60\x0e\xba\x89\xba\x05\x1b\x65\x55\x60\xa3\xcd\x63\xa3\x43\x41\x3a\x74\x65\x88\x60\x7a\x02\x36\x5a\x09\x6d\x27\x8c\x6e\xae\x83\xe6\x2b\xf3\xf2\x2d\x5f\x8c\xe4\xa5\x2f\x46\x30\x53\xbf\x1c\xcc\x14\xcc\x94\x6f\x14\xcc\xb4\x1a\xcc\x34\x07\xda\x1c\x8c\x99\x8e\x56\x47\xb7\x80\x99\x36\x42\x57\xef\x71\x55\xd7\x06\xaa\x17\x2c\x95\x55\x81\xa5\x36\x8f\xa5\x8e\x04\x29\xd4\x95\xd1\xea\x1b\x2a\xc0\x52\x89\x48\x9b\x80\xa5\x96\xab\xf9\xea\xa5\xe9\x8e\xe2\x2f\x05\x23\x0d\xca\xc1\x48\xc1\x48\xf9\x46\xc1\x48\xab\xc1\x48\x73\x60\xcc\xe1\x18\xe9\xea\xdb\x65\xc0\x48\x6b\xa9\x97\x8b\xf0\x93\x82\x7d\x82\x7d\x82\x7d\x86\x6f\xef\x7d\x41\x94\xd3\x66\xb4\xfa\xc6\x0a\xb0\x4f\x22\xd2\x1c\x60\x9f\x25\x68\xb9\x54\x7e\xdd\x3a\xe8\xb7\xf2\x78\xa7\x97\x09\x82\x6a\x22\x76\x70\xe8\x05\x13\xe4\x66\x39\x21\xc0\x40\xc1\x40\x33\x9d\x04\x03\x05\x03\x95\x83\x81\x66\xa1\xcc\xe1\x18\x68\x74\x4b\x0e\x18\x68\x13\x74\xf3\xce\xdc\x73\x0b\x55\x0b\x16\xca\xaa\xc0\x42\x9b\xc7\x42\xc7\xa2\xec\x44\x23\x64\x27\x22\x60\xa1\xf2\x6a\xba\xe4\x85\x25\x75\xd0\x74\xe5\xb1\xd0\x17\x4c\x78\x60\x9d\x7e\x39\x58\x27\x58\x27\xdf\x28\x58\x67\x35\x58\x67\x0e\x74\x39\x1c\xeb\x44\x76\xa2\x46\xe9\xe2\x9d\x59\xe7\x0a\xd5\x0a\x96\xc9\xaa\xc0\x32\x9b\xc7\x32\x95\xb6\x28\x3d\xd1\x08\xe9\x89\x08\x68\xa6\xb4\xaa\x2d\x7d\xc5\x63\x1d\x74\x5b\x79\x3c\xf3\x8d\x2f\x3d\x10\x4d\xbf\x1c\x44\x13\x44\x93\x6f\x14\x44\xb3\x1a\x44\x33\x0f\xbd\x1c\x8e\x69\x22\x1b\x51\xb3\xd4\xf1\xce\x54\x73\x95\x76\x05\xd7\x64\x55\xe0\x9a\x0d\xe4\x9a\x8a\x28\xf9\xd0\x08\xc9\x87\x08\xb8\xa6\xb4\xca\x2d\x79\x07\x7d\xad\x74\x5c\x79\x94\xf3\xbd\x2f\x44\xf2\x81\x09\x11\xcc\xd3\x2f\x07\xf3\x04\xf3\xe4\x1b\x05\xf3\xac\x08\xf3\xcc\xc1\x32\x07\x63\x9e\x63\x64\x1b\x6a\xa4\x72\xde\x99\x80\x6e\xa0\x6b\xc1\x43\x59\x15\x78\x68\x03\x79\x68\x47\x94\x5e\x68\x8c\xf4\x42\x04\x3c\x54\x76\x55\x57\x2f\x25\x57\x3a\x03\x05\xf7\x0c\xca\xc1\x3d\xc1\x3d\xf9\x46\xc1\x3d\x2b\xc2\x3d\x73\xf0\xcb\xe1\xb8\x27\xf2\x0a\x35\x4c\x21\xef\xcb\x3a\xc1\x37\xc1\x37\xc1\x37\xc3\xb7\x67\x0f\x88\x12\x0a\x8d\x91\x50\x88\x80\x6f\xca\xab\xde\x5c\xb5\x66\xd1\x23\x25\x92\x4d\x4f\x76\x60\x9a\x7e\x39\x98\x26\x98\x26\xdf\x28\x98\x66\x55\x98\x66\x89\xf9\x83\xc6\xc8\x1f\xd4\x24\x55\xbc\x3b\xcd\x14\x6a\x56\x70\x4c\x56\x05\x8e\xd9\x40\x8e\xd9\x15\xa5\x0b\x1a\x23\x5d\x10\x01\xc7\x94\x56\xb1\x2d\x1d\x2a\x5f\xfa\x0b\x73\xbd\x66\xfa\xad\x3c\xaa\xf9\x8b\x27\x42\xf2\x9a\x89\x10\x8c\xd3\x2f\x07\xe3\x04\xe3\xe4\x1b\x05\xe3\xac\x08\xe3\xcc\xc1\x31\x87\x63\x9c\xc8\x1d\xd4\x40\xc5\xbc\x33\xf1\x5c\xab\x67\xc1\x3f\x59\x15\xf8\x67\x03\xf9\x67\x4f\x94\x47\x68\x8c\x3c\x42\x04\xfc\x53\x5e\x35\xe7\xea\x86\xfe\x07\x4b\x46\x5e\x2b\x2d\x57\x22\xfd\x8c\x25\x08\xf6\xe9\x97\x83\x7d\x82\x7d\xf2\x8d\x82\x7d\x56\x84\x7d\xe6\xa0\x98\xc3\xb1\x4f\xe4\x13\x6a\x9e\x5a\xde\x9d\x7c\xae\xd1\xb2\xe0\x9e\xac\x0a\xdc\x53\xc0\x3d\xe9\x86\x6e\xa8\x0b\x87\xa1\xa8\xe4\x1e\x20\xdc\x3f\x79\x01\x78\xaf\xd5\xe9\x71\x05\xb9\x24\xb0\x2f\x48\xf0\xd3\xe7\x18\x8a\x6a\x6a\x46\x06\x63\x06\x93\xfc\x7f\x2d\xfb\x33\xdd\xaf\x5a\x99\xe9\x64\x53\xb9\xe6\xbe\x56\x75\x29\xf5\x06\xd2\xec\xe5\x4b\xb3\x87\x6c\x49\x04\x8c\x7a\x2f\xd5\xfd\x85\x2d\xb4\xc9\xbf\xad\x8b\xc9\x94\xee\x39\x62\xbd\x7c\x4f\x38\xdd\xeb\xad\x46\xa1\xf6\xf5\x74\xcd\xc4\x7f\xf9\x43\x6b\x60\xaf\x1f\x95\xa0\xc6\x7f\xb5\x2e\x32\x54\x98\xca\x9c\x24\x05\x09\xce\x5b\x2c\xe7\xb5\xc0\x78\xa5\x63\xbc\xd5\xd5\xd3\xeb\x4d\xdf\x83\x8e\x00\xf5\xac\x3e\xd0\x0a\x3d\x4d\xa0\xa7\x37\xd7\xd3\x36\x55\xd3\xbe\x8a\xdd\x8c\x50\x07\x44\xfa\x9d\xb6\xa0\x5b\x0d\x55\x44\xb5\xa2\xd1\xe5\xa9\x70\x5f\x7e\x9e\xd6\xce\x1a\xb5\xfd\x11\x81\x39\x1b\xaa\xbd\xc9\xaa\xbd\x7c\x63\x76\x7f\x38\xee\xbd\xec\xc0\x98\x1d\x15\xe4\x1b\xb3\x73\x70\xc9\xa1\x8c\xd9\xfd\xd5\x87\xcc\xe5\xb7\x65\x43\xd1\x92\x62\xed\xd5\x02\xbd\x99\x30\x53\xef\xae\x3f\x61\xa0\x6e\xd5\xcd\x40\x5d\x67\x86\x38\x6c\x67\x04\xea\x6f\x9b\xab\x0f\xa0\x82\x21\x12\x91\x32\x00\x43\x2c\x4c\x71\xfd\x64\xb9\xfa\xe5\x5d\xdd\x14\x57\x79\x0c\xd1\x97\x1f\x18\x22\x18\x62\xf2\x65\xc0\x10\xc3\x86\xcb\x67\x88\x17\xbd\xcb\xcb\x76\x1b\x0c\x31\x2a\xc8\x67\x88\x39\xb8\xe4\x60\x0c\x71\xf5\x31\xf0\x26\x30\xc4\x7a\x2a\xda\x9d\x19\xa2\x40\x6f\x82\x21\x82\x21\xe6\x33\xc4\x52\x8e\x30\x0d\x47\x99\x37\xf3\x8f\x6b\xc6\xa9\xfa\xf2\x8e\x30\xd9\xda\x42\xf3\x87\x62\xa6\x2d\x0c\xeb\x6e\x4e\xf5\xc3\x33\xcb\xbc\xd4\xaf\x38\x3e\x31\xb5\x28\x05\xf8\xd5\xa7\xb3\x89\xd1\x4d\x7d\xe3\x49\x72\x57\x72\x34\x43\x9b\xba\xd9\xd7\x66\x95\xae\x76\xcb\x7e\xf6\x82\xb2\x0e\xba\xb4\xcf\xa6\xb6\x65\x26\xd7\x31\xc3\x5b\x99\x47\x32\x4b\xfa\x3e\xbb\x42\xdf\x5a\x33\x87\x9c\x7c\x97\xee\x1f\xbf\xf8\xd6\x9d\xcf\x9a\xaa\x54\xa9\x7e\xa0\x6b\xd8\x5a\x66\x76\x02\xa6\x74\xcf\x69\xa7\xae\xec\x60\xd2\x24\xd4\x06\xab\xfe\x35\xe8\x7c\x72\xbc\xa7\xa1\x99\x20\xde\xc7\x5b\xdf\xbe\xec\xf4\xc6\xfd\x67\xfc\x42\xb0\xaf\x2e\xd4\x93\x4e\x77\x78\xea\x25\x32\x3a\x25\xbd\xf6\x29\x55\xd8\xa3\x31\xbf\xdd\xb6\xbe\xed\x8c\xc7\xd3\xde\x20\x94\x47\x3c\xa2\x1b\xa8\xe2\xbc\x65\xc9\x2d\x4a\x93\xa2\x04\x4e\x6f\xab\x4b\x46\x56\xb9\x81\xa7\x4b\x32\x7c\x3f\xa5\xdd\x4e\x2e\xcb\xb0\x22\x67\x6d\xa6\xc1\x58\xc4\x75\x5e\x7b\x28\x32\xa3\xf6\xf8\x27\xde\xa8\xfe\x01\x3b\xc1\x96\x25\x5c\x47\xdd\xd4\x3a\x1a\xac\x5d\x46\x39\x89\xa3\x28\x88\xf0\x66\x42\x48\xa8\x73\x61\x42\x37\x16\x24\xaf\xe7\x62\x31\xe6\x60\x01\xba\x49\x2f\x28\x8e\xfc\xe0\xcf\x45\x25\xaf\x7c\x85\xce\x0f\xa8\x8b\xbf\x4c\x88\x6b\x11\xb6\xa2\x72\x57\x90\x12\x15\x8a\x40\x7e\xd8\x18\x03\x8d\xab\x1b\xeb\xc4\x8d\x65\xe6\x1e\x9d\x18\xcf\xe9\x7c\x7b\x1b\x5a\x2c\xb8\xd9\x91\xb5\x96\x50\x85\x68\xfa\xfb\x43\xe2\x99\x0f\xfe\xc6\x90\x58\x71\xf9\xa6\x14\x63\x79\x45\xe7\x1b\x9d\x16\xb4\xce\x6b\x70\xf0\xa8\xf3\xa8\x17\x37\xb6\xb0\x1c\xf7\x52\xbf\x4d\x0e\x43\x50\xf8\xd2\x32\xc3\x6d\xbb\xd5\x6f\xff\x89\xab\xa7\xe8\x21\xf3\x1d\x56\x26\xfc\x0a\x93\xd9\x1b\xba\xc1\x8b\xc7\xea\xd2\x07\x1a\x49\x03\x11\xab\x09\xb7\xc1\x9f\x1e\x3f\x4d\x55\x58\xd1\x17\x56\x08\xbc\x2a\x5b\x33\x45\x94\xf6\x67\xc3\x37\x22\x71\xdd\xf4\x6c\x97\x11\xf9\x61\xbb\x5e\x57\xa1\x9b\x9e\x32\x3a\x65\x77\x6b\xd2\x5d\x4f\x19\x25\x76\xbd\xcb\xa5\x91\x67\xe6\xf3\x5a\xe6\xdb\xf1\x9b\xe9\xd0\x7d\x53\x19\x77\x13\x0d\xac\xc4\xeb\xae\x7a\x61\x78\xed\x2c\xe7\x66\x72\x06\x6c\x05\xc0\x3f\x2f\x2f\xb4\x09\xd5\xab\x86\x3e\x65\xc7\xde\xe9\x3c\x77\x6d\x0a\x01\x28\x08\xf7\xb2\xa1\x2d\x1d\x5a\xa9\xce\xee\xc2\x47\x1c\x0e\x7f\x3f\x88\xe1\xf7\x83\xd3\xdc\x26\x7e\xf8\xcf\x83\x8c\x5e\x7b\xf4\xfd\x83\xfb\xdd\x8f\x37\xc6\x90\x7b\x25\xe2\xde\xdb\x98\xd5\x52\x4e\x3b\x9c\x44\x63\xf8\xdd\xea\xb6\x9d\x64\x45\x88\xbf\xd3\x35\xa1\x8b\x66\x69\x9a\x74\x7f\x24\x0b\xaa\xe6\xb3\x2a\xdd\xa1\x55\x86\xe6\x49\x3a\xae\x63\xf3\x95\x5f\xc0\x23\x7e\x01\xb3\xda\xd5\x0b\xd8\xf2\x70\x7a\xeb\x87\xfc\xb5\xdb\x16\x2c\x8e\x75\x8b\x97\x3d\xf8\x53\xb0\xf3\x7a\x36\xf3\x83\x20\x91\xb7\xe1\x8e\x96\x03\x45\xb6\x40\x29\x01\xdc\xd8\x16\xa5\x04\xe0\x06\x28\x65\x1f\x94\xc2\x7f\xde\x0f\xa5\xc4\x5e\x05\x0e\xa5\x24\xa6\x14\x70\x4a\xe1\x38\x05\x38\x04\x38\x44\x32\x1c\xb2\xd0\xa6\x05\xe3\x0f\x72\x46\xea\x03\x7e\x8e\x0a\x6f\x7e\x31\xd5\x1b\x55\x37\xbc\x39\x00\x88\x03\x63\x4b\x7d\x60\x4c\x8e\x1f\x67\x57\x1c\x13\x3b\x65\x60\x6d\x81\xb5\x05\x28\x87\xfd\xdb\x38\x94\xc3\x42\x40\x4f\xc2\xff\x9b\xae\xaa\x7b\x59\x1d\xe6\xda\xdc\xb2\xef\x26\x7e\x1e\xc0\x8b\x3b\x57\x13\x82\x0c\xaa\x5d\xf3\x20\xc5\xd9\x47\xf5\xec\x8f\xf6\xd9\xf8\xd3\x7f\xc7\x7f\x79\x08\xc7\x73\x7a\x9a\x74\x39\x3d\x6c\x92\xb1\x85\xf9\x52\x66\xba\xe3\xda\xfa\xc5\x92\xce\x61\x62\x99\xe4\x9a\x2e\x6b\xc0\x92\x22\x61\xc9\x8e\xd6\x95\x59\xaf\xa7\x76\x55\xc0\x12\xb2\x17\x2c\xe1\x0f\xa0\xec\x07\x4b\xe2\x63\xb1\x30\xaf\xc0\xbc\xc2\xd5\x00\x78\xd4\x0a\x78\xd0\x09\x7e\xe2\x59\x3b\x66\x9a\xe1\xaa\xbe\xcd\x83\x62\x89\x49\x8c\x41\x22\x5b\x07\xfd\xc3\x76\x9d\x09\x3b\x9f\x5e\x08\x0c\xf9\xd8\x8f\x0e\x60\xd1\xef\x35\x0a\x8a\xbc\x51\x6f\x99\x41\x84\x84\x62\x25\x27\x86\xea\xb8\xa4\x4f\xa8\xce\xa1\xd8\xc4\xc9\x39\xe0\x51\x37\x4c\x52\x9d\x68\x93\x21\xb7\x1c\x37\x3b\xc2\x34\x12\xe4\x23\x50\xb8\x28\x8c\x02\xa2\x4d\x3c\x55\xfe\x62\xbe\x70\xef\x5a\x99\xe3\x62\xe1\x79\xd1\x6c\x4d\x9d\x42\x54\x88\xea\x90\x3f\xbc\xb7\x5c\xa3\x58\x37\x0d\x55\xe9\x27\xcb\xb7\x0a\x55\xa9\x8a\x62\x93\x31\xa4\xa6\x5c\x03\xff\xfe\x8a\xa6\x93\xa8\x4e\x2b\x1a\xb6\x3e\xce\x12\x06\x6f\xee\x39\x2a\xad\xcf\xad\x9c\x08\x01\x4e\x21\x25\xca\x3d\x21\xb3\xc1\x58\x8b\x79\xeb\x7f\x74\x83\xb9\x13\x72\x24\x1b\xc9\xee\xbc\x55\x98\x94\xe0\x58\xda\x6a\x68\x96\xe6\xda\xc1\x79\xb6\xf9\xe0\xd4\xd6\x1e\xb6\x74\xb4\xd9\x59\xd2\xea\x94\x14\xd2\x73\x49\x62\xea\xde\x5a\x33\xc2\xe4\x4f\x4e\xd8\x7e\x76\x4a\xd8\xf8\x9e\x92\xa5\xe9\xfd\xfb\x90\xa8\xe6\xcc\xc7\xb0\xec\x6d\x62\xc3\x9a\xce\xa9\x28\x84\xd6\x15\x1b\x5a\x77\xf0\xb8\xb5\x4a\x07\xd7\xf1\xb0\x13\xa9\xd5\x72\xa9\x0c\x6f\x31\xdc\x90\xca\x64\x2d\x88\x8c\xca\x14\x9b\x01\xb5\xf1\x4c\xa6\x28\x06\xb3\x47\xb0\xbd\x1f\x40\xa3\xcd\xce\xef\xde\x65\x0d\xdc\xe0\x37\xfb\xf0\x9b\xe3\x5b\xd7\x0e\x8d\x6b\xfc\x30\x46\xfa\x4b\x95\x48\x18\xf0\x76\x53\xdb\xdb\x0e\xf0\xa5\x9d\xde\x47\x1a\x02\x5f\x80\x52\x92\x9d\x94\x2e\x05\x40\x19\xb1\x9b\x63\xd1\x1d\x64\x9d\x58\x25\xae\x0c\xde\x4c\xac\x57\xbf\xf4\x15\xdd\x88\x18\xcb\xf5\xda\x19\xb4\xdb\xc3\xb1\xa2\x0c\xbb\xbc\x27\xce\x7f\xee\xad\xd7\xee\xab\x54\xa4\x68\x29\x6a\x2b\xbc\x86\x63\x8d\xea\x8a\x1e\x5b\xa1\xbe\x10\xf9\x49\x36\xc3\xbc\xf0\xfa\x13\xe1\x22\x1c\x64\x1a\xdf\xc8\xe9\xaf\x74\x72\x83\x2a\x70\x18\x11\x87\x11\xbd\x47\x0e\x76\x26\x60\xd7\x6d\xbe\x9b\xae\x8a\xb9\x4b\x62\x72\x57\x4d\x07\x34\xe5\xec\x41\x7d\x8d\xe1\x12\x1d\x35\x40\x88\xe9\x36\x90\x07\x21\xa6\x95\x87\x43\x99\x10\xd3\xdd\xe1\x50\x4c\x23\x70\x08\x12\x87\x20\xb9\x9a\x63\x01\x9e\x4e\xaa\x0a\x80\xa7\x56\x80\x07\x47\x0e\x10\xcb\x5a\x1f\x2c\x05\xf3\x51\x25\xf0\x52\x36\x96\x75\x77\xc0\x14\x5f\xb7\x05\xfb\x11\xec\x47\xd2\xc3\xa9\x61\xaa\x0a\x70\xaa\x52\x70\xaa\xb6\x87\x04\x25\x32\x1f\x21\x68\x16\x41\xb3\x35\xc7\x3f\x99\xa0\xd9\xdd\xf1\x4f\x1c\x3f\x02\x83\x11\x0c\x46\x5c\xcd\xb1\x10\xce\x20\x55\x05\x84\x53\x29\x84\x83\xe8\x5c\x44\xe7\x22\x3a\x97\x15\x14\x18\x9d\x3b\x16\x1c\x69\x57\x3a\xc5\x5e\x06\xd7\xf8\x43\xed\x12\x85\xe7\xee\x7c\x94\x50\x49\x55\xd5\x45\x83\xca\x78\x4c\x1e\x61\xc0\x08\x03\x46\x18\x70\xa5\x87\x06\x61\xc0\x08\x03\x46\x18\x30\xc2\x80\x11\x06\x2c\x33\x67\xda\x3a\x0c\xb8\xd3\xce\xda\x44\x03\xce\xb4\xfa\x56\x49\x70\xa6\xb8\xb4\x5a\x81\xc0\xdb\x73\xa5\x71\xaa\x06\x54\xe9\x70\x54\xe9\xf8\x16\xc1\x43\x43\x24\x44\x14\x23\xa2\xf8\xe8\x58\xa2\x2a\x98\xa7\xce\x41\xc5\x1d\x45\x94\x4d\xb1\x13\xb9\x6e\x6b\x18\x54\x4c\x77\x04\xdd\x59\xa3\xbe\xfc\x67\x56\xe8\x2e\x84\x13\x93\xcd\xb0\x33\xce\x43\x10\xe1\xf2\xeb\x66\x1a\xdf\xf0\x38\x44\x0c\x08\x71\x1c\x14\xc7\x41\xa5\x3f\x2c\x21\x53\x38\x71\x21\xbb\x7f\x53\x8e\x49\xd4\xd7\x9c\x2e\xd1\xa9\x08\x04\x12\x6f\x03\x76\x10\x48\x5c\x79\x20\x94\x0e\x24\xde\x1d\x08\x71\x9a\x05\xe7\x42\x71\x2e\x94\xab\x41\x20\x31\xa0\x0e\x29\x12\xea\xe0\xb8\x02\x42\x88\xeb\x83\xa2\x60\x32\xaa\x04\x52\xca\x84\x10\xef\x01\x95\x62\x9c\x03\x9b\x11\x6c\x46\xd2\x03\x29\x99\x42\x88\x01\xa4\x10\x3c\x2c\x95\xc9\x08\xc1\xc3\x08\x1e\xae\x39\xf2\x49\x07\x0f\xef\x81\x7c\x72\x93\xef\xc2\x48\x04\x23\x11\x82\x87\x81\x6d\xc8\x36\xd8\x06\x61\xc3\x08\x1b\x46\xd8\x30\x2b\x28\x2e\x6c\xb8\xa3\x88\x8e\xc0\x77\xe3\x93\x61\x38\x02\x1f\x15\x22\x6c\x98\xaf\xaa\xbe\xee\x94\xf1\x14\x3c\x02\x86\x11\x30\x8c\x80\xe1\x4a\x0f\x0d\x02\x86\x11\x30\x8c\x80\x61\x04\x0c\x23\x60\x58\x66\xb6\xb4\x7d\xc0\x70\x27\x6b\x07\x0d\xd8\x52\x9c\x24\x1a\x6c\x29\x2a\x44\xc0\x30\x48\x12\x42\x85\xc3\x47\x10\x2a\xbc\x23\x06\x42\xa8\xb0\x24\x21\xb8\xf2\xa2\x9d\x5a\x87\x0a\x77\x44\xa9\x1d\xbb\x9c\x11\xb0\x76\xa1\xc2\x5f\x2c\xcf\xbb\xbd\x46\x79\x05\x0f\xad\xd0\x5e\x08\x16\x26\x9b\xe1\x66\x9c\x7f\x20\xa2\x05\xd8\x6d\x67\x1a\xdf\xf0\xf8\x43\xec\x6d\xc6\xc1\x4f\x1c\xfc\x94\xfe\x70\x84\x4c\xc1\xc2\x05\xed\xff\x4d\x39\x1e\x51\x5f\x63\xba\x44\xa7\x21\x10\x2e\xbc\x0d\xdc\x41\xb8\x70\xe5\xa1\x50\x26\x5c\x78\x77\x28\x14\x07\x15\xe0\x24\x28\x4e\x82\x72\x35\x08\x17\x06\xd8\x09\x2b\xa4\x3d\x26\x53\xab\xe3\x0a\x08\x18\x0e\x7f\xb1\x12\x38\x0a\x66\xa3\x4a\x60\xa5\x6c\xc0\xf0\xee\x60\x29\xce\x01\x09\xbb\x11\xec\x46\xd2\x43\x29\x99\x02\x86\x01\xa5\xc8\x36\x50\xaa\xb6\x87\x0b\x25\x32\x1b\x21\x64\x18\x21\xc3\x35\xc7\x3e\x99\x90\xe1\xdd\xb1\x4f\x6e\x82\x5d\x18\x8a\x60\x28\x42\xc8\x30\xd0\x4d\x58\x81\xa0\x61\x89\xf1\x0e\x82\x86\xab\x74\x0c\x7e\xfb\xa0\xe1\xae\xe8\x18\x7c\x2f\xee\x39\x8e\xc1\x47\x85\x08\x1a\xe6\xab\xea\xa0\x3d\x65\x3c\x11\x8f\xb0\x61\x84\x0d\x23\x6c\xb8\xd2\x43\x83\xb0\x61\x84\x0d\x23\x6c\x18\x61\xc3\x08\x1b\x96\x99\x2f\x6d\x1f\x36\xdc\xcb\xda\x42\x03\xbe\xc4\x51\x02\xf0\xa5\xb0\x10\x61\xc3\xa0\x49\x08\x1c\x8e\x1f\xd9\x25\x70\x98\xfe\x10\xc2\x86\x9b\x89\x81\x24\x40\x11\x55\x41\x3b\xb5\x0e\x1b\xee\x09\x52\x3b\xc6\xa9\x4a\x56\x06\x0d\x67\x55\x4a\xb9\x4a\x68\x6a\x73\x24\x88\x3d\x91\x51\x41\xec\x91\x15\x0a\x28\xda\x66\x9e\xbd\xfd\x85\xfc\xe2\x31\xde\x3d\x03\x80\xab\x03\x52\xb7\x37\xea\xf7\x86\xf9\xd3\x65\x10\xcf\x17\xc1\x59\xc4\x02\x90\x6b\x9d\x40\xa8\x44\x46\xfb\xaa\x2c\x54\x29\x91\xa2\x67\xcc\x9a\x44\x98\x2f\x89\x16\x9f\xc4\xb8\x71\xba\x58\x06\xd6\x34\x47\xa3\xa5\xb3\x00\x39\x3e\xf9\xfa\x95\x3c\x7a\xbf\x9c\xbf\xa3\x50\x9e\xdc\xdf\x73\x40\xf2\x3f\xfb\x5a\xd9\x8a\x01\x91\xab\xed\xef\x2b\x40\x64\x61\x26\xf8\x63\x02\xcf\x48\x23\xc0\xce\x26\x39\xc6\x84\x39\x8d\x2b\x97\x0b\x61\xf6\x7b\xf9\x90\x41\xe9\x71\x06\x94\x83\x27\xa6\x89\xf1\x49\x29\xea\xce\xd0\xe9\x56\x66\xae\x35\x8f\x44\x8f\x01\x9f\x16\x83\x4f\xfb\x7d\xd1\x64\x8b\x13\x13\x01\xa0\x56\x0c\xa0\xee\xba\xe6\x39\x52\x72\x7c\x73\x69\x81\x3b\x02\x80\xb0\x94\x40\x58\x94\x86\x11\x50\x18\x50\x18\x50\x38\xd9\xc9\x66\x42\xe1\x41\x27\x33\x6f\x02\x74\xb2\xa1\xb9\xb5\x8a\x50\xb8\x90\xf4\xc2\x00\xc1\xec\xf3\x86\xd3\x4c\x78\xf2\x3a\x06\x61\x00\xc1\x00\xc1\x48\x35\x0e\xf8\x0b\xf8\x0b\xf8\x0b\xf8\x5b\x76\x27\x9b\x09\x7f\x87\xc2\x13\x8e\x71\xf0\x6c\xed\xe0\x6f\xd1\x19\xca\x01\x80\x37\x98\x68\x8a\x68\xa2\xe5\xe6\x97\x04\x00\x26\x00\xc0\x95\xda\x0d\x00\x81\x01\x81\xfd\x01\x02\x04\x06\x04\xe6\x8a\x01\x81\x25\x87\xc0\xa3\x7c\x64\xb2\xe1\x1d\x3d\x75\x3b\x6e\xfb\xf7\x25\xdd\xfa\x4b\x05\xb2\x53\x96\x28\x26\x25\xdf\x12\xd0\xed\x25\x97\xa0\x84\xa2\x15\x2e\x43\x49\x91\xb8\x57\x74\x07\x14\xf0\xed\x9e\xf8\x96\xaa\xbd\x60\xf4\x12\xca\x11\xc7\x73\x39\x4c\x3a\xf5\x02\xe3\x33\xa8\x7a\x25\x52\xa5\xb3\xe3\x47\x4d\x9d\xb1\xd7\x4e\x7e\xcd\x07\x0b\xdc\xeb\xd2\x85\x9b\xda\x71\x69\x73\x53\xe1\x5c\x29\x10\x04\x3b\xee\x9d\xb1\x4a\xb3\xb3\x0d\xc8\x93\xd4\x87\x24\xb2\xf0\x77\x0a\x2d\xc6\x88\xff\xa4\xff\x9d\xbd\x79\x73\xf6\xfc\x39\xf9\xf1\xc7\x27\xf3\xf9\x13\x27\x85\x39\x17\xaa\x4b\x41\xa7\x99\xdf\x56\xb8\x11\x5e\xeb\xb3\x99\x66\xae\x8f\xf0\x8f\xba\x95\x05\x6e\xa1\x40\x2d\x3b\x58\x0b\x19\x65\x1c\xe7\x4e\xfc\xb4\xcf\x0b\x71\x91\xd6\x29\xf8\xec\x63\xe1\xec\xc4\xf4\x2a\x3e\x44\xb0\xb2\xf5\xdc\xa6\x1b\x29\x99\x59\x5f\x52\xb3\xd3\x7b\xec\x17\x3b\x49\xde\xd3\x22\x64\xc9\x11\xc9\xb7\xe9\x34\x70\xf9\x88\x39\x21\x62\x73\x39\xbf\x48\xb3\xb4\xa5\xa9\x73\xc0\x68\x3b\xe9\xbf\xd3\x7e\xa7\xfb\x5a\x3a\x13\x41\x53\x06\xe0\x5c\x9e\x01\x20\x7f\x6a\xe6\x10\x3c\x2b\x76\x08\x02\xb5\xc7\x3e\x6e\x37\x10\xaf\xf5\xb9\xde\xd4\x75\xf0\xfc\xf8\xeb\xc0\x17\x7f\x53\x57\xc1\x0b\x19\x56\xc1\x5b\x6b\x26\x9f\xf4\x93\xf8\x7c\x27\xe1\x3f\x9e\x3d\xf6\x2c\x78\x3f\x85\x96\x3a\x72\x7f\x9f\x29\x38\xeb\x3a\x53\xd5\xd0\xce\xbc\xc0\x7a\xdb\xd4\x5c\xcd\x39\x9b\x5a\xf3\xc5\xd2\xd5\xce\xe8\x50\x30\x0a\xe5\x78\x79\x8d\xfe\xe7\x46\xb5\xcf\x62\x03\x60\x6c\xfe\xfb\xb3\x57\xe1\x59\x00\xbf\x9b\x4c\xa6\x5a\x3a\xf5\x2b\x37\xe2\x8b\xb4\x94\xcb\x5e\x6d\x12\x8d\x31\x27\x96\xc7\x8f\xbe\x7f\xbc\xbd\x5c\xbc\x7c\x43\xe6\xd5\x66\x72\x09\xfe\xe2\x0c\x8b\xf5\x37\x21\x7b\x09\x68\xd3\xc6\x63\x4a\x33\x18\x79\xce\xac\xac\x2d\xed\xca\xc2\xd4\x0c\x89\xf2\x3d\x52\xd7\x25\xf3\x5b\x84\xcb\x90\xfe\xe1\x23\x26\x26\xeb\xa9\x65\x27\x32\x80\xd5\x4e\x9c\xe7\x45\x88\x53\xea\xc9\x4b\x1e\x13\x0c\x39\x3f\xe4\xcf\x0e\xbd\x82\x0c\x86\xb5\x9a\x21\xcc\xe7\x58\x3f\xcd\x1a\xf0\x17\x6b\x07\x3c\x0b\x04\x76\x70\x55\xae\xf7\x48\x32\x0f\xc2\x7e\x1e\xc9\x1d\x53\x00\xd9\xaa\xe9\x78\x83\x90\x1d\x82\x08\x38\xa5\x8a\xe1\xad\x6c\x8a\xb7\xf2\x1b\xae\xdb\xe5\x3a\x18\x47\x82\x7c\x3e\x4a\x2f\xbe\x2d\xee\xf0\x67\xec\x62\x77\x47\xd5\x62\x2b\xe1\x9e\x3c\x9c\x7b\x72\x24\x48\x1e\xa4\xf4\xe2\xbc\x86\x70\x50\x56\xce\x41\xb9\x73\xd2\xff\x54\x4d\x5d\xa2\xb3\xe1\x07\x85\x1f\x54\x42\xeb\x37\xfc\xa0\xf0\x83\xc2\x0f\x5a\xe8\x10\xc0\x0f\xba\xcb\x20\xc0\x0f\x0a\x3f\x28\xfc\xa0\xf0\x83\xc2\x0f\x5a\x4d\x53\xb8\x4c\x76\x68\xf8\x41\x0b\x15\x27\xfc\xa0\x8d\x1b\x72\xf8\x41\x0b\x14\x26\xfc\xa0\x0d\x1b\x70\xf8\x41\xe1\x07\x85\x1f\x54\x42\x3f\xe8\x58\x94\x75\xba\x1f\x9b\xc0\x6b\xe7\x07\x2d\x3e\xd5\x1e\x3c\xa0\x85\x7a\x40\xc7\xa2\xf4\xd4\x7d\x84\x68\x12\x78\x40\xab\x9f\x9a\x0f\xbe\x4f\xf8\x3e\x25\xb4\x78\xc3\xf7\x09\xdf\x27\x7c\x9f\x85\x0e\x01\x7c\x9f\xbb\x0c\x02\x7c\x9f\xf0\x7d\xc2\xf7\x09\xdf\x27\x7c\x9f\xd5\x34\x7f\xcb\x64\x7b\x86\xef\xb3\x50\x71\xc2\xf7\xd9\xb8\x21\x87\xef\xb3\x40\x61\xc2\xf7\xd9\xb0\x01\x87\xef\x13\xbe\x4f\xf8\x3e\xe5\xf3\x7d\x76\xdb\xa2\x6b\xc6\xfa\x65\xde\xb8\x5b\xb2\xef\xf3\x10\xf7\x2c\xc0\xfb\x59\xa4\xf7\xb3\xdb\x16\xdd\x4b\xd6\x8f\x2f\xe7\x85\xf7\x13\xde\xcf\x0a\xdf\xcb\x00\xff\x27\xfc\x9f\x12\x5a\xbd\xe1\xff\x84\xff\x13\xfe\xcf\x42\x87\x00\xfe\xcf\x5d\x06\x01\xfe\x4f\xf8\x3f\xe1\xff\x84\xff\x13\xfe\xcf\x6a\x9a\xc0\x65\xb2\x3f\xc3\xff\x59\xa8\x38\xe1\xff\x6c\xdc\x90\xc3\xff\x59\xa0\x30\xe1\xff\x6c\xd8\x80\xc3\xff\x09\xff\x27\xfc\x9f\x12\xfa\x3f\x15\xc1\x3d\xf3\x1b\xde\x32\x5f\xa7\x4b\x36\xdf\x68\x73\xcb\xbe\xc3\x85\xf1\xeb\x66\x8c\xe0\xc2\xf8\xd8\x5f\x0e\xbf\xa4\xb4\x17\xc3\x57\x65\x51\x4a\x79\x69\x7b\x8c\xe1\xe6\x6c\xab\x08\x60\xe5\xc5\x9d\xbb\x02\xbc\x91\x6d\xd0\xdb\x29\x89\x7e\xe3\xbf\x7e\x78\x80\x3b\xd9\x8b\xbc\x93\x9d\xdf\xde\xf7\x03\x80\xb8\x96\x9d\x4d\x79\x80\xbc\x26\x5e\xcb\xde\x55\x04\xd7\xb2\x2b\xfd\xe8\xab\x25\x9c\x98\x8b\xf1\x69\xd5\x72\x9e\x03\x6f\x86\x9f\x37\x9c\x6f\xa2\x8b\xda\xfb\x31\x0e\x02\xe2\x94\x16\x71\xee\xba\xc0\x3b\xa9\x9a\xba\xdc\x78\x00\x60\x0b\x60\x0b\x60\x0b\x60\x9b\x7e\x13\x00\x5b\xae\xd1\xa3\x00\xdb\x8e\xe8\x3a\xb0\xfe\x86\xc6\xd0\x2a\x02\xdb\x62\xd3\xe0\x01\xd2\x6e\x36\xd3\x44\x97\x7b\xf5\xa3\xb6\x00\x69\x01\x69\xa5\x5f\xf7\x00\xb3\xc7\x00\xb3\xf4\x87\x00\x65\x01\x65\x01\x65\x37\xe9\x64\x33\xa1\x6c\x57\x98\xd1\xb9\xcc\x9b\x6d\x4b\x86\xb2\x45\x47\x35\x03\xcc\x6e\x36\xd7\x84\x79\x9a\x71\x53\x2d\xc0\x6c\x95\x56\x3e\xe0\x2c\x6c\xb3\x00\xb4\x00\xb4\x99\x37\x01\xa0\xe5\x1a\x3d\x0a\xa0\xed\x09\xd2\xf4\xc4\xf6\xb2\xa6\x1d\x53\x45\xbe\x9d\x42\x51\x6c\x4f\x90\x6f\x07\xd9\x76\xf6\xc5\xb0\x47\xcc\xb6\x53\x95\xb5\x7d\xac\x54\x38\x9c\x6e\x43\x26\x9c\x9c\x6e\xe5\xa2\xb7\x50\xa4\x47\x0f\xc2\xaf\x60\x32\x1c\xfa\x4a\x3e\x8a\xdb\x7a\x0c\x90\x12\x47\xaa\x61\x68\x6a\x4a\x10\x29\x12\xe3\x04\x63\x81\xdc\x38\x85\x8d\xc3\xee\x0b\x02\x19\x72\x0a\x1c\x06\x64\xc8\x41\x86\x1c\xe1\x30\x4b\x34\xc6\x15\xca\x90\xb3\x93\xf9\xf8\xc0\xd6\xe3\x5a\xe7\xbf\x09\xc4\x5c\x9c\x80\x25\x95\x68\x21\x29\x70\x8e\x38\x3b\xb7\x49\x70\xd3\x94\x31\x2d\x2b\xc7\x4d\x53\xe4\x59\x48\x9a\x1b\xb9\xd7\x48\xc3\x46\x54\x8a\x3c\x36\xbc\x07\x60\x3f\xa7\x22\x52\xd9\xb0\xff\x90\xca\xa6\x0e\xa9\x6c\xfa\x82\x54\x36\xca\x20\xb6\x61\x1f\xfe\xd0\x5b\xec\x91\xac\x5a\x68\x22\x3c\x8c\xad\x43\x7a\x18\xfb\x82\xbc\x39\xca\x80\xf3\xbd\xc1\xc7\x58\x35\x1f\xe3\xae\xfb\x44\x37\x55\x53\x97\xf8\x66\xb8\x32\xe1\xca\x94\xd2\x56\x0d\x57\xa6\x14\xc3\x00\x57\xa6\x24\x03\x01\x57\xa6\x1c\xe3\x00\x57\xa6\x14\xc3\x00\x57\xa6\x48\xfa\x70\x65\xae\x1b\x6c\xb8\x32\xe1\xca\x0c\x2b\xe1\xca\xac\x9c\x51\x1f\xae\xcc\xfa\x8d\x29\x5c\x99\xc5\xca\x13\xae\xcc\xba\x8d\x28\x5c\x99\x04\xae\xcc\x3c\xc8\x09\x57\xa6\xff\xef\x31\x5d\x99\xa2\x1c\xcb\x83\x38\xec\xbe\x76\xae\xcc\x83\xa4\xa2\x83\x13\xb3\x58\x27\xa6\x28\x19\xf3\x00\x81\x92\x04\x4e\xcc\xea\x67\xb4\x83\xfb\x12\xee\x4b\x29\xed\xd3\x70\x5f\x4a\x31\x0c\x70\x5f\x4a\x32\x10\x70\x5f\xca\x31\x0e\x70\x5f\x4a\x31\x0c\x70\x5f\x8a\xa4\x0f\xf7\xe5\xba\xc1\x86\xfb\x12\xee\xcb\xb0\x12\xee\xcb\xca\x19\xf2\xe1\xbe\xac\xdf\x98\xc2\x7d\x59\xac\x3c\xe1\xbe\xac\xdb\x88\xc2\x7d\x49\xe0\xbe\xcc\x83\x9c\x70\x5f\xfa\xff\x1e\xd1\x7d\x39\x10\xdd\xa4\x35\x28\xf3\x8a\xd8\x92\xdd\x97\x07\xba\x7e\x00\x0e\xcc\x42\x1d\x98\x03\xd1\xd5\x5b\x03\xdc\x26\x4b\xe0\xc0\xac\xc3\x2d\x06\x70\x61\xc2\x85\x29\xa5\x8d\x1a\x2e\x4c\x29\x86\x01\x2e\x4c\x49\x06\x02\x2e\x4c\x39\xc6\x01\x2e\x4c\x29\x86\x01\x2e\x4c\x91\xf4\xe1\xc2\x5c\x37\xd8\x70\x61\xc2\x85\x19\x56\xc2\x85\x59\x39\x63\x3e\x5c\x98\xf5\x1b\x53\xb8\x30\x8b\x95\x27\x5c\x98\x75\x1b\x51\xb8\x30\x09\x5c\x98\x79\x90\x13\x2e\x4c\xff\xdf\x23\xba\x30\x87\xc2\x1b\xd4\xa3\xcf\x8d\xb9\x71\xf2\x27\xcd\xf5\xdc\x0e\x0d\xbb\x09\x7d\xb0\xfd\x9c\x11\xdd\x84\x1e\x7b\xa2\xe1\x5a\x4c\xba\x16\xd7\xb9\x14\x37\x75\x1d\x76\x92\xe5\xdb\xb8\x0e\xab\xb2\x1c\xa5\xbc\x9d\x5c\xb7\x55\x57\xe3\x50\xa5\xe9\x6f\x15\x14\xd2\x4d\x35\xfd\x26\x00\x96\x13\x36\xdf\x8a\x41\x72\x1f\xfb\xf3\x4f\x0f\x4b\xbe\xa1\x9c\xfe\x50\xce\xfd\xe4\x9c\x39\xa0\x60\xc0\xb6\xe3\x5d\xe3\xef\x7c\x99\x93\x73\xd5\x9c\xf9\x2b\x72\x2f\x38\xd7\xd4\x0b\xc7\x39\x43\x5d\x06\xad\xc4\x13\xec\x7c\x71\xd0\xab\xc8\x5b\xed\x14\x37\xa8\x05\x9c\xe3\x87\xe0\x30\x97\x91\xd7\x19\x5e\x8c\x44\x09\xe4\x47\x80\x17\x80\x17\x8d\x82\x17\xcc\x68\x30\xd7\xdd\x7a\xe0\x0b\x42\x7f\x89\x54\x01\x61\x7c\x08\xc4\x0e\x88\xb1\x1f\xc4\x00\x8c\x48\x76\x53\x3a\x18\x51\x8a\x89\x69\x24\x3c\x8a\x1c\x61\x83\x12\x4e\xc9\x73\x06\xad\xaa\xdd\x35\x02\x03\x55\xf4\x79\xc3\x19\x27\x4c\x2b\x07\x0b\x95\xc4\x10\x72\xe7\xa5\x3d\x4c\x55\xd5\xe5\x8e\xa1\x2a\x41\xd5\x5a\x59\xc2\x2a\x83\x54\x61\x0b\x2b\x04\xa8\xc2\x16\x56\x61\x10\x5b\x67\x24\x33\x16\xb9\x67\x07\x30\x86\xd5\x11\xc9\x8c\x52\x55\x40\x32\xe5\x23\x19\x18\xdd\x60\x74\xab\x30\x96\x01\x5e\x49\x76\x53\x3a\xbc\x52\x86\xd1\xad\xd7\x16\x5e\x12\x3e\x8e\xd5\x5a\xed\x8c\x6e\xc5\xa6\xd6\x87\xb9\x6d\xc3\xb9\x26\x72\xd8\x0e\xe3\x9e\x03\xa4\xd6\x07\xa4\xca\x64\x6e\x2b\x26\x1b\x7e\x95\xe0\x29\x0c\x6d\xc7\x40\xa7\x30\xb4\x15\x02\x4e\x61\x68\xab\x30\x70\xad\x35\x86\x11\x39\xa9\x87\x9c\x6e\x07\x86\xa9\x0d\x86\x91\xc9\xd0\xd6\x3c\x0c\x03\x13\x1b\x4c\x6c\x15\x46\x31\x40\x2a\xc9\x6e\x4a\x87\x54\x4a\x31\xb1\x29\x5d\x11\x64\x28\xf3\xf2\xca\xb2\x4d\x6c\x45\xa7\x7f\x85\x91\x6d\xc3\xd9\x26\xf2\x04\x0f\x71\x23\x65\x1d\x01\xaa\x4c\x46\xb6\xa2\x32\xb6\x56\x09\xa2\xc2\xcc\x76\x0c\x84\x0a\x33\x5b\x21\x00\x15\x66\xb6\x0a\x83\xd7\x3a\xa3\x98\x8e\xc8\x2d\x3d\x8c\x6f\x4c\x00\x8a\xa9\x0f\x8a\x91\xc9\xcc\xd6\x44\x14\x53\x2f\x43\x5b\x45\x12\x54\xc0\xcc\x56\x0c\x8a\x01\x52\x49\x76\xf3\xf8\x48\x85\x32\x09\xfd\x92\x3c\x7a\xff\xfa\x67\xca\x26\x52\xb0\xa5\x14\x9b\x5b\x47\x70\xe3\x52\xbf\xbf\x3a\x96\x34\x5a\x9b\xb4\xeb\xb5\x34\x28\x8d\x52\xa2\x54\x3a\x6b\x45\x29\xf0\x78\xf6\xfb\x08\x92\x94\x18\x8a\x49\x07\x3f\x22\x00\xf1\xc4\x53\xb1\x1a\x4b\xad\x3e\x09\xe0\xd4\x24\x0c\x0e\x88\xd2\x02\x3f\x71\x96\xd3\xa9\xe6\x38\x13\x86\x24\xbd\xff\x6b\xfd\x39\x87\x48\x7e\x6b\xc5\x88\xe4\xb7\xd6\xfd\xfe\xb0\x22\xb9\xbf\xa6\x61\x45\x7f\xbe\x01\x96\x10\xec\xfc\x85\x09\xa0\xdb\x3e\xa2\x04\xe8\x8f\x0b\x44\x70\x5e\xa2\x08\x06\xd7\xc7\x93\xc0\xe0\x5a\x20\x80\x67\x9b\x0b\xc0\xbb\x62\x80\xaa\x96\x47\x4f\x6f\x54\x9d\x2a\x7d\xdd\xd0\xdd\xbb\x9f\x2f\xfe\x4d\xc9\x89\x67\xcd\xba\xbf\x27\x8f\xe9\x6a\x6c\x1f\xfa\x4d\xac\xf0\x17\x05\x2f\xf4\x5c\x12\x80\xfc\xde\x1f\x7f\xc2\xc6\x1f\xd8\xf8\x70\x16\x3e\xfe\x6e\x90\x23\x00\x53\x00\xe8\xda\x98\xfa\xd6\xe2\xcb\x44\xc1\x1a\x80\x89\xd8\x55\x00\xcc\x03\x01\xcc\xc9\x6c\xe9\xdb\x28\xa9\x3e\x9d\x5a\xe6\xcc\x79\xb2\x18\xf7\x8f\x0e\x33\x69\x1f\x4a\xc6\x99\x79\x72\x18\x4b\x20\x87\xf1\x06\x60\xf3\x98\xd0\xe4\x35\x95\x90\x39\xbd\x03\x28\x39\x1c\x28\x39\xa8\x29\x0f\x50\x24\x59\xbe\xd2\x96\x47\xd7\x26\xe5\x26\xdf\x04\xed\x7b\x8b\xd1\x5b\x67\xde\xfb\x2b\x6d\x7f\x94\x5a\xce\xf4\x5a\x9b\xab\xbf\x6a\xb6\x13\xf8\x7d\x46\x7e\xb1\x77\x49\xad\xf7\xe0\x4c\xb5\x3f\xfb\x4f\x52\xad\x14\x4f\x8d\x96\x7f\xc5\x5a\x20\xc1\xd0\xbb\xd2\x8a\x7e\xca\xd5\xe6\x0b\x83\xee\x4f\x66\xec\xd2\x69\x79\x5b\x18\x37\xb7\xb8\x37\x8c\x75\x7f\x62\xb8\x42\x07\x8f\x47\xbe\x9e\x47\xe8\xc6\xbb\xe9\x8d\xa4\x7d\x04\x91\x9f\x27\xff\xd9\xbc\x99\xe1\xdd\x7c\xeb\x7d\x85\x37\x9c\xe9\xe6\xd4\x58\xce\xb4\xa7\x46\x1e\x5a\xc8\x9f\x20\xad\xf9\x92\xee\x1f\x39\x8f\x07\x6b\xb2\x95\x83\xcb\x12\xea\x9f\xbf\x0d\xad\x45\xf7\x76\xfb\x8e\xed\xa4\x74\xdb\xd3\xdc\x6b\x6d\xc9\xaf\x26\x6e\x04\x95\x44\xe9\x95\xe6\x4d\x45\xfe\x7e\x96\x96\xf3\x59\x5f\xfc\x62\x1b\xef\xef\xcc\x69\x4e\xe7\xc2\xbd\x88\xeb\x5c\x7a\x33\x48\xcc\x40\xe3\xd7\x40\xc0\xa9\x97\x17\x8d\x5c\x30\x57\x3e\x9d\x8a\xc6\x93\xbf\xaf\x6f\xc5\x50\x26\x1e\x2b\x74\x14\x63\x75\xdb\xda\x62\x30\x73\xbf\xc4\x8d\x25\xf7\x22\xbc\x40\x48\xc2\x95\x99\xbe\x86\x67\x53\xd1\x6c\x26\x9c\x78\x57\xe1\xf6\x15\x7e\x6a\xad\xf8\x8d\x0d\x67\xcd\x74\xe9\xb8\x54\x2b\x17\x3a\x63\x02\x01\x3c\x4d\xdf\xb6\x18\xbd\xf1\x77\x93\x09\x6d\x35\x7f\x12\xac\xa7\x42\x4c\x7b\x5d\xea\x94\x28\xfb\xfb\x9c\x3f\x0f\x26\xbe\x32\x8c\x2f\x73\xd2\xcd\x4b\x8b\x83\x4f\x39\xee\xd3\xd0\x91\xfc\xe8\xfb\x07\xf7\xa7\x24\xe5\x1a\x5d\x3b\x1b\x53\xba\x38\x9a\x8c\xc2\x5b\x56\x36\xd9\x60\x56\x7c\x77\xdd\x36\x73\x18\x29\xac\xd9\xa6\x1e\x9f\x3c\xfa\xfe\x61\x9e\xd7\xf9\xf1\xe6\x53\x31\x40\x62\x7c\xfb\x74\xd3\x61\x73\xce\xf9\x7b\xf8\x76\xad\x64\x6d\x46\x0c\x5e\x59\xfe\xc3\xc1\x44\xf7\xe5\xc4\x55\x2c\x1d\xed\x83\xdf\x50\x82\x32\xb2\x7f\x3d\x38\x75\xef\x6b\x3f\x9d\x8d\x4c\xa0\xf7\x2e\x7d\x04\xdb\x32\xad\x2f\x67\x4a\x08\xfc\x28\xee\x0c\xca\x5a\x89\xaf\x2d\xf4\xe9\x67\xc6\xda\x82\x2f\x07\xa2\x9c\x84\x40\x9c\xdf\x65\x5a\xfd\x58\x39\x44\x1a\x9d\x7d\xe8\xf2\x1f\x94\xd8\x00\xcc\xdb\xc3\x5b\x0a\xff\x81\x37\x13\xb7\x14\x0e\x9e\x76\xb8\xbf\x95\x99\xbf\xfc\x3e\x85\xef\xe0\xd1\x86\xec\xee\x27\xfe\x15\xbe\xe1\x01\xdf\x30\xff\x2b\x9d\x1e\xff\x21\xbe\x60\xb5\x35\x9c\xf1\xfd\x0d\xfb\x92\x10\xdf\x1f\x16\x63\xa2\xad\x00\xb2\x84\xa8\x3f\xbd\xe5\x91\xc7\xc4\x07\x30\xf4\x8f\xf3\x00\xbb\xb0\x6f\xdc\xc4\x40\xe8\x9b\xfb\x6f\xfe\x1f\x38\x7d\x53\x5f\xec\xec\x03\x00")
 
 func monitoringBackendGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -144,7 +144,7 @@ func monitoringBackendGrafanaDashboard1JsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xeb\x6e\xdb\x38\x16\xfe\x3f\x4f\xc1\xe5\x76\x06\xed\x22\x6e\x62\x27\x69\xda\x00\xc5\xa2\x4d\xb7\xed\x60\xdb\xd9\x4c\xd2\x0e\x50\xb4\x85\x87\x91\x18\x9b\x88\x44\x6a\x48\x2a\x97\x06\xde\x67\x1f\x88\xba\x91\x12\xad\xd8\x8e\x2f\x8a\xcd\xfe\xa9\x7d\x28\x51\xe4\xf9\xce\xe5\x13\x79\x42\xdf\xfe\x04\x00\x00\x10\x51\xca\x24\x92\x84\x51\x01\x0f\x41\x2a\x04\x00\x06\x44\x48\x78\x08\xbe\x66\xdf\x41\xd1\xa2\x5a\xcf\x62\x12\xc8\x5f\x29\x3c\x04\xdd\x2d\x5d\xee\x23\x89\x04\x8b\xb9\x87\xe1\x21\x80\x9d\x0e\x78\xc7\xd1\x39\xa2\x08\x74\x3a\xd0\xb8\x10\x53\x74\x16\x24\x17\x49\x1e\x63\xa3\x65\x48\x7c\xab\x9c\x78\x8c\x1e\xb1\x80\xf1\xa4\x67\x3e\x38\x43\x8f\x77\xb6\x40\xaf\xdb\xdd\x02\xbd\xfd\xfd\x2d\xd0\x7d\x62\x3e\x80\xa2\x50\x8d\xe1\x55\x39\x3d\xf0\x0b\x78\x15\x60\x2e\x85\x79\xa5\xbc\x89\xd4\x95\x3e\x12\xc3\x33\x86\xb8\x0f\x8b\xd6\x51\xf6\xe9\xbb\xfa\x7f\x94\xde\x06\xb1\x4f\x64\x6d\xf4\x70\x40\xb1\xfc\xd5\x87\x87\x80\xc6\x41\x90\xcb\x38\x8a\x86\x9f\x18\x0b\x24\x89\xe0\x21\xd8\xc9\xc4\xc4\x2f\x15\x07\x03\x42\x2f\x12\xcd\x7f\xfd\x9e\x09\x22\x44\x71\x20\x34\xdd\x97\x9a\x87\x1e\x0b\x02\x14\x09\x9c\x74\x70\x8e\x02\xa1\xa9\x08\x0e\x38\xf1\x8f\x99\x0e\x62\xaa\xcf\x1a\x48\x57\xf0\x10\xf4\xf6\x0c\xd1\x75\x39\xba\x4c\x72\x93\x48\x4a\x45\x68\xcf\x49\x47\xdf\xd3\x24\xe5\x88\xbf\x6b\x52\x8e\x23\x8c\xa4\xa1\x90\x54\xdf\x44\x2a\xdd\xc1\xa3\xe3\xcf\xe0\xb3\x40\x03\x0c\xf5\xd6\x0c\x0d\xce\xae\x72\x1c\x8a\x87\x6b\x8a\x40\x01\x41\x42\x99\x83\x9a\xb0\x3e\xbe\x33\xa4\x64\x55\xf5\x24\xf0\x7e\xc0\x74\x20\x95\x42\x76\x2a\x2d\xd8\x7e\x8b\x6e\xcd\x8f\xb4\xaf\xda\x45\xe7\x24\x08\xaa\x3d\x26\xb2\x77\x1c\xf9\x04\x53\x69\x6a\xb6\x09\xa5\x83\xd9\x50\xea\x36\xa0\xa4\x09\x02\x3c\xc0\xd4\xaf\x3e\x17\x5d\x0e\xea\x13\x4f\x0c\x2d\xe6\x3c\x1d\x7c\xbd\x2d\x44\xd7\x76\x39\xa1\x56\xb9\x18\xb2\x2b\x9b\x47\x4b\x26\x51\x60\xbd\xe3\x12\x05\x71\x89\x88\x75\x7e\x01\xa1\xea\x0a\xb3\x57\x25\xbe\x22\xbe\x02\x79\xc7\x94\x5f\xd4\x2c\x34\x31\xcc\x63\x46\xa8\xfc\xc8\x54\xcc\x51\x02\x80\x04\xf8\x81\x39\xd3\x31\x66\x51\x35\x3e\x82\xdc\x3e\x3e\x14\x1d\x5b\x87\x19\x61\xee\x61\x2a\x13\x23\xaf\xdb\x57\x94\x3c\x3b\x31\x93\x38\xe9\x61\xbf\xda\x62\x33\x49\x8e\xa9\x8f\x39\x56\x41\xf0\x3c\x60\x52\x1f\xa5\xc0\x9c\x60\xf1\xbf\x4b\xcc\x39\xf1\x71\x6d\xb6\x22\x42\x1e\xb6\x7b\x80\x90\xc8\xbb\xa8\x2b\x53\x48\x1c\x45\xd8\xff\x40\xa8\x6d\xf4\x12\xf1\x01\x96\xc2\x48\x12\x66\x9a\x48\x62\xe5\x75\xa4\xc6\x2a\xe2\xf0\x31\x65\x3e\xee\x27\x71\x59\x8d\xa4\x1f\x31\xbf\xef\x31\x2a\x11\xa1\x98\x1f\x16\x9f\xfa\x5e\x14\xf7\xe3\x24\x2c\xf4\x05\xf6\x18\xf5\x45\x5f\x19\xca\xe1\xed\x2d\x78\x7a\x1a\x87\x27\x48\x62\x30\x1a\xdd\x7a\x41\x2c\x24\xe6\x2f\xbf\xc1\x47\xd9\xc7\x6f\x70\x0b\x14\xdd\x27\xf2\xe2\xcb\x37\x38\x7a\x02\xce\x6e\xc0\xe3\x88\xf9\x66\x9e\x48\x9c\x95\xf1\x50\xc5\x29\x28\x49\x98\x3c\x34\xd1\x62\xf5\x22\x42\x25\xe6\x97\x28\x78\x8b\x3c\xa9\x52\x50\xaf\x72\x41\xea\x5e\x6f\x8b\xbe\x6e\x6f\xff\xbc\xbd\x8d\x98\x3f\x1a\xfd\x39\x1a\x55\x7b\x4b\x2f\x4e\x4c\xa7\x16\x1e\x33\x94\xcf\x55\x2a\x81\xaf\xaa\x77\x26\x90\x28\xf4\x34\xf1\xa8\xf8\xac\xc3\x2d\x87\x1c\x8b\x21\x0b\xfc\x9a\x21\x24\xd3\x7c\xcb\x59\x68\x0b\xcd\x21\x3e\xc1\x83\xcc\xd6\x6b\x37\x9d\x0e\xc9\xf9\xf4\x01\xbd\x48\x7f\xb7\x66\x50\x40\xdc\x96\xc6\x92\x26\xc6\x65\x2d\xd2\xa9\x90\xd0\xcf\xb3\x03\xa1\x3e\xb9\x24\x7e\x8c\x02\x68\x75\xbb\xfc\x3a\x95\x80\xf5\xd1\x5c\xa3\x6b\x52\xf3\xe3\xb3\xd8\xbb\x48\x2d\xb9\x8a\x05\x0c\xb3\xc8\x90\x4c\xdf\x4a\x30\x6a\x77\x8c\x8b\x76\x45\x4c\x1b\x13\x29\x6e\xd0\x35\xbe\xc3\x99\x4a\x4b\x15\xc3\x44\x47\x55\xab\x42\x67\x38\xb0\x1b\x54\xc0\x06\xaf\x91\xc0\x55\x2a\x50\x04\x73\xcb\x2d\x69\x34\xdf\xa9\xda\x5f\x39\x3b\xdd\x02\xb7\x5a\x35\x68\x4b\x43\x36\x6e\x33\x9f\x8c\x73\x9d\x1b\x9b\x91\xa0\x80\x0c\xec\xf9\x4d\xb5\x7c\xc0\x97\xc5\x3c\x6a\xfc\xd1\xc6\x60\x96\x4b\xe5\x9e\x37\x90\x84\xdd\xf9\x50\xb9\xdf\x63\x26\xd1\x52\xa8\x9c\xc7\x82\x38\xac\x87\xa8\x45\x33\x3c\x5d\xc4\xa8\x3c\x25\x3f\xd4\x5d\xdd\x9d\x9d\x9f\xe1\x42\x19\xde\x8b\xf1\xe0\xf5\x36\x9a\xe1\x75\x17\xc1\xf0\x0c\xfa\x86\x06\x38\xc3\xb9\x62\xfa\x4b\x25\x76\x1e\x67\xca\x04\xab\xfc\xec\x0e\xc2\x37\x64\x57\xef\x31\xf2\x55\xa7\xd5\x5b\xd3\x1c\x6b\x58\x8a\xc7\x82\x9a\xf9\xf9\x58\x78\xd5\x78\x3f\x9a\x8e\x54\x56\x67\xda\xcc\x2a\x85\xbc\x09\xee\xca\x83\x2a\x62\x24\x8a\xfa\x54\xcd\xca\xa9\x4b\xe3\x92\x86\x7d\xf9\xf2\xe5\x4b\xe7\xe3\xc7\xce\x9b\x37\xe0\xfd\xfb\xc3\x30\x3c\x14\x35\x6a\x17\x21\x29\x31\xa7\xe3\xfa\xcb\xa3\xd8\x90\xf8\x3e\xa6\x70\xc2\xac\x57\x0c\xd1\xc6\x89\x72\x6d\x33\x9e\x99\xa5\x25\x61\x79\x79\x40\xd4\x21\x9d\x6d\x82\x3e\xf6\x48\x88\x54\x54\xaf\xb1\xd6\x94\x82\xd6\xfc\x33\x6b\x2a\x97\x2e\xe0\x1b\x4e\x82\x00\xf8\xec\x8a\xd6\x32\x38\xa1\x17\x9f\x79\x62\x3a\xb0\x41\xb5\x7f\x24\x0e\x0f\xfe\x59\x23\xb4\x63\x69\xaa\xa1\x7c\x1a\x87\x67\x98\x57\xef\x8d\x29\xd1\x88\xc5\x2c\xc8\x9c\xe0\xbf\x62\x2c\x64\x4d\x65\x9b\x09\xce\xeb\x76\x82\x03\x7e\x76\xf0\x24\xf0\x1c\x2d\x02\x9e\x2c\x8f\xa9\xaf\xb3\x80\xf4\x81\x84\xc4\xf9\x4f\x0a\xd0\x9b\x76\xf9\x4f\x0a\x8d\xf3\x9e\x14\x9c\xff\xb4\xcd\x7b\x8e\x99\xdf\x66\x64\xaa\x64\x7e\x46\x60\x9e\x6e\xfb\xdb\xb7\xb7\xe0\xe9\x6f\xf9\x5a\x1c\x18\x8d\x6a\x82\xce\xae\xf0\x50\x80\x3b\x17\xf1\x19\xe6\x14\x4b\x2c\x3a\x1e\x0b\xa3\x58\xe2\x0e\xc7\xe9\x0b\x99\xe8\x44\xcc\xff\xf7\x25\xe2\x9d\xf2\x25\xed\xa5\xf6\xc2\xf6\x4b\xd2\x94\xaf\x09\xe6\x2b\x82\x4a\x58\x2e\x09\x96\x0b\x82\xaa\x21\x62\xfe\xcb\x47\xfd\xbe\x87\x83\xa0\xc1\x7c\xa2\x3a\x4c\xab\x73\xeb\x56\x1a\x8c\xa6\xac\xed\xa7\xff\xda\x9e\x4d\x5b\x42\x72\x42\x07\x93\x6b\xab\xf8\x6c\x2c\x52\xae\xdf\x92\xb4\xda\x68\xac\x34\x13\x2a\x24\x52\xaf\xee\x16\x27\x9d\x7a\xa5\xba\xda\xfb\x94\x8b\xcf\x0d\x66\xab\x6b\x3a\x71\x6d\x53\xbf\xfd\xdc\xb5\xfb\x3c\x23\x7a\x4a\xcf\x1e\xe3\x58\xac\x9b\x2a\x6b\xcc\xfa\x3e\xaa\x6c\x89\xd1\x82\x6d\xe0\x80\xad\x71\xf2\x45\xf9\x48\xa0\xc8\xdc\xfa\x2a\xb2\xc6\x9d\x37\xc3\x43\xd6\x1d\xd6\x1a\xeb\x5e\xfc\x06\xe6\x24\xbb\x94\xf5\xbd\x8a\x95\xec\x52\x72\x44\x45\x82\x93\x0d\xa5\x82\x19\xd5\x1a\xdc\x1e\x66\xa5\xc5\xed\x61\xa6\xff\x72\x50\x56\xbf\xdd\xd8\x7d\x66\xb5\x8f\x74\xbf\x51\xeb\x60\xd6\xfd\xc6\x8f\x38\x64\xfc\xc6\x55\x8f\x69\x48\xcd\x5a\x3d\x76\x30\x1e\x29\x7d\x63\x78\xf3\x36\x17\x5d\xf9\x58\xbb\xca\xc7\x4a\xf6\x14\x2a\xef\xef\x5f\x31\x7e\x41\xe8\xa0\x2f\xb0\xec\x9f\xdd\xc8\xa9\xc9\xd3\x16\x28\xba\xfc\xc7\xcb\x6f\xd0\x15\x89\x2d\xa5\x48\x4c\x0f\xdd\xe0\xf1\xd5\x36\x03\x1e\xf2\x86\xf8\xc9\xea\xd9\xd8\x9a\xd7\x8c\x29\x17\x71\x7c\xcb\xd5\x8c\x4d\x4a\x0d\x7a\x7b\x0d\x24\x6e\x7f\x6e\x24\xce\xd5\x8d\xdd\x09\xe0\x8c\xdc\xae\xb7\x3f\x1e\xc0\xbd\x8d\xe6\x76\xae\x70\xcc\x15\x8e\xb5\xbc\x70\x6c\xcc\x2b\x6e\xae\xf0\xd5\x6f\xfb\xad\x47\xed\x58\xca\x8a\x66\x04\xc7\x95\x8f\x2d\xbc\x7c\x6c\x2e\xf8\xb8\x1a\x98\x96\x56\x90\x65\x38\xb9\x22\xb2\x05\x17\x91\xdd\xcb\x8b\x5c\x1d\x59\xab\xeb\xc8\xcc\x05\x95\x93\xd3\xd3\xda\xe2\xd5\x66\x02\xf5\xb6\x75\x8e\x94\x41\x74\x54\x5d\xef\xda\x60\x90\xde\xb5\x15\xa4\xd3\x2b\x14\x39\x8c\x14\x46\xef\xdb\x83\x91\xab\x99\x75\x35\xb3\xd3\xda\x4c\x2b\x0d\xe6\x01\xd7\xcc\xce\x7f\x1f\x6e\xa6\x6d\xb8\x8d\x2a\x8c\xcd\x34\x3d\x8b\x76\x5b\xaf\xcd\xb9\xd6\xc6\x2e\xd3\x38\xa7\x29\x80\x35\x00\x7c\xb0\x50\x2d\xbb\xda\x75\x9d\xcd\x7e\xae\x05\xaf\x2d\x33\x7b\x1b\x7c\x0f\x16\xa8\xc9\x4a\x58\x67\x05\x8a\x8b\xb5\x4f\x97\xb5\x17\xf1\xb9\x2a\x50\x55\x8f\xac\xbb\x0a\x6b\xaf\xc9\x73\x55\xa1\xb8\x42\xd1\xba\x6b\xb0\xf6\x12\xbb\xfa\x42\xf4\xb1\xf5\x0f\xae\x16\xdd\x98\xb6\xab\x45\x5f\xf1\xa0\x1f\x76\x2d\xfa\x6e\xcf\x6a\x1f\x69\x19\xd3\x33\x4d\x32\x63\x19\xd3\x6f\x58\x26\x6c\x6b\x2d\xca\xd0\x27\x03\x62\xc6\x72\xa4\xdd\xdd\xf1\x40\xe8\xa5\x2e\xae\x1c\x69\xfe\xe5\x48\x0f\xaa\x8e\x7c\xb3\x0b\x7f\x8e\x52\x83\x06\x27\xd8\xc3\xe4\x12\x83\xd7\x88\xfa\xa9\xa1\x54\x9e\xd3\x8a\x85\xcc\xf5\xa8\x02\x7a\x1d\x4d\xbf\x17\x91\xe3\xf4\x29\x61\x4e\x21\x91\x0e\xa8\x25\x94\x03\xcd\x02\x94\xfa\x3b\x5e\x76\x9e\x3b\x94\x0f\x8e\x51\xca\x4f\x1d\x4c\x0b\xab\x09\xba\x07\x4c\xb9\x3f\x49\x87\xd4\x12\x2a\x83\xee\x83\x54\xd5\xa1\xc0\x1b\xce\x92\x44\xed\xe0\x5a\x5c\xa1\xd0\x7c\x1d\xcb\x21\xb6\xf0\x8a\xa1\x59\x10\x5b\xa3\x32\x07\x20\x19\x88\x98\x5f\xff\xc3\x33\x57\xee\xe0\xca\x1d\xa6\xd3\xd6\x6a\xca\x1d\x08\x47\x12\x6b\x6b\xe6\x34\x5d\xee\xe9\xf3\x34\xf9\xa5\xbb\x5b\xe9\xc1\x31\x77\xae\xa1\xff\xbf\xb2\xf5\xf5\xf5\x51\xbe\xc0\xfd\xfd\x49\xdb\x17\xd0\xe7\x5a\xf4\x30\x4e\xa9\x32\x4b\x51\x1b\xa3\xd5\xb9\x16\x3f\xdc\x65\xaa\x51\x9a\xf4\x37\x40\xad\x73\x2d\x54\xb8\xd3\x58\x37\x47\xaf\x73\x2d\x5a\x98\xd4\x5c\xfd\x94\xa3\x6e\x80\x7a\xe7\x5a\x6a\x30\xb1\xd9\x6e\x8e\x7e\x27\xab\x44\xb0\xf3\x86\x45\x9d\x46\x96\xad\x22\x66\xbb\x48\x6d\xf9\xfd\x24\xb7\x1b\xec\x76\x83\xd7\x72\x37\x78\xaf\xe9\x47\x2d\xb5\x8d\x4d\xb7\x1b\x6c\xf6\x38\xff\xed\xe0\xbd\x86\x1f\xae\xd4\xb7\xe5\x37\x6f\x3b\x78\x21\x27\x8f\x3d\xdc\xed\xe0\xc5\x1e\x2b\xd6\xaa\xf7\x7b\x77\xb6\xd8\xdd\x8c\xa9\x69\x5f\xdc\x9d\x1c\xa6\x4f\x60\x5e\x7c\xe8\x75\xe4\xce\x0d\x5b\x53\x36\xd4\xf4\xbb\x90\xcf\x1d\x1b\x5a\x22\x1b\x6a\xf8\x91\xc7\x03\xc7\x86\x4a\xb9\x63\x43\xab\x60\x43\xab\x59\x98\x77\x74\xe8\x6e\x3a\xd4\x58\x7e\xe6\xf8\x90\x3e\x01\xc7\x87\x1c\x1f\xca\x45\xf6\x2c\xbc\xdf\x74\x6e\xfd\x0b\xc7\x87\x96\xc7\x87\xf6\x1b\xce\xa5\x7f\xee\xf8\x50\x29\x77\x7c\x68\x95\xab\x43\x4b\xde\xfa\x75\x84\x68\x82\xf5\xa1\xbb\xcb\xbd\x1d\x2d\xd2\x27\xe0\x68\x91\xa3\x45\xb9\xc8\x9e\x8c\x9f\x35\x9c\x04\xdf\xdb\x71\xb4\x68\x79\xb4\xe8\x59\xc3\x91\xee\x2f\x1c\x2d\x2a\xe5\x8e\x16\xad\x74\x99\xc8\xf1\xa2\xd6\xf2\xa2\xe6\xbf\xaf\x72\xd4\x48\x9f\x80\xa3\x46\x8e\x1a\xe5\x22\x7b\x42\x3e\x68\x38\x5d\xa2\xd7\x75\xd4\x68\x79\xd4\xe8\xa0\xe1\x78\x09\xe3\xd1\x8e\x1b\x39\x6e\xd4\x82\x25\xa3\x25\x97\x5d\x3b\x8a\x34\xfb\xd2\x91\xe5\xcf\x64\x1d\x4f\xd2\x27\xe0\x78\x92\xe3\x49\xb9\xc8\x9e\x9d\x9f\x37\xd4\x5d\xf7\x7a\x8e\x27\x2d\x8f\x27\x3d\x6f\xa8\xbb\xee\x76\x1d\x4f\x2a\xe5\x8e\x27\xb5\x62\x0d\xc9\x11\xa5\xd6\x12\xa5\xc9\x8e\x14\x71\x5c\x49\x9f\x80\xe3\x4a\x2d\xe7\x4a\xea\xff\xcc\x57\x12\x2f\x4c\x5c\x08\xaa\x1f\xec\xcd\x15\x0e\x85\x37\xc4\x21\xfa\x03\x73\x41\x18\xd5\x6a\xa5\xd3\xc3\x08\x93\x8b\x7d\x54\x90\x11\x28\xd1\x40\x47\x15\x6a\x67\x98\x84\xe4\x9a\x94\x87\xd6\xc0\xf4\x90\x13\x68\x8c\x40\xe2\x30\x0a\x90\x24\x74\xa0\xd9\x2c\x0c\x88\x90\x86\xa5\x98\xf4\x80\x28\x93\x35\xdd\x87\x50\x2f\x88\x7d\xfc\x2a\xb0\xa7\xd0\x71\x38\xc0\x30\x0e\x24\xb1\xde\x92\xd9\x3f\xb4\xd2\x19\x23\x0b\x9a\x87\x7a\xc0\xbf\x62\xcc\x13\x2e\x02\x23\xce\x42\x2c\x87\x38\x36\x6d\x59\x53\x7b\xb7\x22\x1f\xe0\xeb\xda\xdf\xf8\x42\x71\x41\xa2\xcf\x3c\x38\xbd\xa1\x9e\x75\xa0\x79\x2c\xd0\x06\x6a\x73\xc0\xca\x8f\x77\x07\xea\x5c\x20\x9b\x4a\x26\xa1\x73\xea\x44\x94\x73\x42\x89\x4c\x6d\xa4\x32\xe4\x0c\xa2\xde\x2c\x10\xc1\x2c\xf3\xc1\x29\x71\xb2\xde\x76\x37\x48\xea\xb1\xfd\x34\x78\x95\xe7\xfe\x13\x7a\xce\xb6\x40\xd6\xe5\x93\x85\xc2\x97\x65\x03\x53\x57\x12\x0d\x14\x3e\xe2\xf7\x7c\x9c\xb0\xda\x6e\x99\x54\x22\x1d\x77\x43\x66\x24\xe9\xbc\x8d\xa6\x58\xe0\x4f\x69\x77\x63\xf9\xe5\xc4\xb6\x53\x12\xe5\x4a\x9c\xb4\x0e\x58\x05\x80\xeb\x8c\x4d\x98\x47\x22\x55\x03\xe9\x65\xf6\xc4\xfa\x85\xe3\x02\xf5\xbd\xe2\x04\x2c\x58\xd6\xb4\x66\x38\xe6\x46\xcd\x10\x8d\x89\x99\x6a\x52\xcc\x36\xc0\x9e\x54\xcc\xa1\x7e\x5c\xc0\xe4\x0a\x9b\x54\x65\x7a\x92\x30\xe8\x95\xee\x23\x8d\xcf\x9a\x38\x3e\x79\xb1\x90\x2c\xbc\x5f\x6c\x42\xb1\x64\xd6\x87\x24\x0d\x7d\x8f\xc5\xca\xf6\x76\x77\xea\x6d\x69\xee\xd4\xf2\x5c\xd6\x68\x46\xbb\x71\x21\xfb\x3e\x01\x6d\x86\x9c\x93\x93\xf1\x05\xdb\xd0\x5e\xf5\x34\x57\xdd\x6a\xf6\x86\x53\xdb\x49\xa5\x3f\x2d\x52\xf6\xe6\x18\x29\xbb\xcb\x89\x94\x76\x0c\xc6\x06\xcb\xec\x53\x4a\xb4\x46\x39\xbd\x21\x0a\xcf\x82\xd8\x9c\xa7\x2f\x25\x90\xb2\xab\x4e\xb7\x54\x16\x54\x46\x9d\x48\x61\xed\xf6\x88\x78\x17\xea\x1d\xb8\xe8\x24\xd3\x6a\x3f\x1f\xa0\x69\x10\x70\xdf\xd8\xf1\x36\xed\x1d\xee\x9a\x5f\xbb\xa1\xfe\x6d\xdf\xf8\xd6\x35\xbf\xee\xee\x98\xad\xc6\x5b\x47\xcf\xf8\xd6\xf5\x73\xcb\xf9\x5e\xce\x30\x79\xf3\xb4\x19\x70\xf3\x53\xcd\xc7\x3c\x33\x1f\x63\x3e\xb5\xb7\x67\x7e\x35\x5e\xd2\x0e\x7c\x73\x2e\xe5\x08\x6b\x0a\xff\xc1\xd4\x12\x00\x2c\x08\x6e\xfe\x5e\x58\x8d\x82\x60\x1b\xa4\x94\x16\x6c\x83\xff\x16\xac\x17\x6c\x83\xa3\xf4\xec\x3e\x70\x92\x9f\xdd\x07\xb6\x41\x79\xe3\xe3\x63\xe6\x8b\x9c\x52\xc0\xcb\x92\x66\xff\x34\xfa\x3b\x00\x00\xff\xff\xf8\xa0\xda\xa3\xb6\xb6\x00\x00")
+var _monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\x6b\x6f\xdb\x38\x16\xfd\x3e\xbf\x42\xab\xed\x0c\xda\x45\xdc\xc4\xce\xab\x53\xa0\x58\xb4\xc9\x76\x3a\xd8\x76\x36\x93\xb4\x05\x16\x6d\xe1\x61\x24\xc6\x26\x22\x89\x1a\x8a\xca\xa3\x81\xe7\xb7\x0f\x49\xbd\x48\x91\x56\x6c\xc7\x0f\xc5\x66\x3e\xb4\xd6\xa5\x44\x91\xf7\xdc\x7b\x79\x48\x5e\xd3\x77\x3f\x38\xec\xcf\x05\x51\x84\x29\xa0\x08\x47\x89\xfb\xd2\xb9\x13\x42\x26\x0e\x50\x42\xd9\xf5\x97\xfc\xda\x29\x4b\x44\xe9\x79\x8a\x02\xfa\x6b\xc4\x6e\xe8\x6e\xc9\x72\x1f\x50\x90\xe0\x94\x78\x90\x15\xb9\x9d\x8e\xf3\x0b\x01\x17\x20\x02\x4e\xa7\xe3\x2a\x37\xc2\x08\x9c\x07\xfc\x26\x4a\x52\xa8\x94\x0c\x91\x6f\x94\x23\x0f\x47\x47\x38\xc0\x84\xd7\x4c\x06\xe7\xe0\xe9\xce\x96\xd3\xeb\x76\xd9\x3f\xfb\xfb\x5b\x4e\xf7\x99\xfa\x82\x08\x84\xa2\x0d\xaf\xab\xee\x39\x3f\x39\xaf\x03\x48\x68\xa2\xde\x49\x6f\x63\x71\xa7\x0f\x92\xe1\x39\x06\xc4\x77\xcb\xd2\x51\xfe\xe9\x9b\xf8\x7f\x94\x3d\xe6\x42\x1f\x51\xad\xf5\xee\x20\x82\xf4\x57\x9f\xc9\xa2\x34\x08\x0a\x19\x01\xf1\xf0\x23\xc6\x01\x45\x31\x2b\xd9\xc9\xc5\xc8\xaf\x14\xc7\x14\x1d\x5d\x72\xcd\x7f\xf9\x96\x0b\x62\x10\xc1\x20\x91\x74\x5f\x69\xde\xf5\x70\x10\x80\x38\x81\xbc\x82\x0b\x10\x24\x92\x8a\xd8\xcb\x90\x7f\x82\x65\x10\x33\x7d\x6a\x20\x5d\x33\x49\x6f\x4f\x11\xdd\x54\xad\xcb\x25\xb7\x5c\x52\x29\x42\x7a\x4f\xd6\xfa\x9e\x24\xa9\x5a\xfc\x4d\x92\x12\x18\x43\x40\x15\x85\x64\xfa\x46\x54\xe8\xce\x3d\x3a\xf9\xe4\x7c\x4a\xc0\x00\xba\x72\x69\x8e\x06\xc1\xd7\x05\x0e\xe5\xcb\x25\x45\x80\x00\x81\x44\x98\x83\xe8\xb0\xdc\xbe\x73\x20\x64\x75\xf5\x70\x78\xdf\xc3\x68\x40\x85\x42\x76\x6a\x25\xd0\xfc\x88\x6c\xcd\x4f\xa4\x4b\xe9\xa6\x0b\x14\x04\xf5\x1a\xb9\x8c\x59\xbe\x8f\x60\x44\x55\xcd\x36\xa1\x74\x38\x1b\x4a\xdd\x06\x94\x24\x41\x00\x07\x30\xf2\xeb\xef\x05\x57\x03\xbd\xe3\xdc\xd0\x52\x42\xb2\xc6\xeb\x65\x21\xb8\x31\xcb\x51\x64\x94\x27\x43\x7c\x6d\xf2\x68\xca\x1c\x33\x30\x3e\x71\x05\x82\xb4\x42\xc4\xd8\x3f\xe6\x36\xe2\x0e\xb5\x56\x21\xbe\x46\xbe\x00\x79\x47\x95\x5f\x6a\x16\xca\x0d\xf3\x04\xa3\x88\x7e\xc0\x22\xe6\x08\x81\x03\x12\xe7\x3b\x24\x58\xc6\x18\xc7\xf5\xf8\x28\xc4\xdc\x20\xde\x97\x15\x1b\x9b\x19\x43\x66\x2e\x11\xe5\x46\xae\xdb\x57\xcc\xdf\xcd\xcd\x24\xe5\x35\xec\xd7\x4b\x4c\x26\xc9\x40\xf1\x21\x81\x22\x08\x5e\x04\x98\xca\xad\x4c\x20\x41\x30\xf9\xdf\x15\x24\xcc\xc6\xa0\xd6\xdb\x24\x06\x1e\x34\x7b\x40\x42\x81\x77\xa9\x2b\x33\xa1\x30\x8e\xa1\xcf\xba\x68\x6a\x3d\x05\x64\x00\xa9\x1c\xa8\xf8\x9f\xac\x20\x1e\x2b\x6f\x62\xd1\xd6\x24\x0d\x9f\x46\x4c\xcb\x7d\x1e\x97\x45\x4b\xfa\x31\xf6\xfb\x2c\xa6\x53\xc0\xaa\x27\x2f\xcb\x4f\x7d\x2f\x4e\xfb\x29\x0f\x0b\xfd\x04\x32\xa9\x9f\xf4\x85\xa1\xbc\xbc\xbb\x73\x9e\x9f\xa5\xe1\x29\xa0\xd0\x19\x8d\xee\xbc\x20\x65\xed\x23\xaf\xbe\xba\x4f\xf2\x8f\x5f\xdd\x2d\xa7\xac\x9e\xcb\xcb\x8b\xaf\xee\xe8\x99\x73\x7e\xeb\x3c\x65\xef\x54\xc7\x09\xee\xac\x98\x84\x22\x4e\xb1\xc8\x14\xf2\x97\x72\x2d\xd6\x6f\x62\x70\x40\xc2\xcc\xf2\x2d\xf0\xa8\x18\x82\x7a\xb5\x1b\x32\xf7\x7a\x5b\xd6\x75\x77\xf7\xc7\xdd\x1d\x7b\xdd\x68\xf4\xc7\x68\x54\xaf\x2d\xbb\x99\x9b\x8e\x16\x1e\x73\x94\x2f\xc4\x50\xe2\xbe\xae\x3f\xc9\x21\x11\xe8\x49\xe2\x51\xf9\x59\x86\x9b\x0e\x09\x64\x9e\x17\xf8\x9a\x21\xf0\x6e\xbe\x25\x38\x34\x85\xe6\x10\x9e\xc2\x41\x6e\xeb\xda\x43\x67\x43\x74\x31\x7d\x40\x2f\x87\x3f\xc5\x77\x92\x21\x20\xa6\x61\x8c\x17\x61\x52\x8b\x9a\x45\x48\xe8\x17\xa3\x03\x8a\x7c\x74\x85\xfc\x94\xc5\x0f\xa3\xdb\x15\xf7\x89\x01\x58\x6e\xcd\x0d\xb8\x41\x9a\x1f\x9f\xa7\xde\x65\x66\xc9\x75\x2c\xdc\x30\x8f\x0c\xbc\xfb\x46\x82\xa1\x3d\x31\x2e\xda\x95\x31\x6d\x4c\xa4\xb8\x05\x37\xf0\x1e\x67\xaa\x2c\x95\xbd\x84\x50\xcd\xaa\xc0\x39\x0c\xcc\x06\x15\xe0\xc1\x1b\x90\xc0\x3a\x15\x28\x83\xb9\xe1\x91\x2c\x9a\xef\xd4\xed\xaf\xea\x9d\x6c\x81\x5b\xad\x6a\xb4\xa1\x20\x6f\xb7\x3a\x9e\x8c\x73\x9d\x5b\x93\x91\x30\xc6\x31\x30\x8f\x6f\xa2\xe4\x3d\xbc\x2a\xfb\xa1\xf1\x47\x13\x83\x59\x2e\x95\x7b\xd1\x40\x12\x76\xe7\x43\xe5\x7e\x4f\x59\x98\x5e\x0a\x95\x63\xaa\x4b\x43\x3d\x44\x2d\x9a\xe1\xc9\x22\x36\x5a\x9d\xa1\xef\xe2\xa9\xee\xce\xce\x8f\xee\x64\xe0\xcd\xc8\xf0\x7e\x1e\x0f\x9e\x4c\xc3\x37\x8f\xe1\x75\x55\xf9\x7c\x18\x9e\x42\xdf\xd8\x60\x96\xe3\x5c\x33\xfd\xa5\x12\x3b\x8f\x60\x61\x82\x75\x7e\x76\x0f\xe1\x63\xa8\xbc\x83\xc0\x17\x95\xd6\x1f\xcd\xc6\x58\xc5\x52\x98\x5b\x69\xe6\xc7\xaa\xf5\xea\xf1\x5e\x56\xd0\x04\xa4\xb2\xde\xd3\x66\x56\x99\xd0\xdb\xe0\xbe\x71\x50\x44\x0c\xae\xa8\x8f\xf5\x51\x39\x73\x69\x58\xd1\xb0\xff\xb3\xbf\xce\x87\x0f\x9d\xe3\x63\xe7\xdd\xbb\x97\x61\xf8\x32\xd1\xa8\x5d\x0c\x28\xe3\x76\xd1\xb8\xfa\x8a\x28\x36\x44\xbe\x0f\x23\x57\x2a\x6c\x1a\xf5\xca\x26\x9a\x38\x51\xa1\x6d\x4c\x72\xb3\x34\x0c\x58\x5e\x11\x10\x65\x48\x67\xeb\xa0\x0f\x3d\x14\x02\x11\xd5\x35\xd6\x9a\x51\x50\xcd\x3f\xf3\xa2\x6a\xe9\xc2\x3d\x26\x2c\x0a\x3a\x3e\xbe\x8e\xb4\x11\x9c\xdd\xf8\x89\x70\xd3\x71\x1b\x54\xfb\x99\x3b\xbc\xf3\x4f\x8d\xd0\x8e\xa5\xa9\x8a\xf2\xa3\x34\x3c\x67\x66\x5c\x2b\x4d\x23\x24\x11\x8b\x59\x90\x39\x85\x7f\xb2\x30\x44\x35\x95\x6d\x26\x38\x6f\xda\x09\x8e\xf3\xa3\x85\x87\xc3\x73\xb4\x08\x78\xf2\x71\x4c\x5c\xce\x02\xd2\x7b\x14\x22\xeb\x3f\x19\x40\xc7\xed\xf2\x9f\x0c\x1a\xeb\x3d\x19\x38\xff\x69\x9b\xf7\x9c\x60\xbf\xcd\xc8\xd4\xc9\xfc\x8c\xc0\x3c\xdf\xf6\xb7\xf9\xca\xdd\x6f\xc5\x5a\x9c\x33\x1a\x69\x82\xce\x6e\xe2\x81\x00\x76\x2e\x53\xa6\xeb\x08\x52\x98\x74\x3c\x1c\xc6\x29\x85\x1d\x86\x91\x98\x90\x25\x9d\x18\xfb\xff\xbe\x02\xa4\x53\x4d\xd2\x5e\x49\x13\xb6\x9f\x78\x51\xb1\x26\x58\xac\x08\x0a\x61\xb5\x24\x58\x2d\x08\x8a\x02\x56\xe1\xab\x27\xfd\xbe\x07\x19\xb7\x1e\x6f\x3e\xb1\x0e\xd3\xea\xdc\xba\x95\x06\x23\x29\x6b\xfb\xf9\xbf\xb6\x67\xd3\x56\x42\x09\x8a\x06\x93\x6b\xab\xfc\xac\x2c\x52\xae\xdf\x92\xb4\xd8\x68\xac\x15\xa3\x88\xcd\xad\xc4\xd4\xdd\xe0\xa4\x53\xaf\x54\xd7\x6b\x9f\x72\xf1\xb9\xc1\x6c\x65\x4d\x73\xd7\x56\xf5\xdb\x2f\x5c\x9b\x7d\xc8\x88\x9e\xd0\xb3\x87\x99\x7c\xdd\x54\xa9\x31\xeb\x87\xa8\xb2\x25\x46\xeb\x6c\x3b\x16\x58\x8d\x93\x2f\xca\x47\x02\x41\xe6\xd6\x57\x91\x1a\x77\xde\x0c\x0f\x59\x77\x58\x35\xd6\xbd\xf8\x0d\xcc\x49\x76\x29\xf5\xbd\x8a\x95\xec\x52\x12\x10\x25\x1c\x27\x13\x4a\x25\x33\xd2\x0a\xec\x1e\x66\xad\xc4\xee\x61\x66\x7f\x05\x28\xab\xdf\x6e\xec\x1e\x18\xed\x23\xdb\x6f\x94\x2a\x98\x75\xbf\xf1\x03\x0c\x31\xb9\xb5\xd9\x63\x12\x52\xb3\x66\x8f\x1d\x8e\x47\x4a\xde\x18\xde\xbc\xcd\x45\x9b\x3e\xd6\xae\xf4\xb1\x8a\x3d\x85\xc2\xfb\xfb\xd7\x98\x5c\xa2\x68\xc0\xc8\x1b\xed\x9f\xdf\xd2\xa9\xc9\xd3\x96\x53\x56\xf9\x0f\x56\x64\x93\xc4\x96\x92\x24\x26\x87\x6e\xe7\xe9\xf5\x36\x76\x3c\xe0\x0d\xe1\xb3\xd5\xb3\xb1\x35\xcf\x19\x13\x2e\x62\xf9\x96\xcd\x19\x9b\x94\x1a\xf4\xf6\x1a\x48\x9c\x32\xdc\x3c\x88\xc4\xd9\xbc\xb1\xac\x6c\xfe\xdc\xae\xb7\x3f\x1e\x40\x99\x84\x6f\x1e\xb7\xb3\x89\x63\x36\x71\xac\xe5\x89\x63\x63\xa6\xb8\x85\xc2\x57\xbf\xed\xb7\x1e\xb9\x63\x19\x2b\x9a\x11\x1c\x9b\x3e\xb6\xf0\xf4\xb1\xb9\xe0\x63\x73\x60\x5a\x9a\x41\x96\xe3\x64\x93\xc8\x16\x9c\x44\xf6\x20\x2f\xb2\x79\x64\xad\xce\x23\x53\x17\x54\x4e\xcf\xce\xb4\xc5\xab\xcd\x04\xea\x6d\xeb\x1c\x29\x87\xe8\xa8\xbe\xde\x55\xa1\xb0\x71\x20\xfd\xd2\x56\x90\xce\xae\x41\x6c\x31\x12\x18\xbd\x6b\x0f\x46\x36\x67\xd6\xe6\xcc\x4e\x6b\x33\xad\x34\x98\x47\x9c\x33\x3b\xff\x7d\xb8\x99\xb6\xe1\x36\x2a\x31\x36\xd7\xf4\x2c\xda\x6d\xbd\x36\xe7\x9a\x1b\xbb\x4c\xe3\x9c\x26\x01\x56\x01\xf0\xd1\x42\xb5\xec\x6c\xd7\x75\x36\xfb\xb9\x26\xbc\xb6\xcc\xec\x4d\xf0\x3d\x5a\xa0\x26\x4b\x61\x9d\x15\x28\x92\xac\xfd\x70\xa9\x4d\xc4\xe7\xaa\x40\x91\x3d\xb2\xee\x2a\xd4\xa6\xc9\x73\x55\x61\xc2\xe6\xba\xeb\xae\x41\x6d\x12\xbb\xfa\x44\xf4\xb1\xf9\x0f\x36\x17\x5d\xe9\xb6\xcd\x45\x5f\x71\xa3\x1f\x77\x2e\xfa\x6e\xcf\x68\x1f\x59\x1a\xd3\x81\x24\x99\x31\x8d\xe9\x37\x48\x39\xdb\x5a\x8b\x34\xf4\xc9\x80\x98\x31\x1d\x69\x77\x77\x3c\x10\x72\xaa\x8b\x4d\x47\x9a\x7f\x3a\xd2\xa3\xca\x23\xdf\xec\xc4\x9f\xa3\xcc\xa0\x9d\x53\xe8\x41\x74\x05\x9d\x37\x20\xf2\x33\x43\xa9\xbd\xa7\x15\x0b\x99\xeb\x91\x05\xf4\x26\x9e\x7e\x2f\xa2\xc0\xe9\x23\x67\x4e\x6c\xbe\x6b\x81\x5a\x42\x3a\xd0\x2c\x40\x89\xef\xf1\xe2\x8b\xc2\xa1\x7c\xe7\x04\x64\xfc\xd4\xc2\xb4\xb0\x9c\xa0\x07\xc0\x54\xf8\x13\xb5\x48\x2d\x21\x33\xe8\x21\x48\xd5\x1d\xca\x39\x26\x98\x0f\xd4\x16\xae\xc5\x25\x0a\xcd\xd7\xb1\x2c\x62\x0b\xcf\x18\x9a\x05\xb1\x35\x4a\x73\x70\x28\x76\x62\xec\xeb\x5f\x3c\xb3\xe9\x0e\x36\xdd\x61\x3a\x6d\xad\x26\xdd\x01\x11\xa6\x07\x69\xcd\x3c\xca\x96\x7b\xfa\x24\x1b\xfc\xb2\xdd\xad\xec\xe0\x98\x7b\xd7\xd0\xff\xaa\x6d\x7d\x7d\x79\x52\x2c\x70\x7f\x7b\xd6\xf6\x05\xf4\xb9\x26\x3d\x8c\x53\x2a\xcd\x87\xa8\x8d\xd1\xea\x5c\x93\x1f\xee\x33\xd5\x38\x1b\xf4\x37\x40\xad\x73\x4d\x54\xb8\xd7\x58\x37\x47\xaf\x73\x4d\x5a\x98\xd4\x5c\xfd\x8c\xa3\x6e\x80\x7a\xe7\x9a\x6a\x30\xb1\xd9\x6e\x8e\x7e\x27\xcb\x44\x30\xf3\x86\x45\x9d\x46\x96\xaf\x22\xe6\xbb\x48\x6d\xf9\xfd\x24\xbb\x1b\x6c\x77\x83\xd7\x72\x37\x78\xaf\xe9\x47\x2d\xa5\x8d\x4d\xbb\x1b\xac\xd6\x38\xff\xed\xe0\xbd\x86\x1f\xae\x94\xb7\xe5\x37\x6f\x3b\x78\x21\x27\x8f\x3d\xde\xed\xe0\xc5\x1e\x2b\xd6\xaa\xf9\xbd\x3d\x5b\xec\x7e\xc6\xd4\xb4\x2f\x6e\x4f\x0e\x93\x3b\x30\x2f\x3e\xc4\x37\x5d\x2d\x1b\x5a\x4b\x36\xd4\xf4\xbb\x90\x2f\x2c\x1b\x72\x96\xc7\x86\x1a\x7e\xe4\x51\xa6\xa5\x96\x0d\x59\x36\xb4\x02\x36\xb4\x9a\x85\x79\x4b\x87\xee\xa7\x43\x8d\xe9\x67\x96\x0f\xc9\x1d\xb0\x7c\xc8\xf2\xa1\x42\x64\x1e\x85\xf7\x9b\xce\xad\xff\xd9\xf2\x21\x67\x69\x7c\x68\xbf\xe1\x5c\x7a\x99\x98\x5a\x3e\x64\xf9\xd0\x0a\x57\x87\x96\xbc\xf5\x6b\x09\xd1\x04\xeb\x43\xf7\xa7\x7b\x5b\x5a\x24\x77\xc0\xd2\x22\x4b\x8b\x0a\x91\x79\x30\x3e\x68\x38\x09\xbe\x27\x07\x65\x4b\x8b\x16\x4c\x8b\x0e\x1a\x8e\x74\x97\xf9\xa9\xa5\x45\x96\x16\xad\x72\x99\xc8\xf2\xa2\xd6\xf2\xa2\xe6\xef\x57\x59\x6a\x24\x77\xc0\x52\x23\x4b\x8d\x0a\x91\x79\x40\x3e\x6c\x38\x5d\xa2\x27\x7f\xaf\xdf\x52\xa3\x05\x53\xa3\xc3\x86\xe3\x25\x94\x57\x5b\x6e\x64\xb9\x51\x0b\x96\x8c\x96\x9c\x76\x6d\x29\xd2\xec\x4b\x47\x86\xaf\xc9\x5a\x9e\x24\x77\xc0\xf2\x24\xcb\x93\x0a\x91\x79\x74\x7e\xd1\x90\x77\xdd\x93\x02\x8e\xe5\x49\x8b\xe6\x49\x2f\x1a\xf2\xae\xbb\xca\x49\x54\x96\x27\x59\x9e\xb4\x7c\x9e\xb4\xea\xef\xa7\x59\xa2\xf4\xa0\xb5\x24\xcb\x95\x2c\x57\x7a\xdc\x5c\x49\xfc\x9f\xfb\x0a\xf7\x42\xee\x42\xbc\xf5\xdd\x9d\x42\xe1\x6e\xe2\x0d\x61\x08\x3e\x43\x92\xb0\x28\xef\x56\xb9\xd2\xd9\x61\x84\xfc\x66\x1f\x94\x64\x84\x85\xc6\x81\x8c\xaa\x2b\x9d\x61\x12\xa2\x1b\x54\x1d\x5a\xe3\x66\x87\x9c\xb8\x4a\x0b\x28\x0c\xe3\x00\x50\x7e\xf4\x45\x65\xb3\x6c\x78\x4a\xa8\x62\x29\x8a\x35\x57\x83\x71\x0d\x0b\x0a\x6f\xf2\xc8\xe4\x3c\x3f\x2e\x49\x08\x3f\x68\xc5\xd1\x23\x94\x30\xd7\xf1\x77\x8f\x83\x9f\x1f\x7c\x08\x35\xe7\x45\x11\x0b\xd7\x3e\x7c\x1d\x98\x07\xf0\x71\x56\xe0\x86\x29\x0b\x1d\xc6\x47\x72\xef\x73\x8d\x64\x4a\x19\x83\xd5\x23\x45\xdc\x3f\x53\x48\x38\x13\x72\x63\x16\x00\x21\x1d\xc2\x54\xf5\x24\x09\xf4\x6e\x4d\x3e\x80\xdc\xee\xd4\x6f\x18\xbb\xc9\x25\x8a\x3f\x91\xe0\xec\x36\xf2\x8c\x0d\x2d\x22\x91\xd4\x50\x93\xfb\xab\x34\x2b\x08\x3e\xe7\xea\xd7\x54\x32\x09\x99\xe4\xb7\xc1\x0b\x14\x21\x9a\x59\x68\xad\xc9\x39\x44\xbd\x59\x20\x72\xf3\x71\xd7\x9d\x12\x27\xe3\x63\xf7\x83\x24\x5e\xdb\xcf\x42\x67\xf5\xab\x03\x28\xba\xc0\x5b\x4e\x5e\xe5\xb3\x85\xc2\x97\x8f\x45\xaa\xae\x98\x4f\x0b\x7c\x92\xdf\x8b\x76\xba\xf5\x72\x43\xa7\xb8\x74\xdc\x03\xb9\x91\x64\xfd\x56\x8a\xd2\x04\x7e\xcc\xaa\x1b\xcb\x6e\x27\xb6\x9d\xf1\x91\xc1\xd4\xe0\x5a\xc4\x90\x0f\x64\x6a\x0c\x16\xca\x8d\x0b\x89\x13\x6e\xc9\xf1\xa6\x35\xc3\x31\x0f\x4a\x86\xa8\x74\x4c\x55\x93\xe0\xd5\x01\xf4\xa8\xe0\x2d\xfa\x61\x05\x93\x2b\x6c\x52\x95\xc9\x43\x94\x42\xee\x64\x1f\x69\x7c\xd7\xc4\xf1\xc9\x63\xce\xc4\x18\xe1\x83\xec\x0b\xa4\x14\x1b\x5f\xc2\x0b\xfa\x1e\x4e\x85\xed\xed\xee\xe8\x65\xd9\xc8\x2d\x8d\xb2\x79\xe1\x84\xd1\xee\x21\x11\x6d\x86\x41\xa7\x98\x0b\x2c\xd8\x88\xf6\xea\x87\xc9\xca\x66\xc3\x0a\xa7\x35\x94\x5a\x7d\x52\xa8\xec\xd5\xe4\x0f\x09\x95\x6a\xd8\x5d\x58\xa8\x34\x63\x30\x36\x5a\xe6\x9f\x32\x9e\x37\x2a\xd8\x15\x12\x78\x96\xbc\xea\x22\x9b\x13\xb9\x11\xbe\xee\x74\x2b\x65\xb9\xc2\xaa\xb9\xd4\xd5\x1e\x8f\x11\x9b\x3a\x10\xb9\x92\x5c\xab\xfd\xa2\x81\xaa\x41\xb8\xfb\xca\x86\xbb\x6a\xf0\xee\xae\x7a\xd9\x0d\xe5\xab\x7d\xe5\xaa\xab\x5e\xee\xee\xa8\xa5\xca\xa4\xa7\xa7\x5c\x75\xfd\xc2\x72\xbe\x55\x3d\xe4\x13\x5f\x93\x01\x37\xbf\x55\x7d\xcd\x81\xfa\x1a\xf5\xad\xbd\x3d\xf5\x52\x99\x23\x1e\xfa\x6a\x5f\xaa\x16\x6a\x0a\xff\x8e\xc5\x0a\x44\x61\x15\xd5\xb4\xb4\x1e\x06\x9d\x6d\x27\x63\xd4\xec\xc3\x7f\x4b\xd2\xcd\x2e\x8e\xb2\xa3\x03\x9d\xd3\xe2\xe8\x40\x26\xab\x1e\x7c\x7a\x82\xfd\xa4\xe0\x14\xee\x55\xc5\xf2\x7f\x18\xfd\x0d\xd7\x69\x84\x14\x35\xb7\x00\x00")
 
 func monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -164,7 +164,7 @@ func monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTpl() (*asset,
 	return a, nil
 }
 
-var _monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6d\x73\xd3\xb8\x16\xfe\xbe\xbf\x42\xab\xcb\xde\x81\x3b\x2d\x6d\xd2\x37\xe8\x0c\x1f\x80\x5e\x60\xe7\xc2\x6e\xb7\x85\x9d\x61\x80\xc9\x2a\xb6\x9a\x68\x6a\x4b\x5e\x49\xee\x0b\x9d\xec\x6f\xbf\x63\xf9\x4d\xb2\xe4\x90\x26\x69\xeb\x36\xe2\x0b\xf1\x91\x25\x4b\xe7\x39\xe7\xe8\x91\x7c\xac\x5e\xfd\x04\x00\x00\x10\x51\xca\x24\x92\x84\x51\x01\xf7\x41\x2e\x04\x00\x46\x44\x48\xb8\x0f\xbe\x14\xd7\xa0\x2a\x51\xa5\xc3\x94\x44\xf2\x57\x0a\xf7\x41\x6f\x4d\x97\x87\x48\x22\xc1\x52\x1e\x60\xb8\x0f\xe0\xfa\x3a\x78\xcb\xd1\x09\xa2\x08\xac\xaf\x43\xe3\x46\x4c\xd1\x30\xca\x6e\x92\x3c\xc5\x46\xc9\x98\x84\x4e\x39\x09\x18\x7d\xcd\x22\xc6\xb3\x96\xf9\x68\x88\x1e\x6f\xae\x81\x7e\xaf\xb7\x06\xfa\x3b\x3b\x6b\xa0\xf7\xc4\x7c\x00\x45\xb1\xea\xc3\xcb\x7a\x78\xe0\xdf\xe0\x65\x84\xb9\x14\xe6\x9d\xf2\x32\x51\x77\x86\x48\x8c\x87\x0c\xf1\x10\x56\xa5\x93\xe2\xd7\x37\xf5\xff\x24\xaf\x06\x71\x48\xa4\xd5\x7b\x38\xa2\x58\xfe\x1a\xc2\x7d\x40\xd3\x28\x2a\x65\x1c\x25\xe3\x8f\x8c\x45\x92\x24\x70\x1f\x6c\x16\x62\x12\xd6\x8a\x83\x11\xa1\xa7\x99\xe6\xbf\x7c\x2b\x04\x09\xa2\x38\x12\x9a\xee\x6b\xcd\xc3\x80\x45\x11\x4a\x04\xce\x1a\x38\x41\x91\xd0\x54\x04\x47\x9c\x84\x87\x4c\x07\x31\xd7\xa7\x05\xd2\x39\xdc\x07\xfd\x6d\x43\x74\x51\xf7\xae\x90\x5c\x66\x92\x5a\x11\xda\x73\xf2\xde\x6b\x4d\x6a\x3d\xfe\xa6\x49\x39\x4e\x30\x92\x86\x42\x72\x7d\x13\xa9\x74\x07\x5f\x1f\x7e\x02\x9f\x04\x1a\x61\xa8\x97\x16\x68\x70\x76\x5e\xe2\x50\x3d\x5c\x53\x04\x8a\x08\x12\xca\x1c\xd4\x80\xf5\xfe\x0d\x91\x92\x35\xd5\x93\xc1\xfb\x1e\xd3\x91\x54\x0a\xd9\x6c\x94\x60\x77\x15\xdd\x9a\x1f\x69\x97\xda\x4d\x27\x24\x8a\x9a\x2d\x66\xb2\xb7\x1c\x85\x04\x53\x69\x6a\x76\x1a\x4a\x7b\xf3\xa1\xd4\x9b\x82\x92\x26\x88\xf0\x08\xd3\xb0\xf9\x5c\x74\x36\xb2\x07\x9e\x19\x5a\xca\x79\xde\x79\xbb\x2c\x46\x17\x6e\x39\xa1\x4e\xb9\x18\xb3\x73\x97\x47\x4b\x26\x51\xe4\xac\x71\x86\xa2\xb4\x46\xc4\x39\xbe\x88\x50\x75\x87\xd9\xaa\x12\x9f\x93\x50\x81\xbc\x69\xca\x4f\x2d\x0b\xcd\x0c\xf3\x90\x11\x2a\x3f\x30\x15\x73\x94\x00\x20\x01\xbe\x63\xce\x74\x8c\x59\xd2\x8c\x8f\xa0\xb4\x8f\xf7\x55\xc3\xce\x6e\x26\x98\x07\x98\xca\xcc\xc8\x6d\xfb\x4a\xb2\x67\x67\x66\x92\x66\x2d\xec\x34\x4b\x5c\x26\xc9\x31\x0d\x31\xc7\x2a\x08\x9e\x44\x4c\xea\xbd\x14\x98\x13\x2c\x7e\x3f\xc3\x9c\x93\x10\x5b\xa3\x15\x09\x0a\xb0\xdb\x03\x84\x44\xc1\xa9\xad\x4c\x21\x71\x92\xe0\xf0\x3d\xa1\xae\xde\x4b\xc4\x47\x58\x0a\x63\x92\x30\xa7\x89\x2c\x56\x5e\x24\xaa\xaf\x22\x8d\x1f\x53\x16\xe2\x41\x16\x97\x55\x4f\x06\x09\x0b\x07\x01\xa3\x12\x11\x8a\xf9\x7e\xf5\x6b\x10\x24\xe9\x20\xcd\xc2\xc2\x40\xe0\x80\xd1\x50\x0c\x94\xa1\xec\x5f\x5d\x81\xa7\xc7\x69\x7c\x84\x24\x06\x93\xc9\x55\xd5\xd0\x8b\xaf\xf0\x51\x75\xf1\x15\xae\x81\x84\x85\x99\x2c\x61\x61\x76\x55\x35\xfc\xf3\x8b\xaf\xf0\xf0\xf7\x03\x25\x8b\x52\x21\x31\xcf\xee\x2a\x7e\x7e\x85\x93\x27\x60\x78\x09\x1e\x57\xb7\x9b\xf3\x49\xe6\xd4\x8c\xc7\x2a\x9e\x41\x49\xe2\xac\x73\x99\xb6\x9b\x37\x11\x2a\x31\x3f\x43\xd1\x1b\x14\x48\x35\x55\xf5\x1b\x37\xe4\x6e\xf8\xa6\x6a\xeb\xea\xea\xaf\xab\xab\xea\xa1\x93\xc9\x5f\x93\x49\xb3\xcd\xbc\x4a\x66\x68\x56\x30\x2d\x6c\xe2\x44\x4d\x3c\xf0\x65\xb3\x66\x06\xa0\xc2\x5a\x13\x4f\xaa\xdf\xba\x71\xc8\x31\xc7\x62\xcc\xa2\xd0\x32\x9b\x6c\xb0\x6f\x38\x8b\x5d\x81\x3c\xc6\x47\x78\x54\x78\x86\x55\xe9\x78\x4c\x4e\xae\x1f\xfe\xab\xc9\xf2\xca\x0c\x21\x88\xbb\x26\xbd\xac\x88\x71\x69\xc5\x45\x15\x40\x06\xe5\x5c\x42\x68\x48\xce\x48\x98\xa2\x08\x3a\x9d\xb4\xbc\x4f\x4d\xd7\x7a\x6f\x2e\xd0\x05\xb1\xbc\x7e\x98\x06\xa7\xb9\xdd\x37\xb1\x80\x71\x11\x47\xb2\xe1\x3b\xe9\x88\x55\xa3\x2d\x36\x56\x11\xb0\x25\xae\x5c\xa2\x0b\xfc\x03\xd7\xab\xed\x55\x8c\x33\x1d\x35\xad\x0a\x0d\x71\xe4\x36\xa8\x88\x8d\x5e\x21\x81\x9b\xc4\xa1\x0a\xfd\x8e\x2a\x79\xec\xdf\x6c\xda\x5f\x3d\x3a\xdd\x02\xd7\x3a\xd5\x69\x47\x41\xd1\x6f\x73\xf6\x69\x73\x9d\x4b\x97\x91\xa0\x88\x8c\xdc\xb3\xa1\x2a\x79\x8f\xcf\xaa\x71\x58\x6c\xd3\xc5\x77\x6e\x97\xf8\x3d\x9b\x42\x29\xfa\xcb\x21\x7e\x7f\xa4\x4c\xa2\x5b\x21\x7e\x01\x8b\xd2\xd8\x0e\x51\x37\xcd\x07\x75\x11\xa3\xf2\x98\x7c\x57\xb5\x7a\x9b\x9b\xbf\xc0\x1b\xe5\x83\xcf\xdb\xc1\xeb\xaf\x34\x1f\xec\xdd\x04\x1f\x34\xc8\x1e\x1a\xe1\x02\xe7\x86\xe9\xdf\x2a\x0d\x0c\x38\x53\x26\xd8\x64\x73\x3f\xa0\x87\x63\x76\xfe\x0e\xa3\x50\x35\xda\xac\x9a\xcf\xb1\x86\xa5\x04\x2c\xb2\xcc\x2f\xc4\x22\x68\xc6\xfb\xc9\xf5\x28\x68\x73\xa4\xd3\x39\xa8\x90\x97\xd1\x8f\xe6\x41\x15\x31\x32\x45\x7d\x6c\xce\xca\xb9\x4b\xe3\x9a\x8c\x7d\xfe\xfc\xf9\xf3\xfa\x87\x0f\xeb\x07\x07\xe0\xdd\xbb\xfd\x38\xde\x17\x16\xc1\x4b\x90\x94\x98\xd3\xb6\xf6\xca\x28\x36\x26\x61\x88\x29\x9c\x71\xd6\xab\xba\xe8\xe2\x44\xa5\xb6\x19\x2f\xcc\xd2\x31\x61\x05\x65\x40\xd4\x21\x9d\x6f\x80\x21\x0e\x48\x8c\x54\x54\xb7\xb8\x6b\x4e\x41\x2d\xff\x2c\x8a\xea\x8d\x0e\x78\xc0\x49\x14\x81\x90\x9d\x53\x6b\x06\x27\xf4\xf4\x13\xcf\x4c\x07\x4e\x51\xed\x9f\x99\xc3\x83\x7f\x59\x84\xb6\x95\xa6\x1a\xca\xa7\x69\x3c\xc4\xbc\x59\x37\xa5\x44\x23\x16\xf3\x20\x73\x84\xff\x4e\xb1\x90\x96\xca\x56\x13\x9c\x57\xdd\x04\x07\xfc\xe2\xe1\xc9\xe0\x79\x7d\x13\xf0\x14\xf3\x98\xba\x9c\x07\xa4\xf7\x24\x26\xde\x7f\x72\x80\x0e\xba\xe5\x3f\x39\x34\xde\x7b\x72\x70\xfe\xdb\x39\xef\x29\xb7\x88\x56\x1c\x9f\xa0\x4d\x0f\x77\xe7\x3d\x9d\x44\x44\x53\xd9\xc6\xd3\xff\x6c\xcc\xa7\x2d\x21\x39\xa1\xa3\xd9\xb5\x55\xfd\x36\xf6\x02\xef\x76\x9f\xd8\xb5\xd7\xbb\x06\xe6\xdf\x3d\xbe\xce\x3e\xb1\x7a\x4b\xd8\x28\x26\x54\x48\xa4\x56\xd2\xcd\x05\xf0\x3c\xdb\xc7\xcd\xd6\xaf\xb9\x17\x3c\xc5\xbc\x75\x44\x4e\xd3\x61\x03\x87\x01\xc7\xf9\xd6\xc7\x80\x17\xbc\x4b\xe1\x11\x30\x8e\xc5\x42\x2a\xbf\x27\xea\xb5\xc8\xef\x22\xea\xed\x8e\xc1\x5b\xda\x07\x1b\xc0\x1b\x80\xcb\x00\x2c\x7a\x7d\x53\xfe\x15\x29\x5e\xb6\x5a\xca\xb5\xa8\xf1\xea\x7a\xd7\x2a\xc2\x6f\x91\xef\x9b\x7f\x8f\x39\xcb\xcb\x4a\xfb\x95\xc5\x9d\xbc\xac\xe4\x88\x8a\x0c\x27\x17\x4a\x15\x73\xb3\x0a\xfc\xab\xcc\x46\x89\x7f\x95\x99\xff\x2b\x41\xb9\xfb\xb7\x8e\xbd\x5d\xa7\x7d\xe4\xaf\x1d\xb7\x34\xc9\x9c\xaf\x1d\x3f\xe0\x98\xf1\x4b\x9f\x72\xa6\x21\x35\x6f\xca\xd9\x5e\x3b\x52\x3a\x50\xab\xf7\x8e\xd1\xe7\x9c\x75\x2b\xe7\xac\x66\x54\xb1\xf2\xfe\x01\x17\x8b\x51\xa8\x96\x84\x32\x5d\xd6\xa5\x5c\x32\xf0\xf8\xe8\xf8\xd8\x7a\xfc\x92\x33\xca\x66\x24\xe2\x16\x16\x01\x0a\xc6\x78\xb5\xd0\x78\x9d\x0d\x79\x7e\x3c\x96\xba\xed\x60\xe1\x21\xce\x51\xb2\x5a\x70\x1c\x9f\xa3\x64\x7e\x34\x66\xdb\x03\x58\xea\x3a\x65\x81\x7c\xcb\x56\xfa\xe3\x53\x2e\xc1\xd2\xd7\x29\xc3\x4b\x69\xdb\xac\x5f\xa7\xf8\x94\xcb\x36\x4a\xdd\xdf\x9e\xb2\xf8\xd9\x5e\xda\xe2\xc7\xa7\x5d\xfe\x10\xc0\x39\xd7\x44\xfd\x9d\x76\x00\xb7\x57\x7a\x4d\xe4\xf3\x2e\x7d\xde\x65\xc7\xf3\x2e\x5b\xb8\x51\xa9\xf0\xbb\x7f\x9d\xff\x30\x52\x2f\x73\x56\x34\x27\x38\x3e\xfb\xf2\xc6\xb3\x2f\x97\x82\x8f\x4f\x21\xeb\x68\x02\x66\x81\x93\xcf\xc1\xbc\xe1\x1c\xcc\x85\xbc\xc8\xa7\x61\x76\x3a\x0d\x53\x27\x0a\xee\xbd\xd5\xd5\x04\xea\x4d\xe7\x1c\xa9\x80\xc8\xbd\xe1\xba\x9a\x20\xbd\xed\x2a\x48\xce\x6d\xd8\xd5\xc4\xe8\x5d\x77\x30\xf2\x29\xe7\x3e\xe5\x7c\x45\x52\xce\xad\x57\x61\xe7\x8c\x9f\x12\x3a\x1a\x08\x2c\x07\xca\x7b\xba\xf5\x5e\x6c\xa5\x12\xcb\x0b\x48\x16\x87\xe1\x9e\x68\xf8\x66\x5f\xf2\x2e\xd7\xb2\x17\x4a\x18\x37\x80\x7d\x50\x10\xde\x76\x76\xf8\xd2\x5c\xe4\xbe\x46\xa5\xa5\x66\x8c\xdf\xee\x6c\xb0\x50\x5e\xf8\xc3\x75\xa1\xd9\x92\xc0\xe7\x45\x74\x89\x69\x60\xe0\xe7\x17\x20\x43\xd1\x12\xdd\xa7\xcf\xc5\xac\xf5\x7b\x77\x13\xbd\x1e\x84\xbe\xad\xa5\x78\x67\x13\xb9\x1e\x84\xba\xad\x55\xf5\xdd\x7f\x51\xd2\x9a\x90\xe1\x3f\x2a\x31\x86\xed\x3f\x2a\xb9\xe3\x4e\xdf\xef\x8f\x4a\xb6\xfa\x4e\xfb\xc8\xf3\xaa\x8c\x9c\x8f\xf9\xf2\xaa\x7e\xc3\x32\x63\x87\xfe\x7b\x92\x45\x72\xa7\xb6\xb6\xda\x41\xd2\x31\x5a\xbd\xdc\x29\xff\x3d\x49\xb7\xbe\x27\x21\x1c\x49\xac\x11\x2e\x9a\xbb\xff\x80\xe3\x00\x93\x33\x9c\x2f\xc4\xf2\x2f\x87\xb5\xe5\xd8\x3f\x2e\xae\xf5\x4f\xb5\x20\xfb\xf2\xa8\x64\x3a\xdf\x9e\xe4\x7c\x2a\x61\xe1\x6d\xa6\xc5\x27\x2c\x5c\xa5\x93\x87\x8f\x72\xb0\xc0\x2b\x44\xc3\xdc\xd1\xee\x9c\x7f\x3d\xf0\x74\xf8\x57\x89\x4f\x86\xf7\xc9\xf0\x33\xf3\x81\xed\x69\x7f\x79\x62\xd7\x93\xb6\x4e\x90\xb6\xed\x29\x7f\x78\x62\xd7\x93\xb6\x5a\xee\x49\x5b\x47\x49\x9b\xda\xf5\x88\x89\xf4\xac\x4d\xd3\x7b\x67\x59\xdb\xc7\x02\x2d\x4f\xdb\x3c\x6d\xf3\xb4\xad\x93\xb4\x6d\xda\xdf\x8d\xd8\xf3\xb4\xad\x1b\xb4\x6d\xca\xdf\x87\xd8\xf3\xb4\xad\x96\x7b\xda\xd6\x51\xda\x56\xee\xb5\x25\x48\xcd\xa7\x9e\xb7\x75\x9b\xb7\xa9\x93\x13\xd9\x09\x28\x76\xdd\x42\x70\x98\xe3\xe6\xd9\x9b\x67\x6f\xcd\x2e\x7b\xf6\x76\x77\xec\x6d\x67\xda\xf1\x7b\xcf\x3c\x7b\xeb\x04\x7b\xdb\x99\x72\xf2\xde\x33\xcf\xde\x6a\xb9\x67\x6f\x1d\x65\x6f\xd5\xa6\x9b\xa7\x6f\xba\xe6\x3b\x4f\xdf\xca\xed\x37\xe9\x19\x9c\x67\x70\x9e\xc1\x75\x91\xc1\xed\x4e\x3b\x43\xec\xb9\x67\x70\x9d\x60\x70\xbb\x53\xce\x09\x7b\xee\x19\x5c\x2d\xf7\x0c\xae\xa3\x0c\xae\xb9\xff\x16\x72\x96\x3d\xd6\x13\xb9\xfb\x41\xe4\x9a\xfb\x70\xe0\x20\xc7\xcf\xb3\x39\xcf\xe6\x9a\x5d\xf6\x6c\xee\xee\xd8\xdc\xde\x94\x2f\x17\xfa\x9b\x9e\xcd\x75\x82\xcd\xed\x4d\xf9\x72\xc1\xe8\x95\xa7\x73\x9e\xce\x75\x93\xce\x59\x1b\x72\x9e\xcf\xe9\x08\x74\x9e\xcf\x39\x36\xe6\x3c\xa5\xf3\x94\xce\x53\xba\x66\xf3\xf9\xff\xc5\x23\x32\xaf\xcf\x5c\x16\xaa\xc3\xe1\x4b\x14\xa1\x08\xc6\x38\x46\x7f\x62\x2e\x08\xa3\xda\x8b\xcf\xfc\xc4\xeb\xec\xe6\x10\x55\x9c\x09\x4a\x34\xd2\x4d\x05\x9e\xa6\x43\xcc\x29\x96\x58\xac\xc7\xe4\x82\xd4\xe7\x8d\xc1\x2d\x11\xa0\x08\x43\xa3\x07\x12\xc7\x49\x84\x24\xa1\x23\x6d\x94\x30\x22\x42\x1a\xe6\x67\x52\x15\xa2\xfc\xc0\xf4\x49\x42\x83\x28\x0d\xf1\xcb\xc8\x3d\x9d\xb7\x81\x0b\xe3\x34\x92\xc4\x59\xa5\x70\x2a\xe8\x64\x5d\xc6\x8c\x6c\x1e\xe4\x05\xff\x4e\x31\xcf\x78\x11\x4c\x38\x8b\xb1\x1c\xe3\xd4\x74\x10\x4d\xed\xbd\x86\x7c\x84\x2f\xac\x6f\xf8\xa1\x38\x25\xc9\x27\x1e\x1d\x5f\xd2\xc0\xd9\xd1\x32\xc0\x68\x1d\x75\x79\x75\xc3\x86\x22\x75\xd8\x9e\x4b\x25\xb3\xb0\x4e\x75\x0a\xda\x09\xa1\x44\xe6\x36\xd2\xe8\x72\x01\x51\x7f\x1e\x88\x60\x71\x52\x03\xbc\x26\x4e\xce\x6a\x3f\x06\x49\x3d\x76\x90\x47\xc4\xfa\xb4\x19\x42\x4f\xd8\x1a\x28\x9a\x7c\x72\xa3\xf0\x15\x53\x8c\xa9\x2b\x89\x46\x0a\x1f\xf1\x47\xd9\x4f\xd8\x2c\x77\x0c\x2a\x93\xb6\x55\x28\x8c\x24\x1f\xb7\x51\x94\x0a\xfc\x31\x6f\xae\x95\xeb\xce\x6c\x3b\x35\x69\x6f\x04\x5f\x67\x87\x55\x00\xb8\x28\xd8\x0b\x78\xfa\x5b\x49\xac\x80\x4d\x60\xce\x8a\x27\xda\x37\xb6\x45\xff\x85\xe2\x04\xac\x48\xde\x75\xcd\xb0\xa5\xa2\x66\x88\xc6\xc0\x4c\x35\x29\x0a\x1d\xe1\x40\x2a\x3a\x62\x1f\x07\x32\xbb\xc2\x66\x55\x99\x3e\xf3\x18\x73\x8f\xee\x23\x53\x9f\x35\x73\x7c\x0a\x52\x21\x59\xdc\x95\xd8\x34\x9f\x59\x24\x2c\xbc\xae\x41\x58\x55\x16\x89\x49\xd7\x3c\xd1\x66\xa2\x16\x28\x3e\x7e\xcd\x6c\x5f\x28\x95\xcc\x4d\xad\x52\xc9\x06\x01\x4b\x55\x6c\xdb\xda\xb4\xcb\x72\xc2\xa7\xf1\xa8\xa2\xd0\xb4\xd8\x36\x4a\xb0\xc8\x84\x39\x07\xa7\x29\x17\x97\x37\x1c\xa3\xb6\xc7\x53\xa2\xd2\xf6\xf8\xda\x71\xa8\xd1\x9e\x66\xc9\xfd\x25\x5a\x72\xef\x76\x2c\xd9\x8d\x41\xab\x31\x17\xbf\xf2\x05\xdb\xa4\xa4\xcf\x44\xe1\x59\x11\xe7\x93\x7c\x91\x0d\x29\x3b\x5f\xef\xd5\xca\x82\xca\xa8\x33\x29\xb4\xaa\x27\x24\x38\x55\x9b\x39\x55\x23\x85\x56\x07\x65\x07\x4d\x83\x80\x3b\x46\xce\x8b\x69\xef\x70\xcb\xbc\xec\xc5\xfa\xd5\x8e\x71\xd5\x33\x2f\xb7\x36\xcd\x52\x63\xa9\xdc\x37\xae\x7a\x61\x69\x39\xdf\xea\x11\x92\x18\x0f\x5c\x06\x3c\xfd\xa9\xe6\x63\x76\xcd\xc7\x98\x4f\xed\x6f\x9b\x97\xc6\xce\xc2\x5e\x68\x8e\xa5\xee\xa1\xa5\xf0\xef\x4c\xed\x65\xc1\x6a\x01\x55\x6e\x66\x34\x67\x59\xb0\x01\xf2\x25\x13\xd8\x00\xff\xab\x56\x55\x60\x03\xbc\x66\x71\x92\x4a\x0c\x8e\x8a\x83\x10\x33\xd9\x61\x35\xcb\xc0\x54\xed\xbc\xb6\x4d\xda\xf0\xac\x5e\xd8\xfd\x34\xf9\x7f\x00\x00\x00\xff\xff\x73\x10\x93\xb1\x01\xa3\x00\x00")
+var _monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\x5b\x73\xdb\xb6\x12\x7e\xef\xaf\x40\x79\xd2\x33\x49\xc7\x4e\x24\xf9\xd6\x7a\xa6\x0f\x49\xdc\x34\x9d\x93\xa4\xae\x9d\x64\xe6\x4c\xe2\x51\x21\x12\x96\x30\xe6\xad\x20\xe8\x4b\x3c\xea\x6f\x2f\x00\xde\x00\x02\xa4\x65\x49\xb6\x68\x0b\x7a\xb0\xc5\x05\x09\x02\xfb\xed\x2e\x3e\x80\x4b\xe8\xfa\x3b\xc0\x3e\x0e\x0c\xc3\x88\x42\x8a\xa3\x30\x71\xf6\xc1\xb5\x10\x32\xb1\x8f\x13\xca\x8e\xbf\xe4\xc7\xa0\x2c\x11\xa5\xa3\x14\xfb\xf4\xf7\x90\x9d\xd0\xdf\x90\xe5\x1e\xa4\x30\x89\x52\xe2\x22\x56\xe4\x6c\x6e\x82\xdf\x08\x3c\x85\x21\x04\x9b\x9b\x8e\x72\x22\x0a\xe1\xc8\xe7\x27\x51\x92\x22\xa5\x64\x82\x3d\xa3\x1c\xbb\x51\xf8\x3a\xf2\x23\xc2\x6b\x26\xe3\x11\x7c\xda\xdb\x00\x83\x7e\x9f\xfd\xd9\xd9\xd9\x00\xfd\x67\xea\x0d\x42\x18\x88\x36\xbc\xac\xba\x07\xfe\x0b\x5e\xfa\x88\xd0\x44\x3d\x93\x5e\xc5\xe2\x4c\x0f\x26\x93\x51\x04\x89\xe7\x94\xa5\xd3\xfc\xdb\x89\xf8\x3f\xcd\x2e\x73\x90\x87\xa9\xd6\x7a\x67\x1c\x22\xfa\xbb\xc7\x64\x61\xea\xfb\x85\x8c\xc0\x78\xf2\x31\x8a\x7c\x8a\x63\x56\xd2\xcb\xc5\xd8\xab\x14\xc7\x14\x1d\x9e\x71\xcd\x7f\x39\xc9\x05\x31\x0c\x91\x9f\x48\xba\xaf\x34\xef\xb8\x91\xef\xc3\x38\x41\xbc\x82\x53\xe8\x27\x92\x8a\xd8\xcd\xb0\x77\x18\xc9\x20\x66\xfa\xd4\x40\xba\x60\x92\xc1\xb6\x22\xba\xac\x5a\x97\x4b\xae\xb8\xa4\x52\x84\x74\x9f\xac\xf5\x52\x95\x52\x8b\x4f\x24\x29\x41\x31\x82\x54\x51\x48\xa6\x6f\x4c\x85\xee\x9c\xd7\x87\x9f\xc0\xa7\x04\x8e\x91\x23\x97\xe6\x68\x90\xe8\xa2\xc0\xa1\xbc\xb9\xa4\x08\xe8\x63\x98\x08\x73\x10\x1d\x96\xdb\x37\x82\x42\x56\x57\x0f\x87\xf7\x1d\x0a\xc7\x54\x28\xa4\x57\x2b\x41\xe6\x4b\x64\x6b\x7e\x22\x1d\x4a\x27\x9d\x62\xdf\xaf\xd7\xc8\x65\xcc\xf2\x3d\x8c\x42\xaa\x6a\xb6\x0d\xa5\xbd\xf9\x50\xea\xb7\xa0\x24\x09\x7c\x34\x46\xa1\x57\xbf\x2f\x3c\x1f\xeb\x1d\xe7\x86\x96\x12\x92\x35\x5e\x2f\x0b\xe0\xa5\x59\x8e\x43\xa3\x3c\x99\x44\x17\x26\x8f\xa6\xcc\x31\x7d\xe3\x15\xe7\xd0\x4f\x2b\x44\x8c\xfd\x63\x6e\x23\xce\x50\x6b\x15\xe2\x0b\xec\x09\x90\x7b\xaa\xfc\x4c\xb3\x50\x6e\x98\x87\x11\x0e\xe9\xfb\x48\xc4\x1c\x21\x00\x30\x01\xdf\x10\x89\x64\x8c\xa3\xb8\x1e\x1f\x85\x98\x1b\xc4\xbb\xb2\x62\x63\x33\x63\xc4\xcc\x25\xa4\xdc\xc8\x75\xfb\x8a\xf9\xbd\xb9\x99\xa4\xbc\x86\x9d\x7a\x89\xc9\x24\x19\x28\x1e\x22\x48\x04\xc1\x53\x3f\xa2\x72\x2b\x13\x44\x30\x4a\xfe\x38\x47\x84\xd9\x18\xd2\x7a\x9b\xc4\xd0\x45\x66\x0f\x48\x28\x74\xcf\x74\x65\x26\x14\xc5\x31\xf2\x58\x17\x4d\xad\xa7\x90\x8c\x11\x95\x03\x15\xff\xc8\x0a\xe2\xb1\xf2\x32\x16\x6d\x4d\xd2\xe0\x69\xc8\xb4\x3c\xe4\x71\x59\xb4\x64\x18\x47\xde\x90\xc5\x74\x0a\x59\xf5\x64\xbf\xfc\x36\x74\xe3\x74\x98\xf2\xb0\x30\x4c\x10\x93\x7a\xc9\x50\x18\xca\xfe\xf5\x35\x78\x7e\x9c\x06\x47\x90\x22\x30\x9d\x5e\x97\x15\xfd\xf2\xd5\x79\x52\x1e\x7c\x75\x36\x00\xab\x98\xcb\xd8\x3f\x7e\x54\x56\xfc\x3d\x13\x1e\xfe\x71\x20\x64\x7e\xca\xfa\x46\xf8\x59\xf9\xd7\xaf\xce\xf4\x19\x18\x5d\x81\xa7\xe5\xe9\xea\x78\xc2\x9d\x3a\x22\x81\x88\x67\x2c\x82\x05\xbc\x71\x5c\xdb\xf5\x93\x18\x6c\x88\x30\xf3\x7d\x03\x5d\x2a\x86\xaa\x41\xed\x84\xcc\x0d\xdf\x94\x75\x5d\x5f\xff\x75\x7d\x5d\xde\x74\x3a\xfd\x6b\x3a\xad\xd7\x99\x5d\xc2\x0d\x4d\x0b\xa6\xb9\x4d\x9c\x8a\x81\xc7\x79\x59\xbf\x92\x03\x28\xb0\x96\xc4\xd3\xf2\xbb\x6c\x1c\x74\x42\x10\xf3\x53\xdf\xd3\xcc\x86\x77\xf6\x0d\x89\x02\x53\x20\x0f\xd0\x11\x1a\xe7\x9e\xa1\x5d\x74\x3c\xc1\xa7\xb7\x0f\xff\xe5\x60\xa9\x78\x5a\x32\x81\xc4\x34\xe8\xf1\xa2\x88\xd4\x62\x6c\x11\x40\x86\xc5\x58\x82\x43\x0f\x9f\x63\x2f\x65\xd1\xc6\xe8\xa4\xc5\x79\x62\xb8\x96\x5b\x73\x09\x2f\xb1\xe6\xf5\xa3\xd4\x3d\xcb\xec\xbe\x8e\x85\x13\xe4\x71\x84\x77\xdf\x48\x47\xb4\x2b\x9a\x62\x63\x19\x01\x1b\xe2\xca\x15\xbc\x44\x37\xb8\x5e\x65\xaf\xec\x26\x84\x6a\x56\x05\x47\xc8\x37\x1b\x94\x1f\x8d\x5f\xc1\x04\xd5\x89\x43\x19\xfa\x0d\x97\x64\xb1\xbf\x57\xb7\xbf\xaa\x77\xb2\x05\x6e\x74\xaa\xd1\x86\x82\xbc\xdd\xea\xe8\xd3\xe4\x3a\x57\x26\x23\x61\xfc\x64\x6c\x1e\x0d\x45\xc9\x3b\x74\x5e\xf6\x43\x63\x9b\x26\xbe\x73\xbf\xc4\xef\xa7\x16\x4a\x21\x05\xb4\x45\x88\xdf\x9f\x29\x0b\xea\xf7\x42\xfc\x98\xea\xd2\x40\x0f\x51\x77\xcd\x07\x65\x11\x0b\xef\xc7\xf8\x9b\xb8\xaa\xdf\xeb\xfd\xe0\xcc\x06\xde\x9c\x7c\xf0\xe7\x66\xf0\x64\xec\xd6\x8f\x0f\xf6\x55\xf9\x72\xf8\xa0\x42\xf6\xd8\x60\x96\xe3\x5c\x33\xfd\x7b\xa5\x81\x2e\x89\x84\x09\xd6\xd9\xdc\x0d\xf4\x90\xa1\xf2\x16\x41\x4f\x54\x5a\xbf\x34\x1b\x63\x15\x4b\x61\x6e\xa5\x99\x1f\xab\xd6\xad\xc7\x7b\x59\x41\x33\x50\xd0\x7a\x4f\xdb\x39\x68\x42\xaf\xfc\x9b\xc6\x41\x11\x31\xb8\xa2\x3e\xd6\x47\xe5\xcc\xa5\x51\x45\xc6\xfe\xcf\x3e\x9b\xef\xdf\x6f\x1e\x1c\x80\xb7\x6f\xf7\x83\x60\x3f\xd1\x08\x5e\x0c\x29\x63\x78\x61\x53\x7d\x45\x14\x9b\x60\xcf\x43\xa1\x23\x15\xb6\x8d\x7a\x65\x13\x4d\x9c\xa8\xd0\x76\x44\x72\xb3\x34\x0c\x58\x6e\x11\x10\x65\x48\xe7\xeb\xa0\x87\x5c\x1c\x40\x11\xd5\x35\xee\x9a\x51\x50\xcd\x3f\xf3\xa2\x6a\xa1\xc3\x39\x20\x2c\x0a\x02\x2f\xba\x08\xb5\x11\x9c\x9d\xf8\x89\x70\xd3\x71\x5a\x54\xfb\x99\x3b\x3c\xf8\x8f\x46\x68\x1b\x69\xaa\xa2\xfc\x30\x0d\x46\xcc\x8c\x6b\xa5\x69\x88\x25\x62\x31\x0f\x32\x47\xe8\x6f\x16\x86\xa8\xa6\xb2\xf5\x04\xe7\x55\x37\xc1\x01\x3f\x58\x78\x38\x3c\xaf\xef\x02\x9e\x7c\x1c\x13\x87\xf3\x80\xf4\x0e\x07\xd8\xfa\x4f\x06\xd0\x41\xb7\xfc\x27\x83\xc6\x7a\x4f\x06\xce\xaf\x9d\xf3\x9e\x62\x89\x68\xcd\xf1\x71\x9b\xf4\xb0\x3a\xef\xe9\x24\x22\x92\xca\x5e\x3c\xff\xf1\xc5\x7c\xda\x4a\x28\xc1\xe1\x78\x76\x6d\x95\xdf\x95\xb5\xc0\xd5\xae\x13\x9b\xd6\x7a\x37\xc0\xfc\xab\xc7\xb7\x59\x27\x16\x4f\x09\x6b\xc5\x38\x64\x53\x1d\x31\x93\xae\x4f\x80\xc1\x1c\xcb\xc7\xf5\xda\x6f\xb9\x16\xdc\x62\xde\x32\x22\x67\xe9\xa8\x86\xc3\x90\x99\x8f\x58\xfa\x60\x5f\x32\xde\x25\xf0\x70\x23\x26\x5f\x48\xe5\x0f\x44\xbd\x1a\xf9\x5d\x44\xbd\xdd\x31\x78\x4d\xfb\xe0\x05\xb0\x06\x60\x32\x00\x8d\x5e\xdf\x95\x7f\xf9\x82\x97\xad\x97\x72\x35\x6a\xbc\xbe\xde\xb5\x8e\xf0\x6b\xe4\xfb\xee\x9f\x63\xce\xf2\xb0\x52\x7f\x64\xb1\x92\x87\x95\x04\x86\x09\xc7\xc9\x84\x52\xc9\xdc\xb4\x02\xfb\x28\xb3\x56\x62\x1f\x65\x66\x9f\x02\x94\xd5\x3f\x75\xec\xef\x1a\xed\x23\x7b\xec\xb8\x25\x49\xe6\x7c\xec\xf8\x1e\x05\x11\xb9\xb2\x29\x67\x12\x52\xf3\xa6\x9c\xed\x35\x23\x25\x03\xb5\x7e\xcf\x18\x6d\xce\x59\xb7\x72\xce\x2a\x46\x15\x08\xef\x1f\x92\x64\x31\x0a\xd5\x90\x50\x26\xcb\xba\x94\x4b\x06\x9e\x1e\x1d\x1f\x6b\xb7\x5f\x72\x46\xd9\x8c\x44\x5c\xc3\xc2\x85\xee\x04\xad\x17\x1a\xaf\x79\x97\xe7\xc7\x63\xa9\xcb\x0e\x1a\x1e\xc9\x05\x8c\xd7\x0b\x8e\x63\xd6\xe3\xf9\xd1\x98\x6d\x0d\x60\xa9\xf3\x94\x05\xf2\x2d\x1b\xe9\x8f\x4d\xb9\xac\x3a\xb0\xac\x79\xca\xe8\x8a\xea\x36\x6b\xe7\x29\x36\xe5\xb2\x89\x52\x0f\xb6\x5b\x26\x3f\x52\x05\x0b\x4e\x7e\x6c\xda\x65\x56\xb6\xfc\x39\xd1\x60\xa7\x19\x40\x19\xbf\xf5\x9b\x13\xd9\xbc\x4b\x9b\x77\xd9\xf1\xbc\xcb\x06\x6e\x54\x28\x7c\xf5\x8f\xf3\x1f\x47\xea\x65\xc6\x8a\xe6\x04\xc7\x66\x5f\xde\x79\xf6\xe5\x52\xf0\xb1\x29\x64\x1d\x4d\xc0\xcc\x71\xb2\x39\x98\x77\x9c\x83\xb9\x90\x17\xd9\x34\xcc\x4e\xa7\x61\xca\x44\xc1\xbc\xb6\xba\x9e\x40\xbd\xe9\x9c\x23\xe5\x10\x99\x17\x5c\xd7\x13\xa4\xdf\xba\x0a\x92\x71\x19\x76\x3d\x31\x7a\xdb\x1d\x8c\x6c\xca\xb9\x4d\x39\x5f\x93\x94\x73\xed\x51\xd8\x45\x44\xce\x58\x3b\x86\x09\xa2\x43\xe1\x3d\xdd\x7a\x2e\xb6\x56\x89\xe5\x39\x24\x8b\xc3\xf0\x40\x34\x7c\xb7\x0f\x79\x97\x6b\xd9\x0b\x25\x8c\x2b\xc0\x3e\x2a\x08\xef\x3b\x3b\x7c\x69\x2e\xf2\x50\xa3\xd2\x52\x33\xc6\xef\x77\x34\x58\x28\x2f\xfc\xf1\xba\xd0\x6c\x49\xe0\xf3\x22\xba\xc4\x34\x30\xf0\xfd\x2f\x80\xa3\xa8\x89\x1e\xd2\xeb\x62\xda\xfc\xbd\xbb\x89\x5e\x8f\x42\xdf\xda\x54\xbc\xb3\x89\x5c\x8f\x42\xdd\xda\xac\x7a\xf5\x6f\x94\x34\x26\x64\xd8\x97\x4a\x94\x6e\xdb\x97\x4a\x56\xdc\xe8\x87\xfd\x52\xc9\xd6\xc0\x68\x1f\x59\x5e\x95\x92\xf3\x31\x5f\x5e\xd5\x07\x44\x39\x3b\xb4\xef\x93\x2c\x92\x3b\xb5\xb5\xd5\x0c\x92\x8c\xd1\xfa\xe5\x4e\xd9\xf7\x49\xba\xf5\x3e\x09\x26\x90\x22\x89\x70\x85\x99\xfb\xb3\x79\x99\x8b\xf0\x39\xca\x26\x62\xd9\x9b\xc3\xd2\x74\xec\x1f\x13\xd7\xfa\xa7\x9c\x90\x7d\x79\x52\x30\x9d\x93\x67\x19\x9f\x62\xf2\xfb\x4c\x8b\x67\xb7\x5b\xa7\x9d\x87\x8f\x32\xb0\xc0\x2b\x18\x7a\x99\xa3\xad\x9c\x7f\x3d\xf2\x74\xf8\x57\xb1\x4d\x86\xb7\xc9\xf0\x33\xf3\x81\xed\xb6\x5f\x9e\xd8\xb5\xa4\x0d\x74\x81\xb4\x6d\xb7\xfc\xf0\x84\x8c\x91\x25\x6d\x96\xb4\x75\x93\xb4\x89\x55\x8f\x00\x53\xcb\xda\x24\xbd\x77\x96\xb5\x7d\xcc\xd1\xb2\xb4\x0d\x58\xda\x66\x69\x5b\x17\x69\x5b\xdb\xef\x46\x48\x2c\xc3\xd2\xb6\x55\xd2\xb6\x96\xdf\x87\x90\x31\xb2\xb4\xcd\xd2\xb6\x6e\xd2\xb6\x62\xad\x8d\xb5\x8f\x8f\xa7\x96\xb7\x75\x9b\xb7\x89\x9d\x13\xa3\x53\x90\xaf\xba\x79\xe0\x30\xc3\xcd\xb2\x37\xcb\xde\xea\x4d\xb6\xec\x6d\x75\xec\x6d\xa7\x6d\xfb\xbd\x9f\x2c\x7b\x03\x5d\x60\x6f\x3b\x2d\x3b\xef\xc9\x18\x59\xf6\x66\xd9\x5b\x37\xd9\x5b\xb9\xe8\x66\xe9\x9b\xac\xf9\xce\xd3\xb7\x62\xf9\x8d\x5a\x06\x07\x2c\x83\xb3\x0c\xae\x83\x0c\x6e\xb7\x6d\x0f\xb1\x9f\x2d\x83\x03\x5d\x60\x70\xbb\x2d\xfb\x84\xc9\x18\x59\x06\x67\x19\x5c\x37\x19\x5c\x7d\xfd\xcd\x23\x11\xbf\xad\x25\x72\x0f\x83\xc8\xd5\xd7\xe1\xc0\x41\x86\x9f\x65\x73\x96\xcd\xd5\x9b\x6c\xd9\xdc\xea\xd8\xdc\x5e\xcb\x9b\x0b\x03\x79\x34\xb0\x6c\x6e\x75\x6c\x6e\xaf\xe5\xcd\x05\xa5\x55\x96\xce\x59\x3a\xd7\x4d\x3a\xa7\x2d\xc8\x59\x3e\x27\x23\xd0\x79\x3e\x67\x58\x98\xb3\x94\xce\x52\x3a\x4b\xe9\xea\xd5\x67\xff\xf3\x5b\x70\xaf\xe7\x2e\xcb\x55\xd2\xef\x15\x28\x3a\x89\x3b\x41\x01\xfc\x8c\x48\xc2\xdc\xd2\xa9\x1e\x7c\x66\x3b\x5e\xf3\x93\x3d\x58\x72\x26\x16\x83\xc7\xb2\xa9\x38\x7c\xc3\x0e\xc2\xe2\x2a\x4a\x36\x03\x7c\x89\xab\xfd\xc6\x9c\xad\xc4\x85\xec\x7a\xa5\x05\x14\x05\xb1\x0f\x29\xdf\xee\xaa\xea\x25\x1b\x2a\x13\xaa\x98\x9f\xba\xfb\x77\x49\x0c\x6a\x00\x53\x74\x99\x47\x42\xf0\xfc\xa0\xe4\x4a\x1f\x98\x73\x00\x3d\x22\x0a\x1f\x68\x3e\xbb\xc9\xa6\xf8\xee\xda\x48\x8b\x08\x38\x74\xfd\xd4\x43\x2f\x7d\x33\x99\x68\x32\x2d\x27\x48\x59\x3c\x32\x5e\x92\xbb\xb4\x63\xe4\x7c\x0a\x1f\x50\xb7\x11\x73\xfe\x4e\x11\xe1\xac\xcc\x89\x59\xc0\x45\x74\x82\x52\xd5\x3d\x25\xd0\xfb\x35\xf9\x18\x71\x63\x56\x77\x10\x70\x92\x33\x1c\x7f\x22\xfe\xf1\x55\xe8\x1a\x1b\x5a\x84\x37\xa9\xa1\xa6\x98\x52\xb3\x60\xff\x73\xae\x7e\x4d\x25\xb3\x70\x5e\x7e\x1a\x3a\xc5\x21\xa6\x99\x85\xd6\x9a\x9c\x43\x34\x98\x07\x22\x27\xdf\x27\xc2\xb9\x25\x4e\xc6\xcb\x6e\x06\x49\xdc\x76\x98\xc5\xe3\x6a\xaf\x1b\x1c\x9e\x46\x1b\x20\xaf\xf2\xd9\x9d\xc2\x97\x0f\x70\xaa\xae\x98\x4f\x0b\x7c\x92\x3f\x8b\x76\x3a\xf5\x72\x43\xa7\xb8\xb4\xe9\x82\xdc\x48\xb2\x7e\x2b\x45\x69\x82\x3e\x66\xd5\x35\x32\xed\x99\x6d\xa7\x39\x32\x98\x1a\x5c\x8b\x18\x1f\x0a\x5a\x77\x53\xb0\x50\x4e\xbc\x93\x38\xe1\x94\x14\xf3\xb6\x66\xd8\x70\xa1\x64\x88\x4a\xc7\x54\x35\x09\x02\xef\x23\x97\x0a\x32\xa4\x6f\x46\x32\xbb\xc2\x66\x55\x99\x3c\xee\x29\x23\x9f\xec\x23\xad\xf7\x9a\x39\x3e\xb9\xcc\x99\x18\x03\xed\x48\x6c\x9a\xcf\x2c\x58\x6c\xb8\xad\x41\x68\x97\x2c\x12\x93\x6e\xb9\x9f\xce\x54\x4c\x8f\x6c\xfc\x9a\xd9\xbe\x60\x4a\x23\x33\xb1\x63\x05\x43\x37\x4a\x45\x6c\xdb\xea\xe9\x65\x19\xdd\x94\x58\x5c\x5e\x38\xa3\xc5\x2e\x32\x62\xce\x41\x6a\x8a\xb9\xed\x1d\x07\xa9\xed\x49\x4b\x58\x62\x85\xb7\x0d\x44\xb5\xfa\x24\x53\x1e\x2c\xd1\x94\xfb\xf7\x63\xca\x66\x0c\x1a\xad\x39\xff\x96\xcd\x17\xa7\x05\x7b\xc7\x02\xcf\x92\xb7\x9f\x66\x73\x7c\x27\x8c\x2e\x36\xfb\x95\xb2\x1c\x61\xd5\x5c\xea\x68\x97\xc7\x98\xcd\x77\x89\x5c\x49\xae\xd5\x61\xd1\x40\xd5\x20\x9c\x1d\x25\xe5\x46\x35\x78\x67\x4b\x3d\xec\x07\xf2\xd1\x8e\x72\xd4\x57\x0f\xb7\x7a\x6a\xa9\x32\x53\x1f\x28\x47\x7d\xaf\xb0\x9c\x93\xaa\x87\x7c\x21\xc7\x64\xc0\xed\x77\x55\x6f\xb3\xab\xde\x46\xbd\xeb\x60\x5b\x3d\x54\x16\x36\xf6\x3c\xb5\x2f\x55\x0b\x35\x85\x7f\x8b\xc4\x52\x5a\x61\x15\xd5\x5a\x4a\x7d\x98\x05\x2f\x40\x36\x63\x63\x5f\xfe\x57\x4e\xea\xd8\xc1\xeb\x28\x88\x53\x8a\xc0\x51\xbe\x0f\x23\x97\x1d\x96\xc3\x8c\x93\x8a\x85\xdf\xa6\x51\xdb\x39\xaf\xe6\x95\xdf\x4d\xff\x05\xd7\xe1\x8b\x50\x80\xa3\x00\x00")
 
 func monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -184,7 +184,7 @@ func monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTpl() (*asset, error
 	return a, nil
 }
 
-var _monitoringSystemGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xdb\x38\xb2\xf6\xf7\xfc\x0a\xbc\x9c\x99\xb5\x33\x2b\xd9\xa2\x2e\xf1\xa5\x2a\xf5\x56\x9c\x4c\xce\xec\x56\x92\xf5\x38\x9e\xd9\x9a\x93\x4a\x69\x21\x12\x96\xb0\x26\x01\x06\x00\x6d\x29\x2e\xcf\x6f\x3f\x45\x90\x14\xc1\x9b\xae\xd4\x1d\x5f\x12\x0b\x20\x41\xb0\xbb\xd1\xcf\x03\xa0\xd1\x7c\x7a\x01\x80\x01\x09\xa1\x02\x0a\x4c\x09\x37\x2e\x41\x50\x04\x80\xe1\x60\x2e\x8c\x4b\xf0\x45\xfe\x02\x51\xa9\xac\xe9\xf9\xd8\x11\xff\x20\xc6\x25\x30\x6b\x49\xa9\x0d\x05\xe4\xd4\x67\x16\x32\x2e\x81\x51\xaf\x83\xff\x61\xf0\x0e\x12\x08\xea\x75\x43\xb9\x0c\x11\xd8\x73\x82\x4b\x04\xf3\x91\x52\x3e\xc0\x76\x41\x29\xb6\x28\x79\x4b\x1d\xca\x82\x36\x59\xbf\x07\x8f\x1b\x35\xd0\x34\xcd\x1a\x68\x76\x3a\x35\x60\xbe\x54\x9b\x26\xd0\x95\xcf\x7e\x93\xbc\x0e\xf8\x1b\x78\xe3\x20\x26\xb8\x7a\x9d\x18\x79\xf2\x3a\x1b\xf2\x41\x8f\x42\x66\x1b\x51\xdd\xb3\xfc\xff\xeb\x0b\x00\x9e\x83\xcb\x0d\x1b\x71\x8b\x61\x2f\x68\x29\xb8\x5e\xb6\x61\x20\x1b\x8b\xcc\x2b\x18\x7d\x82\xc4\x3f\x6c\xe3\x12\x10\xdf\x71\xc2\x12\x06\xbd\xc1\x2d\xa5\x8e\xc0\x9e\x71\x09\x1a\xb2\x10\x07\x97\x9c\x85\x7f\x0a\xc4\x60\xd4\xb0\xf9\xaa\x61\x5e\x9c\x75\xcc\x8e\x69\xb6\x2e\x64\xad\x83\xc9\x7d\xa0\x8a\x2f\x5f\xe5\x4f\x0f\x12\xe4\xf0\xb1\x32\x62\x55\x18\x16\x75\x1c\xe8\x71\x14\x34\x7b\x07\x1d\x3e\x96\x9c\xd1\x67\xd8\xbe\xa6\x89\x36\x43\x11\x67\x34\xf6\x68\x5c\x82\x66\x5b\x29\x18\xc6\x3d\x8d\x7e\x8f\x82\xdf\xb1\x6c\xc6\x6d\xcb\xb7\x38\x1f\xff\x4c\x3a\xf7\x75\x5c\x26\xb0\x90\x02\x32\xfe\x8d\x7a\xe0\x8d\xe7\x19\x49\x4d\x24\x7b\x46\x1f\x43\xa9\x47\xed\x8e\xdf\x09\x3a\x18\x72\xa9\x72\xd9\xfb\xe4\xb1\x3d\x28\x4b\xd2\xef\x19\xa8\xf0\x03\x22\x7d\x21\xdf\xad\x91\x2a\x47\x45\x97\xab\x36\xfa\xa3\xf2\x73\x7c\xc9\x1d\x76\x1c\x55\x4e\xe5\xa2\x3c\xcf\x88\xd2\x6c\x4e\x11\xa5\x59\x2c\xca\x8b\xf1\x4f\x07\xf5\x11\xb1\xd3\x4f\x82\x0f\xfd\xec\x6b\x04\x9a\xf7\x19\x43\x44\x14\xd4\xb8\x70\x58\x54\x8a\x49\x41\x29\x1f\xd0\xc7\xfc\x98\x13\x54\x40\xa7\xe0\xea\x07\xe8\xf8\x89\x4c\x73\xef\xe2\x60\x22\x6b\xd5\xd6\x64\xe1\x23\xb6\x45\xca\xf4\x32\xe6\x2d\x8b\x82\x81\x73\x4d\x31\x11\x1f\xa9\xf4\x03\xb2\x20\xd1\x0a\xf5\xc6\xde\x29\x79\xa2\x87\x98\x85\x88\x80\x7d\x94\x53\xb4\x17\x34\xc5\xa0\x8d\xfd\xe0\x9e\x66\xba\x3c\x6f\x17\x0c\x11\x1b\x31\x24\xbd\xcc\x9d\x43\x45\xf2\x60\x8e\x18\x46\xfc\x5f\x0f\x88\x31\x6c\xa3\x4c\xa7\xb9\x07\x2d\x54\x64\x7e\x5c\x40\xeb\x3e\x2b\x0b\x2e\x90\xe7\x21\xfb\x03\x26\xf9\xfe\x0a\xc8\xfa\x48\x70\xc5\xdf\xaa\x1e\x37\xf0\x3a\x43\x4f\xf6\x8e\xfb\xee\x31\x83\x02\x1d\x33\x88\x1d\xde\x65\xe8\x9b\x8f\xb8\xe0\x5d\xa9\xb4\xa7\xc0\x03\xca\x4e\xbd\x3e\xfa\x71\xfc\xf7\x51\xcd\xa3\xf6\xeb\xbf\x8e\xf8\x88\x0b\xe4\xd6\xa1\xe7\xd5\xbf\xc0\xfa\xf7\x46\xfd\xe2\xeb\xdf\x93\xbf\x8e\x9e\xbf\x98\xee\xd7\x97\x2f\x41\x6f\x04\x8e\xb9\x80\xc2\xe7\xaa\x73\x0d\x46\x06\x65\x2e\x0c\x4c\xce\x10\xd8\x45\xdd\x50\x32\xe9\x4b\x30\x11\x88\x3d\x48\xeb\x31\x4c\xb7\xb8\xee\x3d\xb4\x84\xf4\xe7\x66\xaa\x3a\xb4\xfd\xf7\xe3\x67\x3c\x3d\xfd\xe7\xe9\x29\xec\xc7\xf3\xf3\x7f\x9e\x9f\xd3\x8d\x31\x74\x27\xfd\xad\xf1\xc6\x18\x17\x3f\x47\x7f\x29\x6e\x68\xc0\x10\x1f\x50\xc7\xce\xb9\x27\x17\xbd\x67\xd4\x55\x1c\xf6\xb8\xfc\x06\xf5\x23\x4b\xcb\xdc\xf0\x79\x80\xef\x44\xfe\x8e\xc8\xd1\x7d\x96\xc2\x05\xb1\x3e\x80\x87\x18\xe0\xc8\xa2\xc4\x06\xc7\xbd\x11\xc8\x0a\xd4\x10\x63\x68\x78\x52\xc7\x23\x64\xd2\x99\x67\x46\x24\xa7\x4c\x64\xfc\x89\x1c\x8c\xdd\xd8\x9b\x62\x62\xe3\x07\x6c\xfb\xd0\x31\x72\xe3\x32\xbe\x46\x22\x52\xd2\x81\x21\x1c\xe2\x8c\x53\xeb\xf9\xd6\x7d\x68\x84\xea\x3b\x06\xde\x23\x1a\x93\x81\x18\x0a\x00\x37\x73\x75\xb1\x57\x19\x7b\x8f\x2f\x5f\x73\x5d\x1c\xc1\x21\x9a\x60\xfb\x36\xb2\xb0\x0b\x25\xc6\x98\x25\x16\xc9\xd0\x37\x2f\x63\x8b\x0e\xec\x21\x67\x0c\xdb\x49\x31\xed\x5f\x41\x8e\x72\x6d\x85\x7e\x33\xfd\x2a\x63\xc7\x99\x2b\x56\xde\x31\xb1\xbf\x5a\x71\xf7\x93\x5e\xf2\x41\xa0\xc8\xc2\x5e\xe6\x9e\xb0\xc2\x7e\xe6\xc6\xc9\x28\x6f\x0b\xd0\xc1\xfd\x22\xc8\x90\xe5\x1f\xd0\xc3\xb8\xd3\x29\xea\xb4\xc7\x60\x9e\x2a\x98\x80\xe6\x4a\xbf\x35\x9c\x6b\x38\xdf\x0a\x38\xb7\x28\x11\x8c\x3a\x0e\x62\x9b\x87\xf4\xa4\x2f\x7b\x00\xeb\x45\x82\xd5\xd0\xae\xa1\x1d\x68\x68\xdf\x21\x68\xcf\xce\xd3\x2f\x4a\x90\xdd\xd4\xc8\xae\x91\x7d\xf3\xc8\x5e\x0b\x27\x93\xaf\xff\x3a\x6a\x7e\x09\x4a\x7e\xd6\x58\x5f\x25\xd6\x37\x87\x43\x8d\xf7\xe3\xb7\xd0\x78\xaf\xf1\x1e\xec\x36\xde\xbf\xca\xc0\x7d\x6e\x26\x5f\x86\xf7\x4d\x8d\xf7\x15\xe0\x7d\xea\xb1\x5b\x02\xf7\xe9\xa7\xec\x0c\xde\xb7\x35\xde\x57\x8f\xf7\x6d\x8d\xf7\xca\x5b\xec\x1d\xde\x1b\x0d\x43\xc3\xfd\x41\xc3\xfd\xf9\x8c\x70\xdf\xd2\x70\xbf\xaf\xd3\xfb\x1d\xc5\xfb\x8e\xc6\xfb\xea\xf1\xbe\xa3\xf1\x5e\x79\x0b\x8d\xf7\x85\x9d\xd4\x78\xbf\x33\x78\x3f\x3d\xec\xee\xac\x04\xf0\xdb\x1a\xf0\x35\xe0\x2f\x09\xf8\x5d\xdb\x0f\xe3\x78\xbb\x21\x8c\xf0\x2e\xf7\xdd\x4a\x36\xee\x4f\xc1\xac\x4f\xb4\xa8\x4f\x44\x15\xcf\x5c\x98\x50\xcc\x48\x1a\x6e\xa5\x81\x4f\x67\x09\x25\xee\xb9\x44\x13\x76\xaf\xcb\x7c\x12\xf5\x71\xc5\x3a\x28\x78\xd6\xce\x48\xff\x8d\x25\xf0\x03\xba\x41\x16\x65\x76\x89\x12\xae\x16\x56\xc2\x03\x46\x8f\xeb\x53\x43\xe1\xd3\x76\x46\x11\x7f\x60\xf4\x58\xa2\x80\xb7\x5b\xc2\x95\x6f\x02\x31\x03\xf8\x80\x18\xec\x23\xc0\x10\xf7\x28\xe1\x08\xa4\x58\xa5\x26\xc6\x8b\x11\xe3\x1c\xf5\x53\x68\xa7\xde\xf7\xda\x36\x62\x6c\x41\x66\x67\x1a\x0e\x8a\xae\xa1\x6d\x63\xd2\xcf\x5b\x4e\x50\x79\x43\x7d\x62\x67\x1a\x1f\xf7\xd4\x8a\x8e\x33\x65\x1a\x1c\x9f\x72\xfa\xa1\x73\x76\xd1\x7e\xdf\x54\x6d\x54\xde\xf2\xd9\x82\xe1\xd8\xe4\xdf\x52\x1a\x88\x6b\x07\xc8\x8d\xc6\x91\x40\xcc\xa3\x0e\x14\xe8\x4a\x9a\xab\x72\x29\x1a\x7a\x94\x84\xf4\xb5\x71\xd2\x29\x18\x1d\xd4\x83\x16\x16\xa3\xfc\x08\x0c\x38\x7b\xe2\xc1\x04\x8f\xc7\xd9\x3c\x1c\xbf\xe8\x38\x15\x58\x8e\xeb\xe7\xa3\x72\xf3\x64\x7f\x80\xa0\x70\xa1\x97\xa6\xb0\x03\x6c\xa3\xff\x45\x8c\x5e\x8d\xfd\x45\x9a\x16\x0e\x70\x7f\xe0\xe0\xfe\x40\xbc\x8d\xf4\x9f\x62\xd7\xe1\xfc\xa1\x33\x71\xfe\x10\xd9\x6d\x29\x67\xcf\x12\xf1\x42\xa6\xcd\xd0\x03\x62\x1c\xfd\x59\xd6\xcd\x39\xd9\xeb\x44\x1a\x19\xaa\x74\x66\x00\x3d\xf9\xf9\xe8\x39\x5c\x92\x72\x50\x29\x64\xc6\xb2\x2f\x84\xcb\xd9\x96\xa1\x1a\x13\xb1\x54\xae\x43\x39\x68\xf1\xf5\xa7\x09\xd8\x39\x05\x22\x0b\xd6\x90\xa2\xd7\x95\x6b\x49\x29\xd0\x04\xa1\x70\x01\x26\xd1\xa5\x33\x9c\x05\x29\x42\xa0\xa0\xf4\x57\xcc\x05\xed\x33\xe8\x96\x5a\x57\x0c\x97\x59\xe9\x1b\xc3\x37\x39\x2f\x99\x77\xaf\x49\x3b\xc3\xd0\xec\x3e\xf9\x6e\x4f\x4e\xd1\x52\x82\x88\x2a\x3f\xe3\xef\x59\xfc\x34\x46\xf9\xc7\x28\xf8\xa7\x52\x80\x62\xe8\x2b\x46\x8e\x42\xdc\x28\x44\x8d\x32\xe1\x79\x0e\x16\x63\xc3\x2a\x74\xce\xa3\xf0\xa5\xae\x22\x07\x6e\x40\x5f\x50\x23\x5b\x5b\x2c\x8f\x51\x4e\x1e\x1a\x4f\x96\xc0\x93\x52\x4c\x38\x9b\x77\xfd\xa7\xd9\x59\x1f\x24\xbc\xda\x39\x48\x98\xbe\xbc\x30\x27\x2e\x14\x6e\x69\x24\x0b\xeb\xaf\x8f\x08\x15\xf8\x0e\x5b\xe1\x01\xed\xd4\xde\xc6\x61\x02\xc9\x27\x55\x1e\x85\x5b\x13\x1a\x56\x80\x86\x15\x0d\x2b\x6b\x9a\xa6\x2c\x8e\x3c\xb9\xd9\xc8\x3a\xa1\xe7\x4c\x43\xcf\x54\xe8\x11\x88\x40\x22\x34\xe8\x00\xe3\x36\x94\x84\x86\x1b\x0d\x37\x1a\x6e\x76\x14\x6e\xb2\x13\x9d\x56\x73\x7d\x68\x73\xae\xd1\x66\x2a\xda\x84\x71\x5c\xa7\x0e\x7e\x40\x1a\x71\x8c\x0f\xf8\x01\x11\xc4\x35\xe4\x68\xc8\xd1\x90\xb3\xab\x90\x93\x9b\xe1\xac\x13\x73\x26\x27\x4a\xd3\x98\xa3\x60\x0e\x43\xd0\x1e\x69\xd0\x31\x6e\x10\xb4\xb1\x46\x1d\x8d\x3a\x0b\xa3\xce\x1a\x52\x6c\xb6\x4a\x4e\xa4\x34\x93\xb8\xc6\x89\x49\x36\x3f\x63\x1b\xdd\xe3\x6f\x46\xce\xa0\xf6\x30\xc9\xe6\x54\x61\xb6\x4b\x12\x96\x36\xa7\x64\xef\x70\x70\x9f\xbc\xe1\xb7\xc5\xc9\x69\xc3\x58\xe0\x4c\x61\x12\x0a\x9c\xa9\x98\x27\x12\x98\x05\x98\xf7\xb9\x30\xf7\xed\x82\x41\xc2\x85\xae\x41\xc7\x08\xaf\x37\x46\x98\x87\x43\xb2\xfb\x5f\xda\xe3\x5d\xee\x5b\x16\xe2\x93\x8f\x06\x2d\x1a\x7a\x18\xa5\x4d\xce\x58\x43\x11\x46\x37\x27\x42\xf4\xe7\xb0\x8f\x77\xbe\x03\x82\x3e\x57\x18\xa4\x9b\x12\xc5\x1d\xc4\x0e\xb2\x57\x22\x89\x39\x5f\xf8\xbd\xec\xc9\xa4\x97\xbd\xda\x92\x58\xcc\x7f\xd2\x1e\x07\x1e\xa3\x81\x7e\x90\x0d\x02\x86\x3a\x8a\x68\xc9\x72\xb1\x98\xcd\xac\xfb\x38\x90\x58\xcc\xc4\x98\x68\xd9\x49\xa4\x6a\x62\x19\xf5\x59\x24\xb5\x5c\x9f\x45\xba\x04\xed\xf3\x12\x76\x32\x25\xd7\x48\x85\xec\x24\x40\x8c\x5f\x5c\x4f\x8c\x8a\xab\x82\x49\xfa\xa1\x52\x1a\x00\x39\xf8\x1e\xbc\xff\xae\x53\x9b\x15\xa6\x33\x5b\x94\xd9\xc8\x45\x88\x6f\x3e\xf2\xcb\xd7\x21\x56\x4e\x72\xae\xc7\x20\x8a\x09\x90\x8b\x12\xb2\x43\xb3\xae\x4b\xac\x98\xf1\x2c\x23\xa1\xc5\xc8\xcf\xcc\x52\xd8\x52\x2a\xd4\x1b\x01\xd9\x75\x4d\x83\x34\x0d\xd2\x34\x68\x8b\x69\x50\x6e\xdb\xa0\x94\x07\x4d\x49\xc2\xa2\x79\xd0\x3a\x78\x90\xe6\x3f\x63\xd9\x57\xcb\x7f\x1e\x29\xbb\x5f\x36\x71\xcb\x52\xc0\x2f\xe1\x3e\xec\xc5\x2e\xa4\x6c\xc9\xe3\xfd\x7f\x69\x0f\x48\xe8\xd5\x90\xaf\x21\x5f\x43\xfe\xf6\x42\x7e\x76\xe5\xa3\xf3\xaa\x04\xf1\xa7\x64\x61\x99\x01\xf1\xcb\x73\xb4\xcc\x0e\xf9\x1a\xd8\x0f\x14\xd8\x5d\x38\x4c\x63\xfa\x23\xc4\x02\x93\xfe\xc4\x74\x12\xcf\xeb\x9c\xac\xcf\xbf\x50\xb1\x49\xc8\xfe\x2d\xe8\x28\xe0\xf8\xbb\xf2\xd6\x1a\xa3\x17\xc2\xe8\x95\xc3\x9f\x46\x69\xb5\x7c\x67\x50\x3a\x93\x47\x21\x1c\x71\x0e\x14\x88\x58\x23\x80\x39\x10\x03\x04\x6c\x7c\x77\x87\x18\x22\x16\x52\x82\x98\x00\xc7\x41\x41\x50\x4f\x1d\x1b\x71\x21\xe9\x34\x26\xb2\x44\x7a\x18\xf0\x08\x39\x40\x44\xfe\x6d\x9f\x80\x7f\x31\x30\xa0\x8f\xc0\xa1\xa4\x1f\x5e\xca\x41\xe4\x1e\x01\x16\x1c\x08\x9f\x11\x20\x28\xe8\x21\x80\x86\xc8\xf2\x05\xb2\x4f\xd6\xb3\x7e\x50\xca\x26\x26\xe7\x64\xd0\x6c\x42\xb3\x89\xd5\x46\x80\xa8\x74\x42\x8e\xa2\x6e\x34\x30\x35\x93\x58\x8c\x49\x84\xe2\xc3\x9a\x4d\x2c\xcd\x26\x34\x93\xd0\x4c\x62\x7d\xf3\xfd\x57\xed\x12\x84\x9e\x9c\x22\x43\x67\x5d\xd5\x78\xaa\xe2\xe9\x20\x8e\x9e\xef\x7e\xf3\x21\x11\xd8\x41\xc7\x8d\x93\x8b\x4e\x0d\x14\x2d\xc8\xe7\x52\x42\x4e\x3c\x5d\x91\x39\x1d\xf1\x12\xac\x1a\x7e\x2f\x3a\x3f\x81\xf8\x2d\x00\xbd\x03\x51\xcf\xc1\x96\xe3\xf0\x3f\x69\x2f\x5e\x83\x0f\x88\x7f\x9c\x14\x52\xe7\x82\xd4\x98\xac\x31\x79\xab\x31\x39\x37\x6d\x2e\x05\xe5\xc9\xc9\x43\x34\x28\x6b\x50\xde\x00\x28\x87\xfb\xd4\x35\xb0\x16\x70\xde\xbd\x9d\xf1\x32\x50\xd6\xdb\xe4\x40\x03\xb4\x06\xe8\xa8\x70\xab\x01\x3a\x3b\x69\x3e\xcb\x9f\xa6\x0f\xf1\x79\x72\xba\x95\x35\x85\xc5\xe9\x55\xed\x9d\x00\xfc\x75\x05\xbf\xc5\xdb\x44\x5b\x16\xdb\xbe\x5b\x8b\xdc\xd1\xd9\x6d\x79\x0e\x11\x78\x3e\x1f\xe8\xb0\xf6\xea\xc0\x5b\xef\x9f\x6b\x00\x5f\xf3\x0c\xbb\x14\xc1\x27\x27\xaf\xd9\xcd\x8d\xe9\xec\x1d\x1a\xc3\xb7\x17\xc3\x73\x71\x6e\x0c\x09\x36\x9a\x12\xe5\xa6\xb1\x3a\x9d\x4c\x48\xc8\x93\xf7\x42\x2f\x7b\x6b\x60\xde\x47\x60\x5e\x47\xb2\xa3\xf3\x92\xfc\x3c\xad\xc9\xc9\x8e\x18\xf2\x50\xa8\x15\x1b\x79\x0e\x1d\xb9\x88\x88\xb7\x94\xdc\xe1\xbe\x91\x1f\xa8\xd7\xd4\xe6\xe0\xf8\xc7\xec\x95\x2f\xe7\xc8\x90\x64\x41\x6b\x80\x6e\xb1\x8b\xa8\x9f\x73\x08\x32\xcf\xdf\x15\xb4\xee\xfb\x2c\x4a\x3c\x95\x72\xd7\xb2\xfa\x8f\x60\x9c\xe4\xc4\x68\xc5\xa4\x27\x19\x22\xc6\x0f\xef\x9b\xed\x8b\xce\x5b\x75\x40\xb2\x7e\x0f\x1e\x37\x5b\x67\x35\x60\x36\x2f\x6a\xa0\xdd\xa8\x81\xc6\xc9\xf9\x85\xea\x8e\x8d\x1f\x9a\x17\x17\x56\xfb\x95\x91\xb3\x8e\x99\x02\x08\xf3\xd9\xbd\x14\xbb\x27\x94\xa8\xc9\x02\xa1\x2f\x71\xf2\x29\xc5\x22\xe2\xf7\x33\x1b\x8d\x34\x91\x88\x2b\x1a\x33\xd0\x85\xd8\x75\x7f\x08\x46\x55\x0e\x5c\xd5\x2b\x3e\x42\x76\x8f\x58\x29\x85\x28\x35\xcf\x56\xc6\x3c\xb3\x1f\x87\xcf\x59\xa7\x99\x6b\x3b\x60\x53\x81\x21\xc4\x50\x5e\x98\x9b\xb0\x95\x70\x94\xc2\xb3\x5a\x45\xcc\xc5\x85\x9e\x87\x49\xff\x36\x34\x45\xb3\xa8\x7c\x82\x37\x8d\x9c\x76\xe8\x90\x81\xa0\x40\xa0\x61\xc6\x59\x3d\xc4\x3a\x9a\xea\xf3\xe2\xc6\x18\x24\xfd\x29\x8d\x35\x27\x38\x26\x17\x0e\xdf\x41\x01\xaf\x63\xae\xa4\x18\x47\x9e\xa7\x59\x94\x10\x64\x09\xa4\x24\xb1\x09\xae\xb9\x0d\x9e\x9c\x19\x70\xc5\x24\xce\xf1\xfb\x98\xfc\x81\x18\x8f\xe2\x60\x5f\x9d\x34\x4f\xda\x86\xc2\xd8\xb8\xb8\xc3\xc3\xb4\x1a\xa2\xc2\xf7\x94\xc4\x99\xdf\x8c\x4e\xe3\x27\xa5\x9e\xa1\xfc\x3d\xb2\xac\xf4\x16\x29\xb3\x8f\xd0\x9b\x84\x7c\x21\x0f\x49\x53\x53\x59\x23\xc2\xb7\x35\x3e\x9d\xbe\xc9\x54\xd0\xf1\x0d\x13\x04\xce\x3d\xc8\xee\x9d\x90\x17\x2a\x96\x1f\x4c\x70\xc6\x69\x4c\xa5\x33\x69\x99\x35\x60\x9a\xe7\x35\x60\x9e\x5f\x04\xce\xc4\x3c\x4f\x39\x93\x3b\xdf\x29\xe2\xed\x41\xcb\x6a\x3b\x61\x33\xcd\x46\x0d\x98\x17\xad\x54\x03\x13\x93\x6f\x8a\x60\x56\xf3\x96\x3a\xbe\x9b\xf9\xee\xdb\x5c\x0b\x50\xf7\x7e\x0f\x75\x19\xf2\x9c\xe8\x53\x29\x49\xd6\xcb\x6e\x98\xf4\xb2\x2b\x93\x5e\xc6\x97\xf0\xb2\x9c\x9a\x85\x4d\xbc\xfe\xeb\x28\x07\x17\xf5\x2f\xd1\x57\x41\x2b\xff\x20\xe8\x02\xcc\xd6\x30\x6b\x4d\xa3\x88\xdc\x1a\xad\x06\x37\x0a\x49\x6c\xb6\x26\x66\xb1\x3e\x21\x98\xf4\x81\x47\x6d\x9e\x07\x44\x8e\x49\xdf\x41\x81\x40\x93\x3a\x39\xf4\x55\xfb\x3f\x57\xed\x5f\xd6\x4e\xb6\x7f\x1a\x10\x64\xe3\x75\xb1\xe9\x37\x8a\xfd\xcc\x54\xdb\x97\x17\x7e\x8a\x1c\x57\x30\x43\x5e\x09\x8e\x5f\xc7\x0e\xa1\x00\xc8\xe7\xc0\xf8\x08\xac\xe7\xc5\xf8\x88\x1a\x68\x8c\x5f\x06\xe3\x5f\x55\x85\xf1\xcd\x22\x8c\x4f\x59\x94\x46\xf9\xca\x51\x5e\xa3\xf8\xc1\xa0\xb8\x87\xac\x55\xa0\x37\xa8\x03\xcd\x1f\xaa\xe3\x0f\xbf\x13\xf8\x00\xb1\x13\x58\x83\xe6\x10\x7a\x2d\x60\x7f\x78\x42\x6e\x2f\x67\x61\xa2\xd0\xd2\x8b\x01\x7a\x31\x40\xd3\x88\x15\xd1\x08\xb9\x65\x75\x1c\xff\x4b\x04\xc4\x04\xb1\xae\x8b\x5c\xca\x46\x5d\x9f\xc3\x3e\xea\xf6\x46\x02\x95\x02\x78\xf8\x51\x8d\x02\xb8\x2e\xf8\xb6\x46\x74\x3c\x93\x50\x1b\x55\x91\x92\x7a\x8b\x60\x5c\xae\x91\xdb\x98\x0b\x86\x7b\xbe\x40\x36\xa0\x04\x0c\x28\x17\x1a\xcf\xab\xc4\xf3\x05\xe7\xfd\x76\xbb\x0d\x5b\x50\xe3\xf9\x72\x78\x7e\x5e\x15\x9e\xb7\xf5\xc4\x7f\xfd\x88\xae\x11\x7b\x6f\x10\x5b\xc6\x9e\xf8\xee\xb1\x8d\x1c\x01\xc3\x89\xb8\x47\xed\x6e\x02\xde\xe3\x09\x38\x17\x90\x89\xc9\xf9\x14\xe7\xc3\xef\x2f\x1d\xf7\xeb\xcb\x28\xfe\xd4\xa3\x76\xe5\x18\x9e\x8d\x68\x29\x09\x61\x99\x3d\x81\x72\x75\x08\xff\x11\x0e\xe5\x04\x1d\xc4\x62\x05\xc7\x0e\xe4\x02\x74\x80\x8b\x89\x2f\x10\x2f\xd8\x0f\xdf\x37\xa8\xdf\x9d\x50\xc3\xec\xa7\xf7\xa6\x07\x52\x94\x9c\xe5\x6b\x4d\x49\x81\x33\xe7\x59\xbe\x95\xa7\xc9\xdd\xa2\x03\x80\x2e\x1c\x5e\x23\x76\x23\xfb\xd3\x2a\x87\xb6\x55\x7c\x2a\xa0\x93\x2e\x9f\x27\xd2\x70\x1c\x1a\x93\x02\xd5\xb0\xf4\x1d\x66\xc8\x8a\x12\x45\xa5\xaa\xb7\xee\x4c\xe1\x86\x16\x87\x57\x1d\xe1\x28\xed\xb8\x9e\x5a\x27\x55\xae\xfb\x80\xc9\x7d\x51\x1c\x98\x32\x33\x4c\x95\x07\x92\x96\x1a\x99\x4a\xf9\xf6\x74\xe7\x3c\x2b\x5e\xb9\x14\x5d\x20\xde\x42\xe0\x5d\x52\x54\x7a\x7b\x62\x41\x25\xf9\x64\xaa\x9a\xde\xce\xae\xa6\xbd\x59\xfe\xc9\x89\x89\x23\xbb\x9e\x5e\x82\x49\x0b\xe9\xdd\x96\xc4\x41\x5f\x53\x1b\x48\xf9\x83\x63\xe9\xde\x6a\x40\xea\xb7\x06\x7c\x12\xfc\xff\x12\x40\x62\x87\xcc\x53\xbe\x4d\xb2\xca\x14\xe0\x50\xd2\x9c\x3e\xd3\x34\x73\xe8\x74\xf1\xe7\x58\xd7\x1b\xac\x9c\x7e\xf0\x8e\x45\x54\xab\x6c\x51\x9f\x75\x2a\x9c\x80\xbc\x9a\x77\x02\x72\x91\x5f\x4e\x0b\x27\x20\xd5\x66\xf8\xd2\x13\x90\x6d\x3d\xe2\xb4\x3f\x13\x8f\x8d\x2d\x4c\xad\x6d\x5d\x4a\x9e\xb4\xf2\xa8\xbd\x0b\xe7\xac\xae\x67\x5d\xb4\xd2\x87\xaf\xf4\xe1\xab\xca\xa9\xc2\x3e\x1f\xbe\xba\x38\x2b\x81\xec\x24\xff\xd7\xd2\x87\xaf\xde\x5e\xff\x0e\x7e\x0f\xa6\x61\x4b\x9e\xc0\xda\x1d\xe6\x34\xf7\xd2\xed\x45\xc9\xd7\xcf\x5a\x53\xd2\xbc\x1c\x46\x1a\xb6\xfd\x5e\x85\x35\x14\xfc\xd8\x4a\x2e\x44\xa8\x8d\xba\x63\x56\x93\xe6\x43\x97\x09\x33\xb2\x3c\x3f\x5a\x6d\x89\xf3\xba\x49\x63\xb9\x7c\x7a\x02\x27\x9f\x7d\xf7\x06\x0a\x04\x9e\x9f\x15\xaa\xf4\xd7\xb2\xab\x30\xd5\xd0\xa4\x19\x0e\xa4\x17\xd3\xa4\xca\x56\x6c\x37\x49\xad\xc6\xce\x59\xaf\xc3\x6c\x39\x8b\xd2\xcb\x2d\x4a\xf9\x56\x91\x28\xb3\xd1\x29\x81\xef\x8b\x6a\x59\xd4\x6f\x3e\x15\x70\xad\x2c\xca\x92\x51\x1e\x99\x7e\xaf\x81\x5a\xdd\x29\xd1\x05\x66\x43\x0d\x2f\xa8\x8e\x74\x99\x8d\x92\x6f\xc6\xb4\x1b\x9a\x75\xad\x84\x75\xc1\x3e\x8a\x94\x9a\x02\xa3\xad\x65\x63\x16\xa3\xd2\x2e\xd3\xc9\x77\x26\x72\xb4\x01\x7d\xfc\x15\x41\x5b\x76\x21\x7d\x5b\x08\x94\x8a\x1d\x59\xd4\xc9\x78\x1b\x1b\x71\xab\x54\x9b\x15\xd2\x3f\x2e\x46\xce\x24\x54\x93\x3e\x22\x10\xc6\x6d\x1a\x55\xc3\xc1\x8c\x12\x76\xf4\xe7\x9f\x7f\xfe\x59\xff\xf8\xb1\xfe\xee\x1d\xf8\xf5\xd7\x4b\xd7\xbd\xe4\x19\xb6\xe5\x41\x21\x10\x23\xc5\x6d\xc5\xbe\x6a\x80\x6d\x1b\x91\xe9\x1b\x7e\xe3\x6e\xe5\x49\x4b\x2c\x50\xca\x22\xbb\xcc\x01\x51\x12\x8d\xfb\x75\x99\x17\x52\x36\x5e\x32\xc4\x31\xe4\x81\x99\x21\x1a\x55\xdc\x8e\x29\x95\xf1\x8e\x61\xc7\x01\x36\x7d\x24\x46\xee\xb2\xdf\x59\xc1\x07\x7d\x15\x11\xca\x70\x5b\xf0\x43\x36\xb4\xb0\x98\x2d\xa6\x44\x4c\x7c\xb7\x87\x58\xfa\x3e\x9f\x60\x85\x14\xcc\x27\xfd\x1b\xf4\xcd\x47\xb9\x8d\xc9\x43\x51\xc0\xd5\xf6\x28\x00\xfc\x74\x98\x2a\x78\x5b\xad\x0a\x22\x08\x92\x3f\xe7\x53\xc4\x07\xec\xe2\x43\x1d\x07\xef\x36\x3f\x0e\x42\xf1\x1f\xea\x28\xf8\x65\x1b\x46\xc1\x35\xb5\xb7\x4f\xfa\x69\x06\xbd\x90\xf0\x4f\xed\xd3\xa7\x27\x70\xf2\x29\x5e\xa3\x02\xcf\xcf\xb9\x82\x7a\x8b\x5b\xd0\x41\xf5\x7b\xbf\x87\x18\x41\x02\xf1\xba\x45\x5d\xcf\x17\xa8\xce\x50\x38\xcb\xe1\x75\x8f\xda\xff\xff\x01\xb2\x7a\xb2\xf4\x95\x2c\x7c\xfd\x2d\xa8\xf0\xa8\xfd\xfa\xc7\x6e\xd7\x42\xd9\xe3\x04\x8a\xc6\xbd\xac\x94\xd7\x3d\xda\xb6\x48\xc7\x8a\x58\x4e\x4f\x7e\x3e\x9d\x5f\x2e\x5c\x30\x4c\xfa\xb3\xc9\x25\xfa\x4b\x59\x54\xdb\xff\xc5\x53\x99\x75\x36\x55\x89\x09\x17\xb0\x20\xb7\xec\xfc\x2b\xaa\xa5\x7b\xcd\xa9\xf2\x25\x02\x5b\xd3\x1b\xf6\xf1\x30\xec\xb2\x88\x31\x49\x59\x5b\x94\xa5\x02\x02\xf7\x4e\x9c\x57\x55\x88\x73\xab\x8d\x17\x9c\x02\xad\xf2\x74\xc8\xec\x8a\x47\x90\x23\xb9\xd6\x61\x08\xf3\x9d\x1e\x3f\x87\xa5\xf0\x5f\xd6\xb3\x4d\x37\x7d\x37\x4e\x2e\xf2\x6f\x24\xa6\x89\x41\xc2\x03\x25\xe4\x55\x30\x26\x4e\x99\x62\xbd\x53\x77\x28\x3b\x75\x2f\xc0\xa6\x36\xd7\xcc\x56\x4e\xd5\xe1\x2e\x4d\xb2\x7b\xb1\xf4\xe6\xda\x47\x79\x58\x44\x47\x29\x4d\x53\x45\xc9\x09\xd3\x76\x12\x33\x7c\xc0\x1b\x66\xdb\x1e\x90\xb4\x95\xc1\x45\x33\x1e\xd8\x5a\x9c\x6e\xd4\xc0\xf8\x11\xff\xef\xf5\x91\x0e\x1d\xaa\x2e\x74\x48\x75\x9a\x3a\x7a\x68\x39\x4e\x22\xed\x5d\x73\x92\x83\x8c\x1e\x6a\x96\x1c\x9b\x6a\xb7\x2a\x27\x38\x3a\x80\xa8\x52\x3e\xd4\x2c\xf9\xb6\x57\xbb\xad\xf9\xd0\xee\x86\x0a\x6d\x2a\xec\xa7\x30\xd9\x99\x8e\xfa\x19\x77\xab\x90\x6f\xc4\x22\xdd\xf8\x86\xe3\x0e\x06\xfe\xd8\xc8\x0a\x79\xc7\xdc\x3a\xd0\xe1\x3f\x5b\xa5\x86\x43\x0d\x7f\xd8\x8a\x20\xa0\x48\x17\x3a\x0e\xa8\x32\x3d\x2c\x3e\x20\x74\x34\x50\x85\x6a\xd0\xd1\x40\x3a\x1a\x48\x47\x03\x55\x1a\x0d\xb4\x8d\xab\x9d\x7b\x1d\xeb\x13\x89\xb9\x3a\x01\x6f\xa9\x44\x2b\x09\xf7\xd9\xa0\x75\xce\x13\xcc\x73\x28\x3a\x5d\x57\x3c\xcf\xa1\xc8\xb3\x92\x90\x9e\xed\x1e\x23\x07\xa6\xd1\xad\x88\xd9\x51\x97\xd6\x75\xd8\x8e\x0e\xdb\xd9\xaa\x2d\xb2\x17\x60\x63\xbb\x5a\x17\x39\x55\x87\x7b\x23\xca\xca\xfc\xb2\xbb\x5a\x9f\x90\x78\xa4\xec\x5e\xc7\xed\x4c\xd1\x45\xab\xe4\x13\xfb\xed\x6a\x13\x33\xee\xd3\x3e\x55\x55\xf1\x3a\x4b\x64\x53\xdc\xca\x78\x1d\xcc\xa0\x40\x0a\x0b\x22\xe1\x10\xec\x32\x64\x21\xfc\x10\x11\xa1\x5c\x56\xc4\x25\x98\x47\xe5\x69\x11\x17\x0e\xda\xd9\xba\xb4\x88\x37\xa1\xcc\xc1\x15\x24\x76\x68\xe9\x4b\xd1\x8f\x43\x8d\xc2\x51\x16\x96\x72\xe8\x9a\x18\xd8\x95\xb7\xd2\xf8\x9c\x1d\xcf\x91\xa8\xd3\x29\xaf\x02\xb6\x4b\x92\x33\xb6\xcf\x34\x6c\x6b\xd8\xae\x04\xb6\xe5\xe4\xd1\xc5\x42\xe3\xf6\x1a\x71\xfb\x36\x12\xba\x06\xee\xaa\xd6\x06\x34\x38\x6f\x25\x38\xaf\x65\xa1\xa1\x5d\x92\x7c\x4f\xfd\x18\xe5\xd2\x2b\x0d\x6f\x2c\x11\x30\xed\x1b\x64\x51\x66\xaf\x37\x80\x76\xc2\xb7\x69\x37\x49\x67\xce\xe7\x57\x54\x59\xba\x3d\x9d\xe4\x78\x05\x74\x66\xea\xf7\xcf\xf7\x99\xee\x48\xbf\x2a\xbf\x8c\x05\x2d\x74\xcc\x20\x76\x78\x37\xfa\xfe\x2c\xa6\xa4\xeb\x51\xea\x28\xbf\x2b\xda\x23\xa9\x1d\x25\x4d\x1e\xd5\xc0\xd1\x8f\x66\xf0\x2f\x17\x50\xa0\xe0\x8f\xe3\x93\x9f\x5f\x1e\x45\x8c\x28\xb9\x70\x71\x62\x24\x23\xdb\x5d\xa3\x06\x26\xb2\xa6\x19\x3e\x02\x91\x74\x66\x17\xc8\xd3\x4d\xa0\x4c\x70\x4d\x6d\xf0\x36\x51\xe0\x46\xf6\x5d\x76\x9e\x3f\xcd\xf0\x11\x29\x87\x5a\x30\xbb\xad\x17\x93\x96\xcc\xae\xdc\x21\x32\xab\x4d\x7d\x1a\x62\x77\x56\x3d\xb2\x34\xc1\x6c\x4e\xa3\x09\x9d\x92\x43\xc6\x9d\x8d\x1f\x32\xce\x16\xef\x05\x4f\xd8\x67\x1e\x00\x4a\xa0\x9f\xe3\xef\xa8\xa2\xb8\x88\x05\xe0\x3b\x0e\x8a\xc8\x87\x04\x67\xa1\x7d\x09\x64\xdf\x09\x14\x4f\x10\x1c\x5c\x53\xea\x00\x79\xe2\x4b\x43\xf9\x72\x50\x6e\x6e\x08\xca\x67\xc3\x48\x8d\xe5\x7b\x8c\xe5\xa9\x82\xc9\x60\x6e\x6a\x30\xd7\x60\x5e\x09\x98\x3f\x42\x2c\x30\xe9\x6f\x0e\xcf\x0f\x1b\xb3\xff\x1d\x8a\x5f\xc3\xb6\x9e\x81\xef\x11\x6a\x77\xd2\x90\xfd\x22\x6a\x33\x18\x94\xc1\x78\x93\x83\xbd\x11\x7a\x02\x83\x5b\x03\xe4\xc2\x64\xa9\xd5\x3c\x0f\x8b\xc5\x28\x1c\x37\x36\x64\xf7\xe1\x95\x02\xf6\x13\x63\x30\xc2\xc3\x62\x91\xd0\x0c\x3e\xe2\x02\xb9\xc6\xf8\x49\x02\xb9\x9e\x03\xe5\xd0\x8a\xbb\x6f\x38\x98\x0b\xc5\x98\x54\x78\xc6\xd2\x8e\xd5\x71\x84\x89\xe5\xf8\x36\x7a\xe3\x14\xa1\x5a\xb1\x72\x0c\xd7\x77\x04\x2e\xb8\x3c\x1a\x0e\x46\x01\x7f\x48\xc1\x94\x7a\xc0\xca\xf8\xe6\x23\x36\x92\x87\xd5\x18\x75\x91\x18\x20\x5f\x75\x9d\x8a\x28\xcd\x54\x69\x1f\x0d\x33\xa6\x6d\xf0\x7b\xec\xfd\xce\x9c\xcf\x23\x62\x15\x21\x77\xe4\x06\x94\xce\x65\xc7\x61\x4a\xfd\x8e\x3c\x2e\x99\x7f\xf9\x84\x5e\xa4\x8c\x3c\x56\x5a\xe6\xec\x18\x1a\x46\x2b\xa8\xe9\x23\x80\xe9\x31\xf0\x10\x3d\x29\x7f\x59\xd1\xd8\x5a\x58\x8b\xc6\x18\xee\x8c\x39\x94\x59\x78\x93\xa2\x4b\xe5\x45\x54\x81\x48\x7a\xe0\x20\x4b\x14\xf8\xf3\xd9\x45\x33\x9b\x70\x92\x21\xad\x0c\x6a\xd5\xb4\x26\x3c\x63\x46\xab\xb1\x7c\x2e\xa8\x3b\xa3\xc5\xa4\xac\x72\x3a\xb7\x96\x1e\xfe\x0e\x13\x1c\x7f\x5c\x28\xdc\x97\x08\x01\x23\x39\xc8\x81\xc9\x1d\x9d\xfc\x09\xe9\xd0\x39\xd4\x4f\x7e\x3e\x7a\xae\x81\x4c\x38\xc5\x54\xab\xc9\xc0\xd5\xd8\x68\x4a\xb7\x3d\x67\x71\x04\x13\xee\x9d\xe6\x0e\x56\x22\x84\x29\xde\xe4\xf4\x38\x20\x79\x11\xd7\xfb\xfa\xf7\x97\xf5\x2f\x01\xe1\x4b\x1d\x16\x9d\x62\x2f\x11\x53\x51\x5b\x17\xb0\x2f\x0d\x83\xff\x16\xbf\x9a\x91\xae\xcd\xc9\x20\x28\x2b\xbe\x38\xb2\xc6\x50\x48\x4a\x85\xcf\xd1\x6d\xd8\x50\x6a\xfe\x21\xff\x0f\xe8\xc6\x73\x08\x16\x58\xaa\x25\x82\x89\xbb\x90\xf7\x19\x84\x3e\xd6\xcd\x98\x18\x19\x82\x46\x65\x46\xea\x36\x0f\x5b\xf7\x72\x0a\x10\xdd\x1c\x09\xb2\x1b\x73\x57\xd5\x15\x18\x1d\x65\xa7\x25\xc6\x3f\xf9\xa3\xa5\xfe\x50\x68\xb0\xd1\x51\xfe\x36\xd5\x1f\xad\x86\x5a\xa3\xd0\xb7\xa6\xf2\xb7\x69\x87\x03\xf3\x6b\xfc\x0e\x01\x0b\xcf\xbb\xa8\xf2\xa7\xa8\x0d\xbf\x52\x1b\x56\x9f\xd2\x6c\xab\x3f\x92\x83\xd5\xc6\x99\xad\xf6\x37\xee\x4b\x4a\x7c\xdf\x29\x49\x1c\x43\xc2\x95\xb3\x7e\x09\x9c\x82\x10\xee\xc1\x29\xf8\x1c\x22\xbd\xbc\xe1\x21\x61\x0d\x2f\x9e\x5f\xfc\x5f\x00\x00\x00\xff\xff\x08\x95\x1c\x22\x7c\x4d\x01\x00")
+var _monitoringSystemGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\x6d\x73\xdb\xb8\x11\xfe\x9e\x5f\x81\xf2\x72\xb5\x93\x4a\xb6\x24\x4b\xb1\x95\x99\x4c\xc7\x76\x2e\xbd\x76\x92\xd4\x67\x3b\xd7\x69\x3d\x19\x15\x26\x21\x89\x35\x45\x32\x24\x68\x5b\xc9\xf8\x7e\x7b\xf1\xc2\x17\x90\x04\xf5\x4a\xc9\x94\x84\x7c\x70\x24\x80\x02\xc1\xdd\xc5\x3e\x0f\x80\xc5\xf2\xc7\x0b\x00\x34\x68\xdb\x0e\x86\xd8\x74\x6c\x5f\x7b\x0b\x7e\x90\x22\x52\x68\x99\x3e\x26\xdf\x6e\xd8\x37\x10\x96\xb2\x9a\xdb\xc0\xb4\xf0\xdf\x6d\x52\xd9\xac\x25\xa5\x06\xc4\xd0\x77\x02\x4f\x47\xa4\x42\xab\xd7\xc1\xdf\x3c\xd8\x87\x36\x04\xf5\xba\x26\x5c\x86\x6c\x78\x6b\xd1\x4b\xb0\x17\x20\xa1\x7c\x68\x1a\x92\x52\x53\x77\xec\x73\xc7\x72\x3c\xda\xa6\x37\xb8\x85\xfb\x8d\x1a\x68\x35\x9b\xe4\x4f\xa7\x53\x03\xcd\x57\x62\xd3\x36\x1c\xb1\x7b\x9f\x26\x8f\x03\xfe\x0c\x4e\x2d\xe4\x61\x5f\xbc\x0e\x8f\x5d\x76\x9d\x01\xfd\xe1\xad\x03\x3d\x43\x0b\xeb\x9e\xd8\xff\x5f\xc9\xdf\x27\x7a\xb9\x66\x20\x5f\xf7\x4c\x97\xb6\x44\xaf\x67\x6d\x68\xc8\x30\x71\xe6\x11\xb4\x81\x8d\xf0\xdf\x0d\x52\x62\x07\x96\xc5\x4b\x3c\xe8\x0e\xaf\x1d\xc7\xc2\xa6\x4b\xca\x1b\xac\xd0\xa4\x97\x1c\xf3\x8f\x18\x79\x30\x6c\xb8\xf9\xa6\xd1\xec\x1e\x77\x9a\x9d\x66\xf3\xa8\xcb\x6a\x2d\xd3\xbe\xa3\xaa\xb8\xf9\xca\xbe\xba\xd0\x46\x96\x1f\x2b\x23\x52\x85\xa6\x3b\x96\x05\x5d\x1f\xd1\x66\xfb\xd0\xf2\x63\xc9\x91\xdb\x9b\xc6\x85\x93\x68\x93\x8b\x38\xa3\xb1\x07\xf2\xbd\xd5\x16\x0a\x1e\xa3\x9e\x86\xdf\xc7\xf4\x7b\x24\x9b\xb8\x6d\xf6\x14\x27\xf1\xd7\xa4\x73\x5f\xe3\x32\x6c\x62\x26\x20\xed\x5f\xe8\x16\x9c\xba\xae\x96\xd4\x84\xb2\xf7\x9c\x07\x2e\xf5\xb0\xdd\xf8\x99\xa0\x65\x42\x9f\xa9\x9c\xf5\x3e\xb9\xed\x2d\x64\x25\xe9\xe7\xa4\x2a\xfc\x88\xec\x01\x66\xcf\xd6\x48\x95\x23\xd9\xe5\xa2\x8d\xbe\x14\xbe\xc6\x97\xf4\x4d\xcb\x12\xe5\x54\x2c\xca\x93\x8c\x28\x9b\xad\x29\xa2\x6c\xca\x45\xd9\x8d\xbf\x5a\x68\x80\x6c\x23\x7d\x27\x78\x3f\xc8\x3e\x06\xd5\x7c\xe0\x79\xc8\xc6\x92\x9a\x11\x7c\x94\x95\x9a\xb6\xa4\xd4\x1f\x3a\x0f\xf9\x31\x87\xc9\xe0\xb1\x24\x57\xdf\x43\x2b\x48\x64\x9a\x7b\x16\x62\xb3\xac\x56\x6c\x8d\x15\x3e\x98\x06\x4e\x99\x5e\xc6\xbc\x59\x11\x1d\x38\x17\x8e\x69\xe3\x4f\x0e\xf3\x03\xac\x20\xd1\x8a\xe3\xc6\xde\x29\xb9\xa3\x8b\x88\xe6\x6c\x0c\x07\x28\xa7\x68\x97\x36\xe5\x41\xc3\x0c\xe8\x6f\x5a\xe9\xf2\xbc\x5d\x10\x59\x1a\xc8\x43\xcc\xcb\xf4\x2d\x07\x27\x37\xf6\x91\x67\x22\xff\x9f\xf7\xc8\x23\x66\x80\x32\x9d\xf6\x5d\xa8\x23\x99\xf9\xf9\x18\xea\x77\x59\x59\xf8\x18\xb9\x2e\x32\x3e\x12\x91\xe4\x3a\x80\xa1\x37\x40\x38\x19\xe2\xf4\x5f\x62\x04\xd4\xeb\x3c\xba\xac\x77\x7e\x30\xda\x27\x8e\x03\x91\x3f\xa6\xe5\xf7\x3c\xf4\x8d\xe8\x04\xfb\x3d\xa6\xb4\x1f\xd4\x03\xb2\x4e\xbd\xdb\x7b\x19\x7f\xde\xab\xb9\x8e\xf1\xee\x8f\x3d\x7f\x4c\x7a\x30\xaa\x43\xd7\xad\xdf\xc0\xfa\xf7\x46\xbd\xfb\xf5\x2f\xc9\xa7\xbd\xa7\x9b\xe6\xe8\xeb\xab\x57\xe0\x76\x0c\xf6\x49\xff\x71\xe0\x8b\xce\x95\x8e\x0c\xc7\x1b\x41\x6a\x72\x64\x78\x8f\x50\x8f\x4b\x26\x7d\x09\x11\x2e\xf2\xee\x99\xf5\x68\xcd\x91\xbc\xee\x03\xd4\x31\xf3\xe7\xcd\x54\x35\xb7\xfd\x0f\xf1\x3d\x7e\xfc\xf8\xef\x8f\x1f\xbc\x1f\x4f\x4f\xff\x7d\x7a\x4a\x37\xe6\xa1\x3e\xf3\xb7\xda\xa9\x16\x17\x3f\x85\x9f\x04\x37\x34\xf4\x10\xb1\x71\xcb\xc8\xb9\xa7\x11\xfa\xe0\x39\x23\xc1\x61\xc7\xe5\x97\x68\x10\x5a\x5a\xe6\x07\x57\x43\xb3\x8f\xf3\xbf\x08\x1d\xdd\x15\x13\x2e\x88\xf4\x01\x88\x6d\x02\x1f\x11\xf4\x32\xc0\x3e\x91\x68\x56\xa0\x64\x90\x45\xd0\x20\x8c\x75\x7f\x08\x3d\xe6\xcc\x33\x23\xd2\x77\x3c\x9c\xf1\x27\x6c\x30\xf6\x22\x6f\x6a\xda\x86\x79\x6f\x1a\x01\x91\x7c\x6e\x5c\x46\xd7\x30\x44\x4a\x3a\xf0\x08\x1f\xcd\x8c\x53\xbb\x0d\xf4\x3b\x6e\x84\xe2\x33\x52\xef\x11\x8e\x49\x2a\x06\x09\xe0\x66\xae\x96\x7b\x95\xd8\x7b\xdc\x7c\xcd\x75\x71\x0c\x1f\xd1\x04\xdb\x37\x90\x6e\x8e\x20\xc3\x98\x66\x81\x45\x12\xb9\xbb\x19\x5b\xb4\xe0\x2d\xb2\x62\xd8\x4e\x8a\x9d\xc1\x19\xf4\x51\xae\x2d\xee\x37\xd3\x8f\x12\x3b\xce\x5c\xb1\xf0\x8c\x89\xfd\xd5\xe4\xdd\x4f\x7a\x49\x7e\xe5\x61\x79\x2f\x73\x77\x58\x61\x3f\x73\xe3\x64\x9c\xb7\x05\x02\xc3\x03\x19\x64\xb0\xf2\x8f\xe8\x3e\xee\x74\x8a\x3a\x6d\x31\x98\xa7\x0a\x26\xa0\xb9\xd0\x6f\x05\xe7\x0a\xce\x2b\x01\xe7\x04\x86\xb0\x47\xe6\x0a\xc8\x7b\x7e\x48\x4f\xfa\xb2\x05\xb0\x2e\x13\xac\x82\x76\x05\xed\xa9\x5e\x2a\x68\xaf\x38\xb4\x67\xe7\xe9\xdd\x02\x64\x17\x10\x4f\x21\xbb\x42\xf6\xe7\x42\xf6\x1a\x9f\x4c\x92\x0b\x5b\x37\xb4\xe4\xb5\xc2\xfa\x32\xb1\xbe\xf5\xf8\xa8\xf0\x3e\x7e\x0a\x85\xf7\x0a\xef\xa3\xab\x37\x14\xef\xdf\x64\xe0\x3e\x37\x93\x2f\xc2\xfb\x04\x8d\x14\xde\x2f\x8e\xf7\xa9\xdb\x56\x04\xee\xd3\x77\xd9\x18\xbc\x6f\x2b\xbc\x2f\x1f\xef\xdb\x0a\xef\x85\xa7\xd8\x3a\xbc\xd7\x1a\xe9\x46\x14\xdc\xef\x1a\xdc\x8b\x17\x4c\x82\xfb\x23\x05\xf7\xdb\x3a\xbd\xdf\x50\xbc\xef\x28\xbc\x2f\x1f\xef\x3b\x0a\xef\x85\xa7\x50\x78\x2f\xed\xa4\xc2\xfb\x8d\xc1\xfb\xe9\x61\x77\xc7\x05\x80\x1f\x87\x3e\x2a\xc0\x57\x80\xbf\x20\xe0\xf7\x8c\x80\xc7\xf1\xf6\x38\x8c\xf8\x3d\x72\x5d\x29\x1b\xf7\x87\x60\xd6\x3b\xea\x4e\x60\xe3\x32\xee\xb9\x30\xa1\x98\x91\x34\x5c\x33\x03\x9f\xce\x12\x0a\xdc\x73\x81\x26\x8c\xdb\x9e\x47\x04\xc0\xfb\xb8\x62\x1d\x48\xee\xb5\x31\xd2\x3f\xd5\xb1\x79\x4f\xc8\x93\xee\x78\x46\x81\x12\xce\x16\x56\xc2\xbd\x89\x1e\xd6\xa7\x06\xe9\xdd\x36\x46\x11\xbf\x93\xde\x17\x28\xe0\xbc\x22\x5c\xf9\x92\x8a\x19\x40\xe2\x7d\x89\x93\x27\x74\xd9\x77\x49\x23\x08\xa4\x58\xa5\x22\xc6\x8b\x11\xe3\x1c\xf5\x13\x68\xa7\xda\xf7\xaa\x1a\x31\xd6\xa1\x67\x64\x1a\xa6\x45\x17\xd0\x30\x4c\x7b\x90\xb7\x1c\x5a\x79\x49\x3c\x91\x91\x69\x3c\xee\xa9\x1e\x1e\x67\xca\x34\x18\x9f\x72\xfa\xa9\x73\xdc\x6d\x7f\x68\x89\x36\xca\x7e\x72\xa5\x43\x3e\x36\xfd\x6f\x29\x0d\x44\xb5\x43\x34\x0a\xc7\x11\x71\x46\xae\x63\x11\x6f\x79\xc6\xcc\x55\xb8\x94\x38\x6f\xc7\xe6\xf4\xb5\x71\xd0\x91\x8c\x0e\x87\x78\x4b\x13\x8f\xf3\x23\x90\x72\xf6\xc4\x83\x61\x3f\x1a\x67\xf3\x70\x7c\xd9\x71\x2a\x56\x51\x66\x54\x6e\x9e\xec\x0f\x11\xc4\x23\xe8\xa6\x29\x2c\x3d\x6e\xf6\x1f\xe4\x39\x67\xb1\xbf\x48\xd3\xc2\xa1\x39\x18\x12\x3b\x19\xe2\xf3\x50\xff\x29\x76\xcd\xe7\x0f\xb1\x00\xa5\xf3\x87\xd0\x6e\x0b\x39\x7b\x96\x88\x4b\x99\xb6\x47\xcc\xd4\xf3\xd1\xbf\x8b\xba\x39\x27\x7b\x9d\x48\x23\xb9\x4a\x67\x06\xd0\x83\xd7\x7b\x4f\x7c\x49\xca\x42\x85\x90\x19\xc9\x5e\x0a\x97\xda\x4c\xcb\x50\x8d\x54\xbd\x74\x1d\xca\x42\x8b\xaf\x3f\x4d\xc0\xce\x29\x10\x29\x59\x43\x0a\x1f\x97\xad\x25\xa5\x40\x13\x70\xe1\x02\xd3\x0e\x2f\x9d\xe1\x2c\x88\x0c\x81\x68\xe9\xaf\xa6\x8f\x1d\x82\x84\xa3\x42\xeb\x8a\xe0\x32\x2b\x7d\xed\xf1\x34\xe7\x25\xf3\xee\x35\x69\xe7\x91\x9b\xdd\xe7\x60\x74\xcb\xa6\x68\x29\x41\x84\x95\x57\xe6\xf7\x2c\x7e\x6a\xe3\xfc\x6d\x04\xfc\x13\x29\x80\x1c\xfa\xe4\xc8\x21\xc5\x0d\x29\x6a\x14\x09\xcf\xb5\x4c\x1c\x1b\x96\xd4\x39\x8f\xf9\x43\x9d\x85\x0e\x5c\x83\x01\x76\xb4\x6c\xad\x5c\x1e\xe3\x9c\x3c\x14\x9e\x2c\x81\x27\x85\x98\x70\x3c\x0d\x13\xb2\xeb\x3f\xad\xce\xfa\x20\x21\xde\x7c\xda\x18\x48\x98\xbe\xbc\x30\x27\x2e\x48\xb7\x34\x92\x85\xf5\x77\x7b\xb6\x83\xcd\xbe\xa9\xf3\x03\xda\xa9\xbd\x8d\xdd\x04\x92\xcf\xa2\x3c\xa4\x5b\x13\x0a\x56\x58\x95\x82\x15\x05\x2b\x6b\x98\xa6\x2c\x8e\x3c\xb9\xd9\xc8\x3a\xa1\x27\xee\x9d\x82\x9e\x42\xe8\xc1\xc8\x86\x36\x56\xa0\x03\xb4\x6b\x2e\x09\x05\x37\x0a\x6e\x14\xdc\x6c\x28\xdc\x64\x27\x3a\x47\xad\xf5\xa1\x4d\x92\xff\x47\xa1\x4d\x11\xda\xf0\x38\xae\x43\xcb\xbc\x47\x0a\x71\xb4\x8f\x44\x0c\x36\xf2\x15\xe4\x28\xc8\x51\x90\xb3\xa9\x90\x93\x9b\xe1\xac\x13\x73\x26\x27\x4a\x53\x98\x23\x60\x8e\x87\xa0\x31\x56\xa0\xa3\x5d\x12\x39\x98\x0a\x75\x14\xea\x2c\x8c\x3a\x6b\x48\xb1\x79\x54\x70\x22\xa5\x95\xc4\x35\x4e\x4c\xb2\x79\x45\x7c\xeb\x9d\xf9\x4d\xcb\x19\xd4\x16\x26\xd9\x9c\x2a\xcc\x76\x41\xc2\xd2\xd6\x94\xec\x1d\x34\x28\xe4\xd4\xbf\x96\x27\xa7\xe5\xb1\xc0\x99\xc2\x24\x14\x38\x53\x31\x4f\x24\xb0\x47\x31\xef\x4a\x9a\xfb\x76\xc1\x20\x61\xa9\x6b\x50\x31\xc2\xeb\x8d\x11\xf6\xf9\x90\xec\xfd\xcf\xb9\xa5\x61\x90\xba\x4e\x20\x68\xe2\xd1\xa0\x45\x43\x0f\xc3\xb4\xc9\x19\x6b\x90\x61\x74\x2b\x55\x9d\x85\xe8\x2b\xde\xc7\x7e\x60\x01\xda\xe7\x12\x83\x74\x53\xa2\xe8\x13\x36\x84\x8c\x95\x48\x62\xce\x07\xfe\xc0\x7a\x32\xe9\x61\xcf\x2a\x12\x8b\xf9\x0f\xd2\x45\xe0\x7a\x0e\xd5\x0f\xe9\x31\x65\xa8\xe3\x90\x96\x2c\x17\x8b\xd9\xca\xba\x8f\x1d\x89\xc5\x4c\x8c\xc9\x29\x3a\x89\x54\x4e\x2c\xa3\x3a\x8b\x24\x96\xab\xb3\x48\x84\x9d\x9c\x14\xb0\x93\x29\xb9\x46\x4a\x64\x27\x14\x31\x7e\x19\xb9\x78\x2c\xaf\xa2\x93\xf4\x5d\xa5\x34\x00\xfa\xe0\x3b\x7d\xfe\x4d\xa7\x36\x2b\x4c\x67\xb6\x28\xb3\x61\x8b\x10\x64\x0a\x1e\x14\xaf\x43\xac\x9c\xe4\x5c\xc4\x20\x4a\x26\xf6\x6c\x51\x82\x75\x68\xd6\x75\x89\x15\x33\x9e\x65\x24\xb4\x18\xf9\x99\x59\x0a\x15\xa5\x42\x44\x60\xac\xeb\x8a\x06\x29\x1a\xa4\x68\x50\x85\x69\x50\x6e\xdb\xa0\x90\x07\x4d\x49\xc2\xa2\x78\xd0\x3a\x78\x90\xe2\x3f\xb1\xec\xcb\xe5\x3f\x0f\x8e\x77\xb7\x6c\xe2\x96\xa5\x80\x9f\xc1\x3d\xef\xc5\x26\xa4\x6c\xc9\xe3\x3d\x11\x3b\x60\xd0\xab\x20\x5f\x41\xbe\x82\xfc\xea\x42\x7e\x76\xe5\xa3\xf3\xa6\x00\xf1\xa7\x64\x61\x99\x01\xf1\x8b\x73\xb4\xcc\x0e\xf9\x0a\xd8\x77\x14\xd8\x89\xe2\xd3\x98\xfe\x00\x4d\x6c\xda\x83\x89\xe9\x24\x9e\xd6\x39\x59\x9f\x7f\xa1\xe2\x39\x21\xfb\x37\xda\x51\xe0\x9b\xdf\x85\xa7\x56\x18\xbd\x10\x46\xaf\x1c\xfe\x14\x4a\x8b\xe5\x1b\x83\xd2\x99\x3c\x0a\x7c\xc4\xd1\x30\x43\x5b\x1f\x03\xd3\x07\x78\x88\x80\x61\xf6\xfb\xc4\x01\xdb\x3a\x12\x82\x98\xc8\xb0\xa4\x05\xb4\x9e\xf8\x02\xe4\x63\x46\xa7\x49\x3d\x2d\x61\x1e\x06\x3c\x40\x1f\x20\x9b\x7d\x36\x0e\xc0\x3f\x3d\x40\x44\x0d\x2c\xc7\x1e\xf0\x4b\x7d\x10\xba\x47\x60\x62\x72\xa3\xc0\x23\xbf\x75\xc0\x2d\x02\xe8\x11\xe9\x01\x26\xbf\x59\xcf\xfa\x41\x21\x9b\x98\x9c\x93\x41\xb1\x09\xc5\x26\x04\x36\xb1\x82\x08\x10\x91\x4e\xb0\x51\xd4\x0b\x07\xa6\x62\x12\x8b\x31\x09\x2e\x3e\x53\xb1\x89\xa5\xd9\x84\x62\x12\x8a\x49\xac\x6f\xbe\xff\xa6\x5d\x80\xd0\x93\x53\x64\xa8\xac\xab\x40\xe1\xa9\x80\xa7\xc3\x28\x7a\x9e\xa0\x29\xb4\xb1\x69\xa1\xfd\xc6\x41\xb7\x53\x03\xb2\x05\xf9\x5c\x4a\xc8\x89\xa7\x2b\x32\xa7\x23\x5e\x81\x55\xc3\x6f\xb7\xf3\x33\x88\x9e\x02\x38\x7d\x10\xf6\x1c\x54\x1c\x87\xff\x41\x88\x7f\xb8\x06\x4f\x89\x7f\x94\x14\x52\xe5\x82\x54\x98\xac\x30\xb9\xd2\x98\x9c\x9b\x36\x17\x82\xf2\xe4\xe4\x21\x0a\x94\x81\x02\xe5\xf5\x83\x32\xdf\xa7\xae\x81\xb5\x80\xf3\xe6\xed\x8c\x17\x81\xb2\xda\x26\x4f\x3a\xac\x00\x5a\x01\x74\x85\x01\x3a\x3b\x69\x3e\xce\x9f\xa6\xe7\xf8\x3c\x39\xdd\xca\x9a\xc2\xe2\xd4\xaa\xf6\x46\x00\xfe\xba\x82\xdf\xa2\x6d\xa2\x8a\xc5\xb6\x6f\xd6\x22\x77\x78\x76\x9b\x9d\x43\x04\x6e\x40\x7c\x92\x0a\x6b\x2f\x0d\xbc\xd5\xfe\xb9\x02\xf0\x35\xcf\xb0\x0b\x11\x7c\x72\xf2\x9a\xcd\xdc\x98\xce\xfe\x42\x61\x78\x75\x31\x3c\x17\xe7\xe6\x21\xec\x8d\xa7\x44\xb9\x29\xac\x16\xb1\xfa\x92\x4a\x0c\xf8\x08\x2f\x07\xcc\x6a\xd9\x5b\x01\x73\x35\x81\x79\x1d\xc9\x8e\x4e\x0a\xf2\xf3\x1c\x4d\x4e\x76\xe4\x21\x17\x71\xad\x18\xc8\xb5\x9c\xf1\x88\xf8\xef\x73\xc7\xee\x9b\x03\x61\x30\x46\x03\xf5\xc2\x31\x7c\xb0\xff\x32\x7b\xa5\x98\xc3\x6b\x5a\x86\x24\x1d\xea\x43\x74\x4d\x06\x8a\x13\xe4\x1c\x02\xcb\xf3\x77\x46\x1c\xf5\xc0\x0b\x13\x4f\xa5\xdc\x35\xab\xfe\x9d\x8e\x93\x9c\x18\xf5\x88\xf4\x24\x43\x44\xfb\xe9\x43\xab\xdd\xed\x9c\x8b\x03\xd2\x1b\xdc\xc2\xfd\xd6\xd1\x71\x8d\xf0\x8b\x6e\x0d\xb4\x1b\x35\xd0\x38\x38\xe9\x8a\xee\x58\xfb\xa9\xd5\xed\xea\xed\x37\x5a\xce\x3a\x66\x0a\x20\xcc\x67\xf7\x12\xec\xde\x76\x6c\x31\x59\x20\x0c\x18\x4e\x0a\x8a\x27\x46\x1d\x3d\x5f\xb3\x21\x2a\x98\x98\x75\x54\x21\x16\x17\xd1\x85\xc8\x75\x7f\xa4\xa3\x2a\x07\xae\xe2\x15\x9f\x20\x5d\x98\x2d\xa4\x10\x85\xe6\x79\x94\x31\xcf\xec\xcb\xe1\x73\xd6\xd9\xcc\xb5\x4d\xd9\x14\x35\x84\x08\xca\xb3\xea\xe6\xd6\x9b\x70\x14\xe9\x59\x2d\x19\x73\x19\x41\xd7\x35\xed\xc1\x35\x37\xc5\xa6\xac\x7c\x82\x37\x0d\x9d\x36\x77\xc8\x34\x08\x13\xa3\xc7\x8c\xb3\xba\x8f\x74\x34\xd5\xe7\x45\x8d\x79\xd0\x1e\x4c\x69\xac\x95\x34\x96\x33\x3d\x62\x17\xef\x89\xb9\x5d\x44\x5c\x49\x30\x8e\x3c\x4f\xd3\x1d\xdb\x46\x3a\x46\x42\x12\x1b\x7a\xcd\x35\xbd\x73\x66\xc0\xc9\x49\x9c\x15\x0c\x88\xb9\x11\xab\x08\xe3\x60\xdf\x1c\xb4\x0e\xda\x49\x63\xae\xe3\xe3\xbe\xf9\x98\x56\x43\x58\xf8\xc1\xb1\xa3\xcc\x6f\x5a\xa7\xf1\xb3\x50\x4f\x48\x47\xee\x37\xac\xac\xf0\x27\x4c\x66\x9f\x88\xdb\x9c\x80\x7c\x9c\x87\xa4\xa9\x29\xab\xc1\xfc\x69\xb5\xcf\x87\xa7\x99\x0a\x27\xfe\xc1\x04\x81\x13\x9e\xe6\xdd\x59\x9c\x17\x0a\x96\x4f\x27\x38\x71\x1a\x53\xe6\x4c\x88\x7d\x82\x66\xf3\x84\xfc\x39\xe9\x52\x67\xd2\x3c\x49\x39\x93\x7e\x60\xc9\x78\x3b\x6d\x59\x6c\x87\x37\xd3\x22\xee\xa8\xd9\x3d\x4a\x35\x30\x31\xf9\x26\xa6\xb3\x1a\xd2\x4e\x30\xca\xbc\xf7\x6d\xae\x05\xa8\xbb\xe0\x16\x11\xd2\xea\x5a\xe1\xab\x52\x92\xac\x97\x3d\x9e\xf4\xb2\xc7\x92\x5e\x46\x97\xf8\x45\x39\x35\xa5\x4d\xbc\xfb\x63\x2f\x07\x17\xf5\x9b\xf0\xad\xa0\xa5\xbf\x10\x74\x01\x66\xab\x35\x6b\x2d\x11\xe9\x62\x72\xab\x1d\x35\xfc\x74\x45\x44\x62\xb3\x35\x11\x8b\x0d\x6c\x9b\x6e\x19\xb9\x04\x24\xf3\x80\x48\x77\x93\x2c\x44\x05\x9a\xd4\xb1\xa1\x2f\xda\xff\x89\x68\xff\xac\x76\xb2\xfd\x3b\x94\x20\x6b\xef\xe4\xa6\xdf\x90\xfb\x99\xa9\xb6\xcf\x2e\xfc\x1c\x3a\x2e\x3a\x43\x5e\x09\x8e\x5f\x44\x0e\x41\x02\xe4\x73\x60\x7c\x08\xd6\xf3\x62\x7c\x48\x0d\x14\xc6\x2f\x83\xf1\xe2\xf7\xa5\x30\x3e\x59\x0d\x10\x30\x3e\x65\x51\x0a\xe5\x4b\x47\x79\x85\xe2\x3b\x83\xe2\x2e\xd2\x57\x81\xde\xa0\x0e\x14\x7f\x28\x8f\x3f\x7c\xb1\xe1\x3d\x34\x2d\x6a\x0d\x8a\x43\xa8\xb5\x80\xed\xe1\x09\xb9\xbd\x9c\x85\x89\x42\x92\xc4\x48\x2d\x06\xa8\xc5\x00\x45\x23\xca\xa5\x11\x6c\xcb\x6a\x3f\xfa\x6b\x63\x48\x3a\xe6\xf5\x46\x68\xe4\x78\xe3\x5e\xe0\xc3\x01\xea\xdd\x8e\x31\x2a\x04\x70\xfe\x52\x0d\x09\x5c\x4b\xde\xad\x11\x1e\xcf\xb4\x89\x99\x96\x91\x92\xba\x42\x30\xce\xd6\xc8\x0d\xd3\xc7\x9e\x79\x4b\x0f\x6e\x03\xc7\x06\x43\x32\x20\x14\x9e\x97\x89\xe7\x0b\xce\xfb\x8d\x76\x1b\x1e\x41\x85\xe7\x60\x29\x3c\x17\xa3\x37\x96\xc2\xf3\x24\x45\x91\x9a\xf8\xab\x89\xbf\x50\xa3\x10\x7b\xf6\xd8\x13\x32\x05\x37\x90\x85\x21\x9f\x88\x13\x10\xee\x25\xe0\x1d\x4f\xc0\xc9\x07\x0f\x4f\xce\xa7\x38\x1f\x7e\xdf\x74\x68\xf8\x29\x47\x71\xf2\xc3\xd2\x31\x3c\x1b\xd1\x52\x10\xc2\x32\x7b\x02\xe5\xf2\x10\xfe\x13\x7c\x64\x13\x74\x10\x89\x15\xec\x5b\xd0\xc7\xa0\x03\x88\x2b\x27\x90\xef\x4b\xf6\xc3\xb7\x0d\xea\x37\x27\xd4\x30\xfb\xea\xbd\xe9\x81\x14\x05\x67\xf9\x8e\xa6\xa4\xc0\x99\xf3\x2c\xdf\xca\xd3\xe4\x56\xe8\x00\x20\xe9\xf6\x05\xf2\x2e\x59\x7f\x92\x39\xfc\x5a\x5e\x15\xd0\x49\x97\xcf\x13\x69\x18\x87\xc6\xa4\x40\x95\x97\xbe\x37\x3d\x02\xc2\x7c\x4e\x9c\xaa\xae\xdc\x99\xc2\x67\x5a\x1c\x5e\x75\x84\x23\xb3\xe3\x7a\x6a\x9d\x54\xb8\x8e\x88\xec\x4e\x93\xc4\x81\x09\x33\xc3\x54\x39\x95\x34\xd3\xc8\x54\xca\xb7\xa5\x3b\xe7\x59\xf1\xb2\xa5\x68\x89\x78\xa5\xc0\xbb\xa4\xa8\xd4\xf6\xc4\x82\x4a\x0a\xec\xa9\x6a\x3a\x9f\x5d\x4d\x5b\xb3\xfc\x93\x13\x93\x8f\x8c\x7a\x7a\x09\x26\x2d\xa4\xf7\x15\x89\x83\xbe\x70\x0c\xc0\xe4\x0f\xf6\x99\x7b\xab\x01\xa6\xdf\x1a\x08\x6c\xfa\xff\x2b\x00\x6d\x83\x33\x4f\xf6\x34\xc9\x2a\x13\xc5\xa1\xa4\x39\x75\xa6\x69\xe6\xd0\x69\xf9\xeb\x58\xc1\x5a\x83\x95\xd3\x37\xde\xb0\x88\x6a\x91\x2d\xaa\xb3\x4e\xd2\x09\x88\xb8\x7c\x36\xd3\x04\xa4\x9b\x5f\x4e\xe3\x13\x90\x72\x33\x7c\xa9\x09\x48\x55\x8f\x38\x6d\xcf\xc4\xe3\xd9\x16\xa6\xd6\xb6\x2e\xc5\x4e\x5a\x91\x1b\x6d\xc2\x39\xab\x8b\x59\x17\xad\xd4\xe1\x2b\x75\xf8\x6a\xe1\x6e\xee\xe2\xe1\xab\xee\x71\x01\x64\x27\xf9\xbf\x96\x3e\x7c\x75\x7e\xf1\x05\x7c\xa1\xd3\xb0\x25\x4f\x60\x6d\x0e\x73\x9a\x7b\xe9\xb6\x5b\xf0\xf6\xb3\xa3\x29\x69\x5e\x76\x23\x0d\xdb\x76\xaf\xc2\x6a\x02\x7e\x54\x92\x0b\xd1\xc5\x8e\x5e\xcc\x6a\xd2\x7c\xe8\x6d\xc2\x8c\x74\x37\x08\x57\x5b\xa2\xbc\x6e\xcc\x58\xde\xfe\xf8\x01\x0e\xae\x82\xd1\x25\xc4\x08\x3c\x3d\x09\x54\xe9\x8f\x65\x57\x61\xca\xa1\x49\x33\x1c\x48\x97\xd3\xa4\xd2\x56\x6c\x9f\x93\x5a\xc5\xce\x59\xad\xc3\x54\x9c\x45\xa9\xe5\x16\xa1\xbc\x52\x24\xaa\xd9\xe8\x14\xc0\x77\x92\xe3\xa5\x14\x16\xf5\x5b\x40\x3c\xea\x5a\x59\x94\xce\xa2\x3c\x32\xfd\x5e\x03\xb5\xea\x0b\xd1\x05\xcd\x86\x18\x5e\x50\x1e\xe9\x6a\x36\x0a\xde\x19\xd3\x4e\x1e\x49\xb1\xae\x32\x59\x17\x81\x99\x50\xa9\x29\x30\xaa\x2c\x1b\xd3\xe9\x66\x59\x56\x80\x93\x39\x1a\xd1\xdf\xaf\x08\x1a\xac\x0b\xe9\x9f\x71\xa0\x14\xec\x88\x8c\xad\x8c\xb7\xa1\xef\x71\x2a\xd4\x66\x89\xf4\xcf\xc7\x63\x6b\x12\xaa\x31\x1f\x41\x85\x71\x9d\x46\x55\x3e\x98\x51\xc2\x8e\xfe\x4d\xfe\xd5\x3f\x7d\xaa\xbf\x7f\x0f\x7e\xfd\xf5\xed\x68\xf4\xd6\xcf\xb0\x2d\x17\x62\x42\xb7\x6c\x79\x5b\x91\xaf\x1a\x9a\x86\x81\xec\xe9\x1b\x7e\x71\xb7\xf2\xa4\x25\x12\xa8\xe3\x85\x76\x99\x03\xa2\x24\x1a\xf7\xeb\x32\x0f\x24\x6c\xbc\x64\x88\x23\xe7\x81\x99\x21\x1a\x56\x5c\xc7\x94\x4a\x7b\xef\x11\x5f\x07\x0c\xe7\xc1\xd6\x72\x97\x7d\xf1\x24\x2f\xf4\x15\x44\xc8\xc2\x6d\xc1\x4f\xd9\xd0\x42\x39\x5b\x4c\x89\xd8\x0e\x46\xb7\xc4\x26\x53\x75\x81\x6d\x0a\xa4\x60\x3e\xe9\x5f\xa2\x6f\xc4\xf3\x64\x37\x26\x77\x45\x01\x67\xd5\x51\x00\xf8\x79\x37\x55\x70\x5e\xae\x0a\x42\x08\x62\x5f\xe7\x53\xc4\x47\x73\x64\xee\xea\x38\x78\xff\xfc\xe3\x80\x8b\x7f\x57\x47\xc1\x2f\x55\x18\x05\x17\x8e\x51\x3d\xe9\xa7\x19\xf4\x42\xc2\x3f\x34\x0e\xe9\xda\xd5\xe7\x68\x8d\x0a\x3c\x3d\xe5\x0a\xea\x47\xbe\x0e\x2d\x54\xa7\x3b\x85\x9e\x8d\x30\xf2\xeb\xba\x33\x72\x03\x8c\xea\x44\x15\x6c\x96\xe3\xd3\x30\xa7\xbf\xde\x43\xaf\x9e\x2c\x7d\x25\x0b\x5f\x7f\xa6\x15\x74\xed\xeb\x65\xaf\xa7\xa3\xec\x71\x02\x41\xe3\x6e\x56\xca\xeb\x1e\x6d\x15\xd2\xb1\x20\x96\xc3\x83\xd7\x87\xf3\xcb\x85\x86\x1f\xd9\x83\xd9\xe4\x12\x7e\x12\x16\xd5\xb6\x7f\xf1\x94\x65\x9d\x4d\x55\x9a\x36\x99\x66\x48\x72\xcb\x82\xb9\x57\x54\x0b\xf7\x9a\x53\xe5\x4b\x04\xb6\xa6\x37\xec\xa3\x61\x48\x3e\x70\xc6\xc4\x64\xad\x3b\x5e\x2a\x20\x70\xeb\xc4\x79\x56\x86\x38\x2b\x6d\xbc\xe0\x10\x28\x95\x83\x54\xc8\xec\x8a\x47\x90\xc5\xb8\xd6\x6e\x08\xf3\xbd\x1a\x3f\xbb\xa5\xf0\x5f\xa6\x2a\x3c\x4f\x04\x16\xd8\xa6\x9b\xbe\x1b\xc7\x16\xf9\x9f\x25\xa6\xc9\x83\xb6\x4f\x95\x90\x57\x41\x4c\x9c\x32\xc5\x6a\xa7\x6e\x57\x76\xea\x5e\x08\xdd\x5e\xef\xe6\x5a\xf3\x28\xa7\x6a\xbe\x4b\x93\xec\x5e\x2c\xbd\xb9\xf6\x89\x1d\x16\x51\x51\x4a\xd3\x54\x51\x70\xc2\xb4\x9d\xc4\x0c\xef\xf0\x86\x59\xd5\x03\x92\x2a\x19\x5c\x34\xe3\x81\xad\xc5\xe9\x46\x0d\xc4\xb7\xf8\xd3\xbb\x3d\x15\x3a\x54\x5e\xe8\x90\xe8\x34\x55\xf4\xd0\x72\x9c\x84\xd9\xbb\xe2\x24\x3b\x19\x3d\xd4\x2a\x38\x36\xd5\x4e\xa2\x2e\xca\x22\x38\x2a\x80\xa8\x54\x3e\xd4\x2a\x78\xb7\x57\x3b\xc9\x0f\xa5\xf8\xd0\xc6\x85\x0a\x3d\x57\xd8\x8f\x34\xd9\x99\x8a\xfa\x89\xbb\x25\xe5\x1b\x91\x48\x9f\x7d\xc3\x71\x03\x03\x7f\xc8\x23\x71\xde\x31\xb7\x0e\x54\xf8\x4f\xa5\xd4\xb0\xab\xe1\x0f\x95\x08\x02\x0a\x75\xa1\xe2\x80\x4a\xd3\xc3\xe2\x03\x42\x45\x03\x95\xa8\x06\x15\x0d\xa4\xa2\x81\x0a\xd5\x5c\x21\x1d\x6f\x50\x34\x50\x15\x57\x3b\xb7\x3a\xd6\x27\x14\x73\x79\x02\xae\xa8\x44\x4b\x09\xf7\x79\x46\xeb\x9c\x27\x98\x67\x57\x74\xba\xae\x78\x9e\x5d\x91\x67\x29\x21\x3d\xd5\x1e\x23\x3b\xa6\xd1\x4a\xc4\xec\x88\x4b\xeb\x2a\x6c\x47\x85\xed\x54\x6a\x8b\xec\x85\xd0\xed\x35\xef\x6a\x75\x73\xaa\xe6\x7b\x23\xc2\xca\xfc\xb2\xbb\x5a\x9f\x11\x7e\x70\xbc\x3b\x15\xb7\x33\x45\x17\x47\x8d\x02\x5d\x94\x9b\x98\x71\x9b\xf6\xa9\xca\x8a\xd7\x59\x22\x9b\x62\x25\xe3\x75\x4c\x8f\xcc\xda\x05\x16\x64\xf3\x21\x48\x28\x88\x8e\xcc\xfb\x90\x08\xe5\xb2\x22\x2e\xc1\x3c\x4a\x4f\x8b\xb8\x70\xd0\x4e\xe5\xd2\x22\x5e\x72\x99\x83\x33\x68\x1b\xdc\xd2\x97\xa2\x1f\xbb\x1a\x85\x23\x2c\x2c\xe5\xd0\x35\x31\xb0\x33\x77\xa5\xf1\x39\x1b\x9e\x23\x51\xa5\x53\x5e\x05\x6c\x17\x24\x67\x6c\x27\xc9\x19\x15\x6c\x2b\xd8\x5e\x06\xb6\xd9\xe4\x71\x64\x62\x85\xdb\x6b\xc4\xed\xeb\x50\xe8\x0a\xb8\x97\x03\x6e\x05\xce\xe9\x6e\x56\x0e\x9c\xd7\xb2\xd0\xd0\x2e\x48\xbe\x27\xbe\x8c\x72\xe9\x95\x86\x53\x1d\x53\xa6\x4d\x18\xb7\xe3\x19\xeb\x0d\xa0\x9d\xf0\x6e\xda\xe7\xa4\x33\x27\xf3\x2b\xaa\x28\xdd\x9e\x4a\x72\xbc\x02\x3a\x33\xf5\xfd\xe7\xdb\x4c\x77\x98\x5f\x65\x6f\xc6\x22\xf7\xdf\xf7\xa0\x69\xf9\xbd\xf0\xfd\xb3\x44\x1c\x3d\x97\x80\xac\xf0\xbd\xa4\x3d\x92\xda\x5e\xd2\xe4\x5e\x0d\xec\xbd\x6c\xd2\xbf\xf4\x85\x11\x88\x7e\xd8\x3f\x78\xfd\x6a\x2f\x64\x44\xc9\x85\x8b\x13\x23\x7a\x51\x73\xa4\xd5\xc0\x44\xd6\x34\xc3\x4b\x20\x92\xce\x6c\x02\x79\xba\xa4\xca\x04\xf4\x8d\x53\xe7\x89\x02\x9f\x65\xdf\x65\xe3\xf9\xd3\x0c\x2f\x91\xb2\x1c\x1a\xd0\x24\x27\x2d\x99\x5d\xb9\x5d\x64\x56\xcf\xf5\x6a\x88\xcd\x59\xf5\xc8\xd2\x84\xa6\x38\x59\x91\xd2\x84\x4e\xc1\x21\xe3\xce\xb3\x1f\x32\xce\x16\x6f\x05\x4f\xd8\x66\x1e\x00\x0a\xa0\xdf\x37\xbf\xa3\x92\xe2\x22\x16\x80\xef\x28\x28\x22\x1f\x12\x9c\x85\xf6\x25\x90\x7d\x23\x50\x3c\x41\x70\x02\xe8\x8e\x05\xd8\x89\x2f\x05\xe5\xcb\x41\x79\xb3\xc0\x22\x57\x0d\xe5\xb3\x61\xa4\xc2\xf2\x2d\xc6\xf2\x54\xc1\x64\x30\x17\x30\x4e\x81\xb9\x02\xf3\x25\xc0\xfc\x01\x9a\xd8\xb4\x07\xcf\x87\xe7\xbb\x8d\xd9\xff\xe2\xe2\x57\xb0\xad\x66\xe0\x5b\x84\xda\xf1\x9e\xc2\x8b\xe8\x2f\x6b\x93\x0e\x4a\x3a\xde\xd8\x60\x6f\x70\x4f\xa0\xf9\xfa\x10\x8d\x60\xb2\xd4\xda\x3c\xe1\xc5\xf4\xb8\x3d\xbd\xd0\x80\xde\x1d\xbf\x92\x38\xed\xc4\x18\x34\x7e\x58\x2c\x14\x9a\xe6\x8f\x89\xa3\x1c\x69\xf1\x9d\xc8\x17\xd7\x82\x6c\x68\x45\xdd\x27\x08\xe2\x63\xc1\x98\xc4\xa4\x02\x31\x34\xa6\x14\x84\xd1\x63\xb8\xce\x07\x0e\xde\xc7\xd8\x4f\x8f\xac\x81\xec\x42\x1f\xb3\xe2\xe2\x6b\x65\xb6\xc0\x5e\xb7\x9c\x19\xbd\xa6\xad\x5b\x81\x81\x4e\x2d\x19\x96\xca\x4d\x42\x1b\x05\xc4\x61\x48\x2e\x0f\x07\xa1\x26\x61\x2d\x29\x70\x14\x8f\x75\x69\xdf\x02\xe4\x51\xea\xa1\xb9\xc4\xfb\x21\x3c\x44\x81\xe8\xb0\x05\x05\x36\x53\xa5\x03\xf4\x98\x19\x50\x9a\x7f\x67\xba\x5f\x3c\xeb\x6a\x6c\xeb\x92\xce\x45\xce\x47\xe8\x5c\x76\xf4\xa7\x8c\xce\xfa\x3d\x14\x70\xe6\xe1\x8b\x34\x17\x9a\x4a\xe6\xc4\x9a\xa0\x4f\xf1\xe0\xe1\x04\x55\xa6\x2e\x2b\x55\x8b\x5a\x0c\xb2\xda\x1c\xca\x94\xfe\x48\xd0\xa5\xf0\x20\xa2\x40\x18\x29\xb1\x08\xf0\x48\x50\x64\x76\xd1\xcc\x26\x9c\xc4\x91\x08\xae\x44\x34\xad\x09\xf7\x98\xd1\x6a\xf4\xc0\xc7\xce\x68\x46\x8b\x49\x59\xe5\x74\x46\xcf\x70\xa5\x6f\xda\x66\xf4\x4a\x23\xbe\x1b\xc2\x61\x2a\x39\x3e\x62\xda\x7d\x67\xf2\x8b\xab\xb9\x4b\xaa\x1f\xbc\xde\x7b\xaa\x81\x4c\x10\xc7\x54\xab\xc9\x80\x64\x6c\x34\x85\x9b\xad\xb3\x38\x82\x09\xbf\x9d\xe6\x0e\x56\x22\x84\x29\xde\xe4\x70\x9f\x52\xcb\x90\x61\x7e\xfd\xcb\xab\xfa\x0d\xa5\x99\xa9\x23\xaa\x53\xec\x25\xe4\x47\x62\xeb\xc4\x33\x30\xc3\xf0\x7f\x8b\x1e\x4d\x4b\xd7\xe6\x64\x40\xcb\xe4\x17\x87\xd6\xc8\x85\x24\x54\x04\x3e\xba\xe6\x0d\xa5\x66\x3d\xec\x7f\x4a\x72\x9e\x38\x44\x99\x4c\x2d\x21\x38\xf5\x39\xdb\xd4\x6c\xe7\xa1\xde\x8c\xe8\x18\x61\x83\x61\x99\x96\xfa\x99\x6b\x12\x42\xe6\x25\x3f\x0e\x05\xd9\x8b\x18\xb3\xe8\x0a\xb4\x8e\xb0\xbf\x13\xa1\x2e\xfb\x72\x24\x7e\x11\xc8\xb7\xd6\x11\x3e\x37\xc5\x2f\x47\x0d\xb1\x46\x20\x8d\x2d\xe1\x73\xd3\xe0\x03\xf3\x6b\xf4\x0c\x94\xfb\xe7\x5d\x54\xf1\x5d\xc4\x86\xdf\x88\x0d\x8b\x77\x69\xb5\xc5\x2f\xc9\x71\x6e\xed\xd8\x10\xfb\x1b\xf5\x25\x25\xbe\xef\x8e\x9d\x38\x86\x84\xa1\x67\xfd\x12\x38\x04\x9c\x64\x90\x0f\x57\x9c\x5f\xb0\x1f\xdc\x27\x5c\xe5\xc5\xd3\x8b\xff\x03\x49\x4d\xbf\x7e\xf2\x4d\x01\x00")
 
 func monitoringSystemGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -204,7 +204,7 @@ func monitoringSystemGrafanaDashboard1JsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _monitoringZyncGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xeb\x72\xdb\xb8\x92\xfe\x9f\xa7\xc0\x72\xe6\x1c\x3b\x59\xc9\x96\x64\xcb\xb7\xaa\xd4\x56\x9c\x4c\x76\xce\x56\x92\xf5\x38\xce\x54\x65\x52\x29\x1d\x88\x6c\x49\x58\x93\x00\x03\x80\xb6\x14\x97\xe7\xd9\xb7\x08\xde\xc0\x9b\x24\xdb\xb4\x2d\x5b\xf8\x31\x13\x0b\x20\x41\xb0\xbb\xd1\xdf\x87\x46\x83\xb8\x7a\x81\x90\x85\x29\x65\x12\x4b\xc2\xa8\xb0\x8e\x50\x58\x84\x90\xe5\x12\x21\xad\x23\xf4\x4d\xfd\x42\x71\xa9\xaa\x19\x06\xc4\x95\xff\xa2\xd6\x11\xea\xb6\xb2\x52\x07\x4b\x2c\x58\xc0\x6d\xb0\x8e\x90\xd5\x6e\xa3\xff\xe6\x78\x84\x29\x46\xed\xb6\xa5\x5d\x06\x14\x0f\xdd\xf0\x12\xc9\x03\xd0\xca\x27\xc4\xa9\x28\x25\x36\xa3\x6f\x99\xcb\x78\xd8\x26\x1f\x0f\xf1\x66\xa7\x85\x7a\xdd\x6e\x0b\xf5\xfa\xfd\x16\xea\xbe\xd4\x9b\xa6\xd8\x53\xcf\x7e\x93\xbd\x0e\xfa\x27\x7a\xe3\x02\x97\x42\xbf\x4e\xce\x7c\x75\x9d\x83\xc5\x64\xc8\x30\x77\xac\xb8\xee\x5a\xfd\xfb\xfd\x05\x42\xd7\xe1\xe5\x16\x38\x44\x16\x7a\x6b\x8d\x29\xc8\x7f\x39\xd6\x11\xa2\x81\xeb\x46\x25\x1c\xfb\x93\x33\xc6\x5c\x49\xfc\x44\x26\x16\x91\xc0\x55\x17\xd2\x12\x97\xd0\xf3\x50\xbc\xdf\xbe\xab\x9f\x3e\xa6\xe0\x8a\x54\xc0\x89\x78\x2d\x9b\xb9\x2e\xf6\x05\x84\x8f\x18\x61\x57\xa4\xd2\xb0\xc6\x9c\x38\x27\x2c\xd3\x50\x24\xb6\x82\x16\x2e\xad\x23\xd4\xdb\xd5\x0a\xa6\xd6\x11\xea\x68\xbf\x67\xe1\xef\xe4\x7d\xd3\xb6\x49\xf8\xb8\x83\x7e\xfa\x3b\xeb\xdd\xf7\xb4\x4c\x12\xa9\x44\x61\xfd\x35\xa3\xb6\x95\x15\xc7\xd2\xe4\xec\x32\x92\x63\xdc\x6a\xfa\x46\xd8\x25\x58\x28\x25\xaa\xbe\x67\x0f\x1d\x62\x55\x92\x7f\xcb\x50\x29\x1f\x80\x8e\xa5\x7a\xb3\x4e\xae\x1c\xaa\x2e\xd7\xad\xee\x57\xed\x67\x7a\xc9\x88\xb8\xae\x2e\xa5\x7a\x41\x1e\x14\x04\xd9\xed\x2d\x10\x64\xb7\x5a\x90\xfb\xfb\xe9\x6f\x17\xc6\x40\x9d\xfc\xa3\xf0\xc5\xb8\xf8\x1e\xa1\xe2\x03\xce\x81\xca\x8a\x1a\x0f\x4f\xab\x4a\x09\xad\x28\x15\x13\x76\x59\x1e\x46\x92\x49\xec\x56\x5c\x7d\x81\xdd\x20\x13\x6a\xe9\x65\x5c\x42\x55\xad\xde\x9a\x2a\xbc\x24\x8e\xcc\x59\x5e\xc1\xba\x55\x51\x38\x40\x4e\x18\xa1\xf2\x23\x53\x43\x5b\x15\x64\x6a\x61\x7e\xea\x70\xb2\x27\xfa\xc0\x6d\xa0\x12\x8f\xa1\xa4\x69\x3f\x6c\x8a\x63\x87\x04\xe1\x3d\xbd\x7c\x79\xd9\x30\x38\x50\x07\x38\x28\xc7\x31\x72\x99\xcc\x1e\x2c\x80\x13\x10\xff\x7b\x01\x9c\x13\x07\x0a\x9d\x16\x3e\xb6\xa1\xca\xfe\x84\xc4\xf6\x79\x51\x16\x42\x82\xef\x83\xf3\x81\xd0\x72\x7f\x25\xe6\x63\x90\x42\x73\xa1\xba\x13\x0d\xbd\xcb\xd4\x57\xbd\x13\x81\xb7\xc9\xb1\x84\x4d\x8e\x89\x2b\x06\x1c\x7e\x04\x20\xa4\x18\x28\xa5\x5d\x85\x4e\x4d\x75\xea\xf5\xc6\xaf\xe9\xdf\x1b\x2d\x9f\x39\xaf\xff\xde\xf8\x39\xa3\x76\xfb\x1b\x6e\xff\xec\xb4\x0f\xbf\xff\x67\xf6\xd7\xc6\xf5\xb7\xae\xf7\xfd\xe5\x4b\x34\x9c\xa1\x4d\x21\xb1\x0c\x84\xee\x29\xc3\x41\xc1\xb8\x87\x43\x63\xb3\x24\xf1\x60\x10\xc9\x24\x7f\x09\xa1\x12\xf8\x85\xb2\x1b\xab\xeb\x55\xd7\xbd\xc7\xb6\x54\xce\xb9\x9b\xab\x8e\xac\xfe\x7d\xfa\x8c\xab\xab\x7f\x5f\x5d\x45\xfd\xb8\xbe\xfe\xf7\xf5\x75\xbe\x31\x0e\x23\xe5\x51\xad\x37\x56\x5a\x7c\x1d\xff\xa5\xb9\x9f\x09\x07\x31\x61\xae\x53\x72\x4b\x1e\xbc\xe7\xcc\xd3\x5c\x72\x5a\x7e\x0a\xe3\xd8\xc6\x0a\x37\x7c\x9e\x90\x91\x2c\xdf\xa1\x39\x38\x94\xe8\x01\xf9\xc0\x91\x00\x9b\x51\x07\x6d\x0e\x67\xa8\x28\x4e\x4b\xa6\xae\xff\x4a\x1f\x87\x98\x2b\x1f\x5e\x18\x89\x82\x71\x59\x70\x24\x6a\x10\x0e\x12\x37\x4a\xa8\x43\x2e\x88\x13\x60\xd7\x2a\x8d\xc7\xe4\x1a\x85\x38\x59\x07\xa6\x78\x4a\x0a\xde\x6c\x18\xd8\xe7\x91\xf1\xe9\x6f\x18\x7a\x8d\x78\x2c\x86\x42\xa8\xc0\xce\xc2\xd5\xd5\xde\x24\xf5\x1a\xdf\xbe\x97\xba\x38\xc3\x53\x98\x63\xf3\x0e\xd8\xc4\xc3\x0a\x59\xba\x35\xf6\xc8\xe1\x87\x5f\xb0\x44\x17\x0f\x41\x99\x61\xa1\x98\x8d\x8f\xb1\x80\x52\x5b\x91\xbf\xcc\xbf\x4a\xea\x30\x4b\xc5\xda\x3b\x66\xd6\xd7\xaa\xee\x7e\xd6\x4b\x31\x09\x15\x59\xd9\xcb\xd2\x13\xee\xb1\x9f\xa5\x51\x32\x2b\xdb\x02\x76\xc9\xb8\x0a\x2a\x54\xf9\x07\xb8\x48\x3b\x9d\x63\x41\xcf\x18\xc5\x73\x05\xf3\x60\xfc\xc0\xc0\xb8\x81\xf1\x55\x81\x71\x9b\x51\xc9\x99\xeb\x02\x7f\x7c\x28\xcf\xfa\xf2\xe4\xe1\xbc\x4a\xac\x06\xd2\x0d\xa4\x23\x03\xe9\x4f\x08\xd2\x8b\x13\xf3\xc3\x1a\x44\x3f\x34\x88\x6e\x10\xfd\x31\x11\xbd\x15\x4d\x1f\x5f\xff\xbd\xd1\xfb\x16\x96\xbc\x32\x18\xdf\x1c\xc6\xf7\xa6\x53\x83\xf3\xe9\x5b\x18\x9c\x37\x38\x8f\x9e\x36\xce\xef\x15\x60\xbe\x34\x73\xaf\xc1\xf9\x83\x8e\xc1\xf9\x06\x70\x3e\xf7\xd8\x15\x81\xf9\xfc\x53\x9e\x00\xce\xef\x1a\x9c\x6f\x1a\xe7\x77\x0d\xce\x6b\x6f\xf1\xec\x70\xde\xea\x58\x06\xe6\xd7\x1a\xe6\x0f\x96\x84\xf9\xae\x81\xf9\xe7\x3a\x9d\x7f\x72\x38\xdf\x37\x38\xdf\x34\xce\xf7\x0d\xce\x6b\x6f\x61\x70\xbe\xb2\x93\x06\xe7\x9f\x0c\xce\x2f\xce\xa7\xdb\xaf\x01\xfa\x1d\x03\xf4\x06\xe8\xef\x08\xf4\x03\x27\x88\x52\x72\x07\x11\x8c\x88\x81\x08\xbc\x3b\x2e\xcc\x6f\xa3\x65\x9f\x65\xb3\x80\xca\xbb\x3d\xed\xd6\x14\x62\x49\x9a\x70\xa6\x8c\x7a\x31\x2f\xa8\x71\xc9\x35\xd2\x77\x86\x03\x1e\xd0\xb8\x8f\xf7\x26\xf7\x8a\xa7\x3c\x01\x89\xbf\xb1\x25\xb9\x80\x53\xb0\x19\x77\x6a\x04\x7f\x7c\x6b\xc1\x5f\x10\xb8\x7c\x08\xd1\x57\x3e\xe7\x09\x08\xff\x4f\x02\x97\x35\x42\x7f\xbb\x22\x2c\xf8\x34\x14\x30\xc2\x17\xc0\xf1\x18\x10\x07\xe1\x33\x2a\x00\xe5\x18\xa3\x21\xbd\xb7\x23\xbd\x25\x5a\xa7\x51\x4a\xb3\x86\xb5\x6a\xa4\xd7\xc6\xdc\x29\x34\x1c\x16\x9d\x60\xc7\x21\x74\x5c\xb6\x9c\xb0\xf2\x94\x05\xd4\x29\x34\x9e\xf6\xd4\x8e\x77\x15\x15\x1a\x4c\x37\x1b\xfd\xd2\xdf\x3f\xdc\x7d\xdf\xd3\x6d\x54\xdd\xf2\xd9\xc6\xd1\xd8\x14\x3f\x72\x1a\x48\x6a\x27\xe0\xc5\xe3\x48\x02\xf7\x99\x8b\x25\x1c\x2b\x73\xd5\x2e\x85\xa9\xcf\x68\x44\x4d\x3b\x5b\xfd\x8a\xd1\xc1\x7c\x6c\x13\x39\x2b\x8f\xc0\x90\x8f\x67\x1e\x4c\x8a\x64\x9c\xdd\x84\xbf\x3b\x20\x6c\x4e\xfc\x78\x73\x52\x66\xd4\x8d\x66\xd4\x96\x89\xfc\x04\xb0\xf4\xb0\x9f\xa7\xa7\x13\xe2\xc0\x5f\xc0\xd9\x71\xea\x2f\xf2\x94\x6f\x42\xc6\x13\x97\x8c\x27\xf2\x6d\xac\xff\x1c\x73\x8e\xe6\x06\xf3\x37\xdb\xc4\x76\x5b\xcb\xc7\x8b\x24\xbb\x92\x45\x73\xb8\x00\x2e\xe0\x6b\x5d\x37\x1b\x67\xa6\x91\x5e\x97\xc0\xcf\xad\x7c\xc0\xc9\x85\x5a\xd8\x4c\xe4\x5f\x09\x99\xcb\x05\x99\x3a\x73\xf1\x54\x45\x99\x5c\xb8\x7d\x74\x69\x0e\x7e\x2e\x80\xc9\x8a\x18\x51\xfc\xba\x2a\x56\x94\x03\x4e\x14\xc9\x16\x11\x1a\x5f\xba\xc4\x5e\x8e\x2a\x14\x0a\x4b\x7f\x27\x42\xb2\x31\xc7\x5e\xad\x85\x25\x90\x59\x94\xbe\x35\x7d\x53\xf2\x94\x65\x17\x9b\xb5\x33\x8d\x4c\xef\x53\xe0\x0d\xd5\x14\x2c\x27\x88\xb8\xf2\x33\xf9\x59\xc4\x50\x6b\x56\x7e\x8c\x86\x81\x3a\x0d\xa8\x86\xbf\x6a\xf4\xa8\xc4\x8e\x4a\xe4\xa8\x13\x9e\xef\x12\x99\x1a\x56\xa5\x83\x9e\x45\x2f\x75\x1c\x3b\x71\x0b\x07\x92\x59\xc5\xda\x6a\x79\xcc\x4a\xf2\x30\x98\x72\x07\x4c\xa9\xc5\x85\xfd\x9b\xc6\x77\x7a\xfd\x87\x83\x85\xf9\x9b\x37\x9e\x39\x2c\x54\x2e\x55\x64\x21\xf3\xd7\x1b\x94\x49\x32\x22\x76\xb4\x4b\xda\x40\x88\xf5\x49\x97\x47\xe5\xa2\x83\x01\x14\x64\x00\xc5\x00\xca\x03\x4d\x52\x6e\x8f\x39\xa5\xb9\xc8\x43\x82\xce\xfc\xfd\x05\xeb\x0e\x3a\x12\x28\xa6\xd2\xc0\x0d\xb2\xce\x22\x49\x18\xa0\x31\x40\x63\x80\xe6\x89\x02\x4d\x71\x72\xb3\xd3\x7b\x30\x9c\x39\x9c\x9f\xf8\xb6\xee\x38\x13\xe5\x64\x6d\xbb\xe4\x02\x0c\xd6\x58\x1f\xc8\x05\x50\x10\x06\x6c\x0c\xd8\x18\xb0\x79\xaa\x60\x53\x9a\xd5\x3c\x24\xda\xcc\xdf\x4d\x65\xd0\x46\xa1\x0d\x07\xec\xcc\x0c\xdc\x58\xa7\x80\x1d\x62\xf0\xc6\xe0\xcd\xad\xf1\xe6\x01\xbe\x80\xb9\x53\xb3\xa3\xa4\xaf\xa5\x41\x2e\xfa\x04\x26\xfa\x23\xd0\xb3\x70\x56\xfd\x33\x98\x85\x65\xff\x48\x45\x88\x8d\xd0\xff\xb1\xa1\x40\x40\x7f\x04\x10\x80\x83\x30\x75\x90\x72\x65\x48\x32\x34\x04\x04\x53\xb0\x03\x09\x0e\x7a\xf3\xf9\xcd\x09\xda\xa4\xa1\x9f\x46\x23\x4c\x5c\x70\x5a\x88\x32\x8e\xc6\x4c\x22\x98\xfa\x84\x83\xf3\x72\xab\x89\x2c\xe1\x85\xca\xdb\xad\xf9\x7e\xe9\xae\xf9\xba\x87\xc9\x12\x5e\x9e\x29\x78\x78\xba\xf9\x23\x80\x41\x68\xff\x03\x61\x4f\xc0\x09\x5c\x70\x96\xde\x0d\xf4\x23\x80\xad\x57\x1b\xad\x70\xdc\xbf\xde\x88\xc1\x3f\xc2\xfd\x90\x63\x72\x09\x4e\xd8\xf2\x7d\xef\xfb\x59\x82\x05\xe8\xdd\x79\x0a\x5b\x7f\x4e\x95\xf7\xf9\x9f\xd0\x2b\xa9\x2c\xd2\xbb\x65\x3a\xf6\x8a\xe3\x6e\xfd\x32\x1d\x3b\x35\x26\x48\x19\x85\xfb\xcc\x21\x34\xfb\x7b\xf4\xf2\x27\xb3\xbf\x67\x49\xa2\x50\xa0\x07\x82\x79\x31\x91\x27\x14\xc9\x09\xa0\x51\x20\x03\x0e\x2d\x34\x0c\x24\xa2\x2c\xfc\xef\xf2\x41\xe8\xc3\xc2\x38\xed\xee\x41\x35\x7d\x38\x34\x9b\x8c\x0c\x7d\x78\x1c\xfa\x90\xde\x6e\x28\x44\x23\x14\xe2\x73\x22\x4f\x43\x23\x0c\x8d\x30\x34\x62\x05\x69\x84\x9c\x60\x19\xf3\x00\x84\x25\x72\x01\x0b\x89\x18\xb5\xa1\x85\x1c\xe2\x28\xc2\xc0\x03\x8a\x58\x20\xc3\x5b\xb0\x94\xe0\xf9\x52\x84\xa4\x83\x83\xe4\x33\x15\xa6\x90\x13\xe0\x30\x62\x1c\x10\xe6\x80\x52\x1f\x8a\x46\x8c\xa7\x57\xcd\x22\x4e\x22\x18\xa3\xf7\xc3\x2e\x4a\x81\xf9\x5a\x7a\xb1\x6b\xe8\x85\xa1\x17\x8f\x42\x2f\xa2\x71\x66\xb8\x45\x23\xdc\xe2\x7d\xe4\xb4\x0c\xb1\x30\xc4\xc2\x10\x8b\x15\x25\x16\x59\x54\x22\xb0\x6d\x10\x62\x14\xb8\xee\xec\x56\xf8\x7f\xe3\x2c\xb0\xfe\x5e\x0d\xfc\xef\x19\xf8\x37\xf0\xff\x38\xf0\x4f\x28\x11\x13\x43\x00\x9a\x22\x00\xb1\x38\x0d\x05\x30\x14\xc0\x50\x80\x55\xa5\x00\x49\x6c\x81\x3a\x88\xe3\xfa\x40\x42\x4b\x8b\x22\x5c\x32\xba\x21\xd1\x10\x54\x0d\x09\x6f\x1e\x63\x72\xbb\xb0\xc1\xcd\xf3\xf9\x6a\x79\x43\xdf\xf0\x06\xc3\x1b\x1e\x85\x37\xc4\xcb\x72\x86\x36\x34\x42\x1b\x7e\x8b\xa4\x69\x58\x83\x61\x0d\x6b\xca\x1a\x1e\x22\xb1\x73\x6f\xa7\x1a\x46\xbb\xd9\xe2\x7e\x55\x62\x27\x07\x1f\x22\xad\x38\xe0\xbb\x6c\xe6\x01\x95\x6f\x19\x1d\x91\xb1\x55\x1e\xc9\x27\xcc\x11\x68\xf3\xd7\xe2\x95\x2f\x6f\x90\x0c\x6a\x63\x7b\x02\x67\xc4\x03\x16\x94\x3c\x86\xda\xcd\x70\x8c\xed\xf3\x31\x8f\x93\x6c\x73\x98\xa9\xaa\xff\x0c\xc7\x4f\x49\x8c\x76\xc2\xc8\xb2\xa1\x63\xfd\xf2\xbe\xb7\x7b\xd8\x7f\xab\x0f\x54\x75\x8e\x7e\x6f\x67\xbf\x85\xba\xbd\xc3\x16\xda\xed\xb4\x50\x67\xeb\xe0\x30\x77\x96\xfe\x2f\xbd\xc3\x43\x7b\x77\xcf\x2a\x59\xc7\x52\xec\xac\x3c\x42\xeb\x46\xa7\x35\xc6\x81\x02\xe8\xab\x1c\x47\x49\xde\xaf\xdb\xe9\xe4\x69\x4a\x52\xd1\x29\xbb\x98\xa2\x71\xa6\xbe\xfd\x43\x38\xaa\x4a\xa8\xae\x5f\xf1\x11\xf3\x73\xe0\xa2\x2e\xcb\xbb\xd6\x3c\x77\x0a\xe6\x59\xfc\x90\x7d\xc9\x3a\x77\x4b\x6d\x4f\x88\xa3\x0c\x21\xe1\x10\x95\x3b\x30\xf6\xb2\xb5\x23\x1d\x47\xad\x79\x94\xc9\xc3\xbe\x4f\xe8\xf8\x2c\x32\xc5\x6e\x55\xf9\x1c\x2f\x1b\x3b\xf3\xc8\x51\x87\x6c\x59\xc2\xb4\xe0\xac\x2e\x12\x1d\x2d\xf4\x79\x49\x63\x1c\xd3\xf1\x82\xc6\x7a\x73\x1c\x93\x87\xa7\xef\xb0\xc4\x27\x09\x49\xd3\x8c\xa3\x4c\x10\x6d\x46\x29\xd8\x12\xb2\xef\x6a\xaa\x6b\xce\xc2\x27\x17\x06\x5c\x35\x7b\x74\x83\x31\xa1\x7f\x02\x17\xf1\x24\x63\x6f\xab\xb7\xb5\x6b\x69\x54\x51\xc8\x11\x99\xe6\xd5\x10\x17\xbe\x67\x34\xc9\x72\xb7\xfa\x9d\x7f\x68\xf5\x1c\xca\xf7\xa8\xb2\xda\x5b\x94\xcc\x3e\x62\x7f\x8e\xae\x46\x11\x51\xc9\x73\x62\x55\x23\xa3\xb7\xb5\x3e\x6d\xbf\x29\x54\xb0\xf4\x86\x39\x02\x17\x3e\xe6\xe7\x6e\xc4\x47\x35\xcb\x0f\xa7\x41\xe9\x66\x2d\xe5\x4c\x76\xba\x2d\xd4\xed\x1e\xb4\x50\xf7\xe0\x30\x74\x26\xdd\x83\x9c\x33\x19\x05\x6e\xd5\x8c\x21\x6c\x59\x6f\x27\x6a\xa6\xd7\x69\xa1\xee\xe1\x4e\xae\x81\xb9\x5b\x8c\x24\x1e\xba\x61\x3b\x81\x57\xf8\x9e\xdd\x8d\xf6\x0c\x9d\x07\x43\x18\x70\xf0\xdd\xf8\x23\x30\xd9\x0e\x9f\x41\xb4\xc1\x67\xa0\x72\x7c\x93\x4b\x44\x1d\x99\xae\x6c\xe2\xf5\xdf\x1b\x25\xb8\x68\x7f\x8b\xbf\x76\xda\xf8\x87\x4e\x6f\x41\x7d\xad\x6e\xab\x67\x55\xb1\x5f\x6b\xa7\x23\xac\x4a\x96\x5b\xac\x49\xb2\x77\x03\x4a\x09\x1d\x23\x9f\x39\xa2\x0c\x88\x82\xd0\xb1\x0b\xa1\x40\xb3\x3a\x35\xf4\x75\xfb\x3f\xd0\xed\x5f\xd5\xce\xb7\x7f\x16\x12\x67\xeb\x75\xb5\xe9\x77\xaa\xfd\xcc\x42\xdb\x57\x17\x7e\x8a\x1d\x57\x38\xcb\xbe\x17\x1c\x3f\x49\x1c\x42\x05\x90\xdf\x00\xe3\x63\xb0\xbe\x29\xc6\xc7\xd4\xc0\x60\xfc\x5d\x30\x7e\xaf\x29\x8c\xef\x57\x61\x7c\xce\xa2\x0c\xca\x37\x8e\xf2\x06\xc5\xd7\x06\xc5\x7d\xb0\xef\x03\xbd\x51\x1b\x19\xfe\xd0\x1c\x7f\xf8\x42\xf1\x05\x26\x6e\x68\x0d\x86\x43\x98\x58\xc0\xf3\xe1\x09\xa5\x15\x9f\x5b\x13\x85\x3d\x13\x0c\x30\xc1\x00\x43\x23\xee\x89\x46\xa8\xa5\x99\xcd\xe4\xff\x54\x62\x42\x81\x0f\x3c\xf0\x18\x9f\x0d\x02\x81\xc7\x30\x18\xce\x24\xd4\x02\x78\xb4\x9a\x56\x01\xd7\x55\x27\x9d\x44\x2b\x6b\x94\x39\xd0\xfc\x79\x27\x8f\x0a\xe3\x2a\x46\xee\x10\x21\x39\x19\xaa\x4c\x3c\x46\xd1\x84\x09\x69\xf0\xbc\x49\x3c\xbf\xe5\xbc\xdf\xd9\xdd\xc5\x3b\xd8\xe0\xf9\xdd\xf0\xbc\x78\x48\xed\x6d\xf1\x7c\xa7\x12\xcf\xcd\xc4\xdf\x4c\xfc\x0d\x62\x2f\x9d\xf3\x12\x4e\xc1\x1d\x70\x25\x8e\x26\xe2\x3e\x73\x06\x19\x78\xa7\x13\x70\x21\x31\x5f\xee\xc0\xdf\x25\xf1\xfb\x5b\xdf\xfb\xfe\x32\xfe\xdc\x97\xcf\x9c\x7b\x3f\xb3\xac\x26\xc7\xe5\xf8\x11\x10\xfe\x23\x9e\xaa\x09\x3a\x4a\xc4\x8a\x36\x5d\x2c\x24\xea\x23\x8f\xd0\x40\x82\xa8\x58\x0f\x7f\x6e\x50\xff\xd8\x79\x90\xb7\x4f\x48\x5c\x9c\x48\x51\x73\x14\x6b\xb7\xd9\x33\xd7\x43\x84\xfc\xcd\xf3\xe5\xac\x9c\x23\x94\x7c\xb9\xb0\x5c\xf3\x44\x93\x18\x3d\x3c\x3d\x01\x7e\xaa\xfa\xb3\x53\x0f\x6d\x61\x01\xc2\x02\xfd\x0c\xdf\x7d\x01\x8a\x2d\x9b\xe2\xd8\xcf\x97\xdf\x24\xc5\x31\x4d\x8d\xc9\x81\x6a\x54\xfa\x8e\x70\xb0\xe3\x2c\xdc\x5c\xf5\xca\xe5\x45\x3e\x52\x70\xf8\xee\x78\xd0\x9b\x8b\x07\xca\x8e\xdb\xb9\x38\xa9\x76\xdd\x07\x42\xcf\xab\xf2\xc0\xb4\x99\x61\xae\x3c\x94\xb4\xd2\xc8\x42\xca\xf7\x4c\x57\xce\x8b\xe2\x55\xa1\xe8\x0a\xf1\xde\xf6\x74\x56\xb3\x3c\x71\x0f\x4a\x0a\xe8\x42\x35\xbd\x5d\x5e\x4d\xcf\x26\xfc\x53\x12\x93\x00\xa7\x9d\x0f\xc1\xe4\x85\xf4\x6e\x45\x12\xa5\x4f\x98\x13\xe5\x47\xa3\x4d\xe5\xde\x5a\x48\xe9\xb7\x85\x02\x1a\xfe\xfb\x52\xed\xe9\x50\xcc\x53\xbd\x4d\x16\x65\x0a\x71\x28\x6b\xce\xa4\x54\x37\x99\x52\x7d\xef\xc9\xca\xf9\x07\x3f\xb1\x8c\x6a\x9d\x2d\xae\xd5\x46\xac\xe5\x27\x20\x7b\x37\x9d\x80\xec\x97\xc3\x69\xd1\x86\x28\x33\xff\xb8\xff\xf9\x47\x53\xf3\x8e\x3b\x6c\xad\x7a\x3e\xf3\x8e\x47\x8b\x4b\x3d\x58\x58\x4a\x6d\xc8\xf2\x99\xf3\x14\xf6\x61\x9d\x2c\x1b\xb3\x32\xc7\xea\x2f\x4d\x20\x1e\x10\x82\xcd\xde\x2b\xbd\x7c\xa5\xf6\x5e\x1d\xd4\x7d\x97\x3d\x03\x81\xbb\x6e\xbd\x7a\x7b\xf2\x05\x7d\x09\x27\x61\x77\xdc\x7f\xf5\x74\x78\xd3\x8d\x03\xb7\x07\xdd\x6a\x2d\xec\xcf\x3f\xc5\x65\x4d\x36\x92\x3f\xef\x18\xac\xa5\xc1\xc7\x4a\x52\x21\xca\x1c\x18\xa4\xa4\x26\x4f\x87\x8e\x32\x62\x64\xfb\x41\x1c\x6b\x49\x8e\xeb\x51\xc6\x72\x74\x75\x85\xb6\x3e\x07\xde\x29\x96\x80\xae\xaf\x35\xa6\xf4\xf7\x5d\x63\x30\xcd\xb0\xa4\xf9\xc1\xda\x39\x2c\xa9\xb1\x78\xed\x63\x32\xab\xd4\x39\x9b\x28\xcc\x8a\x93\x28\x13\x6c\xd1\xca\x57\x8b\x43\xd5\x7c\x3d\xb6\xdf\x2c\x87\xfa\x23\x60\x12\x3f\x28\x87\xb2\x55\x86\x47\xa1\xdf\x0f\x40\xac\x46\x5a\x66\x41\xb7\xa3\xa7\x16\x34\x48\xb9\x6a\x4e\x93\x6a\x76\xa9\xdc\x30\xae\x74\x04\x8c\xa1\xea\x50\xb5\x95\x65\x62\x36\x67\xca\x2a\x73\x02\x9c\xcf\xcf\x26\xec\xf2\x77\xc0\x8e\xea\x42\xfe\xb6\x08\x24\x35\x3b\xb2\x99\x5b\xf0\x34\x0e\x08\xbb\x56\x9b\x0d\x52\x3f\x21\x67\xee\x3c\x44\x53\x1e\x22\x14\xc6\x59\x1e\x51\xa3\xa1\x0c\x19\x33\xfa\xfa\xf5\xeb\xd7\xf6\xc7\x8f\xed\x77\xef\xd0\xef\xbf\x1f\x79\xde\x91\x28\x30\x2d\x1f\x4b\x09\x9c\x56\xb7\x95\x1e\xe6\x47\x1c\x07\xe8\xe2\xa5\xbe\xb4\x5b\x65\xc2\x92\x08\x94\xf1\xd8\x2e\x4b\x20\x94\xe5\xe1\x7e\xbf\xcb\x0b\x69\x4b\x2e\x05\xd2\x18\x71\xc0\xc2\x10\x8d\x2b\xce\x52\x3a\x65\xbd\xe3\xc4\x75\x91\xc3\x2e\xa9\x55\xba\xec\x0b\x77\xcb\xc9\x62\x9a\x08\x55\xa2\x2d\xfa\xa5\x98\x54\x58\xcd\x14\x73\x22\xa6\xd1\xc1\x7e\xb9\xba\x80\x12\x8d\x10\xdc\x4c\xfa\xa7\xf1\x11\x91\xeb\xa9\x80\xe3\xd5\x51\x00\xfa\xc7\x7a\xaa\xe0\x6d\xb3\x2a\x88\x21\x48\xfd\xbc\x99\x22\x3e\x10\x8f\xac\xeb\x38\x78\xf7\xf8\xe3\x20\x12\xff\xba\x8e\x82\xdf\x56\x61\x14\x9c\x30\x67\xf5\xa4\x9f\x67\xd0\xb7\x12\xfe\xb6\xb3\x7d\x75\x85\xb6\x3e\x25\xf1\x29\x74\x7d\x5d\x2a\x68\xef\x08\x1b\xbb\xd0\x3e\x0f\x86\xc0\x29\x48\x10\x6d\x9b\x79\x7e\x20\xa1\xcd\x21\x9a\xe3\x88\xb6\xcf\x9c\xff\xba\xc0\xbc\x9d\x85\xbd\xb2\xa0\xd7\x3f\xc3\x0a\x9f\x39\xaf\x7f\x1d\x0c\x6c\x28\x6e\x24\xd0\x34\xee\x17\xa5\xfc\xd0\xa3\x6d\x85\x74\xac\x89\x65\x7b\xeb\xd5\xf6\xcd\xe5\x22\x24\x27\x74\xbc\x9c\x5c\xe2\xbf\xb4\x80\xda\xf3\x0f\x9c\xe2\xa1\x0b\xc5\x90\xa9\x90\x58\x4d\x6f\x4b\x23\xeb\x86\xd1\xd4\xda\x65\xe6\x5c\xf9\x1d\x52\x5a\xf3\x6b\xf5\xc9\x30\x4c\x4e\x94\x17\x4a\xd6\x36\xe3\xb9\x54\xc0\x67\x27\xce\xe3\x26\xc4\xb9\xd2\xc6\x8b\xb6\x91\x51\x79\x3e\x59\xf6\x9e\x47\x90\xab\xb8\xd6\x7a\x08\xf3\x9d\x19\x3f\xeb\xa5\xf0\xdf\x1e\x66\x89\x6e\xf1\x4a\x9c\x0a\xf1\x3f\x4a\x3a\x13\xc7\x54\x84\x4a\x28\xab\x20\x25\x4e\x85\x62\xb3\x4a\xb7\x2e\xab\x74\x2f\xd0\x23\x2d\xac\x1d\xd6\x9c\xaf\x90\x6d\xbe\xbf\xf3\xc2\xda\x47\xb5\x49\xc4\xe4\x27\x2d\x50\x44\xcd\xc6\xd2\x2c\x55\x78\x8d\x17\xcb\x56\x3d\x11\x69\x25\x93\x8a\x96\xdc\xa6\x75\x7b\xaa\xd1\x42\xe9\x23\xfe\xe3\xf5\x86\x49\x19\x6a\x2e\x65\x48\x77\x99\x26\x6b\xe8\x6e\x7c\x44\xd9\xbb\xe1\x23\xeb\x98\x35\xd4\xed\xd4\x6c\x96\xda\x6f\x9c\xdd\x98\xcc\xa1\x26\xc9\x50\xb7\xd3\xaf\x56\xdc\x8e\x61\x43\x4f\x37\x49\xe8\xb1\x12\x7e\x2a\x3f\x70\x66\xf2\x7d\xd2\x6e\x55\xb2\x8d\x44\xa4\x8f\xbe\xd4\xf8\x04\x53\x7e\x1c\xb0\x23\xd6\x71\x63\x1d\x98\xc4\x9f\x95\x52\xc3\xba\x26\x3e\xac\x44\xfa\x4f\xac\x0b\x93\x01\xd4\x98\x1e\x6e\x3f\x20\x4c\x1e\x50\x83\x6a\x30\x79\x40\x26\x0f\xc8\xe4\x01\x35\x9a\x07\xb4\x8a\xb1\xce\x67\x9d\xe5\x13\x8b\xb9\x39\x01\xaf\xa8\x44\x1b\x49\xf4\x79\x44\xeb\xbc\x49\x1a\xcf\xba\xe8\xf4\xa1\x32\x79\xd6\x45\x9e\x8d\x24\xf3\xac\xf6\x18\x59\x33\x8d\xae\x44\xb6\x8e\x1e\x59\x37\x09\x3b\x26\x61\x67\xa5\x16\xc8\x5e\xa0\xc7\x5a\xd3\xea\xf6\x4a\xaa\x8e\x76\x55\x37\xb8\x17\xfe\x13\xc8\x4b\xc6\xcf\x4d\xce\xce\x22\x5d\xd4\x1d\xab\x9f\x2d\x30\x9a\x75\xaa\x95\xfb\x84\xe2\x4a\x66\xeb\x10\x8e\x25\x68\x2c\x88\x46\x43\x70\xc0\xc1\x06\x72\x11\x13\xa1\xd2\xa7\x10\xef\xc0\x3c\x1a\xff\x16\xe2\xad\x53\x76\x56\xee\x5b\x88\xa7\x91\xcc\xd1\x31\xa6\x4e\x64\xe9\x77\xa2\x1f\xeb\x9a\x83\xa3\x05\x96\x4a\xe8\x9a\x19\xd8\xb1\x7f\xaf\xd9\x39\x4f\xfc\xc3\x88\xe6\x13\xca\xf7\x00\xdb\xbd\x9a\x2f\x32\x66\x67\x97\x19\xd8\x36\xb0\x7d\x27\xd8\x56\x93\x47\x8f\x48\x83\xdb\x0f\x88\xdb\x67\xb1\xd0\x0d\x70\x37\x15\x1b\x30\xe0\xbc\x1a\xe0\xfc\x22\x6e\x36\x1c\x73\xe1\x70\x8a\x92\x2f\x23\xdd\x58\xc2\x9e\x80\x87\xb3\x63\x98\x23\x18\x8b\xd2\xd2\xd4\x84\x1f\xf3\xf3\xe8\x4a\x89\xc7\x99\xda\xad\x68\x51\x35\x16\x9c\xf5\x73\x46\x6d\x2b\x7d\x8e\x04\xcf\x77\xb1\x24\x74\x9c\xf6\xdf\x72\x89\x90\x9a\xd1\xe8\x30\x1c\x1d\xe2\xa9\x03\x2d\xa1\xb6\x1b\x38\xf0\xa6\xfa\x8c\xc4\x4a\xf5\x58\x5e\xe0\x4a\x52\x71\x79\x72\x92\x66\x05\x4f\xc8\x01\x92\xbe\x0c\x69\xfd\x08\x80\xcf\xd4\x92\x2e\x67\x1e\xc8\x09\x04\xba\x29\x6b\x82\xec\xe6\x4a\xc7\x30\x2d\x04\x46\x2d\x71\x4e\xfc\x2f\xdc\xfd\x1c\x0a\xa8\xdc\xb9\x64\xb8\x6b\x9d\x2b\x8e\xb7\x9c\xfe\xdd\xe4\x3c\xd7\xc2\xcb\x67\x3c\x22\x67\xe6\x89\xca\x0a\x2b\xac\xf1\x39\x7e\xc5\x85\xf2\xfc\x28\x48\x8f\xf5\x2b\x5d\x56\x35\xba\x6e\xad\x45\x2b\x85\x32\xeb\x06\xca\xac\xbc\x49\xd3\xa5\xf6\x22\xba\x40\x14\x11\x70\xa3\x33\x4e\xcb\xf1\xef\x65\x45\xb3\x9c\x70\xb2\x31\xad\x8d\x6a\xdd\xb4\xe6\x3c\x63\x49\xab\xb1\x03\x21\x99\xb7\xa4\xc5\xe4\xac\x72\x31\x87\x56\x53\xb0\x11\xa1\x24\xf9\xf8\x9e\x52\xd8\x20\x02\x86\x6c\xb9\x83\xd0\x11\x9b\x7f\xba\x42\xe8\x1a\xda\x5b\xaf\x36\xae\x5b\xa8\x40\x39\x16\xda\x4c\x01\x94\x52\x93\xa9\x0d\x42\x2e\xe3\x06\xe6\xdc\xbb\xc8\x19\xdc\x83\x08\x16\x78\x92\xed\xcd\xad\x57\x2f\xab\xa8\xdc\xf6\xf2\xd6\x12\xf3\x11\xbd\x7d\x89\xc7\xca\x2c\xc4\x1f\xc9\xab\x59\xf9\xda\x92\x0c\xc2\xb2\xea\x8b\x63\x5b\x8c\x84\xa4\x55\x04\x02\xce\xa2\x86\x72\xf3\x0c\xf5\x6f\x48\x2a\xae\x23\xa8\x20\x4a\x2d\x31\x48\xa4\xc7\xf6\xb2\xcb\x76\x37\xa1\x3f\xc9\xc9\xbc\x51\x8c\x38\xbb\xcd\x27\xf6\xb9\xa2\xfa\xf1\xcd\xb1\x28\x07\x09\x47\xd5\x1d\x81\xd5\xd7\x8e\x5b\xed\xea\x67\xaf\xe6\x0e\x62\xed\x7a\xd9\xdf\x7d\xed\xef\xae\xfe\x63\xa7\xa3\xd7\x68\x24\xad\xa7\xfd\xdd\x75\xa2\x61\xf9\x3d\x79\x87\x90\x51\x97\x1d\x54\xfd\x53\xf4\x86\xf7\xf4\x86\xf5\xa7\xf4\x76\xf5\x1f\xda\xb9\xcd\xfb\x8e\xde\xdf\xa4\x2f\x39\xf1\xfd\x64\x34\x73\x0b\x19\x0d\x2e\x7a\x25\xb4\x8d\x22\xa8\x47\xdb\xe8\xaf\xd0\xc0\xd4\xe5\x17\x19\x5f\x78\x71\xfd\xe2\xff\x03\x00\x00\xff\xff\x57\x2f\x1e\x8c\xf1\x14\x01\x00")
+var _monitoringZyncGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5d\xff\x53\xdb\x38\x16\xff\xbd\x7f\x85\xce\xbb\xb7\xd0\x5e\x02\x49\x20\x05\x3a\xd3\xb9\x81\xb2\xbd\xbd\x9b\xb6\xc7\x52\xba\x33\xbb\x9d\x4e\x4e\xb1\x45\xa2\xc3\xb1\xbd\xb2\x0c\xa4\x0c\xf7\xb7\xdf\x93\xfc\x4d\xfe\x96\x84\xc4\x84\x84\x68\x67\x96\xc6\x92\x2c\xcb\xef\x3d\xbd\xcf\x47\xd2\x93\x75\xf7\x02\x21\x03\x3b\x8e\xcb\x31\xa7\xae\xe3\x1b\x6f\xd0\x1d\x24\x41\xa2\x4d\x7d\x0e\x57\x5f\xe5\x15\x8a\x52\x65\x4e\x3f\xa0\x36\xff\xa7\x03\x99\xed\x46\x9a\x6a\x61\x8e\x7d\x37\x60\x26\x81\x0c\xa3\xd9\x44\xff\x60\xf8\x12\x3b\x18\x35\x9b\x86\x52\x8c\x38\xb8\x6f\x8b\x22\x9c\x05\x44\x49\x1f\x52\xab\x24\x95\x9a\xae\xf3\xce\xb5\x5d\x26\xea\x64\x83\x3e\xde\x6e\x35\x50\xa7\xdd\x86\x3f\xdd\x6e\x03\xb5\x5f\xaa\x55\x3b\x78\x24\x9f\x7d\x9c\xbe\x0e\xfa\x09\x1d\xdb\x84\x71\x5f\x2d\xc7\xc7\x9e\x2c\x67\x61\x7f\xd8\x77\x31\xb3\x8c\x28\xef\x5e\xfe\xfb\x0d\xfe\xde\x8b\xe2\x06\xb1\x28\xcf\xb5\xd6\x18\x38\x84\xff\xd3\x82\x14\x27\xb0\xed\x30\x85\x61\x6f\x78\xe1\xba\x36\xa7\x5e\x2c\x13\x83\x72\xc2\x64\x13\x92\x14\x9b\x3a\x57\x42\xbc\x5f\xbf\xc9\x4b\x0f\x3b\xc4\xf6\x13\x01\xc7\xe2\x35\x4c\xd7\xb6\xb1\xe7\x13\xf1\x88\x4b\x6c\xfb\x89\x34\xe0\x39\xd4\x3a\x73\x53\x0d\x85\x62\xcb\x69\xe1\x06\xae\x3b\xfb\x4a\xc2\x2d\x24\xb4\x94\xeb\xb1\xb8\x8e\xdf\x37\xa9\x9b\x8a\xc7\x1d\x76\x93\xeb\xb4\x75\xdf\x92\x34\x4e\xb9\x14\x85\xf1\xc7\xd8\x31\x8d\x34\x39\x92\x26\x73\x6f\x42\x39\x46\xb5\x26\x6f\x84\x6d\x8a\x7d\xa9\x44\xd9\xf6\xf4\xa1\x7d\x2c\x53\xb2\x6f\x29\x94\xf2\x81\x38\x03\x2e\xdf\xac\x95\x49\x27\x65\xc5\x55\xab\xfb\x51\xb9\x4c\x8a\x5c\x52\xdb\x56\xa5\x54\x2d\xc8\xc3\x9c\x20\xdb\x9d\x29\x82\x6c\x97\x0b\xf2\xe0\x20\xb9\xb6\xc9\x80\x38\x56\xf6\x51\xf8\x7a\x90\x7f\x0f\xa1\xf8\x80\x31\xe2\xf0\x92\x9c\x11\xbe\x2d\x4b\xa5\x4e\x49\xaa\x3f\x74\x6f\x8a\xdd\x88\x43\x7f\xb0\x4b\x4a\x5f\x63\x3b\x48\x85\x5a\x78\x19\x30\x59\x99\xab\xd6\x26\x13\x6f\xa8\xc5\x33\x96\x97\xb3\x6e\x99\x24\x3a\xc8\x99\x4b\x1d\xfe\xd1\x95\x5d\x5b\x26\xa4\x6a\x71\xbd\xc4\xe1\xa4\x4f\xf4\x08\xa8\xce\xe1\x78\x40\x0a\x9a\xf6\x44\x55\x0c\x5b\x34\x10\xf7\x74\xb2\xe9\x45\xc3\x00\x59\x5a\x84\x11\xe9\x38\x2e\x6d\x97\xa7\x0f\xf6\x09\xa3\xc4\xff\xf7\x35\x61\x60\x07\x24\xd7\x68\xdf\xc3\x26\x29\xb3\x3f\x9f\x63\xf3\x2a\x2f\x0b\x9f\x13\xcf\x23\xd6\x07\x10\x49\xa1\x01\x1c\xb3\x01\xe1\x69\x0f\x17\xff\xa5\x46\x20\xbc\xcb\xad\x27\x5b\xe7\x07\xa3\x6d\xf0\x15\x04\xfe\x50\xdb\xef\x31\xf2\x27\xe8\x84\xfb\x3d\xa9\xb4\x3b\xe1\xd4\x64\xa3\xde\x6e\xfd\x98\xfc\xde\x6a\x78\xae\xf5\xf6\x7f\x5b\xdf\xa1\x27\x36\xbf\xe2\xe6\xf7\x56\xf3\xe8\xdb\xdf\xd2\x5f\x5b\xf7\x5f\xdb\xa3\x6f\x2f\x5f\xa2\xfe\x18\x6d\x43\xcb\x79\xe0\xab\x9e\x52\x74\x0a\x97\x8d\xb0\x30\x36\xe8\xd6\x23\xd2\x0b\x65\x92\x2d\x02\x62\x25\xec\x5a\xda\x8d\xd1\x1e\x95\xe7\xbd\xc7\x26\x97\xce\xb9\x9d\xc9\x0e\xad\xfe\x7d\xf2\x8c\xbb\xbb\xff\xdc\xdd\x85\xed\xb8\xbf\xff\xcf\xfd\x7d\xb6\x32\x46\x2e\xa5\x47\x35\x8e\x8d\x24\xf9\x3e\xfa\xa5\xb8\x9f\x21\x23\x60\xdd\xb6\x55\x70\x4b\x23\xf2\x9e\xb9\x23\xc5\x25\x27\xe9\xe7\x64\x10\xd9\x58\xee\x86\xcf\x43\x7a\xc9\x8b\x77\x28\x0e\x0e\xc5\x7a\x40\x60\x93\xc8\x27\x00\x44\x16\xda\x06\x79\xe6\xc5\x09\x9d\x2b\x76\xfd\x4a\x1f\xf7\x87\x98\x49\x1f\x9e\xeb\x89\xbe\xcb\x78\xce\x91\xc8\x4e\xd8\x8b\xdd\x28\x75\x2c\x7a\x4d\xad\x00\xe4\x5e\xe8\x8f\x71\x19\x89\x38\x69\x03\x6e\xf1\x2d\xcd\x79\xb3\x7e\x60\x5e\x85\xc6\xa7\xbe\xa1\xf0\x1a\x51\x5f\x14\x42\x28\xc1\xce\x5c\xe9\x72\x6f\x92\x78\x8d\xaf\xdf\x0a\x4d\x1c\xe3\x5b\x32\xc1\xe6\x2d\x62\xd2\x11\x96\xc8\xd2\xae\xb0\x47\x90\xbb\x97\xb3\x44\x1b\xf7\x89\x34\xc3\x5c\xb2\x3b\x38\xc1\x3e\x29\xd4\x15\xfa\xcb\xec\xab\x24\x0e\xb3\x90\xac\xbc\x63\x6a\x7d\x8d\xf2\xe6\xa7\xad\x84\xbb\x18\x2f\x6f\x65\xe1\x09\x8f\xd8\xce\x42\x2f\x19\x17\x6d\x01\xf0\x77\x50\x06\x15\x32\xfd\x03\xb9\x4e\x1a\x9d\x61\x41\xcf\x18\xc5\x33\x09\x93\x60\x3c\xa9\x49\xc3\xb8\x86\xf1\xa7\x86\x71\x00\x20\xce\x60\x70\x40\xd8\xd3\x43\x79\xda\x96\xb5\x87\xf3\x32\xb1\x6a\x48\xd7\x90\x9e\x69\xa5\x86\xf4\x15\x87\xf4\xfc\xc0\xfc\xa8\x02\xd1\x8f\x34\xa2\x6b\x44\x7f\x4a\x44\x6f\x84\xc3\x47\x28\xd2\xf9\x2a\x52\x5e\x69\x8c\xaf\x0f\xe3\x3b\xb7\xb7\x1a\xe7\x93\xb7\xd0\x38\xaf\x71\x3e\x2e\xbd\xa6\x38\xff\x3a\x07\xf3\x85\x91\x7b\x05\xce\x1f\xa6\xcd\xd6\x38\x3f\x3f\xce\x67\x1e\xbb\x22\x30\x9f\x7d\xca\x1a\xe0\xfc\xbe\xc6\xf9\xba\x71\x7e\x5f\xe3\xbc\xf2\x16\xcf\x0e\xe7\x8d\x56\xb6\x12\x0d\xf3\x9b\x06\xf3\x6a\x81\x49\x30\xaf\xc0\x9f\x86\xf9\xe7\x35\x9c\x5f\x3b\x9c\xef\x6a\x9c\xaf\x1b\xe7\xbb\x1a\xe7\x95\xb7\xd0\x38\x5f\xda\x48\x8d\xf3\x6b\x83\xf3\xd3\xe3\xe9\x0e\x2a\x80\x7e\x4f\x03\xbd\x06\xfa\x05\x81\xbe\x67\x05\x61\x48\x6e\x2f\x84\x11\xbf\x07\xe5\x16\x5c\x98\xdf\x45\xb3\x3e\xcb\x74\x03\x87\x2f\xf6\xb4\xb9\x29\xc4\x8c\x34\xe1\x42\x1a\xf5\x74\x5e\x50\xe1\x92\x2b\xa4\x6f\xf5\x7b\x0c\x5e\x3d\x6c\xe3\xa3\xc9\xbd\xe4\x29\x6b\x20\xf1\x63\x93\xd3\x6b\xa0\x48\xa6\xcb\xac\x0a\xc1\x9f\xcc\x2d\xf8\x6b\x4a\x6e\x96\x21\xfa\xd2\xe7\xac\x81\xf0\x7f\x83\x76\x57\x08\xfd\xdd\x8a\xb0\xe0\x73\x21\x60\x84\xc1\xb3\x82\x03\x07\x2a\xec\x7b\x50\x09\x41\x19\xc6\xa8\x49\xef\x7c\xa4\xb7\x40\xeb\x14\x4a\xa9\xd7\xb0\x56\x8d\xf4\x9a\x98\x59\xb9\x8a\x45\xd2\x19\xb6\x2c\xea\x0c\x8a\x96\x23\x32\xcf\xc1\x07\x59\xb9\xca\x93\x96\x9a\xd1\xae\xa2\x5c\x85\xc9\x66\xa3\x1f\xba\x07\x47\xfb\xef\x3b\xaa\x8d\xca\x5b\x3e\x9b\x38\xec\x9b\xfe\x9f\x19\x0d\xc4\xb9\x43\x32\x8a\xfa\x11\x38\x23\xcf\xb5\xc1\x4f\x9e\x48\x73\x55\x8a\x82\xc3\x76\x9d\x90\x9a\xb6\x76\xba\x25\xbd\xc3\x05\x3f\x49\xf9\xb8\xd8\x03\x05\x1f\x4f\x3d\x18\xf7\xe3\x7e\xf6\x10\xfe\x0e\x14\xcd\x64\xd4\x8b\x36\x27\xa5\x46\x5d\x6b\x44\x6d\x91\xc8\x0f\x09\xe6\x23\xec\x65\xe9\xa9\xd8\xf5\xf5\x07\x61\xee\x49\xe2\x2f\xb2\x94\x6f\x48\x07\x43\xb0\x93\x21\x7f\x17\xe9\x3f\xc3\x9c\xc3\xb1\xc1\xe4\xcd\x36\x91\xdd\x56\xf2\xf1\x3c\xc9\x2e\x65\xd1\x0c\xcc\x94\xf9\xe4\xf7\xaa\x66\xd6\xce\x4c\x43\xbd\xce\x80\x9f\x3b\xd9\x09\x27\x9b\x54\xc2\x66\x2c\xff\x52\xc8\x34\x66\x9a\x64\x6a\x65\xf2\x4b\x67\x99\x6c\x32\xff\xec\xd2\x04\xfc\x9c\x02\x93\x25\x73\x44\xd1\xeb\xca\xb9\xa2\x0c\x70\xa2\x50\xb6\x88\x3a\x51\xd1\x19\xf6\x72\x94\xa1\x90\x48\xfd\x85\xfa\xdc\x05\x34\x1c\x55\x5a\x58\x0c\x99\x79\xe9\x1b\xb7\xc7\x05\x4f\x59\x74\xb1\x69\x3d\xb7\xa1\xe9\x7d\x0a\x46\x7d\x39\x04\xcb\x08\x22\xca\xfc\x4c\xbf\xe7\x31\xd4\x18\x17\x1f\xa3\x60\xa0\x4a\x03\xca\xe1\xaf\x1c\x3d\x4a\xb1\xa3\x14\x39\xaa\x84\xe7\xd9\x94\x27\x86\x55\xea\xa0\xc7\xe1\x4b\x9d\x44\x4e\xdc\xc0\x01\x77\x8d\x7c\x6e\xb9\x3c\xc6\x05\x79\x68\x4c\x59\x00\x53\x2a\x71\xe1\x40\x79\xcc\x4c\xf3\x3b\x9d\xee\xf2\x60\x61\xf2\xe6\x8d\x67\x0e\x0b\xa5\x4b\x15\xe9\x94\xf9\xdb\x2d\xc7\xe5\xf4\x92\x9a\xe1\x2e\x69\x0d\x21\xc6\x27\x55\x1e\xa5\x8b\x0e\x1a\x50\x64\x96\x06\x14\x0d\x28\x4b\x18\xa4\xcc\x8f\x39\x85\xb1\xc8\x32\x41\x67\xf2\xfe\x82\x4d\x07\x1d\x4e\x1c\xec\x70\x0d\x37\xc8\xb8\x08\x25\xa1\x81\x46\x03\x8d\x06\x9a\x35\x05\x9a\xfc\xe0\x66\xaf\xb3\x34\x9c\x39\x9a\x1c\xf8\xb6\xe9\x38\x13\xc6\x64\xed\xda\xf4\x9a\x68\xac\x31\x3e\x80\x18\x1c\xe2\x6b\xb0\xd1\x60\xa3\xc1\x66\x5d\xc1\xa6\x30\xaa\x59\x26\xda\x4c\xde\x4d\xa5\xd1\x46\xa2\x0d\x23\xd8\x1a\x6b\xb8\x31\xce\x41\x0e\x54\xe3\x8d\xc6\x9b\xb9\xf1\x66\x09\x5f\xc0\xdc\xab\xd8\x51\xd2\x55\xc2\x20\xa7\x7d\x02\x13\xfd\x1a\xa8\x51\x38\xab\xfe\x19\xcc\xdc\xb2\x7f\xa8\x22\xe4\x5e\xa2\xff\xba\x7d\x1f\x11\x07\xfa\x6a\x40\x2c\x84\xa1\x93\x4a\x57\x86\xb8\x8b\xfa\x04\x91\x5b\x62\x06\x1c\x32\x8e\x3f\x1f\x9f\xa1\x6d\x47\xf8\x69\x78\x38\xb5\x89\xd5\x40\x8e\xcb\xd0\xc0\xe5\x50\xc8\xa3\x8c\x58\x2f\x77\xea\x88\x12\x9e\xaa\xbc\xfd\x8a\xef\x97\xee\xeb\xaf\x7b\x20\x1d\x25\x3c\x33\x53\x00\xfd\x6e\x83\xd1\xf7\x84\xfd\xf7\x7c\x60\x8f\x56\x00\x46\x3d\xf3\x6e\x20\xb8\x75\xe7\xd5\x56\x43\xf4\xfb\xb7\x5b\x11\xf8\x87\xb8\x2f\x38\x26\x83\x2e\x23\x6a\x7e\xec\x7d\x3f\x33\xb0\x00\xb5\x39\xeb\xb0\xf5\xe7\x5c\x7a\x9f\x7f\x09\xaf\x24\xa3\x48\x17\x8b\x74\xec\xe4\xfb\xdd\xe6\x45\x3a\xb6\x2a\x4c\xd0\x81\x91\xd0\x63\xc6\x10\xea\xfd\x3d\x6a\xfa\xda\xec\xef\x99\x91\x28\xe4\xe8\x81\xef\x8e\x22\x22\x0f\x0c\x9e\x0f\x09\xba\x0c\x78\xc0\x48\x03\x78\x3d\x07\x9e\x20\xfe\xbf\x59\x0a\x7d\x98\x3a\x4f\xbb\x7f\x58\x4e\x1f\x8e\xf4\x26\x23\x4d\x1f\x9e\x86\x3e\x24\xb7\x6b\x0a\x51\x0b\x85\xf8\x1c\xcb\x53\xd3\x88\xa4\xc1\x9a\x46\x68\x1a\x21\x33\x56\x82\x46\xf0\x21\xe6\x11\x0f\x40\xf0\xcb\x26\xd8\xe7\xc8\x75\x4c\x20\x0c\x16\xb5\x24\x61\x60\x81\x83\x5c\x20\x0f\x70\x0b\xe6\x9c\x8c\x3c\xee\x0b\xd2\xc1\x08\x67\x63\x39\x4d\x01\x2c\x03\x3c\x8f\xcb\x08\x82\xae\x8b\x12\x1f\x8a\x20\x29\x29\x35\x0e\x39\x89\xef\xba\xce\xe3\xb0\x8b\xc2\xc4\x7c\x25\xbd\x48\xa6\x35\x34\xbd\xd0\xf4\x62\xa9\xf4\x22\xec\x67\x9a\x5b\xd4\xc2\x2d\xde\x87\x4e\x4b\x13\x0b\x4d\x2c\x34\xb1\x58\x51\x62\x91\xce\x4a\x04\xa6\x49\x7c\xff\x12\xde\x69\x3c\x17\xfe\x3f\x38\x0a\xac\xfb\xba\x02\xfe\x93\x8f\x9c\x69\xf8\xd7\xf0\xbf\x5c\xf8\xa7\x0e\x85\xce\xa6\x09\x40\x4d\x04\x20\x12\xa7\xa6\x00\x49\x83\x35\x05\xd0\x14\x40\x66\xac\x0e\x05\x88\xe7\x16\x44\x38\x03\xae\x9e\x48\x68\x28\xb3\x08\x37\xae\xb3\xc5\xc5\xa2\x86\xc8\xa1\xe2\xe6\x01\xa6\xf3\x4d\x1b\x3c\x3c\x9e\xaf\x92\x37\xa4\x87\xb2\x6a\xde\xa0\x79\xc3\x32\x79\x43\xb4\x2c\xa7\x69\x43\x2d\xb4\xe1\xe7\x50\x9a\x9a\x35\x24\x0d\xd6\xac\x61\xb3\x58\xc3\x32\x02\x3b\x5f\xef\x95\xc3\x68\x3b\x5d\xdc\x2f\x0b\xec\x64\xc4\x23\xa1\x56\x2c\xe2\xd9\xee\x78\x04\xc0\xf1\xce\x75\x2e\xe9\x40\xe9\xa4\x71\x4f\x3e\x73\x2d\x1f\x6d\xff\x98\x2f\xa9\xc6\x2b\x4f\x0b\x06\x35\x31\xb8\xe6\x0b\xe8\x40\xc0\x4b\xf2\x1e\x43\xee\x66\x38\x01\x84\x18\xb0\x28\xc8\x36\x83\x99\x32\xfb\x37\xd1\x7f\x0a\x62\x34\x63\x46\x96\x76\x1d\xe3\x87\xf7\x9d\xfd\xa3\xee\x3b\xb5\xa3\xb2\x41\x1f\x6f\x77\xf6\x0e\x1a\xc0\x42\x8e\x1a\x68\xbf\xd5\x40\xad\x9d\xc3\x23\xd5\xa7\x1b\x3f\x74\x8e\x8e\xcc\xfd\xd7\x46\xc1\x3a\x66\x62\x67\xc5\x1e\x5a\xd5\x3b\x8d\x01\x0e\x24\x40\x2b\x8a\x07\xa3\x8e\xdf\xaf\xdd\x52\x15\x0c\x66\x1d\x67\xa8\xc9\xea\x06\x04\x95\xa8\xc4\xbe\xfd\x83\xe8\x55\x05\x54\x57\x4b\x7c\xc4\xec\x8a\x30\x3f\xdb\x37\xee\xa7\x9b\xe7\x5e\xce\x3c\xf3\x1f\xb2\x2f\x58\xe7\x7e\xa1\x6e\xb1\x3f\x43\x18\x42\xcc\x21\xf2\xea\x96\xd6\xfb\x3a\x5d\x3b\x52\x71\x34\x95\x62\x09\x65\x1a\x61\xcf\xa3\xce\xe0\x22\x34\xc5\x76\x59\xfa\x04\x2f\x1b\x39\xf3\xd0\x51\x0b\xb6\xcc\xc9\x6d\xce\x59\x5d\xc7\x3a\x9a\xea\xf3\xe2\xca\x80\x88\x0f\xa6\x54\xd6\x49\x2b\x2b\x98\x1e\xd8\xc5\x29\x98\xdb\x59\x4c\xd2\x14\xe3\x28\x12\x44\xd3\x75\x1c\x62\x02\x3f\x30\x32\x65\x2e\xc4\x93\x73\x1d\xae\x9c\x3d\xda\xc1\x00\xcc\x0d\xac\x22\x1a\x64\xbc\xde\xe9\xec\xec\xa7\x95\x79\xae\xcf\x2f\xe9\x6d\x56\x0d\x51\xe2\x7b\xd7\x89\xa3\xdc\x8d\x6e\xeb\xaf\x4a\x3e\xb0\x92\xc2\x3d\x32\xad\xf2\x16\x29\xb3\x8f\xe0\x36\xab\x75\x75\x19\x12\x95\x2c\x27\x96\x39\x3c\x7c\x5b\xe3\xd3\xee\x71\x2e\xc3\x4d\x6e\x98\x20\x70\xe0\x87\xec\xca\x0e\xf9\xa8\x62\xf9\x62\x18\x94\x6c\xd6\x92\xce\x64\xaf\x0d\xbe\xa4\x7d\x08\x7f\x0e\x8f\x84\x33\x69\x1f\x66\x9c\x89\x98\x7e\x2d\xe9\x7e\xa2\x66\xb5\x9e\xb0\x9a\x0e\xb8\xa3\xf6\xd1\x5e\xa6\x82\x89\x5b\x8c\x38\xee\xdb\xa2\x9e\x60\x94\xfb\x9e\xdd\x83\xf6\x0c\x5d\x05\x7d\xd2\x03\x18\xb0\xa3\x8f\xc0\xa4\x3b\x7c\x7a\xe1\x06\x9f\x9e\x8c\xf1\x8d\x8b\xf8\x55\x64\xba\xb4\x0a\xa0\xd7\x05\xb8\x68\x7e\x8d\xbe\x76\x5a\xfb\x87\x4e\xe7\xa0\xbe\x46\xbb\xd1\x51\x91\x2e\x61\xbf\xc6\x5e\xcb\xcf\x66\xc4\x2c\x37\x9f\x13\x47\xef\x06\x8e\x03\xee\x05\xc1\xa0\xc2\x2f\x02\xa2\x0f\x59\x36\x11\x02\x4d\xf3\x64\xd7\x57\xed\xff\x50\xb5\x7f\x99\x3b\xd9\xfe\x5d\x41\x9c\x8d\xb7\xe5\xa6\xdf\x2a\xf7\x33\x53\x6d\x5f\x16\xfc\x14\x39\x2e\x31\xca\x7e\x14\x1c\x3f\x8b\x1d\x42\x09\x90\x3f\x00\xe3\x23\xb0\x7e\x28\xc6\x47\xd4\x40\x63\xfc\x22\x18\xaf\x5e\x2f\x84\xf1\xe9\x44\x8f\x82\xf1\x19\x8b\xd2\x28\x5f\x3b\xca\x6b\x14\xdf\x18\x14\xf7\x88\xf9\x18\xe8\x8d\x9a\x48\xf3\x87\xfa\xf8\xc3\x17\x07\x5f\x63\x6a\x0b\x6b\xd0\x1c\x42\xcf\x05\x3c\x1f\x9e\x50\x58\xf1\x99\x9b\x28\xa4\x91\x24\x7a\x32\x40\x4f\x06\x68\x1a\x51\x2f\x8d\x90\x4b\x33\xdb\xf1\x5f\x87\x63\x68\x18\xeb\x8d\xc8\xc8\x65\xe3\x5e\xe0\xe3\x01\xe9\xf5\xc7\x9c\x54\x02\x78\xb8\x9a\x56\x02\xd7\x65\x27\x9d\x84\x2b\x6b\x0e\x98\x69\xfd\xe7\x9d\x3c\x29\x8c\xcb\x39\x72\x8b\xfa\x9c\xd1\xbe\x8c\xc4\x73\x1d\x34\x84\x0e\xa1\xf1\xbc\x4e\x3c\x9f\x73\xdc\x6f\xed\xef\xe3\x3d\xac\xf1\x1c\x2d\x84\xe7\xf9\x43\x6a\xe7\xc5\xf3\xbd\x52\x3c\xd7\x03\x7f\x3d\xf0\xd7\x88\x3d\x73\xcc\x8b\x18\x82\x5b\xc4\xe6\x38\x1c\x88\x03\x08\xf7\x52\xf0\x4e\x06\xe0\xf0\x83\xcd\x76\xe0\xef\x8c\xf8\xfd\xb5\x2b\xbe\xf6\x15\xa2\x38\xdc\xf8\xe8\x67\x96\x55\xc4\xb8\x9c\x3c\x01\xc2\x7f\xc4\xb7\x72\x80\x8e\x62\xb1\xa2\x6d\x5b\x6c\xe2\xeb\x22\x70\xe5\x00\xf9\x7e\xc9\x7a\xf8\x73\x83\xfa\xa7\x8e\x83\x9c\x3f\x20\x71\x7a\x20\x45\xc5\x51\xac\xed\x7a\xcf\x5c\x17\x08\xf9\xf3\xc8\xe3\x63\xa3\x10\x23\x14\x7f\xb9\xb0\x98\xb3\xa6\x41\x8c\xd0\xec\x33\xc2\xce\x65\x7b\xd2\x70\x94\xd2\xd0\x46\x84\x7d\xf4\x5d\xbc\xfb\x14\x14\x9b\x35\xc4\xb1\x9b\x4d\x7f\x48\x88\x63\x12\x1a\x93\x01\xd5\x30\xf5\x94\x32\x00\xe1\x70\x4c\x9c\xc9\x5e\xb9\xb8\xc8\x27\x9a\x1c\x5e\x1c\x0f\x3a\x99\xec\x3c\x1e\x48\x3b\x6e\x66\xe6\x49\x95\x72\x20\xb2\x2b\xa3\x24\x0e\x4c\x19\x19\x66\xd2\x85\xa4\xa5\x46\xa6\x52\xbe\x67\xba\x72\x9e\x17\xaf\x9c\x8a\x2e\x11\xef\xbc\xa7\xb3\xea\xe5\x89\x47\x50\x52\xe0\x4c\x55\xd3\xbb\xd9\xd5\xf4\x6c\xa6\x7f\x0a\x62\xf2\x89\xd5\xcc\x4e\xc1\x64\x85\x74\xba\x22\x81\xd2\x67\xae\x15\xc6\x47\xa3\x6d\xe9\xde\x1a\x48\xea\xb7\x81\x02\x47\xfc\xfb\x52\xee\xe9\x90\xcc\x53\xbe\x4d\x3a\xcb\x24\x70\x28\xad\x4e\x87\x54\xd7\x19\x52\xfd\xe8\xc1\xca\xd9\x07\xaf\x59\x44\xb5\xca\x16\x37\x6a\x23\xd6\xec\x03\x10\x75\xfa\x6c\xa6\x01\xc8\x41\x71\x3a\x2d\xdc\x10\xa5\xc7\x1f\x8f\x3f\xfe\xa8\x6b\xdc\xb1\xc0\xd6\xaa\xe7\x33\xee\x78\xb2\x79\xa9\xa5\x4d\x4b\xc9\x0d\x59\xf0\xa0\x75\xd8\x87\x75\x36\xeb\x9c\xd5\x1c\x04\xa2\x95\xef\xca\x1b\x42\x20\x96\x08\xc1\x7a\xef\x95\x9a\xbe\x52\x7b\xaf\x0e\xab\xbe\xcb\x9e\x82\xc0\xa2\x5b\xaf\xde\x9d\x7d\x41\x5f\xc4\x20\x6c\xc1\xfd\x57\xeb\xc3\x9b\x1e\x3c\x71\x7b\xd8\x2e\xd7\xc2\xc1\xe4\x53\x5c\x36\x64\x23\xf9\xf3\x9e\x83\x35\x14\xf8\x58\x49\x2a\x24\xa6\x3a\x7a\x09\xa9\xc9\xd2\xa1\x37\x29\x31\x32\xbd\x20\x9a\x6b\x89\x8f\xeb\x91\xc6\xf2\xe6\xee\x0e\xed\x7c\x0e\x46\xe7\x98\x13\x74\x7f\xaf\x30\xa5\xff\x2d\x3a\x07\x53\x0f\x4b\x9a\x3c\x59\x3b\x81\x25\xd5\x36\x5f\xfb\x94\xcc\x2a\x71\xce\x7a\x16\x66\xc5\x49\x94\x9e\x6c\x51\xd2\x57\x8b\x43\x55\x7c\x3d\x56\x01\x8f\x3a\x38\xd4\xaf\x01\xf8\xd3\xa5\x72\x28\x53\x46\x78\xe4\xda\xbd\x04\x62\x75\xa9\x44\x16\xb4\x5b\x6a\x68\x41\x8d\x94\xab\xe2\x34\xa9\x7a\x97\xca\x35\xe3\x8a\x12\x3d\x80\x98\xb2\x43\xd5\x56\x96\x89\x99\x62\x99\x2c\x2f\xc0\xc9\xfc\x4c\x9c\x62\x47\xb0\x25\x9b\x90\xbd\x2d\x04\x49\xc5\x8e\xa0\x67\xe5\x3c\x8d\xf8\x3c\x56\xa5\x36\x6b\xa4\x7e\x3e\x1f\xdb\x93\x10\x4d\x7a\x08\x21\x8c\x8b\x2c\xa2\x86\x5d\x99\xa4\xcc\xe8\x77\xf8\xaf\xf9\xf1\x63\xf3\xf4\x14\xfd\xf2\xcb\x9b\xd1\xe8\x8d\x9f\x63\x5a\x9e\xf8\x70\x17\x73\xca\xeb\x4a\x0e\xf3\xa3\x96\x45\x9c\xe9\x4b\x7d\x49\xb3\x8a\x84\x25\x16\xa8\xcb\x22\xbb\x2c\x80\x50\x1a\x87\xfb\x6d\x91\x17\x52\x96\x5c\x72\xa4\x31\xe4\x80\xb9\x2e\x1a\x65\x5c\x24\x74\xca\x38\x65\xe0\xe9\x90\xe5\xde\x38\x46\xa1\xd8\x17\x96\xdd\x11\x92\x17\xa1\x0c\xb4\x45\x3f\xe4\x83\x0a\xcb\x99\x62\x46\xc4\x4e\x78\xb0\x5f\x26\x2f\x70\xa8\x42\x08\x1e\x26\xfd\xf3\xe8\x88\xc8\xcd\x54\xc0\xc9\xea\x28\x00\xfd\x75\x33\x55\xf0\xae\x5e\x15\x44\x10\x24\x2f\x1f\xa6\x88\x0f\x74\x44\x37\xb5\x1f\x9c\x3e\x7d\x3f\x08\xc5\xbf\xa9\xbd\xe0\xe7\x55\xe8\x05\x67\xae\xb5\x7a\xd2\xcf\x32\xe8\xb9\x84\xbf\x6b\xed\x8a\x79\xab\x4f\xf1\xfc\x14\xba\xbf\x2f\x24\x34\xf7\x7c\x71\xb8\x79\x53\x2c\x12\x32\x87\x70\xe2\x37\x4d\x77\xe4\x05\x9c\x34\x41\x15\x72\x8c\xe3\x8b\x00\xa7\xbf\x5f\x63\xd6\x4c\xa7\xbd\xd2\x49\xaf\x9f\x44\x86\x98\xf7\xfa\xb1\xd7\x33\x49\x7e\x23\x81\xa2\x71\x2f\x2f\xe5\x65\xf7\xb6\x15\xd2\xb1\x22\x96\xdd\x9d\x57\xbb\x0f\x97\x8b\x08\x3c\x72\x06\xb3\xc9\x25\xfa\xa5\x4c\xa8\x3d\xff\x89\x53\xb1\xb5\x23\x3f\x65\x0a\xc3\x0c\x39\xbc\x2d\xf4\xac\x07\xce\xa6\x56\x2e\x33\x67\xd2\x17\x08\x69\xcd\xae\xd5\xc7\xdd\x30\x3e\x51\xde\x97\xb2\x36\x5d\x96\x09\x05\x7c\x76\xe2\x3c\xa9\x43\x9c\x2b\x6d\xbc\x68\x17\x69\x95\xa3\x4c\xb0\xec\x23\xf7\x20\x5b\x72\xad\xcd\x10\xe6\xa9\xee\x3f\x9b\xa5\xf0\x9f\xa7\x2a\xbc\x48\x04\xe6\x58\xa2\x9b\xbe\x12\x27\xa7\xf8\x9f\x24\x9c\x89\x61\xc7\x17\x4a\x28\xaa\x20\x21\x4e\xb9\x64\xbd\x4a\xb7\x29\xab\x74\x2f\x94\x66\x2f\x75\x61\xed\xa8\xe2\x7c\x85\x74\xf3\xfd\xc2\x0b\x6b\x1f\xe5\x26\x11\x1d\x9f\x34\x45\x11\x15\x1b\x4b\xd3\x50\xe1\x0d\x5e\x2c\x5b\xf5\x40\xa4\x95\x0c\x2a\x9a\x71\x9b\xd6\xfc\x54\xa3\x81\x92\x47\xfc\xe5\xed\x96\x0e\x19\xaa\x2f\x64\x48\x75\x99\x3a\x6a\x68\x31\x3e\x22\xed\x5d\xf3\x91\x4d\x8c\x1a\x6a\xb7\x2a\x36\x4b\x25\xe8\x5c\x1b\xbb\xd1\x91\x43\x75\x92\xa1\x76\xab\x5b\xae\xb8\x34\x52\x46\xb3\xa1\xb5\x0b\x12\x7a\xaa\x80\x9f\xd2\x0f\x9c\xe9\x78\x9f\xa4\x59\xa5\x6c\x23\x16\xe9\x93\x2f\x35\xae\x61\xc8\x0f\xbc\x52\xc8\x3a\x1e\xac\x03\x1d\xf8\xb3\x52\x6a\xd8\xd4\xc0\x87\x95\x08\xff\x89\x74\xa1\x23\x80\x6a\xd3\xc3\xfc\x1d\x42\xc7\x01\xd5\xa8\x06\x1d\x07\xa4\xe3\x80\x2a\xd5\xbc\x42\x3a\x5e\xa3\x38\xa0\x55\x9c\xeb\x7c\xd6\x51\x3e\x91\x98\xeb\x13\xf0\x8a\x4a\xb4\x96\x40\x9f\x27\xb4\xce\x87\x84\xf1\x6c\x8a\x4e\x97\x15\xc9\xb3\x29\xf2\xac\x25\x98\x67\xb5\xfb\xc8\x86\x69\x74\x25\xa2\x75\xd4\x99\x75\x1d\xb0\xa3\x03\x76\x56\x6a\x81\xec\x85\xd2\xec\xe5\xae\x69\xb5\x3b\x05\x55\x87\xbb\xaa\x6b\xdc\x0b\xff\x89\xf0\x1b\x97\x5d\xe9\x98\x9d\x69\xba\xd8\xab\xd0\x45\xba\xc0\xa8\xd7\xa9\x56\xee\x13\x8a\x2b\x19\xad\x43\x19\x8c\xda\x15\x16\xe4\x84\x5d\x10\x28\x88\x49\xe8\x75\x44\x84\x0a\x9f\x42\x5c\x80\x79\xd4\xfe\x2d\xc4\xb9\x43\x76\x56\xee\x5b\x88\xe7\xa1\xcc\xd1\x09\x76\xac\xd0\xd2\x17\xa2\x1f\x9b\x1a\x83\xa3\x4c\x2c\x15\xd0\x35\x35\xb0\x13\xef\x51\xa3\x73\xd6\xfc\xc3\x88\xfa\x13\xca\x8f\x00\xdb\x9d\x8a\x2f\x32\xa6\x67\x97\x69\xd8\xd6\xb0\xbd\x10\x6c\xcb\xc1\xe3\x88\x72\x8d\xdb\x4b\xc4\xed\x8b\x48\xe8\x1a\xb8\x17\x03\x6e\x0d\xce\xd9\x66\x3e\x3d\x38\xbf\x88\xaa\x15\x7d\x4e\x74\x27\x43\x06\x5f\x86\xba\x31\x7c\x73\x48\x46\x38\x3d\x86\x39\x84\xb1\x30\x2c\x4d\x14\xb4\x30\xbb\x0a\x4b\x82\x83\x4e\xd5\x6e\x84\x8b\xaa\x91\xe0\x8c\xef\x63\xc7\x34\x92\xe7\x70\x32\xf2\x6c\xcc\xc5\xf2\x5d\xdc\x7e\xc0\x0a\x9f\x2b\x46\xa3\x86\xde\x25\x18\x98\x51\x51\x7c\xda\x9c\x58\xce\x3d\x4d\x30\x5e\x2c\xec\xa2\xbc\x2f\x49\x4e\xa0\x2b\x2f\x5b\x66\x0d\xf2\x24\x82\x1c\xbc\x53\xc7\xb4\x03\x8b\x1c\x97\x9f\xcc\x58\x6a\x14\xc6\x28\x00\xc7\x50\x52\x3c\x3e\xbf\xb3\x84\x9d\x64\x60\x50\x5d\xfc\x34\xfe\x0c\x08\x13\x1c\xc3\xf0\xc0\xb5\x11\x3e\x24\x81\xda\x81\x14\xf5\xb5\x33\xa9\x03\x92\x3d\x3f\x53\xa8\xef\x8a\x7a\x5f\x98\xfd\x59\xa8\xa5\xd8\xb8\xd8\xc9\x28\x8d\xcb\xf7\xf2\x8c\xd5\xd9\xf1\x29\xb2\xb9\x97\xaf\xd2\x5c\x64\x28\xb9\x75\x5d\x45\x9f\xea\xf2\xfc\x04\x55\x66\x8a\xd5\xaa\x45\x23\x01\x50\xe3\x01\xca\x2c\xbd\x49\xd1\xa5\xf2\x22\xaa\x40\x24\xfd\xb0\xc3\x93\x55\x8b\xb3\xee\xb3\x8a\x66\x36\xe1\xa4\x9e\x44\xf1\x25\xaa\x69\x4d\x78\xc6\x8c\x56\x63\x06\x3e\x07\xe4\x9d\xcd\x62\x32\x56\x39\x9d\xb9\x8b\x42\xe4\x92\x3a\x34\xfe\xe4\x9f\x54\x58\x2f\x84\xa3\x74\x91\x85\x3a\x97\xee\xe4\x33\x1d\x84\x43\x6a\xee\xbc\xda\xba\x6f\xa0\x1c\xd1\x99\x6a\x33\x39\x28\x4c\x4c\xa6\x72\xea\x73\x16\x37\x30\xe1\xde\x69\xce\xe0\x11\x44\x30\xc5\x93\xec\x6e\xef\xbc\x7a\x59\x46\x20\x77\x67\xb7\x96\x88\x05\xa9\xf5\x83\x5f\x90\x66\xe1\xff\x1a\xbf\x9a\x91\xcd\x2d\xc8\x40\xa4\x95\x17\x8e\x6c\x31\x14\x92\x92\x11\xf8\xe4\x22\xac\x28\x33\xba\x91\xff\x0a\x2a\x73\x1f\x02\x14\x95\x6a\x89\xa0\x29\x39\x2c\xd8\xbd\x69\xb6\x63\xd2\x15\x9f\x07\x1c\xce\x4c\xa7\xb7\x79\x14\x68\x17\x4b\x6f\x8e\x44\xd9\x8b\x99\xb1\xea\x08\x8c\xae\x72\xc8\x6b\x5b\x3d\xf1\x35\x73\xfc\x6b\x7b\x94\xfe\xee\x2a\xbf\xdb\xea\xc5\x5e\x4b\xcd\x51\xa8\x61\x47\xf9\xdd\xb6\xc2\x6e\xf9\x2d\x7e\x07\xc1\xe3\x8b\x0e\xaa\xfa\x29\x6a\xc5\xaf\xd5\x8a\xd5\xa7\x74\xf6\xd5\x0b\xe5\xb4\xe8\x03\x4b\x6d\x6f\xdc\x96\x8c\xf8\xbe\xbb\x4e\xea\x16\x52\xf2\x9d\xf7\x4a\x68\x17\x85\x04\x03\x7e\xfc\x21\x0c\x4c\x16\xbf\x4e\x59\xca\x8b\xfb\x17\xff\x07\xc8\x14\x2e\x4c\x67\x15\x01\x00")
 
 func monitoringZyncGrafanaDashboard1JsonTplBytes() ([]byte, error) {
 	return bindataRead(
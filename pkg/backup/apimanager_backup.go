@@ -111,7 +111,7 @@ func (b *APIManagerBackup) BackupSecretsAndConfigMapsToPVCJob() *batchv1.Job {
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -151,7 +151,7 @@ func (b *APIManagerBackup) BackupSecretsAndConfigMapsToPVCJob() *batchv1.Job {
 				},
 			},
 		},
-	}
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerBackup) BackupAPIManagerCustomResourceToPVCJob() *batchv1.Job {
@@ -165,7 +165,7 @@ func (b *APIManagerBackup) BackupAPIManagerCustomResourceToPVCJob() *batchv1.Job
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -205,7 +205,7 @@ func (b *APIManagerBackup) BackupAPIManagerCustomResourceToPVCJob() *batchv1.Job
 				},
 			},
 		},
-	}
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerBackup) BackupSystemFileStoragePVCToPVCJob() *batchv1.Job {
@@ -219,7 +219,7 @@ func (b *APIManagerBackup) BackupSystemFileStoragePVCToPVCJob() *batchv1.Job {
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -261,7 +261,63 @@ func (b *APIManagerBackup) BackupSystemFileStoragePVCToPVCJob() *batchv1.Job {
 				},
 			},
 		},
+	}, b.options.JobPodTemplate)
+}
+
+// BackupChecksumsToPVCJob stores the checksums of the files of each backed up
+// artifact and reports the checksum of each artifact in the termination message
+func (b *APIManagerBackup) BackupChecksumsToPVCJob() *batchv1.Job {
+	if b.options.APIManagerBackupPVCOptions == nil {
+		return nil
+	}
+
+	jobName, err := helper.UIDBasedJobName("backup-checksums", b.options.APIManagerBackupUID)
+	if err != nil {
+		panic(err)
 	}
+
+	var completions int32 = 1
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: b.options.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			Completions: &completions,
+			// TODO BackoffLimit field controls how many times the job is retried
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{
+						b.pvcBackupDestinationPodVolume(),
+					},
+					Containers: []v1.Container{
+						v1.Container{
+							Name:  "backup-checksums",
+							Image: b.options.OCCLIImageURL,
+							Command: []string{
+								"/bin/bash",
+							},
+							Args: []string{
+								"-c",
+								"-e",
+								b.backupChecksumsContainerArgs(),
+							},
+							VolumeMounts: []v1.VolumeMount{
+								b.pvcBackupDestinationContainerVolumeMount(),
+							},
+							TerminationMessagePolicy: v1.TerminationMessageReadFile,
+						},
+					},
+					RestartPolicy:      v1.RestartPolicyNever, // Only "Never" or "OnFailure" are accepted in Kubernetes Jobs
+					ServiceAccountName: ServiceAccountName,
+				},
+			},
+		},
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerBackup) systemFileStoragePodVolume() v1.Volume {
@@ -369,6 +425,32 @@ rsync -av ${SYSTEM_FILESTORAGE_PVC_DIR}/ ${PVC_BACKUP_FILESTORAGE_SUBDIR};
 	)
 }
 
+func (b *APIManagerBackup) backupChecksumsContainerArgs() string {
+	// The checksum of each artifact is the checksum of its sha256sum manifest,
+	// which lists the files sorted by path
+	return fmt.Sprintf(`
+BASEPATH='%s';
+ARTIFACTS='%s';
+CHECKSUMS_SUBDIR="${BASEPATH}/%s";
+mkdir -p ${CHECKSUMS_SUBDIR};
+SUMMARY="";
+for i in $(echo -n $ARTIFACTS); do
+  if [ ! -d ${BASEPATH}/${i} ]; then
+    echo "Artifact ${i} not found. Skipping";
+    continue;
+  fi
+  (cd ${BASEPATH}/${i} && find . -type f -print0 | LC_ALL=C sort -z | xargs -0 -r sha256sum) > ${CHECKSUMS_SUBDIR}/${i}.sha256;
+  SUMMARY="${SUMMARY}${i} $(sha256sum ${CHECKSUMS_SUBDIR}/${i}.sha256 | awk '{print $1}')
+";
+done;
+echo -n "${SUMMARY}" | tee /dev/termination-log;
+`,
+		BackupPVCMountPath,
+		strings.Join(BackupArtifacts, " "),
+		ChecksumsSubdir,
+	)
+}
+
 func (b *APIManagerBackup) ServiceAccount() *v1.ServiceAccount {
 	return &v1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,47 @@
+package backup
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+// ChecksumsSubdir is the backup data subdirectory holding the sha256sum
+// manifests of the artifacts, named <artifact>.sha256
+const ChecksumsSubdir = "checksums"
+
+// BackupArtifacts are the backup data subdirectories whose files are checksummed
+var BackupArtifacts = []string{
+	"apimanager",
+	"configmaps",
+	"secrets",
+	"system-filestorage-pvc",
+}
+
+var sha256Regexp = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// ParseArtifactChecksums parses the "<artifact> <sha256>" lines reported
+// by the backup checksums job
+func ParseArtifactChecksums(message string) ([]appsv1alpha1.APIManagerBackupArtifactChecksum, error) {
+	res := []appsv1alpha1.APIManagerBackupArtifactChecksum{}
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !sha256Regexp.MatchString(fields[1]) {
+			return nil, fmt.Errorf("invalid artifact checksum '%s'", line)
+		}
+		res = append(res, appsv1alpha1.APIManagerBackupArtifactChecksum{Artifact: fields[0], SHA256: fields[1]})
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no artifact checksums reported")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,49 @@
+package backup
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+func TestParseArtifactChecksums(t *testing.T) {
+	secretsSum := strings.Repeat("a", 64)
+	apimanagerSum := strings.Repeat("0123456789abcdef", 4)
+
+	cases := []struct {
+		testName    string
+		message     string
+		expected    []appsv1alpha1.APIManagerBackupArtifactChecksum
+		expectedErr bool
+	}{
+		{"Valid", "secrets " + secretsSum + "\napimanager " + apimanagerSum + "\n",
+			[]appsv1alpha1.APIManagerBackupArtifactChecksum{
+				{Artifact: "secrets", SHA256: secretsSum},
+				{Artifact: "apimanager", SHA256: apimanagerSum},
+			}, false},
+		{"Empty", "", nil, true},
+		{"MissingChecksum", "secrets\n", nil, true},
+		{"InvalidChecksum", "secrets " + strings.Repeat("z", 64), nil, true},
+		{"ShortChecksum", "secrets abcdef", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			checksums, err := ParseArtifactChecksums(tc.message)
+			if tc.expectedErr {
+				if err == nil {
+					subT.Errorf("expected error, got %v", checksums)
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if !reflect.DeepEqual(checksums, tc.expected) {
+				subT.Errorf("expected %v, got %v", tc.expected, checksums)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package backup
 
 import (
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	validator "github.com/go-playground/validator/v10"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -14,6 +15,8 @@ type APIManagerBackupOptions struct {
 	APIManager                 *appsv1alpha1.APIManager    `validate:"required"`
 	APIManagerBackupPVCOptions *APIManagerBackupPVCOptions `validate:"required"`
 	OCCLIImageURL              string                      `validate:"required"`
+	// Scheduling settings of the backup job pods
+	JobPodTemplate *helper.JobPodTemplateOptions `validate:"-"`
 }
 
 func NewAPIManagerBackupOptions() *APIManagerBackupOptions {
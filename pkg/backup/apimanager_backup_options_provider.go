@@ -39,6 +39,7 @@ func (a *APIManagerBackupOptionsProvider) Options() (*APIManagerBackupOptions, e
 	res.APIManager = apiManager
 	res.APIManagerName = apiManager.Name
 	res.OCCLIImageURL = a.ocCLIImageURL()
+	res.JobPodTemplate = a.jobPodTemplateOptions(apiManager)
 
 	pvcOptions, err := a.pvcBackupOptions()
 	if err != nil {
@@ -71,6 +72,21 @@ func (a *APIManagerBackupOptionsProvider) pvcBackupOptions() (*APIManagerBackupP
 	return res, res.Validate()
 }
 
+// jobPodTemplateOptions returns the scheduling settings of system-app, whose
+// file storage is backed up, overridden by the jobs template of the APIManager
+func (a *APIManagerBackupOptionsProvider) jobPodTemplateOptions(apiManager *appsv1alpha1.APIManager) *helper.JobPodTemplateOptions {
+	inherited := &helper.JobPodTemplateOptions{}
+	if apiManager.Spec.System != nil && apiManager.Spec.System.AppSpec != nil {
+		appSpec := apiManager.Spec.System.AppSpec
+		inherited.NodeSelector = appSpec.NodeSelector
+		inherited.Affinity = appSpec.Affinity
+		inherited.Tolerations = appSpec.Tolerations
+		inherited.PriorityClassName = helper.GetStringPointerValueOrDefault(appSpec.PriorityClassName, "")
+	}
+
+	return helper.MergeJobPodTemplateOptions(inherited, apiManager.Spec.JobsTemplate.JobPodTemplateOptions())
+}
+
 func (a *APIManagerBackupOptionsProvider) apiManager() (*appsv1alpha1.APIManager, error) {
 	return a.autodiscoveredAPIManager()
 }
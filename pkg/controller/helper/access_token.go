@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	accessTokensPath = "/admin/api/personal/access_tokens"
+)
+
+// AccessToken is an access token of the admin user owning the client token.
+// Value is only returned when the access token is created
+type AccessToken struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	Permission string   `json:"permission"`
+	Value      string   `json:"value,omitempty"`
+}
+
+type accessTokenItem struct {
+	Element AccessToken `json:"access_token"`
+}
+
+// AccessTokenClient manages the personal access tokens of the admin user
+// owning the client token.
+// The porta client does not implement the personal access tokens API.
+type AccessTokenClient struct {
+	*adminAPIClient
+}
+
+func NewAccessTokenClient(adminURLStr, token string, httpClient *http.Client) (*AccessTokenClient, error) {
+	client, err := newAdminAPIClient(adminURLStr, token, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccessTokenClient{adminAPIClient: client}, nil
+}
+
+// ReadAccessToken returns the access token, or nil when it does not exist.
+// The token value is not returned
+func (c *AccessTokenClient) ReadAccessToken(id int64) (*AccessToken, error) {
+	item := &accessTokenItem{}
+	found, err := c.do(http.MethodGet, fmt.Sprintf("%s/%d.json", accessTokensPath, id), nil, item)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &item.Element, nil
+}
+
+// CreateAccessToken creates the access token. The returned access token includes the token value
+func (c *AccessTokenClient) CreateAccessToken(name, permission string, scopes []string) (*AccessToken, error) {
+	params := url.Values{}
+	params.Set("name", name)
+	params.Set("permission", permission)
+	for _, scope := range scopes {
+		params.Add("scopes[]", scope)
+	}
+
+	item := &accessTokenItem{}
+	_, err := c.do(http.MethodPost, accessTokensPath+".json", params, item)
+	if err != nil {
+		return nil, err
+	}
+	return &item.Element, nil
+}
+
+// DeleteAccessToken revokes the access token. Missing access tokens are ignored
+func (c *AccessTokenClient) DeleteAccessToken(id int64) error {
+	_, err := c.do(http.MethodDelete, fmt.Sprintf("%s/%d.json", accessTokensPath, id), nil, nil)
+	return err
+}
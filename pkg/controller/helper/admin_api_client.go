@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// adminAPIClient sends requests to the admin API endpoints
+// not implemented by the porta client
+type adminAPIClient struct {
+	adminURL   *url.URL
+	token      string
+	httpClient *http.Client
+}
+
+func newAdminAPIClient(adminURLStr, token string, httpClient *http.Client) (*adminAPIClient, error) {
+	adminURL, err := url.Parse(adminURLStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminAPIClient{adminURL: adminURL, token: token, httpClient: httpClient}, nil
+}
+
+// do sends the request and decodes the response into result.
+// Returns false when the resource is not found
+func (c *adminAPIClient) do(method, path string, params url.Values, result interface{}) (bool, error) {
+	endpoint := *c.adminURL
+	endpoint.Path = path
+
+	req, err := http.NewRequest(method, endpoint.String(), strings.NewReader(params.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.SetBasicAuth("", c.token)
+	req.Header.Set("Accept", "application/json")
+	if params != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return false, fmt.Errorf("%s %s: unexpected status code %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return false, fmt.Errorf("%s %s: decoding response: %w", method, path, err)
+		}
+	}
+
+	return true, nil
+}
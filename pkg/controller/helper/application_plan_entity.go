@@ -2,6 +2,7 @@ package helper
 
 import (
 	"fmt"
+	"sync"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 
@@ -14,7 +15,9 @@ type ApplicationPlanEntity struct {
 	obj          threescaleapi.ApplicationPlanItem
 	limits       *threescaleapi.ApplicationPlanLimitList
 	pricingRules *threescaleapi.ApplicationPlanPricingRuleList
-	logger       logr.Logger
+	// cacheMutex guards the limits and pricing rules caches reset by concurrent writes
+	cacheMutex sync.Mutex
+	logger     logr.Logger
 }
 
 func NewApplicationPlanEntity(productID int64, obj threescaleapi.ApplicationPlanItem, cl *threescaleapi.ThreeScaleClient, logger logr.Logger) *ApplicationPlanEntity {
@@ -108,6 +111,8 @@ func (b *ApplicationPlanEntity) CreateLimit(metricID int64, params threescaleapi
 }
 
 func (b *ApplicationPlanEntity) resetLimits() {
+	b.cacheMutex.Lock()
+	defer b.cacheMutex.Unlock()
 	b.limits = nil
 }
 
@@ -153,5 +158,7 @@ func (b *ApplicationPlanEntity) CreatePricingRule(metricID int64, params threesc
 }
 
 func (b *ApplicationPlanEntity) resetPricingRules() {
+	b.cacheMutex.Lock()
+	defer b.cacheMutex.Unlock()
 	b.pricingRules = nil
 }
@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	authenticationProvidersPath = "/admin/api/account/authentication_providers"
+
+	// AuthenticationProviderKindKeycloak is the kind of the RH-SSO (keycloak) authentication providers
+	AuthenticationProviderKindKeycloak = "keycloak"
+)
+
+// AuthenticationProvider is an admin portal authentication provider of a 3scale account
+type AuthenticationProvider struct {
+	ID                           int64  `json:"id"`
+	Kind                         string `json:"kind"`
+	SystemName                   string `json:"system_name"`
+	ClientID                     string `json:"client_id"`
+	Site                         string `json:"site"`
+	Published                    bool   `json:"published"`
+	AutomaticallyApproveAccounts bool   `json:"automatically_approve_accounts"`
+}
+
+type authenticationProviderItem struct {
+	Element AuthenticationProvider `json:"authentication_provider"`
+}
+
+type authenticationProviderList struct {
+	Items []authenticationProviderItem `json:"authentication_providers"`
+}
+
+// AuthenticationProviderClient manages the admin portal authentication
+// providers of the account owning the access token.
+// The porta client does not implement the authentication providers API.
+type AuthenticationProviderClient struct {
+	*adminAPIClient
+}
+
+func NewAuthenticationProviderClient(adminURLStr, token string, httpClient *http.Client) (*AuthenticationProviderClient, error) {
+	client, err := newAdminAPIClient(adminURLStr, token, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticationProviderClient{adminAPIClient: client}, nil
+}
+
+// ListAuthenticationProviders returns the admin portal authentication providers
+func (c *AuthenticationProviderClient) ListAuthenticationProviders() ([]AuthenticationProvider, error) {
+	list := &authenticationProviderList{}
+	_, err := c.do(http.MethodGet, authenticationProvidersPath+".json", nil, list)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]AuthenticationProvider, 0, len(list.Items))
+	for _, item := range list.Items {
+		providers = append(providers, item.Element)
+	}
+	return providers, nil
+}
+
+// ReadAuthenticationProvider returns the authentication provider, or nil when it does not exist
+func (c *AuthenticationProviderClient) ReadAuthenticationProvider(id int64) (*AuthenticationProvider, error) {
+	item := &authenticationProviderItem{}
+	found, err := c.do(http.MethodGet, fmt.Sprintf("%s/%d.json", authenticationProvidersPath, id), nil, item)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &item.Element, nil
+}
+
+func (c *AuthenticationProviderClient) CreateAuthenticationProvider(params url.Values) (*AuthenticationProvider, error) {
+	item := &authenticationProviderItem{}
+	_, err := c.do(http.MethodPost, authenticationProvidersPath+".json", params, item)
+	if err != nil {
+		return nil, err
+	}
+	return &item.Element, nil
+}
+
+func (c *AuthenticationProviderClient) UpdateAuthenticationProvider(id int64, params url.Values) (*AuthenticationProvider, error) {
+	item := &authenticationProviderItem{}
+	_, err := c.do(http.MethodPut, fmt.Sprintf("%s/%d.json", authenticationProvidersPath, id), params, item)
+	if err != nil {
+		return nil, err
+	}
+	return &item.Element, nil
+}
+
+// DeleteAuthenticationProvider removes the authentication provider. Missing providers are ignored
+func (c *AuthenticationProviderClient) DeleteAuthenticationProvider(id int64) error {
+	_, err := c.do(http.MethodDelete, fmt.Sprintf("%s/%d.json", authenticationProvidersPath, id), nil, nil)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/helper"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,7 +15,7 @@ import (
 // BackendList returns a list of backend custom resources where all elements:
 // - Sync state (ensure remote backend exist and in sync)
 // - Same 3scale provider Account
-func BackendList(ns string, cl client.Client, providerAccountURLStr string, logger logr.Logger) ([]capabilitiesv1beta1.Backend, error) {
+func BackendList(ns string, cl client.Client, apiReader client.Reader, providerAccountURLStr string, logger logr.Logger) ([]capabilitiesv1beta1.Backend, error) {
 	backendList := &capabilitiesv1beta1.BackendList{}
 	opts := []controllerclient.ListOption{
 		controllerclient.InNamespace(ns),
@@ -33,7 +34,11 @@ func BackendList(ns string, cl client.Client, providerAccountURLStr string, logg
 			continue
 		}
 
-		backendProviderAccount, err := LookupProviderAccount(cl, ns, backendList.Items[idx].Spec.ProviderAccountRef, logger)
+		backendProviderAccount, err := LookupProviderAccountReference(cl, apiReader, ns, backendList.Items[idx].Spec.ProviderAccountRef, logger)
+		if helper.IsAccessDeniedError(err) {
+			// Provider account not granted, it cannot be compared
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("BackendList: %w", err)
 		}
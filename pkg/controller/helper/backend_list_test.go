@@ -79,7 +79,7 @@ func TestBackendList(t *testing.T) {
 			&capabilitiesv1beta1.Backend{
 				ObjectMeta: metav1.ObjectMeta{Name: "somename", Namespace: ns},
 				Spec: capabilitiesv1beta1.BackendSpec{
-					ProviderAccountRef: &corev1.LocalObjectReference{
+					ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{
 						Name: anotherProviderSecretName,
 					},
 				},
@@ -99,7 +99,7 @@ func TestBackendList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.testName, func(subT *testing.T) {
 			cl := fake.NewFakeClient(anotherProviderSecret, providerSecret, tc.backend)
-			backendList, err := BackendList(ns, cl, providerAccountURLStr, logrtesting.NullLogger{})
+			backendList, err := BackendList(ns, cl, cl, providerAccountURLStr, logrtesting.NullLogger{})
 			if err != nil {
 				subT.Fatal(err)
 			}
@@ -3,6 +3,7 @@ package helper
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/3scale/3scale-operator/pkg/helper"
 
@@ -17,6 +18,8 @@ type BackendAPIEntity struct {
 	metricsAndMethods *threescaleapi.MetricJSONList
 	methods           *threescaleapi.MethodList
 	mappingRules      *threescaleapi.MappingRuleJSONList
+	// mappingRulesMutex guards the mapping rules cache reset by concurrent writes
+	mappingRulesMutex sync.Mutex
 	logger            logr.Logger
 }
 
@@ -252,6 +255,8 @@ func (b *BackendAPIEntity) resetMetrics() {
 }
 
 func (b *BackendAPIEntity) resetMappingRules() {
+	b.mappingRulesMutex.Lock()
+	defer b.mappingRulesMutex.Unlock()
 	b.mappingRules = nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
@@ -57,6 +58,68 @@ func LookupProviderAccount(cl client.Client, ns string, providerAccountRef *core
 	return nil, errors.New("LookupProviderAccount: no provider account found")
 }
 
+// LookupProviderAccountReference looks up for account provider url and credentials
+// of a provider account reference that can point to other namespace.
+// References to the resource namespace are resolved by LookupProviderAccount.
+// Secrets from other namespaces are only read when some ProviderAccountGrant in the secret namespace
+// allows the resource namespace, otherwise AccessDeniedError is returned.
+// Objects from other namespaces are read with apiReader, as the client cache only holds the watched namespace.
+func LookupProviderAccountReference(cl client.Client, apiReader client.Reader, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
+	if !providerAccountRef.IsCrossNamespace(ns) {
+		return LookupProviderAccount(cl, ns, providerAccountRef.LocalObjectReference(), logger)
+	}
+
+	logger.Info("LookupProviderAccountReference", "ns", ns, "providerAccountRef", providerAccountRef)
+	granted, err := providerAccountGranted(apiReader, ns, providerAccountRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if !granted {
+		return nil, &helper.AccessDeniedError{
+			Namespace:       ns,
+			SecretName:      providerAccountRef.Name,
+			SecretNamespace: providerAccountRef.Namespace,
+		}
+	}
+
+	secret := &corev1.Secret{}
+	err = apiReader.Get(context.TODO(), client.ObjectKey{Name: providerAccountRef.Name, Namespace: providerAccountRef.Namespace}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	adminURLStr := helper.GetSecretDataValue(secret.Data, providerAccountSecretURLFieldName)
+	if adminURLStr == nil {
+		return nil, fmt.Errorf("LookupProviderAccountReference: Secret field '%s' is required in secret '%s/%s'", providerAccountSecretURLFieldName, secret.Namespace, secret.Name)
+	}
+	token := helper.GetSecretDataValue(secret.Data, providerAccountSecretTokenFieldName)
+	if token == nil {
+		return nil, fmt.Errorf("LookupProviderAccountReference: Secret field '%s' is required in secret '%s/%s'", providerAccountSecretTokenFieldName, secret.Namespace, secret.Name)
+	}
+
+	logger.Info("LookupProviderAccountReference providerAccountRef found", "adminURL", *adminURLStr)
+	return &ProviderAccount{AdminURLStr: *adminURLStr, Token: *token, Annotations: secret.Annotations}, nil
+}
+
+// providerAccountGranted tells whether some grant in the secret namespace allows the namespace to use the secret.
+// Grants are listed on every lookup, so revoked grants are honored as soon as they are deleted.
+func providerAccountGranted(apiReader client.Reader, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference) (bool, error) {
+	grantList := &capabilitiesv1beta1.ProviderAccountGrantList{}
+	err := apiReader.List(context.TODO(), grantList, client.InNamespace(providerAccountRef.Namespace))
+	if err != nil {
+		return false, fmt.Errorf("providerAccountGranted: %w", err)
+	}
+
+	for idx := range grantList.Items {
+		if grantList.Items[idx].Allows(providerAccountRef.Name, ns) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func providerAccountFromSecretReferenceSource(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error) {
 	if providerAccountRef != nil {
 		logger.Info("LookupProviderAccount", "ns", ns, "providerAccountRef", providerAccountRef)
@@ -69,8 +132,12 @@ func providerAccountFromSecretReferenceSource(cl client.Client, ns string, provi
 		if err != nil {
 			return nil, err
 		}
+		secret, err := helper.GetSecret(providerAccountRef.Name, ns, cl)
+		if err != nil {
+			return nil, err
+		}
 
-		return &ProviderAccount{AdminURLStr: adminURLStr, Token: token}, nil
+		return &ProviderAccount{AdminURLStr: adminURLStr, Token: token, Annotations: secret.Annotations}, nil
 	}
 
 	return nil, nil
@@ -89,7 +156,7 @@ func providerAccountFromDefaultSecretSource(cl client.Client, ns string, provide
 			return nil, fmt.Errorf("providerAccountFromDefaultSecretSource: Secret field '%s' is required in secret '%s'", providerAccountSecretTokenFieldName, defaulSecret.Name)
 		}
 
-		return &ProviderAccount{AdminURLStr: *adminURLStr, Token: *token}, nil
+		return &ProviderAccount{AdminURLStr: *adminURLStr, Token: *token, Annotations: defaulSecret.Annotations}, nil
 	} else if err != nil && !apierrors.IsNotFound(err) {
 		return nil, fmt.Errorf("providerAccountFromDefaultSecretSource: %w", err)
 	}
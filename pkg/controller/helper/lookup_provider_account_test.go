@@ -1,11 +1,14 @@
 package helper
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
 
 	logrtesting "github.com/go-logr/logr/testing"
 	corev1 "k8s.io/api/core/v1"
@@ -98,3 +101,87 @@ func TestLookupProviderAccountNotFoundError(t *testing.T) {
 	_, err := LookupProviderAccount(cl, ns, nil, logrtesting.NullLogger{})
 	equals(t, errors.New("LookupProviderAccount: no provider account found"), err)
 }
+
+func TestLookupProviderAccountReferenceCrossNamespace(t *testing.T) {
+	ns := "app-team"
+	secretNS := "credentials"
+	secretName := "provideraccount"
+	providerAccountURLStr := "https://example.com"
+	providerAccountToken := "12345"
+
+	s := scheme.Scheme
+	err := capabilitiesv1beta1.AddToScheme(s)
+	ok(t, err)
+
+	data := map[string]string{
+		providerAccountSecretURLFieldName:   providerAccountURLStr,
+		providerAccountSecretTokenFieldName: providerAccountToken,
+	}
+	providerSecret := GetTestSecret(secretNS, secretName, data)
+	otherGrant := &capabilitiesv1beta1.ProviderAccountGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: secretNS},
+		Spec: capabilitiesv1beta1.ProviderAccountGrantSpec{
+			SecretRef:  corev1.LocalObjectReference{Name: secretName},
+			Namespaces: []string{"other-team"},
+		},
+	}
+
+	cl := fake.NewFakeClientWithScheme(s, providerSecret, otherGrant)
+
+	providerAccountRef := &capabilitiesv1beta1.ProviderAccountReference{Name: secretName, Namespace: secretNS}
+
+	// no grant allows the namespace
+	_, err = LookupProviderAccountReference(cl, cl, ns, providerAccountRef, logrtesting.NullLogger{})
+	assert(t, helper.IsAccessDeniedError(err), "expected access denied error, got %v", err)
+
+	// grant created
+	grant := &capabilitiesv1beta1.ProviderAccountGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-team", Namespace: secretNS},
+		Spec: capabilitiesv1beta1.ProviderAccountGrantSpec{
+			SecretRef:  corev1.LocalObjectReference{Name: secretName},
+			Namespaces: []string{"other-team", ns},
+		},
+	}
+	err = cl.Create(context.TODO(), grant)
+	ok(t, err)
+
+	providerAccount, err := LookupProviderAccountReference(cl, cl, ns, providerAccountRef, logrtesting.NullLogger{})
+	ok(t, err)
+	assert(t, providerAccount != nil, "provider account returned nil")
+	equals(t, providerAccount.AdminURLStr, providerAccountURLStr)
+	equals(t, providerAccount.Token, providerAccountToken)
+
+	// grant revoked
+	err = cl.Delete(context.TODO(), grant)
+	ok(t, err)
+
+	_, err = LookupProviderAccountReference(cl, cl, ns, providerAccountRef, logrtesting.NullLogger{})
+	assert(t, helper.IsAccessDeniedError(err), "expected access denied error, got %v", err)
+}
+
+func TestLookupProviderAccountReferenceSameNamespace(t *testing.T) {
+	ns := "some_namespace"
+	secretName := "provideraccount"
+	providerAccountURLStr := "https://example.com"
+	providerAccountToken := "12345"
+
+	data := map[string]string{
+		providerAccountSecretURLFieldName:   providerAccountURLStr,
+		providerAccountSecretTokenFieldName: providerAccountToken,
+	}
+	providerSecret := GetTestSecret(ns, secretName, data)
+
+	// no grant is required within the namespace
+	cl := fake.NewFakeClient(providerSecret)
+
+	for _, providerAccountRef := range []*capabilitiesv1beta1.ProviderAccountReference{
+		{Name: secretName},
+		{Name: secretName, Namespace: ns},
+	} {
+		providerAccount, err := LookupProviderAccountReference(cl, cl, ns, providerAccountRef, logrtesting.NullLogger{})
+		ok(t, err)
+		assert(t, providerAccount != nil, "provider account returned nil")
+		equals(t, providerAccount.AdminURLStr, providerAccountURLStr)
+		equals(t, providerAccount.Token, providerAccountToken)
+	}
+}
@@ -3,6 +3,8 @@ package helper
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"sync"
 
 	"github.com/3scale/3scale-operator/pkg/helper"
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
@@ -17,6 +19,8 @@ type ProductEntity struct {
 	metricsAndMethods *threescaleapi.MetricJSONList
 	methods           *threescaleapi.MethodList
 	mappingRules      *threescaleapi.MappingRuleJSONList
+	// mappingRulesMutex guards the mapping rules cache reset by concurrent writes
+	mappingRulesMutex sync.Mutex
 	backendUsages     threescaleapi.BackendAPIUsageList
 	proxy             *threescaleapi.ProxyJSON
 	plans             *threescaleapi.ApplicationPlanJSONList
@@ -330,6 +334,16 @@ func (b *ProductEntity) CreateApplicationPlan(params threescaleapi.Params) (*thr
 	return obj, nil
 }
 
+func (b *ProductEntity) SetDefaultApplicationPlan(id int64) error {
+	b.logger.V(1).Info("SetDefaultApplicationPlan", "ID", id)
+	_, err := b.client.SetDefaultPlan(strconv.FormatInt(b.productObj.Element.ID, 10), strconv.FormatInt(id, 10))
+	if err != nil {
+		return fmt.Errorf("product [%s] set default plan: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetApplicationPlans()
+	return nil
+}
+
 func (b *ProductEntity) PromoteProxyToStaging() error {
 	b.logger.V(1).Info("PromoteProxyToStaging")
 	proxyObj, err := b.client.DeployProductProxy(b.productObj.Element.ID)
@@ -406,6 +420,8 @@ func (b *ProductEntity) resetMetrics() {
 }
 
 func (b *ProductEntity) resetMappingRules() {
+	b.mappingRulesMutex.Lock()
+	defer b.mappingRulesMutex.Unlock()
 	b.mappingRules = nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/helper"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,7 +15,7 @@ import (
 // ProductList returns a list of product custom resources where all elements:
 // - Sync state (ensure remote product exist and in sync)
 // - Same 3scale provider Account
-func ProductList(ns string, cl client.Client, providerAccountURLStr string, logger logr.Logger) ([]capabilitiesv1beta1.Product, error) {
+func ProductList(ns string, cl client.Client, apiReader client.Reader, providerAccountURLStr string, logger logr.Logger) ([]capabilitiesv1beta1.Product, error) {
 	productList := &capabilitiesv1beta1.ProductList{}
 	opts := []controllerclient.ListOption{
 		controllerclient.InNamespace(ns),
@@ -33,7 +34,11 @@ func ProductList(ns string, cl client.Client, providerAccountURLStr string, logg
 			continue
 		}
 
-		productProviderAccount, err := LookupProviderAccount(cl, ns, productList.Items[idx].Spec.ProviderAccountRef, logger)
+		productProviderAccount, err := LookupProviderAccountReference(cl, apiReader, ns, productList.Items[idx].Spec.ProviderAccountRef, logger)
+		if helper.IsAccessDeniedError(err) {
+			// Provider account not granted, it cannot be compared
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("ProductList: %w", err)
 		}
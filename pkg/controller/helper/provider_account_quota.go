@@ -0,0 +1,100 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ProviderAccountMaxProductsAnnotation sets, in the provider account secret,
+	// the max number of Products synchronized into the provider account
+	ProviderAccountMaxProductsAnnotation = "capabilities.3scale.net/max-products"
+	// ProviderAccountMaxBackendsAnnotation sets, in the provider account secret,
+	// the max number of Backends synchronized into the provider account
+	ProviderAccountMaxBackendsAnnotation = "capabilities.3scale.net/max-backends"
+
+	// MaxProductsPerProviderAccountEnvVar sets the operator wide Products quota
+	MaxProductsPerProviderAccountEnvVar = "MAX_PRODUCTS_PER_PROVIDER_ACCOUNT"
+	// MaxBackendsPerProviderAccountEnvVar sets the operator wide Backends quota
+	MaxBackendsPerProviderAccountEnvVar = "MAX_BACKENDS_PER_PROVIDER_ACCOUNT"
+)
+
+// ProviderAccountResourcesMetric exposes the number of custom resources
+// synchronized into each provider account
+var ProviderAccountResourcesMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "threescale_provider_account_resources",
+		Help: "Number of custom resources synchronized into the 3scale provider account",
+	},
+	[]string{"namespace", "provider_account", "kind"},
+)
+
+// ProviderAccountQuota returns the max number of resources allowed in the provider account.
+// The provider account secret annotation takes precedence over the operator wide env var.
+// Nil is returned when there is no quota.
+func ProviderAccountQuota(providerAccount *ProviderAccount, annotation, envVar string) (*int, error) {
+	value, ok := providerAccount.Annotations[annotation]
+	source := fmt.Sprintf("annotation '%s'", annotation)
+	if !ok {
+		value = helper.GetEnvVar(envVar, "")
+		source = fmt.Sprintf("env var '%s'", envVar)
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	quota, err := strconv.Atoi(value)
+	if err != nil || quota < 0 {
+		return nil, fmt.Errorf("invalid quota value '%s' in %s", value, source)
+	}
+
+	return &quota, nil
+}
+
+// ProviderAccountProductCount returns the number of Products of the namespace
+// already created in the 3scale provider account
+func ProviderAccountProductCount(ns string, cl client.Client, providerAccountURLStr string) (int, error) {
+	productList := &capabilitiesv1beta1.ProductList{}
+	err := cl.List(context.TODO(), productList, client.InNamespace(ns))
+	if err != nil {
+		return 0, fmt.Errorf("ProviderAccountProductCount: %w", err)
+	}
+
+	count := 0
+	for idx := range productList.Items {
+		status := productList.Items[idx].Status
+		if status.ID != nil && status.ProviderAccountHost == providerAccountURLStr {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// ProviderAccountBackendCount returns the number of Backends of the namespace
+// already created in the 3scale provider account
+func ProviderAccountBackendCount(ns string, cl client.Client, providerAccountURLStr string) (int, error) {
+	backendList := &capabilitiesv1beta1.BackendList{}
+	err := cl.List(context.TODO(), backendList, client.InNamespace(ns))
+	if err != nil {
+		return 0, fmt.Errorf("ProviderAccountBackendCount: %w", err)
+	}
+
+	count := 0
+	for idx := range backendList.Items {
+		status := backendList.Items[idx].Status
+		if status.ID != nil && status.ProviderAccountHost == providerAccountURLStr {
+			count++
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"os"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/apis/capabilities/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestProviderAccountQuota(t *testing.T) {
+	cases := []struct {
+		testName    string
+		annotations map[string]string
+		envValue    string
+		expected    *int
+		expectedErr bool
+	}{
+		{"no quota", nil, "", nil, false},
+		{"env var quota", nil, "5", intPtr(5), false},
+		{"annotation quota", map[string]string{ProviderAccountMaxProductsAnnotation: "2"}, "5", intPtr(2), false},
+		{"invalid quota", map[string]string{ProviderAccountMaxProductsAnnotation: "-1"}, "", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			os.Setenv(MaxProductsPerProviderAccountEnvVar, tc.envValue)
+			defer os.Unsetenv(MaxProductsPerProviderAccountEnvVar)
+
+			providerAccount := &ProviderAccount{AdminURLStr: "https://example.com", Annotations: tc.annotations}
+			quota, err := ProviderAccountQuota(providerAccount, ProviderAccountMaxProductsAnnotation, MaxProductsPerProviderAccountEnvVar)
+			if (err != nil) != tc.expectedErr {
+				subT.Fatalf("unexpected error: %v", err)
+			}
+			if (quota == nil) != (tc.expected == nil) || (quota != nil && *quota != *tc.expected) {
+				subT.Fatalf("expected quota %v, got %v", tc.expected, quota)
+			}
+		})
+	}
+}
+
+func TestProviderAccountProductCount(t *testing.T) {
+	ns := "somenamespace"
+	providerAccountURLStr := "https://example.com"
+
+	s := scheme.Scheme
+	err := capabilitiesv1beta1.AddToScheme(s)
+	if err != nil {
+		t.Fatalf("Unable to add Apps scheme: (%v)", err)
+	}
+
+	product := func(name, providerAccountHost string, id *int64) *capabilitiesv1beta1.Product {
+		return &capabilitiesv1beta1.Product{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Status:     capabilitiesv1beta1.ProductStatus{ID: id, ProviderAccountHost: providerAccountHost},
+		}
+	}
+
+	cl := fake.NewFakeClient(
+		product("created", providerAccountURLStr, int64Ptr(1)),
+		product("pending", providerAccountURLStr, nil),
+		product("other-account", "https://other.example.com", int64Ptr(2)),
+	)
+
+	count, err := ProviderAccountProductCount(ns, cl, providerAccountURLStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 product, got %d", count)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
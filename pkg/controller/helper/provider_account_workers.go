@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// ProviderAccountSyncWorkersEnvVar sets the max number of concurrent 3scale API calls
+	// made into each provider account when synchronizing the custom resources
+	ProviderAccountSyncWorkersEnvVar = "PROVIDER_ACCOUNT_SYNC_WORKERS"
+	// DefaultProviderAccountSyncWorkers is used when the env var is not set or not valid
+	DefaultProviderAccountSyncWorkers = 5
+)
+
+var (
+	providerAccountWorkersMutex sync.Mutex
+	// provider account admin URL -> worker slots
+	providerAccountWorkers = map[string]chan struct{}{}
+)
+
+// providerAccountWorkerSlots returns the worker slots shared by all the
+// custom resources synchronized into the provider account
+func providerAccountWorkerSlots(providerAccountURLStr string) chan struct{} {
+	providerAccountWorkersMutex.Lock()
+	defer providerAccountWorkersMutex.Unlock()
+
+	slots, ok := providerAccountWorkers[providerAccountURLStr]
+	if !ok {
+		workers, err := strconv.Atoi(helper.GetEnvVar(ProviderAccountSyncWorkersEnvVar, ""))
+		if err != nil || workers < 1 {
+			workers = DefaultProviderAccountSyncWorkers
+		}
+		slots = make(chan struct{}, workers)
+		providerAccountWorkers[providerAccountURLStr] = slots
+	}
+
+	return slots
+}
+
+// RunProviderAccountTasks runs the 3scale API calls concurrently. The concurrency is bounded
+// per provider account, so large specs do not overload the 3scale API.
+// All the tasks are run even when some of them fail, so the next sync resumes
+// from the progress made. The errors are aggregated.
+func RunProviderAccountTasks(providerAccountURLStr string, tasks []func() error) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	slots := providerAccountWorkerSlots(providerAccountURLStr)
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(tasks))
+	for idx := range tasks {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(idx int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			errs[idx] = tasks[idx]()
+		}(idx)
+	}
+	wg.Wait()
+
+	return utilerrors.NewAggregate(errs)
+}
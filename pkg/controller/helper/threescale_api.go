@@ -17,6 +17,8 @@ const (
 type ProviderAccount struct {
 	AdminURLStr string
 	Token       string
+	// Annotations of the provider account secret, if any
+	Annotations map[string]string
 }
 
 // PortaClient instantiate porta_client.ThreeScaleClient from ProviderAccount object
@@ -39,6 +41,11 @@ func PortaClientFromURL(url *url.URL, token string) (*threescaleapi.ThreeScaleCl
 		return nil, err
 	}
 
+	return threescaleapi.NewThreeScale(adminPortal, token, PortaHTTPClient()), nil
+}
+
+// PortaHTTPClient returns the HTTP client used to call the 3scale APIs
+func PortaHTTPClient() *http.Client {
 	// TODO By default should not skip verification
 	// Activated by some env var or Spec param
 	var transport http.RoundTripper = &http.Transport{
@@ -50,5 +57,5 @@ func PortaClientFromURL(url *url.URL, token string) (*threescaleapi.ThreeScaleCl
 		transport = &helper.Transport{Transport: transport}
 	}
 
-	return threescaleapi.NewThreeScale(adminPortal, token, &http.Client{Transport: transport}), nil
+	return &http.Client{Transport: transport}
 }
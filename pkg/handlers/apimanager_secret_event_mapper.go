@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+
+	appscommon "github.com/3scale/3scale-operator/apis/apps"
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/go-logr/logr"
+	appsv1 "github.com/openshift/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ handler.Mapper = &APIManagerSecretEventMapper{}
+
+// APIManagerSecretEventMapper is an EventHandler that maps a secret to the APIManagers
+// referencing it, so credential rotations are applied and missing secrets are picked up
+// once created, and to the APIManagers owning the DeploymentConfigs whose env vars read it,
+// so the secret hash of the pods is updated.
+// This handler should only be used on Secret objects.
+type APIManagerSecretEventMapper struct {
+	K8sClient client.Client
+	Logger    logr.Logger
+}
+
+func (h *APIManagerSecretEventMapper) Map(mapObject handler.MapObject) []reconcile.Request {
+	apimanagerNames := h.referencingAPIManagers(mapObject)
+	for _, name := range h.readingAPIManagers(mapObject) {
+		if !helper.ArrayContains(apimanagerNames, name) {
+			apimanagerNames = append(apimanagerNames, name)
+		}
+	}
+
+	var res []reconcile.Request
+	for _, name := range apimanagerNames {
+		h.Logger.V(2).Info("Secret event detected. Reenqueuing as APIManager event", "APIManager name", name, "secret name", mapObject.Meta.GetName())
+		res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      name,
+			Namespace: mapObject.Meta.GetNamespace(),
+		}})
+	}
+
+	return res
+}
+
+// referencingAPIManagers returns the APIManagers referencing the secret in their spec
+func (h *APIManagerSecretEventMapper) referencingAPIManagers(mapObject handler.MapObject) []string {
+	apimanagerList := &appsv1alpha1.APIManagerList{}
+	err := h.K8sClient.List(context.Background(), apimanagerList, client.InNamespace(mapObject.Meta.GetNamespace()))
+	if err != nil {
+		h.Logger.Error(err, "Could not list APIManagers", "Namespace", mapObject.Meta.GetNamespace())
+		return nil
+	}
+
+	apimanagerNames := []string{}
+	for idx := range apimanagerList.Items {
+		apimanager := &apimanagerList.Items[idx]
+		if helper.ArrayContains(apimanager.ReferencedSecretNames(), mapObject.Meta.GetName()) {
+			apimanagerNames = append(apimanagerNames, apimanager.Name)
+		}
+	}
+
+	return apimanagerNames
+}
+
+// readingAPIManagers returns the APIManagers owning the DeploymentConfigs whose env vars read the secret
+func (h *APIManagerSecretEventMapper) readingAPIManagers(mapObject handler.MapObject) []string {
+	dcList := &appsv1.DeploymentConfigList{}
+	err := h.K8sClient.List(context.Background(), dcList, client.InNamespace(mapObject.Meta.GetNamespace()))
+	if err != nil {
+		h.Logger.Error(err, "Could not list DeploymentConfigs", "Namespace", mapObject.Meta.GetNamespace())
+		return nil
+	}
+
+	apimanagerNames := []string{}
+	for idx := range dcList.Items {
+		dc := &dcList.Items[idx]
+		if dc.Spec.Template == nil || !helper.ArrayContains(component.PodSecretNames(&dc.Spec.Template.Spec), mapObject.Meta.GetName()) {
+			continue
+		}
+
+		for _, ref := range dc.GetOwnerReferences() {
+			refGV, err := schema.ParseGroupVersion(ref.APIVersion)
+			if err != nil {
+				continue
+			}
+			if ref.Kind == appscommon.APIManagerKind && refGV.Group == appsv1alpha1.GroupVersion.Group && !helper.ArrayContains(apimanagerNames, ref.Name) {
+				apimanagerNames = append(apimanagerNames, ref.Name)
+			}
+		}
+	}
+
+	return apimanagerNames
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	logrtesting "github.com/go-logr/logr/testing"
+	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appscommon "github.com/3scale/3scale-operator/apis/apps"
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
+)
+
+func TestAPIManagerSecretEventMapperMap(t *testing.T) {
+	namespace := "examplenamespace"
+	referencingAPIManager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "referencing", Namespace: namespace},
+		Spec: appsv1alpha1.APIManagerSpec{
+			System: &appsv1alpha1.SystemSpec{
+				InboundEmail: &appsv1alpha1.SystemInboundEmailSpec{
+					CredentialsSecretRef: v1.LocalObjectReference{Name: "inbound-email"},
+				},
+			},
+		},
+	}
+	readingAPIManager := &appsv1alpha1.APIManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "reading", Namespace: namespace},
+	}
+	dc := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      component.SystemSidekiqName,
+			Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: appsv1alpha1.GroupVersion.String(),
+					Kind:       appscommon.APIManagerKind,
+					Name:       readingAPIManager.Name,
+				},
+			},
+		},
+		Spec: appsv1.DeploymentConfigSpec{
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: "system-sidekiq",
+							EnvFrom: []v1.EnvFromSource{
+								{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "inbound-email"}}},
+								{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "system-seed"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := appsv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewFakeClientWithScheme(s, referencingAPIManager, readingAPIManager, dc)
+
+	mapper := APIManagerSecretEventMapper{
+		K8sClient: cl,
+		Logger:    logrtesting.NullLogger{},
+	}
+
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}
+	}
+
+	cases := []struct {
+		testName   string
+		secretName string
+		expected   []reconcile.Request
+	}{
+		{"ReferencedAndRead", "inbound-email", []reconcile.Request{request("referencing"), request("reading")}},
+		{"OnlyRead", "system-seed", []reconcile.Request{request("reading")}},
+		{"NotReferenced", "some-secret", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: tc.secretName, Namespace: namespace}}
+			result := mapper.Map(handler.MapObject{Meta: secret, Object: secret})
+			if !reflect.DeepEqual(result, tc.expected) {
+				subT.Errorf("unexpected requests. Expected: %v, got: %v", tc.expected, result)
+			}
+		})
+	}
+}
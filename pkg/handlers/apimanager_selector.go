@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+
+	appscommon "github.com/3scale/3scale-operator/apis/apps"
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// APIManagerSelectorMatches tells whether the given APIManager labels match the selector.
+// A nil selector matches every APIManager.
+func APIManagerSelectorMatches(selector labels.Selector, apimanagerLabels map[string]string) bool {
+	return selector == nil || selector.Empty() || selector.Matches(labels.Set(apimanagerLabels))
+}
+
+// APIManagerSelectorPredicate filters out the events of the APIManagers
+// not matching the selector. This predicate should only be used on APIManager objects.
+func APIManagerSelectorPredicate(selector labels.Selector) predicate.Funcs {
+	matches := func(object metav1.Object) bool {
+		return APIManagerSelectorMatches(selector, object.GetLabels())
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return matches(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Relabelling the APIManager out of the selector is
+			// also notified, the reconcile loop then ignores it
+			return matches(e.MetaOld) || matches(e.MetaNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return matches(e.Meta)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return matches(e.Meta)
+		},
+	}
+}
+
+// APIManagerOwnedSelectorPredicate filters out the events of the objects
+// whose controller APIManager does not match the selector. The owner APIManager
+// is looked up through the controller OwnerReference of the object.
+func APIManagerOwnedSelectorPredicate(k8sClient client.Client, selector labels.Selector, logger logr.Logger) predicate.Funcs {
+	matches := func(object metav1.Object) bool {
+		if APIManagerSelectorMatches(selector, nil) {
+			return true
+		}
+
+		ref := metav1.GetControllerOf(object)
+		if ref == nil {
+			return false
+		}
+
+		refGV, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || ref.Kind != appscommon.APIManagerKind || refGV.Group != appsv1alpha1.GroupVersion.Group {
+			return false
+		}
+
+		return apimanagerMatchesSelector(k8sClient, selector, types.NamespacedName{Name: ref.Name, Namespace: object.GetNamespace()}, logger)
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return matches(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return matches(e.MetaNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return matches(e.Meta)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return matches(e.Meta)
+		},
+	}
+}
+
+var _ handler.Mapper = &APIManagerSelectorMapper{}
+
+// APIManagerSelectorMapper wraps a mapper returning APIManager requests and
+// drops the requests of the APIManagers not matching the selector
+type APIManagerSelectorMapper struct {
+	Mapper    handler.Mapper
+	K8sClient client.Client
+	Selector  labels.Selector
+	Logger    logr.Logger
+}
+
+func (h *APIManagerSelectorMapper) Map(mapObject handler.MapObject) []reconcile.Request {
+	requests := h.Mapper.Map(mapObject)
+	if APIManagerSelectorMatches(h.Selector, nil) {
+		return requests
+	}
+
+	var res []reconcile.Request
+	for _, request := range requests {
+		if apimanagerMatchesSelector(h.K8sClient, h.Selector, request.NamespacedName, h.Logger) {
+			res = append(res, request)
+		}
+	}
+	return res
+}
+
+func apimanagerMatchesSelector(k8sClient client.Client, selector labels.Selector, nn types.NamespacedName, logger logr.Logger) bool {
+	apimanager := &appsv1alpha1.APIManager{}
+	err := k8sClient.Get(context.Background(), nn, apimanager)
+	if err != nil {
+		// Gone APIManagers have nothing left to reconcile
+		logger.V(2).Info("Could not get APIManager", "APIManager", nn, "error", err.Error())
+		return false
+	}
+
+	return APIManagerSelectorMatches(selector, apimanager.GetLabels())
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	logrtesting "github.com/go-logr/logr/testing"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appscommon "github.com/3scale/3scale-operator/apis/apps"
+	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+)
+
+type staticMapper []reconcile.Request
+
+func (m staticMapper) Map(handler.MapObject) []reconcile.Request {
+	return m
+}
+
+func TestAPIManagerSelectorPredicates(t *testing.T) {
+	namespace := "examplenamespace"
+	newAPIManager := func(name, shard string) *appsv1alpha1.APIManager {
+		return &appsv1alpha1.APIManager{
+			TypeMeta: metav1.TypeMeta{APIVersion: appsv1alpha1.GroupVersion.String(), Kind: appscommon.APIManagerKind},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: namespace, UID: types.UID(name),
+				Labels: map[string]string{"shard": shard},
+			},
+		}
+	}
+	newOwned := func(owner *appsv1alpha1.APIManager) *v1.ConfigMap {
+		trueValue := true
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: owner.Name + "-owned", Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: appsv1alpha1.GroupVersion.String(), Kind: appscommon.APIManagerKind,
+					Name: owner.Name, UID: owner.UID, Controller: &trueValue,
+				}},
+			},
+		}
+	}
+
+	apimanagerA := newAPIManager("apimanager-a", "a")
+	apimanagerB := newAPIManager("apimanager-b", "b")
+
+	s := scheme.Scheme
+	s.AddKnownTypes(appsv1alpha1.GroupVersion, apimanagerA)
+	cl := fake.NewFakeClientWithScheme(s, apimanagerA, apimanagerB)
+
+	selector, err := labels.Parse("shard=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apimanagerPredicate := APIManagerSelectorPredicate(selector)
+	if !apimanagerPredicate.Create(event.CreateEvent{Meta: apimanagerA, Object: apimanagerA}) {
+		t.Error("expected APIManager matching the selector to be accepted")
+	}
+	if apimanagerPredicate.Create(event.CreateEvent{Meta: apimanagerB, Object: apimanagerB}) {
+		t.Error("expected APIManager not matching the selector to be filtered out")
+	}
+
+	ownedPredicate := APIManagerOwnedSelectorPredicate(cl, selector, logrtesting.NullLogger{})
+	ownedA, ownedB := newOwned(apimanagerA), newOwned(apimanagerB)
+	if !ownedPredicate.Update(event.UpdateEvent{MetaOld: ownedA, ObjectOld: ownedA, MetaNew: ownedA, ObjectNew: ownedA}) {
+		t.Error("expected object owned by an APIManager matching the selector to be accepted")
+	}
+	if ownedPredicate.Update(event.UpdateEvent{MetaOld: ownedB, ObjectOld: ownedB, MetaNew: ownedB, ObjectNew: ownedB}) {
+		t.Error("expected object owned by an APIManager not matching the selector to be filtered out")
+	}
+
+	// Without selector, every object is accepted
+	if !APIManagerOwnedSelectorPredicate(cl, nil, logrtesting.NullLogger{}).Delete(event.DeleteEvent{Meta: ownedB, Object: ownedB}) {
+		t.Error("expected every object to be accepted without selector")
+	}
+
+	requestA := reconcile.Request{NamespacedName: types.NamespacedName{Name: apimanagerA.Name, Namespace: namespace}}
+	requestB := reconcile.Request{NamespacedName: types.NamespacedName{Name: apimanagerB.Name, Namespace: namespace}}
+	mapper := &APIManagerSelectorMapper{
+		Mapper:    staticMapper{requestA, requestB},
+		K8sClient: cl,
+		Selector:  selector,
+		Logger:    logrtesting.NullLogger{},
+	}
+	res := mapper.Map(handler.MapObject{Meta: ownedA, Object: ownedA})
+	if !reflect.DeepEqual(res, []reconcile.Request{requestA}) {
+		t.Errorf("unexpected mapped requests: %v", res)
+	}
+}
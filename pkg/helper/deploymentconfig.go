@@ -16,3 +16,60 @@ func IsDeploymentConfigAvailable(dc *appsv1.DeploymentConfig) bool {
 	}
 	return false
 }
+
+// SetTerminationMessagePolicy sets the termination message policy of all the containers,
+// including the init containers, of the pod spec
+func SetTerminationMessagePolicy(podSpec *corev1.PodSpec, policy corev1.TerminationMessagePolicy) {
+	for idx := range podSpec.InitContainers {
+		podSpec.InitContainers[idx].TerminationMessagePolicy = policy
+	}
+	for idx := range podSpec.Containers {
+		podSpec.Containers[idx].TerminationMessagePolicy = policy
+	}
+}
+
+// SetRestrictedSecurityContexts defaults the unset fields of the security contexts of the pod template
+// to comply with the restricted Pod Security Standard. The pod runs as non-root with the runtime/default
+// seccomp profile, and all the containers, including the init containers, drop all the capabilities and
+// do not allow privilege escalation. The fields already set are kept. The seccomp profile is set with the
+// pod annotation, as the pod security context field is not defined by the k8s.io/api version in use
+func SetRestrictedSecurityContexts(template *corev1.PodTemplateSpec) {
+	podSpec := &template.Spec
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if podSpec.SecurityContext.RunAsNonRoot == nil {
+		runAsNonRoot := true
+		podSpec.SecurityContext.RunAsNonRoot = &runAsNonRoot
+	}
+
+	if _, ok := template.Annotations[corev1.SeccompPodAnnotationKey]; !ok {
+		// The annotations map may be shared with the custom annotations options
+		annotations := map[string]string{}
+		for key, val := range template.Annotations {
+			annotations[key] = val
+		}
+		annotations[corev1.SeccompPodAnnotationKey] = corev1.SeccompProfileRuntimeDefault
+		template.Annotations = annotations
+	}
+
+	for idx := range podSpec.InitContainers {
+		setRestrictedContainerSecurityContext(&podSpec.InitContainers[idx])
+	}
+	for idx := range podSpec.Containers {
+		setRestrictedContainerSecurityContext(&podSpec.Containers[idx])
+	}
+}
+
+func setRestrictedContainerSecurityContext(container *corev1.Container) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	if container.SecurityContext.AllowPrivilegeEscalation == nil {
+		allowPrivilegeEscalation := false
+		container.SecurityContext.AllowPrivilegeEscalation = &allowPrivilegeEscalation
+	}
+	if container.SecurityContext.Capabilities == nil {
+		container.SecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+	}
+}
@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSetRestrictedSecurityContexts(t *testing.T) {
+	trueValue := true
+	falseValue := false
+	var userID int64 = 1001
+	dropAll := &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+	netBind := &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}, Drop: []corev1.Capability{"ALL"}}
+	runtimeDefault := map[string]string{corev1.SeccompPodAnnotationKey: corev1.SeccompProfileRuntimeDefault}
+	localhost := map[string]string{corev1.SeccompPodAnnotationKey: "localhost/apicast.json"}
+
+	cases := []struct {
+		name                       string
+		annotations                map[string]string
+		podSecurityContext         *corev1.PodSecurityContext
+		securityContext            *corev1.SecurityContext
+		expectedAnnotations        map[string]string
+		expectedPodSecurityContext *corev1.PodSecurityContext
+		expectedSecurityContext    *corev1.SecurityContext
+	}{
+		{
+			"not set",
+			nil, nil, nil,
+			runtimeDefault,
+			&corev1.PodSecurityContext{RunAsNonRoot: &trueValue},
+			&corev1.SecurityContext{AllowPrivilegeEscalation: &falseValue, Capabilities: dropAll},
+		},
+		{
+			"partially set",
+			map[string]string{"custom": "value"},
+			&corev1.PodSecurityContext{RunAsUser: &userID},
+			&corev1.SecurityContext{ReadOnlyRootFilesystem: &trueValue},
+			map[string]string{"custom": "value", corev1.SeccompPodAnnotationKey: corev1.SeccompProfileRuntimeDefault},
+			&corev1.PodSecurityContext{RunAsUser: &userID, RunAsNonRoot: &trueValue},
+			&corev1.SecurityContext{ReadOnlyRootFilesystem: &trueValue, AllowPrivilegeEscalation: &falseValue, Capabilities: dropAll},
+		},
+		{
+			"overridden",
+			localhost,
+			&corev1.PodSecurityContext{RunAsNonRoot: &falseValue},
+			&corev1.SecurityContext{AllowPrivilegeEscalation: &trueValue, Capabilities: netBind},
+			localhost,
+			&corev1.PodSecurityContext{RunAsNonRoot: &falseValue},
+			&corev1.SecurityContext{AllowPrivilegeEscalation: &trueValue, Capabilities: netBind},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			template := &corev1.PodTemplateSpec{}
+			template.Annotations = tc.annotations
+			template.Spec = corev1.PodSpec{
+				SecurityContext: tc.podSecurityContext,
+				InitContainers:  []corev1.Container{{Name: "init", SecurityContext: tc.securityContext.DeepCopy()}},
+				Containers:      []corev1.Container{{Name: "main", SecurityContext: tc.securityContext.DeepCopy()}},
+			}
+			SetRestrictedSecurityContexts(template)
+			if diff := cmp.Diff(tc.expectedAnnotations, template.Annotations); diff != "" {
+				subT.Errorf("unexpected annotations (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedPodSecurityContext, template.Spec.SecurityContext); diff != "" {
+				subT.Errorf("unexpected pod security context (-want +got):\n%s", diff)
+			}
+			for _, container := range append(template.Spec.InitContainers, template.Spec.Containers...) {
+				if diff := cmp.Diff(tc.expectedSecurityContext, container.SecurityContext); diff != "" {
+					subT.Errorf("unexpected security context of the container %s (-want +got):\n%s", container.Name, diff)
+				}
+			}
+		})
+	}
+}
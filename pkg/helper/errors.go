@@ -1,6 +1,9 @@
 package helper
 
 import (
+	"errors"
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -56,6 +59,32 @@ func (s *WaitError) Error() string {
 	return s.Err.Error()
 }
 
+// QuotaExceededError represents that the resource is not synchronized because
+// the provider account has already reached the quota for the resource kind.
+// This is a transient error, cleared when the quota is raised or other resources are removed.
+type QuotaExceededError struct {
+	Kind            string
+	Quota           int
+	ProviderAccount string
+}
+
+func (s *QuotaExceededError) Error() string {
+	return fmt.Sprintf("provider account '%s' quota of %d %s resources exceeded", s.ProviderAccount, s.Quota, s.Kind)
+}
+
+// AccessDeniedError represents that the resource references a provider account secret
+// from another namespace not granted to the resource namespace.
+// This is a transient error, cleared when the access is granted.
+type AccessDeniedError struct {
+	Namespace       string
+	SecretName      string
+	SecretNamespace string
+}
+
+func (s *AccessDeniedError) Error() string {
+	return fmt.Sprintf("no ProviderAccountGrant in namespace '%s' allows namespace '%s' to use provider account secret '%s'", s.SecretNamespace, s.Namespace, s.SecretName)
+}
+
 func IsInvalidSpecError(err error) bool {
 	if specErrorObj, ok := err.(SpecError); ok && specErrorObj.FieldType() == InvalidError {
 		return true
@@ -70,7 +99,18 @@ func IsOrphanSpecError(err error) bool {
 	return false
 }
 
+// IsWaitError returns true when the error, or any error it wraps, is a WaitError
 func IsWaitError(err error) bool {
-	_, ok := err.(*WaitError)
+	var waitErr *WaitError
+	return errors.As(err, &waitErr)
+}
+
+func IsQuotaExceededError(err error) bool {
+	_, ok := err.(*QuotaExceededError)
+	return ok
+}
+
+func IsAccessDeniedError(err error) bool {
+	_, ok := err.(*AccessDeniedError)
 	return ok
 }
@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DNSLabelMaxLength is the maximum length of each of the dot separated parts of a host
+	DNSLabelMaxLength = 63
+	// DNSHostMaxLength is the maximum length of a host
+	DNSHostMaxLength = 253
+)
+
+var nonAlphanumericRegexp = regexp.MustCompile("[^a-z0-9]+")
+
+// HostLengthError returns why the host is rejected by the routers,
+// or an empty string when the host is within the DNS length limits
+func HostLengthError(host string) string {
+	if len(host) > DNSHostMaxLength {
+		return fmt.Sprintf("host is %d characters long, exceeding the %d characters limit", len(host), DNSHostMaxLength)
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if len(label) > DNSLabelMaxLength {
+			return fmt.Sprintf("host label '%s' is %d characters long, exceeding the %d characters limit", label, len(label), DNSLabelMaxLength)
+		}
+	}
+
+	return ""
+}
+
+// NormalizeDomain lowercases the domain and removes the trailing dot
+func NormalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(domain), ".")
+}
+
+// TenantSubdomain returns the subdomain system generates for an organization name:
+// lowercased, with every run of non alphanumeric characters replaced by a dash
+func TenantSubdomain(orgName string) string {
+	return strings.Trim(nonAlphanumericRegexp.ReplaceAllString(strings.ToLower(orgName), "-"), "-")
+}
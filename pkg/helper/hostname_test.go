@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHostLengthError(t *testing.T) {
+	cases := []struct {
+		name          string
+		host          string
+		expectedError bool
+	}{
+		{"valid", "3scale-admin.apps.example.com", false},
+		{"labelAtLimit", strings.Repeat("a", DNSLabelMaxLength) + ".example.com", false},
+		{"labelTooLong", strings.Repeat("a", DNSLabelMaxLength+1) + ".example.com", true},
+		{"hostTooLong", strings.Repeat(strings.Repeat("a", 50)+".", 5) + "com", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			reason := HostLengthError(tc.host)
+			if (reason != "") != tc.expectedError {
+				subT.Errorf("expected error %t, got '%s'", tc.expectedError, reason)
+			}
+		})
+	}
+}
+
+func TestTenantSubdomain(t *testing.T) {
+	cases := []struct {
+		orgName  string
+		expected string
+	}{
+		{"example", "example"},
+		{"My Org", "my-org"},
+		{"  ACME, Inc.  ", "acme-inc"},
+		{"foo__bar", "foo-bar"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.orgName, func(subT *testing.T) {
+			if subdomain := TenantSubdomain(tc.orgName); subdomain != tc.expected {
+				subT.Errorf("expected '%s', got '%s'", tc.expected, subdomain)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package helper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const dockerHubOfficialRepositoryPrefix = "library/"
+
+var (
+	imageRegistryHostRegexp     = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]{1,5})?$`)
+	imageRepositoryPrefixRegexp = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+	imageTagRegexp              = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+	imageDigestRegexp           = regexp.MustCompile(`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-fA-F0-9]{32,}$`)
+)
+
+// SplitImageReference splits the image reference into the registry host,
+// the repository and the tag or digest suffix (":tag", "@sha256:..." or both).
+// The registry is empty for Docker Hub references without explicit registry.
+func SplitImageReference(image string) (registry, repository, suffix string) {
+	name := image
+	if idx := strings.Index(name, "@"); idx >= 0 {
+		name, suffix = name[:idx], name[idx:]
+	}
+	// A tag is the colon suffix of the last path component
+	if idx := strings.LastIndex(name, ":"); idx >= 0 && !strings.Contains(name[idx:], "/") {
+		name, suffix = name[:idx], name[idx:]+suffix
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], parts[1], suffix
+	}
+
+	return "", name, suffix
+}
+
+// OverrideImageRegistry rewrites the image reference to be pulled from the given
+// registry host, with the repository prefix prepended to the repository.
+// The tag or digest of the image is kept.
+func OverrideImageRegistry(image, host, repositoryPrefix string) string {
+	registry, repository, suffix := SplitImageReference(image)
+
+	// Mirroring keeps the implicit namespace of the Docker Hub official images
+	if (registry == "" || registry == "docker.io") && !strings.Contains(repository, "/") {
+		repository = dockerHubOfficialRepositoryPrefix + repository
+	}
+
+	if repositoryPrefix != "" {
+		repository = fmt.Sprintf("%s/%s", strings.Trim(repositoryPrefix, "/"), repository)
+	}
+
+	return fmt.Sprintf("%s/%s%s", host, repository, suffix)
+}
+
+// ImageRegistryHostError returns why the image registry host is rejected,
+// or an empty string when it is a valid host[:port]
+func ImageRegistryHostError(host string) string {
+	if strings.ContainsAny(host, "/@") {
+		return "registry host must not contain a repository or digest"
+	}
+	if !imageRegistryHostRegexp.MatchString(host) {
+		return "registry host must be host[:port], without tag"
+	}
+	return ""
+}
+
+// ImageRepositoryPrefixError returns why the image repository prefix is rejected,
+// or an empty string when it is a valid repository path
+func ImageRepositoryPrefixError(repositoryPrefix string) string {
+	if strings.ContainsAny(repositoryPrefix, ":@") {
+		return "repository prefix must not contain a tag or digest"
+	}
+	if !imageRepositoryPrefixRegexp.MatchString(strings.Trim(repositoryPrefix, "/")) {
+		return "repository prefix must be a lowercase repository path"
+	}
+	return ""
+}
+
+// ImageReferenceError returns why the image reference is rejected, or an empty
+// string when it is a valid [registry/]repository reference with an optional
+// tag, digest or both
+func ImageReferenceError(image string) string {
+	registry, repository, suffix := SplitImageReference(image)
+	if registry != "" && ImageRegistryHostError(registry) != "" {
+		return "image registry must be host[:port]"
+	}
+	if !imageRepositoryPrefixRegexp.MatchString(repository) {
+		return "image repository must be a lowercase repository path"
+	}
+
+	tag, digest := suffix, ""
+	if idx := strings.Index(suffix, "@"); idx >= 0 {
+		tag, digest = suffix[:idx], suffix[idx+1:]
+		if !imageDigestRegexp.MatchString(digest) {
+			return "image digest must be algorithm:hex, e.g. sha256:<64 hex characters>"
+		}
+	}
+	if tag != "" && !imageTagRegexp.MatchString(strings.TrimPrefix(tag, ":")) {
+		return "image tag must be up to 128 letters, digits, '_', '.' or '-'"
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package helper
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MergeImagePullSecrets returns the defaults followed by the secrets not already
+// in the list, deduplicated by name. The order of the inputs is kept so the
+// result is stable across reconciliations
+func MergeImagePullSecrets(defaults, secrets []corev1.LocalObjectReference) []corev1.LocalObjectReference {
+	result := make([]corev1.LocalObjectReference, 0, len(defaults)+len(secrets))
+	seen := map[string]bool{}
+
+	for _, list := range [][]corev1.LocalObjectReference{defaults, secrets} {
+		for _, secret := range list {
+			if seen[secret.Name] {
+				continue
+			}
+			seen[secret.Name] = true
+			result = append(result, secret)
+		}
+	}
+
+	return result
+}
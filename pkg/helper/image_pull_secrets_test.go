@@ -0,0 +1,38 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestMergeImagePullSecrets(t *testing.T) {
+	registryAuth := corev1.LocalObjectReference{Name: "threescale-registry-auth"}
+	mirror := corev1.LocalObjectReference{Name: "mirror-auth"}
+	other := corev1.LocalObjectReference{Name: "other-auth"}
+
+	cases := []struct {
+		name     string
+		defaults []corev1.LocalObjectReference
+		secrets  []corev1.LocalObjectReference
+		expected []corev1.LocalObjectReference
+	}{
+		{"nil secrets", []corev1.LocalObjectReference{registryAuth}, nil, []corev1.LocalObjectReference{registryAuth}},
+		{"empty secrets", []corev1.LocalObjectReference{registryAuth}, []corev1.LocalObjectReference{}, []corev1.LocalObjectReference{registryAuth}},
+		{"nil defaults", nil, []corev1.LocalObjectReference{mirror}, []corev1.LocalObjectReference{mirror}},
+		{"both nil", nil, nil, []corev1.LocalObjectReference{}},
+		{"appended", []corev1.LocalObjectReference{registryAuth}, []corev1.LocalObjectReference{mirror, other}, []corev1.LocalObjectReference{registryAuth, mirror, other}},
+		{"overlapping", []corev1.LocalObjectReference{registryAuth}, []corev1.LocalObjectReference{mirror, registryAuth}, []corev1.LocalObjectReference{registryAuth, mirror}},
+		{"duplicated secrets", []corev1.LocalObjectReference{registryAuth}, []corev1.LocalObjectReference{mirror, mirror}, []corev1.LocalObjectReference{registryAuth, mirror}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			result := MergeImagePullSecrets(tc.defaults, tc.secrets)
+			if diff := cmp.Diff(tc.expected, result); diff != "" {
+				subT.Errorf("unexpected image pull secrets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package helper
+
+import (
+	"testing"
+)
+
+func TestOverrideImageRegistry(t *testing.T) {
+	cases := []struct {
+		name             string
+		image            string
+		host             string
+		repositoryPrefix string
+		expected         string
+	}{
+		{"registryWithTag", "quay.io/3scale/apicast:latest", "mirror.example.com:5000", "", "mirror.example.com:5000/3scale/apicast:latest"},
+		{"registryWithPrefix", "quay.io/3scale/apisonator:3scale-2.10", "mirror.example.com", "mirrors/3scale", "mirror.example.com/mirrors/3scale/3scale/apisonator:3scale-2.10"},
+		{"digest", "registry.redhat.io/3scale-amp2/backend-rhel8@sha256:0123456789abcdef", "mirror.example.com", "", "mirror.example.com/3scale-amp2/backend-rhel8@sha256:0123456789abcdef"},
+		{"tagAndDigest", "quay.io/3scale/zync:nightly@sha256:abcdef", "mirror.example.com", "", "mirror.example.com/3scale/zync:nightly@sha256:abcdef"},
+		{"dockerHubOfficial", "memcached:1.5", "mirror.example.com", "", "mirror.example.com/library/memcached:1.5"},
+		{"dockerHubNamespaced", "centos/redis-5-centos7", "mirror.example.com", "", "mirror.example.com/centos/redis-5-centos7"},
+		{"registryPort", "localhost:5000/3scale/system:latest", "mirror.example.com", "/prefix/", "mirror.example.com/prefix/3scale/system:latest"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			res := OverrideImageRegistry(tc.image, tc.host, tc.repositoryPrefix)
+			if res != tc.expected {
+				subT.Errorf("expected '%s', got '%s'", tc.expected, res)
+			}
+		})
+	}
+}
+
+func TestImageRegistryOverrideErrors(t *testing.T) {
+	cases := []struct {
+		name             string
+		host             string
+		repositoryPrefix string
+		expectedError    bool
+	}{
+		{"host", "mirror.example.com", "", false},
+		{"hostWithPort", "mirror.example.com:5000", "", false},
+		{"hostWithPrefix", "mirror.example.com", "mirrors/3scale", false},
+		{"hostWithRepository", "mirror.example.com/3scale", "", true},
+		{"hostWithTag", "mirror.example.com:latest", "", true},
+		{"hostWithDigest", "mirror.example.com@sha256:abcdef", "", true},
+		{"prefixWithTag", "mirror.example.com", "mirrors/3scale:latest", true},
+		{"prefixWithDigest", "mirror.example.com", "mirrors@sha256:abcdef", true},
+		{"prefixUppercase", "mirror.example.com", "Mirrors", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			reason := ImageRegistryHostError(tc.host)
+			if reason == "" && tc.repositoryPrefix != "" {
+				reason = ImageRepositoryPrefixError(tc.repositoryPrefix)
+			}
+			if (reason != "") != tc.expectedError {
+				subT.Errorf("expected error %t, got '%s'", tc.expectedError, reason)
+			}
+		})
+	}
+}
+
+func TestImageReferenceError(t *testing.T) {
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	cases := []struct {
+		name          string
+		image         string
+		expectedError bool
+	}{
+		{"repository", "memcached", false},
+		{"tag", "quay.io/3scale/zync:nightly", false},
+		{"digest", "registry.redhat.io/3scale-amp2/zync-rhel8@" + digest, false},
+		{"tagAndDigest", "quay.io/3scale/zync:nightly@" + digest, false},
+		{"registryPort", "localhost:5000/3scale/system:latest", false},
+		{"empty", "", true},
+		{"emptyTag", "quay.io/3scale/zync:", true},
+		{"invalidTag", "quay.io/3scale/zync:night/ly", true},
+		{"shortDigest", "quay.io/3scale/zync@sha256:abcdef", true},
+		{"digestWithoutAlgorithm", "quay.io/3scale/zync@0123456789abcdef0123456789abcdef", true},
+		{"uppercaseRepository", "quay.io/3scale/Zync:latest", true},
+		{"onlyDigest", "@" + digest, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			reason := ImageReferenceError(tc.image)
+			if (reason != "") != tc.expectedError {
+				subT.Errorf("expected error %t, got '%s'", tc.expectedError, reason)
+			}
+		})
+	}
+}
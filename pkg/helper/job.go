@@ -1,11 +1,15 @@
 package helper
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // UIDBasedJobName returns a Job name that is compromised of the provided prefix,
@@ -26,3 +30,99 @@ func UIDBasedJobName(prefix string, uid types.UID) (string, error) {
 
 	return jobName, err
 }
+
+// JobPodLabel is the label set by the job controller on the job pods
+const JobPodLabel = "job-name"
+
+// JobTerminationMessage returns the termination message of the most recently
+// terminated container of the job pods. Empty when no container has terminated
+func JobTerminationMessage(cl client.Client, job *batchv1.Job) (string, error) {
+	podList := &v1.PodList{}
+	listOps := []client.ListOption{
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{JobPodLabel: job.Name},
+	}
+	err := cl.List(context.TODO(), podList, listOps...)
+	if err != nil {
+		return "", err
+	}
+
+	return PodsTerminationMessage(podList.Items), nil
+}
+
+// PodsTerminationMessage returns the termination message of the most recently
+// terminated container of the given pods
+func PodsTerminationMessage(pods []v1.Pod) string {
+	var latest *v1.ContainerStateTerminated
+	for podIdx := range pods {
+		for _, containerStatus := range pods[podIdx].Status.ContainerStatuses {
+			terminated := containerStatus.State.Terminated
+			if terminated == nil {
+				continue
+			}
+			if latest == nil || latest.FinishedAt.Before(&terminated.FinishedAt) {
+				latest = terminated
+			}
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+	return latest.Message
+}
+
+// JobPodTemplateOptions are the scheduling settings of the pods of the jobs created by the operator
+type JobPodTemplateOptions struct {
+	NodeSelector      map[string]string
+	Affinity          *v1.Affinity
+	Tolerations       []v1.Toleration
+	PriorityClassName string
+}
+
+// MergeJobPodTemplateOptions returns the inherited options overridden by the set fields of the override
+func MergeJobPodTemplateOptions(inherited, override *JobPodTemplateOptions) *JobPodTemplateOptions {
+	res := &JobPodTemplateOptions{}
+	for _, opts := range []*JobPodTemplateOptions{inherited, override} {
+		if opts == nil {
+			continue
+		}
+		if len(opts.NodeSelector) > 0 {
+			res.NodeSelector = opts.NodeSelector
+		}
+		if opts.Affinity != nil {
+			res.Affinity = opts.Affinity
+		}
+		if len(opts.Tolerations) > 0 {
+			res.Tolerations = opts.Tolerations
+		}
+		if opts.PriorityClassName != "" {
+			res.PriorityClassName = opts.PriorityClassName
+		}
+	}
+	return res
+}
+
+// ApplyJobPodTemplateOptions sets the scheduling settings in the pod spec of a job.
+// Unset fields keep the settings of the pod spec, i.e. the ones inherited from the component
+func ApplyJobPodTemplateOptions(podSpec *v1.PodSpec, opts *JobPodTemplateOptions) {
+	if opts == nil {
+		return
+	}
+	merged := MergeJobPodTemplateOptions(&JobPodTemplateOptions{
+		NodeSelector:      podSpec.NodeSelector,
+		Affinity:          podSpec.Affinity,
+		Tolerations:       podSpec.Tolerations,
+		PriorityClassName: podSpec.PriorityClassName,
+	}, opts)
+	podSpec.NodeSelector = merged.NodeSelector
+	podSpec.Affinity = merged.Affinity
+	podSpec.Tolerations = merged.Tolerations
+	podSpec.PriorityClassName = merged.PriorityClassName
+}
+
+// JobWithPodTemplateOptions applies the scheduling settings to the pod template of the job
+func JobWithPodTemplateOptions(job *batchv1.Job, opts *JobPodTemplateOptions) *batchv1.Job {
+	ApplyJobPodTemplateOptions(&job.Spec.Template.Spec, opts)
+	return job
+}
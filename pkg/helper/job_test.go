@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func terminatedTestPod(message string, finishedAt time.Time) v1.Pod {
+	return v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "job",
+					State: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{Message: message, FinishedAt: metav1.NewTime(finishedAt)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPodsTerminationMessage(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	running := v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "job", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+			},
+		},
+	}
+
+	cases := []struct {
+		testName string
+		pods     []v1.Pod
+		expected string
+	}{
+		{"NoPods", nil, ""},
+		{"NotTerminated", []v1.Pod{running}, ""},
+		{"Terminated", []v1.Pod{running, terminatedTestPod("artifact secrets: checksum mismatch", now)}, "artifact secrets: checksum mismatch"},
+		{"LatestTerminated", []v1.Pod{
+			terminatedTestPod("latest", now),
+			terminatedTestPod("previous", now.Add(-time.Minute)),
+		}, "latest"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			if res := PodsTerminationMessage(tc.pods); res != tc.expected {
+				subT.Errorf("expected '%s', got '%s'", tc.expected, res)
+			}
+		})
+	}
+}
+
+func TestApplyJobPodTemplateOptions(t *testing.T) {
+	inheritedTolerations := []v1.Toleration{{Key: "component", Operator: v1.TolerationOpExists}}
+	overrideTolerations := []v1.Toleration{{Key: "jobs", Operator: v1.TolerationOpExists}}
+	affinity := &v1.Affinity{NodeAffinity: &v1.NodeAffinity{}}
+
+	podSpec := &v1.PodSpec{Tolerations: inheritedTolerations, PriorityClassName: "component"}
+	ApplyJobPodTemplateOptions(podSpec, nil)
+	if !reflect.DeepEqual(podSpec.Tolerations, inheritedTolerations) || podSpec.PriorityClassName != "component" {
+		t.Fatalf("unexpected pod spec without options: %v", podSpec)
+	}
+
+	ApplyJobPodTemplateOptions(podSpec, &JobPodTemplateOptions{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		Affinity:     affinity,
+	})
+	if !reflect.DeepEqual(podSpec.Tolerations, inheritedTolerations) || podSpec.PriorityClassName != "component" {
+		t.Errorf("expected the unset fields to keep the inherited settings: %v", podSpec)
+	}
+	if podSpec.Affinity != affinity || podSpec.NodeSelector["node-role.kubernetes.io/infra"] != "" || len(podSpec.NodeSelector) != 1 {
+		t.Errorf("expected the set fields to be applied: %v", podSpec)
+	}
+
+	ApplyJobPodTemplateOptions(podSpec, &JobPodTemplateOptions{Tolerations: overrideTolerations, PriorityClassName: "jobs"})
+	if !reflect.DeepEqual(podSpec.Tolerations, overrideTolerations) || podSpec.PriorityClassName != "jobs" {
+		t.Errorf("expected the override to take precedence: %v", podSpec)
+	}
+}
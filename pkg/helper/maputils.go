@@ -2,6 +2,18 @@ package helper
 
 import "sort"
 
+// MergeMapsStringString returns a new map holding all the entries.
+// On duplicated keys, the value of the last map wins.
+func MergeMapsStringString(maps ...map[string]string) map[string]string {
+	result := map[string]string{}
+	for _, m := range maps {
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 func SortedMapStringStringValues(input map[string]string) []string {
 	var sortedValues []string
 	for _, v := range input {
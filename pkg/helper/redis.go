@@ -0,0 +1,119 @@
+package helper
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RedisListsLength returns the sum of the length of the given redis lists.
+// Only redis:// and rediss:// URLs are supported, sentinel setups are not.
+func RedisListsLength(redisURL string, keys []string, timeout time.Duration) (int64, error) {
+	redisURLParts, err := ParseRedisURL(redisURL)
+	if err != nil {
+		return 0, err
+	}
+	u, err := url.Parse(redisURL)
+	if err != nil {
+		return 0, err
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "6379")
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	if redisURLParts.TLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", host, &tls.Config{ServerName: u.Hostname()})
+	} else {
+		conn, err = dialer.Dial("tcp", host)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return 0, err
+	}
+
+	reader := bufio.NewReader(conn)
+	command := func(args ...string) (string, error) {
+		_, err := conn.Write(redisCommand(args...))
+		if err != nil {
+			return "", err
+		}
+		return redisReply(reader)
+	}
+
+	// Redis 6 ACL users authenticate with both the username and the password
+	if redisURLParts.Username != "" {
+		if _, err := command("AUTH", redisURLParts.Username, redisURLParts.Password); err != nil {
+			return 0, err
+		}
+	} else if redisURLParts.HasPassword {
+		if _, err := command("AUTH", redisURLParts.Password); err != nil {
+			return 0, err
+		}
+	}
+
+	if redisURLParts.Database != "" {
+		if _, err := command("SELECT", redisURLParts.Database); err != nil {
+			return 0, err
+		}
+	}
+
+	var total int64
+	for _, key := range keys {
+		reply, err := command("LLEN", key)
+		if err != nil {
+			return 0, err
+		}
+		length, err := strconv.ParseInt(reply, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unexpected LLEN reply '%s': %w", reply, err)
+		}
+		total += length
+	}
+
+	return total, nil
+}
+
+// redisCommand encodes the command as a RESP array of bulk strings
+func redisCommand(args ...string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	return []byte(b.String())
+}
+
+// redisReply reads a simple string, error or integer RESP reply
+func redisReply(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return "", fmt.Errorf("empty redis reply")
+	}
+
+	switch line[0] {
+	case '+', ':':
+		return line[1:], nil
+	case '-':
+		return "", fmt.Errorf("redis error: %s", line[1:])
+	default:
+		return "", fmt.Errorf("unexpected redis reply '%s'", line)
+	}
+}
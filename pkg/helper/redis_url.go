@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RedisURL holds the parts of a redis URL checked by the operator
+type RedisURL struct {
+	// TLS is true for rediss:// URLs
+	TLS bool
+	// Username is the ACL user of redis 6, empty for the legacy password only URLs
+	Username    string
+	Password    string
+	HasPassword bool
+	// Host is the redis host, or the name of the master group of the sentinel setups
+	Host string
+	// Database is empty when the URL does not set it, the database 0 is used then
+	Database string
+}
+
+// ParseRedisURL parses and validates redis://[[user]:password@]host[:port][/db]
+// and rediss:// URLs. The user and password are percent-decoded. The userinfo
+// without password of the legacy URLs, i.e. redis://password@host, is the password
+func ParseRedisURL(rawURL string) (*RedisURL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RedisURL{}
+	switch u.Scheme {
+	case "redis":
+	case "rediss":
+		result.TLS = true
+	default:
+		return nil, fmt.Errorf("unsupported redis URL scheme '%s'", u.Scheme)
+	}
+
+	result.Host = u.Hostname()
+	if result.Host == "" {
+		return nil, fmt.Errorf("missing redis host")
+	}
+
+	if port := u.Port(); port != "" {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil || portNumber < 1 || portNumber > 65535 {
+			return nil, fmt.Errorf("invalid port '%s'", port)
+		}
+	}
+
+	if u.User != nil {
+		result.Password, result.HasPassword = u.User.Password()
+		if result.HasPassword {
+			result.Username = u.User.Username()
+		} else {
+			result.Password, result.HasPassword = u.User.Username(), true
+		}
+	}
+
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if dbNumber, err := strconv.Atoi(db); err != nil || dbNumber < 0 {
+			return nil, fmt.Errorf("invalid database '%s'", db)
+		}
+		result.Database = db
+	}
+
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package helper
+
+import (
+	"context"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretSnapshotClient is a client reading each secret once. The secrets read,
+// and the secrets not found, are served from memory until they are written
+// through the client. Any other object is read from the wrapped client.
+//
+// The snapshot is meant to live for one reconcile: all the secret sources
+// built on top of it share the same secret reads, and nothing is kept
+// between reconciles.
+type SecretSnapshotClient struct {
+	k8sclient.Client
+	mutex   sync.Mutex
+	secrets map[types.NamespacedName]SecretCacheElement
+}
+
+// blank assignment to verify that SecretSnapshotClient implements client.Client
+var _ k8sclient.Client = &SecretSnapshotClient{}
+
+func NewSecretSnapshotClient(client k8sclient.Client) *SecretSnapshotClient {
+	return &SecretSnapshotClient{
+		Client:  client,
+		secrets: map[types.NamespacedName]SecretCacheElement{},
+	}
+}
+
+func (c *SecretSnapshotClient) Get(ctx context.Context, key k8sclient.ObjectKey, obj runtime.Object) error {
+	secret, ok := obj.(*v1.Secret)
+	if !ok {
+		return c.Client.Get(ctx, key, obj)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.secrets[key]
+	if !ok {
+		element.Err = c.Client.Get(ctx, key, secret)
+		if element.Err != nil && !errors.IsNotFound(element.Err) {
+			// Transient errors are not kept, next read retries
+			return element.Err
+		}
+		if element.Err == nil {
+			element.Secret = secret.DeepCopy()
+		}
+		c.secrets[key] = element
+		return element.Err
+	}
+
+	if element.Err != nil {
+		return element.Err
+	}
+	element.Secret.DeepCopyInto(secret)
+	return nil
+}
+
+func (c *SecretSnapshotClient) Create(ctx context.Context, obj runtime.Object, opts ...k8sclient.CreateOption) error {
+	c.invalidate(obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *SecretSnapshotClient) Update(ctx context.Context, obj runtime.Object, opts ...k8sclient.UpdateOption) error {
+	c.invalidate(obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *SecretSnapshotClient) Patch(ctx context.Context, obj runtime.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
+	c.invalidate(obj)
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *SecretSnapshotClient) Delete(ctx context.Context, obj runtime.Object, opts ...k8sclient.DeleteOption) error {
+	c.invalidate(obj)
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+func (c *SecretSnapshotClient) DeleteAllOf(ctx context.Context, obj runtime.Object, opts ...k8sclient.DeleteAllOfOption) error {
+	if _, ok := obj.(*v1.Secret); ok {
+		c.mutex.Lock()
+		c.secrets = map[types.NamespacedName]SecretCacheElement{}
+		c.mutex.Unlock()
+	}
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+// invalidate drops the secret from the snapshot, so the next read
+// gets the state written
+func (c *SecretSnapshotClient) invalidate(obj runtime.Object) {
+	secret, ok := obj.(*v1.Secret)
+	if !ok {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.secrets, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace})
+}
@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// getCountingClient counts the reads done against the wrapped client
+type getCountingClient struct {
+	client.Client
+	gets int
+}
+
+func (c *getCountingClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	c.gets++
+	return c.Client.Get(ctx, key, obj)
+}
+
+func TestSecretSnapshotClient(t *testing.T) {
+	namespace := "someNS"
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-secret", Namespace: namespace},
+		Data:       map[string][]byte{"user": []byte("someUser")},
+	}
+
+	t.Run("ReadOnce", func(subT *testing.T) {
+		counting := &getCountingClient{Client: fake.NewFakeClient(secret)}
+		snapshot := NewSecretSnapshotClient(counting)
+
+		// Each options provider builds its own secret source
+		for i := 0; i < 3; i++ {
+			source := NewSecretSource(snapshot, namespace)
+			value, err := source.FieldValue("some-secret", "user", "default")
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if value != "someUser" {
+				subT.Errorf("unexpected value: %s", value)
+			}
+			value, err = source.FieldValue("missing-secret", "user", "default")
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if value != "default" {
+				subT.Errorf("unexpected value: %s", value)
+			}
+			_, err = source.RequiredFieldValueFromRequiredSecret("missing-secret", "user")
+			if !errors.IsNotFound(err) {
+				subT.Errorf("expected not found error, got %v", err)
+			}
+			_, err = source.RequiredFieldValueFromRequiredSecret("some-secret", "password")
+			if err == nil {
+				subT.Error("expected required field error")
+			}
+		}
+
+		if counting.gets != 2 {
+			subT.Errorf("expected 2 secret reads, got %d", counting.gets)
+		}
+	})
+
+	t.Run("ReadCopy", func(subT *testing.T) {
+		snapshot := NewSecretSnapshotClient(fake.NewFakeClient(secret))
+		key := types.NamespacedName{Name: "some-secret", Namespace: namespace}
+
+		read := &v1.Secret{}
+		if err := snapshot.Get(context.TODO(), key, read); err != nil {
+			subT.Fatal(err)
+		}
+		read.Data["user"] = []byte("modified")
+
+		read = &v1.Secret{}
+		if err := snapshot.Get(context.TODO(), key, read); err != nil {
+			subT.Fatal(err)
+		}
+		if string(read.Data["user"]) != "someUser" {
+			subT.Errorf("unexpected value: %s", read.Data["user"])
+		}
+	})
+
+	t.Run("WriteInvalidates", func(subT *testing.T) {
+		counting := &getCountingClient{Client: fake.NewFakeClient()}
+		snapshot := NewSecretSnapshotClient(counting)
+
+		value, err := NewSecretSource(snapshot, namespace).FieldValue("some-secret", "user", "default")
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if value != "default" {
+			subT.Errorf("unexpected value: %s", value)
+		}
+
+		if err := snapshot.Create(context.TODO(), secret.DeepCopy()); err != nil {
+			subT.Fatal(err)
+		}
+
+		value, err = NewSecretSource(snapshot, namespace).FieldValue("some-secret", "user", "default")
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if value != "someUser" {
+			subT.Errorf("expected the created secret, got %s", value)
+		}
+		if counting.gets != 2 {
+			subT.Errorf("expected 2 secret reads, got %d", counting.gets)
+		}
+	})
+}
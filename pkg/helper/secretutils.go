@@ -3,6 +3,7 @@ package helper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -78,11 +79,38 @@ func MergeSecretData(from, to map[string][]byte) map[string][]byte {
 	return result
 }
 
+// SecretResetFieldAnnotation lists, comma separated, the operator generated fields
+// of the secret to be generated again on the next reconcile
+const SecretResetFieldAnnotation = "apps.3scale.net/reset-field"
+
+// SecretResetFields returns the fields named in the reset annotation of the secret
+func SecretResetFields(secret *v1.Secret) map[string]bool {
+	fields := map[string]bool{}
+	if secret == nil {
+		return fields
+	}
+	for _, field := range strings.Split(secret.Annotations[SecretResetFieldAnnotation], ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields[field] = true
+		}
+	}
+	return fields
+}
+
 type SecretCacheElement struct {
 	Secret *v1.Secret
 	Err    error
 }
 
+// SecretSource reads the fields of the secrets the options are built from.
+//
+// All the options providers read through it, so the operator managed secrets
+// follow the same update policy:
+//   - FieldValue fields are operator generated: the default is only used, and
+//     written to the secret, when the field does not exist. Existing values,
+//     including user edits, are never overwritten, unless the field is named in
+//     the SecretResetFieldAnnotation of the secret, then the default is used again.
+//   - Fields of required secrets are user provided and always read as they are.
 type SecretSource struct {
 	client      k8sclient.Client
 	namespace   string
@@ -122,6 +150,10 @@ func (s *SecretSource) fieldReader(secretName, fieldName string, secretRequired,
 	}
 	// when secret is not found, it behaves like an empty secret
 	result := GetSecretDataValue(secret.Data, fieldName)
+	if !secretRequired && !fieldRequired && SecretResetFields(secret)[fieldName] {
+		// operator generated field to be generated again
+		result = nil
+	}
 	if fieldRequired && result == nil {
 		return "", fmt.Errorf("Secret field '%s' is required in secret '%s'", fieldName, secretName)
 	}
@@ -133,6 +165,21 @@ func (s *SecretSource) fieldReader(secretName, fieldName string, secretRequired,
 	return *result, nil
 }
 
+// ReadSecretVersions returns the resource version of each secret read
+// through the source, empty for the secrets not found
+func (s *SecretSource) ReadSecretVersions() map[string]string {
+	versions := map[string]string{}
+	for name, element := range s.secretCache.store {
+		secretElement, ok := element.(SecretCacheElement)
+		if !ok || secretElement.Err != nil || secretElement.Secret == nil {
+			versions[name] = ""
+			continue
+		}
+		versions[name] = secretElement.Secret.ResourceVersion
+	}
+	return versions
+}
+
 func (s *SecretSource) CachedSecret(secretName string) (*v1.Secret, error) {
 	var secret *v1.Secret
 	secretElementI, err := s.secretCache.Get(secretName)
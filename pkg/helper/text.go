@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeText returns the text as stored by 3scale: surrounding whitespace trimmed,
+// CRLF line breaks converted to LF and unicode in NFC normalization form.
+// Comparing normalized texts avoids updating the same value on every reconcile
+// when 3scale returns it in a different but equivalent form
+func NormalizeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return norm.NFC.String(strings.TrimSpace(text))
+}
+
+// TextEquals tells whether both texts are equal once normalized
+func TextEquals(a, b string) bool {
+	return NormalizeText(a) == NormalizeText(b)
+}
+
+// TextLength returns the number of characters of the normalized text,
+// as counted by 3scale length validations
+func TextLength(text string) int {
+	return utf8.RuneCountInString(NormalizeText(text))
+}
@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"testing"
+)
+
+func TestTextEquals(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"ascii", "Hits", "Hits", true},
+		{"different", "Hits", "Calls", false},
+		{"emoji", "Rocket 🚀 calls", "Rocket 🚀 calls", true},
+		{"cjk", "请求次数", "请求次数", true},
+		{"cjkDifferent", "请求次数", "请求数", false},
+		// "é" precomposed (NFC) and decomposed (NFD)
+		{"composedAndDecomposed", "caf\u00e9", "cafe\u0301", true},
+		{"surroundingWhitespace", "  日本語の説明\n", "日本語の説明", true},
+		{"lineBreaks", "first\r\nsecond", "first\nsecond", true},
+		{"innerWhitespace", "a b", "a  b", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			if got := TextEquals(tc.a, tc.b); got != tc.expected {
+				subT.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestTextLength(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected int
+	}{
+		{"Hits", 4},
+		{"🚀🚀", 2},
+		{"请求次数", 4},
+		{"cafe\u0301", 4},
+		{"  ", 0},
+	}
+
+	for _, tc := range cases {
+		if got := TextLength(tc.text); got != tc.expected {
+			t.Errorf("%q: expected length %d, got %d", tc.text, tc.expected, got)
+		}
+	}
+}
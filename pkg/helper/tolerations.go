@@ -0,0 +1,46 @@
+package helper
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	TaintNodeNotReady    = "node.kubernetes.io/not-ready"
+	TaintNodeUnreachable = "node.kubernetes.io/unreachable"
+)
+
+// WithUnreachableTolerations returns the tolerations followed by the not-ready and unreachable
+// NoExecute tolerations for the given seconds. The tolerations provided already tolerating
+// one of the taints take precedence. The tolerations are returned unchanged when seconds is nil
+func WithUnreachableTolerations(tolerations []corev1.Toleration, seconds *int64) []corev1.Toleration {
+	if seconds == nil {
+		return tolerations
+	}
+
+	result := make([]corev1.Toleration, 0, len(tolerations)+2)
+	result = append(result, tolerations...)
+
+	for _, taintKey := range []string{TaintNodeNotReady, TaintNodeUnreachable} {
+		if toleratesNoExecuteTaint(tolerations, taintKey) {
+			continue
+		}
+		result = append(result, corev1.Toleration{
+			Key:               taintKey,
+			Operator:          corev1.TolerationOpExists,
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: &[]int64{*seconds}[0],
+		})
+	}
+
+	return result
+}
+
+func toleratesNoExecuteTaint(tolerations []corev1.Toleration, taintKey string) bool {
+	taint := &corev1.Taint{Key: taintKey, Effect: corev1.TaintEffectNoExecute}
+	for idx := range tolerations {
+		if tolerations[idx].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWithUnreachableTolerations(t *testing.T) {
+	seconds := int64(60)
+	userSeconds := int64(600)
+
+	notReady := corev1.Toleration{Key: TaintNodeNotReady, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &seconds}
+	unreachable := corev1.Toleration{Key: TaintNodeUnreachable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &seconds}
+	dedicated := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "3scale", Effect: corev1.TaintEffectNoSchedule}
+	userUnreachable := corev1.Toleration{Key: TaintNodeUnreachable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &userSeconds}
+	userUnreachableNoSchedule := corev1.Toleration{Key: TaintNodeUnreachable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	userAnyEffect := corev1.Toleration{Key: TaintNodeNotReady, Operator: corev1.TolerationOpExists}
+	userAll := corev1.Toleration{Operator: corev1.TolerationOpExists}
+
+	cases := []struct {
+		name     string
+		input    []corev1.Toleration
+		seconds  *int64
+		expected []corev1.Toleration
+	}{
+		{"not set", []corev1.Toleration{dedicated}, nil, []corev1.Toleration{dedicated}},
+		{"not set without tolerations", nil, nil, nil},
+		{"without tolerations", nil, &seconds, []corev1.Toleration{notReady, unreachable}},
+		{"user tolerations first", []corev1.Toleration{dedicated}, &seconds, []corev1.Toleration{dedicated, notReady, unreachable}},
+		{"user unreachable toleration", []corev1.Toleration{userUnreachable, dedicated}, &seconds, []corev1.Toleration{userUnreachable, dedicated, notReady}},
+		{"user toleration with other effect", []corev1.Toleration{userUnreachableNoSchedule}, &seconds, []corev1.Toleration{userUnreachableNoSchedule, notReady, unreachable}},
+		{"user toleration for any effect", []corev1.Toleration{userAnyEffect}, &seconds, []corev1.Toleration{userAnyEffect, unreachable}},
+		{"user toleration for all taints", []corev1.Toleration{userAll}, &seconds, []corev1.Toleration{userAll}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			result := WithUnreachableTolerations(tc.input, tc.seconds)
+			if !reflect.DeepEqual(result, tc.expected) {
+				subT.Errorf("diff %s", cmp.Diff(result, tc.expected))
+			}
+		})
+	}
+
+	t.Run("input not modified", func(subT *testing.T) {
+		input := make([]corev1.Toleration, 1, 10)
+		input[0] = dedicated
+		WithUnreachableTolerations(input, &seconds)
+		if extended := input[:2]; !reflect.DeepEqual(extended[1], corev1.Toleration{}) {
+			subT.Errorf("input backing array modified: %v", extended)
+		}
+	})
+}
@@ -22,3 +22,11 @@ func VolumeFromSecretEqual(a v1.Volume, b v1.Volume) bool {
 
 	return a.Name == b.Name && a.Secret.SecretName == b.Secret.SecretName
 }
+
+func VolumeFromConfigMapEqual(a v1.Volume, b v1.Volume) bool {
+	if a.ConfigMap == nil || b.ConfigMap == nil {
+		return false
+	}
+
+	return a.Name == b.Name && a.ConfigMap.Name == b.ConfigMap.Name
+}
@@ -67,6 +67,14 @@ func (b *BaseReconciler) Client() client.Client {
 	return b.client
 }
 
+// WithClient returns a copy of the reconciler using the given client
+// for reads and writes
+func (b *BaseReconciler) WithClient(cl client.Client) *BaseReconciler {
+	copied := *b
+	copied.client = cl
+	return &copied
+}
+
 // APIClientReader return a client that directly reads objects
 // from the Kubernetes APIServer
 func (b *BaseReconciler) APIClientReader() client.Reader {
@@ -93,6 +101,10 @@ func (b *BaseReconciler) EventRecorder() record.EventRecorder {
 	return b.recorder
 }
 
+func (b *BaseReconciler) StatusWriter() *StatusWriter {
+	return NewStatusWriter(b.ctx, b.Client(), b.Logger())
+}
+
 // ReconcileResource attempts to mutate the existing state
 // in order to match the desired state. The object's desired state must be reconciled
 // with the existing state inside the passed in callback MutateFn.
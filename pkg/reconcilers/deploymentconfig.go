@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "github.com/openshift/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -44,15 +45,23 @@ func DeploymentConfigMutator(opts ...DCMutateFn) MutateFn {
 }
 
 // GenericZyncMutators returns the generic mutators for zync components
-func GenericZyncMutators() MutateFn {
-	return DeploymentConfigMutator(
+func GenericZyncMutators() []DCMutateFn {
+	return []DCMutateFn{
 		DeploymentConfigImageChangeTriggerMutator,
-		DeploymentConfigReplicasMutator,
 		DeploymentConfigContainerResourcesMutator,
 		DeploymentConfigAffinityMutator,
+		DeploymentConfigNodeSelectorMutator,
 		DeploymentConfigTolerationsMutator,
+		DeploymentConfigSecurityContextMutator,
+		DeploymentConfigTopologySpreadConstraintsMutator,
+		DeploymentConfigPriorityClassMutator,
+		DeploymentConfigRuntimeClassMutator,
+		DeploymentConfigDNSMutator,
+		DeploymentConfigHostAliasesMutator,
+		DeploymentConfigImagePullSecretsMutator,
 		DeploymentConfigPodTemplateLabelsMutator,
-	)
+		DeploymentConfigPodTemplateAnnotationsMutator,
+	}
 }
 
 // GenericBackendMutators returns the generic mutators for backend
@@ -61,8 +70,17 @@ func GenericBackendMutators() []DCMutateFn {
 		DeploymentConfigImageChangeTriggerMutator,
 		DeploymentConfigContainerResourcesMutator,
 		DeploymentConfigAffinityMutator,
+		DeploymentConfigNodeSelectorMutator,
 		DeploymentConfigTolerationsMutator,
+		DeploymentConfigSecurityContextMutator,
+		DeploymentConfigTopologySpreadConstraintsMutator,
+		DeploymentConfigPriorityClassMutator,
+		DeploymentConfigRuntimeClassMutator,
+		DeploymentConfigDNSMutator,
+		DeploymentConfigHostAliasesMutator,
+		DeploymentConfigImagePullSecretsMutator,
 		DeploymentConfigPodTemplateLabelsMutator,
+		DeploymentConfigPodTemplateAnnotationsMutator,
 	}
 }
 
@@ -90,6 +108,19 @@ func DeploymentConfigAffinityMutator(desired, existing *appsv1.DeploymentConfig)
 	return updated, nil
 }
 
+func DeploymentConfigNodeSelectorMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	if !reflect.DeepEqual(existing.Spec.Template.Spec.NodeSelector, desired.Spec.Template.Spec.NodeSelector) {
+		diff := cmp.Diff(existing.Spec.Template.Spec.NodeSelector, desired.Spec.Template.Spec.NodeSelector)
+		log.Info(fmt.Sprintf("%s spec.template.spec.NodeSelector has changed: %s", common.ObjectInfo(desired), diff))
+		existing.Spec.Template.Spec.NodeSelector = desired.Spec.Template.Spec.NodeSelector
+		updated = true
+	}
+
+	return updated, nil
+}
+
 func DeploymentConfigTolerationsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
 	updated := false
 
@@ -103,6 +134,178 @@ func DeploymentConfigTolerationsMutator(desired, existing *appsv1.DeploymentConf
 	return updated, nil
 }
 
+func DeploymentConfigTopologySpreadConstraintsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	if !reflect.DeepEqual(existing.Spec.Template.Spec.TopologySpreadConstraints, desired.Spec.Template.Spec.TopologySpreadConstraints) {
+		diff := cmp.Diff(existing.Spec.Template.Spec.TopologySpreadConstraints, desired.Spec.Template.Spec.TopologySpreadConstraints)
+		log.Info(fmt.Sprintf("%s spec.template.spec.TopologySpreadConstraints has changed: %s", common.ObjectInfo(desired), diff))
+		existing.Spec.Template.Spec.TopologySpreadConstraints = desired.Spec.Template.Spec.TopologySpreadConstraints
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigPriorityClassMutator reconciles the priority class of the pod template
+func DeploymentConfigPriorityClassMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	if existing.Spec.Template.Spec.PriorityClassName != desired.Spec.Template.Spec.PriorityClassName {
+		log.Info(fmt.Sprintf("%s spec.template.spec.PriorityClassName has changed from '%s' to '%s'", common.ObjectInfo(desired),
+			existing.Spec.Template.Spec.PriorityClassName, desired.Spec.Template.Spec.PriorityClassName))
+		existing.Spec.Template.Spec.PriorityClassName = desired.Spec.Template.Spec.PriorityClassName
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigRuntimeClassMutator reconciles the runtime class of the pod template
+func DeploymentConfigRuntimeClassMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	existingRuntimeClassName := helper.GetStringPointerValueOrDefault(existing.Spec.Template.Spec.RuntimeClassName, "")
+	desiredRuntimeClassName := helper.GetStringPointerValueOrDefault(desired.Spec.Template.Spec.RuntimeClassName, "")
+	if existingRuntimeClassName != desiredRuntimeClassName {
+		log.Info(fmt.Sprintf("%s spec.template.spec.RuntimeClassName has changed from '%s' to '%s'", common.ObjectInfo(desired),
+			existingRuntimeClassName, desiredRuntimeClassName))
+		existing.Spec.Template.Spec.RuntimeClassName = desired.Spec.Template.Spec.RuntimeClassName
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigDNSMutator reconciles the DNS policy and the DNS config of the pod template.
+// The DNS policy is defaulted to ClusterFirst by the API server
+func DeploymentConfigDNSMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+	existingSpec := &existing.Spec.Template.Spec
+	desiredSpec := &desired.Spec.Template.Spec
+
+	desiredDNSPolicy := desiredSpec.DNSPolicy
+	if desiredDNSPolicy == "" {
+		desiredDNSPolicy = v1.DNSClusterFirst
+	}
+	existingDNSPolicy := existingSpec.DNSPolicy
+	if existingDNSPolicy == "" {
+		existingDNSPolicy = v1.DNSClusterFirst
+	}
+	if existingDNSPolicy != desiredDNSPolicy {
+		log.Info(fmt.Sprintf("%s spec.template.spec.DNSPolicy has changed from '%s' to '%s'", common.ObjectInfo(desired),
+			existingDNSPolicy, desiredDNSPolicy))
+		existingSpec.DNSPolicy = desiredSpec.DNSPolicy
+		updated = true
+	}
+
+	if !reflect.DeepEqual(existingSpec.DNSConfig, desiredSpec.DNSConfig) {
+		diff := cmp.Diff(existingSpec.DNSConfig, desiredSpec.DNSConfig)
+		log.Info(fmt.Sprintf("%s spec.template.spec.DNSConfig has changed: %s", common.ObjectInfo(desired), diff))
+		existingSpec.DNSConfig = desiredSpec.DNSConfig
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigHostAliasesMutator reconciles the host aliases of the pod template
+func DeploymentConfigHostAliasesMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	if !reflect.DeepEqual(existing.Spec.Template.Spec.HostAliases, desired.Spec.Template.Spec.HostAliases) {
+		diff := cmp.Diff(existing.Spec.Template.Spec.HostAliases, desired.Spec.Template.Spec.HostAliases)
+		log.Info(fmt.Sprintf("%s spec.template.spec.HostAliases has changed: %s", common.ObjectInfo(desired), diff))
+		existing.Spec.Template.Spec.HostAliases = desired.Spec.Template.Spec.HostAliases
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigImagePullSecretsMutator reconciles the image pull secrets of the pod template
+func DeploymentConfigImagePullSecretsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	if !reflect.DeepEqual(existing.Spec.Template.Spec.ImagePullSecrets, desired.Spec.Template.Spec.ImagePullSecrets) {
+		diff := cmp.Diff(existing.Spec.Template.Spec.ImagePullSecrets, desired.Spec.Template.Spec.ImagePullSecrets)
+		log.Info(fmt.Sprintf("%s spec.template.spec.ImagePullSecrets has changed: %s", common.ObjectInfo(desired), diff))
+		existing.Spec.Template.Spec.ImagePullSecrets = desired.Spec.Template.Spec.ImagePullSecrets
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// DeploymentConfigServiceAccountNameMutator reconciles the service account of the pod template.
+// The deprecated serviceAccount field is kept in sync as it is defaulted from the existing name
+func DeploymentConfigServiceAccountNameMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	desiredName := desired.Spec.Template.Spec.ServiceAccountName
+	if desiredName == "" || existing.Spec.Template.Spec.ServiceAccountName == desiredName {
+		return false, nil
+	}
+
+	log.Info(fmt.Sprintf("%s spec.template.spec.ServiceAccountName has changed from %s to %s", common.ObjectInfo(desired), existing.Spec.Template.Spec.ServiceAccountName, desiredName))
+	existing.Spec.Template.Spec.ServiceAccountName = desiredName
+	existing.Spec.Template.Spec.DeprecatedServiceAccount = desiredName
+	return true, nil
+}
+
+// DeploymentConfigSecurityContextMutator reconciles the security contexts of the pod template
+// and of its containers and init containers. Containers are matched by name
+func DeploymentConfigSecurityContextMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+	existingSpec := &existing.Spec.Template.Spec
+	desiredSpec := &desired.Spec.Template.Spec
+
+	// The pod security context is defaulted to an empty one by the API server
+	existingPodSecurityContext := existingSpec.SecurityContext
+	if existingPodSecurityContext == nil {
+		existingPodSecurityContext = &v1.PodSecurityContext{}
+	}
+	desiredPodSecurityContext := desiredSpec.SecurityContext
+	if desiredPodSecurityContext == nil {
+		desiredPodSecurityContext = &v1.PodSecurityContext{}
+	}
+
+	if !reflect.DeepEqual(existingPodSecurityContext, desiredPodSecurityContext) {
+		diff := cmp.Diff(existingPodSecurityContext, desiredPodSecurityContext)
+		log.Info(fmt.Sprintf("%s spec.template.spec.SecurityContext has changed: %s", common.ObjectInfo(desired), diff))
+		existingSpec.SecurityContext = desiredSpec.SecurityContext
+		updated = true
+	}
+
+	tmpUpdated := containersSecurityContextReconciler(desired, desiredSpec.InitContainers, existingSpec.InitContainers)
+	updated = updated || tmpUpdated
+	tmpUpdated = containersSecurityContextReconciler(desired, desiredSpec.Containers, existingSpec.Containers)
+	updated = updated || tmpUpdated
+
+	return updated, nil
+}
+
+func containersSecurityContextReconciler(desired *appsv1.DeploymentConfig, desiredContainers, existingContainers []v1.Container) bool {
+	updated := false
+
+	for desiredIdx := range desiredContainers {
+		desiredContainer := &desiredContainers[desiredIdx]
+		for existingIdx := range existingContainers {
+			existingContainer := &existingContainers[existingIdx]
+			if existingContainer.Name != desiredContainer.Name {
+				continue
+			}
+			if !reflect.DeepEqual(existingContainer.SecurityContext, desiredContainer.SecurityContext) {
+				diff := cmp.Diff(existingContainer.SecurityContext, desiredContainer.SecurityContext)
+				log.Info(fmt.Sprintf("%s container %s securityContext has changed: %s", common.ObjectInfo(desired), desiredContainer.Name, diff))
+				existingContainer.SecurityContext = desiredContainer.SecurityContext
+				updated = true
+			}
+			break
+		}
+	}
+
+	return updated
+}
+
 func DeploymentConfigContainerResourcesMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
 	desiredName := common.ObjectInfo(desired)
 	update := false
@@ -132,10 +335,84 @@ func DeploymentConfigContainerResourcesMutator(desired, existing *appsv1.Deploym
 // Updated when in desired and in existing but not equal
 // Removed when not in desired and exists in existing DC
 func DeploymentConfigEnvVarReconciler(desired, existing *appsv1.DeploymentConfig, envVar string) bool {
+	return containerEnvVarReconciler(&desired.Spec.Template.Spec.Containers[0], &existing.Spec.Template.Spec.Containers[0], envVar)
+}
+
+// DeploymentConfigContainersEnvVarReconciler reconciles the env var in every container of the pod template.
+// Desired and existing containers are matched by name
+func DeploymentConfigContainersEnvVarReconciler(desired, existing *appsv1.DeploymentConfig, envVar string) bool {
 	update := false
 
-	existingContainer := &existing.Spec.Template.Spec.Containers[0]
-	desiredContainer := desired.Spec.Template.Spec.Containers[0]
+	for desiredIdx := range desired.Spec.Template.Spec.Containers {
+		desiredContainer := &desired.Spec.Template.Spec.Containers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.Containers {
+			existingContainer := &existing.Spec.Template.Spec.Containers[existingIdx]
+			if existingContainer.Name == desiredContainer.Name {
+				tmpUpdate := containerEnvVarReconciler(desiredContainer, existingContainer, envVar)
+				update = update || tmpUpdate
+				break
+			}
+		}
+	}
+
+	return update
+}
+
+// DeploymentConfigContainersPortReconciler reconciles the named port in every container of the pod template.
+// Desired and existing containers are matched by name
+func DeploymentConfigContainersPortReconciler(desired, existing *appsv1.DeploymentConfig, portName string) bool {
+	update := false
+
+	for desiredIdx := range desired.Spec.Template.Spec.Containers {
+		desiredContainer := &desired.Spec.Template.Spec.Containers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.Containers {
+			existingContainer := &existing.Spec.Template.Spec.Containers[existingIdx]
+			if existingContainer.Name == desiredContainer.Name {
+				tmpUpdate := containerPortReconciler(desiredContainer, existingContainer, portName)
+				update = update || tmpUpdate
+				break
+			}
+		}
+	}
+
+	return update
+}
+
+func containerPortReconciler(desiredContainer, existingContainer *v1.Container, portName string) bool {
+	desiredIdx := findContainerPort(desiredContainer.Ports, portName)
+	existingIdx := findContainerPort(existingContainer.Ports, portName)
+
+	if desiredIdx < 0 && existingIdx >= 0 {
+		// port exists in existing and does not exist in desired => Remove from the list
+		existingContainer.Ports = append(existingContainer.Ports[:existingIdx], existingContainer.Ports[existingIdx+1:]...)
+		return true
+	}
+
+	if desiredIdx >= 0 && existingIdx < 0 {
+		// port exists in desired and does not exist in existing => Add to the list
+		existingContainer.Ports = append(existingContainer.Ports, desiredContainer.Ports[desiredIdx])
+		return true
+	}
+
+	if desiredIdx >= 0 && existingIdx >= 0 && !reflect.DeepEqual(existingContainer.Ports[existingIdx], desiredContainer.Ports[desiredIdx]) {
+		existingContainer.Ports[existingIdx] = desiredContainer.Ports[desiredIdx]
+		return true
+	}
+
+	return false
+}
+
+func findContainerPort(ports []v1.ContainerPort, portName string) int {
+	for idx := range ports {
+		if ports[idx].Name == portName {
+			return idx
+		}
+	}
+	return -1
+}
+
+func containerEnvVarReconciler(desiredContainer, existingContainer *v1.Container, envVar string) bool {
+	update := false
 
 	desiredIdx := helper.FindEnvVar(desiredContainer.Env, envVar)
 	existingIdx := helper.FindEnvVar(existingContainer.Env, envVar)
@@ -161,6 +438,111 @@ func DeploymentConfigEnvVarReconciler(desired, existing *appsv1.DeploymentConfig
 	return update
 }
 
+// DeploymentConfigVolumeReconciler implements basic volume reconcilliation for single container deployment configs.
+// Both the pod volume and the container volume mount named volumeName are reconciled.
+// Added when in desired and not in existing
+// Updated when in desired and in existing but not equal
+// Removed when not in desired and exists in existing DC
+func DeploymentConfigVolumeReconciler(desired, existing *appsv1.DeploymentConfig, volumeName string) bool {
+	update := podVolumeReconciler(&desired.Spec.Template.Spec, &existing.Spec.Template.Spec, volumeName)
+
+	tmpUpdate := containerVolumeMountReconciler(&desired.Spec.Template.Spec.Containers[0], &existing.Spec.Template.Spec.Containers[0], volumeName)
+	return update || tmpUpdate
+}
+
+// DeploymentConfigContainersVolumeReconciler reconciles the pod volume named volumeName
+// and its volume mount in every container of the pod template.
+// Desired and existing containers are matched by name
+func DeploymentConfigContainersVolumeReconciler(desired, existing *appsv1.DeploymentConfig, volumeName string) bool {
+	update := podVolumeReconciler(&desired.Spec.Template.Spec, &existing.Spec.Template.Spec, volumeName)
+
+	for desiredIdx := range desired.Spec.Template.Spec.Containers {
+		desiredContainer := &desired.Spec.Template.Spec.Containers[desiredIdx]
+		for existingIdx := range existing.Spec.Template.Spec.Containers {
+			existingContainer := &existing.Spec.Template.Spec.Containers[existingIdx]
+			if existingContainer.Name == desiredContainer.Name {
+				tmpUpdate := containerVolumeMountReconciler(desiredContainer, existingContainer, volumeName)
+				update = update || tmpUpdate
+				break
+			}
+		}
+	}
+
+	return update
+}
+
+// DeploymentConfigPreHookReconciler reconciles the env var envVar and the volume
+// volumeName of the pre lifecycle hook pod of the rolling strategy
+func DeploymentConfigPreHookReconciler(desired, existing *appsv1.DeploymentConfig, envVar, volumeName string) bool {
+	desiredHook := deploymentConfigPreHookPod(desired)
+	existingHook := deploymentConfigPreHookPod(existing)
+	if desiredHook == nil || existingHook == nil {
+		return false
+	}
+
+	desiredContainer := &v1.Container{Env: desiredHook.Env}
+	existingContainer := &v1.Container{Env: existingHook.Env}
+	update := false
+	if envVar != "" {
+		update = containerEnvVarReconciler(desiredContainer, existingContainer, envVar)
+		existingHook.Env = existingContainer.Env
+	}
+
+	if volumeName != "" {
+		desiredOk := helper.ArrayContains(desiredHook.Volumes, volumeName)
+		existingOk := helper.ArrayContains(existingHook.Volumes, volumeName)
+		if !desiredOk && existingOk {
+			existingHook.Volumes = helper.ArrayStringDifference(existingHook.Volumes, []string{volumeName})
+			update = true
+		} else if desiredOk && !existingOk {
+			existingHook.Volumes = append(existingHook.Volumes, volumeName)
+			update = true
+		}
+	}
+
+	return update
+}
+
+func deploymentConfigPreHookPod(dc *appsv1.DeploymentConfig) *appsv1.ExecNewPodHook {
+	rollingParams := dc.Spec.Strategy.RollingParams
+	if rollingParams == nil || rollingParams.Pre == nil {
+		return nil
+	}
+	return rollingParams.Pre.ExecNewPod
+}
+
+func podVolumeReconciler(desiredSpec, existingSpec *v1.PodSpec, volumeName string) bool {
+	desiredIdx := helper.FindVolumeByName(desiredSpec.Volumes, volumeName)
+	existingIdx := helper.FindVolumeByName(existingSpec.Volumes, volumeName)
+	if desiredIdx < 0 && existingIdx >= 0 {
+		existingSpec.Volumes = append(existingSpec.Volumes[:existingIdx], existingSpec.Volumes[existingIdx+1:]...)
+		return true
+	} else if desiredIdx >= 0 && existingIdx < 0 {
+		existingSpec.Volumes = append(existingSpec.Volumes, desiredSpec.Volumes[desiredIdx])
+		return true
+	} else if desiredIdx >= 0 && !reflect.DeepEqual(existingSpec.Volumes[existingIdx], desiredSpec.Volumes[desiredIdx]) {
+		existingSpec.Volumes[existingIdx] = desiredSpec.Volumes[desiredIdx]
+		return true
+	}
+	return false
+}
+
+func containerVolumeMountReconciler(desiredContainer, existingContainer *v1.Container, volumeName string) bool {
+	desiredIdx := helper.FindVolumeMountByName(desiredContainer.VolumeMounts, volumeName)
+	existingIdx := helper.FindVolumeMountByName(existingContainer.VolumeMounts, volumeName)
+	if desiredIdx < 0 && existingIdx >= 0 {
+		existingContainer.VolumeMounts = append(existingContainer.VolumeMounts[:existingIdx], existingContainer.VolumeMounts[existingIdx+1:]...)
+		return true
+	} else if desiredIdx >= 0 && existingIdx < 0 {
+		existingContainer.VolumeMounts = append(existingContainer.VolumeMounts, desiredContainer.VolumeMounts[desiredIdx])
+		return true
+	} else if desiredIdx >= 0 && !reflect.DeepEqual(existingContainer.VolumeMounts[existingIdx], desiredContainer.VolumeMounts[desiredIdx]) {
+		existingContainer.VolumeMounts[existingIdx] = desiredContainer.VolumeMounts[desiredIdx]
+		return true
+	}
+	return false
+}
+
 func findDeploymentTriggerOnImageChange(triggerPolicies []appsv1.DeploymentTriggerPolicy) (int, error) {
 	result := -1
 	for i := range triggerPolicies {
@@ -200,6 +582,29 @@ func DeploymentConfigImageChangeTriggerMutator(desired, existing *appsv1.Deploym
 	return false, nil
 }
 
+// DeploymentConfigImageChangeTriggerContainerNamesMutator ensures the containers
+// updated by the image change trigger are reconciled
+func DeploymentConfigImageChangeTriggerContainerNamesMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	desiredDeploymentTriggerImageChangePos, err := findDeploymentTriggerOnImageChange(desired.Spec.Triggers)
+	if err != nil {
+		return false, fmt.Errorf("unexpected: '%s' in DeploymentConfig '%s'", err, desired.Name)
+	}
+	existingDeploymentTriggerImageChangePos, err := findDeploymentTriggerOnImageChange(existing.Spec.Triggers)
+	if err != nil {
+		return false, fmt.Errorf("unexpected: '%s' in DeploymentConfig '%s'", err, existing.Name)
+	}
+
+	desiredDeploymentTriggerImageChangeParams := desired.Spec.Triggers[desiredDeploymentTriggerImageChangePos].ImageChangeParams
+	existingDeploymentTriggerImageChangeParams := existing.Spec.Triggers[existingDeploymentTriggerImageChangePos].ImageChangeParams
+
+	if !reflect.DeepEqual(existingDeploymentTriggerImageChangeParams.ContainerNames, desiredDeploymentTriggerImageChangeParams.ContainerNames) {
+		existingDeploymentTriggerImageChangeParams.ContainerNames = desiredDeploymentTriggerImageChangeParams.ContainerNames
+		return true, nil
+	}
+
+	return false, nil
+}
+
 // DeploymentConfigPodTemplateLabelsMutator ensures pod template labels are reconciled
 func DeploymentConfigPodTemplateLabelsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
 	updated := false
@@ -208,3 +613,96 @@ func DeploymentConfigPodTemplateLabelsMutator(desired, existing *appsv1.Deployme
 
 	return updated, nil
 }
+
+// DeploymentConfigPodTemplateAnnotationsMutator ensures pod template annotations are reconciled.
+// Annotations not in the desired pod template are kept
+func DeploymentConfigPodTemplateAnnotationsMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	updated := false
+
+	helper.MergeMapStringString(&updated, &existing.Spec.Template.Annotations, desired.Spec.Template.Annotations)
+
+	return updated, nil
+}
+
+// DeploymentConfigPodTemplateAnnotationReconciler reconciles a pod template annotation,
+// removing it when it is not desired
+func DeploymentConfigPodTemplateAnnotationReconciler(desired, existing *appsv1.DeploymentConfig, annotation string) bool {
+	desiredVal, desiredOk := desired.Spec.Template.Annotations[annotation]
+	existingVal, existingOk := existing.Spec.Template.Annotations[annotation]
+
+	if !desiredOk {
+		if existingOk {
+			delete(existing.Spec.Template.Annotations, annotation)
+			return true
+		}
+		return false
+	}
+
+	if existingOk && existingVal == desiredVal {
+		return false
+	}
+
+	if existing.Spec.Template.Annotations == nil {
+		existing.Spec.Template.Annotations = map[string]string{}
+	}
+	existing.Spec.Template.Annotations[annotation] = desiredVal
+	return true
+}
+
+// DeploymentConfigImagePullPolicyMutator reconciles the image pull policy of all the containers,
+// including the init containers. Desired and existing containers are matched by name
+func DeploymentConfigImagePullPolicyMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := containersImagePullPolicyReconciler(desired.Spec.Template.Spec.InitContainers, existing.Spec.Template.Spec.InitContainers)
+	tmpUpdate := containersImagePullPolicyReconciler(desired.Spec.Template.Spec.Containers, existing.Spec.Template.Spec.Containers)
+	return update || tmpUpdate, nil
+}
+
+func containersImagePullPolicyReconciler(desired, existing []v1.Container) bool {
+	update := false
+
+	for desiredIdx := range desired {
+		for existingIdx := range existing {
+			existingContainer := &existing[existingIdx]
+			if existingContainer.Name != desired[desiredIdx].Name {
+				continue
+			}
+			// Unset policy is defaulted by the API server depending on the image tag
+			if desired[desiredIdx].ImagePullPolicy != "" && existingContainer.ImagePullPolicy != desired[desiredIdx].ImagePullPolicy {
+				existingContainer.ImagePullPolicy = desired[desiredIdx].ImagePullPolicy
+				update = true
+			}
+			break
+		}
+	}
+
+	return update
+}
+
+// DeploymentConfigTerminationMessagePolicyMutator reconciles the termination message policy
+// of all the containers, including the init containers. Desired and existing containers are matched by name
+func DeploymentConfigTerminationMessagePolicyMutator(desired, existing *appsv1.DeploymentConfig) (bool, error) {
+	update := containersTerminationMessagePolicyReconciler(desired.Spec.Template.Spec.InitContainers, existing.Spec.Template.Spec.InitContainers)
+	tmpUpdate := containersTerminationMessagePolicyReconciler(desired.Spec.Template.Spec.Containers, existing.Spec.Template.Spec.Containers)
+	return update || tmpUpdate, nil
+}
+
+func containersTerminationMessagePolicyReconciler(desired, existing []v1.Container) bool {
+	update := false
+
+	for desiredIdx := range desired {
+		for existingIdx := range existing {
+			existingContainer := &existing[existingIdx]
+			if existingContainer.Name != desired[desiredIdx].Name {
+				continue
+			}
+			// Unset policy is defaulted to File by the API server
+			if desired[desiredIdx].TerminationMessagePolicy != "" && existingContainer.TerminationMessagePolicy != desired[desiredIdx].TerminationMessagePolicy {
+				existingContainer.TerminationMessagePolicy = desired[desiredIdx].TerminationMessagePolicy
+				update = true
+			}
+			break
+		}
+	}
+
+	return update
+}
@@ -65,6 +65,80 @@ func TestDeploymentConfigReplicasMutator(t *testing.T) {
 	}
 }
 
+func TestDeploymentConfigTerminationMessagePolicyMutator(t *testing.T) {
+	dcFactory := func(policy v1.TerminationMessagePolicy) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						InitContainers: []v1.Container{{Name: "init", TerminationMessagePolicy: policy}},
+						Containers:     []v1.Container{{Name: "main", TerminationMessagePolicy: policy}},
+					},
+				},
+			},
+		}
+	}
+
+	existing := dcFactory(v1.TerminationMessageReadFile)
+	update, err := DeploymentConfigTerminationMessagePolicyMutator(dcFactory(v1.TerminationMessageFallbackToLogsOnError), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Fatal("expected update")
+	}
+	for _, container := range append(existing.Spec.Template.Spec.InitContainers, existing.Spec.Template.Spec.Containers...) {
+		if container.TerminationMessagePolicy != v1.TerminationMessageFallbackToLogsOnError {
+			t.Errorf("container %s: policy not reconciled: %s", container.Name, container.TerminationMessagePolicy)
+		}
+	}
+
+	update, err = DeploymentConfigTerminationMessagePolicyMutator(dcFactory(v1.TerminationMessageFallbackToLogsOnError), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update {
+		t.Error("unexpected update")
+	}
+}
+
+func TestDeploymentConfigImagePullPolicyMutator(t *testing.T) {
+	dcFactory := func(policy v1.PullPolicy) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						InitContainers: []v1.Container{{Name: "init", ImagePullPolicy: policy}},
+						Containers:     []v1.Container{{Name: "main", ImagePullPolicy: policy}},
+					},
+				},
+			},
+		}
+	}
+
+	existing := dcFactory(v1.PullIfNotPresent)
+	update, err := DeploymentConfigImagePullPolicyMutator(dcFactory(v1.PullAlways), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Fatal("expected update")
+	}
+	for _, container := range append(existing.Spec.Template.Spec.InitContainers, existing.Spec.Template.Spec.Containers...) {
+		if container.ImagePullPolicy != v1.PullAlways {
+			t.Errorf("container %s: policy not reconciled: %s", container.Name, container.ImagePullPolicy)
+		}
+	}
+
+	update, err = DeploymentConfigImagePullPolicyMutator(dcFactory(v1.PullAlways), existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update {
+		t.Error("unexpected update")
+	}
+}
+
 func TestDeploymentConfigContainerResourcesMutator(t *testing.T) {
 	emptyResourceRequirements := corev1.ResourceRequirements{
 		Limits:   corev1.ResourceList{},
@@ -219,6 +293,58 @@ func TestDeploymentConfigAffinityMutator(t *testing.T) {
 	}
 }
 
+func TestDeploymentConfigNodeSelectorMutator(t *testing.T) {
+	dcFactory := func(nodeSelector map[string]string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						NodeSelector: nodeSelector,
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		testName             string
+		existingNodeSelector map[string]string
+		desiredNodeSelector  map[string]string
+		expectedResult       bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"EqualNodeSelectors", map[string]string{"node-role": "infra"}, map[string]string{"node-role": "infra"}, false},
+		{"DifferentNodeSelectors", map[string]string{"node-role": "infra"}, map[string]string{"node-role": "worker"}, true},
+		{"NodeSelectorAdded", nil, map[string]string{"node-role": "infra"}, true},
+		{"NodeSelectorRemoved", map[string]string{"node-role": "infra"}, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingNodeSelector)
+			desired := dcFactory(tc.desiredNodeSelector)
+			update, err := DeploymentConfigNodeSelectorMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.NodeSelector, desired.Spec.Template.Spec.NodeSelector) {
+				subT.Fatal(cmp.Diff(existing.Spec.Template.Spec.NodeSelector, desired.Spec.Template.Spec.NodeSelector))
+			}
+		})
+	}
+}
+
 func TestDeploymentConfigTolerationsMutator(t *testing.T) {
 	testTolerations1 := []corev1.Toleration{
 		corev1.Toleration{
@@ -298,6 +424,466 @@ func TestDeploymentConfigTolerationsMutator(t *testing.T) {
 
 }
 
+func TestDeploymentConfigTopologySpreadConstraintsMutator(t *testing.T) {
+	zoneConstraints := []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"deploymentConfig": "myDC"},
+			},
+		},
+	}
+	hostnameConstraints := []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           2,
+			TopologyKey:       "kubernetes.io/hostname",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"deploymentConfig": "myDC"},
+			},
+		},
+	}
+	dcFactory := func(constraints []corev1.TopologySpreadConstraint) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						TopologySpreadConstraints: constraints,
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		testName            string
+		existingConstraints []corev1.TopologySpreadConstraint
+		desiredConstraints  []corev1.TopologySpreadConstraint
+		expectedResult      bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"EqualConstraints", zoneConstraints, zoneConstraints, false},
+		{"DifferentConstraints", zoneConstraints, hostnameConstraints, true},
+		{"ConstraintsAdded", nil, zoneConstraints, true},
+		{"ConstraintsRemoved", zoneConstraints, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingConstraints)
+			desired := dcFactory(tc.desiredConstraints)
+			update, err := DeploymentConfigTopologySpreadConstraintsMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.TopologySpreadConstraints, desired.Spec.Template.Spec.TopologySpreadConstraints) {
+				subT.Fatal(cmp.Diff(existing.Spec.Template.Spec.TopologySpreadConstraints, desired.Spec.Template.Spec.TopologySpreadConstraints))
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigPriorityClassMutator(t *testing.T) {
+	dcFactory := func(priorityClassName string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						PriorityClassName: priorityClassName,
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		testName         string
+		existingPriority string
+		desiredPriority  string
+		expectedResult   bool
+	}{
+		{"NothingToReconcile", "", "", false},
+		{"EqualPriorityClasses", "high", "high", false},
+		{"DifferentPriorityClasses", "high", "low", true},
+		{"PriorityClassAdded", "", "high", true},
+		{"PriorityClassRemoved", "high", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingPriority)
+			desired := dcFactory(tc.desiredPriority)
+			update, err := DeploymentConfigPriorityClassMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if existing.Spec.Template.Spec.PriorityClassName != tc.desiredPriority {
+				subT.Fatalf("expected priority class '%s', got '%s'", tc.desiredPriority, existing.Spec.Template.Spec.PriorityClassName)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigRuntimeClassMutator(t *testing.T) {
+	dcFactory := func(runtimeClassName *string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RuntimeClassName: runtimeClassName,
+					},
+				},
+			},
+		}
+	}
+
+	gvisor := "gvisor"
+	kata := "kata"
+
+	cases := []struct {
+		testName        string
+		existingRuntime *string
+		desiredRuntime  *string
+		expectedResult  bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"EqualRuntimeClasses", &gvisor, &gvisor, false},
+		{"DifferentRuntimeClasses", &gvisor, &kata, true},
+		{"RuntimeClassAdded", nil, &gvisor, true},
+		{"RuntimeClassRemoved", &gvisor, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingRuntime)
+			desired := dcFactory(tc.desiredRuntime)
+			update, err := DeploymentConfigRuntimeClassMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.RuntimeClassName, tc.desiredRuntime) {
+				subT.Fatalf("expected runtime class %v, got %v", tc.desiredRuntime, existing.Spec.Template.Spec.RuntimeClassName)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigDNSMutator(t *testing.T) {
+	dcFactory := func(dnsPolicy corev1.DNSPolicy, dnsConfig *corev1.PodDNSConfig) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						DNSPolicy: dnsPolicy,
+						DNSConfig: dnsConfig,
+					},
+				},
+			},
+		}
+	}
+
+	searches := &corev1.PodDNSConfig{Searches: []string{"db.example.internal"}}
+
+	cases := []struct {
+		testName          string
+		existingDNSPolicy corev1.DNSPolicy
+		existingDNSConfig *corev1.PodDNSConfig
+		desiredDNSPolicy  corev1.DNSPolicy
+		desiredDNSConfig  *corev1.PodDNSConfig
+		expectedResult    bool
+	}{
+		{"NothingToReconcile", "", nil, "", nil, false},
+		{"DefaultedDNSPolicy", corev1.DNSClusterFirst, nil, "", nil, false},
+		{"DifferentDNSPolicies", corev1.DNSClusterFirst, nil, corev1.DNSDefault, nil, true},
+		{"DNSConfigAdded", corev1.DNSClusterFirst, nil, "", searches, true},
+		{"EqualDNSConfigs", corev1.DNSClusterFirst, searches, "", searches, false},
+		{"DNSConfigRemoved", corev1.DNSClusterFirst, searches, "", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingDNSPolicy, tc.existingDNSConfig)
+			desired := dcFactory(tc.desiredDNSPolicy, tc.desiredDNSConfig)
+			update, err := DeploymentConfigDNSMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.DNSConfig, tc.desiredDNSConfig) {
+				subT.Fatalf("expected DNS config %v, got %v", tc.desiredDNSConfig, existing.Spec.Template.Spec.DNSConfig)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigHostAliasesMutator(t *testing.T) {
+	dcFactory := func(hostAliases []corev1.HostAlias) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						HostAliases: hostAliases,
+					},
+				},
+			},
+		}
+	}
+
+	idp := []corev1.HostAlias{{IP: "10.0.0.20", Hostnames: []string{"sso.example.internal"}}}
+	portal := []corev1.HostAlias{{IP: "10.0.0.30", Hostnames: []string{"admin.example.internal"}}}
+
+	cases := []struct {
+		testName            string
+		existingHostAliases []corev1.HostAlias
+		desiredHostAliases  []corev1.HostAlias
+		expectedResult      bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"EqualHostAliases", idp, idp, false},
+		{"DifferentHostAliases", idp, portal, true},
+		{"HostAliasesAdded", nil, idp, true},
+		{"HostAliasesRemoved", idp, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingHostAliases)
+			desired := dcFactory(tc.desiredHostAliases)
+			update, err := DeploymentConfigHostAliasesMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.HostAliases, tc.desiredHostAliases) {
+				subT.Fatalf("expected host aliases %v, got %v", tc.desiredHostAliases, existing.Spec.Template.Spec.HostAliases)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigImagePullSecretsMutator(t *testing.T) {
+	dcFactory := func(secrets []corev1.LocalObjectReference) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						ImagePullSecrets: secrets,
+					},
+				},
+			},
+		}
+	}
+
+	registry := []corev1.LocalObjectReference{{Name: "threescale-registry-auth"}}
+	mirror := []corev1.LocalObjectReference{{Name: "mirror-auth"}}
+
+	cases := []struct {
+		testName        string
+		existingSecrets []corev1.LocalObjectReference
+		desiredSecrets  []corev1.LocalObjectReference
+		expectedResult  bool
+	}{
+		{"NothingToReconcile", nil, nil, false},
+		{"EqualSecrets", registry, registry, false},
+		{"DifferentSecrets", registry, mirror, true},
+		{"SecretsAdded", nil, mirror, true},
+		{"SecretsRemoved", mirror, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingSecrets)
+			desired := dcFactory(tc.desiredSecrets)
+			update, err := DeploymentConfigImagePullSecretsMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.ImagePullSecrets, tc.desiredSecrets) {
+				subT.Fatalf("expected image pull secrets %v, got %v", tc.desiredSecrets, existing.Spec.Template.Spec.ImagePullSecrets)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigServiceAccountNameMutator(t *testing.T) {
+	dcFactory := func(name string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						ServiceAccountName:       name,
+						DeprecatedServiceAccount: name,
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		testName       string
+		existingName   string
+		desiredName    string
+		expectedResult bool
+		expectedName   string
+	}{
+		{"NothingToReconcile", "amp", "amp", false, "amp"},
+		{"Renamed", "amp", "threescale-workloads", true, "threescale-workloads"},
+		{"DesiredNotSet", "amp", "", false, "amp"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingName)
+			desired := dcFactory(tc.desiredName)
+			update, err := DeploymentConfigServiceAccountNameMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if existing.Spec.Template.Spec.ServiceAccountName != tc.expectedName || existing.Spec.Template.Spec.DeprecatedServiceAccount != tc.expectedName {
+				subT.Fatalf("expected service account %s, got %s/%s", tc.expectedName, existing.Spec.Template.Spec.ServiceAccountName, existing.Spec.Template.Spec.DeprecatedServiceAccount)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigSecurityContextMutator(t *testing.T) {
+	dcFactory := func(podSecurityContext *corev1.PodSecurityContext, securityContext *corev1.SecurityContext) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						SecurityContext: podSecurityContext,
+						InitContainers:  []corev1.Container{{Name: "init", SecurityContext: securityContext}},
+						Containers:      []corev1.Container{{Name: "container1", SecurityContext: securityContext}},
+					},
+				},
+			},
+		}
+	}
+
+	podSecurityContext := &corev1.PodSecurityContext{FSGroup: &[]int64{1001}[0]}
+	securityContext := &corev1.SecurityContext{RunAsNonRoot: &[]bool{true}[0]}
+
+	cases := []struct {
+		testName                   string
+		existingPodSecurityContext *corev1.PodSecurityContext
+		existingSecurityContext    *corev1.SecurityContext
+		desiredPodSecurityContext  *corev1.PodSecurityContext
+		desiredSecurityContext     *corev1.SecurityContext
+		expectedResult             bool
+	}{
+		{"NothingToReconcile", nil, nil, nil, nil, false},
+		{"DefaultedPodSecurityContext", &corev1.PodSecurityContext{}, nil, nil, nil, false},
+		{"EqualSecurityContexts", podSecurityContext, securityContext, podSecurityContext, securityContext, false},
+		{"PodSecurityContextAdded", &corev1.PodSecurityContext{}, nil, podSecurityContext, nil, true},
+		{"PodSecurityContextRemoved", podSecurityContext, nil, nil, nil, true},
+		{"SecurityContextAdded", nil, nil, nil, securityContext, true},
+		{"SecurityContextRemoved", nil, securityContext, nil, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingPodSecurityContext, tc.existingSecurityContext)
+			desired := dcFactory(tc.desiredPodSecurityContext, tc.desiredSecurityContext)
+			update, err := DeploymentConfigSecurityContextMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if update && !reflect.DeepEqual(existing.Spec.Template.Spec, desired.Spec.Template.Spec) {
+				subT.Fatal(cmp.Diff(existing.Spec.Template.Spec, desired.Spec.Template.Spec))
+			}
+		})
+	}
+}
+
 func TestDeploymentConfigEnvVarReconciler(t *testing.T) {
 	dcFactory := func(envs []corev1.EnvVar) *appsv1.DeploymentConfig {
 		return &appsv1.DeploymentConfig{
@@ -396,6 +982,58 @@ func TestDeploymentConfigEnvVarReconciler(t *testing.T) {
 	}
 }
 
+func TestDeploymentConfigContainersPortReconciler(t *testing.T) {
+	dcFactory := func(ports []corev1.ContainerPort) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Name: "container1", Ports: ports},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	httpPort := corev1.ContainerPort{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP}
+	metricsPort := corev1.ContainerPort{Name: "metrics", ContainerPort: 9090, Protocol: corev1.ProtocolTCP}
+	otherMetricsPort := corev1.ContainerPort{Name: "metrics", ContainerPort: 9091, Protocol: corev1.ProtocolTCP}
+
+	cases := []struct {
+		testName       string
+		existingPorts  []corev1.ContainerPort
+		desiredPorts   []corev1.ContainerPort
+		expectedResult bool
+		expectedPorts  []corev1.ContainerPort
+	}{
+		{"NothingToReconcile", []corev1.ContainerPort{httpPort, metricsPort}, []corev1.ContainerPort{httpPort, metricsPort}, false, []corev1.ContainerPort{httpPort, metricsPort}},
+		{"MissingPort", []corev1.ContainerPort{httpPort}, []corev1.ContainerPort{httpPort, metricsPort}, true, []corev1.ContainerPort{httpPort, metricsPort}},
+		{"UpdatedPort", []corev1.ContainerPort{httpPort, metricsPort}, []corev1.ContainerPort{httpPort, otherMetricsPort}, true, []corev1.ContainerPort{httpPort, otherMetricsPort}},
+		{"RemovedPort", []corev1.ContainerPort{httpPort, metricsPort}, []corev1.ContainerPort{httpPort}, true, []corev1.ContainerPort{httpPort}},
+		{"OtherPortsKept", []corev1.ContainerPort{httpPort}, []corev1.ContainerPort{}, false, []corev1.ContainerPort{httpPort}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingPorts)
+			desired := dcFactory(tc.desiredPorts)
+			update := DeploymentConfigContainersPortReconciler(desired, existing, "metrics")
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Spec.Containers[0].Ports, tc.expectedPorts) {
+				subT.Fatal(cmp.Diff(existing.Spec.Template.Spec.Containers[0].Ports, tc.expectedPorts))
+			}
+		})
+	}
+}
+
 func TestDeploymentConfigImageChangeTriggerMutator(t *testing.T) {
 	dcFactory := func(triggers []appsv1.DeploymentTriggerPolicy) *appsv1.DeploymentConfig {
 		return &appsv1.DeploymentConfig{
@@ -535,3 +1173,60 @@ func TestDeploymentConfigPodTemplateLabelsMutator(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentConfigPodTemplateAnnotationsMutator(t *testing.T) {
+	dcFactory := func(annotations map[string]string) *appsv1.DeploymentConfig {
+		return &appsv1.DeploymentConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DeploymentConfig",
+				APIVersion: "apps.openshift.io/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myDC",
+				Namespace: "myNS",
+			},
+			Spec: appsv1.DeploymentConfigSpec{
+				Template: &corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: annotations,
+					},
+				},
+			},
+		}
+	}
+
+	annotationsA := func() map[string]string { return map[string]string{"a": "1", "a2": "2"} }
+	annotationsB := func() map[string]string { return map[string]string{"a": "other", "b": "1"} }
+
+	cases := []struct {
+		testName               string
+		existingAnnotations    map[string]string
+		desiredAnnotations     map[string]string
+		expectedResult         bool
+		expectedNewAnnotations map[string]string
+	}{
+		{"NothingToReconcile", annotationsA(), annotationsA(), false, annotationsA()},
+		{"AnnotationsReconciled", annotationsB(), annotationsA(), true, map[string]string{
+			"a": "1", "a2": "2", "b": "1",
+		}},
+		{"AnnotationsAdded", nil, annotationsA(), true, annotationsA()},
+		{"DesiredWithoutAnnotations", annotationsA(), nil, false, annotationsA()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := dcFactory(tc.existingAnnotations)
+			desired := dcFactory(tc.desiredAnnotations)
+			update, err := DeploymentConfigPodTemplateAnnotationsMutator(desired, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Spec.Template.Annotations, tc.expectedNewAnnotations) {
+				subT.Fatal(cmp.Diff(existing.Spec.Template.Annotations, tc.expectedNewAnnotations))
+			}
+		})
+	}
+}
@@ -29,5 +29,13 @@ func GenericGrafanaDashboardsMutator(existingObj, desiredObj common.KubernetesOb
 		updated = true
 	}
 
+	// Labels are replaced rather than merged, the labels of a previous
+	// dashboard label selector must not be kept
+	if !reflect.DeepEqual(existing.GetLabels(), desired.GetLabels()) {
+		log.V(1).Info(fmt.Sprintf("%s labels have changed: %s", common.ObjectInfo(desired), cmp.Diff(existing.GetLabels(), desired.GetLabels())))
+		existing.SetLabels(desired.GetLabels())
+		updated = true
+	}
+
 	return updated, nil
 }
@@ -1,9 +1,11 @@
 package reconcilers
 
 import (
+	"reflect"
 	"testing"
 
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGenericGrafanaDashboardsMutatorWhenCopied(t *testing.T) {
@@ -64,3 +66,29 @@ func TestGenericGrafanaDashboardsMutatorWhenDiff(t *testing.T) {
 		t.Errorf("Spec.Json does not match. got [%s], expected [%s]", existing.Spec.Json, desired.Spec.Json)
 	}
 }
+
+func TestGenericGrafanaDashboardsMutatorWhenLabelsDiff(t *testing.T) {
+	existing := &grafanav1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "3scale-api-management", "monitoring-key": "middleware"},
+		},
+	}
+	desired := &grafanav1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "3scale-api-management", "grafana": "dashboards"},
+		},
+	}
+
+	update, err := GenericGrafanaDashboardsMutator(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !update {
+		t.Fatal("when existing and desired labels are different, reconciler reported not update needed")
+	}
+
+	if !reflect.DeepEqual(existing.GetLabels(), desired.GetLabels()) {
+		t.Errorf("Labels do not match. got [%v], expected [%v]", existing.GetLabels(), desired.GetLabels())
+	}
+}
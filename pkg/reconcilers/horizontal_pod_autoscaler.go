@@ -0,0 +1,28 @@
+package reconcilers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+)
+
+func GenericHPAMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *autoscalingv2beta2.HorizontalPodAutoscaler", existingObj)
+	}
+	desired, ok := desiredObj.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *autoscalingv2beta2.HorizontalPodAutoscaler", desiredObj)
+	}
+
+	updated := false
+	if !reflect.DeepEqual(desired.Spec, existing.Spec) {
+		existing.Spec = desired.Spec
+		updated = true
+	}
+
+	return updated, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/3scale/3scale-operator/pkg/common"
 	"k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func GenericPDBMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
@@ -26,3 +27,25 @@ func GenericPDBMutator(existingObj, desiredObj common.KubernetesObject) (bool, e
 
 	return updated, nil
 }
+
+// PodDisruptionBudgetBlocksEviction returns true when the PDB does not allow
+// evicting any of the replicas, e.g. minAvailable 1 with a single replica.
+// Such a PDB blocks draining the node the pods run on
+func PodDisruptionBudgetBlocksEviction(pdb *v1beta1.PodDisruptionBudget, replicas int32) bool {
+	if replicas <= 0 {
+		return false
+	}
+
+	// Percentages are rounded up, as the disruption controller does
+	if pdb.Spec.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetValueFromIntOrPercent(pdb.Spec.MaxUnavailable, int(replicas), true)
+		return err == nil && maxUnavailable <= 0
+	}
+
+	if pdb.Spec.MinAvailable != nil {
+		minAvailable, err := intstr.GetValueFromIntOrPercent(pdb.Spec.MinAvailable, int(replicas), true)
+		return err == nil && minAvailable >= int(replicas)
+	}
+
+	return false
+}
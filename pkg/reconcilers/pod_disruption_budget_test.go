@@ -42,3 +42,56 @@ func TestGenericPDBMutator(t *testing.T) {
 		t.Fatalf("Maxunavailable not reconciled. Expected: %d, got: %d", desiredMaxUnavailable, existing.Spec.MaxUnavailable.IntVal)
 	}
 }
+
+func TestGenericPDBMutatorPolicyChange(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	existing := pdbTestFactory(1)
+	desired := pdbTestFactory(1)
+	desired.Spec.MaxUnavailable = nil
+	desired.Spec.MinAvailable = &minAvailable
+
+	update, err := GenericPDBMutator(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update {
+		t.Fatal("policy change not detected")
+	}
+	if existing.Spec.MaxUnavailable != nil || existing.Spec.MinAvailable == nil || existing.Spec.MinAvailable.StrVal != "50%" {
+		t.Fatalf("policy not reconciled: %+v", existing.Spec)
+	}
+}
+
+func TestPodDisruptionBudgetBlocksEviction(t *testing.T) {
+	pdbFactory := func(minAvailable, maxUnavailable *intstr.IntOrString) *policyv1beta1.PodDisruptionBudget {
+		pdb := pdbTestFactory(0)
+		pdb.Spec.MinAvailable = minAvailable
+		pdb.Spec.MaxUnavailable = maxUnavailable
+		return pdb
+	}
+	intOrStr := func(value intstr.IntOrString) *intstr.IntOrString { return &value }
+
+	cases := []struct {
+		testName       string
+		pdb            *policyv1beta1.PodDisruptionBudget
+		replicas       int32
+		expectedResult bool
+	}{
+		{"DefaultSingleReplica", pdbFactory(nil, intOrStr(intstr.FromInt(1))), 1, false},
+		{"MaxUnavailableZero", pdbFactory(nil, intOrStr(intstr.FromInt(0))), 3, true},
+		{"MaxUnavailablePercentageRoundsUp", pdbFactory(nil, intOrStr(intstr.FromString("10%"))), 1, false},
+		{"MinAvailableSingleReplica", pdbFactory(intOrStr(intstr.FromInt(1)), nil), 1, true},
+		{"MinAvailableSeveralReplicas", pdbFactory(intOrStr(intstr.FromInt(1)), nil), 3, false},
+		{"MinAvailableAllPercentage", pdbFactory(intOrStr(intstr.FromString("100%")), nil), 3, true},
+		{"MinAvailablePercentageRoundsUp", pdbFactory(intOrStr(intstr.FromString("50%")), nil), 1, true},
+		{"ScaledDown", pdbFactory(intOrStr(intstr.FromInt(1)), nil), 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			if result := PodDisruptionBudgetBlocksEviction(tc.pdb, tc.replicas); result != tc.expectedResult {
+				subT.Errorf("expected: %t, got: %t", tc.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package reconcilers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func GenericPrometheusRuleMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*monitoringv1.PrometheusRule)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PrometheusRule", existingObj)
+	}
+	desired, ok := desiredObj.(*monitoringv1.PrometheusRule)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *monitoringv1.PrometheusRule", desiredObj)
+	}
+
+	updated := false
+
+	if !reflect.DeepEqual(existing.Spec, desired.Spec) {
+		diff := cmp.Diff(existing.Spec, desired.Spec)
+		log.V(1).Info(fmt.Sprintf("%s spec has changed: %s", common.ObjectInfo(desired), diff))
+		existing.Spec = desired.Spec
+		updated = true
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,50 @@
+package reconcilers
+
+import (
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestGenericPrometheusRuleMutator(t *testing.T) {
+	desired := &monitoringv1.PrometheusRule{
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "ns/test.rules",
+					Rules: []monitoringv1.Rule{
+						{Record: "namespace:test:ratio", Expr: intstr.FromString("vector(1)")},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("equal", func(subT *testing.T) {
+		existing := desired.DeepCopy()
+		update, err := GenericPrometheusRuleMutator(existing, desired)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if update {
+			subT.Fatal("when existing and desired are cloned, reconciler reported update needed")
+		}
+	})
+
+	t.Run("different", func(subT *testing.T) {
+		existing := desired.DeepCopy()
+		existing.Spec.Groups[0].Rules[0].Expr = intstr.FromString("vector(0)")
+		update, err := GenericPrometheusRuleMutator(existing, desired)
+		if err != nil {
+			subT.Fatal(err)
+		}
+		if !update {
+			subT.Fatal("when existing and desired are different, reconciler reported not update needed")
+		}
+		if !reflect.DeepEqual(existing.Spec, desired.Spec) {
+			subT.Errorf("spec does not match. got %v, expected %v", existing.Spec, desired.Spec)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package reconcilers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// RoleBindingSubjectsMutator reconciles the subjects of the RoleBinding.
+// The role reference is immutable and is not reconciled
+func RoleBindingSubjectsMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*rbacv1.RoleBinding)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *rbacv1.RoleBinding", existingObj)
+	}
+	desired, ok := desiredObj.(*rbacv1.RoleBinding)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *rbacv1.RoleBinding", desiredObj)
+	}
+
+	if reflect.DeepEqual(existing.Subjects, desired.Subjects) {
+		return false, nil
+	}
+
+	existing.Subjects = desired.Subjects
+	return true, nil
+}
@@ -0,0 +1,55 @@
+package reconcilers
+
+import (
+	"reflect"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRoleBindingSubjectsMutator(t *testing.T) {
+	cases := []struct {
+		testName       string
+		existingName   string
+		desiredName    string
+		expectedResult bool
+	}{
+		{"NothingToReconcile", "zync-que-sa", "zync-que-sa", false},
+		{"SubjectRenamed", "zync-que-sa", "zync-que-workload-identity", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			existing := roleBindingTestFactory(tc.existingName)
+			desired := roleBindingTestFactory(tc.desiredName)
+			update, err := RoleBindingSubjectsMutator(existing, desired)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if update != tc.expectedResult {
+				subT.Fatalf("result failed, expected: %t, got: %t", tc.expectedResult, update)
+			}
+			if !reflect.DeepEqual(existing.Subjects, desired.Subjects) {
+				subT.Fatalf("expected subjects %v, got %v", desired.Subjects, existing.Subjects)
+			}
+		})
+	}
+}
+
+func roleBindingTestFactory(serviceAccountName string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "myrolebinding",
+			Namespace: "someNs",
+		},
+		Subjects: []rbacv1.Subject{
+			{Kind: "ServiceAccount", Name: serviceAccountName},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     "myrole",
+		},
+	}
+}
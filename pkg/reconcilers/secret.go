@@ -4,13 +4,16 @@ import (
 	"fmt"
 
 	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/helper"
 
 	v1 "k8s.io/api/core/v1"
 )
 
 // DefaultsOnlySecretMutator is useful for secrets pre-created by the user and when not all the fields are created.
 // Fields referenced from deployment configs must exist,
-// so defaults only reconciliation makes sure they exist with default values when user does doe pre-create them
+// so defaults only reconciliation makes sure they exist with default values when user does doe pre-create them.
+// Fields named in the helper.SecretResetFieldAnnotation of the existing secret are overwritten
+// with the desired value, and the annotation removed
 func DefaultsOnlySecretMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
 	existing, ok := existingObj.(*v1.Secret)
 	if !ok {
@@ -27,13 +30,19 @@ func DefaultsOnlySecretMutator(existingObj, desiredObj common.KubernetesObject)
 		existing.StringData = map[string]string{}
 	}
 
+	resetFields := helper.SecretResetFields(existing)
 	for k, v := range desired.StringData {
-		if _, ok := existing.Data[k]; !ok {
+		if _, ok := existing.Data[k]; !ok || resetFields[k] {
 			existing.StringData[k] = v
 			updated = true
 		}
 	}
 
+	if _, ok := existing.Annotations[helper.SecretResetFieldAnnotation]; ok {
+		delete(existing.Annotations, helper.SecretResetFieldAnnotation)
+		updated = true
+	}
+
 	return updated, nil
 }
 
@@ -76,3 +76,45 @@ func TestDefaultsOnlySecretReconciler(t *testing.T) {
 		t.Fatal("existingSecret does not have a3 data")
 	}
 }
+
+func TestDefaultsOnlySecretMutatorResetField(t *testing.T) {
+	desired := &v1.Secret{
+		StringData: map[string]string{
+			"a1": "a1Value",
+			"a2": "a2Value",
+		},
+	}
+	existing := &v1.Secret{
+		StringData: map[string]string{
+			"a1": "other_a1_value",
+			"a2": "other_a2_value",
+		},
+	}
+	existing.Data = helper.GetSecretDataFromStringData(existing.StringData)
+	existing.Annotations = map[string]string{helper.SecretResetFieldAnnotation: "a2, a3"}
+
+	update, err := DefaultsOnlySecretMutator(existing, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !update {
+		t.Fatal("when fields are reset, reconciler reported no update needed")
+	}
+
+	if existing.StringData["a1"] != "other_a1_value" {
+		t.Fatalf("existingSecret data not expected. Expected: 'other_a1_value', got: %s", existing.StringData["a1"])
+	}
+
+	if existing.StringData["a2"] != "a2Value" {
+		t.Fatalf("existingSecret data not expected. Expected: 'a2Value', got: %s", existing.StringData["a2"])
+	}
+
+	if _, ok := existing.StringData["a3"]; ok {
+		t.Fatal("existingSecret has a3 data not in the desired secret")
+	}
+
+	if _, ok := existing.Annotations[helper.SecretResetFieldAnnotation]; ok {
+		t.Fatal("existingSecret reset annotation not removed")
+	}
+}
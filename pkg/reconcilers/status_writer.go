@@ -0,0 +1,142 @@
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatusMutateFn sets the desired status into the given object.
+// It may be called more than once per write: when the write conflicts,
+// the object is read again from the cluster and the function re-applied.
+type StatusMutateFn func(obj common.KubernetesObject) error
+
+// StatusWriter writes the status subresource of objects using merge patches
+// touching only the status. Writes are skipped when the status is unchanged.
+// The patches carry the resourceVersion of the object, so a status computed from
+// a stale object is rejected with a conflict instead of overwriting newer changes.
+type StatusWriter struct {
+	client client.Client
+	ctx    context.Context
+	logger logr.Logger
+}
+
+func NewStatusWriter(ctx context.Context, client client.Client, logger logr.Logger) *StatusWriter {
+	return &StatusWriter{
+		client: client,
+		ctx:    ctx,
+		logger: logger,
+	}
+}
+
+// Write applies mutateFn on obj and patches the status subresource when the status changed.
+// All the status changes done by mutateFn are sent in a single write.
+// On conflict, obj is refreshed from the cluster and the write retried.
+//
+// It returns whether the status has been written.
+func (w *StatusWriter) Write(obj common.KubernetesObject, mutateFn StatusMutateFn) (bool, error) {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return false, err
+	}
+
+	written := false
+	refresh := false
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if refresh {
+			if err := w.client.Get(w.ctx, key, obj); err != nil {
+				return err
+			}
+		}
+
+		base, ok := obj.DeepCopyObject().(common.KubernetesObject)
+		if !ok {
+			return fmt.Errorf("%T is not a common.KubernetesObject", obj)
+		}
+
+		if err := mutateFn(obj); err != nil {
+			return err
+		}
+
+		equalStatus, err := statusEquals(base, obj)
+		if err != nil {
+			return err
+		}
+		if equalStatus {
+			return nil
+		}
+
+		w.logger.Info(fmt.Sprintf("Patched status of object '%s/%s'", strings.Replace(fmt.Sprintf("%T", obj), "*", "", 1), obj.GetName()))
+		err = w.client.Status().Patch(w.ctx, obj, optimisticLockMergeFrom(base))
+		if errors.IsConflict(err) {
+			refresh = true
+		}
+		if err == nil {
+			written = true
+		}
+		return err
+	})
+
+	return written, err
+}
+
+// optimisticLockPatch is a merge patch sending the resourceVersion of the base object,
+// which the API server checks before applying the patch
+type optimisticLockPatch struct {
+	client.Patch
+	resourceVersion string
+}
+
+func optimisticLockMergeFrom(base common.KubernetesObject) client.Patch {
+	return &optimisticLockPatch{Patch: client.MergeFrom(base), resourceVersion: base.GetResourceVersion()}
+}
+
+func (p *optimisticLockPatch) Data(obj runtime.Object) ([]byte, error) {
+	data, err := p.Patch.Data(obj)
+	if err != nil || p.resourceVersion == "" {
+		return data, err
+	}
+
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	metadata, ok := patch["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+	}
+	metadata["resourceVersion"] = p.resourceVersion
+	patch["metadata"] = metadata
+	return json.Marshal(patch)
+}
+
+// statusEquals deep compares the status subtree of both objects
+func statusEquals(a, b runtime.Object) (bool, error) {
+	aStatus, err := statusSubtree(a)
+	if err != nil {
+		return false, err
+	}
+	bStatus, err := statusSubtree(b)
+	if err != nil {
+		return false, err
+	}
+	return equality.Semantic.DeepEqual(aStatus, bStatus), nil
+}
+
+func statusSubtree(obj runtime.Object) (interface{}, error) {
+	unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return unstructuredObj["status"], nil
+}
@@ -0,0 +1,201 @@
+package reconcilers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// countingClient counts the status writes and reads done against the wrapped client.
+// The first pendingConflicts status writes fail with a conflict error.
+type countingClient struct {
+	client.Client
+	gets             int
+	statusWrites     int
+	pendingConflicts int
+}
+
+func (c *countingClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	c.gets++
+	return c.Client.Get(ctx, key, obj)
+}
+
+func (c *countingClient) Status() client.StatusWriter {
+	return &countingStatusWriter{StatusWriter: c.Client.Status(), parent: c}
+}
+
+type countingStatusWriter struct {
+	client.StatusWriter
+	parent *countingClient
+}
+
+func (w *countingStatusWriter) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	if err := w.count(obj); err != nil {
+		return err
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func (w *countingStatusWriter) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := w.count(obj); err != nil {
+		return err
+	}
+	return w.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func (w *countingStatusWriter) count(obj runtime.Object) error {
+	w.parent.statusWrites++
+	if w.parent.pendingConflicts > 0 {
+		w.parent.pendingConflicts--
+		return errors.NewConflict(schema.GroupResource{Resource: "pods"}, "mypod", nil)
+	}
+	return nil
+}
+
+func statusWriterTestPod() *v1.Pod {
+	return &v1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mypod", Namespace: "operator-unittest"},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: v1.ConditionFalse},
+			},
+		},
+	}
+}
+
+func TestStatusWriter(t *testing.T) {
+	cases := []struct {
+		testName             string
+		pendingConflicts     int
+		mutateFn             func(pod *v1.Pod)
+		expectedWritten      bool
+		expectedStatusWrites int
+		expectedGets         int
+		expectedPhase        v1.PodPhase
+	}{
+		{"unchanged", 0, func(pod *v1.Pod) {}, false, 0, 0, v1.PodPending},
+		{"unchanged after assigning same values", 0, func(pod *v1.Pod) {
+			pod.Status = statusWriterTestPod().Status
+		}, false, 0, 0, v1.PodPending},
+		{"several changes", 0, func(pod *v1.Pod) {
+			pod.Status.Phase = v1.PodRunning
+			pod.Status.Conditions[0].Status = v1.ConditionTrue
+			pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{Type: v1.ContainersReady, Status: v1.ConditionTrue})
+		}, true, 1, 0, v1.PodRunning},
+		{"conflict", 2, func(pod *v1.Pod) {
+			pod.Status.Phase = v1.PodRunning
+		}, true, 3, 2, v1.PodRunning},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			pod := statusWriterTestPod()
+			cl := &countingClient{Client: fake.NewFakeClient(pod.DeepCopy()), pendingConflicts: tc.pendingConflicts}
+			writer := NewStatusWriter(context.TODO(), cl, log)
+
+			written, err := writer.Write(pod, func(common.KubernetesObject) error {
+				tc.mutateFn(pod)
+				return nil
+			})
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if written != tc.expectedWritten {
+				subT.Errorf("written: expected %t, got %t", tc.expectedWritten, written)
+			}
+			if cl.statusWrites != tc.expectedStatusWrites {
+				subT.Errorf("status writes: expected %d, got %d", tc.expectedStatusWrites, cl.statusWrites)
+			}
+			if cl.gets != tc.expectedGets {
+				subT.Errorf("gets: expected %d, got %d", tc.expectedGets, cl.gets)
+			}
+
+			existing := &v1.Pod{}
+			err = cl.Client.Get(context.TODO(), client.ObjectKey{Name: pod.Name, Namespace: pod.Namespace}, existing)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if existing.Status.Phase != tc.expectedPhase {
+				subT.Errorf("phase: expected %s, got %s", tc.expectedPhase, existing.Status.Phase)
+			}
+		})
+	}
+}
+
+func TestStatusWriterConflictRetriesExhausted(t *testing.T) {
+	pod := statusWriterTestPod()
+	cl := &countingClient{Client: fake.NewFakeClient(pod.DeepCopy()), pendingConflicts: 100}
+	writer := NewStatusWriter(context.TODO(), cl, log)
+
+	written, err := writer.Write(pod, func(common.KubernetesObject) error {
+		pod.Status.Phase = v1.PodRunning
+		return nil
+	})
+	if !errors.IsConflict(err) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+	if written {
+		t.Fatal("status should not be written")
+	}
+}
+
+func TestStatusWriterStaleObject(t *testing.T) {
+	// Created, instead of tracked by the fake client, to be versioned
+	cl := &countingClient{Client: fake.NewFakeClient()}
+	if err := cl.Client.Create(context.TODO(), statusWriterTestPod()); err != nil {
+		t.Fatal(err)
+	}
+	key := client.ObjectKey{Name: "mypod", Namespace: "operator-unittest"}
+
+	pod := &v1.Pod{}
+	if err := cl.Client.Get(context.TODO(), key, pod); err != nil {
+		t.Fatal(err)
+	}
+
+	// Status written by someone else after pod was read
+	other := pod.DeepCopy()
+	other.Status.Conditions = append(other.Status.Conditions, v1.PodCondition{Type: v1.PodScheduled, Status: v1.ConditionTrue})
+	if err := cl.Client.Status().Update(context.TODO(), other); err != nil {
+		t.Fatal(err)
+	}
+
+	writer := NewStatusWriter(context.TODO(), cl, log)
+	written, err := writer.Write(pod, func(common.KubernetesObject) error {
+		pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{Type: v1.ContainersReady, Status: v1.ConditionTrue})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !written {
+		t.Fatal("status should be written")
+	}
+	if cl.statusWrites != 2 || cl.gets != 1 {
+		t.Errorf("expected the write retried after a read, got %d status writes and %d gets", cl.statusWrites, cl.gets)
+	}
+
+	existing := &v1.Pod{}
+	if err := cl.Client.Get(context.TODO(), key, existing); err != nil {
+		t.Fatal(err)
+	}
+	conditionTypes := []v1.PodConditionType{}
+	for _, condition := range existing.Status.Conditions {
+		conditionTypes = append(conditionTypes, condition.Type)
+	}
+	expected := []v1.PodConditionType{v1.PodReady, v1.PodScheduled, v1.ContainersReady}
+	if !reflect.DeepEqual(conditionTypes, expected) {
+		t.Errorf("conditions: expected %v, got %v", expected, conditionTypes)
+	}
+}
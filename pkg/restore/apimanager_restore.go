@@ -98,7 +98,7 @@ func (b *APIManagerRestore) RestoreSecretsAndConfigMapsFromPVCJob() *batchv1.Job
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -138,7 +138,7 @@ func (b *APIManagerRestore) RestoreSecretsAndConfigMapsFromPVCJob() *batchv1.Job
 				},
 			},
 		},
-	}
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerRestore) RestoreSystemFileStoragePVCFromPVCJob() *batchv1.Job {
@@ -152,7 +152,7 @@ func (b *APIManagerRestore) RestoreSystemFileStoragePVCFromPVCJob() *batchv1.Job
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -194,7 +194,7 @@ func (b *APIManagerRestore) RestoreSystemFileStoragePVCFromPVCJob() *batchv1.Job
 				},
 			},
 		},
-	}
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerRestore) CreateAPIManagerSharedSecretJob() *batchv1.Job {
@@ -208,7 +208,7 @@ func (b *APIManagerRestore) CreateAPIManagerSharedSecretJob() *batchv1.Job {
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -248,7 +248,7 @@ func (b *APIManagerRestore) CreateAPIManagerSharedSecretJob() *batchv1.Job {
 				},
 			},
 		},
-	}
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerRestore) ZyncResyncDomainsJob() *batchv1.Job {
@@ -262,7 +262,7 @@ func (b *APIManagerRestore) ZyncResyncDomainsJob() *batchv1.Job {
 	}
 
 	var completions int32 = 1
-	return &batchv1.Job{
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
@@ -295,7 +295,76 @@ func (b *APIManagerRestore) ZyncResyncDomainsJob() *batchv1.Job {
 				},
 			},
 		},
+	}, b.options.JobPodTemplate)
+}
+
+// VerifyBackupFromPVCJob performs a restore rehearsal. The checksums of the
+// backup data are verified and the backed up objects are checked to be valid
+// Kubernetes objects. Nothing is restored. The result is reported in the
+// termination message, naming the failed artifact on failure
+func (b *APIManagerRestore) VerifyBackupFromPVCJob() *batchv1.Job {
+	if b.options.APIManagerRestorePVCOptions == nil {
+		return nil
+	}
+
+	jobName, err := helper.UIDBasedJobName("restore-verify", b.options.APIManagerRestoreUID)
+	if err != nil {
+		panic(err)
 	}
+
+	// The backup data is never modified by the verification
+	readOnlySource := b.options.APIManagerRestorePVCOptions.PersistentVolumeClaimVolumeSource
+	readOnlySource.ReadOnly = true
+
+	var completions int32 = 1
+	// Fail fast, a checksum mismatch will not be fixed retrying
+	var backoffLimit int32 = 0
+	return helper.JobWithPodTemplateOptions(&batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: b.options.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:  &completions,
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{
+						v1.Volume{
+							Name: readOnlySource.ClaimName,
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &readOnlySource,
+							},
+						},
+					},
+					Containers: []v1.Container{
+						v1.Container{
+							Name:  "job",
+							Image: b.options.OCCLIImageURL,
+							Command: []string{
+								"/bin/bash",
+							},
+							Args: []string{
+								"-c",
+								"-e",
+								b.verifyBackupContainerArgs(),
+							},
+							VolumeMounts: []v1.VolumeMount{
+								b.restoreSourcePVCContainerVolumeMount(),
+							},
+							TerminationMessagePolicy: v1.TerminationMessageReadFile,
+						},
+					},
+					RestartPolicy:      v1.RestartPolicyNever, // Only "Never" or "OnFailure" are accepted in Kubernetes Jobs
+					ServiceAccountName: ServiceAccountName,
+				},
+			},
+		},
+	}, b.options.JobPodTemplate)
 }
 
 func (b *APIManagerRestore) SystemStoragePVC(restoreInfo *RuntimeAPIManagerRestoreInfo) *v1.PersistentVolumeClaim {
@@ -393,6 +462,55 @@ func (b *APIManagerRestore) restoreSecretsAndConfigMapsContainerArgs() string {
 	)
 }
 
+func (b *APIManagerRestore) verifyBackupContainerArgs() string {
+	return fmt.Sprintf(`
+BASEPATH='%s';
+ARTIFACTS='%s';
+CHECKSUMS_SUBDIR="${BASEPATH}/%s";
+PYTHON_CHECK_SUBSCRIPT="%s";
+fail() {
+  echo "$1";
+  echo -n "$1" > /dev/termination-log;
+  exit 1;
+}
+VERIFIED="";
+for i in $(echo -n $ARTIFACTS); do
+  if [ ! -d ${BASEPATH}/${i} ]; then
+    echo "Artifact ${i} not found. Skipping";
+    continue;
+  fi
+  if [ ! -f ${CHECKSUMS_SUBDIR}/${i}.sha256 ]; then
+    fail "artifact ${i}: checksums not found";
+  fi
+  (cd ${BASEPATH}/${i} && sha256sum --quiet -c ${CHECKSUMS_SUBDIR}/${i}.sha256) || fail "artifact ${i}: checksum mismatch";
+  VERIFIED="${VERIFIED} ${i}";
+done;
+for f in ${BASEPATH}/secrets/*.json ${BASEPATH}/configmaps/*.json ${BASEPATH}/apimanager/*.json; do
+  if [ ! -f ${f} ]; then
+    continue;
+  fi
+  python -c "${PYTHON_CHECK_SUBSCRIPT}" < ${f} || fail "artifact ${f#${BASEPATH}/}: not a restorable object";
+done;
+echo -n "verified artifacts:${VERIFIED}" | tee /dev/termination-log;
+`,
+		RestorePVCMountPath,
+		strings.Join(backup.BackupArtifacts, " "),
+		backup.ChecksumsSubdir,
+		b.pythonCheckK8sObjectScript(),
+	)
+}
+
+// pythonCheckK8sObjectScript checks the backed up object can be created
+func (b *APIManagerRestore) pythonCheckK8sObjectScript() string {
+	return `
+import sys, json
+
+parsed=json.load(sys.stdin)
+if not parsed.get('apiVersion') or not parsed.get('kind') or not parsed.get('metadata', {}).get('name'):
+  sys.exit(1)
+`
+}
+
 func (b *APIManagerRestore) zyncResyncDomainsContainerArgs() string {
 	return `
 	dcname="system-sidekiq"
@@ -1,6 +1,7 @@
 package restore
 
 import (
+	"github.com/3scale/3scale-operator/pkg/helper"
 	validator "github.com/go-playground/validator/v10"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -12,6 +13,11 @@ type APIManagerRestoreOptions struct {
 
 	APIManagerRestorePVCOptions *APIManagerRestorePVCOptions `validate:"required"`
 	OCCLIImageURL               string                       `validate:"required"`
+
+	Verify bool // Restore rehearsal: only the backup data is verified
+
+	// Scheduling settings of the restore job pods
+	JobPodTemplate *helper.JobPodTemplateOptions `validate:"-"`
 }
 
 func NewAPIManagerRestoreOptions() *APIManagerRestoreOptions {
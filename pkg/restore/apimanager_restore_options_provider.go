@@ -28,6 +28,8 @@ func (a *APIManagerRestoreOptionsProvider) Options() (*APIManagerRestoreOptions,
 	res.Namespace = a.APIManagerRestoreCR.Namespace
 
 	res.OCCLIImageURL = a.ocCLIImageURL()
+	res.Verify = a.APIManagerRestoreCR.VerifyOnly()
+	res.JobPodTemplate = a.APIManagerRestoreCR.Spec.JobsTemplate.JobPodTemplateOptions()
 
 	pvcOptions, err := a.pvcRestoreOptions()
 	if err != nil {
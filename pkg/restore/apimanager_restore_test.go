@@ -0,0 +1,89 @@
+package restore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/3scale/3scale-operator/pkg/backup"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestVerifyBackupFromPVCJob(t *testing.T) {
+	options := &APIManagerRestoreOptions{
+		Namespace:             "someNS",
+		APIManagerRestoreName: "someRestore",
+		APIManagerRestoreUID:  types.UID("0123456789"),
+		APIManagerRestorePVCOptions: &APIManagerRestorePVCOptions{
+			PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{ClaimName: "apimanager-backup"},
+		},
+		OCCLIImageURL: "oc-cli",
+		Verify:        true,
+	}
+
+	job := NewAPIManagerRestore(options).VerifyBackupFromPVCJob()
+
+	if job.Spec.BackoffLimit == nil || *job.Spec.BackoffLimit != 0 {
+		t.Errorf("expected the verification not to be retried, got backoffLimit %v", job.Spec.BackoffLimit)
+	}
+
+	volumes := job.Spec.Template.Spec.Volumes
+	if len(volumes) != 1 || volumes[0].PersistentVolumeClaim == nil ||
+		volumes[0].PersistentVolumeClaim.ClaimName != "apimanager-backup" || !volumes[0].PersistentVolumeClaim.ReadOnly {
+		t.Errorf("expected the backup PVC mounted read-only, got %v", volumes)
+	}
+	if options.APIManagerRestorePVCOptions.PersistentVolumeClaimVolumeSource.ReadOnly {
+		t.Error("restore source options modified")
+	}
+
+	args := job.Spec.Template.Spec.Containers[0].Args[2]
+	for _, artifact := range backup.BackupArtifacts {
+		if !strings.Contains(args, artifact) {
+			t.Errorf("expected artifact %s to be verified", artifact)
+		}
+	}
+	if strings.Contains(args, "oc create") {
+		t.Error("expected the verification not to create objects")
+	}
+}
+
+func TestRestoreJobsPodTemplate(t *testing.T) {
+	options := &APIManagerRestoreOptions{
+		Namespace:             "someNS",
+		APIManagerRestoreName: "someRestore",
+		APIManagerRestoreUID:  types.UID("0123456789"),
+		APIManagerRestorePVCOptions: &APIManagerRestorePVCOptions{
+			PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{ClaimName: "apimanager-backup"},
+		},
+		OCCLIImageURL: "oc-cli",
+		JobPodTemplate: &helper.JobPodTemplateOptions{
+			NodeSelector:      map[string]string{"node-role": "jobs"},
+			Tolerations:       []v1.Toleration{{Key: "jobs", Operator: v1.TolerationOpExists}},
+			PriorityClassName: "restore",
+		},
+	}
+
+	restore := NewAPIManagerRestore(options)
+	jobs := []*batchv1.Job{
+		restore.RestoreSecretsAndConfigMapsFromPVCJob(),
+		restore.RestoreSystemFileStoragePVCFromPVCJob(),
+		restore.CreateAPIManagerSharedSecretJob(),
+		restore.ZyncResyncDomainsJob(),
+		restore.VerifyBackupFromPVCJob(),
+	}
+	for _, job := range jobs {
+		podSpec := job.Spec.Template.Spec
+		if podSpec.NodeSelector["node-role"] != "jobs" {
+			t.Errorf("job %s: expected node selector, got %v", job.Name, podSpec.NodeSelector)
+		}
+		if len(podSpec.Tolerations) != 1 || podSpec.Tolerations[0].Key != "jobs" {
+			t.Errorf("job %s: expected tolerations, got %v", job.Name, podSpec.Tolerations)
+		}
+		if podSpec.PriorityClassName != "restore" {
+			t.Errorf("job %s: expected priority class, got %s", job.Name, podSpec.PriorityClassName)
+		}
+	}
+}
@@ -28,6 +28,58 @@ const (
 	systemPostgreSQLPVCResourceRequestsPath  = "/spec/system/database/postgresql/persistentVolumeClaim/resources/requests"
 	productPoliciesConfigurationPath         = "/spec/policies/configuration"
 	policyConfigurationPath                  = "/spec/schema/configuration"
+	databaseBackupPVCResourceRequestsPath    = "/spec/databases/backup/persistentVolumeClaim/resources/requests"
+	databaseBackupStartTimePath              = "/status/databaseBackup/startTime"
+	databaseBackupCompletionTimePath         = "/status/databaseBackup/completionTime"
+	systemMySQLSharedMemorySizeLimitPath     = "/spec/system/database/mysql/sharedMemorySizeLimit"
+	zyncDatabaseSharedMemorySizeLimitPath    = "/spec/zync/databaseSharedMemorySizeLimit"
+	zyncDatabaseStorageSizePath              = "/spec/zync/databaseStorage/size"
+	requestLoggingStartTimePath              = "/status/backendListenerRequestLogging/startTime"
+	requestLoggingExpirationTimePath         = "/status/backendListenerRequestLogging/expirationTime"
+	fileStorageMigrationStartTimePath        = "/status/fileStorageMigration/startTime"
+	fileStorageMigrationCompletionTimePath   = "/status/fileStorageMigration/completionTime"
+	shutdownStageStartTimePath               = "/status/shutdown/stageStartTime"
+	workloadLastFailureTimestampPath         = "/status/workloads/lastFailure/timestamp"
+	lastSyncDurationPath                     = "/status/lastSyncDuration"
+	jobsTemplateAffinityPath                 = "/spec/jobsTemplate/affinity"
+)
+
+// Parents of the missing fields path omissions repeated in several objects
+var (
+	componentSpecPaths = []string{
+		"/spec/apicast/productionSpec",
+		"/spec/apicast/stagingSpec",
+		"/spec/backend/listenerSpec",
+		"/spec/backend/workerSpec",
+		"/spec/backend/cronSpec",
+		"/spec/system/appSpec",
+		"/spec/system/sidekiqSpec",
+		"/spec/system/sphinxSpec",
+		"/spec/zync/appSpec",
+		"/spec/zync/queSpec",
+	}
+	databaseSpecPaths = []string{
+		"/spec/system/database/mysql",
+		"/spec/system/database/postgresql",
+	}
+	// Parents of the database fields named after the database, e.g. redisAffinity
+	databaseFieldPrefixPaths = []string{
+		"/spec/backend/redis",
+		"/spec/system/redis",
+		"/spec/system/memcached",
+		"/spec/zync/database",
+	}
+	podDisruptionBudgetPaths = []string{
+		"/spec/podDisruptionBudget/apicastProduction",
+		"/spec/podDisruptionBudget/apicastStaging",
+		"/spec/podDisruptionBudget/backendListener",
+		"/spec/podDisruptionBudget/backendWorker",
+		"/spec/podDisruptionBudget/backendCron",
+		"/spec/podDisruptionBudget/systemApp",
+		"/spec/podDisruptionBudget/systemSidekiq",
+		"/spec/podDisruptionBudget/zync",
+		"/spec/podDisruptionBudget/zyncQue",
+	}
 )
 
 type testCRInfo struct {
@@ -180,7 +232,36 @@ func TestCompleteCRD(t *testing.T) {
 		systemPostgreSQLPVCResourceRequestsPath,
 		productPoliciesConfigurationPath,
 		policyConfigurationPath,
+		databaseBackupPVCResourceRequestsPath,
+		databaseBackupStartTimePath,
+		databaseBackupCompletionTimePath,
+		systemMySQLSharedMemorySizeLimitPath,
+		zyncDatabaseSharedMemorySizeLimitPath,
+		zyncDatabaseStorageSizePath,
+		requestLoggingStartTimePath,
+		requestLoggingExpirationTimePath,
+		fileStorageMigrationStartTimePath,
+		fileStorageMigrationCompletionTimePath,
+		shutdownStageStartTimePath,
+		workloadLastFailureTimestampPath,
+		lastSyncDurationPath,
+		jobsTemplateAffinityPath,
 	}
+	pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, "env/valueFrom/resourceFieldRef/divisor")...)
+	pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, "sidecars")...)
+	pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, "sidecarVolumes")...)
+	pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, "initContainers")...)
+	// The pod scheduling and security context fields are schemaless to keep the CRD size down
+	for _, field := range []string{"affinity", "topologySpreadConstraints", "podSecurityContext", "securityContext"} {
+		pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, field)...)
+		pathOmissions = append(pathOmissions, fieldPaths(databaseSpecPaths, field)...)
+		for _, parent := range databaseFieldPrefixPaths {
+			pathOmissions = append(pathOmissions, parent+strings.Title(field))
+		}
+	}
+	pathOmissions = append(pathOmissions, fieldPaths(componentSpecPaths, "dnsConfig")...)
+	pathOmissions = append(pathOmissions, fieldPaths(podDisruptionBudgetPaths, "maxUnavailable")...)
+	pathOmissions = append(pathOmissions, fieldPaths(podDisruptionBudgetPaths, "minAvailable")...)
 
 	for crd, elem := range crdStructMap {
 		t.Run(crd, func(subT *testing.T) {
@@ -205,6 +286,15 @@ func getSchemaVersioned(t *testing.T, crd string, version string) validation.Sch
 	return schema
 }
 
+// fieldPaths returns the path of the field in each of the parents
+func fieldPaths(parents []string, field string) []string {
+	paths := make([]string, 0, len(parents))
+	for _, parent := range parents {
+		paths = append(paths, fmt.Sprintf("%s/%s", parent, field))
+	}
+	return paths
+}
+
 func missingFieldPathInPathOmissions(path string, omissions []string) bool {
 	for _, omit := range omissions {
 		if strings.HasPrefix(path, omit) {
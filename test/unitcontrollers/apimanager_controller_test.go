@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	appsv1alpha1 "github.com/3scale/3scale-operator/apis/apps/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 
 	appscontrollers "github.com/3scale/3scale-operator/controllers/apps"
@@ -114,13 +115,17 @@ func TestAPIManagerControllerCreate(t *testing.T) {
 		t.Fatalf("get APIManager: (%v)", err)
 	}
 
-	backendListenerExistingReplicas := finalAPIManager.Spec.Backend.ListenerSpec.Replicas
-	if backendListenerExistingReplicas == nil {
-		t.Errorf("APIManager's backend listener replicas does not have a default value set")
-
+	// Replicas are not defaulted in the APIManager, they are only set on creation
+	if finalAPIManager.Spec.Backend.ListenerSpec.Replicas != nil {
+		t.Errorf("APIManager's backend listener replicas unexpectedly set to %d", *finalAPIManager.Spec.Backend.ListenerSpec.Replicas)
 	}
 
-	if *backendListenerExistingReplicas != 1 {
-		t.Errorf("APIManager's backend listener replicas size (%d) is not the expected size (%d)", backendListenerExistingReplicas, 1)
+	backendListener := &appsv1.DeploymentConfig{}
+	err = r.Client().Get(context.TODO(), types.NamespacedName{Name: component.BackendListenerName, Namespace: namespace}, backendListener)
+	if err != nil {
+		t.Fatalf("get backend listener DeploymentConfig: (%v)", err)
+	}
+	if backendListener.Spec.Replicas != 1 {
+		t.Errorf("backend listener replicas size (%d) is not the expected size (%d)", backendListener.Spec.Replicas, 1)
 	}
 }